@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging/logspec"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
@@ -157,7 +159,7 @@ func (a networkAction) do(ctx context.Context, s *Service) {
 	case protocol.AgreementVoteTag:
 		data = protocol.Encode(&a.UnauthenticatedVote)
 	case protocol.VoteBundleTag:
-		data = protocol.Encode(&a.UnauthenticatedBundle)
+		data = encodeBundle(s.Ledger, &a.UnauthenticatedBundle)
 	case protocol.ProposalPayloadTag:
 		msg := a.CompoundMessage
 		payload := transmittedPayload{
@@ -165,6 +167,10 @@ func (a networkAction) do(ctx context.Context, s *Service) {
 			PriorVote:               msg.Vote,
 		}
 		data = protocol.Encode(&payload)
+		if (a.T == broadcast || a.T == relay) && len(data) > proposalChunkSize && chunkedPayloads(s.Ledger, msg.Proposal.Round()) {
+			a.doChunked(s, msg.Proposal.value().EncodingDigest, data)
+			return
+		}
 	}
 
 	switch a.T {
@@ -179,6 +185,22 @@ func (a networkAction) do(ctx context.Context, s *Service) {
 	}
 }
 
+// doChunked sends an encoded proposal payload as a sequence of chunks.
+func (a networkAction) doChunked(s *Service, proposal crypto.Digest, data []byte) {
+	for _, chunk := range EncodeProposalChunks(proposal, data) {
+		var err error
+		switch a.T {
+		case broadcast:
+			err = s.Network.Broadcast(protocol.ProposalChunkTag, chunk)
+		case relay:
+			err = s.Network.Relay(a.h, protocol.ProposalChunkTag, chunk)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
 type cryptoAction struct {
 	nonpersistent
 
@@ -234,14 +256,28 @@ type ensureAction struct {
 	Certificate Certificate
 	// The time that the lowest proposal-vote was validated for `credentialRoundLag` rounds ago (R-credentialRoundLag). This may not have been the winning proposal, since we wait `credentialRoundLag` rounds to see if there was a better one.
 	voteValidatedAt time.Duration
+	// The sender and round of that proposal-vote, if voteValidatedAt is set.
+	voteSender basics.Address
+	voteRound  round
 	// The dynamic filter timeout calculated for this round, even if not enabled, for reporting to telemetry.
 	dynamicFilterTimeout time.Duration
+	// The filter timeout used in period 0 of this round, for reporting to telemetry.
+	filterTimeout time.Duration
 }
 
 func (a ensureAction) t() actionType {
 	return ensure
 }
 
+func (a *ensureAction) setLowestCredential(v vote, ok bool) {
+	if !ok {
+		return
+	}
+	a.voteValidatedAt = v.validatedAt
+	a.voteSender = v.R.Sender
+	a.voteRound = v.R.Round
+}
+
 func (a ensureAction) String() string {
 	return fmt.Sprintf("%s: %.5s: %v, %v, %.5s", a.t().String(), a.Payload.Digest().String(), a.Certificate.Round, a.Certificate.Period, a.Certificate.Proposal.BlockDigest.String())
 }
@@ -267,6 +303,7 @@ func (a ensureAction) do(ctx context.Context, s *Service) {
 			ReceivedAt:           a.Payload.receivedAt,
 			VoteValidatedAt:      a.voteValidatedAt,
 			DynamicFilterTimeout: a.dynamicFilterTimeout,
+			FilterTimeout:        a.filterTimeout,
 			PreValidated:         true,
 			PropBufLen:           uint64(len(s.demux.rawProposals)),
 			VoteBufLen:           uint64(len(s.demux.rawVotes)),
@@ -284,12 +321,20 @@ func (a ensureAction) do(ctx context.Context, s *Service) {
 			ReceivedAt:           a.Payload.receivedAt,
 			VoteValidatedAt:      a.voteValidatedAt,
 			DynamicFilterTimeout: a.dynamicFilterTimeout,
+			FilterTimeout:        a.filterTimeout,
 			PreValidated:         false,
 			PropBufLen:           uint64(len(s.demux.rawProposals)),
 			VoteBufLen:           uint64(len(s.demux.rawVotes)),
 		})
 		s.Ledger.EnsureBlock(block, a.Certificate)
 	}
+	if a.voteValidatedAt != 0 {
+		lp, lagging := s.lateProposers.observe(a.voteRound, a.voteSender, a.voteValidatedAt, a.filterTimeout)
+		if lagging {
+			s.log.EventWithDetails(telemetryspec.Agreement, telemetryspec.LateCredentialEvent, lp.telemetry(a.filterTimeout))
+		}
+	}
+
 	logEventStart := logEvent
 	logEventStart.Type = logspec.RoundStart
 	s.log.with(logEventStart).Infof("finished round %d", a.Certificate.Round)
@@ -354,7 +399,7 @@ func (a rezeroAction) do(ctx context.Context, s *Service) {
 
 	// Garbage collect clocks that are too old
 	for rnd := range s.historicalClocks {
-		if a.Round > rnd+credentialRoundLag {
+		if a.Round > rnd+s.dynamicFilter.credentialRoundLag() {
 			delete(s.historicalClocks, rnd)
 		}
 	}
@@ -0,0 +1,459 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+// An adversary scripts Byzantine behavior on a testingNetwork with
+// declarative rules, instead of hand-written multicastInterceptFn closures.
+//
+// For instance,
+//
+//	adv := installAdversary(baseNetwork, services)
+//	adv.from(2).votes(soft).inPeriod(1).equivocate()
+//	adv.from(0, 1).votes(cert).delay(1)
+//
+// makes node 2 equivocate on its soft votes in period 1, and holds back all
+// cert votes sent by nodes 0 and 1 until one more timeout has fired (see
+// triggerGlobalTimeout).
+//
+// Rules are checked in the order they were added; the first matching rule
+// decides what happens to a message. Messages which match no rule are
+// delivered unchanged.
+type adversary struct {
+	network  *testingNetwork
+	services []*Service
+
+	mu deadlock.Mutex // guards all fields below
+
+	rules   []*adversaryRule
+	delayed []delayedMulticast
+}
+
+type adversaryAction int
+
+const (
+	adversaryDeliver adversaryAction = iota
+	adversaryDrop
+	adversaryDelay
+	adversaryEquivocate
+)
+
+// An adversaryRule matches a class of messages sent into a testingNetwork.
+// Each filter which is left unset matches all messages.
+type adversaryRule struct {
+	adv *adversary
+
+	sources map[nodeID]bool
+	tag     protocol.Tag
+	steps   map[step]bool
+	periods map[period]bool
+	rounds  map[round]bool
+
+	action  adversaryAction
+	timeout int // for adversaryDelay
+
+	hits int
+}
+
+type delayedMulticast struct {
+	params    multicastParams
+	remaining int
+}
+
+// installAdversary attaches a new adversary to the network. Equivocating
+// nodes sign their votes with the keys of the given services.
+//
+// The adversary is removed by network.repairAll.
+func installAdversary(network *testingNetwork, services []*Service) *adversary {
+	adv := &adversary{network: network, services: services}
+
+	network.mu.Lock()
+	defer network.mu.Unlock()
+	network.adversary = adv
+	return adv
+}
+
+// from starts a rule matching messages sent by the given nodes.
+func (a *adversary) from(sources ...nodeID) *adversaryRule {
+	r := &adversaryRule{adv: a, sources: make(map[nodeID]bool)}
+	for _, id := range sources {
+		r.sources[id] = true
+	}
+	return r
+}
+
+// fromAll starts a rule matching messages sent by any node.
+func (a *adversary) fromAll() *adversaryRule {
+	return &adversaryRule{adv: a}
+}
+
+// votes restricts the rule to votes, and if any steps are given, to votes
+// from those steps.
+func (r *adversaryRule) votes(steps ...step) *adversaryRule {
+	r.tag = protocol.AgreementVoteTag
+	return r.inStep(steps...)
+}
+
+// bundles restricts the rule to vote bundles, and if any steps are given, to
+// bundles from those steps.
+func (r *adversaryRule) bundles(steps ...step) *adversaryRule {
+	r.tag = protocol.VoteBundleTag
+	return r.inStep(steps...)
+}
+
+// proposals restricts the rule to proposal payloads.
+func (r *adversaryRule) proposals() *adversaryRule {
+	r.tag = protocol.ProposalPayloadTag
+	return r
+}
+
+func (r *adversaryRule) inStep(steps ...step) *adversaryRule {
+	if len(steps) == 0 {
+		return r
+	}
+	r.steps = make(map[step]bool)
+	for _, s := range steps {
+		r.steps[s] = true
+	}
+	return r
+}
+
+// inPeriod restricts the rule to messages from the given periods. The period
+// of a proposal payload is its original period.
+func (r *adversaryRule) inPeriod(periods ...period) *adversaryRule {
+	r.periods = make(map[period]bool)
+	for _, p := range periods {
+		r.periods[p] = true
+	}
+	return r
+}
+
+// inRound restricts the rule to messages from the given rounds.
+func (r *adversaryRule) inRound(rounds ...round) *adversaryRule {
+	r.rounds = make(map[round]bool)
+	for _, rnd := range rounds {
+		r.rounds[rnd] = true
+	}
+	return r
+}
+
+// drop discards all matching messages.
+func (r *adversaryRule) drop() *adversaryRule {
+	r.action = adversaryDrop
+	return r.install()
+}
+
+// delay holds back all matching messages until the given number of timeouts
+// have fired through adversary.triggerGlobalTimeout.
+func (r *adversaryRule) delay(timeouts int) *adversaryRule {
+	if timeouts <= 0 {
+		panic(fmt.Errorf("adversary: cannot delay by %d timeouts", timeouts))
+	}
+	r.action = adversaryDelay
+	r.timeout = timeouts
+	return r.install()
+}
+
+// equivocate follows every matching vote cast by the sender with a second
+// vote for a different, fabricated proposal-value.
+func (r *adversaryRule) equivocate() *adversaryRule {
+	if r.tag != protocol.AgreementVoteTag {
+		panic("adversary: only votes may be equivocated")
+	}
+	r.action = adversaryEquivocate
+	return r.install()
+}
+
+// deliver lets matching messages through unchanged, shadowing any rules
+// which are added later.
+func (r *adversaryRule) deliver() *adversaryRule {
+	r.action = adversaryDeliver
+	return r.install()
+}
+
+func (r *adversaryRule) install() *adversaryRule {
+	r.adv.mu.Lock()
+	defer r.adv.mu.Unlock()
+	r.adv.rules = append(r.adv.rules, r)
+	return r
+}
+
+// matched returns the number of messages which this rule has acted on.
+func (r *adversaryRule) matched() int {
+	r.adv.mu.Lock()
+	defer r.adv.mu.Unlock()
+	return r.hits
+}
+
+// adversaryMessage holds the decoded fields of a multicast which rules may
+// filter on.
+type adversaryMessage struct {
+	round  round
+	period period
+	step   step
+	vote   *unauthenticatedVote
+}
+
+func decodeAdversaryMessage(params multicastParams) (m adversaryMessage) {
+	r := bytes.NewBuffer(params.data)
+	switch params.tag {
+	case protocol.AgreementVoteTag:
+		var uv unauthenticatedVote
+		err := protocol.DecodeStream(r, &uv)
+		if err != nil {
+			panic(err)
+		}
+		m.round, m.period, m.step, m.vote = uv.R.Round, uv.R.Period, uv.R.Step, &uv
+	case protocol.VoteBundleTag:
+		var ub unauthenticatedBundle
+		err := protocol.DecodeStream(r, &ub)
+		if err != nil {
+			panic(err)
+		}
+		m.round, m.period, m.step = ub.Round, ub.Period, ub.Step
+	case protocol.ProposalPayloadTag:
+		var tp transmittedPayload
+		err := protocol.DecodeStream(r, &tp)
+		if err != nil {
+			panic(err)
+		}
+		m.round, m.period, m.step = tp.Round(), tp.OriginalPeriod, propose
+	}
+	return
+}
+
+func (r *adversaryRule) match(params multicastParams, m adversaryMessage) bool {
+	if r.sources != nil && !r.sources[params.source] {
+		return false
+	}
+	if r.tag != "" && r.tag != params.tag {
+		return false
+	}
+	if r.steps != nil && !r.steps[m.step] {
+		return false
+	}
+	if r.periods != nil && !r.periods[m.period] {
+		return false
+	}
+	if r.rounds != nil && !r.rounds[m.round] {
+		return false
+	}
+	return true
+}
+
+// apply returns the messages which must be delivered in place of params.
+//
+// apply is called by the testingNetwork with its lock held.
+func (a *adversary) apply(params multicastParams) []multicastParams {
+	if params.tag == UnknownMsgTag {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.rules) == 0 {
+		return []multicastParams{params}
+	}
+
+	m := decodeAdversaryMessage(params)
+	for _, r := range a.rules {
+		if !r.match(params, m) {
+			continue
+		}
+
+		switch r.action {
+		case adversaryDrop:
+			r.hits++
+			return nil
+		case adversaryDelay:
+			r.hits++
+			a.delayed = append(a.delayed, delayedMulticast{params: params, remaining: r.timeout})
+			return nil
+		case adversaryEquivocate:
+			ev, ok := a.equivocation(params, *m.vote)
+			if !ok {
+				// not a vote cast by the sender itself
+				return []multicastParams{params}
+			}
+			r.hits++
+			return []multicastParams{params, ev}
+		default:
+			r.hits++
+			return []multicastParams{params}
+		}
+	}
+	return []multicastParams{params}
+}
+
+// equivocation signs a vote which conflicts with uv using the keys of the
+// sender of params.
+func (a *adversary) equivocation(params multicastParams, uv unauthenticatedVote) (multicastParams, bool) {
+	if int(params.source) >= len(a.services) {
+		return multicastParams{}, false
+	}
+	s := a.services[params.source]
+
+	rv := uv.R
+	if rv.Proposal == bottom {
+		// there is no way to vote for a second value in the down step
+		return multicastParams{}, false
+	}
+
+	cparams, err := s.Ledger.ConsensusParams(ParamsRound(rv.Round))
+	if err != nil {
+		panic(err)
+	}
+	for _, part := range s.KeyManager.VotingKeys(rv.Round, BalanceRound(rv.Round, cparams)) {
+		if part.Account != rv.Sender {
+			continue
+		}
+
+		rv.Proposal = proposalValue{OriginalPeriod: rv.Period, OriginalProposer: rv.Sender, BlockDigest: randomBlockHash()}
+		ev, err := makeVote(rv, part.VotingSigner(), part.VRF, s.Ledger)
+		if err != nil {
+			panic(err)
+		}
+		params.data = protocol.Encode(&ev)
+		return params, true
+	}
+	return multicastParams{}, false
+}
+
+// tick counts down the delay of all held messages and returns the ones which
+// have become due.
+func (a *adversary) tick() (due []multicastParams) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	held := a.delayed[:0]
+	for _, d := range a.delayed {
+		d.remaining--
+		if d.remaining > 0 {
+			held = append(held, d)
+		} else {
+			due = append(due, d.params)
+		}
+	}
+	a.delayed = held
+	return
+}
+
+// release delivers the given messages, bypassing all rules, and waits for the
+// network to quiesce.
+func (a *adversary) release(due []multicastParams, activityMonitor *activityMonitor) {
+	if len(due) == 0 {
+		return
+	}
+
+	a.network.prepareAllMulticast()
+	a.network.mu.Lock()
+	for _, p := range due {
+		a.network.deliver(p.tag, p.data, p.source, p.exclude)
+	}
+	a.network.mu.Unlock()
+	a.network.finishAllMulticast()
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+}
+
+// triggerGlobalTimeout fires a timeout on all clocks like the package-level
+// triggerGlobalTimeout, and then delivers the delayed messages which have
+// become due.
+func (a *adversary) triggerGlobalTimeout(d time.Duration, timeoutType TimeoutType, clocks []timers.Clock[TimeoutType], activityMonitor *activityMonitor) {
+	triggerGlobalTimeout(d, timeoutType, clocks, activityMonitor)
+	a.release(a.tick(), activityMonitor)
+}
+
+func TestAgreementAdversaryEquivocatingSoftVotes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 5
+	baseNetwork, baseLedger, cleanupFn, services, clocks, ledgers, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	startRound := baseLedger.NextRound()
+	defer cleanupFn()
+
+	adv := installAdversary(baseNetwork, services)
+	rule := adv.from(4).votes(soft).inPeriod(0).equivocate()
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(t, clocks, 0)
+
+	// equivocation votes count toward both values, so the honest majority
+	// still certifies its proposal
+	triggerGlobalTimeoutType(TimeoutFilter, clocks, activityMonitor)
+	zeroes = expectNewPeriod(t, clocks, zeroes)
+	require.Equal(t, uint(2), zeroes)
+	require.Positive(t, rule.matched())
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+	sanityCheck(startRound, 1, ledgers)
+}
+
+func TestAgreementAdversaryDelayedCertVotes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 5
+	baseNetwork, baseLedger, cleanupFn, services, clocks, ledgers, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	startRound := baseLedger.NextRound()
+	version, _ := baseLedger.ConsensusVersion(startRound)
+	defer cleanupFn()
+
+	adv := installAdversary(baseNetwork, services)
+	rule := adv.from(0, 1, 2, 3).votes(cert).inRound(startRound).delay(1)
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	expectNewPeriod(t, clocks, 0)
+
+	// without the cert votes of most nodes, no node may certify the block
+	adv.triggerGlobalTimeout(FilterTimeout(0, version), TimeoutFilter, clocks, activityMonitor)
+	require.Positive(t, rule.matched())
+	for i := 0; i < numNodes; i++ {
+		require.Equal(t, startRound, ledgers[i].NextRound())
+	}
+
+	// the cert votes arrive one timeout late and complete the round
+	adv.triggerGlobalTimeout(DeadlineTimeout(0, version), TimeoutDeadline, clocks, activityMonitor)
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+	sanityCheck(startRound, 1, ledgers)
+}
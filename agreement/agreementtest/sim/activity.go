@@ -0,0 +1,122 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sim
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// Activity aggregates the outstanding work of all the nodes in a simulation.
+//
+// A simulation is busy while any node has outstanding work, and quiet once
+// all of the work on all nodes has been completed.
+type Activity struct {
+	mu deadlock.Mutex
+
+	busy bool
+	sums map[NodeID]uint
+
+	activity chan struct{}
+	quiet    chan struct{}
+}
+
+// MakeActivity creates an Activity which tracks no nodes.
+func MakeActivity() *Activity {
+	return &Activity{
+		sums:     make(map[NodeID]uint),
+		activity: make(chan struct{}, 1000),
+		quiet:    make(chan struct{}, 1000),
+	}
+}
+
+// Listener returns the agreement.SimulationListener for the node with the
+// given id.
+func (a *Activity) Listener(id NodeID) *NodeActivity {
+	return &NodeActivity{id: id, parent: a}
+}
+
+// WaitForActivity blocks until some node becomes busy.
+func (a *Activity) WaitForActivity() {
+	<-a.activity
+}
+
+// WaitForQuiet blocks until all nodes become quiet, or returns an error after
+// the given timeout has elapsed.
+func (a *Activity) WaitForQuiet(timeout time.Duration) error {
+	select {
+	case <-a.quiet:
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out waiting for quiet: %v", a.Sums())
+	}
+}
+
+// Sums returns the amount of outstanding work on each node.
+func (a *Activity) Sums() map[NodeID]uint {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	sums := make(map[NodeID]uint, len(a.sums))
+	for id, s := range a.sums {
+		sums[id] = s
+	}
+	return sums
+}
+
+// sum must be called with a.mu held.
+func (a *Activity) sum() (s uint) {
+	for _, n := range a.sums {
+		s += n
+	}
+	return
+}
+
+// NodeActivity reports the outstanding work of a single node to its Activity.
+// It implements agreement.SimulationListener.
+type NodeActivity struct {
+	id     NodeID
+	parent *Activity
+}
+
+// Busy implements agreement.SimulationListener.
+func (n *NodeActivity) Busy(total uint) {
+	a := n.parent
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.sums[n.id] = total
+	if !a.busy {
+		a.activity <- struct{}{}
+		a.busy = true
+	}
+}
+
+// Idle implements agreement.SimulationListener.
+func (n *NodeActivity) Idle(total uint) {
+	a := n.parent
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.sums[n.id] = total
+	if a.busy && a.sum() == 0 {
+		a.quiet <- struct{}{}
+		a.busy = false
+	}
+}
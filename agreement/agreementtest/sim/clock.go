@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sim
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+type timeout struct {
+	delta time.Duration
+	ch    chan time.Time
+	fired bool
+}
+
+// Clock is a timers.Clock whose timeouts only fire when explicitly told to.
+type Clock struct {
+	mu deadlock.Mutex
+
+	zeroes  uint
+	pending map[agreement.TimeoutType]timeout
+
+	monitor *agreement.SimulationMonitor
+}
+
+// MakeClock creates a Clock which reports to the given monitor, which may be
+// nil.
+func MakeClock(monitor *agreement.SimulationMonitor) *Clock {
+	return &Clock{
+		pending: make(map[agreement.TimeoutType]timeout),
+		monitor: monitor,
+	}
+}
+
+// Zero implements timers.Clock. It cancels all pending timeouts.
+func (c *Clock) Zero() timers.Clock[agreement.TimeoutType] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.zeroes++
+	c.pending = make(map[agreement.TimeoutType]timeout)
+	if c.monitor != nil {
+		c.monitor.ClockZeroed()
+	}
+	return c
+}
+
+// Since implements timers.Clock.
+func (c *Clock) Since() time.Duration {
+	return 1
+}
+
+// TimeoutAt implements timers.Clock.
+func (c *Clock) TimeoutAt(d time.Duration, timeoutType agreement.TimeoutType) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ta, ok := c.pending[timeoutType]
+	if !ok || ta.delta != d {
+		ta = timeout{delta: d, ch: make(chan time.Time)}
+		c.pending[timeoutType] = ta
+	}
+	return ta.ch
+}
+
+// Encode implements timers.Clock.
+func (c *Clock) Encode() []byte {
+	return nil
+}
+
+// Decode implements timers.Clock.
+func (c *Clock) Decode([]byte) (timers.Clock[agreement.TimeoutType], error) {
+	return MakeClock(c.monitor), nil
+}
+
+// When returns the duration of the pending timeout of the given type.
+func (c *Clock) When(timeoutType agreement.TimeoutType) (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ta, ok := c.pending[timeoutType]
+	if !ok {
+		return 0, fmt.Errorf("no timeout of type %v", timeoutType)
+	}
+	return ta.delta, nil
+}
+
+// Zeroes returns the number of times the Clock has been zeroed.
+func (c *Clock) Zeroes() uint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.zeroes
+}
+
+// Pending returns true if a timeout of the given type is pending and has not
+// yet fired.
+func (c *Clock) Pending(timeoutType agreement.TimeoutType) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ta, ok := c.pending[timeoutType]
+	return ok && !ta.fired
+}
+
+// prepareToFire records that a timeout is about to fire. It must be called on
+// all clocks before any of them fire, so that no node is considered quiet
+// between the first and the last firing.
+func (c *Clock) prepareToFire() {
+	if c.monitor != nil {
+		c.monitor.TimeoutPending()
+	}
+}
+
+// fire fires the pending timeout of the given type.
+func (c *Clock) fire(timeoutType agreement.TimeoutType) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ta, ok := c.pending[timeoutType]
+	if !ok || ta.fired {
+		panic(fmt.Errorf("no timeout of type %v", timeoutType))
+	}
+	close(ta.ch)
+	ta.fired = true
+	c.pending[timeoutType] = ta
+}
@@ -0,0 +1,328 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sim
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// NodeID identifies a node in a simulated Network.
+type NodeID int
+
+// NoNode is used as the Exclude field of a Multicast which excludes no peer.
+const NoNode NodeID = -1
+
+// DropTag may be set as the Tag of a Multicast returned by an InterceptFn to
+// drop the message.
+const DropTag protocol.Tag = "??"
+
+// A Multicast is a message sent by a node into the simulated network.
+type Multicast struct {
+	Tag  protocol.Tag
+	Data []byte
+
+	// Source is the node which sent the message.
+	Source NodeID
+	// Exclude is the peer which must not receive the message (e.g., the
+	// peer which relayed it to Source), or NoNode.
+	Exclude NodeID
+}
+
+// An InterceptFn rewrites a Multicast before it is delivered. Returning a
+// Multicast tagged with DropTag drops the message.
+type InterceptFn func(Multicast) Multicast
+
+// Network is a simulated gossip network connecting a fixed set of nodes.
+//
+// Messages are delivered synchronously into per-node buffered queues. The
+// topology of the network may be changed at any time by disconnecting pairs
+// of nodes, partitioning the network, or arranging it into a star topology
+// around a set of relays.
+type Network struct {
+	votes    []chan agreement.Message
+	payloads []chan agreement.Message
+	bundles  []chan agreement.Message
+	monitors []*agreement.SimulationMonitor
+
+	mu deadlock.Mutex // guards all fields below
+
+	connected   [][]bool // symmetric
+	nextHandle  int
+	source      map[agreement.MessageHandle]NodeID
+	partitioned map[NodeID]bool
+	crowned     map[NodeID]bool
+	relays      map[NodeID]bool
+	intercepts  []InterceptFn
+}
+
+// MakeNetwork creates a fully-connected Network of n nodes, buffering up to
+// bufferCapacity messages of each type for each node.
+//
+// If monitors is non-nil, it must hold one SimulationMonitor for each node.
+func MakeNetwork(n int, bufferCapacity int, monitors []*agreement.SimulationMonitor) *Network {
+	net := &Network{
+		votes:    make([]chan agreement.Message, n),
+		payloads: make([]chan agreement.Message, n),
+		bundles:  make([]chan agreement.Message, n),
+		monitors: make([]*agreement.SimulationMonitor, n),
+		source:   make(map[agreement.MessageHandle]NodeID),
+	}
+	copy(net.monitors, monitors)
+
+	net.connected = make([][]bool, n)
+	for i := 0; i < n; i++ {
+		net.votes[i] = make(chan agreement.Message, bufferCapacity)
+		net.payloads[i] = make(chan agreement.Message, bufferCapacity)
+		net.bundles[i] = make(chan agreement.Message, bufferCapacity)
+
+		net.connected[i] = make([]bool, n)
+		for j := 0; j < n; j++ {
+			net.connected[i][j] = true
+		}
+	}
+	return net
+}
+
+// Size returns the number of nodes in the Network.
+func (n *Network) Size() int {
+	return len(n.votes)
+}
+
+// Endpoint returns the agreement.Network used by the given node.
+func (n *Network) Endpoint(id NodeID) agreement.Network {
+	return &endpoint{parent: n, id: id}
+}
+
+// Intercept adds f to the chain of functions which rewrite every message
+// sent into the network. Interceptors run in the order they were added.
+func (n *Network) Intercept(f InterceptFn) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.intercepts = append(n.intercepts, f)
+}
+
+// Disconnect severs the link between nodes a and b.
+func (n *Network) Disconnect(a, b NodeID) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.connected[a][b] = false
+	n.connected[b][a] = false
+}
+
+// Partition splits the network into the given set of nodes and the rest,
+// replacing any previous partition.
+func (n *Network) Partition(part ...NodeID) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.partitioned = make(map[NodeID]bool, len(part))
+	for _, id := range part {
+		n.partitioned[id] = true
+	}
+}
+
+// Crown restricts delivery of all messages to the given set of nodes.
+func (n *Network) Crown(nodes ...NodeID) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.crowned = make(map[NodeID]bool, len(nodes))
+	for _, id := range nodes {
+		n.crowned[id] = true
+	}
+}
+
+// MakeRelays arranges the network into a star topology with the given nodes
+// at its center.
+func (n *Network) MakeRelays(relays ...NodeID) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.relays = make(map[NodeID]bool, len(relays))
+	for _, id := range relays {
+		n.relays[id] = true
+	}
+}
+
+// RepairAll reconnects all nodes and removes all partitions, relay
+// topologies, and interceptors.
+func (n *Network) RepairAll() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for i := range n.connected {
+		for j := range n.connected[i] {
+			n.connected[i][j] = true
+		}
+	}
+	n.partitioned = nil
+	n.crowned = nil
+	n.relays = nil
+	n.intercepts = nil
+}
+
+// Inject delivers a message into the network as if it had been sent by
+// m.Source, bypassing all interceptors.
+func (n *Network) Inject(m Multicast) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.deliver(m)
+}
+
+// Send delivers a message only to the given node as if it had been sent by
+// m.Source, bypassing all interceptors and the topology of the network.
+func (n *Network) Send(to NodeID, m Multicast) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	queues := n.queues(m.Tag)
+	if queues == nil {
+		return
+	}
+	n.enqueue(queues, m, to, n.newHandle(m.Source))
+}
+
+func (n *Network) multicast(m Multicast) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, f := range n.intercepts {
+		m = f(m)
+		if m.Tag == DropTag {
+			return
+		}
+	}
+	n.deliver(m)
+}
+
+// deliver must be called with n.mu held.
+func (n *Network) deliver(m Multicast) {
+	queues := n.queues(m.Tag)
+	if queues == nil {
+		return
+	}
+
+	handle := n.newHandle(m.Source)
+	for i, connected := range n.connected[m.Source] {
+		peer := NodeID(i)
+		if peer == m.Source || peer == m.Exclude || !connected {
+			continue
+		}
+		if n.partitioned != nil && n.partitioned[m.Source] != n.partitioned[peer] {
+			continue
+		}
+		if n.crowned != nil && !n.crowned[peer] {
+			continue
+		}
+		if n.relays != nil && !n.relays[m.Source] && !n.relays[peer] {
+			continue
+		}
+
+		n.enqueue(queues, m, peer, handle)
+	}
+}
+
+// queues returns the per-node queues for messages with the given tag, or nil
+// if the message should be dropped.
+func (n *Network) queues(tag protocol.Tag) []chan agreement.Message {
+	switch tag {
+	case protocol.AgreementVoteTag:
+		return n.votes
+	case protocol.VoteBundleTag:
+		return n.bundles
+	case protocol.ProposalPayloadTag:
+		return n.payloads
+	case DropTag:
+		return nil
+	default:
+		panic(fmt.Errorf("sim: bad multicast tag %v", tag))
+	}
+}
+
+// newHandle must be called with n.mu held.
+func (n *Network) newHandle(source NodeID) agreement.MessageHandle {
+	n.nextHandle++
+	handle := new(int)
+	*handle = n.nextHandle
+	n.source[handle] = source
+	return handle
+}
+
+// enqueue must be called with n.mu held.
+func (n *Network) enqueue(queues []chan agreement.Message, m Multicast, peer NodeID, handle agreement.MessageHandle) {
+	monitor := n.monitors[peer]
+	if monitor != nil {
+		monitor.MessageQueued()
+	}
+	select {
+	case queues[peer] <- agreement.Message{MessageHandle: handle, Data: m.Data}:
+	default:
+		logging.Base().Warnf("sim: message from %d to %d dropped: queue full", m.Source, peer)
+		if monitor != nil {
+			monitor.MessageDropped()
+		}
+	}
+}
+
+func (n *Network) sourceOf(h agreement.MessageHandle) (NodeID, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	id, ok := n.source[h]
+	return id, ok
+}
+
+type endpoint struct {
+	parent *Network
+	id     NodeID
+}
+
+func (e *endpoint) Messages(tag protocol.Tag) <-chan agreement.Message {
+	switch tag {
+	case protocol.AgreementVoteTag:
+		return e.parent.votes[e.id]
+	case protocol.VoteBundleTag:
+		return e.parent.bundles[e.id]
+	case protocol.ProposalPayloadTag:
+		return e.parent.payloads[e.id]
+	default:
+		panic(fmt.Errorf("sim: bad messages tag %v", tag))
+	}
+}
+
+func (e *endpoint) Broadcast(tag protocol.Tag, data []byte) error {
+	e.parent.multicast(Multicast{Tag: tag, Data: data, Source: e.id, Exclude: e.id})
+	return nil
+}
+
+func (e *endpoint) Relay(h agreement.MessageHandle, tag protocol.Tag, data []byte) error {
+	exclude := e.id
+	if source, ok := e.parent.sourceOf(h); ok {
+		exclude = source
+	}
+	e.parent.multicast(Multicast{Tag: tag, Data: data, Source: e.id, Exclude: exclude})
+	return nil
+}
+
+func (e *endpoint) Disconnect(h agreement.MessageHandle) {
+	if source, ok := e.parent.sourceOf(h); ok {
+		e.parent.Disconnect(e.id, source)
+	}
+}
+
+func (e *endpoint) Start() {}
@@ -0,0 +1,276 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package sim provides a deterministic harness for simulating a network of
+// agreement Services.
+//
+// A Simulation connects a set of Services over an in-memory Network and
+// drives each of them with a Clock whose timeouts only fire on request. After
+// every step, the Simulation waits until all the Services have quiesced, so
+// that tests can make exact assertions about the state of each node.
+package sim
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// DefaultQuietTimeout is the amount of time a Simulation waits for its nodes
+// to quiesce before giving up.
+const DefaultQuietTimeout = 10 * time.Second
+
+// DefaultBufferCapacity is the number of messages of each type which are
+// buffered for each node if Config.BufferCapacity is zero.
+const DefaultBufferCapacity = 1000
+
+// Config describes the nodes of a Simulation.
+//
+// Ledgers, KeyManagers, and BlockFactories must all have one entry per node.
+type Config struct {
+	// Name is used to name the crash databases of the nodes.
+	Name string
+
+	Ledgers        []agreement.Ledger
+	KeyManagers    []agreement.KeyManager
+	BlockFactories []agreement.BlockFactory
+	Validator      agreement.BlockValidator
+
+	// Logger is the base logger of all nodes. If nil, logging.Base() is used.
+	Logger logging.Logger
+	// Local is the node configuration shared by all nodes.
+	Local config.Local
+
+	// BufferCapacity is the number of messages of each type buffered for
+	// each node. If zero, DefaultBufferCapacity is used.
+	BufferCapacity int
+	// QuietTimeout bounds the time spent waiting for nodes to quiesce. If
+	// zero, DefaultQuietTimeout is used.
+	QuietTimeout time.Duration
+}
+
+// FixedRandomSource is an agreement.RandomSource which always returns the
+// same value, so that all nodes choose the same timeouts.
+type FixedRandomSource struct{}
+
+// Uint64 implements agreement.RandomSource.
+func (FixedRandomSource) Uint64() uint64 {
+	return ^uint64(0) / 2
+}
+
+// A Simulation is a deterministic network of agreement Services.
+type Simulation struct {
+	net       *Network
+	clocks    []*Clock
+	services  []*agreement.Service
+	accessors []db.Accessor
+	activity  *Activity
+
+	quietTimeout time.Duration
+	started      bool
+}
+
+// New creates a Simulation from the given Config.
+func New(cfg Config) (*Simulation, error) {
+	n := len(cfg.Ledgers)
+	if len(cfg.KeyManagers) != n || len(cfg.BlockFactories) != n {
+		return nil, fmt.Errorf("sim: mismatched node count: %d ledgers, %d key managers, %d block factories", n, len(cfg.KeyManagers), len(cfg.BlockFactories))
+	}
+
+	bufCap := cfg.BufferCapacity
+	if bufCap == 0 {
+		bufCap = DefaultBufferCapacity
+	}
+	log := cfg.Logger
+	if log == nil {
+		log = logging.Base()
+	}
+
+	s := &Simulation{
+		clocks:       make([]*Clock, n),
+		services:     make([]*agreement.Service, n),
+		accessors:    make([]db.Accessor, 0, n),
+		activity:     MakeActivity(),
+		quietTimeout: cfg.QuietTimeout,
+	}
+	if s.quietTimeout == 0 {
+		s.quietTimeout = DefaultQuietTimeout
+	}
+
+	monitors := make([]*agreement.SimulationMonitor, n)
+	for i := range monitors {
+		monitors[i] = agreement.MakeSimulationMonitor(i, s.activity.Listener(NodeID(i)))
+	}
+	s.net = MakeNetwork(n, bufCap, monitors)
+
+	for i := 0; i < n; i++ {
+		accessor, err := db.MakeAccessor(cfg.Name+"_sim_"+strconv.Itoa(i)+"_crash.db", false, true)
+		if err != nil {
+			s.closeAccessors()
+			return nil, err
+		}
+		s.accessors = append(s.accessors, accessor)
+
+		s.clocks[i] = MakeClock(monitors[i])
+		params := agreement.Parameters{
+			Logger:            log.WithFields(logging.Fields{"Source": "sim-" + strconv.Itoa(i)}),
+			Ledger:            cfg.Ledgers[i],
+			Network:           s.net.Endpoint(NodeID(i)),
+			KeyManager:        cfg.KeyManagers[i],
+			BlockValidator:    cfg.Validator,
+			BlockFactory:      cfg.BlockFactories[i],
+			Clock:             s.clocks[i],
+			Accessor:          accessor,
+			Local:             cfg.Local,
+			RandomSource:      FixedRandomSource{},
+			SimulationMonitor: monitors[i],
+		}
+		s.services[i], err = agreement.MakeService(params)
+		if err != nil {
+			s.closeAccessors()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Size returns the number of nodes in the Simulation.
+func (s *Simulation) Size() int {
+	return len(s.services)
+}
+
+// Network returns the Network connecting the nodes of the Simulation.
+func (s *Simulation) Network() *Network {
+	return s.net
+}
+
+// Clock returns the Clock of the given node.
+func (s *Simulation) Clock(id NodeID) *Clock {
+	return s.clocks[id]
+}
+
+// Service returns the agreement Service of the given node.
+func (s *Simulation) Service(id NodeID) *agreement.Service {
+	return s.services[id]
+}
+
+// Activity returns the Activity tracking the work of all nodes.
+func (s *Simulation) Activity() *Activity {
+	return s.activity
+}
+
+// Start starts all nodes and waits for them to quiesce.
+func (s *Simulation) Start() error {
+	for _, svc := range s.services {
+		svc.Start()
+	}
+	s.started = true
+	return s.settle()
+}
+
+// Shutdown stops all nodes and releases their resources.
+func (s *Simulation) Shutdown() {
+	if s.started {
+		for _, svc := range s.services {
+			svc.Shutdown()
+		}
+		s.started = false
+	}
+	s.closeAccessors()
+}
+
+// FireTimeout fires the pending timeout of the given type on every node and
+// waits for all nodes to quiesce.
+//
+// It returns an error if some node has no pending timeout of that type.
+func (s *Simulation) FireTimeout(timeoutType agreement.TimeoutType) error {
+	for i, c := range s.clocks {
+		if !c.Pending(timeoutType) {
+			return fmt.Errorf("sim: node %d has no pending timeout of type %v", i, timeoutType)
+		}
+	}
+	for _, c := range s.clocks {
+		c.prepareToFire()
+	}
+	for _, c := range s.clocks {
+		c.fire(timeoutType)
+	}
+	return s.settle()
+}
+
+// RunRound fires the filter timeout on every node and checks that all nodes
+// then entered a new period.
+func (s *Simulation) RunRound() error {
+	zeroes := make([]uint, len(s.clocks))
+	for i, c := range s.clocks {
+		zeroes[i] = c.Zeroes()
+	}
+	err := s.FireTimeout(agreement.TimeoutFilter)
+	if err != nil {
+		return err
+	}
+	for i, c := range s.clocks {
+		if c.Zeroes() == zeroes[i] {
+			return fmt.Errorf("sim: node %d did not advance", i)
+		}
+	}
+	return nil
+}
+
+// Replay delivers the given inputs, as extracted from a cadaver by
+// agreement.Autopsy.Inputs, to the node target in order, waiting for all
+// nodes to quiesce after each one. Messages are sent to target as if they had
+// come from the node source, and timeouts fire only on target.
+//
+// It returns an error if target has no pending timeout of the type of some
+// timeout input.
+func (s *Simulation) Replay(target, source NodeID, inputs []agreement.CadaverInput) error {
+	c := s.clocks[target]
+	for i, in := range inputs {
+		if in.Timeout {
+			if !c.Pending(in.TimeoutType) {
+				return fmt.Errorf("sim: input %d (round %d): node %d has no pending timeout of type %v", i, in.Round, target, in.TimeoutType)
+			}
+			c.prepareToFire()
+			c.fire(in.TimeoutType)
+		} else {
+			s.net.Send(target, Multicast{Tag: in.Tag, Data: in.Data, Source: source, Exclude: NoNode})
+		}
+
+		err := s.settle()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Simulation) settle() error {
+	s.activity.WaitForActivity()
+	return s.activity.WaitForQuiet(s.quietTimeout)
+}
+
+func (s *Simulation) closeAccessors() {
+	for _, a := range s.accessors {
+		a.Close()
+	}
+	s.accessors = nil
+}
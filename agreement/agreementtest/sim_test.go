@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreementtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/agreement/agreementtest/sim"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeSimulation(t *testing.T, numNodes int, numRounds int) (*sim.Simulation, *testLedger, []*testLedger, func()) {
+	_, accs, release := generateNAccounts(t, numNodes, 0, basics.Round(numRounds+10), 100000)
+
+	genesis := make(map[basics.Address]basics.AccountData)
+	for _, account := range accs {
+		genesis[account.Address()] = basics.AccountData{
+			Status:      basics.Online,
+			MicroAlgos:  basics.MicroAlgos{Raw: 100000},
+			SelectionID: account.VRFSecrets().PK,
+			VoteID:      account.VotingSecrets().OneTimeSignatureVerifier,
+		}
+	}
+	base := makeTestLedger(genesis).(*testLedger)
+
+	cfg := sim.Config{
+		Name:      t.Name(),
+		Validator: testBlockValidator{},
+		Logger:    logging.TestingLog(t),
+		Local:     config.Local{CadaverSizeTarget: 0},
+	}
+	ledgers := make([]*testLedger, numNodes)
+	for i := 0; i < numNodes; i++ {
+		ledgers[i] = base.copy()
+		cfg.Ledgers = append(cfg.Ledgers, ledgers[i])
+		cfg.KeyManagers = append(cfg.KeyManagers, SimpleKeyManager(accs[i:i+1]))
+		cfg.BlockFactories = append(cfg.BlockFactories, testBlockFactory{Owner: i})
+	}
+
+	s, err := sim.New(cfg)
+	if err != nil {
+		release()
+		require.NoError(t, err)
+	}
+	return s, base, ledgers, func() {
+		s.Shutdown()
+		release()
+	}
+}
+
+func TestSimulationHarness(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 5
+	numRounds := 5
+
+	s, base, ledgers, cleanup := makeSimulation(t, numNodes, numRounds)
+	defer cleanup()
+	require.Equal(t, numNodes, s.Size())
+
+	require.NoError(t, s.Start())
+	for i := 0; i < numNodes; i++ {
+		require.Equal(t, uint(1), s.Clock(sim.NodeID(i)).Zeroes())
+	}
+
+	for r := 0; r < numRounds; r++ {
+		require.NoError(t, s.RunRound())
+	}
+
+	startRound := base.NextRound()
+	for i := 0; i < numNodes; i++ {
+		require.Equal(t, startRound+basics.Round(numRounds), ledgers[i].NextRound())
+		for r := startRound; r < startRound+basics.Round(numRounds); r++ {
+			require.Equal(t, ledgers[0].entries[r].Digest(), ledgers[i].entries[r].Digest())
+		}
+	}
+
+	// no node is waiting on a deadline timeout during the filter step
+	require.Error(t, s.FireTimeout(agreement.TimeoutDeadline))
+}
+
+func TestSimulationReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s, _, _, cleanup := makeSimulation(t, 5, 1)
+	defer cleanup()
+	require.NoError(t, s.Start())
+
+	filter := agreement.CadaverInput{Timeout: true, TimeoutType: agreement.TimeoutFilter}
+	require.NoError(t, s.Replay(0, 1, []agreement.CadaverInput{filter}))
+	require.False(t, s.Clock(0).Pending(agreement.TimeoutFilter))
+	require.True(t, s.Clock(1).Pending(agreement.TimeoutFilter))
+
+	// node 0 cannot leave the period on its own, so its filter timeout has
+	// already fired
+	require.Error(t, s.Replay(0, 1, []agreement.CadaverInput{filter}))
+}
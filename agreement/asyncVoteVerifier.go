@@ -57,12 +57,28 @@ type AsyncVoteVerifier struct {
 	execpoolOut     chan interface{}
 	ctx             context.Context
 	ctxCancel       context.CancelFunc
+
+	// backend checks the signatures and credentials of votes.
+	backend VoteVerifier
+	// batching is set if votes should be handed to the backend in batches.
+	batching bool
 }
 
 // MakeAsyncVoteVerifier creates an AsyncVoteVerifier with workers as the number of CPUs
 func MakeAsyncVoteVerifier(verificationPool execpool.BacklogPool) *AsyncVoteVerifier {
+	return MakeAsyncVoteVerifierWithBackend(verificationPool, nil)
+}
+
+// MakeAsyncVoteVerifierWithBackend creates an AsyncVoteVerifier which checks votes using the given VoteVerifier.
+// If backend is nil, each vote is verified individually.
+func MakeAsyncVoteVerifierWithBackend(verificationPool execpool.BacklogPool, backend VoteVerifier) *AsyncVoteVerifier {
 	verifier := &AsyncVoteVerifier{
-		done: make(chan struct{}),
+		done:     make(chan struct{}),
+		backend:  backend,
+		batching: backend != nil,
+	}
+	if backend == nil {
+		verifier.backend = defaultVoteVerifier{}
 	}
 	if verificationPool == nil {
 		// The MakeBacklog would internall allocate an execution pool if none was provided.
@@ -86,9 +102,15 @@ func MakeAsyncVoteVerifier(verificationPool execpool.BacklogPool) *AsyncVoteVeri
 func (avv *AsyncVoteVerifier) worker() {
 	defer close(avv.workerWaitCh)
 	for res := range avv.execpoolOut {
-		asyncResponse := res.(*asyncVerifyVoteResponse)
-		if asyncResponse != nil {
-			asyncResponse.req.out <- *asyncResponse
+		switch asyncResponse := res.(type) {
+		case *asyncVerifyVoteResponse:
+			if asyncResponse != nil {
+				asyncResponse.req.out <- *asyncResponse
+			}
+		case []asyncVerifyVoteResponse:
+			for _, r := range asyncResponse {
+				r.req.out <- r
+			}
 		}
 		avv.wg.Done()
 	}
@@ -103,7 +125,7 @@ func (avv *AsyncVoteVerifier) executeVoteVerification(task interface{}) interfac
 		return &asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: &req, index: req.index}
 	default:
 		// request was not cancelled, so we verify it here and return the result on the channel
-		v, err := req.uv.verify(req.l)
+		v, err := req.uv.verifyWith(req.l, avv.backend)
 		req.message.Vote = v
 
 		var e *LedgerDroppedRoundError
@@ -122,7 +144,7 @@ func (avv *AsyncVoteVerifier) executeEqVoteVerification(task interface{}) interf
 		return &asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: &req, index: req.index}
 	default:
 		// request was not cancelled, so we verify it here and return the result on the channel
-		ev, err := req.uev.verify(req.l)
+		ev, err := req.uev.verifyWith(req.l, avv.backend)
 
 		var e *LedgerDroppedRoundError
 		cancelled := errors.As(err, &e)
@@ -131,6 +153,49 @@ func (avv *AsyncVoteVerifier) executeEqVoteVerification(task interface{}) interf
 	}
 }
 
+// executeVoteBatchVerification verifies a batch of votes with a single call to the backend.
+func (avv *AsyncVoteVerifier) executeVoteBatchVerification(task interface{}) interface{} {
+	reqs := task.([]asyncVerifyVoteRequest)
+	responses := make([]asyncVerifyVoteResponse, len(reqs))
+	tasks := make([]VoteVerificationTask, 0, len(reqs))
+	pending := make([]int, 0, len(reqs))
+
+	for i := range reqs {
+		req := &reqs[i]
+		select {
+		case <-req.ctx.Done():
+			// request cancelled, return an error response on the channel
+			responses[i] = asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: req, index: req.index}
+			continue
+		default:
+		}
+
+		responses[i] = asyncVerifyVoteResponse{index: req.index, message: req.message, req: req}
+		t, err := req.uv.verificationTask(req.l)
+		if err != nil {
+			var e *LedgerDroppedRoundError
+			responses[i].err = err
+			responses[i].cancelled = errors.As(err, &e)
+			continue
+		}
+		tasks = append(tasks, t)
+		pending = append(pending, i)
+	}
+
+	if len(tasks) == 0 {
+		return responses
+	}
+
+	results := avv.backend.VerifyVotes(tasks)
+	for j, i := range pending {
+		v, err := reqs[i].uv.authenticate(tasks[j], results[j])
+		responses[i].v = v
+		responses[i].message.Vote = v
+		responses[i].err = err
+	}
+	return responses
+}
+
 func (avv *AsyncVoteVerifier) verifyVote(verctx context.Context, l LedgerReader, uv unauthenticatedVote, index uint64, message message, out chan<- asyncVerifyVoteResponse) error {
 	select {
 	case <-avv.ctx.Done(): // if we're quitting, don't enqueue the request
@@ -171,6 +236,20 @@ func (avv *AsyncVoteVerifier) verifyEqVote(verctx context.Context, l LedgerReade
 	return nil
 }
 
+// verifyVoteBatch enqueues the given requests to be verified together by the backend.
+func (avv *AsyncVoteVerifier) verifyVoteBatch(reqs []asyncVerifyVoteRequest) error {
+	select {
+	case <-avv.ctx.Done(): // if we're quitting, don't enqueue the requests
+	default:
+		avv.wg.Add(1)
+		if err := avv.backlogExecPool.EnqueueBacklog(avv.ctx, avv.executeVoteBatchVerification, reqs, avv.execpoolOut); err != nil {
+			avv.wg.Done()
+			return err
+		}
+	}
+	return nil
+}
+
 // Quit tells the AsyncVoteVerifier to shutdown and waits until all workers terminate.
 func (avv *AsyncVoteVerifier) Quit() {
 	// indicate we're done and wait for all workers to finish
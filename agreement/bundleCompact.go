@@ -0,0 +1,246 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/config/bounds"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The compact bundle encoding lays out the votes of a bundle as fixed-size
+// records instead of msgpack maps, which omits the field names and framing
+// repeated in every vote. Its layout is:
+//
+//	prefix, version
+//	uvarint round, uvarint period, uvarint step
+//	uvarint len, msgpack proposal-value
+//	uvarint count, count * vote record
+//	uvarint count, count * equivocation vote record
+//
+// A vote record holds the sender, the credential proof, and the one-time
+// signature (without the unused PKSigOld). An equivocation vote record holds
+// the sender, the credential proof, two one-time signatures, and two
+// length-prefixed msgpack proposal-values.
+const (
+	// compactBundlePrefix starts every compactly encoded bundle. It is never
+	// used by msgpack, so it cannot start a msgpack-encoded bundle.
+	compactBundlePrefix  byte = 0xc1
+	compactBundleVersion byte = 1
+
+	compactSigSize  = 32 + 64 + 32 + 64 + 64 // PK, PK1Sig, PK2, PK2Sig, Sig
+	compactVoteSize = 32 + len(crypto.VrfProof{}) + compactSigSize
+)
+
+var errCompactBundleTruncated = errors.New("compact bundle: truncated")
+
+// encodeBundle encodes a bundle for transmission, using the compact encoding
+// if the consensus protocol of the bundle's round supports it.
+func encodeBundle(l LedgerReader, b *unauthenticatedBundle) []byte {
+	cv, err := l.ConsensusVersion(ParamsRound(b.Round))
+	if err == nil && config.Consensus[cv].CompactVoteBundles {
+		if data, ok := encodeCompactBundle(b); ok {
+			return data
+		}
+	}
+	return protocol.Encode(b)
+}
+
+// encodeCompactBundle encodes a bundle in the compact encoding. It returns
+// false if the bundle cannot be represented in it.
+func encodeCompactBundle(b *unauthenticatedBundle) ([]byte, bool) {
+	// PKSigOld is not carried in the compact encoding
+	var zero crypto.OneTimeSignature
+	for _, v := range b.Votes {
+		if v.Sig.PKSigOld != zero.PKSigOld {
+			return nil, false
+		}
+	}
+	for _, ev := range b.EquivocationVotes {
+		if ev.Sigs[0].PKSigOld != zero.PKSigOld || ev.Sigs[1].PKSigOld != zero.PKSigOld {
+			return nil, false
+		}
+	}
+
+	data := make([]byte, 0, 64+len(b.Votes)*compactVoteSize+len(b.EquivocationVotes)*(compactVoteSize+compactSigSize+128))
+	data = append(data, compactBundlePrefix, compactBundleVersion)
+	data = binary.AppendUvarint(data, uint64(b.Round))
+	data = binary.AppendUvarint(data, uint64(b.Period))
+	data = binary.AppendUvarint(data, uint64(b.Step))
+	data = appendCompactProposal(data, b.Proposal)
+
+	data = binary.AppendUvarint(data, uint64(len(b.Votes)))
+	for _, v := range b.Votes {
+		data = append(data, v.Sender[:]...)
+		data = append(data, v.Cred.Proof[:]...)
+		data = appendCompactSig(data, v.Sig)
+	}
+
+	data = binary.AppendUvarint(data, uint64(len(b.EquivocationVotes)))
+	for _, ev := range b.EquivocationVotes {
+		data = append(data, ev.Sender[:]...)
+		data = append(data, ev.Cred.Proof[:]...)
+		data = appendCompactSig(data, ev.Sigs[0])
+		data = appendCompactSig(data, ev.Sigs[1])
+		data = appendCompactProposal(data, ev.Proposals[0])
+		data = appendCompactProposal(data, ev.Proposals[1])
+	}
+	return data, true
+}
+
+func appendCompactSig(data []byte, sig crypto.OneTimeSignature) []byte {
+	data = append(data, sig.PK[:]...)
+	data = append(data, sig.PK1Sig[:]...)
+	data = append(data, sig.PK2[:]...)
+	data = append(data, sig.PK2Sig[:]...)
+	return append(data, sig.Sig[:]...)
+}
+
+func appendCompactProposal(data []byte, p proposalValue) []byte {
+	enc := protocol.Encode(&p)
+	data = binary.AppendUvarint(data, uint64(len(enc)))
+	return append(data, enc...)
+}
+
+// compactBundleReader consumes a compactly encoded bundle.
+type compactBundleReader struct {
+	data []byte
+	err  error
+}
+
+func (r *compactBundleReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.data) < n {
+		r.err = errCompactBundleTruncated
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *compactBundleReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	x, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = fmt.Errorf("compact bundle: bad varint")
+		return 0
+	}
+	r.data = r.data[n:]
+	return x
+}
+
+// count reads the number of records which follow, each at least size bytes.
+func (r *compactBundleReader) count(size int) int {
+	n := r.uvarint()
+	if r.err != nil {
+		return 0
+	}
+	if n > uint64(bounds.MaxVoteThreshold) {
+		r.err = fmt.Errorf("compact bundle: %d votes exceeds the maximum of %d", n, bounds.MaxVoteThreshold)
+		return 0
+	}
+	if n*uint64(size) > uint64(len(r.data)) {
+		r.err = errCompactBundleTruncated
+		return 0
+	}
+	return int(n)
+}
+
+func (r *compactBundleReader) sig() (sig crypto.OneTimeSignature) {
+	b := r.next(compactSigSize)
+	if b == nil {
+		return
+	}
+	b = b[copy(sig.PK[:], b):]
+	b = b[copy(sig.PK1Sig[:], b):]
+	b = b[copy(sig.PK2[:], b):]
+	b = b[copy(sig.PK2Sig[:], b):]
+	copy(sig.Sig[:], b)
+	return
+}
+
+func (r *compactBundleReader) proposal() (p proposalValue) {
+	n := r.uvarint()
+	if r.err != nil {
+		return
+	}
+	if n > uint64(len(r.data)) {
+		r.err = errCompactBundleTruncated
+		return
+	}
+	err := protocol.Decode(r.next(int(n)), &p)
+	if err != nil {
+		r.err = fmt.Errorf("compact bundle: bad proposal-value: %w", err)
+	}
+	return
+}
+
+// decodeCompactBundle decodes a bundle in the compact encoding.
+func decodeCompactBundle(data []byte) (b unauthenticatedBundle, err error) {
+	if len(data) < 2 || data[0] != compactBundlePrefix {
+		return b, fmt.Errorf("compact bundle: bad prefix")
+	}
+	if data[1] != compactBundleVersion {
+		return b, fmt.Errorf("compact bundle: unsupported version %d", data[1])
+	}
+
+	r := compactBundleReader{data: data[2:]}
+	b.Round = round(r.uvarint())
+	b.Period = period(r.uvarint())
+	b.Step = step(r.uvarint())
+	b.Proposal = r.proposal()
+
+	if n := r.count(compactVoteSize); n > 0 {
+		b.Votes = make([]voteAuthenticator, n)
+		for i := range b.Votes {
+			copy(b.Votes[i].Sender[:], r.next(len(b.Votes[i].Sender)))
+			copy(b.Votes[i].Cred.Proof[:], r.next(len(b.Votes[i].Cred.Proof)))
+			b.Votes[i].Sig = r.sig()
+		}
+	}
+
+	if n := r.count(compactVoteSize + compactSigSize); n > 0 {
+		b.EquivocationVotes = make([]equivocationVoteAuthenticator, n)
+		for i := range b.EquivocationVotes {
+			ev := &b.EquivocationVotes[i]
+			copy(ev.Sender[:], r.next(len(ev.Sender)))
+			copy(ev.Cred.Proof[:], r.next(len(ev.Cred.Proof)))
+			ev.Sigs[0] = r.sig()
+			ev.Sigs[1] = r.sig()
+			ev.Proposals[0] = r.proposal()
+			ev.Proposals[1] = r.proposal()
+		}
+	}
+
+	if r.err != nil {
+		return unauthenticatedBundle{}, r.err
+	}
+	if len(r.data) != 0 {
+		return unauthenticatedBundle{}, fmt.Errorf("compact bundle: %d trailing bytes", len(r.data))
+	}
+	return b, nil
+}
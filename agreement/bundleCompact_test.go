@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeCompactBundleFixture(t *testing.T) unauthenticatedBundle {
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+
+	var proposal, proposal2 proposalValue
+	proposal.BlockDigest = randomBlockHash()
+	proposal2.BlockDigest = randomBlockHash()
+
+	ub := unauthenticatedBundle{Round: round, Period: 1, Step: cert, Proposal: proposal}
+	for i, address := range addresses {
+		rv0 := rawVote{Sender: address, Round: round, Period: 1, Step: cert, Proposal: proposal}
+		uv0, err := makeVote(rv0, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+
+		if i%10 != 0 {
+			ub.Votes = append(ub.Votes, voteAuthenticator{Sender: address, Cred: uv0.Cred, Sig: uv0.Sig})
+			continue
+		}
+
+		rv1 := rawVote{Sender: address, Round: round, Period: 1, Step: cert, Proposal: proposal2}
+		uv1, err := makeVote(rv1, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+		ub.EquivocationVotes = append(ub.EquivocationVotes, equivocationVoteAuthenticator{
+			Sender:    address,
+			Cred:      uv1.Cred,
+			Sigs:      [2]crypto.OneTimeSignature{uv0.Sig, uv1.Sig},
+			Proposals: [2]proposalValue{proposal, proposal2},
+		})
+	}
+	return ub
+}
+
+func TestCompactBundleRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ub := makeCompactBundleFixture(t)
+
+	compact, ok := encodeCompactBundle(&ub)
+	require.True(t, ok)
+	require.Less(t, len(compact), len(protocol.Encode(&ub)))
+
+	decoded, err := decodeBundle(compact)
+	require.NoError(t, err)
+	require.Equal(t, ub, decoded)
+
+	// msgpack-encoded bundles are still accepted
+	decoded, err = decodeBundle(protocol.Encode(&ub))
+	require.NoError(t, err)
+	require.Equal(t, ub, decoded)
+}
+
+func TestCompactBundleMalformed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ub := makeCompactBundleFixture(t)
+	compact, ok := encodeCompactBundle(&ub)
+	require.True(t, ok)
+
+	for _, n := range []int{1, 2, 3, len(compact) / 2, len(compact) - 1} {
+		_, err := decodeCompactBundle(compact[:n])
+		require.Error(t, err, "truncated to %d bytes", n)
+	}
+
+	_, err := decodeCompactBundle(append(append([]byte{}, compact...), 0))
+	require.Error(t, err)
+
+	badVersion := append([]byte{}, compact...)
+	badVersion[1]++
+	_, err = decodeCompactBundle(badVersion)
+	require.Error(t, err)
+
+	// a vote count above the maximum threshold is rejected before allocating
+	huge := []byte{compactBundlePrefix, compactBundleVersion, 1, 0, 2}
+	huge = appendCompactProposal(huge, ub.Proposal)
+	huge = append(huge, 0xff, 0xff, 0xff, 0xff, 0x0f)
+	_, err = decodeCompactBundle(huge)
+	require.Error(t, err)
+
+	// bundles with a PKSigOld cannot be encoded compactly
+	ub.Votes[0].Sig.PKSigOld[0] = 1
+	_, ok = encodeCompactBundle(&ub)
+	require.False(t, ok)
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var crashDBSizeGauge = metrics.MakeGauge(
+	metrics.MetricName{Name: "algod_agreement_crash_db_size_bytes", Description: "Size of the agreement crash recovery database in bytes"})
+var crashDBCompactionsCounter = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_agreement_crash_db_compactions", Description: "Number of times the agreement crash recovery database was compacted"})
+
+// defaultCrashCompactionInterval is used when no compaction interval is
+// configured.
+const defaultCrashCompactionInterval = time.Hour
+
+// crashCompactionMaxPasses bounds the number of times a single compaction
+// prunes the crash database while it remains above its size target.
+const crashCompactionMaxPasses = 4
+
+// crashCompactor periodically measures the crash database and compacts it
+// once it grows beyond its size target.
+//
+// While the database is too large, a compaction discards the oldest half of
+// the recorded equivocation evidence. The recovery state of the Service is
+// never discarded. The compactor never vacuums the database: the pages it
+// frees are reclaimed by the incremental vacuum of the node's database
+// maintenance, which may run while the Service is using the database.
+type crashCompactor struct {
+	log      serviceLogger
+	crash    db.Accessor
+	target   uint64
+	interval time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func makeCrashCompactor(log serviceLogger, crash db.Accessor, cfg config.Local) *crashCompactor {
+	c := &crashCompactor{
+		log:      log,
+		crash:    crash,
+		target:   cfg.AgreementCrashDBSizeTarget,
+		interval: cfg.AgreementCrashDBCompactionInterval,
+	}
+	if c.interval <= 0 {
+		c.interval = defaultCrashCompactionInterval
+	}
+	return c
+}
+
+// start begins measuring, and possibly compacting, the crash database.
+func (c *crashCompactor) start() {
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.done = make(chan struct{})
+	go c.loop()
+}
+
+// quit stops the compactor, waiting for any compaction in progress.
+func (c *crashCompactor) quit() {
+	if c.cancel == nil {
+		return
+	}
+	c.cancel()
+	<-c.done
+	c.cancel = nil
+}
+
+func (c *crashCompactor) loop() {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := c.compact(c.ctx)
+		if err != nil && c.ctx.Err() == nil {
+			c.log.Warnf("crashCompactor: could not compact crash database: %v", err)
+		}
+	}
+}
+
+// size returns the size of the crash database in bytes, not counting the
+// unused pages which are left for the database maintenance to reclaim.
+func (c *crashCompactor) size(ctx context.Context) (uint64, error) {
+	stats, err := c.crash.GetPageStats(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return stats.PageSize * (stats.PageCount - stats.FreelistCount), nil
+}
+
+// compact measures the crash database and, if it exceeds the size target,
+// compacts it.
+func (c *crashCompactor) compact(ctx context.Context) error {
+	size, err := c.size(ctx)
+	if err != nil {
+		return err
+	}
+	crashDBSizeGauge.Set(size)
+	if c.target == 0 || size <= c.target {
+		return nil
+	}
+
+	before := size
+	for pass := 0; pass < crashCompactionMaxPasses && size > c.target; pass++ {
+		var pruned int64
+		err = c.crash.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+			pruned, err = pruneEquivocationEvidence(tx)
+			return
+		})
+		if err != nil {
+			return err
+		}
+		if pruned == 0 {
+			// nothing left which may be discarded
+			break
+		}
+
+		size, err = c.size(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	crashDBCompactionsCounter.Inc(nil)
+	crashDBSizeGauge.Set(size)
+	if size > c.target {
+		c.log.Warnf("crashCompactor: crash database is %d bytes after compaction (from %d bytes), above its target of %d bytes", size, before, c.target)
+	} else {
+		c.log.Infof("crashCompactor: compacted crash database from %d to %d bytes", before, size)
+	}
+	return nil
+}
+
+// pruneEquivocationEvidence discards the oldest half of the recorded
+// equivocation evidence, returning the number of records discarded.
+func pruneEquivocationEvidence(tx *sql.Tx) (int64, error) {
+	err := installEquivocationEvidenceTable(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	err = tx.QueryRow("select count(*) from EquivocationEvidence").Scan(&n)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, nil
+	}
+
+	res, err := tx.Exec("delete from EquivocationEvidence where rowid in (select rowid from EquivocationEvidence order by round limit ?)", (n+1)/2)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
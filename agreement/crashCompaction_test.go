@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestCrashCompaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accessor, err := db.MakeAccessor(filepath.Join(t.TempDir(), "crash.sqlite"), false, false)
+	require.NoError(t, err)
+	defer accessor.Close()
+
+	const records = 1000
+	err = accessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err := agreeInstallDatabase(tx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("insert into Service (rowid, data) values (1, ?)", []byte("state"))
+		if err != nil {
+			return err
+		}
+		err = installEquivocationEvidenceTable(tx)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < records; i++ {
+			data := make([]byte, 4096)
+			crypto.RandBytes(data)
+			_, err = tx.Exec("insert into EquivocationEvidence (sender, round, period, step, data) values (?, ?, 0, 0, ?)", data[:32], i, data)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultLocal()
+	cfg.AgreementCrashDBSizeTarget = 0
+	c := makeCrashCompactor(serviceLogger{Logger: logging.TestingLog(t)}, accessor, cfg)
+
+	before, err := c.size(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, before, uint64(records*4096))
+
+	// a disabled compactor only measures the database
+	require.NoError(t, c.compact(context.Background()))
+	size, err := c.size(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, before, size)
+
+	c.target = before / 3
+	require.NoError(t, c.compact(context.Background()))
+	size, err = c.size(context.Background())
+	require.NoError(t, err)
+	require.LessOrEqual(t, size, c.target)
+
+	// the compactor leaves the freed pages to the database maintenance
+	stats, err := accessor.GetPageStats(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, stats.FreelistCount, uint64(0))
+
+	var remaining, oldest int
+	var state []byte
+	err = accessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err := tx.QueryRow("select count(*), min(round) from EquivocationEvidence").Scan(&remaining, &oldest)
+		if err != nil {
+			return err
+		}
+		return tx.QueryRow("select data from Service").Scan(&state)
+	})
+	require.NoError(t, err)
+	require.Less(t, remaining, records/3)
+	require.Greater(t, remaining, 0)
+	require.Equal(t, records-remaining, oldest)
+	require.Equal(t, []byte("state"), state)
+}
@@ -81,3 +81,19 @@ func (history *credentialArrivalHistory) orderStatistics(idx int) time.Duration
 	sort.Slice(sortedArrivals, func(i, j int) bool { return sortedArrivals[i] < sortedArrivals[j] })
 	return sortedArrivals[idx]
 }
+
+// samples returns a copy of the samples in the buffer, oldest first.
+func (history *credentialArrivalHistory) samples() []time.Duration {
+	if !history.full {
+		return append([]time.Duration(nil), history.history[:history.writePtr]...)
+	}
+	samples := make([]time.Duration, 0, len(history.history))
+	samples = append(samples, history.history[history.writePtr:]...)
+	return append(samples, history.history[:history.writePtr]...)
+}
+
+// clone returns a copy of the history which does not share its buffer.
+func (history credentialArrivalHistory) clone() credentialArrivalHistory {
+	history.history = append([]time.Duration(nil), history.history...)
+	return history
+}
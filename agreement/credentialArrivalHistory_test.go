@@ -127,3 +127,36 @@ func TestOrderStatistics(t *testing.T) {
 		require.Equal(t, time.Duration(i+1), buffer.orderStatistics(i))
 	}
 }
+
+func TestCredentialHistorySamples(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	size := 5
+	history := makeCredentialArrivalHistory(size)
+	require.Empty(t, history.samples())
+
+	for i := 0; i < 3; i++ {
+		history.store(time.Duration(i))
+	}
+	require.Equal(t, []time.Duration{0, 1, 2}, history.samples())
+
+	for i := 3; i < 7; i++ {
+		history.store(time.Duration(i))
+	}
+	require.Equal(t, []time.Duration{2, 3, 4, 5, 6}, history.samples())
+}
+
+func TestCredentialHistoryClone(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	history := makeCredentialArrivalHistory(3)
+	history.store(1)
+	history.store(2)
+
+	clone := history.clone()
+	clone.store(3)
+	clone.store(4)
+	require.Equal(t, []time.Duration{1, 2}, history.samples())
+	require.False(t, history.isFull())
+	require.Equal(t, []time.Duration{2, 3, 4}, clone.samples())
+}
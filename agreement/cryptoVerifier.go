@@ -213,15 +213,15 @@ func (c *poolCryptoVerifier) voteFillWorker(toBundleWait chan<- bundleFuture) {
 				continue
 			}
 
+			if c.voteVerifier.batching {
+				c.verifyVoteBatch(drainVoteBatch(votereq, votesin))
+				continue
+			}
+
 			uv := votereq.message.UnauthenticatedVote
 			err := c.voteVerifier.verifyVote(votereq.ctx, c.ledger, uv, votereq.TaskIndex, votereq.message, c.votes.out)
-			if err != nil && c.votes.out != nil {
-				select {
-				case c.votes.out <- asyncVerifyVoteResponse{index: votereq.TaskIndex, err: err, cancelled: true}:
-				default:
-					voteVerifierOutFullCounter.Inc(nil)
-					c.log.Infof("poolCryptoVerifier.voteFillWorker unable to write failed enqueue response to output channel")
-				}
+			if err != nil {
+				c.failVoteEnqueue(votereq, err)
 			}
 		case bundlereq, ok := <-bundlesin:
 			if !ok {
@@ -251,6 +251,51 @@ func (c *poolCryptoVerifier) voteFillWorker(toBundleWait chan<- bundleFuture) {
 	}
 }
 
+// drainVoteBatch collects the vote requests already queued behind first, up to
+// maxVoteVerificationBatch of them, so that they may be verified together.
+func drainVoteBatch(first cryptoVoteRequest, votesin <-chan cryptoVoteRequest) []cryptoVoteRequest {
+	batch := []cryptoVoteRequest{first}
+	for len(batch) < maxVoteVerificationBatch {
+		select {
+		case votereq, ok := <-votesin:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, votereq)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (c *poolCryptoVerifier) verifyVoteBatch(batch []cryptoVoteRequest) {
+	reqs := make([]asyncVerifyVoteRequest, len(batch))
+	for i, votereq := range batch {
+		uv := votereq.message.UnauthenticatedVote
+		reqs[i] = asyncVerifyVoteRequest{ctx: votereq.ctx, l: c.ledger, uv: &uv, index: votereq.TaskIndex, message: votereq.message, out: c.votes.out}
+	}
+	err := c.voteVerifier.verifyVoteBatch(reqs)
+	if err != nil {
+		for _, votereq := range batch {
+			c.failVoteEnqueue(votereq, err)
+		}
+	}
+}
+
+// failVoteEnqueue reports a vote request which could not be enqueued into the vote verifier.
+func (c *poolCryptoVerifier) failVoteEnqueue(votereq cryptoVoteRequest, err error) {
+	if c.votes.out == nil {
+		return
+	}
+	select {
+	case c.votes.out <- asyncVerifyVoteResponse{index: votereq.TaskIndex, err: err, cancelled: true}:
+	default:
+		voteVerifierOutFullCounter.Inc(nil)
+		c.log.Infof("poolCryptoVerifier.voteFillWorker unable to write failed enqueue response to output channel")
+	}
+}
+
 func (c *poolCryptoVerifier) bundleWaitWorker(fromVoteFill <-chan bundleFuture) {
 	defer c.wg.Done()
 	for future := range fromVoteFill {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
@@ -64,6 +65,10 @@ type demux struct {
 	monitor           *coserviceMonitor
 	cancelTokenizers  context.CancelFunc
 
+	// voteDedup holds recently verified votes so that relayed copies of
+	// them can be dropped by the vote tokenizer.
+	voteDedup *voteDedupCache
+
 	log logging.Logger
 }
 
@@ -89,6 +94,7 @@ func makeDemux(params demuxParams) (d *demux) {
 	d.monitor = params.monitor
 	d.queue = make([]<-chan externalEvent, 0)
 	d.processingMonitor = params.processingMonitor
+	d.voteDedup = makeVoteDedupCache(voteDedupCacheSize, voteDedupCacheTTL)
 
 	tokenizerCtx, cancelTokenizers := context.WithCancel(context.Background())
 	d.rawVotes = d.tokenizeMessages(tokenizerCtx, params.net, protocol.AgreementVoteTag, decodeVote)
@@ -142,6 +148,13 @@ func (d *demux) tokenizeMessages(ctx context.Context, net Network, tag protocol.
 				var msg message
 				switch tag {
 				case protocol.AgreementVoteTag:
+					if d.voteDedup.seen(makeVoteDedupKey(o.(unauthenticatedVote)), time.Now()) {
+						// we have already verified an identical copy of this vote
+						voteDedupDroppedCounter.Inc(nil)
+						d.UpdateEventsQueue(eventQueueTokenizing[tag], 0)
+						d.monitor.dec(tokenizerCoserviceType)
+						continue
+					}
 					msg = message{messageHandle: raw.MessageHandle, Tag: tag, UnauthenticatedVote: o.(unauthenticatedVote)}
 				case protocol.VoteBundleTag:
 					msg = message{messageHandle: raw.MessageHandle, Tag: tag, UnauthenticatedBundle: o.(unauthenticatedBundle)}
@@ -343,6 +356,10 @@ func (d *demux) next(s *Service, deadline Deadline, fastDeadline Deadline, curre
 	// authenticated
 	case r := <-d.crypto.VerifiedVotes():
 		e = messageEvent{T: voteVerified, Input: r.message, TaskIndex: r.index, Err: makeSerErr(r.err), Cancelled: r.cancelled}
+		if r.err == nil && !r.cancelled {
+			d.voteDedup.add(makeVoteDedupKey(r.message.UnauthenticatedVote), time.Now())
+			s.expectProposal(r.message.Vote)
+		}
 		d.UpdateEventsQueue(eventQueueDemux, 1)
 		d.UpdateEventsQueue(eventQueueCryptoVerifierVote, 0)
 		d.monitor.inc(demuxCoserviceType)
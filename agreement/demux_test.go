@@ -655,6 +655,7 @@ func (t *demuxTester) TestUsecase(testcase demuxTestUsecase) bool {
 
 	dmx.crypto = t
 	dmx.ledger = t
+	dmx.voteDedup = makeVoteDedupCache(voteDedupCacheSize, voteDedupCacheTTL)
 	dmx.rawVotes = t.makeRawChannel(protocol.AgreementVoteTag, testcase.rawVotes, false)
 	dmx.rawProposals = t.makeRawChannel(protocol.ProposalPayloadTag, testcase.rawProposals, testcase.compoundProposals)
 	dmx.rawBundles = t.makeRawChannel(protocol.VoteBundleTag, testcase.rawBundles, false)
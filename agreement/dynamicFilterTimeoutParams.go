@@ -16,7 +16,11 @@
 
 package agreement
 
-import "time"
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+)
 
 // This file contains parameters for the dynamic filter timeout mechanism. When
 // this feature is enabled (dynamicFilterTimeout is true), these parameters
@@ -33,13 +37,117 @@ const dynamicFilterCredentialArrivalHistory int = 40
 // filter timeout must meet.
 const dynamicFilterTimeoutLowerBound time.Duration = 2500 * time.Millisecond
 
-// DynamicFilterTimeoutCredentialArrivalHistoryIdx specified which sample to use
-// out of a sorted DynamicFilterCredentialArrivalHistory-sized array of time
-// samples. The 95th percentile of dynamicFilterCredentialArrivalHistory = 40
-// sorted samples, is at index 37.
-const dynamicFilterTimeoutCredentialArrivalHistoryIdx int = 37
+// DynamicFilterTimeoutPercentile specifies which sample to use out of the
+// sorted credential arrival history. The 95th percentile of
+// dynamicFilterCredentialArrivalHistory = 40 sorted samples is at index 37.
+const dynamicFilterTimeoutPercentile int = 95
 
 // DynamicFilterTimeoutGraceInterval is additional extension to the dynamic
 // filter time atop the one calculated based on the history of credential
 // arrivals.
 const dynamicFilterTimeoutGraceInterval time.Duration = 50 * time.Millisecond
+
+// maxDynamicFilterCredentialArrivalHistory bounds the configurable size of the
+// credential arrival history.
+const maxDynamicFilterCredentialArrivalHistory = 1000
+
+// maxCredentialRoundLagFactor bounds the configurable credential round lag to
+// a multiple of its default, credentialRoundLag.
+const maxCredentialRoundLagFactor = 4
+
+// dynamicFilterConfig holds the parameters of the dynamic filter timeout
+// which an operator may set through config.Local.
+//
+// A nil *dynamicFilterConfig holds the default parameters.
+type dynamicFilterConfig struct {
+	historySize int
+	roundLag    round
+	percentile  int
+	margin      time.Duration
+}
+
+// makeDynamicFilterConfig reads the dynamic filter timeout parameters from
+// the given configuration, bounding them to values which are consistent
+// with the consensus parameters.
+func makeDynamicFilterConfig(cfg config.Local) *dynamicFilterConfig {
+	c := new(dynamicFilterConfig)
+
+	c.historySize = dynamicFilterCredentialArrivalHistory
+	if cfg.AgreementCredentialArrivalHistory != 0 {
+		c.historySize = maxDynamicFilterCredentialArrivalHistory
+		if cfg.AgreementCredentialArrivalHistory < maxDynamicFilterCredentialArrivalHistory {
+			c.historySize = int(cfg.AgreementCredentialArrivalHistory)
+		}
+	}
+
+	c.percentile = dynamicFilterTimeoutPercentile
+	if cfg.AgreementDynamicFilterPercentile != 0 {
+		c.percentile = 100
+		if cfg.AgreementDynamicFilterPercentile < 100 {
+			c.percentile = int(cfg.AgreementDynamicFilterPercentile)
+		}
+	}
+
+	c.margin = dynamicFilterTimeoutGraceInterval
+	if cfg.AgreementDynamicFilterMargin > 0 {
+		c.margin = cfg.AgreementDynamicFilterMargin
+	}
+
+	c.roundLag = credentialRoundLag
+	if cfg.AgreementCredentialRoundLag != 0 {
+		c.roundLag = round(cfg.AgreementCredentialRoundLag)
+		// credentials may arrive up to 2*SmallLambda late, so a shorter lag
+		// would discard old round routers while they are still useful.
+		if c.roundLag < consensusCredentialRoundLag {
+			c.roundLag = consensusCredentialRoundLag
+		}
+		if c.roundLag > maxCredentialRoundLagFactor*credentialRoundLag {
+			c.roundLag = maxCredentialRoundLagFactor * credentialRoundLag
+		}
+	}
+	return c
+}
+
+// credentialHistorySize returns the number of credential arrivals measured
+// to determine the filter timeout.
+func (c *dynamicFilterConfig) credentialHistorySize() int {
+	if c == nil {
+		return dynamicFilterCredentialArrivalHistory
+	}
+	return c.historySize
+}
+
+// credentialHistoryIdx returns the index of the sample, out of the sorted
+// credential arrival history, which determines the filter timeout. It is the
+// nearest-rank index of the configured percentile.
+func (c *dynamicFilterConfig) credentialHistoryIdx() int {
+	size := c.credentialHistorySize()
+	percentile := dynamicFilterTimeoutPercentile
+	if c != nil {
+		percentile = c.percentile
+	}
+
+	idx := (size*percentile+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return idx
+}
+
+// filterTimeoutMargin returns the safety margin added to the selected
+// credential arrival time to obtain the dynamic filter timeout.
+func (c *dynamicFilterConfig) filterTimeoutMargin() time.Duration {
+	if c == nil {
+		return dynamicFilterTimeoutGraceInterval
+	}
+	return c.margin
+}
+
+// credentialRoundLag returns the number of rounds for which credentials
+// from a past round are tracked.
+func (c *dynamicFilterConfig) credentialRoundLag() round {
+	if c == nil {
+		return credentialRoundLag
+	}
+	return c.roundLag
+}
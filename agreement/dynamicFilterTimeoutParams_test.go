@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -28,9 +29,13 @@ func TestSampleIndexIsValid(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
 	require.GreaterOrEqual(t, dynamicFilterCredentialArrivalHistory, 0)
-	require.GreaterOrEqual(t, dynamicFilterTimeoutCredentialArrivalHistoryIdx, 0)
+	require.Greater(t, dynamicFilterTimeoutPercentile, 0)
+	require.LessOrEqual(t, dynamicFilterTimeoutPercentile, 100)
+
+	var c *dynamicFilterConfig
+	require.GreaterOrEqual(t, c.credentialHistoryIdx(), 0)
 	if dynamicFilterCredentialArrivalHistory > 0 {
-		require.Less(t, dynamicFilterTimeoutCredentialArrivalHistoryIdx, dynamicFilterCredentialArrivalHistory)
+		require.Less(t, c.credentialHistoryIdx(), dynamicFilterCredentialArrivalHistory)
 	}
 }
 
@@ -39,3 +44,71 @@ func TestLowerBound(t *testing.T) {
 
 	require.Less(t, 20*time.Millisecond, dynamicFilterTimeoutLowerBound)
 }
+
+func TestDynamicFilterConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// a nil config holds the defaults
+	var c *dynamicFilterConfig
+	require.Equal(t, dynamicFilterCredentialArrivalHistory, c.credentialHistorySize())
+	require.Equal(t, 37, c.credentialHistoryIdx())
+	require.Equal(t, dynamicFilterTimeoutGraceInterval, c.filterTimeoutMargin())
+	require.Equal(t, credentialRoundLag, c.credentialRoundLag())
+
+	// so does the default config
+	c = makeDynamicFilterConfig(config.GetDefaultLocal())
+	require.Equal(t, dynamicFilterCredentialArrivalHistory, c.credentialHistorySize())
+	require.Equal(t, 37, c.credentialHistoryIdx())
+	require.Equal(t, dynamicFilterTimeoutGraceInterval, c.filterTimeoutMargin())
+	require.Equal(t, credentialRoundLag, c.credentialRoundLag())
+
+	for _, size := range []uint64{1, 2, 10, 40, 100, 1000, 5000} {
+		cfg := config.GetDefaultLocal()
+		cfg.AgreementCredentialArrivalHistory = size
+		c = makeDynamicFilterConfig(cfg)
+		require.LessOrEqual(t, c.credentialHistorySize(), maxDynamicFilterCredentialArrivalHistory)
+		require.GreaterOrEqual(t, c.credentialHistoryIdx(), 0)
+		require.Less(t, c.credentialHistoryIdx(), c.credentialHistorySize())
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.AgreementCredentialRoundLag = 1
+	require.Equal(t, consensusCredentialRoundLag, makeDynamicFilterConfig(cfg).credentialRoundLag())
+
+	cfg.AgreementCredentialRoundLag = uint64(credentialRoundLag) + 1
+	require.Equal(t, credentialRoundLag+1, makeDynamicFilterConfig(cfg).credentialRoundLag())
+
+	cfg.AgreementCredentialRoundLag = 1 << 40
+	require.Equal(t, maxCredentialRoundLagFactor*credentialRoundLag, makeDynamicFilterConfig(cfg).credentialRoundLag())
+}
+
+func TestDynamicFilterPercentile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.AgreementCredentialArrivalHistory = 100
+
+	cfg.AgreementDynamicFilterPercentile = 50
+	require.Equal(t, 49, makeDynamicFilterConfig(cfg).credentialHistoryIdx())
+
+	cfg.AgreementDynamicFilterPercentile = 100
+	require.Equal(t, 99, makeDynamicFilterConfig(cfg).credentialHistoryIdx())
+
+	cfg.AgreementDynamicFilterPercentile = 1000
+	require.Equal(t, 99, makeDynamicFilterConfig(cfg).credentialHistoryIdx())
+
+	cfg.AgreementDynamicFilterPercentile = 1
+	require.Equal(t, 0, makeDynamicFilterConfig(cfg).credentialHistoryIdx())
+
+	cfg.AgreementDynamicFilterMargin = 300 * time.Millisecond
+	require.Equal(t, 300*time.Millisecond, makeDynamicFilterConfig(cfg).filterTimeoutMargin())
+
+	// a single slow round does not move a percentile below the maximum
+	history := makeCredentialArrivalHistory(100)
+	for i := 0; i < 99; i++ {
+		history.store(time.Second)
+	}
+	history.store(time.Minute)
+	cfg.AgreementDynamicFilterPercentile = 95
+	require.Equal(t, time.Second, history.orderStatistics(makeDynamicFilterConfig(cfg).credentialHistoryIdx()))
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// An EventListener is notified of the state transitions of the agreement
+// protocol as they are observed by a Service.
+//
+// Callbacks are invoked synchronously from the main agreement loop, so they
+// must return quickly and must not call back into the Service. Callbacks are
+// not invoked for events which are replayed from the crash database.
+type EventListener interface {
+	// OnProposalObserved is called when a proposal-vote for the given
+	// round and period is accepted.
+	OnProposalObserved(rnd basics.Round, per uint64, proposer basics.Address, digest crypto.Digest)
+
+	// OnSoftThreshold is called when a soft threshold is reached for the
+	// block with the given digest.
+	OnSoftThreshold(rnd basics.Round, per uint64, digest crypto.Digest)
+
+	// OnCertThreshold is called when a cert threshold is reached for the
+	// block with the given digest.
+	OnCertThreshold(rnd basics.Round, per uint64, digest crypto.Digest)
+
+	// OnPeriodChange is called when the Service moves from one period of a
+	// round to another. digest identifies the starting value of the new
+	// period, and is zero if there is none.
+	OnPeriodChange(rnd basics.Round, from, to uint64, digest crypto.Digest)
+
+	// OnFastRecovery is called when a fast recovery timeout fires.
+	OnFastRecovery(rnd basics.Round, per uint64, stp uint64)
+}
+
+// NoopEventListener is an EventListener which ignores all events. It may be
+// embedded by implementations which are only interested in some events.
+type NoopEventListener struct{}
+
+// OnProposalObserved implements EventListener.
+func (NoopEventListener) OnProposalObserved(basics.Round, uint64, basics.Address, crypto.Digest) {}
+
+// OnSoftThreshold implements EventListener.
+func (NoopEventListener) OnSoftThreshold(basics.Round, uint64, crypto.Digest) {}
+
+// OnCertThreshold implements EventListener.
+func (NoopEventListener) OnCertThreshold(basics.Round, uint64, crypto.Digest) {}
+
+// OnPeriodChange implements EventListener.
+func (NoopEventListener) OnPeriodChange(basics.Round, uint64, uint64, crypto.Digest) {}
+
+// OnFastRecovery implements EventListener.
+func (NoopEventListener) OnFastRecovery(basics.Round, uint64, uint64) {}
+
+// eventListeners passes each event to several EventListeners in order.
+type eventListeners []EventListener
+
+func (ls eventListeners) OnProposalObserved(rnd basics.Round, per uint64, proposer basics.Address, digest crypto.Digest) {
+	for _, l := range ls {
+		l.OnProposalObserved(rnd, per, proposer, digest)
+	}
+}
+
+func (ls eventListeners) OnSoftThreshold(rnd basics.Round, per uint64, digest crypto.Digest) {
+	for _, l := range ls {
+		l.OnSoftThreshold(rnd, per, digest)
+	}
+}
+
+func (ls eventListeners) OnCertThreshold(rnd basics.Round, per uint64, digest crypto.Digest) {
+	for _, l := range ls {
+		l.OnCertThreshold(rnd, per, digest)
+	}
+}
+
+func (ls eventListeners) OnPeriodChange(rnd basics.Round, from, to uint64, digest crypto.Digest) {
+	for _, l := range ls {
+		l.OnPeriodChange(rnd, from, to, digest)
+	}
+}
+
+func (ls eventListeners) OnFastRecovery(rnd basics.Round, per uint64, stp uint64) {
+	for _, l := range ls {
+		l.OnFastRecovery(rnd, per, stp)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// equivocationEvidenceRetention is the number of rounds for which evidence
+// is kept in the crash database, counting back from the newest evidence.
+const equivocationEvidenceRetention = basics.Round(10000)
+
+// equivocationEvidenceQueueSize bounds the number of equivocations which may
+// be waiting to be recorded.
+const equivocationEvidenceQueueSize = 1024
+
+// EquivocationEvidence proves that a voter cast votes for two different
+// proposal-values in the same round, period, and step.
+type EquivocationEvidence struct {
+	Sender basics.Address
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// BlockDigests identify the two proposals the sender voted for.
+	BlockDigests [2]crypto.Digest
+	// Weight is the weight of the sender's credential in the step.
+	Weight uint64
+
+	// Votes is the msgpack encoding of the pair of conflicting votes, which
+	// any node may verify against its ledger.
+	Votes []byte
+}
+
+// An EquivocationObserver is notified of the equivocations detected by a
+// Service.
+type EquivocationObserver interface {
+	// ObserveEquivocation is called once for each voter which equivocates
+	// in a given round, period, and step, after its evidence has been
+	// written to the crash database.
+	//
+	// ObserveEquivocation is called from a dedicated goroutine; a slow
+	// EquivocationObserver delays the recording of later evidence.
+	ObserveEquivocation(EquivocationEvidence)
+}
+
+func makeEquivocationEvidence(ev equivocationVote) EquivocationEvidence {
+	uev := unauthenticatedEquivocationVote{
+		Sender:    ev.Sender,
+		Round:     ev.Round,
+		Period:    ev.Period,
+		Step:      ev.Step,
+		Cred:      ev.Cred.UnauthenticatedCredential,
+		Proposals: ev.Proposals,
+		Sigs:      ev.Sigs,
+	}
+	return EquivocationEvidence{
+		Sender:       ev.Sender,
+		Round:        ev.Round,
+		Period:       uint64(ev.Period),
+		Step:         uint64(ev.Step),
+		BlockDigests: [2]crypto.Digest{ev.Proposals[0].BlockDigest, ev.Proposals[1].BlockDigest},
+		Weight:       ev.Cred.Weight,
+		Votes:        protocol.Encode(&uev),
+	}
+}
+
+// An evidenceRecorder writes the equivocations detected by the state machine
+// to the crash database and passes them on to an EquivocationObserver.
+//
+// Equivocations are recorded asynchronously so that the state machine never
+// waits on the database.
+type evidenceRecorder struct {
+	log      serviceLogger
+	crash    db.Accessor
+	observer EquivocationObserver
+
+	queue chan EquivocationEvidence
+	done  chan struct{}
+}
+
+func makeEvidenceRecorder(log serviceLogger, crash db.Accessor, observer EquivocationObserver) *evidenceRecorder {
+	return &evidenceRecorder{
+		log:      log,
+		crash:    crash,
+		observer: observer,
+	}
+}
+
+// start installs the evidence table, if needed, and begins recording.
+func (r *evidenceRecorder) start() {
+	err := r.crash.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return installEquivocationEvidenceTable(tx)
+	})
+	if err != nil {
+		r.log.Warnf("evidenceRecorder: could not install equivocation evidence table: %v", err)
+	}
+
+	r.queue = make(chan EquivocationEvidence, equivocationEvidenceQueueSize)
+	r.done = make(chan struct{})
+	go r.loop()
+}
+
+// quit records all pending evidence and then stops the recorder.
+func (r *evidenceRecorder) quit() {
+	if r.queue == nil {
+		return
+	}
+	close(r.queue)
+	<-r.done
+	r.queue = nil
+}
+
+// record enqueues an equivocation to be recorded. It never blocks.
+func (r *evidenceRecorder) record(ev equivocationVote) {
+	if r.queue == nil {
+		return
+	}
+	select {
+	case r.queue <- makeEquivocationEvidence(ev):
+	default:
+		r.log.Warnf("evidenceRecorder: dropped evidence of equivocation by %v at (%d, %d, %d): queue full", ev.Sender, ev.Round, ev.Period, ev.Step)
+	}
+}
+
+func (r *evidenceRecorder) loop() {
+	defer close(r.done)
+	for ev := range r.queue {
+		err := r.crash.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			return writeEquivocationEvidence(tx, ev)
+		})
+		if err != nil {
+			r.log.Errorf("evidenceRecorder: could not record equivocation by %v at (%d, %d, %d): %v", ev.Sender, ev.Round, ev.Period, ev.Step, err)
+		}
+		if r.observer != nil {
+			r.observer.ObserveEquivocation(ev)
+		}
+	}
+}
+
+// load returns all recorded evidence from rounds no earlier than minRound,
+// ordered by round, period, and step.
+func (r *evidenceRecorder) load(minRound basics.Round) (evidence []EquivocationEvidence, err error) {
+	err = r.crash.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		evidence, err = readEquivocationEvidence(tx, minRound)
+		return err
+	})
+	return
+}
+
+func installEquivocationEvidenceTable(tx *sql.Tx) error {
+	_, err := tx.Exec(`create table if not exists EquivocationEvidence (
+		sender blob,
+		round integer,
+		period integer,
+		step integer,
+		data blob,
+		primary key (sender, round, period, step)
+	)`)
+	return err
+}
+
+func writeEquivocationEvidence(tx *sql.Tx, ev EquivocationEvidence) error {
+	_, err := tx.Exec("insert or ignore into EquivocationEvidence (sender, round, period, step, data) values (?, ?, ?, ?, ?)",
+		ev.Sender[:], uint64(ev.Round), ev.Period, ev.Step, protocol.EncodeReflect(ev))
+	if err != nil {
+		return err
+	}
+	if ev.Round > equivocationEvidenceRetention {
+		_, err = tx.Exec("delete from EquivocationEvidence where round < ?", uint64(ev.Round-equivocationEvidenceRetention))
+	}
+	return err
+}
+
+func readEquivocationEvidence(tx *sql.Tx, minRound basics.Round) ([]EquivocationEvidence, error) {
+	rows, err := tx.Query("select data from EquivocationEvidence where round >= ? order by round, period, step", uint64(minRound))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var evidence []EquivocationEvidence
+	for rows.Next() {
+		var raw []byte
+		err = rows.Scan(&raw)
+		if err != nil {
+			return nil, err
+		}
+		var ev EquivocationEvidence
+		err = protocol.DecodeReflect(raw, &ev)
+		if err != nil {
+			return nil, err
+		}
+		evidence = append(evidence, ev)
+	}
+	return evidence, rows.Err()
+}
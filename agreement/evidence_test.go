@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type recordingEquivocationObserver struct {
+	mu       deadlock.Mutex
+	evidence []EquivocationEvidence
+}
+
+func (o *recordingEquivocationObserver) ObserveEquivocation(ev EquivocationEvidence) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.evidence = append(o.evidence, ev)
+}
+
+func TestEvidenceRecorder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accessor, err := db.MakeAccessor(t.Name()+"_crash.db", false, true)
+	require.NoError(t, err)
+	defer accessor.Close()
+
+	observer := &recordingEquivocationObserver{}
+	r := makeEvidenceRecorder(serviceLogger{logging.TestingLog(t)}, accessor, observer)
+	r.start()
+
+	makeEquivocation := func(rnd basics.Round, s step) equivocationVote {
+		var ev equivocationVote
+		crypto.RandBytes(ev.Sender[:])
+		ev.Round = rnd
+		ev.Period = 1
+		ev.Step = s
+		ev.Cred = committee.Credential{Weight: 7}
+		ev.Proposals[0].BlockDigest = randomBlockHash()
+		ev.Proposals[1].BlockDigest = randomBlockHash()
+		return ev
+	}
+	old := makeEquivocation(10, soft)
+	ev0 := makeEquivocation(equivocationEvidenceRetention+20, cert)
+	ev1 := makeEquivocation(equivocationEvidenceRetention+20, soft)
+	r.record(old)
+	r.record(ev0)
+	r.record(ev0) // duplicates are ignored by the database
+	r.record(ev1)
+	r.quit()
+
+	// recording after quit is a no-op
+	r.record(makeEquivocation(equivocationEvidenceRetention+30, soft))
+
+	require.Len(t, observer.evidence, 4)
+	require.Equal(t, makeEquivocationEvidence(ev0), observer.evidence[1])
+
+	evidence, err := r.load(0)
+	require.NoError(t, err)
+	// old evidence was pruned; the rest is ordered by step
+	require.Len(t, evidence, 2)
+	require.Equal(t, makeEquivocationEvidence(ev1), evidence[0])
+	require.Equal(t, makeEquivocationEvidence(ev0), evidence[1])
+	require.Equal(t, uint64(7), evidence[0].Weight)
+	require.Equal(t, ev1.Proposals[1].BlockDigest, evidence[0].BlockDigests[1])
+
+	var uev unauthenticatedEquivocationVote
+	require.NoError(t, protocol.Decode(evidence[1].Votes, &uev))
+	require.Equal(t, ev0.Sender, uev.Sender)
+	require.Equal(t, ev0.Proposals, uev.Proposals)
+
+	evidence, err = r.load(equivocationEvidenceRetention + 21)
+	require.NoError(t, err)
+	require.Empty(t, evidence)
+}
+
+func TestAgreementRecordsEquivocations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 5
+	baseNetwork, baseLedger, cleanupFn, services, clocks, ledgers, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	startRound := baseLedger.NextRound()
+	defer cleanupFn()
+
+	observer := &recordingEquivocationObserver{}
+	services[0].evidence.observer = observer
+
+	adv := installAdversary(baseNetwork, services)
+	adv.from(4).votes(soft).inPeriod(0).equivocate()
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(t, clocks, 0)
+	triggerGlobalTimeoutType(TimeoutFilter, clocks, activityMonitor)
+	expectNewPeriod(t, clocks, zeroes)
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+	sanityCheck(startRound, 1, ledgers)
+
+	evidence, err := services[0].Equivocations(startRound)
+	require.NoError(t, err)
+	require.Len(t, evidence, 1)
+	require.Equal(t, startRound, evidence[0].Round)
+	require.Equal(t, uint64(soft), evidence[0].Step)
+	require.NotEqual(t, evidence[0].BlockDigests[0], evidence[0].BlockDigests[1])
+	require.Equal(t, evidence, observer.evidence)
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gossip
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var bytesSentByType = metrics.NewTagCounter("algod_agreement_sent_bytes_{TAG}", "Number of bytes of agreement {TAG} messages sent",
+	agreementVoteMessageType, agreementProposalMessageType, agreementBundleMessageType, agreementProposalChunkMessageType)
+var bytesReceivedByType = metrics.NewTagCounter("algod_agreement_received_bytes_{TAG}", "Number of bytes of agreement {TAG} messages received",
+	agreementVoteMessageType, agreementProposalMessageType, agreementBundleMessageType, agreementProposalChunkMessageType)
+
+// bandwidthHistoryRounds is the number of certified rounds for which
+// bandwidth usage is retained.
+const bandwidthHistoryRounds = 16
+
+var messageTypes = map[protocol.Tag]string{
+	protocol.AgreementVoteTag:   agreementVoteMessageType,
+	protocol.ProposalPayloadTag: agreementProposalMessageType,
+	protocol.VoteBundleTag:      agreementBundleMessageType,
+	protocol.ProposalChunkTag:   agreementProposalChunkMessageType,
+}
+
+// TagBandwidth describes the agreement messages of a single tag which were
+// sent or received.
+//
+// Sent bytes count the payload handed to the network once per broadcast or
+// relay, regardless of the number of peers it is sent to.
+type TagBandwidth struct {
+	SentMessages     uint64
+	SentBytes        uint64
+	ReceivedMessages uint64
+	ReceivedBytes    uint64
+}
+
+// RoundBandwidth describes the agreement messages sent or received while a
+// round was in progress.
+type RoundBandwidth struct {
+	// Round is the round in progress, or zero if it is not yet known.
+	Round basics.Round
+	Tags  map[protocol.Tag]TagBandwidth
+}
+
+func (b RoundBandwidth) clone() RoundBandwidth {
+	tags := make(map[protocol.Tag]TagBandwidth, len(b.Tags))
+	for tag, tb := range b.Tags {
+		tags[tag] = tb
+	}
+	return RoundBandwidth{Round: b.Round, Tags: tags}
+}
+
+// bandwidthAccountant attributes agreement traffic to the rounds during
+// which it was sent or received. It learns of round boundaries as an
+// agreement.EventListener.
+type bandwidthAccountant struct {
+	agreement.NoopEventListener
+
+	mu      deadlock.Mutex
+	current RoundBandwidth
+	history []RoundBandwidth
+}
+
+func makeBandwidthAccountant() *bandwidthAccountant {
+	return &bandwidthAccountant{current: RoundBandwidth{Tags: make(map[protocol.Tag]TagBandwidth)}}
+}
+
+func (a *bandwidthAccountant) sent(tag protocol.Tag, n int) {
+	bytesSentByType.Add(messageTypes[tag], uint64(n))
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	tb := a.current.Tags[tag]
+	tb.SentMessages++
+	tb.SentBytes += uint64(n)
+	a.current.Tags[tag] = tb
+}
+
+func (a *bandwidthAccountant) received(tag protocol.Tag, n int) {
+	bytesReceivedByType.Add(messageTypes[tag], uint64(n))
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	tb := a.current.Tags[tag]
+	tb.ReceivedMessages++
+	tb.ReceivedBytes += uint64(n)
+	a.current.Tags[tag] = tb
+}
+
+// OnCertThreshold implements agreement.EventListener. It closes the account
+// of the certified round and opens one for the next round.
+func (a *bandwidthAccountant) OnCertThreshold(rnd basics.Round, per uint64, digest crypto.Digest) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.current.Round != 0 && rnd < a.current.Round {
+		// a late threshold for a round which was already closed
+		return
+	}
+
+	a.current.Round = rnd
+	a.history = append(a.history, a.current)
+	if len(a.history) > bandwidthHistoryRounds {
+		a.history = a.history[len(a.history)-bandwidthHistoryRounds:]
+	}
+	a.current = RoundBandwidth{Round: rnd + 1, Tags: make(map[protocol.Tag]TagBandwidth)}
+}
+
+func (a *bandwidthAccountant) usage() (current RoundBandwidth, recent []RoundBandwidth) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	current = a.current.clone()
+	recent = make([]RoundBandwidth, len(a.history))
+	for i, b := range a.history {
+		recent[i] = b.clone()
+	}
+	return
+}
+
+// BandwidthListener returns an agreement.EventListener which must be passed
+// to the agreement.Service using the result of WrapNetwork, so that the
+// bandwidth it reports is attributed to the correct rounds.
+func BandwidthListener(net agreement.Network) agreement.EventListener {
+	return net.(*networkImpl).bandwidth
+}
+
+// Bandwidth reports the agreement traffic of the result of WrapNetwork during
+// the current round and during recently certified rounds, oldest first.
+func Bandwidth(net agreement.Network) (current RoundBandwidth, recent []RoundBandwidth) {
+	return net.(*networkImpl).bandwidth.usage()
+}
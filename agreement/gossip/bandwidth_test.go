@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gossip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBandwidthAccountantRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := makeBandwidthAccountant()
+	a.sent(protocol.AgreementVoteTag, 100)
+	a.sent(protocol.AgreementVoteTag, 50)
+	a.received(protocol.ProposalPayloadTag, 1000)
+
+	cur, recent := a.usage()
+	require.Empty(t, recent)
+	require.Equal(t, TagBandwidth{SentMessages: 2, SentBytes: 150}, cur.Tags[protocol.AgreementVoteTag])
+	require.Equal(t, TagBandwidth{ReceivedMessages: 1, ReceivedBytes: 1000}, cur.Tags[protocol.ProposalPayloadTag])
+
+	a.OnCertThreshold(10, 0, crypto.Digest{})
+	a.received(protocol.VoteBundleTag, 7)
+
+	cur, recent = a.usage()
+	require.Len(t, recent, 1)
+	require.EqualValues(t, 10, recent[0].Round)
+	require.EqualValues(t, 150, recent[0].Tags[protocol.AgreementVoteTag].SentBytes)
+	require.EqualValues(t, 11, cur.Round)
+	require.Equal(t, TagBandwidth{ReceivedMessages: 1, ReceivedBytes: 7}, cur.Tags[protocol.VoteBundleTag])
+	require.NotContains(t, cur.Tags, protocol.AgreementVoteTag)
+
+	// a late threshold for an old round is ignored
+	a.OnCertThreshold(9, 1, crypto.Digest{})
+	cur, recent = a.usage()
+	require.Len(t, recent, 1)
+	require.EqualValues(t, 11, cur.Round)
+
+	// the returned usage is a copy
+	cur.Tags[protocol.VoteBundleTag] = TagBandwidth{}
+	cur, _ = a.usage()
+	require.EqualValues(t, 7, cur.Tags[protocol.VoteBundleTag].ReceivedBytes)
+}
+
+func TestBandwidthAccountantHistoryBounded(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := makeBandwidthAccountant()
+	for r := 1; r <= 2*bandwidthHistoryRounds; r++ {
+		a.OnCertThreshold(basics.Round(r), 0, crypto.Digest{})
+	}
+	_, recent := a.usage()
+	require.Len(t, recent, bandwidthHistoryRounds)
+	require.EqualValues(t, bandwidthHistoryRounds+1, recent[0].Round)
+	require.EqualValues(t, 2*bandwidthHistoryRounds, recent[len(recent)-1].Round)
+}
@@ -23,6 +23,8 @@ import (
 
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
@@ -33,6 +35,7 @@ import (
 var messagesHandledTotal = metrics.MakeCounter(metrics.AgreementMessagesHandled)
 var messagesHandledByType = metrics.NewTagCounter("algod_agreement_handled_{TAG}", "Number of agreement {TAG} messages handled",
 	agreementVoteMessageType, agreementProposalMessageType, agreementBundleMessageType)
+var proposalChunksForwarded = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_proposal_chunks_forwarded", Description: "Number of proposal payload chunks forwarded before the payload was reassembled"})
 var messagesDroppedTotal = metrics.MakeCounter(metrics.AgreementMessagesDropped)
 var messagesDroppedByType = metrics.NewTagCounter("algod_agreement_dropped_{TAG}", "Number of agreement {TAG} messages dropped",
 	agreementVoteMessageType, agreementProposalMessageType, agreementBundleMessageType)
@@ -41,10 +44,16 @@ const (
 	agreementVoteMessageType     = "vote"
 	agreementProposalMessageType = "proposal"
 	agreementBundleMessageType   = "bundle"
+
+	agreementProposalChunkMessageType = "proposal_chunk"
 )
 
 type messageMetadata struct {
 	raw network.IncomingMessage
+
+	// chunked is set if the message is a proposal payload which was
+	// reassembled from chunks. Its chunks have already been forwarded.
+	chunked bool
 }
 
 // networkImpl wraps network.GossipNode to provide a compatible interface with agreement.
@@ -57,6 +66,9 @@ type networkImpl struct {
 	log logging.Logger
 
 	trace messagetracer.MessageTracer
+
+	bandwidth *bandwidthAccountant
+	chunks    *agreement.ProposalAssembler
 }
 
 // WrapNetwork adapts a network.GossipNode into an agreement.Network.
@@ -69,6 +81,8 @@ func WrapNetwork(net network.GossipNode, log logging.Logger, cfg config.Local) a
 
 	i.net = net
 	i.log = log
+	i.bandwidth = makeBandwidthAccountant()
+	i.chunks = agreement.MakeProposalAssembler()
 
 	return i
 }
@@ -83,6 +97,7 @@ func (i *networkImpl) Start() {
 	handlers := []network.TaggedMessageHandler{
 		{Tag: protocol.AgreementVoteTag, MessageHandler: network.HandlerFunc(i.processVoteMessage)},
 		{Tag: protocol.ProposalPayloadTag, MessageHandler: network.HandlerFunc(i.processProposalMessage)},
+		{Tag: protocol.ProposalChunkTag, MessageHandler: network.HandlerFunc(i.processProposalChunkMessage)},
 		{Tag: protocol.VoteBundleTag, MessageHandler: network.HandlerFunc(i.processBundleMessage)},
 	}
 	i.net.RegisterHandlers(handlers)
@@ -96,6 +111,7 @@ func messageMetadataFromHandle(h agreement.MessageHandle) *messageMetadata {
 }
 
 func (i *networkImpl) processVoteMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	i.bandwidth.received(protocol.AgreementVoteTag, len(raw.Data))
 	return i.processMessage(raw, i.voteCh, agreementVoteMessageType)
 }
 
@@ -103,19 +119,78 @@ func (i *networkImpl) processProposalMessage(raw network.IncomingMessage) networ
 	if i.trace != nil {
 		i.trace.HashTrace(messagetracer.Proposal, raw.Data)
 	}
+	i.bandwidth.received(protocol.ProposalPayloadTag, len(raw.Data))
 	return i.processMessage(raw, i.proposalCh, agreementProposalMessageType)
 }
 
+func (i *networkImpl) processProposalChunkMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	i.bandwidth.received(protocol.ProposalChunkTag, len(raw.Data))
+
+	chunk, err := agreement.DecodeProposalChunk(raw.Data)
+	if err != nil {
+		i.log.Infof("agreement: malformed proposal chunk from %v: %v", raw.Sender, err)
+		return network.OutgoingMessage{Action: network.Disconnect}
+	}
+	res, err := i.chunks.Add(chunk, raw.Sender)
+	if err != nil {
+		i.log.Debugf("agreement: could not reassemble proposal chunk from %v: %v", raw.Sender, err)
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+
+	if res.Payload != nil {
+		if i.trace != nil {
+			i.trace.HashTrace(messagetracer.Proposal, res.Payload)
+		}
+		i.submit(&messageMetadata{raw: raw, chunked: res.Forwarded}, res.Payload, i.proposalCh, agreementProposalMessageType)
+	}
+
+	if !res.Forward {
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+	// cut-through: forward the chunk of a payload whose proposal-vote was validated
+	// before the payload is reassembled and validated
+	proposalChunksForwarded.Inc(nil)
+	i.bandwidth.sent(protocol.ProposalChunkTag, len(raw.Data))
+	return network.OutgoingMessage{Action: network.Broadcast}
+}
+
+// SetProposalChunksRound implements agreement.ProposalChunkNetwork.
+func (i *networkImpl) SetProposalChunksRound(rnd basics.Round, enabled bool) {
+	i.chunks.SetRound(rnd, enabled)
+}
+
+// ExpectProposal implements agreement.ProposalChunkNetwork. The chunks of the
+// proposal's payload received before its proposal-vote was validated are
+// forwarded now.
+func (i *networkImpl) ExpectProposal(rnd basics.Round, proposal crypto.Digest) {
+	for _, held := range i.chunks.ExpectProposal(rnd, proposal) {
+		data := held.Chunk.Encode()
+		err := i.net.Relay(context.Background(), protocol.ProposalChunkTag, data, false, held.Sender)
+		if err != nil {
+			i.log.Infof("agreement: could not relay proposal chunk from %v: %v", held.Sender, err)
+			continue
+		}
+		proposalChunksForwarded.Inc(nil)
+		i.bandwidth.sent(protocol.ProposalChunkTag, len(data))
+	}
+}
+
 func (i *networkImpl) processBundleMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	i.bandwidth.received(protocol.VoteBundleTag, len(raw.Data))
 	return i.processMessage(raw, i.bundleCh, agreementBundleMessageType)
 }
 
 // i.e. process<Type>Message
 func (i *networkImpl) processMessage(raw network.IncomingMessage, submit chan<- agreement.Message, msgType string) network.OutgoingMessage {
-	metadata := &messageMetadata{raw: raw}
+	i.submit(&messageMetadata{raw: raw}, raw.Data, submit, msgType)
 
+	// Immediately ignore everything here, sometimes Relay/Broadcast/Disconnect later based on API handles saved from IncomingMessage
+	return network.OutgoingMessage{Action: network.Ignore}
+}
+
+func (i *networkImpl) submit(metadata *messageMetadata, data []byte, submit chan<- agreement.Message, msgType string) {
 	select {
-	case submit <- agreement.Message{MessageHandle: agreement.MessageHandle(metadata), Data: raw.Data}:
+	case submit <- agreement.Message{MessageHandle: agreement.MessageHandle(metadata), Data: data}:
 		// It would be slightly better to measure at de-queue
 		// time, but that happens in many places in code and
 		// this is much easier.
@@ -125,9 +200,6 @@ func (i *networkImpl) processMessage(raw network.IncomingMessage, submit chan<-
 		messagesDroppedTotal.Inc(nil)
 		messagesDroppedByType.Add(msgType, 1)
 	}
-
-	// Immediately ignore everything here, sometimes Relay/Broadcast/Disconnect later based on API handles saved from IncomingMessage
-	return network.OutgoingMessage{Action: network.Ignore}
 }
 
 func (i *networkImpl) Messages(t protocol.Tag) <-chan agreement.Message {
@@ -145,15 +217,27 @@ func (i *networkImpl) Messages(t protocol.Tag) <-chan agreement.Message {
 }
 
 func (i *networkImpl) Broadcast(t protocol.Tag, data []byte) (err error) {
+	if t == protocol.ProposalChunkTag {
+		i.markChunkSent(data)
+	}
 	err = i.net.Broadcast(context.Background(), t, data, false, nil)
 	if err != nil {
 		i.log.Infof("agreement: could not broadcast message with tag %v: %v", t, err)
+		return
 	}
+	i.bandwidth.sent(t, len(data))
 	return
 }
 
 func (i *networkImpl) Relay(h agreement.MessageHandle, t protocol.Tag, data []byte) (err error) {
 	metadata := messageMetadataFromHandle(h)
+	if t == protocol.ProposalChunkTag {
+		if metadata != nil && metadata.chunked {
+			// the chunks were forwarded as they arrived
+			return nil
+		}
+		i.markChunkSent(data)
+	}
 	if metadata == nil { // synthentic loopback
 		err = i.net.Broadcast(context.Background(), t, data, false, nil)
 		if err != nil {
@@ -165,9 +249,21 @@ func (i *networkImpl) Relay(h agreement.MessageHandle, t protocol.Tag, data []by
 			i.log.Infof("agreement: could not relay message from %v with tag %v: %v", metadata.raw.Sender, t, err)
 		}
 	}
+	if err == nil {
+		i.bandwidth.sent(t, len(data))
+	}
 	return
 }
 
+// markChunkSent records that this node sent the payload of a chunk, so that
+// the chunks of the payload are ignored if they are echoed back.
+func (i *networkImpl) markChunkSent(data []byte) {
+	chunk, err := agreement.DecodeProposalChunk(data)
+	if err == nil && chunk.Index == 0 {
+		i.chunks.MarkSent(chunk.Digest)
+	}
+}
+
 func (i *networkImpl) Disconnect(h agreement.MessageHandle) {
 	metadata := messageMetadataFromHandle(h)
 
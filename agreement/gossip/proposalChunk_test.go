@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gossip
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// relayRecorder records the messages relayed through it.
+type relayRecorder struct {
+	network.GossipNode
+	relayed []network.Peer
+}
+
+func (r *relayRecorder) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	r.relayed = append(r.relayed, except)
+	return nil
+}
+
+func makeChunkTestNetwork(t *testing.T) *networkImpl {
+	return &networkImpl{
+		proposalCh: make(chan agreement.Message, 1),
+		net:        &relayRecorder{},
+		log:        logging.TestingLog(t),
+		bandwidth:  makeBandwidthAccountant(),
+		chunks:     agreement.MakeProposalAssembler(),
+	}
+}
+
+func makeChunkTestPayload() (proposal crypto.Digest, payload []byte) {
+	payload = make([]byte, 600*1024)
+	for j := range payload {
+		payload[j] = byte(j)
+	}
+	crypto.RandBytes(proposal[:])
+	return proposal, payload
+}
+
+func TestProposalChunkCutThrough(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	i := makeChunkTestNetwork(t)
+	proposal, payload := makeChunkTestPayload()
+	chunks := agreement.EncodeProposalChunks(proposal, payload)
+	require.Len(t, chunks, 3)
+
+	i.SetProposalChunksRound(10, true)
+	i.ExpectProposal(10, proposal)
+
+	// every new chunk is forwarded as soon as it arrives
+	for _, c := range chunks[:2] {
+		out := i.processProposalChunkMessage(network.IncomingMessage{Tag: protocol.ProposalChunkTag, Data: c})
+		require.Equal(t, network.Broadcast, out.Action)
+	}
+	out := i.processProposalChunkMessage(network.IncomingMessage{Tag: protocol.ProposalChunkTag, Data: chunks[0]})
+	require.Equal(t, network.Ignore, out.Action)
+	require.Empty(t, i.proposalCh)
+
+	// the reassembled payload is delivered to agreement
+	out = i.processProposalChunkMessage(network.IncomingMessage{Tag: protocol.ProposalChunkTag, Data: chunks[2]})
+	require.Equal(t, network.Broadcast, out.Action)
+	require.Len(t, i.proposalCh, 1)
+	msg := <-i.proposalCh
+	require.Equal(t, payload, msg.Data)
+
+	// relaying the reassembled payload sends nothing, since its chunks were forwarded
+	for _, c := range chunks {
+		require.NoError(t, i.Relay(msg.MessageHandle, protocol.ProposalChunkTag, c))
+	}
+	require.Empty(t, i.net.(*relayRecorder).relayed)
+
+	out = i.processProposalChunkMessage(network.IncomingMessage{Tag: protocol.ProposalChunkTag, Data: []byte{1, 2, 3}})
+	require.Equal(t, network.Disconnect, out.Action)
+
+	cur, _ := i.bandwidth.usage()
+	require.EqualValues(t, 5, cur.Tags[protocol.ProposalChunkTag].ReceivedMessages)
+	require.EqualValues(t, 3, cur.Tags[protocol.ProposalChunkTag].SentMessages)
+}
+
+func TestProposalChunkNotForwardedUnlessValidated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	i := makeChunkTestNetwork(t)
+	proposal, payload := makeChunkTestPayload()
+	chunks := agreement.EncodeProposalChunks(proposal, payload)
+	sender := &struct{ id int }{1}
+
+	// chunks are dropped while chunked payloads are disabled
+	i.SetProposalChunksRound(10, false)
+	out := i.processProposalChunkMessage(network.IncomingMessage{Sender: sender, Tag: protocol.ProposalChunkTag, Data: chunks[0]})
+	require.Equal(t, network.Ignore, out.Action)
+	i.ExpectProposal(10, proposal)
+	require.Empty(t, i.net.(*relayRecorder).relayed)
+
+	// chunks of a proposal without a validated proposal-vote are held, not forwarded
+	i.SetProposalChunksRound(11, true)
+	for _, c := range chunks[:2] {
+		out = i.processProposalChunkMessage(network.IncomingMessage{Sender: sender, Tag: protocol.ProposalChunkTag, Data: c})
+		require.Equal(t, network.Ignore, out.Action)
+	}
+
+	// once the proposal-vote is validated, the held chunks are forwarded, except to their sender
+	i.ExpectProposal(11, proposal)
+	require.Equal(t, []network.Peer{sender, sender}, i.net.(*relayRecorder).relayed)
+	out = i.processProposalChunkMessage(network.IncomingMessage{Sender: sender, Tag: protocol.ProposalChunkTag, Data: chunks[2]})
+	require.Equal(t, network.Broadcast, out.Action)
+	msg := <-i.proposalCh
+	require.Equal(t, payload, msg.Data)
+}
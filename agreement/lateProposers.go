@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+)
+
+// lateProposerMaxTracked bounds the number of proposers tracked by a
+// lateProposerTracker. When it is exceeded, the proposer seen least recently
+// is forgotten.
+const lateProposerMaxTracked = 1000
+
+// A proposer is considered lagging once at least lateProposerMinLate of its
+// lowest credentials arrived after the filter timeout, making up at least
+// half of the rounds in which it held the lowest credential.
+const lateProposerMinLate = 3
+
+// A LateProposer summarizes how often the credential of a proposer which held
+// the lowest credential of a round arrived after the filter timeout.
+type LateProposer struct {
+	Address basics.Address
+
+	// Rounds is the number of rounds in which the proposer held the lowest
+	// credential, and LateRounds the number of those in which the credential
+	// arrived after the filter timeout.
+	Rounds     uint64
+	LateRounds uint64
+
+	// LastRound is the last round in which the proposer held the lowest
+	// credential, and LastArrival the time since the start of that round at
+	// which the credential arrived.
+	LastRound   basics.Round
+	LastArrival time.Duration
+	// MaxLateness is the largest amount by which the credential arrived
+	// after the filter timeout.
+	MaxLateness time.Duration
+}
+
+// Lagging reports whether the credentials of the proposer consistently
+// arrive after the filter timeout.
+func (p LateProposer) Lagging() bool {
+	return p.LateRounds >= lateProposerMinLate && 2*p.LateRounds >= p.Rounds
+}
+
+// lateProposerTracker accumulates the arrival times of the lowest credentials
+// collected for the credential arrival history.
+type lateProposerTracker struct {
+	mu        deadlock.Mutex
+	proposers map[basics.Address]*LateProposer
+}
+
+func makeLateProposerTracker() *lateProposerTracker {
+	return &lateProposerTracker{proposers: make(map[basics.Address]*LateProposer)}
+}
+
+// observe records that the lowest credential of rnd, held by sender, arrived
+// at the given time, and reports whether the proposer is now lagging after a
+// late arrival. A zero filterTimeout means no filter timeout was chosen and
+// the arrival cannot be judged.
+func (t *lateProposerTracker) observe(rnd round, sender basics.Address, arrival time.Duration, filterTimeout time.Duration) (LateProposer, bool) {
+	if filterTimeout == 0 {
+		return LateProposer{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.proposers[sender]
+	if !ok {
+		if len(t.proposers) >= lateProposerMaxTracked {
+			t.evictOldest()
+		}
+		p = &LateProposer{Address: sender}
+		t.proposers[sender] = p
+	}
+
+	p.Rounds++
+	p.LastRound = rnd
+	p.LastArrival = arrival
+	late := arrival > filterTimeout
+	if late {
+		p.LateRounds++
+		if arrival-filterTimeout > p.MaxLateness {
+			p.MaxLateness = arrival - filterTimeout
+		}
+	}
+	return *p, late && p.Lagging()
+}
+
+// evictOldest must be called with t.mu held.
+func (t *lateProposerTracker) evictOldest() {
+	var oldest *LateProposer
+	for _, p := range t.proposers {
+		if oldest == nil || p.LastRound < oldest.LastRound {
+			oldest = p
+		}
+	}
+	if oldest != nil {
+		delete(t.proposers, oldest.Address)
+	}
+}
+
+// snapshot returns the tracked proposers, most often late first.
+func (t *lateProposerTracker) snapshot() []LateProposer {
+	t.mu.Lock()
+	res := make([]LateProposer, 0, len(t.proposers))
+	for _, p := range t.proposers {
+		res = append(res, *p)
+	}
+	t.mu.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].LateRounds != res[j].LateRounds {
+			return res[i].LateRounds > res[j].LateRounds
+		}
+		if res[i].Rounds != res[j].Rounds {
+			return res[i].Rounds < res[j].Rounds
+		}
+		return res[i].LastRound > res[j].LastRound
+	})
+	return res
+}
+
+func (p LateProposer) telemetry(filterTimeout time.Duration) telemetryspec.LateCredentialEventDetails {
+	return telemetryspec.LateCredentialEventDetails{
+		Address:       p.Address.String(),
+		Round:         uint64(p.LastRound),
+		Arrival:       p.LastArrival,
+		FilterTimeout: filterTimeout,
+		Rounds:        p.Rounds,
+		LateRounds:    p.LateRounds,
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLateProposerTrackerLagging(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tracker := makeLateProposerTracker()
+	var addr basics.Address
+	addr[0] = 1
+	filter := 2 * time.Second
+
+	// an unknown filter timeout is ignored
+	_, lagging := tracker.observe(1, addr, 5*time.Second, 0)
+	require.False(t, lagging)
+	require.Empty(t, tracker.snapshot())
+
+	_, lagging = tracker.observe(1, addr, time.Second, filter)
+	require.False(t, lagging)
+	for rnd := round(2); rnd < 2+lateProposerMinLate-1; rnd++ {
+		_, lagging = tracker.observe(rnd, addr, 3*time.Second, filter)
+		require.False(t, lagging)
+	}
+	p, lagging := tracker.observe(10, addr, 4*time.Second, filter)
+	require.True(t, lagging)
+	require.Equal(t, uint64(lateProposerMinLate+1), p.Rounds)
+	require.Equal(t, uint64(lateProposerMinLate), p.LateRounds)
+	require.Equal(t, round(10), p.LastRound)
+	require.Equal(t, 4*time.Second, p.LastArrival)
+	require.Equal(t, 2*time.Second, p.MaxLateness)
+
+	// an on-time arrival does not report the proposer again
+	p, lagging = tracker.observe(11, addr, time.Second, filter)
+	require.False(t, lagging)
+	require.True(t, p.Lagging())
+
+	// enough on-time arrivals clear the lagging state
+	for rnd := round(12); rnd < 20; rnd++ {
+		p, _ = tracker.observe(rnd, addr, time.Second, filter)
+	}
+	require.False(t, p.Lagging())
+}
+
+func TestLateProposerTrackerSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tracker := makeLateProposerTracker()
+	filter := 2 * time.Second
+	addrs := make([]basics.Address, 3)
+	for i := range addrs {
+		addrs[i][0] = byte(i + 1)
+		for j := 0; j <= i; j++ {
+			tracker.observe(round(10*i+j), addrs[i], 3*time.Second, filter)
+		}
+	}
+
+	snapshot := tracker.snapshot()
+	require.Len(t, snapshot, len(addrs))
+	for i, p := range snapshot {
+		require.Equal(t, addrs[len(addrs)-1-i], p.Address)
+	}
+}
+
+func TestLateProposerTrackerEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tracker := makeLateProposerTracker()
+	for i := 0; i <= lateProposerMaxTracked; i++ {
+		var addr basics.Address
+		addr[0] = byte(i)
+		addr[1] = byte(i >> 8)
+		tracker.observe(round(i+1), addr, time.Second, 2*time.Second)
+	}
+
+	snapshot := tracker.snapshot()
+	require.Len(t, snapshot, lateProposerMaxTracked)
+	for _, p := range snapshot {
+		require.NotEqual(t, round(1), p.LastRound)
+	}
+}
@@ -80,6 +80,14 @@ func decodeVote(data []byte) (interface{}, error) {
 //
 // It returns an error on failure.
 func decodeBundle(data []byte) (interface{}, error) {
+	if len(data) > 0 && data[0] == compactBundlePrefix {
+		b, err := decodeCompactBundle(data)
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+
 	var b unauthenticatedBundle
 	err := protocol.Decode(data, &b)
 	if err != nil {
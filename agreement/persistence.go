@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
@@ -43,6 +44,38 @@ type diskState struct {
 	Actions     [][]byte     `codec:"Actions,allocbound=-"`
 }
 
+// A Snapshot holds the full state of the agreement state machine (the player
+// along with its proposal and vote state) as captured when a Service is shut
+// down.
+//
+// Passing a Snapshot to MakeService through Parameters lets the new Service
+// rejoin the captured round mid-period instead of starting over with a fresh
+// filter timeout. Across restarts, PersistSnapshot stores it as the recovery
+// record of the crash database.
+type Snapshot struct {
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// Data holds the encoded state machine, in the same format as the
+	// recovery record written to the crash database.
+	Data []byte
+}
+
+// credentialHistoryState is the form in which the player's history of lowest
+// credential arrivals is written to the crash database.
+type credentialHistoryState struct {
+	// Round is the round the player entered when the history was written.
+	Round basics.Round
+	// Samples holds the arrival times, oldest first.
+	Samples []time.Duration
+}
+
+// credentialHistoryMaxAge is the number of rounds after which a persisted
+// credential arrival history is considered too old to describe the network
+// and is not restored.
+const credentialHistoryMaxAge = basics.Round(1000)
+
 func persistent(as []action) bool {
 	for _, a := range as {
 		if a.persistent() {
@@ -294,6 +327,92 @@ func decode(raw []byte, t0 timers.Clock[TimeoutType], log serviceLogger, reflect
 	return
 }
 
+func installCredentialHistoryTable(tx *sql.Tx) error {
+	_, err := tx.Exec("create table if not exists CredentialHistory (data blob)")
+	return err
+}
+
+// persistCredentialHistory writes the credential arrival history of the
+// given round to the crash database, replacing any older history.
+func persistCredentialHistory(crash db.Accessor, rnd basics.Round, samples []time.Duration) error {
+	raw := protocol.EncodeReflect(credentialHistoryState{Round: rnd, Samples: samples})
+	return crash.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err := installCredentialHistoryTable(tx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("insert or replace into CredentialHistory (rowid, data) values (1, ?)", raw)
+		return err
+	})
+}
+
+// restoreCredentialHistory reads the credential arrival history from the
+// crash database.
+//
+// The history holds up to size samples. It is empty if none was persisted, or
+// if the persisted history is more than credentialHistoryMaxAge rounds older
+// than nextRound.
+func restoreCredentialHistory(log logging.Logger, crash db.Accessor, nextRound basics.Round, size int) credentialArrivalHistory {
+	history := makeCredentialArrivalHistory(size)
+
+	var raw []byte
+	err := crash.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err := installCredentialHistoryTable(tx)
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRow("select data from CredentialHistory").Scan(&raw)
+		if err == sql.ErrNoRows {
+			raw = nil
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		log.Warnf("restore (agreement): could not read credential arrival history: %v", err)
+		return history
+	}
+	if raw == nil {
+		return history
+	}
+
+	var s credentialHistoryState
+	err = protocol.DecodeReflect(raw, &s)
+	if err != nil {
+		log.Warnf("restore (agreement): could not decode credential arrival history: %v", err)
+		return history
+	}
+	if s.Round+credentialHistoryMaxAge < nextRound {
+		log.Infof("restore (agreement): discarding credential arrival history from round %d (next round is %d)", s.Round, nextRound)
+		return history
+	}
+
+	samples := s.Samples
+	if len(samples) > size {
+		samples = samples[len(samples)-size:]
+	}
+	for _, sample := range samples {
+		history.store(sample)
+	}
+	log.Infof("restore (agreement): restored %d credential arrival samples from round %d", len(samples), s.Round)
+	return history
+}
+
+// PersistSnapshot writes the Snapshot returned by Service.Shutdown to the
+// crash database as its recovery record, replacing the one written while the
+// Service ran, so that the next Service restores it on startup.
+func PersistSnapshot(crash db.Accessor, s *Snapshot) error {
+	return crash.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("insert or replace into Service (rowid, data) values (1, ?)", s.Data)
+		return err
+	})
+}
+
+type credentialHistoryRequest struct {
+	round   basics.Round
+	samples []time.Duration
+}
+
 type persistentRequest struct {
 	round  basics.Round
 	period period
@@ -311,6 +430,10 @@ type asyncPersistenceLoop struct {
 	wg      sync.WaitGroup // wait for goroutine to abort.
 	ctxExit context.CancelFunc
 	pending chan persistentRequest
+
+	// history holds the latest credential arrival history which has yet
+	// to be written.
+	history chan credentialHistoryRequest
 }
 
 func makeAsyncPersistenceLoop(log serviceLogger, crash db.Accessor, ledger LedgerReader) *asyncPersistenceLoop {
@@ -319,6 +442,7 @@ func makeAsyncPersistenceLoop(log serviceLogger, crash db.Accessor, ledger Ledge
 		crashDb: crash,
 		ledger:  ledger,
 		pending: make(chan persistentRequest, 1),
+		history: make(chan credentialHistoryRequest, 1),
 	}
 }
 
@@ -336,6 +460,23 @@ func (p *asyncPersistenceLoop) Enqueue(clock timers.Clock[TimeoutType], round ba
 	return eventsChannel
 }
 
+// EnqueueCredentialHistory schedules the credential arrival history of the
+// given round to be written to the crash database. It never blocks: if an
+// older history is still waiting to be written, it is replaced.
+func (p *asyncPersistenceLoop) EnqueueCredentialHistory(round basics.Round, samples []time.Duration) {
+	req := credentialHistoryRequest{round: round, samples: samples}
+	select {
+	case p.history <- req:
+	default:
+		select {
+		case <-p.history:
+		default:
+		}
+		// we are the only writer, so there is room for req now
+		p.history <- req
+	}
+}
+
 func (p *asyncPersistenceLoop) Start() {
 	p.wg.Add(1)
 	ctx, ctxExit := context.WithCancel(context.Background())
@@ -355,6 +496,12 @@ func (p *asyncPersistenceLoop) loop(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
+		case h := <-p.history:
+			err := persistCredentialHistory(p.crashDb, h.round, h.samples)
+			if err != nil {
+				p.log.Warnf("could not persist credential arrival history for round %d: %v", h.round, err)
+			}
+			continue
 		case s = <-p.pending:
 		}
 
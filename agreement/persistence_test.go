@@ -129,6 +129,62 @@ func TestAgreementPersistence(t *testing.T) {
 	require.Equalf(t, raw[:], raw2[:], "raw data was persisted incorrectly.")
 }
 
+func TestCredentialHistoryPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accessor, err := db.MakeAccessor(t.Name()+"_crash.db", false, true)
+	require.NoError(t, err)
+	defer accessor.Close()
+	log := logging.Base()
+
+	// nothing persisted yet
+	history := restoreCredentialHistory(log, accessor, 100, dynamicFilterCredentialArrivalHistory)
+	require.False(t, history.isFull())
+	require.Empty(t, history.samples())
+
+	var samples []time.Duration
+	for i := 0; i < dynamicFilterCredentialArrivalHistory+5; i++ {
+		samples = append(samples, time.Duration(i)*time.Millisecond)
+	}
+	require.NoError(t, persistCredentialHistory(accessor, 100, samples))
+
+	history = restoreCredentialHistory(log, accessor, 101, dynamicFilterCredentialArrivalHistory)
+	require.True(t, history.isFull())
+	require.Equal(t, samples[5:], history.samples())
+
+	// a newer history replaces the older one
+	require.NoError(t, persistCredentialHistory(accessor, 200, samples[:3]))
+	history = restoreCredentialHistory(log, accessor, 200, dynamicFilterCredentialArrivalHistory)
+	require.False(t, history.isFull())
+	require.Equal(t, samples[:3], history.samples())
+
+	// a stale history is not restored
+	history = restoreCredentialHistory(log, accessor, 200+credentialHistoryMaxAge+1, dynamicFilterCredentialArrivalHistory)
+	require.Empty(t, history.samples())
+}
+
+func TestSnapshotPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accessor, err := db.MakeAccessor(t.Name()+"_crash.db", false, true)
+	require.NoError(t, err)
+	defer accessor.Close()
+
+	accessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return agreeInstallDatabase(tx)
+	}) // ignore error
+
+	persist(serviceLogger{Logger: logging.Base()}, accessor, 10, 1, 2, []byte{1, 2, 3})
+	require.NoError(t, PersistSnapshot(accessor, &Snapshot{Round: 11, Period: 0, Step: 1, Data: []byte{4, 5, 6}}))
+
+	// the snapshot replaces the recovery record, and is restored like it
+	for i := 0; i < 2; i++ {
+		raw, err := restore(serviceLogger{Logger: logging.Base()}, accessor)
+		require.NoError(t, err)
+		require.Equal(t, []byte{4, 5, 6}, raw)
+	}
+}
+
 func BenchmarkAgreementPersistence(b *testing.B) {
 
 	// temporary skip now until we implement more meaningfull test.
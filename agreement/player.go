@@ -63,10 +63,18 @@ type player struct {
 	// ronuds, used for calculating the filter timeout dynamically.
 	lowestCredentialArrivals credentialArrivalHistory
 
+	// dynamicFilter holds the parameters of the dynamic filter timeout, or
+	// nil if the defaults are used.
+	dynamicFilter *dynamicFilterConfig
+
 	// The period 0 dynamic filter timeout calculated for this round, if set,
 	// even if dynamic filter timeouts are not enabled. It is used for reporting
 	// to telemetry.
 	dynamicFilterTimeout time.Duration
+
+	// The period 0 filter timeout chosen for this round, for reporting to
+	// telemetry.
+	filterTimeout time.Duration
 }
 
 func (p *player) T() stateMachineTag {
@@ -100,13 +108,15 @@ func (p *player) handle(r routerHandle, e event) []action {
 			r.t.logTimeout(*p)
 		}
 
-		var deadlineTimeout time.Duration
+		var deadlineTimeout, extraTimeout time.Duration
 		if e.Proto.Version == "" || e.Proto.Err != nil {
 			r.t.log.Errorf("failed to read valid protocol version for timeout event (proto %v): %v. "+
 				"Falling Back to default deadline timeout.", e.Proto.Version, e.Proto.Err)
 			deadlineTimeout = DefaultDeadlineTimeout()
+			extraTimeout = defaultRecoveryExtraTimeout
 		} else {
 			deadlineTimeout = DeadlineTimeout(p.Period, e.Proto.Version)
+			extraTimeout = recoveryExtraTimeout(config.Consensus[e.Proto.Version])
 		}
 
 		switch p.Step {
@@ -122,16 +132,16 @@ func (p *player) handle(r routerHandle, e event) []action {
 			p.Step = next
 			// update tracer state to match player
 			r.t.setMetadata(tracerMetadata{p.Round, p.Period, p.Step})
-			return p.issueNextVote(r, deadlineTimeout)
+			return p.issueNextVote(r, deadlineTimeout, extraTimeout)
 		default:
 			if p.Napping {
-				return p.issueNextVote(r, deadlineTimeout) // sets p.Napping to false
+				return p.issueNextVote(r, deadlineTimeout, extraTimeout) // sets p.Napping to false
 			}
 			// not napping, so we should enter a new step
 			p.Step++ // note: this must happen before next timeout setting.
 			// TODO add unit test to ensure that deadlines increase monotonically here
 
-			lower, upper := p.Step.nextVoteRanges(deadlineTimeout)
+			lower, upper := p.Step.nextVoteRanges(deadlineTimeout, extraTimeout)
 			delta := time.Duration(e.RandomEntropy % uint64(upper-lower))
 
 			p.Napping = true
@@ -153,13 +163,13 @@ func (p *player) handleFastTimeout(r routerHandle, e timeoutEvent) []action {
 		return nil
 	}
 
-	lambda := config.Consensus[e.Proto.Version].FastRecoveryLambda
+	lambda, firstDelay := fastRecoveryTimeouts(config.Consensus[e.Proto.Version])
 	k := (p.FastRecoveryDeadline + lambda - 1) / lambda // round up
 	lower, upper := k*lambda, (k+1)*lambda
 	delta := time.Duration(e.RandomEntropy % uint64(upper-lower))
 	if p.FastRecoveryDeadline == 0 {
 		// don't vote the first time
-		p.FastRecoveryDeadline = lower + delta + lambda // add lambda for extra delay the first time
+		p.FastRecoveryDeadline = lower + delta + firstDelay // add extra delay the first time
 		return nil
 	}
 	p.FastRecoveryDeadline = lower + delta
@@ -211,7 +221,7 @@ func (p *player) issueCertVote(r routerHandle, e committableEvent) action {
 	return pseudonodeAction{T: attest, Round: p.Round, Period: p.Period, Step: cert, Proposal: e.Proposal}
 }
 
-func (p *player) issueNextVote(r routerHandle, deadlineTimeout time.Duration) []action {
+func (p *player) issueNextVote(r routerHandle, deadlineTimeout, extraTimeout time.Duration) []action {
 	actions := p.partitionPolicy(r)
 
 	a := pseudonodeAction{T: attest, Round: p.Round, Period: p.Period, Step: p.Step, Proposal: bottom}
@@ -235,7 +245,7 @@ func (p *player) issueNextVote(r routerHandle, deadlineTimeout time.Duration) []
 
 	r.t.timeR().RecStep(p.Period, p.Step, a.Proposal)
 
-	_, upper := p.Step.nextVoteRanges(deadlineTimeout)
+	_, upper := p.Step.nextVoteRanges(deadlineTimeout, extraTimeout)
 	p.Napping = false
 	p.Deadline = Deadline{Duration: upper, Type: TimeoutDeadline}
 	return actions
@@ -287,37 +297,45 @@ func (p *player) handleCheckpointEvent(r routerHandle, e checkpointEvent) []acti
 // updateCredentialArrivalHistory is called at the end of a successful
 // uninterrupted round (just after ensureAction is generated) to collect
 // credential arrival times to dynamically set the filter timeout.
-// It returns the time of the lowest credential's arrival from
-// credentialRoundLag rounds ago, if one was collected and added to
-// lowestCredentialArrivals, or zero otherwise.
-func (p *player) updateCredentialArrivalHistory(r routerHandle, ver protocol.ConsensusVersion) time.Duration {
+// It returns the lowest credential from credentialRoundLag rounds ago, if
+// one was collected and added to lowestCredentialArrivals, or false otherwise.
+func (p *player) updateCredentialArrivalHistory(r routerHandle, ver protocol.ConsensusVersion) (vote, bool) {
 	if p.Period != 0 {
 		// only append to lowestCredentialArrivals if this was a successful round completing in period 0.
-		return 0
+		return vote{}, false
 	}
 
-	if p.Round <= credentialRoundLag {
+	lag := p.dynamicFilter.credentialRoundLag()
+	if p.Round <= lag {
 		// not sufficiently many rounds had passed to collect any measurement
-		return 0
+		return vote{}, false
 	}
 
 	// look up the validatedAt time of the winning proposal-vote from credentialRoundLag ago,
 	// by now we should have seen the lowest credential for that round.
-	credHistoryRound := p.Round - credentialRoundLag
+	credHistoryRound := p.Round - lag
 	re := readLowestEvent{T: readLowestVote, Round: credHistoryRound, Period: 0}
 	re = r.dispatch(*p, re, proposalMachineRound, credHistoryRound, 0, 0).(readLowestEvent)
 	if !re.HasLowestIncludingLate {
-		return 0
+		return vote{}, false
 	}
 
 	p.lowestCredentialArrivals.store(re.LowestIncludingLate.validatedAt)
-	return re.LowestIncludingLate.validatedAt
+	return re.LowestIncludingLate, true
 }
 
 // calculateFilterTimeout chooses the appropriate filter timeout.
 func (p *player) calculateFilterTimeout(ver protocol.ConsensusVersion, tracer *tracer) time.Duration {
+	timeout := p.chooseFilterTimeout(ver, tracer)
+	if p.Period == 0 {
+		p.filterTimeout = timeout
+	}
+	return timeout
+}
+
+func (p *player) chooseFilterTimeout(ver protocol.ConsensusVersion, tracer *tracer) time.Duration {
 	proto := config.Consensus[ver]
-	if dynamicFilterCredentialArrivalHistory <= 0 || p.Period != 0 {
+	if p.dynamicFilter.credentialHistorySize() <= 0 || p.Period != 0 {
 		// Either dynamic filter timeout is disabled, or we're not in period 0
 		// and therefore, can't use dynamic timeout
 		return FilterTimeout(p.Period, ver)
@@ -328,7 +346,7 @@ func (p *player) calculateFilterTimeout(ver protocol.ConsensusVersion, tracer *t
 		return defaultTimeout
 	}
 
-	dynamicTimeout := p.lowestCredentialArrivals.orderStatistics(dynamicFilterTimeoutCredentialArrivalHistoryIdx) + dynamicFilterTimeoutGraceInterval
+	dynamicTimeout := p.lowestCredentialArrivals.orderStatistics(p.dynamicFilter.credentialHistoryIdx()) + p.dynamicFilter.filterTimeoutMargin()
 
 	// Make sure the dynamic filter timeout is not too small nor too large
 	clampedTimeout := dynamicTimeout
@@ -354,6 +372,7 @@ func (p *player) calculateFilterTimeout(ver protocol.ConsensusVersion, tracer *t
 
 func (p *player) handleThresholdEvent(r routerHandle, e thresholdEvent) []action {
 	r.t.timeR().RecThreshold(e)
+	r.t.logThreshold(e)
 
 	var actions []action
 	switch e.t() {
@@ -366,8 +385,9 @@ func (p *player) handleThresholdEvent(r routerHandle, e thresholdEvent) []action
 		if res.Committable {
 			cert := Certificate(e.Bundle)
 			a0 := ensureAction{Payload: res.Payload, Certificate: cert}
-			a0.voteValidatedAt = p.updateCredentialArrivalHistory(r, e.Proto)
+			a0.setLowestCredential(p.updateCredentialArrivalHistory(r, e.Proto))
 			a0.dynamicFilterTimeout = p.dynamicFilterTimeout
+			a0.filterTimeout = p.filterTimeout
 			actions = append(actions, a0)
 			as := p.enterRound(r, e, p.Round+1)
 			return append(actions, as...)
@@ -657,6 +677,7 @@ func (p *player) handleMessageEvent(r routerHandle, e messageEvent) (actions []a
 		v := e.Input.Vote
 		a := relayAction(e, protocol.AgreementVoteTag, v.u())
 		ep := ef.(proposalAcceptedEvent)
+		r.t.logProposalObserved(ep)
 		if ep.PayloadOk {
 			transmit := compoundMessage{
 				Proposal: ep.Payload.u(),
@@ -716,8 +737,9 @@ func (p *player) handleMessageEvent(r routerHandle, e messageEvent) (actions []a
 			if freshestRes.Ok && freshestRes.Event.t() == certThreshold && freshestRes.Event.Proposal == e.Input.Proposal.value() {
 				cert := Certificate(freshestRes.Event.Bundle)
 				a0 := ensureAction{Payload: e.Input.Proposal, Certificate: cert}
-				a0.voteValidatedAt = p.updateCredentialArrivalHistory(r, e.Proto.Version)
+				a0.setLowestCredential(p.updateCredentialArrivalHistory(r, e.Proto.Version))
 				a0.dynamicFilterTimeout = p.dynamicFilterTimeout
+				a0.filterTimeout = p.filterTimeout
 				actions = append(actions, a0)
 				as := p.enterRound(r, delegatedE, cert.Round+1)
 				return append(actions, as...)
@@ -4011,3 +4011,32 @@ func assertPayloadTimings(t *testing.T, pWhite *player, pM ioAutomata, r round,
 }
 
 // todo: test pipelined rounds, and round interruption
+
+func TestPlayerRecoveryTimeoutsFromConsensus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// a copy of the current parameters, leaving config.Consensus untouched
+	params := config.Consensus[protocol.ConsensusCurrentVersion]
+	params.FastRecoveryLambda = 10 * time.Second
+	params.FastRecoveryFirstDelay = 0
+	params.AgreementRecoveryExtraTimeout = 500 * time.Millisecond
+
+	lambda, firstDelay := fastRecoveryTimeouts(params)
+	require.Equal(t, 10*time.Second, lambda)
+	require.Equal(t, lambda, firstDelay)
+
+	extra := recoveryExtraTimeout(params)
+	require.Equal(t, 500*time.Millisecond, extra)
+	deadline := DeadlineTimeout(0, protocol.ConsensusCurrentVersion)
+	lower, upper := (next + 1).nextVoteRanges(deadline, extra)
+	require.Equal(t, deadline+extra, lower)
+	require.Equal(t, lower+2*extra, upper)
+
+	// versions which predate the parameter fall back to the defaults
+	params.AgreementRecoveryExtraTimeout = 0
+	require.Equal(t, defaultRecoveryExtraTimeout, recoveryExtraTimeout(params))
+
+	lambda, firstDelay = fastRecoveryTimeouts(config.Consensus[protocol.ConsensusCurrentVersion])
+	require.Equal(t, config.Consensus[protocol.ConsensusCurrentVersion].FastRecoveryLambda, lambda)
+	require.Equal(t, lambda, firstDelay)
+}
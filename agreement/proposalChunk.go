@@ -0,0 +1,477 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Large proposal payloads may be split into chunks which are sent with the
+// ProposalChunkTag, so that relays can forward each chunk as soon as it
+// arrives instead of waiting for the full payload to be received and
+// validated. The layout of a chunk is:
+//
+//	version, payload digest, proposal digest
+//	uvarint index, uvarint total
+//	chunk data
+//
+// where the payload digest is the hash of the encoded transmittedPayload and
+// the proposal digest is the EncodingDigest of the proposal-value the payload
+// carries. Chunks are only forwarded once a proposal-vote for that proposal
+// value was validated in the current round.
+const proposalChunkVersion = 1
+
+// proposalChunkHeaderSize is the size of the fixed part of a chunk header.
+const proposalChunkHeaderSize = 1 + 2*crypto.DigestSize
+
+// proposalChunkSize is the size of the data carried by all but the last chunk
+// of a payload.
+const proposalChunkSize = 256 * 1024
+
+// maxProposalChunks is the largest number of chunks a payload may be split
+// into.
+const maxProposalChunks = (protocol.ProposalPayloadTagMaxSize + proposalChunkSize - 1) / proposalChunkSize
+
+// maxPendingChunkedPayloads bounds the number of partially received payloads
+// of validated proposals held by a ProposalAssembler. Once it is reached, the
+// chunks of new payloads are dropped; pending payloads are never evicted.
+const maxPendingChunkedPayloads = 64
+
+// maxHeldChunkedPayloads bounds the number of partially received payloads
+// whose proposal-vote has not been validated yet.
+const maxHeldChunkedPayloads = 16
+
+// maxHeldChunkedPayloadsPerSender bounds the number of payloads which are not
+// validated yet held for a single sender, so that a sender cannot push out
+// the payloads received from the others.
+const maxHeldChunkedPayloadsPerSender = 2
+
+// maxExpectedProposals bounds the number of validated proposals of the
+// current round whose payloads a ProposalAssembler accepts.
+const maxExpectedProposals = 64
+
+var (
+	errProposalChunkTruncated = errors.New("proposal chunk: truncated")
+	errProposalChunkVersion   = errors.New("proposal chunk: unknown version")
+	errProposalChunkDigest    = errors.New("proposal chunk: payload does not match digest")
+	errProposalChunksDisabled = errors.New("proposal chunk: chunked proposal payloads are not enabled")
+	errProposalChunkDropped   = errors.New("proposal chunk: too many pending payloads")
+)
+
+// A ProposalChunk is a piece of an encoded proposal payload.
+type ProposalChunk struct {
+	// Digest is the hash of the full encoded payload.
+	Digest crypto.Digest
+	// Proposal is the EncodingDigest of the proposal-value of the payload.
+	Proposal crypto.Digest
+	// Index is the position of this chunk in the payload and Total is the
+	// number of chunks the payload was split into.
+	Index uint64
+	Total uint64
+	Data  []byte
+}
+
+// ProposalChunkMaxSize returns the maximum size of an encoded ProposalChunk.
+func ProposalChunkMaxSize() int {
+	return proposalChunkHeaderSize + 2*binary.MaxVarintLen64 + proposalChunkSize
+}
+
+// chunkedPayloads reports whether proposal payloads of the given round are
+// sent in chunks.
+func chunkedPayloads(l LedgerReader, rnd round) bool {
+	cv, err := l.ConsensusVersion(ParamsRound(rnd))
+	return err == nil && config.Consensus[cv].ChunkedProposalPayloads
+}
+
+// EncodeProposalChunks splits an encoded proposal payload, carrying the
+// proposal-value with the given EncodingDigest, into encoded chunks.
+func EncodeProposalChunks(proposal crypto.Digest, data []byte) [][]byte {
+	digest := crypto.Hash(data)
+	total := (len(data) + proposalChunkSize - 1) / proposalChunkSize
+	chunks := make([][]byte, 0, total)
+	for i := 0; i < total; i++ {
+		end := (i + 1) * proposalChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		c := ProposalChunk{Digest: digest, Proposal: proposal, Index: uint64(i), Total: uint64(total), Data: data[i*proposalChunkSize : end]}
+		chunks = append(chunks, c.Encode())
+	}
+	return chunks
+}
+
+// Encode encodes a chunk to be sent with the ProposalChunkTag.
+func (c ProposalChunk) Encode() []byte {
+	enc := make([]byte, 0, proposalChunkHeaderSize+2*binary.MaxVarintLen64+len(c.Data))
+	enc = append(enc, proposalChunkVersion)
+	enc = append(enc, c.Digest[:]...)
+	enc = append(enc, c.Proposal[:]...)
+	enc = binary.AppendUvarint(enc, c.Index)
+	enc = binary.AppendUvarint(enc, c.Total)
+	return append(enc, c.Data...)
+}
+
+// DecodeProposalChunk decodes a message sent with the ProposalChunkTag.
+func DecodeProposalChunk(data []byte) (c ProposalChunk, err error) {
+	if len(data) < proposalChunkHeaderSize {
+		return c, errProposalChunkTruncated
+	}
+	if data[0] != proposalChunkVersion {
+		return c, errProposalChunkVersion
+	}
+	copy(c.Digest[:], data[1:1+crypto.DigestSize])
+	copy(c.Proposal[:], data[1+crypto.DigestSize:proposalChunkHeaderSize])
+	data = data[proposalChunkHeaderSize:]
+
+	var n int
+	c.Index, n = binary.Uvarint(data)
+	if n <= 0 {
+		return c, errProposalChunkTruncated
+	}
+	data = data[n:]
+	c.Total, n = binary.Uvarint(data)
+	if n <= 0 {
+		return c, errProposalChunkTruncated
+	}
+	c.Data = data[n:]
+
+	if c.Total == 0 || c.Total > maxProposalChunks {
+		return c, fmt.Errorf("proposal chunk: bad chunk count %d", c.Total)
+	}
+	if c.Index >= c.Total {
+		return c, fmt.Errorf("proposal chunk: index %d out of range (%d chunks)", c.Index, c.Total)
+	}
+	if len(c.Data) > proposalChunkSize || (c.Index < c.Total-1 && len(c.Data) != proposalChunkSize) {
+		return c, fmt.Errorf("proposal chunk: bad chunk size %d", len(c.Data))
+	}
+	return c, nil
+}
+
+type pendingChunkedPayload struct {
+	proposal crypto.Digest
+	sender   any
+	// validated is set once a proposal-vote for the proposal was validated,
+	// from which point on the chunks of the payload are forwarded; round is
+	// then the round of the proposal.
+	validated bool
+	round     basics.Round
+
+	total    uint64
+	received uint64
+	chunks   [][]byte
+}
+
+// ProposalChunkResult is the outcome of adding a chunk to a ProposalAssembler.
+type ProposalChunkResult struct {
+	// Forward is set if the chunk is new and should be forwarded to other peers.
+	Forward bool
+	// Payload is the encoded payload, once all of its chunks have been received.
+	Payload []byte
+	// Forwarded is set along with Payload if all the chunks of the payload were
+	// forwarded as they arrived, so that the payload need not be relayed again.
+	Forwarded bool
+}
+
+// A HeldProposalChunk is a chunk received before the proposal-vote of its
+// payload was validated, which may be forwarded once it is.
+type HeldProposalChunk struct {
+	Sender any
+	Chunk  ProposalChunk
+}
+
+// A ProposalAssembler reassembles proposal payloads from their chunks.
+//
+// Only the chunks of the proposals whose proposal-votes were validated in the
+// current round are forwarded. The chunks of other payloads are held, without
+// being forwarded, in a small number of slots of their sender, since they may
+// arrive before the proposal-vote is validated. The payloads of validated
+// proposals are never evicted by the chunks of other payloads. The assembler
+// also remembers the digests of the payloads it recently completed.
+type ProposalAssembler struct {
+	mu deadlock.Mutex
+
+	// enabled is set when the proposal payloads of the current round are sent
+	// in chunks; chunks are dropped otherwise.
+	enabled bool
+	round   basics.Round
+	// expected maps the EncodingDigests of the proposals whose proposal-votes
+	// were validated to their round.
+	expected map[crypto.Digest]basics.Round
+
+	pending map[crypto.Digest]*pendingChunkedPayload
+	// held lists the digests of the payloads of each sender which are not
+	// validated yet, from the oldest to the newest.
+	held map[any][]crypto.Digest
+
+	done      map[crypto.Digest]bool
+	doneOrder []crypto.Digest
+}
+
+// MakeProposalAssembler creates an empty ProposalAssembler.
+func MakeProposalAssembler() *ProposalAssembler {
+	return &ProposalAssembler{
+		expected: make(map[crypto.Digest]basics.Round),
+		pending:  make(map[crypto.Digest]*pendingChunkedPayload),
+		held:     make(map[any][]crypto.Digest),
+		done:     make(map[crypto.Digest]bool),
+	}
+}
+
+// SetRound informs the assembler of the current round, and of whether the
+// proposal payloads of that round are sent in chunks. The validated proposals
+// of earlier rounds, and their pending payloads, are forgotten.
+func (a *ProposalAssembler) SetRound(rnd basics.Round, enabled bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.enabled = enabled
+	if !enabled {
+		a.round = rnd
+		clear(a.expected)
+		clear(a.pending)
+		clear(a.held)
+		return
+	}
+	if rnd == a.round {
+		return
+	}
+	a.round = rnd
+	for d, r := range a.expected {
+		if r < rnd {
+			delete(a.expected, d)
+		}
+	}
+	for d, p := range a.pending {
+		if p.validated && p.round < rnd {
+			a.forget(d)
+		}
+	}
+}
+
+// ExpectProposal records that a proposal-vote for the proposal with the given
+// EncodingDigest was validated in round rnd. The chunks of its payload which
+// were held until then are returned, to be forwarded.
+func (a *ProposalAssembler) ExpectProposal(rnd basics.Round, proposal crypto.Digest) (held []HeldProposalChunk) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.enabled || rnd < a.round {
+		return nil
+	}
+	if _, ok := a.expected[proposal]; ok || len(a.expected) >= maxExpectedProposals {
+		return nil
+	}
+	a.expected[proposal] = rnd
+
+	for d, p := range a.pending {
+		if p.validated || p.proposal != proposal {
+			continue
+		}
+		if a.validatedPending() >= maxPendingChunkedPayloads {
+			a.forget(d)
+			continue
+		}
+		a.unhold(p.sender, d)
+		p.validated = true
+		p.round = rnd
+		for i, data := range p.chunks {
+			if data != nil {
+				held = append(held, HeldProposalChunk{
+					Sender: p.sender,
+					Chunk:  ProposalChunk{Digest: d, Proposal: proposal, Index: uint64(i), Total: p.total, Data: data},
+				})
+			}
+		}
+	}
+	return held
+}
+
+// Add records a chunk received from sender.
+func (a *ProposalAssembler) Add(c ProposalChunk, sender any) (res ProposalChunkResult, err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.enabled {
+		return res, errProposalChunksDisabled
+	}
+	if a.done[c.Digest] {
+		return res, nil
+	}
+
+	p, ok := a.pending[c.Digest]
+	if !ok {
+		rnd, validated := a.expected[c.Proposal]
+		if validated {
+			if a.validatedPending() >= maxPendingChunkedPayloads {
+				return res, errProposalChunkDropped
+			}
+		} else {
+			// evict the oldest payload held for this sender only, so that a
+			// sender cannot push out the payloads received from the others
+			if senderHeld := a.held[sender]; len(senderHeld) >= maxHeldChunkedPayloadsPerSender {
+				a.forget(senderHeld[0])
+			}
+			if a.heldPending() >= maxHeldChunkedPayloads {
+				return res, errProposalChunkDropped
+			}
+			a.held[sender] = append(a.held[sender], c.Digest)
+		}
+		p = &pendingChunkedPayload{
+			proposal:  c.Proposal,
+			sender:    sender,
+			validated: validated,
+			round:     rnd,
+			total:     c.Total,
+			chunks:    make([][]byte, c.Total),
+		}
+		a.pending[c.Digest] = p
+	}
+	if c.Proposal != p.proposal {
+		return res, fmt.Errorf("proposal chunk: proposal %v does not match %v", c.Proposal, p.proposal)
+	}
+	if c.Total != p.total {
+		return res, fmt.Errorf("proposal chunk: chunk count %d does not match %d", c.Total, p.total)
+	}
+	if p.chunks[c.Index] != nil {
+		return res, nil
+	}
+	p.chunks[c.Index] = append([]byte(nil), c.Data...)
+	p.received++
+	res.Forward = p.validated
+	if p.received < p.total {
+		return res, nil
+	}
+
+	a.forget(c.Digest)
+	var payload []byte
+	for _, part := range p.chunks {
+		payload = append(payload, part...)
+	}
+	if crypto.Hash(payload) != c.Digest {
+		return res, errProposalChunkDigest
+	}
+	a.markDone(c.Digest)
+	res.Payload = payload
+	res.Forwarded = p.validated
+	return res, nil
+}
+
+// validatedPending must be called with a.mu held.
+func (a *ProposalAssembler) validatedPending() (n int) {
+	for _, p := range a.pending {
+		if p.validated {
+			n++
+		}
+	}
+	return n
+}
+
+// heldPending must be called with a.mu held.
+func (a *ProposalAssembler) heldPending() int {
+	return len(a.pending) - a.validatedPending()
+}
+
+// unhold must be called with a.mu held.
+func (a *ProposalAssembler) unhold(sender any, d crypto.Digest) {
+	senderHeld := a.held[sender]
+	for i := range senderHeld {
+		if senderHeld[i] == d {
+			senderHeld = append(senderHeld[:i], senderHeld[i+1:]...)
+			break
+		}
+	}
+	if len(senderHeld) == 0 {
+		delete(a.held, sender)
+	} else {
+		a.held[sender] = senderHeld
+	}
+}
+
+// forget must be called with a.mu held.
+func (a *ProposalAssembler) forget(d crypto.Digest) {
+	p, ok := a.pending[d]
+	if !ok {
+		return
+	}
+	delete(a.pending, d)
+	if !p.validated {
+		a.unhold(p.sender, d)
+	}
+}
+
+// markDone must be called with a.mu held.
+func (a *ProposalAssembler) markDone(d crypto.Digest) {
+	if len(a.doneOrder) >= maxPendingChunkedPayloads {
+		delete(a.done, a.doneOrder[0])
+		a.doneOrder = a.doneOrder[1:]
+	}
+	a.done[d] = true
+	a.doneOrder = append(a.doneOrder, d)
+}
+
+// MarkSent records that the payload with the given digest was sent by this
+// node, so that its chunks are not reassembled or forwarded if they are
+// echoed back.
+func (a *ProposalAssembler) MarkSent(d crypto.Digest) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.done[d] {
+		return
+	}
+	a.forget(d)
+	a.markDone(d)
+}
+
+// ProposalChunkNetwork is implemented by the Networks which forward proposal
+// payloads sent in chunks as they arrive.
+type ProposalChunkNetwork interface {
+	// SetProposalChunksRound informs the Network of the current round, and of
+	// whether its proposal payloads are sent in chunks.
+	SetProposalChunksRound(rnd basics.Round, enabled bool)
+
+	// ExpectProposal informs the Network that a proposal-vote for the
+	// proposal with the given EncodingDigest was validated in round rnd, so
+	// that the chunks of its payload may be forwarded.
+	ExpectProposal(rnd basics.Round, proposal crypto.Digest)
+}
+
+// setProposalChunksRound informs the Network of the current round.
+func (s *Service) setProposalChunksRound(rnd round) {
+	if n, ok := s.Network.(ProposalChunkNetwork); ok {
+		n.SetProposalChunksRound(rnd, chunkedPayloads(s.Ledger, rnd))
+	}
+}
+
+// expectProposal informs the Network of a validated proposal-vote.
+func (s *Service) expectProposal(v vote) {
+	if v.R.Step != propose {
+		return
+	}
+	if n, ok := s.Network.(ProposalChunkNetwork); ok {
+		n.ExpectProposal(v.R.Round, v.R.Proposal.EncodingDigest)
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeChunkedPayload(t *testing.T, size int) ([]byte, []ProposalChunk) {
+	data := make([]byte, size)
+	crypto.RandBytes(data)
+	var proposal crypto.Digest
+	crypto.RandBytes(proposal[:])
+
+	var chunks []ProposalChunk
+	for _, enc := range EncodeProposalChunks(proposal, data) {
+		require.LessOrEqual(t, len(enc), ProposalChunkMaxSize())
+		c, err := DecodeProposalChunk(enc)
+		require.NoError(t, err)
+		chunks = append(chunks, c)
+	}
+	return data, chunks
+}
+
+// makeExpectingAssembler returns an assembler for round 1 which expects the
+// proposals of the given chunks.
+func makeExpectingAssembler(chunks ...ProposalChunk) *ProposalAssembler {
+	a := MakeProposalAssembler()
+	a.SetRound(1, true)
+	for _, c := range chunks {
+		a.ExpectProposal(1, c.Proposal)
+	}
+	return a
+}
+
+func TestProposalChunkEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	data, chunks := makeChunkedPayload(t, 2*proposalChunkSize+100)
+	require.Len(t, chunks, 3)
+	for i, c := range chunks {
+		require.Equal(t, crypto.Hash(data), c.Digest)
+		require.Equal(t, chunks[0].Proposal, c.Proposal)
+		require.EqualValues(t, i, c.Index)
+		require.EqualValues(t, 3, c.Total)
+	}
+	require.Len(t, chunks[2].Data, 100)
+
+	enc := EncodeProposalChunks(chunks[0].Proposal, data)
+	require.Equal(t, enc[1], chunks[1].Encode())
+	_, err := DecodeProposalChunk(enc[0][:10])
+	require.ErrorIs(t, err, errProposalChunkTruncated)
+
+	bad := bytes.Clone(enc[0])
+	bad[0] = 0
+	_, err = DecodeProposalChunk(bad)
+	require.ErrorIs(t, err, errProposalChunkVersion)
+
+	// a short chunk which is not the last one
+	_, err = DecodeProposalChunk(enc[0][:len(enc[0])-1])
+	require.Error(t, err)
+
+	require.Len(t, EncodeProposalChunks(crypto.Digest{}, make([]byte, proposalChunkSize)), 1)
+}
+
+func TestProposalAssembler(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	data, chunks := makeChunkedPayload(t, 3*proposalChunkSize+1)
+	a := makeExpectingAssembler(chunks[0])
+
+	// chunks may arrive in any order, and duplicates are not forwarded
+	for _, i := range []int{2, 0, 3} {
+		res, err := a.Add(chunks[i], "peer")
+		require.NoError(t, err)
+		require.True(t, res.Forward)
+		require.Nil(t, res.Payload)
+	}
+	res, err := a.Add(chunks[0], "peer")
+	require.NoError(t, err)
+	require.False(t, res.Forward)
+	require.Nil(t, res.Payload)
+
+	res, err = a.Add(chunks[1], "other")
+	require.NoError(t, err)
+	require.True(t, res.Forward)
+	require.True(t, res.Forwarded)
+	require.Equal(t, data, res.Payload)
+
+	// chunks of a completed payload are ignored
+	res, err = a.Add(chunks[1], "peer")
+	require.NoError(t, err)
+	require.False(t, res.Forward)
+	require.Nil(t, res.Payload)
+}
+
+func TestProposalAssemblerDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, chunks := makeChunkedPayload(t, proposalChunkSize+1)
+	a := MakeProposalAssembler()
+	_, err := a.Add(chunks[0], "peer")
+	require.ErrorIs(t, err, errProposalChunksDisabled)
+
+	a.SetRound(1, false)
+	require.Nil(t, a.ExpectProposal(1, chunks[0].Proposal))
+	_, err = a.Add(chunks[0], "peer")
+	require.ErrorIs(t, err, errProposalChunksDisabled)
+}
+
+func TestProposalAssemblerHeldUntilValidated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	data, chunks := makeChunkedPayload(t, 2*proposalChunkSize+1)
+	a := MakeProposalAssembler()
+	a.SetRound(1, true)
+
+	// the chunks received before the proposal-vote is validated are held
+	res, err := a.Add(chunks[1], "peer")
+	require.NoError(t, err)
+	require.False(t, res.Forward)
+
+	// proposal-votes of earlier rounds are ignored
+	require.Nil(t, a.ExpectProposal(0, chunks[0].Proposal))
+
+	held := a.ExpectProposal(1, chunks[0].Proposal)
+	require.Equal(t, []HeldProposalChunk{{Sender: "peer", Chunk: chunks[1]}}, held)
+	require.Empty(t, a.held)
+
+	for _, c := range []ProposalChunk{chunks[0], chunks[2]} {
+		res, err = a.Add(c, "peer")
+		require.NoError(t, err)
+		require.True(t, res.Forward)
+	}
+	require.Equal(t, data, res.Payload)
+	require.True(t, res.Forwarded)
+
+	// a payload completed before its proposal-vote was validated was not forwarded
+	data, chunks = makeChunkedPayload(t, proposalChunkSize+1)
+	for _, c := range chunks {
+		res, err = a.Add(c, "peer")
+		require.NoError(t, err)
+		require.False(t, res.Forward)
+	}
+	require.Equal(t, data, res.Payload)
+	require.False(t, res.Forwarded)
+
+	// the validated payloads of earlier rounds are forgotten
+	_, chunks = makeChunkedPayload(t, proposalChunkSize+1)
+	a.ExpectProposal(1, chunks[0].Proposal)
+	_, err = a.Add(chunks[0], "peer")
+	require.NoError(t, err)
+	require.Contains(t, a.pending, chunks[0].Digest)
+	a.SetRound(2, true)
+	require.Empty(t, a.pending)
+	require.Empty(t, a.expected)
+}
+
+func TestProposalAssemblerBadPayload(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	data, chunks := makeChunkedPayload(t, proposalChunkSize+1)
+	a := makeExpectingAssembler(chunks[0])
+
+	corrupt := chunks[1]
+	corrupt.Data = []byte{^chunks[1].Data[0]}
+	_, err := a.Add(chunks[0], "peer")
+	require.NoError(t, err)
+	res, err := a.Add(corrupt, "peer")
+	require.ErrorIs(t, err, errProposalChunkDigest)
+	require.Nil(t, res.Payload)
+
+	// the payload may still be reassembled from correct chunks
+	_, err = a.Add(chunks[0], "peer")
+	require.NoError(t, err)
+	res, err = a.Add(chunks[1], "peer")
+	require.NoError(t, err)
+	require.Equal(t, data, res.Payload)
+
+	// mismatched chunk counts and proposals are rejected
+	_, chunks = makeChunkedPayload(t, proposalChunkSize+1)
+	a.ExpectProposal(1, chunks[0].Proposal)
+	_, err = a.Add(chunks[0], "peer")
+	require.NoError(t, err)
+	mismatched := chunks[1]
+	mismatched.Total = 3
+	_, err = a.Add(mismatched, "peer")
+	require.Error(t, err)
+	mismatched = chunks[1]
+	mismatched.Proposal = crypto.Digest{}
+	_, err = a.Add(mismatched, "peer")
+	require.Error(t, err)
+}
+
+// TestProposalAssemblerJunkCannotEvict checks that the chunks of payloads
+// without a validated proposal-vote cannot push out the payload of a
+// validated proposal, nor the payloads held for other senders.
+func TestProposalAssemblerJunkCannotEvict(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	data, chunks := makeChunkedPayload(t, 2*proposalChunkSize+1)
+	_, early := makeChunkedPayload(t, proposalChunkSize+1)
+	a := makeExpectingAssembler(chunks[0])
+
+	res, err := a.Add(chunks[0], "honest")
+	require.NoError(t, err)
+	require.True(t, res.Forward)
+	res, err = a.Add(early[0], "honest")
+	require.NoError(t, err)
+	require.False(t, res.Forward)
+
+	// a sender floods junk chunks of unknown payloads
+	for i := 0; i < 10*maxPendingChunkedPayloads; i++ {
+		_, junk := makeChunkedPayload(t, proposalChunkSize+1)
+		res, err = a.Add(junk[0], "attacker")
+		require.NoError(t, err)
+		require.False(t, res.Forward)
+	}
+	require.Len(t, a.held["attacker"], maxHeldChunkedPayloadsPerSender)
+	require.Contains(t, a.pending, chunks[0].Digest)
+	require.Contains(t, a.pending, early[0].Digest)
+
+	// junk chunks from many senders fill the held slots, but never evict anything
+	for i := 0; i < 2*maxHeldChunkedPayloads; i++ {
+		_, junk := makeChunkedPayload(t, proposalChunkSize+1)
+		if _, err := a.Add(junk[0], i); err != nil {
+			require.ErrorIs(t, err, errProposalChunkDropped)
+		}
+	}
+	require.LessOrEqual(t, a.heldPending(), maxHeldChunkedPayloads)
+	require.Contains(t, a.pending, chunks[0].Digest)
+	require.Contains(t, a.pending, early[0].Digest)
+
+	// the honest payload is still delivered
+	for _, c := range chunks[1:] {
+		res, err = a.Add(c, "honest")
+		require.NoError(t, err)
+		require.True(t, res.Forward)
+	}
+	require.Equal(t, data, res.Payload)
+
+	// payloads sent by this node are not reassembled
+	_, chunks = makeChunkedPayload(t, proposalChunkSize+1)
+	a.ExpectProposal(1, chunks[0].Proposal)
+	a.MarkSent(chunks[0].Digest)
+	res, err = a.Add(chunks[0], "honest")
+	require.NoError(t, err)
+	require.False(t, res.Forward)
+}
@@ -159,7 +159,7 @@ func (m *proposalManager) handleMessageEvent(r routerHandle, p player, e filtera
 		keepForLateCredentialTracking := false
 		if err != nil {
 			// if we should keep processing this credential message only to record its timestamp, we continue
-			keepForLateCredentialTracking = proposalUsefulForCredentialHistory(e.FreshnessData.PlayerRound, v.u())
+			keepForLateCredentialTracking = proposalUsefulForCredentialHistory(p.dynamicFilter, e.FreshnessData.PlayerRound, v.u())
 			if !keepForLateCredentialTracking {
 				err := makeSerErrf("proposalManager: ignoring proposal-vote due to age: %v", err)
 				return filteredEvent{T: voteFiltered, Err: err}
@@ -250,7 +250,7 @@ func (m *proposalManager) handleMessageEvent(r routerHandle, p player, e filtera
 // It also returns a bool indicating whether this proposal-vote should still be verified for tracking credential history.
 func (m *proposalManager) filterProposalVote(p player, r routerHandle, uv unauthenticatedVote, freshData freshnessData) (bool, error) {
 	// check if the vote is within the credential history window
-	credHistory := proposalUsefulForCredentialHistory(freshData.PlayerRound, uv)
+	credHistory := proposalUsefulForCredentialHistory(p.dynamicFilter, freshData.PlayerRound, uv)
 
 	// checkDup asks proposalTracker if the vote is a duplicate, returning true if so
 	checkDup := func() bool {
@@ -276,11 +276,11 @@ func (m *proposalManager) filterProposalVote(p player, r routerHandle, uv unauth
 	return credHistory, nil
 }
 
-func proposalUsefulForCredentialHistory(curRound round, vote unauthenticatedVote) bool {
-	if vote.R.Round < curRound && curRound <= vote.R.Round+credentialRoundLag &&
+func proposalUsefulForCredentialHistory(c *dynamicFilterConfig, curRound round, vote unauthenticatedVote) bool {
+	if vote.R.Round < curRound && curRound <= vote.R.Round+c.credentialRoundLag() &&
 		vote.R.Period == 0 &&
 		vote.R.Step == propose {
-		if dynamicFilterCredentialArrivalHistory > 0 {
+		if c.credentialHistorySize() > 0 {
 			// continue processing old period 0 votes so we could track their
 			// arrival times and inform setting the filter timeout dynamically.
 			return true
@@ -0,0 +1,232 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A ReplayStep describes the result of re-driving the agreement state machine
+// with a single event recorded in a cadaver.
+type ReplayStep struct {
+	// Run is the sequence number of the cadaver-generating process (see
+	// PrepareAutopsy) which recorded the event.
+	Run int
+
+	// Round, Period, and Step describe the state of the player before the
+	// event was delivered.
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// EventType and Event describe the recorded input event.
+	EventType string
+	Event     string
+
+	// Actions are the actions emitted by the state machine on replay.
+	Actions []string
+	// Recorded are the actions emitted by the state machine when the cadaver
+	// was written, or nil if none were recorded.
+	Recorded []string
+
+	// Diverged is set if the replayed actions do not match the recorded ones.
+	Diverged bool
+}
+
+// ReplayCadaver loads the cadaver file with the given name (along with its
+// archive, if present) and deterministically re-drives the agreement state
+// machine with the recorded events, returning the resulting action sequence.
+//
+// Only the player state is recorded in a cadaver, so the state of the rest of
+// the state machine tree is reconstructed from the replayed events alone.
+// Replays starting in the middle of a round may thus legitimately diverge from
+// the recorded actions until the next round begins.
+func ReplayCadaver(filename string) ([]ReplayStep, error) {
+	var steps []ReplayStep
+	err := ReplayCadaverFunc(filename, func(step ReplayStep) {
+		steps = append(steps, step)
+	})
+	return steps, err
+}
+
+// ReplayCadaverFunc is like ReplayCadaver, but passes each ReplayStep to emit
+// as soon as it is produced instead of accumulating them.
+func ReplayCadaverFunc(filename string, emit func(ReplayStep)) error {
+	var replayErr error
+	a, err := PrepareAutopsy(filename, func(int, AutopsyBounds) {}, func(_ int, err error) { replayErr = err })
+	if err != nil {
+		return err
+	}
+	defer a.Close()
+
+	a.Replay(emit)
+	return replayErr
+}
+
+// Replay re-drives the agreement state machine with the events in the
+// Autopsy, passing the outcome of each event to emit.
+func (a *Autopsy) Replay(emit func(ReplayStep)) {
+	var replayTracer tracer
+	replayTracer.log = serviceLogger{logging.Base()}
+	replayTracer.w = io.Discard
+	var router rootRouter
+
+	run := 0
+	for cdv := range a.cdvs {
+		for tr := range cdv {
+			player := tr.x
+			router.root = checkedActor{actor: &player, actorContract: playerContract{}}
+
+			for pair := range tr.p {
+				step := ReplayStep{
+					Run:       run,
+					Round:     player.Round,
+					Period:    uint64(player.Period),
+					Step:      uint64(player.Step),
+					EventType: pair.e.t().String(),
+					Event:     pair.e.String(),
+				}
+
+				var as []action
+				player, as = router.submitTop(&replayTracer, player, pair.e)
+
+				step.Actions = actionStrings(as)
+				if pair.aok {
+					step.Recorded = actionStrings(pair.a)
+					step.Diverged = !actionsEqual(as, pair.a)
+				}
+				emit(step)
+			}
+		}
+		run++
+	}
+}
+
+func actionStrings(as []action) []string {
+	strs := make([]string, len(as))
+	for i, a := range as {
+		strs[i] = a.String()
+	}
+	return strs
+}
+
+// actionsEqual compares two action sequences by their encodings.
+func actionsEqual(as0, as1 []action) bool {
+	if len(as0) != len(as1) {
+		return false
+	}
+	for i := range as0 {
+		if as0[i].t() != as1[i].t() {
+			return false
+		}
+		if !bytes.Equal(protocol.EncodeReflect(as0[i]), protocol.EncodeReflect(as1[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// A CadaverInput is a network message or timeout which was delivered to the
+// agreement state machine, as recorded in a cadaver.
+type CadaverInput struct {
+	// Round, Period, and Step describe the state of the player before the
+	// input was delivered.
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// Tag and Data hold a message as it was received from the network.
+	Tag  protocol.Tag
+	Data []byte
+
+	// Timeout is set if the input was a timeout of type TimeoutType instead
+	// of a message.
+	Timeout     bool
+	TimeoutType TimeoutType
+}
+
+// Inputs passes the messages and timeouts recorded in the Autopsy to emit in
+// the order in which they were delivered to the state machine. Messages are
+// re-encoded in their wire format, so they may be injected into a network.
+//
+// Inputs for rounds which are excluded by the filter are skipped.
+func (a *Autopsy) Inputs(filter AutopsyFilter, emit func(CadaverInput)) {
+	var replayTracer tracer
+	replayTracer.log = serviceLogger{logging.Base()}
+	replayTracer.w = io.Discard
+	var router rootRouter
+
+	for cdv := range a.cdvs {
+		for tr := range cdv {
+			player := tr.x
+			router.root = checkedActor{actor: &player, actorContract: playerContract{}}
+
+			for pair := range tr.p {
+				in, ok := cadaverInput(player, pair.e)
+				if ok && (!filter.Enabled || (in.Round >= filter.First && in.Round <= filter.Last)) {
+					emit(in)
+				}
+				// the player state determines the type of the next timeout
+				player, _ = router.submitTop(&replayTracer, player, pair.e)
+			}
+		}
+	}
+}
+
+func cadaverInput(p player, e event) (CadaverInput, bool) {
+	in := CadaverInput{Round: p.Round, Period: uint64(p.Period), Step: uint64(p.Step)}
+
+	switch e := e.(type) {
+	case timeoutEvent:
+		in.Timeout = true
+		in.TimeoutType = p.Deadline.Type
+		if e.T == fastTimeout {
+			in.TimeoutType = TimeoutFastRecovery
+		}
+		return in, true
+
+	case messageEvent:
+		switch e.T {
+		case votePresent:
+			if e.Tail != nil {
+				in.Tag = protocol.ProposalPayloadTag
+				in.Data = protocol.Encode(&transmittedPayload{
+					unauthenticatedProposal: e.Tail.Input.UnauthenticatedProposal,
+					PriorVote:               e.Input.UnauthenticatedVote,
+				})
+				return in, true
+			}
+			in.Tag = protocol.AgreementVoteTag
+			in.Data = protocol.Encode(&e.Input.UnauthenticatedVote)
+			return in, true
+		case payloadPresent:
+			in.Tag = protocol.ProposalPayloadTag
+			in.Data = protocol.Encode(&transmittedPayload{unauthenticatedProposal: e.Input.UnauthenticatedProposal})
+			return in, true
+		case bundlePresent:
+			in.Tag = protocol.VoteBundleTag
+			in.Data = protocol.Encode(&e.Input.UnauthenticatedBundle)
+			return in, true
+		}
+	}
+	return in, false
+}
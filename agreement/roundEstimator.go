@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// roundEstimatorSmoothing is the weight, as a fraction 1/n, given to each new
+// observation by the roundEstimator's moving averages.
+const roundEstimatorSmoothing = 8
+
+// roundEstimator estimates when the current round will be certified.
+//
+// It follows the player's round and period from the main agreement loop, and
+// learns how long it takes the network to reach the soft and cert thresholds
+// from the rounds which completed in period 0.
+//
+// roundEstimator is safe for concurrent use.
+type roundEstimator struct {
+	NoopEventListener
+
+	mu deadlock.Mutex
+
+	round         round
+	period        period
+	periodStart   time.Time
+	filterTimeout time.Duration
+	softAt        time.Time
+
+	// startToSoft and softToCert are moving averages of the time from the
+	// start of period 0 to its soft threshold, and from the soft threshold
+	// to the cert threshold. They are zero until first observed.
+	startToSoft time.Duration
+	softToCert  time.Duration
+
+	now func() time.Time
+}
+
+func makeRoundEstimator() *roundEstimator {
+	return &roundEstimator{now: time.Now}
+}
+
+func smooth(avg, sample time.Duration) time.Duration {
+	if avg == 0 {
+		return sample
+	}
+	return avg + (sample-avg)/roundEstimatorSmoothing
+}
+
+// observe records the state of the player after it has handled an event.
+func (e *roundEstimator) observe(status player) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	switch {
+	case status.Round != e.round:
+		if e.period == 0 && !e.softAt.IsZero() && status.Round == e.round+1 {
+			e.softToCert = smooth(e.softToCert, now.Sub(e.softAt))
+		}
+		e.round = status.Round
+	case status.Period != e.period:
+	default:
+		return
+	}
+
+	e.period = status.Period
+	e.periodStart = now
+	e.softAt = time.Time{}
+	e.filterTimeout = 0
+	if status.Deadline.Type == TimeoutFilter {
+		e.filterTimeout = status.Deadline.Duration
+	}
+}
+
+// OnSoftThreshold implements EventListener.
+func (e *roundEstimator) OnSoftThreshold(rnd basics.Round, per uint64, digest crypto.Digest) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if rnd != e.round || period(per) != e.period || !e.softAt.IsZero() {
+		return
+	}
+	e.softAt = e.now()
+	if e.period == 0 {
+		e.startToSoft = smooth(e.startToSoft, e.softAt.Sub(e.periodStart))
+	}
+}
+
+// expected returns the estimated time at which the current round will be
+// certified, or the zero time if no round has started.
+func (e *roundEstimator) expected() time.Time {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.periodStart.IsZero() {
+		return time.Time{}
+	}
+
+	var estimate time.Time
+	if !e.softAt.IsZero() {
+		estimate = e.softAt.Add(e.softToCert)
+	} else {
+		// the soft threshold cannot be reached before the filter timeout
+		toSoft := e.filterTimeout
+		if e.period == 0 && e.startToSoft > toSoft {
+			toSoft = e.startToSoft
+		}
+		estimate = e.periodStart.Add(toSoft + e.softToCert)
+	}
+
+	// an overdue round is expected to complete at any moment
+	if now := e.now(); estimate.Before(now) {
+		return now
+	}
+	return estimate
+}
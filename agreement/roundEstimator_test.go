@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRoundEstimator(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	start := time.Unix(1700000000, 0)
+	now := start
+	e := makeRoundEstimator()
+	e.now = func() time.Time { return now }
+
+	require.True(t, e.expected().IsZero())
+
+	filter := Deadline{Duration: 4 * time.Second, Type: TimeoutFilter}
+	e.observe(player{Round: 10, Step: soft, Deadline: filter})
+
+	// with no history, the round is expected to end at the filter timeout
+	require.Equal(t, start.Add(4*time.Second), e.expected())
+
+	// soft threshold after 5s, cert 1s later
+	now = start.Add(5 * time.Second)
+	e.OnSoftThreshold(10, 0, crypto.Digest{})
+	require.Equal(t, now, e.expected())
+	now = now.Add(time.Second)
+	e.observe(player{Round: 11, Step: soft, Deadline: filter})
+
+	// the next round is expected to take as long as the last one
+	roundStart := now
+	require.Equal(t, roundStart.Add(6*time.Second), e.expected())
+
+	now = roundStart.Add(5 * time.Second)
+	e.OnSoftThreshold(11, 0, crypto.Digest{})
+	require.Equal(t, now.Add(time.Second), e.expected())
+
+	// soft thresholds for other rounds are ignored
+	e.OnSoftThreshold(12, 0, crypto.Digest{})
+	require.Equal(t, now.Add(time.Second), e.expected())
+
+	// an overdue round is expected to end immediately
+	now = now.Add(time.Minute)
+	require.Equal(t, now, e.expected())
+
+	// a new period restarts the estimate from its filter timeout
+	e.observe(player{Round: 11, Period: 1, Step: soft, Deadline: filter})
+	require.Equal(t, now.Add(5*time.Second), e.expected())
+}
+
+func TestRoundEstimatorSmoothing(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, time.Second, smooth(0, time.Second))
+	require.Equal(t, time.Second, smooth(time.Second, time.Second))
+	require.Equal(t, time.Second+time.Second/roundEstimatorSmoothing, smooth(time.Second, 2*time.Second))
+}
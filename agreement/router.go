@@ -57,6 +57,11 @@ type routerHandle struct {
 // dynamicFilterTimeoutLowerBound parameter as the minimal round time.
 var credentialRoundLag round
 
+// consensusCredentialRoundLag is the smallest number of rounds which covers
+// the time it takes a credential to arrive, assuming that rounds are never
+// shorter than dynamicFilterTimeoutLowerBound.
+var consensusCredentialRoundLag round
+
 func init() {
 	// credential arrival time should be at most 2*config.Protocol.SmallLambda after it was sent
 	// Note that the credentialRoundLag is inversely proportional to the dynamicFilterTimeoutLowerBound
@@ -64,14 +69,15 @@ func init() {
 	// for consistency in analytics we are setting the minimum to be 8 rounds
 	// (equivalent to a dynamicFilterTimeoutLowerBound of 500 ms).
 	minCredentialRoundLag := round(8) // round 2*2000ms / 500ms
-	credentialRoundLag = round(2 * config.Protocol.SmallLambda / dynamicFilterTimeoutLowerBound)
+	consensusCredentialRoundLag = round(2 * config.Protocol.SmallLambda / dynamicFilterTimeoutLowerBound)
+	if consensusCredentialRoundLag*round(dynamicFilterTimeoutLowerBound) < round(2*config.Protocol.SmallLambda) {
+		consensusCredentialRoundLag++
+	}
 
+	credentialRoundLag = consensusCredentialRoundLag
 	if credentialRoundLag < minCredentialRoundLag {
 		credentialRoundLag = minCredentialRoundLag
 	}
-	if credentialRoundLag*round(dynamicFilterTimeoutLowerBound) < round(2*config.Protocol.SmallLambda) {
-		credentialRoundLag++
-	}
 }
 
 // dispatch sends an event to the given state machine listener with the given stateMachineTag.
@@ -165,7 +171,7 @@ func (router *rootRouter) update(state player, r round, gc bool) {
 			// We may still receive credential messages from old rounds. Keep
 			// old round routers around, for as long as those credentials may
 			// arrive to keep track of them.
-			rr := r + credentialRoundLag
+			rr := r + state.dynamicFilter.credentialRoundLag()
 			if rr >= state.Round {
 				children[r] = c
 			}
@@ -24,10 +24,13 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
 	"github.com/algorand/go-algorand/util/timers"
 )
 
@@ -54,14 +57,35 @@ type Service struct {
 	voteVerifier    *AsyncVoteVerifier
 	persistenceLoop *asyncPersistenceLoop
 
+	evidence *evidenceRecorder
+
+	estimator *roundEstimator
+
+	compactor *crashCompactor
+
+	lateProposers *lateProposerTracker
+
 	monitor *coserviceMonitor
 
 	persistRouter  rootRouter
 	persistStatus  player
 	persistActions []action
 
-	// Retain old rounds' period 0 start times.
+	// Retain old rounds' period 0 start times. Unlike the credential arrival
+	// history, they are not persisted: they only cover the credentialRoundLag
+	// rounds before the current one, so losing them on restart only drops the
+	// arrival samples of a few late credentials.
 	historicalClocks map[round]roundStartTimer
+
+	// dynamicFilter holds the configured dynamic filter timeout parameters.
+	dynamicFilter *dynamicFilterConfig
+
+	// credentialArrivals is the credential arrival history restored from
+	// the crash database, which seeds the dynamic filter timeout.
+	credentialArrivals credentialArrivalHistory
+
+	// lastSnapshot is captured by the main state machine loop on exit.
+	lastSnapshot *Snapshot
 }
 
 // Parameters holds the parameters necessary to run the agreement protocol.
@@ -78,11 +102,46 @@ type Parameters struct {
 	logging.Logger
 	config.Local
 	execpool.BacklogPool
+
+	// VoteVerifier optionally replaces the default vote verification
+	// backend. If set, votes queued for verification are handed to it in
+	// batches.
+	VoteVerifier
+
+	// Snapshot optionally holds state returned by Service.Shutdown. If set,
+	// the Service resumes from it instead of from the crash database.
+	Snapshot *Snapshot
+
+	// SimulationMonitor is only set by deterministic simulations, which use
+	// it to detect when the Service has quiesced.
+	SimulationMonitor *SimulationMonitor
+
+	// EquivocationObserver is optionally notified of every equivocation
+	// detected by the Service.
+	EquivocationObserver EquivocationObserver
+
+	// EventListener is optionally notified of the state transitions of the
+	// protocol.
+	EventListener EventListener
+
+	// ObserverOnly is set if the Service must follow consensus without ever
+	// voting or proposing. The KeyManager is ignored and may be nil.
+	ObserverOnly bool
 }
 
 // parameters is a convenience typedef for Parameters.
 type parameters Parameters
 
+// observerKeyManager is the KeyManager of a Service in observer mode. It holds
+// no participation keys, so the Service never votes or proposes.
+type observerKeyManager struct{}
+
+func (observerKeyManager) VotingKeys(votingRound, keysRound basics.Round) []account.ParticipationRecordForRound {
+	return nil
+}
+
+func (observerKeyManager) Record(basics.Address, basics.Round, account.ParticipationAction) {}
+
 // externalDemuxSignals used to syncronize the external signals that goes to the demux with the main loop.
 type externalDemuxSignals struct {
 	Deadline             Deadline
@@ -104,6 +163,9 @@ func MakeService(p Parameters) (*Service, error) {
 	s := new(Service)
 
 	s.parameters = parameters(p)
+	if p.ObserverOnly {
+		s.KeyManager = observerKeyManager{}
+	}
 
 	s.log = makeServiceLogger(p.Logger)
 
@@ -124,6 +186,26 @@ func MakeService(p Parameters) (*Service, error) {
 	s.persistenceLoop = makeAsyncPersistenceLoop(s.log, s.Accessor, s.Ledger)
 
 	s.historicalClocks = make(map[round]roundStartTimer)
+	s.dynamicFilter = makeDynamicFilterConfig(s.Local)
+	s.credentialArrivals = restoreCredentialHistory(s.log, s.Accessor, s.Ledger.NextRound(), s.dynamicFilter.credentialHistorySize())
+
+	s.evidence = makeEvidenceRecorder(s.log, s.Accessor, p.EquivocationObserver)
+	s.tracer.evidence = s.evidence
+	s.compactor = makeCrashCompactor(s.log, s.Accessor, s.Local)
+
+	s.lateProposers = makeLateProposerTracker()
+
+	s.estimator = makeRoundEstimator()
+	s.tracer.listener = s.estimator
+	if p.EventListener != nil {
+		s.tracer.listener = eventListeners{s.estimator, p.EventListener}
+	}
+
+	if p.SimulationMonitor != nil {
+		s.monitor = &p.SimulationMonitor.m
+		// balanced by the demux once it requests its first event
+		s.monitor.inc(demuxCoserviceType)
+	}
 
 	return s, nil
 }
@@ -141,7 +223,7 @@ func (s *Service) Start() {
 
 	s.quit = make(chan struct{})
 
-	s.voteVerifier = MakeAsyncVoteVerifier(s.BacklogPool)
+	s.voteVerifier = MakeAsyncVoteVerifierWithBackend(s.BacklogPool, s.parameters.VoteVerifier)
 	s.demux = makeDemux(demuxParams{
 		net:               s.Network,
 		ledger:            s.Ledger,
@@ -162,6 +244,8 @@ func (s *Service) Start() {
 	})
 
 	s.persistenceLoop.Start()
+	s.evidence.start()
+	s.compactor.start()
 	input := make(chan externalEvent)
 	output := make(chan []action)
 	ready := make(chan externalDemuxSignals)
@@ -172,8 +256,10 @@ func (s *Service) Start() {
 
 // Shutdown the execution of the protocol.
 //
-// This method returns after all resources have been cleaned up.
-func (s *Service) Shutdown() {
+// This method returns after all resources have been cleaned up. It returns a
+// Snapshot of the state machine, which may be passed to MakeService to resume
+// execution, or nil if no state was captured.
+func (s *Service) Shutdown() *Snapshot {
 	s.log.Debug("agreement service is stopping")
 	defer s.log.Debug("agreement service has stopped")
 
@@ -181,6 +267,31 @@ func (s *Service) Shutdown() {
 	s.quitFn()
 	s.wg.Wait()
 	s.persistenceLoop.Quit()
+	s.evidence.quit()
+	s.compactor.quit()
+	return s.lastSnapshot
+}
+
+// Equivocations returns the evidence of all equivocations recorded in the
+// crash database for rounds no earlier than minRound.
+func (s *Service) Equivocations(minRound basics.Round) ([]EquivocationEvidence, error) {
+	return s.evidence.load(minRound)
+}
+
+// ExpectedRoundCompletion returns an estimate of when the current round will
+// be certified, based on the filter timeout of the current period and on how
+// long recent rounds took to reach their soft and cert thresholds.
+//
+// It returns the zero time if the Service has not yet started a round.
+func (s *Service) ExpectedRoundCompletion() time.Time {
+	return s.estimator.expected()
+}
+
+// LateProposers summarizes the proposers which held the lowest credential of
+// recent rounds, ordered by how often their credentials arrived after the
+// filter timeout, most often first.
+func (s *Service) LateProposers() []LateProposer {
+	return s.lateProposers.snapshot()
 }
 
 // DumpDemuxQueues dumps the demux queues to the given writer.
@@ -222,7 +333,13 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 	var a []action
 	var err error
 	raw, err := restore(s.log, s.Accessor)
-	if err == nil {
+	if snapshot := s.parameters.Snapshot; snapshot != nil {
+		// the snapshot holds the same state as the recovery record, captured
+		// when the previous Service stopped. Only ever resume from it once.
+		s.parameters.Snapshot = nil
+		raw, err = snapshot.Data, nil
+	}
+	if err == nil && raw != nil {
 		clock, router, status, a, err = decode(raw, s.Clock, s.log, false)
 		if err != nil {
 			reset(s.log, s.Accessor)
@@ -251,6 +368,13 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 	} else {
 		s.Clock = clock
 	}
+	// the credential arrival history is not part of the recovery state,
+	// so it is always taken from its own record in the crash database. It is
+	// copied so that a restarted main loop starts from the restored history.
+	status.lowestCredentialArrivals = s.credentialArrivals.clone()
+	status.dynamicFilter = s.dynamicFilter
+	s.estimator.observe(status)
+	s.setProposalChunksRound(status.Round)
 
 	for {
 		output <- a
@@ -261,7 +385,13 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 			break
 		}
 
+		prevRound := status.Round
 		status, a = router.submitTop(s.tracer, status, e)
+		s.estimator.observe(status)
+		if status.Round > prevRound {
+			s.persistenceLoop.EnqueueCredentialHistory(status.Round, status.lowestCredentialArrivals.samples())
+			s.setProposalChunksRound(status.Round)
+		}
 
 		if persistent(a) {
 			s.persistRouter = router
@@ -269,6 +399,14 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 			s.persistActions = a
 		}
 	}
+
+	// All actions have been executed by the demuxLoop by now, so none are pending.
+	s.lastSnapshot = &Snapshot{
+		Round:  status.Round,
+		Period: uint64(status.Period),
+		Step:   uint64(status.Step),
+		Data:   encode(s.Clock, router, status, nil, false),
+	}
 	close(output)
 }
 
@@ -288,3 +426,97 @@ func (s *Service) do(ctx context.Context, as []action) {
 		a.do(ctx, s)
 	}
 }
+
+var agreementRoundsCounter = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_agreement_rounds", Description: "Number of rounds concluded by agreement"})
+var agreementPeriodsCounter = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_agreement_periods", Description: "Number of non-zero periods entered by agreement"})
+var agreementRecoveryCounter = metrics.NewTagCounter("algod_agreement_recovery_{TAG}", "Number of agreement {TAG} recovery timeouts", "next", "fast")
+var agreementStepTimeGauge = metrics.MakeGauge(
+	metrics.MetricName{Name: "algod_agreement_round_step_ms", Description: "Milliseconds from the start of the last round until the given milestone was reached"})
+var agreementStepTimeCounter = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_agreement_round_step_microsec_total", Description: "Total microseconds from the start of each round until the given milestone was reached"})
+
+var (
+	proposalMilestoneLabels = map[string]string{"step": "proposal"}
+	softMilestoneLabels     = map[string]string{"step": "soft"}
+	certMilestoneLabels     = map[string]string{"step": "cert"}
+)
+
+// metricsReporter records per-round agreement timings and recovery events
+// into the default metrics registry.
+//
+// Like the tracer which drives it, it is only accessed by the main state
+// machine loop.
+type metricsReporter struct {
+	// enabled is unset for tracers which re-drive recorded state machine
+	// executions (e.g., autopsies), which must not be reported.
+	enabled bool
+
+	round      round
+	roundStart time.Time
+
+	sawProposal bool
+	sawSoft     bool
+	sawCert     bool
+}
+
+// startRound marks the beginning of the given round.
+func (m *metricsReporter) startRound(r round) {
+	if !m.enabled {
+		return
+	}
+	if !m.roundStart.IsZero() {
+		agreementRoundsCounter.Inc(nil)
+	}
+	*m = metricsReporter{enabled: true, round: r, roundStart: time.Now()}
+}
+
+// milestone records the time since the start of the round at which e was
+// first observed in round r.
+func (m *metricsReporter) milestone(e eventType, r round) {
+	if m.roundStart.IsZero() || r != m.round {
+		return
+	}
+
+	var labels map[string]string
+	switch e {
+	case payloadAccepted:
+		if m.sawProposal {
+			return
+		}
+		m.sawProposal = true
+		labels = proposalMilestoneLabels
+	case softThreshold:
+		if m.sawSoft {
+			return
+		}
+		m.sawSoft = true
+		labels = softMilestoneLabels
+	case certThreshold:
+		if m.sawCert {
+			return
+		}
+		m.sawCert = true
+		labels = certMilestoneLabels
+	default:
+		return
+	}
+
+	agreementStepTimeGauge.SetLabels(uint64(time.Since(m.roundStart).Milliseconds()), labels)
+	agreementStepTimeCounter.AddMicrosecondsSince(m.roundStart, labels)
+}
+
+// periodConcluded records that agreement moved on to a non-zero period.
+func (m *metricsReporter) periodConcluded(target period) {
+	if m.enabled && target != 0 {
+		agreementPeriodsCounter.Inc(nil)
+	}
+}
+
+// recovery records that a recovery timeout of the given kind fired.
+func (m *metricsReporter) recovery(kind string) {
+	if m.enabled {
+		agreementRecoveryCounter.Add(kind, 1)
+	}
+}
@@ -151,6 +151,7 @@ type testingNetwork struct {
 	crownedNodes      map[nodeID]bool
 	relayNodes        map[nodeID]bool
 	interceptFn       multicastInterceptFn
+	adversary         *adversary
 }
 
 type testingNetworkEndpoint struct {
@@ -220,6 +221,17 @@ func (n *testingNetwork) multicast(tag protocol.Tag, data []byte, source nodeID,
 		tag, data, source, exclude = out.tag, out.data, out.source, out.exclude
 	}
 
+	if n.adversary != nil {
+		for _, out := range n.adversary.apply(multicastParams{tag, data, source, exclude}) {
+			n.deliver(out.tag, out.data, out.source, out.exclude)
+		}
+		return
+	}
+	n.deliver(tag, data, source, exclude)
+}
+
+// deliver must be called with n.mu held.
+func (n *testingNetwork) deliver(tag protocol.Tag, data []byte, source nodeID, exclude nodeID) {
 	if n.dropSoftVotes || n.dropSlowNextVotes || n.dropVotes || n.certVotePocket != nil || n.softVotePocket != nil || n.compoundPocket != nil {
 		if tag == protocol.ProposalPayloadTag {
 			r := bytes.NewBuffer(data)
@@ -385,6 +397,7 @@ func (n *testingNetwork) repairAll() {
 	n.crownedNodes = nil
 	n.relayNodes = nil
 	n.interceptFn = nil
+	n.adversary = nil
 }
 
 func (n *testingNetwork) disconnect(a nodeID, b nodeID) {
@@ -1982,11 +1995,11 @@ func TestAgreementRecoverBothVAndBotQuorums(t *testing.T) {
 		activityMonitor.waitForQuiet()
 
 		// actually create the value quorum
-		_, upper := (next).nextVoteRanges(DeadlineTimeout(0, version))
+		_, upper := (next).nextVoteRanges(DeadlineTimeout(0, version), recoveryExtraTimeout(config.Consensus[version]))
 		triggerGlobalTimeout(upper, TimeoutDeadline, clocks[1:], activityMonitor) // activates next timers
 		zeroes = expectNoNewPeriod(t, clocks[1:], zeroes)
 
-		lower, upper := (next + 1).nextVoteRanges(DeadlineTimeout(0, version))
+		lower, upper := (next + 1).nextVoteRanges(DeadlineTimeout(0, version), recoveryExtraTimeout(config.Consensus[version]))
 		delta := time.Duration(testingRand{}.Uint64() % uint64(upper-lower))
 		triggerGlobalTimeout(lower+delta, TimeoutDeadline, clocks[1:], activityMonitor)
 		zeroes = expectNewPeriod(t, clocks, zeroes)
@@ -2558,3 +2571,318 @@ func TestAgreementServiceStartDeadline(t *testing.T) {
 	require.Equal(t, testConsensusParams.AgreementFilterTimeoutPeriod0, demuxSignal.Deadline.Duration)
 	require.Equal(t, baseLedger.NextRound(), demuxSignal.CurrentRound)
 }
+
+func TestMetricsReporterMilestones(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	m := metricsReporter{enabled: true}
+	roundsBefore := agreementRoundsCounter.GetUint64Value()
+	softBefore := agreementStepTimeCounter.GetUint64ValueForLabels(softMilestoneLabels)
+
+	// milestones before the first round start are ignored
+	m.milestone(softThreshold, 1)
+	require.False(t, m.sawSoft)
+
+	m.startRound(10)
+	require.Equal(t, roundsBefore, agreementRoundsCounter.GetUint64Value())
+
+	// milestones from other rounds are ignored
+	m.milestone(softThreshold, 11)
+	require.False(t, m.sawSoft)
+
+	time.Sleep(time.Millisecond)
+	m.milestone(payloadAccepted, 10)
+	m.milestone(softThreshold, 10)
+	m.milestone(softThreshold, 10)
+	m.milestone(certThreshold, 10)
+	require.True(t, m.sawProposal)
+	require.True(t, m.sawSoft)
+	require.True(t, m.sawCert)
+	require.Greater(t, agreementStepTimeCounter.GetUint64ValueForLabels(softMilestoneLabels), softBefore)
+
+	m.startRound(11)
+	require.Equal(t, roundsBefore+1, agreementRoundsCounter.GetUint64Value())
+	require.Equal(t, round(11), m.round)
+	require.False(t, m.sawProposal)
+	require.False(t, m.sawSoft)
+	require.False(t, m.sawCert)
+}
+
+func TestAgreementReplayCadaver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	simulateAgreement(t, 1, 5, disabled)
+
+	steps, err := ReplayCadaver(fmt.Sprintf("%v-%v.cdv", t.Name(), 0))
+	require.NoError(t, err)
+	require.NotEmpty(t, steps)
+
+	var recorded int
+	for _, step := range steps {
+		require.NotEmpty(t, step.EventType)
+		if step.Recorded != nil {
+			recorded++
+		}
+	}
+	require.Equal(t, len(steps), recorded)
+	require.Greater(t, steps[len(steps)-1].Round, steps[0].Round)
+}
+
+func TestAgreementCadaverInputs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	simulateAgreement(t, 3, 5, disabled)
+
+	a, err := PrepareAutopsy(fmt.Sprintf("%v-%v.cdv", t.Name(), 0), func(int, AutopsyBounds) {}, func(int, error) {})
+	require.NoError(t, err)
+	defer a.Close()
+
+	var timeouts, messages int
+	var last basics.Round
+	a.Inputs(AutopsyFilter{}, func(in CadaverInput) {
+		require.GreaterOrEqual(t, in.Round, last)
+		last = in.Round
+
+		if in.Timeout {
+			timeouts++
+			return
+		}
+		messages++
+		var err error
+		switch in.Tag {
+		case protocol.AgreementVoteTag:
+			_, err = decodeVote(in.Data)
+		case protocol.ProposalPayloadTag:
+			_, err = decodeProposal(in.Data)
+		case protocol.VoteBundleTag:
+			_, err = decodeBundle(in.Data)
+		default:
+			require.Fail(t, "unexpected tag", in.Tag)
+		}
+		require.NoError(t, err)
+	})
+	require.NotZero(t, timeouts)
+	require.NotZero(t, messages)
+}
+
+func TestAgreementShutdownSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, baseLedger, cleanupFn, services, clocks, ledgers, activityMonitor := setupAgreement(t, 1, disabled, makeTestLedger)
+	startRound := baseLedger.NextRound()
+	defer cleanupFn()
+
+	services[0].Start()
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(t, clocks, 0)
+	runRoundTriggerFilter(t, clocks, activityMonitor, zeroes)
+
+	snapshot := services[0].Shutdown()
+	require.NotNil(t, snapshot)
+	require.Equal(t, startRound+1, snapshot.Round)
+	require.Equal(t, ledgers[0].NextRound(), snapshot.Round)
+
+	_, _, status, pending, err := decode(snapshot.Data, clocks[0], services[0].log, false)
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Round, status.Round)
+	require.Equal(t, snapshot.Period, uint64(status.Period))
+	require.Equal(t, snapshot.Step, uint64(status.Step))
+	require.Empty(t, pending)
+}
+
+func TestAgreementRestartFromSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, _, cleanupFn, services, clocks, _, activityMonitor := setupAgreement(t, 1, disabled, makeTestLedger)
+	defer cleanupFn()
+
+	services[0].Start()
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(t, clocks, 0)
+	runRoundTriggerFilter(t, clocks, activityMonitor, zeroes)
+
+	// save the snapshot on shutdown and restore it on restart, as the node does
+	snapshot := services[0].Shutdown()
+	require.NotNil(t, snapshot)
+	require.NoError(t, PersistSnapshot(services[0].Accessor, snapshot))
+
+	restarted, err := MakeService(Parameters(services[0].parameters))
+	require.NoError(t, err)
+	restarted.tracer = services[0].tracer
+	restarted.tracer.evidence = restarted.evidence
+	restarted.monitor = services[0].monitor
+	restarted.monitor.inc(demuxCoserviceType)
+
+	restarted.Start()
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	resumed := restarted.Shutdown()
+	require.NotNil(t, resumed)
+	require.Equal(t, snapshot.Round, resumed.Round)
+	require.Equal(t, snapshot.Period, resumed.Period)
+	require.Equal(t, snapshot.Step, resumed.Step)
+}
+
+func TestAgreementObserverOnly(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 10
+	observerID := nodeID(numNodes - 1)
+	baseNetwork, baseLedger, cleanupFn, services, clocks, ledgers, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	startRound := baseLedger.NextRound()
+	defer cleanupFn()
+
+	// rebuild the last node as an observer
+	keys := services[observerID].KeyManager.(*recordingKeyManager)
+	observerAddress := keys.keys[0].Parent
+	params := Parameters(services[observerID].parameters)
+	params.ObserverOnly = true
+	observer, err := MakeService(params)
+	require.NoError(t, err)
+	observer.tracer = services[observerID].tracer
+	observer.tracer.evidence = observer.evidence
+	observer.monitor = services[observerID].monitor
+	services[observerID] = observer
+
+	var observerVotes int
+	var mu deadlock.Mutex
+	baseNetwork.intercept(func(params multicastParams) multicastParams {
+		if params.source != observerID {
+			return params
+		}
+		switch params.tag {
+		case protocol.AgreementVoteTag:
+			var uv unauthenticatedVote
+			require.NoError(t, protocol.DecodeStream(bytes.NewBuffer(params.data), &uv))
+			if uv.R.Sender == observerAddress {
+				mu.Lock()
+				observerVotes++
+				mu.Unlock()
+			}
+		case protocol.ProposalPayloadTag:
+			var tp transmittedPayload
+			require.NoError(t, protocol.DecodeStream(bytes.NewBuffer(params.data), &tp))
+			require.NotEqual(t, observerAddress, tp.OriginalProposer)
+		}
+		return params
+	})
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(t, clocks, 0)
+
+	numRounds := 3
+	for j := 0; j < numRounds; j++ {
+		zeroes = runRoundTriggerFilter(t, clocks, activityMonitor, zeroes)
+	}
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+
+	// the observer follows consensus without ever voting or proposing
+	sanityCheck(startRound, round(numRounds), ledgers)
+	mu.Lock()
+	require.Zero(t, observerVotes)
+	mu.Unlock()
+	keys.mutex.Lock()
+	require.Empty(t, keys.recording)
+	keys.mutex.Unlock()
+}
+
+type recordingEventListener struct {
+	mu deadlock.Mutex
+
+	proposals []crypto.Digest
+	soft      []crypto.Digest
+	cert      []crypto.Digest
+	periods   [][2]uint64
+	rounds    []basics.Round
+}
+
+func (l *recordingEventListener) OnProposalObserved(rnd basics.Round, per uint64, proposer basics.Address, digest crypto.Digest) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.proposals = append(l.proposals, digest)
+}
+
+func (l *recordingEventListener) OnSoftThreshold(rnd basics.Round, per uint64, digest crypto.Digest) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.soft = append(l.soft, digest)
+	l.rounds = append(l.rounds, rnd)
+}
+
+func (l *recordingEventListener) OnCertThreshold(rnd basics.Round, per uint64, digest crypto.Digest) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cert = append(l.cert, digest)
+}
+
+func (l *recordingEventListener) OnPeriodChange(rnd basics.Round, from, to uint64, digest crypto.Digest) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.periods = append(l.periods, [2]uint64{from, to})
+}
+
+func (l *recordingEventListener) OnFastRecovery(rnd basics.Round, per uint64, stp uint64) {}
+
+func TestAgreementEventListener(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 5
+	baseNetwork, baseLedger, cleanupFn, services, clocks, ledgers, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	startRound := baseLedger.NextRound()
+	version, _ := baseLedger.ConsensusVersion(startRound)
+	defer cleanupFn()
+
+	listener := &recordingEventListener{}
+	services[0].tracer.listener = listener
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(t, clocks, 0)
+
+	// round 1 completes in period 0
+	zeroes = runRound(t, clocks, activityMonitor, zeroes, FilterTimeout(0, version))
+
+	// round 2 needs a second period: without any proposals, no soft
+	// threshold is reached and the nodes next-vote bottom
+	baseNetwork.intercept(func(params multicastParams) multicastParams {
+		switch params.tag {
+		case protocol.ProposalPayloadTag:
+			params.tag = UnknownMsgTag
+		case protocol.AgreementVoteTag:
+			var uv unauthenticatedVote
+			require.NoError(t, protocol.DecodeStream(bytes.NewBuffer(params.data), &uv))
+			if uv.R.Step == propose {
+				params.tag = UnknownMsgTag
+			}
+		}
+		return params
+	})
+	triggerGlobalTimeout(FilterTimeout(0, version), TimeoutFilter, clocks, activityMonitor)
+	zeroes = expectNoNewPeriod(t, clocks, zeroes)
+	triggerGlobalTimeout(DeadlineTimeout(0, version), TimeoutDeadline, clocks, activityMonitor)
+	expectNewPeriod(t, clocks, zeroes)
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+	sanityCheck(startRound, 1, ledgers)
+
+	listener.mu.Lock()
+	defer listener.mu.Unlock()
+	require.NotEmpty(t, listener.proposals)
+	require.Equal(t, []basics.Round{startRound}, listener.rounds)
+	require.Equal(t, listener.soft, listener.cert)
+	require.Equal(t, ledgers[0].(*testLedger).entries[startRound].Digest(), listener.soft[0])
+	require.Equal(t, [][2]uint64{{0, 1}}, listener.periods)
+}
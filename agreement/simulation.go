@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+// A SimulationMonitor counts the outstanding work of a Service and of the
+// simulated network and clock which drive it, so that a deterministic
+// simulation can tell when the Service has quiesced.
+//
+// The network must call MessageQueued for every message it delivers to the
+// Service (and MessageDropped if delivery then fails), and the clock must call
+// TimeoutPending before firing a timeout and ClockZeroed whenever it is
+// zeroed. The Service accounts for all other work itself.
+//
+// A SimulationMonitor is only meant for simulations; production Services
+// should not set one.
+type SimulationMonitor struct {
+	m coserviceMonitor
+}
+
+// A SimulationListener is notified of changes in the amount of outstanding
+// work tracked by a SimulationMonitor.
+type SimulationListener interface {
+	// Busy is called when work is added; total is the outstanding amount.
+	Busy(total uint)
+	// Idle is called when work is completed; total is the outstanding amount.
+	Idle(total uint)
+}
+
+type simulationListenerAdapter struct {
+	SimulationListener
+}
+
+func (a simulationListenerAdapter) inc(sum uint, state map[coserviceType]uint) {
+	a.Busy(sum)
+}
+
+func (a simulationListenerAdapter) dec(sum uint, state map[coserviceType]uint) {
+	a.Idle(sum)
+}
+
+// MakeSimulationMonitor creates a SimulationMonitor for the node with the
+// given id which reports to the given listener.
+func MakeSimulationMonitor(id int, listener SimulationListener) *SimulationMonitor {
+	m := new(SimulationMonitor)
+	m.m.id = id
+	if listener != nil {
+		m.m.coserviceListener = simulationListenerAdapter{listener}
+	}
+	return m
+}
+
+// MessageQueued records that a message was queued for delivery to the Service.
+func (m *SimulationMonitor) MessageQueued() {
+	m.m.inc(tokenizerCoserviceType)
+}
+
+// MessageDropped records that a message previously passed to MessageQueued
+// was dropped instead of being delivered.
+func (m *SimulationMonitor) MessageDropped() {
+	m.m.dec(tokenizerCoserviceType)
+}
+
+// TimeoutPending records that a timeout is about to fire.
+func (m *SimulationMonitor) TimeoutPending() {
+	m.m.inc(clockCoserviceType)
+}
+
+// ClockZeroed records that the clock was zeroed, which cancels all pending
+// timeouts.
+func (m *SimulationMonitor) ClockZeroed() {
+	m.m.Mutex.Lock()
+	defer m.m.Mutex.Unlock()
+
+	if m.m.c == nil {
+		m.m.c = make(map[coserviceType]uint)
+	}
+	m.m.c[clockCoserviceType] = 0
+
+	if m.m.coserviceListener != nil {
+		m.m.coserviceListener.dec(m.m.sum(), m.m.c)
+	}
+}
+
+// Pending returns the total amount of outstanding work.
+func (m *SimulationMonitor) Pending() uint {
+	m.m.Mutex.Lock()
+	defer m.m.Mutex.Unlock()
+	return m.m.sum()
+}
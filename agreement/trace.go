@@ -61,6 +61,17 @@ type tracer struct {
 	// picks up the right state. Optional.
 	playerInfo tracerMetadata
 
+	// metrics exports per-round timings into the metrics registry
+	metrics metricsReporter
+
+	// evidence records detected equivocations; it is nil for tracers which
+	// re-drive recorded state machine executions
+	evidence *evidenceRecorder
+
+	// listener is notified of state transitions; like evidence, it is only
+	// set for the tracer of a running Service
+	listener EventListener
+
 	// Please use accessors to update timing info, since they may be nil
 	tR      *timingInfoGenerator
 	tRPlus1 *timingInfoGenerator // pipelining
@@ -79,6 +90,7 @@ func makeTracer(log serviceLogger, cadaverFilename string, cadaverSizeTarget uin
 	t.log = log
 	t.verboseReports = verboseReportFlag
 	t.timingReports = timingReportFlag
+	t.metrics.enabled = true
 	t.w = os.Stdout
 
 	fileSizeTarget := int64(cadaverSizeTarget)
@@ -144,6 +156,7 @@ func (t *tracer) ein(src, dest stateMachineTag, e event, r round, p period, s st
 
 func (t *tracer) eout(src, dest stateMachineTag, e event, r round, p period, s step) {
 	t.seq++
+	t.metrics.milestone(e.t(), r)
 	if t.level >= all {
 		// fmt.Fprintf(t.w, "%v %3v %23v <-  %23v: %30v\n", t.tag, t.seq, src, dest, e)
 		fmt.Fprintf(t.w, "%v] %23v <-  %23v: %30v\n", t.tag, src, dest, e)
@@ -184,6 +197,9 @@ func (t *tracer) aoutTop(src, dest stateMachineTag, as []action, r round, p peri
 /* Ad-hoc logging */
 
 func (t *tracer) logTimeout(p player) {
+	if p.Step >= next {
+		t.metrics.recovery("next")
+	}
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -196,7 +212,35 @@ func (t *tracer) logTimeout(p player) {
 	t.log.with(logEvent).Infof("timeout fired on (%v, %v, %v) with value %v (napping: %v)", p.Round, p.Period, p.Step, p.Deadline, p.Napping)
 }
 
+func (t *tracer) logEquivocation(ev equivocationVote) {
+	if t.evidence != nil {
+		t.evidence.record(ev)
+	}
+}
+
+func (t *tracer) logProposalObserved(e proposalAcceptedEvent) {
+	if t.listener != nil {
+		t.listener.OnProposalObserved(e.Round, uint64(e.Period), e.Proposal.OriginalProposer, e.Proposal.BlockDigest)
+	}
+}
+
+func (t *tracer) logThreshold(e thresholdEvent) {
+	if t.listener == nil {
+		return
+	}
+	switch e.T {
+	case softThreshold:
+		t.listener.OnSoftThreshold(e.Round, uint64(e.Period), e.Proposal.BlockDigest)
+	case certThreshold:
+		t.listener.OnCertThreshold(e.Round, uint64(e.Period), e.Proposal.BlockDigest)
+	}
+}
+
 func (t *tracer) logFastTimeout(p player) {
+	t.metrics.recovery("fast")
+	if t.listener != nil {
+		t.listener.OnFastRecovery(p.Round, uint64(p.Period), uint64(p.Step))
+	}
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -220,6 +264,10 @@ func (t *tracer) logProposalFrozen(prop proposalValue, propRound round, propPeri
 }
 
 func (t *tracer) logPeriodConcluded(p player, target period, prop proposalValue) {
+	t.metrics.periodConcluded(target)
+	if t.listener != nil {
+		t.listener.OnPeriodChange(p.Round, uint64(p.Period), uint64(target), prop.BlockDigest)
+	}
 	logEvent := logspec.AgreementEvent{
 		Type:         logspec.PeriodConcluded,
 		Hash:         prop.BlockDigest.String(),
@@ -246,6 +294,8 @@ func (t *tracer) logPeriodConcluded(p player, target period, prop proposalValue)
 }
 
 func (t *tracer) logRoundStart(p player, target round) {
+	t.metrics.startRound(target)
+
 	// Log timing telemetry.
 	if t.tR != nil && t.timingReports {
 		timeInfo := t.tR.Build(p.Step)
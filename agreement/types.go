@@ -52,7 +52,7 @@ type Deadline struct {
 
 var defaultDeadlineTimeout = config.Protocol.BigLambda + config.Protocol.SmallLambda
 var partitionStep = next + 3
-var recoveryExtraTimeout = config.Protocol.SmallLambda
+var defaultRecoveryExtraTimeout = config.Protocol.SmallLambda
 
 // FilterTimeout is the duration of the first agreement step.
 func FilterTimeout(p period, v protocol.ConsensusVersion) time.Duration {
@@ -71,6 +71,26 @@ func DeadlineTimeout(p period, v protocol.ConsensusVersion) time.Duration {
 	return defaultDeadlineTimeout
 }
 
+// recoveryExtraTimeout is the duration by which the first next-vote step is
+// extended past the deadline timeout.
+func recoveryExtraTimeout(proto config.ConsensusParams) time.Duration {
+	if t := proto.AgreementRecoveryExtraTimeout; t > 0 {
+		return t
+	}
+	return defaultRecoveryExtraTimeout
+}
+
+// fastRecoveryTimeouts returns the time between fast recovery attempts and the
+// extra delay before the first attempt of a round.
+func fastRecoveryTimeouts(proto config.ConsensusParams) (lambda, firstDelay time.Duration) {
+	lambda = proto.FastRecoveryLambda
+	firstDelay = proto.FastRecoveryFirstDelay
+	if firstDelay == 0 {
+		firstDelay = lambda
+	}
+	return lambda, firstDelay
+}
+
 // DefaultDeadlineTimeout is the default duration of the second agreement step.
 func DefaultDeadlineTimeout() time.Duration {
 	return defaultDeadlineTimeout
@@ -100,9 +120,9 @@ const (
 	down
 )
 
-func (s step) nextVoteRanges(deadlineTimeout time.Duration) (lower, upper time.Duration) {
-	extra := recoveryExtraTimeout // eg  2000 ms
-	lower = deadlineTimeout       // based on types.DeadlineTimeout()
+func (s step) nextVoteRanges(deadlineTimeout, extraTimeout time.Duration) (lower, upper time.Duration) {
+	extra := extraTimeout   // eg  2000 ms, based on recoveryExtraTimeout()
+	lower = deadlineTimeout // based on types.DeadlineTimeout()
 	upper = lower + extra
 
 	for i := next; i < s; i++ {
@@ -17,6 +17,7 @@
 package agreement
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -95,55 +96,82 @@ type (
 
 // verify verifies that a vote that was received from the network is valid.
 func (uv unauthenticatedVote) verify(l LedgerReader) (vote, error) {
+	return uv.verifyWith(l, defaultVoteVerifier{})
+}
+
+// verifyWith is like verify, but checks the signature and credential of the
+// vote using the given VoteVerifier.
+func (uv unauthenticatedVote) verifyWith(l LedgerReader, vv VoteVerifier) (vote, error) {
+	task, err := uv.verificationTask(l)
+	if err != nil {
+		return vote{}, err
+	}
+	res := vv.VerifyVotes([]VoteVerificationTask{task})
+	return uv.authenticate(task, res[0])
+}
+
+// verificationTask performs all the non-cryptographic checks on a vote and
+// returns the cryptographic material which remains to be verified.
+func (uv unauthenticatedVote) verificationTask(l LedgerReader) (VoteVerificationTask, error) {
 	rv := uv.R
 	m, err := membership(l, rv.Sender, rv.Round, rv.Period, rv.Step)
 	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
+		return VoteVerificationTask{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
 	}
 
 	switch rv.Step {
 	case propose:
 		if rv.Period == rv.Proposal.OriginalPeriod && rv.Sender != rv.Proposal.OriginalProposer {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote sender mismatches with proposal-value: %v != %v", rv.Sender, rv.Proposal.OriginalProposer)
+			return VoteVerificationTask{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote sender mismatches with proposal-value: %v != %v", rv.Sender, rv.Proposal.OriginalProposer)
 		}
 		// The following check could apply to all steps, but it's sufficient to only check in the propose step.
 		if rv.Proposal.OriginalPeriod > rv.Period {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote in period %d claims to repropose block from future period %d", rv.Period, rv.Proposal.OriginalPeriod)
+			return VoteVerificationTask{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote in period %d claims to repropose block from future period %d", rv.Period, rv.Proposal.OriginalPeriod)
 		}
 		fallthrough
 	case soft:
 		fallthrough
 	case cert:
 		if rv.Proposal == bottom {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: votes from step %d cannot validate bottom", rv.Step)
+			return VoteVerificationTask{}, fmt.Errorf("unauthenticatedVote.verify: votes from step %d cannot validate bottom", rv.Step)
 		}
 	}
 
 	proto, err := l.ConsensusParams(ParamsRound(rv.Round))
 	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
+		return VoteVerificationTask{}, fmt.Errorf("unauthenticatedVote.verify: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
 	}
 
 	if rv.Round < m.Record.VoteFirstValid {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d before VoteFirstValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteFirstValid, uv)
+		return VoteVerificationTask{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d before VoteFirstValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteFirstValid, uv)
 	}
 
 	if m.Record.VoteLastValid != 0 && rv.Round > m.Record.VoteLastValid {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d after VoteLastValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteLastValid, uv)
+		return VoteVerificationTask{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d after VoteLastValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteLastValid, uv)
 	}
 
-	ephID := basics.OneTimeIDForRound(rv.Round, m.Record.KeyDilution(proto))
-	voteID := m.Record.VoteID
-	if !voteID.Verify(ephID, rv, uv.Sig) {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not verify FS signature on vote by %v given %v: %+v", rv.Sender, voteID, uv)
-	}
+	return VoteVerificationTask{
+		Voter:      m.Record.VoteID,
+		ID:         basics.OneTimeIDForRound(rv.Round, m.Record.KeyDilution(proto)),
+		Message:    rv,
+		Sig:        uv.Sig,
+		Cred:       uv.Cred,
+		Proto:      proto,
+		Membership: m,
+	}, nil
+}
 
-	cred, err := uv.Cred.Verify(proto, m)
-	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", err)
+// authenticate converts the vote into an authenticated vote given the result
+// of verifying its VoteVerificationTask.
+func (uv unauthenticatedVote) authenticate(task VoteVerificationTask, res VoteVerificationResult) (vote, error) {
+	if errors.Is(res.Err, ErrInvalidVoteSignature) {
+		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not verify FS signature on vote by %v given %v: %+v", uv.R.Sender, task.Voter, uv)
+	}
+	if res.Err != nil {
+		return vote{}, fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", res.Err)
 	}
 
-	return vote{R: rv, Cred: cred, Sig: uv.Sig}, nil
+	return vote{R: uv.R, Cred: res.Cred, Sig: uv.Sig}, nil
 }
 
 var (
@@ -205,6 +233,12 @@ func (v vote) u() unauthenticatedVote {
 }
 
 func (pair unauthenticatedEquivocationVote) verify(l LedgerReader) (equivocationVote, error) {
+	return pair.verifyWith(l, defaultVoteVerifier{})
+}
+
+// verifyWith is like verify, but checks the signatures and credential of the
+// pair using the given VoteVerifier.
+func (pair unauthenticatedEquivocationVote) verifyWith(l LedgerReader, vv VoteVerifier) (equivocationVote, error) {
 	if pair.Proposals[0] == pair.Proposals[1] {
 		return equivocationVote{}, fmt.Errorf("isEquivocationPair: not an equivocation pair: identical vote (block hash %v == %v)", pair.Proposals[0], pair.Proposals[1])
 	}
@@ -215,12 +249,12 @@ func (pair unauthenticatedEquivocationVote) verify(l LedgerReader) (equivocation
 	uv0 := unauthenticatedVote{R: rv0, Cred: pair.Cred, Sig: pair.Sigs[0]}
 	uv1 := unauthenticatedVote{R: rv1, Cred: pair.Cred, Sig: pair.Sigs[1]}
 
-	v0, err := uv0.verify(l)
+	v0, err := uv0.verifyWith(l, vv)
 	if err != nil {
 		return equivocationVote{}, fmt.Errorf("unauthenticatedEquivocationVote.verify: failed to verify pair 0: %w", err)
 	}
 
-	_, err = uv1.verify(l)
+	_, err = uv1.verifyWith(l, vv)
 	if err != nil {
 		return equivocationVote{}, fmt.Errorf("unauthenticatedEquivocationVote.verify: failed to verify pair 1: %w", err)
 	}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var voteDedupDroppedCounter = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_agreement_vote_dedup_dropped", Description: "Number of relayed votes dropped because an identical vote was already verified"})
+
+const (
+	// voteDedupCacheSize bounds the number of entries held in a single
+	// generation of the voteDedupCache.
+	voteDedupCacheSize = 50000
+
+	// voteDedupCacheTTL is the length of time after which a verified vote
+	// is forgotten by the voteDedupCache.
+	voteDedupCacheTTL = time.Minute
+)
+
+// voteDedupKey identifies a vote for the purposes of deduplication.
+//
+// The proposal-value is part of the key so that an equivocating vote (which
+// shares its sender, round, period, and step with a vote we already have) is
+// never suppressed and still reaches the state machine.
+type voteDedupKey struct {
+	Sender   basics.Address
+	Round    round
+	Period   period
+	Step     step
+	Proposal proposalValue
+}
+
+func makeVoteDedupKey(uv unauthenticatedVote) voteDedupKey {
+	return voteDedupKey{
+		Sender:   uv.R.Sender,
+		Round:    uv.R.Round,
+		Period:   uv.R.Period,
+		Step:     uv.R.Step,
+		Proposal: uv.R.Proposal,
+	}
+}
+
+// voteDedupCache remembers votes which were recently verified so that copies
+// of them relayed to us by other peers can be discarded before they reach the
+// vote verifier.
+//
+// Only successfully verified votes are added to the cache: a copy of a vote
+// which failed verification (or whose verification was cancelled) must still
+// be processed, since it may carry a valid signature.
+//
+// The cache keeps two generations of entries. The current generation is
+// rotated out once it is full or older than the TTL, so the cache holds at
+// most 2*size entries and each entry lives for at most 2*ttl.
+//
+// voteDedupCache is safe for concurrent use.
+type voteDedupCache struct {
+	mu deadlock.Mutex
+
+	size int
+	ttl  time.Duration
+
+	cur      map[voteDedupKey]time.Time
+	prev     map[voteDedupKey]time.Time
+	curStart time.Time
+}
+
+func makeVoteDedupCache(size int, ttl time.Duration) *voteDedupCache {
+	return &voteDedupCache{
+		size: size,
+		ttl:  ttl,
+		cur:  make(map[voteDedupKey]time.Time),
+		prev: make(map[voteDedupKey]time.Time),
+	}
+}
+
+// seen returns true if a vote with the given key was added to the cache
+// within the last ttl.
+func (c *voteDedupCache) seen(k voteDedupKey, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expire(now)
+	if added, ok := c.cur[k]; ok && now.Sub(added) < c.ttl {
+		return true
+	}
+	if added, ok := c.prev[k]; ok && now.Sub(added) < c.ttl {
+		return true
+	}
+	return false
+}
+
+// add records that a vote with the given key was verified at the given time.
+func (c *voteDedupCache) add(k voteDedupKey, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expire(now)
+	if len(c.cur) >= c.size {
+		c.swap(now)
+	}
+	c.cur[k] = now
+}
+
+// expire rotates out the current generation if it is older than the ttl.
+func (c *voteDedupCache) expire(now time.Time) {
+	if now.Sub(c.curStart) >= c.ttl {
+		c.swap(now)
+	}
+}
+
+func (c *voteDedupCache) swap(now time.Time) {
+	c.prev = c.cur
+	c.cur = make(map[voteDedupKey]time.Time, len(c.prev))
+	c.curStart = now
+}
+
+// len returns the number of entries held by the cache.
+func (c *voteDedupCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.cur) + len(c.prev)
+}
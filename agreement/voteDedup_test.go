@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestVoteDedupCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	c := makeVoteDedupCache(10, time.Minute)
+	now := time.Now()
+
+	var sender basics.Address
+	sender[0] = 1
+	k := voteDedupKey{Sender: sender, Round: 5, Period: 1, Step: cert}
+	k.Proposal.BlockDigest = randomBlockHash()
+
+	require.False(t, c.seen(k, now))
+	c.add(k, now)
+	require.True(t, c.seen(k, now.Add(time.Second)))
+
+	// a vote for a different value (i.e., an equivocation) is not a duplicate
+	eq := k
+	eq.Proposal.BlockDigest = randomBlockHash()
+	require.False(t, c.seen(eq, now.Add(time.Second)))
+
+	// a vote for a different step is not a duplicate
+	next := k
+	next.Step = next.Step + 1
+	require.False(t, c.seen(next, now.Add(time.Second)))
+
+	// entries expire after the ttl
+	require.True(t, c.seen(k, now.Add(time.Minute-time.Second)))
+	require.False(t, c.seen(k, now.Add(time.Minute)))
+}
+
+func TestVoteDedupCacheBounded(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const size = 10
+	c := makeVoteDedupCache(size, time.Hour)
+	now := time.Now()
+
+	keys := make([]voteDedupKey, 5*size)
+	for i := range keys {
+		keys[i] = voteDedupKey{Round: basics.Round(i)}
+		c.add(keys[i], now)
+		require.LessOrEqual(t, c.len(), 2*size)
+	}
+
+	// the most recent entries are retained
+	for _, k := range keys[len(keys)-size:] {
+		require.True(t, c.seen(k, now))
+	}
+	require.False(t, c.seen(keys[0], now))
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"errors"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/committee"
+)
+
+// maxVoteVerificationBatch bounds the number of queued votes which are
+// collected into a single VoteVerifier batch.
+const maxVoteVerificationBatch = 64
+
+// ErrInvalidVoteSignature is returned by a VoteVerifier when the one-time
+// signature on a vote fails to verify.
+var ErrInvalidVoteSignature = errors.New("invalid one-time signature on vote")
+
+// A VoteVerificationTask holds the cryptographic material of a single vote
+// which must be checked by a VoteVerifier.
+//
+// All non-cryptographic checks on the vote (e.g., that the sender is
+// registered for the vote's round) have already passed by the time a
+// VoteVerificationTask is constructed.
+type VoteVerificationTask struct {
+	// Voter is the participation key which must have signed Message.
+	Voter crypto.OneTimeSignatureVerifier
+	// ID identifies the ephemeral key used to sign Message.
+	ID crypto.OneTimeSignatureIdentifier
+	// Message is the signed portion of the vote.
+	Message crypto.Hashable
+	// Sig is the one-time signature over Message.
+	Sig crypto.OneTimeSignature
+
+	// Cred is the sortition credential attached to the vote.
+	Cred committee.UnauthenticatedCredential
+	// Proto holds the consensus parameters of the vote's round.
+	Proto config.ConsensusParams
+	// Membership describes the committee which Cred claims membership in.
+	Membership committee.Membership
+}
+
+// A VoteVerificationResult is the outcome of checking a VoteVerificationTask.
+type VoteVerificationResult struct {
+	// Cred is the verified credential. It is only set if Err is nil.
+	Cred committee.Credential
+	// Err is ErrInvalidVoteSignature if the signature failed to verify,
+	// or the credential verification error otherwise.
+	Err error
+}
+
+// A VoteVerifier checks the signatures and credentials of agreement votes.
+//
+// Implementations may verify votes one at a time, aggregate their signatures
+// into a single batch verification, or offload the work to dedicated hardware.
+// VerifyVotes may be called concurrently from multiple goroutines.
+type VoteVerifier interface {
+	// VerifyVotes checks each task and returns a slice of results of the
+	// same length, where the ith result corresponds to the ith task.
+	VerifyVotes(tasks []VoteVerificationTask) []VoteVerificationResult
+}
+
+// defaultVoteVerifier checks each vote individually.
+type defaultVoteVerifier struct{}
+
+func (defaultVoteVerifier) VerifyVotes(tasks []VoteVerificationTask) []VoteVerificationResult {
+	results := make([]VoteVerificationResult, len(tasks))
+	for i, t := range tasks {
+		if !t.Voter.Verify(t.ID, t.Message, t.Sig) {
+			results[i].Err = ErrInvalidVoteSignature
+			continue
+		}
+		results[i].Cred, results[i].Err = t.Cred.Verify(t.Proto, t.Membership)
+	}
+	return results
+}
+
+// MakeBatchVoteVerifier creates a VoteVerifier which checks the signatures
+// of all the votes in a batch with a single ed25519 batch verification.
+func MakeBatchVoteVerifier() VoteVerifier {
+	return batchVoteVerifier{}
+}
+
+// batchVoteVerifier enqueues the three ed25519 signatures which make up each
+// one-time signature into a crypto.BatchVerifier.
+type batchVoteVerifier struct{}
+
+const sigsPerOneTimeSignature = 3
+
+func (batchVoteVerifier) VerifyVotes(tasks []VoteVerificationTask) []VoteVerificationResult {
+	results := make([]VoteVerificationResult, len(tasks))
+	if len(tasks) == 0 {
+		return results
+	}
+
+	bv := crypto.MakeBatchVerifierWithHint(sigsPerOneTimeSignature * len(tasks))
+	for _, t := range tasks {
+		t.Sig.ToHeartbeatProof().BatchPrep(t.Voter, t.ID, t.Message, bv)
+	}
+	failed, err := bv.VerifyWithFeedback()
+
+	for i, t := range tasks {
+		if err != nil {
+			base := sigsPerOneTimeSignature * i
+			if failed[base] || failed[base+1] || failed[base+2] {
+				results[i].Err = ErrInvalidVoteSignature
+				continue
+			}
+		}
+		results[i].Cred, results[i].Err = t.Cred.Verify(t.Proto, t.Membership)
+	}
+	return results
+}
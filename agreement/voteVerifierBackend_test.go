@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBatchVoteVerifierMatchesDefault(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+
+	var uvs []unauthenticatedVote
+	var tasks []VoteVerificationTask
+	for i, address := range addresses {
+		var proposal proposalValue
+		proposal.BlockDigest = randomBlockHash()
+		rv := rawVote{Sender: address, Round: round, Period: 0, Step: step(2), Proposal: proposal}
+		uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+
+		// corrupt every third signature
+		if i%3 == 0 {
+			uv.Sig.Sig[0]++
+		}
+		task, err := uv.verificationTask(ledger)
+		require.NoError(t, err)
+		uvs = append(uvs, uv)
+		tasks = append(tasks, task)
+	}
+
+	expected := defaultVoteVerifier{}.VerifyVotes(tasks)
+	actual := MakeBatchVoteVerifier().VerifyVotes(tasks)
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		if i%3 == 0 {
+			require.ErrorIs(t, actual[i].Err, ErrInvalidVoteSignature)
+		}
+		require.Equal(t, expected[i].Err, actual[i].Err)
+		require.Equal(t, expected[i].Cred, actual[i].Cred)
+
+		v1, err1 := uvs[i].authenticate(tasks[i], expected[i])
+		v2, err2 := uvs[i].verifyWith(ledger, MakeBatchVoteVerifier())
+		require.Equal(t, err1 == nil, err2 == nil)
+		require.Equal(t, v1, v2)
+	}
+
+	require.Empty(t, MakeBatchVoteVerifier().VerifyVotes(nil))
+	require.Empty(t, MakeBatchVoteVerifier().VerifyVotes([]VoteVerificationTask{}))
+}
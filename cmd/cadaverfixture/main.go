@@ -0,0 +1,229 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// cadaverfixture converts an agreement cadaver into a Go test which replays
+// the recorded messages and timeouts through the agreementtest/sim harness.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	cadaverFile string
+	outFile     string
+	packageName string
+	fixtureName string
+	setupFunc   string
+	firstRound  uint64
+	lastRound   uint64
+	versionFlag bool
+)
+
+func init() {
+	rootCmd.Flags().StringVarP(&cadaverFile, "file", "f", "", "Name of the input cadaver file")
+	rootCmd.Flags().StringVarP(&outFile, "out", "o", "", "Name of the output Go file (otherwise, use stdout)")
+	rootCmd.Flags().StringVarP(&packageName, "package", "p", "agreementtest", "Package of the generated test")
+	rootCmd.Flags().StringVarP(&fixtureName, "name", "n", "Cadaver", "Name of the generated fixture; the test is named Test<name>Replay")
+	rootCmd.Flags().StringVarP(&setupFunc, "setup", "s", "newReplaySimulation", "Function in the test package which creates the *sim.Simulation to replay the cadaver in")
+	rootCmd.Flags().Uint64Var(&firstRound, "skip-head", 0, "The first round to include in the fixture")
+	rootCmd.Flags().Uint64Var(&lastRound, "skip-tail", 0, "The last round to include in the fixture")
+	rootCmd.Flags().BoolVarP(&versionFlag, "version", "v", false, "Display and write current build version and exit")
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "cadaverfixture",
+	Short: "Convert an agreement cadaver into a regression test fixture",
+	Long: `Convert an agreement cadaver into a regression test fixture.
+
+The generated test delivers the messages and timeouts recorded in the cadaver,
+in their recorded order, to node 0 of a simulation created by the function
+named by --setup, which must have the signature
+
+	func(t *testing.T) *sim.Simulation
+
+and create at least two nodes. Messages are sent as if they came from node 1.
+Votes and proposals are only accepted if the ledgers of the simulation match
+those of the node which recorded the cadaver.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if versionFlag {
+			fmt.Println(config.FormatVersionAndLicense())
+			return
+		}
+		if cadaverFile == "" {
+			cmd.HelpFunc()(cmd, args)
+			os.Exit(1)
+		}
+
+		src, err := generate()
+		if err != nil {
+			reportErrorf("cadaverfixture: %v", err)
+		}
+
+		if outFile == "" {
+			_, err = os.Stdout.Write(src)
+		} else {
+			err = os.WriteFile(outFile, src, 0644)
+		}
+		if err != nil {
+			reportErrorf("cadaverfixture: failed to write fixture: %v", err)
+		}
+	},
+}
+
+func reportErrorf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+var tagNames = map[protocol.Tag]string{
+	protocol.AgreementVoteTag:   "protocol.AgreementVoteTag",
+	protocol.ProposalPayloadTag: "protocol.ProposalPayloadTag",
+	protocol.VoteBundleTag:      "protocol.VoteBundleTag",
+}
+
+var timeoutNames = map[agreement.TimeoutType]string{
+	agreement.TimeoutDeadline:     "agreement.TimeoutDeadline",
+	agreement.TimeoutFastRecovery: "agreement.TimeoutFastRecovery",
+	agreement.TimeoutFilter:       "agreement.TimeoutFilter",
+}
+
+type fixtureInput struct {
+	agreement.CadaverInput
+	TagName     string
+	TimeoutName string
+}
+
+type fixture struct {
+	Source  string
+	Package string
+	Name    string
+	Setup   string
+	Inputs  []fixtureInput
+
+	// HasMessages is set if some input is a message rather than a timeout.
+	HasMessages bool
+}
+
+var fixtureTemplate = template.Must(template.New("fixture").Parse(`// Code generated by cadaverfixture from {{.Source}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+{{- if .HasMessages}}
+	"github.com/algorand/go-algorand/protocol"
+{{- end}}
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// {{.Name}}Inputs holds the inputs recorded in {{.Source}}.
+var {{.Name}}Inputs = []agreement.CadaverInput{
+{{- range .Inputs}}
+	{{if .Timeout -}}
+	{Round: {{.Round}}, Period: {{.Period}}, Step: {{.Step}}, Timeout: true, TimeoutType: {{.TimeoutName}}},
+	{{- else -}}
+	{Round: {{.Round}}, Period: {{.Period}}, Step: {{.Step}}, Tag: {{.TagName}}, Data: []byte({{printf "%q" .Data}})},
+	{{- end}}
+{{- end}}
+}
+
+func Test{{.Name}}Replay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s := {{.Setup}}(t)
+	defer s.Shutdown()
+
+	require.NoError(t, s.Start())
+	require.NoError(t, s.Replay(0, 1, {{.Name}}Inputs))
+}
+`))
+
+func generate() ([]byte, error) {
+	var autopsyErr error
+	autopsy, err := agreement.PrepareAutopsy(cadaverFile, func(int, agreement.AutopsyBounds) {}, func(_ int, err error) { autopsyErr = err })
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare autopsy: %w", err)
+	}
+	defer autopsy.Close()
+
+	var filter agreement.AutopsyFilter
+	if firstRound != 0 || lastRound != 0 {
+		filter.Enabled = true
+		filter.First = basics.Round(firstRound)
+		filter.Last = basics.Round(lastRound)
+		if lastRound == 0 {
+			filter.Last = basics.Round(^uint64(0))
+		}
+	}
+
+	f := fixture{
+		Source:  filepath.Base(cadaverFile),
+		Package: packageName,
+		Name:    fixtureName,
+		Setup:   setupFunc,
+	}
+	autopsy.Inputs(filter, func(in agreement.CadaverInput) {
+		f.Inputs = append(f.Inputs, fixtureInput{
+			CadaverInput: in,
+			TagName:      tagNames[in.Tag],
+			TimeoutName:  timeoutNames[in.TimeoutType],
+		})
+		if !in.Timeout {
+			f.HasMessages = true
+		}
+	})
+	if autopsyErr != nil {
+		return nil, fmt.Errorf("failed to extract full autopsy trace: %w", autopsyErr)
+	}
+	if len(f.Inputs) == 0 {
+		return nil, fmt.Errorf("no inputs found in %s", cadaverFile)
+	}
+
+	return render(f)
+}
+
+func render(f fixture) ([]byte, error) {
+	var buf bytes.Buffer
+	err := fixtureTemplate.Execute(&buf, f)
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRenderFixture(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	f := fixture{
+		Source:  "node.cdv",
+		Package: "agreementtest",
+		Name:    "Incident",
+		Setup:   "newReplaySimulation",
+		Inputs: []fixtureInput{
+			{CadaverInput: agreement.CadaverInput{Round: 10, Tag: protocol.AgreementVoteTag, Data: []byte{0x81, 0x00, '"'}}, TagName: tagNames[protocol.AgreementVoteTag]},
+			{CadaverInput: agreement.CadaverInput{Round: 10, Step: 1, Timeout: true, TimeoutType: agreement.TimeoutFilter}, TimeoutName: timeoutNames[agreement.TimeoutFilter]},
+		},
+		HasMessages: true,
+	}
+
+	src, err := render(f)
+	require.NoError(t, err)
+	require.Contains(t, string(src), "func TestIncidentReplay(t *testing.T)")
+	require.Contains(t, string(src), `Data: []byte("\x81\x00\"")`)
+	require.Contains(t, string(src), "TimeoutType: agreement.TimeoutFilter")
+	require.Contains(t, string(src), "newReplaySimulation(t)")
+
+	parsed, err := parser.ParseFile(token.NewFileSet(), "fixture_test.go", src, parser.ImportsOnly)
+	require.NoError(t, err)
+	require.Equal(t, "agreementtest", parsed.Name.Name)
+	require.Len(t, parsed.Imports, 5)
+
+	// the protocol package is not imported if there are only timeouts
+	f.Inputs = f.Inputs[1:]
+	f.HasMessages = false
+	src, err = render(f)
+	require.NoError(t, err)
+	require.NotContains(t, string(src), "go-algorand/protocol")
+}
@@ -17,11 +17,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"os/signal"
+	"time"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/gen"
@@ -33,6 +36,14 @@ var netName = flag.String("n", "", "The name of the network for this ledger (wil
 var configFile = flag.String("c", "", "The config file containing the genesis ledger and wallets")
 var quiet = flag.Bool("q", false, "Skip verbose informational messages")
 var short = flag.Bool("s", false, "Cap the last participation key round to 1500")
+var mnemonicFile = flag.String("m", "", "A file holding the BIP39 mnemonic the wallet root keys are derived from (will override config file).")
+var stream = flag.Bool("stream", false, "Write genesis.json while creating the wallets, and shard the wallet files into subdirectories, for networks with millions of accounts")
+var resume = flag.Bool("resume", false, "Record the completed wallets, and continue an interrupted run from the wallets it completed")
+var template = flag.Bool("template", false, "Substitute the ${NAME} variables of the config file from the environment")
+var valuesFile = flag.String("values", "", "A file of NAME=value lines substituted for the ${NAME} variables of the config file, before the environment (implies -template)")
+var showProgress = flag.Bool("progress", false, "Report the number of wallets done and the estimated time left as the wallets get created")
+var kmdDir = flag.String("kmd", "", "A kmd data directory to create the wallets in, instead of writing rootkey files (will override config file).")
+var deterministicSeed = flag.String("deterministic", "", "A master seed all the wallet keys are derived from, for reproducible test networks (will override config file).")
 
 func init() {
 	flag.Parse()
@@ -48,7 +59,20 @@ func main() {
 		reportErrorf("missing configuration file '%s'\n", cfgFile)
 	}
 
-	genesisData, err := gen.LoadGenesisData(cfgFile)
+	var genesisData gen.GenesisData
+	var err error
+	if *template || *valuesFile != "" {
+		var values map[string]string
+		if *valuesFile != "" {
+			values, err = gen.LoadTemplateValues(*valuesFile)
+			if err != nil {
+				reportErrorf("error loading values file: %v\n", err)
+			}
+		}
+		genesisData, err = gen.LoadGenesisTemplate(cfgFile, values, true)
+	} else {
+		genesisData, err = gen.LoadGenesisData(cfgFile)
+	}
 	if err != nil {
 		reportErrorf("error loading configuration file: %v\n", err)
 	}
@@ -57,6 +81,26 @@ func main() {
 		genesisData.NetworkName = *netName
 	}
 
+	if *mnemonicFile != "" {
+		genesisData.RootKeyMnemonicFile = *mnemonicFile
+	}
+
+	if *deterministicSeed != "" {
+		genesisData.DeterministicSeed = *deterministicSeed
+	}
+
+	if *stream {
+		genesisData.Streaming = true
+	}
+
+	if *resume {
+		genesisData.Resume = true
+	}
+
+	if *kmdDir != "" {
+		genesisData.KMDDir = *kmdDir
+	}
+
 	var verboseOut io.Writer = nil
 	if !*quiet {
 		verboseOut = os.Stdout
@@ -66,9 +110,27 @@ func main() {
 		if genesisData.LastPartKeyRound > 1500 {
 			genesisData.LastPartKeyRound = 1500
 		}
+		for _, wallet := range genesisData.Wallets {
+			if wallet.LastPartKeyRound != nil && *wallet.LastPartKeyRound > 1500 {
+				*wallet.LastPartKeyRound = 1500
+			}
+		}
 	}
 
-	err = gen.GenerateGenesisFiles(genesisData, config.Consensus, *outDir, verboseOut)
+	var progress gen.ProgressFunc
+	if *showProgress {
+		progress = func(p gen.GenerateProgress) {
+			if p.Message == "" {
+				fmt.Printf("%d/%d wallets done, %s left\n", p.WalletsDone, p.WalletsTotal, p.ETA.Round(time.Second))
+			}
+		}
+	}
+
+	// an interrupted run stops creating wallets, and can be resumed with -resume
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err = gen.GenerateGenesisFilesWithProgress(ctx, genesisData, config.Consensus, *outDir, verboseOut, progress)
 	if err != nil {
 		reportErrorf("Cannot write genesis files: %s", err)
 	}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/nodecontrol"
+	"github.com/algorand/go-algorand/util"
+)
+
+var snapshotOutFile string
+
+func init() {
+	nodeCmd.AddCommand(snapshotCmd)
+	snapshotCmd.AddCommand(snapshotExportCmd)
+	snapshotCmd.AddCommand(snapshotImportCmd)
+
+	snapshotExportCmd.Flags().StringVarP(&snapshotOutFile, "out", "o", "", "Filename of the ledger snapshot to write")
+	snapshotExportCmd.MarkFlagRequired("out")
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Export and import snapshots of the ledger",
+	Long:  "Export a consistent snapshot of the ledger of a running node, and import it into the data directory of a stopped node on another machine, instead of copying the ledger database files.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//Fall back
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var snapshotExportCmd = &cobra.Command{
+	Use:     "export",
+	Short:   "Export a snapshot of the ledger of the node",
+	Long:    "Export a snapshot of the tracker database and the blocks of the running node into a gzipped tarball. The node keeps running meanwhile. The admin API token of the node is required.",
+	Example: "goal node snapshot export -o ledger.tar.gz",
+	Args:    validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		// write to a temporary file first, so that an interrupted export doesn't leave a truncated snapshot behind
+		tempFile := snapshotOutFile + ".partial"
+		f, err := os.Create(tempFile)
+		if err != nil {
+			reportErrorf(errorExportingSnapshot, err)
+		}
+		w := bufio.NewWriter(f)
+		err = client.ExportLedgerSnapshot(context.Background(), w)
+		if err == nil {
+			err = w.Flush()
+		}
+		if err == nil {
+			err = f.Sync()
+		}
+		closeErr := f.Close()
+		if err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Rename(tempFile, snapshotOutFile)
+		}
+		if err != nil {
+			os.Remove(tempFile)
+			reportErrorf(errorExportingSnapshot, err)
+		}
+		reportInfof(infoSnapshotExported, snapshotOutFile)
+	},
+}
+
+var snapshotImportCmd = &cobra.Command{
+	Use:     "import [snapshot file]",
+	Short:   "Import a snapshot of the ledger into the data directory",
+	Long:    "Import a ledger snapshot written by goal node snapshot export into the data directory, which must not hold a ledger yet. The node must be stopped, and resumes from the snapshot once started. The snapshot must be of the same network as the genesis of the data directory.",
+	Example: "goal node snapshot import ledger.tar.gz",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		binDir, err := util.ExeDir()
+		if err != nil {
+			panic(err)
+		}
+		nc := nodecontrol.MakeNodeController(binDir, dataDir)
+		if _, err = nc.GetAlgodPID(); err == nil {
+			reportErrorf(errorSnapshotNodeRunning)
+		}
+
+		cfg, err := config.LoadConfigFromDisk(dataDir)
+		if err != nil && !os.IsNotExist(err) {
+			reportErrorf(errLoadingConfig, dataDir, err)
+		}
+		genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(dataDir, config.GenesisJSONFile))
+		if err != nil {
+			reportErrorf(errorImportingSnapshot, err)
+		}
+		genesisDirs, err := cfg.EnsureAndResolveGenesisDirs(dataDir, genesis.ID(), log)
+		if err != nil {
+			reportErrorf(errorImportingSnapshot, err)
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			reportErrorf(errorImportingSnapshot, err)
+		}
+		defer f.Close()
+		dirs := ledger.DirsAndPrefix{
+			ResolvedGenesisDirs: genesisDirs,
+			DBFilePrefix:        config.LedgerFilenamePrefix,
+		}
+		header, err := ledger.ImportSnapshot(log, dirs, cfg, genesis.Hash(), bufio.NewReader(f))
+		if err != nil {
+			reportErrorf(errorImportingSnapshot, err)
+		}
+		reportInfof(infoSnapshotImported, header.TrackerRound, header.FirstBlock, header.LatestBlock)
+	},
+}
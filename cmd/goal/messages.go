@@ -92,6 +92,11 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorExportingSnapshot                  = "Error exporting the ledger snapshot: %s"
+	infoSnapshotExported                    = "Ledger snapshot written to %s"
+	errorImportingSnapshot                  = "Error importing the ledger snapshot: %s"
+	errorSnapshotNodeRunning                = "The node must be stopped before importing a ledger snapshot"
+	infoSnapshotImported                    = "Imported the ledger snapshot of round %d, with the blocks %d to %d"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
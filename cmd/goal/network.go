@@ -28,6 +28,7 @@ import (
 
 	"github.com/algorand/go-algorand/cmd/util/datadir"
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/gen"
 	"github.com/algorand/go-algorand/netdeploy"
 	"github.com/algorand/go-algorand/util"
@@ -81,6 +82,10 @@ func init() {
 		_ = command.Flags().MarkHidden("rootdir")
 		command.Parent().HelpFunc()(command, strings)
 	})
+
+	networkCmd.AddCommand(networkGenesisCmd)
+	networkGenesisCmd.AddCommand(networkGenesisValidateCmd)
+	networkGenesisCmd.AddCommand(networkGenesisDiffCmd)
 }
 
 var networkCmd = &cobra.Command{
@@ -345,3 +350,56 @@ var networkPregenCmd = &cobra.Command{
 		}
 	},
 }
+
+var networkGenesisCmd = &cobra.Command{
+	Use:   "genesis",
+	Short: "Check genesis.json files",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//Fall back
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var networkGenesisValidateCmd = &cobra.Command{
+	Use:   "validate [genesis file]",
+	Short: "Check that a genesis.json file can start a network",
+	Long:  "Checks a genesis.json file for a stake that doesn't add up to the total money, accounts below their minimum balance, repeated addresses and an unsupported consensus protocol.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		genesis, err := bookkeeping.LoadGenesisFromFile(args[0])
+		if err != nil {
+			reportErrorf("Error loading genesis file %s: %v", args[0], err)
+		}
+		err = gen.ValidateGenesis(genesis, config.Consensus)
+		if err != nil {
+			reportErrorf("Genesis file %s is not valid:\n%v", args[0], err)
+		}
+		reportInfof("Genesis file %s is valid", args[0])
+	},
+}
+
+var networkGenesisDiffCmd = &cobra.Command{
+	Use:   "diff [genesis file] [genesis file]",
+	Short: "Show the differences between two genesis.json files",
+	Long:  "Shows the differences between two genesis.json files: their fields, and the allocations removed (-), added (+) or changed (~) by the second file.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var genesis [2]bookkeeping.Genesis
+		for i, filename := range args {
+			var err error
+			genesis[i], err = bookkeeping.LoadGenesisFromFile(filename)
+			if err != nil {
+				reportErrorf("Error loading genesis file %s: %v", filename, err)
+			}
+		}
+		diff := gen.DiffGenesis(genesis[0], genesis[1])
+		if len(diff) == 0 {
+			reportInfoln("The genesis files are the same")
+			return
+		}
+		for _, line := range diff {
+			fmt.Println(line)
+		}
+	},
+}
@@ -447,7 +447,8 @@ func (s *cdtSession) handleCdtRequest(req *cdt.ChromeRequest, state *cdtState) (
 	case "Debugger.setBreakpointByUrl":
 		p := req.Params.(map[string]interface{})
 		bpLine := int(p["lineNumber"].(float64))
-		err = s.debugger.SetBreakpoint(bpLine)
+		condition, _ := p["condition"].(string)
+		err = s.debugger.SetConditionalBreakpoint(bpLine, condition)
 		if err != nil {
 			return
 		}
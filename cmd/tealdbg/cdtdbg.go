@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -36,6 +37,7 @@ type CdtFrontend struct {
 	latestSids []string
 	router     *mux.Router
 	apiAddress string
+	token      string
 	verbose    bool
 }
 
@@ -43,6 +45,7 @@ type CdtFrontend struct {
 type CdtFrontendParams struct {
 	router     *mux.Router
 	apiAddress string
+	token      string
 	verbose    bool
 }
 
@@ -53,6 +56,7 @@ func MakeCdtFrontend(params *CdtFrontendParams) (a *CdtFrontend) {
 	a.sessions = make(map[string]cdtSession)
 	a.router = params.router
 	a.apiAddress = params.apiAddress
+	a.token = params.token
 	a.verbose = params.verbose
 
 	a.router.HandleFunc("/json/version", a.versionHandler).Methods("GET")
@@ -72,7 +76,7 @@ func (a *CdtFrontend) SessionStarted(sid string, debugger Control, ch chan Notif
 	// first add new routes
 	if name, source := debugger.GetSource(); len(source) != 0 {
 		s.scriptURL = name
-		s.sourceMapURL = fmt.Sprintf("http://%s/%s/sourcemap", a.apiAddress, sid)
+		s.sourceMapURL = fmt.Sprintf("http://%s/%s/sourcemap%s", a.apiAddress, sid, a.tokenQuery())
 		a.router.HandleFunc(fmt.Sprintf("/%s/sourcemap", sid), s.sourceMapHandler).Methods("GET")
 		a.router.HandleFunc(fmt.Sprintf("/%s/source", sid), s.sourceHandler).Methods("GET")
 	}
@@ -141,7 +145,7 @@ func (a *CdtFrontend) enableWebsocketEndpoint(
 	uuid string, apiAddress string,
 	handler func(http.ResponseWriter, *http.Request),
 ) cdt.TabDescription {
-	address := apiAddress + "/" + uuid
+	address := apiAddress + "/" + uuid + a.tokenQuery()
 	desc := cdt.TabDescription{
 		Description:               "",
 		ID:                        uuid,
@@ -165,6 +169,14 @@ func (a *CdtFrontend) enableWebsocketEndpoint(
 	return desc
 }
 
+// tokenQuery returns the query of the URLs handed to Chrome DevTools with the token of a named session
+func (a *CdtFrontend) tokenQuery() string {
+	if len(a.token) == 0 {
+		return ""
+	}
+	return "?token=" + url.QueryEscape(a.token)
+}
+
 func (a *CdtFrontend) versionHandler(w http.ResponseWriter, r *http.Request) {
 	type devtoolsVersion struct {
 		Browser         string `json:"Browser"`
@@ -124,6 +124,13 @@ func (c *MockDebugControl) SetBreakpoint(line int) error {
 	return nil
 }
 
+func (c *MockDebugControl) SetConditionalBreakpoint(line int, condition string) error {
+	if c.errOnCall {
+		return errors.New("mock err")
+	}
+	return nil
+}
+
 func (c *MockDebugControl) RemoveBreakpoint(line int) error {
 	if c.errOnCall {
 		return errors.New("mock err")
@@ -135,6 +142,45 @@ func (c *MockDebugControl) SetBreakpointsActive(active bool) {
 	c.bpActive = active
 }
 
+func (c *MockDebugControl) SetWatchpoint(wp Watchpoint) error {
+	if c.errOnCall {
+		return errors.New("mock err")
+	}
+	return nil
+}
+
+func (c *MockDebugControl) RemoveWatchpoint(wp Watchpoint) error {
+	if c.errOnCall {
+		return errors.New("mock err")
+	}
+	return nil
+}
+
+func (c *MockDebugControl) StepBack() error {
+	if c.errOnCall {
+		return errors.New("mock err")
+	}
+	return nil
+}
+
+func (c *MockDebugControl) ReverseResume() error {
+	if c.errOnCall {
+		return errors.New("mock err")
+	}
+	return nil
+}
+
+func (c *MockDebugControl) GotoLine(line int) error {
+	if c.errOnCall {
+		return errors.New("mock err")
+	}
+	return nil
+}
+
+func (c *MockDebugControl) SteppedInto() bool {
+	return false
+}
+
 func (c *MockDebugControl) GetSourceMap() ([]byte, error) {
 	if c.errOnCall {
 		return nil, errors.New("mock err")
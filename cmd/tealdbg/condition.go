@@ -0,0 +1,550 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// breakCondition is a breakpoint condition, evaluated on the state of the execution
+// at the breakpoint line. The condition language is:
+//
+//	expr    := and { "||" and }
+//	and     := unary { "&&" unary }
+//	unary   := "!" unary | compare
+//	compare := operand [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) operand ]
+//	operand := "(" expr ")" | INT | STRING | 0xHEX | "pc" | "line"
+//	         | "stack" "[" INDEX "]" | "scratch" "[" INDEX "]"
+//	         | "txn" FIELD [ INT ] | "gtxn" INT FIELD [ INT ] | "global" FIELD
+//	         | "writes" ( "global" | "local" | "box" ) [ STRING | 0xHEX ]
+//
+// Stack indexes start from the bottom of the stack, negative ones from its top.
+// writes is the number of writes and deletions the execution made so far to the state, or to its key.
+// Integers are compared as such, byte slices only for equality, and a string
+// that is an address is equal to the bytes of the address.
+type breakCondition struct {
+	source string
+	expr   condExpr
+}
+
+// condValue is a TEAL value, or the boolean result of a comparison as a uint
+type condValue struct {
+	isBytes bool
+	uint    uint64
+	bytes   []byte
+	// addr is set for string literals that are also addresses
+	addr *basics.Address
+}
+
+type condExpr func(state *condState) (condValue, error)
+
+// condState is the state conditions are evaluated on: a step of the execution
+// and the app state writes made before it
+type condState struct {
+	*logic.DebugState
+	writes stateWrites
+	// written is set when the opcode of the previous step wrote the app state,
+	// before are then the writes made before it
+	written bool
+	before  stateWrites
+}
+
+func parseBreakCondition(source string) (*breakCondition, error) {
+	tokens, err := tokenizeCondition(source)
+	if err != nil {
+		return nil, fmt.Errorf("condition %s: %w", source, err)
+	}
+	p := condParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %s", p.tokens[p.pos])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("condition %s: %w", source, err)
+	}
+	return &breakCondition{source, expr}, nil
+}
+
+// holds evaluates the condition on state
+func (c *breakCondition) holds(state *condState) (bool, error) {
+	v, err := c.expr(state)
+	if err != nil {
+		return false, fmt.Errorf("condition %s: %w", c.source, err)
+	}
+	if v.isBytes {
+		return false, fmt.Errorf("condition %s: result is a byte slice", c.source)
+	}
+	return v.uint != 0, nil
+}
+
+func (c *breakCondition) String() string {
+	return c.source
+}
+
+// MarshalText encodes the condition as its source
+func (c *breakCondition) MarshalText() ([]byte, error) {
+	return []byte(c.source), nil
+}
+
+// UnmarshalText parses the condition source
+func (c *breakCondition) UnmarshalText(text []byte) error {
+	parsed, err := parseBreakCondition(string(text))
+	if err != nil {
+		return err
+	}
+	*c = *parsed
+	return nil
+}
+
+func tokenizeCondition(source string) (tokens []string, err error) {
+	for i := 0; i < len(source); {
+		c := rune(source[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(source) && source[end] != '"'; end++ {
+				if source[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(source) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, source[i:end+1])
+			i = end + 1
+		case c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			end := i + 1
+			for end < len(source) && (source[end] == '_' || unicode.IsLetter(rune(source[end])) || unicode.IsDigit(rune(source[end]))) {
+				end++
+			}
+			tokens = append(tokens, source[i:end])
+			i = end
+		default:
+			op := source[i : i+1]
+			if i+1 < len(source) {
+				switch two := source[i : i+2]; two {
+				case "&&", "||", "==", "!=", "<=", ">=":
+					op = two
+				}
+			}
+			switch op {
+			case "&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", "-":
+			default:
+				return nil, fmt.Errorf("unexpected %s", op)
+			}
+			tokens = append(tokens, op)
+			i += len(op)
+		}
+	}
+	return tokens, nil
+}
+
+type condParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *condParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *condParser) next() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end")
+	}
+	p.pos++
+	return p.tokens[p.pos-1], nil
+}
+
+func (p *condParser) expect(token string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t != token {
+		return fmt.Errorf("expected %s, got %s", token, t)
+	}
+	return nil
+}
+
+func (p *condParser) parseOr() (condExpr, error) {
+	left, err := p.parseAnd()
+	for err == nil && p.peek() == "||" {
+		p.pos++
+		var right condExpr
+		right, err = p.parseAnd()
+		left = logicalExpr(left, right, true)
+	}
+	return left, err
+}
+
+func (p *condParser) parseAnd() (condExpr, error) {
+	left, err := p.parseUnary()
+	for err == nil && p.peek() == "&&" {
+		p.pos++
+		var right condExpr
+		right, err = p.parseUnary()
+		left = logicalExpr(left, right, false)
+	}
+	return left, err
+}
+
+// logicalExpr makes a short-circuit || when or is set, and a && otherwise
+func logicalExpr(left, right condExpr, or bool) condExpr {
+	return func(state *condState) (condValue, error) {
+		l, err := truth(left, state)
+		if err != nil || l == or {
+			return boolValue(l), err
+		}
+		r, err := truth(right, state)
+		return boolValue(r), err
+	}
+}
+
+func (p *condParser) parseUnary() (condExpr, error) {
+	if p.peek() != "!" {
+		return p.parseCompare()
+	}
+	p.pos++
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return func(state *condState) (condValue, error) {
+		v, err := truth(operand, state)
+		return boolValue(!v), err
+	}, nil
+}
+
+func (p *condParser) parseCompare() (condExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	op := p.peek()
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+	default:
+		return left, nil
+	}
+	p.pos++
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return func(state *condState) (condValue, error) {
+		l, err := left(state)
+		if err != nil {
+			return condValue{}, err
+		}
+		r, err := right(state)
+		if err != nil {
+			return condValue{}, err
+		}
+		return compare(op, l, r)
+	}, nil
+}
+
+func compare(op string, l, r condValue) (condValue, error) {
+	if l.isBytes != r.isBytes {
+		return condValue{}, fmt.Errorf("%s compares an integer with a byte slice", op)
+	}
+	if l.isBytes {
+		equal := bytes.Equal(l.bytes, r.bytes) ||
+			l.addr != nil && bytes.Equal(l.addr[:], r.bytes) ||
+			r.addr != nil && bytes.Equal(l.bytes, r.addr[:])
+		switch op {
+		case "==":
+			return boolValue(equal), nil
+		case "!=":
+			return boolValue(!equal), nil
+		}
+		return condValue{}, fmt.Errorf("%s compares integers only", op)
+	}
+	switch op {
+	case "==":
+		return boolValue(l.uint == r.uint), nil
+	case "!=":
+		return boolValue(l.uint != r.uint), nil
+	case "<":
+		return boolValue(l.uint < r.uint), nil
+	case "<=":
+		return boolValue(l.uint <= r.uint), nil
+	case ">":
+		return boolValue(l.uint > r.uint), nil
+	default:
+		return boolValue(l.uint >= r.uint), nil
+	}
+}
+
+func (p *condParser) parseOperand() (condExpr, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case token == "(":
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return expr, p.expect(")")
+	case token[0] == '"':
+		str, err := strconv.Unquote(token)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string %s", token)
+		}
+		v := condValue{isBytes: true, bytes: []byte(str)}
+		if addr, err := basics.UnmarshalChecksumAddress(str); err == nil {
+			v.addr = &addr
+		}
+		return constant(v), nil
+	case strings.HasPrefix(token, "0x"):
+		data, err := hex.DecodeString(token[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid byte slice %s", token)
+		}
+		return constant(condValue{isBytes: true, bytes: data}), nil
+	case unicode.IsDigit(rune(token[0])):
+		n, err := strconv.ParseUint(token, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %s", token)
+		}
+		return constant(condValue{uint: n}), nil
+	case token == "pc":
+		return func(state *condState) (condValue, error) {
+			return condValue{uint: uint64(state.PC)}, nil
+		}, nil
+	case token == "line":
+		return func(state *condState) (condValue, error) {
+			return condValue{uint: uint64(state.Line)}, nil
+		}, nil
+	case token == "stack" || token == "scratch":
+		return p.parseIndexed(token)
+	case token == "txn" || token == "gtxn":
+		return p.parseTxnField(token == "gtxn")
+	case token == "global":
+		return p.parseGlobalField()
+	case token == "writes":
+		return p.parseWrites()
+	}
+	return nil, fmt.Errorf("unexpected %s", token)
+}
+
+// parseIndexed parses the index of a stack or scratch space value
+func (p *condParser) parseIndexed(array string) (condExpr, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+	negative := p.peek() == "-"
+	if negative {
+		p.pos++
+	}
+	index, err := p.parseInt()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect("]"); err != nil {
+		return nil, err
+	}
+	label := strconv.FormatUint(index, 10)
+	if negative {
+		label = "-" + label
+	}
+
+	return func(state *condState) (condValue, error) {
+		values := state.Stack
+		if array == "scratch" {
+			values = state.Scratch
+		}
+		n := uint64(len(values))
+		switch {
+		case negative && index > 0 && index <= n:
+			return encodedValue(values[n-index])
+		case !negative && index < n:
+			return encodedValue(values[index])
+		}
+		return condValue{}, fmt.Errorf("%s has no value at %s", array, label)
+	}, nil
+}
+
+func (p *condParser) parseInt() (uint64, error) {
+	token, err := p.next()
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(token, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected an integer, got %s", token)
+	}
+	return n, nil
+}
+
+func (p *condParser) parseTxnField(group bool) (condExpr, error) {
+	var groupIndex uint64
+	var err error
+	if group {
+		groupIndex, err = p.parseInt()
+		if err != nil {
+			return nil, err
+		}
+	}
+	name, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	field := -1
+	for i, fieldName := range logic.TxnFieldNames {
+		if fieldName == name {
+			field = i
+		}
+	}
+	if field < 0 {
+		return nil, fmt.Errorf("unknown txn field %s", name)
+	}
+	var arrayIndex uint64
+	if len(p.peek()) > 0 && unicode.IsDigit(rune(p.peek()[0])) {
+		arrayIndex, err = p.parseInt()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return func(state *condState) (condValue, error) {
+		gi := int(groupIndex)
+		if !group {
+			gi = state.GroupIndex
+		}
+		if gi >= len(state.TxnGroup) {
+			return condValue{}, fmt.Errorf("no transaction %d in the group", gi)
+		}
+		tv, err := logic.TxnFieldToTealValue(&state.TxnGroup[gi].Txn, gi, logic.TxnField(field), arrayIndex, false)
+		if err != nil {
+			return condValue{}, err
+		}
+		if tv.Type == basics.TealBytesType {
+			return condValue{isBytes: true, bytes: []byte(tv.Bytes)}, nil
+		}
+		return condValue{uint: tv.Uint}, nil
+	}, nil
+}
+
+func (p *condParser) parseGlobalField() (condExpr, error) {
+	name, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	field := -1
+	for i, fieldName := range logic.GlobalFieldNames {
+		if fieldName == name {
+			field = i
+		}
+	}
+	if field < 0 {
+		return nil, fmt.Errorf("unknown global field %s", name)
+	}
+
+	return func(state *condState) (condValue, error) {
+		if field >= len(state.Globals) {
+			return condValue{}, fmt.Errorf("global %s is not available", name)
+		}
+		return encodedValue(state.Globals[field])
+	}, nil
+}
+
+// parseWrites parses the number of writes to an app state, and to one of its keys if one follows
+func (p *condParser) parseWrites() (condExpr, error) {
+	name, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	var state logic.AppStateEnum
+	switch name {
+	case "global":
+		state = logic.GlobalState
+	case "local":
+		state = logic.LocalState
+	case "box":
+		state = logic.BoxState
+	default:
+		return nil, fmt.Errorf("unknown state %s, expected global, local or box", name)
+	}
+	var key *string
+	if next := p.peek(); len(next) > 0 && (next[0] == '"' || strings.HasPrefix(next, "0x")) {
+		operand, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		v, _ := operand(nil)
+		k := string(v.bytes)
+		key = &k
+	}
+
+	return func(cs *condState) (condValue, error) {
+		return condValue{uint: uint64(cs.writes.count(state, key))}, nil
+	}, nil
+}
+
+func constant(v condValue) condExpr {
+	return func(*condState) (condValue, error) {
+		return v, nil
+	}
+}
+
+// encodedValue decodes a debug state value, which has its bytes base64 encoded
+func encodedValue(tv basics.TealValue) (condValue, error) {
+	if tv.Type != basics.TealBytesType {
+		return condValue{uint: tv.Uint}, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(tv.Bytes)
+	if err != nil {
+		return condValue{}, err
+	}
+	return condValue{isBytes: true, bytes: data}, nil
+}
+
+func truth(expr condExpr, state *condState) (bool, error) {
+	v, err := expr(state)
+	if err != nil {
+		return false, err
+	}
+	if v.isBytes {
+		return false, fmt.Errorf("a byte slice is not a boolean")
+	}
+	return v.uint != 0, nil
+}
+
+func boolValue(b bool) condValue {
+	if b {
+		return condValue{uint: 1}
+	}
+	return condValue{}
+}
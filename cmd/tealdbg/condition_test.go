@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBreakCondition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	require.NoError(t, err)
+	globals := make([]basics.TealValue, len(logic.GlobalFieldNames))
+	globals[logic.MinTxnFee] = basics.TealValue{Type: basics.TealUintType, Uint: 1000}
+
+	var txn transactions.SignedTxnWithAD
+	txn.Txn.Sender = sender
+	txn.Txn.Fee = basics.MicroAlgos{Raw: 1000}
+	state := logic.DebugState{
+		PC:   5,
+		Line: 3,
+		Stack: []basics.TealValue{
+			{Type: basics.TealUintType, Uint: 1500},
+			{Type: basics.TealBytesType, Bytes: base64.StdEncoding.EncodeToString([]byte("hi"))},
+		},
+		TxnGroup: []transactions.SignedTxnWithAD{txn},
+		Globals:  globals,
+	}
+
+	conditions := map[string]bool{
+		`stack[0] > 1000`:                            true,
+		`stack[0] > 1000 && stack[-1] == "hi"`:       true,
+		`stack[-1] == 0x6869`:                        true,
+		`!(stack[0] > 1000) || line == 3`:            true,
+		`txn Fee == global MinTxnFee`:                true,
+		`gtxn 0 Sender == "` + sender.String() + `"`: true,
+		`txn Sender == "` + sender.String() + `"`:    true,
+		`pc != 5`:                false,
+		`stack[-1] != "hi" || 0`: false,
+	}
+	for source, expected := range conditions {
+		cond, err := parseBreakCondition(source)
+		require.NoError(t, err, source)
+		holds, err := cond.holds(&condState{DebugState: &state})
+		require.NoError(t, err, source)
+		require.Equal(t, expected, holds, source)
+	}
+
+	for _, source := range []string{`stack[`, `stack[0] >`, `txn Foo`, `1 & 2`, `"abc`, `(1`, `1 2`} {
+		_, err := parseBreakCondition(source)
+		require.Error(t, err, source)
+	}
+
+	for _, source := range []string{`stack[2] == 1`, `stack[-3] == 1`, `stack[-1] > 1`, `stack[-1]`, `"a" == 1`, `gtxn 1 Fee == 1`} {
+		cond, err := parseBreakCondition(source)
+		require.NoError(t, err, source)
+		_, err = cond.holds(&condState{DebugState: &state})
+		require.Error(t, err, source)
+	}
+}
+
+func TestBreakConditionWrites(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var writes stateWrites
+	for _, change := range []logic.AppStateChange{
+		{State: logic.GlobalState, Key: "price"},
+		{State: logic.GlobalState, Key: "price"},
+		{State: logic.GlobalState, Key: "owner"},
+		{State: logic.BoxState, Key: "\x01"},
+	} {
+		prev := writes
+		writes = writes.add(&change)
+		// the counts of the previous steps are not modified
+		require.NotEqual(t, prev, writes)
+	}
+	state := condState{DebugState: &logic.DebugState{}, writes: writes}
+
+	conditions := map[string]bool{
+		`writes global "price" == 2`: true,
+		`writes global == 3`:         true,
+		`writes box 0x01 == 1`:       true,
+		`writes box > 1`:             false,
+		`writes local`:               false,
+		`writes global "other"`:      false,
+	}
+	for source, expected := range conditions {
+		cond, err := parseBreakCondition(source)
+		require.NoError(t, err, source)
+		holds, err := cond.holds(&state)
+		require.NoError(t, err, source)
+		require.Equal(t, expected, holds, source)
+	}
+
+	for _, source := range []string{`writes`, `writes app`, `writes global "a`} {
+		_, err := parseBreakCondition(source)
+		require.Error(t, err, source)
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// branchKey identifies a branching instruction of a source line by its program offset
+type branchKey struct {
+	line  int
+	block int
+}
+
+// fileCoverage counts the executions of the lines of a source, numbered from 1,
+// and of the branches of its conditional branching instructions
+type fileCoverage struct {
+	source   string
+	lines    map[int]int
+	branches map[branchKey][]int
+}
+
+// coverage is the coverage of the program sources across runs
+type coverage struct {
+	files map[string]*fileCoverage
+	names []string
+}
+
+func makeCoverage() *coverage {
+	return &coverage{files: make(map[string]*fileCoverage)}
+}
+
+func (c *coverage) file(name string) *fileCoverage {
+	fc, ok := c.files[name]
+	if !ok {
+		fc = &fileCoverage{lines: make(map[int]int), branches: make(map[branchKey][]int)}
+		c.files[name] = fc
+		c.names = append(c.names, name)
+	}
+	return fc
+}
+
+// coverageName is the source file name the coverage of the run is reported for
+func coverageName(run *evaluation) string {
+	if len(run.name) != 0 {
+		return run.name
+	}
+	return logic.GetProgramID(run.program) + ".teal"
+}
+
+// branchTargets returns the program offsets the conditional branching instruction at pc may go to,
+// the fall through one last, or nil for other instructions
+func branchTargets(program []byte, pc int) []int {
+	opcode := program[pc]
+	switch opcode {
+	case logic.OpsByName[logic.LogicVersion]["bz"].Opcode, logic.OpsByName[logic.LogicVersion]["bnz"].Opcode:
+		if pc+3 > len(program) {
+			return nil
+		}
+		end := pc + 3
+		offset := int(int16(uint16(program[pc+1])<<8 | uint16(program[pc+2])))
+		return []int{end + offset, end}
+	case logic.OpsByName[logic.LogicVersion]["switch"].Opcode, logic.OpsByName[logic.LogicVersion]["match"].Opcode:
+		if pc+2 > len(program) {
+			return nil
+		}
+		n := int(program[pc+1])
+		end := pc + 2 + 2*n
+		if end > len(program) {
+			return nil
+		}
+		targets := make([]int, 0, n+1)
+		for i := 0; i < n; i++ {
+			at := pc + 2 + 2*i
+			offset := int(int16(uint16(program[at])<<8 | uint16(program[at+1])))
+			targets = append(targets, end+offset)
+		}
+		return append(targets, end)
+	}
+	return nil
+}
+
+// addRun counts the executions of the lines and branches of the run source in the run trace
+func (c *coverage) addRun(run *evaluation, rt *runTrace) {
+	source, offsetToSource := runSource(run)
+	fc := c.file(coverageName(run))
+	fc.source = source
+
+	executions := make(map[int]int)
+	units := rt.ExecTrace.programTrace()
+	for i, unit := range units {
+		executions[unit.PC]++
+		next := len(run.program)
+		if i+1 < len(units) {
+			next = units[i+1].PC
+		}
+		if targets := branchTargets(run.program, unit.PC); targets != nil {
+			// the first target matching tells the branch taken, falling through if none does
+			branch := len(targets) - 1
+			for j, target := range targets {
+				if target == next {
+					branch = j
+					break
+				}
+			}
+			fc.addBranch(branchKey{offsetToSource[unit.PC].Line + 1, unit.PC}, len(targets), branch)
+		}
+	}
+
+	// lines are executed as many times as their most executed instruction
+	lines := make(map[int]int)
+	for pc, loc := range offsetToSource {
+		line := loc.Line + 1
+		if hits, ok := lines[line]; !ok || executions[pc] > hits {
+			lines[line] = executions[pc]
+		}
+		if targets := branchTargets(run.program, pc); targets != nil {
+			fc.addBranch(branchKey{line, pc}, len(targets), -1)
+		}
+	}
+	for line, hits := range lines {
+		fc.lines[line] += hits
+	}
+}
+
+// addBranch counts an execution taking the branch, or only makes sure the branches are counted if negative
+func (fc *fileCoverage) addBranch(key branchKey, count int, branch int) {
+	counts := fc.branches[key]
+	for len(counts) < count {
+		counts = append(counts, 0)
+	}
+	if branch >= 0 && branch < len(counts) {
+		counts[branch]++
+	}
+	fc.branches[key] = counts
+}
+
+func sortedKeys(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func (fc *fileCoverage) sortedBranches() []branchKey {
+	keys := make([]branchKey, 0, len(fc.branches))
+	for k := range fc.branches {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].line != keys[j].line {
+			return keys[i].line < keys[j].line
+		}
+		return keys[i].block < keys[j].block
+	})
+	return keys
+}
+
+// writeLcov writes the coverage in the lcov tracefile format
+func (c *coverage) writeLcov(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, name := range c.names {
+		fc := c.files[name]
+		fmt.Fprintf(bw, "TN:\nSF:%s\n", name)
+		branchesHit := 0
+		branchesFound := 0
+		for _, key := range fc.sortedBranches() {
+			for i, count := range fc.branches[key] {
+				fmt.Fprintf(bw, "BRDA:%d,%d,%d,%d\n", key.line, key.block, i, count)
+				branchesFound++
+				if count > 0 {
+					branchesHit++
+				}
+			}
+		}
+		fmt.Fprintf(bw, "BRF:%d\nBRH:%d\n", branchesFound, branchesHit)
+		linesHit := 0
+		for _, line := range sortedKeys(fc.lines) {
+			fmt.Fprintf(bw, "DA:%d,%d\n", line, fc.lines[line])
+			if fc.lines[line] > 0 {
+				linesHit++
+			}
+		}
+		fmt.Fprintf(bw, "LF:%d\nLH:%d\nend_of_record\n", len(fc.lines), linesHit)
+	}
+	return bw.Flush()
+}
+
+// readLcov reads the line and branch coverage of an lcov tracefile
+func readLcov(r io.Reader) (*coverage, error) {
+	c := makeCoverage()
+	var fc *fileCoverage
+	scanner := bufio.NewScanner(r)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		record := strings.TrimSpace(scanner.Text())
+		kind, value, _ := strings.Cut(record, ":")
+		var err error
+		switch kind {
+		case "SF":
+			fc = c.file(value)
+		case "DA", "BRDA":
+			if fc == nil {
+				return nil, fmt.Errorf("line %d: %s record outside of a source file", lineno, kind)
+			}
+			fields := strings.Split(value, ",")
+			nums := make([]int, len(fields))
+			for i, field := range fields {
+				if field == "-" {
+					// lcov marks branches of code not executed
+					continue
+				}
+				nums[i], err = strconv.Atoi(field)
+				if err != nil {
+					break
+				}
+			}
+			switch {
+			case err != nil:
+			case kind == "DA" && len(nums) >= 2:
+				fc.lines[nums[0]] += nums[1]
+			case kind == "BRDA" && len(nums) == 4:
+				fc.addBranch(branchKey{nums[0], nums[1]}, nums[2]+1, -1)
+				fc.branches[branchKey{nums[0], nums[1]}][nums[2]] += nums[3]
+			default:
+				err = fmt.Errorf("invalid %s record", kind)
+			}
+		case "end_of_record":
+			fc = nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineno, err)
+		}
+	}
+	return c, scanner.Err()
+}
+
+// merge adds the counts of other to the coverage
+func (c *coverage) merge(other *coverage) {
+	for _, name := range other.names {
+		ofc := other.files[name]
+		fc := c.file(name)
+		if len(fc.source) == 0 {
+			fc.source = ofc.source
+		}
+		for line, hits := range ofc.lines {
+			fc.lines[line] += hits
+		}
+		for key, counts := range ofc.branches {
+			fc.addBranch(key, len(counts), -1)
+			for i, count := range counts {
+				fc.branches[key][i] += count
+			}
+		}
+	}
+}
+
+// writeAnnotated writes the sources with the execution count of every line,
+// ##### for lines not executed, and the counts of the branches taken below branching lines
+func (c *coverage) writeAnnotated(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	written := false
+	for _, name := range c.names {
+		fc := c.files[name]
+		if len(fc.source) == 0 {
+			continue
+		}
+		if written {
+			fmt.Fprintln(bw)
+		}
+		written = true
+		fmt.Fprintf(bw, "%s\n", name)
+		branches := fc.sortedBranches()
+		for n, text := range strings.Split(fc.source, "\n") {
+			line := n + 1
+			count := "-"
+			if hits, ok := fc.lines[line]; ok {
+				count = "#####"
+				if hits > 0 {
+					count = strconv.Itoa(hits)
+				}
+			}
+			fmt.Fprintf(bw, "%9s:%5d: %s\n", count, line, text)
+			for _, key := range branches {
+				if key.line != line {
+					continue
+				}
+				for b, taken := range fc.branches[key] {
+					fmt.Fprintf(bw, "branch %d taken %d\n", b, taken)
+				}
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// updateCoverage runs the programs dp sets up, adds their coverage to the lcov tracefile at path,
+// creating it if missing, and writes the annotated sources of the programs with the merged counts
+// to annotated unless nil
+func updateCoverage(dp *DebugParams, path string, annotated io.Writer) error {
+	r := MakeLocalRunner(nil)
+	err := r.Setup(dp)
+	if err != nil {
+		return err
+	}
+	runs, runErr := r.Trace()
+
+	c := makeCoverage()
+	for i := range runs {
+		c.addRun(&r.runs[i], &runs[i])
+	}
+	if f, err := os.Open(path); err == nil {
+		prev, err := readLcov(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		prev.merge(c)
+		c = prev
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = c.writeLcov(f)
+	if err != nil {
+		return err
+	}
+	if annotated != nil {
+		err = c.writeAnnotated(annotated)
+		if err != nil {
+			return err
+		}
+	}
+	return runErr
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+const coverageSource = `#pragma version 8
+pushint 1
+bnz yes
+err
+yes:
+pushint 2
+switch a b
+pushint 1
+return
+a:
+pushint 1
+return
+b:
+pushint 1
+return`
+
+func TestCoverage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	dp := DebugParams{
+		ProgramNames: []string{"test.teal"},
+		ProgramBlobs: [][]byte{[]byte(coverageSource)},
+		Proto:        string(protocol.ConsensusCurrentVersion),
+		RunMode:      "signature",
+	}
+	path := filepath.Join(t.TempDir(), "coverage.lcov")
+	a.NoError(updateCoverage(&dp, path, nil))
+	data, err := os.ReadFile(path)
+	a.NoError(err)
+	a.Equal(`TN:
+SF:test.teal
+BRDA:3,3,0,1
+BRDA:3,3,1,0
+BRDA:7,9,0,0
+BRDA:7,9,1,0
+BRDA:7,9,2,1
+BRF:5
+BRH:2
+DA:2,1
+DA:3,1
+DA:4,0
+DA:6,1
+DA:7,1
+DA:8,1
+DA:9,1
+DA:11,0
+DA:12,0
+DA:14,0
+DA:15,0
+LF:11
+LH:6
+end_of_record
+`, string(data))
+
+	// coverage adds up across runs
+	var annotated bytes.Buffer
+	a.NoError(updateCoverage(&dp, path, &annotated))
+	data, err = os.ReadFile(path)
+	a.NoError(err)
+	a.Contains(string(data), "BRDA:3,3,0,2\n")
+	a.Contains(string(data), "DA:2,2\n")
+	a.Contains(string(data), "DA:4,0\n")
+	a.Contains(string(data), "LF:11\nLH:6\n")
+
+	lines := strings.Split(annotated.String(), "\n")
+	a.Equal("test.teal", lines[0])
+	a.Equal("        -:    1: #pragma version 8", lines[1])
+	a.Equal("        2:    2: pushint 1", lines[2])
+	a.Equal("        2:    3: bnz yes", lines[3])
+	a.Equal("branch 0 taken 2", lines[4])
+	a.Equal("branch 1 taken 0", lines[5])
+	a.Equal("    #####:    4: err", lines[6])
+}
+
+func TestReadLcov(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	c, err := readLcov(strings.NewReader(`TN:
+SF:a.teal
+FN:1,main
+BRDA:2,4,0,-
+BRDA:2,4,1,3
+DA:1,3
+DA:2,3
+LF:2
+LH:2
+end_of_record
+SF:b.teal
+DA:1,0
+end_of_record
+`))
+	a.NoError(err)
+	a.Equal([]string{"a.teal", "b.teal"}, c.names)
+	a.Equal(map[int]int{1: 3, 2: 3}, c.files["a.teal"].lines)
+	a.Equal(map[branchKey][]int{{2, 4}: {0, 3}}, c.files["a.teal"].branches)
+	a.Equal(map[int]int{1: 0}, c.files["b.teal"].lines)
+
+	_, err = readLcov(strings.NewReader("DA:1,1\n"))
+	a.ErrorContains(err, "line 1: DA record outside of a source file")
+	_, err = readLcov(strings.NewReader("SF:a.teal\nDA:x,1\n"))
+	a.ErrorContains(err, "line 2")
+}
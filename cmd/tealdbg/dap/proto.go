@@ -0,0 +1,266 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dap
+
+import "encoding/json"
+
+// definitions of the Debug Adapter Protocol messages used by tealdbg, see
+// https://microsoft.github.io/debug-adapter-protocol/specification
+
+// ProtocolMessage is the base of requests, responses and events
+type ProtocolMessage struct {
+	Seq  int    `json:"seq"`
+	Type string `json:"type"` // "request", "response" or "event"
+}
+
+// Request is a client request, its arguments are decoded by the command handler
+type Request struct {
+	ProtocolMessage
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+// Response is the reply to a request
+type Response struct {
+	ProtocolMessage
+	RequestSeq int         `json:"request_seq"`
+	Success    bool        `json:"success"`
+	Command    string      `json:"command"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+// Event is a notification sent by the debug adapter
+type Event struct {
+	ProtocolMessage
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+// InitializeArguments type
+type InitializeArguments struct {
+	ClientID        string `json:"clientID,omitempty"`
+	AdapterID       string `json:"adapterID"`
+	LinesStartAt1   *bool  `json:"linesStartAt1,omitempty"`   // defaults to true
+	ColumnsStartAt1 *bool  `json:"columnsStartAt1,omitempty"` // defaults to true
+}
+
+// Capabilities of the debug adapter, returned by the initialize request
+type Capabilities struct {
+	SupportsConfigurationDoneRequest bool `json:"supportsConfigurationDoneRequest,omitempty"`
+	SupportsTerminateRequest         bool `json:"supportsTerminateRequest,omitempty"`
+	SupportsStepBack                 bool `json:"supportsStepBack,omitempty"`
+	SupportsGotoTargetsRequest       bool `json:"supportsGotoTargetsRequest,omitempty"`
+	SupportsDataBreakpoints          bool `json:"supportsDataBreakpoints,omitempty"`
+	SupportsConditionalBreakpoints   bool `json:"supportsConditionalBreakpoints,omitempty"`
+}
+
+// LaunchArguments are the arguments of both launch and attach requests
+type LaunchArguments struct {
+	NoDebug     bool  `json:"noDebug,omitempty"`
+	StopOnEntry *bool `json:"stopOnEntry,omitempty"` // defaults to true
+}
+
+// Source is a source shown by the client. Sources with a non-zero SourceReference are
+// retrieved with the source request.
+type Source struct {
+	Name            string `json:"name,omitempty"`
+	Path            string `json:"path,omitempty"`
+	SourceReference int    `json:"sourceReference,omitempty"`
+}
+
+// SourceBreakpoint is a breakpoint requested by the client
+type SourceBreakpoint struct {
+	Line      int    `json:"line"`
+	Column    int    `json:"column,omitempty"`
+	Condition string `json:"condition,omitempty"`
+}
+
+// SetBreakpointsArguments type
+type SetBreakpointsArguments struct {
+	Source      Source             `json:"source"`
+	Breakpoints []SourceBreakpoint `json:"breakpoints,omitempty"`
+}
+
+// Breakpoint is a breakpoint as set by the debug adapter
+type Breakpoint struct {
+	Verified bool    `json:"verified"`
+	Message  string  `json:"message,omitempty"`
+	Source   *Source `json:"source,omitempty"`
+	Line     int     `json:"line,omitempty"`
+}
+
+// SetBreakpointsResponseBody type
+type SetBreakpointsResponseBody struct {
+	Breakpoints []Breakpoint `json:"breakpoints"`
+}
+
+// DataBreakpointInfoArguments type. Name is a child of the VariablesReference container,
+// or an expression when VariablesReference is zero.
+type DataBreakpointInfoArguments struct {
+	VariablesReference int    `json:"variablesReference,omitempty"`
+	Name               string `json:"name"`
+}
+
+// DataBreakpointInfoResponseBody type. DataID is null when no data breakpoint can be set.
+type DataBreakpointInfoResponseBody struct {
+	DataID      *string  `json:"dataId"`
+	Description string   `json:"description"`
+	AccessTypes []string `json:"accessTypes,omitempty"`
+	CanPersist  bool     `json:"canPersist,omitempty"`
+}
+
+// DataBreakpoint is a data breakpoint requested by the client
+type DataBreakpoint struct {
+	DataID     string `json:"dataId"`
+	AccessType string `json:"accessType,omitempty"`
+}
+
+// SetDataBreakpointsArguments type, the response body is a SetBreakpointsResponseBody
+type SetDataBreakpointsArguments struct {
+	Breakpoints []DataBreakpoint `json:"breakpoints"`
+}
+
+// ThreadArguments are the arguments of the requests about a single thread:
+// continue, next, stepIn, stepOut, stepBack, reverseContinue and stackTrace
+type ThreadArguments struct {
+	ThreadID int `json:"threadId"`
+}
+
+// GotoTargetsArguments type
+type GotoTargetsArguments struct {
+	Source Source `json:"source"`
+	Line   int    `json:"line"`
+}
+
+// GotoTarget is a location the execution can jump to
+type GotoTarget struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+	Line  int    `json:"line"`
+}
+
+// GotoTargetsResponseBody type
+type GotoTargetsResponseBody struct {
+	Targets []GotoTarget `json:"targets"`
+}
+
+// GotoArguments type
+type GotoArguments struct {
+	ThreadID int `json:"threadId"`
+	TargetID int `json:"targetId"`
+}
+
+// ContinueResponseBody type
+type ContinueResponseBody struct {
+	AllThreadsContinued bool `json:"allThreadsContinued"`
+}
+
+// Thread type
+type Thread struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ThreadsResponseBody type
+type ThreadsResponseBody struct {
+	Threads []Thread `json:"threads"`
+}
+
+// StackFrame type
+type StackFrame struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Source *Source `json:"source,omitempty"`
+	Line   int     `json:"line"`
+	Column int     `json:"column"`
+}
+
+// StackTraceResponseBody type
+type StackTraceResponseBody struct {
+	StackFrames []StackFrame `json:"stackFrames"`
+	TotalFrames int          `json:"totalFrames"`
+}
+
+// ScopesArguments type
+type ScopesArguments struct {
+	FrameID int `json:"frameId"`
+}
+
+// Scope is a named container of variables
+type Scope struct {
+	Name               string `json:"name"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+// ScopesResponseBody type
+type ScopesResponseBody struct {
+	Scopes []Scope `json:"scopes"`
+}
+
+// VariablesArguments type
+type VariablesArguments struct {
+	VariablesReference int `json:"variablesReference"`
+}
+
+// Variable is a variable of a scope, or a container of variables itself when its
+// VariablesReference is not zero
+type Variable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+// VariablesResponseBody type
+type VariablesResponseBody struct {
+	Variables []Variable `json:"variables"`
+}
+
+// SourceArguments type
+type SourceArguments struct {
+	Source          *Source `json:"source,omitempty"`
+	SourceReference int     `json:"sourceReference"`
+}
+
+// SourceResponseBody type
+type SourceResponseBody struct {
+	Content  string `json:"content"`
+	MimeType string `json:"mimeType,omitempty"`
+}
+
+// StoppedEventBody type
+type StoppedEventBody struct {
+	Reason            string `json:"reason"` // "entry", "step", "breakpoint", "data breakpoint", "goto" or "exception"
+	Description       string `json:"description,omitempty"`
+	ThreadID          int    `json:"threadId"`
+	Text              string `json:"text,omitempty"`
+	AllThreadsStopped bool   `json:"allThreadsStopped"`
+}
+
+// ThreadEventBody type
+type ThreadEventBody struct {
+	Reason   string `json:"reason"` // "started" or "exited"
+	ThreadID int    `json:"threadId"`
+}
+
+// OutputEventBody type
+type OutputEventBody struct {
+	Category string `json:"category,omitempty"` // "console", "stdout" or "stderr"
+	Output   string `json:"output"`
+}
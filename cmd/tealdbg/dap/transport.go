@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const contentLengthHeader = "Content-Length"
+
+// maxMessageSize bounds the messages read, DAP clients send short requests
+const maxMessageSize = 1 << 20
+
+// ReadMessage reads the content of the next message of r: a header part of
+// "Name: value" lines ended by an empty line, and Content-Length bytes of JSON.
+func ReadMessage(r *bufio.Reader) ([]byte, error) {
+	length := -1
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid header line %q", line)
+		}
+		if strings.TrimSpace(name) == contentLengthHeader {
+			length, err = strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q", contentLengthHeader, value)
+			}
+		}
+	}
+	if length < 0 || length > maxMessageSize {
+		return nil, fmt.Errorf("invalid or missing %s", contentLengthHeader)
+	}
+
+	content := make([]byte, length)
+	_, err := io.ReadFull(r, content)
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// WriteMessage writes msg as a JSON message with its header
+func WriteMessage(w io.Writer, msg interface{}) error {
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s: %d\r\n\r\n%s", contentLengthHeader, len(content), content)
+	return err
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dap
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestMessages(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteMessage(&buf, Event{ProtocolMessage{1, "event"}, "initialized", nil}))
+	require.NoError(t, WriteMessage(&buf, Event{ProtocolMessage{2, "event"}, "stopped", StoppedEventBody{Reason: "entry", ThreadID: 1}}))
+	require.True(t, strings.HasPrefix(buf.String(), "Content-Length: 46\r\n\r\n"+`{"seq":1,"type":"event","event":"initialized"}Content-Length:`))
+
+	r := bufio.NewReader(&buf)
+	content, err := ReadMessage(r)
+	require.NoError(t, err)
+	require.Equal(t, `{"seq":1,"type":"event","event":"initialized"}`, string(content))
+	content, err = ReadMessage(r)
+	require.NoError(t, err)
+	require.Equal(t, `{"seq":2,"type":"event","event":"stopped","body":{"reason":"entry","threadId":1,"allThreadsStopped":false}}`, string(content))
+
+	for _, invalid := range []string{
+		"Content-Type: application/json\r\n\r\n{}",
+		"Content-Length: x\r\n\r\n{}",
+		"no header\r\n\r\n{}",
+		"Content-Length: 10\r\n\r\n{}",
+	} {
+		_, err = ReadMessage(bufio.NewReader(strings.NewReader(invalid)))
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// dapSession is a TEAL execution shown to the DAP client as a thread
+type dapSession struct {
+	mu            deadlock.Mutex
+	threadID      int
+	sid           string
+	name          string
+	debugger      Control
+	notifications chan Notification
+	// done is closed when the notifications processing is over
+	done chan struct{}
+
+	state       logic.DebugState
+	appState    AppState
+	breakpoints map[int]struct{}
+	watchpoints map[Watchpoint]struct{}
+	// paused is set while the execution waits for a continue or step request
+	paused bool
+	// reason is the reason of the next stop, set when the execution is resumed
+	reason string
+}
+
+func makeDapSession(threadID int, sid string, debugger Control, ch chan Notification) *dapSession {
+	s := new(dapSession)
+	s.threadID = threadID
+	s.sid = sid
+	s.debugger = debugger
+	s.notifications = ch
+	s.done = make(chan struct{})
+	s.breakpoints = make(map[int]struct{})
+	s.watchpoints = make(map[Watchpoint]struct{})
+
+	name, _ := debugger.GetSource()
+	if len(name) == 0 {
+		name = fmt.Sprintf("program-%d", threadID)
+	}
+	s.name = name + ".dis"
+	return s
+}
+
+func (s *dapSession) update(state logic.DebugState) {
+	appState := s.debugger.GetStates(&state)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+	s.appState = appState
+}
+
+func (s *dapSession) snapshot() (logic.DebugState, AppState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state, s.appState
+}
+
+// setPaused marks the session paused or running until a stop with reason,
+// and returns whether it was paused
+func (s *dapSession) setPaused(paused bool, reason string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	was := s.paused
+	s.paused = paused
+	s.reason = reason
+	return was
+}
+
+// stopReason is the reason of the current stop, a breakpoint unless the execution was stepped,
+// or a data breakpoint when the current opcode modifies watched app state
+func (s *dapSession) stopReason() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.reason) != 0 {
+		return s.reason
+	}
+	if change := s.state.StateChange; change != nil {
+		for wp := range s.watchpoints {
+			if wp.matches(change) {
+				return "data breakpoint"
+			}
+		}
+	}
+	return "breakpoint"
+}
+
+// setBreakpoints replaces the breakpoints of the session by the ones with zero based lines,
+// returning the error of each breakpoint that can't be set
+func (s *dapSession) setBreakpoints(breakpoints []dap.SourceBreakpoint) []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for line := range s.breakpoints {
+		s.debugger.RemoveBreakpoint(line)
+	}
+	s.breakpoints = make(map[int]struct{}, len(breakpoints))
+
+	errs := make([]error, len(breakpoints))
+	for i, bp := range breakpoints {
+		errs[i] = s.debugger.SetConditionalBreakpoint(bp.Line, bp.Condition)
+		if errs[i] == nil {
+			s.breakpoints[bp.Line] = struct{}{}
+		}
+	}
+	return errs
+}
+
+// setWatchpoints replaces the watchpoints of the session, returning the error of each
+// watchpoint that can't be set
+func (s *dapSession) setWatchpoints(watchpoints []Watchpoint) []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for wp := range s.watchpoints {
+		s.debugger.RemoveWatchpoint(wp)
+	}
+	s.watchpoints = make(map[Watchpoint]struct{}, len(watchpoints))
+
+	errs := make([]error, len(watchpoints))
+	for i, wp := range watchpoints {
+		errs[i] = s.debugger.SetWatchpoint(wp)
+		if errs[i] == nil {
+			s.watchpoints[wp] = struct{}{}
+		}
+	}
+	return errs
+}
+
+func (s *dapSession) source() *dap.Source {
+	return &dap.Source{Name: s.name, SourceReference: s.threadID}
+}
+
+// stackFrames lists the frames from the innermost, the current line, to the outermost program frame.
+// Lines are zero based.
+func (s *dapSession) stackFrames() []dap.StackFrame {
+	state, _ := s.snapshot()
+	return s.callFrames(state.Line, state.CallStack)
+}
+
+// callFrames lists the frames of the program at line with callStack, from the innermost
+func (s *dapSession) callFrames(line int, callStack []logic.CallFrame) (frames []dap.StackFrame) {
+	name := func(depth int) string {
+		if depth == 0 {
+			return "main"
+		}
+		return callStack[depth-1].LabelName
+	}
+
+	depth := len(callStack)
+	frames = append(frames, dap.StackFrame{Name: name(depth), Line: line})
+	for depth > 0 {
+		depth--
+		frames = append(frames, dap.StackFrame{Name: name(depth), Line: callStack[depth].FrameLine})
+	}
+	for i := range frames {
+		frames[i].ID = dapFrameID(s.threadID, i)
+		frames[i].Source = s.source()
+	}
+	return
+}
+
+// dapScope is a scope with a function listing its variables
+type dapScope struct {
+	name      string
+	variables func(handle dapHandleFunc) []dap.Variable
+	// watch makes the watchpoint of a variable, it is nil when the variables can't be watched
+	watch func(name string) Watchpoint
+}
+
+// dapHandleFunc registers a scope of variables and returns the reference to it
+type dapHandleFunc func(scope dapScope) int
+
+// scopes lists the scopes of the current state of the session
+func (s *dapSession) scopes() []dapScope {
+	state, appState := s.snapshot()
+
+	scopes := []dapScope{
+		{"Stack", fieldsScope(prepareArray(state.Stack)), nil},
+		{"Scratch", fieldsScope(prepareArray(state.Scratch)), nil},
+	}
+	if state.GroupIndex < len(state.TxnGroup) {
+		txn := &state.TxnGroup[state.GroupIndex].Txn
+		scopes = append(scopes, dapScope{"Transaction", fieldsScope(prepareTxn(txn, state.GroupIndex, false)), nil})
+	}
+	scopes = append(scopes, dapScope{"Global fields", fieldsScope(prepareGlobals(state.Globals)), nil})
+
+	if appState.appIdx != 0 {
+		scopes = append(scopes, dapScope{"App global state", fieldsScope(tkvToFieldDescs(appState.global[appState.appIdx])), func(name string) Watchpoint {
+			return Watchpoint{State: logic.GlobalState, Key: name}
+		}})
+		scopes = append(scopes, dapScope{"App local state", func(handle dapHandleFunc) []dap.Variable {
+			addrs := make([]basics.Address, 0, len(appState.locals))
+			for addr, locals := range appState.locals {
+				if _, ok := locals[appState.appIdx]; ok {
+					addrs = append(addrs, addr)
+				}
+			}
+			sort.Slice(addrs, func(i, j int) bool { return addrs[i].String() < addrs[j].String() })
+
+			vars := make([]dap.Variable, 0, len(addrs))
+			for _, addr := range addrs {
+				tkv := appState.locals[addr][appState.appIdx]
+				account := dapScope{variables: fieldsScope(tkvToFieldDescs(tkv)), watch: func(name string) Watchpoint {
+					return Watchpoint{State: logic.LocalState, Account: addr, Key: name}
+				}}
+				vars = append(vars, dap.Variable{
+					Name:               addr.String(),
+					Value:              fmt.Sprintf("%d keys", len(tkv)),
+					VariablesReference: handle(account),
+				})
+			}
+			return vars
+		}, nil})
+		scopes = append(scopes, dapScope{"Logs", fieldsScope(prepareStringArray(appState.logs)), nil})
+	}
+
+	if len(state.Error) > 0 {
+		scopes = append(scopes, dapScope{"Error", fieldsScope([]fieldDesc{{"message", state.Error, "string"}}), nil})
+	}
+	return scopes
+}
+
+func fieldsScope(fields []fieldDesc) func(dapHandleFunc) []dap.Variable {
+	return func(dapHandleFunc) []dap.Variable {
+		vars := make([]dap.Variable, len(fields))
+		for i, field := range fields {
+			vars[i] = dap.Variable{Name: field.Name, Value: field.Value, Type: field.Type}
+		}
+		return vars
+	}
+}
+
+func tkvToFieldDescs(tkv basics.TealKeyValue) []fieldDesc {
+	fields := make([]fieldDesc, 0, len(tkv))
+	for key, value := range tkv {
+		fields = append(fields, tealValueToFieldDesc(key, basics.TealValue{Type: value.Type, Uint: value.Uint, Bytes: value.Bytes}))
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	return fields
+}
+
+// dapFramesPerThread bounds the call stack depth shown, frame IDs encode the thread they belong to
+const dapFramesPerThread = 1 << 16
+
+func dapFrameID(threadID int, depth int) int {
+	return threadID*dapFramesPerThread + depth
+}
+
+func dapFrameThread(frameID int) int {
+	return frameID / dapFramesPerThread
+}
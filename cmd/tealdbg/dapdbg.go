@@ -0,0 +1,750 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+)
+
+// dapDisconnectTimeout is how long WaitForCompletion waits for the client
+// to disconnect once it is told the debugging is over
+const dapDisconnectTimeout = 5 * time.Second
+
+// DapFrontend is Debug Adapter Protocol frontend for VS Code and other DAP capable editors.
+// Each TEAL execution is a thread of the client, showing the program disassembly.
+type DapFrontend struct {
+	mu       deadlock.Mutex
+	listener net.Listener
+	verbose  bool
+
+	client *dapClient
+	// configured is closed when the client has set its breakpoints, sessions wait for it
+	configured chan struct{}
+
+	sessions     map[string]*dapSession
+	threads      map[int]*dapSession
+	nextThreadID int
+	latestSid    string
+	// breakpoints are the breakpoints by source name, with zero based lines, set in the later
+	// executions of the source
+	breakpoints map[string][]dap.SourceBreakpoint
+	// watchpoints are the data breakpoints, set in every execution
+	watchpoints []Watchpoint
+
+	handles    map[int]dapHandle
+	nextHandle int
+}
+
+// DapFrontendParams for Setup
+type DapFrontendParams struct {
+	address string
+	verbose bool
+}
+
+// dapHandle is a variables reference of a paused thread
+type dapHandle struct {
+	threadID int
+	scope    dapScope
+}
+
+// dapClient is a connected DAP client
+type dapClient struct {
+	mu     deadlock.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+	seq    int
+	closed chan struct{}
+
+	// lineBase and columnBase are the numbers of the first line and column for the client
+	lineBase    int
+	columnBase  int
+	stopOnEntry bool
+}
+
+// MakeDapFrontend creates new DapFrontend listening for DAP clients on params.address
+func MakeDapFrontend(params *DapFrontendParams) (a *DapFrontend, err error) {
+	a = new(DapFrontend)
+	a.verbose = params.verbose
+	a.configured = make(chan struct{})
+	a.sessions = make(map[string]*dapSession)
+	a.threads = make(map[int]*dapSession)
+	a.breakpoints = make(map[string][]dap.SourceBreakpoint)
+	a.handles = make(map[int]dapHandle)
+
+	a.listener, err = net.Listen("tcp", params.address)
+	if err != nil {
+		return nil, err
+	}
+	log.Println("------------------------------------------------")
+	log.Printf("DAP debugger listening on: %s", a.listener.Addr())
+	log.Println("------------------------------------------------")
+
+	go a.serve()
+	return a, nil
+}
+
+// SessionStarted registers new session
+func (a *DapFrontend) SessionStarted(sid string, debugger Control, ch chan Notification) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.nextThreadID++
+	s := makeDapSession(a.nextThreadID, sid, debugger, ch)
+	a.sessions[sid] = s
+	a.threads[s.threadID] = s
+	a.latestSid = sid
+
+	go a.runSession(s)
+}
+
+// SessionEnded removes the session once its completion is reported to the client
+func (a *DapFrontend) SessionEnded(sid string) {
+	a.mu.Lock()
+	s, ok := a.sessions[sid]
+	a.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	go func() {
+		<-s.done
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		delete(a.threads, s.threadID)
+		a.releaseHandles(s.threadID)
+		delete(a.sessions, sid)
+	}()
+}
+
+// WaitForCompletion returns when no sessions are left and the client, if any,
+// disconnected after being told the debugging is over
+func (a *DapFrontend) WaitForCompletion() {
+	for {
+		a.mu.Lock()
+		active := len(a.sessions)
+		client := a.client
+		a.mu.Unlock()
+		if active == 0 {
+			if client != nil {
+				client.event("terminated", nil)
+				select {
+				case <-client.closed:
+				case <-time.After(dapDisconnectTimeout):
+				}
+			}
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// URL returns the address DAP clients connect to, once there is a session to debug
+func (a *DapFrontend) URL() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.latestSid) == 0 {
+		return ""
+	}
+	return "tcp://" + a.listener.Addr().String()
+}
+
+// serve accepts the DAP clients, one at a time
+func (a *DapFrontend) serve() {
+	for {
+		conn, err := a.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Printf("DAP listener error: %v", err)
+			}
+			return
+		}
+		a.serveClient(conn)
+	}
+}
+
+func (a *DapFrontend) serveClient(conn net.Conn) {
+	client := &dapClient{
+		conn:        conn,
+		reader:      bufio.NewReader(conn),
+		closed:      make(chan struct{}),
+		lineBase:    1,
+		columnBase:  1,
+		stopOnEntry: true,
+	}
+	a.mu.Lock()
+	a.client = client
+	a.mu.Unlock()
+
+	defer func() {
+		a.detach()
+		conn.Close()
+		close(client.closed)
+	}()
+
+	for {
+		content, err := dap.ReadMessage(client.reader)
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("DAP client error: %v", err)
+			}
+			return
+		}
+		var req dap.Request
+		err = json.Unmarshal(content, &req)
+		if err != nil {
+			log.Printf("DAP client sent an invalid message: %v", err)
+			continue
+		}
+		if req.Type != "request" {
+			continue
+		}
+		if a.verbose {
+			log.Printf("DAP request: %s %s\n", req.Command, req.Arguments)
+		}
+
+		body, after, err := a.handleRequest(client, &req)
+		client.respond(&req, body, err)
+		if after != nil {
+			after()
+		}
+		if req.Command == "disconnect" {
+			return
+		}
+	}
+}
+
+// detach forgets the client and lets the paused sessions run without breakpoints
+func (a *DapFrontend) detach() {
+	a.mu.Lock()
+	a.client = nil
+	select {
+	case <-a.configured:
+		a.configured = make(chan struct{})
+	default:
+	}
+	sessions := make([]*dapSession, 0, len(a.sessions))
+	for _, s := range a.sessions {
+		sessions = append(sessions, s)
+	}
+	a.handles = make(map[int]dapHandle)
+	a.mu.Unlock()
+
+	for _, s := range sessions {
+		s.debugger.SetBreakpointsActive(false)
+		if s.setPaused(false, "") {
+			s.debugger.Resume()
+		}
+	}
+}
+
+// waitForClient returns the client once it has been configured
+func (a *DapFrontend) waitForClient() *dapClient {
+	for {
+		a.mu.Lock()
+		configured := a.configured
+		a.mu.Unlock()
+		<-configured
+
+		a.mu.Lock()
+		client := a.client
+		a.mu.Unlock()
+		if client != nil {
+			return client
+		}
+	}
+}
+
+func (a *DapFrontend) currentClient() *dapClient {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.client
+}
+
+// runSession processes the debugger notifications of the session
+func (a *DapFrontend) runSession(s *dapSession) {
+	defer close(s.done)
+	for notification := range s.notifications {
+		if a.verbose {
+			log.Printf("received: %s\n", notification.Event)
+		}
+
+		switch notification.Event {
+		case "registered":
+			client := a.waitForClient()
+			s.update(notification.DebugState)
+
+			a.mu.Lock()
+			breakpoints := a.breakpoints[s.name]
+			watchpoints := a.watchpoints
+			a.mu.Unlock()
+			s.setBreakpoints(breakpoints)
+			s.setWatchpoints(watchpoints)
+
+			client.event("thread", dap.ThreadEventBody{Reason: "started", ThreadID: s.threadID})
+			if len(notification.DebugState.Callers) > 0 {
+				// inner transaction programs pause on entry only when stepped into
+				if s.debugger.SteppedInto() {
+					a.stop(client, s, "step", "")
+				} else {
+					s.debugger.Resume()
+				}
+			} else if client.stopOnEntry {
+				a.stop(client, s, "entry", "")
+			} else {
+				s.debugger.Resume()
+			}
+		case "updated":
+			s.update(notification.DebugState)
+			client := a.currentClient()
+			if client == nil {
+				// the client is gone, let the execution complete
+				s.debugger.SetBreakpointsActive(false)
+				s.debugger.Resume()
+				continue
+			}
+			if len(notification.DebugState.Error) > 0 {
+				a.stop(client, s, "exception", notification.DebugState.Error)
+			} else {
+				a.stop(client, s, s.stopReason(), "")
+			}
+		case "completed":
+			s.update(notification.DebugState)
+			client := a.currentClient()
+			if client != nil {
+				output := fmt.Sprintf("%s completed\n", s.name)
+				category := "console"
+				if len(notification.DebugState.Error) > 0 {
+					output = fmt.Sprintf("%s failed: %s\n", s.name, notification.DebugState.Error)
+					category = "stderr"
+				}
+				client.event("output", dap.OutputEventBody{Category: category, Output: output})
+				client.event("thread", dap.ThreadEventBody{Reason: "exited", ThreadID: s.threadID})
+			}
+			return
+		default:
+			log.Println("Unk event: " + notification.Event)
+		}
+	}
+}
+
+func (a *DapFrontend) stop(client *dapClient, s *dapSession, reason string, text string) {
+	s.setPaused(true, "")
+	client.event("stopped", dap.StoppedEventBody{Reason: reason, ThreadID: s.threadID, Text: text})
+}
+
+// callerFrames lists the frames of the programs waiting for the inner transaction of s,
+// from the innermost. Their frames refer to the threads of the callers.
+func (a *DapFrontend) callerFrames(s *dapSession) (frames []dap.StackFrame) {
+	state, _ := s.snapshot()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := len(state.Callers) - 1; i >= 0; i-- {
+		caller := state.Callers[i]
+		if cs, ok := a.sessions[caller.ExecID]; ok {
+			frames = append(frames, cs.callFrames(caller.Line, caller.CallStack)...)
+		}
+	}
+	return
+}
+
+func (a *DapFrontend) thread(threadID int) (*dapSession, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	s, ok := a.threads[threadID]
+	if !ok {
+		return nil, fmt.Errorf("unknown thread %d", threadID)
+	}
+	return s, nil
+}
+
+// newHandle must be called with a.mu locked
+func (a *DapFrontend) newHandle(threadID int, scope dapScope) int {
+	a.nextHandle++
+	a.handles[a.nextHandle] = dapHandle{threadID, scope}
+	return a.nextHandle
+}
+
+// releaseHandles invalidates the variables references of a resumed thread, it must be called with a.mu locked
+func (a *DapFrontend) releaseHandles(threadID int) {
+	for ref, handle := range a.handles {
+		if handle.threadID == threadID {
+			delete(a.handles, ref)
+		}
+	}
+}
+
+// resume runs a paused session, or moves it through its recorded steps, with move
+// until the next stop with reason
+func (a *DapFrontend) resume(s *dapSession, reason string, move func() error) error {
+	s.setPaused(false, reason)
+	err := move()
+	if err != nil {
+		s.setPaused(true, "")
+		return err
+	}
+
+	a.mu.Lock()
+	a.releaseHandles(s.threadID)
+	a.mu.Unlock()
+	return nil
+}
+
+// forward makes a Control function running the execution a move function for resume
+func forward(run func()) func() error {
+	return func() error {
+		run()
+		return nil
+	}
+}
+
+// handleRequest handles a request and returns the body of the response, and
+// a function to call once the response is sent, if any
+func (a *DapFrontend) handleRequest(client *dapClient, req *dap.Request) (body interface{}, after func(), err error) {
+	decode := func(args interface{}) error {
+		if len(req.Arguments) == 0 {
+			return nil
+		}
+		return json.Unmarshal(req.Arguments, args)
+	}
+
+	switch req.Command {
+	case "initialize":
+		var args dap.InitializeArguments
+		if err = decode(&args); err != nil {
+			return
+		}
+		if args.LinesStartAt1 != nil && !*args.LinesStartAt1 {
+			client.lineBase = 0
+		}
+		if args.ColumnsStartAt1 != nil && !*args.ColumnsStartAt1 {
+			client.columnBase = 0
+		}
+		body = dap.Capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsStepBack:                 true,
+			SupportsGotoTargetsRequest:       true,
+			SupportsDataBreakpoints:          true,
+			SupportsConditionalBreakpoints:   true,
+		}
+		after = func() { client.event("initialized", nil) }
+	case "launch", "attach":
+		var args dap.LaunchArguments
+		if err = decode(&args); err != nil {
+			return
+		}
+		if args.StopOnEntry != nil {
+			client.stopOnEntry = *args.StopOnEntry
+		}
+	case "configurationDone":
+		a.mu.Lock()
+		select {
+		case <-a.configured:
+		default:
+			close(a.configured)
+		}
+		a.mu.Unlock()
+	case "setBreakpoints":
+		var args dap.SetBreakpointsArguments
+		if err = decode(&args); err != nil {
+			return
+		}
+		body = a.setBreakpoints(client, &args)
+	case "dataBreakpointInfo":
+		var args dap.DataBreakpointInfoArguments
+		if err = decode(&args); err != nil {
+			return
+		}
+		body = a.dataBreakpointInfo(&args)
+	case "setDataBreakpoints":
+		var args dap.SetDataBreakpointsArguments
+		if err = decode(&args); err != nil {
+			return
+		}
+		body = a.setDataBreakpoints(&args)
+	case "setExceptionBreakpoints":
+		body = dap.SetBreakpointsResponseBody{Breakpoints: []dap.Breakpoint{}}
+	case "threads":
+		threads := make([]dap.Thread, 0)
+		a.mu.Lock()
+		for id := 1; id <= a.nextThreadID; id++ {
+			if s, ok := a.threads[id]; ok {
+				threads = append(threads, dap.Thread{ID: id, Name: s.name})
+			}
+		}
+		a.mu.Unlock()
+		body = dap.ThreadsResponseBody{Threads: threads}
+	case "stackTrace":
+		var args dap.ThreadArguments
+		var s *dapSession
+		if err = decode(&args); err != nil {
+			return
+		}
+		if s, err = a.thread(args.ThreadID); err != nil {
+			return
+		}
+		frames := append(s.stackFrames(), a.callerFrames(s)...)
+		for i := range frames {
+			frames[i].Line += client.lineBase
+			frames[i].Column = client.columnBase
+		}
+		body = dap.StackTraceResponseBody{StackFrames: frames, TotalFrames: len(frames)}
+	case "scopes":
+		var args dap.ScopesArguments
+		var s *dapSession
+		if err = decode(&args); err != nil {
+			return
+		}
+		if s, err = a.thread(dapFrameThread(args.FrameID)); err != nil {
+			return
+		}
+		scopes := s.scopes()
+		result := make([]dap.Scope, len(scopes))
+		a.mu.Lock()
+		for i, scope := range scopes {
+			result[i] = dap.Scope{Name: scope.name, VariablesReference: a.newHandle(s.threadID, scope)}
+		}
+		a.mu.Unlock()
+		body = dap.ScopesResponseBody{Scopes: result}
+	case "variables":
+		var args dap.VariablesArguments
+		if err = decode(&args); err != nil {
+			return
+		}
+		a.mu.Lock()
+		handle, ok := a.handles[args.VariablesReference]
+		a.mu.Unlock()
+		if !ok {
+			err = fmt.Errorf("unknown variables reference %d", args.VariablesReference)
+			return
+		}
+		newHandle := func(scope dapScope) int {
+			a.mu.Lock()
+			defer a.mu.Unlock()
+			return a.newHandle(handle.threadID, scope)
+		}
+		body = dap.VariablesResponseBody{Variables: handle.scope.variables(newHandle)}
+	case "source":
+		var args dap.SourceArguments
+		var s *dapSession
+		if err = decode(&args); err != nil {
+			return
+		}
+		ref := args.SourceReference
+		if args.Source != nil && args.Source.SourceReference != 0 {
+			ref = args.Source.SourceReference
+		}
+		if s, err = a.thread(ref); err != nil {
+			return
+		}
+		state, _ := s.snapshot()
+		body = dap.SourceResponseBody{Content: state.Disassembly, MimeType: "text/x-teal"}
+	case "continue", "next", "stepIn", "stepOut", "stepBack", "reverseContinue":
+		var args dap.ThreadArguments
+		var s *dapSession
+		if err = decode(&args); err != nil {
+			return
+		}
+		if s, err = a.thread(args.ThreadID); err != nil {
+			return
+		}
+		switch req.Command {
+		case "continue":
+			body = dap.ContinueResponseBody{}
+			after = func() { a.resume(s, "", forward(s.debugger.Resume)) }
+		case "next":
+			after = func() { a.resume(s, "step", forward(s.debugger.StepOver)) }
+		case "stepIn":
+			after = func() { a.resume(s, "step", forward(s.debugger.Step)) }
+		case "stepOut":
+			after = func() { a.resume(s, "step", forward(s.debugger.StepOut)) }
+		case "stepBack":
+			// going back doesn't run the execution and fails when there is no recorded step to go to
+			err = a.resume(s, "step", s.debugger.StepBack)
+		case "reverseContinue":
+			err = a.resume(s, "", s.debugger.ReverseResume)
+		}
+	case "gotoTargets":
+		var args dap.GotoTargetsArguments
+		if err = decode(&args); err != nil {
+			return
+		}
+		// the target is the latest recorded step at the line, its id is the line
+		line := args.Line - client.lineBase
+		body = dap.GotoTargetsResponseBody{Targets: []dap.GotoTarget{
+			{ID: line + 1, Label: fmt.Sprintf("latest step at line %d", args.Line), Line: args.Line},
+		}}
+	case "goto":
+		var args dap.GotoArguments
+		var s *dapSession
+		if err = decode(&args); err != nil {
+			return
+		}
+		if s, err = a.thread(args.ThreadID); err != nil {
+			return
+		}
+		err = a.resume(s, "goto", func() error { return s.debugger.GotoLine(args.TargetID - 1) })
+	case "disconnect":
+	default:
+		err = fmt.Errorf("unsupported request %s", req.Command)
+	}
+	return
+}
+
+// setBreakpoints sets the breakpoints of a source: the disassembly of an execution,
+// and of the later executions of the same program
+func (a *DapFrontend) setBreakpoints(client *dapClient, args *dap.SetBreakpointsArguments) dap.SetBreakpointsResponseBody {
+	result := make([]dap.Breakpoint, len(args.Breakpoints))
+
+	a.mu.Lock()
+	s, ok := a.threads[args.Source.SourceReference]
+	a.mu.Unlock()
+	if !ok || args.Source.SourceReference == 0 {
+		for i, bp := range args.Breakpoints {
+			result[i] = dap.Breakpoint{Line: bp.Line, Message: "breakpoints can only be set in the disassembly of a running program"}
+		}
+		return dap.SetBreakpointsResponseBody{Breakpoints: result}
+	}
+
+	breakpoints := make([]dap.SourceBreakpoint, len(args.Breakpoints))
+	for i, bp := range args.Breakpoints {
+		breakpoints[i] = bp
+		breakpoints[i].Line -= client.lineBase
+	}
+	errs := s.setBreakpoints(breakpoints)
+
+	verified := make([]dap.SourceBreakpoint, 0, len(breakpoints))
+	for i, bp := range args.Breakpoints {
+		result[i] = dap.Breakpoint{Verified: errs[i] == nil, Source: s.source(), Line: bp.Line}
+		if errs[i] != nil {
+			result[i].Message = errs[i].Error()
+		} else {
+			verified = append(verified, breakpoints[i])
+		}
+	}
+
+	a.mu.Lock()
+	a.breakpoints[s.name] = verified
+	a.mu.Unlock()
+	return dap.SetBreakpointsResponseBody{Breakpoints: result}
+}
+
+// dataBreakpointInfo makes the data breakpoint of an app state variable, or of a watchpoint
+// expression in the global:KEY, local:[ADDR]:KEY or box:NAME form. Its id is the watchpoint expression.
+func (a *DapFrontend) dataBreakpointInfo(args *dap.DataBreakpointInfoArguments) dap.DataBreakpointInfoResponseBody {
+	var wp Watchpoint
+	if args.VariablesReference != 0 {
+		a.mu.Lock()
+		handle, ok := a.handles[args.VariablesReference]
+		a.mu.Unlock()
+		if !ok || handle.scope.watch == nil {
+			return dap.DataBreakpointInfoResponseBody{Description: "only app state keys can be watched"}
+		}
+		wp = handle.scope.watch(args.Name)
+	} else {
+		var err error
+		wp, err = parseWatchpoint(args.Name)
+		if err != nil {
+			return dap.DataBreakpointInfoResponseBody{Description: err.Error()}
+		}
+	}
+
+	id := wp.String()
+	return dap.DataBreakpointInfoResponseBody{
+		DataID:      &id,
+		Description: id,
+		AccessTypes: []string{"write"},
+		CanPersist:  true,
+	}
+}
+
+// setDataBreakpoints replaces the watchpoints of all executions
+func (a *DapFrontend) setDataBreakpoints(args *dap.SetDataBreakpointsArguments) dap.SetBreakpointsResponseBody {
+	result := make([]dap.Breakpoint, len(args.Breakpoints))
+	watchpoints := make([]Watchpoint, 0, len(args.Breakpoints))
+	for i, bp := range args.Breakpoints {
+		wp, err := parseWatchpoint(bp.DataID)
+		if err == nil {
+			err = wp.validate()
+		}
+		if err != nil {
+			result[i].Message = err.Error()
+			continue
+		}
+		result[i].Verified = true
+		watchpoints = append(watchpoints, wp)
+	}
+
+	a.mu.Lock()
+	a.watchpoints = watchpoints
+	sessions := make([]*dapSession, 0, len(a.sessions))
+	for _, s := range a.sessions {
+		sessions = append(sessions, s)
+	}
+	a.mu.Unlock()
+
+	for _, s := range sessions {
+		s.setWatchpoints(watchpoints)
+	}
+	return dap.SetBreakpointsResponseBody{Breakpoints: result}
+}
+
+func (c *dapClient) write(msg interface{}) {
+	err := dap.WriteMessage(c.conn, msg)
+	if err != nil {
+		log.Printf("DAP client write error: %v", err)
+	}
+}
+
+func (c *dapClient) respond(req *dap.Request, body interface{}, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	resp := dap.Response{
+		ProtocolMessage: dap.ProtocolMessage{Seq: c.seq, Type: "response"},
+		RequestSeq:      req.Seq,
+		Success:         err == nil,
+		Command:         req.Command,
+		Body:            body,
+	}
+	if err != nil {
+		resp.Message = err.Error()
+	}
+	c.write(&resp)
+}
+
+func (c *dapClient) event(event string, body interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	c.write(&dap.Event{
+		ProtocolMessage: dap.ProtocolMessage{Seq: c.seq, Type: "event"},
+		Event:           event,
+		Body:            body,
+	})
+}
@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type dapTestClient struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *bufio.Reader
+	seq    int
+	events []string
+}
+
+type dapTestMessage struct {
+	Type    string          `json:"type"`
+	Command string          `json:"command"`
+	Event   string          `json:"event"`
+	Success bool            `json:"success"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// call sends a request and returns the body of its response, recording the events received meanwhile
+func (c *dapTestClient) call(command string, args interface{}, body interface{}) {
+	c.seq++
+	req := map[string]interface{}{"seq": c.seq, "type": "request", "command": command, "arguments": args}
+	require.NoError(c.t, dap.WriteMessage(c.conn, req))
+	msg := c.wait(func(msg *dapTestMessage) bool { return msg.Type == "response" && msg.Command == command })
+	require.True(c.t, msg.Success, command)
+	if body != nil {
+		require.NoError(c.t, json.Unmarshal(msg.Body, body))
+	}
+}
+
+// event waits for the next event named event and decodes its body
+func (c *dapTestClient) event(event string, body interface{}) {
+	msg := c.wait(func(msg *dapTestMessage) bool { return msg.Type == "event" && msg.Event == event })
+	if body != nil {
+		require.NoError(c.t, json.Unmarshal(msg.Body, body))
+	}
+}
+
+func (c *dapTestClient) wait(match func(msg *dapTestMessage) bool) *dapTestMessage {
+	for {
+		content, err := dap.ReadMessage(c.reader)
+		require.NoError(c.t, err)
+		var msg dapTestMessage
+		require.NoError(c.t, json.Unmarshal(content, &msg))
+		if msg.Type == "event" {
+			c.events = append(c.events, msg.Event)
+		}
+		if match(&msg) {
+			return &msg
+		}
+	}
+}
+
+func TestDapFrontend(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a, err := MakeDapFrontend(&DapFrontendParams{address: "127.0.0.1:0"})
+	require.NoError(t, err)
+	defer a.listener.Close()
+	require.Empty(t, a.URL())
+
+	debugger := MakeDebugger()
+	debugger.AddAdapter(a)
+
+	proto := config.Consensus[protocol.ConsensusV18]
+	ops, err := logic.AssembleStringWithVersion("int 0; int 1; +; int 1; ==", 1)
+	require.NoError(t, err)
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+
+	evalDone := make(chan error)
+	go func() {
+		ep := logic.NewSigEvalParams([]transactions.SignedTxn{txn}, &proto, logic.NoHeaderLedger{})
+		ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(debugger)
+		_, err := logic.EvalSignature(0, ep)
+		a.WaitForCompletion()
+		evalDone <- err
+	}()
+
+	conn, err := net.Dial("tcp", a.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	c := &dapTestClient{t: t, conn: conn, reader: bufio.NewReader(conn)}
+
+	var capabilities dap.Capabilities
+	c.call("initialize", dap.InitializeArguments{AdapterID: "teal"}, &capabilities)
+	require.True(t, capabilities.SupportsConfigurationDoneRequest)
+	require.True(t, capabilities.SupportsStepBack)
+	require.True(t, capabilities.SupportsDataBreakpoints)
+	require.True(t, capabilities.SupportsConditionalBreakpoints)
+	c.event("initialized", nil)
+	c.call("attach", map[string]interface{}{"stopOnEntry": true}, nil)
+	c.call("configurationDone", nil, nil)
+
+	var stopped dap.StoppedEventBody
+	c.event("stopped", &stopped)
+	require.Equal(t, "entry", stopped.Reason)
+	threadID := stopped.ThreadID
+	require.Contains(t, c.events, "thread")
+	require.NotEmpty(t, a.URL())
+
+	var threads dap.ThreadsResponseBody
+	c.call("threads", nil, &threads)
+	require.Equal(t, []dap.Thread{{ID: threadID, Name: "program-1.dis"}}, threads.Threads)
+
+	var source dap.SourceResponseBody
+	c.call("source", dap.SourceArguments{SourceReference: threadID}, &source)
+	require.Equal(t, "#pragma version 1\nintcblock 0 1\nintc_0 // 0\nintc_1 // 1\n+\nintc_1 // 1\n==\n", source.Content)
+
+	// "+" is on the fifth line, and there is no hundredth line
+	var breakpoints dap.SetBreakpointsResponseBody
+	args := dap.SetBreakpointsArguments{
+		Source:      dap.Source{Name: "program-1.dis", SourceReference: threadID},
+		Breakpoints: []dap.SourceBreakpoint{{Line: 5}, {Line: 100}},
+	}
+	c.call("setBreakpoints", args, &breakpoints)
+	require.Len(t, breakpoints.Breakpoints, 2)
+	require.True(t, breakpoints.Breakpoints[0].Verified)
+	require.False(t, breakpoints.Breakpoints[1].Verified)
+
+	c.call("continue", dap.ThreadArguments{ThreadID: threadID}, nil)
+	c.event("stopped", &stopped)
+	require.Equal(t, "breakpoint", stopped.Reason)
+
+	var stackTrace dap.StackTraceResponseBody
+	c.call("stackTrace", dap.ThreadArguments{ThreadID: threadID}, &stackTrace)
+	require.Len(t, stackTrace.StackFrames, 1)
+	frame := stackTrace.StackFrames[0]
+	require.Equal(t, "main", frame.Name)
+	require.Equal(t, 5, frame.Line)
+	require.Equal(t, threadID, frame.Source.SourceReference)
+
+	var scopes dap.ScopesResponseBody
+	c.call("scopes", dap.ScopesArguments{FrameID: frame.ID}, &scopes)
+	names := make([]string, len(scopes.Scopes))
+	for i, scope := range scopes.Scopes {
+		names[i] = scope.Name
+	}
+	require.Equal(t, []string{"Stack", "Scratch", "Transaction", "Global fields"}, names)
+
+	var variables dap.VariablesResponseBody
+	c.call("variables", dap.VariablesArguments{VariablesReference: scopes.Scopes[0].VariablesReference}, &variables)
+	require.Equal(t, []dap.Variable{{Name: "0", Value: "0", Type: "bigint"}, {Name: "1", Value: "1", Type: "bigint"}}, variables.Variables)
+
+	// app state can be watched, the stack can't
+	var info dap.DataBreakpointInfoResponseBody
+	c.call("dataBreakpointInfo", dap.DataBreakpointInfoArguments{VariablesReference: scopes.Scopes[0].VariablesReference, Name: "0"}, &info)
+	require.Nil(t, info.DataID)
+	c.call("dataBreakpointInfo", dap.DataBreakpointInfoArguments{Name: "box:b64:AAE="}, &info)
+	require.NotNil(t, info.DataID)
+	require.Equal(t, "box:b64:AAE=", *info.DataID)
+	require.Equal(t, []string{"write"}, info.AccessTypes)
+
+	var dataBreakpoints dap.SetBreakpointsResponseBody
+	dataArgs := dap.SetDataBreakpointsArguments{Breakpoints: []dap.DataBreakpoint{{DataID: *info.DataID}, {DataID: "stack:0"}}}
+	c.call("setDataBreakpoints", dataArgs, &dataBreakpoints)
+	require.Len(t, dataBreakpoints.Breakpoints, 2)
+	require.True(t, dataBreakpoints.Breakpoints[0].Verified)
+	require.False(t, dataBreakpoints.Breakpoints[1].Verified)
+
+	c.call("next", dap.ThreadArguments{ThreadID: threadID}, nil)
+	c.event("stopped", &stopped)
+	require.Equal(t, "step", stopped.Reason)
+	c.call("stackTrace", dap.ThreadArguments{ThreadID: threadID}, &stackTrace)
+	require.Equal(t, 6, stackTrace.StackFrames[0].Line)
+
+	// the variables of the previous stop are gone
+	c.seq++
+	require.NoError(t, dap.WriteMessage(conn, map[string]interface{}{
+		"seq": c.seq, "type": "request", "command": "variables",
+		"arguments": dap.VariablesArguments{VariablesReference: scopes.Scopes[0].VariablesReference},
+	}))
+	msg := c.wait(func(msg *dapTestMessage) bool { return msg.Type == "response" })
+	require.False(t, msg.Success)
+
+	// going back shows the recorded steps, and continuing goes forward through them first
+	c.call("stepBack", dap.ThreadArguments{ThreadID: threadID}, nil)
+	c.event("stopped", &stopped)
+	require.Equal(t, "step", stopped.Reason)
+	c.call("stackTrace", dap.ThreadArguments{ThreadID: threadID}, &stackTrace)
+	require.Equal(t, 5, stackTrace.StackFrames[0].Line)
+
+	var targets dap.GotoTargetsResponseBody
+	c.call("gotoTargets", dap.GotoTargetsArguments{Source: args.Source, Line: 3}, &targets)
+	require.Len(t, targets.Targets, 1)
+	c.call("goto", dap.GotoArguments{ThreadID: threadID, TargetID: targets.Targets[0].ID}, nil)
+	c.event("stopped", &stopped)
+	require.Equal(t, "goto", stopped.Reason)
+	c.call("stackTrace", dap.ThreadArguments{ThreadID: threadID}, &stackTrace)
+	require.Equal(t, 3, stackTrace.StackFrames[0].Line)
+
+	c.call("continue", dap.ThreadArguments{ThreadID: threadID}, nil)
+	c.event("stopped", &stopped)
+	require.Equal(t, "breakpoint", stopped.Reason)
+	c.call("stackTrace", dap.ThreadArguments{ThreadID: threadID}, &stackTrace)
+	require.Equal(t, 5, stackTrace.StackFrames[0].Line)
+
+	c.call("continue", dap.ThreadArguments{ThreadID: threadID}, nil)
+	var output dap.OutputEventBody
+	c.event("output", &output)
+	require.Equal(t, "program-1.dis completed\n", output.Output)
+	c.event("terminated", nil)
+	require.Contains(t, c.events, "thread")
+	c.call("disconnect", nil, nil)
+
+	require.NoError(t, <-evalDone)
+}
@@ -19,12 +19,15 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/logging"
 )
@@ -58,8 +61,28 @@ type Control interface {
 	StepOut()
 	Resume()
 	SetBreakpoint(line int) error
+	// SetConditionalBreakpoint sets a breakpoint pausing the execution only when the condition
+	// holds, see breakCondition for its language. An empty condition always holds.
+	SetConditionalBreakpoint(line int, condition string) error
 	RemoveBreakpoint(line int) error
 	SetBreakpointsActive(active bool)
+	// SetWatchpoint and RemoveWatchpoint manage the app state the execution pauses on
+	// when modified, breakpoints activation applies to them as well.
+	SetWatchpoint(wp Watchpoint) error
+	RemoveWatchpoint(wp Watchpoint) error
+
+	// StepBack, ReverseResume and GotoLine go back through the recorded steps of the execution
+	// without running it: StepBack shows the previous step, ReverseResume the previous step on an
+	// active breakpoint or the first recorded step, and GotoLine the latest previous step at line.
+	// The recorded step is published as an "updated" notification, and the next Step, StepOver,
+	// StepOut and Resume go forward through the recorded steps before running the execution again.
+	StepBack() error
+	ReverseResume() error
+	GotoLine(line int) error
+
+	// SteppedInto tells if the execution is the one of an inner transaction program the
+	// caller stepped into, it should then pause on entry.
+	SteppedInto() bool
 
 	GetSourceMap() ([]byte, error)
 	GetSource() (string, []byte)
@@ -71,6 +94,16 @@ type Debugger struct {
 	mus      deadlock.Mutex
 	sessions map[string]*session
 	programs map[string]*programMeta
+	// watchpoints and deltaBreaks are set on every new session
+	watchpoints []Watchpoint
+	deltaBreaks []*breakCondition
+	// execs is the number of sessions started
+	execs int
+	// sessionPath is the file the debugging session is saved to, if any
+	sessionPath string
+	saved       savedSession
+	// restored is the debugging session the new sessions are restored from, if any
+	restored *savedSession
 
 	mud deadlock.Mutex
 	das []DebugAdapter
@@ -89,6 +122,7 @@ type programMeta struct {
 	program        []byte
 	source         string
 	offsetToSource map[int]logic.SourceLocation
+	sourceMapped   bool
 	states         AppState
 }
 
@@ -97,9 +131,38 @@ type debugConfig struct {
 	NoBreak     bool `json:"nobreak"`
 	StepBreak   bool `json:"stepbreak"`
 	StepOutOver bool `json:"stepover"`
+	// StepInto is set by Step, the execution of an inner transaction issued in the step pauses on entry
+	StepInto bool `json:"stepinto"`
 
 	ActiveBreak map[int]struct{} `json:"activebreak"`
 	CallDepth   int              `json:"calldepth"`
+	ActiveWatch []Watchpoint     `json:"activewatch"`
+	// DeltaBreaks are the conditions on the app state writes made so far to break on
+	// when an app state write makes them hold
+	DeltaBreaks []*breakCondition `json:"deltabreaks"`
+
+	Conditions map[int]*breakCondition `json:"conditions"`
+
+	// SourceStep is set when stepping through the lines of the original source
+	// of the program rather than its opcodes
+	SourceStep *sourceStep `json:"-"`
+}
+
+// sourceStep breaks on the first opcode of a source line other than the one the step started from
+type sourceStep struct {
+	offsetToSource map[int]logic.SourceLocation
+	line           int
+	// callDepth is the maximum call depth to break at when stepping over, or -1
+	callDepth int
+}
+
+// leaves checks if the opcode of state starts another source line
+func (ss *sourceStep) leaves(state *logic.DebugState) bool {
+	if ss.callDepth >= 0 && len(state.CallStack) > ss.callDepth {
+		return false
+	}
+	loc, ok := ss.offsetToSource[state.PC]
+	return ok && loc.Line != ss.line
 }
 
 func makeDebugConfig() debugConfig {
@@ -116,6 +179,11 @@ func (dc *debugConfig) setStepBreak() {
 	dc.StepBreak = true
 }
 
+func (dc *debugConfig) setStepInto() {
+	dc.StepBreak = true
+	dc.StepInto = true
+}
+
 func (dc *debugConfig) setStepOutOver(callDepth int) {
 	dc.StepOutOver = true
 	dc.CallDepth = callDepth
@@ -127,6 +195,82 @@ func (dc *debugConfig) setActiveBreak(line int) {
 	dc.ActiveBreak[line] = struct{}{}
 }
 
+// setBreakCondition sets the condition of an active breakpoint
+func (dc *debugConfig) setBreakCondition(line int, cond *breakCondition) {
+	if dc.Conditions == nil {
+		dc.Conditions = make(map[int]*breakCondition)
+	}
+	dc.Conditions[line] = cond
+}
+
+func (dc *debugConfig) setActiveWatch(wp Watchpoint) {
+	dc.ActiveWatch = append(dc.ActiveWatch, wp)
+}
+
+func (dc *debugConfig) setDeltaBreak(cond *breakCondition) {
+	dc.DeltaBreaks = append(dc.DeltaBreaks, cond)
+}
+
+// isWatched checks if Update() should break because the opcode modifies the app state
+// of an active watchpoint, or the app state writes made so far satisfy an active delta
+// breakpoint, regardless of the callDepth.
+func (dc *debugConfig) isWatched(state *condState) bool {
+	if change := state.StateChange; change != nil {
+		for _, wp := range dc.ActiveWatch {
+			if wp.matches(change) {
+				return true
+			}
+		}
+	}
+	return state.written && deltaBreakHolds(dc.DeltaBreaks, state)
+}
+
+// breaksAt checks if Update() should break at this state
+func (dc *debugConfig) breaksAt(state *condState) bool {
+	if dc.SourceStep != nil && !dc.SourceStep.leaves(state.DebugState) {
+		return dc.isWatched(state)
+	}
+	if dc.isBreak(state.Line, len(state.CallStack)) && (dc.StepBreak || conditionHolds(dc.Conditions[state.Line], state)) {
+		return true
+	}
+	return dc.isWatched(state)
+}
+
+// deltaBreakHolds checks if the app state write of the previous step made any of the delta
+// breakpoint conditions hold. A condition failing to evaluate holds so that the execution pauses on it.
+func deltaBreakHolds(conds []*breakCondition, state *condState) bool {
+	before := &condState{DebugState: state.DebugState, writes: state.before}
+	for _, cond := range conds {
+		holds, err := cond.holds(state)
+		if err != nil {
+			logging.Base().Warnf("delta breakpoint: %s", err.Error())
+			return true
+		}
+		if !holds {
+			continue
+		}
+		held, err := cond.holds(before)
+		if err != nil || !held {
+			return true
+		}
+	}
+	return false
+}
+
+// conditionHolds checks the condition of a breakpoint, if any. A condition failing to evaluate
+// holds so that the execution pauses on it.
+func conditionHolds(cond *breakCondition, state *condState) bool {
+	if cond == nil {
+		return true
+	}
+	holds, err := cond.holds(state)
+	if err != nil {
+		logging.Base().Warnf("breakpoint at line %d: %s", state.Line, err.Error())
+		return true
+	}
+	return holds
+}
+
 // isBreak checks if Update() should break at this line and callDepth.
 func (dc *debugConfig) isBreak(line int, callDepth int) bool {
 	if dc.StepBreak {
@@ -165,15 +309,46 @@ type session struct {
 	source         string
 	offsetToSource map[int]logic.SourceLocation // pc to source line/col
 	pcOffset       map[int]int                  // disassembly line to pc
+	// sourceMapped is set when source is the original source of the TEAL program,
+	// stepping then goes through its lines
+	sourceMapped bool
 
 	breakpoints []breakpoint
+	// conditions of the conditional breakpoints by line
+	conditions map[int]*breakCondition
+	// watchpoints maps each watchpoint to whether it is active
+	watchpoints map[Watchpoint]bool
+	// deltaBreaks maps each delta breakpoint condition to whether it is active
+	deltaBreaks map[*breakCondition]bool
 	line        atomicInt
 
 	callStack []logic.CallFrame
 
 	states AppState
+
+	// history holds the recorded steps of the execution, the last one is
+	// the step the execution is paused at
+	history []logic.DebugState
+	// writes are the app state writes made before each recorded step
+	writes []stateWrites
+	// shown is the index in history of the step shown when going back
+	// through the history, or -1 when the shown step is the last one
+	shown int
+
+	// steppedInto is set when the program of an inner transaction was stepped into by its caller
+	steppedInto bool
+
+	// exec is the index of the session in the sessions started by the debugger
+	exec int
+	// steps is the number of steps the execution went through
+	steps int
+	// stopAt is the number of steps of a restored execution to pause at, or 0
+	stopAt int
 }
 
+// maxHistorySteps bounds the number of recorded steps, the oldest ones are dropped
+const maxHistorySteps = 100000
+
 type breakpoint struct {
 	set    bool
 	active bool
@@ -197,8 +372,12 @@ func makeSession(disassembly string, line int) (s *session) {
 	s.disassembly = disassembly
 	s.lines = strings.Split(disassembly, "\n")
 	s.breakpoints = make([]breakpoint, len(s.lines))
+	s.conditions = make(map[int]*breakCondition)
+	s.watchpoints = make(map[Watchpoint]bool)
+	s.deltaBreaks = make(map[*breakCondition]bool)
 	s.line.Store(line)
 	s.callStack = []logic.CallFrame{}
+	s.shown = -1
 	return
 }
 
@@ -223,10 +402,31 @@ func (s *session) Step() {
 		s.mu.Lock()
 		defer s.mu.Unlock()
 		s.debugConfig = makeDebugConfig()
-		s.debugConfig.setStepBreak()
+		s.debugConfig.setStepInto()
+		s.setSourceStep(false)
 	}()
 
-	s.resume()
+	s.proceed()
+}
+
+// setSourceStep makes the step go to another line of the original source
+// when there is one, lock must be taken
+func (s *session) setSourceStep(over bool) bool {
+	if !s.sourceMapped {
+		return false
+	}
+	step := &sourceStep{offsetToSource: s.offsetToSource, line: -1, callDepth: -1}
+	if len(s.history) > 0 {
+		if loc, ok := s.offsetToSource[s.history[s.currentStep()].PC]; ok {
+			step.line = loc.Line
+		}
+	}
+	if over {
+		step.callDepth = len(s.callStack)
+	}
+	s.debugConfig.SourceStep = step
+	s.setActiveWatches()
+	return true
 }
 
 func (s *session) StepOver() {
@@ -237,8 +437,10 @@ func (s *session) StepOver() {
 		currentOp := strings.Fields(s.lines[s.line.Load()])[0]
 		s.debugConfig = makeDebugConfig()
 
-		// Step over a function call (callsub op).
-		if currentOp == "callsub" && s.line.Load() < len(s.breakpoints) {
+		if s.setSourceStep(true) {
+			// source lines make calls on their own, step over them all
+			s.debugConfig.setStepBreak()
+		} else if currentOp == "callsub" && s.line.Load() < len(s.breakpoints) {
 			// Set a flag to check if we are in StepOver mode and to
 			// save our initial call depth so we can pass over breakpoints that
 			// are not on the correct call depth.
@@ -247,11 +449,12 @@ func (s *session) StepOver() {
 			if err != nil {
 				s.debugConfig.setStepBreak()
 			}
+			s.setActiveWatches()
 		} else {
 			s.debugConfig.setStepBreak()
 		}
 	}()
-	s.resume()
+	s.proceed()
 }
 
 func (s *session) StepOut() {
@@ -260,7 +463,10 @@ func (s *session) StepOut() {
 		defer s.mu.Unlock()
 		s.debugConfig = makeDebugConfig()
 		if len(s.callStack) == 0 {
-			s.debugConfig.setNoBreak()
+			// only stop on watchpoints when stepping out of the program
+			if !s.setActiveWatches() {
+				s.debugConfig.setNoBreak()
+			}
 		} else {
 			callFrame := s.callStack[len(s.callStack)-1]
 			s.debugConfig.setStepOutOver(len(s.callStack) - 1)
@@ -268,10 +474,11 @@ func (s *session) StepOut() {
 			if err != nil {
 				s.debugConfig.setStepBreak()
 			}
+			s.setActiveWatches()
 		}
 	}()
 
-	s.resume()
+	s.proceed()
 }
 
 func (s *session) Resume() {
@@ -285,14 +492,228 @@ func (s *session) Resume() {
 				err := s.setBreakpoint(line)
 				if err != nil {
 					s.debugConfig.setStepBreak()
+				} else if cond, ok := s.conditions[line]; ok {
+					s.debugConfig.setBreakCondition(line, cond)
 				}
 			}
 		}
+		s.setActiveWatches()
 	}()
 
+	s.proceed()
+}
+
+// applyDiff rebuilds the stack and scratch space of a state sent with
+// their changes only from the last step recorded
+func (s *session) applyDiff(state *logic.DebugState) error {
+	if !state.Diff {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var prev *logic.DebugState
+	if len(s.history) > 0 {
+		prev = &s.history[len(s.history)-1]
+	}
+	return state.ApplyDiff(prev)
+}
+
+// record adds the step of state to the history
+func (s *session) record(state *logic.DebugState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	step := *state
+	var prev *logic.DebugState
+	if len(s.history) > 0 {
+		prev = &s.history[len(s.history)-1]
+	}
+	if prev != nil && slices.Equal(prev.Scratch, step.Scratch) {
+		// most steps don't write the scratch space, share it with the previous one
+		step.Scratch = prev.Scratch
+	}
+	step.EvalDelta = snapshotEvalDelta(&state.EvalDelta, prev)
+
+	var writes stateWrites
+	if prev != nil {
+		writes = s.writes[len(s.writes)-1]
+		if prev.StateChange != nil {
+			writes = writes.add(prev.StateChange)
+		}
+	}
+
+	if len(s.history) >= maxHistorySteps {
+		s.history = s.history[1:]
+		s.writes = s.writes[1:]
+	}
+	s.history = append(s.history, step)
+	s.writes = append(s.writes, writes)
+	s.shown = -1
+	s.steps++
+}
+
+// condState returns the state conditions are evaluated on at the recorded step i, lock must be taken
+func (s *session) condState(i int) *condState {
+	cs := &condState{DebugState: &s.history[i], writes: s.writes[i]}
+	if i > 0 {
+		cs.before = s.writes[i-1]
+		cs.written = s.history[i-1].StateChange != nil
+	}
+	return cs
+}
+
+// reachedStop checks if a restored execution reached the step it was saved at
+func (s *session) reachedStop() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopAt == 0 || s.steps != s.stopAt {
+		return false
+	}
+	s.stopAt = 0
+	return true
+}
+
+// snapshotEvalDelta copies the state changes of delta the evaluator keeps updating,
+// sharing the ones of the previous step when unchanged
+func snapshotEvalDelta(delta *transactions.EvalDelta, prev *logic.DebugState) transactions.EvalDelta {
+	snapshot := transactions.EvalDelta{
+		SharedAccts: slices.Clip(delta.SharedAccts),
+		Logs:        slices.Clip(delta.Logs),
+		InnerTxns:   slices.Clip(delta.InnerTxns),
+	}
+	if prev != nil && delta.GlobalDelta.Equal(prev.GlobalDelta) {
+		snapshot.GlobalDelta = prev.GlobalDelta
+	} else {
+		snapshot.GlobalDelta = maps.Clone(delta.GlobalDelta)
+	}
+	if prev != nil && maps.EqualFunc(delta.LocalDeltas, prev.LocalDeltas, maps.Equal[basics.StateDelta, basics.StateDelta]) {
+		snapshot.LocalDeltas = prev.LocalDeltas
+	} else if delta.LocalDeltas != nil {
+		snapshot.LocalDeltas = make(map[uint64]basics.StateDelta, len(delta.LocalDeltas))
+		for idx, sd := range delta.LocalDeltas {
+			snapshot.LocalDeltas[idx] = maps.Clone(sd)
+		}
+	}
+	return snapshot
+}
+
+// showStep publishes the recorded step i, lock must be taken
+func (s *session) showStep(i int) {
+	step := s.history[i]
+	s.shown = i
+	if i == len(s.history)-1 {
+		s.shown = -1
+	}
+	s.line.Store(step.Line)
+	s.callStack = step.CallStack
+
+	go func() {
+		s.notifications <- Notification{"updated", step}
+	}()
+}
+
+// currentStep is the index in history of the shown step, lock must be taken
+func (s *session) currentStep() int {
+	if s.shown >= 0 {
+		return s.shown
+	}
+	return len(s.history) - 1
+}
+
+// proceed shows the next recorded step the debug config breaks at when going through
+// the history, and resumes the execution otherwise
+func (s *session) proceed() {
+	s.mu.Lock()
+	if s.shown >= 0 {
+		if !s.debugConfig.NoBreak {
+			for i := s.shown + 1; i < len(s.history); i++ {
+				if s.debugConfig.breaksAt(s.condState(i)) {
+					s.showStep(i)
+					s.mu.Unlock()
+					return
+				}
+			}
+		}
+		// back to the step the execution is paused at
+		last := s.history[len(s.history)-1]
+		s.shown = -1
+		s.line.Store(last.Line)
+		s.callStack = last.CallStack
+	}
+	s.mu.Unlock()
+
 	s.resume()
 }
 
+func (s *session) SteppedInto() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.steppedInto
+}
+
+func (s *session) StepBack() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := s.currentStep()
+	if current <= 0 {
+		return fmt.Errorf("no recorded step before the current one")
+	}
+	s.showStep(current - 1)
+	return nil
+}
+
+func (s *session) ReverseResume() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := s.currentStep()
+	if current <= 0 {
+		return fmt.Errorf("no recorded step before the current one")
+	}
+	for i := current - 1; i > 0; i-- {
+		cs := s.condState(i)
+		line := s.history[i].Line
+		if line < len(s.breakpoints) && s.breakpoints[line].set && s.breakpoints[line].active &&
+			conditionHolds(s.conditions[line], cs) {
+			s.showStep(i)
+			return nil
+		}
+		if change := s.history[i].StateChange; change != nil {
+			for wp, active := range s.watchpoints {
+				if active && wp.matches(change) {
+					s.showStep(i)
+					return nil
+				}
+			}
+		}
+		if cs.written {
+			for cond, active := range s.deltaBreaks {
+				if active && deltaBreakHolds([]*breakCondition{cond}, cs) {
+					s.showStep(i)
+					return nil
+				}
+			}
+		}
+	}
+	s.showStep(0)
+	return nil
+}
+
+func (s *session) GotoLine(line int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := s.currentStep() - 1; i >= 0; i-- {
+		if s.history[i].Line == line {
+			s.showStep(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("no recorded step at line %d before the current one", line)
+}
+
 // setBreakpoint must be called with lock taken
 // Used for setting a breakpoint in step execution and adding bp to the session.
 func (s *session) setBreakpoint(line int) error {
@@ -309,9 +730,74 @@ func (s *session) SetBreakpoint(line int) error {
 	defer s.mu.Unlock()
 	// Reset all existing flags and breakpoints and set a new bp.
 	s.debugConfig = makeDebugConfig()
+	delete(s.conditions, line)
 	return s.setBreakpoint(line)
 }
 
+func (s *session) SetConditionalBreakpoint(line int, condition string) error {
+	if len(condition) == 0 {
+		return s.SetBreakpoint(line)
+	}
+	cond, err := parseBreakCondition(condition)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Reset all existing flags and breakpoints and set a new bp, as SetBreakpoint does.
+	s.debugConfig = makeDebugConfig()
+	err = s.setBreakpoint(line)
+	if err != nil {
+		return err
+	}
+	s.conditions[line] = cond
+	s.debugConfig.setBreakCondition(line, cond)
+	return nil
+}
+
+// setActiveWatches adds the active watchpoints and delta breakpoints to the debug config
+// and reports whether there are any, lock must be taken
+func (s *session) setActiveWatches() bool {
+	for wp, active := range s.watchpoints {
+		if active {
+			s.debugConfig.setActiveWatch(wp)
+		}
+	}
+	for cond, active := range s.deltaBreaks {
+		if active {
+			s.debugConfig.setDeltaBreak(cond)
+		}
+	}
+	return len(s.debugConfig.ActiveWatch) > 0 || len(s.debugConfig.DeltaBreaks) > 0
+}
+
+func (s *session) SetWatchpoint(wp Watchpoint) error {
+	if err := wp.validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Reset all existing flags and breakpoints and set a new wp, as SetBreakpoint does.
+	s.debugConfig = makeDebugConfig()
+	s.watchpoints[wp] = true
+	s.debugConfig.setActiveWatch(wp)
+	return nil
+}
+
+func (s *session) RemoveWatchpoint(wp Watchpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.watchpoints[wp]; ok {
+		s.debugConfig = makeDebugConfig()
+		s.debugConfig.setNoBreak()
+		delete(s.watchpoints, wp)
+	}
+	return nil
+}
+
 func (s *session) setCallStack(callStack []logic.CallFrame) {
 	s.mu.Lock()
 	s.callStack = callStack
@@ -329,6 +815,7 @@ func (s *session) RemoveBreakpoint(line int) error {
 		s.debugConfig = makeDebugConfig()
 		s.debugConfig.setNoBreak()
 		s.breakpoints[line] = breakpoint{}
+		delete(s.conditions, line)
 	}
 	return nil
 }
@@ -342,6 +829,12 @@ func (s *session) SetBreakpointsActive(active bool) {
 			s.breakpoints[i].active = active
 		}
 	}
+	for wp := range s.watchpoints {
+		s.watchpoints[wp] = active
+	}
+	for cond := range s.deltaBreaks {
+		s.deltaBreaks[cond] = active
+	}
 	if !active {
 		s.debugConfig = makeDebugConfig()
 		s.debugConfig.setNoBreak()
@@ -477,21 +970,31 @@ func (d *Debugger) getSession(sid string) (s *session, err error) {
 	return
 }
 
-func (d *Debugger) createSession(sid string, disassembly string, line int, pcOffset map[int]int) (s *session) {
+func (d *Debugger) createSession(sid string, programID string, disassembly string, line int, pcOffset map[int]int) (s *session) {
 	d.mus.Lock()
 	defer d.mus.Unlock()
 
 	s = makeSession(disassembly, line)
 	d.sessions[sid] = s
-	meta, ok := d.programs[sid]
+	meta, ok := d.programs[programID]
 	if ok {
 		s.programName = meta.name
 		s.program = meta.program
 		s.source = meta.source
 		s.offsetToSource = meta.offsetToSource
+		s.sourceMapped = meta.sourceMapped
 		s.pcOffset = pcOffset
 		s.states = meta.states
 	}
+	for _, wp := range d.watchpoints {
+		s.watchpoints[wp] = true
+	}
+	for _, cond := range d.deltaBreaks {
+		s.deltaBreaks[cond] = true
+	}
+	s.exec = d.execs
+	d.execs++
+	d.restoreExec(s)
 	return
 }
 
@@ -503,6 +1006,39 @@ func (d *Debugger) removeSession(sid string) (s *session) {
 	return
 }
 
+// SetWatchpoints sets the watchpoints of the sessions started afterwards
+func (d *Debugger) SetWatchpoints(watchpoints []Watchpoint) error {
+	for _, wp := range watchpoints {
+		if err := wp.validate(); err != nil {
+			return err
+		}
+	}
+
+	d.mus.Lock()
+	defer d.mus.Unlock()
+	d.watchpoints = watchpoints
+	return nil
+}
+
+// SetDeltaBreaks sets the delta breakpoints of the sessions started afterwards: the executions
+// pause after the app state write making any of the conditions hold, see breakCondition
+// for their language and writes for the app state writes made so far
+func (d *Debugger) SetDeltaBreaks(conditions []string) error {
+	deltaBreaks := make([]*breakCondition, 0, len(conditions))
+	for _, condition := range conditions {
+		cond, err := parseBreakCondition(condition)
+		if err != nil {
+			return err
+		}
+		deltaBreaks = append(deltaBreaks, cond)
+	}
+
+	d.mus.Lock()
+	defer d.mus.Unlock()
+	d.deltaBreaks = deltaBreaks
+	return nil
+}
+
 // AddAdapter adds a new debugger adapter
 func (d *Debugger) AddAdapter(da DebugAdapter) {
 	d.mud.Lock()
@@ -510,10 +1046,11 @@ func (d *Debugger) AddAdapter(da DebugAdapter) {
 	d.das = append(d.das, da)
 }
 
-// SaveProgram stores program, source and offsetToLine for later use
+// SaveProgram stores program, source and offsetToLine for later use.
+// sourceMapped tells that source is the original source of the TEAL program.
 func (d *Debugger) SaveProgram(
 	name string, program []byte, source string, offsetToSource map[int]logic.SourceLocation,
-	states AppState,
+	sourceMapped bool, states AppState,
 ) {
 	hash := logic.GetProgramID(program)
 	d.mus.Lock()
@@ -523,6 +1060,7 @@ func (d *Debugger) SaveProgram(
 		program,
 		source,
 		offsetToSource,
+		sourceMapped,
 		states,
 	}
 }
@@ -534,7 +1072,15 @@ func (d *Debugger) Register(state *logic.DebugState) {
 	for _, pco := range state.PCOffset {
 		pcOffset[state.PCToLine(pco.PC)] = pco.PC
 	}
-	s := d.createSession(sid, state.Disassembly, state.Line, pcOffset)
+	programID := state.ProgramID
+	if len(programID) == 0 {
+		// evaluators not reporting inner transaction programs identify executions by program
+		programID = sid
+	}
+	s := d.createSession(sid, programID, state.Disassembly, state.Line, pcOffset)
+	if len(state.Callers) > 0 {
+		d.enterInner(s, state)
+	}
 
 	// Store the state for this execution
 	d.mud.Lock()
@@ -558,6 +1104,28 @@ func (d *Debugger) Register(state *logic.DebugState) {
 	<-s.acknowledged
 }
 
+// enterInner sets up the session of an inner transaction program: it shows the app state
+// of the inner app call, and pauses on entry when the caller stepped into the inner transaction
+func (d *Debugger) enterInner(s *session, state *logic.DebugState) {
+	txn := state.TxnGroup[state.GroupIndex]
+	appIdx := txn.Txn.ApplicationID
+	if appIdx == 0 {
+		appIdx = txn.ApplyData.ApplicationID
+	}
+	steppedInto := false
+	if caller, err := d.getSession(state.Callers[len(state.Callers)-1].ExecID); err == nil {
+		caller.mu.Lock()
+		steppedInto = caller.debugConfig.StepInto
+		caller.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// app state of the program metadata is the one of top-level app calls
+	s.states = AppState{appIdx: appIdx}
+	s.steppedInto = steppedInto
+}
+
 // Update process state update notifications: pauses or continues as needed
 func (d *Debugger) Update(state *logic.DebugState) {
 	err := d.update(state)
@@ -572,16 +1140,26 @@ func (d *Debugger) update(state *logic.DebugState) error {
 	if err != nil {
 		return err
 	}
+	err = s.applyDiff(state)
+	if err != nil {
+		return err
+	}
 	s.line.Store(state.Line)
+	s.record(state)
 	cfg := s.debugConfig
+	restored := s.reachedStop()
+	s.mu.Lock()
+	last := s.condState(len(s.history) - 1)
+	s.mu.Unlock()
 
 	// copy state to prevent a data race in this the go-routine and upcoming updates to the state
 	go func(localState logic.DebugState) {
 		// Check if we are triggered and acknowledge asynchronously
-		if !cfg.NoBreak {
-			if cfg.isBreak(localState.Line, len(localState.CallStack)) {
+		if !cfg.NoBreak || restored {
+			if restored || cfg.breaksAt(&condState{DebugState: &localState, writes: last.writes, written: last.written, before: last.before}) {
 				// Copy callstack information
 				s.setCallStack(state.CallStack)
+				d.saveExec(s)
 				// Breakpoint hit! Inform the user
 				s.notifications <- Notification{"updated", localState}
 			} else {
@@ -614,6 +1192,11 @@ func (d *Debugger) complete(state *logic.DebugState) error {
 	if err != nil {
 		return err
 	}
+	err = s.applyDiff(state)
+	if err != nil {
+		return err
+	}
+	d.saveExec(s)
 
 	// Inform the user
 	s.notifications <- Notification{"completed", *state}
@@ -18,11 +18,14 @@ package main
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/protocol"
@@ -121,6 +124,610 @@ func TestDebuggerSimple(t *testing.T) {
 	require.Equal(t, 3, da.eventCount) // register, update, complete
 }
 
+// scriptedDbgAdapter runs the next action of its script on every stop, recording the steps shown
+type scriptedDbgAdapter struct {
+	actions []func(c Control)
+	shown   [][2]int // line and stack height of the steps shown
+	changes []*logic.AppStateChange
+	done    chan struct{}
+}
+
+func (d *scriptedDbgAdapter) SessionStarted(_ string, debugger Control, ch chan Notification) {
+	go func() {
+		for n := range ch {
+			switch n.Event {
+			case "completed":
+				if len(n.DebugState.Callers) == 0 {
+					// inner transaction programs complete before the top-level one
+					close(d.done)
+				}
+				return
+			case "updated":
+				d.shown = append(d.shown, [2]int{n.DebugState.Line, len(n.DebugState.Stack)})
+				d.changes = append(d.changes, n.DebugState.StateChange)
+			}
+			action := d.actions[0]
+			d.actions = d.actions[1:]
+			action(debugger)
+		}
+	}()
+}
+
+func (d *scriptedDbgAdapter) SessionEnded(_ string) {}
+
+func (d *scriptedDbgAdapter) WaitForCompletion() {
+	<-d.done
+}
+
+func (d *scriptedDbgAdapter) URL() string {
+	return ""
+}
+
+func TestDebuggerHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusV18]
+	debugger := MakeDebugger()
+
+	step := func(c Control) { c.Step() }
+	stepBack := func(c Control) { require.NoError(t, c.StepBack()) }
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){
+		step, step, step, step,
+		// at "+", go back to the first step and forward again
+		stepBack, stepBack,
+		func(c Control) { require.NoError(t, c.GotoLine(1)) },
+		step,
+		func(c Control) {
+			require.NoError(t, c.SetBreakpoint(4))
+			c.Resume()
+		},
+		func(c Control) { require.NoError(t, c.ReverseResume()) },
+		func(c Control) { c.Resume() },
+		func(c Control) {
+			require.Error(t, c.GotoLine(6))
+			c.Resume()
+		},
+	}
+	debugger.AddAdapter(da)
+
+	// lines: #pragma, intcblock, intc_0, intc_1, +, intc_2, ==
+	ops, err := logic.AssembleStringWithVersion("int 1; int 2; +; int 3; ==", 1)
+	require.NoError(t, err)
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+
+	ep := logic.NewSigEvalParams([]transactions.SignedTxn{txn}, &proto, logic.NoHeaderLedger{})
+	ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(debugger)
+
+	pass, err := logic.EvalSignature(0, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+	da.WaitForCompletion()
+
+	require.Empty(t, da.actions)
+	require.Equal(t, [][2]int{
+		{1, 0}, {2, 0}, {3, 1}, {4, 2},
+		{3, 1}, {2, 0}, {1, 0}, {2, 0},
+		{4, 2}, {1, 0}, {4, 2},
+	}, da.shown)
+}
+
+// compactHook forwards states to the debugger the way a WebDebugger with Diffs set sends them
+type compactHook struct {
+	*Debugger
+}
+
+func (h compactHook) Update(state *logic.DebugState) {
+	h.Debugger.Update(state.Compact())
+}
+
+func (h compactHook) Complete(state *logic.DebugState) {
+	h.Debugger.Complete(state.Compact())
+}
+
+func TestDebuggerStateDiff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	debugger := MakeDebugger()
+
+	var s *session
+	step := func(c Control) {
+		s = c.(*session)
+		c.Step()
+	}
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){step, step, step, step, step, step, step, step}
+	debugger.AddAdapter(da)
+
+	ops, err := logic.AssembleString("#pragma version 8\npushint 1\npushbytes 0x01\nstore 3\npushint 2\nstore 200\ndup\npop\n")
+	require.NoError(t, err)
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+
+	ep := logic.NewSigEvalParams([]transactions.SignedTxn{txn}, &proto, logic.NoHeaderLedger{})
+	ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(compactHook{debugger})
+
+	pass, err := logic.EvalSignature(0, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+	da.WaitForCompletion()
+
+	require.Empty(t, da.actions)
+	require.Equal(t, [][2]int{
+		{1, 0}, {2, 1}, {3, 2}, {4, 1}, {5, 2}, {6, 1}, {7, 2},
+	}, da.shown)
+
+	// the stack and scratch space are rebuilt from the diffs of every step
+	s.mu.Lock()
+	last := s.history[len(s.history)-1]
+	s.mu.Unlock()
+	require.Equal(t, 7, last.Line)
+	one := basics.TealValue{Type: basics.TealUintType, Uint: 1}
+	require.Equal(t, []basics.TealValue{one, one}, last.Stack)
+	require.Equal(t, basics.TealValue{Type: basics.TealBytesType, Bytes: "AQ=="}, last.Scratch[3])
+	require.Equal(t, basics.TealValue{Type: basics.TealUintType, Uint: 2}, last.Scratch[200])
+	require.Equal(t, basics.TealValue{Type: basics.TealUintType}, last.Scratch[4])
+}
+
+func TestDebuggerConditionalBreakpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	ops, err := logic.AssembleStringWithVersion(`int 0
+loop:
+int 1
++
+dup
+int 5
+<
+bnz loop
+int 5
+==`, 4)
+	require.NoError(t, err)
+	disassembly, err := logic.Disassemble(ops.Program)
+	require.NoError(t, err)
+	dupLine := slices.Index(strings.Split(disassembly, "\n"), "dup")
+	require.Positive(t, dupLine)
+
+	debugger := MakeDebugger()
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){
+		func(c Control) {
+			require.Error(t, c.SetConditionalBreakpoint(dupLine, "stack[-1] =="))
+			// stop in the third iteration of the loop only
+			require.NoError(t, c.SetConditionalBreakpoint(dupLine, "stack[-1] == 3"))
+			c.Resume()
+		},
+		func(c Control) { c.Resume() },
+	}
+	debugger.AddAdapter(da)
+
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+	ep := logic.NewSigEvalParams([]transactions.SignedTxn{txn}, &proto, logic.NoHeaderLedger{})
+	ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(debugger)
+
+	pass, err := logic.EvalSignature(0, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+	da.WaitForCompletion()
+
+	require.Empty(t, da.actions)
+	require.Equal(t, [][2]int{{dupLine, 1}}, da.shown)
+}
+
+func TestDebuggerSourceStep(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	ops, err := logic.AssembleString(`#pragma version 4
+pushint 1
+pushint 2
++
+callsub double
+pushint 6
+==
+return
+double:
+dup
++
+retsub`)
+	require.NoError(t, err)
+	source := `x = 1 + 2
+y = double(x)
+assert y == 6
+approve()
+def double(v):
+    return v + v
+`
+	sm, err := parseSourceMap(makeTestSourceMap(t, []int{-1, 0, 0, 0, 1, 2, 2, 3, -1, 5, 5, 5}, source))
+	require.NoError(t, err)
+	_, offsetToSource, err := sm.mapSource(ops.OffsetToSource)
+	require.NoError(t, err)
+
+	disassembly, err := logic.Disassemble(ops.Program)
+	require.NoError(t, err)
+	lines := strings.Split(disassembly, "\n")
+	lineOf := func(prefix string) int {
+		line := slices.IndexFunc(lines, func(l string) bool { return strings.HasPrefix(l, prefix) })
+		require.Positive(t, line, prefix)
+		return line
+	}
+
+	debugger := MakeDebugger()
+	debugger.SaveProgram("contract.teal", ops.Program, source, offsetToSource, true, AppState{})
+	step := func(c Control) { c.Step() }
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){
+		step, step, step,
+		// from within the subroutine up to the next line of the caller
+		func(c Control) { c.StepOver() },
+		step, step,
+	}
+	debugger.AddAdapter(da)
+
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+	ep := logic.NewSigEvalParams([]transactions.SignedTxn{txn}, &proto, logic.NoHeaderLedger{})
+	ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(debugger)
+
+	pass, err := logic.EvalSignature(0, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+	da.WaitForCompletion()
+
+	require.Empty(t, da.actions)
+	require.Equal(t, [][2]int{
+		{lineOf("pushint 1"), 0},
+		{lineOf("callsub"), 1},
+		{lineOf("dup"), 1},
+		{lineOf("pushint 6"), 1},
+		{lineOf("return"), 1},
+	}, da.shown)
+}
+
+func TestDebuggerWatchpoints(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	require.NoError(t, err)
+
+	ops, err := logic.AssembleString(`#pragma version 8
+byte "a"
+int 1
+app_global_put
+byte "b"
+int 2
+app_global_put
+txn Sender
+byte "l"
+int 3
+app_local_put
+byte "a"
+app_global_del
+int 1`)
+	require.NoError(t, err)
+
+	appIdx := basics.AppIndex(1)
+	br := basics.BalanceRecord{
+		Addr: sender,
+		AccountData: basics.AccountData{
+			MicroAlgos: basics.MicroAlgos{Raw: 5000000},
+			AppParams: map[basics.AppIndex]basics.AppParams{
+				appIdx: {
+					ApprovalProgram:   ops.Program,
+					ClearStateProgram: ops.Program,
+					StateSchemas: basics.StateSchemas{
+						LocalStateSchema:  basics.StateSchema{NumUint: 1},
+						GlobalStateSchema: basics.StateSchema{NumUint: 2},
+					},
+				},
+			},
+			AppLocalStates: map[basics.AppIndex]basics.AppLocalState{
+				appIdx: {Schema: basics.StateSchema{NumUint: 1}},
+			},
+		},
+	}
+	stxn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.ApplicationCallTx,
+			Header: transactions.Header{Fee: basics.MicroAlgos{Raw: 1000}, Sender: sender},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: appIdx,
+			},
+		},
+	}
+
+	var watchpoints []Watchpoint
+	for _, spec := range []string{"global:str:a", "local::str:l", "box:str:a"} {
+		wp, err := parseWatchpoint(spec)
+		require.NoError(t, err)
+		watchpoints = append(watchpoints, wp)
+	}
+	debugger := MakeDebugger()
+	require.NoError(t, debugger.SetWatchpoints(watchpoints))
+
+	resume := func(c Control) { c.Resume() }
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){resume, resume, resume, resume}
+	debugger.AddAdapter(da)
+
+	dp := DebugParams{
+		ProgramNames:    []string{"test"},
+		BalanceBlob:     protocol.EncodeMsgp(&br),
+		TxnBlob:         protocol.EncodeMsgp(&stxn),
+		Proto:           string(protocol.ConsensusCurrentVersion),
+		Round:           222,
+		LatestTimestamp: 333,
+		RunMode:         "application",
+	}
+	local := MakeLocalRunner(debugger)
+	require.NoError(t, local.Setup(&dp))
+	require.NoError(t, local.RunAll())
+	da.WaitForCompletion()
+	require.NoError(t, local.runs[0].result.err)
+	require.True(t, local.runs[0].result.pass)
+
+	// the execution pauses before the opcodes modifying the watched global and local keys,
+	// but not the unwatched global key "b"
+	require.Empty(t, da.actions)
+	require.Equal(t, []*logic.AppStateChange{
+		{State: logic.GlobalState, Op: logic.AppStateWrite, AppID: appIdx, Key: "a"},
+		{State: logic.LocalState, Op: logic.AppStateWrite, AppID: appIdx, Account: sender, Key: "l"},
+		{State: logic.GlobalState, Op: logic.AppStateDelete, AppID: appIdx, Key: "a"},
+	}, da.changes)
+}
+
+func TestDebuggerDeltaBreaks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	require.NoError(t, err)
+
+	ops, err := logic.AssembleString(`#pragma version 8
+byte "a"
+int 1
+app_global_put
+byte "b"
+int 2
+app_global_put
+txn Sender
+byte "l"
+int 3
+app_local_put
+byte "a"
+app_global_del
+int 1`)
+	require.NoError(t, err)
+
+	appIdx := basics.AppIndex(1)
+	br := basics.BalanceRecord{
+		Addr: sender,
+		AccountData: basics.AccountData{
+			MicroAlgos: basics.MicroAlgos{Raw: 5000000},
+			AppParams: map[basics.AppIndex]basics.AppParams{
+				appIdx: {
+					ApprovalProgram:   ops.Program,
+					ClearStateProgram: ops.Program,
+					StateSchemas: basics.StateSchemas{
+						LocalStateSchema:  basics.StateSchema{NumUint: 1},
+						GlobalStateSchema: basics.StateSchema{NumUint: 2},
+					},
+				},
+			},
+			AppLocalStates: map[basics.AppIndex]basics.AppLocalState{
+				appIdx: {Schema: basics.StateSchema{NumUint: 1}},
+			},
+		},
+	}
+	stxn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.ApplicationCallTx,
+			Header: transactions.Header{Fee: basics.MicroAlgos{Raw: 1000}, Sender: sender},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: appIdx,
+			},
+		},
+	}
+
+	debugger := MakeDebugger()
+	require.Error(t, debugger.SetDeltaBreaks([]string{`writes foo > 1`}))
+	require.NoError(t, debugger.SetDeltaBreaks([]string{`writes global > 1`, `writes global "a" == 2`}))
+
+	var s *session
+	resume := func(c Control) {
+		s = c.(*session)
+		c.Resume()
+	}
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){resume, resume, resume}
+	debugger.AddAdapter(da)
+
+	dp := DebugParams{
+		ProgramNames:    []string{"test"},
+		BalanceBlob:     protocol.EncodeMsgp(&br),
+		TxnBlob:         protocol.EncodeMsgp(&stxn),
+		Proto:           string(protocol.ConsensusCurrentVersion),
+		Round:           222,
+		LatestTimestamp: 333,
+		RunMode:         "application",
+	}
+	local := MakeLocalRunner(debugger)
+	require.NoError(t, local.Setup(&dp))
+	require.NoError(t, local.RunAll())
+	da.WaitForCompletion()
+	require.NoError(t, local.runs[0].result.err)
+	require.True(t, local.runs[0].result.pass)
+
+	// the execution pauses once after the write of "b" makes two global writes,
+	// and once after the deletion of "a", but not after the local write
+	require.Empty(t, da.actions)
+	require.Len(t, da.shown, 2)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	require.Equal(t, "txn Sender", s.lines[da.shown[0][0]])
+	require.Equal(t, "intc_0 // 1", s.lines[da.shown[1][0]])
+	last := s.writes[len(s.writes)-1]
+	require.Equal(t, 3, last.count(logic.GlobalState, nil))
+	require.Equal(t, 1, last.count(logic.LocalState, nil))
+	a := "a"
+	require.Equal(t, 2, last.count(logic.GlobalState, &a))
+}
+
+func TestDebuggerInnerPrograms(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	require.NoError(t, err)
+
+	const innerCall = `itxn_begin
+pushint 6
+itxn_field TypeEnum
+pushint 2
+itxn_field ApplicationID
+itxn_submit
+`
+	outer, err := logic.AssembleString("#pragma version 6\n" + innerCall + innerCall + "pushint 1")
+	require.NoError(t, err)
+	inner, err := logic.AssembleString("#pragma version 6\npushint 1")
+	require.NoError(t, err)
+
+	disassembly, err := logic.Disassemble(outer.Program)
+	require.NoError(t, err)
+	var submits []int
+	for line, text := range strings.Split(disassembly, "\n") {
+		if text == "itxn_submit" {
+			submits = append(submits, line)
+		}
+	}
+	require.Len(t, submits, 2)
+
+	outerIdx, innerIdx := basics.AppIndex(1), basics.AppIndex(2)
+	br := basics.BalanceRecord{
+		Addr: sender,
+		AccountData: basics.AccountData{
+			MicroAlgos: basics.MicroAlgos{Raw: 5000000},
+			AppParams: map[basics.AppIndex]basics.AppParams{
+				outerIdx: {ApprovalProgram: outer.Program, ClearStateProgram: inner.Program},
+				innerIdx: {ApprovalProgram: inner.Program, ClearStateProgram: inner.Program},
+			},
+		},
+	}
+	appAccount := basics.BalanceRecord{
+		Addr:        outerIdx.Address(),
+		AccountData: basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000000}},
+	}
+	stxn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.ApplicationCallTx,
+			Header: transactions.Header{Fee: basics.MicroAlgos{Raw: 3000}, Sender: sender},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: outerIdx,
+				ForeignApps:   []basics.AppIndex{innerIdx},
+			},
+		},
+	}
+
+	debugger := MakeDebugger()
+	resume := func(c Control) { c.Resume() }
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){
+		func(c Control) {
+			for _, line := range submits {
+				require.NoError(t, c.SetBreakpoint(line))
+			}
+			c.Resume()
+		},
+		// step into the first inner app call, then back to the caller
+		func(c Control) { c.Step() },
+		func(c Control) {
+			require.True(t, c.SteppedInto())
+			c.Step()
+		},
+		resume,
+		resume,
+		// step over the second one
+		func(c Control) { c.StepOver() },
+		func(c Control) {
+			require.False(t, c.SteppedInto())
+			c.Resume()
+		},
+		resume,
+	}
+	debugger.AddAdapter(da)
+
+	dp := DebugParams{
+		ProgramNames:    []string{"test"},
+		BalanceBlob:     append(protocol.EncodeMsgp(&br), protocol.EncodeMsgp(&appAccount)...),
+		TxnBlob:         protocol.EncodeMsgp(&stxn),
+		Proto:           string(protocol.ConsensusCurrentVersion),
+		Round:           222,
+		LatestTimestamp: 333,
+		RunMode:         "application",
+	}
+	local := MakeLocalRunner(debugger)
+	require.NoError(t, local.Setup(&dp))
+	require.NoError(t, local.RunAll())
+	da.WaitForCompletion()
+	require.NoError(t, local.runs[0].result.err)
+	require.True(t, local.runs[0].result.pass)
+
+	require.Empty(t, da.actions)
+	require.Equal(t, [][2]int{
+		{submits[0], 0},
+		// the first line of the inner program
+		{1, 0},
+		// the caller goes on stepping
+		{submits[0] + 1, 0},
+		{submits[1], 0},
+		{submits[1] + 1, 0},
+	}, da.shown)
+}
+
+func TestParseWatchpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := "47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU"
+	sender, err := basics.UnmarshalChecksumAddress(addr)
+	require.NoError(t, err)
+
+	valid := map[string]Watchpoint{
+		"global:str:counter":       {State: logic.GlobalState, Key: "counter"},
+		"global:b64:AAE=":          {State: logic.GlobalState, Key: "\x00\x01"},
+		"box:int:1":                {State: logic.BoxState, Key: "\x00\x00\x00\x00\x00\x00\x00\x01"},
+		"local::str:x":             {State: logic.LocalState, Key: "x"},
+		"local:" + addr + ":str:x": {State: logic.LocalState, Account: sender, Key: "x"},
+	}
+	for spec, expected := range valid {
+		wp, err := parseWatchpoint(spec)
+		require.NoError(t, err, spec)
+		require.Equal(t, expected, wp, spec)
+		require.NoError(t, wp.validate())
+
+		wp, err = parseWatchpoint(wp.String())
+		require.NoError(t, err, spec)
+		require.Equal(t, expected, wp, spec)
+	}
+
+	for _, spec := range []string{"global", "app:str:x", "global:counter", "local:str:x", "local:" + addr[1:] + ":str:x"} {
+		_, err := parseWatchpoint(spec)
+		require.Error(t, err, spec)
+	}
+
+	require.Error(t, Watchpoint{State: logic.BoxState, Account: sender, Key: "x"}.validate())
+	require.Error(t, Watchpoint{Key: "x"}.validate())
+}
+
 func createSessionFromSource(t *testing.T, program string) *session {
 	source := fmt.Sprintf(program, logic.LogicVersion)
 	ops, err := logic.AssembleStringWithVersion(source, logic.LogicVersion)
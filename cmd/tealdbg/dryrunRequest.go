@@ -19,6 +19,8 @@ package main
 import (
 	"log"
 
+	"github.com/algorand/avm-abi/apps"
+
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/protocol"
 
@@ -26,16 +28,34 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 )
 
-// ddrFromParams converts serialized DryrunRequest to v2.DryrunRequest
-func ddrFromParams(dp *DebugParams) (ddr v2.DryrunRequest, err error) {
+// dryrunRequest is a v2.DryrunRequest with the contents of the boxes of its apps.
+// Boxes are not part of the algod dryrun API, they are set in an additional "boxes" field.
+type dryrunRequest struct {
+	v2.DryrunRequest
+	Boxes []dryrunBox `codec:"boxes"`
+}
+
+// dryrunBox is the content of a box of an app
+type dryrunBox struct {
+	AppIndex basics.AppIndex `codec:"app-index"`
+	Name     []byte          `codec:"name"`
+	Value    []byte          `codec:"value"`
+}
+
+// ddrFromParams converts serialized DryrunRequest to dryrunRequest
+func ddrFromParams(dp *DebugParams) (ddr dryrunRequest, err error) {
 	if len(dp.DdrBlob) == 0 {
 		return
 	}
 
-	var gdr model.DryrunRequest
+	var gdr struct {
+		model.DryrunRequest
+		Boxes []dryrunBox `json:"boxes"`
+	}
 	err1 := protocol.DecodeJSON(dp.DdrBlob, &gdr)
 	if err1 == nil {
-		ddr, err = v2.DryrunRequestFromGenerated(&gdr)
+		ddr.DryrunRequest, err = v2.DryrunRequestFromGenerated(&gdr.DryrunRequest)
+		ddr.Boxes = gdr.Boxes
 	} else {
 		err = protocol.DecodeReflect(dp.DdrBlob, &ddr)
 		// if failed report intermediate decoding error
@@ -47,7 +67,16 @@ func ddrFromParams(dp *DebugParams) (ddr v2.DryrunRequest, err error) {
 	return
 }
 
-func balanceRecordsFromDdr(ddr *v2.DryrunRequest) (records []basics.BalanceRecord, err error) {
+// boxesFromDdr returns the box contents of the dryrun request by their key in the ledger
+func boxesFromDdr(ddr *dryrunRequest) map[string][]byte {
+	boxes := make(map[string][]byte, len(ddr.Boxes))
+	for _, box := range ddr.Boxes {
+		boxes[apps.MakeBoxKey(uint64(box.AppIndex), string(box.Name))] = box.Value
+	}
+	return boxes
+}
+
+func balanceRecordsFromDdr(ddr *dryrunRequest) (records []basics.BalanceRecord, err error) {
 	accounts := make(map[basics.Address]basics.AccountData)
 	for _, a := range ddr.Accounts {
 		var addr basics.Address
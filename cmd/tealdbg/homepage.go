@@ -91,6 +91,10 @@ var homepage string = `
                 background-color: #93F593;
             }
 
+            .changed {
+                background-color: #F5E393;
+            }
+
             .codetable tr {
                 height: 10px;
             }
@@ -207,10 +211,11 @@ var homepage string = `
                 sessions[state["execid"]] = clone;
             }
 
-            function updateMemory(table, values) {
+            function updateMemory(table, values, changed) {
                 table.innerHTML = "";
                 for (var i = 0; i < values.length; i++) {
                     var row = table.insertRow(-1);
+                    row.classList.toggle("changed", changed(i));
                     var lineno = row.insertCell(0);
                     var type = row.insertCell(1);
                     var value = row.insertCell(2);
@@ -229,12 +234,18 @@ var homepage string = `
             function setExecContents(exec, state) {
                 exec.querySelector(".exectitle").innerText = "Execution " + state["execid"];
 
-                // Update stack and scratch
+                // Update stack and scratch, highlighting the values changed by the last step
+                var stackdiff = state["stackdiff"] || {};
                 var stacktable = exec.querySelector(".stack");
-                updateMemory(stacktable, state["stack"] || [])
+                updateMemory(stacktable, state["stack"] || [], function(i) {
+                    return i >= (stackdiff["height"] || 0);
+                })
 
+                var scratchchanges = state["scratchchanges"] || [];
                 var scratchtable = exec.querySelector(".scratch");
-                updateMemory(scratchtable, state["scratch"])
+                updateMemory(scratchtable, state["scratch"], function(i) {
+                    return scratchchanges.some(function(change) { return (change["slot"] || 0) === i; });
+                })
 
                 var codelines = state["disasm"].split("\n");
                 var codetable = exec.querySelector(".codetable");
@@ -285,7 +296,7 @@ var homepage string = `
                         if (singlestep) {
                             // Tell server to notify us on this PC
                             let req = new XMLHttpRequest();
-                            req.open("POST", "/exec/step", false);
+                            req.open("POST", "exec/step" + location.search, false);
                             req.setRequestHeader("Content-Type", "application/json");
                             req.send(JSON.stringify({"execid": state["execid"], "breakatline": 0}));
                             return
@@ -303,13 +314,13 @@ var homepage string = `
 
                         // Tell server to notify us on this PC
                         var req = new XMLHttpRequest();
-                        req.open("POST", "/exec/config", false);
+                        req.open("POST", "exec/config" + location.search, false);
                         req.setRequestHeader("Content-Type", "application/json");
                         req.send(JSON.stringify({"execid": state["execid"], "breakatline": breakLine}));
 
                         // Tell server to continue
                         req = new XMLHttpRequest();
-                        req.open("POST", "/exec/continue");
+                        req.open("POST", "exec/continue" + location.search);
                         req.setRequestHeader("Content-Type", "application/json");
                         req.send(JSON.stringify({"execid": state["execid"]}));
                     }
@@ -326,7 +337,8 @@ var homepage string = `
         </script>
 
         <script>
-            const socket = new WebSocket('ws://localhost:9392/ws');
+            // endpoints are relative to the page, served under /sessions/<name>/ for named sessions
+            const socket = new WebSocket('ws://' + location.host + location.pathname.replace(/\/$/, '') + '/ws' + location.search);
 
             socket.addEventListener('open', function (event) {
                 socket.send('opened');
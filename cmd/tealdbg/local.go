@@ -228,6 +228,7 @@ type evaluation struct {
 	program        []byte
 	source         string
 	offsetToSource map[int]logic.SourceLocation
+	sourceMapped   bool
 	name           string
 	groupIndex     uint64
 	mode           modeType
@@ -237,6 +238,23 @@ type evaluation struct {
 	states         AppState
 }
 
+// applySourceMap makes the evaluation source the original one the TEAL source
+// was generated from, according to the source map in data
+func (e *evaluation) applySourceMap(data []byte) error {
+	sm, err := parseSourceMap(data)
+	if err != nil {
+		return err
+	}
+	source, offsetToSource, err := sm.mapSource(e.offsetToSource)
+	if err != nil {
+		return err
+	}
+	e.source = source
+	e.offsetToSource = offsetToSource
+	e.sourceMapped = true
+	return nil
+}
+
 func (e *evaluation) eval(gi int, sep *logic.EvalParams, aep *logic.EvalParams) (pass bool, err error) {
 	if e.mode == modeStateful {
 		pass, _, err = e.ba.StatefulEval(gi, aep, e.aidx, e.program)
@@ -252,7 +270,9 @@ type LocalRunner struct {
 	proto     config.ConsensusParams
 	protoName string
 	txnGroup  []transactions.SignedTxn
+	blocks    blockHeaders
 	runs      []evaluation
+	tracer    *execTracer
 }
 
 func makeAppState() (states AppState) {
@@ -308,7 +328,12 @@ func determineEvalMode(program []byte, modeIn string) (mode modeType, err error)
 //     In this case Accounts data is used as a base for balance records creation,
 //     and Apps supply updates to AppParams field.
 func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
-	ddr, err := ddrFromParams(dp)
+	var ddr dryrunRequest
+	if dp.FetchState {
+		ddr, err = ddrFromNetwork(dp)
+	} else {
+		ddr, err = ddrFromParams(dp)
+	}
 	if err != nil {
 		return
 	}
@@ -354,6 +379,7 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 	for _, record := range records {
 		balances[record.Addr] = record.AccountData
 	}
+	boxes := boxesFromDdr(&ddr)
 
 	if dp.Round == 0 && ddr.Round != 0 {
 		dp.Round = ddr.Round
@@ -363,6 +389,11 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 		dp.LatestTimestamp = int64(ddr.LatestTimestamp)
 	}
 
+	r.blocks, err = makeBlockHeaders(dp, r.protoName)
+	if err != nil {
+		return
+	}
+
 	// if program(s) specified then run from it
 	if len(dp.ProgramBlobs) > 0 {
 		if len(r.txnGroup) == 1 && dp.GroupIndex != 0 {
@@ -397,7 +428,15 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 				if !dp.DisableSourceMap {
 					r.runs[i].offsetToSource = ops.OffsetToSource
 					r.runs[i].source = source
+					if i < len(dp.SourceMapBlobs) && len(dp.SourceMapBlobs[i]) > 0 {
+						err = r.runs[i].applySourceMap(dp.SourceMapBlobs[i])
+						if err != nil {
+							return fmt.Errorf("%s: %w", dp.ProgramNames[i], err)
+						}
+					}
 				}
+			} else if i < len(dp.SourceMapBlobs) && len(dp.SourceMapBlobs[i]) > 0 {
+				return fmt.Errorf("%s: source map requires TEAL source of the program", dp.ProgramNames[i])
 			}
 			r.runs[i].groupIndex = uint64(dp.GroupIndex)
 			r.runs[i].name = dp.ProgramNames[i]
@@ -419,8 +458,8 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 				}
 
 				b, states, err = makeBalancesAdapter(
-					balances, r.txnGroup, dp.GroupIndex,
-					r.protoName, dp.Round, dp.LatestTimestamp, appIdx,
+					balances, boxes, r.txnGroup, dp.GroupIndex,
+					r.protoName, dp.Round, dp.LatestTimestamp, r.blocks, appIdx,
 					dp.Painless, dp.IndexerURL, dp.IndexerToken,
 				)
 				if err != nil {
@@ -454,8 +493,8 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 				if len(stxn.Txn.ApprovalProgram) > 0 {
 					appIdx = dp.AppID
 					b, states, err = makeBalancesAdapter(
-						balances, r.txnGroup, gi,
-						r.protoName, dp.Round, dp.LatestTimestamp,
+						balances, boxes, r.txnGroup, gi,
+						r.protoName, dp.Round, dp.LatestTimestamp, r.blocks,
 						appIdx, dp.Painless, dp.IndexerURL, dp.IndexerToken,
 					)
 					if err != nil {
@@ -489,8 +528,8 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 								return
 							}
 							b, states, err = makeBalancesAdapter(
-								balances, r.txnGroup, gi,
-								r.protoName, dp.Round, dp.LatestTimestamp,
+								balances, boxes, r.txnGroup, gi,
+								r.protoName, dp.Round, dp.LatestTimestamp, r.blocks,
 								appIdx, dp.Painless, dp.IndexerURL, dp.IndexerToken,
 							)
 							if err != nil {
@@ -525,32 +564,48 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 	return
 }
 
+// evalParams makes the logic sig and app eval params of the transaction group
+func (r *LocalRunner) evalParams() (sep *logic.EvalParams, aep *logic.EvalParams) {
+	txngroup := transactions.WrapSignedTxnsWithAD(r.txnGroup)
+	sep = logic.NewSigEvalParams(r.txnGroup, &r.proto, r.blocks)
+	aep = logic.NewAppEvalParams(txngroup, &r.proto, &transactions.SpecialAddresses{})
+	return
+}
+
 // RunAll runs all the programs
 func (r *LocalRunner) RunAll() error {
 	if len(r.runs) < 1 {
 		return fmt.Errorf("no program to debug")
 	}
 
-	txngroup := transactions.WrapSignedTxnsWithAD(r.txnGroup)
 	failed := 0
 	start := time.Now()
 
-	sep := logic.NewSigEvalParams(r.txnGroup, &r.proto, &logic.NoHeaderLedger{})
-	aep := logic.NewAppEvalParams(txngroup, &r.proto, &transactions.SpecialAddresses{})
+	sep, aep := r.evalParams()
 	if r.debugger != nil {
-		t := logic.MakeEvalTracerDebuggerAdaptor(r.debugger)
+		t := logic.MakeEvalTracerDebuggerAdaptorWithInners(r.debugger)
 		sep.Tracer = t
 		aep.Tracer = t
 	}
+	if r.tracer != nil {
+		sep.Tracer = r.tracer
+		aep.Tracer = r.tracer
+	}
 
 	var last error
 	for i := range r.runs {
 		run := &r.runs[i]
 		if r.debugger != nil {
-			r.debugger.SaveProgram(run.name, run.program, run.source, run.offsetToSource, run.states)
+			r.debugger.SaveProgram(run.name, run.program, run.source, run.offsetToSource, run.sourceMapped, run.states)
+		}
+		if r.tracer != nil {
+			r.tracer.beginRun(run)
 		}
 
 		run.result.pass, run.result.err = run.eval(int(run.groupIndex), sep, aep)
+		if r.tracer != nil {
+			r.tracer.endRun(run)
+		}
 		if run.result.err != nil {
 			failed++
 			last = run.result.err
@@ -562,3 +617,12 @@ func (r *LocalRunner) RunAll() error {
 	}
 	return nil
 }
+
+// Trace runs all the programs non-interactively and returns their execution traces
+func (r *LocalRunner) Trace() ([]runTrace, error) {
+	t := &execTracer{}
+	r.tracer = t
+	err := r.RunAll()
+	r.tracer = nil
+	return t.runs, err
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/apply"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
@@ -58,30 +60,120 @@ type ApplicationIndexerResponse struct {
 	CurrentRound uint64 `json:"current-round"`
 }
 
+// blockHeaders provides the headers of past blocks to the block opcode and to the
+// FirstValidTime txn field. All rounds share the seed and timestamp set in the debug
+// params so that programs consuming the randomness of the chain run deterministically.
+type blockHeaders struct {
+	proto     protocol.ConsensusVersion
+	seed      committee.Seed
+	timestamp int64
+}
+
+// makeBlockHeaders makes the block headers of dp, the timestamp of the blocks
+// defaults to the latest timestamp
+func makeBlockHeaders(dp *DebugParams, proto string) (blockHeaders, error) {
+	blocks := blockHeaders{
+		proto:     protocol.ConsensusVersion(proto),
+		timestamp: dp.BlockTimestamp,
+	}
+	if len(dp.BlockSeed) != 0 {
+		if len(dp.BlockSeed) != len(blocks.seed) {
+			return blockHeaders{}, fmt.Errorf("block seed must be %d bytes, got %d", len(blocks.seed), len(dp.BlockSeed))
+		}
+		copy(blocks.seed[:], dp.BlockSeed)
+	}
+	if blocks.timestamp == 0 {
+		blocks.timestamp = dp.LatestTimestamp
+	}
+	return blocks, nil
+}
+
+// BlockHdr returns the header of the block of round rnd
+func (b blockHeaders) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	return bookkeeping.BlockHeader{
+		Round:        rnd,
+		Seed:         b.seed,
+		TimeStamp:    b.timestamp,
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: b.proto},
+	}, nil
+}
+
+// GenesisHash returns the fixed genesis hash of programs evaluated in isolation
+func (b blockHeaders) GenesisHash() crypto.Digest {
+	return logic.NoHeaderLedger{}.GenesisHash()
+}
+
+// txnResources are the accounts and apps of an app call of the group. Under the
+// group resource sharing rules they are available to every app call of the group,
+// as well as the local states of the accounts of an app call in its apps.
+type txnResources struct {
+	accounts []basics.Address
+	apps     []basics.AppIndex
+}
+
+// groupResources returns the resources of the app calls of txnGroup, where appIdx
+// is the app called by the transaction at groupIndex
+func groupResources(txnGroup []transactions.SignedTxn, groupIndex int, appIdx basics.AppIndex) []txnResources {
+	resources := make([]txnResources, 0, len(txnGroup))
+	for gi := range txnGroup {
+		txn := &txnGroup[gi].Txn
+		var res txnResources
+		switch {
+		case gi == groupIndex:
+			res.apps = append(res.apps, appIdx)
+		case txn.Type != protocol.ApplicationCallTx:
+			continue
+		case txn.ApplicationID != 0:
+			res.apps = append(res.apps, txn.ApplicationID)
+		}
+		res.accounts = append(res.accounts, txn.Sender)
+		res.accounts = append(res.accounts, txn.Accounts...)
+		res.apps = append(res.apps, txn.ForeignApps...)
+		resources = append(resources, res)
+	}
+	return resources
+}
+
 type localLedger struct {
 	balances   map[basics.Address]basics.AccountData
+	boxes      map[string][]byte
 	txnGroup   []transactions.SignedTxn
 	groupIndex int
 	round      basics.Round
+	blocks     blockHeaders
 	aidx       basics.AppIndex
 }
 
 func makeBalancesAdapter(
-	balances map[basics.Address]basics.AccountData, txnGroup []transactions.SignedTxn,
-	groupIndex int, proto string, round basics.Round, latestTimestamp int64,
+	balances map[basics.Address]basics.AccountData, boxes map[string][]byte, txnGroup []transactions.SignedTxn,
+	groupIndex int, proto string, round basics.Round, latestTimestamp int64, blocks blockHeaders,
 	appIdx basics.AppIndex, painless bool, indexerURL string, indexerToken string,
 ) (apply.Balances, AppState, error) {
 
 	if groupIndex >= len(txnGroup) {
 		return nil, AppState{}, fmt.Errorf("invalid groupIndex %d exceed txn group length %d", groupIndex, len(txnGroup))
 	}
-	txn := txnGroup[groupIndex]
-
-	accounts := []basics.Address{txn.Txn.Sender}
-	accounts = append(accounts, txn.Txn.Accounts...)
 
-	apps := []basics.AppIndex{appIdx}
-	apps = append(apps, txn.Txn.ForeignApps...)
+	// the resources of the whole group are available to programs sharing them
+	resources := groupResources(txnGroup, groupIndex, appIdx)
+	var accounts []basics.Address
+	var apps []basics.AppIndex
+	seenAccounts := make(map[basics.Address]bool)
+	seenApps := make(map[basics.AppIndex]bool)
+	for _, res := range resources {
+		for _, addr := range res.accounts {
+			if !seenAccounts[addr] {
+				seenAccounts[addr] = true
+				accounts = append(accounts, addr)
+			}
+		}
+		for _, aid := range res.apps {
+			if !seenApps[aid] {
+				seenApps[aid] = true
+				apps = append(apps, aid)
+			}
+		}
+	}
 
 	// populate balances from the indexer if not already
 	if indexerURL != "" {
@@ -109,9 +201,11 @@ func makeBalancesAdapter(
 
 	ll := &localLedger{
 		balances:   balances,
+		boxes:      boxes,
 		txnGroup:   txnGroup,
 		groupIndex: groupIndex,
 		round:      round,
+		blocks:     blocks,
 	}
 
 	appsExist := make(map[basics.AppIndex]bool, len(apps))
@@ -138,7 +232,7 @@ func makeBalancesAdapter(
 		}
 	}
 
-	// painless mode creates all missed global states and opt-in all mentioned accounts
+	// painless mode creates all missed global states and opt-in the accounts of every app call in its apps
 	if painless {
 		for _, aid := range apps {
 			if ok := appsExist[aid]; !ok {
@@ -157,21 +251,26 @@ func makeBalancesAdapter(
 				}
 				balances[addr] = ad
 			}
-			for _, addr := range accounts {
-				ad, ok := balances[addr]
-				if !ok {
-					ad = basics.AccountData{
-						AppLocalStates: map[basics.AppIndex]basics.AppLocalState{},
+		}
+		for _, res := range resources {
+			for _, aid := range res.apps {
+				for _, addr := range res.accounts {
+					ad, ok := balances[addr]
+					if !ok {
+						ad = basics.AccountData{
+							AppLocalStates: map[basics.AppIndex]basics.AppLocalState{},
+						}
+						balances[addr] = ad
 					}
-					balances[addr] = ad
-				}
-				if ad.AppLocalStates == nil {
-					ad.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState)
-				}
-				_, ok = ad.AppLocalStates[aid]
-				if !ok {
-					ad.AppLocalStates[aid] = basics.AppLocalState{
-						Schema: makeLocalSchema(),
+					if ad.AppLocalStates == nil {
+						ad.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState)
+						balances[addr] = ad
+					}
+					_, ok = ad.AppLocalStates[aid]
+					if !ok {
+						ad.AppLocalStates[aid] = basics.AppLocalState{
+							Schema: makeLocalSchema(),
+						}
 					}
 				}
 			}
@@ -276,8 +375,8 @@ func getRandomAddress() (basics.Address, error) {
 	return basics.Address(address), nil
 }
 
-func (l *localLedger) BlockHdr(basics.Round) (bookkeeping.BlockHeader, error) {
-	return bookkeeping.BlockHeader{}, nil
+func (l *localLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	return l.blocks.BlockHdr(rnd)
 }
 
 func (l *localLedger) GenesisHash() crypto.Digest {
@@ -325,7 +424,8 @@ func (l *localLedger) LookupApplication(rnd basics.Round, addr basics.Address, a
 }
 
 func (l *localLedger) LookupKv(rnd basics.Round, name string) ([]byte, error) {
-	return nil, fmt.Errorf("boxes not implemented in debugger")
+	// nil for a missing box
+	return l.boxes[name], nil
 }
 
 func (l *localLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
@@ -106,8 +106,8 @@ int 2
 	}
 
 	ba, _, err := makeBalancesAdapter(
-		balances, []transactions.SignedTxn{txn}, 0, string(protocol.ConsensusCurrentVersion),
-		100, 102030, appIdx, false, "", "",
+		balances, nil, []transactions.SignedTxn{txn}, 0, string(protocol.ConsensusCurrentVersion),
+		100, 102030, blockHeaders{}, appIdx, false, "", "",
 	)
 	a.NoError(err)
 
@@ -17,7 +17,9 @@
 package main
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -1537,6 +1539,175 @@ func TestGroupTxnIdx(t *testing.T) {
 	a.Equal(allPassing(len(local.runs)), r)
 }
 
+func TestDdrBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	ops, err := logic.AssembleString(`#pragma version 8
+byte "counter"
+box_get
+assert
+btoi
+int 41
+==
+assert
+byte "counter"
+int 42
+itob
+box_put
+int 1`)
+	a.NoError(err)
+	program := base64.StdEncoding.EncodeToString(ops.Program)
+
+	ddrBlob := func(boxes string) []byte {
+		return []byte(fmt.Sprintf(`{
+		"accounts": [
+		  {
+			"address": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ",
+			"amount": 5000000,
+			"amount-without-pending-rewards": 5000000,
+			"pending-rewards": 0,
+			"rewards": 0,
+			"round": 2,
+			"status": "Offline"
+		  }
+		],
+		"apps": [
+		  {
+			"id": 1,
+			"params": {
+			  "approval-program": "%s",
+			  "clear-state-program": "%s",
+			  "creator": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ"
+			}
+		  }
+		],
+		%s
+		"round": 2,
+		"txns": [
+		  {
+			"txn": {
+			  "apid": 1,
+			  "apbx": [{"n": "Y291bnRlcg=="}],
+			  "fee": 1000,
+			  "fv": 3,
+			  "lv": 1003,
+			  "snd": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ",
+			  "type": "appl"
+			}
+		  }
+		]
+	  }`, program, program, boxes))
+	}
+
+	// "counter" box of the app holding 41
+	withBoxes := ddrBlob(`"boxes": [{"app-index": 1, "name": "Y291bnRlcg==", "value": "AAAAAAAAACk="}],`)
+	ds := DebugParams{
+		Proto:   string(protocol.ConsensusCurrentVersion),
+		DdrBlob: withBoxes,
+		RunMode: "application",
+	}
+	ddr, err := ddrFromParams(&ds)
+	a.NoError(err)
+	a.Equal([]dryrunBox{{AppIndex: 1, Name: []byte("counter"), Value: []byte{0, 0, 0, 0, 0, 0, 0, 41}}}, ddr.Boxes)
+
+	local := MakeLocalRunner(nil)
+	a.NoError(local.Setup(&ds))
+	r := runAllResultFromInvocation(*local)
+	a.Equal(allPassing(len(local.runs)), r)
+
+	// the box does not exist without it
+	ds.DdrBlob = ddrBlob("")
+	local = MakeLocalRunner(nil)
+	a.NoError(local.Setup(&ds))
+	r = runAllResultFromInvocation(*local)
+	a.Len(r.results, 1)
+	a.False(r.results[0].pass)
+	a.Error(r.results[0].err)
+}
+
+func TestFetchState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	ops, err := logic.AssembleString(`#pragma version 8
+byte "counter"
+box_get
+assert
+btoi
+int 41
+==`)
+	a.NoError(err)
+	program := base64.StdEncoding.EncodeToString(ops.Program)
+
+	creator := "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ"
+	responses := map[string]string{
+		"/v2/status": `{"last-round": 2}`,
+		"/v2/accounts/" + creator: fmt.Sprintf(`{"address": "%s", "amount": 5000000, "amount-without-pending-rewards": 5000000,
+			"pending-rewards": 0, "rewards": 0, "round": 2, "status": "Offline"}`, creator),
+		"/v2/applications/1": fmt.Sprintf(`{"id": 1, "params": {"approval-program": "%s", "clear-state-program": "%s", "creator": "%s"}}`,
+			program, program, creator),
+		"/v2/applications/1/box": `{"name": "Y291bnRlcg==", "round": 2, "value": "AAAAAAAAACk="}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("token", r.Header.Get("X-Algo-API-Token"))
+		if r.URL.Path == "/v2/applications/1/box" {
+			a.Equal("b64:Y291bnRlcg==", r.URL.Query().Get("name"))
+		}
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(200)
+		w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	txnBlob := []byte(fmt.Sprintf(`{
+		"txn": {
+		  "apid": 1,
+		  "apbx": [{"n": "Y291bnRlcg=="}],
+		  "fee": 1000,
+		  "fv": 3,
+		  "lv": 1003,
+		  "snd": "%s",
+		  "type": "appl"
+		}
+	  }`, creator))
+	ds := DebugParams{
+		Proto:      string(protocol.ConsensusCurrentVersion),
+		TxnBlob:    txnBlob,
+		AlgodURL:   srv.URL,
+		AlgodToken: "token",
+		FetchState: true,
+		RunMode:    "application",
+	}
+	ddr, err := ddrFromNetwork(&ds)
+	a.NoError(err)
+	a.Equal(basics.Round(2), ddr.Round)
+	a.Len(ddr.Txns, 1)
+	a.Len(ddr.Apps, 1)
+	a.Equal(basics.AppIndex(1), ddr.Apps[0].Id)
+	a.Len(ddr.Accounts, 1)
+	a.Equal(creator, ddr.Accounts[0].Address)
+	a.Equal([]dryrunBox{{AppIndex: 1, Name: []byte("counter"), Value: []byte{0, 0, 0, 0, 0, 0, 0, 41}}}, ddr.Boxes)
+
+	local := MakeLocalRunner(nil)
+	a.NoError(local.Setup(&ds))
+	r := runAllResultFromInvocation(*local)
+	a.Equal(allPassing(len(local.runs)), r)
+
+	// fetching requires algod or indexer
+	ds.AlgodURL = ""
+	_, err = ddrFromNetwork(&ds)
+	a.Error(err)
+}
+
 func TestRunAllGloads(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -1841,3 +2012,136 @@ func TestRunAllGloads(t *testing.T) {
 	err = local.RunAll()
 	a.NoError(err)
 }
+
+func TestBlockSeed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	seed := bytes.Repeat([]byte{7}, 32)
+	source := fmt.Sprintf(`#pragma version 8
+txn FirstValid
+int 1
+-
+dup
+block BlkSeed
+byte 0x%s
+==
+assert
+block BlkTimestamp
+int 1700000000
+==`, hex.EncodeToString(seed))
+
+	txnBlob := []byte(`{
+		"txn": {
+		  "apid": 1,
+		  "fee": 1000,
+		  "fv": 3,
+		  "lv": 1003,
+		  "snd": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ",
+		  "type": "appl"
+		}
+	  }`)
+
+	for _, mode := range []string{"signature", "application"} {
+		t.Run(mode, func(t *testing.T) {
+			a := require.New(t)
+			dp := DebugParams{
+				ProgramNames:    []string{"random.teal"},
+				ProgramBlobs:    [][]byte{[]byte(source)},
+				Proto:           string(protocol.ConsensusCurrentVersion),
+				TxnBlob:         txnBlob,
+				RunMode:         mode,
+				Painless:        true,
+				BlockSeed:       seed,
+				LatestTimestamp: 1700000000,
+			}
+			local := MakeLocalRunner(nil)
+			a.NoError(local.Setup(&dp))
+			r := runAllResultFromInvocation(*local)
+			a.Equal(allPassing(len(local.runs)), r)
+
+			// the block timestamp overrides the latest one
+			dp.BlockTimestamp = 1700000001
+			a.NoError(local.Setup(&dp))
+			r = runAllResultFromInvocation(*local)
+			a.False(r.results[0].pass)
+		})
+	}
+
+	dp := DebugParams{
+		ProgramNames: []string{"random.teal"},
+		ProgramBlobs: [][]byte{[]byte(source)},
+		TxnBlob:      txnBlob,
+		RunMode:      "signature",
+		BlockSeed:    seed[:31],
+	}
+	err := MakeLocalRunner(nil).Setup(&dp)
+	a.ErrorContains(err, "block seed must be 32 bytes, got 31")
+}
+
+func TestGroupResourceSharing(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	a.NoError(err)
+	account, err := basics.UnmarshalChecksumAddress("FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ")
+	a.NoError(err)
+
+	// the first app call makes the account and app 100 available to the second one
+	txn0 := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.ApplicationCallTx,
+			Header: transactions.Header{Sender: sender},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: 100,
+				Accounts:      []basics.Address{account},
+			},
+		},
+	}
+	txn1 := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.ApplicationCallTx,
+			Header: transactions.Header{Sender: sender},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: 200,
+			},
+		},
+	}
+	txnBlob := protocol.EncodeMsgp(&txn0)
+	txnBlob = append(txnBlob, protocol.EncodeMsgp(&txn1)...)
+
+	source := fmt.Sprintf(`#pragma version 9
+addr %s
+int 100
+app_opted_in
+assert
+int 100
+app_params_get AppCreator
+assert
+pop
+int 1`, account)
+
+	dp := DebugParams{
+		ProgramNames: []string{"shared.teal"},
+		ProgramBlobs: [][]byte{[]byte(source)},
+		Proto:        string(protocol.ConsensusCurrentVersion),
+		TxnBlob:      txnBlob,
+		GroupIndex:   1,
+		RunMode:      "application",
+		Painless:     true,
+	}
+	local := MakeLocalRunner(nil)
+	a.NoError(local.Setup(&dp))
+	a.Equal(allPassing(len(local.runs)), runAllResultFromInvocation(*local))
+
+	resources := groupResources(local.txnGroup, 1, 200)
+	a.Equal([]txnResources{
+		{accounts: []basics.Address{sender, account}, apps: []basics.AppIndex{100}},
+		{accounts: []basics.Address{sender}, apps: []basics.AppIndex{200}},
+	}, resources)
+}
@@ -17,9 +17,12 @@
 package main
 
 import (
+	"fmt"
+	"io"
 	"log"
 	"os"
 
+	"github.com/algorand/avm-abi/apps"
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
@@ -50,7 +53,7 @@ var rootCmd = &cobra.Command{
 	Use:   "tealdbg",
 	Short: "Algorand TEAL Debugger",
 	Long: `Debug a local or remote TEAL code in controlled environment
-with Web or Chrome DevTools frontends`,
+with Web, Chrome DevTools or Debug Adapter Protocol frontends`,
 	Run: func(cmd *cobra.Command, args []string) {
 		//If no arguments passed, we should fallback to help
 		cmd.HelpFunc()(cmd, args)
@@ -85,15 +88,24 @@ func (f *frontendValue) value() string {
 	return f.CobraStringValue.String()
 }
 
-func (f *frontendValue) Make(router *mux.Router, appAddress string) (da DebugAdapter) {
+func (f *frontendValue) Make(router *mux.Router, appAddress string, token string) (da DebugAdapter) {
 	switch f.value() {
 	case "web":
-		wa := MakeWebPageFrontend(&WebPageFrontendParams{router, appAddress})
+		wa := MakeWebPageFrontend(&WebPageFrontendParams{router, appAddress, token})
 		return wa
+	case "dap":
+		if len(token) != 0 {
+			log.Fatalln("Error: dap frontend does not support named sessions")
+		}
+		dapFrontend, err := MakeDapFrontend(&DapFrontendParams{fmt.Sprintf("%s:%d", iface, dapPort), verbose})
+		if err != nil {
+			log.Fatalf("Error starting DAP frontend: %s", err)
+		}
+		return dapFrontend
 	case "cdt":
 		fallthrough
 	default:
-		cdt := MakeCdtFrontend(&CdtFrontendParams{router, appAddress, verbose})
+		cdt := MakeCdtFrontend(&CdtFrontendParams{router, appAddress, token, verbose})
 		return cdt
 	}
 }
@@ -102,7 +114,7 @@ type runModeValue struct {
 	*cmdutil.CobraStringValue
 }
 
-var frontend frontendValue = frontendValue{cmdutil.MakeCobraStringValue("cdt", []string{"web"})}
+var frontend frontendValue = frontendValue{cmdutil.MakeCobraStringValue("cdt", []string{"web", "dap"})}
 var proto string
 var txnFile string
 var groupIndex int
@@ -110,10 +122,16 @@ var balanceFile string
 var ddrFile string
 var indexerURL string
 var indexerToken string
+var algodURL string
+var algodToken string
+var fetchState bool
 var roundNumber uint64
 var timestamp int64
+var blockSeed string
+var blockTimestamp int64
 var runMode runModeValue = runModeValue{cmdutil.MakeCobraStringValue("auto", []string{"signature", "application"})}
 var port int
+var dapPort int
 var iface string
 var noFirstRun bool
 var noBrowserCheck bool
@@ -122,19 +140,44 @@ var verbose bool
 var painless bool
 var appID basics.AppIndex
 var listenForDrReq bool
+var watchSpecs []string
+var deltaBreaks []string
+var sessionSpecs []string
+var sourceMapFiles []string
+var traceFile string
+var profileFile string
+var replMode bool
+var coverageFile string
+var coverageAnnotate bool
+var saveSessionFile string
+var restoreSessionFile string
+var simulate bool
+var matrixProtos []string
 
 func init() {
 	rootCmd.PersistentFlags().VarP(&frontend, "frontend", "f", "Frontend to use: "+frontend.AllowedString())
 	rootCmd.PersistentFlags().IntVar(&port, "remote-debugging-port", 9392, "Port to listen on")
 	rootCmd.PersistentFlags().StringVar(&iface, "listen", "127.0.0.1", "Network interface to listen on")
+	rootCmd.PersistentFlags().IntVar(&dapPort, "dap-port", 9393, "Port to listen on for DAP clients with the dap frontend")
 	rootCmd.PersistentFlags().BoolVar(&noFirstRun, "no-first-run", false, "")
 	rootCmd.PersistentFlags().MarkHidden("no-first-run")
 	rootCmd.PersistentFlags().BoolVar(&noBrowserCheck, "no-default-browser-check", false, "")
 	rootCmd.PersistentFlags().MarkHidden("no-default-browser-check")
 	rootCmd.PersistentFlags().BoolVar(&noSourceMap, "no-source-map", false, "Do not generate source maps")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Verbose output")
+	rootCmd.PersistentFlags().StringArrayVar(&deltaBreaks, "break-delta", nil, "Condition on the app state writes made so far to pause on after every app state write, e.g. 'writes global \"price\" > 0' or 'writes box > 3'")
+	rootCmd.PersistentFlags().StringArrayVar(&watchSpecs, "watch", nil, "App state to pause on when modified, in the form global:KEY, local:[ADDR]:KEY or box:NAME. Keys and box names are encoded as app call args, e.g. str:counter or b64:AA==")
 
 	debugCmd.Flags().StringVarP(&proto, "proto", "p", "", "Consensus protocol version for TEAL evaluation")
+	debugCmd.Flags().StringVar(&traceFile, "trace", "", "Run non-interactively and write the JSON execution trace to the file, - for stdout")
+	debugCmd.Flags().StringSliceVar(&matrixProtos, "proto-matrix", nil, "Run non-interactively under every consensus version of the comma separated list, such as current,future, and report the differences of results and costs")
+	debugCmd.Flags().StringVar(&profileFile, "profile", "", "Run non-interactively and write the budget consumption profile by source line and by opcode to the file, - for stdout")
+	debugCmd.Flags().StringVar(&coverageFile, "coverage", "", "Run non-interactively and add the line and branch coverage to the lcov tracefile, created if missing")
+	debugCmd.Flags().BoolVar(&coverageAnnotate, "coverage-annotate", false, "Print the program sources annotated with the coverage along with the tracefile")
+	debugCmd.Flags().StringVar(&saveSessionFile, "save-session", "", "Save the debugging session with the program(s), state, breakpoints and current step to the file every time the execution pauses")
+	debugCmd.Flags().StringVar(&restoreSessionFile, "restore-session", "", "Restore the debugging session saved to the file, pausing at the saved step")
+	debugCmd.Flags().BoolVar(&replMode, "repl", false, "Evaluate TEAL snippets typed in interactively against the transaction(s) and state instead of debugging program(s)")
+	debugCmd.Flags().StringArrayVar(&sourceMapFiles, "source-map", nil, "Source map of a TEAL program to the PyTeal or Tealish source it was generated from, one per program in the same order, empty for none")
 	debugCmd.Flags().StringVarP(&txnFile, "txn", "t", "", "Transaction(s) to evaluate TEAL on in form of json or msgpack file")
 	debugCmd.Flags().IntVarP(&groupIndex, "group-index", "g", 0, "Transaction index in a txn group")
 	debugCmd.Flags().StringVarP(&balanceFile, "balance", "b", "", "Balance records to evaluate stateful TEAL on in form of json or msgpack file")
@@ -142,11 +185,19 @@ func init() {
 	debugCmd.Flags().Uint64VarP((*uint64)(&appID), "app-id", "a", 1380011588, "Application ID for stateful TEAL if not set in transaction(s)")
 	debugCmd.Flags().Uint64VarP(&roundNumber, "round", "r", 0, "Ledger round number to evaluate stateful TEAL on")
 	debugCmd.Flags().Int64VarP(&timestamp, "latest-timestamp", "l", 0, "Latest confirmed timestamp to evaluate stateful TEAL on")
+	debugCmd.Flags().StringVar(&blockSeed, "block-seed", "", "Seed of the blocks returned by the block opcode, 32 bytes encoded as an app call arg, e.g. b64:... or addr:...")
+	debugCmd.Flags().Int64Var(&blockTimestamp, "block-timestamp", 0, "Timestamp of the blocks returned by the block opcode, defaults to the latest timestamp")
 	debugCmd.Flags().VarP(&runMode, "mode", "m", "TEAL evaluation mode: "+runMode.AllowedString())
 	debugCmd.Flags().BoolVar(&painless, "painless", false, "Automatically create balance record for all accounts and applications")
 	debugCmd.Flags().StringVarP(&indexerURL, "indexer-url", "i", "", "URL for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().StringVarP(&indexerToken, "indexer-token", "", "", "API token for indexer to fetch Balance records from to evaluate stateful TEAL")
+	debugCmd.Flags().StringVarP(&algodURL, "algod-url", "", "", "URL for algod to fetch the state from to evaluate stateful TEAL")
+	debugCmd.Flags().StringVarP(&algodToken, "algod-token", "", "", "API token for algod to fetch the state from to evaluate stateful TEAL")
+	debugCmd.Flags().BoolVarP(&fetchState, "fetch-state", "", false, "Fetch apps, accounts, assets and boxes the transaction(s) reference from algod or indexer")
 	debugCmd.Flags().BoolVarP(&listenForDrReq, "listen-dr-req", "q", false, "Listen for upcoming debugging dryrun request objects instead of taking program(s) from command line")
+	debugCmd.Flags().BoolVar(&simulate, "simulate", false, "Run the transaction(s) with the simulate endpoint of algod and debug the execution traces it returns")
+
+	remoteCmd.Flags().StringArrayVar(&sessionSpecs, "session", nil, "Named session in the form name:token served at /sessions/name to the requests with the token, for sharing the debugger between users. Repeat for more sessions")
 
 	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(remoteCmd)
@@ -154,12 +205,44 @@ func init() {
 
 func debugRemote() {
 	ds := makeDebugServer(iface, port, &frontend, nil)
+	setWatches(ds.debugger)
+	for _, spec := range sessionSpecs {
+		name, token, err := parseSessionSpec(spec)
+		if err != nil {
+			log.Fatalf("Error: %s", err.Error())
+		}
+		s, err := ds.addSession(&frontend, name, token)
+		if err != nil {
+			log.Fatalf("Error: %s", err.Error())
+		}
+		setWatches(s.debugger)
+	}
 	err := ds.startRemote()
 	if err != nil {
 		log.Fatalln(err.Error())
 	}
 }
 
+// setWatches sets the watchpoints and delta breakpoints of the command line on debugger
+func setWatches(debugger *Debugger) {
+	watchpoints := make([]Watchpoint, 0, len(watchSpecs))
+	for _, spec := range watchSpecs {
+		wp, err := parseWatchpoint(spec)
+		if err != nil {
+			log.Fatalf("Error: %s", err.Error())
+		}
+		watchpoints = append(watchpoints, wp)
+	}
+	err := debugger.SetWatchpoints(watchpoints)
+	if err != nil {
+		log.Fatalf("Error: %s", err.Error())
+	}
+	err = debugger.SetDeltaBreaks(deltaBreaks)
+	if err != nil {
+		log.Fatalf("Error: %s", err.Error())
+	}
+}
+
 func debugLocal(args []string) {
 	// local debugging works in two modes:
 	// - listening for upcoming Dryrun Requests
@@ -169,22 +252,74 @@ func debugLocal(args []string) {
 		log.Fatalln("Can not combine listening for Dryrun Requests and program(s), or transaction(s), or dryrun-req object")
 	}
 
-	if !listenForDrReq {
+	nonInteractive := 0
+	for _, file := range []string{traceFile, profileFile, coverageFile} {
+		if len(file) != 0 {
+			nonInteractive++
+		}
+	}
+	if len(matrixProtos) != 0 {
+		nonInteractive++
+	}
+
+	if listenForDrReq && nonInteractive > 0 {
+		log.Fatalln("Can not combine listening for Dryrun Requests and trace, profile, coverage or proto-matrix")
+	}
+
+	if nonInteractive > 1 {
+		log.Fatalln("Error: cannot specify more than one of trace, profile, coverage and proto-matrix")
+	}
+
+	if len(matrixProtos) != 0 && len(proto) != 0 {
+		log.Fatalln("Error: cannot specify both proto and proto-matrix")
+	}
+
+	if coverageAnnotate && len(coverageFile) == 0 {
+		log.Fatalln("Error: coverage-annotate requires coverage")
+	}
+
+	if replMode && (listenForDrReq || len(args) != 0 || nonInteractive > 0) {
+		log.Fatalln("Can not combine REPL and program(s), listening for Dryrun Requests, trace, profile, coverage or proto-matrix")
+	}
+
+	if len(saveSessionFile) != 0 && (listenForDrReq || replMode || nonInteractive > 0) {
+		log.Fatalln("Can not combine saving the session and listening for Dryrun Requests, REPL, trace, profile, coverage or proto-matrix")
+	}
+
+	if len(restoreSessionFile) != 0 && (listenForDrReq || len(args) != 0 || len(txnFile) != 0 || len(ddrFile) != 0 || len(balanceFile) != 0 || fetchState) {
+		log.Fatalln("Can not combine restoring a session and program(s), transaction(s), balance records, dryrun-req or fetch-state")
+	}
+
+	if simulate {
+		if len(txnFile) == 0 || len(algodURL) == 0 {
+			log.Fatalln("Error: simulate requires transaction(s) and algod URL")
+		}
+		if listenForDrReq || replMode || len(args) != 0 || len(ddrFile) != 0 || len(balanceFile) != 0 || fetchState || nonInteractive > 0 ||
+			len(saveSessionFile) != 0 || len(restoreSessionFile) != 0 {
+			log.Fatalln("Can not combine simulate and program(s), dryrun-req, balance records, fetch-state, listening for Dryrun Requests, REPL, trace, profile, coverage, proto-matrix or sessions")
+		}
+	}
+
+	if !listenForDrReq && len(restoreSessionFile) == 0 {
 		// program can be set either directly
 		// or with SignedTxn.Lsig.Logic,
 		// or with BalanceRecord.AppParams.ApprovalProgram
-		if len(args) == 0 && len(txnFile) == 0 && len(ddrFile) == 0 {
+		if len(args) == 0 && len(txnFile) == 0 && len(ddrFile) == 0 && !replMode {
 			log.Fatalln("No program to debug: must specify program(s), or transaction(s), or dryrun-req object")
 		}
 
-		if len(args) == 0 && groupIndex != 0 {
+		if len(args) == 0 && groupIndex != 0 && !replMode {
 			log.Fatalln("Error: group-index may be only set only along with program(s)")
 		}
 
-		if len(args) == 0 && runMode.IsSet() {
+		if len(args) == 0 && runMode.IsSet() && !replMode {
 			log.Fatalln("Error: mode may be only set only along with program(s)")
 		}
 
+		if len(sourceMapFiles) > len(args) {
+			log.Fatalln("Error: more source maps than programs")
+		}
+
 		if len(txnFile) != 0 && len(ddrFile) != 0 {
 			log.Fatalln("Error: cannot specify both transaction(s) and dryrun-req")
 		}
@@ -192,6 +327,18 @@ func debugLocal(args []string) {
 		if len(balanceFile) != 0 && len(ddrFile) != 0 {
 			log.Fatalln("Error: cannot specify both balance records(s) and dryrun-req")
 		}
+
+		if fetchState {
+			if len(txnFile) == 0 {
+				log.Fatalln("Error: fetch-state requires transaction(s)")
+			}
+			if len(algodURL) == 0 && len(indexerURL) == 0 {
+				log.Fatalln("Error: fetch-state requires algod or indexer URL")
+			}
+			if len(balanceFile) != 0 {
+				log.Fatalln("Error: cannot specify both balance records(s) and fetch-state")
+			}
+		}
 	}
 
 	var programNames []string
@@ -209,6 +356,21 @@ func debugLocal(args []string) {
 		}
 	}
 
+	var sourceMapBlobs [][]byte
+	if len(sourceMapFiles) > 0 {
+		sourceMapBlobs = make([][]byte, len(sourceMapFiles))
+		for i, file := range sourceMapFiles {
+			if len(file) == 0 {
+				continue
+			}
+			data, err := loadSourceMap(file)
+			if err != nil {
+				log.Fatalf("Error source map reading %s: %s", file, err)
+			}
+			sourceMapBlobs[i] = data
+		}
+	}
+
 	var err error
 	var txnBlob []byte
 	if len(txnFile) > 0 {
@@ -226,6 +388,18 @@ func debugLocal(args []string) {
 		}
 	}
 
+	var seed []byte
+	if len(blockSeed) > 0 {
+		arg, err := apps.NewAppCallBytes(blockSeed)
+		if err != nil {
+			log.Fatalf("Error block seed %s: %s", blockSeed, err)
+		}
+		seed, err = arg.Raw()
+		if err != nil {
+			log.Fatalf("Error block seed %s: %s", blockSeed, err)
+		}
+	}
+
 	var ddrBlob []byte
 	if len(ddrFile) > 0 {
 		ddrBlob, err = os.ReadFile(ddrFile)
@@ -237,6 +411,7 @@ func debugLocal(args []string) {
 	dp := DebugParams{
 		ProgramNames:     programNames,
 		ProgramBlobs:     programBlobs,
+		SourceMapBlobs:   sourceMapBlobs,
 		Proto:            proto,
 		TxnBlob:          txnBlob,
 		GroupIndex:       groupIndex,
@@ -244,16 +419,109 @@ func debugLocal(args []string) {
 		DdrBlob:          ddrBlob,
 		IndexerURL:       indexerURL,
 		IndexerToken:     indexerToken,
+		AlgodURL:         algodURL,
+		AlgodToken:       algodToken,
+		FetchState:       fetchState,
 		Round:            basics.Round(roundNumber),
 		LatestTimestamp:  timestamp,
+		BlockSeed:        seed,
+		BlockTimestamp:   blockTimestamp,
 		RunMode:          runMode.String(),
 		DisableSourceMap: noSourceMap,
 		AppID:            appID,
 		Painless:         painless,
 		ListenForDrReq:   listenForDrReq,
+		Simulate:         simulate,
+	}
+
+	var restored *savedSession
+	if len(restoreSessionFile) != 0 {
+		restored, err = loadSession(restoreSessionFile)
+		if err != nil {
+			log.Fatalf("Error session reading %s: %s", restoreSessionFile, err)
+		}
+		dp = restored.Params
+	}
+
+	if len(saveSessionFile) != 0 {
+		dp, err = freezeParams(dp)
+		if err != nil {
+			log.Fatalf("Error session saving: %s", err)
+		}
+	}
+
+	if replMode {
+		r, err := makeRepl(&dp)
+		if err != nil {
+			log.Fatalf("REPL error: %s", err.Error())
+		}
+		err = r.loop(os.Stdin, os.Stdout)
+		if err != nil {
+			log.Fatalf("REPL error: %s", err.Error())
+		}
+		return
+	}
+
+	if len(traceFile) != 0 {
+		out := os.Stdout
+		if traceFile != "-" {
+			out, err = os.Create(traceFile)
+			if err != nil {
+				log.Fatalf("Error trace creating %s: %s", traceFile, err)
+			}
+			defer out.Close()
+		}
+		err = writeTrace(&dp, out)
+		if err != nil {
+			log.Fatalf("Trace error: %s", err.Error())
+		}
+		return
+	}
+
+	if len(coverageFile) != 0 {
+		var annotated io.Writer
+		if coverageAnnotate {
+			annotated = os.Stdout
+		}
+		err = updateCoverage(&dp, coverageFile, annotated)
+		if err != nil {
+			log.Fatalf("Coverage error: %s", err.Error())
+		}
+		return
+	}
+
+	if len(profileFile) != 0 {
+		out := os.Stdout
+		if profileFile != "-" {
+			out, err = os.Create(profileFile)
+			if err != nil {
+				log.Fatalf("Error profile creating %s: %s", profileFile, err)
+			}
+			defer out.Close()
+		}
+		err = writeProfile(&dp, out)
+		if err != nil {
+			log.Fatalf("Profile error: %s", err.Error())
+		}
+		return
+	}
+
+	if len(matrixProtos) != 0 {
+		err = writeProtoMatrix(&dp, matrixProtos, os.Stdout)
+		if err != nil {
+			log.Fatalf("Proto matrix error: %s", err.Error())
+		}
+		return
 	}
 
 	ds := makeDebugServer(iface, port, &frontend, &dp)
+	setWatches(ds.debugger)
+	if len(saveSessionFile) != 0 {
+		ds.debugger.SaveSession(saveSessionFile, &dp)
+	}
+	if restored != nil {
+		ds.debugger.RestoreSession(restored)
+	}
 
 	err = ds.startDebug()
 	if err != nil {
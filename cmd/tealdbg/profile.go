@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// profileEntry is the budget consumption of a source line or an opcode
+type profileEntry struct {
+	line  int
+	op    string
+	text  string
+	count int
+	cost  int
+}
+
+// runProfile is the budget consumption of a program run grouped by source line and by opcode
+type runProfile struct {
+	name       string
+	groupIndex uint64
+	budget     int
+	cost       int
+	lines      []profileEntry
+	ops        []profileEntry
+}
+
+// programTrace returns the trace of the program the transaction evaluated
+func (tt *txnTrace) programTrace() []traceUnit {
+	switch {
+	case len(tt.LogicSigTrace) > 0:
+		return tt.LogicSigTrace
+	case len(tt.ClearStateProgramTrace) > 0:
+		return tt.ClearStateProgramTrace
+	default:
+		return tt.ApprovalProgramTrace
+	}
+}
+
+// runSource returns the source of the run and its program offsets to source locations,
+// disassembling the program if the run has no source
+func runSource(run *evaluation) (string, map[int]logic.SourceLocation) {
+	if len(run.source) != 0 && run.offsetToSource != nil {
+		return run.source, run.offsetToSource
+	}
+	source, err := logic.Disassemble(run.program)
+	if err != nil {
+		return "", nil
+	}
+	ops, err := logic.AssembleString(source)
+	if err != nil {
+		return "", nil
+	}
+	return source, ops.OffsetToSource
+}
+
+func sortProfileEntries(entries []profileEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].cost != entries[j].cost {
+			return entries[i].cost > entries[j].cost
+		}
+		return entries[i].count > entries[j].count
+	})
+}
+
+// makeRunProfile groups the budget consumption of the run trace by source line and by opcode
+func makeRunProfile(run *evaluation, rt *runTrace, proto *config.ConsensusParams) runProfile {
+	p := runProfile{name: run.name, groupIndex: run.groupIndex, cost: rt.BudgetConsumed}
+	if run.mode == modeStateful {
+		p.budget = proto.MaxAppProgramCost
+	} else {
+		p.budget = int(proto.LogicSigMaxCost)
+	}
+
+	source, offsetToSource := runSource(run)
+	sourceLines := strings.Split(source, "\n")
+	lines := make(map[int]*profileEntry)
+	ops := make(map[string]*profileEntry)
+	for _, unit := range rt.ExecTrace.programTrace() {
+		line := -1
+		if loc, ok := offsetToSource[unit.PC]; ok {
+			line = loc.Line
+		}
+		le, ok := lines[line]
+		if !ok {
+			le = &profileEntry{line: line}
+			if line >= 0 && line < len(sourceLines) {
+				le.text = strings.TrimSpace(sourceLines[line])
+			}
+			lines[line] = le
+		}
+		le.count++
+		le.cost += unit.Cost
+
+		oe, ok := ops[unit.Op]
+		if !ok {
+			oe = &profileEntry{op: unit.Op}
+			ops[unit.Op] = oe
+		}
+		oe.count++
+		oe.cost += unit.Cost
+	}
+
+	for _, le := range lines {
+		p.lines = append(p.lines, *le)
+	}
+	sort.Slice(p.lines, func(i, j int) bool { return p.lines[i].line < p.lines[j].line })
+	sortProfileEntries(p.lines)
+	for _, oe := range ops {
+		p.ops = append(p.ops, *oe)
+	}
+	sort.Slice(p.ops, func(i, j int) bool { return p.ops[i].op < p.ops[j].op })
+	sortProfileEntries(p.ops)
+	return p
+}
+
+func (p *runProfile) percent(cost int) string {
+	if p.budget == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(cost)*100/float64(p.budget))
+}
+
+// write writes the profile as tables of source lines and opcodes, the most expensive first
+func (p *runProfile) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "%s (group index %d): cost %d of %d budget (%s)\n\n", p.name, p.groupIndex, p.cost, p.budget, p.percent(p.cost))
+
+	fmt.Fprintf(tw, "Line\tCount\tCost\t%% Budget\t\n")
+	for _, e := range p.lines {
+		line := "-"
+		if e.line >= 0 {
+			line = fmt.Sprintf("%d", e.line+1)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t  %s\n", line, e.count, e.cost, p.percent(e.cost), e.text)
+	}
+	fmt.Fprintf(tw, "\nOpcode\tCount\tCost\t%% Budget\t\n")
+	for _, e := range p.ops {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t\n", e.op, e.count, e.cost, p.percent(e.cost))
+	}
+	return tw.Flush()
+}
+
+// writeProfile runs the programs dp sets up and writes their budget consumption profiles to w
+func writeProfile(dp *DebugParams, w io.Writer) error {
+	r := MakeLocalRunner(nil)
+	err := r.Setup(dp)
+	if err != nil {
+		return err
+	}
+	runs, runErr := r.Trace()
+	for i := range runs {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		p := makeRunProfile(&r.runs[i], &runs[i], &r.proto)
+		err = p.write(w)
+		if err != nil {
+			return err
+		}
+	}
+	return runErr
+}
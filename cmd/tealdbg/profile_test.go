@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	source := `#pragma version 8
+pushint 3
+loop:
+pushbytes "abc"
+sha256
+pop
+pushint 1
+-
+dup
+bnz loop
+pop
+pushint 1`
+	ops, err := logic.AssembleString(source)
+	a.NoError(err)
+
+	for _, blob := range [][]byte{[]byte(source), ops.Program} {
+		dp := DebugParams{
+			ProgramNames: []string{"test"},
+			ProgramBlobs: [][]byte{blob},
+			Proto:        string(protocol.ConsensusCurrentVersion),
+			RunMode:      "signature",
+		}
+		local := MakeLocalRunner(nil)
+		a.NoError(local.Setup(&dp))
+		runs, err := local.Trace()
+		a.NoError(err)
+		a.Len(runs, 1)
+
+		p := makeRunProfile(&local.runs[0], &runs[0], &local.proto)
+		a.Equal("test", p.name)
+		a.Equal(126, p.cost)
+		a.Equal(int(local.proto.LogicSigMaxCost), p.budget)
+
+		// the most expensive line and opcode come first
+		a.Equal(profileEntry{line: p.lines[0].line, text: "sha256", count: 3, cost: 105}, p.lines[0])
+		a.Equal(profileEntry{op: "sha256", count: 3, cost: 105}, p.ops[0])
+		a.Equal(profileEntry{op: "pushint", count: 5, cost: 5}, p.ops[1])
+		total := 0
+		for _, e := range p.lines {
+			total += e.cost
+		}
+		a.Equal(p.cost, total)
+	}
+
+	// lines of the source are numbered from 1
+	var out bytes.Buffer
+	dp := DebugParams{
+		ProgramNames: []string{"test"},
+		ProgramBlobs: [][]byte{[]byte(source)},
+		Proto:        string(protocol.ConsensusCurrentVersion),
+		RunMode:      "signature",
+	}
+	a.NoError(writeProfile(&dp, &out))
+	report := out.String()
+	a.Contains(report, "test (group index 0): cost 126 of 20000 budget (0.6%)")
+	a.Regexp(`\s5\s+3\s+105\s+\S+%\s+sha256\n`, report)
+	a.Regexp(`sha256\s+3\s+105\s+\S+%`, report)
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// matrixResult is the result of a program run under a consensus version
+type matrixResult struct {
+	pass bool
+	err  string
+	cost int
+}
+
+func (m matrixResult) String() string {
+	switch {
+	case len(m.err) != 0:
+		return fmt.Sprintf("error, cost %d", m.cost)
+	case m.pass:
+		return fmt.Sprintf("pass, cost %d", m.cost)
+	default:
+		return fmt.Sprintf("reject, cost %d", m.cost)
+	}
+}
+
+// protoMatrix is the results of the program runs dp sets up under several consensus versions
+type protoMatrix struct {
+	protos []string
+	// setupErrors are the errors of the consensus versions the runs could not be set up under
+	setupErrors []string
+	names       []string
+	groups      []uint64
+	// results are the results of the runs by consensus version
+	results [][]matrixResult
+}
+
+// makeProtoMatrix runs the programs dp sets up under every consensus version of protos.
+// The state is fetched once so that every version evaluates the programs against the same one.
+func makeProtoMatrix(dp *DebugParams, protos []string) (*protoMatrix, error) {
+	if len(protos) == 0 {
+		return nil, fmt.Errorf("no consensus versions to run under")
+	}
+	params := *dp
+	if params.FetchState {
+		ddr, err := ddrFromNetwork(&params)
+		if err != nil {
+			return nil, err
+		}
+		params.DdrBlob = protocol.EncodeReflect(&ddr)
+		params.FetchState = false
+	}
+
+	m := &protoMatrix{
+		protos:      protos,
+		setupErrors: make([]string, len(protos)),
+		results:     make([][]matrixResult, len(protos)),
+	}
+	setUp := 0
+	for i, proto := range protos {
+		params.Proto = proto
+		r := MakeLocalRunner(nil)
+		err := r.Setup(&params)
+		if err != nil {
+			m.setupErrors[i] = err.Error()
+			continue
+		}
+		setUp++
+		// a run error only reports all the runs failed, which the results tell
+		runs, _ := r.Trace()
+		if m.names == nil {
+			for _, run := range runs {
+				m.names = append(m.names, run.Name)
+				m.groups = append(m.groups, run.GroupIndex)
+			}
+		}
+		for _, run := range runs {
+			m.results[i] = append(m.results[i], matrixResult{pass: run.Pass, err: run.Error, cost: run.BudgetConsumed})
+		}
+	}
+	if setUp == 0 {
+		return nil, fmt.Errorf("programs could not be set up under any consensus version: %s", m.setupErrors[0])
+	}
+	return m, nil
+}
+
+// result returns the result of run under the consensus version, false if it did not run
+func (m *protoMatrix) result(proto int, run int) (matrixResult, bool) {
+	if run >= len(m.results[proto]) {
+		return matrixResult{}, false
+	}
+	return m.results[proto][run], true
+}
+
+// differences describes the results of run differing between consensus versions,
+// empty if all the versions agree
+func (m *protoMatrix) differences(run int) []string {
+	var diffs []string
+	base := -1
+	for i := range m.protos {
+		result, ok := m.result(i, run)
+		if !ok {
+			continue
+		}
+		if base < 0 {
+			base = i
+			continue
+		}
+		first, _ := m.result(base, run)
+		if result != first {
+			diffs = append(diffs, fmt.Sprintf("%s: %s, %s: %s", m.protos[base], describeResult(first), m.protos[i], describeResult(result)))
+		}
+	}
+	return diffs
+}
+
+func describeResult(m matrixResult) string {
+	if len(m.err) != 0 {
+		return fmt.Sprintf("%s (%s)", m, m.err)
+	}
+	return m.String()
+}
+
+// write writes the results as a table of runs by consensus version followed by their differences
+func (m *protoMatrix) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Program\tGroup index\t%s\t\n", strings.Join(m.protos, "\t"))
+	for run, name := range m.names {
+		cells := make([]string, len(m.protos))
+		for i := range m.protos {
+			if result, ok := m.result(i, run); ok {
+				cells[i] = result.String()
+			} else {
+				cells[i] = "-"
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t\n", name, m.groups[run], strings.Join(cells, "\t"))
+	}
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	same := true
+	for i, setupErr := range m.setupErrors {
+		if len(setupErr) != 0 {
+			fmt.Fprintf(w, "%s: not run: %s\n", m.protos[i], setupErr)
+			same = false
+		}
+	}
+	for run, name := range m.names {
+		for _, diff := range m.differences(run) {
+			fmt.Fprintf(w, "%s (group index %d) differs: %s\n", name, m.groups[run], diff)
+			same = false
+		}
+	}
+	if same {
+		fmt.Fprintln(w, "No differences between consensus versions")
+	}
+	return nil
+}
+
+// writeProtoMatrix runs the programs dp sets up under every consensus version of protos
+// and writes their results and differences to w
+func writeProtoMatrix(dp *DebugParams, protos []string, w io.Writer) error {
+	m, err := makeProtoMatrix(dp, protos)
+	if err != nil {
+		return err
+	}
+	return m.write(w)
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProtoMatrix(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	protos := []string{string(protocol.ConsensusV18), string(protocol.ConsensusCurrentVersion)}
+	dp := DebugParams{
+		ProgramNames: []string{"test"},
+		ProgramBlobs: [][]byte{[]byte("int 1")},
+		RunMode:      "signature",
+	}
+	m, err := makeProtoMatrix(&dp, protos)
+	a.NoError(err)
+	a.Equal([]string{"test"}, m.names)
+	a.Len(m.results, 2)
+	a.True(m.results[0][0].pass)
+	a.Equal(m.results[0][0], m.results[1][0])
+	a.Empty(m.differences(0))
+
+	var out bytes.Buffer
+	a.NoError(m.write(&out))
+	a.Contains(out.String(), "pass, cost")
+	a.Contains(out.String(), "No differences between consensus versions")
+
+	// programs beyond a consensus version are not run under it
+	dp.ProgramBlobs = [][]byte{[]byte("#pragma version 8\npushint 1")}
+	m, err = makeProtoMatrix(&dp, protos)
+	a.NoError(err)
+	a.Contains(m.setupErrors[0], "beyond the maximum supported")
+	a.Empty(m.results[0])
+	a.True(m.results[1][0].pass)
+
+	out.Reset()
+	a.NoError(m.write(&out))
+	a.Contains(out.String(), string(protocol.ConsensusV18)+": not run")
+	a.NotContains(out.String(), "No differences")
+
+	_, err = makeProtoMatrix(&dp, protos[:1])
+	a.ErrorContains(err, "could not be set up")
+}
+
+func TestProtoMatrixDifferences(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	m := protoMatrix{
+		protos:      []string{"current", "future", "next"},
+		setupErrors: make([]string, 3),
+		names:       []string{"test"},
+		groups:      []uint64{0},
+		results: [][]matrixResult{
+			{{pass: true, cost: 10}},
+			{{pass: true, cost: 10}},
+			{{err: "budget exceeded", cost: 700}},
+		},
+	}
+	require.Equal(t, []string{"current: pass, cost 10, next: error, cost 700 (budget exceeded)"}, m.differences(0))
+
+	var out bytes.Buffer
+	require.NoError(t, m.write(&out))
+	require.Contains(t, out.String(), "test (group index 0) differs: current: pass, cost 10, next: error, cost 700 (budget exceeded)")
+}
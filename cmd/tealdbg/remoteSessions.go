@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// sessionTokenHeader is the header of the requests to named sessions with their token
+const sessionTokenHeader = "X-Tealdbg-Token"
+
+var sessionNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// namedSession is a debugging session of a shared remote debugger served under /sessions/<name>,
+// with its own debugger and frontend so that concurrent users do not see each other's programs
+type namedSession struct {
+	name     string
+	token    string
+	debugger *Debugger
+	frontend DebugAdapter
+	remote   *RemoteHookAdapter
+}
+
+// parseSessionSpec parses a name:token named session specification
+func parseSessionSpec(spec string) (name string, token string, err error) {
+	name, token, ok := strings.Cut(spec, ":")
+	if !ok || len(token) == 0 {
+		return "", "", fmt.Errorf("session %s must be in form name:token", spec)
+	}
+	return name, token, nil
+}
+
+// addSession adds the named session protected by token to the server. It must be called before
+// the server starts, and the routes of the unnamed session are not served once there is one.
+func (ds *DebugServer) addSession(ff FrontendFactory, name string, token string) (*namedSession, error) {
+	if !sessionNameRe.MatchString(name) {
+		return nil, fmt.Errorf("invalid session name %q: only letters, digits, - and _ are allowed", name)
+	}
+	if len(token) == 0 {
+		return nil, fmt.Errorf("session %s requires a token", name)
+	}
+	if _, ok := ds.sessions[name]; ok {
+		return nil, fmt.Errorf("duplicate session %s", name)
+	}
+
+	prefix := "/sessions/" + name
+	router := ds.router.PathPrefix(prefix).Subrouter()
+	router.Use(tokenAuth(token))
+
+	s := &namedSession{name: name, token: token, debugger: MakeDebugger()}
+	s.frontend = ff.Make(router, ds.server.Addr+prefix, token)
+	s.debugger.AddAdapter(s.frontend)
+	s.remote = MakeRemoteHook(s.debugger)
+	s.remote.Setup(router)
+
+	if ds.sessions == nil {
+		ds.sessions = make(map[string]*namedSession)
+		ds.router.Use(sessionsOnly)
+	}
+	ds.sessions[name] = s
+	return s, nil
+}
+
+// tokenAuth rejects the requests without token in the X-Tealdbg-Token header or the token
+// query parameter, the latter for browsers and Chrome DevTools unable to set headers
+func tokenAuth(token string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got := r.Header.Get(sessionTokenHeader)
+			if len(got) == 0 {
+				got = r.URL.Query().Get("token")
+			}
+			if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// sessionsOnly rejects the requests to the routes of the unnamed session, which have no token,
+// once the server serves named sessions
+func sessionsOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/sessions/") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
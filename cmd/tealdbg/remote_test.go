@@ -86,3 +86,63 @@ func TestRemoteAdapterHandlers(t *testing.T) {
 	router.ServeHTTP(rr, req)
 	require.Equal(t, http.StatusBadRequest, rr.Code)
 }
+
+func TestRemoteSessions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ds := makeDebugServer("127.0.0.1", 0, &mockFactory{}, nil)
+	ds.router.HandleFunc("/exec/step", func(w http.ResponseWriter, r *http.Request) {}).Methods("POST")
+	alice, err := ds.addSession(&mockFactory{}, "alice", "alice-token")
+	require.NoError(t, err)
+	bob, err := ds.addSession(&mockFactory{}, "bob", "bob-token")
+	require.NoError(t, err)
+	require.NotSame(t, alice.debugger, bob.debugger)
+
+	_, err = ds.addSession(&mockFactory{}, "alice", "other")
+	require.ErrorContains(t, err, "duplicate")
+	_, err = ds.addSession(&mockFactory{}, "a/b", "token")
+	require.ErrorContains(t, err, "invalid session name")
+	_, err = ds.addSession(&mockFactory{}, "carol", "")
+	require.ErrorContains(t, err, "requires a token")
+
+	data := protocol.EncodeJSON(&logic.DebugState{ExecID: "test"})
+	post := func(path string, token string) int {
+		req, _ := http.NewRequest("POST", path, bytes.NewReader(data))
+		if len(token) != 0 {
+			req.Header.Set(sessionTokenHeader, token)
+		}
+		rr := httptest.NewRecorder()
+		ds.router.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	require.Equal(t, http.StatusUnauthorized, post("/sessions/alice/exec/register", ""))
+	require.Equal(t, http.StatusUnauthorized, post("/sessions/alice/exec/register", "bob-token"))
+	require.Equal(t, http.StatusOK, post("/sessions/alice/exec/register", "alice-token"))
+	require.Equal(t, http.StatusOK, post("/sessions/alice/exec/update?token=alice-token", ""))
+	require.Equal(t, http.StatusOK, post("/sessions/alice/exec/complete", "alice-token"))
+	require.Equal(t, http.StatusNotFound, post("/sessions/carol/exec/register", "alice-token"))
+
+	// the unnamed session is not served to anyone
+	require.Equal(t, http.StatusUnauthorized, post("/exec/step", ""))
+	require.Equal(t, http.StatusUnauthorized, post("/exec/step", "alice-token"))
+
+	// the session of bob does not know the program of alice
+	require.Equal(t, http.StatusBadRequest, post("/sessions/bob/exec/update", "bob-token"))
+}
+
+func TestParseSessionSpec(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	name, token, err := parseSessionSpec("ci:s3cr:et")
+	require.NoError(t, err)
+	require.Equal(t, "ci", name)
+	require.Equal(t, "s3cr:et", token)
+
+	_, _, err = parseSessionSpec("ci")
+	require.Error(t, err)
+	_, _, err = parseSessionSpec("ci:")
+	require.Error(t, err)
+}
@@ -0,0 +1,276 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// replTail ends every snippet program successfully whatever the snippet leaves on the stack
+const replTail = "\npushint 1\nreturn"
+
+// replTailSize is the size of the assembled replTail
+const replTailSize = 3
+
+const replHelp = `TEAL opcodes, one or more per line separated by ';', run against the stack and scratch space.
+Commands:
+  :stack    show the stack
+  :scratch  show the scratch space
+  :clear    clear the stack and scratch space
+  :help     show this help
+  :quit     exit`
+
+// repl evaluates TEAL snippets in the context of a local run, keeping
+// the stack and scratch space between them. App state changes persist as well.
+type repl struct {
+	runner  *LocalRunner
+	run     *evaluation
+	version uint64
+	stack   []basics.TealValue
+	scratch map[int]basics.TealValue
+}
+
+// replTracer saves the stack and scratch space when the snippet completes
+type replTracer struct {
+	logic.NullEvalTracer
+	tail    int
+	depth   int
+	done    bool
+	stack   []basics.TealValue
+	scratch map[int]basics.TealValue
+}
+
+func (t *replTracer) save(cx *logic.EvalContext) {
+	t.done = true
+	t.stack = make([]basics.TealValue, len(cx.Stack))
+	for i := range cx.Stack {
+		t.stack[i] = cx.Stack[i].ToTealValue()
+	}
+	t.scratch = make(map[int]basics.TealValue)
+	for i := range cx.Scratch {
+		tv := cx.Scratch[i].ToTealValue()
+		if tv.Type == basics.TealBytesType || tv.Uint != 0 {
+			t.scratch[i] = tv
+		}
+	}
+}
+
+// BeforeProgram tracks the depth of inner transaction programs
+func (t *replTracer) BeforeProgram(cx *logic.EvalContext) {
+	t.depth++
+}
+
+// BeforeOpcode saves the state when the snippet reaches the tail
+func (t *replTracer) BeforeOpcode(cx *logic.EvalContext) {
+	if t.depth == 1 && !t.done && cx.PC() == t.tail {
+		t.save(cx)
+	}
+}
+
+// AfterProgram saves the state of snippets ending the program themselves
+func (t *replTracer) AfterProgram(cx *logic.EvalContext, pass bool, evalError error) {
+	if t.depth == 1 && !t.done && evalError == nil {
+		t.save(cx)
+	}
+	t.depth--
+}
+
+// makeRepl sets up the context of the debug params to evaluate snippets in,
+// in the mode of the transaction at the group index unless set
+func makeRepl(dp *DebugParams) (*repl, error) {
+	var txnGroup []transactions.SignedTxn
+	if len(dp.TxnBlob) == 0 && len(dp.DdrBlob) != 0 {
+		ddr, err := ddrFromParams(dp)
+		if err != nil {
+			return nil, err
+		}
+		txnGroup = ddr.Txns
+	}
+	if len(txnGroup) == 0 {
+		var err error
+		txnGroup, err = txnGroupFromParams(dp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if dp.GroupIndex < 0 || dp.GroupIndex >= len(txnGroup) {
+		return nil, fmt.Errorf("invalid group index %d for a transaction group of %d", dp.GroupIndex, len(txnGroup))
+	}
+	if dp.RunMode == "auto" {
+		dp.RunMode = "signature"
+		if txnGroup[dp.GroupIndex].Txn.Type == protocol.ApplicationCallTx {
+			dp.RunMode = "application"
+		}
+	}
+	dp.ProgramNames = []string{"repl"}
+	dp.ProgramBlobs = [][]byte{[]byte("int 1")}
+
+	r := &repl{runner: MakeLocalRunner(nil)}
+	err := r.runner.Setup(dp)
+	if err != nil {
+		return nil, err
+	}
+	r.run = &r.runner.runs[0]
+	r.version = r.runner.proto.LogicSigVersion
+	r.scratch = make(map[int]basics.TealValue)
+	return r, nil
+}
+
+// pushValue returns the opcode pushing the value
+func pushValue(tv basics.TealValue) string {
+	if tv.Type == basics.TealBytesType {
+		if len(tv.Bytes) == 0 {
+			return `pushbytes ""`
+		}
+		return "pushbytes 0x" + hex.EncodeToString([]byte(tv.Bytes))
+	}
+	return fmt.Sprintf("pushint %d", tv.Uint)
+}
+
+// program makes the program restoring the scratch space and the stack, then evaluating the snippet
+func (r *repl) program(snippet string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "#pragma version %d\n", r.version)
+	slots := make([]int, 0, len(r.scratch))
+	for slot := range r.scratch {
+		slots = append(slots, slot)
+	}
+	sort.Ints(slots)
+	for _, slot := range slots {
+		fmt.Fprintf(&sb, "%s\nstore %d\n", pushValue(r.scratch[slot]), slot)
+	}
+	for _, tv := range r.stack {
+		fmt.Fprintf(&sb, "%s\n", pushValue(tv))
+	}
+	sb.WriteString(snippet)
+	sb.WriteString(replTail)
+	return sb.String()
+}
+
+// eval evaluates the snippet, keeping the stack and scratch space it leaves unless it fails
+func (r *repl) eval(snippet string) error {
+	ops, err := logic.AssembleStringWithVersion(r.program(snippet), r.version)
+	if err != nil {
+		if len(ops.Errors) > 0 {
+			return ops.Errors[0].Err
+		}
+		return err
+	}
+
+	tracer := &replTracer{tail: len(ops.Program) - replTailSize}
+	sep, aep := r.runner.evalParams()
+	sep.Tracer = tracer
+	aep.Tracer = tracer
+	r.run.program = ops.Program
+	_, err = r.run.eval(int(r.run.groupIndex), sep, aep)
+	if !tracer.done {
+		if err == nil {
+			err = fmt.Errorf("snippet did not complete")
+		}
+		return err
+	}
+	r.stack = tracer.stack
+	r.scratch = tracer.scratch
+	return nil
+}
+
+// formatValue formats the value as a number, or as hex bytes along with the string they make if printable
+func formatValue(tv basics.TealValue) string {
+	if tv.Type != basics.TealBytesType {
+		return strconv.FormatUint(tv.Uint, 10)
+	}
+	s := "0x" + hex.EncodeToString([]byte(tv.Bytes))
+	if len(tv.Bytes) > 0 && IsText([]byte(tv.Bytes)) {
+		s += fmt.Sprintf(" %q", tv.Bytes)
+	}
+	return s
+}
+
+func (r *repl) writeStack(w io.Writer) {
+	if len(r.stack) == 0 {
+		fmt.Fprintln(w, "stack is empty")
+		return
+	}
+	for i := len(r.stack) - 1; i >= 0; i-- {
+		fmt.Fprintf(w, "%d: %s\n", i, formatValue(r.stack[i]))
+	}
+}
+
+func (r *repl) writeScratch(w io.Writer) {
+	if len(r.scratch) == 0 {
+		fmt.Fprintln(w, "scratch space is empty")
+		return
+	}
+	slots := make([]int, 0, len(r.scratch))
+	for slot := range r.scratch {
+		slots = append(slots, slot)
+	}
+	sort.Ints(slots)
+	for _, slot := range slots {
+		fmt.Fprintf(w, "scratch %d: %s\n", slot, formatValue(r.scratch[slot]))
+	}
+}
+
+// loop reads snippets and commands from in until the end of input or :quit, writing the results to out
+func (r *repl) loop(in io.Reader, out io.Writer) error {
+	fmt.Fprintf(out, "TEAL v%d %s mode, :help for help\n", r.version, r.run.mode.String())
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+		case ":quit":
+			return nil
+		case ":help":
+			fmt.Fprintln(out, replHelp)
+		case ":stack":
+			r.writeStack(out)
+		case ":scratch":
+			r.writeScratch(out)
+		case ":clear":
+			r.stack = nil
+			r.scratch = make(map[int]basics.TealValue)
+		default:
+			if strings.HasPrefix(line, ":") {
+				fmt.Fprintf(out, "unknown command %s, :help for help\n", line)
+				continue
+			}
+			err := r.eval(line)
+			if err != nil {
+				fmt.Fprintf(out, "error: %s\n", err.Error())
+				continue
+			}
+			r.writeStack(out)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplSignature(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	dp := DebugParams{
+		Proto:   string(protocol.ConsensusCurrentVersion),
+		RunMode: "auto",
+	}
+	r, err := makeRepl(&dp)
+	a.NoError(err)
+	a.Equal(modeLogicsig, r.run.mode)
+
+	a.NoError(r.eval("int 1; int 2"))
+	a.Equal([]basics.TealValue{{Type: basics.TealUintType, Uint: 1}, {Type: basics.TealUintType, Uint: 2}}, r.stack)
+	a.NoError(r.eval("+"))
+	a.Equal([]basics.TealValue{{Type: basics.TealUintType, Uint: 3}}, r.stack)
+	a.NoError(r.eval("store 5"))
+	a.Empty(r.stack)
+	a.Equal(map[int]basics.TealValue{5: {Type: basics.TealUintType, Uint: 3}}, r.scratch)
+	a.NoError(r.eval(`load 5; itob; byte "abc"`))
+	a.Equal([]basics.TealValue{
+		{Type: basics.TealBytesType, Bytes: string([]byte{0, 0, 0, 0, 0, 0, 0, 3})},
+		{Type: basics.TealBytesType, Bytes: "abc"},
+	}, r.stack)
+
+	// failing snippets leave the stack as is
+	a.ErrorContains(r.eval("pop; err"), "err opcode")
+	a.Len(r.stack, 2)
+	a.ErrorContains(r.eval("bogus"), "unknown opcode")
+	a.Len(r.stack, 2)
+	a.ErrorContains(r.eval("box_get"), "not allowed in current mode")
+	a.Len(r.stack, 2)
+
+	var out bytes.Buffer
+	a.NoError(r.loop(strings.NewReader(":stack\n:scratch\nconcat; len\n:clear\n:stack\n:bogus\n:quit\nint 1\n"), &out))
+	a.Equal(fmt.Sprintf(`TEAL v%d logicsig mode, :help for help
+> 1: 0x616263 "abc"
+0: 0x0000000000000003
+> scratch 5: 3
+> 0: 11
+> > stack is empty
+> unknown command :bogus, :help for help
+> `, r.version), out.String())
+}
+
+func TestReplBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	ops, err := logic.AssembleString("#pragma version 8\nint 1")
+	a.NoError(err)
+	program := base64.StdEncoding.EncodeToString(ops.Program)
+
+	ddrBlob := []byte(fmt.Sprintf(`{
+		"accounts": [
+		  {
+			"address": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ",
+			"amount": 5000000,
+			"amount-without-pending-rewards": 5000000,
+			"pending-rewards": 0,
+			"rewards": 0,
+			"round": 2,
+			"status": "Offline"
+		  }
+		],
+		"apps": [
+		  {
+			"id": 1,
+			"params": {
+			  "approval-program": "%s",
+			  "clear-state-program": "%s",
+			  "creator": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ"
+			}
+		  }
+		],
+		"round": 2,
+		"txns": [
+		  {
+			"txn": {
+			  "apid": 1,
+			  "apbx": [{"n": "Y291bnRlcg=="}],
+			  "fee": 1000,
+			  "fv": 3,
+			  "lv": 1003,
+			  "snd": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ",
+			  "type": "appl"
+			}
+		  }
+		]
+	  }`, program, program))
+	dp := DebugParams{
+		Proto:   string(protocol.ConsensusCurrentVersion),
+		DdrBlob: ddrBlob,
+		RunMode: "auto",
+	}
+	r, err := makeRepl(&dp)
+	a.NoError(err)
+	a.Equal(modeStateful, r.run.mode)
+
+	// boxes persist between snippets
+	a.NoError(r.eval(`byte "counter"; int 42; itob; box_put`))
+	a.Empty(r.stack)
+	a.NoError(r.eval(`byte "counter"; box_get`))
+	a.Equal([]basics.TealValue{
+		{Type: basics.TealBytesType, Bytes: string([]byte{0, 0, 0, 0, 0, 0, 0, 42})},
+		{Type: basics.TealUintType, Uint: 1},
+	}, r.stack)
+	a.NoError(r.eval(`assert; btoi; int 1; +; itob; byte "counter"; swap; box_put; byte "counter"; box_get; assert; btoi`))
+	a.Equal([]basics.TealValue{{Type: basics.TealUintType, Uint: 43}}, r.stack)
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// savedSession is a debugging session saved to a file to restore it later, possibly on
+// another machine: the debug params with the programs and the state they run on, and the
+// breakpoints, watchpoints and step of each execution in the order they started
+type savedSession struct {
+	Params DebugParams `json:"params"`
+	Execs  []savedExec `json:"execs"`
+}
+
+// savedExec is the debugging state of an execution of a program
+type savedExec struct {
+	Program     string            `json:"program"`
+	Breakpoints []savedBreakpoint `json:"breakpoints,omitempty"`
+	Watchpoints []savedWatchpoint `json:"watchpoints,omitempty"`
+	// Step is the number of steps the execution went through
+	Step int `json:"step"`
+}
+
+type savedBreakpoint struct {
+	Line      int    `json:"line"`
+	Active    bool   `json:"active"`
+	Condition string `json:"condition,omitempty"`
+}
+
+type savedWatchpoint struct {
+	Watchpoint string `json:"watchpoint"`
+	Active     bool   `json:"active"`
+}
+
+// freezeParams makes debug params not depending on the network: the state fetched
+// for dp is set as the dryrun request of the params, and API tokens are dropped
+func freezeParams(dp DebugParams) (DebugParams, error) {
+	if dp.ListenForDrReq {
+		return DebugParams{}, fmt.Errorf("cannot save the session of dryrun requests listened for")
+	}
+	if dp.FetchState {
+		ddr, err := ddrFromNetwork(&dp)
+		if err != nil {
+			return DebugParams{}, err
+		}
+		dp.DdrBlob = protocol.EncodeReflect(&ddr)
+		dp.FetchState = false
+	} else if len(dp.IndexerURL) != 0 {
+		return DebugParams{}, fmt.Errorf("cannot save the session of balance records fetched from indexer, use fetch-state")
+	}
+	dp.AlgodURL, dp.AlgodToken = "", ""
+	dp.IndexerURL, dp.IndexerToken = "", ""
+	return dp, nil
+}
+
+// loadSession reads the debugging session saved to the file at path
+func loadSession(path string) (*savedSession, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ss savedSession
+	err = json.Unmarshal(data, &ss)
+	if err != nil {
+		return nil, fmt.Errorf("session %s: %w", path, err)
+	}
+	return &ss, nil
+}
+
+// SaveSession makes the debugger save the debugging session of dp to the file at path
+// every time an execution pauses or completes
+func (d *Debugger) SaveSession(path string, dp *DebugParams) {
+	d.mus.Lock()
+	defer d.mus.Unlock()
+	d.sessionPath = path
+	d.saved = savedSession{Params: *dp}
+}
+
+// RestoreSession sets the breakpoints and watchpoints of the executions of ss
+// on the sessions started afterwards, which pause at the step saved
+func (d *Debugger) RestoreSession(ss *savedSession) {
+	d.mus.Lock()
+	defer d.mus.Unlock()
+	d.restored = ss
+}
+
+// restoreExec sets up s as the execution of the restored session started in the same order
+// when it runs the same program, lock must be taken
+func (d *Debugger) restoreExec(s *session) {
+	if d.restored == nil || s.exec >= len(d.restored.Execs) {
+		return
+	}
+	saved := d.restored.Execs[s.exec]
+	if saved.Program != s.programName {
+		logging.Base().Warnf("session execution %d runs %s rather than %s, not restored", s.exec, s.programName, saved.Program)
+		return
+	}
+	for _, bp := range saved.Breakpoints {
+		if bp.Line < 0 || bp.Line >= len(s.breakpoints) {
+			logging.Base().Warnf("invalid saved bp line %d", bp.Line)
+			continue
+		}
+		if len(bp.Condition) > 0 {
+			cond, err := parseBreakCondition(bp.Condition)
+			if err != nil {
+				logging.Base().Warnf("saved bp line %d: %s", bp.Line, err.Error())
+				continue
+			}
+			s.conditions[bp.Line] = cond
+		}
+		s.breakpoints[bp.Line] = breakpoint{set: true, active: bp.Active}
+	}
+	for _, sw := range saved.Watchpoints {
+		wp, err := parseWatchpoint(sw.Watchpoint)
+		if err != nil {
+			logging.Base().Warnf("saved watchpoint: %s", err.Error())
+			continue
+		}
+		s.watchpoints[wp] = sw.Active
+	}
+	s.stopAt = saved.Step
+}
+
+// saveExec saves the debugging state of the execution of s to the session file, if any
+func (d *Debugger) saveExec(s *session) {
+	s.mu.Lock()
+	exec := savedExec{Program: s.programName, Step: s.steps}
+	for line, bp := range s.breakpoints {
+		if bp.NonEmpty() {
+			saved := savedBreakpoint{Line: line, Active: bp.active}
+			if cond, ok := s.conditions[line]; ok {
+				saved.Condition = cond.source
+			}
+			exec.Breakpoints = append(exec.Breakpoints, saved)
+		}
+	}
+	for wp, active := range s.watchpoints {
+		exec.Watchpoints = append(exec.Watchpoints, savedWatchpoint{wp.String(), active})
+	}
+	s.mu.Unlock()
+	slices.SortFunc(exec.Watchpoints, func(a, b savedWatchpoint) int {
+		return cmp.Compare(a.Watchpoint, b.Watchpoint)
+	})
+
+	d.mus.Lock()
+	defer d.mus.Unlock()
+	if len(d.sessionPath) == 0 {
+		return
+	}
+	for len(d.saved.Execs) <= s.exec {
+		d.saved.Execs = append(d.saved.Execs, savedExec{})
+	}
+	d.saved.Execs[s.exec] = exec
+	data, err := json.MarshalIndent(&d.saved, "", "  ")
+	if err == nil {
+		err = os.WriteFile(d.sessionPath, data, 0644)
+	}
+	if err != nil {
+		logging.Base().Errorf("error saving session to %s: %s", d.sessionPath, err.Error())
+	}
+}
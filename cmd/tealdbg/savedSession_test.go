@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSaveRestoreSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusV18]
+	// lines: #pragma, intcblock, intc_0, intc_1, +, intc_2, ==
+	ops, err := logic.AssembleStringWithVersion("int 1; int 2; +; int 3; ==", 1)
+	require.NoError(t, err)
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+
+	run := func(debugger *Debugger, da *scriptedDbgAdapter) {
+		debugger.AddAdapter(da)
+		ep := logic.NewSigEvalParams([]transactions.SignedTxn{txn}, &proto, logic.NoHeaderLedger{})
+		ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(debugger)
+		pass, err := logic.EvalSignature(0, ep)
+		require.NoError(t, err)
+		require.True(t, pass)
+		da.WaitForCompletion()
+		require.Empty(t, da.actions)
+	}
+
+	path := filepath.Join(t.TempDir(), "session.json")
+	dp := DebugParams{ProgramNames: []string{"test.teal"}, ProgramBlobs: [][]byte{ops.Program}, RunMode: "signature"}
+	debugger := MakeDebugger()
+	debugger.SaveSession(path, &dp)
+
+	var saved *savedSession
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){
+		func(c Control) {
+			require.NoError(t, c.SetConditionalBreakpoint(4, "stack[0] == 1"))
+			require.NoError(t, c.SetWatchpoint(Watchpoint{State: logic.GlobalState, Key: "counter"}))
+			c.Resume()
+		},
+		func(c Control) { c.Step() },
+		func(c Control) {
+			var err error
+			saved, err = loadSession(path)
+			require.NoError(t, err)
+			c.Resume()
+		},
+	}
+	run(debugger, da)
+	require.Equal(t, [][2]int{{4, 2}, {5, 1}}, da.shown)
+
+	require.Equal(t, dp, saved.Params)
+	require.Equal(t, []savedExec{{
+		Breakpoints: []savedBreakpoint{{Line: 4, Active: true, Condition: "stack[0] == 1"}},
+		Watchpoints: []savedWatchpoint{{Watchpoint: "global:str:counter", Active: true}},
+		Step:        5,
+	}}, saved.Execs)
+
+	// the restored session pauses at the breakpoint, and then at the step it was saved at
+	debugger = MakeDebugger()
+	debugger.RestoreSession(saved)
+	resume := func(c Control) { c.Resume() }
+	da = &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){resume, resume, resume}
+	run(debugger, da)
+	require.Equal(t, [][2]int{{4, 2}, {5, 1}}, da.shown)
+}
+
+func TestFreezeParams(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dp := DebugParams{
+		ProgramNames: []string{"test.teal"},
+		ProgramBlobs: [][]byte{{1}},
+		AlgodURL:     "http://localhost:4001",
+		AlgodToken:   "token",
+	}
+	frozen, err := freezeParams(dp)
+	require.NoError(t, err)
+	require.Empty(t, frozen.AlgodURL)
+	require.Empty(t, frozen.AlgodToken)
+	require.Equal(t, dp.ProgramBlobs, frozen.ProgramBlobs)
+
+	// balance records from indexer are not part of the params
+	dp.IndexerURL = "http://localhost:8980"
+	_, err = freezeParams(dp)
+	require.ErrorContains(t, err, "use fetch-state")
+
+	dp.IndexerURL = ""
+	dp.ListenForDrReq = true
+	_, err = freezeParams(dp)
+	require.Error(t, err)
+}
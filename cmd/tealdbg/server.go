@@ -72,6 +72,7 @@ type DebugServer struct {
 	remote    *RemoteHookAdapter
 	params    *DebugParams
 	spinoffCh chan spinoffMsg
+	sessions  map[string]*namedSession
 }
 
 type spinoffMsg struct {
@@ -83,6 +84,7 @@ type spinoffMsg struct {
 type DebugParams struct {
 	ProgramNames     []string
 	ProgramBlobs     [][]byte
+	SourceMapBlobs   [][]byte
 	Proto            string
 	TxnBlob          []byte
 	GroupIndex       int
@@ -90,18 +92,30 @@ type DebugParams struct {
 	DdrBlob          []byte
 	IndexerURL       string
 	IndexerToken     string
+	AlgodURL         string
+	AlgodToken       string
+	FetchState       bool
 	Round            basics.Round
 	LatestTimestamp  int64
+	BlockSeed        []byte
+	BlockTimestamp   int64
 	RunMode          string
 	DisableSourceMap bool
 	AppID            basics.AppIndex
 	Painless         bool
 	ListenForDrReq   bool
+	Simulate         bool
+}
+
+// runner executes the programs of DebugParams with the debugger attached
+type runner interface {
+	Setup(dp *DebugParams) error
+	RunAll() error
 }
 
 // FrontendFactory interface for attaching debug frontends
 type FrontendFactory interface {
-	Make(router *mux.Router, appAddress string) (da DebugAdapter)
+	Make(router *mux.Router, appAddress string, token string) (da DebugAdapter)
 }
 
 func makeDebugServer(iface string, port int, ff FrontendFactory, dp *DebugParams) DebugServer {
@@ -110,7 +124,7 @@ func makeDebugServer(iface string, port int, ff FrontendFactory, dp *DebugParams
 	router := mux.NewRouter()
 	appAddress := fmt.Sprintf("%s:%d", iface, port)
 
-	da := ff.Make(router, appAddress)
+	da := ff.Make(router, appAddress, "")
 	debugger.AddAdapter(da)
 
 	server := &http.Server{
@@ -131,9 +145,15 @@ func makeDebugServer(iface string, port int, ff FrontendFactory, dp *DebugParams
 }
 
 func (ds *DebugServer) startRemote() error {
-	remote := MakeRemoteHook(ds.debugger)
-	remote.Setup(ds.router)
-	ds.remote = remote
+	// a shared debugger only serves the named sessions, see sessionsOnly
+	if len(ds.sessions) == 0 {
+		remote := MakeRemoteHook(ds.debugger)
+		remote.Setup(ds.router)
+		ds.remote = remote
+	}
+	for name := range ds.sessions {
+		log.Printf("serving session %s at http://%s/sessions/%s", name, ds.server.Addr, name)
+	}
 
 	log.Printf("starting server on %s", ds.server.Addr)
 	err := ds.server.ListenAndServe()
@@ -148,7 +168,10 @@ func (ds *DebugServer) startRemote() error {
 // So that for ListenForDrReq case a new endpoint is created and incoming data is await first.
 // Then execution is set up and program(s) run with stage-by-stage sync with ListenForDrReq's handler.
 func (ds *DebugServer) startDebug() (err error) {
-	local := MakeLocalRunner(ds.debugger)
+	var local runner = MakeLocalRunner(ds.debugger)
+	if ds.params.Simulate {
+		local = makeSimulateRunner(ds.debugger)
+	}
 
 	if ds.params.ListenForDrReq {
 		path := "/spinoff"
@@ -65,7 +65,7 @@ func (t testServerDebugFrontend) eventLoop() {
 	}
 }
 
-func (f *mockFactory) Make(router *mux.Router, appAddress string) (da DebugAdapter) {
+func (f *mockFactory) Make(router *mux.Router, appAddress string, token string) (da DebugAdapter) {
 	return testServerDebugFrontend{}
 }
 
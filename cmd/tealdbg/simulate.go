@@ -0,0 +1,382 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// simulateRunner runs the transaction group with the simulate endpoint of algod rather than
+// the local evaluator, and replays the execution traces it returns to the debugger. The programs
+// then run exactly as on the node, with its ledger and consensus rules.
+type simulateRunner struct {
+	debugger *Debugger
+	fetcher  *stateFetcher
+	proto    config.ConsensusParams
+	txnGroup []transactions.SignedTxn
+	round    basics.Round
+}
+
+// simulateReplay is the execution of a program replayed from its trace
+type simulateReplay struct {
+	name       string
+	program    []byte
+	groupIndex int
+	states     AppState
+	trace      []model.SimulationOpcodeTraceUnit
+	err        string
+}
+
+func makeSimulateRunner(debugger *Debugger) *simulateRunner {
+	return &simulateRunner{debugger: debugger}
+}
+
+// Setup validates the transaction group and algod to simulate it with
+func (r *simulateRunner) Setup(dp *DebugParams) (err error) {
+	if len(dp.AlgodURL) == 0 {
+		return fmt.Errorf("simulate requires algod URL")
+	}
+	if len(dp.TxnBlob) == 0 {
+		return fmt.Errorf("simulate requires transaction(s)")
+	}
+	r.fetcher = &stateFetcher{url: dp.AlgodURL, token: dp.AlgodToken}
+	_, r.proto, err = protoFromString(dp.Proto)
+	if err != nil {
+		return
+	}
+	r.txnGroup, err = txnGroupFromParams(dp)
+	r.round = dp.Round
+	return
+}
+
+// simulate runs the transaction group with the simulate endpoint of algod, with empty
+// signatures allowed to debug unsigned transactions
+func (r *simulateRunner) simulate() (*model.SimulateTransactionGroupResult, error) {
+	txns := make([]json.RawMessage, len(r.txnGroup))
+	for i := range r.txnGroup {
+		txns[i] = protocol.EncodeJSON(&r.txnGroup[i])
+	}
+	enable := true
+	request := model.SimulateRequest{
+		TxnGroups:            []model.SimulateRequestTransactionGroup{{Txns: txns}},
+		AllowEmptySignatures: &enable,
+		ExecTraceConfig: &model.SimulateTraceConfig{
+			Enable:        &enable,
+			StackChange:   &enable,
+			ScratchChange: &enable,
+			StateChange:   &enable,
+		},
+	}
+	if r.round != 0 {
+		request.Round = &r.round
+	}
+	var response model.SimulateResponse
+	err := r.fetcher.post("/v2/transactions/simulate", &request, &response)
+	if err != nil {
+		return nil, fmt.Errorf("simulate: %w", err)
+	}
+	if len(response.TxnGroups) != 1 || len(response.TxnGroups[0].TxnResults) != len(r.txnGroup) {
+		return nil, fmt.Errorf("simulate: unexpected results of %d groups", len(response.TxnGroups))
+	}
+	log.Printf("Simulated at round %d", response.LastRound)
+	return &response.TxnGroups[0], nil
+}
+
+// appProgram returns the approval or clear state program of the app called by the
+// transaction at gi, checking it is the one with hash that ran, and the app state
+func (r *simulateRunner) appProgram(gi int, clearState bool, hash *[]byte) (program []byte, states AppState, err error) {
+	txn := &r.txnGroup[gi].Txn
+	states = makeAppState()
+	states.appIdx = txn.ApplicationID
+	if txn.ApplicationID == 0 {
+		program = txn.ApprovalProgram
+		if clearState {
+			program = txn.ClearStateProgram
+		}
+	} else {
+		var app model.Application
+		err = r.fetcher.get(fmt.Sprintf("/v2/applications/%d", txn.ApplicationID), url.Values{}, "", &app)
+		if err != nil {
+			return nil, AppState{}, fmt.Errorf("app %d: %w", txn.ApplicationID, err)
+		}
+		var params basics.AppParams
+		params, err = v2.ApplicationParamsToAppParams(&app.Params)
+		if err != nil {
+			return nil, AppState{}, fmt.Errorf("app %d: %w", txn.ApplicationID, err)
+		}
+		program = params.ApprovalProgram
+		if clearState {
+			program = params.ClearStateProgram
+		}
+		states.schemas = params.StateSchemas
+		states.global[txn.ApplicationID] = params.GlobalState
+	}
+	if hash != nil {
+		digest := crypto.Hash(program)
+		if !bytes.Equal(digest[:], *hash) {
+			return nil, AppState{}, fmt.Errorf("app %d: the program that ran is not the current one", txn.ApplicationID)
+		}
+	}
+	return program, states, nil
+}
+
+// replays returns the program executions of the simulation result in the order they ran:
+// logic sigs first, and then app calls. The failing program of the group gets its error.
+func (r *simulateRunner) replays(result *model.SimulateTransactionGroupResult) ([]simulateReplay, error) {
+	var sigs, apps []simulateReplay
+	for gi, txnResult := range result.TxnResults {
+		trace := txnResult.ExecTrace
+		if trace == nil {
+			continue
+		}
+		if trace.LogicSigTrace != nil {
+			sigs = append(sigs, simulateReplay{
+				name:       fmt.Sprintf("logicsig %d", gi),
+				program:    r.txnGroup[gi].Lsig.Logic,
+				groupIndex: gi,
+				trace:      *trace.LogicSigTrace,
+			})
+		}
+		for _, clearState := range []bool{false, true} {
+			units, hash, name := trace.ApprovalProgramTrace, trace.ApprovalProgramHash, "approval"
+			if clearState {
+				units, hash, name = trace.ClearStateProgramTrace, trace.ClearStateProgramHash, "clear state"
+			}
+			if units == nil {
+				continue
+			}
+			program, states, err := r.appProgram(gi, clearState, hash)
+			if err != nil {
+				return nil, err
+			}
+			apps = append(apps, simulateReplay{
+				name:       fmt.Sprintf("app %d %s", r.txnGroup[gi].Txn.ApplicationID, name),
+				program:    program,
+				groupIndex: gi,
+				states:     states,
+				trace:      *units,
+			})
+		}
+	}
+	replays := append(sigs, apps...)
+
+	if result.FailureMessage != nil && result.FailedAt != nil && len(*result.FailedAt) > 0 {
+		failed := (*result.FailedAt)[0]
+		for i := len(replays) - 1; i >= 0; i-- {
+			if replays[i].groupIndex == failed {
+				replays[i].err = *result.FailureMessage
+				break
+			}
+		}
+	}
+	return replays, nil
+}
+
+// RunAll simulates the transaction group and replays the programs that ran to the debugger
+func (r *simulateRunner) RunAll() error {
+	result, err := r.simulate()
+	if err != nil {
+		return err
+	}
+	replays, err := r.replays(result)
+	if err != nil {
+		return err
+	}
+	if len(replays) == 0 {
+		return fmt.Errorf("no programs ran in the simulation")
+	}
+
+	txnGroup := transactions.WrapSignedTxnsWithAD(r.txnGroup)
+	for gi, txnResult := range result.TxnResults {
+		if txnResult.TxnResult.ApplicationIndex != nil {
+			txnGroup[gi].ApplyData.ApplicationID = *txnResult.TxnResult.ApplicationIndex
+		}
+	}
+	for i := range replays {
+		replays[i].run(r.debugger, txnGroup, &r.proto, result.TxnResults[replays[i].groupIndex].TxnResult.Logs)
+	}
+	return nil
+}
+
+// avmValue converts a value of an execution trace to a debugger one
+func avmValue(av model.AvmValue) basics.TealValue {
+	if av.Type == uint64(basics.TealBytesType) && av.Bytes != nil {
+		return basics.TealValue{Type: basics.TealBytesType, Bytes: base64.StdEncoding.EncodeToString(*av.Bytes)}
+	}
+	if av.Uint != nil {
+		return basics.TealValue{Type: basics.TealUintType, Uint: *av.Uint}
+	}
+	if av.Type == uint64(basics.TealBytesType) {
+		return basics.TealValue{Type: basics.TealBytesType}
+	}
+	return basics.TealValue{Type: basics.TealUintType}
+}
+
+// stateChange converts the app state operation of an execution trace made by the app call
+// txn to the app state change of a debugger step
+func stateChange(op model.ApplicationStateOperation, txn *transactions.Transaction) *logic.AppStateChange {
+	change := &logic.AppStateChange{AppID: txn.ApplicationID, Key: string(op.Key), Op: logic.AppStateWrite}
+	if op.Operation == "d" {
+		change.Op = logic.AppStateDelete
+	}
+	switch op.AppStateType {
+	case "g":
+		change.State = logic.GlobalState
+	case "l":
+		change.State = logic.LocalState
+		if op.Account != nil {
+			change.Account, _ = basics.UnmarshalChecksumAddress(*op.Account)
+		}
+	case "b":
+		change.State = logic.BoxState
+	default:
+		return nil
+	}
+	return change
+}
+
+// applyStateChange applies the app state change of the step with the value of op to delta
+func applyStateChange(delta *transactions.EvalDelta, change *logic.AppStateChange, op model.ApplicationStateOperation, txn *transactions.Transaction) {
+	vd := basics.ValueDelta{Action: basics.DeleteAction}
+	if change.Op == logic.AppStateWrite && op.NewValue != nil {
+		if tv := avmValue(*op.NewValue); tv.Type == basics.TealBytesType {
+			vd = basics.ValueDelta{Action: basics.SetBytesAction, Bytes: string(*op.NewValue.Bytes)}
+		} else {
+			vd = basics.ValueDelta{Action: basics.SetUintAction, Uint: tv.Uint}
+		}
+	}
+	switch change.State {
+	case logic.GlobalState:
+		if delta.GlobalDelta == nil {
+			delta.GlobalDelta = make(basics.StateDelta)
+		}
+		delta.GlobalDelta[change.Key] = vd
+	case logic.LocalState:
+		accounts := append([]basics.Address{txn.Sender}, txn.Accounts...)
+		for idx, addr := range accounts {
+			if addr == change.Account {
+				if delta.LocalDeltas == nil {
+					delta.LocalDeltas = make(map[uint64]basics.StateDelta)
+				}
+				if delta.LocalDeltas[uint64(idx)] == nil {
+					delta.LocalDeltas[uint64(idx)] = make(basics.StateDelta)
+				}
+				delta.LocalDeltas[uint64(idx)][change.Key] = vd
+				break
+			}
+		}
+	}
+}
+
+// run replays the execution to the debugger as the evaluator does: the program is registered,
+// updated before every opcode with the stack, scratch space and app state changes of the trace,
+// and completed with the logs of the app call
+func (e *simulateReplay) run(debugger *Debugger, txnGroup []transactions.SignedTxnWithAD, proto *config.ConsensusParams, logs *[][]byte) {
+	debugger.SaveProgram(e.name, e.program, "", nil, false, e.states)
+	state := logic.MakeProgramDebugState(e.program, txnGroup, e.groupIndex, proto)
+	lines := strings.Split(state.Disassembly, "\n")
+	txn := &txnGroup[e.groupIndex].Txn
+
+	var stack []basics.TealValue
+	scratch := make([]basics.TealValue, 256)
+	for i := range scratch {
+		scratch[i] = basics.TealValue{Type: basics.TealUintType}
+	}
+	var callStack []logic.CallFrame
+	state.SetStep(0, stack, scratch, callStack)
+	debugger.Register(state)
+
+	for _, unit := range e.trace {
+		state.SetStep(unit.Pc, stack, scratch, callStack)
+		if unit.StateChanges != nil && len(*unit.StateChanges) > 0 {
+			state.StateChange = stateChange((*unit.StateChanges)[0], txn)
+		}
+		debugger.Update(state)
+
+		// the effects of the opcode make the next step
+		stack = stack[:len(stack):len(stack)]
+		if unit.StackPopCount != nil {
+			stack = stack[:max(len(stack)-*unit.StackPopCount, 0)]
+		}
+		if unit.StackAdditions != nil {
+			for _, av := range *unit.StackAdditions {
+				stack = append(stack, avmValue(av))
+			}
+		}
+		if unit.ScratchChanges != nil {
+			scratch = append([]basics.TealValue(nil), scratch...)
+			for _, sc := range *unit.ScratchChanges {
+				if sc.Slot >= 0 && sc.Slot < len(scratch) {
+					scratch[sc.Slot] = avmValue(sc.NewValue)
+				}
+			}
+		}
+		if unit.StateChanges != nil {
+			for _, op := range *unit.StateChanges {
+				if change := stateChange(op, txn); change != nil {
+					applyStateChange(&state.EvalDelta, change, op, txn)
+				}
+			}
+		}
+		if state.Line >= len(lines) {
+			continue
+		}
+		if fields := strings.Fields(lines[state.Line]); len(fields) > 0 {
+			switch fields[0] {
+			case "callsub":
+				frame := logic.CallFrame{FrameLine: state.Line}
+				if len(fields) > 1 {
+					frame.LabelName = fields[1]
+				}
+				callStack = append(callStack[:len(callStack):len(callStack)], frame)
+			case "retsub":
+				if len(callStack) > 0 {
+					callStack = callStack[:len(callStack)-1]
+				}
+			}
+		}
+	}
+
+	pc := len(e.program)
+	if len(e.trace) > 0 && len(e.err) > 0 {
+		// failing programs stop at the failing opcode
+		pc = e.trace[len(e.trace)-1].Pc
+	}
+	state.SetStep(pc, stack, scratch, callStack)
+	state.Error = e.err
+	if logs != nil {
+		for _, l := range *logs {
+			state.Logs = append(state.Logs, string(l))
+		}
+	}
+	debugger.Complete(state)
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSimulateRunner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	// pcs: pushint 1 at 1, pushbytes 0x01 at 3, store 3 at 6, dup at 8, pop at 9
+	ops, err := logic.AssembleString("#pragma version 8\npushint 1\npushbytes 0x01\nstore 3\ndup\npop\n")
+	a.NoError(err)
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+
+	response := `{"last-round": 10, "version": 2, "txn-groups": [{
+		"failure-message": "transaction rejected by logic",
+		"failed-at": [0],
+		"txn-results": [{"txn-result": {"pool-error": "", "txn": {}}, "exec-trace": {"logic-sig-trace": [
+			{"pc": 1, "stack-additions": [{"type": 2, "uint": 1}]},
+			{"pc": 3, "stack-additions": [{"type": 1, "bytes": "AQ=="}]},
+			{"pc": 6, "stack-pop-count": 1, "scratch-changes": [{"slot": 3, "new-value": {"type": 1, "bytes": "AQ=="}}]},
+			{"pc": 8, "stack-pop-count": 1, "stack-additions": [{"type": 2, "uint": 1}, {"type": 2, "uint": 1}]},
+			{"pc": 9, "stack-pop-count": 1}
+		]}}]
+	}]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("POST", r.Method)
+		a.Equal("/v2/transactions/simulate", r.URL.Path)
+		a.Equal("token", r.Header.Get("X-Algo-API-Token"))
+		var request model.SimulateRequest
+		a.NoError(json.NewDecoder(r.Body).Decode(&request))
+		a.Len(request.TxnGroups, 1)
+		a.Len(request.TxnGroups[0].Txns, 1)
+		a.True(*request.AllowEmptySignatures)
+		a.True(*request.ExecTraceConfig.StackChange)
+		a.True(*request.ExecTraceConfig.ScratchChange)
+		w.WriteHeader(200)
+		w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	debugger := MakeDebugger()
+	var s *session
+	step := func(c Control) {
+		s = c.(*session)
+		c.Step()
+	}
+	da := &scriptedDbgAdapter{done: make(chan struct{})}
+	da.actions = []func(c Control){step, step, step, step, step, step}
+	debugger.AddAdapter(da)
+
+	runner := makeSimulateRunner(debugger)
+	err = runner.Setup(&DebugParams{
+		TxnBlob:    protocol.EncodeMsgp(&txn),
+		AlgodURL:   srv.URL,
+		AlgodToken: "token",
+		Simulate:   true,
+	})
+	a.NoError(err)
+	a.NoError(runner.RunAll())
+	da.WaitForCompletion()
+
+	a.Empty(da.actions)
+	a.Equal([][2]int{{1, 0}, {2, 1}, {3, 2}, {4, 1}, {5, 2}}, da.shown)
+
+	// the stack and scratch space are rebuilt from the changes of the trace
+	s.mu.Lock()
+	last := s.history[len(s.history)-1]
+	s.mu.Unlock()
+	a.Equal(5, last.Line)
+	one := basics.TealValue{Type: basics.TealUintType, Uint: 1}
+	a.Equal([]basics.TealValue{one, one}, last.Stack)
+	a.Equal(basics.TealValue{Type: basics.TealBytesType, Bytes: "AQ=="}, last.Scratch[3])
+	a.Equal(basics.TealValue{Type: basics.TealUintType}, last.Scratch[4])
+
+	// the failure of the group goes to the program of the failed transaction
+	result, err := runner.simulate()
+	a.NoError(err)
+	replays, err := runner.replays(result)
+	a.NoError(err)
+	a.Len(replays, 1)
+	a.Equal("logicsig 0", replays[0].name)
+	a.Equal("transaction rejected by logic", replays[0].err)
+}
+
+func TestSimulateRunnerSetup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txn := transactions.SignedTxn{}
+	runner := makeSimulateRunner(MakeDebugger())
+	err := runner.Setup(&DebugParams{TxnBlob: protocol.EncodeMsgp(&txn)})
+	require.ErrorContains(t, err, "algod URL")
+
+	err = runner.Setup(&DebugParams{AlgodURL: "http://127.0.0.1:8080"})
+	require.ErrorContains(t, err, "transaction(s)")
+}
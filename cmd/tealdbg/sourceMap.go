@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// sourceMap is a version 3 source map of a TEAL program to its original source,
+// as generated by PyTeal or Tealish. See https://sourcemaps.info/spec.html
+type sourceMap struct {
+	Version        int       `json:"version"`
+	File           string    `json:"file,omitempty"`
+	SourceRoot     string    `json:"sourceRoot,omitempty"`
+	Sources        []string  `json:"sources"`
+	SourcesContent []*string `json:"sourcesContent,omitempty"`
+	Names          []string  `json:"names,omitempty"`
+	Mappings       string    `json:"mappings"`
+}
+
+const vlqTable = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+func parseSourceMap(data []byte) (*sourceMap, error) {
+	var sm sourceMap
+	err := json.Unmarshal(data, &sm)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source map: %w", err)
+	}
+	if sm.Version != 3 {
+		return nil, fmt.Errorf("unsupported source map version %d", sm.Version)
+	}
+	if len(sm.Sources) == 0 {
+		return nil, fmt.Errorf("source map has no sources")
+	}
+	return &sm, nil
+}
+
+// loadSourceMap reads the source map file at path, and embeds the content of its
+// sources read relatively to the source map file when not included already
+func loadSourceMap(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	sm, err := parseSourceMap(data)
+	if err != nil {
+		return nil, err
+	}
+
+	complete := len(sm.SourcesContent) == len(sm.Sources)
+	for i := 0; complete && i < len(sm.Sources); i++ {
+		complete = sm.SourcesContent[i] != nil
+	}
+	if complete {
+		return data, nil
+	}
+
+	contents := make([]*string, len(sm.Sources))
+	copy(contents, sm.SourcesContent)
+	for i, name := range sm.Sources {
+		if contents[i] != nil {
+			continue
+		}
+		file := filepath.Join(sm.SourceRoot, name)
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(filepath.Dir(path), file)
+		}
+		source, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("source map source %s: %w", name, err)
+		}
+		content := string(source)
+		contents[i] = &content
+	}
+	sm.SourcesContent = contents
+	return json.Marshal(sm)
+}
+
+// mapSource maps the locations of the TEAL source in offsetToSource to the original
+// source, and returns the content of the original source.
+// Only source maps with a single original source are supported.
+func (sm *sourceMap) mapSource(offsetToSource map[int]logic.SourceLocation) (string, map[int]logic.SourceLocation, error) {
+	lines, sourceIdx, err := sm.decodeMappings()
+	if err != nil {
+		return "", nil, err
+	}
+	if sourceIdx >= len(sm.SourcesContent) || sm.SourcesContent[sourceIdx] == nil {
+		return "", nil, fmt.Errorf("source map does not include the content of %s", sm.Sources[sourceIdx])
+	}
+
+	mapped := make(map[int]logic.SourceLocation, len(offsetToSource))
+	for pc, loc := range offsetToSource {
+		if original, ok := lines[loc.Line]; ok {
+			mapped[pc] = original
+		}
+	}
+	return *sm.SourcesContent[sourceIdx], mapped, nil
+}
+
+// decodeMappings returns the original source location of each generated line, taken
+// from the first segment of the line, and the index of the original source
+func (sm *sourceMap) decodeMappings() (map[int]logic.SourceLocation, int, error) {
+	lines := make(map[int]logic.SourceLocation)
+	sourceIdx := -1
+	// all fields but the generated column are relative to their previous value in the mappings
+	var source, line, column int
+	for genLine, segments := range strings.Split(sm.Mappings, ";") {
+		mapped := false
+		for _, segment := range strings.Split(segments, ",") {
+			if segment == "" {
+				continue
+			}
+			fields, err := decodeVLQ(segment)
+			if err != nil {
+				return nil, 0, fmt.Errorf("invalid source map mappings at line %d: %w", genLine, err)
+			}
+			if len(fields) < 4 {
+				continue
+			}
+			source += fields[1]
+			line += fields[2]
+			column += fields[3]
+			if source < 0 || source >= len(sm.Sources) || line < 0 {
+				return nil, 0, fmt.Errorf("invalid source map mappings at line %d: location out of range", genLine)
+			}
+			if sourceIdx == -1 {
+				sourceIdx = source
+			} else if source != sourceIdx {
+				return nil, 0, fmt.Errorf("source maps of multiple sources are not supported")
+			}
+			if !mapped {
+				lines[genLine] = logic.SourceLocation{Line: line, Column: column}
+				mapped = true
+			}
+		}
+	}
+	if sourceIdx == -1 {
+		return nil, 0, fmt.Errorf("source map has no mappings")
+	}
+	return lines, sourceIdx, nil
+}
+
+// decodeVLQ decodes the base64 VLQ fields of a source map mappings segment
+func decodeVLQ(segment string) ([]int, error) {
+	var fields []int
+	value, shift := 0, 0
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(vlqTable, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid character %q", segment[i])
+		}
+		value |= (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			if shift > 30 {
+				return nil, fmt.Errorf("value overflow")
+			}
+			continue
+		}
+		if value&1 != 0 {
+			fields = append(fields, -(value >> 1))
+		} else {
+			fields = append(fields, value>>1)
+		}
+		value, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated value")
+	}
+	return fields, nil
+}
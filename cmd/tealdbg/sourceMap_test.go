@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// makeTestSourceMap makes the source map of TEAL lines to the original lines, -1 for none
+func makeTestSourceMap(t *testing.T, original []int, source string) []byte {
+	lines := make([]string, len(original))
+	prev := 0
+	for i, line := range original {
+		if line >= 0 {
+			lines[i] = logic.MakeSourceMapLine(0, 0, line-prev, 0)
+			prev = line
+		}
+	}
+	sm := sourceMap{
+		Version:        3,
+		Sources:        []string{"contract.py"},
+		SourcesContent: []*string{&source},
+		Mappings:       strings.Join(lines, ";"),
+	}
+	data, err := json.Marshal(&sm)
+	require.NoError(t, err)
+	return data
+}
+
+func TestDecodeVLQ(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, fields := range [][4]int{{0, 0, 0, 0}, {0, 1, -1, 15}, {3, 0, 16, -16}, {0, 2, 1000, -123456}} {
+		segment := logic.MakeSourceMapLine(fields[0], fields[1], fields[2], fields[3])
+		decoded, err := decodeVLQ(segment)
+		require.NoError(t, err)
+		require.Equal(t, fields[:], decoded)
+	}
+
+	_, err := decodeVLQ("A!")
+	require.Error(t, err)
+	_, err = decodeVLQ("g")
+	require.Error(t, err)
+}
+
+func TestSourceMapMapSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := "x = 1 + 2\nassert x == 3\n"
+	sm, err := parseSourceMap(makeTestSourceMap(t, []int{-1, 0, 0, 0, 1, 1}, source))
+	require.NoError(t, err)
+
+	offsetToSource := map[int]logic.SourceLocation{
+		1: {Line: 1}, 3: {Line: 2}, 5: {Line: 3}, 6: {Line: 4}, 8: {Line: 5}, 9: {Line: 7},
+	}
+	original, mapped, err := sm.mapSource(offsetToSource)
+	require.NoError(t, err)
+	require.Equal(t, source, original)
+	require.Equal(t, map[int]logic.SourceLocation{
+		1: {Line: 0}, 3: {Line: 0}, 5: {Line: 0}, 6: {Line: 1}, 8: {Line: 1},
+	}, mapped)
+
+	_, err = parseSourceMap([]byte(`{"version": 2, "sources": ["a.py"], "mappings": "AAAA"}`))
+	require.Error(t, err)
+	sm, err = parseSourceMap([]byte(`{"version": 3, "sources": ["a.py", "b.py"], "sourcesContent": ["a", "b"], "mappings": "AAAA;ACAA"}`))
+	require.NoError(t, err)
+	_, _, err = sm.mapSource(offsetToSource)
+	require.ErrorContains(t, err, "multiple sources")
+	sm, err = parseSourceMap([]byte(`{"version": 3, "sources": ["a.py"], "mappings": "AAAA"}`))
+	require.NoError(t, err)
+	_, _, err = sm.mapSource(offsetToSource)
+	require.ErrorContains(t, err, "content")
+}
+
+func TestLoadSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "contract.py"), []byte("approve()\n"), 0644))
+	path := filepath.Join(dir, "contract.teal.map")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 3, "sourceRoot": "src", "sources": ["contract.py"], "mappings": ";AAAA"}`), 0644))
+
+	data, err := loadSourceMap(path)
+	require.NoError(t, err)
+	sm, err := parseSourceMap(data)
+	require.NoError(t, err)
+	require.Len(t, sm.SourcesContent, 1)
+	require.Equal(t, "approve()\n", *sm.SourcesContent[0])
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 3, "sources": ["missing.py"], "mappings": ";AAAA"}`), 0644))
+	_, err = loadSourceMap(path)
+	require.Error(t, err)
+}
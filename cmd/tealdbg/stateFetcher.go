@@ -0,0 +1,264 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// errNotFound is returned for the state missing on-chain
+var errNotFound = errors.New("not found")
+
+// stateFetcher fetches the on-chain state a transaction group uses from algod or indexer
+type stateFetcher struct {
+	url   string
+	token string
+	// indexer is set when fetching from indexer rather than algod
+	indexer bool
+	// round is the round to fetch the state of from indexer, the latest one if zero
+	round basics.Round
+}
+
+func makeStateFetcher(dp *DebugParams) (*stateFetcher, error) {
+	if len(dp.AlgodURL) != 0 {
+		return &stateFetcher{url: dp.AlgodURL, token: dp.AlgodToken}, nil
+	}
+	if len(dp.IndexerURL) != 0 {
+		return &stateFetcher{url: dp.IndexerURL, token: dp.IndexerToken, indexer: true, round: dp.Round}, nil
+	}
+	return nil, fmt.Errorf("fetching the state requires algod or indexer URL")
+}
+
+// get fetches the object at path into out. Indexer responses wrap the object into field.
+func (f *stateFetcher) get(path string, query url.Values, field string, out interface{}) error {
+	if f.indexer && f.round != 0 && field == "account" {
+		query.Set("round", fmt.Sprintf("%d", f.round))
+	}
+	u := fmt.Sprintf("%s%s", f.url, path)
+	if len(query) > 0 {
+		u = fmt.Sprintf("%s?%s", u, query.Encode())
+	}
+	request, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return fmt.Errorf("request error: %w", err)
+	}
+	return f.do(request, field, out)
+}
+
+// post posts the JSON encoding of in to path of algod and decodes the response into out
+func (f *stateFetcher) post(path string, in interface{}, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("request encode error: %w", err)
+	}
+	request, err := http.NewRequest("POST", f.url+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("request error: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return f.do(request, "", out)
+}
+
+// do sends request and decodes the response into out
+func (f *stateFetcher) do(request *http.Request, field string, out interface{}) error {
+	u := request.URL.String()
+	if f.indexer {
+		request.Header.Set("X-Indexer-API-Token", f.token)
+	} else {
+		request.Header.Set("X-Algo-API-Token", f.token)
+	}
+	resp, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("request error: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("response error: %s, status code: %d, request: %s", string(msg), resp.StatusCode, u)
+	}
+
+	if f.indexer && len(field) != 0 {
+		var wrapped map[string]json.RawMessage
+		err = json.NewDecoder(resp.Body).Decode(&wrapped)
+		if err != nil {
+			return fmt.Errorf("response decode error: %w", err)
+		}
+		err = json.Unmarshal(wrapped[field], out)
+	} else {
+		err = json.NewDecoder(resp.Body).Decode(out)
+	}
+	if err != nil {
+		return fmt.Errorf("response decode error: %w", err)
+	}
+	return nil
+}
+
+// dryrunRequest makes the dryrun request of txnGroup with the accounts, apps, assets and boxes
+// it references, including the creators of the apps and assets and the accounts of the apps
+func (f *stateFetcher) dryrunRequest(txnGroup []transactions.SignedTxn) (ddr dryrunRequest, err error) {
+	ddr.Txns = txnGroup
+	ddr.Round = f.round
+	if !f.indexer {
+		var status model.NodeStatusResponse
+		err = f.get("/v2/status", url.Values{}, "", &status)
+		if err != nil {
+			return
+		}
+		ddr.Round = status.LastRound
+	}
+
+	addrs := make(map[basics.Address]bool)
+	apps := make(map[basics.AppIndex]bool)
+	assets := make(map[basics.AssetIndex]bool)
+	type boxRef struct {
+		app  basics.AppIndex
+		name string
+	}
+	boxes := make(map[boxRef]bool)
+	for _, stxn := range txnGroup {
+		txn := &stxn.Txn
+		addrs[txn.Sender] = true
+		for _, addr := range []basics.Address{txn.Receiver, txn.CloseRemainderTo, txn.AssetReceiver, txn.AssetCloseTo, txn.AssetSender} {
+			if !addr.IsZero() {
+				addrs[addr] = true
+			}
+		}
+		for _, addr := range txn.Accounts {
+			addrs[addr] = true
+		}
+		for _, aidx := range []basics.AssetIndex{txn.XferAsset, txn.ConfigAsset, txn.FreezeAsset} {
+			if aidx != 0 {
+				assets[aidx] = true
+			}
+		}
+		for _, aidx := range txn.ForeignAssets {
+			assets[aidx] = true
+		}
+		if txn.Type != protocol.ApplicationCallTx {
+			continue
+		}
+		if txn.ApplicationID != 0 {
+			apps[txn.ApplicationID] = true
+		}
+		for _, aidx := range txn.ForeignApps {
+			apps[aidx] = true
+		}
+		for _, br := range txn.Boxes {
+			app := txn.ApplicationID
+			if br.Index > 0 && br.Index <= uint64(len(txn.ForeignApps)) {
+				app = txn.ForeignApps[br.Index-1]
+			}
+			if app != 0 && len(br.Name) > 0 {
+				boxes[boxRef{app, string(br.Name)}] = true
+			}
+		}
+	}
+
+	for aidx := range apps {
+		var app model.Application
+		err = f.get(fmt.Sprintf("/v2/applications/%d", aidx), url.Values{}, "application", &app)
+		if err == errNotFound {
+			// the group may create it
+			continue
+		}
+		if err != nil {
+			return ddr, fmt.Errorf("application %d: %w", aidx, err)
+		}
+		ddr.Apps = append(ddr.Apps, app)
+		addrs[aidx.Address()] = true
+		var creator basics.Address
+		creator, err = basics.UnmarshalChecksumAddress(app.Params.Creator)
+		if err != nil {
+			return
+		}
+		addrs[creator] = true
+	}
+	for aidx := range assets {
+		var asset model.Asset
+		err = f.get(fmt.Sprintf("/v2/assets/%d", aidx), url.Values{}, "asset", &asset)
+		if err == errNotFound {
+			continue
+		}
+		if err != nil {
+			return ddr, fmt.Errorf("asset %d: %w", aidx, err)
+		}
+		var creator basics.Address
+		creator, err = basics.UnmarshalChecksumAddress(asset.Params.Creator)
+		if err != nil {
+			return
+		}
+		addrs[creator] = true
+	}
+	for addr := range addrs {
+		var account model.Account
+		err = f.get(fmt.Sprintf("/v2/accounts/%s", addr), url.Values{}, "account", &account)
+		if err == errNotFound {
+			continue
+		}
+		if err != nil {
+			return ddr, fmt.Errorf("account %s: %w", addr, err)
+		}
+		ddr.Accounts = append(ddr.Accounts, account)
+	}
+	for ref := range boxes {
+		var box model.Box
+		query := url.Values{"name": []string{"b64:" + base64.StdEncoding.EncodeToString([]byte(ref.name))}}
+		err = f.get(fmt.Sprintf("/v2/applications/%d/box", ref.app), query, "", &box)
+		if err == errNotFound {
+			// the group may create it
+			continue
+		}
+		if err != nil {
+			return ddr, fmt.Errorf("box %q of application %d: %w", ref.name, ref.app, err)
+		}
+		ddr.Boxes = append(ddr.Boxes, dryrunBox{AppIndex: ref.app, Name: box.Name, Value: box.Value})
+	}
+	return ddr, nil
+}
+
+// ddrFromNetwork makes the dryrun request of the transaction group in dp
+// with the state fetched from algod or indexer
+func ddrFromNetwork(dp *DebugParams) (ddr dryrunRequest, err error) {
+	if len(dp.TxnBlob) == 0 {
+		err = fmt.Errorf("fetching the state requires transaction(s)")
+		return
+	}
+	txnGroup, err := txnGroupFromParams(dp)
+	if err != nil {
+		return
+	}
+	f, err := makeStateFetcher(dp)
+	if err != nil {
+		return
+	}
+	return f.dryrunRequest(txnGroup)
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// The execution trace follows the simulate exec-trace JSON format,
+// and adds the opcode name and cost to every step.
+
+type traceValue struct {
+	Type  uint64 `json:"type"`
+	Bytes []byte `json:"bytes,omitempty"`
+	Uint  uint64 `json:"uint,omitempty"`
+}
+
+type traceScratchChange struct {
+	Slot     int        `json:"slot"`
+	NewValue traceValue `json:"new-value"`
+}
+
+type traceStateChange struct {
+	AppStateType string      `json:"app-state-type"`
+	Operation    string      `json:"operation"`
+	Key          []byte      `json:"key"`
+	NewValue     *traceValue `json:"new-value,omitempty"`
+	Account      string      `json:"account,omitempty"`
+
+	appState logic.AppStateEnum
+	stateOp  logic.AppStateOpEnum
+	appID    basics.AppIndex
+	account  basics.Address
+}
+
+type traceUnit struct {
+	PC             int                  `json:"pc"`
+	Op             string               `json:"op"`
+	Cost           int                  `json:"cost"`
+	SpawnedInners  []int                `json:"spawned-inners,omitempty"`
+	StackAdditions []traceValue         `json:"stack-additions,omitempty"`
+	StackPopCount  int                  `json:"stack-pop-count,omitempty"`
+	ScratchChanges []traceScratchChange `json:"scratch-changes,omitempty"`
+	StateChanges   []traceStateChange   `json:"state-changes,omitempty"`
+}
+
+type txnTrace struct {
+	ApprovalProgramTrace   []traceUnit `json:"approval-program-trace,omitempty"`
+	ApprovalProgramHash    []byte      `json:"approval-program-hash,omitempty"`
+	ClearStateProgramTrace []traceUnit `json:"clear-state-program-trace,omitempty"`
+	ClearStateProgramHash  []byte      `json:"clear-state-program-hash,omitempty"`
+	LogicSigTrace          []traceUnit `json:"logic-sig-trace,omitempty"`
+	LogicSigHash           []byte      `json:"logic-sig-hash,omitempty"`
+	InnerTrace             []txnTrace  `json:"inner-trace,omitempty"`
+}
+
+// runTrace is the execution trace of a program run
+type runTrace struct {
+	Name           string   `json:"name"`
+	GroupIndex     uint64   `json:"group-index"`
+	Pass           bool     `json:"pass"`
+	Error          string   `json:"error,omitempty"`
+	BudgetConsumed int      `json:"budget-consumed"`
+	ExecTrace      txnTrace `json:"exec-trace"`
+}
+
+// traceFrame is the state of the transaction being traced
+type traceFrame struct {
+	trace   *txnTrace
+	program *[]traceUnit
+	// stackHeight is the stack height after the current opcode pops its arguments
+	stackHeight int
+	// scratchSlots are the slots the current opcode writes
+	scratchSlots []int
+	cost         int
+}
+
+// execTracer records the execution traces of the runs, including the programs of their inner transactions
+type execTracer struct {
+	logic.NullEvalTracer
+	runs   []runTrace
+	frames []*traceFrame
+	// groups are the frame counts the inner transaction groups started at
+	groups []int
+}
+
+func (t *execTracer) top() *traceFrame {
+	return t.frames[len(t.frames)-1]
+}
+
+// beginRun starts tracing a run
+func (t *execTracer) beginRun(run *evaluation) {
+	t.runs = append(t.runs, runTrace{Name: run.name, GroupIndex: run.groupIndex})
+	t.frames = []*traceFrame{{trace: &t.runs[len(t.runs)-1].ExecTrace}}
+	t.groups = nil
+}
+
+// endRun completes tracing a run with its result
+func (t *execTracer) endRun(run *evaluation) {
+	rt := &t.runs[len(t.runs)-1]
+	rt.Pass = run.result.pass
+	if run.result.err != nil {
+		rt.Error = run.result.err.Error()
+	}
+	t.frames = nil
+}
+
+func makeTraceValue(tv basics.TealValue) traceValue {
+	return traceValue{Type: uint64(tv.Type), Bytes: []byte(tv.Bytes), Uint: tv.Uint}
+}
+
+func traceAppState(state logic.AppStateEnum) string {
+	switch state {
+	case logic.LocalState:
+		return "l"
+	case logic.GlobalState:
+		return "g"
+	case logic.BoxState:
+		return "b"
+	default:
+		return ""
+	}
+}
+
+func traceStateOp(op logic.AppStateOpEnum) string {
+	switch op {
+	case logic.AppStateWrite:
+		return "w"
+	case logic.AppStateDelete:
+		return "d"
+	default:
+		return ""
+	}
+}
+
+// BeforeTxnGroup remembers where an inner transaction group starts
+func (t *execTracer) BeforeTxnGroup(ep *logic.EvalParams) {
+	if len(t.frames) > 0 {
+		t.groups = append(t.groups, len(t.frames))
+	}
+}
+
+// AfterTxnGroup drops the frames of the inner transactions a failed group left
+func (t *execTracer) AfterTxnGroup(ep *logic.EvalParams, deltas *ledgercore.StateDelta, evalError error) {
+	if len(t.groups) > 0 {
+		t.frames = t.frames[:t.groups[len(t.groups)-1]]
+		t.groups = t.groups[:len(t.groups)-1]
+	}
+}
+
+// BeforeTxn adds the inner transaction trace to the spawning opcode
+func (t *execTracer) BeforeTxn(ep *logic.EvalParams, groupIndex int) {
+	if len(t.frames) == 0 {
+		return
+	}
+	parent := t.top()
+	parent.trace.InnerTrace = append(parent.trace.InnerTrace, txnTrace{})
+	index := len(parent.trace.InnerTrace) - 1
+	if parent.program != nil && len(*parent.program) > 0 {
+		unit := &(*parent.program)[len(*parent.program)-1]
+		unit.SpawnedInners = append(unit.SpawnedInners, index)
+	}
+	t.frames = append(t.frames, &traceFrame{trace: &parent.trace.InnerTrace[index]})
+}
+
+// AfterTxn completes the inner transaction trace
+func (t *execTracer) AfterTxn(ep *logic.EvalParams, groupIndex int, ad transactions.ApplyData, evalError error) {
+	if len(t.frames) > 1 {
+		t.frames = t.frames[:len(t.frames)-1]
+	}
+}
+
+// BeforeProgram selects the program trace of the transaction
+func (t *execTracer) BeforeProgram(cx *logic.EvalContext) {
+	if len(t.frames) == 0 {
+		return
+	}
+	f := t.top()
+	digest := crypto.Hash(cx.GetProgram())
+	hash := digest[:]
+	switch {
+	case cx.RunMode() == logic.ModeSig:
+		f.program = &f.trace.LogicSigTrace
+		f.trace.LogicSigHash = hash
+	case cx.TxnGroup[cx.GroupIndex()].Txn.OnCompletion == transactions.ClearStateOC:
+		f.program = &f.trace.ClearStateProgramTrace
+		f.trace.ClearStateProgramHash = hash
+	default:
+		f.program = &f.trace.ApprovalProgramTrace
+		f.trace.ApprovalProgramHash = hash
+	}
+	f.cost = cx.Cost()
+}
+
+// AfterProgram records the budget the run consumed
+func (t *execTracer) AfterProgram(cx *logic.EvalContext, pass bool, evalError error) {
+	if len(t.frames) == 1 {
+		t.runs[len(t.runs)-1].BudgetConsumed = cx.Cost()
+	}
+}
+
+// BeforeOpcode records the opcode and what it pops off the stack
+func (t *execTracer) BeforeOpcode(cx *logic.EvalContext) {
+	if len(t.frames) == 0 || t.top().program == nil {
+		return
+	}
+	f := t.top()
+	spec := cx.GetOpSpec()
+	unit := traceUnit{PC: cx.PC(), Op: spec.Name}
+	unit.StackPopCount, _ = spec.StackExplain(cx)
+	f.stackHeight = len(cx.Stack) - unit.StackPopCount
+	f.cost = cx.Cost()
+
+	f.scratchSlots = nil
+	switch spec.Name {
+	case "store":
+		f.scratchSlots = append(f.scratchSlots, int(cx.GetProgram()[cx.PC()+1]))
+	case "stores":
+		if len(cx.Stack) > 1 {
+			slot := cx.Stack[len(cx.Stack)-2].Uint
+			if slot < uint64(len(cx.Scratch)) {
+				f.scratchSlots = append(f.scratchSlots, int(slot))
+			}
+		}
+	}
+
+	if spec.AppStateExplain != nil {
+		appState, stateOp, appID, account, key := spec.AppStateExplain(cx)
+		if stateOp != logic.AppStateRead {
+			sc := traceStateChange{
+				AppStateType: traceAppState(appState),
+				Operation:    traceStateOp(stateOp),
+				Key:          []byte(key),
+				appState:     appState,
+				stateOp:      stateOp,
+				appID:        appID,
+				account:      account,
+			}
+			if appState == logic.LocalState {
+				sc.Account = account.String()
+			}
+			unit.StateChanges = append(unit.StateChanges, sc)
+		}
+	}
+	*f.program = append(*f.program, unit)
+}
+
+// AfterOpcode records what the opcode pushed, and the scratch slots and state it changed
+func (t *execTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
+	if len(t.frames) == 0 || t.top().program == nil || len(*t.top().program) == 0 {
+		return
+	}
+	f := t.top()
+	unit := &(*f.program)[len(*f.program)-1]
+	unit.Cost = cx.Cost() - f.cost
+	if evalError != nil {
+		return
+	}
+	for i := f.stackHeight; i >= 0 && i < len(cx.Stack); i++ {
+		unit.StackAdditions = append(unit.StackAdditions, makeTraceValue(cx.Stack[i].ToTealValue()))
+	}
+	for _, slot := range f.scratchSlots {
+		unit.ScratchChanges = append(unit.ScratchChanges, traceScratchChange{Slot: slot, NewValue: makeTraceValue(cx.Scratch[slot].ToTealValue())})
+	}
+	for i := range unit.StateChanges {
+		sc := &unit.StateChanges[i]
+		if sc.stateOp == logic.AppStateWrite {
+			tv := logic.AppStateQuerying(cx, sc.appState, sc.stateOp, sc.appID, sc.account, string(sc.Key))
+			nv := makeTraceValue(tv)
+			sc.NewValue = &nv
+		}
+	}
+}
+
+// writeTrace runs the programs dp sets up and writes their execution traces to w as JSON
+func writeTrace(dp *DebugParams, w io.Writer) error {
+	r := MakeLocalRunner(nil)
+	err := r.Setup(dp)
+	if err != nil {
+		return err
+	}
+	runs, runErr := r.Trace()
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(runs)
+	if err != nil {
+		return err
+	}
+	return runErr
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceSignature(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	source := `#pragma version 8
+pushint 1
+pushint 2
++
+store 0
+load 0
+pushint 3
+==`
+	dp := DebugParams{
+		ProgramNames: []string{"test"},
+		ProgramBlobs: [][]byte{[]byte(source)},
+		Proto:        string(protocol.ConsensusCurrentVersion),
+		RunMode:      "signature",
+	}
+	local := MakeLocalRunner(nil)
+	a.NoError(local.Setup(&dp))
+	runs, err := local.Trace()
+	a.NoError(err)
+	a.Len(runs, 1)
+	a.Equal("test", runs[0].Name)
+	a.True(runs[0].Pass)
+	a.Equal(7, runs[0].BudgetConsumed)
+
+	trace := runs[0].ExecTrace
+	a.Empty(trace.ApprovalProgramTrace)
+	a.NotEmpty(trace.LogicSigHash)
+	ops := make([]string, len(trace.LogicSigTrace))
+	for i, unit := range trace.LogicSigTrace {
+		ops[i] = unit.Op
+		a.Equal(1, unit.Cost)
+	}
+	a.Equal([]string{"pushint", "pushint", "+", "store", "load", "pushint", "=="}, ops)
+
+	add := trace.LogicSigTrace[2]
+	a.Equal(2, add.StackPopCount)
+	a.Equal([]traceValue{{Type: 2, Uint: 3}}, add.StackAdditions)
+	store := trace.LogicSigTrace[3]
+	a.Equal(1, store.StackPopCount)
+	a.Empty(store.StackAdditions)
+	a.Equal([]traceScratchChange{{Slot: 0, NewValue: traceValue{Type: 2, Uint: 3}}}, store.ScratchChanges)
+	eq := trace.LogicSigTrace[6]
+	a.Equal([]traceValue{{Type: 2, Uint: 1}}, eq.StackAdditions)
+
+	// the trace is written in the simulate exec-trace format
+	var out bytes.Buffer
+	a.NoError(writeTrace(&dp, &out))
+	var decoded []map[string]interface{}
+	a.NoError(json.Unmarshal(out.Bytes(), &decoded))
+	a.Len(decoded, 1)
+	execTrace := decoded[0]["exec-trace"].(map[string]interface{})
+	a.Contains(execTrace, "logic-sig-trace")
+	a.Contains(execTrace, "logic-sig-hash")
+	a.NotContains(execTrace, "approval-program-trace")
+	unit := execTrace["logic-sig-trace"].([]interface{})[3].(map[string]interface{})
+	a.Equal(map[string]interface{}{
+		"pc":              float64(6),
+		"op":              "store",
+		"cost":            float64(1),
+		"stack-pop-count": float64(1),
+		"scratch-changes": []interface{}{
+			map[string]interface{}{"slot": float64(0), "new-value": map[string]interface{}{"type": float64(2), "uint": float64(3)}},
+		},
+	}, unit)
+}
+
+func TestTraceStateChanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	ops, err := logic.AssembleString(`#pragma version 8
+pushbytes "counter"
+pushint 42
+itob
+box_put
+pushint 1`)
+	a.NoError(err)
+	program := base64.StdEncoding.EncodeToString(ops.Program)
+
+	ddrBlob := []byte(fmt.Sprintf(`{
+		"accounts": [
+		  {
+			"address": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ",
+			"amount": 5000000,
+			"amount-without-pending-rewards": 5000000,
+			"pending-rewards": 0,
+			"rewards": 0,
+			"round": 2,
+			"status": "Offline"
+		  }
+		],
+		"apps": [
+		  {
+			"id": 1,
+			"params": {
+			  "approval-program": "%s",
+			  "clear-state-program": "%s",
+			  "creator": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ"
+			}
+		  }
+		],
+		"round": 2,
+		"txns": [
+		  {
+			"txn": {
+			  "apid": 1,
+			  "apbx": [{"n": "Y291bnRlcg=="}],
+			  "fee": 1000,
+			  "fv": 3,
+			  "lv": 1003,
+			  "snd": "FPVVJ7N42QRVP2OWBGZ3XPTQAZFQNBYHJGZ2CJFOATAQNWFA5NWB4MPWBQ",
+			  "type": "appl"
+			}
+		  }
+		]
+	  }`, program, program))
+	dp := DebugParams{
+		Proto:   string(protocol.ConsensusCurrentVersion),
+		DdrBlob: ddrBlob,
+		RunMode: "application",
+	}
+	local := MakeLocalRunner(nil)
+	a.NoError(local.Setup(&dp))
+	runs, err := local.Trace()
+	a.NoError(err)
+	a.Len(runs, 1)
+	a.True(runs[0].Pass)
+
+	trace := runs[0].ExecTrace
+	a.Empty(trace.LogicSigTrace)
+	a.NotEmpty(trace.ApprovalProgramHash)
+	a.Len(trace.ApprovalProgramTrace, 5)
+	boxPut := trace.ApprovalProgramTrace[3]
+	a.Equal("box_put", boxPut.Op)
+	a.Equal(2, boxPut.StackPopCount)
+	a.Len(boxPut.StateChanges, 1)
+	change := boxPut.StateChanges[0]
+	a.Equal("b", change.AppStateType)
+	a.Equal("w", change.Operation)
+	a.Equal([]byte("counter"), change.Key)
+	a.Empty(change.Account)
+	a.Equal(&traceValue{Type: 1, Bytes: []byte{0, 0, 0, 0, 0, 0, 0, 42}}, change.NewValue)
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// Watchpoint identifies app state the execution pauses on when an opcode modifies it:
+// a global key, a local key of an account, or a box
+type Watchpoint struct {
+	State logic.AppStateEnum
+	// Account of a local key, the zero address watches the key of every account
+	Account basics.Address
+	Key     string
+}
+
+// parseWatchpoint parses a watchpoint in the global:KEY, local:[ADDR]:KEY or box:NAME form,
+// where KEY and NAME are encoded as application call arguments, e.g. str:counter or b64:AA==
+func parseWatchpoint(spec string) (wp Watchpoint, err error) {
+	kind, rest, ok := strings.Cut(spec, ":")
+	if !ok {
+		return Watchpoint{}, fmt.Errorf("watchpoint %s: expected global:KEY, local:[ADDR]:KEY or box:NAME", spec)
+	}
+	switch kind {
+	case "global":
+		wp.State = logic.GlobalState
+	case "box":
+		wp.State = logic.BoxState
+	case "local":
+		wp.State = logic.LocalState
+		var addr string
+		addr, rest, ok = strings.Cut(rest, ":")
+		if !ok {
+			return Watchpoint{}, fmt.Errorf("watchpoint %s: expected local:[ADDR]:KEY", spec)
+		}
+		if len(addr) > 0 {
+			wp.Account, err = basics.UnmarshalChecksumAddress(addr)
+			if err != nil {
+				return Watchpoint{}, fmt.Errorf("watchpoint %s: %w", spec, err)
+			}
+		}
+	default:
+		return Watchpoint{}, fmt.Errorf("watchpoint %s: unknown state %s, expected global, local or box", spec, kind)
+	}
+
+	key, err := apps.NewAppCallBytes(rest)
+	if err != nil {
+		return Watchpoint{}, fmt.Errorf("watchpoint %s: %w", spec, err)
+	}
+	raw, err := key.Raw()
+	if err != nil {
+		return Watchpoint{}, fmt.Errorf("watchpoint %s: %w", spec, err)
+	}
+	wp.Key = string(raw)
+	return wp, nil
+}
+
+// String formats wp in the form parsed by parseWatchpoint
+func (wp Watchpoint) String() string {
+	key := "b64:" + base64.StdEncoding.EncodeToString([]byte(wp.Key))
+	if IsText([]byte(wp.Key)) {
+		key = "str:" + wp.Key
+	}
+	switch wp.State {
+	case logic.GlobalState:
+		return "global:" + key
+	case logic.LocalState:
+		var addr string
+		if !wp.Account.IsZero() {
+			addr = wp.Account.String()
+		}
+		return "local:" + addr + ":" + key
+	default:
+		return "box:" + key
+	}
+}
+
+func (wp Watchpoint) validate() error {
+	switch wp.State {
+	case logic.GlobalState, logic.BoxState:
+		if !wp.Account.IsZero() {
+			return fmt.Errorf("only local state watchpoints may have an account")
+		}
+	case logic.LocalState:
+	default:
+		return fmt.Errorf("invalid watchpoint state %d", wp.State)
+	}
+	return nil
+}
+
+// matches checks if the state change modifies the value watched by wp
+func (wp Watchpoint) matches(change *logic.AppStateChange) bool {
+	if change.State != wp.State || change.Key != wp.Key {
+		return false
+	}
+	return wp.Account.IsZero() || change.Account == wp.Account
+}
+
+// stateKey is a key of an app state, the name of a box
+type stateKey struct {
+	state logic.AppStateEnum
+	key   string
+}
+
+// stateWrites counts the writes and deletions of the app state an execution made, by state and key.
+// The counts of a recorded step are shared by the next ones, adding a change copies them.
+type stateWrites map[stateKey]int
+
+// add returns the counts with the write or deletion of change added
+func (sw stateWrites) add(change *logic.AppStateChange) stateWrites {
+	added := maps.Clone(sw)
+	if added == nil {
+		added = make(stateWrites)
+	}
+	added[stateKey{change.State, change.Key}]++
+	return added
+}
+
+// count returns the number of writes and deletions of state, only of key if not nil
+func (sw stateWrites) count(state logic.AppStateEnum, key *string) int {
+	if key != nil {
+		return sw[stateKey{state, *key}]
+	}
+	total := 0
+	for k, n := range sw {
+		if k.state == state {
+			total += n
+		}
+	}
+	return total
+}
@@ -24,6 +24,7 @@ import (
 	"html/template"
 	"log"
 	"net/http"
+	"net/url"
 
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-deadlock"
@@ -36,6 +37,7 @@ type WebPageFrontend struct {
 	mu         deadlock.Mutex
 	sessions   map[string]wpaSession
 	apiAddress string
+	token      string
 	done       chan struct{}
 }
 
@@ -62,6 +64,7 @@ type ContinueRequest struct {
 type WebPageFrontendParams struct {
 	router     *mux.Router
 	apiAddress string
+	token      string
 }
 
 // MakeWebPageFrontend creates new WebPageFrontend
@@ -69,6 +72,7 @@ func MakeWebPageFrontend(params *WebPageFrontendParams) (a *WebPageFrontend) {
 	a = new(WebPageFrontend)
 	a.sessions = make(map[string]wpaSession)
 	a.apiAddress = params.apiAddress
+	a.token = params.token
 	a.done = make(chan struct{})
 
 	params.router.HandleFunc("/", a.homeHandler).Methods("GET")
@@ -88,7 +92,7 @@ func (a *WebPageFrontend) SessionStarted(sid string, debugger Control, ch chan N
 
 	a.sessions[sid] = wpaSession{debugger, ch}
 
-	log.Printf("Open http://%s in a web browser", a.apiAddress)
+	log.Printf("Open %s in a web browser", a.pageURL())
 }
 
 // SessionEnded removes the session
@@ -112,6 +116,14 @@ func (a *WebPageFrontend) URL() string {
 		return ""
 	}
 
+	return a.pageURL()
+}
+
+// pageURL returns the URL of the web page, with the token of a named session
+func (a *WebPageFrontend) pageURL() string {
+	if len(a.token) != 0 {
+		return fmt.Sprintf("http://%s/?token=%s", a.apiAddress, url.QueryEscape(a.token))
+	}
 	return fmt.Sprintf("http://%s/", a.apiAddress)
 }
 
@@ -177,7 +189,11 @@ func (a *WebPageFrontend) configHandler(w http.ResponseWriter, r *http.Request)
 				return
 			}
 		} else {
-			err := s.debugger.SetBreakpoint(int(line))
+			var condition string
+			if cond := req.debugConfig.Conditions[line]; cond != nil {
+				condition = cond.String()
+			}
+			err := s.debugger.SetConditionalBreakpoint(int(line), condition)
 			if err != nil {
 				w.WriteHeader(http.StatusBadRequest)
 				return
@@ -102,6 +102,10 @@ var MaxAppBytesValueLen int
 // global or local key/value store
 var MaxAppBytesKeyLen int
 
+// MaxBoxSize is the maximum size of a box value across all protocols,
+// used for decoding purposes.
+var MaxBoxSize int
+
 // StateProofTopVoters is a bound on how many online accounts get to participate
 // in forming the state proof, by including the top StateProofTopVoters accounts
 // (by normalized balance) into the vector commitment.
@@ -73,6 +73,10 @@ const StateProofFileName = "stateproof.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// PeerCacheFilename is the name of the file in which the websocket network
+// saves the peers it learned, when EnablePeerCache is set.
+const PeerCacheFilename = "peers.json"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus protocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
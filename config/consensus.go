@@ -168,6 +168,11 @@ type ConsensusParams struct {
 	AgreementDeadlineTimeoutPeriod0 time.Duration
 
 	FastRecoveryLambda time.Duration // time between fast recovery attempts
+	// extra time to wait before the first fast recovery attempt of a round; if zero, FastRecoveryLambda is used
+	FastRecoveryFirstDelay time.Duration
+	// time by which the first next-vote recovery step is extended past the deadline timeout; each later step
+	// doubles it. If zero, Protocol.SmallLambda is used
+	AgreementRecoveryExtraTimeout time.Duration
 
 	// how to commit to the payset: flat or merkle tree
 	PaysetCommit PaysetCommitType
@@ -554,6 +559,14 @@ type ConsensusParams struct {
 
 	// Heartbeat support
 	Heartbeat bool
+
+	// CompactVoteBundles makes agreement send vote bundles in a compact
+	// binary encoding, which omits the msgpack framing of each vote.
+	CompactVoteBundles bool
+
+	// ChunkedProposalPayloads makes agreement split large proposal payloads
+	// into chunks, which relays forward before the full payload is received.
+	ChunkedProposalPayloads bool
 }
 
 // ProposerPayoutRules puts several related consensus parameters in one place. The same
@@ -712,6 +725,7 @@ func checkSetAllocBounds(p ConsensusParams) {
 	checkSetMax(p.MaxAssetURLBytes, &bounds.MaxAssetURLBytes)
 	checkSetMax(p.MaxAppBytesValueLen, &bounds.MaxAppBytesValueLen)
 	checkSetMax(p.MaxAppKeyLen, &bounds.MaxAppBytesKeyLen)
+	checkSetMax(int(p.MaxBoxSize), &bounds.MaxBoxSize)
 	checkSetMax(int(p.StateProofTopVoters), &bounds.StateProofTopVoters)
 	checkSetMax(p.MaxTxnBytesPerBlock, &bounds.MaxTxnBytesPerBlock)
 
@@ -807,7 +821,9 @@ func initConsensusProtocols() {
 		AgreementFilterTimeoutPeriod0:   4 * time.Second,
 		AgreementDeadlineTimeoutPeriod0: Protocol.BigLambda + Protocol.SmallLambda,
 
-		FastRecoveryLambda: 5 * time.Minute,
+		FastRecoveryLambda:            5 * time.Minute,
+		FastRecoveryFirstDelay:        5 * time.Minute,
+		AgreementRecoveryExtraTimeout: Protocol.SmallLambda,
 
 		SeedLookback:        2,
 		SeedRefreshInterval: 100,
@@ -1397,6 +1413,9 @@ func initConsensusProtocols() {
 	vFuture.LogicSigVersion = 12       // When moving this to a release, put a new higher LogicSigVersion here
 	vFuture.EnableAppVersioning = true // if not promoted when v12 goes into effect, update logic/field.go
 
+	vFuture.CompactVoteBundles = true
+	vFuture.ChunkedProposalPayloads = true
+
 	Consensus[protocol.ConsensusFuture] = vFuture
 
 	// vAlphaX versions are an separate series of consensus parameters and versions for alphanet
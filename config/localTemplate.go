@@ -44,7 +44,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32" version[33]:"33" version[34]:"34" version[35]:"35" version[36]:"36"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32" version[33]:"33" version[34]:"34" version[35]:"35" version[36]:"36" version[37]:"37"`
 
 	// Archival nodes retain a full copy of the block history. Non-Archival nodes will delete old blocks and only retain what's need to properly validate blockchain messages (the precise number of recent blocks depends on the consensus parameters. Currently the last 1321 blocks are required). This means that non-Archival nodes require significantly less storage than Archival nodes.  If setting this to true for the first time, the existing ledger may need to be deleted to get the historical values stored as the setting only affects current blocks forward. To do this, shutdown the node and delete all .sqlite files within the data/testnet-version directory, except the crash.sqlite file. Restart the node and wait for the node to sync.
 	Archival bool `version[0]:"false"`
@@ -188,6 +188,18 @@ type Local struct {
 	// This is not typically something a user would configure. For more information see config/dnsbootstrap.go.
 	DNSBootstrapID string `version[0]:"<network>.algorand.network" version[28]:"<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)"`
 
+	// DNSBootstrapRefreshInterval is how often the DNS bootstrap records are resolved again to refresh the phonebook.
+	// A random duration of up to DNSBootstrapRefreshJitter is added to every interval.
+	DNSBootstrapRefreshInterval time.Duration `version[37]:"60000000000"`
+
+	// DNSBootstrapRefreshJitter is the maximum random duration added to DNSBootstrapRefreshInterval.
+	DNSBootstrapRefreshJitter time.Duration `version[37]:"10000000000"`
+
+	// DNSBootstrapShrinkAlertPercent is the percentage by which the relay or archival addresses of a DNS bootstrap
+	// record may shrink between two refreshes before a warning and a telemetry event are emitted, as this may be the
+	// sign of a DNS outage or hijack. A value of 0 disables the alert.
+	DNSBootstrapShrinkAlertPercent uint64 `version[37]:"50"`
+
 	// LogSizeLimit is the log file size limit in bytes. When set to 0 logs will be written to stdout.
 	LogSizeLimit uint64 `version[0]:"1073741824"`
 
@@ -349,6 +361,33 @@ type Local struct {
 	// value, the connection is refused.
 	ConnectionsRateLimitingCount uint `version[4]:"60"`
 
+	// SubnetConnectionsRateLimitingCount is like ConnectionsRateLimitingCount, but counts the connections to all the
+	// addresses which share a subnet, so that many addresses from a single network can't be used to bypass the
+	// per-address limit. The subnets are given by SubnetRateLimitingIPv4Prefix and SubnetRateLimitingIPv6Prefix.
+	// Providing a zero value in this variable disables the subnet rate limiting.
+	SubnetConnectionsRateLimitingCount uint `version[37]:"0"`
+
+	// SubnetConnectionsRateLimitingWindowSeconds is the window used along with SubnetConnectionsRateLimitingCount.
+	SubnetConnectionsRateLimitingWindowSeconds uint `version[37]:"10"`
+
+	// SubnetRateLimitingIPv4Prefix is the CIDR prefix length which IPv4 addresses are aggregated by for subnet rate limiting.
+	SubnetRateLimitingIPv4Prefix int `version[37]:"24"`
+
+	// SubnetRateLimitingIPv6Prefix is the CIDR prefix length which IPv6 addresses are aggregated by for subnet rate limiting.
+	SubnetRateLimitingIPv6Prefix int `version[37]:"48"`
+
+	// ConnectionBackoffBase is how long a relay is skipped after failing to connect, on top of the connection rate
+	// limiting. The backoff doubles after each consecutive failure, up to ConnectionBackoffMax, and is reset once
+	// a connection succeeds. Providing a zero value in this variable disables the backoff.
+	ConnectionBackoffBase time.Duration `version[37]:"2000000000"`
+
+	// ConnectionBackoffMax is the longest backoff of a relay which repeatedly fails to connect.
+	ConnectionBackoffMax time.Duration `version[37]:"600000000000"`
+
+	// ConnectionBackoffDecay is how long a relay has to go without failing to connect for one of its consecutive
+	// failures to be forgiven, which shortens its next backoff.
+	ConnectionBackoffDecay time.Duration `version[37]:"1800000000000"`
+
 	// EnableRequestLogger enabled the logging of the incoming requests to the telemetry server.
 	EnableRequestLogger bool `version[4]:"false"`
 
@@ -559,12 +598,50 @@ type Local struct {
 	// AgreementIncomingBundlesQueueLength sets the size of the buffer holding incoming bundles.
 	AgreementIncomingBundlesQueueLength uint64 `version[21]:"7" version[27]:"15"`
 
+	// AgreementCredentialArrivalHistory sets the number of past rounds whose lowest credential arrival times
+	// are used to compute the dynamic filter timeout. A value of 0 uses the default history size.
+	// Values above 1000 are treated as 1000.
+	AgreementCredentialArrivalHistory uint64 `version[37]:"40"`
+
+	// AgreementCredentialRoundLag sets the number of rounds after which a late credential from a past round is
+	// no longer tracked for the dynamic filter timeout. A value of 0 derives the lag from the consensus parameters.
+	// Other values are bounded by what the consensus parameters allow.
+	AgreementCredentialRoundLag uint64 `version[37]:"0"`
+
+	// AgreementDynamicFilterPercentile sets the percentile of the credential arrival history which determines
+	// the dynamic filter timeout. A value of 0 uses the default percentile. Values above 100 are treated as 100.
+	AgreementDynamicFilterPercentile uint64 `version[37]:"95"`
+
+	// AgreementDynamicFilterMargin sets the safety margin which is added to the credential arrival time
+	// selected by AgreementDynamicFilterPercentile to obtain the dynamic filter timeout.
+	// A value of 0 uses the default margin.
+	AgreementDynamicFilterMargin time.Duration `version[37]:"50000000"`
+
+	// AgreementCrashDBSizeTarget is the size, in bytes, above which agreement compacts its crash recovery database
+	// by pruning old equivocation evidence. The unused pages are reclaimed by the database maintenance, see
+	// DatabaseMaintenanceInterval. A value of 0 disables compaction.
+	AgreementCrashDBSizeTarget uint64 `version[37]:"67108864"`
+
+	// AgreementCrashDBCompactionInterval is how often agreement checks the size of its crash recovery database.
+	// A value of 0 uses the default interval.
+	AgreementCrashDBCompactionInterval time.Duration `version[37]:"3600000000000"`
+
+	// AgreementNTPServers is a comma-separated list of NTP servers. If set, agreement timeouts are measured by a clock
+	// disciplined against these servers instead of the system's monotonic clock.
+	AgreementNTPServers string `version[37]:""`
+
+	// AgreementNTPInterval is how often the NTP servers in AgreementNTPServers are queried.
+	AgreementNTPInterval time.Duration `version[37]:"1024000000000"`
+
 	// MaxAcctLookback sets the maximum lookback range for account states,
 	// i.e. the ledger can answer account states questions for the range Latest-MaxAcctLookback...Latest
+	// Every round of the range is kept in memory, so values above 10000 are lowered to it.
 	MaxAcctLookback uint64 `version[23]:"4"`
 
 	// BlockHistoryLookback sets the max lookback range for block information.
 	// i.e. the block DB can return transaction IDs for questions for the range Latest-MaxBlockHistoryLookback...Latest
+	// On non-archival nodes it is the block retention window: the blocks older than it, and not otherwise required
+	// by the ledger, are pruned online, at most BlockPruningBatchSize blocks at a time.
 	MaxBlockHistoryLookback uint64 `version[31]:"0"`
 
 	// EnableUsageLog enables 10Hz log of CPU and RAM usage.
@@ -646,6 +723,217 @@ type Local struct {
 
 	// EnableVoteCompression controls whether vote compression is enabled for websocket networks
 	EnableVoteCompression bool `version[36]:"true"`
+
+	// EnableBatchVoteVerification makes agreement verify the signatures of queued votes together
+	// using ed25519 batch verification, rather than verifying each vote individually.
+	EnableBatchVoteVerification bool `version[37]:"false"`
+
+	// EnablePeerCache makes the websocket network save the relay and archival peers it learned to the data
+	// directory on shutdown, and reload them on startup so it can reconnect before DNS bootstrapping completes.
+	EnablePeerCache bool `version[37]:"false"`
+
+	// MisbehavingPeerBanDuration is how long the websocket network avoids reconnecting to a relay it disconnected
+	// from because it sent invalid messages or otherwise violated the protocol. A value of 0 disables banning.
+	MisbehavingPeerBanDuration time.Duration `version[37]:"600000000000"`
+
+	// EnablePeerExchange makes relays with a PublicAddress periodically share a signed sample of the relays they are
+	// connected to, and makes nodes merge the samples received from identity-verified relays into their phonebook.
+	EnablePeerExchange bool `version[37]:"false"`
+
+	// PeerExchangeInterval is how often a relay shares its peers when EnablePeerExchange is set.
+	PeerExchangeInterval time.Duration `version[37]:"600000000000"`
+
+	// PeerExchangeMaxPeers caps the number of relay addresses learned through peer exchange which are kept in the
+	// phonebook, so that peer exchange can't crowd out the addresses obtained from the DNS bootstrap records.
+	PeerExchangeMaxPeers int `version[37]:"64"`
+
+	// EnableMDNSDiscovery makes the websocket network advertise itself and discover the other nodes of the same
+	// network on the local network segment using multicast DNS, and add them to the phonebook. It is meant for
+	// private and development networks.
+	EnableMDNSDiscovery bool `version[37]:"false"`
+
+	// PeerASNDatabaseFile is the path of an IP to autonomous system table, in the tab separated format of
+	// iptoasn.com and optionally gzip compressed. When it is set, the outgoing relay connections are spread across
+	// as many autonomous systems as possible, so that a single provider or datacenter can't host all of a node's
+	// peers. When it is empty, the outgoing relays are picked at random.
+	PeerASNDatabaseFile string `version[37]:""`
+
+	// OutgoingProxy is the URL of a proxy through which all the outgoing relay connections are made, for nodes which
+	// can't reach the network directly. Both socks5://[user:pass@]host:port and http://[user:pass@]host:port (using
+	// the CONNECT method) are supported. The proxy resolves the relay host names, and the DNS bootstrap records are
+	// looked up over TCP through the proxy using FallbackDNSResolverAddress (which must then be an IP address) or a
+	// public DNS server, without DNSSEC validation. A p2p node using a proxy doesn't accept incoming connections.
+	OutgoingProxy string `version[37]:""`
+
+	// EnableMessageCompression makes the node advertise support for zstd compressed gossip messages, and compress the
+	// large vote bundles and transaction groups it sends to the peers which advertised it as well. Proposal payloads
+	// are always compressed.
+	EnableMessageCompression bool `version[37]:"false"`
+
+	// MessageCompressionThreshold is the size in bytes from which the messages are compressed when
+	// EnableMessageCompression is set. Lower values save more bandwidth at the expense of CPU time.
+	MessageCompressionThreshold int `version[37]:"2048"`
+
+	// MessageCompressionLevel is the zstd compression level used for the messages compressed when
+	// EnableMessageCompression is set, from 1 (fastest) to 22 (smallest output).
+	MessageCompressionLevel int `version[37]:"1"`
+
+	// EnablePortMapping makes a node accepting incoming connections map its listening port on the local router
+	// using NAT-PMP or UPnP, and renew the mapping while it runs, so that nodes run at home can be reached. The
+	// external address of the mapping is advertised to the peers when PublicAddress is not set.
+	EnablePortMapping bool `version[37]:"false"`
+
+	// PreferIPv6 makes the node try the IPv6 addresses of peers having both IPv4 and IPv6 addresses first.
+	// Otherwise, the address family returned first by the resolver is tried first.
+	PreferIPv6 bool `version[37]:"false"`
+
+	// DualStackFallbackDelay is how long a connection attempt to one of the addresses of a peer is given
+	// before an attempt to its next address, alternating the address families, is started concurrently.
+	DualStackFallbackDelay time.Duration `version[37]:"300000000"`
+
+	// MaxOutgoingDialsInFlight is the maximal number of outgoing connections being dialed at the same time.
+	// Further dials wait for one of them to complete. A value of 0 does not limit the number of dials.
+	MaxOutgoingDialsInFlight int `version[37]:"8"`
+
+	// OutgoingDialInterval is the minimal time between the starts of two outgoing dials, so that a node which
+	// has just started does not dial all the addresses it knows at once. A value of 0 disables the pacing.
+	OutgoingDialInterval time.Duration `version[37]:"50000000"`
+
+	// GossipTagSubscriptions maps relay addresses to the comma-separated gossip tags, e.g. "AV,VB", to be exchanged
+	// on the outgoing connections to them, so that dedicated links can carry the votes and the payloads to different
+	// relays. The relay agrees to the subscription during the handshake; other relays exchange all the gossip tags.
+	GossipTagSubscriptions map[string]string `version[37]:""`
+
+	// EnablePersistentPeerReconnect makes the node keep connections to the persistent relays, i.e. those given on the
+	// command line, in the phonebook.json file or through the admin API, separately from the other outgoing connections.
+	// An unreachable persistent relay is retried with an exponential backoff with jitter, up to PersistentPeerBackoffMax.
+	EnablePersistentPeerReconnect bool `version[37]:"false"`
+
+	// PersistentPeerBackoffMax is the maximal delay between two connection attempts to a persistent relay.
+	PersistentPeerBackoffMax time.Duration `version[37]:"60000000000"`
+
+	// PersistentPeerHealthTimeout is how long a connection to a persistent relay may go without receiving any message
+	// before it is dropped and made again. A value of 0 disables this health check.
+	PersistentPeerHealthTimeout time.Duration `version[37]:"60000000000"`
+
+	// OutgoingConnectionBudgets maps phonebook roles ("relay", "archival", "blockservice" and "txgossip") to the
+	// number of outgoing connections to maintain to the peers having them, e.g. {"relay": 4, "archival": 2}. Each
+	// budget is met independently. When it is empty, GossipFanout connections are made to relays instead.
+	OutgoingConnectionBudgets map[string]int `version[37]:""`
+
+	// PeerOutgoingBytesPerSecond caps the bandwidth used to send messages to each incoming connection, so that a
+	// single node can't consume a large share of the bandwidth of a relay. Bursts of up to one second of traffic
+	// are allowed. A value of 0 disables the cap.
+	PeerOutgoingBytesPerSecond uint64 `version[37]:"0"`
+
+	// PeerOutgoingTagBytesPerSecond caps the bandwidth used to send the messages of the given tags to each incoming
+	// connection, e.g. {"TS": 1000000} caps the topic responses used by catchup to 1MB/s per peer. It applies on
+	// top of PeerOutgoingBytesPerSecond.
+	PeerOutgoingTagBytesPerSecond map[string]uint64 `version[37]:""`
+
+	// GossipDropTelemetrySampling controls the telemetry events reported for dropped gossip messages. Every dropped
+	// message is counted in the algod_network_gossip_messages_dropped_total metric; in addition, one GossipMessageDropped
+	// event is emitted for every GossipDropTelemetrySampling dropped messages. A value of 0 disables the event.
+	GossipDropTelemetrySampling uint64 `version[37]:"0"`
+
+	// PersistNetIdentityKeys writes the key used in the identity challenge exchange to the node's data directory,
+	// so that the node keeps its identity across restarts. This is only used when PublicAddress is set.
+	// Deleting the keys file while the node is stopped makes the node generate a new identity key.
+	PersistNetIdentityKeys bool `version[37]:"false"`
+
+	// NetIdentityKeyRotationInterval is how often the key used in the identity challenge exchange is replaced
+	// with a new one. A value of 0 disables the rotation.
+	NetIdentityKeyRotationInterval time.Duration `version[37]:"0"`
+
+	// NetIdentityKeyRotationOverlap is how long the previous identity key is kept after a rotation, so that the
+	// identity challenge exchanges started with it can still be completed.
+	NetIdentityKeyRotationOverlap time.Duration `version[37]:"600000000000"`
+
+	// RelayDrainTimeout is the longest time a draining relay waits for its incoming peers to move to other relays
+	// before it reports that it is safe to shut down. Peers running older versions don't leave a draining relay.
+	RelayDrainTimeout time.Duration `version[37]:"120000000000"`
+
+	// BlockStorageEngine allows to control which type of storage to use for the ledger's block database,
+	// independently of the StorageEngine used for the trackers. The blocks are not migrated between engines,
+	// so it should only be changed on a node with an empty data directory.
+	// Available options are:
+	// - sqlite (default)
+	// - pebbledb (experimental, in development)
+	BlockStorageEngine string `version[37]:"sqlite"`
+
+	// AccountHistoryRounds is the number of committed rounds for which the ledger keeps the state of the modified
+	// accounts, so that account balances can be looked up at any of these rounds. A value of 0 disables the history.
+	// The history only covers the rounds committed since it was enabled, and it is restarted after a fast catchup.
+	// Applying the rewards requires the block headers, so it should not exceed the rounds of blocks kept unless Archival is set.
+	AccountHistoryRounds uint64 `version[37]:"0"`
+
+	// DeltaStreamSocket is the path of a unix socket on which the node streams the state delta of every committed
+	// round to its subscribers, such as indexing pipelines. A relative path is relative to the data directory.
+	// Subscribers falling behind lose the stream unless the deltas are held with a sync round, see EnableFollowMode.
+	// An empty value disables the stream.
+	DeltaStreamSocket string `version[37]:""`
+
+	// BlockEvalParallelism is the number of goroutines evaluating the transaction groups of a block when validating
+	// or applying it. Groups which access disjoint accounts, assets and applications are evaluated concurrently,
+	// and the resulting state delta is the same as that of a sequential evaluation. A value lower than 2 disables it.
+	BlockEvalParallelism int `version[37]:"0"`
+
+	// LedgerAccountsCacheSize is the number of recently used accounts the ledger caches in memory, on top of the
+	// accounts modified by the rounds not yet written to the ledger database. Values are bounded to the
+	// 1000...10000000 range. The cache is not used when DisableLedgerLRUCache is set.
+	LedgerAccountsCacheSize int `version[37]:"100000"`
+
+	// TxTailRetainRounds is the number of recent rounds for which the ledger keeps the block headers and the
+	// transactions needed for duplicate detection. The protocol requires MaxTxnLife + DeeperBlockHeaderHistory
+	// rounds, which is used by default and for lower values, and values above 100000 are lowered to it.
+	// Keeping more rounds lets block header lookups of older rounds be answered from memory.
+	TxTailRetainRounds uint64 `version[37]:"0"`
+
+	// ParticipationStorageMode makes a non-archival node keep only the ledger data required to validate blocks and
+	// vote. Catchpoint tracking, AccountHistoryRounds, MaxBlockHistoryLookback and TxTailRetainRounds are disabled,
+	// the accounts merkle trie left by an earlier catchpoint tracking is dropped, and the space it used is reclaimed
+	// on startup. The setting is ignored when Archival is set.
+	ParticipationStorageMode bool `version[37]:"false"`
+
+	// CatchpointWritingMaxBytesPerSecond limits the rate at which the catchpoint data file is written, counting the
+	// account data before compression. A value of 0 disables the limit.
+	CatchpointWritingMaxBytesPerSecond uint64 `version[37]:"0"`
+
+	// CatchpointWritingMaxCPUPercent limits the share of time spent writing the catchpoint data file, the remaining
+	// time being spent pausing between the written chunks. Values of 0 and 100 or above disable the limit.
+	CatchpointWritingMaxCPUPercent uint64 `version[37]:"0"`
+
+	// CatchpointWritingWindows is a comma separated list of HH:MM-HH:MM daily time windows, in local time, during which
+	// the catchpoint data file is written. Outside these windows the writing pauses. An empty value allows it at any time.
+	CatchpointWritingWindows string `version[37]:""`
+
+	// CatchpointWritingMaxDeferredRounds is the number of rounds that may await being committed to the ledger
+	// database while the catchpoint data file is written, before the writing ignores the limits set by
+	// CatchpointWritingMaxBytesPerSecond, CatchpointWritingMaxCPUPercent and CatchpointWritingWindows and completes
+	// as fast as possible. The writing also completes as fast as possible once the next catchpoint round is reached.
+	// A value of 0 disables the limit.
+	CatchpointWritingMaxDeferredRounds uint64 `version[37]:"1000"`
+
+	// DatabaseMaintenanceInterval is how often the node measures the fragmentation of its ledger and agreement
+	// databases and, while it isn't busy writing to the ledger, refreshes their query planner statistics and
+	// reclaims their unused pages. The first startup with the maintenance enabled vacuums the ledger and
+	// agreement databases once to enable the incremental vacuuming of their unused pages, which may take long
+	// on large databases.
+	// A value of 0 disables the maintenance.
+	DatabaseMaintenanceInterval time.Duration `version[37]:"0"`
+
+	// DatabaseMaintenanceFreePagesPercent is the percentage of unused pages of a database above which the
+	// database maintenance reclaims them. See DatabaseMaintenanceInterval.
+	DatabaseMaintenanceFreePagesPercent uint64 `version[37]:"10"`
+
+	// DatabaseMaintenancePagesPerStep is the number of unused pages the database maintenance reclaims at once,
+	// before checking whether the node became busy. See DatabaseMaintenanceInterval.
+	DatabaseMaintenancePagesPerStep uint64 `version[37]:"1024"`
+
+	// BlockPruningBatchSize is the maximum number of blocks a non-archival node deletes from its block database
+	// after each flush of new blocks, which bounds the time the block database is held by the pruning after the
+	// retention window was shortened. A value of 0 uses the default of 10000 blocks.
+	BlockPruningBatchSize uint64 `version[37]:"10000"`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
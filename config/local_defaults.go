@@ -20,18 +20,30 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    36,
+	Version:                                    37,
+	AccountHistoryRounds:                       0,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
+	AgreementCrashDBCompactionInterval:         3600000000000,
+	AgreementCrashDBSizeTarget:                 67108864,
+	AgreementCredentialArrivalHistory:          40,
+	AgreementCredentialRoundLag:                0,
+	AgreementDynamicFilterMargin:               50000000,
+	AgreementDynamicFilterPercentile:           95,
 	AgreementIncomingBundlesQueueLength:        15,
 	AgreementIncomingProposalsQueueLength:      50,
 	AgreementIncomingVotesQueueLength:          20000,
+	AgreementNTPInterval:                       1024000000000,
+	AgreementNTPServers:                        "",
 	AnnounceParticipationKey:                   true,
 	Archival:                                   false,
 	BaseLoggerDebugLevel:                       4,
 	BlockDBDir:                                 "",
+	BlockEvalParallelism:                       0,
+	BlockPruningBatchSize:                      10000,
 	BlockServiceCustomFallbackEndpoints:        "",
 	BlockServiceMemCap:                         500000000,
+	BlockStorageEngine:                         "sqlite",
 	BroadcastConnectionsLimit:                  -1,
 	CadaverDirectory:                           "",
 	CadaverSizeTarget:                          0,
@@ -39,6 +51,10 @@ var defaultLocal = Local{
 	CatchpointFileHistoryLength:                365,
 	CatchpointInterval:                         10000,
 	CatchpointTracking:                         0,
+	CatchpointWritingMaxBytesPerSecond:         0,
+	CatchpointWritingMaxCPUPercent:             0,
+	CatchpointWritingMaxDeferredRounds:         1000,
+	CatchpointWritingWindows:                   "",
 	CatchupBlockDownloadRetryAttempts:          1000,
 	CatchupBlockValidateMode:                   0,
 	CatchupFailurePeerRefreshRate:              10,
@@ -47,22 +63,34 @@ var defaultLocal = Local{
 	CatchupLedgerDownloadRetryAttempts:         50,
 	CatchupParallelBlocks:                      16,
 	ColdDataDir:                                "",
+	ConnectionBackoffBase:                      2000000000,
+	ConnectionBackoffDecay:                     1800000000000,
+	ConnectionBackoffMax:                       600000000000,
 	ConnectionsRateLimitingCount:               60,
 	ConnectionsRateLimitingWindowSeconds:       1,
 	CrashDBDir:                                 "",
 	DNSBootstrapID:                             "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)",
+	DNSBootstrapRefreshInterval:                60000000000,
+	DNSBootstrapRefreshJitter:                  10000000000,
+	DNSBootstrapShrinkAlertPercent:             50,
 	DNSSecurityFlags:                           9,
+	DatabaseMaintenanceFreePagesPercent:        10,
+	DatabaseMaintenanceInterval:                0,
+	DatabaseMaintenancePagesPerStep:            1024,
 	DeadlockDetection:                          0,
 	DeadlockDetectionThreshold:                 30,
+	DeltaStreamSocket:                          "",
 	DisableAPIAuth:                             false,
 	DisableLedgerLRUCache:                      false,
 	DisableLocalhostConnectionRateLimit:        true,
 	DisableNetworking:                          false,
 	DisableOutgoingConnectionThrottling:        false,
+	DualStackFallbackDelay:                     300000000,
 	EnableAccountUpdatesStats:                  false,
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
 	EnableAssembleStats:                        false,
+	EnableBatchVoteVerification:                false,
 	EnableBlockService:                         false,
 	EnableDHTProviders:                         false,
 	EnableDeveloperAPI:                         false,
@@ -72,12 +100,18 @@ var defaultLocal = Local{
 	EnableGossipService:                        true,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
+	EnableMDNSDiscovery:                        false,
+	EnableMessageCompression:                   false,
 	EnableMetricReporting:                      false,
 	EnableNetDevMetrics:                        false,
 	EnableOutgoingNetworkMessageFiltering:      true,
 	EnableP2P:                                  false,
 	EnableP2PHybridMode:                        false,
+	EnablePeerCache:                            false,
+	EnablePeerExchange:                         false,
+	EnablePersistentPeerReconnect:              false,
 	EnablePingHandler:                          true,
+	EnablePortMapping:                          false,
 	EnablePrivateNetworkAccessHeader:           false,
 	EnableProcessBlockStats:                    false,
 	EnableProfiler:                             false,
@@ -95,12 +129,15 @@ var defaultLocal = Local{
 	ForceFetchTransactions:                     false,
 	ForceRelayMessages:                         false,
 	GoMemLimit:                                 0,
+	GossipDropTelemetrySampling:                0,
 	GossipFanout:                               4,
+	GossipTagSubscriptions:                     map[string]string{},
 	HeartbeatUpdateInterval:                    600,
 	HotDataDir:                                 "",
 	IncomingConnectionsLimit:                   2400,
 	IncomingMessageFilterBucketCount:           5,
 	IncomingMessageFilterBucketSize:            512,
+	LedgerAccountsCacheSize:                    100000,
 	LedgerSynchronousMode:                      2,
 	LogArchiveDir:                              "",
 	LogArchiveMaxAge:                           "",
@@ -113,26 +150,46 @@ var defaultLocal = Local{
 	MaxBlockHistoryLookback:                    0,
 	MaxCatchpointDownloadDuration:              43200000000000,
 	MaxConnectionsPerIP:                        8,
+	MaxOutgoingDialsInFlight:                   8,
+	MessageCompressionLevel:                    1,
+	MessageCompressionThreshold:                2048,
 	MinCatchpointFileDownloadBytesPerSecond:    20480,
+	MisbehavingPeerBanDuration:                 600000000000,
 	NetAddress:                                 "",
+	NetIdentityKeyRotationInterval:             0,
+	NetIdentityKeyRotationOverlap:              600000000000,
 	NetworkMessageTraceServer:                  "",
 	NetworkProtocolVersion:                     "",
 	NodeExporterListenAddress:                  ":9100",
 	NodeExporterPath:                           "./node_exporter",
 	OptimizeAccountsDatabaseOnStartup:          false,
+	OutgoingConnectionBudgets:                  map[string]int{},
+	OutgoingDialInterval:                       50000000,
 	OutgoingMessageFilterBucketCount:           3,
 	OutgoingMessageFilterBucketSize:            128,
+	OutgoingProxy:                              "",
 	P2PHybridIncomingConnectionsLimit:          1200,
 	P2PHybridNetAddress:                        "",
 	P2PPersistPeerID:                           false,
 	P2PPrivateKeyLocation:                      "",
 	ParticipationKeysRefreshInterval:           60000000000,
+	ParticipationStorageMode:                   false,
+	PeerASNDatabaseFile:                        "",
 	PeerConnectionsUpdateInterval:              3600,
+	PeerExchangeInterval:                       600000000000,
+	PeerExchangeMaxPeers:                       64,
+	PeerOutgoingBytesPerSecond:                 0,
+	PeerOutgoingTagBytesPerSecond:              map[string]uint64{},
 	PeerPingPeriodSeconds:                      0,
+	PersistNetIdentityKeys:                     false,
+	PersistentPeerBackoffMax:                   60000000000,
+	PersistentPeerHealthTimeout:                60000000000,
+	PreferIPv6:                                 false,
 	PriorityPeers:                              map[string]bool{},
 	ProposalAssemblyTime:                       500000000,
 	PublicAddress:                              "",
 	ReconnectTime:                              60000000000,
+	RelayDrainTimeout:                          120000000000,
 	ReservedFDs:                                256,
 	RestConnectionsHardLimit:                   2048,
 	RestConnectionsSoftLimit:                   1024,
@@ -141,6 +198,10 @@ var defaultLocal = Local{
 	RunHosted:                                  false,
 	StateproofDir:                              "",
 	StorageEngine:                              "sqlite",
+	SubnetConnectionsRateLimitingCount:         0,
+	SubnetConnectionsRateLimitingWindowSeconds: 10,
+	SubnetRateLimitingIPv4Prefix:               24,
+	SubnetRateLimitingIPv6Prefix:               48,
 	SuggestedFeeBlockHistory:                   3,
 	SuggestedFeeSlidingWindowSize:              50,
 	TLSCertFile:                                "",
@@ -162,6 +223,7 @@ var defaultLocal = Local{
 	TxPoolSize:                                 75000,
 	TxSyncIntervalSeconds:                      60,
 	TxSyncServeResponseSize:                    1000000,
+	TxTailRetainRounds:                         0,
 	TxSyncTimeoutSeconds:                       30,
 	UseXForwardedForAddressField:               "",
 	VerifiedTranscationsCacheSize:              150000,
@@ -26,6 +26,26 @@ import (
 
 // KeysBuilder Responsible for generate slice of falcon keys
 func KeysBuilder(numberOfKeys uint64) ([]crypto.FalconSigner, error) {
+	return buildKeys(numberOfKeys, func(uint64) (*crypto.FalconSigner, error) {
+		return crypto.NewFalconSigner()
+	})
+}
+
+// KeysBuilderFromRNG is a version of KeysBuilder that draws the seeds of the keys from rng,
+// in the order of the keys, so the same rng state always builds the same keys.
+func KeysBuilderFromRNG(numberOfKeys uint64, rng crypto.RNG) ([]crypto.FalconSigner, error) {
+	seeds := make([]crypto.FalconSeed, numberOfKeys)
+	for i := range seeds {
+		rng.RandBytes(seeds[i][:])
+	}
+	return buildKeys(numberOfKeys, func(k uint64) (*crypto.FalconSigner, error) {
+		signer, err := crypto.GenerateFalconSigner(seeds[k])
+		return &signer, err
+	})
+}
+
+// buildKeys generates the keys in parallel, calling newKey for every key index.
+func buildKeys(numberOfKeys uint64, newKey func(k uint64) (*crypto.FalconSigner, error)) ([]crypto.FalconSigner, error) {
 	numOfKeysPerRoutine, _ := calculateRanges(numberOfKeys)
 
 	ctx, ctxCancel := context.WithCancel(context.Background())
@@ -49,7 +69,7 @@ func KeysBuilder(numberOfKeys uint64) ([]crypto.FalconSigner, error) {
 		wg.Add(1)
 		go func(startIdx, endIdx uint64, keys []crypto.FalconSigner) {
 			defer wg.Done()
-			if err := generateKeysForRange(ctx, startIdx, endIdx, keys); err != nil {
+			if err := generateKeysForRange(ctx, startIdx, endIdx, keys, newKey); err != nil {
 				// write to the error channel, if it's not full already.
 				select {
 				case errors <- err:
@@ -81,12 +101,12 @@ func calculateRanges(numberOfKeys uint64) (numOfKeysPerRoutine uint64, numOfRout
 	return
 }
 
-func generateKeysForRange(ctx context.Context, startIdx uint64, endIdx uint64, keys []crypto.FalconSigner) error {
+func generateKeysForRange(ctx context.Context, startIdx uint64, endIdx uint64, keys []crypto.FalconSigner, newKey func(k uint64) (*crypto.FalconSigner, error)) error {
 	for k := startIdx; k < endIdx; k++ {
 		if ctx.Err() != nil {
 			return nil //nolint:nilerr // we don't need to return the ctx error, since the other goroutine will report it.
 		}
-		sigAlgo, err := crypto.NewFalconSigner()
+		sigAlgo, err := newKey(k)
 		if err != nil {
 			return err
 		}
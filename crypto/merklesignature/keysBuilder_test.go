@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -76,3 +77,22 @@ func BenchmarkMerkleSignatureSchemeGenerate(b *testing.B) {
 		New(0, 3000000, 256)
 	}
 }
+
+func TestBuilderFromRNG(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	numOfKeys := uint64(runtime.NumCPU()*2 + 3)
+	keys, err := KeysBuilderFromRNG(numOfKeys, crypto.MakePRNG([]byte("seed")))
+	a.NoError(err)
+	a.Equal(numOfKeys, uint64(len(keys)))
+
+	// the same rng state builds the same keys, in the same order
+	again, err := KeysBuilderFromRNG(numOfKeys, crypto.MakePRNG([]byte("seed")))
+	a.NoError(err)
+	a.Equal(keys, again)
+
+	other, err := KeysBuilderFromRNG(numOfKeys, crypto.MakePRNG([]byte("other seed")))
+	a.NoError(err)
+	a.NotEqual(keys[0], other[0])
+}
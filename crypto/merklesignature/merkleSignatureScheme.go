@@ -106,6 +106,18 @@ var (
 // This function generates one key for each round within the participation period [firstValid, lastValid] (inclusive bounds)
 // which holds round % interval == 0.
 func New(firstValid, lastValid, keyLifetime uint64) (*Secrets, error) {
+	return newSecrets(firstValid, lastValid, keyLifetime, KeysBuilder)
+}
+
+// NewFromRNG is a version of New whose keys are generated from rng, so the same rng state
+// always gives the same secrets. It is meant for reproducible test networks.
+func NewFromRNG(firstValid, lastValid, keyLifetime uint64, rng crypto.RNG) (*Secrets, error) {
+	return newSecrets(firstValid, lastValid, keyLifetime, func(numberOfKeys uint64) ([]crypto.FalconSigner, error) {
+		return KeysBuilderFromRNG(numberOfKeys, rng)
+	})
+}
+
+func newSecrets(firstValid, lastValid, keyLifetime uint64, keysBuilder func(numberOfKeys uint64) ([]crypto.FalconSigner, error)) (*Secrets, error) {
 	if firstValid > lastValid {
 		return nil, ErrStartBiggerThanEndRound
 	}
@@ -120,7 +132,7 @@ func New(firstValid, lastValid, keyLifetime uint64) (*Secrets, error) {
 		numberOfKeys = lastValid/keyLifetime + 1 // add 1 for round zero
 	}
 
-	keys, err := KeysBuilder(numberOfKeys)
+	keys, err := keysBuilder(numberOfKeys)
 	if err != nil {
 		return nil, err
 	}
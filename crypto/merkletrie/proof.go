@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// ErrElementNotFound is returned by Prove if the element is not in the trie.
+var ErrElementNotFound = errors.New("element not found in the trie")
+
+// ErrProofMismatch is returned by VerifyProof if the proof doesn't prove the element against the root hash.
+var ErrProofMismatch = errors.New("the proof doesn't match the root hash")
+
+// ProofChild is a child of a node on the path from the root of the trie to an element.
+type ProofChild struct {
+	// Index is the element byte selecting the child in its parent.
+	Index byte
+	// Leaf is set if the child is a leaf.
+	Leaf bool
+	// Hash is the hash of a non-leaf child, or the remainder of the element held by a leaf child.
+	Hash []byte
+}
+
+// ProofNode holds the children of a node on the path from the root of the trie to an element,
+// except for the child the path goes through.
+type ProofNode struct {
+	Siblings []ProofChild
+}
+
+// Proof proves that an element is in a trie. It holds, starting from the root, the siblings of the
+// nodes on the path to the leaf holding the element, which are enough to recompute the root hash.
+type Proof struct {
+	Path []ProofNode
+}
+
+// Prove returns a proof that the given element is in the trie, to be checked with VerifyProof
+// against the root hash of the trie.
+func (mt *Trie) Prove(d []byte) (*Proof, error) {
+	if mt.root == storedNodeIdentifierNull {
+		return nil, ErrElementNotFound
+	}
+	if mt.cache.modified {
+		if _, err := mt.Commit(); err != nil {
+			return nil, err
+		}
+	}
+	pnode, err := mt.cache.getNode(mt.root)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &Proof{}
+	for depth := 0; !pnode.leaf(); depth++ {
+		if depth >= len(d) || !pnode.childrenMask.Bit(d[depth]) {
+			return nil, ErrElementNotFound
+		}
+		var level ProofNode
+		var next storedNodeIdentifier
+		for _, child := range pnode.children {
+			if child.hashIndex == d[depth] {
+				next = child.id
+				continue
+			}
+			childNode, err := mt.cache.getNode(child.id)
+			if err != nil {
+				return nil, err
+			}
+			level.Siblings = append(level.Siblings, ProofChild{
+				Index: child.hashIndex,
+				Leaf:  childNode.leaf(),
+				Hash:  slices.Clone(childNode.hash),
+			})
+		}
+		proof.Path = append(proof.Path, level)
+		pnode, err = mt.cache.getNode(next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !bytes.Equal(pnode.hash, d[len(proof.Path):]) {
+		return nil, ErrElementNotFound
+	}
+	return proof, nil
+}
+
+// VerifyProof checks that the proof proves the inclusion of the element in a trie whose root hash is root.
+func VerifyProof(root crypto.Digest, d []byte, proof *Proof) error {
+	depth := len(proof.Path)
+	if depth > len(d) {
+		return ErrProofMismatch
+	}
+
+	// recompute the hashes from the leaf holding the element up to the root, the same way calculateHash does.
+	child := ProofChild{Leaf: true, Hash: d[depth:]}
+	for i := depth - 1; i >= 0; i-- {
+		child.Index = d[i]
+		accumulator := append([]byte{byte(i)}, d[:i]...)
+		appendChild := func(c ProofChild) {
+			if c.Leaf {
+				accumulator = append(accumulator, byte(0))
+			} else {
+				accumulator = append(accumulator, byte(1))
+			}
+			accumulator = append(accumulator, byte(len(c.Hash)), c.Index)
+			accumulator = append(accumulator, c.Hash...)
+		}
+
+		appended := false
+		for j, sibling := range proof.Path[i].Siblings {
+			if sibling.Index == child.Index || (j > 0 && sibling.Index <= proof.Path[i].Siblings[j-1].Index) {
+				return ErrProofMismatch
+			}
+			if !appended && sibling.Index > child.Index {
+				appendChild(child)
+				appended = true
+			}
+			appendChild(sibling)
+		}
+		if !appended {
+			appendChild(child)
+		}
+		hash := crypto.Hash(accumulator)
+		child = ProofChild{Hash: hash[:]}
+	}
+
+	var rootHash crypto.Digest
+	if child.Leaf {
+		rootHash = crypto.Hash(append([]byte{0}, child.Hash...))
+	} else {
+		rootHash = crypto.Hash(append([]byte{1}, child.Hash...))
+	}
+	if rootHash != root {
+		return ErrProofMismatch
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+
+	hashes := make([]crypto.Digest, 1000)
+	for i := range hashes {
+		hashes[i] = crypto.Hash([]byte{byte(i % 256), byte(i / 256)})
+	}
+
+	// a trie holding a single element is a leaf.
+	_, err = mt.Add(hashes[0][:])
+	require.NoError(t, err)
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+	proof, err := mt.Prove(hashes[0][:])
+	require.NoError(t, err)
+	require.Empty(t, proof.Path)
+	require.NoError(t, VerifyProof(root, hashes[0][:], proof))
+
+	for i := 1; i < len(hashes); i++ {
+		_, err = mt.Add(hashes[i][:])
+		require.NoError(t, err)
+	}
+	root, err = mt.RootHash()
+	require.NoError(t, err)
+
+	for i := range hashes {
+		proof, err = mt.Prove(hashes[i][:])
+		require.NoError(t, err)
+		require.NotEmpty(t, proof.Path)
+		require.NoError(t, VerifyProof(root, hashes[i][:], proof))
+
+		// the proof doesn't hold for other elements, nor other roots.
+		require.ErrorIs(t, VerifyProof(root, hashes[(i+1)%len(hashes)][:], proof), ErrProofMismatch)
+		require.ErrorIs(t, VerifyProof(crypto.Hash(root[:]), hashes[i][:], proof), ErrProofMismatch)
+	}
+
+	// tampering with a sibling breaks the proof.
+	proof, err = mt.Prove(hashes[10][:])
+	require.NoError(t, err)
+	proof.Path[0].Siblings[0].Hash[0]++
+	require.ErrorIs(t, VerifyProof(root, hashes[10][:], proof), ErrProofMismatch)
+
+	missing := crypto.Hash([]byte("missing"))
+	_, err = mt.Prove(missing[:])
+	require.ErrorIs(t, err, ErrElementNotFound)
+
+	// proofs are built from the committed pages as well.
+	mt.Evict(true)
+	proof, err = mt.Prove(hashes[20][:])
+	require.NoError(t, err)
+	require.NoError(t, VerifyProof(root, hashes[20][:], proof))
+}
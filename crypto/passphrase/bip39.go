@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	bip39SeedIterations = 2048
+	bip39SeedLenBytes   = 64
+
+	// slip10Hardened is added to an index to derive a hardened child key, the only kind ed25519 supports
+	slip10Hardened = 0x80000000
+)
+
+// Bip39MnemonicToSeed checks the words and checksum of a 12 to 24 word BIP39 mnemonic,
+// and returns the 64-byte seed it stands for. BIP39 passphrases are not supported, so
+// the seed is the one of an empty passphrase.
+func Bip39MnemonicToSeed(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, errWrongBip39MnemonicLen
+	}
+
+	// every word holds 11 bits; the last len(words)/3 bits are the checksum
+	bits := make([]bool, 0, len(words)*bitsPerWord)
+	for _, w := range words {
+		idx := indexOf(wordlist, w)
+		if idx == -1 {
+			return nil, fmt.Errorf("%s is not in the words list", w)
+		}
+		for b := bitsPerWord - 1; b >= 0; b-- {
+			bits = append(bits, idx&(1<<b) != 0)
+		}
+	}
+	checksumBits := len(words) / 3
+	entropy := make([]byte, (len(bits)-checksumBits)/8)
+	for i := range entropy {
+		for b := 0; b < 8; b++ {
+			if bits[i*8+b] {
+				entropy[i] |= 0x80 >> b
+			}
+		}
+	}
+	hash := sha256.Sum256(entropy)
+	for i := 0; i < checksumBits; i++ {
+		if bits[len(entropy)*8+i] != (hash[i/8]&(0x80>>(i%8)) != 0) {
+			return nil, errWrongChecksum
+		}
+	}
+
+	return pbkdf2.Key([]byte(strings.Join(words, sepStr)), []byte("mnemonic"), bip39SeedIterations, bip39SeedLenBytes, sha512.New), nil
+}
+
+// DeriveEd25519Seed derives the ed25519 key seed at path from a BIP39 seed, following SLIP-0010.
+// All the path indices are hardened, so they must be below 2^31.
+func DeriveEd25519Seed(seed []byte, path []uint32) ([32]byte, error) {
+	var key [32]byte
+	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
+	mac.Write(seed)
+	i := mac.Sum(nil)
+
+	for _, index := range path {
+		if index >= slip10Hardened {
+			return key, fmt.Errorf("derivation index %d is not below 2^31", index)
+		}
+		data := make([]byte, 0, 1+32+4)
+		data = append(data, 0)
+		data = append(data, i[:32]...)
+		data = binary.BigEndian.AppendUint32(data, index+slip10Hardened)
+		mac = hmac.New(sha512.New, i[32:])
+		mac.Write(data)
+		i = mac.Sum(nil)
+	}
+	copy(key[:], i[:32])
+	return key, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBip39MnemonicToSeed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// BIP39 test vector, without passphrase
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+	seed, err := Bip39MnemonicToSeed(mnemonic)
+	require.NoError(t, err)
+	require.Equal(t, "5eb00bbddcf069084889a8ab9155568165f5c453ccb85e70811aaed6f6da5fc1"+
+		"9a5ac40b389cd370d086206dec8aa6c43daea6690f20ad3d8d48b2d2ce9e38e4", hex.EncodeToString(seed))
+
+	// extra whitespace is ignored
+	seed2, err := Bip39MnemonicToSeed("  " + strings.ReplaceAll(mnemonic, " ", "\n ") + "\n")
+	require.NoError(t, err)
+	require.Equal(t, seed, seed2)
+
+	_, err = Bip39MnemonicToSeed(strings.Repeat("abandon ", 12))
+	require.ErrorIs(t, err, errWrongChecksum)
+
+	_, err = Bip39MnemonicToSeed(strings.Repeat("abandon ", 10) + "about")
+	require.ErrorIs(t, err, errWrongBip39MnemonicLen)
+
+	_, err = Bip39MnemonicToSeed(strings.Repeat("abandon ", 11) + "algorand")
+	require.ErrorContains(t, err, "algorand is not in the words list")
+}
+
+func TestDeriveEd25519Seed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// SLIP-0010 ed25519 test vector 1
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+	key, err := DeriveEd25519Seed(seed, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", hex.EncodeToString(key[:]))
+	key, err = DeriveEd25519Seed(seed, []uint32{0})
+	require.NoError(t, err)
+	require.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", hex.EncodeToString(key[:]))
+
+	_, err = DeriveEd25519Seed(seed, []uint32{slip10Hardened})
+	require.ErrorContains(t, err, "not below 2^31")
+}
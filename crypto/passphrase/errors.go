@@ -23,3 +23,4 @@ import (
 var errWrongKeyLen = fmt.Errorf("key length must be %d bytes", keyLenBytes)
 var errWrongMnemonicLen = fmt.Errorf("mnemonic must be %d words", mnemonicLenWords)
 var errWrongChecksum = fmt.Errorf("checksum failed to validate")
+var errWrongBip39MnemonicLen = fmt.Errorf("BIP39 mnemonic must be 12, 15, 18, 21 or 24 words")
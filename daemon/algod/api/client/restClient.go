@@ -752,6 +752,39 @@ func (client RestClient) doGetWithQuery(ctx context.Context, path string, queryA
 	return
 }
 
+// ExportLedgerSnapshot streams a snapshot of the ledger of the node into w.
+func (client RestClient) ExportLedgerSnapshot(ctx context.Context, w io.Writer) error {
+	queryURL := client.serverURL
+	queryURL.Path = "/v2/admin/ledger/snapshot"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", queryURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(authHeader, client.apiToken)
+
+	httpClient := http.Client{}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = extractError(resp)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, resp.Body)
+	if err != nil {
+		return err
+	}
+	// the trailer is only available once the body has been read
+	if exportErr := resp.Trailer.Get(common.LedgerSnapshotErrorTrailer); exportErr != "" {
+		return fmt.Errorf("the node failed to export the ledger snapshot: %s", exportErr)
+	}
+	return nil
+}
+
 // RawDryrun gets the raw DryrunResponse associated with the passed address
 func (client RestClient) RawDryrun(data []byte) (response []byte, err error) {
 	var blob Blob
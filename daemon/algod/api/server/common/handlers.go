@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 )
 
@@ -78,6 +80,7 @@ func HealthCheck(ctx lib.ReqContext, context echo.Context) {
 	// swagger:operation GET /health HealthCheck
 	//---
 	//     Summary: Returns OK if healthy.
+	//     Description: The connectivity of the persistent peers is included when the node maintains them separately.
 	//     Produces:
 	//     - application/json
 	//     Schemes:
@@ -89,7 +92,47 @@ func HealthCheck(ctx lib.ReqContext, context echo.Context) {
 	w := context.Response().Writer
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(nil)
+
+	var response *HealthResponse
+	if reporter, ok := ctx.Node.(persistentPeersReporter); ok {
+		if statuses := reporter.PersistentPeers(); statuses != nil {
+			response = &HealthResponse{PersistentPeers: make([]PersistentPeer, len(statuses))}
+			for i, s := range statuses {
+				response.PersistentPeers[i] = PersistentPeer{
+					Address:        s.Address,
+					Connected:      s.Connected,
+					ConnectedSince: unixOrZero(s.ConnectedSince),
+					LastAttempt:    unixOrZero(s.LastAttempt),
+					Failures:       s.Failures,
+					NextAttempt:    unixOrZero(s.NextAttempt),
+				}
+			}
+		}
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// persistentPeersReporter is implemented by nodes whose network maintains
+// dedicated connections to its persistent peers.
+type persistentPeersReporter interface {
+	PersistentPeers() []network.PersistentPeerStatus
+}
+
+// HealthResponse is the body of GET /health when the node maintains dedicated
+// connections to its persistent peers.
+type HealthResponse struct {
+	PersistentPeers []PersistentPeer `json:"persistent-peers"`
+}
+
+// PersistentPeer describes the connectivity of a persistent peer. Times are in
+// seconds since the epoch, and are omitted when unset.
+type PersistentPeer struct {
+	Address        string `json:"address"`
+	Connected      bool   `json:"connected"`
+	ConnectedSince int64  `json:"connected-since,omitempty"`
+	LastAttempt    int64  `json:"last-attempt,omitempty"`
+	Failures       int    `json:"failures,omitempty"`
+	NextAttempt    int64  `json:"next-attempt,omitempty"`
 }
 
 // Ready is a httpHandler for route GET /ready
@@ -192,3 +235,10 @@ func VersionsHandler(ctx lib.ReqContext, context echo.Context) {
 func optionsHandler(ctx lib.ReqContext, context echo.Context) {
 	context.Response().Writer.WriteHeader(http.StatusOK)
 }
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -17,10 +17,13 @@
 package test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
@@ -28,6 +31,7 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/common"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -86,3 +90,48 @@ func TestReadyEndpoint(t *testing.T) {
 	mockNodeInstance.catchupStatus = StoppedAtUnsupported
 	readyEndpointTestHelper(t, mockNodeInstance, http.StatusInternalServerError)
 }
+
+// persistentPeersNode is a mock node maintaining persistent peers.
+type persistentPeersNode struct {
+	*mockNode
+	statuses []network.PersistentPeerStatus
+}
+
+func (n persistentPeersNode) PersistentPeers() []network.PersistentPeerStatus {
+	return n.statuses
+}
+
+func healthRequest(n lib.NodeInterface) *httptest.ResponseRecorder {
+	reqCtx := lib.ReqContext{
+		Node:     n,
+		Log:      logging.NewLogger(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+	common.HealthCheck(reqCtx, e.NewContext(req, rec))
+	return rec
+}
+
+func TestHealthCheckPersistentPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rec := healthRequest(makeMockNode(CaughtUpAndReady))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "null", strings.TrimSpace(rec.Body.String()))
+
+	connectedSince := time.Unix(1700000000, 0)
+	n := persistentPeersNode{mockNode: makeMockNode(CaughtUpAndReady), statuses: []network.PersistentPeerStatus{
+		{Address: "a:4160", Connected: true, ConnectedSince: connectedSince},
+		{Address: "b:4160", Failures: 3, NextAttempt: connectedSince.Add(time.Minute)},
+	}}
+	rec = healthRequest(n)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response common.HealthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, []common.PersistentPeer{
+		{Address: "a:4160", Connected: true, ConnectedSince: connectedSince.Unix()},
+		{Address: "b:4160", Failures: 3, NextAttempt: connectedSince.Add(time.Minute).Unix()},
+	}, response.PersistentPeers)
+}
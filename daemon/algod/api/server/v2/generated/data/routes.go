@@ -115,225 +115,251 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5MbN5Ig/lUQ3I2QpR/JlmTZM9YvJvbalh+9li2Fuu25XbXOBquSJKaLQA2AYpPW",
-	"9Xe/QOJRqCoUWWRTsn03f0nNwiORSCQS+Xw/ysSqFBy4VqPn70cllXQFGiT+RfNcgsL/5qAyyUrNBB89",
-	"H51zQrNMVFyTspoVLCM3sJ2OxiNmvpZUL0fjEacrGD0Pg4xHEv5ZMQn56LmWFYxHKlvCitpptQZp+r49",
-	"n/z348kX795/9te70Xikt6UZQ2nJ+GI0Hm0mCzFxP86oYpmanrvx7/Z9pWVZsIyaJUxYnl5U3YSwHLhm",
-	"cwayb2HN8Xatb8U4W1Wr0fPHYUmMa1iA7FlTWV7wHDZ9i4o+U6VA967HfBywEj/GSddgBt25ikaDjOps",
-	"WQrGdWIlBL8S+zm5hKj7rkXMhVxR3W4fkR/S3pPxk8d3/xZI8cn4s0/TxEiLhZCU55Mw7ldhXHJp290d",
-	"0NB/bSPgK8HnbFFJUOR2CXoJkuglEAmqFFwBEbN/QKYJU+Q/L1/9SIQkP4BSdAGvaXZDgGcih3xKLuaE",
-	"C01KKdYsh3xMcpjTqtCKaIE9A338swK5rbHr4IoxCdzQwtvRP5Tgo/FopRYlzW5G79poursbjwq2YolV",
-	"/UA3hqIIr1YzkETMzYI8OBJ0JXkfQHbEGJ6dJFkxrj9/1qbD+tcV3XTBu5IVz6iGPAJQS8oVzUwLhDJn",
-	"qizoFlG7opu/PR47wBWhRUFK4DnjC6I3XPUtxcx9soVw2CQQfbUEYr6Qki4gwvOU/KQAKQm/anEDPFAH",
-	"mW3xUylhzUSlQqeedeDUiYVEdCBFxVOMiuAHh+YeHmX7npJBvcER73Z/U2zhPrWhvmSLq20JZM4Kc1+S",
-	"f1RKBwKuFG77EogqITO8NydmGIN8xRac6krC82v+yPxFJuRSU55TmZtfVvanH6pCs0u2MD8V9qeXYsGy",
-	"S7bo2YEAa+qcKuy2sv+Y8dJHVW+Sd8lLIW6qMl5QFp8FQysXL/oow47ZTxppBnke5AbcHzfW1ebiRR9L",
-	"3d1Db8JG9gDZi7uSmoY3sJVgoKXZHP/ZzJG06Fz+NrLihemty3kKtYb8HbtGgercyk/ntRDxxn02XzPB",
-	"NdirMBIzzpDZPn8fS05SlCA1s4PSspwUIqPFRGmqcaR/lzAfPR/921kt6J3Z7uosmvyl6XWJncxlLMEw",
-	"vgktywPGeG2ERxS1eg664UP2qM+FJLdLli2JXjJFGLebiHKX4TQFrCnX09FBJ/ku5g5vHRD1VthL0m5F",
-	"iwH17gWxDWegkPad0PtANSRFxDhBjBPKc7IoxCz88Ml5WdbIxe/nZWlRNSZsToDhfQ4bprR6iJih9SGL",
-	"57l4MSXfxmPfsqIgghdbMgN370BuxrR82/FxJ4AbxOIa6hEfKII7LeTU7JpHg5HLTkGMKFUuRWGuwL1k",
-	"ZBp/59rGFGh+H9T5T099Mdr76Q4leodUpCb7S/1wI5+0iKpLU9jDUNN5u+9xFGVG2UFL6qJG8KnpCn9h",
-	"GlZqL5FEEEWE5raHSkm3XoKaoCTUpaCfFFjiKemCcYR2bARyTlb0xu6HQLwbQgAVJG1LZla8umV6WYtc",
-	"AfXTzvviz03IqT0nZsMpM7IxKZjSRhjCzVRkCQUKnDQoFmIqOopoBtDCjkUEmG8lLS2Zuy9WjmOc0PD+",
-	"srDe8yYfeMkmYY7VFjXeEaqjmflehpuExCocmjB8WYjs5juqlic4/DM/VvdY4DRkCTQHSZZULRNnqkXb",
-	"9WhD6Ns0RJols2iqaVjiS7FQJ1hiIQ7hamX5FS0KM3WXm7VWiwMPOshFQUxjAiumzQOYcTwBC7YGblnP",
-	"lHxNs6URJkhGi2Jc6yVEOSlgDQURkjDOQY6JXlJdH34c2T+U8BwpMHxQA4lW43QaU3K1BAlzIfGhKoGs",
-	"KF5OK/M8Kotmn8BcFV1BS3bCy1JU2sAYvVwuXvjVwRo48qQwNIIf1ogP/njwqZnbfcKZubCLoxJQ0cJ4",
-	"VlR5jb/ALxpAm9b1VcvrKYTMUdFDtfmNSZIJaYewl7+b3PwHqKw7W+r8pJQwcUNIugapaGFW11rUw0C+",
-	"pzqde05mTjWNTqajwvSLznIO7IdCIciEduMV/ocWxHw2Ao6hpJp6GMopKNOE/cA726DKzmQaGL6lBVlZ",
-	"vRkpaXZzEJRf1ZOn2cygk/e1VdW5LXSLCDt0tWG5OtU24WB9e9U8IVbn49lRR0zZyXSiuYYg4EqUxLKP",
-	"FgiWU+BoFiFic/Jr7UuxScH0pdh0rjSxgZPshBlnMLP/UmxeOMiE3I95HHsI0s0COV2BwtutYQYxs9Sq",
-	"6vOZkMdJEx3TRK2AJ9SMGglT4xaSsGlVTtzZTKjHbYPWQCSol3YLAe3hUxhrYOFS0w+ABWVGPQUWmgOd",
-	"GgtiVbICTkD6y6QQN6MKPn1KLr87/+zJ01+efva5IclSioWkKzLbalDkE6fnI0pvC3iYfDihdJEe/fNn",
-	"3iDSHDc1jhKVzGBFy+5Q1tBiH8a2GTHtulhrohlXHQAcxBHBXG0W7eSN7Xc3Hr2AWbW4BK3NI/i1FPOT",
-	"c8PODCnosNHrUhrBQjWNUk5aOstNkzPYaEnPSmwJPLemN7MOpswbcDU7CVH1bXxez5ITh9Ec9h6KQ7ep",
-	"nmYbb5XcyuoUmg+QUsjkFVxKoUUmiomR85hI6C5euxbEtfDbVbZ/t9CSW6qImRsNYBXPe1QUesOH3192",
-	"6KsNr3Gz8waz602szs07ZF+ayK9fISXIid5wgtTZ0JzMpVgRSnLsiLLGt6Ct/MVWcKnpqnw1n59GRypw",
-	"oISKh61AmZmIbWGkHwWZ4Lnaq83x1sAWMt1UQ3DWxpa3Zel+qByaLrc8QzXSKc5yv/bLmfqI2vIsUoUZ",
-	"GAvIFw1a/aAqrz5MWSgeqASkBlMv8TNaBF5Aoek3Ql7V4u63UlTlydl5e86hy6FuMc7mkJu+XqPM+KKA",
-	"hqS+MLBPU2v8XRb0VVA62DUg9EisL9liqaP35WspPsAdmpwlBSh+sMqlwvTpqph+FLlhPrpSJxA968Fq",
-	"jmjoNuaDdCYqTSjhIgfc/EqlhdIerx1zULNKSuA6lnNRn8EUmYGhroxWZrVVSbRI3S91xwnN7AmdIGpU",
-	"j5tDcNWwrex0S7oGQgsJNN+SGQAnYmYWXXs54CKpIqWRnZ1Y50Tiofy2AWwpRQZKQT5x+uy98Pp29v7R",
-	"O5CHq8FVhFmIEmRO5YdZwc16L/A3sJ2saVEZ8fz7n9XDP8oitNC02LMF2Ca1EW31XXcp94BpFxG3IYpJ",
-	"2WoL7UkwIrZhOgVo6EP2/bHXu/1tMDtE8IEQuAaJHjUf9Gj5ST4AUQb4P/DB+iBLqMqJEQN71Q9GcjX7",
-	"zSkXXjbcM0OYoKBKT/ZdKaZRQ29ilhpx8dQtggP3yJMvqdIoBhLGc9Tf2qsQ57GypZlidKBTGU7Z+xoz",
-	"k/7sH2LdaTNzvXNVqfAqU1VZCqkhTy0Pbda9c/0ImzCXmEdjh6efFqRSsG/kPgRG4zs8OkUA/kF1sFA7",
-	"m3d3ceh1YMSX7aFYbsBX42gXjJe+VYT42Km2B0am6j2w5MZUi95mQhRAUWWqtChLw6H0pOKhXx8GL23r",
-	"c/1T3bZLktYMZCWVXIBCE5Nr7yC/tUhXaOtaUkUcHN4/ARVe1kWuC7M51hPFeAaTXecFH8GmVXxwjjru",
-	"VbmQNIdJDgXdJrwt7GdiPx9IGH5sJJBafyA0TGZoTUzTSH0mvL/pcbMKnEqlBG+CX0hmzrl5RtWk5nof",
-	"P2kOOG2KbzpifRBmQTCSdODHQ2RZekqMiHf/WmhDVo7ocDXuVrrnWnqwF2b9IAjEcSe1IqA9+3+BcnMH",
-	"Aeyk829B9S28nvpUy+5R/+Pd3rgwW1dZ67ZJXhG9fHkPY+zjQT22iNdUapaxEp+r38P25K/39gRJXwmS",
-	"g6asgJxEH+xLvoz7E+uG3B7zuNf8IHVrF/yOvjWxHO+Z1QT+BraoNnltIxoibdUp1BGJUc2FSzlBQL3X",
-	"vHnxxE1gQzNdbI1gq5ewJbcggahqZr1WuiY0LcpJPEA6Zqp/RmeQT5rDd3oIXOJQ0fJSnof2tbUbvqvW",
-	"k6uBDvfKKoUoEvrP9onvICMJwSB3IVIKs+uMFsWW6BA24ympAaS7INAbI8gzD1QDzbgC8l+iIhnl+MKt",
-	"NAQhTUiUfFBYNjMYcTPM6VxVawxBASuwr3n88uhRe+GPHrk9Z4rM4da63HBs2EbHo0eoinstlG4crhNo",
-	"u81xu0hcOmirNJese7W1ecp+Jzc38pCdfN0aPBg4zZlSyhGuWf69GUDrZG6GrD2mkWEOfjjuIPNd0yWs",
-	"s27c90u2qgqqT2GohDUtJmINUrIc9nJyNzET/Os1LV6FbnfjEWwgMzSawSTDKMGBY8GV6WMDC804jDNz",
-	"gG3gyFCA4ML2urSd9ry0a79ltlpBzqiGYktKCRnYKDkjpaqw1CmxIRPZkvIFvoCkqBbO1dmOgwy/UlYT",
-	"JiveGeJQUUxv+ARNGCoZpoZmSx9taYQwoOZl27Z/2MfaLQ2g2Mto0KUdbU/bHpQ0mY5HvQ9/g+91/fC3",
-	"eGuGjB5rTGzIhxHSamgGWs8Qn0ZW6iIx3kZz+AwxfBgrTT10CsruxJFTeP2xzy/8sirLYnsCIckORCSU",
-	"EhReabEaUNmvYk5+YJkU58VChDtPbZWGVdd4Y7v+0nNc3xzzAha8YBwmK8Eh8aR/hV9/wI+D1Y72Gu4Z",
-	"EQWigwZsP3waSGgtoDn5EJK+7yYhybTPftvSqb4R8lRWdjvg4DfFAMv1XrcON+Wx9nVaFAmTtFU/dLiI",
-	"GgencCYJVUpkDAXFi1yNnfe5tWJbt/YW+l+H0KgTHOD2uC3baxSGZRX5UJSEkqxgqOYXXGlZZfqaU9T0",
-	"RUtNOAt65UC/Wvgr3ySth06oid1Q15yio2jQ/yUdg+aQ0EN9A+C1w6paLEDp1gNrDnDNXSvGScWZxrlW",
-	"5rhM7HkpQaLH3tS2XNEtmRua0IL8BlKQWaWbT45VpTRRmhWFMwSbaYiYX3OqSQFUafID41cbHM77kfgj",
-	"y0HfCnkTsDAdzrgWwEExNUl7On5rv2JQicPJ0gWYYKyF/ew9nuvcECOz9kbSiv/1yX88f3s++W86+e3x",
-	"5Iv/7+zd+2d3Dx91fnx697e//e/mT5/e/e3hf/x7avs87KlgcAf5xQv3Rr94gQ+xKE6kDfsfwSCzYnyS",
-	"JMrYoahFi+QTzJfhCO5hU++nl3DN9YYbwlvTguWGF52MfNrXVOdA2yPWorLGxrXUeB4BBz6H7sGqSIJT",
-	"tfjrB5Hn2hPsdLiJt7wVY+A4ozo5gG7gFFztOVNutQ++/fqKnDlCUA+QWNzQUWqBxAvGRTA2vHzMLsWB",
-	"Xdf8mr+AOb4HBX9+zXOq6Zk9TWeVAvklLSjPYLoQ5LkPinxBNb3mnWuoN4FUFNQcZZBKcQq6Sq/l+vot",
-	"LRbi+vpdxw+hK1u5qWIu6s5ZV03mp5wYuUFUeuKSuEwk3FKZsoX4FB8uGhp774TDyiSiskosnyTGjT8d",
-	"CmVZqnayhy6KyrIwKIpIVbl8BWZbidIiBI4ZZu5ibw0N/CicU4mkt/7JWylQ5NcVLd8yrt+RyXX1+PGn",
-	"GIJXpzj41fFAQ7fbEgY/fHuTUbTfu7hwK5ejU/mkpIuUzeT6+q0GWiKFoMCxwpdmURDs1ggP9JEAOFS9",
-	"gBCLfMCWWMgOjuvF5V7aXj6tV3pR+Ak3tRk7fa8djKLij97APZH1tNLLieEIyVUpcwz8XvkEA3Rhrhzv",
-	"QaDYAh8Aaikqs2Qg2RKyG5fZClal3o4b3b2ji7uLPcNhCnVGLjhwzgz+MsrNgFWZUyfIUL5tp7hRNhgC",
-	"B30DN7C9Erb7dGB2sCgbXZRiRfUdXaTd6K415BsfZDdGe/Od35WPEXXpSDDu0pPF80AXvk//0bYCwAmO",
-	"dYooGnk++hBBZQIRlvh7UHDEQs149yL91PIYz4BrtoYJFGzBZkWCTf+9a9fwsBqqlJABW/uo3jCgImxO",
-	"zOtoZq9j92KSlC/AXOrmIhaKFui0P00a+lE6XAKVegZU79TX8jjNhIcOBfJbDJpGpcnYLAE2Zr+ZRiUI",
-	"h1vzwMO3t23jHImnR7lT2TVBfiSovnsdJD095hHhEJ7IZ+fv+7An4b3g/NNi6kSQ7feVweFCiluzmwZA",
-	"4VM3YoKX6J6qFF3A0OuoYSoamBKjYQHCQfZJP0l5R8zbYk1Hxhi4CNt9YvCS5A5gvhj2gGaAloujn9ua",
-	"EJ1V4RUvth6pswIF6uAgakmHyoadjS8OAzbNxkDyWlj1gDWxFh/9JVX+6OfjiKMfKS3+PqlkduXPu4i8",
-	"76juZsfz13SbtY+tPmcGRHDTw2fR86nzfL680fig3HfjkQtxSO2d4ChF51DAwuLENvZ0VudnqnfTwPFq",
-	"PkemN0k58kXKyEgycXOAeYg9IsRqzMngEVKnIAIbLes4MPlRxIedLw4Bkrv8UtSPjXdX9DekgwWtN76R",
-	"kkVpbn3WY7XKPEtx6S1qkafl4ozDEMbHxHDSNS0MJ3WBp/UgnVxt+PZpZWZzvh0P+95EAw+aWyNKJwet",
-	"0sozx6wvFrz9MtKvgoPWMBObiY2MTj6tZpuZORPJeAWM004dXps574EiM7FBnyK84ayD+8HQ9UPmAYvc",
-	"QDZMIZVjvz6x0YJ3GCC7BfkUNSskPadXC2TXJ8keB0yPON1Hdp9EKfROBFJLgVmnAXcanb16lqa01ZVE",
-	"6ut2HLLDhjC1FKvpO5zJnezBaFd52sx1912d7rA/OZo/qx8lyV9XKXefvIy2c2lzLR6SlrFNDg0gdmD1",
-	"dVuITaK16bjUxGuEtRRLMoy+a+zqok1BAagJmDTk6slNyix9ff1WAcoMl75bpOfE3aN8+zDyhpOwYEpD",
-	"bVzwTi4f3/aD6kTz2BLz/tXpUs7N+t4IEQQNa47Fjo1lfvQVoOv6nEmlJ2iZSS7BNPpGoSbtG9M0LQg3",
-	"/e2Ysqaeg+VghOgGtpOcFVWalB1I378wEP0Ybi5VzfCiZNx6G80wFX7SQfcA2yTCYx27dyLopUXQS/ox",
-	"8DPsYJmmBiZpKK85/Z/kiLV44S7OkqDlFDF1N7QXpTt4bRRL32W0kRAduV1Md9l8Oucy92Pv9cbyEf19",
-	"QoQdKbmWKCNiOoBQLBaQ+0xvLijUZr1y+fQKwRd1LkHz+470gVNis/hhEr4d+fucezr0Oac3yolgVYwk",
-	"9PFjBiGvo+sw9yBOsgBuM7eMDq83UiQRFzvGY4tIM/pxeXvHbT7pOnzVcheufXrtHobNxu0pgObuWaXA",
-	"r2/3oe1ul0PduM/puJEidvcBwwGR4phWkQDTIZoezk3LkuWbluHPjjo9giQGinvdTPAtnCFbcoPtwU/T",
-	"sXhPrZ4H5nbE9s7YcYbP/DPzyLT+zM4j15wNmrlsA3kl0ZrU8Bbu5tMPD82Ba//+50stJF2AswhOLEj3",
-	"GgKXcwgaopT0imhmHaRzNp9DbAlTx1hxGsB17B35AMLuIcGuuSy8LXfSZ5fI9tBWvYL9CE3TU4JS+nwu",
-	"rrr2SP/wiHRr4bKJNu4Io2IyocD3sJ38TIvKvISYVLVvqjMQNq/1A2hivfoetjjyXpdPA9ieXUFV3BtA",
-	"Ck1ZV8InFWUJf6Aa1RfwDdzYwgN26jy9SyfaGldKo/9o1DdUo55Ecykf7tjULjIG0iF7dZn2OjFnC5rb",
-	"0ib0fVvE8v2yT/QEiadi6L1xzCUXMm3s9S4DWnjCx8WO7saj+/l7pO5JN+KenXgdrubkLqA3prX/N5y+",
-	"DtwQWpZSrGkxcX4yfUKHFGsndGBz71bzkd9X6VNx9fX5y9cO/LvxKCuAyklQdfSuCtuVf5pV2RIcu68h",
-	"m47d6XatKiza/JAyO/akucXU6y1tWqfWTe03FR1U51kzT3uK7+WbzsXLLnGHqxeUwdOrtkhbR6+mcxdd",
-	"U1Z4w6+HdqiW3S53WHWlJJ+IB7i3k1jk/XfvsXrjBK6v3649Zmt7inWUCinxE7506khP5w6vSZ/Vmtb3",
-	"cEhc5yvMZJp+d3GX5xQZo3M4oyeXA78RsnFRuajGpMPahxMQzWPC4jFtlL9yVviOWDglVoT8dfGr4Q2P",
-	"HsUH/9GjMfm1cB8iAPH3mfsd31GPHiUNw0lVn2FZqMnjdAUPQ1xE70Z8XDUEh9th4sL5ehVkZNFPhoFC",
-	"reeZR/etw96tZA6fufslhwLMT9Mhqop40y26Y2CGnKDLvqjE4Py8suU8FRG8HYOPUbKGtPDqcRU8rJ29",
-	"e4R4tUK780QVLEs7/fCZMiyJW5de05hg48E2ZDNHxXr8ynnFotFNM3WUybO1kGjWJMJVMhNwjd+ZcCyg",
-	"4uyfFURlffEmbl3O/imEo3YE7LR+0Q3crho8Oqbg7/1NhF6rtkthtNPk+iKYAT0iUnWmDox3iGfsMP8d",
-	"sQqOovz1iYFtS+c6vJeydr7zdheBdmZgzz6dxbX/geTKYdrNfDFkp5mazKX4DdKyAxoJE6k7vHWboQL+",
-	"N+ApH9U2IwueA3XB6nr2fQQyXLfQRyr31iX4RYeqecdc4Wk+cdhGH6g0iPa7X22g0unF3Sb0PVRjx5Nm",
-	"IE0PM8MDG7mFYy0f7+5GuT2hNq9FI/Isfc7jQNEzO359zh3MneDagt7OaKrQkXkvGpii7W845mlBfGe/",
-	"QSqkZrCzkyiWIbRlNtlfCbK2HnVTJR/59rPTDn711Y88pLj4eTe2viqFEolhKn5LOfoRYj/LAV1vBdYP",
-	"w/S6FRITfKq0D2EOGVslleHX12/zrOv5lbMFsyXFKwWEzrXL8+gGskXlLRW5at4hF4lDzcWcPB7XZ9bv",
-	"Rs7WTLFZAdjiiW0xowov6OATEbqY5QHXS4XNnw5ovqx4LiHXS2URqwQJ73MUPYMn7Az0LQAnj7Hdky/I",
-	"J+gwrNgaHqYvGCesjZ4/+WK8q3I2YhyLxO9i8jlyeR/IkKZs9Kq2Yxi26kZNRybMJcBv0H+f7DhftuuQ",
-	"04Ut3RW0/3StKKcGISmYVntgsn1xf9GVo4UXbq0zoLQUW8J0en7Q1HCsnmhywxAtGCQTqxXTK+cpqsTK",
-	"UFhdhtxO6ofD+nq+DJqHy39EF+wy8cb/HZ5bdNUT4Yhe9T+ivT1G65hQm7G1YHX8ha9QSy58ZmqsCxfK",
-	"wVncmLnM0lFexXCMOSkl4xq1RpWeT/5qnu+SZoYhTvvAncw+f5aor9YsQcQPA/yj412CArlOo172kL2X",
-	"clxf8gkXfLIyHCV/WKd0iE5lr6942r+3z+24Z+h7S9dm3EkvAVYNAqQRN78XKfIdA96TOMN6DqLQg1f2",
-	"0Wm1kmmCoZXZoZ/evHSSyErIVKWLmgE4qUSClgzWGF+a3iQz5j33QhaDduE+0P++3m1eLI1EN3+6k4+F",
-	"yKqceKeFtEpG0v/5hzo/Phq3bdxuS3spZEJP6zSOH9kt9TB9YduGbt0B8VsP5gajDUfpYqUn3MPGc4Q+",
-	"v4e/Vxsku+cNVemTX4k073iU9R89QqAfPRo7UfnXp83Plr0/ejTcZTatLzS/JlBz3F3Tzl5p+qa2+kuR",
-	"0N75Kp7Bb8ylKkloWJN3mblSZ26MMWmWSvz4csdp4hUPdkNOHyCPGvzcxs3vzF9xM+sImH7+0KwemySf",
-	"PHyPYigo+VJshhJR69ry9PQHQFEPSgZqBXElneq4SU+JvW4+EdmaUWdQCPNSjQtgDfZa+RPtgkHNeMde",
-	"VKzIf66t0K2bSVKeLZNO5TPT8Rf7DIgaRBqMbEk5hyLZ276Wf/Gv6sS7/x+iZ9gV4+lP7ULMFvYWpDVY",
-	"TSD8lH58gyumCzNBjKJmQq6Q4qRYiJzgPHXlkpo1diuapyrJJmL8cdhVpZ1XMiZPcAVF5qxAN9q0PRxb",
-	"TiTVPVwVy/77EldmHKzCr6xawo4OklC2wmtb0VVZAB7CNUi6wK6CQ6s7ZmzDkaOyJESV5hO2xOQvguhK",
-	"ciLm82gZwDWTUGzHpKRK2UEem2XBBucePX/y+PHjYUZGxNeAtVu8+oW/qhf35Ayb2C+u8pctmHAQ+MdA",
-	"f1dT3SGb3yUuV371nxUonWKx+MEGZKOF2NzrtvRqKBM8Jd9ifjJD6I0SAagU9RmWmzlBq7IQNB9jUuir",
-	"r89fEjur7SMBUYelXxeoAWwekaSRZ3iOVJ9/rSd31fBxdqfOMatWehKKsqYyKZoWdS1Z1vJ+Qt1gjJ0p",
-	"eWHVssGxx05CMLW4XEEe1YC1agAkDvMfrWm2RH3ndLRTpdxTDWh4CWPPAWtzURT3GgpmIQc3y3BVjG0R",
-	"4zERegnylinAvBOwhmbCxpDt1CnkfQLH5mplxbklnOkB0msoj3XoLnjgrOjr/SuSkLX24d62vzqTBxY5",
-	"P7TY8yX2SsfttCpHt/webMmMjS+6MSU/OGNHRrngLMNiEykRHFMxDjOrDqjLkbZ3qpE7y4ljmKxXHQLU",
-	"HRZ7K1h7lukQ13VqiL6a/baEY//UsHFFABegleOBkI99+XhnoGNcgSuAZugr5qhCJly/kmExwYXkhC7p",
-	"4xFmU+vRtX5jvv3odPOYM+aGcdS5OaS6l6A1sBWKoZ2dE6bJQoByq23Gham3ps/0asMRhHfTl2LBsku2",
-	"wDGsK6JBivUC7g517n2CnQ+uafuVaetqF4SfGy51dlK/7ndJFqLC/qdqrveiP+X75R1pIuSG8ePRdhDj",
-	"Tld/vJcNGcIaPf+gxPu8QzahfH1zlK/Nk9XSG7YgNnI3mTaY8QQYLxn3Bt90HqwseZfgxuBp7umnMkm1",
-	"fXQM4nhXQIuecBgMqrceA/cdql2JwaAE1+jn6N/GuvJ+D1sJDerXBeVb4g+Foe5IKPmKFsEZPlFHH6Uz",
-	"J4xZZ+FWZf0UWzFsfeJDcxvo2hsIGrpjNZRD76m+bKOzKl+AntA8T+Wd+xK/EvzqAwphA1kVioCFONNm",
-	"uvYutbmJMsFVtdoxl29wz+lypqhSsJoVCdfbF+Ej5GGHMRHVbIv/pipg9e+Mc3o/OPrbe7jnh9Uo6Eaz",
-	"p6RnQ9MTxRaT4ZjAO+X+6KinPo7Q6/4npXQf+P2HiOtucbl4j1L87WtzccRpujs+/vZqCVm00Z9e4Hef",
-	"Dyxkcm1yJbzKOnXe0CMDNy+xZS3gfcMk4Gta9GRciK029n61loy+vAtZb1oRql32Ok1JzROGqDD6839Z",
-	"D+yWZahr3uzzsbYu1h/SeOLwsRPp/ZbG7xt2Rev1VjOUXnvicSa/mggOtfm5UgxdfSktCpEN5gxumHPT",
-	"qT9Vr1itXOb7hFfeeiXy+CzE3lwAacZmHZYToRX4sE1+w6dV8ou8TY/W0I8EohmatQzR6JYwtoGZHjwP",
-	"jJ06nihS2TrMkm9YgcWh/vPy1Y+j/o2MdqC7pS51dlKF3bcxIVKtTR4L0cDHDh4geJHWf6selTrmhkqf",
-	"BledOPnhG6sgHAKSzZN0SOuXQwfvEMBC2KpQqboZ3ew0o3o7PPIjaqi313KUmDpSVNGutpR4+1ilZ92E",
-	"hEKkgwqTNmSkIcWdUnWE3EvBa2DtRePy0dniSp26TB0G+mKIcNjBx914dJEfJD6lalGN7CgpBvuSLZb6",
-	"y0JkN98BzUHaeiKp56StJrIC8wxVS1bi+6cUitX1gAszmEvkvcThpkNDc66W4LLC+CQBnbG8A/UaMo31",
-	"oWs3UAkw3M+hTC/RQOANitjkd3AFkQA5lHq5U1iyzt2lXtZlQ8FFnjFFZuBMF2vgY8KmMG0Hq+V1UihS",
-	"AJ17JawUQg+oqxvClhCNMdAp+urUaN4tBnZyvkUpDW0p3enwIiznISbABlreUlVnjmqlURgcrj2fQ4YJ",
-	"73em3/v7EniUj23sVXcIyzzKxsdCuCCWbDipRruGdVcivJ2gRjWpPiSkfQkxbmD7QJEGDSUrAocI22My",
-	"wCNyrB3XFxXoM204x0imAj0hgrwfvEvAX9dYOqYIQJSd8kgwPI2b66nOWHkcNF6iOQIM03V6r6L9dTo8",
-	"FEz7svt1q6v3v5RfYDF75ZxKaUg3H+uTyEW3HPOtS1ePiRaDtdAnrgflf/MJWu0sBbtxFWoQYdY2e0tl",
-	"7lucJE2evTdZGuh5mJnVgVFdL59D/XJshGJWCCMATfoCQ5uRSsGF94GyvtZ10jKEeg5SQh5sgoVQMNHC",
-	"h1kdkPzThU/uwJ71Mj8Kby2P/gNChu2KemsovKkLSWA5SIo1E6hzPo+xQiSsqIFeRsUd0mrQfTv0lf3u",
-	"c4r48n671at9eA/nYn+FbB96x1QH8/HpmhMnHBzMvRqJSI7QzDLOQU68Ebdd2oE302RiXuW8yqyoEp/N",
-	"oL0enHZsBzdLKjWz7ipbT6goK8cNbM+s2sdXHfc7HgNtZUgLepRQukUUJ9VVqxTci5OA9/um7yyFKCY9",
-	"lsGLbj2K9mG4YdkNYGLWEJlipOAHzWNjJiGfoEEq+IzcLre+2kJZAof84ZSQc26jA737SLMCaWty/kDv",
-	"mn+Ds+aVrTDjNNDTa54Os8JKL/Ke3M8Ps4Pn9fEmBYZf3nN+O8gRs+sN7/ORu8WSMM06wdOh6o2uf0dL",
-	"hIrIz0KREqAurSH4K2QJiXcUwewsURoh9A+gxBmQiSpEygv/mAwyZqg0puLJECANfMBztYbCDZ5EgHOy",
-	"25OV1X32eUfFnEiofTOOTcDqcppaJq76VCPtmcMsTc44FxLiGdHP1CZqDpFtmOcY/zNjWlK5PSZNahNV",
-	"KTVUL5b3eksGR8l6IbWzZBeHRSFuJ8jWJqG6UkodYNqp5rXt65TW/cxRn0HkdkmVExG3ZElzkgkpIYt7",
-	"pEO8LVQrIWFSCPTCTDl2zLV5JKwwrpOTQiyIKDORgy2ElqagvrkqzinKXhC5siVRYGkHUwbYPhEdD5zS",
-	"3L7WPDtBeW1voQ2/+Vemj01fUae/s4ueWBeBnvgCUC7dncOQbdyFFwnHZmRqK2XTIvKcbZBuQKaO/Jxo",
-	"WcGYuBbtKvzu4FMJZMWUsqAEWrplRYHZI9gmcmgI/kBp1PbIzhfoB71m6PDWzCRiRerS3I4h/UrMAy7j",
-	"jGxEL6WoFsuoPkCA0z/dZeUe9vEoP6kKfRIxRNRM8YyshNLuWWxHqpdcu4B+kgmupSiKpiLPyvkLZ/T9",
-	"gW7Os0y/FOJmRrObh/gI50KHleZjn1Kh7btbzyRbORiHvRT0hk+QPNT+NOu2HXq1OnoezDtb3K9jeNin",
-	"yY/AfLefue63a5x3F9ZeV5PPpt9C55xQLVYsSx+3P5f3a6/Paop7JTMt2irENgsNNkM+EN9jwZ0JuWcX",
-	"zcBpsozqOXE8wrl1ICcy/0Uxvj0umYPjQT13aJfvOAFrkvWKgS0AEFKbCEFX0pYujoW0wHDEwiZOQaeU",
-	"NqADLxz0/bsfbGaEkwOl4V5AdbyRA4CfWA3G2GbEtJ7NM7Hx3x/WKTOPAv5uN5U3mEefU+VlTVrSulX6",
-	"RFY9HCFdgGCnB+IVJsGYDfVDDKXoB17+EQD9nokNGAb5Jx4KxpyyAvJJqkrxRdCBjaPnuouxjEb39Rwt",
-	"J89o5SsBm7ErCS6xkpX+ZdOcWFJDSiI072rEeQ4bsDFav4EUto7vODJnQWHL/LY0CqKcFLCGhsOmy/ZU",
-	"oRTK1uD7qtCZ5AAlWnzbiraUJ2JcJbClfXFrn0S+bEOwm1THWMTanSJ7dC1JzdCGT+wxUUOPkoFozfKK",
-	"NvCnDhU5mrpEc5QTqOo8Hyb+iTl0mp/sCG/8AOe+f0qU8Zh4N4wPHcyC0qjbxYD2eiZXqu/U87RjcpzK",
-	"LBiKcLY82LUtidd8Q5X0lvdrNbskX7/EBu4TEzxC7NcbyFCqcU8hyN1jqMdy4nIgIbVzgNw+GEyXhDZ/",
-	"CZxwEdU8vqUqvGLqrK7+BzsxNmLcPbSPsNHX/sP331mCgxHVSraYLlEayPp+Ov7f5STuPIi946VoRIEL",
-	"5d2hGvPU7Z4d2EBURU642U8j+2ONYHeLOS4+JrPKD1QU4tYWMY6fqC/A23Mt9XkTkxPLWbiWvZ/02CUc",
-	"bmtBWBQhsqJbIiT+Yx6k/6xoweZb5DMWfN+NqCU1JOQMyNaLwvldm4l3i1djD5hXxAg/lV03GzpmNNzW",
-	"jBIBbS5yX7ZNkBW9gXgb0EHE8s9MG8apqhkqNcyV3drOLhbc4n16phXNYyUAJprdNriDT3huev//ddhq",
-	"PJXP/1gWNPMlq13xuSafwar2nrj0Ela7w5y7fM2TQKiUXxOt9Gky8iO0qQeyrlTMT19xrAbYnRLgnbpg",
-	"91rGQKVwq8bRjgDxQUs59S6cJoazs6S41O++xcWVjz/O7iQzRPctYwj4f6BdabhXdCLb0hXU4/XYYukf",
-	"YRcaiXgSsFo1+ExsJhLmap8jjdWDz8SmBlgF3S3jmQSqrN/RxSv3bK0TIDNuntHWazeYVcMoOcwZr1kt",
-	"42WlE68gzIPMtxHCYmsCorXHNtcnYxhRdE2LV2uQkuV9G2dOjy0NHBfp8RYU1zehAAk3cncApuoXIMZT",
-	"1/r5uJm5/m2BQes7qzTlOZV53JxxkoE0UgO5pVt1vKkqWB32GatoJAs1s4VEZiskbQtIsXXW5nsakgKA",
-	"9IQWpQGWIHTSTliBrGJIix7DTxeGP4UlaEU3k0IsMOq350C4PNdoOrQPSMFRiW6lu2Hr9vMo9hvsngZL",
-	"kThGpAXOOmSK3ef+FW4lPkJ/4kzvPPlWw9kOw7aezvZgeqTyRR2eYYmlex5TkfMuMVMcPe9FVZ+mxNMe",
-	"RJuYdInuaNV7dhH9K1zahViFPrxYZdOFIxWfb/UKE9Q3qB0BGKDquAKaOQ+xriKuo6iwSBm77AYH6ums",
-	"dt/fSz3goSJFubPenDY46JhxDqnwuTufwaQU5SQb4ttqqxXlzsjgIG3C2EMfkQmhZ93B70aF+l2NnGiN",
-	"Ql6HFjntLSS2z1ZWZrtUBn1Kph6O3jRgiDnyMjzCVrWGsVZBFTP2j3Nv7G4q0QKTIJRIyCqJSuZbut1f",
-	"+LEn+/zld+efPXn6y9PPPiemAcnZAlRd06BVOLF2TWS8rTX6uM6IneXp9Cb4bCEWcd566cPewqa4s2a5",
-	"raqTEXfKRh6inU5cAKng3G6JvKP2CsepwyL+WNuVWuTJdyyFgg+/Z1IURbqmTJCrEuaX1G5FBhjzAilB",
-	"Kqa0YYRN+ynTtVO2WqJyEbOGr21uKMEz8NpnRwVM9/hypRbS59OL/AxzMTibE4FNWTheZe1Eu9bl3mlW",
-	"v4dCI7rbzICUonSiPZuTFEQYsyUrCHp1pzZFfXrkphuYrXXYTRGic35Pk945dy9hMSe7uX2zFLdOc3qz",
-	"iQnxwh/KI0izz7rRn2fkGE5SGwb+MPwjkTjlZFwjLPdD8Irk+2BHVPh5x2siJA0ZBFo3QUaCPBCAnnjo",
-	"RtBqFGQX5SaX1saA1ghvfm6LHz/UZum9kSkIie+wB7w4lrluF4IpHDi/c2LvHwJSoqW866OExvL3hUd7",
-	"1hsukmiLnNJEa1CWLYmuWBgFxKuvQpx5z6ukE44uhdDEvEyLIhHGbvU4eKZiwjFPArmmxcfnGt8wqfQ5",
-	"4gPyN/2BW3HYcoxki0p18oScL+kgsKIQ5Y8CFX+NsfV/B7OzydvRzeIM/507EFVCtLDe3vNgAQdObnFM",
-	"69j15HMyc+V+SgkZU22Hglsv0oR4W5Bs7vxrYaPbsb/3LhP0s9D3OA5z7w9EfoyMbMFzwMFcH/XfmTn1",
-	"cIDkaUmRaodQEvhL8bq4qPqea+eepWGOS+UUJW48MJVTt1z80OXhOvDyqhR01zn41m/gNnHh12sbmqts",
-	"cIWZ6+u3ejYkoVi6GozpjjnOTlIW5v5FYT5KgjOLSjeGgyRJWLXIvS97TctfMsrT0NxFI+73FJBfWvSb",
-	"0fBRMK+4HS8UQMVYcc/WxXwcvBgEN92ek2v+iKgl9W8L9+fTzz4fjUfAq5VZfP19NB65r+9SL7V8k4wr",
-	"rRPpdHxEXTWBB4qUdDu0hlx/3pwkcus0QR9fnlGazdIPuu/MhuGr1UUfXHDk88hb7PXpkuf8v5v95+AM",
-	"YuGsWGKsEwOFfdiXI+jnvoT4Nul7T52PFt+tWLHXPa5RguVuPFrY9GRYl+QXV6Xu4+65h6AnU6Bb+n0S",
-	"gFnEJNbamDyaKkrnNqAUi+uWqI2BMddZJZneXhr8e4U7++UmlQbq25CYyWX7CrZ3J/VqcQPce5fVaZwq",
-	"5eXqbwUtUO60LgHcSJuimJKvbW0QdyH+7cHsL/DpX5/ljz998pfZXx9/9jiDZ5998fgx/eIZffLFp0/g",
-	"6V8/e/YYnsw//2L2NH/67Ons2dNnn3/2RfbpsyezZ59/8ZcHhtINyBZQX/Pn+eh/Ts6LhZicv76YXBlg",
-	"a5zQkn0PZm9QtzbH1ISI1AwvV1hRVoye+5/+h78ip5lY1cP7X0euEuRoqXWpnp+d3d7eTuMuZwvMfjLR",
-	"osqWZ34ezGLZeKm8vggRQdbrD3e0tjbhpobMfubbm68vr8j564tpTTCj56PH08fTJ5hJsQROSzZ6PvoU",
-	"f8LTs8R9P8P82WfKleE5C0Gjd+POt7K0RXrMp0VIAGr+WgItkD+aP1agJcv8Jwk037r/q1u6WICcYqyY",
-	"/Wn99My/Os7eu4wyd7u+ncV+aGfvG2l58j09vSfVviZn732h/90DNoq4Ow/XqMNAQHc1O5thxb2hTSFe",
-	"Xf9SUM5QZ+/xdd77+5m7rNMfUYFiT9qZl0B6WtosIumPDRS+1xuzkN3DmTbReBnV2bIqz97jf/DQRCuy",
-	"GbzP9IafocPJ2fsGItznDiKav9fd4xaYeNYDJ+ZzhX4xuz6fvbf/RhPBpgTJzKsTk5u5X20+yzMscLvt",
-	"/rzlzj2igFQSsJ+4Aqtd85WJtjyrY3ADH7nIfePLLc/889h7YCN3ePr4sZ3+Gf5n5Co7tvJhnbnzPLL3",
-	"+V4lbyNnNvLeln4/wGsjjY0ojDA8+XgwXHDrdW2Ysb007sajzz4mFi64kW9oQbClnf7Tj7gJINcsA3IF",
-	"q1JIKlmxJT/x4DgeFehPUeANF7fcQ24kjmq1onKLIvNKrEERV6gpIk4iwchO9qGCknBNw3jlUcNH3o7K",
-	"alawbDS2GdLfobSmU4KLVzp3Z/IK93rw5qn4du+ZGL4LTXl4RwKuQXAen7TPzpxIJtzZek8WbW8OC8WD",
-	"1N6N/sUj/sUjTsgjdCV57+mNrjbMcQmli7XPaLaEXayie5FGd/+oFKnkN5c7+IiriNbHRi6bbKT2Wh49",
-	"f9sNSnfUjCqBqX/LGEG9fmrIwJD8uUYXjWg/B9e/a9tP+r+9+0MIBV9R7k96gxas7wSVBQMZ6IPybvm6",
-	"f/GH/2v4gy3LSe2+jomGolAxV9ACuYLVvrksydya/wdyiEa+61oCb/x85pUdqYdrs+X7xp/Nx5haVjoX",
-	"t9EsaCC0NvHu08R8rFT777NbyvRkLqRLmEznGmS3swZanLmifK1f60o3nS9Yvif6MY54T/56Rt0bJfUN",
-	"uWBfx84jOvXVvRN7GvlQC/+5VtXFqi/kwEHp9fad4XIK5Noz51qT8/zsDCP3lkLps9Hd+H1LyxN/fBcI",
-	"y9cpH5WSrbHw0TvDY4VkC8ZpMXGqkLro6Ojp9PHo7v8EAAD//wOCdWgYDQEA",
+	"H4sIAAAAAAACA+19a5PbRpLgX0H0boQsHcFuyZLH1sXEXo/kh9aSpVDLntuzdDZIFEmMQACDArub1um/",
+	"Xz7qBaAKBNlU276bL7aaqEdWVlZWVj4/nMzLdVUWomjkyeMPJ1VSJ2vRiJr+StK0FpL+mQo5r7Oqycri",
+	"5PHJeREl83m5KZqo2szybB69F9vpyeQkw69V0qzg3wWMBH/pQSYntfjnJqtFevK4qTdiciLnK7FOeNoG",
+	"5sS+P5/H/+ss/urdh0dffoQuzbbCMWRTZ8US/r6Ol2WsfpwlMpvL6bka/+Our0lVAaQJLiHOUv+ibJMo",
+	"SwEp2SITdWhh7fGG1rfOimy9WZ88PjNLyopGLEUdWFNVPStScR1alPM5kVI0wfXgxxEr0WMcdQ046OAq",
+	"Wg0AkfNVVcKQnpVE9DXiz94lON2HFrEo63XSdNs75Ee0d39y/+zjvxlSvD959LmfGJN8WdZJkcZm3Cdm",
+	"3OiC233co6H+2kXAk7JYZMsNUHJ0tRLNStQR/CeCv+HsShGVs3+IOWy0jP7z4uUPUVlHL4Dok6V4lczf",
+	"R6KYl6lIp9GzRVSUcGTr8hJoIp1EqVgkm7yRUVNST0Mf/9yIemuxq+ByMSkKpIWfT/4hAcLJyVouK5jr",
+	"5F0XTR9hWXm2zjyrepFcI0VFMNIMVlQucEEanFo0m7oIAcQjuvAMkuQGfv7iYZcO7a/r5LoP3pt6UwCZ",
+	"iNQBsIFNlMkcWxCUaSarPNkSamGQv55NFOAySvI8qkSRAhKi5rqQoaXg3EdbSCGuPYh+A7SCX6IKSMLB",
+	"8zT6EYin0V+b8r0oDHVEsy19qmpxmZUbaToF1kFTexbi0EENN4aPUUX0QaE5wKO47zEZ1Gsa8ePwN5kt",
+	"1acu1BfZ8g18iBZZjvdl9I+NbAwBbyRtO6BPVmKOvDeNcBhEPgxZJEAj4vHb4h7+FcXAAoA5JHWKv6z5",
+	"pxcwUAaT4E85//S8XGZz+CmwAwZW3zmV1G3N/8Px/Ee1ufbeJc/L8v2mchc0d88C0sqzpyHK4DHDpOFn",
+	"kOdGbqD9UWO9uX72NMRSh3sAFHojA0AGcVcl2BBEnFogtMl8Qf+7XhBpJYv6txMWL7B3Uy18qEXyV+ya",
+	"BKpzlp/OrRDxWn3Gr/MSKJevQkfMOCVmC785klNdVqJuMh4U2sZ5OU/yWDbAufCnf6/FAuD4t1Mr6J1y",
+	"d3nqTP4ce11QJ7yMa4GML4bx9hjjFQqPJGoFDjryIT7qsGdwk2VwpzcruLWygjeR5C7kNLm4TIpmerLX",
+	"Sf7ocoefFRB2K/iS5K3oMKDgXkTccAYXL9K+EnrvyJakSBiPCOMREGS0zMuZ+eEzGNUil77DL4yqSZQt",
+	"IpHRfS6uM9nIu4SZxB4ydx44YdG37thXGdwxZZFvo5lQ9w7wGRiT+bbi40oAR8TSGuyIsA7a6RKYLiBF",
+	"owHlsmMQI0mVqzLHK3AnGWHj71RblwLx91Gd//TU56I9THck0SukEjXxL/bhFn3WIao+TVEPpKbzbt/D",
+	"KApHGaAl+cwi+Nh0Rb9kjVjLnUTiQOQQmtqepK6BySsJKiZJqE9BIC0x8YAclRUE7QQF8gJkv/e8HyXh",
+	"HQlBSCNpM5mxeHUFO2NFLoP6ae998ecmZN+eR7jhSYaycZQDYaIwRJspo5XISeBMjGLBpaLvoHFZb49B",
+	"OyGNBuJUk3W5cA+dd2eSNX7qD/P27c8ol7x9+w62uwFGbZ8OL7J5XZ7DR9wnd4IT++7Tknxvv8yUMdJP",
+	"uWli9bSIa3EFcqNnRVrwVGeUeg/CMYnU2HzY1dNFjT8dCeVOknUmjK4SCZcnHIs0AuEy2ZdQUdgCQVp6",
+	"twGYGO5CCmdgySeCG3d2F9iWRQiK2i8XizwrBEjbGSAA33+IwKTRnK6cZ/Qm1GuAc6bmgBc2DhC9LGiA",
+	"0SNskKsAJoAXNBo6B+yqLHMeOPqhxFuuyeYZvI1wc/YAslBXQqLHBtZRlM7fwkPoHVZgVXmK/ndS5cS8",
+	"29RW7cFHOqfecg9cJAhBSTGn95TlGUBCsJ4qwYcYTuvykFd1WS6OwUHUofWSuNKCsMYFN0htp4a2FvOy",
+	"Tj0Mxhyt2bYRLY3U//7sPx6jJiqJfzuLv/pvp+8+PPx4917vxwcf//rX/9P+6fOPf737H//u5V5H5YJ/",
+	"dJZU4c771yqzWY5ChF5sUUIbkH94ugRu6kVdrlnXVpZNBycyWov6fQ7Xe53BkS2vClQJ9fd7EsE9LHK8",
+	"3+gf9E7WIss+sgvR8JNVlqc+yaX7N0Ic4sTDa7l9itx5bQxhXsmm0AT43KKsbybv2Fu5y+4GuBzTmML5",
+	"ZH+ZqcWd/JzO8g6X4QFqMkAHze+yu4M43QgSHFiDAf+qTiqGXX1h1Rcc7cSorBnWGyo/RuolvDC7lh4r",
+	"qhJUB79/d75RvZCwjaYNw9/ycv7+u0SujnBjzfRY/fNF04DwnaQgGaygyW4ZwI42hryxIZFsNHOmmpol",
+	"Pi+X8ghLzMt9HoJV9STJc5y6zzY7q6WBR51jeDdj40isswZFL3WRLbNLePSxNBJ9ncBLDdYVzWH+iTXl",
+	"lFXMNwTIY1lRiHrC0pzhAzSy1i3TOZICn46NiJzVKDPQNAK2Cesva2KN8N91Qu/5NWqUq7zdx7xHJTxE",
+	"O+omYi/A8BBGR9kLH9TqAGi+wc3QBL5Zo9QXoh58inOrTzRzUfLiklqQbSor5vkmtfgz/KIFNLa22onC",
+	"TgEckmxjLApnNaCw5iFYX6Imx38IGMR0Zur8rKpFrIaok0tRS3jB4bXSXtRdQ77HOp07TmaaNIlzMhUV",
+	"+pXgzDmoH+nRYKb+6C/pH7A4/Iw6IaQkSz0ZqXZIDWT2g9QciCqeCRsg34L9XbOpMULJdy8on9jJ/Wxm",
+	"1Mn7mq2bagvVIswOvbnOUnmsbaLBQnvVPiGyJeT15J1BpuPMNQYBb8pKCZgdEJhT0GiMkPL66NcajOmD",
+	"CX7uXWnltTjKTuA4o5k9zPpUQVbWf3p9H45DeIyuBLHABN74zcQczm2bMyZs8KVXcZOtxc0EY0b8GIrE",
+	"3UezmtTSqiuLTRzXh/NZWR8mavVcXaxDR5TgqI6kOelQEDXdVLFiXB53C27QGSgy5sphCak7vA9jLSxc",
+	"NMknwILEUY+BhfZAx8YCHNksF0fgCyuvhAsULT5/EF18d/7o/oNfHjz6AkkSOi7hHEb4tJXRZ8puDCvb",
+	"5uKu92CS6OUf/YuH2sGmPa5vHFlu6jlAX/WHYscdfuVyswjb9bHWRjOt2gA46roQeO8z2qPX3A8aPRWz",
+	"zfJCNKhXlPAcXRz9qujN4IOOGr0CRC60dckQnhIlT1NscgrctE5OK2oJz3F25cJ1ZBJtCuvZUYgqtPGp",
+	"nSWNFEZTsfNQ7LtNdpqtu1X1tt4cw5Im6houRZ98Au2acl7mMQrBWem5G1+pFpFqober6v7O0JKuH+cm",
+	"vTRcL4ErED2lRl/uPPSb68LiZlCw4vV6VqfmHbMvbeTbJxosLYZBIqLOliWOVIhJlFJHEsS+FQ0Lp3An",
+	"A/NfVy8Xi+PY3EsayCNCwEwSZ4q4BYqGUsAkrEYd5XPWQaaaagzOutjSvlFNGCqFpottMSdp5BhnOSxd",
+	"KdexSMJ0jmkVYYQDvhT1bZlQQ5hiKO5ID6SIqedwquBAVqUEgj4Cqio91uij6EKw8xza4UdxQuYq3ANE",
+	"3RLRAyOhU3OSK1EYLoP5e8HIIFyRu81TkTfJN2X9xj6MvgWkVUe/27pzjt3bRO2scuhJsa9214DvpOG2",
+	"b7olwj71rfF3WdATo57iNRD0dHKfZ8tV42giDje+DcLom8UHKH1gNWSOffrKyB/g9r4gC+UR5HA7WFuT",
+	"714K8LTY4LMMLU7KMu2X0AMu8Xgo5pu6Rv2bI/ST5gtu4plA6ponG1wtOm6WvsvWdoyTOR/PmFATMA1a",
+	"ZwZuxdOtkkt4Y+b4GEU1I7xEyxku2roQ0yI7Fm71Phh7+bSABTTNQWBH9zBlD9oFr7EbGXteCHm0GlqF",
+	"mQXk8WiR1J9mBe8vdwL/XmzjyyTf4Fvl+5/QR/CPsQjycNmxBV0vGLMRXUVvfyk3gGmIiLsQuaTM2hM+",
+	"CfjeQKaTi0aEkH1z7AW3vwtmjwg+EQJBJCZ39U96tPQkn4AoDfyf+GB9kiVsqhhl4qAuBsV43O8iKUot",
+	"KO+YwUyQJ7KJd10p2KilRMKlOlzcd4vQwAHh+jl8I5m45aik5mFBG6fY1++Lpgw+TXHSn/SrtD/tHK/3",
+	"QsLtrJ+oclNVZQ0PU9/ySEEcnOsH+Krngq23Y5t3MLCRjRS7Rg4h0Blf4VFpRegPoEjt/qkUzP3FkUsv",
+	"ii/bfbHcgs/iaAjGC93KQbwbsRaAEY1JpieRG3qstehtVpa5SAr2+iurCjlUE28K0y+EwQtufd78aNv2",
+	"SVL5zpGkkpZCkjFStVeQXzHSJVlFVwnqC2lkbQwg7R/7i/RhxmMdg0g/F/HQeSGNALZyD85Bx31TLWsQ",
+	"b2MQyuEF1jdt8OeIP+9JGHpsIhCrTCkbEc/I7uynEXsmtFPgYbOWNJX0Cd4RfQEOBuccn1GW1FTvwyeF",
+	"/+DgPr6piPWOmYXA8NKBHo+QxfTkGZHufmhC3m5MdLQadSvdcC0B7JlZPwkCadzYagG6s/8XzMpzGwHs",
+	"qPNvYfbAwu3Ux1p2wBZCd3vrwuxcZZ3bxntFBPnyDsYY4kEBw4zjaFwW34vt0V/v3Qm8XjXAn+ApiUp2",
+	"5wO/5Cu3f8Qxft0xD3vNj1J49cHvKb08y9FhD23gQQ4ltckr9it0tFXHUEd4RsULF+2yCKgOScUXj9tE",
+	"XMO/8i0KtmRZJjWb3MzYv6lvT0QvJncAf0KC8IzKdcPrODHoS3JBQznL8zrH0mtrGL43nSdXCx3qlUXe",
+	"+B43486J7yHDC8EoxzKYsmFVJ2xGY2LSNSW1gFQXBPntGHkGriUXzbSC6L/KDXC7gl64GwwlVEIaRQWw",
+	"xEMzoLhp5lRxYBZDIhdrwa95+nLvXnfh9+6pPUfXXHHFzlkFNeyi4949UsW9WsFJgxvz/WuxLi+PY8TD",
+	"gdJBd3eSU1GSJjLXm61BuYEzj558rKbbwqN62kdpIZqrsn5vweqg6+b2wAL9qccr/c3cX0PH7W7zmxp+",
+	"LCpUe+Oc711+KZsWKz6G9QPGe+Yhl25UR/cG2u08q0Yeg4BXncGNbwByYCkVm8Pl3/i66PDx6zFrdznK",
+	"OMdhGnfU1rddTXvrJi7xGt8tT+skO8aGpzWbY/rL/nsr20rOfEw3n3olfJCvyjVGB1RCGdCGVFC6dUSt",
+	"4UmJr3VYRgHI4Vt2TIiLTBYibspYrjYNxqGEF4LOr2yLaM2bi0UziZTJk9ZHxlm0UaxIs4UqZP96SaAM",
+	"6DBRXWVGxNnIlwgzz1hLb0QDsDtxVc5X0+ilcqE2LqcG83g1udjfjZsOEZqd7u2TB4l7GCeXbsiUWa36",
+	"m8BHVF1k600ON+kxWPUl3J5wPdR1loqdjFpNDAN/Df1emm4Ak7gWc7yG4VEwpyxDI8cSb7APJyZiss9Q",
+	"RuHEE2MBEs+41wV32qFMtH6Q2XotUgxxBEmnqsVccJYdfIhLs9RpxCkX5iBwLEnJA52XKlSax6HLnuIv",
+	"MePQpugNse9rs7kuYrLSSm+aG3JT0dma8J0pMCKgZ+JlfRR6zChQ+OyNupOd7emavL0uMpOToG4T8X1p",
+	"dZuMt3bKqUOdR1pPYAdpFpqR3hKET3wO9pHobiMevoZdFD6BIdoO7YOyP7ETIWU/hoKkUKWab4/wDuSB",
+	"YHA4MZKkdtfSIfmrNxpTbiWQXt8+zV1/CRzX14co+UoKno7XgGGP1pJDq1/Qx9GWFX5pBEakN99eA3Z1",
+	"Oy0kdBbQnnwMSd90k4hkume/68whvynrY3lV8YDj/YR2O+fsfEeoKQ/1p0IRqO91wxrWHheRE+PBn9Vu",
+	"aP2zVE5UKBY76tiIc2dBr0xqlSMc4O64HfcSJ40L2ypFXgF48zwjSyZMDi/5efO2SMiY4SzV4xyu9Z9h",
+	"y9cT3cRvavNYwtRQAACJSsbE4XUEXQiPUPmNENoAJjdLuNSbjg4Jer0tVCvYnE2BwbEYn4bHJebzAssk",
+	"D+0pt8TguAWJxWX0m6jLaIbh5q5WZY2Z3VgyZ18XnAZGhYU0QEmoM36RoRsqDqf9BvWRNa9WhYXpeMa1",
+	"FIWQmYz9nu3f8leKsFQ4WaloSwo85M86/Oe2A7o17L5kcgpyDCMkNST8A3VNTtBkF/Y/gs0Z3gqxlyhd",
+	"B9IOLUafUb5NRXB326YNgOltgS7DQHgglWcp8qKjkU/3muodaD5iHSprbVzHUqERsOcb/gasKvJwqg5/",
+	"/STyXHeCQZ9Cd8s7AXeKM8qjA6gG9sHVndMXRnHn26/fRKeKEOQdIhY1tJOa0POC0elkXEdG3CU3yvkt",
+	"MPinYkHvwbJ4/LbA6NVTPk2n8Naq/8YJDabLMnqsMwQ8hTZvi941FExU4mYRshmo/5WpaY+0KEB9spss",
+	"so8iIFFEkUOqUuU7xG1FFwgTRY3MXCWLQRr4oVR+c3VypZ+8G9Rr/7pOqp8BkHdR/HZzdvY5xaPbFIm/",
+	"Kh6IdAtAj374BpNZdt+7tHCWyymIKMYgSX8SqUYkFVEICRxremmCFEDdWrHyOvKLhrIL8GX42bUlDNne",
+	"SS5ouRfcS6cF9y+KPtGmtnOv3WgHnax6B2/gjsx8yaZZxcgRvKuSeAz0XukcRskSrxztJIU2R1JCwtHB",
+	"JaNqSGDUAKVvFuuq2U5a3bUvn7qLnWxaqDNSkfJwcGEwtKXBgJsqTZQgkxTbbopcycFvNOhrAQzrTcnd",
+	"pyOzizvZ7J0UrTJ0dIl2nbsWydc9yGqM7uYr11KdMEGlM6UkBJosHhu6cLKYBY42CwBHONY+omjlCQ0h",
+	"Iqk9iGDiD6DggIXieDcifd/yUDVeNHC7xiLPltksF2HVvmO61bAiVaJ6NLvUKS7MgBKtufg60vmF+MVU",
+	"o64UL3WOuIF97wSOO5p/kg5XIqmbmUiaQX1t4aap1NCRQH5FGURIaUIGCHGN+501pAQB6U+k6u3NbVSs",
+	"xPQgj1EVRZQeCKrubjOGTA95RCiEe/Lh6/veyfmk3gvKBdelTgKZv6MNHtUVV7ibCGCpSz9Qgljnntpg",
+	"LPboxGmuCXJsirJWHxxkl/TjlXfQRaYt1vRkjLEJKal7jHjxcgeBX5A9+NIw6rnZS0JZFV5iXhSF1FlO",
+	"ArXxgWfSwTCCyk3UuB+wfjYGb3UrrGrA2lhzjz6a7dTRJ3Ob5uifKq/nJ0lFO5R//5njYJw0/ez6+pru",
+	"svYJ63PgsgYKhh46C79Ova/z7QNg++TO/1cy0k+djFQr00lKLiu89bOA1WquWYrK9WRFnk4UBw0DcE8i",
+	"5KSXSY6cVCUasIP0cr3T26eT2V25r90NvYlGHjS1RpJO9lolyzOHrM8VvPUy/K+CvdYwK69jzoThfVrN",
+	"rmd4JrwhWZSXw3d4OfM+/BcGJ7dJuuE4hmdv6MKQacAcTzfMpI74oX4hsZHB2w+QYUHeR82SSE/p1QzZ",
+	"hSTZw4AJiNMhsvvMScF/JJCOkHvYlbb6koi9bntZiv2sJnQ4vTsZwGhfedrOlf+dLZcQTq6uz+qtFAno",
+	"K+VuUteBO1dcq2Gfsg5dcmgBMYDVV10h1p9/teVt18argzUfS0JG3zd29dEm4WYjTUDckqvj9z6zNCo0",
+	"BMkMF7qbo+ek3UuK7V3H4bcWS7ShWOOCdnK5fdsPqRNjSkkbXl1T1Qtc32snFzGbYzmVr7vMW18BRecs",
+	"shpDM9Ay410CNvpGkibtG2zqF4TbTqLwAw24txxMEGG8aprlGz8pK5C+f4oQ/WBuLrmZ0UUJZEreRjMq",
+	"peeNQdjDNknwcOzKIIKeM4KeJ7eBn3EHC5siTJQFuz39n+SIdXjhEGfx0LKPmPobGkTpEK+1+ck93m8q",
+	"2Tq7a1GgpZtovZ3XWuWznozK8fbGMX3jyEWci2QRzREQ49Raw5WSYdopzWxU7IItPRLZXrfPMzOqg+ld",
+	"Gn0yumgED88yZf1OkDKnXnkOFzPsz6yGkmrlPh2fP9kcw6qmGCAHJ3tMnxycN5XjhTMdMgH2kJbqsXc6",
+	"5+kcNiGZkkfyrsXJFu0PmS+XSwwC5jyHKg0CJ71UuYbzEqje5AHF3wdSK08jznBMCYoHchurgCwRCsdq",
+	"VacNE5f7tiXIbTw55WWmSdAngBK3nexfvjb3Is4NBaMWjqL8dg9eL1DMG/7wphPyYOMSeA/NZtP25JiX",
+	"lV/ZUuj17Sit0tsuhbpJKHCilT5/+IAxBzG1AmyN5y7RBC5yAC5Lrzt2YB51egBJjJT++4UFOzijW0oN",
+	"tgM/bT/zHaWf76CwRO2V7euUtD6nqHNg93bloI1nA24szq+TbmoyLracx/vlGY3eYeTav//poilrzKDK",
+	"BuKYQbrRELScfdDgVDiEtWfsL59mi4VwDaPyEKNeC7ie+SsdQdgBEuxbT42qYZA++0S2g7bsCnYj1E9P",
+	"HkoZqjLkr9DjqlrNZeNs3AE2Zm8Kne9BbvwJFW7ASECqtK7Kyl7cvtb3oInLNQxNI+/0AEbAduwKaWZf",
+	"C6JQn7HNfJKO3HlHtop5kkqktYV77NS5f5eOtDWqMmv4aNgbqlWetL2UT3dsnCo6AOmYvbrwOyHh2RLt",
+	"bekS+q4tytLdso/zInWn2q+gkHvJmdxSO50NRZJrwqfFnnycnNzM/cd3T6oRd+zEK3M1e3eBnHPZHaTl",
+	"A7jnhiSYthkD2JTbVEjogEZK6KDm2svqll9n/lPx5uvz568U+OiHAjJfHRvNV3BV1K7606yKK7oOX0Nc",
+	"qkap+lkz6my+KSfiOlZdUVmajnK1VzrZutE5B1U5Wi38gQM7+aby+OMlDnj+ico4/lkHBfb7a/v6JZdJ",
+	"lms/AA3tWKMLL3dcsW4vn3AHuLHPoOMMeuOxgmEjqIDTmLXmNfabM+WCPK6V8kDH9x6v8Z9VS+s7OCSt",
+	"8yUlMve/uwqV5pwYo/I/TI4uB34DZ8O9qFSQq9d/8dMJiPiYYDz6fTTeKKeMnlg4jViE/HX5K/KGe/fc",
+	"g3/v3iT6NVcfHADp95n6nd5RmDLE86b3an6RZZFiFyuT3DVhMsGNuF01RCGuxokLICYbGbkMk6GhUHZE",
+	"1Oi+Uti7qjOFz1T9go4X+NN0jKrC3XRGtwvMmBN0EQpSNb7w6+QaQ2pMOU7HFk9B00hadPWo6mbsdtE/",
+	"QtCP3BBiCQD4fcCKmUSWVLCHNzaOqPFolwKcY5MFwgyKTeaMjs3kQRbwzkKcWb0Il95CABa/s1KxgE2R",
+	"/RNoI6OE6vCpppu4cznrpxCN2hOw/fpFNTBbku3wY4Vp7LavzmjAYqy1akMKo0EL/FNjFdaI8JUt3zP8",
+	"xZ2xx/wHQlcURenrk+IcVyIflzFk8J1njPRe5YvyCtDsUxngww8kZLa637OnY3Y6k/GiLn8TftmBbMae",
+	"ZFXa2SEjBTz0HmHOsI4ker3u7LsIZLxuIUQqN9Yl6EUrRzvRHHKF+/nEfhu9p9LA2e+w2kD6q4uoTQg9",
+	"VF0/pHZcVYCZ0YF1ogQo6Yz2foRGNCCnOWkFIvrPuRs3fMrj23OuYO7FWufJ1SzxFYHE9yLC5Gx/y08T",
+	"M5SrznqDpMnUwbNHTmiLaasy6QAM1nrULw5w4NuPpx396rOPPKI493k3YdelXJaeYTbFVVKQWyn1Yw6o",
+	"eqM2UpvOrsqaUlpLv0tpCiSy9irDAfnpvO8ImGZLnImzOkfJolHWVDVQxHmziYrSTFZ5sjWpaRRqYEPO",
+	"JvbMmrxG2WWGJnJBLe5PVPFnSRe0Ldetu+DyYJkrSc0fjGi+ApTCMYMujFhAq3mfk+hpHKNnorlC79Ez",
+	"anf/q+gz8h+X2aW4679glLB28vj+V+R2x3+c+WSlVCySTd4MMfmUuLy2Vfspm5zseQxkq2pUf6DKohbi",
+	"NxG+TwbOF3cdc7qopbqCdp+udVIkiBAfTOsdMHFf2l/y7OngpWDrjIDJym2UNf75RZMgxwokF0CGyGBg",
+	"7AOsY60ch2W5RgrTrFUfPz0c1R7WJWI1XPojeeRXnjf+7/DcStaBgFcKsviB7O0uWifoFE/pVzIbjqNY",
+	"JJxAXYuBauaavGWMG5wLl07yKkXnYAVCOBGkNdo0i/hLfL7XcG0AQ5yGwI1ncNL6tWfbFQiL/QC/dbyj",
+	"pai+9KO+DpC9lnJUX8ypUMRr5CjpXZvhwzmVwdABv7t3yAs9MPSNpWscNw4S4KZFgInDzW9EisXAgDck",
+	"TrOevSh075XdOq1uaj/BJBvcoR9fP1eSyLqsfbWdLANQUkktMJ3pJYUb+zcJx7zhXtT5qF24CfS/r7Oj",
+	"Fksd0U2fbu9jwbEqe95pJssWSvo/vbAVYci4zWHcHe0l4Kv/clMax1v2Ut5PX9i1obN3KH0LYG402miU",
+	"PlYC0T8c3mP6/B7+Xl2QeM9bqtL7vwLNLyhFTYn6ZgQaNabc9NcH7c/M3u/dG+9B7dcX4q8e1Bx213Qz",
+	"8GJf31ZjEfc+x1BFvI3fmMpc49Gweu8yvFJnaoxJ1K6UfPtyx3HCV/f2SvcfII0a+tzFze/MX2kzbUBU",
+	"mD8AfTxVq/JpCZB8UvPdCalJsIL9WCLqXFuanm4/IMS/kR7w1J7Sna5qi+mqcpSu7o+wvYHtHKnRpGUq",
+	"d/0dXh47XZScI4ejzgT6SstWucrRHjd/ZArqm9BOJgN7scny9CdrQe/cqsDs5yuvQ/wMO/7CTxingaN9",
+	"QTtxIXJvb37p/6I1Ah6dxT/KwLDwHPN/6ixcwd6B1ILVBkJPqcdHXGUN5kBpoaidW85k64FrEfYb29k6",
+	"Y5atO+KzRXy/RH0/XQUNu940yqOa8oCo8l+LLFdJzn22fGoZ10kTuBFqiiJf2BFB2kZbYaRyjMPoaJvL",
+	"1iRyyARLU9IhhNWhPgjT0RWi052SD9LIThEx1IwXqgou5TEqo2ZTY5LnhbMMtNfBxbedUPp3HuQMlyWu",
+	"ae6Tx/fPzs7GGUgJXyPWznjVC39pF3f/lJrwF8VRubzRXuAfAv1HS3X7bH6fuFTleLoIfCyWPnBuAbJu",
+	"o0zCVeNhMSkplqfRt5RqDwm9VdCHFLo6WXg7ve2myssknVB+c/TvinhW7gPPOkQdVa1fkvayfUS8Bqrx",
+	"6X51KsFAGrbx4wxngcJVyyY29eR9SUGxxRtTcD7reG6RXtPFzjR6yipl45TEk0SUJb9eoyrWjMYqDCIO",
+	"/EfTJAA3qmGnJ4Pq8EDtPltSL+RF9Uq10BzQmrqcEG5T3pI4OC6DfTRQgZuKehKVqF+/yjAh+Qp+vhTt",
+	"3KMmcW+nAEt7tUBWBRPOdA/J2xSz3HcXNHAstmvfEC9knX24sd3SJqUpN/V8jzo5fPIvqJc/5qhoD9bx",
+	"2eACV9e6RNY0eqEMNXPg6UU2p9JQvucDZRUdZxIeUUXLb6uVJ+ose46hh5SdXAsKi2r974IsUyGu75Dh",
+	"fMX9ZsLhPxusN0nWySXmp2AeiJmQcHuwoBzbwEBoEKpcKdKXy1HL2uO25g3pMe4vR3Snh03ExIABPfE3",
+	"+O0HZVeg9EdwC5G+UCFVvWLZOIgZi/CYYNBotMTiprzadkyb/Bn7TIHMCIR30+flMpsDWdAY7EaJSGEP",
+	"5v5Q59qfWfkPY9sn2FaV4TA/t9wBeVK97ndeFiLN/ve1OddFEP0+vzXtBOQg14zvjjZAjINhCnQvIxli",
+	"fRagGVHRfd4jG1HXvkczVmfZML1Ri4iD0L0ZsLPCA8ZzTPZkpGpPSre59y6hjaHTHOgH7TFtwGiOh87K",
+	"gVAeyg/B3g43HapbVARRQmvUc4S3EchcVUQJsBXTwL4uMKOnPhRI3Y5QgiHCxjGchKm2Th2lMyWMsaMz",
+	"Rwkr8c7PVpCtxzqsuIWunUGspjsV9tn3ngolzp1tQKpsMAWrL4Xi3+hrRF91MCQWF9qYkp0mRrZdeaBP",
+	"bWoizKqyWQ/MpRvccLo0k2jqWM9yj9vwU/MR5tE7TDnVZlv6/z61AY3D/t6R69o7P92v3EY/Et8nPSNN",
+	"x5hpbzwm6E65OTrs1IcRuu1/VErXQet/iJj0bgkzZ498/O1rvDjcjPO9+AS+WkxCeIoFKOm7Tm1nkhJ3",
+	"6uQlTLS9OdXmebasA7xu6AUcLr9AtgjX4sT3K1thQjkj5sEMOUmjEjHCKi1PGKPCCKeyY+/xjlWrb5oN",
+	"+Yeze/inNPwofAwiPWwl/b5lE2WPPctQgrbQw8yVlgj2tVeqqiJ9fSncAeV8NGdQw5xjp3DW6XK9VkUc",
+	"PB6Fl2t4iDnfXE80IfyMjZ2tPWEh9LD1fqOnlfdLfeUfraUfMUQzNgEfoVEtYcJBpRo8DQxP7U7kqGwV",
+	"ZqNv4PmFlvX/vHj5w0l4I50d6G+pygLvVWGHNsZE2XXJY1m28DGYoD/xSO2vWonFjedEMEvkBN0M+Gfl",
+	"f9NJY2BxgS8lj5TvpmPQU7aznDr56XQO0KZ0Jx7IotCavhq13hFZ5feffMAz3Z+fdA/EBtJ//o2yexqn",
+	"JAdOx2nf8JGOBdPP9XZfin7O3OU5ZZH7bS8yYM6hFHt+PjC7HkvxmKZ1dFuRVL22nz/wt5X8muygcCbH",
+	"TlZssnFNP3pwi6nN/LvFOf3GAsH59vZp/Xzs4D3uuyy5uqCv/lI/rdWJ5YWa8zms2PJWvs5d1uw7Lc+5",
+	"SCV6pdW+1G4qM752oaCzydGlV6QyB8C4hD0eLcxKrhW4cjq+5o8n9YmeN1AjjCwaO5KgGdB6g9LzEhP+",
+	"UfI/OIdYRcE6/C+ynP7JZZIHKiRQTywCKQeqJNsiyFQi2eTusHkTk8bJzeVglAHjksrrLM8zZeRriZFh",
+	"otxVcNeBwCmN4GApvNmjBdmhsg2NYOh2VtJuVgCOoqo2qH1kDW7jKHtmch0jbHBsZHBj86TGEoU6gcBs",
+	"exhMh+7sOLT5EHasvQ3n29Nns39EOkXnXALoo90s0neBdkuNeuQoNpPaJsrA0mOBAZNJS6syprKpr4im",
+	"0i1qmy0/TVUyZq4s2itK2uOZT8eok3r4AKCfpXspXHyFWE94FO8OZMtV8ze0kX8nklTUXEzPp4DmUnpr",
+	"gVQpV1lFGlOgwcxo86IcB1NVbFY03HRsIHIvi2Z/LB0udgmgo5HDCXqphRjv1Vn5l4gQaBckk9v0lpVK",
+	"sI5UVM1qUL3CoWxVY+qIYjc2pKGPllDODpeiALY0FdNuaH5qU2ByPlVlHcJku9PdHMIEaRMaXaB99NXK",
+	"2v29L+lDS3HUS3js5PMWlJF2D2nk3ERAcloJrNZu8mR2kkaNTk6zWGAi38sduaf/jqY8m4x4oo196u6w",
+	"qagzkxyB6pUd1QZuYR3KAj0IqitafEJIQ+m/YNfuyKhFQ5zuPpRP5JDyR4Qc9vzSFbVCzhAqDASQo+mJ",
+	"EKSj/tSlbAuMHlIBy0nNfiAYmsbxerLp2g+DRj/DDgADu+45aVAYIVVWKLX1K64a4VzlYd36UwGXOTwk",
+	"OIQmMbWWXAsUGtM7lndaHb+K4KFh/It01SYh9W+6OgHPkmfvVXlGfh+QNxcWtNAtjpIUmO/NzA/0wsyc",
+	"2TDwvl/wvp68nI9hnpcoAMWhNBhd8V+p3eBMU2SZTdFKUC9EXYvUeBHB2CLGyl1MBXtkvlfJIgawZ1VP",
+	"e+OtE7+4R4IUXlGwgNhrW0WNaqEnVDAsUaF2LlacTOm2spnfcLprh57wd/0A0rWthw2yIbybcxHvDObQ",
+	"iQbwnulg3j1d6C1KwsHe3KuVdu0AW25WABONtdtXt65Z0U4KTkVF0s28rzs19u7RSVYHuJnXDDrvrzKo",
+	"ikZGfcqGIpWNzOy4CzTLkAy6oyXuEMVRrdvSB/fyKOD9vsnKsRxbHPAletYvxtY9DO8z9P/GFOZG5Y1S",
+	"8J32scFJos/IhcV4mV6ttrrUWAW3nEjvTqMITcuYC0E7nLrl4HqTF3eaofmvadZ0w+UVlc16+rbwB5VT",
+	"mcP6htxPDzPA80K8CZhIeuP5eZADZgc+EvKqv6J6iDiHl+cOqzf6HqEdEcohP4bCK0Ct4NTOyvL910VT",
+	"b/05sttaXe3NW+me04gct8ntH1BoohiMzlRU5Xx1Q1WyCKiRMWNOuVjEsCdZPqDBpe+uEk2gVoFzgQC8",
+	"BaCD95r1fBhRuUjQE1V/JSsTcI911YxOPDfDsJn0YNi4+9jJENxNLXaqFKkaFl5Ml2JgiZrsNeLHQMBv",
+	"mA2l3B9Yrqs8V60Xm9wF4oC5FVXGim6CaFDEa5q1cxeRpC/L/NK4pY93dirrbJkVO+ZFn1ZJVW+SdI2F",
+	"H7vTlzPUOFodxfj5K3ThJnsIiGB5CAH0qRVPq+gNJ2fvwIS0MWY0+jxRzc2hx/BqAHoFg6Ul3hYgl5aX",
+	"e/qX8asqtjvPzulh2pG29C9ZqWzPHsH2ZYAh22gPMGCGMbGCfc9tIvHSpMR3JQUBOMxlfDnfHfvncMVJ",
+	"JKbLKcZAY70WmL+er1Bbv89OBN/emqY1SIM3yCuARu4MoOJXXZe+zPb1b5fQvSGGb442luSehLnHBsjg",
+	"DrSiY+jzzTclsAkX7AH+hCR7nw2YUso6uY8pMCCJlOd4JPPSlzrgkLS3OJQfde5kBFAjihFaZwuFGtyL",
+	"ABVdt6OUjPrseOcgv9dBGYdWjVGFWPgtJkMWju7MZpb2A2eBKV+cGSnAlKtLGc8XKs5E/5hlIDvW20Nq",
+	"u7RR5aO/IJZ3n3IdIWkXYqMk+zjM8/IqptdJbCqE+7T62E62X9+qvqytLC4V47XxlnChsaZnCw8ilHZq",
+	"uD7cHn73AIYKM/DEWEXM66zwPFs0qOtbUzIqLEC9hEOGlqRog660fgoKzbUpUD5IY0OTQRQw7VCeQ+7j",
+	"0PHIKfERzX7ZMalddhaL1Zv/Bvtwzk2bs58XHXNsQCCxAMDGOfoVhrhxH14iHE4j3bWt+jVdi+ya6AaL",
+	"ZvWPPGx9jUkfVAvWK7gkRAcfXy/rTEoGxdDSVZbnlPIyu3YiGUwgkB+1ARXYMwqAvswo0q2d/pQ1YxWK",
+	"NSZnrMsDLtw08vAV2i9XTo1LA6fWwGM4MX12R/lRbigYkfJa4RQPo3WJVh7X58cMZWM/P8MgG7j48rY9",
+	"jtV1S+Xt/SK5Pp/Pm+dwZ+Oj7C7p0lEOMtkIJzoPZDdo185UdwpHjFP4YWQYkYfcXRuO21E4q6Ln0byz",
+	"w/16/gO7rnAHzHe7metu94Tz/sK662rzWb9KE5/4TbnO5v7j9ucKew0Gq/q4l7c8BPVQqXOpGfEB9x4z",
+	"cUzEPftoFgXSsm+/FI9Q8RzEifCfpI3rjhsthOJBgTu0z3eUgBXPg2JgBwCClLM3YqIB4n2ukGYYTrlk",
+	"pzyKRukCOvLCoaC/m8GGIxwdqEbcCKheGLIB8DM2REy4jAeHNGOGG/X9rq3zcRDwH4epvMU8QtGUF5a0",
+	"ao6n1Nm3AxzBXzVxMPTwDWXunI0NQJTa2Wfk5e8AEA5JbMEwKjBxXzBYlRYnTeDeJ1PWxNG6K72BM3qm",
+	"rmzm5POE7/IVq+mAE6hs0Cz9122vIKrSrG5VbN43bKMpUmlpfxN1SXnN0onjlaJLLncMA2UV5+JStCI1",
+	"VYpqVt6hIlH1laYzXPWiIsetrr3M9wYeUMWotcdOENsY7HqtKoxYpfTcYTLxGnjgAudjIsceJYQIJD6Q",
+	"u1pI2FfkaJsE8Sh7UNV7PsT6iTl2mh95hNd6gHPd3yfKaEy8G8eH9mZBftQNMaCdIckbGTr1hT8i2c2/",
+	"bvw9aLbUuKcxiVu+IavkqggbJ/skb19iI/cJRnIQ+zV0J6lGPYWAAvipE9CPqcAjovYCHfhSlhqXhcco",
+	"j34+RWlfRKQn1q8YW4pG/8ATUyNAFz+0D3C1s4HDN9/ZiAaLZKdChF8PbMj6Zqb63+UkDh7E4Hg+GkE/",
+	"NsrhNaAa09Stnh3UoNzkqPqG/UTZf5VcCn2LKS4+gbOjB0JFBkW+tZ6oT4V2y2Lq054iSizPzLWsA6Qn",
+	"qkpSVwuSOakh1qyYxf/hg/SfwFKyxZb4DIOvu0VylSAJKT8wdoZUAdc48bB4NdGAaUVMqafidWdjx3SG",
+	"2+IoDtB4keta81hr4L1wt4H8PJl/zhtknHIzI6UGXtmd7exjQS1e55ReJ6mrBKDqONsWd9BV2rD3f7f5",
+	"qtypdNGKKk/mvNuk2sDkOG0+g8KQIS5osx7Ob9bna5oEdCuHaGudHzM9QJu6J+vyJfsIVfRuge08I9oF",
+	"vY+zjJFK4U5h5oHMcKOWcuxdOE7ypt6SyGlQVxHZsTiuF6UrjtzG7njLWoWWMQb8P9CutLwkeylt/GHA",
+	"7nqoyW3sQisDrwdWVoMDOHAbL3Y6YbAeHJUBtc3dq3W3IDnVAmsFIat89lI9W23Vpox8cjLXVcIZJcWy",
+	"V5bVZkWFBQN6ryAq3lRsHYS51gRC63RkvK6VSjE/xMtLUdcgDAZwgKcHKye0KwtrC4rq61GAmBu5P0Am",
+	"7QuQEqlZ/bzbDK//NFvAcjkEBvhrkaJDttMckDaHCyfBgLtkKw83VRmrwy5jVeLIQu00oY7ZikibAQHB",
+	"ip3GbmhIMgAmR7QojbAEUayVxwrEiiGY3m/46cPwp7AEUUhkuaR0X4EDoYpzkemQH5CYJxhlMJLuxq1b",
+	"zyOz38TwNFQ/VTEiwDbOOmaK4XP/kraSHqE/FlkzePJZw9nNv8YBS3wwNVJRuaqjLJlY+ufRlzJPZWR2",
+	"0+ZpUVXnJ9W0J5xN9EY29bTqgV0k/wqVb9FVocvx1qWWC4cvMR/rFWLSN8iBOErrn0K4lkoR1XNc7yoq",
+	"GCkTldZwTz0da/f1vRQAT6fcp7Pentb42eI442Ujx/HED1FVVvF8TIgKl1hOlZFBQdqGMUAfjgkhsG7j",
+	"dyNN0fFWMvRW9XGW+w8R3jvVz3fZyuDsvBs81l4lU4Cjtw0Y6GcKvIyOMKvWKGTaqGIm+nGujd1tJZph",
+	"EtCnhpFrUjJfsQNVT/3XqiAfKJl38d35o/sPfnnw6IsIG2ChSLQ82ww5nB1Vsw0TYZAVXa3R7cYU9JbX",
+	"+DdBpwllxGnrpY5eN5uizhpzW2krKLVWv69B3HMB+LJyYbZZG+J48F7RODa68Y+1Xb5FHn3HfCj49HuG",
+	"/h/+QrhGrvKYX3y75Rhg8AXiuIK27adZY2Or5IqUi1Tq7JKTQpc6vsBSQdYEfLl8CwmF5hA/oySMyuYE",
+	"A1e54lVsJxpal3qnsX6PhEZyt0EdWFkp0R5uWB9EFHpdb4TRqyu1KenTnWgbw2w57sZHiCqGzU966PFB",
+	"L2Ggr2Fub82MmlF7OD1uoke80IfyANIMWTfCCUYP4STWMPCH4R+ejKlH4xpmuZ+CV3jfBwPJXc57XhMm",
+	"W+go0PqZMT3kQQAE0pq0ck84sfJOQbWabQxkjdDm56748cKapXcGmBIkusMO8NyUJLadiYlU4PzO5ape",
+	"GKQ4S3kXooTW8ndlOdGs11wkzhYppUmDvoNcOqMvFjp5beQTky4m8CrpZZXBfChogEJRtJ+NhvU4dKZc",
+	"wsEnQX3ZSb50K1zjG/TfOCd8iPR1OP7azT7iIplRKY9eieN5MgosJ9PIrUBVvKIUOX8XuLPe21HNogz/",
+	"vTuQVEIgL5O3t0lLh5V/rmhMduy6/0U0UzWK0bE3k12Hgist0pi0GaJGixzHwVw33RQeN65t/FPZ3OA4",
+	"LLQ/UPSDY2QzngMKZnvUf2fmFOAA3tPiI9UeoXjw5+N1WBFhXFHbm9azPSyHs1OxYc8czu7KqKLG6OXR",
+	"OujyAlLvr3P0rd/CrefCt2sbm6R8dFlcrEU+G5NJ3F/CFrtTcvOj1LK9eSXbW8lszqhUYyhIvIRlRe5d",
+	"Seg6/pJOuqX2LqK4798JCgjA8CQYjR4Fi03B42k2zClfNFsvFxPjxYCa+XLxOHpb3ENvCf22UH/CP7GC",
+	"XYHVxH4+sd8xbo2/vvO91NJrb3oImw+v5yOqygjekcA3tmML34fT33mRa7P93b48A2LdzP+g+w43jF6t",
+	"KvrgWUF8nngLX58qB97/v0n89k78ac4KE6PN72f2YVeqv59ClfC42lugwGeH72It0J1WeLf2Kqb64dTI",
+	"VJD0lxks9taTvmgIAiUC1NJvkseTEeNZa2tyZyonlfSIGqyqmycPO6VOgcZZs71A/GuFe/bLe182x29N",
+	"fkWVtNPY3pXU25TvQURW3mU2G+NGarn62zLJSe5kl4ACpc0yn0Zfc1FQdSH+9c7sL+LzLx+mZ5/f/8vs",
+	"y7NHZ3Px8NFXZ2fJVw+T+199fl88+PLRwzNxf/HFV7MH6YOHD2YPHzz84tFX888f3p89/OKrv9xBSkeQ",
+	"GVBd7Pfxyf+MzwEn8fmrZ/EbBNbiBFaNKSw/fiTd2oJqEhBS53S5YlKuHJqpn/6HviKnsBo7vP4V78Ia",
+	"m6+appKPT0+vrq6mbpfTJSUxi5tyM1+d6nmofEXrpfLqmYkIYq8/2lFrbaJNNVnF8dvrry/eRNBvagkG",
+	"vp1Nz6b3KYlFJQpYKvz0Of1Ep2dF+35KhbNOkyVwAhR+T3Ol5KZkxNgE+qtGUhXpPTWRpZ5vaHVYqE9L",
+	"Ux4E/4JtyYmJ4h9AQTW839RfcDWnW/VveZUsgZ9NKaCMf7p8cKqfJqcfVMj8x6Fvp66zGvzspuBLd/TU",
+	"7la7msAPnJVux4BwRkBO3g620Vd4uIWrgz1VzrROB0w7cprWSVZ0f+QUx6cSCECuKIa/9dlkZHA+jMTd",
+	"ULPTWXm9R1PhIjyMXZKP4BNpFYK/nyohw/+RFD/MIbpo77bkJGb+j639+NBc40KGh8M2znhzdAvYVKcf",
+	"6B902J0Vcckx6FOckqPM6YcWItTnHiLav9vubguqlKOBKxcLLvgx9Pn0A//fmUhcAzfKkGFQblX1q6a1",
+	"Dezwtv/ztlBuHWiS7980PxboS0IqfFVKGTrY2GHD/56luvEFNNDPeu05TlztwdkZT/+Q/kEMXGlGHOo7",
+	"VSzmhOWQncrpVpEvujM6dgkDL0dIowhPMNy/PRieFewtjpcIX3bQ5NFtYuEZKkyxqhm15Ok/v8VNEPVl",
+	"NhfRGwF966TO8m30Y2Ec3vm6pXh1HwW+L8qrQkOOktIGxBZk3iDqYyYkGanK0g5xoksdXmYc/IsSvKVh",
+	"uqoT5CM/n1SbGSwafqCSbu9Iymx8ApdWlvdn0oYCO3j7VHy780yM34W2HD+Q/3MUnIfnDOaZPQVYeluv",
+	"yaLrhcJQ3PHt3cm/eMS/eMQReQSGjwdPr3O1UYptUakcAXMsmT7EKvoXqXP3n4Co7ovAHOAjqoR7iI1c",
+	"tNmI9bYG2PrB9IqaSZUx1W8wfGDYJ1JtGJI+1+Ra4uynAvLk8dmeWULC3979IYSCJ0mhT3qLFtjnI6nz",
+	"DMhB00dStJ7ySvb5F3/4f4Q/fJuhNTHhfZ1EjUC/cIcrAFEgV2Ctoan/RG4LIzlEq9yGlcBbP59qJY3v",
+	"Ld1u+aH1Z/sxJlebJoWVOr+gkZBt+f2nCX7cyO7fp1dJ1qDFQdVroOSM/c6NSHLayYwysLi/2tK8vS9U",
+	"b9j50Y3U9/4Kb09+o/i+ERcMdey9yH1f1Tsx0EiHiOjPVsXoquyIAxtl3c/vkMtJIFfNnK0G6vHpKUUc",
+	"ruB2OAWS/dDRTrkf3xnC+qBZdoXVobBS8zvksZwyEpPhsXYmtlqmB9Ozk4//F8eIEBLUMAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,23 +8,17 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
-	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
 	. "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
-	"github.com/algorand/go-algorand/data/basics"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
-	"github.com/oapi-codegen/runtime"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Get a list of assets held by an account, inclusive of asset params.
-	// (GET /v2/accounts/{address}/assets)
-	AccountAssetsInformation(ctx echo.Context, address basics.Address, params AccountAssetsInformationParams) error
 	// Returns OK if experimental API is enabled.
 	// (GET /v2/experimental)
 	ExperimentalCheck(ctx echo.Context) error
@@ -38,40 +32,6 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// AccountAssetsInformation converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountAssetsInformation(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "address" -------------
-	var address basics.Address
-
-	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
-	}
-
-	ctx.Set(Api_keyScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AccountAssetsInformationParams
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
-	// ------------- Optional query parameter "next" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountAssetsInformation(ctx, address, params)
-	return err
-}
-
 // ExperimentalCheck converts echo context to params.
 func (w *ServerInterfaceWrapper) ExperimentalCheck(ctx echo.Context) error {
 	var err error
@@ -122,7 +82,6 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
-	router.GET(baseURL+"/v2/accounts/:address/assets", wrapper.AccountAssetsInformation, m...)
 	router.GET(baseURL+"/v2/experimental", wrapper.ExperimentalCheck, m...)
 	router.POST(baseURL+"/v2/transactions/async", wrapper.RawTransactionAsync, m...)
 
@@ -131,231 +90,252 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+R9+3MbN5Pgv4LibpVjH4eyHTv7xVdf7SlxHto4sctSsrdr+RJwpkni0xCYD8BIZHz6",
-	"36/QeAxmBkMOKdlJ6n6yxcGj0Wg0Gv38MMnFuhIcuFaTFx8mFZV0DRok/kWLQoLC/xagcskqzQSfvJic",
-	"ckLzXNRck6qelywnV7CdTaYTZr5WVK8m0wmna5i8CINMJxL+WTMJxeSFljVMJypfwZraabUGafq+O83+",
-	"+3H25fsPz/92O5lO9LYyYygtGV9OppNNthSZ+3FOFcvV7NSNf7vvK62qkuXULCFjRXpRTRPCCuCaLRjI",
-	"oYW1x9u1vjXjbF2vJy8ehyUxrmEJcmBNVXXGC9gMLSr6TJUCPbge83HESvwY97oGM+jOVbQa5FTnq0ow",
-	"rhMrIfiV2M/JJUTddy1iIeSa6m77iPyQ9p5Mnzy+/ZdAik+mzz9PEyMtl0JSXmRh3K/DuOTctrs9oKH/",
-	"2kXA14Iv2LKWoMjNCvQKJNErIBJUJbgCIub/gFwTpsh/nL/+iQhJfgSl6BLe0PyKAM9FAcWMnC0IF5pU",
-	"UlyzAoopKWBB61IrogX2DPTxzxrktsGugyvGJHBDC+8m/1CCT6aTtVpWNL+avO+i6fZ2OinZmiVW9SPd",
-	"GIoivF7PQRKxMAvy4EjQteRDANkRY3h2kmTNuP7iWZcOm1/XdNMH70LWPKcaighALSlXNDctEMqCqaqk",
-	"W0Ttmm7+/njqAFeEliWpgBeML4necDW0FDP3vS2EwyaB6IsVEPOFVHQJEZ5n5GcFSEn4VYsr4IE6yHyL",
-	"nyoJ10zUKnQaWAdOnVhIRAdS1DzFqAh+cGge4FG2730yqLc44u3ub4ot3acu1OdsebGtgCxYae5L8o9a",
-	"6UDAtcJtXwFRFeSG9xbEDGOQr9iSU11LeHHJH5m/SEbONeUFlYX5ZW1/+rEuNTtnS/NTaX96JZYsP2fL",
-	"gR0IsKbOqcJua/uPGS99VPUmeZe8EuKqruIF5fFZMLRy9nKIMuyYw6SRZpCnQW7A/XFjXWzOXg6x1N09",
-	"9CZs5ACQg7irqGl4BVsJBlqaL/CfzQJJiy7k7xMrXpjeulqkUGvI37FrFKhOrfx02ggRb91n8zUXXIO9",
-	"CiMx4wSZ7YsPseQkRQVSMzsoraqsFDktM6WpxpH+VcJi8mLyLyeNoHdiu6uTaPJXptc5djKXsQTD+DJa",
-	"VQeM8cYIjyhqDRx0w4fsUV8ISW5WLF8RvWKKMG43EeUuw2lKuKZczyYHneTbmDu8c0A0W2EvSbsVHQY0",
-	"uBfENpyDQtp3Qu8D1ZIUEeMEMU4oL8iyFPPww2enVdUgF7+fVpVF1ZSwBQGG9zlsmNLqIWKGNocsnufs",
-	"5Yx8F499w8qSCF5uyRzcvQOFGdPybcfHnQBuEItraEZ8oAjutJAzs2seDUYuuw9iRKlyJUpzBe4lI9P4",
-	"e9c2pkDz+6jOf3nqi9E+THco0TukIjXZX5qHG/msQ1R9msIehppOu32Poygzyg5aUmcNgu+brvAXpmGt",
-	"9hJJBFFEaG57qJR06yWoDCWhPgX9rMAST0WXjCO0UyOQc7KmV3Y/BOLdEAKoIGlbMrPi1Q3Tq0bkCqif",
-	"9d4Xf21CTu05MRtOmZGNScmUNsIQbqYiKyhR4KRBsRBT0VFEM4IWdiwiwHwjaWXJ3H2xchzjhIb3l4X1",
-	"jjf5yEs2CXOstmjwjlAdzcz3MtwkJFbh0Ibhq1LkV99TtbqHwz/3Y/WPBU5DVkALkGRF1Spxpjq03Yw2",
-	"hr5NQ6RZMo+mmoUlvhJLdQ9LLMUhXK2qvqZlaabuc7POanHgUQe5LIlpTGDNtHkAM44nYMmugVvWMyPf",
-	"0HxlhAmS07KcNnoJUWUlXENJhCSMc5BToldUN4cfR/YPJTxHCgwf1ECi1TidxoxcrEDCQkh8qEoga4qX",
-	"09o8j6qy3ScwV0XX0JGd8LIUtTYwRi+Xs5d+dXANHHlSGBrBD2vEB388+MzM7T7hzFzYxVEJqGhhPC/r",
-	"osFf4BctoE3r5qrlzRRCFqjoodr8xiTJhbRD2MvfTW7+A1Q2nS11flZJyNwQkl6DVLQ0q+ss6mEg3/s6",
-	"nXtOZkE1jU6mo8L0i85yDuyHQiHIhHbjNf6HlsR8NgKOoaSGehjKKSjThP3AO9ugys5kGhi+pQVZW70Z",
-	"qWh+dRCUXzeTp9nMqJP3jVXVuS10iwg7dLFhhbqvbcLBhvaqfUKszsezo56YspPpRHONQcCFqIhlHx0Q",
-	"LKfA0SxCxOber7WvxCYF01di07vSxAbuZSfMOKOZ/Vdi89JBJuR+zOPYY5BuFsjpGhTebi0ziJmlUVWf",
-	"zoU8TpromSYaBTyhZtRImJp2kIRN6ypzZzOhHrcNOgORoF7aLQR0h09hrIWFc00/AhaUGfU+sNAe6L6x",
-	"INYVK+EeSH+VFOLmVMHnT8n596fPnzz99enzLwxJVlIsJV2T+VaDIp85PR9RelvCw+TDCaWL9OhfPPMG",
-	"kfa4qXGUqGUOa1r1h7KGFvswts2IadfHWhvNuOoA4CiOCOZqs2gnb22/2+nkJczr5TlobR7Bb6RY3Ds3",
-	"7M2Qgg4bvamkESxU2yjlpKWTwjQ5gY2W9KTClsALa3oz62DKvAHX83shqqGNL5pZCuIwWsDeQ3HoNjXT",
-	"bOOtkltZ34fmA6QUMnkFV1JokYsyM3IeEwndxRvXgrgWfruq7u8WWnJDFTFzowGs5sWAikJv+Pj7yw59",
-	"seENbnbeYHa9idW5ecfsSxv5zSukApnpDSdInS3NyUKKNaGkwI4oa3wH2spfbA3nmq6r14vF/ehIBQ6U",
-	"UPGwNSgzE7EtjPSjIBe8UHu1Od4a2EGmm2oMzrrY8rYsPQyVQ9P5lueoRrqPszys/XKmPqK2PI9UYQbG",
-	"Eopli1Y/qsprCFMWigcqAanB1Cv8jBaBl1Bq+q2QF424+50UdXXv7Lw759jlULcYZ3MoTF+vUWZ8WUJL",
-	"Ul8a2GepNf4hC/o6KB3sGhB6JNZXbLnS0fvyjRQf4Q5NzpICFD9Y5VJp+vRVTD+JwjAfXat7ED2bwRqO",
-	"aOg25oN0LmpNKOGiANz8WqWF0gGvHXNQ81pK4DqWc1GfwRSZg6GunNZmtXVFtEjdL03HjOb2hGaIGjXg",
-	"5hBcNWwrO92KXgOhpQRabMkcgBMxN4tuvBxwkVSRysjOTqxzIvFYftsCtpIiB6WgyJw+ey+8vp29f/QO",
-	"5OFqcBVhFqIEWVD5cVZwdb0X+CvYZte0rI14/sMv6uGfZRFaaFru2QJsk9qIrvquv5Q7wLSLiLsQxaRs",
-	"tYX2JBgR2zCdEjQMIfvu2Bvc/i6YPSL4SAi8BokeNR/1aPlJPgJRBvg/8sH6KEuoq8yIgYPqByO5mv3m",
-	"lAsvG+6ZIUxQUqWzfVeKadTSm5ilRlw8dYvgwAPy5CuqNIqBhPEC9bf2KsR5rGxpppgc6FSGUw6+xsyk",
-	"v/iHWH/a3FzvXNUqvMpUXVVCaihSy0Ob9eBcP8EmzCUW0djh6acFqRXsG3kIgdH4Do9OEYB/UB0s1M7m",
-	"3V8ceh0Y8WV7KJZb8DU42gXjuW8VIT52qh2AkalmDyy5MdWht7kQJVBUmSotqspwKJ3VPPQbwuC5bX2q",
-	"f27a9knSmoGspFIIUGhicu0d5DcW6QptXSuqiIPD+yegwsu6yPVhNsc6U4znkO06L/gINq3ig3PUca+r",
-	"paQFZAWUdJvwtrCfif18IGH4sZFAGv2B0JDN0ZqYppHmTHh/0+NmFTiVSgneBL+Q3Jxz84xqSM31Pn7S",
-	"AnDaFN90xPogzIJgJOnAj4fIsvSUGBHv/muhDVk5osPVuFvpjmsZwF6Y9aMgEMfNGkVAd/b/AuXmDgLY",
-	"vc6/BTW08Gbq+1r2gPof7/bWhdm5yjq3TfKKGOTLexjjEA8asEW8oVKznFX4XP0Btvf+eu9OkPSVIAVo",
-	"ykooSPTBvuSruD+xbsjdMY97zY9St/bB7+lbE8vxnllt4K9gi2qTNzaiIdJW3Yc6IjGquXApJwio95o3",
-	"L564CWxorsutEWz1CrbkBiQQVc+t10rfhKZFlcUDpGOmhmd0BvmkOXynh8A5DhUtL+V5aF9bu+G76Dy5",
-	"Wuhwr6xKiDKh/+ye+B4ykhCMchcilTC7zmhZbokOYTOeklpAugsCvTGCPPNAtdCMKyD/JWqSU44v3FpD",
-	"ENKERMkHhWUzgxE3w5zOVbXBEJSwBvuaxy+PHnUX/uiR23OmyAJurMsNx4ZddDx6hKq4N0Lp1uG6B223",
-	"OW5niUsHbZXmknWvti5P2e/k5kYes5NvOoMHA6c5U0o5wjXLvzMD6JzMzZi1xzQyzsEPxx1lvmu7hPXW",
-	"jft+ztZ1SfV9GCrhmpaZuAYpWQF7ObmbmAn+zTUtX4dut9MJbCA3NJpDlmOU4Mix4ML0sYGFZhzGmTnA",
-	"NnBkLEBwZnud2057XtqN3zJbr6FgVEO5JZWEHGyUnJFSVVjqjNiQiXxF+RJfQFLUS+fqbMdBhl8rqwmT",
-	"Ne8Ncagopjc8QxOGSoapodnSR1saIQyoedl27R/2sXZDAyj2Mhp1aUfb07UHJU2m08ngw9/g+7p5+Fu8",
-	"tUNGjzUmtuTDCGkNNCOtZ4hPIyv1kRhvozl8hhg+jpWmGToFZX/iyCm8+TjkF35eV1W5vQchyQ5EJFQS",
-	"FF5psRpQ2a9iQX5kuRSn5VKEO09tlYZ133hju/46cFzfHvMCFrxkHLK14JB40r/Grz/ix9FqR3sND4yI",
-	"AtFBA3YfPi0kdBbQnnwMSd91k5Bkume/a+lU3wp5X1Z2O+DoN8UIy/Vetw435bH2dVqWCZO0VT/0uIia",
-	"BqdwJglVSuQMBcWzQk2d97m1Ylu39g7634TQqHs4wN1xO7bXKAzLKvKhrAgleclQzS+40rLO9SWnqOmL",
-	"lppwFvTKgWG18Ne+SVoPnVATu6EuOUVH0aD/SzoGLSChh/oWwGuHVb1cgtKdB9YC4JK7VoyTmjONc63N",
-	"ccnsealAosfezLZc0y1ZGJrQgvwOUpB5rdtPjnWtNFGalaUzBJtpiFhccqpJCVRp8iPjFxsczvuR+CPL",
-	"Qd8IeRWwMBvPuJbAQTGVpT0dv7NfMajE4WTlAkww1sJ+9h7PTW6IiVl7K2nF//ns31+8O83+m2a/P86+",
-	"/B8n7z88u334qPfj09u///3/tn/6/PbvD//9X1Pb52FPBYM7yM9eujf62Ut8iEVxIl3Y/wwGmTXjWZIo",
-	"Y4eiDi2SzzBfhiO4h229n17BJdcbbgjvmpasMLzo3sine031DrQ9Yh0qa21cR43nEXDgc+gOrIokOFWH",
-	"v34Uea47wU6Hm3jLOzEGjjOqewfQDZyCqztnyq32wXffXJATRwjqARKLGzpKLZB4wbgIxpaXj9mlOLDr",
-	"kl/yl7DA96DgLy55QTU9safppFYgv6Il5TnMloK88EGRL6mml7x3DQ0mkIqCmqMMUilOQdfptVxevqPl",
-	"Ulxevu/5IfRlKzdVzEXdOeuryfyUmZEbRK0zl8Qlk3BDZcoW4lN8uGho7L0TDiuTiNoqsXySGDf+bCyU",
-	"VaW6yR76KKqq0qAoIlXl8hWYbSVKixA4Zpi5i701NPCTcE4lkt74J2+tQJHf1rR6x7h+T7LL+vHjzzEE",
-	"r0lx8JvjgYZutxWMfvgOJqPovndx4VYuR6fyrKLLlM3k8vKdBlohhaDAscaXZlkS7NYKD/SRADhUs4AQ",
-	"i3zAlljIDo7rxeWe214+rVd6UfgJN7UdO32nHYyi4o/ewD2R9bTWq8xwhOSqlDkGfq98ggG6NFeO9yBQ",
-	"bIkPALUStVkykHwF+ZXLbAXrSm+nre7e0cXdxZ7hMIU6IxccuGAGfznlZsC6KqgTZCjfdlPcKBsMgYO+",
-	"hSvYXgjbfTYyO1iUjS5KsaKGji7SbnTXGvKND7Ibo7v5zu/Kx4i6dCQYd+nJ4kWgC99n+GhbAeAejnWK",
-	"KFp5PoYQQWUCEZb4B1BwxELNeHci/dTyGM+Ba3YNGZRsyeZlgk3/Z9+u4WE1VCkhB3bto3rDgIqwBTGv",
-	"o7m9jt2LSVK+BHOpm4tYKFqi0/4saehH6XAFVOo5UL1TX8vjNBMeOhTIbzBoGpUmU7ME2Jj9ZhqVIBxu",
-	"zAMP3962jXMknh3lTmXXBMWRoPruTZD07JhHhEN4Ip+dv+/DnoT3gvNPi6kTQbbf1waHSyluzG4aAIVP",
-	"3YgJXqJ7qlZ0CWOvo5apaGRKjJYFCAfZJ/0k5R2x6Io1PRlj5CJs98zgJckdwHwx7AHNAB0XRz+3NSE6",
-	"q8JrXm49UuclCtTBQdSSDpUtOxtfHgZsmo2B5I2w6gFrYy0++iuq/NEvphFHP1Ja/GNSyezKn3cWed9R",
-	"3c+O56/pLmufWn3OHIjgpofPoudT5/l8eZPpQbnvphMX4pDaO8FRii6ghKXFiW3s6azJz9TspoHj9WKB",
-	"TC9LOfJFyshIMnFzgHmIPSLEaszJ6BFSpyACGy3rODD5ScSHnS8PAZK7/FLUj413V/Q3pIMFrTe+kZJF",
-	"ZW59NmC1yj1LcektGpGn4+KMwxDGp8Rw0mtaGk7qAk+bQXq52vDt08nM5nw7Hg69iUYeNLdGlE4OWqWV",
-	"Z45ZXyx4+2WkXwUHrWEuNpmNjE4+reabuTkTyXgFjNNOHV6bOe+BInOxQZ8ivOGsg/vB0A1D5gGL3EA2",
-	"TCGVY78hsdGCdxgguwX5FDUrJD2nVwtkNyTJHgfMgDg9RHafRSn07gmkjgKzSQPuNDp79SxtaasviTTX",
-	"7TRkhw1hailWM3Q4kzs5gNG+8rSd6+77Jt3hcHI0f1Y/SZK/vlLuLnkZbefK5lo8JC1jlxxaQOzA6puu",
-	"EJtEa9txqY3XCGsplmQYfd/Y1UebghJQE5C15OrsKmWWvrx8pwBlhnPfLdJz4u5Rvn0YecNJWDKloTEu",
-	"eCeXT2/7QXWieWyJxfDqdCUXZn1vhQiChjXHYsfWMj/5CtB1fcGk0hlaZpJLMI2+VahJ+9Y0TQvCbX87",
-	"pqyp52A5GCG6gm1WsLJOk7ID6YeXBqKfws2l6jlelIxbb6M5psJPOugeYJtEeKxj904EvbIIekU/BX7G",
-	"HSzT1MAkDeW1p/+LHLEOL9zFWRK0nCKm/oYOonQHr41i6fuMNhKiI7eL2S6bT+9cFn7svd5YPqJ/SIiw",
-	"IyXXEmVETAcQiuUSCp/pzQWF2qxXLp9eKfiyySVoft+RPnBGbBY/TMK3I3+fc0+HIef0VjkRrIqRhD5+",
-	"zCDkTXQd5h7ESZbAbeaWyeH1Rsok4mLHeGwRaUY/LW/vuc0nXYcvOu7CjU+v3cOw2bg9JdDCPasU+PXt",
-	"PrT97XKomw45HbdSxO4+YDggUhzTKhJgekQzwLlpVbFi0zH82VFnR5DESHGvnwm+gzNkS26wPfhpOxbv",
-	"qdXzwNyO2N4ZO07wmX9iHpnWn9l55JqzQXOXbaCoJVqTWt7C/Xz64aE5cu0//HKuhaRLcBbBzIJ0pyFw",
-	"OYegIUpJr4hm1kG6YIsFxJYwdYwVpwVcz95RjCDsARLsm8vC23InffaJbA9tNSvYj9A0PSUoZcjn4qJv",
-	"j/QPj0i3Fi6baOOOMComEwr8ANvsF1rW5iXEpGp8U52BsH2tH0AT1+sfYIsj73X5NIDt2RVUxb0FpNCU",
-	"dSV8UlGW8AeqVX0B38CtLTxgp07Tu3RPW+NKaQwfjeaGatWTaC/l4x2bxkXGQDpmr87TXifmbEF7W7qE",
-	"vm+LWLFf9omeIPFUDL03jrnkQqaNvd5lQEtP+LjYye10cjd/j9Q96UbcsxNvwtWc3AX0xrT2/5bT14Eb",
-	"QqtKimtaZs5PZkjokOLaCR3Y3LvVfOL3VfpUXHxz+uqNA/92OslLoDILqo7BVWG76i+zKluCY/c1ZNOx",
-	"O92uVYVFmx9SZseeNDeYer2jTevVumn8pqKD6jxrFmlP8b1807l42SXucPWCKnh6NRZp6+jVdu6i15SV",
-	"3vDroR2rZbfLHVddKckn4gHu7CQWef/deazBOIHLy3fXHrONPcU6SoWU+AlfOnWkp3OP16TPakPrezgk",
-	"rvM1ZjJNv7u4y3OKjNE5nNF7lwO/FbJ1UbmoxqTD2scTEM1jwuIxbZS/cFb4nlg4I1aE/G35m+ENjx7F",
-	"B//Royn5rXQfIgDx97n7Hd9Rjx4lDcNJVZ9hWajJ43QND0NcxOBGfFo1BIebceLC6fU6yMhimAwDhVrP",
-	"M4/uG4e9G8kcPgv3SwElmJ9mY1QV8aZbdMfAjDlB50NRicH5eW3LeSoieDcGH6NkDWnh1eMqeFg7e/8I",
-	"8XqNdudMlSxPO/3wuTIsiVuXXtOYYOPRNmQzR80G/Mp5zaLRTTN1lMmzs5Bo1iTCVTITcIPfuXAsoObs",
-	"nzVEZX3xJu5czv4phKP2BOy0ftEN3K0aPDmm4O/dTYReq7ZLYbTT5PoymAE9IlJ1pg6Md4hn7DH/HbEK",
-	"jqL89YmBbSvnOryXsna+83YXgXZmYM8+ncV1+IHkymHazXw5ZqeZyhZS/A5p2QGNhInUHd66zVAB/zvw",
-	"lI9ql5EFz4GmYHUz+z4CGa9bGCKVO+sS/KJD1bxjrvA0nzhsow9UGkT7Paw2UOn04m4Thh6qseNJO5Bm",
-	"gJnhgY3cwrGWj3d3o9yeUJvXohV5lj7ncaDoiR2/OecO5l5wbUlv5jRV6Mi8Fw1M0fa3HPO0IL6z3yAV",
-	"UjPY2UkUyxDaMpvsrwLZWI/6qZKPfPvZaUe/+ppHHlJc/LybWl+VUonEMDW/oRz9CLGf5YCutwLrh2F6",
-	"3QiJCT5V2oewgJytk8rwy8t3Rd73/CrYktmS4rUCQhfa5Xl0A9mi8paKXDXvkIvEoeZsQR5PmzPrd6Ng",
-	"10yxeQnY4oltMacKL+jgExG6mOUB1yuFzZ+OaL6qeSGh0CtlEasECe9zFD2DJ+wc9A0AJ4+x3ZMvyWfo",
-	"MKzYNTxMXzBOWJu8ePLldFflbMQ4FonfxeQL5PI+kCFN2ehVbccwbNWNmo5MWEiA32H4PtlxvmzXMacL",
-	"W7oraP/pWlNODUJSMK33wGT74v6iK0cHL9xaZ0BpKbaE6fT8oKnhWAPR5IYhWjBILtZrptfOU1SJtaGw",
-	"pgy5ndQPh/X1fBk0D5f/iC7YVeKN/wc8t+h6IMIRvep/Qnt7jNYpoTZja8ma+AtfoZac+czUWBculIOz",
-	"uDFzmaWjvIrhGAtSScY1ao1qvcj+Zp7vkuaGIc6GwM3mXzxL1FdrlyDihwH+yfEuQYG8TqNeDpC9l3Jc",
-	"X/IZFzxbG45SPGxSOkSnctBXPO3fO+R2PDD0naVrM242SIB1iwBpxM3vRIp8x4B3JM6wnoMo9OCVfXJa",
-	"rWWaYGhtdujnt6+cJLIWMlXpomEATiqRoCWDa4wvTW+SGfOOeyHLUbtwF+j/WO82L5ZGops/3cnHQmRV",
-	"TrzTQlolI+n/8mOTHx+N2zZut6O9FDKhp3Uax0/slnqYvrBrQ7fugPhtAHOj0Yaj9LEyEO5h4zlCnz/C",
-	"36sLkt3zlqr0yW9Emnc8yvqPHiHQjx5Nnaj829P2Z8veHz0a7zKb1heaXxOoOe6u6WavNH1TW/2VSGjv",
-	"fBXP4DfmUpUkNKzJu8xcqXM3xpS0SyV+ernjfuIVD3ZDTh8gjxr83MXNH8xfcTObCJhh/tCuHpsknyJ8",
-	"j2IoKPlKbMYSUefa8vT0J0DRAEpGagVxJb3quElPib1uPhHZmlHnUArzUo0LYI32WvkL7YJBzXTHXtSs",
-	"LH5prNCdm0lSnq+STuVz0/FX+wyIGkQajHxFOYcy2du+ln/1r+rEu/8fYmDYNePpT91CzBb2DqQNWG0g",
-	"/JR+fIMrpkszQYyidkKukOKkXIqC4DxN5ZKGNfYrmqcqySZi/HHYda2dVzImT3AFRRasRDfatD0cW2aS",
-	"6gGuimX/fYkrMw5W4VdWLWFHB0koW+O1rei6KgEP4TVIusSugkOnO2Zsw5GjsiREVeYTtsTkL4LoWnIi",
-	"FotoGcA1k1Bup6SiStlBHptlwQbnnrx48vjx43FGRsTXiLVbvPqFv24W9+QEm9gvrvKXLZhwEPjHQH/b",
-	"UN0hm98nLld+9Z81KJ1isfjBBmSjhdjc67b0aigTPCPfYX4yQ+itEgGoFPUZlts5QeuqFLSYYlLoi29O",
-	"XxE7q+0jAVGHpV+XqAFsH5GkkWd8jlSff20gd9X4cXanzjGrVjoLRVlTmRRNi6aWLOt4P6FuMMbOjLy0",
-	"atng2GMnIZhaXK6hiGrAWjUAEof5j9Y0X6G+czbZqVIeqAY0voSx54CNuSiKew0Fs5CDm2W4Ksa2iPGU",
-	"CL0CecMUYN4JuIZ2wsaQ7dQp5H0Cx/ZqZc25JZzZAdJrKI916C544Kzo6/0rkpB19uHOtr8mkwcWOT+0",
-	"2PM59krH7XQqR3f8HmzJjI0vujEjPzpjR0654CzHYhMpERxTMY4zq46oy5G2d6qJO8uJY5isVx0C1B0W",
-	"BytYe5bpENd3aoi+mv22hGP/1LBxRQCXoJXjgVBMffl4Z6BjXIErgGboK+aoQiZcv5JhMcGF5B5d0qcT",
-	"zKY2oGv91nz7yenmMWfMFeOoc3NIdS9Ba2ArFUM7OydMk6UA5VbbjgtT70yf2cWGIwjvZ6/EkuXnbIlj",
-	"WFdEgxTrBdwf6tT7BDsfXNP2a9PW1S4IP7dc6uykft3vkyxEhf1P1VwfRH/K98s70kTIDePHo+0gxp2u",
-	"/ngvGzKEa/T8gwrv8x7ZhPL17VG+MU9WS2/YgtjI3WTaYMYTYLxi3Bt803mw8uRdghuDp3mgn8ol1fbR",
-	"MYrjXQAtB8JhMKjeegzcdahuJQaDElyjn2N4G5vK+wNsJTRoXheUb4k/FIa6I6Hka1oGZ/hEHX2Uzpww",
-	"Zp2FO5X1U2zFsPXMh+a20LU3EDR0x2ooh95TQ9lG53WxBJ3RokjlnfsKvxL86gMKYQN5HYqAhTjTdrr2",
-	"PrW5iXLBVb3eMZdvcMfpCqaoUrCelwnX25fhIxRhhzER1XyL/6YqYA3vjHN6Pzj623u4F4fVKOhHs6ek",
-	"Z0PTmWLLbDwm8E65OzqaqY8j9Kb/vVK6D/z+U8R1d7hcvEcp/vaNuTjiNN09H397tYQs2uhPL/C7zwcW",
-	"Mrm2uRJeZb06b+iRgZuX2LIO8L5hEvBrWg5kXIitNvZ+tZaMobwL+WBaEapd9jpNScMTxqgwhvN/WQ/s",
-	"jmWob94c8rG2LtYf03ji8LET6cOWxh9adkXr9dYwlEF74nEmv4YIDrX5uVIMfX0pLUuRj+YMbphT02k4",
-	"Va9Yr13m+4RX3vVaFPFZiL25ANKMzTosJ0Ir8GGb/IZPq+QXeZMeraUfCUQzNmsZotEtYWoDMz14Hhg7",
-	"dTxRpLJ1mCXfshKLQ/3H+eufJsMbGe1Af0td6uykCntoY0KkWpc8lqKFjx08QPAyrf9WAyp1zA2VPg2u",
-	"OnHyw7dWQTgGJJsn6ZDWr8YO3iOApbBVoVJ1M/rZaSbNdnjkR9TQbK/lKDF1pKiiW20p8faxSs+mCQmF",
-	"SEcVJm3JSGOKO6XqCLmXgtfA2ovG5aOzxZV6dZl6DPTlGOGwh4/b6eSsOEh8StWimthRUgz2FVuu9Fel",
-	"yK++B1qAtPVEUs9JW01kDeYZqlaswvdPJRRr6gGXZjCXyHuFw83GhuZcrMBlhfFJAnpjeQfqa8g11odu",
-	"3EAlwHg/hyq9RAOBNyhikz/AFUQCFFDp1U5hyTp3V3rVlA0FF3nGFJmDM11cA58SNoNZN1itaJJCkRLo",
-	"withpRB6RF3dELaEaIyBTtFXr0bzbjGwl/MtSmloS+nOxhdhOQ0xATbQ8oaqJnNUJ43C6HDtxQJyTHi/",
-	"M/3ef66AR/nYpl51h7Asomx8LIQLYsmGe9VoN7DuSoS3E9SoJtXHhHQoIcYVbB8o0qKhZEXgEGF7TAZ4",
-	"RI614/qiAkOmDecYyVSgJ0SQ94N3CfibGkvHFAGIslMeCYancXM9NRkrj4PGSzRHgGG6zu5UtL9Jh4eC",
-	"6VB2v3519eGX8kssZq+cUykN6eZjfRI565djvnHp6jHRYrAW+sT1oPxvPkGrnaVkV65CDSLM2mZvqCx8",
-	"i3tJk2fvTZYGehFmZk1gVN/L51C/HBuhmJfCCEDZUGBoO1IpuPA+UNbXuklahlAvQEoogk2wFAoyLXyY",
-	"1QHJP1345A7sWS/zo/DW8eg/IGTYrmiwhsLbppAEloOkWDOBOufzGCtEwpoa6GVU3CGtBt23Q1/b7z6n",
-	"iC/vt1u9OoT3cC72V8j2oXdM9TAfn64FccLBwdyrlYjkCM0s4xxk5o243dIOvJ0mE/MqF3VuRZX4bAbt",
-	"9ei0Yzu4WVKpmfdX2XlCRVk5rmB7YtU+vuq43/EYaCtDWtCjhNIdorhXXbVKwb28F/D+2PSdlRBlNmAZ",
-	"POvXo+gehiuWXwEmZg2RKUYKftA+NmYS8hkapILPyM1q66stVBVwKB7OCDnlNjrQu4+0K5B2JucP9K75",
-	"NzhrUdsKM04DPbvk6TArrPQi78j9/DA7eN4Qb1Jg+OUd57eDHDG73vAhH7kbLAnTrhM8G6ve6Pt3dESo",
-	"iPwsFCkB6twagr9GlpB4RxHMzhKlEUL/AEqcAZmoUqS88I/JIGOGSmMqngwB0sBHPFcbKNzgSQQ4J7s9",
-	"WVndZ593VCyIhMY349gErC6nqWXiakg10p05zNLmjAshIZ4R/UxtouYQ2YZ5jvE/c6Ylldtj0qS2UZVS",
-	"Qw1iea+3ZHCUbBbSOEv2cViW4iZDtpaF6kopdYBpp9rXtq9T2vQzR30OkdslVU5E3JIVLUgupIQ87pEO",
-	"8bZQrYWErBTohZly7Fho80hYY1wnJ6VYElHlogBbCC1NQUNz1ZxTlL0gcmVLosDSDqYMsH0iOh45pbl9",
-	"rXk2Q3ltb6ENv/kXpo9NX9Gkv7OLzqyLwEB8ASiX7s5hyDbuw4uEYzMydZWyaRF5wTZINyBTR35BtKxh",
-	"SlyLbhV+d/CpBLJmSllQAi3dsLLE7BFsEzk0BH+gNGoHZOcz9IO+Zujw1s4kYkXqytyOIf1KzAPO44xs",
-	"RK+kqJerqD5AgNM/3WXtHvbxKD+rGn0SMUTUTPGMrIXS7llsR2qW3LiAfpYLrqUoy7Yiz8r5S2f0/ZFu",
-	"TvNcvxLiak7zq4f4COdCh5UWU59Soeu728wkOzkYx70U9IZnSB5qf5p12w69Wh09j+adHe7XMzzs0+RH",
-	"YL7fz1z32zVO+wvrrqvNZ9NvoVNOqBZrlqeP21/L+3XQZzXFvZKZFm0VYpuFBpshH4jvseDOhNyzj2bg",
-	"NFlG9ZQ4HuHcOpATmf+iGN8dlyzA8aCBO7TPd5yAleWDYmAHAITUJkLQtbSli2MhLTAcsbSJU9AppQvo",
-	"yAsHff/uBpsZ4d6B0nAnoHreyAHAz6wGY2ozYlrP5rnY+O8Pm5SZRwF/u5vKW8xjyKnyvCEtad0qfSKr",
-	"AY6QLkCw0wPxApNgzMf6IYZS9CMv/wiAYc/EFgyj/BMPBWNBWQlFlqpSfBZ0YNPoue5iLKPRfT1Hy8lz",
-	"WvtKwGbsWoJLrGSlf9k2J1bUkJIIzfsacV7ABmyM1u8gha3jO43MWVDaMr8djYKoshKuoeWw6bI91SiF",
-	"smvwfVXoTAqACi2+XUVbyhMxrhLY0b64tWeRL9sY7CbVMRaxdqfIHl1LUjO04Zk9JmrsUTIQXbOipi38",
-	"qUNFjrYu0RzlBKp6z4fMPzHHTvOzHeGtH+DU90+JMh4T78fxoYNZUBp1uxjQXs/kWg2dep52TI5TmQVD",
-	"Ec5WBLu2JfGGb6iK3vBhrWaf5JuX2Mh9YoJHiP1mAzlKNe4pBIV7DA1YTlwOJKR2DlDYB4PpktDmr4AT",
-	"LqKaxzdUhVdMk9XV/2AnxkaMu4f2ETb6xn/47jtLcDCiOskW0yVKA1nfTcf/h5zEnQdxcLwUjShwobw7",
-	"VGOeut2zAxuIuiwIN/tpZH+sEexuMcfFp2Re+4HKUtzYIsbxE/UleHuupT5vYnJiOQvXsveTnrqEw10t",
-	"CIsiRNZ0S4TEf8yD9J81Ldlii3zGgu+7EbWihoScAdl6UTi/azPxbvFq6gHzihjhp7LrZmPHjIbbmlEi",
-	"oM1F7su2CbKmVxBvAzqIWP6Za8M4VT1HpYa5sjvb2ceCW7xPz7SmRawEwESz2xZ38AnPTe//2YStxlP5",
-	"/I9VSXNfstoVn2vzGaxq74lLr2C9O8y5z9c8CYRK+Q3RSp8mozhCm3og60rF/AwVx2qB3SsB3qsLdqdl",
-	"jFQKd2oc7QgQH7WU+96F+4nh7C0pLvW7b3Fx5eNPszvJDNFDyxgD/p9oV1ruFb3ItnQF9Xg9tlj6J9iF",
-	"ViKeBKxWDT4Xm0zCQu1zpLF68LnYNACroLtlPJdAlfU7Onvtnq1NAmTGzTPaeu0Gs2oYpYAF4w2rZbyq",
-	"deIVhHmQ+TZCWGxNQLQO2OaGZAwjil7T8vU1SMmKoY0zp8eWBo6L9HgLiuubUICEG7k/AFPNCxDjqRv9",
-	"fNzMXP+2wKD1nVWa8oLKIm7OOMlBGqmB3NCtOt5UFawO+4xVNJKF2tlCIrMVkrYFpNw6a/MdDUkBQHqP",
-	"FqURliB00k5YgaxiSIsBw08fhr+EJWhNN1kplhj1O3AgXJ5rNB3aB6TgqES30t24dft5FPsddk+DpUgc",
-	"I9ICZx0zxe5z/xq3Eh+hP3Omd558q+HshmFbT2d7MD1S+bIJz7DE0j+Pqch5l5gpjp73oqpPU+JpD6JN",
-	"TLpE97TqA7uI/hUu7UKsQh9frLLtwpGKz7d6hQz1DWpHAAaoJq6A5s5DrK+I6ykqLFKmLrvBgXo6q933",
-	"99IAeKhIUe6st6cNDjpmnEMqfO7OZ5BVosryMb6ttlpR4YwMDtI2jAP0EZkQBtYd/G5UqN/VyonWKuR1",
-	"aJHTwUJi+2xlVb5LZTCkZBrg6G0DhlggL8MjbFVrGGsVVDFT/zj3xu62Ei0wCUKJhLyWqGS+odv9hR8H",
-	"ss+ff3/6/MnTX58+/4KYBqRgS1BNTYNO4cTGNZHxrtbo0zoj9pan05vgs4VYxHnrpQ97C5vizprltqpJ",
-	"RtwrG3mIdjpxAaSCc/sl8o7aKxynCYv4c21XapH3vmMpFHz8PZOiLNM1ZYJclTC/pHYrMsCYF0gFUjGl",
-	"DSNs20+Zbpyy1QqVi5g1/NrmhhI8B699dlTA9IAvV2ohQz69yM8wF4OzORHYVKXjVdZOtGtd7p1m9Xso",
-	"NKK7zRxIJSon2rMFSUGEMVuyhqBXd2pT1KdHbrqB2VqH3RQhOuf3NOmdcvcSFguym9u3S3HrNKc3m5gQ",
-	"L/yhPII0h6wbw3lGjuEkjWHgT8M/EolT7o1rhOV+DF6RfB/siAo/7XlNhKQho0DrJ8hIkAcCMBAP3Qpa",
-	"jYLsotzk0toY0Brhzc9d8ePHxiy9NzIFIfEd9oAXxzI37UIwhQPnD07s/WNASrSU90OU0Fr+vvBoz3rD",
-	"RRJtkVOaaA3KsiXRFwujgHj1dYgzH3iV9MLRpRCamJdpWSbC2K0eB89UTDjmSSCvafnpuca3TCp9iviA",
-	"4u1w4FYcthwj2aJS3XtCzld0FFhRiPIngYq/wdj6/wSzs8nb0c3iDP+9OxBVQrS03t6LYAEHTm5wTOvY",
-	"9eQLMnflfioJOVNdh4IbL9KEeFuQbOH8a2Gju7G/dy4T9IvQdzgOC+8PRH6KjGzBc8DB3Bz1P5g5DXCA",
-	"5GlJkWqPUBL4S/G6uKj6nmvnjqVhjkvlFCVuPDCVU79c/Njl4Trw8qoV9Nc5+tZv4TZx4TdrG5urbHSF",
-	"mcvLd3o+JqFYuhqM6Y45zu6lLMzdi8J8kgRnFpVuDAdJkrAakXtf9pqOv2SUp6G9i0bcHyggv7LoN6Ph",
-	"o2BRczteKICKseKerYvFNHgxCG66vSCX/BFRK+rfFu7Pp8+/mEwnwOu1WXzzfTKduK/vUy+1YpOMK20S",
-	"6fR8RF01gQeKVHQ7tobccN6cJHKbNEGfXp5Rms3TD7rvzYbhq9VFH5xx5PPIW+z16ZLn/P+b/efgDGLh",
-	"rFhibBIDhX3YlyPol6GE+Dbp+0Cdjw7frVm51z2uVYLldjpZ2vRkWJfkV1el7tPuuYdgIFOgW/pdEoBZ",
-	"xCTW2po8mipK5zaiFIvrlqiNgTHXeS2Z3p4b/HuFO/v1KpUG6ruQmMll+wq2dyf1anEF3HuXNWmcauXl",
-	"6u8ELVHutC4B3EibopyRb2xtEHch/v3B/N/g8789Kx5//uTf5n97/PxxDs+ef/n4Mf3yGX3y5edP4Onf",
-	"nj97DE8WX3w5f1o8ffZ0/uzpsy+ef5l//uzJ/NkXX/7bA0PpBmQLqK/582Lyv7PTcimy0zdn2YUBtsEJ",
-	"rdgPYPYGdWsLTE2ISM3xcoU1ZeXkhf/pf/krcpaLdTO8/3XiKkFOVlpX6sXJyc3NzSzucrLE7CeZFnW+",
-	"OvHzYBbL1kvlzVmICLJef7ijjbUJNzVk9jPf3n5zfkFO35zNGoKZvJg8nj2ePcFMihVwWrHJi8nn+BOe",
-	"nhXu+wnmzz5RrgzPSQgavZ32vlWVLdJjPi1DAlDz1wpoifzR/LEGLVnuP0mgxdb9X93Q5RLkDGPF7E/X",
-	"T0/8q+Pkg8soc7vr20nsh3byoZWWp9jTM3hSJX0YXglxhS40/h30QHX8wgx6wzacFQb9tiU6PKmzhhEi",
-	"ir2PyuTFu5Su1nlsV/W8ZLkRq2eegM3uRPQVsi01/AM18xPLP9FgHrih4XCPsy/ff3j+t9uki3bfW6tx",
-	"c9z5tbuGH53vQXOJudgBjFTFSKqwon/WILfNktAxaBIvYKTYm/w1aQE2r9bKVWpycM3Iz879Cr9axhWc",
-	"3F0IbCXhmolahU4DSzBDpFYQ3q3vsYgxejMjzT19/NizF/dIj2j3xB2JeEvbBtGeM+MhaV5iZ8PUC8ss",
-	"JkN89I/Fz8om5TPYZJzaQCGMIFjTK2sKRh9hIl2WAIdRF3aASA4hcW5b/A3yESsw3i3DmQUikXm1z60H",
-	"OIAPHIgV+SWzZgrnrrmC0uYl4k3mktvp5NmBhLJTod7KT54A/0daGpCh8Om8LARPPh0EZ9z6t5trz17P",
-	"t9PJ80+JgzNueCctCba0FzJGtCcOA7/i4ob7lkaWqtdrKrcoKekxe+xy06Hvg29nj4S92Kk53u8m9lrA",
-	"EmoVSLYGjpWLb/ddbycfXJa1PZdhbNQ7cdEZUYeRl+yuZidzrBY7timoqPHwUvCNrE4+4Akd/P3EPTTT",
-	"H1H5b6XEE/96HmhpM2ClP7ZQ+EFvzEJ2D2faROPlVOerujr5gP9BgS9aka0+caI3/ASdJU8+tBDhPvcQ",
-	"0f696R63wKTpHjixWCiUh3Z9Pvlg/40mahFmI1S1BaRvokZfryC/mqSvxU5pnqgXsfIwnZdQWOb0bEQH",
-	"LnTc6agD/RZlGEVe/0DYgkB3Cqb8DAecW5uq+gRr128bXPqftzxP/tjf5lZG3oGfT/xzLCVat1t+aP3Z",
-	"PnJqVetC3ESzoAnDWu36kJmPter+fXJDmc4WQrqUrnShQfY7a6DliSsb1vm1qcXR+4IFRqIf45jc5K8n",
-	"1KF6UgmVINu39CZSX55iYyshgNJfCXzRDN1Om2zOOFJQfEM1+gv7sW/o6N1LWP15qxuTcT8hGWZFkoIW",
-	"OVVGoiVNkYD2Y+E2eew+tbTxFS2ITyaVkUb2OHWv5NbS/hySSJLdvIRrKA3FECHJPt7zB8syzx9//umm",
-	"Pwd5zXIgF7CuhKSSlVvyMw/hhkez4m+RvCV1OuFA8tabXNKbdgSjTOfQaVev9NmWgOgNWVFelC7riKix",
-	"kq+hTXQSEZGbornCfDHXSkgEwOYMhsI6bqkZOQ9ubegkVvsXVGHJBq2vmKXfTkLR5c26PYy4SswzxvCD",
-	"JfDMcaRsLoqtK184kfRGb2wmkR7bs3LmAE/sSYGpr07QGWjk41z850ZPGusdUSESNI7v3pu3sgJ57XUl",
-	"jRrtxckJhk2uhNIn+NRvq9jij+8D5nyR+Ekl2TVWnUKkCcnMC7bMnB6qqfg6eTp7PLn9fwEAAP//rwkF",
-	"2pUOAQA=",
+	"H4sIAAAAAAACA+19a5PbRpLgX0H0boQsLcFuvTxjXUzstSXZ1lqyFGrZc3uWzgaJIokRCGBQYD+s7f++",
+	"+agXgCoQZFNtO26/2GqiHllZWVlZ+fx0NC/XVVmIopFHTz4dVUmdrEUjavorSdNaSPpnKuS8zqomK4uj",
+	"J0enRZTM5+WmaKJqM8uzefRRXE2PJkcZfq2SZgX/LmAk+EsPMjmqxT83WS3SoydNvRGTIzlfiXXC0zYw",
+	"J/b9+TT+vyfxVx8+Pf7rNXRpriocQzZ1Vizh78t4Wcbqx1kis7mcnqrxr7d9TaoKIE1wCXGW+hdlm0RZ",
+	"CkjJFpmoQwtrjze0vnVWZOvN+ujJiVlSVjRiKerAmqrqRZGKy9CinM+JlKIJrgc/jliJHuOga8BBB1fR",
+	"agCInK+qEob0rCSirxF/9i7B6T60iEVZr5Om294hP6K9+5P7J9f/Ykjx/uTxQz8xJvmyrJMijc24T824",
+	"0Rm3u96hof7aRcDTslhkyw1QcnSxEs1K1BH8J4K/4exKEZWzf4g5bLSM/uPs9Q9RWUevgOiTpXiTzD9G",
+	"opiXqUin0YtFVJRwZOvyHGginUSpWCSbvJFRU1JPQx//3Ij6ymJXweViUhRICz8f/UMChJOjtVxWMNfR",
+	"hy6armFZebbOPKt6lVwiRUUw0gxWVC5wQRqcWjSbuggBxCO68AyS5AZ+/vJRlw7tr+vksg/eu3pTAJmI",
+	"1AGwgU2UyRxbEJRpJqs8uSLUwiB/O5kowGWU5HlUiSIFJETNZSFDS8G5D7aQQlx6EP0OaAW/RBWQhIPn",
+	"afQjEE+jvzblR1EY6ohmV/SpqsV5Vm6k6RRYB03tWYhDBzXcGD5GFdEHheYAj+K+h2RQb2nE6+FvMluq",
+	"T12oz7LlO/gQLbIc78voHxvZGALeSNp2QJ+sxBx5bxrhMIh8GLJIgEbEk/fFPfwrioEFAHNI6hR/WfNP",
+	"r2CgDCbBn3L+6WW5zObwU2AHDKy+cyqp25r/h+P5j2pz6b1LXpblx03lLmjungWklRfPQpTBY4ZJw88g",
+	"T43cQPujxnp3+eJZiKUO9wAo9EYGgAzirkqwIYg4tUBok/mC/ne5INJKFvVvRyxeYO+mWvhQi+Sv2DUJ",
+	"VKcsP51aIeKt+oxf5yVQLl+FjphxTMwWfnMkp7qsRN1kPCi0jfNynuSxbIBz4U//WosFwPEvx1bQO+bu",
+	"8tiZ/CX2OqNOeBnXAhlfDOPtMMYbFB5J1AocdORDfNRhz+Amy+BOb1Zwa2UFbyLJXchpcnGeFM30aKeT",
+	"fO1yh58VEHYr+JLkregwoOBeRNxwBhcv0r4Seu/IlqRIGI8I4xEQZLTMy5n54QsY1SKXvsMvjKpJlC0i",
+	"kdF9Li4z2ci7hJnEHjJ3Hjhh0bfu2BcZ3DFlkV9FM6HuHeAzMCbzbcXHlQCOiKU12BFhHbTTJTBdQIpG",
+	"A8plhyBGkipXZY5X4FYywsbfqbYuBeLvozr/6anPRXuY7kiiV0glauJf7MMt+qJDVH2aoh5ITafdvvtR",
+	"FI4yQEvyhUXwoemKfskasZZbicSByCE0tT1JXQOTVxJUTJJQn4JAWmLiATkqKwjaCQrkBch+H3k/SsI7",
+	"EoKQRtJmMmPx6gJ2xopcBvXT3vviz03Ivj2PcMOTDGXjKAfCRGGINlNGK5GTwJkYxYJLRd9B47K+OgTt",
+	"hDQaiFNN1uXCPXTenUnW+Kk/zPv3P6Nc8v79B9juBhi1fTq8yuZ1eQofcZ/cCY7su09L8r39MlPGSD/l",
+	"ponV0yKuxQXIjZ4VacFTnVHqPQjHJFJj82FXTxc1/nQklFtJ1pkwukgkXJ5wLNIIhMtkV0JFYQsEaend",
+	"BmBiuAspnIElnwhu3NldYFsWIShqv14s8qwQIG1ngAB8/yECk0ZzunKe0ZtQrwHOmZoDXtg4QPS6oAFG",
+	"j7BBrgKYAF7QaOgcsKuyzHng6IcSb7kmm2fwNsLN2QHIQl0JiR4bWEdROn8LD6F3WIFV5Sn630qVE/Nu",
+	"U1u1Ax/pnHrLPXCRIAQlxZzeU5ZnAAnBeqoEH2I4rctD3tRluTgEB1GH1kviSgvCGhfcILWdGtpazMs6",
+	"9TAYc7RmV41oaaT+3xf//gQ1UUn820n81b8df/j06Pruvd6PD67/9rf/av/08Ppvd//9X73c66Bc8I/O",
+	"kircef9aZTbLUYjQiy1KaAPyD0+XwE29qMs169rKsungREZrUX/M4XqvMziy5UWBKqH+fk8iuIdFjvcb",
+	"/YPeyVpk2UV2IRp+usry1Ce5dP9GiEOceHgtt0+RW6+NIcwr2RSaAJ9blPXN5B17K3fZ3QCXYxpTOJ/s",
+	"LjO1uJOf01ne4TI8QE0G6KD5XXa3F6cbQYIDazDgX9RJxbCrL6z6gqOdGJU1w3pD5cdIvYQXZtfSY0VV",
+	"gmrv9+/WN6oXErbRtGH4Oi/nH79L5OoAN9ZMj9U/XzQNCN9JCpLBCppslwHsaGPIGxsSyUYzZ6qpWeLL",
+	"cikPsMS83OUhWFVPkzzHqftss7NaGnjUOYZ3MzaOxDprUPRSF9kyO4dHH0sj0fMEXmqwrmgO80+sKaes",
+	"Yr4hQB7LikLUE5bmDB+gkbVumc6RFPh0bETkrEaZgaYRsE1Yf1kTa4T/rhN6z69Ro1zl7T7mPSrhIdpR",
+	"NxF7AYaHMDrKXvigVgdA8w1uhibwzRqlvhD14FOcW32imYuSF5fUgmxTWTHPN6nFn+EXLaCxtdVOFHYK",
+	"4JBkG2NROKsBhTUPwfoSNTn+Q8AgpjNT5xdVLWI1RJ2ci1rCCw6vlfai7hryPdTp3HIy06RJnJOpqNCv",
+	"BGfOQf1IjwYz9Ud/Tf+AxeFn1AkhJVnqyUi1Q2ogsx+k5kBU8UzYAPkW7O+aTY0RSr47QfnUTu5nM6NO",
+	"3nO2bqotVIswO/TuMkvlobaJBgvtVfuEyJaQ15N3BpmOM9cYBLwrKyVgdkBgTkGjMULKy4NfazCmDyb4",
+	"uXellZfiIDuB44xm9jDrMwVZWf/p9X04DuExuhDEAhN44zcTcziv2pwxYYMvvYqbbC1uJhgz4sdQJO4+",
+	"mtWkllZdWWziuD6czsp6P1Gr5+piHTqiBEd1JM1Jh4Ko6aaKFePyuFtwg85AkTFXDktI3eF9GGth4axJ",
+	"PgMWJI56CCy0Bzo0FuDIZrk4AF9YeSVcoGjx8EF09t3p4/sPfnnw+EskSei4hHMY4dNWRl8ouzGs7CoX",
+	"d70Hk0Qv/+hfPtIONu1xfePIclPPAfqqPxQ77vArl5tF2K6PtTaaadUGwFHXhcB7n9EeveV+0OiZmG2W",
+	"Z6JBvaKE5+ji4FdFbwYfdNToDSByoa1LhvCUKHmcYpNj4KZ1clxRS3iOsysXriOTaFNYzw5CVKGNT+0s",
+	"aaQwmoqth2LXbbLTXLlbVV/Vm0NY0kRdw6Xok0+gXVPOyzxGITgrPXfjG9UiUi30dlXd3xla0vXj3KSX",
+	"huslcAWip9Toy52HfndZWNwMCla8Xs/q1Lxj9qWNfPtEg6XFMEhE1NmyxJEKMYlS6kiC2LeiYeEU7mRg",
+	"/uvq9WJxGJt7SQN5RAiYSeJMEbdA0VAKmITVqKN8zjrIVFONwVkXW9o3qglDpdB0dlXMSRo5xFkOS1fK",
+	"dSySMJ1jWkUY4YAvRX1bJtQQphiKO9IDKWLqJZwqOJBVKYGgD4CqSo81+ii6EGw9h3b4UZyQuQr3AFG3",
+	"RPTASOjUnORKFIbLYP5RMDIIV+Ru80zkTfJNWb+zD6NvAWnVwe+27pxj9zZRO6scelLsq9014DtpuO2b",
+	"bomwT31r/F0W9NSop3gNBD2d3JfZctU4moj9jW+DMPpm8QFKH1gNmWOfvjLyB7i9z8hCeQA53A7W1uS7",
+	"lwI8LTb4LEOLk7JM+yX0gEs8Hor5pq5R/+YI/aT5gpt4JpC65skGV4uOm6XvsrUd42TOxzMm1ARMg9aZ",
+	"gVvxdKvkHN6YOT5GUc0IL9Fyhou2LsS0yI6FW70Pxl4+LWABTXMQ2NE9TNmDtsFr7EbGnhdCHq2GVmFm",
+	"AXk8WiT151nBx/OtwH8UV/F5km/wrfL9T+gj+MdYBHm4bNmCrheM2Yiuore/lBvANETEXYhcUmbtCZ8E",
+	"fG8g08lFI0LIvjn2gtvfBbNHBJ8JgSASk7v6Zz1aepLPQJQG/s98sD7LEjZVjDJxUBeDYjzud5EUpRaU",
+	"t8xgJsgT2cTbrhRs1FIi4VIdLu67RWjggHD9Er6RTNxyVFLzsKCNU+zq90VTBp+mOOlP+lXan3aO13sh",
+	"4XbWT1S5qaqyhoepb3mkIA7O9QN81XPB1tuxzTsY2MhGim0jhxDojK/wqLQi9AdQpHb/VArm/uLIpRfF",
+	"l6tdsdyCz+JoCMYz3cpBvBuxFoARjUmmJ5Ebeqy16G1WlrlICvb6K6sKOVQTbwrTL4TBM2592vxo2/ZJ",
+	"UvnOkaSSlkKSMVK1V5BfMNIlWUVXCeoLaWRtDCDtH/uL9GHGYx2DSD8X8dB5IY0AtnIPzl7HfVMtaxBv",
+	"YxDK4QXWN23w54g/70gYemwiEKtMKRsRz8ju7KcReya0U+B+s5Y0lfQJ3hF9AQ4G5xyfUZbUVO/9J4X/",
+	"4OA+vqmI9Y6ZhcDw0oEej5DF9OQZke5+aELebkx0tBp1K91wLQHsmVk/CwJp3NhqAbqz/yfMynMbAeyg",
+	"81/B7IGF26kPteyALYTu9taF2bnKOreN94oI8uUtjDHEgwKGGcfRuCy+F1cHf713J/B61QB/gqckKtmd",
+	"D/ySr9z+Ecf4dcfc7zU/SuHVB7+n9PIsR4c9tIEHOZTUJm/Yr9DRVh1CHeEZFS9ctMsioDokFV88bhNx",
+	"Cf/Kr1CwJcsyqdnkZsb+TX17InoxuQP4ExKEZ1SuG17HiUFfkjMaylme1zmWXlvD8L3rPLla6FCvLPLG",
+	"97gZd058DxleCEY5lsGUDas6YTMaE5OuKakFpLogyG/HyDNwLblophVE/1lugNsV9MLdYCihEtIoKoAl",
+	"HpoBxU0zp4oDsxgSuVgLfs3Tl3v3ugu/d0/tObrmigt2ziqoYRcd9+6RKu7NCk4a3Jgf34p1eX4YIx4O",
+	"lA66u5OcipI0kbnebA3KDZx59ORjNd0WHtXTPkoL0VyU9UcLVgddN7cHFuhPPV7pb+Z+Dh2vtpvf1PBj",
+	"UaHaG+d87/JL2bRY8SGsHzDeCw+5dKM6ujfQdudZNfIYBLzpDG58A5ADS6nYHC7/xtdFh49fjlm7y1HG",
+	"OQ7TuKO2vu1q2ls3cYm3+G55VifZITY8rdkc01/231vZVnLmY7r51Cvhg3xVrjE6oBLKgDakgtKtI2oN",
+	"T0p8rcMyCkAO37JjQlxkshBxU8ZytWkwDiW8EHR+ZVtEa95cLJpJpEyetD4yzqKNYkWaLVQh+9dLAmVA",
+	"h4nqKjMizka+RJh5xlp6IxqA3Ymrcr6aRq+VC7VxOTWYx6vJxf523HSI0Ox0b588SNzBOLl0Q6bMatXf",
+	"BD6i6ixbb3K4SQ/Bqs/h9oTroa6zVGxl1GpiGPg59HttugFM4lLM8RqGR8GcsgyNHEu8wz6cmIjJPkMZ",
+	"hRNPjAVIvOBeZ9xpizLR+kFm67VIMcQRJJ2qFnPBWXbwIS7NUqcRp1yYg8CxJCUPdF6qUGkehy57ir/E",
+	"jEObojfErq/N5rKIyUorvWluyE1FZ2vCd6bAiICeiZf1Uegxo0DhszfqTna2p2vy9rrITI6Cuk3E97nV",
+	"bTLe2imn9nUeaT2BHaRZaEZ6SxA+8TnYR6K7jXj4GnZR+AyGaDu0D8r+xE6ElP0YCpJClWp+dYB3IA8E",
+	"g8OJkSS1u5YOyV+90ZjySgLp9e3T3PWXwHF9u4+Sr6Tg6XgNGPZoLTm0+hV9HG1Z4ZdGYER68+00YFe3",
+	"00JCZwHtyceQ9E03iUime/a7zhzym7I+lFcVDzjeT2i7c87Wd4Sacl9/KhSB+l43rGHtcRE5MR78We2G",
+	"1r9I5USFYrGjjo04dxb0xqRWOcAB7o7bcS9x0riwrVLkFYA3zzOyZMLk8JKfN++LhIwZzlI9zuFa/xm2",
+	"fD3VTfymNo8lTA0FAJCoZEwcXkfQhfAIld8IoQ1gcrOES73p6JCg1/tCtYLN2RQYHIvxaXhcYj4vsEzy",
+	"0J5ySwyOW5BYXEa/ibqMZhhu7mpV1pjZjSVz9nXBaWBUWEgDlIQ641cZuqHicNpvUB9Z82pVWJiOZ1xL",
+	"UQiZydjv2f4tf6UIS4WTlYq2pMBD/qzDf247oFvD7ksmpyDHMEJSQ8I/UNfkBE12Yf8j2JzhrRB7idJ1",
+	"IO3QYvQF5dtUBHe3bdoAmN4X6DIMhAdSeZYiLzoY+XSvqd6B5iPWobLWxnUsFRoBO77hb8CqIg+n6vDX",
+	"zyLPdScY9Cl0t7wTcKc4ozw4gGpgH1zdOX1hFHe+ff4uOlaEIO8QsaihndSEnheMTifjOjLiLrlRzu+B",
+	"wT8TC3oPlsWT9wVGrx7zaTqGt1b9NSc0mC7L6InOEPAM2rwvetdQMFGJm0XIZqD+n0xNO6RFAeqT3WSR",
+	"fRQBiSKKHFKVKt8hbiu6QJgoamTmKlkM0sAPpfKbq5ML/eTdoF7713VS/QyAfIji95uTk4cUj25TJP6q",
+	"eCDSLQA9+uEbTGbZfe/SwlkupyCiGIMk/UmkGpFURCEkcKzppQlSAHVrxcrryC8ayi7Al+Fn25YwZDsn",
+	"uaDlnnEvnRbcvyj6RJvazr12ox10surtvYFbMvMlm2YVI0fwrkriMdB7pXMYJUu8crSTFNocSQkJRweX",
+	"jKohgVEDlL5ZrKvmatLqrn351F3sZNNCnZGKlIeDC4OhLQ0G3FRpogSZpLjqpsiVHPxGg74VwLDeldx9",
+	"OjK7uJPN3knRKkNHl2jXuWuRfN2DrMbobr5yLdUJE1Q6U0pCoMniiaELJ4tZ4GizAHCAY+0jilae0BAi",
+	"ktqDCCb+AAr2WCiOdyPS9y0PVeNFA7drLPJsmc1yEVbtO6ZbDStSJapHs3Od4sIMKNGai68jnV+IX0w1",
+	"6krxUueIG9j3TuC4o/kn6XAlkrqZiaQZ1NcWbppKDR0J5BeUQYSUJmSAEJe431lDShCQ/kSq3t7cRsVK",
+	"TPfyGFVRROmeoOruNmPIdJ9HhEK4Jx++vu+dnE/qvaBccF3qJJD5O9rgUV1xgbuJAJa69AMliHXuqQ3G",
+	"Yo9OnOaaIMemKGv1wUG2ST9eeQddZNpiTU/GGJuQkrrHiBcvdxD4BdmDLw2jnpu9JJRV4TXmRVFIneUk",
+	"UBsfeCYdDCOo3ESNuwHrZ2PwVrfCqgasjTX36KPZTh19Mrdpjv658np+llS0Q/n3XzgOxknTz66vr+ku",
+	"a5+wPgcua6Bg6KGz8OvU+zrfPgC2S+78/0lG+rmTkWplOknJZYW3fhawWs01S1G5nqzI04nioGEA7kmE",
+	"nPQ8yZGTqkQDdpBernd6+3Qyuyv3tbuhN9HIg6bWSNLJTqtkeWaf9bmCt16G/1Ww0xpm5WXMmTC8T6vZ",
+	"5QzPhDcki/Jy+A4vZ96H/8Lg5DZJNxzH8OwMXRgyDZjj6YaZ1BE/1C8kNjJ4uwEyLMj7qFkS6Sm9miG7",
+	"kCS7HzABcTpEdl84KfgPBNIBcg+70lZfErHXbS9LsZ/VhA6ndycDGO0rT9u58r+z5RLCydX1Wb2VIgF9",
+	"pdxN6jpw54prNexS1qFLDi0gBrD6pivE+vOvtrzt2nh1sOZjScjo+8auPtok3GykCYhbcnX80WeWRoWG",
+	"IJnhTHdz9Jy0e0lxdddx+K3FEm0o1rignVxu3/ZD6sSYUtKGV9dU9QLX99bJRczmWE7l6y7z1ldA0TmL",
+	"rMbQDLTMeJeAjb6RpEn7Bpv6BeG2kyj8QAPuLAcTRBivmmb5xk/KCqTvnyFEP5ibS25mdFECmZK30YxK",
+	"6XljEHawTRI8HLsyiKCXjKCXyW3gZ9zBwqYIE2XBbk//JzliHV44xFk8tOwjpv6GBlE6xGttfnKP95tK",
+	"ts7uWhRo6SZab+e1VvmsJ6NyvL1zTN84chHnIllEcwTEOLXWcKVkmHZKMxsVu2BLj0S21+3zzIzqYHqX",
+	"Rp+MLhrBw7NMWb8TpMypV57DxQz7M6uhpFq5T8fnTzbHsKopBsjByR7TJwfnTeV44UyHTIA9pKV67K3O",
+	"eTqHTUim5JG8a3GyRftD5svlEoOAOc+hSoPASS9VruG8BKo3eUDx94HUytOIMxxTguKB3MYqIEuEwrFa",
+	"1WnDxOW+bQlyG09OeZlpEvQJoMRtR7uXr829iHNDwaiFoyi/3YPXCxTzhj+864Q82LgE3kOz2bQ9OeZl",
+	"5Ve2FHp9W0qr9LZLoW4SCpxopc8fPmDMQUytAFvjuUs0gYscgMvSy44dmEed7kESI6X/fmHBDs7ollKD",
+	"bcFP2898S+nnOygsUXtl+zomrc8x6hzYvV05aOPZgBuL8+ukm5qMiy3n8X55RqN3GLn27386a8oaM6iy",
+	"gThmkG40BC1nFzQ4FQ5h7Rn7y6fZYiFcw6jcx6jXAq5n/kpHEHaABPvWU6NqGKTPPpFtoS27gu0I9dOT",
+	"h1KGqgz5K/S4qlZz2Tgbt4eN2ZtC53uQG39ChRswEpAqrauyshe3r/UdaOJ8DUPTyFs9gBGwLbtCmtm3",
+	"gijUZ2wzn6Qjd96RrWKepBJpbeEOO3Xq36UDbY2qzBo+GvaGapUnbS/l8x0bp4oOQDpmr878Tkh4tkR7",
+	"W7qEvm2LsnS77OO8SN2pdiso5F5yJrfUVmdDkeSa8GmxR9eTo5u5//juSTXilp14Y65m7y6Qcy67g7R8",
+	"AHfckATTNmMAm3KbCgkd0EgJHdRce1nd8uvMfyrePT99+UaBj34oIPPVsdF8BVdF7ao/zaq4ouvwNcSl",
+	"apSqnzWjzuabciKuY9UFlaXpKFd7pZOtG51zUJWj1cIfOLCVbyqPP17igOefqIzjn3VQYL+/tq9fcp5k",
+	"ufYD0NCONbrwcscV6/byCXeAG/sMOs6gNx4rGDaCCjiNWWteY785Uy7I41op93R87/Ea/1m1tL6FQ9I6",
+	"X1Mic/+7q1BpzokxKv/D5OBy4DdwNtyLSgW5ev0XP5+AiI8JxqPfR+OdcsroiYXTiEXIX5e/Im+4d889",
+	"+PfuTaJfc/XBAZB+n6nf6R2FKUM8b3qv5hdZFil2sTLJXRMmE9yI21VDFOJinLgAYrKRkcswGRoKZUdE",
+	"je4Lhb2LOlP4TNUv6HiBP03HqCrcTWd0u8CMOUFnoSBV4wu/Ti4xpMaU43Rs8RQ0jaRFV4+qbsZuF/0j",
+	"BP3IDSGWAIDfB6yYSWRJBXt4Y+OIGo92KcA5NlkgzKDYZM7o2EzuZQHvLMSZ1Ytw6S0EYPE7KxUL2BTZ",
+	"P4E2MkqoDp9quok7l7N+CtGoPQHbr19UA7Ml2Q4/VpjGbrvqjAYsxlqrNqQwGrTAPzNWYY0IX9nyHcNf",
+	"3Bl7zH8gdEVRlL4+Kc5xJfJxGUMG33nGSO9VviivAM0+lQE+/EBCZqv7vXg2ZqczGS/q8jfhlx3IZuxJ",
+	"VqWdHTJSwEPvEeYM60ii1+vOvo1AxusWQqRyY12CXrRytBPNPle4n0/sttE7Kg2c/Q6rDaS/uojahNBD",
+	"1fVDasdVBZgZHVgnSoCSzmjvR2hEA3Kak1Ygov+cu3HDxzy+PecK5l6sdZ5czBJfEUh8LyJMzva3/DQx",
+	"Q7nqrDdImkwdPHvkhLaYtiqTDsBgrUf94gB7vv142tGvPvvII4pzn3cTdl3KZekZZlNcJAW5lVI/5oCq",
+	"N2ojtensoqwppbX0u5SmQCJrrzIckJ/O+46AabbEmTirc5QsGmVNVQNFnDebqCjNZJUnVyY1jUINbMjJ",
+	"xJ5Zk9coO8/QRC6oxf2JKv4s6YK25bp1F1weLHMlqfmDEc1XgFI4ZtCFEQtoNe9zEj2NY/RMNBfoPXpC",
+	"7e5/FX1B/uMyOxd3/ReMEtaOntz/itzu+I8Tn6yUikWyyZshJp8Sl9e2aj9lk5M9j4FsVY3qD1RZ1EL8",
+	"JsL3ycD54q5jThe1VFfQ9tO1TooEEeKDab0FJu5L+0uePR28FGydETBZeRVljX9+0STIsQLJBZAhMhgY",
+	"+wDrWCvHYVmukcI0a9XHTw9HtYd1iVgNl/5IHvmV543/Ozy3knUg4JWCLH4ge7uL1gk6xVP6lcyG4ygW",
+	"CSdQ12KgmrkmbxnjBufCpZO8StE5WIEQTgRpjTbNIv4rPt9ruDaAIU5D4MYzOGn92rPtCoTFboDfOt7R",
+	"UlSf+1FfB8heSzmqL+ZUKOI1cpT0rs3w4ZzKYOiA39075IUeGPrG0jWOGwcJcNMiwMTh5jcixWJgwBsS",
+	"p1nPThS688punVY3tZ9gkg3u0I9vXypJZF3WvtpOlgEoqaQWmM70nMKN/ZuEY95wL+p81C7cBPrf19lR",
+	"i6WO6KZPt/ex4FiVPe80k2ULJf2fXtmKMGTc5jDujvYS8NV/uSmN4y17Ke+mL+za0Nk7lL4FMDcabTRK",
+	"HyuB6B8O7zF9fg9/ry5IvOctVen9X4HmF5SipkR9MwKNGlNu+uuD9mdm7/fujfeg9usL8VcPava7a7oZ",
+	"eLGvb6uxiHufY6gi3sZvTGWu8WhYvXcZXqkzNcYkaldKvn254zDhqzt7pfsPkEYNfe7i5nfmr7SZNiAq",
+	"zB+APp6pVfm0BEg+qfnuhNQkWMF+LBF1ri1NT7cfEOLfSA94ak/pTle1xXRVOUpX90fY3sB2jtRo0jKV",
+	"u/4WL4+tLkrOkcNRZwJ9pWWrXOVoj5s/MgX1TWhHk4G92GR5+pO1oHduVWD285XXIX6GHX/hJ4zTwNG+",
+	"oJ24ELm3N7/0f9EaAY/O4h9lYFh4jvk/dRauYO9AasFqA6Gn1OMjrrIGc6C0UNTOLWey9cC1CPuN7Wyd",
+	"McvWHfHZIr5for6froKGXW8a5VFNeUBU+a9Flqsk5z5bPrWM66QJ3Ag1RZEv7IggbaOtMFI5xmF0tM1l",
+	"axI5ZIKlKekQwupQH4Tp6ArR6U7JB2lkp4gYasYLVQWX8hiVUbOpMcnzwlkG2uvg4ruaUPp3HuQElyUu",
+	"ae6jJ/dPTk7GGUgJXyPWznjVC39tF3f/mJrwF8VRubzRTuDvA/21pbpdNr9PXKpyPF0EPhZLHzi3AFm3",
+	"USbhqvGwmJQUy9PoW0q1h4TeKuhDCl2dLLyd3nZT5WWSTii/Ofp3RTwr94FnHaKOqtYvSXvZPiJeA9X4",
+	"dL86lWAgDdv4cYazQOGqZRObevK+pKDY4p0pOJ91PLdIr+liZxo9Y5WycUriSSLKkl+vURVrRmMVBhEH",
+	"/qNpEoAb1bDTo0F1eKB2ny2pF/KieqNaaA5oTV1OCLcpb0kcHJfBPhqowE1FPYlK1K9fZJiQfAU/n4t2",
+	"7lGTuLdTgKW9WiCrgglnuoPkbYpZ7roLGjgW27VviBeyzj7c2G5pk9KUm3q+Q50cPvln1Msfc1S0B+v4",
+	"bHCBq0tdImsavVKGmjnw9CKbU2ko3/OBsoqOMwmPqKLlt9XKI3WWPcfQQ8pOrgWFRbX+D0GWqRDXd8hw",
+	"vuJ+M+Hwnw3WmyTr5BLzUzAPxExIuD1YUI5tYCA0CFWuFOnL5ahl7XFb84b0GPeXA7rTwyZiYsCAnvgb",
+	"/PaDsitQ+iO4hUhfqJCqXrFsHMSMRXhMMGg0WmJxU15tO6ZN/ox9pkBmBMKH6ctymc2BLGgMdqNEpLAH",
+	"c3+oU+3PrPyHse1TbKvKcJifW+6APKle9wcvC5Fm//vanMsiiH6f35p2AnKQa8Z3RxsgxsEwBbqXkQyx",
+	"PgvQjKjoPu+Rjahr36MZq7NsmN6oRcRB6N4M2FnhAeMlJnsyUrUnpdvce5fQxtBpDvSD9pg2YDTHQ2fl",
+	"QCgP5Ydgb4ebDtUtKoIooTXqOcLbCGSuKqIE2IppYF8XmNFTHwqkbkcowRBh4xhOwlRbp47SmRLG2NGZ",
+	"o4SVeOdnK8jWYx1W3ELX1iBW050K++x6T4US5842IFU2mILVl0Lxa/oa0VcdDInFhTamZKeJkW1XHuhT",
+	"m5oIs6ps1gNz6QY3nC7NJJo61rPc4zb8zHyEefQOU0612RX9f5fagMZhf+fIde2dn+5WbqMfie+TnpGm",
+	"Y8y0Nx4TdKfcHB126v0I3fY/KKXroPU/REx6t4SZs0c+/vYcLw4343wvPoGvFpMQnmIBSvquU9uZpMSd",
+	"OnkJE21vTrV5ni3rAK8begGHyy+QLcK1OPH9ylaYUM6IeTBDTtKoRIywSssTxqgwwqns2Hu8Y9Xqm2ZD",
+	"/uHsHv45DT8KH4NID1tJv2/ZRNljzzKUoC10P3OlJYJd7ZWqqkhfXwp3QDkfzRnUMKfYKZx1ulyvVREH",
+	"j0fh+RoeYs431xNNCD9jY2drT1gIPWy93+hp5f1SX/hHa+lHDNGMTcBHaFRLmHBQqQZPA8NTuxM5KluF",
+	"2egbeH6hZf0/zl7/cBTeSGcH+luqssB7VdihjTFRdl3yWJYtfAwm6E88UvubVmJx4zkRzBI5QTcD/ln5",
+	"33TSGFhc4EvJI+W76Rj0lO0sp05+Op0DtCndiQeyKLSmr0atd0RW+d0nH/BM9+cn3QGxgfSfX1N2T+OU",
+	"5MDpOO0bPtKxYPq53vZL0c+ZuzynLHK/7UUGzDmUYs/PB2aXYyke07SObiuSqtf24QN/W8mvyQ4KZ3Ls",
+	"ZMUmG9f02oNbTG3m3y3O6TcWCM63t0vrl2MH73HfZcnVBX31l/pprY4sL9Scz2HFlrfyde6yZt9peclF",
+	"KtErrfaldlOZ8bULBZ1Nji69IJU5AMYl7PFoYVZyrcCV0/E1fzypT/S8gRphZNHYkgTNgNYblJ6XmPCP",
+	"kv/BOcQqCtbhf5Hl9E8ukzxQIYF6YhFIOVAl2RZBphLJJneHzZuYNE5uLgejDBiXVF5neZ4pI19LjAwT",
+	"5baCuw4ETmkEB0vhzR4tyA6VbWgEQ7e1knazAnAUVbVB7SNrcBtH2TOTyxhhg2MjgxubJzWWKNQJBGZX",
+	"+8G0786OQ5sPYYfa23C+PX02+0ekU3TOJYA+2s0ifRdot9SoR45iM6ltogwsPRYYMJm0tCpjKpv6imgq",
+	"3aK22fLTVCVj5sqivaKkPZ75bIw6qYcPAPpFupPCxVeI9YhH8e5Atlw1X6ON/DuRpKLmYno+BTSX0lsL",
+	"pEq5yirSmAINZkabF+U4mKpis6LhpmMDkXtZNPtj6XCxcwAdjRxO0EstxHivzsq/RIRAuyCZ3Ka3rFSC",
+	"daSialaD6hUOZasaU0cUu7EhDX20hHJ2OBcFsKWpmHZD81ObApPzqSrrECbbnW7nECZIm9DoAu2jr1bW",
+	"7u99SR9aiqNewmMnn7egjLQ7SCOnJgKS00pgtXaTJ7OTNGp0cprFAhP5nm/JPf13NOXZZMQTbexTd4dN",
+	"RZ2Z5AhUr+ygNnAL61AW6EFQXdHiM0IaSv8Fu3ZHRi0a4nT3oXwi+5Q/IuSw55euqBVyhlBhIIAcTU+E",
+	"IB31py5lW2B0nwpYTmr2PcHQNI7Xk03Xvh80+hm2BxjYdcdJg8IIqbJCqa3fcNUI5yoP69afCbjM4SHB",
+	"ITSJqbXkWqDQmN6xvNPq+FUEDw3jX6SrNgmpf9PVCXiWPPuoyjPy+4C8ubCghW5xkKTAfG9mfqAXZubM",
+	"hoH3/YJ39eTlfAzzvEQBKA6lweiK/0rtBmeaIstsilaCeiHqWqTGiwjGFjFW7mIq2CHzvUoWMYA9q3ra",
+	"GW+d+MUdEqTwioIFxN7aKmpUCz2hgmGJCrVzseJkSreVzfyG02079JS/6weQrm09bJAN4d2ci3hrMIdO",
+	"NID3TAfz7ulCb1ESDnbmXq20a3vYcrMCmGis3b66dc2KdlJwKiqSbuZ93amxd49OsjrAzbxm0Hl/lUFV",
+	"NDLqYzYUqWxkZsddoFmGZNAdLXGHKA5q3ZY+uJcHAe/3TVaO5djigC/Ri34xtu5h+Jih/zemMDcqb5SC",
+	"77SPDU4SfUEuLMbL9GJ1pUuNVXDLifTuNIrQtIy5ELTDqVsOrjd5cacZmv+SZk03XF5R2ayn7wt/UDmV",
+	"OaxvyP30MAM8L8SbgImkN56fB9ljduAjIa/6C6qHiHN4ee6weqPvEdoRoRzyYyi8AtQKTu2sLD8+L5r6",
+	"yp8ju63V1d68le45jchxm9z+AYUmisHoTEVVzlc3VCWLgBoZM+aUi0UMe5LlAxpc+u4q0QRqFTgXCMBb",
+	"ADp4r1nPhxGViwQ9UfVXsjIB91hXzejEczMMm0n3ho27j50Mwd3UYqtKkaph4cV0LgaWqMleI34MBPyG",
+	"2VDK/YHluspz1XqxyV0g9phbUWWs6CaIBkW8plk7dxFJ+rLMz41b+nhnp7LOllmxZV70aZVU9SZJ11j4",
+	"sTt9OUONo9VRjJ+/QhdusoeACJaHEECfWvG0it5wcvYOTEgbY0ajzxPV3Bx6DK8GoFcwWFribQFyaXm+",
+	"o38Zv6piu/PsnB6mHWlL/5KVyvbsEWxfBhiyjfYAA2YYEyvY9dwmEi9NSnxXUhCAw1zGl/Pdsn8OV5xE",
+	"YrqcYgw01muB+ev5CrX1u+xE8O2taVqDNHiDvAFo5NYAKn7VdenLbF//dgndG2L45mhjSe5ImDtsgAzu",
+	"QCs6hj7ffFMCm3DGHuBPSbL32YAppayT+5gCA5JIeY5HMi99qQP2SXuLQ/lR505GADWiGKF1tlCowb0I",
+	"UNF1W0rJqM+Odw7yex2UsW/VGFWIhd9iMmTh6M5sZmk/cBaY8sWZkQJMubqU8Xyh4kz0j1kGsmN9tU9t",
+	"lzaqfPQXxPL2U64jJO1CbJRkH4d5Xl7E9DqJTYVwn1Yf28n261vVl7WVxaVivDbeEi401vRcwYMIpZ0a",
+	"rg+3h989gKHCDDwxVhHzOiu8zBYN6vrWlIwKC1Av4ZChJSnaoCutn4JCc20KlA/S2NBkEAVMO5TnkPs4",
+	"dDxySnxEs192TGqXrcVi9ea/wz6cc9Pm7OdFxxwbEEgsALBxjn6FIW7ch5cIh9NId22rfk3XIrskusGi",
+	"Wf0jD1tfY9IH1YL1Ci4J0cHH18s6k5JBMbR0keU5pbzMLp1IBhMI5EdtQAX2ggKgzzOKdGunP2XNWIVi",
+	"jckZ6/KAMzeNPHyF9suVU+PSwKk18BhOTJ/dUX6UGwpGpLxWOMWjaF2ilcf1+TFD2djPLzDIBi6+vG2P",
+	"Y3XdUnl7v0ouT+fz5iXc2fgou0u6dJSDTDbCic4D2Q3atTPVncIR4xR+GBlG5CG314bjdhTOquh5NO/s",
+	"cL+e/8C2K9wB88N25rrdPeG0v7Duutp81q/SxCd+U66zuf+4/bnCXoPBqj7u5S0PQT1U6lxqRnzAvcdM",
+	"HBNxzz6aRYG07NsvxSNUPAdxIvwnaeO640YLoXhQ4A7t8x0lYMXzoBjYAYAg5eyNmGiAeJ8rpBmGUy7Z",
+	"KY+iUbqAjrxwKOjvZrDhCAcHqhE3AqoXhmwA/IINERMu48EhzZjhRn2/a+t87AX89TCVt5hHKJryzJJW",
+	"zfGUOvt2gCP4qyYOhh6+o8yds7EBiFI7+4y8/B0AwiGJLRhGBSbuCgar0uKkCdz7ZMqaOFp3pTdwRs/U",
+	"lc2cfJ7wXb5iNR1wApUNmqX/uu0VRFWa1a2KzfuGbTRFKi3tb6IuKa9ZOnG8UnTJ5Y5hoKziXJyLVqSm",
+	"SlHNyjtUJKq+0nSGq15U5LjVtZf53sADqhi19tgJYhuDXa9VhRGrlJ5bTCZeAw9c4HxM5NijhBCBxAdy",
+	"VwsJu4ocbZMgHmUPqnrPh1g/McdO8yOP8FYPcKr7+0QZjYkP4/jQzizIj7ohBrQ1JHkjQ6e+8Ecku/nX",
+	"jb8HzZYa9zQmccs3ZJVcFGHjZJ/k7Uts5D7BSA5in0N3kmrUUwgogJ86Af2YCjwiai/QgS9lqXFZeIzy",
+	"6OdTlPZFRHpi/YqxpWj0DzwxNQJ08UN7D1c7Gzh8852NaLBIdipE+PXAhqxvZqr/XU7i4EEMjuejEfRj",
+	"oxxeA6oxTd3q2UENyk2Oqm/YT5T9V8m50LeY4uITODt6IFRkUORb64n6TGi3LKY+7SmixPLMXMs6QHqi",
+	"qiR1tSCZkxpizYpZ/B8+SP8JLCVbXBGfYfB1t0iuEiQh5QfGzpAq4BonHhavJhowrYgp9VS87mzsmM5w",
+	"VziKAzRe5LrWPNYa+CjcbSA/T+af8wYZp9zMSKmBV3ZnO/tYUIvXOaXXSeoqAag6zlWLO+gqbdj7f9l8",
+	"Ve5UumhFlSdz3m1SbWBynDafQWHIEBe0WQ/nN+vzNU0CupVDtLXOj5nuoU3dkXX5kn2EKnq3wHaeEe2C",
+	"3odZxkilcKcw80BmuFFLOfQuHCZ5U29J5DSoq4hsWRzXi9IVR25jd7xlrULLGAP+H2hXWl6SvZQ2/jBg",
+	"dz3U5DZ2oZWB1wMrq8EBHLiNF1udMFgPjsqA2ubu1bpbkJxqgbWCkFW+eK2erbZqU0Y+OZnrKuGMkmLZ",
+	"K8tqs6LCggG9VxAVbyquHIS51gRC63RkvK6VSjE/xOtzUdcgDAZwgKcHKye0KwtrC4rq61GAmBu5P0Am",
+	"7QuQEqlZ/bzbDK//NFvAcjkEBvhrkaJDttMckDaHCyfBgLvkSu5vqjJWh23GqsSRhdppQh2zFZE2AwKC",
+	"FTuN3dCQZABMDmhRGmEJolgrjxWIFUMwvd/w04fhT2EJopDIcknpvgIHQhXnItMhPyAxTzDKYCTdjVu3",
+	"nkdmv4nhaah+qmJEgG2cdcwUw+f+NW0lPUJ/LLJm8OSzhrObf40DlvhgaqSiclVHWTKx9M+jL2Weysjs",
+	"ps3ToqrOT6ppTzib6I1s6mnVA7tI/hUq36KrQpfjrUstFw5fYj7WK8Skb5ADcZTWP4VwLZUique43lVU",
+	"MFImKq3hjno61u7reykAnk65T2e9Pa3xs8VxxstGjuOJH6KqrOL5mBAVLrGcKiODgrQNY4A+HBNCYN3G",
+	"70aaouOtZOit6uMs9+8jvHeqn2+zlcHZ+TB4rL1KpgBHbxsw0M8UeBkdYVatUci0UcVM9ONcG7vbSjTD",
+	"JKBPDSPXpGS+YAeqnvqvVUE+UDLv7LvTx/cf/PLg8ZcRNsBCkWh5thlyODuqZhsmwiArulqj240p6C2v",
+	"8W+CThPKiNPWSx29bjZFnTXmttJWUGqtfleDuOcC8GXlwmyzNsRx772icWx04x9ru3yLPPiO+VDw+fcM",
+	"/T/8hXCNXOUxv/h2yzHA4AvEcQVt20+zxsZWyRUpF6nU2TknhS51fIGlgqwJ+HL5FhIKzSF+RkkYlc0J",
+	"Bq5yxavYTjS0LvVOY/0eCY3kboM6sLJSoj3csD6IKPS63gijV1dqU9KnO9E2htly3I2PEFUMm5/00OOD",
+	"XsJAX8Pc3poZNaP2cHrcRI94oQ/lHqQZsm6EE4zuw0msYeAPwz88GVMPxjXMcj8Hr/C+DwaSu5z2vCZM",
+	"ttBRoPUzY3rIgwAIpDVp5Z5wYuWdgmo12xjIGqHNz13x45U1S28NMCVIdIct4LkpSWw7ExOpwPmdy1W9",
+	"MkhxlvIhRAmt5W/LcqJZr7lInC1SSpMGfQe5dEZfLHTy2sinJl1M4FXSyyqD+VDQAIWiaD8bDetx6Ey5",
+	"hINPgvq8k3zpVrjGN+i/cUr4EOnbcPy1m33ERTKjUh68EsfLZBRYTqaRW4GqeEMpcv4ucGe9t6OaRRn+",
+	"e3cgqYRAXiZvb5OWDiv/XNCY7Nh1/8topmoUo2NvJrsOBRdapDFpM0SNFjmOg7lsuik8blzb+KeyucFx",
+	"WGh/oOgHx8hmPAcUzPao/87MKcABvKfFR6o9QvHgz8frsCLCuKK2N61nu18OZ6diw445nN2VUUWN0cuj",
+	"ddDlBaTeX+foW7+FW8+Fb9c2Nkn56LK4WIt8NiaTuL+ELXan5OYHqWV780q2t5LZnFGpxlCQeAnLitzb",
+	"ktB1/CWddEvtXURx378TFBCA4UkwGj0KFpuCx9NsmFO+aLZeLibGiwE18+XiSfS+uIfeEvptof6Ef2IF",
+	"uwKrif18ZL9j3Bp//eB7qaWX3vQQNh9ez0dUlRG8I4FvXI0tfB9Of+dFrs32d/vyDIh1M/+D7jvcMHq1",
+	"quiDFwXxeeItfH2qHHj//ybx2znxpzkrTIw2v5/Zh22p/n4KVcLjam+BAp8dvou1QLda4d3aq5jqh1Mj",
+	"U0HSX2aw2FtP+qIhCJQIUEu/SR5PRoxnra3JnamcVNIjarCqbp487JQ6BRpnzdUZ4l8r3LNfPvqyOX5r",
+	"8iuqpJ3G9q6k3qb8CCKy8i6z2Rg3UsvV35ZJTnInuwQUKG2W+TR6zkVB1YX4tzuzv4iHf32Unjy8/5fZ",
+	"X08en8zFo8dfnZwkXz1K7n/18L548NfHj07E/cWXX80epA8ePZg9evDoy8dfzR8+uj979OVXf7mDlI4g",
+	"M6C62O+To/8TnwJO4tM3L+J3CKzFCawaU1heX5NubUE1CQipc7pcMSlXDs3UT/9bX5FTWI0dXv+Kd2GN",
+	"zVdNU8knx8cXFxdTt8vxkpKYxU25ma+O9TxUvqL1UnnzwkQEsdcf7ai1NtGmmqzi+O3t87N3EfSbWoKB",
+	"byfTk+l9SmJRiQKWCj89pJ/o9Kxo34+pcNZxsgROgMLvca6U3JSMGJtAf9VIqiK9xyay1PMNrQ4L9Wlp",
+	"yoPgX7AtOTFR/AMoqIb3m/oLrub0Sv1bXiRL4GdTCijjn84fHOunyfEnFTJ/PfTt2HVWg5/dFHzplp7a",
+	"3WpbE/iBs9JtGRDOCMjJV4Nt9BUebuHqYI+VM63TAdOOHKd1khXdHznF8bEEApAriuFvfTYZGZwPI3E3",
+	"1Ox4Vl7u0FS4CA9jl+Qj+ERaheDvx0rI8H8kxQ9ziC7auy05iZn/Y2s/PjWXuJDh4bCNM94c3QI21fEn",
+	"+gcddmdFXHIM+hTH5Chz/KmFCPW5h4j277a724Iq5WjgysWCC34MfT7+xP93JhKXwI0yZBicW1U5BRke",
+	"9SLFyopOo6crMceaNdrBm5jPg5MTTz1Gp1fEvBA9lUnJ8+jk0YgO6FjtdFLhyf2OPxYfi/KiiKh6F1+M",
+	"G7il8KyCZIeRgDJ6/T2adUR3Crj31AzEjBOklJ+Pqs0MKJvqXTvo+XCtkKaP4gYOgMMP9M9Xxdz7Y3+b",
+	"W0mVAz8f66vYxzHbLT+1/mwfObnaNCkgyfkFVUGsse1Dhh83svv38UWSNfiuVFl5KQVPv3MDF8SxqhXb",
+	"+dUWYOt9oapyzo9uPJb3V+AwjOojuOM8ZPs2uXCerqfUmGU3IZuvS7qoSCRQujaHnx1fxrOsSDjlGku3",
+	"bdmVP/aVXD0hAN+z5NSlzQX9nHKUEaMuk3SecAYeWxnKCppoeLz2Hjs6TicDa1EXsLOOQdNNqwSeZ0Vf",
+	"JyCnqEQicfQqyRErsKJTJSG1lsaH/f7tQfei4CgLPNwsJIbYzTMMm0SKQeeXbbzn8W1i+AWaKrCeoGJo",
+	"OP3D25v+TNTn2VxE7wT0rZM6y6+iHwsTarI3K/6GyLtOlD7AkDx7EmK+xVb0Su3Pn9AuWa4zbcBb5DJa",
+	"AfXlKuIc/XhhS5E2yUBYOi4qeIVJlfMZVkgAcNpnIGMy2stpdGZcGshBgOOkSqwxQWRDmncqtMCTJOTu",
+	"wCavEVcJKuqQHwBzjxVHimfAklTN6iPABuaEvPaxPXq4hXhiT6T0fVWCTqCR9nHWn+0b2X1zwuKc1+bP",
+	"H64/4Lf6nB4b8Mk+oeAFRSEzK9iDY6CeT53nlfvxg8HcJ/0sq7C8CZYaJaRxzjPM5sTPi9g+kx5MT46u",
+	"/xtclclOlSsBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
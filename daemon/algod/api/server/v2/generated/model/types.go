@@ -282,6 +282,18 @@ type AccountParticipation struct {
 	VoteParticipationKey []byte `json:"vote-participation-key"`
 }
 
+// AccountProofChild A sibling of a node on the path of an account proof.
+type AccountProofChild struct {
+	// Hash The hash of a non-leaf child, or the remainder of the element held by a leaf child.
+	Hash []byte `json:"hash"`
+
+	// Index The index of the child in its parent.
+	Index int `json:"index"`
+
+	// Leaf Whether the child is a leaf.
+	Leaf bool `json:"leaf"`
+}
+
 // AccountStateDelta Application state delta.
 type AccountStateDelta struct {
 	Address string `json:"address"`
@@ -537,6 +549,9 @@ type Box struct {
 type BoxDescriptor struct {
 	// Name Base64 encoded box name
 	Name []byte `json:"name"`
+
+	// Value Base64 encoded box value, when values are requested.
+	Value *[]byte `json:"value,omitempty"`
 }
 
 // BoxReference References a box of an application.
@@ -677,10 +692,35 @@ type GenesisAllocation struct {
 	Addr    string `json:"addr"`
 	Comment string `json:"comment"`
 	State   struct {
-		Algo    uint64  `json:"algo"`
-		Onl     int     `json:"onl"`
-		Sel     *string `json:"sel,omitempty"`
-		Stprf   *string `json:"stprf,omitempty"`
+		Algo uint64 `json:"algo"`
+
+		// Apar Parameters of the assets created by this account, keyed by asset index.
+		Apar *map[string]interface{} `json:"apar,omitempty"`
+
+		// Appl Local states of the applications this account opted into, keyed by application index.
+		Appl *map[string]interface{} `json:"appl,omitempty"`
+
+		// Appp Parameters of the applications created by this account, keyed by application index.
+		Appp *map[string]interface{} `json:"appp,omitempty"`
+
+		// Asset Assets held by this account, keyed by asset index.
+		Asset *map[string]interface{} `json:"asset,omitempty"`
+
+		// Boxes Boxes of this application account.
+		Boxes *[]struct {
+			N string  `json:"n"`
+			V *string `json:"v,omitempty"`
+		} `json:"boxes,omitempty"`
+		Onl   int     `json:"onl"`
+		Sel   *string `json:"sel,omitempty"`
+		Stprf *string `json:"stprf,omitempty"`
+		Tbx   *uint64 `json:"tbx,omitempty"`
+		Tbxb  *uint64 `json:"tbxb,omitempty"`
+		Teap  *uint32 `json:"teap,omitempty"`
+		Tsch  *struct {
+			Nbs *uint64 `json:"nbs,omitempty"`
+			Nui *uint64 `json:"nui,omitempty"`
+		} `json:"tsch,omitempty"`
 		Vote    *string `json:"vote,omitempty"`
 		VoteFst *uint64 `json:"voteFst,omitempty"`
 		VoteKD  *uint64 `json:"voteKD,omitempty"`
@@ -688,6 +728,30 @@ type GenesisAllocation struct {
 	} `json:"state"`
 }
 
+// LateProposer A proposer which held the lowest credential of recent rounds.
+type LateProposer struct {
+	// Address The address of the proposer.
+	Address string `json:"address"`
+
+	// Lagging Whether the credentials of the proposer consistently arrive after the filter timeout.
+	Lagging bool `json:"lagging"`
+
+	// LastArrivalMs The time since the start of the last round at which the credential arrived, in milliseconds.
+	LastArrivalMs int64 `json:"last-arrival-ms"`
+
+	// LastRound The last round in which the proposer held the lowest credential.
+	LastRound basics.Round `json:"last-round"`
+
+	// LateRounds The number of those rounds in which the credential arrived after the filter timeout.
+	LateRounds uint64 `json:"late-rounds"`
+
+	// MaxLatenessMs The largest amount by which the credential arrived after the filter timeout, in milliseconds.
+	MaxLatenessMs int64 `json:"max-lateness-ms"`
+
+	// Rounds The number of rounds in which the proposer held the lowest credential.
+	Rounds uint64 `json:"rounds"`
+}
+
 // LedgerStateDelta Ledger StateDelta object
 type LedgerStateDelta = map[string]interface{}
 
@@ -782,6 +846,51 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// PhonebookEntry An address of the network phonebook. Times are in seconds since the epoch.
+type PhonebookEntry struct {
+	// Address The address of the peer.
+	Address string `json:"address"`
+
+	// BackoffUntil The time until which the peer is not connected to after its failed connection attempts.
+	BackoffUntil *uint64 `json:"backoff-until,omitempty"`
+
+	// BannedUntil The time until which the peer is banned.
+	BannedUntil *uint64 `json:"banned-until,omitempty"`
+
+	// Failures The number of consecutive failed connection attempts to the peer.
+	Failures *uint64 `json:"failures,omitempty"`
+
+	// LastSuccess The time of the last successful connection to the peer.
+	LastSuccess *uint64 `json:"last-success,omitempty"`
+
+	// NetworkAddresses The network addresses the address was resolved to.
+	NetworkAddresses *[]string `json:"network-addresses,omitempty"`
+
+	// Origins The network names, or admin, the address was obtained for.
+	Origins []string `json:"origins"`
+
+	// PersistentRoles The roles for which the peer was added as a persistent peer, which phonebook refreshes don't remove.
+	PersistentRoles *[]string `json:"persistent-roles,omitempty"`
+
+	// RecentConnectionTimes The times of the recent connections to the peer.
+	RecentConnectionTimes *[]uint64 `json:"recent-connection-times,omitempty"`
+
+	// RetryAfter The time until which the peer asked not to be connected to.
+	RetryAfter *uint64 `json:"retry-after,omitempty"`
+
+	// Roles The roles of the peer, e.g. relay or archival.
+	Roles []string `json:"roles"`
+}
+
+// PhonebookPeersRequest Request to add persistent peers to the network phonebook.
+type PhonebookPeersRequest struct {
+	// Addresses The addresses of the peers.
+	Addresses []string `json:"addresses"`
+
+	// Roles The roles of the peers, e.g. relay or archival. Defaults to relay.
+	Roles *[]string `json:"roles,omitempty"`
+}
+
 // ScratchChange A write operation into a scratch slot.
 type ScratchChange struct {
 	// NewValue Represents an AVM value.
@@ -1148,6 +1257,48 @@ type AccountAssetsInformationResponse struct {
 	Round basics.Round `json:"round"`
 }
 
+// AccountHistoryResponse defines model for AccountHistoryResponse.
+type AccountHistoryResponse struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// Amount \[algo\] total number of MicroAlgos in the account
+	Amount uint64 `json:"amount"`
+
+	// AmountWithoutPendingRewards specifies the amount of MicroAlgos in the account, without the pending rewards.
+	AmountWithoutPendingRewards uint64 `json:"amount-without-pending-rewards"`
+
+	// Round The round the account was looked up at.
+	Round basics.Round `json:"round"`
+
+	// Status \[onl\] delegation status of the account's MicroAlgos
+	// * Offline - indicates that the associated account is delegated.
+	// *  Online  - indicates that the associated account used as part of the delegation pool.
+	// *   NotParticipating - indicates that the associated account is neither a delegator nor a delegate.
+	Status string `json:"status"`
+}
+
+// AccountProofResponse defines model for AccountProofResponse.
+type AccountProofResponse struct {
+	// Account The msgpack encoding of the balance record of the account.
+	Account []byte `json:"account"`
+
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// AmountWithoutPendingRewards specifies the amount of MicroAlgos in the account, without the pending rewards.
+	AmountWithoutPendingRewards uint64 `json:"amount-without-pending-rewards"`
+
+	// Proof The siblings of the nodes on the path from the root of the accounts merkle trie down to the balance record, level by level.
+	Proof [][]AccountProofChild `json:"proof"`
+
+	// Root The root of the accounts merkle trie.
+	Root []byte `json:"root"`
+
+	// Round The round of the accounts merkle trie the proof is for.
+	Round basics.Round `json:"round"`
+}
+
 // AccountResponse Account information at a given round.
 //
 // Definition:
@@ -1192,6 +1343,12 @@ type BoxResponse = Box
 // BoxesResponse defines model for BoxesResponse.
 type BoxesResponse struct {
 	Boxes []BoxDescriptor `json:"boxes"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round the Boxes were read at, when they are returned a page at a time.
+	Round *basics.Round `json:"round,omitempty"`
 }
 
 // CatchpointAbortResponse An catchpoint abort response.
@@ -1248,6 +1405,11 @@ type GetSyncRoundResponse struct {
 	Round basics.Round `json:"round"`
 }
 
+// LateProposersResponse defines model for LateProposersResponse.
+type LateProposersResponse struct {
+	Proposers []LateProposer `json:"proposers"`
+}
+
 // LedgerStateDeltaForTransactionGroupResponse Ledger StateDelta object
 type LedgerStateDeltaForTransactionGroupResponse = LedgerStateDelta
 
@@ -1353,6 +1515,17 @@ type PendingTransactionsResponse struct {
 	TotalTransactions int `json:"total-transactions"`
 }
 
+// PhonebookRemoveResponse defines model for PhonebookRemoveResponse.
+type PhonebookRemoveResponse struct {
+	// Removed The addresses which were in the phonebook.
+	Removed []string `json:"removed"`
+}
+
+// PhonebookResponse defines model for PhonebookResponse.
+type PhonebookResponse struct {
+	Entries []PhonebookEntry `json:"entries"`
+}
+
 // PostParticipationResponse defines model for PostParticipationResponse.
 type PostParticipationResponse struct {
 	// PartId encoding of the participation ID.
@@ -1365,6 +1538,21 @@ type PostTransactionsResponse struct {
 	TxId string `json:"txId"`
 }
 
+// RelayDrainResponse defines model for RelayDrainResponse.
+type RelayDrainResponse struct {
+	// Draining Whether the relay is draining.
+	Draining bool `json:"draining"`
+
+	// IncomingPeers The number of incoming peers still connected.
+	IncomingPeers uint64 `json:"incoming-peers"`
+
+	// SafeToShutdown Whether all the incoming peers left, or the drain timeout has passed.
+	SafeToShutdown bool `json:"safe-to-shutdown"`
+
+	// Since The time the draining started, in seconds since the epoch. Omitted when the relay is not draining.
+	Since *uint64 `json:"since,omitempty"`
+}
+
 // SimulateResponse defines model for SimulateResponse.
 type SimulateResponse struct {
 	// EvalOverrides The set of parameters and limits override during simulation. If this set of parameters is present, then evaluation parameters may differ from standard evaluation in certain ways.
@@ -1481,6 +1669,12 @@ type AccountAssetInformationParams struct {
 // AccountAssetInformationParamsFormat defines parameters for AccountAssetInformation.
 type AccountAssetInformationParamsFormat string
 
+// AccountHistoryParams defines parameters for AccountHistory.
+type AccountHistoryParams struct {
+	// Round The round to look the account up at.
+	Round basics.Round `form:"round" json:"round"`
+}
+
 // GetPendingTransactionsByAddressParams defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParams struct {
 	// Max Truncated number of transactions to display. If max=0, returns all pending txns.
@@ -1493,6 +1687,12 @@ type GetPendingTransactionsByAddressParams struct {
 // GetPendingTransactionsByAddressParamsFormat defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParamsFormat string
 
+// RemovePhonebookPeersParams defines parameters for RemovePhonebookPeers.
+type RemovePhonebookPeersParams struct {
+	// Address The addresses to remove.
+	Address []string `form:"address" json:"address"`
+}
+
 // GetApplicationBoxByNameParams defines parameters for GetApplicationBoxByName.
 type GetApplicationBoxByNameParams struct {
 	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
@@ -1503,6 +1703,21 @@ type GetApplicationBoxByNameParams struct {
 type GetApplicationBoxesParams struct {
 	// Max Max number of box names to return. If max is not set, or max == 0, returns all box-names.
 	Max *uint64 `form:"max,omitempty" json:"max,omitempty"`
+
+	// Prefix Only return the Boxes whose names start with this prefix, encoded like box names (e.g. str:, b64:).
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Round The round to read the Boxes at, which is the latest round by default. The round of the previous page should be given along with next.
+	Round *basics.Round `form:"round,omitempty" json:"round,omitempty"`
+
+	// Values If true, the values of the Boxes are returned along with their names.
+	Values *bool `form:"values,omitempty" json:"values,omitempty"`
 }
 
 // GetBlockParams defines parameters for GetBlock.
@@ -1623,6 +1838,9 @@ type SimulateTransactionParams struct {
 // SimulateTransactionParamsFormat defines parameters for SimulateTransaction.
 type SimulateTransactionParamsFormat string
 
+// AddPhonebookPeersJSONRequestBody defines body for AddPhonebookPeers for application/json ContentType.
+type AddPhonebookPeersJSONRequestBody = PhonebookPeersRequest
+
 // TealCompileTextRequestBody defines body for TealCompile for text/plain ContentType.
 type TealCompileTextRequestBody = TealCompileTextBody
 
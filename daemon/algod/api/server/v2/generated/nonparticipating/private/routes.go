@@ -30,6 +30,27 @@ type ServerInterface interface {
 
 	// (PUT /debug/settings/pprof)
 	PutDebugSettingsProf(ctx echo.Context) error
+	// Stops draining the relay.
+	// (DELETE /v2/admin/drain)
+	StopRelayDrain(ctx echo.Context) error
+	// Returns the progress of the draining of the relay.
+	// (GET /v2/admin/drain)
+	GetRelayDrain(ctx echo.Context) error
+	// Starts draining the relay.
+	// (POST /v2/admin/drain)
+	StartRelayDrain(ctx echo.Context) error
+	// Streams a snapshot of the ledger.
+	// (GET /v2/admin/ledger/snapshot)
+	ExportLedgerSnapshot(ctx echo.Context) error
+	// Removes peers from the network phonebook.
+	// (DELETE /v2/admin/phonebook)
+	RemovePhonebookPeers(ctx echo.Context, params RemovePhonebookPeersParams) error
+	// Lists the entries of the network phonebook.
+	// (GET /v2/admin/phonebook)
+	GetPhonebook(ctx echo.Context) error
+	// Adds peers to the network phonebook.
+	// (POST /v2/admin/phonebook)
+	AddPhonebookPeers(ctx echo.Context) error
 	// Aborts a catchpoint catchup.
 	// (DELETE /v2/catchup/{catchpoint})
 	AbortCatchup(ctx echo.Context, catchpoint string) error
@@ -79,6 +100,92 @@ func (w *ServerInterfaceWrapper) PutDebugSettingsProf(ctx echo.Context) error {
 	return err
 }
 
+// StopRelayDrain converts echo context to params.
+func (w *ServerInterfaceWrapper) StopRelayDrain(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StopRelayDrain(ctx)
+	return err
+}
+
+// GetRelayDrain converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRelayDrain(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRelayDrain(ctx)
+	return err
+}
+
+// StartRelayDrain converts echo context to params.
+func (w *ServerInterfaceWrapper) StartRelayDrain(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartRelayDrain(ctx)
+	return err
+}
+
+// ExportLedgerSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportLedgerSnapshot(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportLedgerSnapshot(ctx)
+	return err
+}
+
+// RemovePhonebookPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) RemovePhonebookPeers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemovePhonebookPeersParams
+	// ------------- Required query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemovePhonebookPeers(ctx, params)
+	return err
+}
+
+// GetPhonebook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPhonebook(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPhonebook(ctx)
+	return err
+}
+
+// AddPhonebookPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPhonebookPeers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddPhonebookPeers(ctx)
+	return err
+}
+
 // AbortCatchup converts echo context to params.
 func (w *ServerInterfaceWrapper) AbortCatchup(ctx echo.Context) error {
 	var err error
@@ -175,6 +282,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/debug/settings/config", wrapper.GetConfig, m...)
 	router.GET(baseURL+"/debug/settings/pprof", wrapper.GetDebugSettingsProf, m...)
 	router.PUT(baseURL+"/debug/settings/pprof", wrapper.PutDebugSettingsProf, m...)
+	router.DELETE(baseURL+"/v2/admin/drain", wrapper.StopRelayDrain, m...)
+	router.GET(baseURL+"/v2/admin/drain", wrapper.GetRelayDrain, m...)
+	router.POST(baseURL+"/v2/admin/drain", wrapper.StartRelayDrain, m...)
+	router.GET(baseURL+"/v2/admin/ledger/snapshot", wrapper.ExportLedgerSnapshot, m...)
+	router.DELETE(baseURL+"/v2/admin/phonebook", wrapper.RemovePhonebookPeers, m...)
+	router.GET(baseURL+"/v2/admin/phonebook", wrapper.GetPhonebook, m...)
+	router.POST(baseURL+"/v2/admin/phonebook", wrapper.AddPhonebookPeers, m...)
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
@@ -184,231 +298,274 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3Mbt5I4+lVQ3K3yY0lKduzsiW+d2qvYeWjjxC5Lyd5dyzcBZ5okjobAHAAjkfHV",
-	"d7+FxmMwMxhySNFOUvv7yxYHj0aj0Wj08+MoE6tScOBajV58HJVU0hVokPgXzXMJCv+bg8okKzUTfPRi",
-	"dMYJzTJRcU3KalawjFzDZjoaj5j5WlK9HI1HnK5g9CIMMh5J+GfFJOSjF1pWMB6pbAkraqfVGqTp+/5s",
-	"8j+nk68+fHz+t7vReKQ3pRlDacn4YjQerScLMXE/zqhimZqeufHvdn2lZVmwjJolTFieXlTdhLAcuGZz",
-	"BrJvYc3xtq1vxThbVavRi9OwJMY1LED2rKksz3kO675FRZ+pUqB712M+DliJH+OoazCDbl1Fo0FGdbYs",
-	"BeM6sRKCX4n9nFxC1H3bIuZCrqhut4/ID2nvyfjJ6d2/BFJ8Mn7+RZoYabEQkvJ8EsZ9GcYlF7bd3R4N",
-	"/dc2Al4KPmeLSoIit0vQS5BEL4FIUKXgCoiY/QMyTZgi/3nx5iciJPkRlKILeEuzawI8EznkU3I+J1xo",
-	"Ukpxw3LIxySHOa0KrYgW2DPQxz8rkJsauw6uGJPADS28H/1DCT4aj1ZqUdLsevShjaa7u/GoYCuWWNWP",
-	"dG0oivBqNQNJxNwsyIMjQVeS9wFkR4zh2UqSFeP6y2dtOqx/XdF1F7xLWfGMasgjALWkXNHMtEAoc6bK",
-	"gm4QtSu6/vvp2AGuCC0KUgLPGV8Qveaqbylm7qMthMM6gejLJRDzhZR0ARGep+RnBUhJ+FWLa+CBOshs",
-	"g59KCTdMVCp06lkHTp1YSEQHUlQ8xagIfnBo7uFRtu8xGdQ7HPFu+zfFFu5TG+oLtrjclEDmrDD3JflH",
-	"pXQg4Erhti+BqBIyw3tzYoYxyFdswamuJLy44o/NX2RCLjTlOZW5+WVlf/qxKjS7YAvzU2F/ei0WLLtg",
-	"i54dCLCmzqnCbiv7jxkvfVT1OnmXvBbiuirjBWXxWTC0cv6qjzLsmP2kkWaQZ0FuwP1xY12uz1/1sdTt",
-	"PfQ6bGQPkL24K6lpeA0bCQZams3xn/UcSYvO5e8jK16Y3rqcp1BryN+xaxSozqz8dFYLEe/cZ/M1E1yD",
-	"vQojMeMEme2Lj7HkJEUJUjM7KC3LSSEyWkyUphpH+lcJ89GL0b+c1ILeie2uTqLJX5teF9jJXMYSDOOb",
-	"0LLcY4y3RnhEUavnoBs+ZI/6XEhyu2TZkuglU4Rxu4kodxlOU8AN5Xo62usk38Xc4b0Dot4Ke0narWgx",
-	"oN69ILbhDBTSvhN6H6iGpIgYJ4hxQnlOFoWYhR8enpVljVz8flaWFlVjwuYEGN7nsGZKq0eIGVofsnie",
-	"81dT8l089i0rCiJ4sSEzcPcO5GZMy7cdH3cCuEEsrqEe8YEiuNNCTs2ueTQYuewYxIhS5VIU5grcSUam",
-	"8feubUyB5vdBnf/y1BejvZ/uUKJ3SEVqsr/UDzfysEVUXZrCHoaaztp9D6MoM8oWWlLnNYKPTVf4C9Ow",
-	"UjuJJIIoIjS3PVRKuvES1AQloS4F/azAEk9JF4wjtGMjkHOyotd2PwTi3RACqCBpWzKz4tUt08ta5Aqo",
-	"n3beF39tQk7tOTEbTpmRjUnBlDbCEG6mIksoUOCkQbEQU9FBRDOAFrYsIsB8K2lpydx9sXIc44SG95eF",
-	"9Z43+cBLNglzrLao8Y5QHczMdzLcJCRW4dCE4etCZNffU7U8wuGf+bG6xwKnIUugOUiypGqZOFMt2q5H",
-	"G0LfpiHSLJlFU03DEl+LhTrCEguxD1cry5e0KMzUXW7WWi0OPOggFwUxjQmsmDYPYMbxBCzYDXDLeqbk",
-	"G5otjTBBMloU41ovIcpJATdQECEJ4xzkmOgl1fXhx5H9QwnPkQLDBzWQaDVOpzEll0uQMBcSH6oSyIri",
-	"5bQyz6OyaPYJzFXRFbRkJ7wsRaUNjNHL5fyVXx3cAEeeFIZG8MMa8cEfDz41c7tPODMXdnFUAipaGM+K",
-	"Kq/xF/hFA2jTur5qeT2FkDkqeqg2vzFJMiHtEPbyd5Ob/wCVdWdLnQ9LCRM3hKQ3IBUtzOpai3oUyPdY",
-	"p3PHycypptHJdFSYftFZzoH9UCgEmdBuvMH/0IKYz0bAMZRUUw9DOQVlmrAfeGcbVNmZTAPDt7QgK6s3",
-	"IyXNrveC8mU9eZrNDDp531hVndtCt4iwQ5drlqtjbRMO1rdXzRNidT6eHXXElK1MJ5prCAIuRUks+2iB",
-	"YDkFjmYRItZHv9a+FusUTF+LdedKE2s4yk6YcQYz+6/F+pWDTMjdmMexhyDdLJDTFSi83RpmEDNLrao+",
-	"mwl5mDTRMU3UCnhCzaiRMDVuIQmbVuXEnc2Eetw2aA1EgnppuxDQHj6FsQYWLjT9BFhQZtRjYKE50LGx",
-	"IFYlK+AIpL9MCnEzquCLp+Ti+7PnT57++vT5l4YkSykWkq7IbKNBkYdOz0eU3hTwKPlwQukiPfqXz7xB",
-	"pDluahwlKpnBipbdoayhxT6MbTNi2nWx1kQzrjoAOIgjgrnaLNrJO9vvbjx6BbNqcQFam0fwWynmR+eG",
-	"nRlS0GGjt6U0goVqGqWctHSSmyYnsNaSnpTYEnhuTW9mHUyZN+BqdhSi6tv4vJ4lJw6jOew8FPtuUz3N",
-	"Jt4quZHVMTQfIKWQySu4lEKLTBQTI+cxkdBdvHUtiGvht6ts/26hJbdUETM3GsAqnveoKPSaD7+/7NCX",
-	"a17jZusNZtebWJ2bd8i+NJFfv0JKkBO95gSps6E5mUuxIpTk2BFlje9AW/mLreBC01X5Zj4/jo5U4EAJ",
-	"FQ9bgTIzEdvCSD8KMsFztVOb462BLWS6qYbgrI0tb8vS/VA5NF1seIZqpGOc5X7tlzP1EbXhWaQKMzAW",
-	"kC8atPpJVV59mLJQPFAJSA2mXuNntAi8gkLTb4W8rMXd76SoyqOz8/acQ5dD3WKczSE3fb1GmfFFAQ1J",
-	"fWFgn6bW+Ics6GVQOtg1IPRIrK/ZYqmj9+VbKT7BHZqcJQUofrDKpcL06aqYfhK5YT66UkcQPevBao5o",
-	"6Dbmg3QmKk0o4SIH3PxKpYXSHq8dc1CzSkrgOpZzUZ/BFJmBoa6MVma1VUm0SN0vdccJzewJnSBqVI+b",
-	"Q3DVsK3sdEt6A4QWEmi+ITMATsTMLLr2csBFUkVKIzs7sc6JxEP5bQPYUooMlIJ84vTZO+H17ez9o7cg",
-	"D1eDqwizECXInMpPs4Lrm53AX8NmckOLyojnP/yiHv1ZFqGFpsWOLcA2qY1oq++6S7kHTNuIuA1RTMpW",
-	"W2hPghGxDdMpQEMfsu+Pvd7tb4PZIYJPhMAbkOhR80mPlp/kExBlgP8TH6xPsoSqnBgxsFf9YCRXs9+c",
-	"cuFlwx0zhAkKqvRk15ViGjX0JmapERdP3SI4cI88+ZoqjWIgYTxH/a29CnEeK1uaKUZ7OpXhlL2vMTPp",
-	"L/4h1p02M9c7V5UKrzJVlaWQGvLU8tBm3TvXT7AOc4l5NHZ4+mlBKgW7Ru5DYDS+w6NTBOAfVAcLtbN5",
-	"dxeHXgdGfNnsi+UGfDWOtsF44VtFiI+dantgZKreA0tuTLXobSZEARRVpkqLsjQcSk8qHvr1YfDCtj7T",
-	"P9dtuyRpzUBWUskFKDQxufYO8luLdIW2riVVxMHh/RNQ4WVd5Lowm2M9UYxnMNl2XvARbFrFB+eg416V",
-	"C0lzmORQ0E3C28J+JvbznoThx0YCqfUHQsNkhtbENI3UZ8L7mx42q8CpVErwJviFZOacm2dUTWqu9+GT",
-	"5oDTpvimI9YHYRYEI0kHfjxElqWnxIh4998IbcjKER2uxt1K91xLD/bCrJ8EgTjupFYEtGf/b1Bu7iCA",
-	"HXX+Dai+hddTH2vZPep/vNsbF2brKmvdNskropcv72CMfTyoxxbxlkrNMlbic/UH2Bz99d6eIOkrQXLQ",
-	"lBWQk+iDfcmXcX9i3ZDbYx72mh+kbu2C39G3JpbjPbOawF/DBtUmb21EQ6StOoY6IjGquXApJwio95o3",
-	"L564CaxppouNEWz1EjbkFiQQVc2s10rXhKZFOYkHSMdM9c/oDPJJc/hWD4ELHCpaXsrz0L62tsN32Xpy",
-	"NdDhXlmlEEVC/9k+8R1kJCEY5C5ESmF2ndGi2BAdwmY8JTWAdBcEemMEeeaBaqAZV0D+W1QkoxxfuJWG",
-	"IKQJiZIPCstmBiNuhjmdq2qNIShgBfY1j18eP24v/PFjt+dMkTncWpcbjg3b6Hj8GFVxb4XSjcN1BG23",
-	"OW7niUsHbZXmknWvtjZP2e3k5kYespNvW4MHA6c5U0o5wjXLvzcDaJ3M9ZC1xzQyzMEPxx1kvmu6hHXW",
-	"jft+wVZVQfUxDJVwQ4uJuAEpWQ47ObmbmAn+zQ0t3oRud+MRrCEzNJrBJMMowYFjwaXpYwMLzTiMM3OA",
-	"beDIUIDg3Pa6sJ12vLRrv2W2WkHOqIZiQ0oJGdgoOSOlqrDUKbEhE9mS8gW+gKSoFs7V2Y6DDL9SVhMm",
-	"K94ZYl9RTK/5BE0YKhmmhmZLH21phDCg5mXbtn/Yx9otDaDYy2jQpR1tT9selDSZjke9D3+D75v64W/x",
-	"1gwZPdSY2JAPI6TV0Ay0niE+jazURWK8jebwGWL4NFaaeugUlN2JI6fw+mOfX/hFVZbF5ghCkh2ISCgl",
-	"KLzSYjWgsl/FnPzIMinOioUId57aKA2rrvHGdv2157i+O+QFLHjBOExWgkPiSf8Gv/6IHwerHe013DMi",
-	"CkR7Ddh++DSQ0FpAc/IhJH3fTUKSaZ/9tqVTfSvksazsdsDBb4oBluudbh1uykPt67QoEiZpq37ocBE1",
-	"Dk7hTBKqlMgYCornuRo773NrxbZu7S30vw2hUUc4wO1xW7bXKAzLKvKhKAklWcFQzS+40rLK9BWnqOmL",
-	"lppwFvTKgX618EvfJK2HTqiJ3VBXnKKjaND/JR2D5pDQQ30L4LXDqlosQOnWA2sOcMVdK8ZJxZnGuVbm",
-	"uEzseSlBosfe1LZc0Q2ZG5rQgvwOUpBZpZtPjlWlNFGaFYUzBJtpiJhfcapJAVRp8iPjl2sczvuR+CPL",
-	"Qd8KeR2wMB3OuBbAQTE1SXs6fme/YlCJw8nSBZhgrIX97D2e69wQI7P2RtKK//fhf7x4fzb5Hzr5/XTy",
-	"1b+dfPj47O7R486PT+/+/vf/r/nTF3d/f/Qf/5raPg97KhjcQX7+yr3Rz1/hQyyKE2nD/mcwyKwYnySJ",
-	"MnYoatEieYj5MhzBPWrq/fQSrrhec0N4N7RgueFFRyOf9jXVOdD2iLWorLFxLTWeR8Cez6F7sCqS4FQt",
-	"/vpJ5Ln2BFsdbuItb8UYOM6ojg6gGzgFV3vOlFvtg+++uSQnjhDUAyQWN3SUWiDxgnERjA0vH7NLcWDX",
-	"Fb/ir2CO70HBX1zxnGp6Yk/TSaVAfk0LyjOYLgR54YMiX1FNr3jnGupNIBUFNUcZpFKcgq7Sa7m6ek+L",
-	"hbi6+tDxQ+jKVm6qmIu6c9ZVk/kpJ0ZuEJWeuCQuEwm3VKZsIT7Fh4uGxt5b4bAyiaisEssniXHjT4dC",
-	"WZaqneyhi6KyLAyKIlJVLl+B2VaitAiBY4aZu9hbQwM/CedUIumtf/JWChT5bUXL94zrD2RyVZ2efoEh",
-	"eHWKg98cDzR0uylh8MO3NxlF+72LC7dyOTqVT0q6SNlMrq7ea6AlUggKHCt8aRYFwW6N8EAfCYBD1QsI",
-	"sch7bImFbO+4Xlzuhe3l03qlF4WfcFObsdP32sEoKv7gDdwRWU8rvZwYjpBclTLHwO+VTzBAF+bK8R4E",
-	"ii3wAaCWojJLBpItIbt2ma1gVerNuNHdO7q4u9gzHKZQZ+SCA+fM4C+j3AxYlTl1ggzlm3aKG2WDIXDQ",
-	"d3ANm0thu08HZgeLstFFKVZU39FF2o3uWkO+8UF2Y7Q33/ld+RhRl44E4y49WbwIdOH79B9tKwAc4Vin",
-	"iKKR56MPEVQmEGGJvwcFByzUjHcv0k8tj/EMuGY3MIGCLdisSLDp/+raNTyshiolZMBufFRvGFARNifm",
-	"dTSz17F7MUnKF2AudXMRC0ULdNqfJg39KB0ugUo9A6q36mt5nGbCQ4cC+S0GTaPSZGyWAGuz30yjEoTD",
-	"rXng4dvbtnGOxNOD3KnsmiA/EFTfvQ6Snh7yiHAIT+Sz8/d92JPwXnD+aTF1Isj2+8rgcCHFrdlNA6Dw",
-	"qRsxwUt0T1WKLmDoddQwFQ1MidGwAOEgu6SfpLwj5m2xpiNjDFyE7T4xeElyBzBfDHtAM0DLxdHPbU2I",
-	"zqrwhhcbj9RZgQJ1cBC1pENlw87GF/sBm2ZjIHktrHrAmliLj/6SKn/083HE0Q+UFv+YVDLb8uedR953",
-	"VHez4/lrus3ax1afMwMiuOnhs+j51Hk+X95ovFfuu/HIhTik9k5wlKJzKGBhcWIbezqr8zPVu2ngeDOf",
-	"I9ObpBz5ImVkJJm4OcA8xB4TYjXmZPAIqVMQgY2WdRyY/CTiw84X+wDJXX4p6sfGuyv6G9LBgtYb30jJ",
-	"ojS3PuuxWmWepbj0FrXI03JxxmEI42NiOOkNLQwndYGn9SCdXG349mllZnO+HY/63kQDD5pbI0one63S",
-	"yjOHrC8WvP0y0q+CvdYwE+uJjYxOPq1m65k5E8l4BYzTTh1emznvgSIzsUafIrzhrIP73tD1Q+YBi9xA",
-	"1kwhlWO/PrHRgrcfINsF+RQ1KyQ9p1cLZNcnyR4GTI843Ud2D6MUekcCqaXArNOAO43OTj1LU9rqSiL1",
-	"dTsO2WFDmFqK1fQdzuRO9mC0qzxt5rr7vk532J8czZ/Vz5Lkr6uUu09eRtu5tLkW90nL2CaHBhBbsPq2",
-	"LcQm0dp0XGriNcJaiiUZRt81dnXRpqAA1ARMGnL15Dpllr66eq8AZYYL3y3Sc+LuUb55FHnDSVgwpaE2",
-	"Lngnl89v+0F1onlsiXn/6nQp52Z974QIgoY1x2LHxjI/+wrQdX3OpNITtMwkl2AafatQk/ataZoWhJv+",
-	"dkxZU8/ecjBCdA2bSc6KKk3KDqQfXhmIfgo3l6pmeFEybr2NZpgKP+mgu4dtEuGxjt1bEfTaIug1/Rz4",
-	"GXawTFMDkzSU15z+L3LEWrxwG2dJ0HKKmLob2ovSLbw2iqXvMtpIiI7cLqbbbD6dc5n7sXd6Y/mI/j4h",
-	"wo6UXEuUETEdQCgWC8h9pjcXFGqzXrl8eoXgizqXoPl9S/rAKbFZ/DAJ35b8fc49Hfqc0xvlRLAqRhL6",
-	"+DGDkNfRdZh7ECdZALeZW0b71xspkoiLHeOxRaQZ/by8veM2n3Qdvmy5C9c+vXYPw2bj9hRAc/esUuDX",
-	"t/3QdrfLoW7c53TcSBG7/YDhgEhxTKtIgOkQTQ/npmXJ8nXL8GdHnR5AEgPFvW4m+BbOkC25wXbgp+lY",
-	"vKNWzwNzO2J7Z+w4wWf+iXlkWn9m55FrzgbNXLaBvJJoTWp4C3fz6YeH5sC1//DLhRaSLsBZBCcWpHsN",
-	"gcvZBw1RSnpFNLMO0jmbzyG2hKlDrDgN4Dr2jnwAYfeQYNdcFt6WW+mzS2Q7aKtewW6EpukpQSl9PheX",
-	"XXukf3hEurVw2UQbd4BRMZlQ4AfYTH6hRWVeQkyq2jfVGQib1/oeNHGz+gE2OPJOl08D2I5dQVXcO0AK",
-	"TVlXwicVZQl/oBrVF/AN3NjCPXbqLL1LR9oaV0qj/2jUN1SjnkRzKZ/u2NQuMgbSIXt1kfY6MWcLmtvS",
-	"JvRdW8Ty3bJP9ASJp2LovXHIJRcybez0LgNaeMLHxY7uxqP7+Xuk7kk34o6deBuu5uQuoDemtf83nL72",
-	"3BBallLc0GLi/GT6hA4pbpzQgc29W81nfl+lT8XlN2ev3zrw78ajrAAqJ0HV0bsqbFf+ZVZlS3Bsv4Zs",
-	"Onan27WqsGjzQ8rs2JPmFlOvt7RpnVo3td9UdFCdZ8087Sm+k286Fy+7xC2uXlAGT6/aIm0dvZrOXfSG",
-	"ssIbfj20Q7XsdrnDqisl+UQ8wL2dxCLvv3uP1RsncHX1/sZjtranWEepkBI/4UunDvR07vCa9FmtaX0H",
-	"h8R1vsFMpul3F3d5TpExOoczenQ58FshGxeVi2pMOqx9OgHRPCYsHtNG+Utnhe+IhVNiRcjfFr8Z3vD4",
-	"cXzwHz8ek98K9yECEH+fud/xHfX4cdIwnFT1GZaFmjxOV/AoxEX0bsTnVUNwuB0mLpzdrIKMLPrJMFCo",
-	"9Tzz6L512LuVzOEzd7/kUID5aTpEVRFvukV3DMyQE3TRF5UYnJ9XtpynIoK3Y/AxStaQFl49roKHtbN3",
-	"jxCvVmh3nqiCZWmnHz5ThiVx69JrGhNsPNiGbOaoWI9fOa9YNLpppg4yebYWEs2aRLhKZgKu8TsTjgVU",
-	"nP2zgqisL97ErcvZP4Vw1I6AndYvuoHbVYNHhxT8vb+J0GvVtimMtppcXwUzoEdEqs7UnvEO8Ywd5r8l",
-	"VsFRlL8+MbBt6VyHd1LW1nfe9iLQzgzs2aezuPY/kFw5TLuZr4bsNFOTuRS/Q1p2QCNhInWHt24zVMD/",
-	"Djzlo9pmZMFzoC5YXc++i0CG6xb6SOXeugS/6FA175ArPM0n9tvoPZUG0X73qw1UOr2424S+h2rseNIM",
-	"pOlhZnhgI7dwrOXj3d0otyfU5rVoRJ6lz3kcKHpix6/PuYO5E1xb0NsZTRU6Mu9FA1O0/Q3HPC2I7+w3",
-	"SIXUDHZ2EsUyhLbMJvsrQdbWo26q5APffnbawa+++pGHFBc/78bWV6VQIjFMxW8pRz9C7Gc5oOutwPph",
-	"mF63QmKCT5X2IcwhY6ukMvzq6n2edT2/crZgtqR4pYDQuXZ5Ht1Atqi8pSJXzTvkInGoOZ+T03F9Zv1u",
-	"5OyGKTYrAFs8sS1mVOEFHXwiQhezPOB6qbD50wHNlxXPJeR6qSxilSDhfY6iZ/CEnYG+BeDkFNs9+Yo8",
-	"RIdhxW7gUfqCccLa6MWTr8bbKmcjxrFI/DYmnyOX94EMacpGr2o7hmGrbtR0ZMJcAvwO/ffJlvNluw45",
-	"XdjSXUG7T9eKcmoQkoJptQMm2xf3F105Wnjh1joDSkuxIUyn5wdNDcfqiSY3DNGCQTKxWjG9cp6iSqwM",
-	"hdVlyO2kfjisr+fLoHm4/Ed0wS4Tb/w/4LlFVz0RjuhV/xPa22O0jgm1GVsLVsdf+Aq15Nxnpsa6cKEc",
-	"nMWNmcssHeVVDMeYk1IyrlFrVOn55G/m+S5pZhjitA/cyezLZ4n6as0SRHw/wD873iUokDdp1MsesvdS",
-	"jutLHnLBJyvDUfJHdUqH6FT2+oqn/Xv73I57hr63dG3GnfQSYNUgQBpx83uRIt8y4D2JM6xnLwrde2Wf",
-	"nVYrmSYYWpkd+vndayeJrIRMVbqoGYCTSiRoyeAG40vTm2TGvOdeyGLQLtwH+j/Wu82LpZHo5k938rEQ",
-	"WZUT77SQVslI+r/8WOfHR+O2jdttaS+FTOhpncbxM7ul7qcvbNvQrTsgfuvB3GC04ShdrPSEe9h4jtDn",
-	"j/D3aoNk97yhKn3yG5HmHY+y/uPHCPTjx2MnKv/2tPnZsvfHj4e7zKb1hebXBGoOu2va2StN39RWfy0S",
-	"2jtfxTP4jblUJQkNa/IuM1fqzI0xJs1SiZ9f7jhOvOLebsjpA+RRg5/buPmD+StuZh0B088fmtVjk+ST",
-	"h+9RDAUlX4v1UCJqXVuenv4EKOpByUCtIK6kUx036Smx080nIlsz6gwKYV6qcQGswV4rf6FdMKgZb9mL",
-	"ihX5L7UVunUzScqzZdKpfGY6/mqfAVGDSIORLSnnUCR729fyr/5VnXj3/0P0DLtiPP2pXYjZwt6CtAar",
-	"CYSf0o9vcMV0YSaIUdRMyBVSnBQLkROcp65cUrPGbkXzVCXZRIw/DruqtPNKxuQJrqDInBXoRpu2h2PL",
-	"iaS6h6ti2X9f4sqMg1X4lVVL2NFBEspWeG0ruioLwEN4A5IusKvg0OqOGdtw5KgsCVGl+YQtMfmLILqS",
-	"nIj5PFoGcM0kFJsxKalSdpBTsyxY49yjF09OT0+HGRkRXwPWbvHqF/6mXtyTE2xiv7jKX7Zgwl7gHwL9",
-	"XU11+2x+l7hc+dV/VqB0isXiBxuQjRZic6/b0quhTPCUfIf5yQyhN0oEoFLUZ1hu5gStykLQfIxJoS+/",
-	"OXtN7Ky2jwREHZZ+XaAGsHlEkkae4TlSff61ntxVw8fZnjrHrFrpSSjKmsqkaFrUtWRZy/sJdYMxdqbk",
-	"lVXLBsceOwnB1OJyBXlUA9aqAZA4zH+0ptkS9Z3T0VaVck81oOEljD0HrM1FUdxrKJiFHNwsw1UxtkWM",
-	"x0ToJchbpgDzTsANNBM2hmynTiHvEzg2Vysrzi3hTPeQXkN5rH13wQNnRV/vX5GErLUP97b91Zk8sMj5",
-	"vsWeL7BXOm6nVTm65fdgS2asfdGNKfnRGTsyygVnGRabSIngmIpxmFl1QF2OtL1TjdxZThzDZL3qEKDu",
-	"sNhbwdqzTIe4rlND9NXstyUc+6eGtSsCuACtHA+EfOzLxzsDHeMKXAE0Q18xRxUy4fqVDIsJLiRHdEkf",
-	"jzCbWo+u9Vvz7Senm8ecMdeMo87NIdW9BK2BrVAM7eycME0WApRbbTMuTL03faaXa44gfJi+FguWXbAF",
-	"jmFdEQ1SrBdwd6gz7xPsfHBN25emratdEH5uuNTZSf26PyRZiAr7n6q53ov+lO+Xd6SJkBvGj0fbQoxb",
-	"Xf3xXjZkCDfo+Qcl3ucdsgnl65ujfGOerJbesAWxkbvJtMGMJ8B4zbg3+KbzYGXJuwQ3Bk9zTz+VSart",
-	"o2MQx7sEWvSEw2BQvfUYuO9Q7UoMBiW4Rj9H/zbWlfd72EpoUL8uKN8QfygMdUdCyUtaBGf4RB19lM6c",
-	"MGadhVuV9VNsxbD1iQ/NbaBrZyBo6I7VUPa9p/qyjc6qfAF6QvM8lXfua/xK8KsPKIQ1ZFUoAhbiTJvp",
-	"2rvU5ibKBFfVastcvsE9p8uZokrBalYkXG9fhY+Qhx3GRFSzDf6bqoDVvzPO6X3v6G/v4Z7vV6OgG82e",
-	"kp4NTU8UW0yGYwLvlPujo576MEKv+x+V0n3g958irrvF5eI9SvG3b8zFEafp7vj426slZNFGf3qB330+",
-	"sJDJtcmV8Crr1HlDjwzcvMSWtYD3DZOA39CiJ+NCbLWx96u1ZPTlXch604pQ7bLXaUpqnjBEhdGf/8t6",
-	"YLcsQ13zZp+PtXWx/pTGE4ePrUjvtzT+0LArWq+3mqH02hMPM/nVRLCvzc+VYujqS2lRiGwwZ3DDnJlO",
-	"/al6xWrlMt8nvPJuViKPz0LszQWQZmzWYTkRWoEP2+Q3fFolv8jb9GgN/UggmqFZyxCNbgljG5jpwfPA",
-	"2KnjiSKVrcMs+ZYVWBzqPy/e/DTq38hoB7pb6lJnJ1XYfRsTItXa5LEQDXxs4QGCF2n9t+pRqWNuqPRp",
-	"cNWJkx++tQrCISDZPEn7tH49dPAOASyErQqVqpvRzU4zqrfDIz+ihnp7LUeJqSNFFe1qS4m3j1V61k1I",
-	"KEQ6qDBpQ0YaUtwpVUfIvRS8BtZeNC4fnS2u1KnL1GGgr4YIhx183I1H5/le4lOqFtXIjpJisK/ZYqm/",
-	"LkR2/T3QHKStJ5J6TtpqIiswz1C1ZCW+f0qhWF0PuDCDuUTeSxxuOjQ053IJLiuMTxLQGcs7UN9AprE+",
-	"dO0GKgGG+zmU6SUaCLxBEZv8Aa4gEiCHUi+3CkvWubvUy7psKLjIM6bIDJzp4gb4mLApTNvBanmdFIoU",
-	"QOdeCSuF0APq6oawJURjDHSKvjo1mreLgZ2cb1FKQ1tKdzq8CMtZiAmwgZa3VNWZo1ppFAaHa8/nkGHC",
-	"+63p9/5rCTzKxzb2qjuEZR5l42MhXBBLNhxVo13Dui0R3lZQo5pUnxLSvoQY17B5oEiDhpIVgUOE7SEZ",
-	"4BE51o7riwr0mTacYyRTgZ4QQd4P3iXgr2ssHVIEIMpOeSAYnsbN9VRnrDwMGi/RHACG6Tq9V9H+Oh0e",
-	"CqZ92f261dX7X8qvsJi9ck6lNKSbj/VJ5LxbjvnWpavHRIvBWugT14Pyv/kErXaWgl27CjWIMGubvaUy",
-	"9y2OkibP3pssDfQ8zMzqwKiul8++fjk2QjErhBGAJn2Boc1IpeDC+0BZX+s6aRlCPQcpIQ82wUIomGjh",
-	"w6z2SP7pwie3YM96mR+Et5ZH/x4hw3ZFvTUU3tWFJLAcJMWaCdQ5n8dYIRJW1EAvo+IOaTXorh16ab/7",
-	"nCK+vN929Wof3sO52F0h24feMdXBfHy65sQJB3tzr0YikgM0s4xzkBNvxG2XduDNNJmYVzmvMiuqxGcz",
-	"aK8Hpx3bws2SSs2su8rWEyrKynENmxOr9vFVx/2Ox0BbGdKCHiWUbhHFUXXVKgX34ijg/bHpO0shikmP",
-	"ZfC8W4+ifRiuWXYNmJg1RKYYKfhB89iYSchDNEgFn5Hb5cZXWyhL4JA/mhJyxm10oHcfaVYgbU3OH+ht",
-	"869x1ryyFWacBnp6xdNhVljpRd6T+/lhtvC8Pt6kwPDLe85vBzlgdr3mfT5yt1gSplkneDpUvdH172iJ",
-	"UBH5WShSAtSFNQS/RJaQeEcRzM4SpRFC/wBKnAGZqEKkvPAPySBjhkpjKp4MAdLABzxXayjc4EkEOCe7",
-	"HVlZ3Wefd1TMiYTaN+PQBKwup6ll4qpPNdKeOczS5IxzISGeEf1MbaLmENmGeY7xPzOmJZWbQ9KkNlGV",
-	"UkP1Ynmnt2RwlKwXUjtLdnFYFOJ2gmxtEqorpdQBpp1qXtu+Tmndzxz1GURul1Q5EXFDljQnmZASsrhH",
-	"OsTbQrUSEiaFQC/MlGPHXJtHwgrjOjkpxIKIMhM52EJoaQrqm6vinKLsBZErWxIFlnYwZYDtE9HxwCnN",
-	"7WvNsxOU13YW2vCbf2n62PQVdfo7u+iJdRHoiS8A5dLdOQzZxl14kXBsRqa2UjYtIs/ZGukGZOrIz4mW",
-	"FYyJa9Guwu8OPpVAVkwpC0qgpVtWFJg9gq0jh4bgD5RGbY/sfI5+0DcMHd6amUSsSF2a2zGkX4l5wEWc",
-	"kY3opRTVYhnVBwhw+qe7rNzDPh7lZ1WhTyKGiJopnpGVUNo9i+1I9ZJrF9CHmeBaiqJoKvKsnL9wRt8f",
-	"6fosy/RrIa5nNLt+hI9wLnRYaT72KRXavrv1TLKVg3HYS0Gv+QTJQ+1Os27boVero+fBvLPF/TqGh12a",
-	"/AjMD7uZ6267xll3Ye11Nfls+i10xgnVYsWy9HH7a3m/9vqsprhXMtOirUJss9BgM+QD8T0W3JmQe3bR",
-	"DJwmy6ieEccjnFsHciLzXxTj2+OSOTge1HOHdvmOE7AmWa8Y2AIAIbWJEHQlbeniWEgLDEcsbOIUdEpp",
-	"AzrwwkHfv/vBZkY4OlAa7gVUxxs5APjQajDGNiOm9WyeibX//qhOmXkQ8HfbqbzBPPqcKi9q0pLWrdIn",
-	"surhCOkCBFs9EC8xCcZsqB9iKEU/8PKPAOj3TGzAMMg/cV8w5pQVkE9SVYrPgw5sHD3XXYxlNLqv52g5",
-	"eUYrXwnYjF1JcImVrPQvm+bEkhpSEqF5VyPOc1iDjdH6HaSwdXzHkTkLClvmt6VREOWkgBtoOGy6bE8V",
-	"SqHsBnxfFTqTHKBEi29b0ZbyRIyrBLa0L27tk8iXbQh2k+oYi1i7U2SHriWpGVrziT0mauhRMhDdsLyi",
-	"DfypfUWOpi7RHOUEqjrPh4l/Yg6d5mc7wjs/wJnvnxJlPCY+DONDe7OgNOq2MaCdnsmV6jv1PO2YHKcy",
-	"C4YinC0Pdm1L4jXfUCW95f1azS7J1y+xgfvEBI8Q+80aMpRq3FMIcvcY6rGcuBxISO0cILcPBtMloc1f",
-	"AidcRDWPb6kKr5g6q6v/wU6MjRh3D+0DbPS1//D9d5bgYES1ki2mS5QGsr6fjv8POYlbD2LveCkaUeBC",
-	"ebeoxjx1u2cHNhBVkRNu9tPI/lgj2N1ijouPyazyAxWFuLVFjOMn6ivw9lxLfd7E5MRyFq5l7yc9dgmH",
-	"21oQFkWIrOiGCIn/mAfpPytasPkG+YwF33cjakkNCTkDsvWicH7XZuLt4tXYA+YVMcJPZdfNho4ZDbcx",
-	"o0RAm4vcl20TZEWvId4GdBCx/DPThnGqaoZKDXNlt7aziwW3eJ+eaUXzWAmAiWY3De7gE56b3v9XHbYa",
-	"T+XzP5YFzXzJald8rslnsKq9Jy69hNX2MOcuX/MkECrl10QrfZqM/ABt6p6sKxXz01ccqwF2pwR4py7Y",
-	"vZYxUCncqnG0JUB80FKOvQvHieHsLCku9btrcXHl48+zO8kM0X3LGAL+n2hXGu4Vnci2dAX1eD22WPpn",
-	"2IVGIp4ErFYNPhPriYS52uVIY/XgM7GuAVZBd8t4JoEq63d0/sY9W+sEyIybZ7T12g1m1TBKDnPGa1bL",
-	"eFnpxCsI8yDzTYSw2JqAaO2xzfXJGEYUvaHFmxuQkuV9G2dOjy0NHBfp8RYU1zehAAk3cncApuoXIMZT",
-	"1/r5uJm5/m2BQes7qzTlOZV53JxxkoE0UgO5pRt1uKkqWB12GatoJAs1s4VEZiskbQtIsXHW5nsakgKA",
-	"9IgWpQGWIHTSTliBrGJIix7DTxeGv4QlaEXXk0IsMOq350C4PNdoOrQPSMFRiW6lu2Hr9vMo9jtsnwZL",
-	"kThGpAXOOmSK7ef+DW4lPkJ/5kxvPflWw9kOw7aezvZgeqTyRR2eYYmlex5TkfMuMVMcPe9FVZ+mxNMe",
-	"RJuYdInuaNV7dhH9K1zahViFPrxYZdOFIxWfb/UKE9Q3qC0BGKDquAKaOQ+xriKuo6iwSBm77AZ76ums",
-	"dt/fSz3goSJFubPenDY46Jhx9qnwuT2fwaQU5SQb4ttqqxXlzsjgIG3C2EMfkQmhZ93B70aF+l2NnGiN",
-	"Ql77FjntLSS2y1ZWZttUBn1Kph6O3jRgiDnyMjzCVrWGsVZBFTP2j3Nv7G4q0QKTIJRIyCqJSuZbutld",
-	"+LEn+/zF92fPnzz99enzL4lpQHK2AFXXNGgVTqxdExlva40+rzNiZ3k6vQk+W4hFnLde+rC3sCnurFlu",
-	"q+pkxJ2ykftopxMXQCo4t1si76C9wnHqsIg/13alFnn0HUuh4NPvmRRFka4pE+SqhPkltVuRAca8QEqQ",
-	"iiltGGHTfsp07ZStlqhcxKzhNzY3lOAZeO2zowKme3y5Ugvp8+lFfoa5GJzNicC6LByvsnaibety7zSr",
-	"30OhEd1tZkBKUTrRns1JCiKM2ZIVBL26U5uiPj1y0w3M1jrspgjROb+nSe+Mu5ewmJPt3L5ZilunOb3Z",
-	"xIR44Q/lAaTZZ93ozzNyCCepDQN/Gv6RSJxyNK4RlvspeEXyfbAlKvys4zURkoYMAq2bICNBHghATzx0",
-	"I2g1CrKLcpNLa2NAa4Q3P7fFjx9rs/TOyBSExHfYAV4cy1y3C8EUDpw/OLH3jwEp0VI+9FFCY/m7wqM9",
-	"6w0XSbRFTmmiNSjLlkRXLIwC4tXLEGfe8yrphKNLITQxL9OiSISxWz0OnqmYcMyTQN7Q4vNzjW+ZVPoM",
-	"8QH5u/7ArThsOUayRaU6ekLO13QQWFGI8meBir/F2Pr/ArOzydvRzeIM/507EFVCtLDe3vNgAQdObnFM",
-	"69j15Esyc+V+SgkZU22Hglsv0oR4W5Bs7vxrYa3bsb/3LhP0i9D3OA5z7w9EfoqMbMFzwMFcH/U/mDn1",
-	"cIDkaUmRaodQEvhL8bq4qPqOa+eepWEOS+UUJW7cM5VTt1z80OXhOvDyqhR01zn41m/gNnHh12sbmqts",
-	"cIWZq6v3ejYkoVi6GozpjjnOjlIW5v5FYT5LgjOLSjeGgyRJWLXIvSt7TctfMsrT0NxFI+73FJBfWvSb",
-	"0fBRMK+4HS8UQMVYcc/WxXwcvBgEN91ekCv+mKgl9W8L9+fT51+OxiPg1cosvv4+Go/c1w+pl1q+TsaV",
-	"1ol0Oj6irprAA0VKuhlaQ64/b04SuXWaoM8vzyjNZukH3fdmw/DV6qIPzjnyeeQt9vp0yXP+92b/2TuD",
-	"WDgrlhjrxEBhH3blCPqlLyG+TfreU+ejxXcrVux0j2uUYLkbjxY2PRnWJfnVVan7vHvuIejJFOiWfp8E",
-	"YBYxibU2Jo+mitK5DSjF4rolamNgzHVWSaY3Fwb/XuHOfr1OpYH6LiRmctm+gu3dSb1aXAP33mV1GqdK",
-	"ebn6O0ELlDutSwA30qYopuQbWxvEXYh/fzD7d/jib8/y0y+e/Pvsb6fPTzN49vyr01P61TP65KsvnsDT",
-	"vz1/dgpP5l9+NXuaP332dPbs6bMvn3+VffHsyezZl1/9+wND6QZkC6iv+fNi9P9MzoqFmJy9PZ9cGmBr",
-	"nNCS/QBmb1C3NsfUhIjUDC9XWFFWjF74n/5vf0VOM7Gqh/e/jlwlyNFS61K9ODm5vb2dxl1OFpj9ZKJF",
-	"lS1P/DyYxbLxUnl7HiKCrNcf7mhtbcJNDZn9zLd331xckrO359OaYEYvRqfT0+kTzKRYAqclG70YfYE/",
-	"4elZ4r6fYP7sE+XK8JzUQaNJO/87DJDxj3m5gJw8DOF//xY8PdQjH0U4d/kn/6EsMYZVnOdIXK5i+ggr",
-	"vqLrJ4L19PTU74V70USC5QnGmr34OLL8I5UIt4PUyxrgJGR1venuon/m11zccoLJfu0BqlYrKjd2BQ1s",
-	"RIPjNtGFQqOcZDeYk9H0buO8LF3xoz6UYz3N5in3nZFAQmUcc8JswRxXwkilUN4tvHRP7G9N/tyZLLE7",
-	"2OitgdknOAsJk9016HCGPiYWYeGMWDVlB9HjUVkl0PkNhvGpbTgbR8V6LDSiyAPGOxh9W/0vwagh3UVI",
-	"/Gv+WgItUC4yf6wMoWb+kwSab9z/1S1dLEBO3TrNTzdPT7y24eSjyyR1t+3bSex/evKxkY4r39HTe1Du",
-	"anLy0WWo2jFgbBA5cZ7tUYeBgG5rdjLDSptDm0K8uv6lIM2rk4+olev9/cQJ6emPqDi1N+yJf3n0tLTZ",
-	"g9IfGyj8qNdmIduHM22i8TKqs2VVnnzE/yDZ3tnTXkAqNZ4t5UVJ3XxMmCZ0JqRW9lfDDXzZeaailp0j",
-	"f2Z6vbQQ4G3q3RFHL953o01xIOJHQhHF3L+1BNGYqRYS0fwaMYUgAjfa14Lw+9PJVx8+Phk/Ob37FyPo",
-	"uj+ff3E3MFbnZRiXXAQpdmDDD/fkeB1tbb1Iu0mBgXUfGY4W+qMJ3Va1BiIBGTsqSbeGTyRkNl2eHZHH",
-	"N+sKJPj71zQnPl8Lzv3k8819zm1EihFUrUB9Nx49/5yrP+eG5GnhRbIDhbcze/hjpkDcZqeEt/GICx5l",
-	"wuULK2aIVM6cHn6jND2A31yYXv+H3zQadrwCMOrX2llcFcBIv2Ivk1ACF3zOcK8DpPkN5ZkP/axjsXC/",
-	"rOTtCCM47FcK5lXh8yGVhdNSmcetn0hVZWk4zpyqQFkuAMw8mG06lzA0qXgmuHW1xFg77zCCaVnQ6URd",
-	"s7LRhc0NVWH2Nx/3OfWb/s8K5Kbe9RUzL9/Om2lYMpb+b5+S8VvsH4HxNwc6MuN/uifz/euv+H/3Vffs",
-	"9G+fDwKfe+2SrUBU+q961V7Ye+9eV62T/G1VrhO95icYRHLysfHIcZ87j5zm73X3uAUWk/EPDzGfK1TI",
-	"bPt88tH+G00E6xIkWwHXmLDc/WrvmxNzIxSb7s8bniV/7K6jkYq/5+cTr4dNva2bLT82/my+F9Wy0rm4",
-	"tTVhklIOXrq0ICvK6cImGgmqS3N7ugHqKgHkTRmuN5dfgFAs7isqXeuWbcCcSzoSvIXwHgw+owvGcQJ0",
-	"4MBZ6Nx0pdG172pwdzWPFw6yn0QOXYkqdX06GBtXaDgKpwlzzIfj6DQjxnu330FBRxPrW9UlI/OxUu2/",
-	"T24p00bucon3EaPdzhpoceKKu7Z+rSumdb5gGbjoxzhzSvLXE9o8F029i9myvo4dpUzqq9M79DTyIXv+",
-	"c23yiU0oSC7BePL+g9l1BfLGU1JtEXhxcoIR4Euh9AnKr01rQfzxQ9joj578/Iabb+uJkGzBOC0mTrVW",
-	"F68ePZ2eju7+/wAAAP//dCgz5mATAQA=",
+	"H4sIAAAAAAACA+19aZfbRpLgX8Grnvd0DMkqXW5b+/rNliXZ1liW9FSye2ctrQ0SSRItEEAjwTqsqf++",
+	"ceQFIBMED5XtaX2xVUQekZGRkZFxfjyaFauyyEVey6PHH4/KuIpXohYV/RUnSSUk/TMRclalZZ0W+dHj",
+	"o9M8imezYp3XUbmeZuks+iCuJkejoxS/lnG9hH/nMBL8pQcZHVXin+u0EsnR47pai9GRnC3FKuZpa5gT",
+	"+/58Ov6/J+Ov3n989OU1dKmvShxD1lWaL+Dvy/GiGKsfp7FMZ3Jyqsa/3vQ1LkuANMYljNPEvyjbJEoT",
+	"QEo6T0UVWlhzvL71rdI8Xa1XR49PzJLSvBYLUQXWVJbP80RchhblfI6lFHVwPfhxwEr0GAddAw7au4pG",
+	"A0DkbFkWMKRnJRF9jfizdwlO975FzItqFdft9g75Ee3dG907uf6LIcV7o0cP/MQYZ4uiivNkbMZ9YsaN",
+	"zrjd9RYN9dc2Ap4U+TxdrIGSo4ulqJeiiuA/EfwNZ1eKqJj+Q8xgo2X0n2evXkZFFf0ARB8vxOt49iES",
+	"+axIRDKJns+jvIAjWxXnQBPJKErEPF5ntYzqgnoa+vjnWlRXFrsKLheTIkda+PnoHxIgHB2t5KKEuY7e",
+	"t9F0DcvK0lXqWdUP8SVSVAQjTWFFxRwXpMGpRL2u8hBAPKILTy9JruHnLx626dD+uoovu+C9rdY5kIlI",
+	"HABr2EQZz7AFQZmkssziK0ItDPK3k5ECXEZxlkWlyBNAQlRf5jK0FJz7YAvJxaUH0W+BVvBLVAJJOHie",
+	"RD8C8dT6a118ELmhjmh6RZ/KSpynxVqaToF10NSehTh0UMGN4WNUEX1QaA7wKO57SAb1hka87v8m04X6",
+	"1Ib6LF28hQ/RPM3wvoz+sZa1IeC1pG0H9MlSzJD3JhEOg8iHIfMYaEQ8fpffxb+iMbAAYA5xleAvK/7p",
+	"BxgohUnwp4x/elEs0hn8FNgBA6vvnErqtuL/4Xj+o1pfeu+SF0XxYV26C5q5ZwFp5fnTEGXwmGHS8DPI",
+	"UyM30P6osd5ePn8aYqn9PQAKvZEBIIO4K2NsCCJOJRDaeDan/13OibTiefXbEYsX2Lsu5z7UIvkrdk0C",
+	"1SnLT6dWiHijPuPXWQGUy1ehI2YcE7OF3xzJqSpKUdUpDwptx1kxi7OxrIFz4U//Vok5wPGXYyvoHXN3",
+	"eexM/gJ7nVEnvIwrgYxvDONtMcZrFB5J1AocdORDfNRhz+AmS+FOr5dwa6U5byLJXchpMnEe5/XkaKuT",
+	"fO1yh58VEHYr+JLkrWgxoOBeRNxwChcv0r4Sem/JhqRIGI8I4xEQZLTIiqn54TaMapFL3+EXRtUoSueR",
+	"SOk+F5eprOUdwkxsD5k7D5yw6Ft37IsU7pgiz66iqVD3DvAZGJP5tuLjSgBHxNIa7IiwDtrpApguIEWj",
+	"AeWyQxAjSZXLIsMrcCMZYePvVFuXAvH3QZ3/9NTnoj1MdyTRK6QSNfEv9uEW3W4RVZemqAdS02m7724U",
+	"haP00JJ8bhF8aLqiX9JarORGInEgcghNbU9cVcDklQQ1JkmoS0EgLTHxgByV5gTtCAXyHGS/D7wfBeEd",
+	"CUFII2kzmbF4dQE7Y0Uug/pJ533x5yZk355HuOFxirJxlAFhojBEmymjpchI4IyNYsGlou+gcVFdHYJ2",
+	"QhoNxKkm62LuHjrvzsQr/NQd5t27n1EueffuPWx3DYzaPh1+SGdVcQofcZ/cCY7su09L8p39MlOOkX6K",
+	"dT1WT4txJS5AbvSsSAue6oxS7144RpEamw+7erqo8ScDodxIss6E0UUs4fKEY5FEIFzG2xIqClsgSEvv",
+	"NgATw11I4Aws+ERw49buAtuyCEFR+9V8nqW5AGk7BQTg+w8RGNea0xWzlN6Eeg1wztQc8MLGAaJXOQ0w",
+	"eIQ1chXABPCCWkPngF0WRcYDRy8LvOXqdJbC2wg3Zwsgc3UlxHpsYB154fwtPITeYgVWlafofyNVjsy7",
+	"TW3VFnykdeot98BFghAU5zN6T1meASQE6yljfIjhtC4PeV0VxfwQHEQdWi+JKy0Ia1xwg9R2amgrMSuq",
+	"xMNgzNGaXtWioZH6f7f/4zFqouLxbyfjr/79+P3Hh9d37nZ+vH/9t7/9d/OnB9d/u/Mf/+blXgflgn90",
+	"llTizvvXKtNphkKEXmxeQBuQf3i6GG7qeVWsWNdWFHULJzJaiepDBtd7lcKRLS5yVAl193sUwT0sMrzf",
+	"6B/0TtYiyzayC9Hwk2WaJT7Jpf03QhzixP1ruXmK3Hht9GFeyabQBPjcvKj2k3fsrdxmdz1cjmlM4Xy0",
+	"vczU4E5+Tmd5h8vwADUpoIPmd9ndTpxuAAn2rMGAf1HFJcOuvrDqC452bFTWDOueyo+BegkvzK6lx4qq",
+	"BNXO79+Nb1QvJGyjacLwdVbMPnwXy+UBbqypHqt7vmgaEL7jBCSDJTTZLAPY0YaQNzYkko2mzlQTs8QX",
+	"xUIeYIlZsc1DsCyfxFmGU3fZZmu1NPCgcwzvZmwciVVao+ilLrJFeg6PPpZGomcxvNRgXdEM5h9ZU05R",
+	"jvmGAHkszXNRjViaM3yARta6ZTpHUuDTsRaRsxplBppEwDZh/UVFrBH+u4rpPb9CjXKZNfuY96iEh2hL",
+	"3UTsBRgewugoe+GDWh0AzTe4GZrAN2uU+kLUg09wbvWJZs4LXlxcCbJNpfksWycWf4ZfNIDG1lY7kdsp",
+	"gEOSbYxF4bQCFFY8BOtL1OT4DwGDmM5MnbfLSozVEFV8LioJLzi8VpqLumPI91Cnc8PJTOI6dk6mokK/",
+	"Epw5B/UjPRrM1B39Ff0DFoefUSeElGSpJyXVDqmBzH6QmgNRxTNhA+RbsL8rNjVGKPluBeUTO7mfzQw6",
+	"ec/Yuqm2UC3C7NDbyzSRh9omGiy0V80TIhtCXkfe6WU6zlxDEPC2KJWA2QKBOQWNxggpLg9+rcGYPpjg",
+	"586VVlyKg+wEjjOY2cOsTxVkRfWn1/fhOITH6EIQC4zhjV+PzOG8anLGmA2+9Cqu05XYTzBmxA+hSNx9",
+	"NKtJLa26stjIcX04nRbVbqJWx9XFOnREMY7qSJqjFgVR03U5VozL427BDVoDRcZc2S8htYf3YayBhbM6",
+	"/gRYkDjqIbDQHOjQWIAjm2biAHxh6ZVwgaLFg/vR2Xenj+7d/+X+oy+QJKHjAs5hhE9bGd1WdmNY2VUm",
+	"7ngPJole/tG/eKgdbJrj+saRxbqaAfRldyh23OFXLjeLsF0Xa00006oNgIOuC4H3PqM9esP9oNFTMV0v",
+	"zkSNekUJz9H5wa+Kzgw+6KjRa0DkXFuXDOEpUfI4wSbHwE2r+LiklvAcZ1cuXEcq0aawmh6EqEIbn9hZ",
+	"kkhhNBEbD8W222SnuXK3qrqq1oewpImqgkvRJ59Au7qYFdkYheC08NyNr1WLSLXQ21W2f2doSdePc5Ne",
+	"Gq6XwBWInlKDL3ce+u1lbnHTK1jxej2rU/MO2Zcm8u0TDZY2hkEios6GJY5UiHGUUEcSxL4VNQuncCcD",
+	"81+Vr+bzw9jcCxrII0LATBJnirgFioZSwCSsRh3kc9ZCpppqCM7a2NK+UXUYKoWms6t8RtLIIc5yWLpS",
+	"rmORhOkc0yrCCAd8IaqbMqGGMMVQ3JIeSBFTL+BUwYEsCwkEfQBUlXqswUfRhWDjObTDD+KEzFW4B4i6",
+	"BaIHRkKn5jhTojBcBrMPgpFBuCJ3m6ciq+NviuqtfRh9C0grD363teccurex2lnl0JNgX+2uAd9Jw23f",
+	"dAuEfeJb4++yoCdGPcVrIOjp5L5IF8va0UTsbnzrhdE3iw9Q+sBqyAz7dJWRL+H2PiML5QHkcDtYU5Pv",
+	"XgrwtFjjswwtTsoy7ZfQAy7xeChm66pC/Zsj9JPmC27iqUDqmsVrXC06bha+y9Z2HMczPp5jQk3ANGid",
+	"GbgVT7eMz+GNmeFjFNWM8BItprho60JMi2xZuNX7YOjl0wAW0DQDgR3dw5Q9aBO8xm5k7Hkh5NFqaBVm",
+	"FpDHo3lcfZoVfDjfCPwHcTU+j7M1vlW+/wl9BP8YiyAPlw1b0PaCMRvRVvR2l7IHTH1E3IbIJWXWnvBJ",
+	"wPcGMp1M1CKE7P2xF9z+NpgdIvhECASRmNzVP+nR0pN8AqI08H/ig/VJlrAuxygTB3UxKMbjfudxXmhB",
+	"ecMMZoIslvV405WCjRpKJFyqw8V9twgNHBCuX8A3kokbjkpqHha0cYpt/b5oyuDTFCf9Sb9Ku9PO8HrP",
+	"JdzO+okq12VZVPAw9S2PFMTBuV7CVz0XbL0d27yDgY2spdg0cgiBzvgKj0orQn8ARWr3T6Vg7i6OXHpR",
+	"fLnaFssN+CyO+mA8060cxLsRawEY0ZhkehK5ocdag96mRZGJOGevv6IskUPV43Vu+oUweMatT+sfbdsu",
+	"SSrfOZJUkkJIMkaq9gryC0a6JKvoMkZ9IY2sjQGk/WN/kS7MeKzHINLPxLjvvJBGAFu5B2en474uFxWI",
+	"t2MQyuEF1jVt8OeIP29JGHpsIhCrTClqMZ6S3dlPI/ZMaKfA3WYtaCrpE7wj+gIcDM45PqMsqaneu08K",
+	"/8HBfXxTEestMwuB4aUDPR4hi+nJMyLd/dCEvN2Y6Gg16lbacy0B7JlZPwkCadyx1QK0Z/8vmJXnNgLY",
+	"Qee/gtkDC7dTH2rZAVsI3e2NC7N1lbVuG+8VEeTLGxhjiAcFDDOOo3GRfy+uDv56b0/g9aoB/gRPSVSy",
+	"Ox/4JV+6/SOO8WuPudtrfpDCqwt+R+nlWY4Oe2gCD3IoqU1es1+ho606hDrCMypeuGiXRUB1SCq+eNwm",
+	"4hL+lV2hYEuWZVKzyfWU/Zu69kT0YnIH8CckCM+oXDe8jhO9viRnNJSzPK9zLL22+uF723pyNdChXlnk",
+	"je9xM26d+A4yvBAMciyDKWtWdcJm1CYmXVNSA0h1QZDfjpFn4Fpy0UwriP6rWAO3y+mFu8ZQQiWkUVQA",
+	"Szw0A4qbZk4VB2YxJDKxEvyapy9377YXfveu2nN0zRUX7JyVU8M2Ou7eJVXc6yWcNLgxP7wRq+L8MEY8",
+	"HCjpdXcnORUlaSJzvdkalD2cefTkQzXdFh7V0z5Kc1FfFNUHC1YLXfvbA3P0px6u9DdzP4OOV5vNb2r4",
+	"oahQ7Y1zvnf5hawbrPgQ1g8Y77mHXNpRHe0baLPzrBp5CAJetwY3vgHIgaVUbA6Xv/d10eLjl0PW7nKU",
+	"YY7DNO6grW+6mnbWTVziDb5bnlZxeogNTyo2x3SX/fdGtpWM+ZhuPvFK+CBfFSuMDiiFMqD1qaB064ha",
+	"w5MSX+uwjByQw7fskBAXGc/FuC7GcrmuMQ4lvBB0fmVbRGPeTMzrUaRMnrQ+Ms6ijWJJmi1UIfvXSwJl",
+	"QIeJ6iozIs5GvkSYecZaeiMagN2Jy2K2nESvlAu1cTk1mMerycX+Zty0iNDsdGefPEjcwji5cEOmzGrV",
+	"3wQ+ouosXa0zuEkPwarP4faE66Gq0kRsZNRqYhj4GfR7ZboBTOJSzPAahkfBjLIMDRxLvMU+nJiIyT5F",
+	"GYUTTwwFSDznXmfcaYMy0fpBpquVSDDEESSdshIzwVl28CEuzVInEadcmIHAsSAlD3ReqFBpHocue4q/",
+	"xIxD67wzxLavzfoyH5OVVnrT3JCbis7WhO9MgREBHRMv66PQY0aBwmdv0J3sbE/b5O11kRkdBXWbiO9z",
+	"q9tkvDVTTu3qPNJ4AjtIs9AM9JYgfOJzsItEdxvx8NXsovAJDNF2aB+U3YmdCCn7MRQkhSrV7OoA70Ae",
+	"CAaHEyNJanctHZK/eqMx5ZUE0uvap7nrL4Hj+mYXJV9BwdPjFWDYo7Xk0Oof6ONgywq/NAIj0ptvqwHb",
+	"up0GEloLaE4+hKT33SQimfbZbztzyG+K6lBeVTzgcD+hzc45G98Raspd/alQBOp63bCGtcNF5Mh48KeV",
+	"G1r/PJEjFYrFjjo24txZ0GuTWuUAB7g9bsu9xEnjwrZKkZUA3ixLyZIJk8NLfla/y2MyZjhL9TiHa/1n",
+	"2PL1RDfxm9o8ljA1FABAopIxcXgdQefCI1R+I4Q2gMn1Ai71uqVDgl7vctUKNmedY3AsxqfhcRnzeYFl",
+	"kof2hFticNycxOIi+k1URTTFcHNXq7LCzG4smbOvC04Do8JCaqAk1Bn/kKIbKg6n/Qb1kTWvVoWFyXDG",
+	"tRC5kKkc+z3bv+WvFGGpcLJU0ZYUeMifdfjPTQd0a9h9yeQU5BhGSGpI+AfqmpygyTbsfwSbM7wVxl6i",
+	"dB1IW7QY3aZ8m4rg7jRNGwDTuxxdhoHwQCpPE+RFByOf9jXVOdB8xFpU1ti4lqVCI2DLN/werCrycKoW",
+	"f/0k8lx7gl6fQnfLWwF3ijPKgwOoBvbB1Z7TF0Zx69tnb6NjRQjyFhGLGtpJTeh5weh0Mq4jI+6SG+X8",
+	"Dhj8UzGn92CRP36XY/TqMZ+mY3hrVV9zQoPJooge6wwBT6HNu7xzDQUTlbhZhGwG6s+ZmrZIiwLUJ9vJ",
+	"IrsoAhJFFDmkKlW+Q9xWdIEwUdTIzFWyGKSBl4Xym6viC/3kXaNe+9dVXP4MgLyPxu/WJycPKB7dpkj8",
+	"VfFApFsAevDDN5jMsv3epYWzXE5BRGMMkvQnkapFXBKFkMCxopcmSAHUrRErryO/aCi7AF+Gn01bwpBt",
+	"neSClnvGvXRacP+i6BNtajP32l476GTV23kDN2Tmi9f1cowcwbsqicdA75XOYRQv8MrRTlJocyQlJBwd",
+	"XDKqhgRGDVD6ZrEq66tRo7v25VN3sZNNC3VGKlIeDi4MhrY0GHBdJrESZOL8qp0iV3LwGw36RgDDeltw",
+	"98nA7OJONnsnRasMHV2iXeeuRfJ1D7Iao735yrVUJ0xQ6UwpCYEmi8eGLpwsZoGjzQLAAY61jygaeUJD",
+	"iIgrDyKY+AMo2GGhON5epO9bHqrG8xpu17HI0kU6zURYte+YbjWsSJWoHk3PdYoLM6BEay6+jnR+IX4x",
+	"VagrxUudI25g31uB447mn6TDpYireiriuldfm7tpKjV0JJBfUAYRUpqQAUJc4n6nNSlBQPoTiXp7cxsV",
+	"KzHZyWNURRElO4Kqu9uMIZNdHhEK4Z58+Pq+d3I+qfeCcsF1qZNA5u9og0d1xQXuJgJY6NIPlCDWuafW",
+	"GIs9OHGaa4IcmqKs0QcH2ST9eOUddJFpijUdGWNoQkrqPka8eLmDwC/IHnxpGPXc7CWhrAqvMC+KQuo0",
+	"I4Ha+MAz6WAYQekmatwOWD8bg7e6FVY1YE2suUcfzXbq6JO5TXP0T5XX85Okou3Lv//ccTCO6252fX1N",
+	"t1n7iPU5cFkDBUMPnYVfp97X+fYBsG1y539ORvqpk5FqZTpJyUWJt34asFrNNEtRuZ6syNOK4qBhAO5R",
+	"hJz0PM6Qk6pEA3aQTq53evu0Mrsr97U7oTfRwIOm1kjSyVarZHlml/W5grdehv9VsNUapsXlmDNheJ9W",
+	"08spnglvSBbl5fAdXs68D/+Fwcltkm44juHZGrowZBowx9MNM6kjfqhfSGxk8LYDpF+Q91GzJNJTejVD",
+	"diFJdjdgAuJ0iOxuOyn4DwTSAXIPu9JWVxKx120nS7Gf1YQOp3cnAxjtKk+bufK/s+USwsnV9Vm9kSIB",
+	"XaXcPnUduHPJtRq2KevQJocGED1Yfd0WYv35Vxvedk28OljzsSRk9F1jVxdtEm420gSMG3L1+IPPLI0K",
+	"DUEyw5nu5ug5affi/OqO4/BbiQXaUKxxQTu53Lzth9SJY0pJG15dXVZzXN8bJxcxm2M5la+7zBtfAUXn",
+	"zNMKQzPQMuNdAjb6RpIm7Rts6heEm06i8AMNuLUcTBBhvGqSZms/KSuQvn+KEL00N5dcT+miBDIlb6Mp",
+	"ldLzxiBsYZskeDh2pRdBLxhBL+KbwM+wg4VNESbKgt2c/k9yxFq8sI+zeGjZR0zdDQ2itI/X2vzkHu83",
+	"lWyd3bUo0NJNtN7Ma63yWY8G5Xh765i+ceR8nIl4Hs0QEOPUWsGVkmLaKc1sVOyCLT0S2V43zzNTqoPp",
+	"XRp9MrpoBA/PMmX9jpEyJ155DhfT78+shpJq5T4dnz/ZHMOqpughByd7TJccnDeV44Uz6TMBdpCW6LE3",
+	"OufpHDYhmZJH8q7FyRbtD5kvFgsMAuY8hyoNAie9VLmGswKo3uQBxd97UitPIs5wTAmKe3Ibq4AsEQrH",
+	"alSnDROX+7YlyG08OeVlpknQJ4AStx1tX7428yLODQWjFo6i/GYPXidQzBv+8LYV8mDjEngPzWbT9mSY",
+	"l5Vf2VLo9W0ordLZLoW6UShwopE+v/+AMQcxtQJsjec20QQucgAuTS5bdmAedbIDSQyU/ruFBVs4o1tK",
+	"DbYBP00/8w2ln2+hsETtle3rmLQ+x6hzYPd25aCNZwNuLM6vk6wrMi42nMe75RmN3mHg2r//6awuKsyg",
+	"ygbiMYO01xC0nG3Q4FQ4hLWn7C+fpPO5cA2jchejXgO4jvkrGUDYARLsWk+NqqGXPrtEtoG27Ao2I9RP",
+	"Tx5K6asy5K/Q46pazWXjbNwONmZvCp3vQW78CRVuwEhAqrSuyspe3LzWt6CJ8xUMTSNv9ABGwDbsCmlm",
+	"3wiiUJ+xzXySjtx5SzaKeZJKpLGFW+zUqX+XDrQ1qjJr+GjYG6pRnrS5lE93bJwqOgDpkL068zsh4dkS",
+	"zW1pE/qmLUqTzbKP8yJ1p9quoJB7yZncUhudDUWcacKnxR5dj472c//x3ZNqxA078dpczd5dIOdcdgdp",
+	"+ABuuSExpm3GADblNhUSOqCREjqoufayuuHXmf9UvH12+uK1Ah/9UEDmq8ZG8xVcFbUr/zSr4oqu/dcQ",
+	"l6pRqn7WjDqbb8qJuI5VF1SWpqVc7ZROtm50zkFVjlZzf+DARr6pPP54iT2ef6I0jn/WQYH9/pq+fvF5",
+	"nGbaD0BDO9TowssdVqzbyyfcAfb2GXScQfceKxg2ggo4jVlrXmO/OVMuyONaKXd0fO/wGv9ZtbS+gUPS",
+	"Ol9RInP/uytXac6JMSr/w/jgcuA3cDbci0oFuXr9Fz+dgIiPCcaj30fjrXLK6IiFk4hFyF8XvyJvuHvX",
+	"Pfh3746iXzP1wQGQfp+q3+kdhSlDPG96r+YXWRYpdrEyyR0TJhPciJtVQ+TiYpi4AGKykZGLMBkaCmVH",
+	"RI3uC4W9iypV+EzUL+h4gT9Nhqgq3E1ndLvADDlBZ6EgVeMLv4ovMaTGlON0bPEUNI2kRVePqm7Gbhfd",
+	"IwT9yA1hLAEAvw9YPpXIknL28MbGETUe7FKAc6zTQJhBvk6d0bGZ3MkC3lqIM6sX4dJbCMDid1ooFrDO",
+	"038CbaSUUB0+VXQTty5n/RSiUTsCtl+/qAZmS7Idfqgwjd221Rn1WIy1Vq1PYdRrgX9qrMIaEb6y5VuG",
+	"v7gzdph/T+iKoih9fVKc41JkwzKG9L7zjJHeq3xRXgGafSoDfPiBhMxW93v+dMhOp3I8r4rfhF92IJux",
+	"J1mVdnZISQEPvQeYM6wjiV6vO/smAhmuWwiRyt66BL1o5Wgn6l2ucD+f2G6jt1QaOPsdVhtIf3URtQmh",
+	"h6rrh9SMqwowMzqwTpQAJZ3R3o/QiAbkNCeNQET/OXfjho95fHvOFcydWOssvpjGviKQ+F5EmJztb/hp",
+	"YoZy1VlvkDSZOnj2yAltMW1VJh2AwVqPusUBdnz78bSDX332kUcU5z7vRuy6lMnCM8w6v4hzciulfswB",
+	"VW/URmrT2UVRUUpr6XcpTYBEVl5lOCA/mXUdAZN0gTNxVucontfKmqoGijhvNlFRksoyi69MahqFGtiQ",
+	"k5E9syavUXqeoolcUIt7I1X8WdIFbct16y64PFjmUlLz+wOaLwGlcMygCyMW0Gre5yR6Gsfoqagv0Hv0",
+	"hNrd+yq6Tf7jMj0Xd/wXjBLWjh7f+4rc7viPE5+slIh5vM7qPiafEJfXtmo/ZZOTPY+BbFWN6g9UmVdC",
+	"/CbC90nP+eKuQ04XtVRX0ObTtYrzGBHig2m1ASbuS/tLnj0tvORsnREwWXEVpbV/flHHyLECyQWQITIY",
+	"GPsA61gpx2FZrJDCNGvVx08PR7WHdYlYDZf+SB75peeN/zs8t+JVIOCVgixekr3dResIneIp/Upqw3EU",
+	"i4QTqGsxUM1ck7eMcYNz4dJJXqXoHKxACCeCtEbrej7+Ep/vFVwbwBAnIXDHUzhp3dqzzQqE+XaA3zje",
+	"0VJUnftRXwXIXks5qi/mVMjHK+QoyR2b4cM5lcHQAb+7d8gLPTD03tI1jjsOEuC6QYCxw833IsW8Z8A9",
+	"idOsZysK3XplN06r68pPMPEad+jHNy+UJLIqKl9tJ8sAlFRSCUxnek7hxv5NwjH33IsqG7QL+0D/+zo7",
+	"arHUEd306fY+FhyrsuedZrJsoaT/0w+2IgwZtzmMu6W9BHx1X25K43jDXsrb6QvbNnT2DqVvAcwNRhuN",
+	"0sVKIPqHw3tMn9/D36sNEu95Q1V671eg+TmlqClQ34xAo8aUm/56v/mZ2fvdu8M9qP36QvzVg5rd7pp2",
+	"Bl7s69tqLOLe5RiqiLfxG1OZazwaVu9dhlfqVI0xipqVkm9e7jhM+OrWXun+A6RRQ5/buPmd+Sttpg2I",
+	"CvMHoI+nalU+LQGST2K+OyE1MVawH0pErWtL09PNB4T4N9IDntpTutNVbTFdVY7S1f0RtjewnQM1mrRM",
+	"5a6/wctjo4uSc+Rw1KlAX2nZKFc52OPmj0xBXRPa0ahnL9ZplvxkLeitWxWY/WzpdYifYsdf+AnjNHC0",
+	"L2gnzkXm7c0v/V+0RsCjs/hHERgWnmP+T62FK9hbkFqwmkDoKfX4iKu0xhwoDRQ1c8uZbD1wLcJ+Yztb",
+	"Z8yydUd8tojvlqjvpqugYVfrWnlUUx4QVf5rnmYqybnPlk8tx1VcB26EiqLI53ZEkLbRVhipHOMwOtrm",
+	"0hWJHDLG0pR0CGF1qA/CdHS5aHWn5IM0slNEDDXjuaqCS3mMiqheV5jkee4sA+11cPFdjSj9Ow9ygssS",
+	"lzT30eN7JycnwwykhK8Ba2e86oW/sou7d0xN+IviqFzeaCvwd4H+2lLdNpvfJS5VOZ4uAh+LpQ+cW4Cs",
+	"2yiTcNV4WExCiuVJ9C2l2kNCbxT0IYWuThbeTG+7LrMiTkaU3xz9uyKelfvAsw5RR1XrF6S9bB4Rr4Fq",
+	"eLpfnUowkIZt+Dj9WaBw1bIem3ryvqSg2OKtKTiftjy3SK/pYmcSPWWVsnFK4kkiypJfrVAVa0ZjFQYR",
+	"B/6jrmOAG9Wwk6NedXigdp8tqRfyonqtWmgOaE1dTgi3KW9JHByXwT4aqMBNRDWKCtSvX6SYkHwJP5+L",
+	"Zu5Rk7i3VYCluVogq5wJZ7KF5G2KWW67Cxo4Ftu1b4gXstY+7G23tElpinU126JODp/8M+rljznKm4O1",
+	"fDa4wNWlLpE1iX5QhpoZ8PQ8nVFpKN/zgbKKDjMJD6ii5bfVyiN1lj3H0EPKTq4FhUW1/vdBlqkQ13XI",
+	"cL7ifjPh8J811psk6+QC81MwD8RMSLg9WFCObWAgNAhVrhTpy+WoReVxW/OG9Bj3lwO608MmYmLAgJ74",
+	"G/z2UtkVKP0R3EKkL1RIVa9YNg5ixiI8Jhg0Gi2wuCmvthnTJn/GPhMgMwLh/eRFsUhnQBY0BrtRIlLY",
+	"g7k71Kn2Z1b+w9j2CbZVZTjMzw13QJ5Ur/u9l4VIs/9dbc5lHkS/z29NOwE5yDXju6P1EGNvmALdy0iG",
+	"WJ8FaEaUdJ93yEZUle/RjNVZ1kxv1CLiIHRvBuw094DxApM9Ganak9Jt5r1LaGPoNAf6QXtMGzCY46Gz",
+	"ciCUh/JDsLfDvkO1i4ogSmiNeo7wNgKZq4ooAbZiGtjXBWb01IcCqdsRSjBE2DiGkzDV1KmjdKaEMXZ0",
+	"5ihhJd752Qqy9bEOK26ga2MQq+lOhX22vadCiXOna5Aqa0zB6kuh+DV9jeirDobE4kJrU7LTxMg2Kw90",
+	"qU1NhFlV1queuXSDPadLUommjtU087gNPzUfYR69w5RTbXpF/9+mNqBx2N86cl175yfbldvoRuL7pGek",
+	"6TFm2huOCbpT9keHnXo3Qrf9D0rpOmj9DxGT3i5h5uyRj789w4vDzTjfiU/gq8UkhKdYgIK+69R2Jilx",
+	"q05ezETbmVNtnmfLWsDrhl7A4fILZItwLU58v7IVJpQzYhbMkBPXKhEjrNLyhCEqjHAqO/Yeb1m1uqbZ",
+	"kH84u4d/SsOPwkcv0sNW0u8bNlH22LMMJWgL3c1caYlgW3ulqirS1ZfCHVDMBnMGNcwpdgpnnS5WK1XE",
+	"weNReL6Ch5jzzfVEE8LP2NjZ2hMWQg9b7zd6Wnm/VBf+0Rr6EUM0QxPwERrVEkYcVKrB08Dw1O5EjspW",
+	"YTb6Bp5faFn/z7NXL4/CG+nsQHdLVRZ4rwo7tDEmyq5NHouigY/eBP2xR2p/3UgsbjwnglkiR+hmwD8r",
+	"/5tWGgOLC3wpeaR8Nx2DnrKZ5dTJT6dzgNaFO3FPFoXG9OWg9Q7IKr/95D2e6f78pFsgNpD+82vK7mmc",
+	"khw4Had9w0daFkw/19t8Kfo5c5vnFHnmt73IgDmHUuz5+cD0cijFY5rWwW1FXHbaPrjvbyv5NdlC4VQO",
+	"nSxfp8OaXntwi6nN/LvFOf2GAsH59rZp/WLo4B3uuyi4uqCv/lI3rdWR5YWa8zms2PJWvs5d1uw7LS+4",
+	"SCV6pVW+1G4qM752oaCzydGlF6QyB8C4hD0eLcxKrhW4cjK85o8n9YmeN1AjjCwaG5KgGdA6g9LzEhP+",
+	"UfI/OIdYRcE6/M/TjP7JZZJ7KiRQTywCKXuqJNsiyFQi2eTusHkT49rJzeVglAHjksqrNMtSZeRriJFh",
+	"otxUcNeBwCmN4GApvNmDBdm+sg21YOg2VtKulwCOoqomqF1k9W7jIHtmfDlG2ODYyODGZnGFJQp1AoHp",
+	"1W4w7bqzw9DmQ9ih9jacb0+fze4RaRWdcwmgi3azSN8F2i416pGj2ExqmygDS4cFBkwmDa3KkMqmviKa",
+	"Sreobbb8NFXJmLmyaKcoaYdnPh2iTurgA4B+nmylcPEVYj3iUbw7kC6W9ddoI/9OxImouJieTwHNpfRW",
+	"AqlSLtOSNKZAg6nR5kUZDqaq2CxpuMnQQOROFs3uWDpc7BxARyOHE/RSCTHcq7P0LxEh0C5IJrfpDSuV",
+	"YB2JKOtlr3qFQ9nK2tQRxW5sSEMfLaGcHc5FDmxpIibt0PzEpsDkfKrKOoTJdiebOYQJ0iY0ukD76KuR",
+	"tft7X9KHhuKok/DYyectKCPtFtLIqYmA5LQSWK3d5MlsJY0anJxmPsdEvucbck//HU15NhnxSBv71N1h",
+	"U1GnJjkC1Ss7qA3cwtqXBboXVFe0+ISQhtJ/wa7dklGDhjjdfSifyC7ljwg57PmlK2qFnCFUGAggR9MT",
+	"IUhH/alL2RYY3aUClpOafUcwNI3j9WTTte8GjX6G7QAGdt1y0qAwQqqsUGrr11w1wrnKw7r1pwIuc3hI",
+	"cAhNbGotuRYoNKa3LO+0On4VwUPD+Bfpqk1C6t90dQKeJUs/qPKM/D4gby4saKFbHCQpMN+bqR/ouZk5",
+	"tWHgXb/gbT15OR/DLCtQABqH0mC0xX+ldoMzTZFlNkUrQT0XVSUS40UEY4sxVu5iKtgi871KFtGDPat6",
+	"2hpvrfjFLRKk8IqCBcTe2CpqVAs9poJhsQq1c7HiZEq3lc38htNNO/SEv+sHkK5t3W+QDeHdnIvxxmAO",
+	"nWgA75kW5t3Thd6iJBxszb0aadd2sOWmOTDRsXb7atc1y5tJwamoSLKedXWnxt49OMlqDzfzmkFn3VUG",
+	"VdHIqI/ZUKSykZkdd4FmGZJBd7TELaI4qHVb+uBeHAS83zdZOZZjGwd8iZ53i7G1D8OHFP2/MYW5UXmj",
+	"FHyreWxwkug2ubAYL9OL5ZUuNVbCLSeSO5MoQtMy5kLQDqduObjO5Pmtum/+S5o1WXN5RWWznrzL/UHl",
+	"VOaw2pP76WF6eF6INwETSfaenwfZYXbgIyGv+guqh4hzeHluv3qj6xHaEqEc8mMovALUEk7ttCg+PMvr",
+	"6sqfI7up1dXevKXuOYnIcZvc/gGFJorB6ExFWcyWe6qSRUCNjBlzivl8DHuSZj0aXPruKtEEahU4FwjA",
+	"mwM6eK9Zz4cRlfMYPVH1V7IyAfdYlfXgxHNTDJtJdoaNuw+dDMFdV2KjSpGqYeHFdC56lqjJXiN+CAT8",
+	"hllTyv2e5brKc9V6vs5cIHaYW1HlWNFNEA2KeE2zZu4ikvRlkZ0bt/Thzk5FlS7SfMO86NMqqepNnKyw",
+	"8GN7+mKKGkeroxg+f4ku3GQPAREsCyGAPjXiaRW94eTsHRiTNsaMRp9Hqrk59BheDUAvYbCkwNsC5NLi",
+	"fEv/Mn5Vje3Os3N6mHakLf1LVirbs0OwXRmgzzbaAQyY4ZhYwbbnNpZ4aVLiu4KCABzmMryc74b9c7ji",
+	"KBKTxQRjoLFeC8xfzZaord9mJ4Jvb03TGqTeG+Q1QCM3BlDxq65NX2b7urdL6N4Q/TdHE0tyS8LcYgNk",
+	"cAca0TH0ef9NCWzCGXuAPyHJ3mcDppSyTu5jCgyII+U5Hsms8KUO2CXtLQ7lR507GQFUi3yA1tlCoQb3",
+	"IkBF120oJaM+O945yO91UMauVWNUIRZ+i8mQhaM9s5ml+cCZY8oXZ0YKMOXqUsbzhYoz0T+mKciO1dUu",
+	"tV2aqPLRXxDLm0+5jpC0C7FRkl0cZllxMabXydhUCPdp9bGdbL6+VX1ZW1lcKsZr4y3hQmNNzxU8iFDa",
+	"qeD6cHv43QMYKszAM8YqYl5nhRfpvEZd34qSUWEB6gUcMrQkRWt0pfVTUGiudY7yQTI2NBlEAdMO5Tnk",
+	"Pg4dD5wSH9Hslz0mtcvGYrF6899iH865aXP286LHHBsQSCwAsHGOfoUhbtyFlwiH00i3bat+Tdc8vSS6",
+	"waJZ3SMPW19h0gfVgvUKLgnRwcfXyyqVkkExtHSRZhmlvEwvnUgGEwjkR21ABfacAqDPU4p0a6Y/Zc1Y",
+	"iWKNyRnr8oAzN408fIX2i6VT49LAqTXwGE5Mn91RfpRrCkakvFY4xcNoVaCVx/X5MUPZ2M/bGGQDF1/W",
+	"tMexum6hvL1/iC9PZ7P6BdzZ+Ci7Q7p0lINMNsKRzgPZDtq1M1WtwhHDFH4YGUbkITfXhuN2FM6q6Hkw",
+	"72xxv47/wKYr3AHz/Wbmutk94bS7sPa6mnzWr9LEJ35drNKZ/7j9ucJeg8GqPu7lLQ9BPVTqXGpGfMC9",
+	"x0wcE3HPLppFjrTs2y/FI1Q8B3Ei/Cdp49rjRnOheFDgDu3yHSVgjWdBMbAFAEHK2Rsx0QDxPldIMwyn",
+	"WLBTHkWjtAEdeOFQ0N9+sOEIBweqFnsB1QlDNgDeZkPEiMt4cEgzZrhR3+/YOh87AX/dT+UN5hGKpjyz",
+	"pFVxPKXOvh3gCP6qib2hh28pc+d0aACi1M4+Ay9/B4BwSGIDhkGBiduCwaq0cVwH7n0yZY0crbvSGzij",
+	"p+rKZk4+i/kuX7KaDjiBygbN0n/V9AqiKs3qVsXmXcM2miKVlvY3URWU1ywZOV4puuRyyzBQlONMnItG",
+	"pKZKUc3KO1Qkqr7SdIarXpTkuNW2l/newD2qGLX2sRPENgS7XqsKI1YpPTeYTLwGHrjA+ZjIoUcJIQKJ",
+	"D+SuBhK2FTmaJkE8yh5UdZ4PY/3EHDrNjzzCGz3Aqe7vE2U0Jt4P40NbsyA/6voY0MaQ5LUMnfrcH5Hs",
+	"5l83/h40W2Lc05jELd+QZXyRh42TXZK3L7GB+wQjOYh9Bt1JqlFPIaAAfuoE9GMq8IioPUcHvoSlxkXu",
+	"Mcqjn09e2BcR6Yn1K8aWotE/8MTUCNDFD+0dXO1s4PD+OxvRYJFsVYjw64ENWe9nqv9dTmLvQQyO56MR",
+	"9GOjHF49qjFN3erZQQ2KdYaqb9hPlP2X8bnQt5ji4iM4O3ogVGRQ5FvjifpUaLcspj7tKaLE8tRcyzpA",
+	"eqSqJLW1IKmTGmLFiln8Hz5I/wksJZ1fEZ9h8HW3SC5jJCHlB8bOkCrgGifuF69GGjCtiCn0VLzudOiY",
+	"znBXOIoDNF7kutY81hr4INxtID9P5p+zGhmnXE9JqYFXdms7u1hQi9c5pVdx4ioBqDrOVYM76Cpt2Pt/",
+	"2XxV7lS6aEWZxTPebVJtYHKcJp9BYcgQF7RZ9ec36/I1TQK6lUO0lc6PmeygTd2SdfmSfYQqejfAdp4R",
+	"zYLeh1nGQKVwqzBzT2a4QUs59C4cJnlTZ0nkNKiriGxYHNeL0hVHbmJ3vGWtQssYAv4faFcaXpKdlDb+",
+	"MGB3PdTkJnahkYHXAyurwQEcuI3nG50wWA+OyoDK5u7VuluQnCqBtYKQVT5/pZ6ttmpTSj45qesq4YyS",
+	"YNkry2rTvMSCAZ1XEBVvyq8chLnWBELrZGC8rpVKMT/Eq3NRVSAMBnCApwcrJzQrC2sLiurrUYCYG7k7",
+	"QCrtC5ASqVn9vNsMr/8kncNyOQQG+GueoEO20xyQNoMLJ8aAu/hK7m6qMlaHTcaq2JGFmmlCHbMVkTYD",
+	"AoIVO43taUgyAMYHtCgNsARRrJXHCsSKIZjeb/jpwvCnsARRSGSxoHRfgQOhinOR6ZAfkJgnGGUwku6G",
+	"rVvPI9PfRP80VD9VMSLANs46ZIr+c/+KtpIeoT/mad178lnD2c6/xgFLfDA1UlG5qqMsmVi659GXMk9l",
+	"ZHbT5mlRVecn1bQnnE30RjZ1tOqBXST/CpVv0VWhy+HWpYYLhy8xH+sVxqRvkD1xlNY/hXAtlSKq47je",
+	"VlQwUkYqreGWejrW7ut7KQCeTrlPZ705rfGzxXGGy0aO44kforIox7MhISpcYjlRRgYFaRPGAH04JoTA",
+	"uo3fjTRFxxvJ0BvVx1nu30V4b1U/32Qrg7PzvvdYe5VMAY7eNGCgnynwMjrCrFqjkGmjihnpx7k2djeV",
+	"aIZJQJ8KRq5IyXzBDlQd9V+jgnygZN7Zd6eP7t3/5f6jLyJsgIUi0fJsM+RwdlTNNkyEQZq3tUY3G1PQ",
+	"WV7t3wSdJpQRp62XOnrdbIo6a8xtpa2g1Fj9tgZxzwXgy8qF2WZtiOPOe0Xj2OjGP9Z2+RZ58B3zoeDT",
+	"7xn6f/gL4Rq5ymN+8e2WY4DBF4jjCtq0n6a1ja2SS1IuUqmzc04KXej4AksFaR3w5fItJBSaQ/yMkjAq",
+	"mxMMXGaKV7GdqG9d6p3G+j0SGsndBnVgRalEe7hhfRBR6HW1FkavrtSmpE93om0Ms+W4Gx8hqhg2P+mh",
+	"xwe9hIG++rm9NTNqRu3h9LiJHvFCH8odSDNk3QgnGN2Fk1jDwB+Gf3gyph6Ma5jlfgpe4X0f9CR3Oe14",
+	"TZhsoYNA62bG9JAHARBIa9LIPeHEyjsF1Sq2MZA1Qpuf2+LHD9YsvTHAlCDRHTaA56Ykse1MTKQC53cu",
+	"V/WDQYqzlPchSmgsf1OWE816zUXibJFSmtToO8ilM7pioZPXRj4x6WICr5JOVhnMh4IGKBRFu9loWI9D",
+	"Z8olHHwSVOet5Es3wjW+Qf+NU8KHSN6E46/d7CMukhmV8uCVOF7Eg8ByMo3cCFT5a0qR83eBO+u9HdUs",
+	"yvDfuQNJJQTyMnl7m7R0WPnngsZkx657X0RTVaMYHXtT2XYouNAijUmbISq0yHEczGXdTuGxd23jn4p6",
+	"j+Mw1/5A0UvHyGY8BxTM9qj/zswpwAG8p8VHqh1C8eDPx+uwIsKworb71rPdLYezU7FhyxzO7sqoosbg",
+	"5dE66PICUu+uc/Ct38Ct58K3axuapHxwWVysRT4dkkncX8IWu1Ny84PUst2/ku2NZDZnVKoxFCRewrIi",
+	"96YkdC1/SSfdUnMXUdz37wQFBGB4EoxGj4L5OufxNBvmlC+arRfzkfFiQM18MX8cvcvvoreEfluoP+Gf",
+	"WMEux2piPx/Z7xi3xl/f+15qyaU3PYTNh9fxEVVlBG9J4BtXQwvfh9PfeZFrs/3dvDwDYt3U/6D7DjeM",
+	"Xq0q+uB5TnyeeAtfnyoH3r9uEr+tE3+as8LEaPP7mX3YlOrvp1AlPK72Fijw2eK7WAt0oxXerb2KqX44",
+	"NTIVJP1lCou98aQvGoJAiQC19H3yeDJiPGttTO5M5aSSHlCDVXXz5GGn1CnQOK2vzhD/WuGe/vLBl83x",
+	"W5NfUSXtNLZ3JfXWxQcQkZV3mc3GuJZarv62iDOSO9klIEdps8gm0TMuCqouxL/dmv5VPPjyYXLy4N5f",
+	"p1+ePDqZiYePvjo5ib96GN/76sE9cf/LRw9PxL35F19N7yf3H96fPrz/8ItHX80ePLw3ffjFV3+9hZSO",
+	"IDOgutjv46P/Mz4FnIxPXz8fv0VgLU5g1ZjC8vqadGtzqklASJ3R5YpJuTJopn763/qKnMBq7PD6V7wL",
+	"K2y+rOtSPj4+vri4mLhdjheUxGxcF+vZ8ljPQ+UrGi+V189NRBB7/dGOWmsTbarJKo7f3jw7extBv4kl",
+	"GPh2MjmZ3KMkFqXIYanw0wP6iU7Pkvb9mApnHccL4AQo/B5nSslNyYixCfRXjaQq0ntsI0u9zgBvKIpG",
+	"v/gr9Kq+bWIE/924g8g7OtRwrqpTYAwZLsEs9XlCFFiryC48QewfSrDfPznRG6aePY70eUwBafAbMxlf",
+	"RYAO5t9agL2QUQdaR3fRP+Yf8uIij6gUEJ+yNZA8Zt/BFTSw4QxOexmje9rPwDnTc0oaf+3BOVpz5n0o",
+	"r1JxLpqsQHcmKjJ1c/EYcjldVeBY+lDeLcu8J/Z7S0N1JvPsDjV6jTDrZKamnJK6KxXOyBGFEWYOEusy",
+	"O4iGk7D2oPMZxfrJPpyNnFK+DE0Bz36N8Q5GX6//RTCKpLswZYHwL2DHGQlP+McKCXWmP4FInlypf8uL",
+	"eAFyzEStE386v3+sVRLHH1WqjOu+b8eukyr87KbeTDb01G6Wm5rAD5yNcsOAcDfC+/iqt40W3cMtXNvL",
+	"sXKidzpguqHjpIpTJaWhs0MgwwklMkF/sLLmMI4VeYU7uXbIiUGVPqfsLey/jRmA6AVFruA8ECtr0YOd",
+	"M+NQKhQ8Cl32fVYX5Rvs85TA3JPmW8XRKjbg9deb4KUDhevmfmuixsmYFr/JscRgkFEFQnWW2bRAQ99u",
+	"Mp5jStSxXK7rBK6P8ELIf4JcKhrzZmJeU+Ypen/g+nQJA3wGUxn2kFMhpXLrSYRkRlRBumieHfXlgote",
+	"rTD7i8kj7GAeKcXF/tavGbPTnX3yINFTrcV715OJ18lHZ1Zr8lFlrIF6eHLvYJy5WSvQA9nznAM+UA5k",
+	"eZUgeHhzELi5zXR+QMoMEWNOsKlClCC9+6MDXloDUINvPnhOKClrR3kMWZLlBnarfcIYiPlF7mSxBxJ8",
+	"Tw9ErxRWFlWNfLOH+YyiZXERrdi83jjLyFJbfISlDD1eSroEJHfS0C8x+gjOFSzTKzV/5rqfue5nrvuZ",
+	"6/5BuK6rFhhEA1uw47KQdZ/cK4njs/TLiVm68i/zWpBnJXmKtI48xmWCINyRg0llCz+kVTO7YVsIBpA/",
+	"8+PP/PgzP/7Mj/8wUnCMwuoeYnBDC8G11I5lHpdyyclCvULyWV2JeIX2+sVvaVmi8iCupniwVfgtukKb",
+	"KpDwz/LKDXz5gPXa4jrGRDfGo5b0dIaAGRKd3FhZJtIVyubkYAzdmIWv4tkS7RHk2LNAMwVtsF6C6sMa",
+	"ETYikIH8TH0fE1YRqjTj3N5UhGSuPGqxL/n6SnZqlrRwXCDyJMVKulfFM+qoCsdpZG51XyBem+RmjWdp",
+	"jps/GqYLV+XxzJZu5AHdmQ/PAx5tufYDH7VHJw9ubvq3mucAbc0wjgypB5MdsZ2YflLK2J2ZgD6Nhuob",
+	"p2g3XmAkoT6t5BvKLc7y4CJFPzub5FlLVW49glObAtqkU8dmT1+e4dVPebrFJYcZ6MpmKvc5KjZVjkuQ",
+	"08ijTWU6Z6HPpvO2L3G6rFPZEGaaJ5UX0MyUTbY1HZwMSOvPZU1qU51hHeMRqIg9hV2wKfOfa0HHVZsa",
+	"TQ5vKxVwa0tww/Nhy/qKbIjIHY6u3x9UJuVlJZuSeTOLpkBo5QPTEKJ3tK3ryYdKQBYe1dPSXydzOTPB",
+	"G7ztv46TSGdm/iyEaYaIV2heNLflz/koZibIfCdMdvurJu3j25J7+8w1UKzzWALHpPT4owZkVPPCyWPg",
+	"lrgYacEMu7ZrOnDJB6/C0rDSw76PoUuli9UOKtjVrJ6zidvo4YdyG9U+WHnn8yn/H3fKX6RSeYBs2vy9",
+	"dV4gJflFKl1JxZx1VIy06nXoNxPKPx9EWatiKHTKOXccX49K06JFU5gh9JoBcDoCUsW32dcF2f4Ps5P+",
+	"eiUb73tVt8RhV8jpJh0B69rPkDzKFDacUy48Spx70+KCPsxOwRS1qs985X8cX6HDvrnSzhCGgiEAGJi4",
+	"EJh5ko7PeAondKyeHpU5UuqhN9DTpq/Z8bS43KKpcN1zwr44rAw6/kixZ8Hfj5Uruv8jhQeyH2nbSafd",
+	"kktd+j82vHc+1pe4kP7hsI0zHr3w1+XxR/vUv+57U3/LTN/RDIzQRBxPyS7tqhCIXrRagXUIHd6NvZ4w",
+	"BJvetac8UKRHotcrepnax2tjpvD71eiqGu2tu/fPJ+Ov3n+8N7p3cv0XdOdWfz56cD0wI+0TM250Zt6T",
+	"Axvu+0juxCTaRfImGQ+8riu9ooVwzmy1Va2BIoOM/si69vA+cfZf/u37p7wk+PC7TCFSm723tBngN5JN",
+	"C1vyGzJIfOY3jYYd6wnltudo4lWaU/I3a45VdhBbvFBp8HWkW5ycx/lMJzi3GYdpv9h1XBGGSUu5lgLr",
+	"eKqqX2WmYrEwhENPJNelsnVIQ1kqzTEaX7hokRka3hRoOKWEYpRRWhtxlNIhyyL5IS0bXdK58n1CpSxn",
+	"N58c+VWkK7Kud5z+h5UcCn/7lIyfsX8Axt8c6MCM//6WzPfPv+J/dTXvlzcHga4w+Ja9Nv7kJvW9rlol",
+	"+VPiHAnvgfyYUqUef2w8ctTnziOn+bvt7rY4XwGn1Q+PYj6XpDDu+3z8kf/vTCQu4bymGDIWZ/ZX7QQA",
+	"N0J21f35Kp95f+yuw8GKGw7S+PlYRxv6gkOaLT82/my+F12vI7+UQ5cuEMcqzoFdUPIME6CnHHFxAHOL",
+	"TaJXpbneVBUNzOOkXJKMYMNpoVVpHZMTh30QdGa0BboawQTktkazcC3z2Ln2lSuSx+1MQfYS7Yodicp3",
+	"fSoYG1eoOQonHgel94cJynMY7/V2B4XSqXAGoS4Z4ce1bP99fBGnNcpdY6JyLgnd7VyLOCNuklLdN/fX",
+	"JJWoeVhNu1+qKxB4nB/d+kDeX4/j5rlohvzgloU6duKBfF+V3iHQSCem1p9tYLMbKEzkYkKEf36Puy5F",
+	"da4pyca9Pj4+pjoHSzhIxyS/NmNi3Y/vzUZ/1OSnN/ya9FFcqBpL8HJs2NjGtt6fnBxd/39WeVn6SlkB",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
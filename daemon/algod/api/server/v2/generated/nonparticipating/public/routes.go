@@ -28,9 +28,18 @@ type ServerInterface interface {
 	// Get account information about a given app.
 	// (GET /v2/accounts/{address}/applications/{application-id})
 	AccountApplicationInformation(ctx echo.Context, address basics.Address, applicationId basics.AppIndex, params AccountApplicationInformationParams) error
+	// Get a list of assets held by an account, inclusive of asset params.
+	// (GET /v2/accounts/{address}/assets)
+	AccountAssetsInformation(ctx echo.Context, address basics.Address, params AccountAssetsInformationParams) error
 	// Get account information about a given asset.
 	// (GET /v2/accounts/{address}/assets/{asset-id})
 	AccountAssetInformation(ctx echo.Context, address basics.Address, assetId basics.AssetIndex, params AccountAssetInformationParams) error
+	// Get the balance of an account at a past round.
+	// (GET /v2/accounts/{address}/history)
+	AccountHistory(ctx echo.Context, address basics.Address, params AccountHistoryParams) error
+	// Get a merkle proof of the balance record of an account.
+	// (GET /v2/accounts/{address}/proof)
+	AccountProof(ctx echo.Context, address basics.Address) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
 	GetApplicationByID(ctx echo.Context, applicationId basics.AppIndex) error
@@ -179,6 +188,40 @@ func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context)
 	return err
 }
 
+// AccountAssetsInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountAssetsInformation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address basics.Address
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountAssetsInformationParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountAssetsInformation(ctx, address, params)
+	return err
+}
+
 // AccountAssetInformation converts echo context to params.
 func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error {
 	var err error
@@ -214,6 +257,51 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 	return err
 }
 
+// AccountHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address basics.Address
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountHistoryParams
+	// ------------- Required query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountHistory(ctx, address, params)
+	return err
+}
+
+// AccountProof converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address basics.Address
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountProof(ctx, address)
+	return err
+}
+
 // GetApplicationByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 	var err error
@@ -281,6 +369,41 @@ func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
 	}
 
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", ctx.QueryParams(), &params.Prefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "values" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "values", ctx.QueryParams(), &params.Values)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter values: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetApplicationBoxes(ctx, applicationId, params)
 	return err
@@ -723,7 +846,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/v2/accounts/:address", wrapper.AccountInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/applications/:application-id", wrapper.AccountApplicationInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/assets", wrapper.AccountAssetsInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/assets/:asset-id", wrapper.AccountAssetInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/history", wrapper.AccountHistory, m...)
+	router.GET(baseURL+"/v2/accounts/:address/proof", wrapper.AccountProof, m...)
 	router.GET(baseURL+"/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/box", wrapper.GetApplicationBoxByName, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/boxes", wrapper.GetApplicationBoxes, m...)
@@ -754,305 +880,349 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9a5PbNpMo/FdQOlvly0oa23GyT/xWat+JnctsHNvlmWTPbuyTQGRLwg4F8AHAGSk+",
-	"/u+n0LgQJEGJmtH4kswne0QSaDQa3Y2+vhtlYlUKDlyr0ZN3o5JKugINEv+ieS5B4X9zUJlkpWaCj56M",
-	"jjmhWSYqrklZzQqWkXPYTEfjETNPS6qXo/GI0xWMnoRBxiMJ/6yYhHz0RMsKxiOVLWFF7bRagzTf/nY8",
-	"+e8Hk6/fvvvyH+9H45HelGYMpSXji9F4tJ4sxMT9OKOKZWp67MZ/v+spLcuCZdQsYcLy9KLqVwjLgWs2",
-	"ZyD7FtYcb9v6VoyzVbUaPXkQlsS4hgXInjWV5QnPYd23qOgxVQp073rMwwEr8WMcdA1m0K2raLyQUZ0t",
-	"S8G4TqyE4FNiHyeXEH2+bRFzIVdUt9+PyA9p7+H44YP3/yuQ4sPxl1+kiZEWCyEpzydh3KdhXHJq33u/",
-	"x4v+aRsBTwWfs0UlQZHLJeglSKKXQCSoUnAFRMz+BzJNmCL/cfryBRGS/AxK0QW8otk5AZ6JHPIpOZkT",
-	"LjQppbhgOeRjksOcVoVWRAv8MtDHPyuQmxq7Dq4Yk8ANLfw2+h8l+Gg8WqlFSbPz0ds2mt6/H48KtmKJ",
-	"Vf1M14aiCK9WM5BEzM2CPDgSdCV5H0B2xBierSRZMa6/etymw/rXFV13wTuTFc+ohjwCUEvKFc3MGwhl",
-	"zlRZ0A2idkXX3zwYO8AVoUVBSuA54wui11z1LcXMfbCFcFgnEH22BGKekJIuIMLzlPyiACkJn2pxDjxQ",
-	"B5lt8FEp4YKJSoWPetaBUycWEtGBFBVPMSqCDxyae3iU/faQDOo1jvh++zPFFu5RG+pTtjjblEDmrDDy",
-	"kvxPpXQg4Erhti+BqBIyw3tzYoYxyFdswamuJDx5w++bv8iEnGrKcypz88vK/vRzVWh2yhbmp8L+9Fws",
-	"WHbKFj07EGBNnVOFn63sP2a89FHV66QseS7EeVXGC8ris2Bo5eRZH2XYMftJI80gj4PegPvjxjpbnzzr",
-	"Y6nbv9DrsJE9QPbirqTmxXPYSDDQ0myO/6znSFp0Lv8cWfXCfK3LeQq1hvwdu0aF6tjqT8e1EvHaPTZP",
-	"M8E1WFEYqRlHyGyfvIs1JylKkJrZQWlZTgqR0WKiNNU40r9ImI+ejP7XUa3oHdnP1VE0+XPz1Sl+ZISx",
-	"BMP4JrQs9xjjlVEeUdXqOeiGD9mjPheSXC5ZtiR6yRRh3G4i6l2G0xRwQbmejvY6ye9j7vCbA6LeCisk",
-	"7Va0GFDvXhD74gwU0r5Teu+ohqaIGCeIcUJ5ThaFmIUf7h6XZY1cfH5clhZVY8LmBBjKc1gzpdU9xAyt",
-	"D1k8z8mzKfkhHvuSFQURvNiQGTi5A7kZ0/Jtx8edAm4Qi2uoR7yjCO60kFOzax4NRi87BDGiVrkUhRGB",
-	"O8nIvPyjezemQPP7oI8/e+qL0d5Pd6jRO6QiNdlf6osbudsiqi5N4ReGmo7b316NoswoW2hJndQIPjRd",
-	"4S9Mw0rtJJIIoojQ3PZQKenGa1AT1IS6FPSLAks8JV0wjtCOjULOyYqe2/0QiHdDCKCCpm3JzKpXl0wv",
-	"a5UroH7auV983oSc2nNiNpwyoxuTgiltlCHcTEWWUKDCSYNhIaaiKxHNAFrYsogA86WkpSVz98TqcYwT",
-	"Gu5fFtZrSvKBQjYJc2y2qPGOUF2Zme9kuElIrMGhCcO3hcjOf6RqeYDDP/NjdY8FTkOWQHOQZEnVMnGm",
-	"WrRdjzaEvs2LSLNkFk01DUt8LhbqAEssxD5crSyf0qIwU3e5WWu1OPCgg1wUxLxMYMW0uQAzjidgwS6A",
-	"W9YzJd/RbGmUCZLRohjXdglRTgq4gIIISRjnIMdEL6muDz+O7C9KeI4UGD6ogUSrcTaNKTlbgoS5kHhR",
-	"lUBWFIXTylyPyqL5TWCuiq6gpTuhsBSVNjBGN5eTZ351cAEceVIYGsEPa8QLfzz41MztHuHMXNjFUQlo",
-	"aGE8K6q8xl/gFw2gzdu1qOX1FELmaOih2vzGJMmEtENY4e8mN/8BKuuPLXXeLSVM3BCSXoBUtDCray3q",
-	"XiDfQ53OHSczp5pGJ9NRYfpGZzkHfodKIciEdeMl/ocWxDw2Co6hpJp6GOopqNOE/UCZbVBlZzIvGL6l",
-	"BVlZuxkpaXa+F5RP68nTbGbQyfvOmurcFrpFhB06W7NcHWqbcLC+vWqeEGvz8eyoo6ZsZTrRXEMQcCZK",
-	"YtlHCwTLKXA0ixCxPrhY+1asUzB9K9YdkSbWcJCdMOMMZvbfivUzB5mQuzGPYw9BulkgpytQKN0abhAz",
-	"S22qPp4JeTVtouOaqA3whJpRI2Vq3EISvlqVE3c2E+Zx+0JrIBLMS9uVgPbwKYw1sHCq6Q1gQZlRD4GF",
-	"5kCHxoJYlayAA5D+MqnEzaiCLx6R0x+Pv3z46PdHX35lSLKUYiHpisw2GhS56+x8ROlNAfeSFyfULtKj",
-	"f/XYO0Sa46bGUaKSGaxo2R3KOlrsxdi+Rsx7Xaw10YyrDgAO4ohgRJtFO3ltv3s/Hj2DWbU4Ba3NJfiV",
-	"FPODc8PODCno8KVXpTSKhWo6pZy2dJSbV45grSU9KvFN4Ll1vZl1MGXugKvZQYiqb+PzepacOIzmsPNQ",
-	"7LtN9TSbeKvkRlaHsHyAlEImRXAphRaZKCZGz2MiYbt45d4g7g2/XWX7dwstuaSKmLnRAVbxvMdEodd8",
-	"uPyyQ5+teY2brRLMrjexOjfvkH1pIr++hZQgJ3rNCVJnw3Iyl2JFKMnxQ9Q1fgBt9S+2glNNV+XL+fww",
-	"NlKBAyVMPGwFysxE7BtG+1GQCZ6rndYc7w1sIdNNNQRnbWx5X5buh8qh6XTDMzQjHeIs91u/nKuPqA3P",
-	"IlOYgbGAfNGg1Rs1efVhykJxRyUgNZh6jo/RI/AMCk2/F/KsVnd/kKIqD87O23MOXQ51i3E+h9x86y3K",
-	"jC8KaGjqCwP7NLXGj7Kgp8HoYNeA0COxPmeLpY7ul6+kuAEZmpwlBSg+sMalwnzTNTG9ELlhPrpSB1A9",
-	"68FqjmjoNuaDdCYqTSjhIgfc/EqlldKeqB1zULNKSuA61nPRnsEUmYGhroxWZrVVSbRIyZf6wwnN7Amd",
-	"IGpUT5hDCNWwb9nplvQCCC0k0HxDZgCciJlZdB3lgIukipRGd3ZqnVOJh/LbBrClFBkoBfnE2bN3wuvf",
-	"s/JHb0EergZXEWYhSpA5lTezgvOLncCfw2ZyQYvKqOc//arufSqL0ELTYscW4DupjWib77pLuQZM24i4",
-	"DVFMytZaaE+CUbEN0ylAQx+yr4+93u1vg9khghtC4AVIjKi50aPlJ7kBogzw3/DBupElVOXEqIG95gej",
-	"uZr95pQLrxvumCFMUFClJ7tEinmpYTcxS424eEqK4MA9+uRzqjSqgYTxHO23VhTiPFa3NFOM9gwqwyl7",
-	"b2Nm0l/9Raw7bWbEO1eVCrcyVZWlkBry1PLQZ9071wtYh7nEPBo7XP20IJWCXSP3ITAa3+HRGQLwD6qD",
-	"h9r5vLuLw6gDo75s9sVyA74aR9tgPPVvRYiPg2p7YGSq3gNLbky16G0mRAEUTaZKi7I0HEpPKh6+68Pg",
-	"qX37WP9Sv9slSesGsppKLkChi8m97yC/tEhX6OtaUkUcHD4+AQ1eNkSuC7M51hPFeAaTbecFL8Hmrfjg",
-	"XOm4V+VC0hwmORR0k4i2sI+JfbwnYfixkUBq+4HQMJmhNzFNI/WZ8PGmV5tV4FQqpXgTfEIyc87NNaom",
-	"Nff11SfNAadN8U1HrHfCLAhGkg78eIgsS0+JEVH2XwhtyMoRHa7GSaVrrqUHe2HWG0EgjjupDQHt2f8L",
-	"lJs7KGAHnX8Dqm/h9dSHWnaP+R9le0NgtkRZS9okRUQvX97BGPt4UI8v4hWVmmWsxOvqT7A5+O29PUEy",
-	"VoLkoCkrICfRA3uTL+PviQ1Dbo95tdv8IHNrF/yOvTWxHB+Z1QT+HDZoNnllMxoia9UhzBGJUY3ApZwg",
-	"oD5q3tx44ldgTTNdbIxiq5ewIZcggahqZqNWui40LcpJPEA6Z6p/RueQT7rDt0YInOJQ0fJSkYf2trUd",
-	"vrPWlauBDnfLKoUoEvbP9onvICMJwaBwIVIKs+uMFsWG6JA24ympAaQTEBiNEfSZO6qBZlwB+S9RkYxy",
-	"vOFWGoKSJiRqPqgsmxmMuhnmdKGqNYaggBXY2zw+uX+/vfD7992eM0XmcGlDbji+2EbH/ftoinsllG4c",
-	"rgNYu81xO0kIHfRVGiHrbm1tnrI7yM2NPGQnX7UGDw5Oc6aUcoRrln9tBtA6mesha49pZFiAH447yH3X",
-	"DAnrrBv3/ZStqoLqQzgq4YIWE3EBUrIcdnJyNzET/LsLWrwMn70fj2ANmaHRDCYZZgkOHAvOzDc2sdCM",
-	"wzgzB9gmjgwFCE7sV6f2ox037Tpuma1WkDOqodiQUkIGNkvOaKkqLHVKbMpEtqR8gTcgKaqFC3W24yDD",
-	"r5S1hMmKd4bYVxXTaz5BF4ZKpqmh29JnWxolDKi52bb9H/aydkkDKFYYDRLa0fa0/UFJl+l41HvxN/i+",
-	"qC/+Fm/NlNGrOhMb+mGEtBqagd4zxKfRlbpIjLfRHD5DDDfjpamHTkHZnTgKCq8f9sWFn1ZlWWwOoCTZ",
-	"gYiEUoJCkRabAZV9KubkZ5ZJcVwsRJB5aqM0rLrOG/vp7z3H9fVVbsCCF4zDZCU4JK70L/Hpz/hwsNnR",
-	"iuGeEVEh2mvA9sWngYTWApqTDyHp624Skkz77Lc9nep7IQ/lZbcDDr5TDPBc7wzrcFNe1b9OiyLhkrbm",
-	"hw4XUeMQFM4koUqJjKGieJKrsYs+t15sG9beQv+rkBp1gAPcHrfle43SsKwhH4qSUJIVDM38gistq0y/",
-	"4RQtfdFSE8GC3jjQbxZ+6l9J26ETZmI31BtOMVA02P+SgUFzSNihvgfw1mFVLRagdOuCNQd4w91bjJOK",
-	"M41zrcxxmdjzUoLEiL2pfXNFN2RuaEIL8idIQWaVbl45VpXSRGlWFM4RbKYhYv6GU00KoEqTnxk/W+Nw",
-	"Po7EH1kO+lLI84CF6XDGtQAOiqlJOtLxB/sUk0ocTpYuwQRzLexjH/Fc14YYmbU3ilb8n7v//uS348l/",
-	"08mfDyZf/+vR23eP39+73/nx0ftvvvm/zZ++eP/NvX//l9T2edhTyeAO8pNn7o5+8gwvYlGeSBv2T8Eh",
-	"s2J8kiTKOKCoRYvkLtbLcAR3r2n300t4w/WaG8K7oAXLDS86GPm0xVTnQNsj1qKyxsa1zHgeAXteh67B",
-	"qkiCU7X4643oc+0JtgbcxFveyjFwnFEdHEA3cAqu9pypsNo7P3x3Ro4cIag7SCxu6Ki0QOIG4zIYG1E+",
-	"ZpfixK43/A1/BnO8Dwr+5A3PqaZH9jQdVQrkt7SgPIPpQpAnPinyGdX0De+Iod4CUlFSc1RBKsUp6Cq9",
-	"ljdvfqPFQrx587YTh9DVrdxUMRd156xrJvNTTozeICo9cUVcJhIuqUz5QnyJD5cNjV9vhcPqJKKyRixf",
-	"JMaNPx0KZVmqdrGHLorKsjAoikhVuXoFZluJ0iIkjhlm7nJvDQ28EC6oRNJLf+WtFCjyx4qWvzGu35LJ",
-	"m+rBgy8wBa8ucfCH44GGbjclDL749hajaN93ceFWL8eg8klJFymfyZs3v2mgJVIIKhwrvGkWBcHPGumB",
-	"PhMAh6oXEHKR99gSC9neeb243FP7lS/rlV4UPsJNbeZOX2sHo6z4K2/gjsx6WunlxHCE5KqUOQZ+r3yB",
-	"AbowIsdHECi2wAuAWorKLBlItoTs3FW2glWpN+PG5z7Qxcliz3CYQpuRSw6cM4O/jHIzYFXm1CkylG/a",
-	"JW6UTYbAQV/DOWzOhP18OrA6WFSNLiqxovqOLtJuJGsN+cYH2Y3R3nwXd+VzRF05Esy79GTxJNCF/6b/",
-	"aFsF4ADHOkUUjToffYigMoEIS/w9KLjCQs141yL91PIYz4BrdgETKNiCzYoEm/7Prl/Dw2qoUkIG7MJn",
-	"9YYBFWFzYm5HMyuO3Y1JUr4AI9SNIBaKFhi0P006+lE7XAKVegZUb7XX8rjMhIcOFfJLTJpGo8nYLAHW",
-	"Zr+ZRiMIh0tzwcO7t33HBRJPrxROZdcE+RVB9Z/XSdLTq1wiHMIT9ey8vA97Eu4LLj4tpk4E2T5fGRwu",
-	"pLg0u2kAFL50IxZ4ieRUpegChoqjhqtoYEmMhgcIB9ml/ST1HTFvqzUdHWPgIuznE4OXJHcA88SwB3QD",
-	"tEIc/dzWhei8Ci95sfFInRWoUIcAUUs6VDb8bHyxH7BpNgaS18qqB6yJtfjoL6nyRz8fRxz9itrixykl",
-	"s61+3kkUfUd1tzqeF9Nt1j629pwZEMHNF76Kni+d5+vljcZ71b4bj1yKQ2rvBEctOocCFhYn9mVPZ3V9",
-	"pno3DRwv53NkepNUIF9kjIw0EzcHmIvYfUKsxZwMHiF1CiKw0bOOA5MXIj7sfLEPkNzVl6J+bJRd0d+Q",
-	"Tha00fhGSxalkfqsx2uVeZbiylvUKk8rxBmHIYyPieGkF7QwnNQlntaDdGq14d2nVZnNxXbc67sTDTxo",
-	"bo2oney1SqvPXGV9seLtl5G+Fey1hplYT2xmdPJqNVvPzJlI5itgnnbq8NrKeXcUmYk1xhShhLMB7ntD",
-	"1w+ZBywKA1kzhVSO3/WpjRa8/QDZrsinqFkh6Tm7WiC7Pk32asD0qNN9ZHc3KqF3IJBaBsy6DLiz6Oy0",
-	"szS1ra4mUovbcagOG9LUUqym73Amd7IHo13jabPW3Y91ucP+4mj+rH6QIn9do9x16jLaj0tba3Gfsoxt",
-	"cmgAsQWrr9pKbBKtzcClJl4jrKVYkmH0XWdXF20KCkBLwKShV0/OU27pN29+U4A6w6n/LLJz4u5RvrkX",
-	"RcNJWDCloXYu+CCXD+/7QXOiuWyJef/qdCnnZn2vhQiKhnXH4oeNZX7wFWDo+pxJpSfomUkuwbz0vUJL",
-	"2vfm1bQi3Iy3Y8q6evbWgxGic9hMclZUaVJ2IP30zED0IkguVc1QUDJuo41mWAo/GaC7h28S4bGB3VsR",
-	"9Nwi6Dn9EPgZdrDMqwYmaSivOf1ncsRavHAbZ0nQcoqYuhvai9ItvDbKpe8y2kiJjsIuptt8Pp1zmfux",
-	"d0Zj+Yz+PiXCjpRcS1QRMZ1AKBYLyH2lN5cUaqteuXp6heCLupag+X1L+cApsVX8sAjflvp9Ljwd+oLT",
-	"G+1EsCtGEvr4MoOQ19l1WHsQJ1kAt5VbRvv3GymSiIsD4/GNyDL6YXl7J2w+GTp81goXrmN67R6Gzcbt",
-	"KYDm7lqlwK9v+6HtbpdD3bgv6LhRInb7AcMBkeKYVpEC0yGaHs5Ny5Ll65bjz446vQJJDFT3upXgWzhD",
-	"tuQG24GfZmDxjl49d4x0xPeds+MIr/lH5pJp45ldRK45GzRz1QbySqI3qREt3K2nHy6aA9f+06+nWki6",
-	"AOcRnFiQrjUELmcfNEQl6RXRzAZI52w+h9gTpq7ixWkA1/F35AMIu4cEu+6ycLfcSp9dIttBW/UKdiM0",
-	"TU8JSumLuTjr+iP9xSOyrQVhE23cFZyKyYICP8Fm8istKnMTYlLVsanOQdgU63vQxMXqJ9jgyDtDPg1g",
-	"O3YFTXGvASk05V0Jj1RUJfyOanRfwDtwYwv32Knj9C4daGtcK43+o1FLqEY/ieZSbu7Y1CEyBtIhe3Wa",
-	"jjoxZwua29Im9F1bxPLduk90BYmnYhi9cRUhFypt7IwuA1p4wsfFjt6PR9eL90jJSTfijp14FURzchcw",
-	"GtP6/xtBX3tuCC1LKS5oMXFxMn1KhxQXTunA131YzQe+X6VPxdl3x89fOfDfj0dZAVROgqmjd1X4XvnZ",
-	"rMq24Nguhmw5dmfbtaawaPNDyew4kuYSS6+3rGmdXjd13FR0UF1kzTwdKb6Tb7oQL7vELaFeUIZIr9oj",
-	"bQO9msFd9IKywjt+PbRDrex2ucO6KyX5RDzAtYPEoui/a4/Vmyfw5s1vFx6ztT/FBkqFkviJWDp1xUjn",
-	"Dq9Jn9Wa1ndwSFznS6xkmr53cVfnFBmjCzijB9cDvxeyIahcVmMyYO3mFERzmbB4TDvlz5wXvqMWTolV",
-	"If9Y/GF4w/378cG/f39M/ijcgwhA/H3mfsd71P37Scdw0tRnWBZa8jhdwb2QF9G7ER/WDMHhcpi6cHyx",
-	"Cjqy6CfDQKE28syj+9Jh71Iyh8/c/ZJDAean6RBTRbzpFt0xMENO0GlfVmIIfl7Zdp6KCN7OwccsWUNa",
-	"KHpcBw/rZ+8eIV6t0O88UQXL0kE/fKYMS+I2pNe8TPDlwT5kM0fFeuLKecWi0c1r6kouz9ZColmTCFfJ",
-	"SsA1fmfCsYCKs39WELX1RUncEs7+KoSjdhTstH3RDdzuGjy6SsPf67sIvVVtm8Foq8v1WXADekSk+kzt",
-	"me8Qz9hh/ltyFRxFefGJiW1LFzq8k7K23vO2N4F2bmDPPp3Htf+C5Nph2s18NmSnmZrMpfgT0roDOgkT",
-	"pTu8d5uhAf5P4KkY1TYjC5EDdcPqevZdBDLcttBHKte2JfhFh655VxHhaT6x30bvaTSI9rvfbKDS5cXd",
-	"JvRdVOPAk2YiTQ8zwwMbhYVjLx8f7ka5PaG2rkUj8yx9zuNE0SM7fn3OHcyd5NqCXs5oqtGRuS8amKLt",
-	"bwTmaUH8x36DVCjNYGcnUS5DeJfZYn8lyNp71C2VfMW7n5128K2vvuQhxcXXu7GNVSmUSAxT8UvKMY4Q",
-	"v7Mc0H2twMZhmK8uhcQCnyodQ5hDxlZJY/ibN7/lWTfyK2cLZluKVwoInWtX59ENZJvKWypy3bxDLRKH",
-	"mpM5eTCuz6zfjZxdMMVmBeAbD+0bM6pQQIeYiPCJWR5wvVT4+qMBry8rnkvI9VJZxCpBwv0cVc8QCTsD",
-	"fQnAyQN87+HX5C4GDCt2AffSAsYpa6MnD78eb+ucjRjHJvHbmHyOXN4nMqQpG6Oq7RiGrbpR05kJcwnw",
-	"J/TLky3ny3465HThm04E7T5dK8qpQUgKptUOmOy3uL8YytHCC7feGVBaig1hOj0/aGo4Vk82uWGIFgyS",
-	"idWK6ZWLFFViZSisbkNuJ/XDYX893wbNw+UfYgh2mbjjf4TrFl31ZDhiVP0L9LfHaB0Taiu2FqzOv/Ad",
-	"asmJr0yNfeFCOziLGzOXWTrqq5iOMSelZFyj1ajS88k/zPVd0swwxGkfuJPZV48T/dWaLYj4foB/cLxL",
-	"UCAv0qiXPWTvtRz3LbnLBZ+sDEfJ79UlHaJT2Rsrno7v7Qs77hn62tq1GXfSS4BVgwBpxM2vRYp8y4DX",
-	"JM6wnr0odO+VfXBarWSaYGhlduiX18+dJrISMtXpomYATiuRoCWDC8wvTW+SGfOaeyGLQbtwHeg/bnSb",
-	"V0sj1c2f7uRlIfIqJ+5poayS0fR//bmuj4/ObZu327JeCpmw0zqL4wcOS93PXtj2odtwQHzWg7nBaMNR",
-	"uljpSfew+Rzhm48R79UGye55w1T68A8izT0edf379xHo+/fHTlX+41HzsWXv9+8PD5lN2wvNrwnUXE3W",
-	"tKtXmm9TW/2tSFjvfBfPEDfmSpUkLKxJWWZE6syNMSbNVokfXu84TL7i3mHI6QPkUYOP27j5yPwVN7PO",
-	"gOnnD83usUnyycPzKIeCkm/FeigRtcSWp6dPAEU9KBloFcSVdLrjJiMldob5RGRrRp1BIcxNNW6ANThq",
-	"5TPaBYOa8Za9qFiR/1p7oVuSSVKeLZNB5TPz4e/2GhC9EFkwsiXlHIrk1/a2/Lu/VSfu/f8jeoZdMZ5+",
-	"1G7EbGFvQVqD1QTCT+nHN7hiujATxChqFuQKJU6KhcgJzlN3LqlZY7ejeaqTbCLHH4ddVdpFJWPxBNdQ",
-	"ZM4KDKNN+8PxzYmkuoerYtt/3+LKjINd+JU1S9jRQRLKVii2FV2VBeAhvABJF/ip4ND6HCu24chRWxKi",
-	"SvMI38TiL4LoSnIi5vNoGcA1k1BsxqSkStlBHphlwRrnHj15+ODBg2FORsTXgLVbvPqFv6wX9/AIX7FP",
-	"XOcv2zBhL/CvAv37mur22fwucbn2q/+sQOkUi8UHNiEbPcRGrtvWq6FN8JT8gPXJDKE3WgSgUdRXWG7W",
-	"BK3KQtB8jEWhz747fk7srPYbCYg6bP26QAtg84gknTzDa6T6+ms9tauGj7O9dI5ZtdKT0JQ1VUnRvFH3",
-	"kmWt6Ce0DcbYmZJn1iwbAnvsJARLi8sV5FEPWGsGQOIw/9GaZku0d05HW03KPd2Ahrcw9hywdhdFea+h",
-	"YRZycLMM18XYNjEeE6GXIC+ZAqw7ARfQLNgYqp06g7wv4Nhcraw4t4Qz3UN7De2x9t0FD5xVfX18RRKy",
-	"1j5c2/dXV/LAJuf7Nns+xa/SeTutztGtuAfbMmPtm25Myc/O2ZFRLjjLsNlESgXHUozD3KoD+nKk/Z1q",
-	"5M5y4hgm+1WHBHWHxd4O1p5lOsR1gxqip2a/LeHYPzWsXRPABWjleCDkY98+3jnoGFfgGqAZ+oo5qpCJ",
-	"0K9kWkwIITlgSPp4hNXUemyt35tnL5xtHmvGnDOONjeHVHcTtA62QjH0s3PCNFkIUG61zbww9Zv5Znq2",
-	"5gjC2+lzsWDZKVvgGDYU0SDFRgF3hzr2McEuBte8+9S863oXhJ8bIXV2Ur/ut0kWosL+p3qu96I/Ffvl",
-	"A2ki5Ibx49G2EOPWUH+Uy4YM4QIj/6BEed4hm9C+vjnKd+bKaukN3yA2czdZNpjxBBjPGfcO33QdrCwp",
-	"S3Bj8DT3fKcySbW9dAzieGdAi550GEyqtxED1x2q3YnBoATX6Ofo38a6834PWwkv1LcLyjfEHwpD3ZFS",
-	"8pQWIRg+0UcftTOnjNlg4VZn/RRbMWx94lNzG+jamQgaPsduKPvKqb5qo7MqX4Ce0DxP1Z37Fp8SfOoT",
-	"CmENWRWagIU802a59i61uYkywVW12jKXf+Ga0+VMUaVgNSsSobfPwkPIww5jIarZBv9NdcDq3xkX9L53",
-	"9rePcM/361HQzWZPac+GpieKLSbDMYEy5froqKe+GqHX3x+U0n3i9yeR193icvEepfjbd0ZwxGW6OzH+",
-	"VrSEKtoYTy/wua8HFiq5NrkSirJOnzeMyMDNS2xZC3j/YhLwC1r0VFyIvTZWvlpPRl/dhay3rAjVrnqd",
-	"pqTmCUNMGP31v2wEdssz1HVv9sVY2xDrm3SeOHxsRXq/p/Gnhl/RRr3VDKXXn3g1l19NBPv6/Fwrhq69",
-	"lBaFyAZzBjfMsfmov1SvWK1c5ftEVN7FSuTxWYijuQDSjM0GLCdSK/Bim3yGV6vkE3mZHq1hHwlEM7Rq",
-	"GaLRLWFsEzM9eB4YO3U8UWSydZgl37MCm0P9x+nLF6P+jYx2oLulrnR20oTdtzEhU61NHgvRwMcWHiB4",
-	"kbZ/qx6TOtaGSp8G1504+eB7ayAcApKtk7TP28+HDt4hgIWwXaFSfTO61WlG9XZ45EfUUG+v5SgxdaSo",
-	"ot1tKXH3sUbP+hUSGpEOakza0JGGNHdK9RFyNwVvgbWCxtWjs82VOn2ZOgz02RDlsIOP9+PRSb6X+pTq",
-	"RTWyo6QY7HO2WOpvC5Gd/wg0B2n7iaSuk7abyArMNVQtWYn3n1IoVvcDLsxgrpD3EoebDk3NOVuCqwrj",
-	"iwR0xvIB1BeQaewPXYeBSoDhcQ5leokGAu9QxFc+QiiIBMih1MutypIN7i71sm4bCi7zjCkyA+e6uAA+",
-	"JmwK03ayWl4XhSIF0Lk3wkoh9IC+uiFtCdEYA52ir06P5u1qYKfmW1TS0LbSnQ5vwnIccgJsouUlVXXl",
-	"qFYZhcHp2vM5ZFjwfmv5vf9cAo/qsY296Q5hmUfV+FhIF8SWDQe1aNewbiuEtxXUqCfVTULaVxDjHDZ3",
-	"FGnQULIjcMiwvUoFeESO9eP6pgJ9rg0XGMlUoCdEkI+DdwX46x5LV2kCEFWnvCIYnsaNeKorVl4NGq/R",
-	"XAEM8+n0Wk3763J4qJj2Vffrdlfvvyk/w2b2ygWV0lBuPrYnkZNuO+ZLV64eCy0Gb6EvXA/K/+YLtNpZ",
-	"CnbuOtQgwqxv9pLK3L9xkDJ5Vm6yNNDzMDOrE6O6UT77xuXYDMWsEEYBmvQlhjYzlUII7x1lY63romUI",
-	"9RykhDz4BAuhYKKFT7Pao/inS5/cgj0bZX4lvLUi+vdIGbYr6u2h8LpuJIHtICn2TKAu+DzGCpGwogZ6",
-	"GTV3SJtBd+3QU/vc1xTx7f22m1f78B7Oxe4O2T71jqkO5uPTNSdOOdibezUKkVzBMss4BznxTtx2awfe",
-	"LJOJdZXzKrOqSnw2g/V6cNmxLdwsadTMuqtsXaGiqhznsDmyZh/fddzveAy01SEt6FFB6RZRHNRWrVJw",
-	"Lw4C3sct31kKUUx6PIMn3X4U7cNwzrJzwMKsITPFaMF3msfGTELuokMqxIxcLje+20JZAof83pSQY26z",
-	"A334SLMDaWtyfkdvm3+Ns+aV7TDjLNDTNzydZoWdXuQ1uZ8fZgvP6+NNCgy/vOb8dpArzK7XvC9G7hJb",
-	"wjT7BE+Hmje68R0tFSoiPwtFSoE6tY7gp8gSEvcogtVZojJCGB9AiXMgE1WIVBT+VSrImKHSmIonQ4A0",
-	"8AHX1RoKN3gSAS7IbkdVVvfY1x0VcyKhjs24agFWV9PUMnHVZxppzxxmaXLGuZAQz4hxprZQc8hswzrH",
-	"+J8Z05LKzVXKpDZRlTJD9WJ5Z7RkCJSsF1IHS3ZxWBTicoJsbRK6K6XMAeY91RTbvk9p/Z056jOIwi6p",
-	"cirihixpTjIhJWTxF+kUbwvVSkiYFAKjMFOBHXNtLgkrzOvkpBALIspM5GAboaUpqG+uinOKuhdEoWxJ",
-	"FFjawZIB9puIjgdOaaSvdc9OUF/b2WjDb/6Z+caWr6jL39lFT2yIQE9+AShX7s5hyL7chRcJx1Zkahtl",
-	"0yrynK2RbkCmjvycaFnBmLg32l343cGnEsiKKWVBCbR0yYoCq0ewdRTQEOKB0qjt0Z1PMA76gmHAW7OS",
-	"iFWpSyMdQ/mVmAecxhXZiF5KUS2WUX+AAKe/usvKXezjUX5RFcYkYoqomeIxWQml3bXYjlQvuQ4BvZsJ",
-	"rqUoiqYhz+r5C+f0/Zmuj7NMPxfifEaz83t4CedCh5XmY19SoR27W88kWzUYh90U9JpPkDzU7jLr9j2M",
-	"anX0PJh3trhfx/Gwy5Ifgfl2N3Pd7dc47i6sva4mn03fhY45oVqsWJY+bp9X9GtvzGqKeyUrLdouxLYK",
-	"Db6GfCCWYyGcCblnF83AabKN6jFxPMKFdSAnMv9FNb49LpmD40E9MrTLd5yCNcl61cAWAAipLYSgK2lb",
-	"F8dKWmA4YmELp2BQShvQgQIHY/+uB5sZ4eBAabgWUJ1o5ADgXWvBGNuKmDayeSbW/vm9umTmlYB/v53K",
-	"G8yjL6jytCYtacMqfSGrHo6QbkCwNQLxDItgzIbGIYZW9AOFfwRAf2RiA4ZB8Yn7gjGnrIB8kupSfBJs",
-	"YOPouu5yLKPRfT9Hy8kzWvlOwGbsSoIrrGS1f9l0J5bUkJIIr3ct4jyHNdgcrT9BCtvHdxy5s6CwbX5b",
-	"FgVRTgq4gEbApqv2VKEWyi7Af6vCxyQHKNHj2za0pSIR4y6BLeuLW/skimUbgt2kOcYi1u4U2WFrSVqG",
-	"1nxij4kaepQMRBcsr2gDf2pflaNpSzRHOYGqzvVh4q+YQ6f5xY7w2g9w7L9PqTIeE2+H8aG9WVAaddsY",
-	"0M7I5Er1nXqeDkyOS5kFRxHOlge/tiXxmm+okl7yfqtml+Trm9jAfWKCR4j9bg0ZajXuKgS5uwz1eE5c",
-	"DSSkdg6Q2wuD+SRhzV8CJ1xEPY8vqQq3mLqqq//BTowvMe4u2lfw0dfxw9ffWYKDEdUqtphuURrI+no2",
-	"/o9yErcexN7xUjSiwKXybjGNeep21w58QVRFTrjZT6P7Y49gJ8UcFx+TWeUHKgpxaZsYx1fUZ+D9uZb6",
-	"vIvJqeUsiGUfJz12BYfbVhAWZYis6IYIif+YC+k/K1qw+Qb5jAXff0bUkhoScg5kG0Xh4q7NxNvVq7EH",
-	"zBtihJ/KrpsNHTMabmNGiYA2gty3bRNkRc8h3gYMELH8M9OGcapqhkYNI7Jb29nFglu8L8+0onlsBMBC",
-	"s5sGd/AFz83X/1+dthpP5es/lgXNfMtq13yuyWewq70nLr2E1fY05y5f8yQQOuXXRCt9mYz8CtbUPVlX",
-	"KuenrzlWA+xOC/BOX7BrLWOgUbjV42hLgvigpRx6Fw6Tw9lZUtzqd9fi4s7HH2Z3khWi+5YxBPxPaFca",
-	"4RWdzLZ0B/V4PbZZ+gfYhUYhngSs1gw+E+uJhLnaFUhj7eAzsa4BVsF2y3gmgSobd3Ty0l1b6wLIjJtr",
-	"tI3aDW7VMEoOc8ZrVst4WenELQjrIPNNhLDYm4Bo7fHN9ekYRhW9oMXLC5CS5X0bZ06PbQ0cN+nxHhT3",
-	"bcIAEiRydwCm6hsg5lPX9vn4NSP+bYNBGzurNOU5lXn8OuMkA2m0BnJJN+rqrqrgddjlrKKRLtSsFhK5",
-	"rZC0LSDFxnmbr+lICgDSA3qUBniCMEg74QWyhiEtehw/XRg+C0/Qiq4nhVhg1m/PgXB1rtF1aC+QgqMR",
-	"3Wp3w9bt51HsT9g+DbYicYxIC5x1yBTbz/1L3Eq8hP7Cmd568q2Fs52GbSOd7cH0SOWLOj3DEkv3PKYy",
-	"511hpjh73quqvkyJpz2INjEZEt2xqvfsIsZXuLILsQl9eLPKZghHKj/f2hUmaG9QWxIwQNV5BTRzEWJd",
-	"Q1zHUGGRMnbVDfa001nrvpdLPeChIUW5s96cNgTomHH26fC5vZ7BpBTlJBsS22q7FeXOyeAgbcLYQx+R",
-	"C6Fn3SHuRoX+XY2aaI1GXvs2Oe1tJLbLV1Zm20wGfUamHo7edGCIOfIyPMLWtIa5VsEUM/aXc+/sbhrR",
-	"ApMglEjIKolG5ku62d34saf6/OmPx18+fPT7oy+/IuYFkrMFqLqnQatxYh2ayHjbavRhgxE7y9PpTfDV",
-	"QizivPfSp72FTXFnzXJbVRcj7rSN3Mc6nRAAqeTcbou8K+0VjlOnRXxa25Va5MF3LIWCm98zKYoi3VMm",
-	"6FUJ90tqtyIHjLmBlCAVU9owwqb/lOk6KFst0biIVcMvbG0owTPw1mdHBUz3xHKlFtIX04v8DGsxOJ8T",
-	"gXVZOF5l/UTb1uXuada+h0ojhtvMgJSidKo9m5MURJizJSsIdnVnNkV7ehSmG5itDdhNEaILfk+T3jF3",
-	"N2ExJ9u5fbMVt05zerOJCfXCH8orkGafd6O/zshVOEntGPhk+EeicMrBuEZY7k3wiuT9YEtW+HEnaiIU",
-	"DRkEWrdARoI8EICefOhG0mqUZBfVJpfWx4DeCO9+bqsfP9du6Z2ZKQiJ/2AHeHEuc/1eSKZw4Hzkwt4/",
-	"B6RES3nbRwmN5e9Kj/asNwiSaIuc0URrUJYtia5aGCXEq6chz7znVtJJR5dCaGJupkWRSGO3dhw8UzHh",
-	"mCuBvKDFh+ca3zOp9DHiA/LX/YlbcdpyjGSLSnXwgpzP6SCwohTlDwIVf4W59f8JZmeT0tHN4hz/HRmI",
-	"JiFa2GjvefCAAyeXOKYN7Hr4FZm5dj+lhIypdkDBpVdpQr4tSDZ38bWw1u3c32u3CfpV6Gsch7mPByIv",
-	"IidbiBxwMNdH/SMzpx4OkDwtKVLtEEoCfyleFzdV3yF2rtka5mqlnKLCjXuWcuq2ix+6PFwHCq9KQXed",
-	"g6V+A7cJgV+vbWitssEdZt68+U3PhhQUS3eDMZ9jjbODtIW5flOYD1LgzKLSjeEgSRJWrXLvql7TipeM",
-	"6jQ0d9Go+z0N5JcW/WY0vBTMK27HCw1QMVfcs3UxH4coBsHNZ0/IG36fqCX1dwv356MvvxqNR8CrlVl8",
-	"/Xw0Hrmnb1M3tXydzCutC+l0YkRdN4E7ipR0M7SHXH/dnCRy6zJBH16fUZrN0he6H82G4a3VZR+ccOTz",
-	"yFus+HTFc/6+1X/2riAWzoolxrowUNiHXTWCfu0riG+Lvvf0+Wjx3YoVO8PjGi1Y3o9HC1ueDPuS/O66",
-	"1H3YPfcQ9FQKdEu/TgEwi5jEWhuTR1NF5dwGtGJxnyV6Y2DOdVZJpjenBv/e4M5+P0+VgfohFGZy1b6C",
-	"791pvVqcA/fRZXUZp0p5vfoHQQvUO21IADfapiim5DvbG8QJxG/uzP4NvvjH4/zBFw//bfaPB18+yODx",
-	"l18/eEC/fkwffv3FQ3j0jy8fP4CH86++nj3KHz1+NHv86PFXX36dffH44ezxV1//2x1D6QZkC6jv+fNk",
-	"9L8nx8VCTI5fnUzODLA1TmjJfgKzN2hbm2NpQkRqhsIVVpQVoyf+p//fi8hpJlb18P7XkesEOVpqXaon",
-	"R0eXl5fT+JOjBVY/mWhRZcsjPw9WsWzcVF6dhIwgG/WHO1p7m3BTQ2U/8+z1d6dn5PjVybQmmNGT0YPp",
-	"g+lDrKRYAqclGz0ZfYE/4elZ4r4fYf3sI+Xa8ByFpNH3486zsrRNesyjRSgAav5aAi2QP5o/VqAly/wj",
-	"CTTfuP+rS7pYgJxirpj96eLRkb91HL1zFWXeG8CSYQa2H0vUdcOHPZfVrGCZ0U1dnSz0N9l0HhW3wnee",
-	"uEqNyYwWlGfgUwZ4jgGRtuCK0W8Cwk9yg2j7/UnN7BCNPg5l9OS3lD22A97UE6nZgYiGQkWlmkeg9X1k",
-	"eSQ6xQPHM1zsweTrt+++/Mf7ZBh2NyKrDmXc+jRZhEwBdnT+gxbFH9b2DWsMmm+FzY37wh3HdaEe/KBG",
-	"2xjNzOFp9Hn9TrMtyR9ccPgjoPGfFchNjUcH2CjGm1fdaFGYFwWHhMbWXfrTOk3w0jUYjyOUo9jl/zh9",
-	"+YIISZwV7BXNzkOKpE+XrVOE42xZ82XfUpzAS63E5Vqu1KJsFt4Pq3mLHZQRUDzmjx488LzNWQgiXB+5",
-	"8xjNNKjNkHVkhlE8OFcYqMsD7aPXoWy2pKU9x8c+0cEo+86VbF+aGup+fMCFNot7X3u57eE6i/6W5kS6",
-	"Ggy4lIef7VJOuA1aN7LMytz349GXn/HenHDDe2lB8E0rtPEcd4XUL/yci0vu3zT6VrVaUblBbUoHodDu",
-	"j0cXCuM3UFZYThWV3eSL0dv3vRLzKI7OPnrXKFaXX0ueWoduo5HkbhHbIwdwLJs+6364e1yWGJx+Gp4f",
-	"l+Urw/sVhiwBQ84La6a0ujclP8RfN/ywFhLrhm1kLzkc+dKZzbCcqGl4Ut43Kqv8rUT/cdNoyXLgms2Z",
-	"rR2cWkeD5rYuZ3CTtkSU//bHt0I8pppORmVUlW7f7JHQvsMpaxPXcXbgGPZIH7DD8vUqmFogkpXVd8qR",
-	"W7Tuj9Y+BS9aStD16g7QH0ao+IrxQQY2hN0NipzPXF39mRaGhKLltrr4nTy7VWP/VmpsqN68sHplWR5A",
-	"sfXpb7teOXrnygsfQt9FM8UgTTe2gETfRhlKd1sc596UHLffuRpbcTWbd+qwNh3vb6e92mLSO/VWRzWH",
-	"1VgbGZC7XrjVWvvVqziJd5+c2oZOZX4f9PFfV029xeNeeqlZxG6N9ArMv6NtOlFzY0LhL6llOqTd6pd/",
-	"a/0ytHy4loYZpzccuTo1kb55LcNq23DKdNAjm51CIqaHBamwYos9wuM6lcuwGJuj4rJT1NhffdG5bm/F",
-	"drPGnYtxV0H8AeIb+Lebk2dDdMPPzSp4o86w+sukOElv8k0z5aRr6fWHcS0NY3KPHzz+cBDEu/BCaPK9",
-	"Dxr/8kPuwSF5Y5qs9uWF21jb0Uysd7E33uJvoRaqOfwNZheqYY+j5+ZtG/xzF8tDzKiCrx77+8u9KfnW",
-	"vVoXnHKBkgthOJ5PK6ZyYT8yTNMgg9zxfz7B8e9MyfeYLK/VGGOVMQsPX2RcP3n46IvH7hVJL20ocPu9",
-	"2VePnxx/8417rZSMawwXsdeezutKyydLKArhPnDCpjuuefDkf//Xf0+n0zs7+bNYf7t5YfjqX5BJj1NV",
-	"egMl9W37Z77bqcs3txvcvwUfMtbjW7FOihOxvhVnH02cGez/JcTYrElG7mocjMeNPngHFGv2mOwj2MZO",
-	"kGHqYJBKU/JCuM6oVUGlrUqGZd8VWVRUUq4B8qmnVMz7Vrb4alYwLFgjiQJ5AXKiWOi8UEkIpbNKCReY",
-	"q1UXJm9AsFtiYKrGX19a/EzXUTT9LCgOWjjcoTl0RdcEO2VpokCPbfnQNfnmG/JgXF/MisIMMAkYTnHp",
-	"FV037KMDwvMPaxgNdDy0zt0zhy8hd8em49hDzGW1hhbKLdfXob+7UPhsbxf2ALiNPRBT3ttHV/vgYqOJ",
-	"axC61VxidUaNzQFUVZbFpi4LbxRIr52luaeZYagl5HPxMN2oBQSdAqlbd3uvbjnCrdXjWnypTVB78iBM",
-	"r1RH79AQETOgDhPA7MOdDMA5sKza0XP2pcs6P9zBDxUPtjzrreUUeoTFlS/IXUybwGpsWIN1g0UdJRZN",
-	"ZXMs/nTPN5l3/RKwqE4deZ9WkuzwEzPpKHGDjXre3HrA+xU9pMVuh4R4A3Nqi+wM6U0aVVBA3y7IxFF8",
-	"if+hRUwCoSWYr1iMxBTowXWdt6YOm/XqEod86Y+SNnrv74byaT15V0dFtBzCNX6L4P0Q3GHx37mKRpan",
-	"uEX8FZJx/MV9Ql6IunyM5fd/SdfzTeonN72gF4KDjbEwlwFLi7fu9KA81ULfVxuzV7q6N+dVFakjX9Rh",
-	"qzb1o6048JlqVDcg0n9MlsJoSB2D2OnOkkj1aEOYta+1QRsq4PRj3s0+Cn/9BC9sH4ODfRiWYyvyOL7j",
-	"1AR+WCaEBf0sMR+Fijh9HOm5eTnS01650ih/U+60jWDSqEoQTqg3RBPFFad/w+P81DVO0770lC0oqRjP",
-	"gCixArxVGDXe9aWwEP7jw0Go2QpyIiqsihllnn9khvPlgy8+3PSnIC9YBuQMVqWQVLJiQ37hoUHadRig",
-	"ItTteWxD7x4Owji6/5qFR7O4uuE1+KJYbHF3Omt/XTrZ1aASlQZpi+a2+mCyDt9OWdGRYTw3U9+qfPi1",
-	"34ahzR+e0qJA/O3y1eHAgyLbi8JuMKyY1nUrqVgCk+9otgybPa5tb6FdsO85Mm5VqcaRXe9YW5ZDgdl4",
-	"DSRaTWThAAlzgX0gQYI3Lq6qQrOyaH4T+mljf8FExJkl1rjM3ckzvzrrPhfzeug2QfsOJW7wqZnbPcKZ",
-	"ubCLoxKQmccG0NgmOW0AbTtt+pD9qD+i6/LoCiAz2apIXUc3lSVQWX9sGcbdUsLEDSHpBUhF8fS2FnXv",
-	"Vp3/NNT5tWuB8Iko80lX73WZ/9VlUyPy/p1es/z9bt29U1b0r+OmOWuVBT15FmdHiVBdz+sVPYsxiNwz",
-	"IfNfRwMqYt10jdWkC6muYtl1xQwrxnrrXRrMUDpna9s9r69o74cWPXWGWHzQiWirBB9VBOmPJYImLRnU",
-	"RMvHk0jY5GYche+UUmiRicJG51VlKaQOVX/VdNBFDPrEXOMe1l9t+hqibM1ytdMIfoZv3V6Jaiv4mcdb",
-	"ygzePL9qSwPvnRGN9VxD7kpnoiT2vtMC4aMyulsdO8XgWhbzz91grntJ78D284zqbFmVR+/wP1ht+H2d",
-	"9op9m9SRXvMj7NR79G5rzCby2AJyQ4z4acPk1en7m4y8fI6f1+2lvhcy0kd+MN/tZp1NpI3bWoDtOozB",
-	"nQmmejNq86222edaaG349R3qiRE75zVUdYh6lQbajZqW+UINtlNxgoRvA0A+rQXV/pY54zmh0Ta2LtVC",
-	"1ozghn0uN73oj+HC+fBRL19+xufshdDkZFUWsAKuIb9eBDRpczgvPbaK2/0UAyf6u2HSXZkfS3yfKRJ0",
-	"kZ0C/i9kubuV8Z+UjH8a3FIxgd5K7M9HYkt/CG+F86cvnL/4bFdzg9EfA4X1FbxoTQFd39H3FNUdNcFZ",
-	"t1omhW0OOLyUt1epvhfSN9u8le9/uXwku8eDY1mGWHV2WW/dlIdI9vmkoB9mmyiKhHWi7wiPQ7gMwzKJ",
-	"ImPYWukkV2MXl2MNGu5836pEn7RKFO31rUZ0a674zMwVPfqPsxQUxRAVZF/V6GIlcvDeWTGfu4rFfXpR",
-	"s3emIU+l6aok9stpb2zrGVvBqXnzpZ3ioCK2BrvllmyBZ5ClIBM8V9OhraNbwslNdVXhhB6rfqg+uIs0",
-	"bIuHxZX6mV6Zjl9HFQw75EHaO6KwEaqv2eyQkcMFMVQ5PQAtH72z/6JdrhQqsZpTT9WdjbnrtsUWobbj",
-	"NgAkr1AztdWs/VdiTh7YWtQVx4TjJXOd0jFGUMuN0V59oTsJtCBZI9EwwNE9Tqe9x2nrzeEstbqeNaWv",
-	"FaI+tte+V9Rd2VO/9mjfrXTwnz74UXlKuTscXVRqQSjhsKCaXYCPMpjeVlW6sjB0NY22sMoxoXluz229",
-	"CXABckNUNVNGVeLNtJE7qnmy9mAtsC5BMiPhaVH7/O0t48iWTNoWy3Rq37imzGtxLVuoSTY7qnvB7Mo4",
-	"iTn5mWVSHBcLEaKR1UZpWHU6j7tPf+9pQOAtFHtZDAQvGIfJSvBUq+yX+PRnfDiYZWCZqr4Rz8zDvQZs",
-	"ifcmEloLaE4+RAW47iZ9IizkWgE6rdVKKIU0N+yZLaxjD9Ge59GfvA3Pusdxw7PIGeceRgPFvbQbPx/5",
-	"ePFGZ+3km+8af7r6bO5Ntax0Li6jWdAOYeMyh1RTwgvAbYptLxFH+EmdufA00Q25ftjfEPlvmnTrXEpx",
-	"SqVLWbsAqVqXzNvM279U5u3gfd+LS5shK7WL01XqsIrRC5GDHbfOtjRHP9UXhYsciPJAtPShEOaZ7sbk",
-	"5Vr9nsUbU2QGWF+TVoulJlVJtOjGPY6jCSY0s6x5Yu9j6Qmjcr321obTLekFEFpIoLm5QwMnYmYWXUtY",
-	"XCRVWHnZJ6+5YNbhalcEbClFBkpBPvHNYXbB69+z6XJ6C/JwNbiKMAtRgsypvJkVnF/sBP4cNhO8vSty",
-	"96df1b1PZRFWF92+Bbama2Ij2km53aVcA6ZtRNyGKCZlmwNsTwJmx4lVWYDLj0sg+/rY693+NpgdIrgh",
-	"BF6AZHN2w0fLT3IDRBngv+GDdSNLqMqJ0TO6cD+1T8/YCjVGTrnwBtsdM4QJCqr0ZJdIMS/Fi1ZmqREX",
-	"T0kRHLjnzv6cKo36OGE8x6qFVhTiPPbmYKbY91aPUxrlwF6lEpP+ah+mps2MmOeqUsSN4HPXIE8tj8N6",
-	"y1wvYB3mwhIgfuyQHGctrbtG7kNgNL7DY9Sah1AdGjECMcMlFod2YOrMP3thuQFfjaNtMJ76tyLEx+EX",
-	"PTAyVe+BJTes+R/TWyg9Ox4pLcrScCg9qXj4rg+Dp/btY/1L/W6XJG1xB6up5AJUnNPoIL+0SFdoQ19S",
-	"RRwcZEXPXdrjwnXW7cJsjvUECwlNtp0XtKqbt+KDc6XjXpULSXOY5FDQhJ3qF/uY2Md7EoYfGwnEE/rk",
-	"QmiYzLBGSJpG6jMhr2LKC7MKnEqlFG+CT0hmzvlcyIjU3NdXnzQHnDbFNx2x3gmzIBhJOvDjIbIsPfUY",
-	"Ec0Yhqwc0eFqnFS65lp6sBdmvREE4riT2gLUnv2/QLm5gwJ20Pk3oPoWXk99qGW3bbqxbG8IzJYoa0mb",
-	"pIjo5cs7GGMfD0pZkT9Lt1E7iO4G8z6bVvToDj+9in3i6JIyPZkLae8tEzrXIHdmc/wnZT4uwzmZtHA1",
-	"iAiO4HQENw5Krbi5n+NYFgTi5J8hEVfryQhlSh6SFeOVtk9Epce2qLUEmi3NHSk2r9uRsAW0K6MkYUFl",
-	"XmAP4HlQBIS0ZZl0S5lBoBMpsk2jjVn390J+5gX/395anG4tTrcWp1uL063F6dbidGtxurU43Vqcbi1O",
-	"txanW4vTrcXp1uL0d7U4fazKbBOvofnap1zwSTuY+jaW+i9V6D/IXm8AQ+vTJWXIAqPCKP12qT0MfRpo",
-	"gThgBfTngdig87Pvjp8TJSqZAckMhIyTsqDm0gVrHRqbz6iCrx77TGWrC9AVmW0MWzEKg3nhi0fk9Mdj",
-	"X7t36ToJNd+9e2xDTYnSmwLuuWZ2wHOrkPuudsAN0l1TO+rFj2+A7trBswJzaBT5Dt9+BhdQiBKkLaiK",
-	"LS27Fr0zoMVTh5sdBr3/NJO7UPs/zGh/jBtGTYe2FS39tcivlSpCbcI2eRalcP8xp4WCP/qyuO14K1pu",
-	"74b51nJfUPpbkW9aJ8Ts2hFuYPNshMZ+M8ap3CQK03WTpdqkoYVhV46wukbM9wdNclsm+191yWwXhaVu",
-	"JrYRQXr0PipPjVNvWGcom+c/b9HJKJWiHovSpW2D5gAcVIsUE6rsnpDX9ruPW3kUIXJHrGbmn0ygcfPN",
-	"wDTwXXMrcqznc80l8ohPnl48+2ND2HmVAWFaEUdxA8SL0QjNSAvgE8eAJjORbyYN9jVqSKGcKaoUrGa7",
-	"JVHMP/HEBeFjnmyXUx9HjDyLFreNJ8dEs544BtzDnTcaBvPmgC0c0bHnCOM3zaL72GgMAnH8KWVba/G+",
-	"fZlePc3mlvHdMr7oNLY0AsZdE582E5neIOOTG1nxfp733RqyygAXn+S76PdAryqsdcOJnsOsWizMbaHr",
-	"ZsVGRjgeE/wjsUK73KFccD8KsoO/9mkw161x0R6uy12ishN3fTHYe7gdlG/QI7QqKd+Y3cA8koliq6qw",
-	"OLStwA/LaG3fglRV+9o62WfBf+WNkpEx2ona5u8WLeSSKmL3F3JS8dwlK3bK6a/58DJJduizNa/Z9NaS",
-	"SHa9idW5eYeICL/LzaIUipQgJ3rN7YFqHCb0jlFiT+5HLd9/KzY+nNiwJS2gh8F2O4LUDOFA0kNGfA3F",
-	"R9T1qs6pbfTCos1M4MYztGj0Z6HFLXzsmweNDeoM3wwRqs0tzt8MRUkoyQqG3mjBlZZVpt9wig6paGHT",
-	"bviQt2H3876n/pW0uzThzXRDveEUg8iCmyrJA+eQcJd8D+BZrKoWC1CGj8YENAd4w91bjJOKm1uYmJMV",
-	"y6SY2Kx4c76M7jK1b67ohsyxIJIgf4IUZGakfrTr1pasNCsKF69kpiFi/oZTTQqgSpOfmeHAZjhfeCWE",
-	"FIK+FPI8YGE63K2/AA6KqUnaWvODfYo9xR1OvFUQLZz2cd1fp30Nqjsq/J+7//7kt+PJf9PJnw8mX//r",
-	"0dt3j9/fu9/58dH7b775v82fvnj/zb1//5fU9nnYWd4L+ckzDEzEqvAFU3FbzDbsn0LcwIrxSZIoz5ZA",
-	"XFxhmxbJXSw56QjuXtM9pZfwhhtpqQVBCUH1Acmn7UbqHGh7xFpU1ti4lrfJI2DQHfIgrIokONWt7+Yv",
-	"lCoe0YH3nOLG274grb3f00/TkNuAHV77pLp96rpg9rzkbiENS1urnpZ746wB8lYnyOdf2vbwF1KPxoNd",
-	"SbsDdtlVs/kn4s1v+JjQQvCFre1qrqgC94nxstKYJXCTVkC4oMVEXICULAc1cKVM8O8uaPEyfPZ+PII1",
-	"ZBMtaQYTa5YYirUz842lUzMO40wzWkzwaj4UIDixX53aj3bI77MQosZWK8gZ1VBsSCkhg9zWPWSK1EaB",
-	"qS3EQrIl5QsU9VJUi6V9zY5zCRJCn1RzD28Psa8uoNd8YmtmdsE/dq2444LjQLNlohcWyr5LGkCBvNFm",
-	"b+D2NCoi9xkBxqNeRd7g+6IOQ7R4a3Kgq2odDf0hQloNzSHqSt8ekttD8nc7JKkKsYjPecukYpEYb+MN",
-	"295uukjyBzTlfZQK6rcNSv7qDUo8W1KEEkkbd5x0z0yqCNPkEsurzYAYeVehC8E1InVGAkz3jI66Kxys",
-	"XNvSbEkZd7W5QrIKwmGu3KsV09r38b4R66tlZmh2NeiArJJMb/BWREv2+zmY/7811woF8sJfmCpZjJ6M",
-	"llqXT46OCpHRYimUPsI+IfUz1Xr4NsD/zt91SskuzP3tPYItJFswbmT0JV0sQNZ2ztGj6YPR+/8XAAD/",
-	"/4/k3jolyQEA",
+	"H4sIAAAAAAACA+19aXfbxpLoX8HRm3O8DEHJSzKJ38m5T7GdxBNvx1Jy507siUGySeEKBHABUBKT8X9/",
+	"tfQGoBsEF0mWoy+JRQDd1dXVVdW1/rk3zuZ5loq0Kvee/LmXR0U0F5Uo6K9oMilESf+ciHJcxHkVZ+ne",
+	"k73DNIjG42yRVkG+GCXxODgVy+HeYC/Gp3lUncC/UxgJ/lKDDPYK8a9FXIjJ3pOqWIjBXjk+EfOIp61g",
+	"Tvz2t8Pwvw/Cbz/8+dU3n+CTapnjGGVVxOkM/r4IZ1kofxxFZTwuh4dy/E+rnkZ5DpBGuIQwnrgXZV4J",
+	"4gkgJZ7GovAtrD5e1/rmcRrPF/O9Jwd6SXFaiZkoPGvK8xfpRFz4FmU9jspSVN714MMeK1Fj7HQNOGjn",
+	"KmovACLHJ3kGQzpWEtDTgB87l2B93rWIaVbMo6r5vkV+RHsPBg8OPv0fTYoPBl89chNjlMyyIkonoR73",
+	"qR43OOL3Pq3xonraRMDTLJ3GswVQcnB+IqoTUQTwnwD+hrNbiiAb/VOMYaPL4D+P3rwOsiJ4BUQfzcTb",
+	"aHwaiHScTcRkGLyYBmkGR7bIzoAmJoNgIqbRIqnKoMroS00f/1qIYmmwK+GyMSlSpIXf9v5ZAoSDvXk5",
+	"y2GuvQ9NNH2CZSXxPHas6lV0gRQVwEgjWFE2xQUpcApRLYrUBxCPaMPTSZIL+Pnrx006NL/Oo4s2eMfF",
+	"IgUyERMLwAo2sYzG+AZBOYnLPImWhFoY5LuDgQS8DKIkCXKRTgAJQXWRlr6l4Nw7W0gqLhyIPgZawSdB",
+	"DiRh4XkY/ALEU6mnVXYqUk0dwWhJj/JCnMXZotQfedZBUzsWYtFBARLDxagCeiDR7OFR/O0uGdQ7GvFT",
+	"97MynslHTaiP4tkxPAimcYLyMvjnoqw0AS9K2nZAX5mLMfLeSYDDIPJhyDQCGhFP3qf38a8gBBYAzCEq",
+	"JvjLnH96BQPFMAn+lPBPL7NZPIafPDugYXWd05I+m/P/cDz3Ua0unLLkZZadLnJ7QWP7LCCtvHjmowwe",
+	"008abgZ5qPUG2h851vHFi2c+ltr9BUChNtIDpBd3eYQvgopTCIQ2Gk/pfxdTIq1oWvyxx+oFfl3lUxdq",
+	"kfwluyaF6pD1p0OjRLyTj/HpOAPKZVFoqRn7xGzhN0tzKrJcFFXMg8K7YZKNoyQsK+Bc+NO/FWIKcPyf",
+	"faPo7fPn5b41+Uv86og+QmFcCGR8IYy3xhhvUXkkVctz0JEP8VGHPQNJFoNMr05AasUpbyLpXchpEnEW",
+	"pdVwb62T/MnmDr9JIMxWsJDkrWgwIO9eBPziCAQv0r5Ueu+UNU2RMB4QxgMgyGCWZCP9w10Y1SCXnsMv",
+	"jKpBEE8DEZM8FxdxWZX3CDOROWT2PHDCgh/tsc9jkDFZmiyDkZByB/gMjMl8W/JxqYAjYmkNZkRYB+10",
+	"BkwXkKLQgHrZLoiRtMqTLEERuJKM8OWf5Ls2BeLvvT6+8dRno91Pd6TRS6QSNfEv5uIW3G0QVZum6Auk",
+	"psPmt5tRFI7SQUvlC4PgXdMV/RJXYl6uJBILIovQ5PZERQFMXmpQIWlCbQoCbYmJB/SoOCVoB6iQp6D7",
+	"nfJ+ZIR3JARRak2byYzVq3PYGaNyadQPW/eLm03Irj0PcMOjGHXjIAHCRGWINrMMTkRCCmekDQs2Ff0E",
+	"L2fFche047NoIE4VWWdT+9A5dyaa46P2MO/f/4Z6yfv3H2C7K2DU5urwKh4X2SE8xH2yJ9gz9z6lybf2",
+	"S08ZIv1kiyqUV4uwEOegNzpWpBRPeUbp6044BoEcmw+7vLrI8Yc9oVxJstaEwXlUgvCEYzEJQLmM1iVU",
+	"VLZAkS6d2wBMDHdhAmdgxieCX27sLrAtgxBUtd9Mp0mcCtC2Y0AA3v8QgVGlOF02julOqNYA50zOATds",
+	"HCB4k9IAvUdYIFcBTAAvqBR0Fth5liU8cPA6QylXxeMY7ka4OWsAmUqREKmxgXWkmfW3cBB6gxUYU56k",
+	"/5VUOdD3NrlVa/CRxqk33AMXCUpQlI7pPmV4BpAQrCeP8CKG09o85G2RZdNdcBB5aJ0kLq0gbHHBDZLb",
+	"qaAtxDgrJg4Go4/WaFmJmkXqf+7+7QlaoqLwj4Pw23/f//Dn40/37rd+fPjpu+/+t/7To0/f3fvbvzm5",
+	"10654OfOknLcefday3iUoBKhFptm8A7oPzxdBJJ6WmRztrVlWdXASRnMRXGagHgvYjiy2XmKJqH2fg8C",
+	"kMMiQflG/6B7slJZ1tFdiIafnsTJxKW5NP9GiH2cuHstV0+RK8VGF+albgqvAJ+bZsV2+o6Ryk1218Hl",
+	"mMYkzgfr60w17uTmdIZ32AwPUBMDOmh+m91txOl6kGDHGjT450WUM+zyCZu+4GhH2mTNsG5p/Ohpl3DC",
+	"bHt6jKpKUG18/115R3VCwj6aOgzfJ9n49KeoPNmBxBqpsdrni6YB5TuagGZwAq+s1gHMaH3IG18kkg1G",
+	"1lRDvcSX2azcwRKTbJ2LYJ4/jZIEp26zzcZqaeBe5xjuzfhyIOZxhaqXFGSz+AwufayNBM8juKnBuoIx",
+	"zD8wrpwsD1lCgD4Wp6koBqzNaT5AIyvbMp2jUuDVsRKBtRrpBhoGwDZh/VlBrBH+O4/oPj9Hi3Ke1L/R",
+	"99ESLqINcxOxF2B4CKNl7IUHcnUANEtwPTSBr9dYKoGoBh/i3PIRzZxmvLioEOSbitNxspgY/Gl+UQMa",
+	"3zbWidRMARySfGOsCscFoLDgIdheIifHfwgYRH/M1Hk3L0QohyiiM1GUcINDsVJf1D1Nvrs6nStO5iSq",
+	"IutkSip0G8GZc9B3ZEeDmdqjv6F/wOLwMdqEkJIM9cRk2iEzkN4PMnMgqngmfAH5FuzvnF2NAWq+a0H5",
+	"1EzuZjO9Tt5z9m7KLZSL0Dt0fBFPyl1tEw3m26v6CSlrSl5L3+lkOtZcfRBwnOVSwWyAwJyCRmOEZBc7",
+	"F2swpgsm+Lkl0rILsZOdwHF6M3uY9ZmELCtuvL0PxyE8BueCWGAEd/xqoA/nss4ZI3b40q24iudiO8WY",
+	"Ed+HInH30a1WKm3V1sUGVujD4SgrNlO1WqEuJqAjiHBUS9McNCiIXl3koWRcjnALfqExUKDdld0aUnN4",
+	"F8ZqWDiqokvAQomj7gIL9YF2jQU4snEidsAXTpwaLlC0ePQwOPrp8KsHD39/+NXXSJLw4QzOYYBX2zK4",
+	"K/3GsLJlIu45DyapXu7Rv36sAmzq47rGKbNFMQbo8/ZQHLjDt1x+LcD32liro5lWrQHsJS4Eyn1Ge/CO",
+	"v4OXnonRYnYkKrQrlnAdne5cVLRmcEFHL70FRE6Vd0kTnlQl9yf4yj5w0yLaz+lNuI5zKBeuIy7RpzAf",
+	"7YSofBs/MbNMAonRiVh5KNbdJjPN0t6qYlksduFJE0UBQtGln8B7VTbOkhCV4DhzyMa38o1AvqG2K2/+",
+	"ztCSrR/nJrs0iBePCMRIqd7CnYc+vkgNbjoVK16vY3Vy3j77Uke+uaLB0kIYJCDqrHniyIQYBRP6kBSx",
+	"H0XFyinIZGD+8/zNdLobn3tGAzlUCJipxJkCfgNVw1LAJGxG7RVz1kCmnKoPzprYUrFRlR8qiaajZTom",
+	"bWQXZ9mvXcnQsaCE6SzXKsIIB3wmiqtyofowxVDcKR2QIqZewqmCA5lnJRD0DlCVq7F6H0UbgpXn0Azf",
+	"ixMyV+EvQNXNED0wEgY1R4lUhUEYjE8FI4NwReE2z0RSRT9kxbG5GP0ISMt3Ltuac/bd20jurAzomeC3",
+	"KlwDnpOF29zpZgj70LXGa1nQU22e4jUQ9HRyX8azk8qyRGzufOuE0TWLC1B6wGbIBL9pGyNfg/Q+Ig/l",
+	"DvRwM1jdkm8LBbhaLPBahh4n6Zl2a+iekHg8FONFUaD9zVL6yfIFkngkkLrG0QJXi4GbmUvYmg/DaMzH",
+	"MyTUeFyDJpiB3+LpTqIzuGMmeBlFMyPcRLMRLtqEENMiGx5ueT/oK3xqwAKaxqCwY3iY9Aetglf7jbQ/",
+	"z4c8Wg2tQs8C+ngwjYrLWcHp2UrgT8UyPIuSBd5Vfv4VYwQ/j0VQhMuKLWhGweiNaBp620vZAqYuIm5C",
+	"ZJMyW0/4JOB9A5lOIirhQ/b22PNufxPMFhFcEgJBJaZw9Us9WmqSSyBKDf8lH6xLWcIiD1En9tpiUI3H",
+	"/U6jNFOK8ooZ9ARJVFbhKpGCL9WMSLhUi4u7pAgN7FGuX8Iz0olrgUpyHla0cYp1475oSu/VFCf9Vd1K",
+	"29OOUbynJUhndUUtF3meFXAxdS2PDMTeuV7DUzUXbL0ZW9+DgY0sSrFqZB8CrfElHqVVhP4AilThn9LA",
+	"3F4chfSi+rJcF8s1+AyOumA8Um9ZiLcz1jwwojNJf0nkhhFrNXobZVkiopSj/rI8Rw5VhYtUf+fD4BG/",
+	"fVj9Yt5tk6SMnSNNZZKJkpyR8n0J+TkjvSSv6EmE9kIaWTkDyPrH8SJtmPFYh6DSj0XYdV7IIoBv2Qdn",
+	"o+O+yGcFqLchKOVwA2u7NvhxwI/XJAw1NhGIMaZklQhH5Hd204g5EyoocLNZM5qqdCneAT0BDgbnHK9R",
+	"htTk15tPCv/BwV18UxLrHT0LgeGkAzUeIYvpyTEiyX54haLdmOhoNVIqbbkWD/b0rJeCQBo3NFaA5uz/",
+	"gFl5bq2A7XT+JczuWbiZelfL9vhCSLbXBGZDlDWkjVNEePnyCsbo40Eex4wVaJylP4vlzm/vzQmcUTXA",
+	"n+AqiUZ26wHf5HP7+4Bz/Jpjbnab72XwaoPfMno5lqPSHurAgx5KZpO3HFdoWat2YY5wjIoCF/2yCKhK",
+	"ScUbj/2KuIB/JUtUbMmzTGa2cjHi+Ka2PxGjmOwB3AUJ/DPK0A1n4ERnLMkRDWUtzxkcS7etbviOG1eu",
+	"GjrkLYui8R1hxo0T30KGE4JegWUwZcWmTtiMSuekK0qqASkFBMXtaH0GxJKNZlpB8I9sAdwupRvuAlMJ",
+	"pZJGWQGs8dAMqG7qOWUemMGQSMRc8G2enty/31z4/ftyzzE0V5xzcFZKLzbRcf8+meLensBJA4l5+k7M",
+	"s7PdOPFwoElnuDvpqahJE5mrzVagbBHMoybva+k28MgvzaU0FdV5VpwasBro2t4fmGI8dX+jv577OXy4",
+	"XO1+k8P3RYV8XwfnO5eflVWNFe/C+wHjvXCQSzOroymBVgfPypH7IOBtY3AdG4AcuCwlm8Plby0uGnz8",
+	"os/abY7SL3CYxu219fVQ09a6iUu8w3vLsyKKd7Hhk4LdMe1l/71WbSVhPqZeHzo1fNCvsjlmB+RCOtC6",
+	"TFDq7YDehisl3tZhGSkgh6VsnxSXMpqKsMrC8mRRYR6KfyEY/Mq+iNq8iZhWg0C6PGl95JxFH8UJWbbQ",
+	"hOxeLymUHhsmmqv0iDgbxRJh5Rnj6Q1oAA4nzrPxyTB4I0OodcipxjyKJhv7q3HTIEK90619ciBxDefk",
+	"zE6Z0quVfxP4iKqjeL5IQJLuglWfgfQE8VAU8USsZNRyYhj4OXz3Rn8GMIkLMUYxDJeCMVUZ6jmWOMZv",
+	"uDARk32MOgoXnugLkHjBXx3xRyuMiSYOMp7PxQRTHEHTyQsxFlxlBy/ipV7qMOCSC2NQOGZk5IGPZzJV",
+	"mschYU/5l1hxaJG2hlj3tlldpCF5aUtnmRsKU1HVmvCeKTAjoOXiZXsURsxIUPjs9ZLJ1vY0Xd7OEJnB",
+	"nte2ifg+M7ZNxlu95NSmwSO1K7CFNANNz2gJwideB9tItLcRD1/FIQqX4Ig2Q7ugbE9sZUiZh74kKTSp",
+	"Jssd3AN5IBgcTkxJWrvt6Sj5qTMbs1yWQHpt/zR/+rvnuL7bxMiXUfJ0OAcMO6yWnFr9ih729qzwTcMz",
+	"It351hqwadupIaGxgPrkfUh6200ikmme/WYwR/lDVuwqqooH7B8ntDo4Z+U9Qk65aTwVqkDtqBu2sLa4",
+	"SDnQEfxxYafWv5iUA5mKxYE6JuPcWtBbXVplBwe4OW4jvMQq48K+SpHkAN44icmTCZPDTX5cvU8jcmZY",
+	"S3UEhyv7p9/z9VS94na1OTxhcigAgFQl7eJwBoJOhUOp/EEI5QArFzMQ6lXDhgRfvU/lW7A5ixSTYzE/",
+	"DY9LyOcFlkkR2kN+E5PjpqQWZ8EfosiCEaab21aVOVZ2Y82cY11wGhgVFlIBJaHN+FWMYag4nIobVEdW",
+	"31olFob9GddMpKKMy9Ad2f4jP6UMS4mTE5ltSYmH/Fil/1x1QreC3VVMTkKOaYRkhoR/oK3JSppswv45",
+	"+JzhrhA6idIOIG3QYnCX6m1KgrtXd20ATO9TDBkGwgOtPJ4gL9oZ+TTFVOtA8xFrUFlt4xqeCoWANe/w",
+	"W7CqwMGpGvz1UvS55gSdMYX2ljcS7iRnLHcOoBzYBVdzTlcaxZ0fnx8H+5IQyjtELHJoqzSh4wajysnY",
+	"gYy4S3aW83tg8M/ElO6DWfrkfYrZq/t8mvbhrlV8zwUNhrMseKIqBDyDd96nLTHkLVRiVxEyFahvKzWt",
+	"URYFqK9sFotsowhIFFFkkWop6x3itmIIhM6iRmYui8UgDbzOZNxcEZ2rK+8C7dof51H+GwDyIQjfLw4O",
+	"HlE+uimR+FHyQKRbALr3xddbzLJ536WFs15OSUQhJkm6i0hVIsqJQkjhmNNNE7QA+qyWK68yv2goswBX",
+	"hZ9VW8KQrV3kgpZ7xF+psuDuRdEj2tR67bWtdtCqqrfxBq6ozBctqpMQOYJzVSUeA7VXqoZRNEORo4Kk",
+	"0OdIRkg4OrhkNA0JzBqg8s1inlfLQe1zFcsnZbFVTQttRjJTHg4uDIa+NBhwkU8iqchE6bJZIrfk5Dca",
+	"9J0AhnWc8efDntXFrWr2VonW0nd0iXYtWYvkax9kOUZz82VoqSqYIMuZUhECRRZPNF1YVcw8R5sVgB0c",
+	"axdR1OqE+hARFQ5EMPF7ULDBQnG8rUjftTw0jacVSNdQJPEsHiXCb9q3XLcKVqRKNI/GZ6rEhR6wRG8u",
+	"3o5UfSG+MRVoK0Whzhk3sO+NxHHL8k/a4YmIimokoqrTXpvaZSoVdKSQn1MFETKakANCXOB+xxUZQUD7",
+	"ExN59+Z3ZK7EcKOIUZlFNNkQVPW5qRgy3OQSIRHuqIev5L1V80neF2QIrk2dBDI/Rx88mivOcTcRwEy1",
+	"fqACsZacWmAudu/CabYLsm+Jsto3OMgq7cep72CITF2taekYfQtS0uch4sXJHQQ+QfbgKsOo5uYoCelV",
+	"eIN1USRSRwkp1DoGnkkH0whyu1DjesC62Rjc1Y2yqgCrY80++ui2k0ef3G2Ko19WXc9LKUXbVX//hRVg",
+	"HFXt6vpKTDdZ+4DtOSCsgYLhC1WFX5XeV/X2AbB1auffFiO97GKkyphOWnKWo9SPPV6rsWIpstaTUXka",
+	"WRw0DMA9CJCTnkUJclJZaMAM0qr1TnefRmV3Gb52z3cn6nnQ5BpJO1lrlazPbLI+W/FWy3DfCtZawyi7",
+	"CLkShvNqNboY4ZlwpmRRXQ7X4eXK+/BfGJzCJknCcQ7P2tD5IVOAWZFuWEkd8UPf+dRGBm89QLoVeRc1",
+	"l0R60q6myc6nyW4GjEed9pHdXasE/45A2kHtYVvbamsiRty2qhS7WY3vcDp30oPRtvG0Xiv/J9MuwV9c",
+	"XZ3VK2kS0DbKbdPXgT/OuVfDOm0dmuRQA6IDq2+bSqy7/mot2q6OVwtrLpaEjL7t7GqjrQTJRpaAsKZX",
+	"h6cutzQaNATpDEfqM8vOSbsXpct7VsBvIWboQzHOBRXkcvW+HzInhlSS1r+6Ki+muL53Vi1idsdyKV97",
+	"mVe+AsrOmcYFpmagZ8a5BHzph5IsaT/gq25FuB4kCj/QgGvrwQQR5qtO4mThJmUJ0s/PEKLXWnKVixEJ",
+	"SiBTijYaUSs9Zw7CGr5JgodzVzoR9JIR9DK6Cvz0O1j4KsJEVbDr09+QI9bghV2cxUHLLmJqb6gXpV28",
+	"1tQnd0S/yWLrHK5FiZZ2ofV6XWtZz3rQq8bbseX6xpHTMBHRNBgjIDqotQCREmPZKcVsZO6CaT0SmK+u",
+	"nmfG1AfTuTR6pG3RCB6eZar6HSFlDp36HC6mO55ZDlXKlbtsfO5icwyrnKKDHKzqMW1ysO5UVhTOsMsF",
+	"2ELaRI29MjhP1bDx6ZQ8knMtVrVod8p8NpthEjDXOZRlELjopaw1nGRA9boOKP7eUVp5GHCFYypQ3FHb",
+	"WCZkCV86Vq07rZ+47LstQW7yyakuM02CMQFUuG1v/fa1iRNxdioYvWEZyq/24LUSxZzpD8eNlAeTl8B7",
+	"qDebtifBuqx8yy6FWt+K1iqt7ZKoG/gSJ2rl87sPGHMQ3SvA9HhuEo1HkANw8eSi4QfmUYcbkERP7b/d",
+	"WLCBM5JScrAV+KnHma9o/XwHlSV6X/q+9snqs482Bw5vlwHaeDZAYnF9ncmiIOdiLXi83Z5R2x16rv3n",
+	"X4+qrMAKquwgDhmkrYag5ayDBqvDIaw95nj5STydCtsxWm7i1KsB13J/TXoQtocE295TbWropM82ka2g",
+	"LbOC1Qh105ODUrq6DLk79NimVi1srI3bwMfsLKHzM+iNv6LBDRgJaJUmVFn6i+tifQ2aOJvD0DTyyghg",
+	"BGzFrpBl9p0gCnU52/Sj0tI775S1Zp5kEqlt4Ro7dejepR1tjezM6j8aRkLV2pPWl3J5x8bqogOQ9tmr",
+	"I3cQEp4tUd+WJqGv2qJ4slr3sW6k9lTrNRSyhZyuLbUy2FBEiSJ8Wuzep8HeduE/LjkpR1yxE2+1aHbu",
+	"AgXncjhILQZwzQ2JsGwzJrDJsCmf0gEvSaWDXldRVld8O3OfiuPnhy/fSvAxDgV0viLUli/vqui9/Mas",
+	"iju6doshblUjTf1sGbU2X7cTsQOrzqktTcO42mqdbMLorIMqA62m7sSBlXxTRvzxEjsi/0SuA/9MgALH",
+	"/dVj/aKzKE5UHICCtq/ThZfbr1m3k0/YA2wdM2gFg249ljdtBA1wCrPGvcZxc7pdkCO0stww8L3Fa9xn",
+	"1dD6Cg5J63xDhczd965UljknxijjD6Od64E/wNmwBZVMcnXGL16egoiXCcajO0bjWAZltNTCYcAq5MfZ",
+	"R+QN9+/bB//+/UHwMZEPLADp95H8ne5RWDLEcad3Wn6RZZFhFzuT3NNpMt6NuFozRCrO+6kLoCZrHTnz",
+	"k6GmUA5EVOg+l9g7L2KJz4n8BQMv8KdhH1OFvemMbhuYPifoyJekqmPh59EFptTodpyWL56SppG0SPTI",
+	"7mYcdtE+QvAdhSGEJQDgjgFLRyWypJQjvPHlgF7uHVKAcyxiT5pBuoit0fG1ciMPeGMh1qxOhJfORgAG",
+	"v6NMsoBFGv8LaCOmgurwqCBJ3BDO6ipEo7YUbLd9UQ7MnmQzfF9lGj9b12bU4TFWVrUug1GnB/6Z9gor",
+	"RLjalq+Z/mLP2GL+HakrkqKU+KQ8xxOR9KsY0nnP0056p/FFRgUo9ikd8P4LEjJb9d2LZ312Oi7DaZH9",
+	"Idy6A/mMHcWqVLBDTAZ4+LqHO8MEkqj12rOvIpD+tgUfqWxtS1CLloF2otpEhLv5xHobvabRwNpvv9mg",
+	"dHcXkZvgu6jacUj1vCoPM6MDa2UJUNEZFf0IL9GAXOaklojoPud23vA+j2/OuYS5lWudROejyNUEEu+L",
+	"CJO1/bU4TaxQLj9WG1TqSh08e2Cltuh3ZSUdgMF4j9rNATa8+/G0vW995pJHFGdf7wYcupSUmWOYRXoe",
+	"pRRWSt8xB5RfozVSuc7Os4JKWpfukNIJkMjcaQwH5E/G7UDASTzDmbiqcxBNK+lNlQMFXDebqGgSl3kS",
+	"LXVpGoka2JCDgTmzuq5RfBaji1zQGw8GsvlzSQLatOtWn+DyYJknJb3+sMfrJ4BSOGbwCSMW0Krv56R6",
+	"6sDokajOMXr0gN578G1wl+LHy/hM3HMLGKms7T158C2F3fEfBy5daSKm0SKpupj8hLi88lW7KZuC7HkM",
+	"ZKtyVHeiyrQQ4g/hlycd54s/7XO66E0pglafrnmURogQF0zzFTDxt7S/FNnTwEvK3hkBk2XLIK7c84sq",
+	"Qo7lKS6ADJHBwNwHWMdcBg6X2RwpTLFWdfzUcNR7WLWIVXCphxSRnzvu+Ndw3YrmnoRXSrJ4Tf52G60D",
+	"DIqn8iuxSceRLBJOoOrFQD1zdd0yxg3OhUsnfZWyc7ADIZwIshotqmn4DV7fCxAbwBCHPnDDEZy0du/Z",
+	"egfCdD3Arxzv6CkqztyoLzxkr7Qc+S3WVEjDOXKUyT1T4cM6ld7UAXe4ty8K3TP01to1jht6CXBRI8DI",
+	"4uZbkWLaMeCWxKnXsxaFrr2yK6fVReEmmGiBO/TLu5dSE5lnhau3k2EAUispBJYzPaN0Y/cm4Zhb7kWR",
+	"9NqFbaC/3mBHpZZaqps63c7LguVVdtzTdJUt1PR/fWU6wpBzm9O4G9ZLwFf75iYtjlccpbyevbDpQ+fo",
+	"UHrmwVxvtNEobax4sn84vUd/cx3xXk2QeM9rptIHH4Hmp1SiJkN7MwKNFlN+9ePD+mNm7/fv94+gdtsL",
+	"8VcHajaTNc0KvPita6uxiXubY8gm3jpuTFaucVhYnbIMRepIjjEI6p2Sr17v2E366tpR6e4DpFBDj5u4",
+	"uWb+SptpEqL8/AHo45lclctKgOQz0c+tlJoIO9j3JaKG2FL0dPUJIe6NdIAn95RkuuwtprrKUbm6z2F7",
+	"PdvZ06JJy5Th+iuiPFaGKFlHDkcdCYyVLmvtKntH3HzOFNR2oe0NOvZiESeTX40HvSFVgdmPT5wB8SP8",
+	"8He+wlgvWNYX9BOnInF+zTf935VFwGGz+GfmGRauY+5HjYVL2BuQGrDqQKgp1fiIq7jCGig1FNVry+lq",
+	"PSAWYb/xPdNnzLB1S302iG+3qG+Xq6Bh54tKRlRTHRDZ/msaJ7LIucuXT2+GRVR5JEJBWeRTMyJo2+gr",
+	"DGSNcRgdfXPxnFSOMsLWlHQIYXVoD8JydKlofE7FB2lkq4kYWsZT2QWX6hhlQbUosMjz1FoG+utA8C0H",
+	"VP6dBznAZYkLmnvvyYODg4N+DlLCV4+1M17Vwt+YxT3Yp1f4ieSo3N5oLfA3gf6Tobp1Nr9NXLJzPAkC",
+	"F4ulB1xbgLzbqJNw13hYzIQMy8PgRyq1h4Rea+hDBl1VLLxe3naRJ1k0GVB9c4zvCnhW/gaudYg66lo/",
+	"I+tl/Yg4HVT9y/2qUoKeMmz9x+muAoWrLqtQ95N3FQXFN451w/m4EblFdk0bO8PgGZuUdVASTxJQlfxi",
+	"jqZYPRqbMIg48B9VFQHcaIYd7nWawz29+0xLPV8U1Vv5huKAxtVlpXDr9pbEwXEZHKOBBtyJKAZBhvb1",
+	"8xgLkp/Az2eiXntUF+5tNGCprxbIKmXCGa6heetmluvuggKO1XYVG+KErLEPW/stTVGabFGM1+iTwyf/",
+	"iL5y5xyl9cEaMRvc4OpCtcgaBq+ko2YMPD2Nx9QaynV9oKqi/VzCPbpouX215Z48y45j6CBlq9aCxKJc",
+	"/wcvy5SIawdkWE9xv5lw+M8K+02Sd3KG9SmYB2IlJNwebCjHPjBQGoRsV4r0ZXPUrHCErTlTenT4yw7D",
+	"6WETsTCgx078Az57Lf0KVP4IpBDZCyVS5S2WnYNYsQiPCSaNBjNsbsqrree0lb/hN0MgMwLhw/BlNovH",
+	"QBY0BodRIlI4grk91KGKZ5bxw/juU3xXtuHQP9fCAXlSte4PThZS6v1vW3MuUi/6XXFrKgjIQq4e3x6t",
+	"gxg70xRILiMZYn8WoBmRkzxvkY0oCtelGbuzLJje6I2Ak9CdFbDj1AHGSyz2pLVqR0m3sVOW0MbQafZ8",
+	"B+9j2YDeHA+DlT2pPFQfgqMdth2q2VQEUUJrVHP4txHIXHZE8bAV/YK5XWBFT3UokLotpQRThHVgOClT",
+	"dZs6amdSGeNAZ84Sluqdm60gWw9VWnENXSuTWPXn1NhnXTnlK5w7WoBWWWEJVlcJxe/paUBPVTIkNhda",
+	"6JadOke23nmgTW1yIqyqsph3zKVe2HK6SVyiq2M+Shxhw8/0Q5hH7TDVVBst6f/r9AbUAftrZ66r6PzJ",
+	"eu022pn4Lu0ZaTrESnv9MUEyZXt0mKk3I3Tz/U4pXSWtfxY56c0WZtYeufjbcxQcdsX5Vn4CixZdEJ5y",
+	"ATJ6rkrb6aLEjT55ERNta065eY4tawCvXnQCDsLPUy3C9jixfGUvjK9mxNhbISeqZCFGWKXhCX1MGP5S",
+	"dhw93vBqtV2zvvhwDg+/TMePxEcn0v1e0p9rPlGO2DMMxesL3cxdaYhgXX+l7CrStpeCDMjGvTmDHOYQ",
+	"P/JXnc7mc9nEwRFReDaHi5j1zI5EE8LN2DjY2pEWQhdb5zO6WjmfFOfu0Wr2EU00fQvwERrlEgacVKrA",
+	"U8Dw1PZElslWYjb4Aa5f6Fn/z6M3r/f8G2ntQHtLZRV4pwnbtzE6y65JHrOsho/OAv2RQ2t/WyssriMn",
+	"vFUiBxhmwD/L+JtGGQODC7wpObR8uxyDmrJe5dSqT6dqgFaZPXFHFYXa9Hmv9faoKr/+5B2R6e76pGsg",
+	"1lP+83uq7qmDkiw4raB9zUcaHkw311stFN2cuclzsjRx+15KjzuHSuy5+cDooi/FY5nW3u+KKG+9++ih",
+	"+92Sb5MNFI7KvpOli7jfq58cuMXSZu7d4pp+fYHgenvrvP2y7+At7jvLuLugq/9Su6zVnuGFivNZrNjw",
+	"VhbnNmt2nZaX3KQSo9IKV2k3WRlfhVDQ2eTs0nMymQNg3MIejxZWJVcG3HLYv+ePo/SJmtfTI4w8GiuK",
+	"oGnQWoPS9RIL/lHxPziH2EXBBPxP44T+yW2SOzok0JfYBLLs6JJsmiBTi2Rdu8PUTYwqqzaXhVEGjFsq",
+	"z+MkiaWTr6ZG+olyVcNdCwKrNYKFJf9m91Zku9o2VIKhW9lJuzoBcCRV1UFtI6tzG3v5M6OLEGGDY1N6",
+	"NzaJCmxRqAoIjJabwbTpzvZDmwthu9pbf709dTbbR6TRdM4mgDba9SJdArTZatShR7Gb1LwiHSwtFuhx",
+	"mdSsKn06m7qaaErbovLZ8tVUFmPmzqKtpqQtnvmsjzmphQ8A+sVkLYOLqxHrHo/i3IF4dlJ9jz7yn0Q0",
+	"EQU303MZoLmV3lwgVZYncU4WU6DBWFvzggQHk11sTmi4Yd9E5FYVzfZYKl3sDEBHJ4eV9FII0T+qM3cv",
+	"ESFQIUi6tukVG5VgHRORVyed5hVOZcsr3UcUP2NHGsZoCRnscCZSYEtDMWym5k9MCUyupyq9Q1hsd7ia",
+	"Q+gkbUKjDbSLvmpVu392FX2oGY5aBY+tet6CKtKuoY0c6gxILiuB3dp1ncxG0ajexWmmUyzke7ai9vTf",
+	"0ZVnihEPlLNPyg5TijrWxRGoX9lOfeAG1q4q0J2g2qrFJULqK/8Fu3anDGo0xOXuffVENml/RMjhyC/V",
+	"UcsXDCHTQAA5ip4IQSrrTwpl02B0kw5YVmn2DcFQNI7iyZRr3wwadQ3bAAz8dM1JvcoImbJ8pa3fctcI",
+	"S5T7bevPBAhzuEhwCk2key3ZHih0pjc877Q6vhXBRUPHF6muTaJUv6nuBDxLEp/K9ox8P6BoLmxood7Y",
+	"SVFglpuxG+ipnjk2aeDtuOB1I3m5HsM4yVABCn1lMJrqvzS7wZmmzDJTopWgnoqiEBMdRQRjixA7dzEV",
+	"rFH5XhaL6MCeMT2tjbdG/uIaBVJ4Rd4GYu9MFzXqhR5Rw7BIptrZWLEqpZvOZm7H6aodesrP1QVI9bbu",
+	"dsj68K7PRbgymUMVGkA508C8fbowWpSUg7W5V63s2ga+3DgFJhqqsK9mX7O0XhScmopMFuO27VT7u3sX",
+	"We3gZk436Li9Sq8pGhn1PjuKZDUyveM20KxDMuiWlbhBFDv1bpcuuGc7Ae96i5VjO7bQE0v0ot2MrXkY",
+	"TmOM/8YS5trkjVrwnfqxwUmCuxTCoqNMz0+WqtVYDlJOTO4NgwBdy1gLQQWc2u3gWpOnd6qu+S9o1smC",
+	"2ytKn/XwfepOKqc2h8WW3E8N08HzfLwJmMhk6/l5kA1mBz7ii6o/p36IOIeT53abN9oRoQ0VyiI/hsKp",
+	"QJ3AqR1l2enztCqW7hrZdauuiubN1ZfDgAK3KewfUKizGLTNVOTZ+GRLU7LwmJGxYk42nYawJ3HSYcGl",
+	"57YRTaBVgWuBALwpoIP3mu18mFE5jTASVT0lLxNwj3le9S48N8K0mcnGsPHnfSdDcBeFWGlSpG5YKJjO",
+	"RMcSFdkrxPeBgO8wCyq537Fc23gu354uEhuIDeaWVBlKuvGiQRKvfq1eu4g0/TJLznRYev9gp6yIZ3G6",
+	"Yl6MaS2p6000mWPjx+b02QgtjsZG0X/+HEO4yR8CKljiQwA9quXTSnrDyTk6MCJrjB6NHg/k6/rQY3o1",
+	"AH0Cg00ylBagl2Zna8aX8a0qNDvPwel+2ilN61/yUpkvWwTb1gG6fKMtwIAZhsQK1j23UYlCkwrfZZQE",
+	"YDGX/u18V+yfxRUHgRjOhpgDjf1aYP5ifILW+nV2wnv3VjStQOqUIG8BmnJlAhXf6pr0pbevLV18ckN0",
+	"S446lso1CXONDSi9O1DLjqHH22+KZxOOOAL8KWn2Lh8wlZS1ah9TYkAUyMjxoEwyV+mATcre4lBu1NmT",
+	"EUCVSHtYnQ0UcnAnAmR23YpWMvKxFZ2D/F4lZWzaNUY2YuG7WOnzcDRn1rPULzhTLPlizUgJptxdSke+",
+	"UHMm+scoBt2xWG7S26WOKhf9ebG8+pSrDEmzEJMl2cZhkmTnId1OQt0h3GXVx/fK+u1b9pc1ncVLyXhN",
+	"viUINLb0LOFChNpOAeLD/sIdHsBQYQWeELuIOYMVXsbTCm19cypGhQ2oZ3DI0JMULDCU1k1BvrkWKeoH",
+	"k1DTpBcFTDtU55C/sei455R4iea47JDMLiubxarNP8ZvuOamqdnPiw45N8BTWABg4xr9EkP8chteIhwu",
+	"I930rbotXdP4gugGm2a1jzxsfYFFH+QbbFewSYgOPt5e5nFZMiials7jJKGSl/GFlcmgE4HcqPWYwF5Q",
+	"AvRZTJlu9fKnbBnLUa3RNWNtHnBkl5GHp/D+7MTqcanhVBZ4TCemx/Yov5QLSkakulY4xeNgnqGXx475",
+	"0UOZ3M+7mGQDgi+p++PYXDeT0d6voovD8bh6CTIbL2X3yJaOepCuRjhQdSCbSbtmpqLROKKfwQ8zw4g8",
+	"ytW94fg9SmeV9Nybdza4Xyt+YJUIt8D8sJq5rg5POGwvrLmuOp91mzTxil9l83jsPm43K+3Vm6zq4l7O",
+	"9hD0hSydS68RH7DlmM5jIu7ZRrNIkZZd+yV5hMznIE6E/yRrXHPcYCokD/LI0DbfkQpWOPaqgQ0ACFKu",
+	"3oiFBoj32UqaZjjZjIPyKBulCWhPgUNJf9vBhiPsHKhKbAVUKw1ZA3iXHREDbuPBKc1Y4UY+v2f6fGwE",
+	"/KduKq8xD1825ZEhrYLzKVX1bQ9HcHdN7Ew9PKbKnaO+CYilCvbpKfwtAPwpiTUYeiUmrgsGm9LCqPLI",
+	"fXJlDSyru7QbWKPHUmQzJx9HLMtP2EwHnEBWg2btv6hHBVGXZilV8fW2YxtdkdJK+4coMqprNhlYUSmq",
+	"5XLDMZDlYSLORC1TU5aoZuMdGhLlt6X+GES9yClwq+kvc92BO0wxcu2hlcTWB7tOrwojVho9V7hMnA4e",
+	"EOB8TMq+RwkhAo0P9K4aEtZVOeouQTzKDlS1rg+humL2neYXHuGdGuBQfe9SZRQmPvTjQ2uzIDfquhjQ",
+	"ypTkRek79ak7I9muv67jPWi2iQ5PYxI3fKPMo/PU75xsk7y5ifXcJxjJQuxz+Jy0GnkVAgrgq47HPiYT",
+	"j4jaUwzgm7DWOEsdTnmM80kzcyMiO7G6xZhWNOoHnpheAnTxRXuDUDuTOLz9zgY0WFA2OkS47cCarLdz",
+	"1V/LSew8iN7xXDSCcWxUw6vDNKaoW1476IVskaDpG/YTdf+T6EwoKSa5+ADOjhoIDRmU+Va7oj4TKiyL",
+	"qU9Fiki1PNZiWSVID2SXpKYVJLZKQ8zZMIv/wwvpv4ClxNMl8RkGX30WlCcRkpCMA+NgSJlwjRN3q1cD",
+	"BZgyxGRqKl533HdMa7gljmIBjYJc9ZrHXgOnwt4GivNk/jmukHGWixEZNVBkN7azjQW5eFVTeh5NbCMA",
+	"dcdZ1riD6tKGX/9fU6/Knko1rciTaMy7TaYNLI5T5zOoDGnignfm3fXN2nxNkYB6yyLaQtXHnGxgTV2T",
+	"dbmKffg6etfAtq4R9Ybeu1lGT6NwozFzR2W4XkvZ9S7spnhTa0kUNKi6iKxYHPeLUh1HrmJ3nG2tfMvo",
+	"A/5ntCu1KMlWSRt3GrC9HnrlKnahVoHXASubwQEckMbTlUEYbAdHY0Bhavcq2y1oToXAXkHIKl+8kddW",
+	"07Upppic2A6VsEaZYNsrw2rjNMeGAa1bEDVvSpcWwmxvAqF12DNf12ilWB/izZkoClAGPTjA04OdE+qd",
+	"hZUHRX7rMIBoidweIC7NDZAKqRn7vP0aiv9JPIXlcgoM8Nd0ggHZ1uuAtDEInAgT7qJlubmrSnsdVjmr",
+	"IksXqpcJtdxWRNoMCChWHDS2pSNJAxjt0KPUwxNEuVYOLxAbhmB6t+OnDcON8ARRSmQ2o3JfngMhm3OR",
+	"65AvkFgnGHUw0u76rVvNU8Z/iO5pqH+qZESAbZy1zxTd5/4NbSVdQn9J46rz5LOFs1l/jROW+GAqpKJx",
+	"VWVZMrG0z6OrZJ6syGyXzVOqqqpPqmhPWJvozGxqWdU9u0jxFbLeom1CL/t7l2ohHK7CfGxXCMneUHbk",
+	"UZr4FMJ1KQ1RrcD1pqGCkTKQZQ3XtNOxdV/JJQ94quQ+nfX6tDrOFsfprxtZgSduiPIsD8d9UlS4xfJE",
+	"OhkkpHUYPfRhuRA869ZxN6VuOl4rhl7rPs56/ybKe6P7+SpfGZydD53H2mlk8nD0ugMD40yBl9ERZtMa",
+	"pUxrU8xAXc6Vs7tuRNNMAr4pYOSCjMznHEDVMv/VOsh7WuYd/XT41YOHvz/86usAX8BGkeh5NhVyuDqq",
+	"Yhs6wyBOm1ajq80paC2vcm+CKhPKiFPeS5W9rjdFnjXmtqXpoFRb/boOcYcAcFXlwmqzJsVx472icUx2",
+	"4+e1Xa5F7nzHXCi4/D3D+A93I1ytVzncL67dshwweAOxQkHr/tO4MrlV5QkZF6nV2RkXhc5UfoGhgrjy",
+	"xHK5FuJLzSF+RkUYpc8JBs4TyavYT9S1LnlPY/seKY0UboM2sCyXqj1IWBdElHpdLIS2q0uzKdnTrWwb",
+	"zWw578ZFiDKHzU16GPFBN2Ggr25ub9yMilE7OD1uokO9UIdyA9L0eTf8BUY34STGMfDZ8A9HxdSdcQ29",
+	"3MvgFc77QUdxl8NW1ISuFtoLtHZlTAd5EACesia12hNWrrzVUK1gHwN5I5T7ual+vDJu6ZUJpgSJ+mAF",
+	"eHZJEvOezomU4Fxzu6pXGinWUj74KKG2/FVVThTr1YLE2iJpNKkwdpBbZ7TVQquuTflUl4vx3EpaVWWw",
+	"Hgo6oFAVbVejYTsOnSmbcPBKUJw1ii9dCdf4AeM3DgkfYvLOn39tVx+xkcyoLHfeieNl1Assq9LIlUCV",
+	"vqUSOX8XuLNO6ShnkY7/lgwkkxDoyxTtrcvSYeefcxqTA7sefB2MZI9iDOyNy2ZAwblSaXTZDFGgR47z",
+	"YC6qZgmPrXsb/5pVWxyHqYoHCl5bTjYdOSBhNkf9mpmThwM4T4uLVFuE4sCfi9dhR4R+TW237We7WQ1n",
+	"q2PDmjWc7ZVRR43ey6N1kPACUm+vs7fUr+HWIfDN2voWKe/dFhd7kY/6VBJ3t7DFz6m4+U562W7fyfZK",
+	"KpszKuUYEhInYRmVe1URuka8pFVuqb6LqO67d4ISAjA9CUajS8F0kfJ4ig1zyRfF1rPpQEcxoGU+mz4J",
+	"3qf3MVpC3S3kn/BP7GCXYjex3/bMc8xb46cfXDe1yYWzPISph9eKEZVtBO+UwDeWfRvf+8vfOZFrqv1d",
+	"vT4Dat3IfaH7CTeMbq0y++BFSnyeeAuLT1kD769bxG/twp/6rDAxmvp+eh9Wlfr71dcJj7u9eRp8Nvgu",
+	"9gJd6YW3e69iqR8ujUwNSX8fwWKvvOiLgsDTIkAufZs6nowYx1prk1tTWaWke/RglZ856rBT6RR4Oa6W",
+	"R4h/ZXCPfz91VXP8UddXlEU7te9dar1VdgoqsowuM9UYF6XSq3/MooT0Tg4JSFHbzJJh8JybgkqB+N2d",
+	"0X+IR988nhw8evAfo28OvjoYi8dffXtwEH37OHrw7aMH4uE3Xz0+EA+mX387ejh5+Pjh6PHDx19/9e34",
+	"0eMHo8dff/sfd5DSEWQGVDX7fbL3X+Eh4CQ8fPsiPEZgDU5g1VjC8tMnsq1NqScBIXVMwhWLciXwmvzp",
+	"/ykROYTVmOHVrygLC3z9pKry8sn+/vn5+dD+ZH9GRczCKluMT/bVPNS+onZTeftCZwRx1B/tqPE20abq",
+	"quL47N3zo+MAvhsagoFnB8OD4QMqYpGLFJYKPz2in+j0nNC+71PjrP1oBpwAld/9RBq5qRgxvgLfy5dK",
+	"2aR3X2eWOp6h12EqH810exD8C7YlISaKfwAFFXB/k3+BaJ4s5b/L82gG/GxICWX809nDfXU12f9Tpsx/",
+	"QuidsQjcrdXqyalio/PFCHRTVGBlTUxySnHOjywZwm9Kd92ixD7xSYQWaZlXkE4oapKLq1EtBbUrLya4",
+	"G/z9C8MRCdcqWAXOvcto2wJvqCgZt8kiNF3BwTASMtHvMSMlz7lmi8jqgM99+POrbz45Y7XbYVsm3rHz",
+	"qbPgKMYBgMj6CCj9yAZycUGR9Y3YuoEvJnJgivLRBwZtA7JF66fW5+adelmGjylImI8ajf9aiGJp8CgB",
+	"27PxpvQ7AB9fhM8dal176U9NLuG5VeFe93kyAc7YeQXdpdJU9hYdAyqPUuXUmjxiO6UWv/QtRUpF10pk",
+	"Qua8nOX1tnx6NR+QkBhQ4gUPDw4UA5RmBAvX+/I8WjP1akIsG6qoURQ4GwzUZpT86J1uqlVEOZ/jQ5UN",
+	"gTcC6W/ml4ZI3Y93uNB666+tl9scrrXo7yOM4eJCDbSUBzd2KS9SjmxHgceCGV756gbvzQu0FWNDN3qT",
+	"JTud47aQ+iU9TbPzVL2JStkCNCQs1gYqV6WFQkO5riKMZP5tj2UFcyqrxDYc6w+fvBJz3w7hhp/twrST",
+	"reQpe30txvzi2WoR65EDNBbn2Mof7h7mOUWwH+nn8At1RCoprknExHnFRVxW5b1h8KP9dc1Zy5Cwr7aW",
+	"4qSqdcky2fXYHRI97JJ1yvta+ZW/lOg/rFs2Y+pUMY1lsS7HOmo017mc3i3cHakA3Y9vhbhNNa20S6sC",
+	"7bopJrq5p1TWwoh7mfUcg490R60VU27YrnVHwfMm8BSrp4hEnEVrFxluXNEZCGfrp5Vy5Bat66PVp+BZ",
+	"S9G6Hr84ElclVFR3GC0Da8LuEkXODVdXX0UJkpC13KxoIO9Wjf1LqbG6U8OM9co834Fiq3PknBosVq+i",
+	"5CibUTSsEpRSZvexfPGMGyJFWBVA13QhbziZPP3aIAHzF1MEX8lEFKvJGReS4CqZyAJ96g9lidW0n54+",
+	"UOev7jq9FxVtpAUX1k0TjS01vFnWQ8sLcRZni1J/5FkCDuFawWVpaa3M1nVK99uZpy53Oy4mJHw4znvJ",
+	"RYwRm3EacdUYKicxj045L4ASxpWJQmFU1qAgJNfPknEnOMvM30yFpckBtNpiRXUmMcesRvXeupHV9vaS",
+	"Zf9qYX3Zovn6ZemlCr/Veyz7DVEijHqPj0S5K6kIP3D3nF2YeKRk7GHcscWr9a2VuXu3oWTfGwaHzXc2",
+	"06RlS6KVZhtOU//LGWy4V9JKU42kmt0aaWqVAVa9cGuo6acCrFNromZGUN3eV3785VpmbvG4tmaz2giz",
+	"AfNvGVikqLk0ofBFGlYk0m5NKn9pk4ruaLiV+ghcBw7KstOqUjv5AdpYOK+HASH2oprMyF6zwBRIbEep",
+	"KZGO/Ubzijp1iTlMqRqRyoLkp0Lk9YkkaMHdUmhn/0/8GzG68p5X6ZOv/RV0PSNLqCRIdtreLJ+mo1rB",
+	"+xe0Vil3/7Mda0VrNB9r93yz8vM9zTXfv/8Ng/Ewwr6i2sPG3PUKe8wdUo85Gc0tJ+gZxc1ThrKWUCjr",
+	"Jfrb29U7Dchun11wDHSdIu5xwxmQcvz+PYxWaC02hVEDYSA7jAZVxLYW2XAQnnMbQPzjLmB5j5nJN100",
+	"dxcEvkEIBvS/mU4pBDV0pbUD48zGMUeZKS5bqjng5oADBG9SGqD3CJR/gOVVgfEq6CywsZ8fDxy8zuxe",
+	"1rA5awCZSmUqUmODNpBm1t+utBZvhyhJ/yupcqAZhdyqNVRJxa9bpjHKhJBhl7KIr1xnRA2edV7hZ2Ag",
+	"o6ZvptWHbj14pnwJDqn1eRjVHh88vjoIjpUon2SCUYQy3Yecm2rvW4Nwt9XNdM6PUzOjBEeLZSigCjHO",
+	"ikmDRSLRclao7JUpqdZOD9WJoXNRnCaY2xKLgdUjb4w1rjhgPYlGIlEjwnADpRLKrjCysQ33CdMdYzD9",
+	"pQgmURVhAXsu8kgEQzlaWADh1JqkZMXvqf4BSxig+8Gv9r2VyS9fmtK3Y/1p3FFUS96K2I5GtZynq6nr",
+	"Gmor7VQH/NwVstxfaKKMqamJafGbYZn7LDWdJSjdrM9ZB759rg9rfb8HAfeRAK5B/6hl/a7jlqQT+vQE",
+	"s6IcTsl2L0dfP8JVa7l6ilypNHdhXuerIp+e1uoCbWDwM3eSprLXoeOpxEHC+WB9oyHtbLeeZ3iHLTVl",
+	"zRaZ93nrDb0exU1raBGqbq3jLxVgrd5h2+A5bDCV2/WR9c1268qF5DrDegUlb6/v2SW49uUJtZLjsOv0",
+	"/qSI4rT5I2tW+2Ua5eUJsczaY92Q13qwVZJAMwkgrrSDuBYobnszqAMLtShg2/zA1C5EFsBF2WQ5Nrhx",
+	"yTBOyiblCE8mgkEryLOtDcL+WdGk3y9fPOujE960CPdLTewyXzpZvnuTr5x5Y5rUu6tJk/oM2ba9C69B",
+	"HfpBWdRuLM91k9W6jLWLte2PsotV7C1t8Dfd/A8Pf43ZaZvQwHqOb3O2+12qh4533a8fq8CEe8Pge/mq",
+	"6bAiZegMc+h1Hd2omJlbGCIjuKP+fELj3xnClmPpoQq44kLGOfKL8NuTBw8fPZavFNE5175pvjf6+vGT",
+	"w+++k6/lBQp0TH1mtbb1Ovz85EQkSSY/0I3Nmy/igyf/9Y//Hg6Hd1by5+zi++Vr5KtfIJMeuNpSakry",
+	"bfsN321n8Cxv8EpX05XkLQPJOcUJ7MytOLsucYbY/yLE2KhORjLmRSdC2JXTdinWRLmuYBtIQUb3by2V",
+	"hsHfpcUqB4TGFwMK4R5wn5eBMmbIAoslNSEhXmDEGjegUxE71ISQF7VIQMenHAxY2kCXSlZh5FgyueSw",
+	"gHESU6+HIihFcSaKsIx10/IFel1k1xmM3acyh6anL4BjreWN6WwmgWwCGHHSAFnvq3iupbHVfkjEBY/H",
+	"MGMV6yV/RUFacGxVpVNsjsYYIrcowkPFsLH5TYURTY0YCKslew85SRXZvnwZ+Sq6sHzuI01XJskEozvn",
+	"8JZ0xZWiGnCXwIvgu++Cg4G5jmLjl+wiZGrwyCb4rBbu2acKVxPmNxipJo+TIbTzk6wUEnq4rBaVyovg",
+	"vkd0ulSp4CQ+FdZi74rhbIii+QkolyC47/mg53E6E1M+03yZm5VfpINs6MBbzKRSTjIZhFjzgQHOpCBh",
+	"f1fNFqwxSdtg6uRLWUH8gwgGUbkqimfnUTsDf2Ms0w9GrsTFVw34Fgf1rYKHcy3DNE3frRtMi8y+PeSe",
+	"SWRkxY1PqLI4FHfeIxE20CF5y24RuZ17ghHfx7VgVArd59mYpf7qyvmNtfKwSJYbuyPleO0kKJPkZBuv",
+	"OWmm22zNd3eODCwXAPLS9KPHi7y6Jbv1OZyhr0X6pqTwXKolmrIuXNbP5l7dcoRb6/NWfKlJUGvyIKrr",
+	"DDyIJKzNgFpMgMoer2QAUlSzLuw5+6sjuNc9+FvphZSuo2tcq1qyd6kUo8p+5bLXeFvDbq2YBQTq8j2V",
+	"OMD8ls0GppqfW2Xk4UOctFtvvE0x7NCCiRZbh+V7ewMxYq9dY9hdTt5q3UDJczBTe/Q39A8sGGxIQCLd",
+	"tEomYtL0oJNFeKZAdhMGRKueI7lsPtkbyqdm8raOSmjZRe7hLYLXQ3CLxT+X9hHmKXIRX0KBT6k7BCHI",
+	"T9O3hvn9F5nbd5n6yWUv6DX2j6AkVrwMMC3e5ivWo+J1N4dGcuBWitS+6ibRqU39xK0ObqhGdQki/Sdn",
+	"D46a1EHErs4XMqP1YdaqyUdUUwGH13k3uxb++hle2K6Dg10Ny+FWQJLvSDUh3S0Tok6CTMyttJwWR3qJ",
+	"L1t6Wr+0lC+WO3URjBtVDsLRjY4iR1fH4V/wOD8lZxElInLPKw5ULmOMTy6zuaBbBarx87gspWPg8cE3",
+	"VwchegomAQYSZKldzf6aGc5XB4+ubvojUZzFsCHHAr4toiKGK9cvaXQWxQmGXm3DALG8XK47yyobevtw",
+	"gOSj4Il6x9Ox3VZxC76YzTrCTqS13/Rsls2vgCYwixkzP6j3tAp+08lTFt92WdGJYbzEqW9VPvpabUO/",
+	"VKw8fwrIJvytamBFA/fKAkoS3mAhc0zbOzkMnmM8rNrsgbG9ZXnI+WUU4AgUMWi0x6aRVSwAd+8VuPFA",
+	"ztZqLAuHgPVj30ocXyjj4hw+j7EZlf2N9qtS7I4j8peJ1e6vBw/k6jj4COhbD90kaJlOpwYf4tzyEXv2",
+	"M14c+luRmdsGUNsmOawB3Yyw0lNw0JLKCY6LRitsE2Wa5yIqzMfMMO7mhQjlEEWEPaYiOr2NRd27Vec/",
+	"D3UelXkkwM9EmXe6erdl/pvLplo61Z/VBcZJrtTdW/1Mvxw3zXGjHymwMav8XKbb+im9wrMYROSaCfH/",
+	"vjfoF5N2mc1dnS4k0z6z7Yrp1wX21rvUm6G0zlbXPc/XLfj6EoTtg04JsbWzdK0iqLouERQ2ZFAdLdcn",
+	"kQS+ObDCd+AYVdk4SzheeJHDbazS7YbLYa+LmPDmCtv3MH+b6y1EGfDccqUR/Jjeur0SGSv4scKbywxe",
+	"P79lrabFmm1+zVx97krHWS7raTRAuFZGd6tjuxhcw2J+0w3mlZf0dmw/pzpOi3z/T1PQ6ZMpPzARSQWr",
+	"ry7S/RmMDq91xmxymX1ZPgo/rZm87JXQaM7Iy5f0OfWBeoZD/JAVlj7yI363mnXWkTZoagE0e0DBnQ6m",
+	"ejlq86226XMtNDZ8e4e6Y8TWeW1WwCGri6JdNsDaFIyG+US4SPg2AOTzWpDxt0xjTO+ztrFxqYZfNCO4",
+	"ZJ/LZS/6Olw4Vx/18tUNPmcYev1inicCXTlismXNoyaHU9KjU9yupxhI0d8Ok27LfFviq0wRrYusFPBf",
+	"kOXuVsZ/VjL+qXZL2QR6K7FvjsQu1CG8Fc6fv3B+dGNXc4nRHz2F9QZetLqANnf0NUV1S02Q1q2GSaHL",
+	"AUeX8uYqS7i4v5OrupXvX1w+Eu9x71iWPladVdZbOeUukn0+K+j72SYwbqdlnfAd4YFdz8HqUfFiUg5k",
+	"XA4bNOzWDbcq0eeqEll7fasR3Zorbpi5wqP/SEtBkvRRQdZVjc7mIGqVdzabTmVLSJ9exAWBxouiQBc5",
+	"kicw2Xke8JdDb2zrMbx5hG++4Sl2KmIN2A23ZAM8RFYpYBJuVNCrnlJDOMmpNhVO5LHyQ3XlLlK9LQoW",
+	"WXxsuDEdv7MqybbII2juCDZjTnVTTIkMIMpgLuvHbUvL+3/y/8kul2elYzVHiqpbG3NXbgt3+eRxawAG",
+	"b0kz5YJH6qtsGhxws89FSgnHmJ3M9XOpPmCxRO1VFRwtBCY11xINNRzt43TkPU6dN4dj1+o8a3JfKzJz",
+	"bLe+V3SXKvNo34108J+v/Kg8jVJ5ONqohP2MgpR6o50JFWUwvK2qtLEwlDWNOljlAOsS8bk1m8B1LMvF",
+	"qERVKa2njdwp6ydrDdYiLuAUxijho8T4/FWTAiqZ1BXLdMRvbCnzGlyLCzUVIodBcZNqglmWcXL28SmX",
+	"JVzluEGKJUTlp797Sq0pC8VaFoOMmg6GcyCjpeMQ09NX9LA3y6AyVb4Rj/HhWgM2xHsdCY0F1CfvowJs",
+	"u0mfCQvZKkCnsVrARVZUpvYmH6I1z6M6ect03D6O8KPljJMPrYGy1PPzvooXNx1MfG/+WftT1meTb5Yn",
+	"iwobT1m/oEbPcZl9qinRBeA2xdZLxBZ+XGdOP9UK+HkRyU7M5iFH5tPtyZRl+isn3UqXkp1SKVPWMG2q",
+	"ccm8zbz9ojJve+/7Wlza9EHu4nSLcreK0Wu4xPC49Z5trsbz1P2rVEA09CEd5umuO6vkmtVElPAGl72R",
+	"oPqa0QIzlxc5aKaumrbmwzAaM2sO+T7mntAqIM63NpruJIIbR5RgwVu8Q8NOZSNctJGwtMhGJ2cZzNpf",
+	"7bKABTSNsR7oJFQdv1bBq1up6c6NPuTRamgVepagzIJpVFzOCk7PVgJ/KpahLA199+df0RbweSyCddHu",
+	"LWh2e9cb0UzKbS9lC5i6iLgJkU3KnAPMJ4Gy4zK0q8r8OAeyt8eed/ubYLaI4JIQCCwXK+Ne7tFSk1wC",
+	"UWr4L/lgXcoSFnmIekYb7qf89Fj20kijNFMG2xUz6AmSqKzCVSIFX7IXXeJSLS7ukiI0sOfO/hKeveMO",
+	"7+mEqhaqev66mj9Ose6tnqZE5YCvUo5Jf+WHrmnHKObTEqSzHEHlromJa3lUhN4712t4quaiEiBqbJ0c",
+	"x5bWVSP7EGiNL/FotUjD6vGmlzgVsW8vjuzAkTT/rIXlGnwGR10wHqm3LMTb4RceGLEwpv6SyI26kNj0",
+	"pkvPguJYZXmOHKoKF6n+zofBI377sPrFvNsmSdlsRjdjtXMaJeTnjPSSbOjY01XCoRoOUKNN7gzchhmP",
+	"dUiFhMKu80JWdXzLPjgbHfdFPiuiiQgnIokcdqpf+HHAj9ckDDU2EYgi9PAsq0Q4ohohbhoxZ6LYxJSn",
+	"Z81oqtKleAf0BDhYyd4FQ2ry680nhf/g4C6+KYn1jp6FwHDSgRqPkMX05DEi4hjU15yJjlYjpdKWa/Fg",
+	"T896KQikcUNjAWrO/g+YlefWCthO51/C7J6Fm6l3teymTdeW7TWB2RBlDWnjFBFevryCMfp4kMuKfCPd",
+	"Rs0gukvM+6xb0a07/HAT+8T+eRRXWOeZ7y1hNAU4V2Zz/D2KVVyGdDKhD5BqEAU0gtQR5Dgktewmq5Jj",
+	"MQiq2Rp1ouFaTyiUo+BBMI/TRcVPsoXqZ1NgG1YxqZvXeSRq3ybLKBViFhWTBIQiKkdKEQCQqSxT1VBm",
+	"CGhHimzdaIPr/iErbnjB/w+3Fqdbi9OtxenW4nRrcbq1ON1anG4tTrcWp1uL063F6dbidGtxurU4/VUt",
+	"TtdVmS1UGpqqfZrCMpvB1Lex1F9UoX8te5UBjKxPaIlDFmgVRvHbpdYw9FUiSggHcSL8eSAcdH78/PAl",
+	"6PiLYoyJOxNSv/MkwksXHMOBtK4FcP7F1491q3vSBaI56DLIVlBhwBcePQyOfjpUtXtPZCeh+rt3DznU",
+	"FDCxTMQ92cxOpBNWyFVXO5Ei0mVTu0iJn7FMs2Yb0xSWF1BSzXN6+xmWxUMDExdUpZaWbYveMSDnqcTN",
+	"CoPe33FyGWr/EUf7OKgZNSXa5lFu+sHzWiO0ZlLCdvDMSuH+OI2SUnz0ZXHzeDBcjy7qxEy+zybLxgnB",
+	"XdunDayfDd3YbxSnUbF0FKZrJ0s1SQNWMBKBJKy2EfPTTpPcTpz9r9pktorCnO3VqRGBe3QflbvGMRvW",
+	"Gorz/KcNOtlzpajbovSE26BJAHvVIqWEKt4TEDL03fVWHiWI5BEzzPyzCTSuv6mZBr2LtyLJem5qLpFC",
+	"vPP00tkfIGFPFvA7+nQkxfUQL6gR4kgzkYaSAYUj4EBhjX3t1aTQJC6xLfN8tFoS2fyTTpwWPvikW05d",
+	"jxh5Zi2uiyfbRHMRSgbs4c7LSvTmzRpbNKJkzxbGL5tF+9ioDUIg+ZPLttbgfesyPTPN8pbx3TI+6zQ2",
+	"NALgCJmTiQwvkfEVy2KR+nne8wsxXiBw9km+S34P8qqiPcl2ok/EaDGb4W2h7WalRkY0Hja9vx5WyMvt",
+	"ywXXoyAe/J1Kg9m2xkVzuDZ3scpO3FXFYO/RdkTpkjxC8xz+hbtBeSRhGc8XCeOQW4HvltFy3wJXVXtj",
+	"nfRZ8N8qo6RljJaitv47owUupUAwtL9ALHD3lMmKrXL6F2n/Mkk89PFFath0Z0kkXq9jdXLePiJC7XK9",
+	"KEUZwNJCGIQPVO0wkXcsCvjkXmv5/luxcXVig0taCA+DbXcEMQxhR9KjsPgaiQ+r65XJqa31worqmcC1",
+	"Z2TR8Geh2S18+M2dxga1hq+HCBlzi/Q3iyQH/I6TmLzRAASImHH1Po3IIWUtbNgOH1I2bD/ve6pecbtL",
+	"Hd5MORQAQEFk2k3l5IFT4XCX/CCEYrElEBTsLMZaWAQEX71P5Vsg7Bcp3sKwAyEmxYecFY/nC3WXIb+J",
+	"7Q+nVBApC/4QBej5KPWtXWdbclmhL5TjlXAaGBUWgrUd0e7/KkYOjMOpwis6pFBU51lxqrEw7O/WxxTy",
+	"Mi5Dt7XmR35KPcUlTpRVkCyc/Nj012leg0xHhf+5+7cn2FUhCv84CL/99/0Pfz7+dO9+68eHn7777n/r",
+	"Pz369N29v/2ba/sU7PHECzk2ikT7JlaFT+LSbovZhP1ziBuYx2noJEqMfZBxhU1aDO5SyUlJcPfq7imA",
+	"6X2K0hIIjyQEJs3ujHyabqTWgeYj1qCy2sY1vE0KAb3ukDthVYGDU936br6gVHGLDpTnlDae+4I09n5N",
+	"P01Nbgvq8OqT6vxUdsH0vCRvITVLW6OelnzjuAZypxPk5pe23f2FVKFxZ1fS9oBtdlVv/kl4Uxs+CKIk",
+	"A3qk2q54Rc1on+I0X1SUJXCZVkABzCfE4gkFbGzZc6Uw8HP47o3+DGBCE0YISxyLkM0SfbF2jN8wneI4",
+	"IOeqGGCiq3lfgMQL/uqIP1ohv491iFo8n4sJ1tAFlpMXYiwmXPcQQ770UodciCUYn0TpjEQ9fDw74dd4",
+	"nHNMglB9UvEe3hxiXV0ApHbINTPb4B/KVtx2wXHMsXD0wiLZhzYBRWuTWpu9nttTq4jsMwIM9ryKPOL7",
+	"zIQhMt7qHGhTraOmP1hIM9Dsoq707SG5PSR/tUPiqhBL+Jw2TCqMRHsbL9n2dtlFkq/QlHctFdRvG5R8",
+	"6Q1KFFvCOKYiqt1x3D0zgfnFwAOpvNpIBCjvFuRCkI1IpZGA0j2toy4LB5eybSnwfix5SnJAJ6sQHHjl",
+	"ns/jqlJ9vC/F+srMjMyuiA4xXhRxtaRbUZTHv59iEc7fPuC1ogTEqwvTokiwFX1V5U/292EZUXICt699",
+	"6hNinpWNhx80/H+qu05exGd4f/tEYGdFPItTlNHn0QxYtbFz7j0cHux9+v/ab31WogwCAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
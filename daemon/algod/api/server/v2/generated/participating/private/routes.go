@@ -22,6 +22,9 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Lists proposers whose credentials arrive after the filter timeout.
+	// (GET /debug/agreement/late-proposers)
+	GetLateProposers(ctx echo.Context) error
 	// Gets the merged config file.
 	// (GET /debug/settings/config)
 	GetConfig(ctx echo.Context) error
@@ -56,6 +59,17 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetLateProposers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLateProposers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLateProposers(ctx)
+	return err
+}
+
 // GetConfig converts echo context to params.
 func (w *ServerInterfaceWrapper) GetConfig(ctx echo.Context) error {
 	var err error
@@ -234,6 +248,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/debug/agreement/late-proposers", wrapper.GetLateProposers, m...)
 	router.GET(baseURL+"/debug/settings/config", wrapper.GetConfig, m...)
 	router.GET(baseURL+"/debug/settings/pprof", wrapper.GetDebugSettingsProf, m...)
 	router.PUT(baseURL+"/debug/settings/pprof", wrapper.PutDebugSettingsProf, m...)
@@ -249,235 +264,262 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3Mbt5LoX0Fxt8qPJSnZsbMnvnVqrxLnoY0Tuywle/dYvgk40yRxNAQmAEYi4+v/",
-	"fguNx2BmMOSQou2kNp9scfBoNBqNRj/fjTKxKgUHrtXo2btRSSVdgQaJf9E8l6DwvzmoTLJSM8FHz0Zn",
-	"nNAsExXXpKxmBcvINWymo/GIma8l1cvReMTpCkbPwiDjkYTfKiYhHz3TsoLxSGVLWFE7rdYgTd83Z5N/",
-	"nE6+ePvu6d/ej8YjvSnNGEpLxhej8Wg9WYiJ+3FGFcvU9MyN/37XV1qWBcuoWcKE5elF1U0Iy4FrNmcg",
-	"+xbWHG/b+laMs1W1Gj07DUtiXMMCZM+ayvKc57DuW1T0mSoFunc95uOAlfgxjroGM+jWVTQaZFRny1Iw",
-	"rhMrIfiV2M/JJUTdty1iLuSK6nb7iPyQ9h6NH52+/5dAio/GTz9LEyMtFkJSnk/CuF+FccmFbfd+j4b+",
-	"axsBXwk+Z4tKgiK3S9BLkEQvgUhQpeAKiJj9EzJNmCL/efHyRyIk+QGUogt4RbNrAjwTOeRTcj4nXGhS",
-	"SnHDcsjHJIc5rQqtiBbYM9DHbxXITY1dB1eMSeCGFt6M/qkEH41HK7UoaXY9ettG0/v341HBViyxqh/o",
-	"2lAU4dVqBpKIuVmQB0eCriTvA8iOGMOzlSQrxvXnT9p0WP+6ousueJey4hnVkEcAakm5oplpgVDmTJUF",
-	"3SBqV3T999OxA1wRWhSkBJ4zviB6zVXfUszcR1sIh3UC0ZdLIOYLKekCIjxPyU8KkJLwqxbXwAN1kNkG",
-	"P5USbpioVOjUsw6cOrGQiA6kqHiKURH84NDcw6Ns32MyqNc44vvt3xRbuE9tqC/Y4nJTApmzwtyX5J+V",
-	"0oGAK4XbvgSiSsgM782JGcYgX7EFp7qS8OyKPzR/kQm50JTnVObml5X96Yeq0OyCLcxPhf3phViw7IIt",
-	"enYgwJo6pwq7rew/Zrz0UdXr5F3yQojrqowXlMVnwdDK+fM+yrBj9pNGmkGeBbkB98eNdbk+f97HUrf3",
-	"0OuwkT1A9uKupKbhNWwkGGhpNsd/1nMkLTqXv4+seGF663KeQq0hf8euUaA6s/LTWS1EvHafzddMcA32",
-	"KozEjBNkts/exZKTFCVIzeygtCwnhchoMVGaahzpXyXMR89G/3JSC3ontrs6iSZ/YXpdYCdzGUswjG9C",
-	"y3KPMV4Z4RFFrZ6DbviQPepzIcntkmVLopdMEcbtJqLcZThNATeU6+lor5P8PuYObxwQ9VbYS9JuRYsB",
-	"9e4FsQ1noJD2ndB7TzUkRcQ4QYwTynOyKMQs/HD/rCxr5OL3s7K0qBoTNifA8D6HNVNaPUDM0PqQxfOc",
-	"P5+Sb+Oxb1lREMGLDZmBu3cgN2Navu34uBPADWJxDfWI9xTBnRZyanbNo8HIZccgRpQql6IwV+BOMjKN",
-	"v3NtYwo0vw/q/Kenvhjt/XSHEr1DKlKT/aV+uJH7LaLq0hT2MNR01u57GEWZUbbQkjqvEXxsusJfmIaV",
-	"2kkkEUQRobntoVLSjZegJigJdSnoJwWWeEq6YByhHRuBnJMVvbb7IRDvhhBABUnbkpkVr26ZXtYiV0D9",
-	"tPO++HMTcmrPidlwyoxsTAqmtBGGcDMVWUKBAicNioWYig4imgG0sGURAeZbSUtL5u6LleMYJzS8vyys",
-	"d7zJB16ySZhjtUWNd4TqYGa+k+EmIbEKhyYMXxYiu/6OquURDv/Mj9U9FjgNWQLNQZIlVcvEmWrRdj3a",
-	"EPo2DZFmySyaahqW+EIs1BGWWIh9uFpZfkWLwkzd5Wat1eLAgw5yURDTmMCKafMAZhxPwILdALesZ0q+",
-	"ptnSCBMko0UxrvUSopwUcAMFEZIwzkGOiV5SXR9+HNk/lPAcKTB8UAOJVuN0GlNyuQQJcyHxoSqBrChe",
-	"TivzPCqLZp/AXBVdQUt2wstSVNrAGL1czp/71cENcORJYWgEP6wRH/zx4FMzt/uEM3NhF0cloKKF8ayo",
-	"8hp/gV80gDat66uW11MImaOih2rzG5MkE9IOYS9/N7n5D1BZd7bUeb+UMHFDSHoDUtHCrK61qAeBfI91",
-	"OneczJxqGp1MR4XpF53lHNgPhUKQCe3GS/wPLYj5bAQcQ0k19TCUU1CmCfuBd7ZBlZ3JNDB8Swuysnoz",
-	"UtLsei8ov6onT7OZQSfva6uqc1voFhF26HLNcnWsbcLB+vaqeUKszsezo46YspXpRHMNQcClKIllHy0Q",
-	"LKfA0SxCxPro19qXYp2C6Uux7lxpYg1H2QkzzmBm/6VYP3eQCbkb8zj2EKSbBXK6AoW3W8MMYmapVdVn",
-	"MyEPkyY6polaAU+oGTUSpsYtJGHTqpy4s5lQj9sGrYFIUC9tFwLaw6cw1sDChaYfAAvKjHoMLDQHOjYW",
-	"xKpkBRyB9JdJIW5GFXz2mFx8d/b00eNfHj/93JBkKcVC0hWZbTQoct/p+YjSmwIeJB9OKF2kR//8iTeI",
-	"NMdNjaNEJTNY0bI7lDW02IexbUZMuy7WmmjGVQcAB3FEMFebRTt5bfu9H4+ew6xaXIDW5hH8Sor50blh",
-	"Z4YUdNjoVSmNYKGaRiknLZ3kpskJrLWkJyW2BJ5b05tZB1PmDbiaHYWo+jY+r2fJicNoDjsPxb7bVE+z",
-	"ibdKbmR1DM0HSClk8goupdAiE8XEyHlMJHQXr1wL4lr47Srbv1toyS1VxMyNBrCK5z0qCr3mw+8vO/Tl",
-	"mte42XqD2fUmVufmHbIvTeTXr5AS5ESvOUHqbGhO5lKsCCU5dkRZ41vQVv5iK7jQdFW+nM+PoyMVOFBC",
-	"xcNWoMxMxLYw0o+CTPBc7dTmeGtgC5luqiE4a2PL27J0P1QOTRcbnqEa6RhnuV/75Ux9RG14FqnCDIwF",
-	"5IsGrX5QlVcfpiwU91QCUoOpF/gZLQLPodD0GyEva3H3Wymq8ujsvD3n0OVQtxhnc8hNX69RZnxRQENS",
-	"XxjYp6k1fpIFfRWUDnYNCD0S6wu2WOrofflKig9whyZnSQGKH6xyqTB9uiqmH0VumI+u1BFEz3qwmiMa",
-	"uo35IJ2JShNKuMgBN79SaaG0x2vHHNSskhK4juVc1GcwRWZgqCujlVltVRItUvdL3XFCM3tCJ4ga1ePm",
-	"EFw1bCs73ZLeAKGFBJpvyAyAEzEzi669HHCRVJHSyM5OrHMi8VB+2wC2lCIDpSCfOH32Tnh9O3v/6C3I",
-	"w9XgKsIsRAkyp/LDrOD6Zifw17CZ3NCiMuL59z+rB3+URWihabFjC7BNaiPa6rvuUu4A0zYibkMUk7LV",
-	"FtqTYERsw3QK0NCH7Ltjr3f722B2iOADIfAGJHrUfNCj5Sf5AEQZ4P/AB+uDLKEqJ0YM7FU/GMnV7Den",
-	"XHjZcMcMYYKCKj3ZdaWYRg29iVlqxMVTtwgO3CNPvqBKoxhIGM9Rf2uvQpzHypZmitGeTmU4Ze9rzEz6",
-	"s3+IdafNzPXOVaXCq0xVZSmkhjy1PLRZ9871I6zDXGIejR2eflqQSsGukfsQGI3v8OgUAfgH1cFC7Wze",
-	"3cWh14ERXzb7YrkBX42jbTBe+FYR4mOn2h4Ymar3wJIbUy16mwlRAEWVqdKiLA2H0pOKh359GLywrc/0",
-	"T3XbLklaM5CVVHIBCk1Mrr2D/NYiXaGta0kVcXB4/wRUeFkXuS7M5lhPFOMZTLadF3wEm1bxwTnouFfl",
-	"QtIcJjkUdJPwtrCfif28J2H4sZFAav2B0DCZoTUxTSP1mfD+pofNKnAqlRK8CX4hmTnn5hlVk5rrffik",
-	"OeC0Kb7piPVemAXBSNKBHw+RZekpMSLe/TdCG7JyRIercbfSHdfSg70w6wdBII47qRUB7dn/G5SbOwhg",
-	"R51/A6pv4fXUx1p2j/of7/bGhdm6ylq3TfKK6OXLOxhjHw/qsUW8olKzjJX4XP0eNkd/vbcnSPpKkBw0",
-	"ZQXkJPpgX/Jl3J9YN+T2mIe95gepW7vgd/StieV4z6wm8NewQbXJKxvREGmrjqGOSIxqLlzKCQLqvebN",
-	"iyduAmua6WJjBFu9hA25BQlEVTPrtdI1oWlRTuIB0jFT/TM6g3zSHL7VQ+ACh4qWl/I8tK+t7fBdtp5c",
-	"DXS4V1YpRJHQf7ZPfAcZSQgGuQuRUphdZ7QoNkSHsBlPSQ0g3QWB3hhBnrmnGmjGFZD/FhXJKMcXbqUh",
-	"CGlCouSDwrKZwYibYU7nqlpjCApYgX3N45eHD9sLf/jQ7TlTZA631uWGY8M2Oh4+RFXcK6F043AdQdtt",
-	"jtt54tJBW6W5ZN2rrc1Tdju5uZGH7OSr1uDBwGnOlFKOcM3y78wAWidzPWTtMY0Mc/DDcQeZ75ouYZ11",
-	"475fsFVVUH0MQyXc0GIibkBKlsNOTu4mZoJ/fUOLl6Hb+/EI1pAZGs1gkmGU4MCx4NL0sYGFZhzGmTnA",
-	"NnBkKEBwbntd2E47Xtq13zJbrSBnVEOxIaWEDGyUnJFSVVjqlNiQiWxJ+QJfQFJUC+fqbMdBhl8pqwmT",
-	"Fe8Msa8optd8giYMlQxTQ7Olj7Y0QhhQ87Jt2z/sY+2WBlDsZTTo0o62p20PSppMx6Peh7/B90398Ld4",
-	"a4aMHmpMbMiHEdJqaAZazxCfRlbqIjHeRnP4DDF8GCtNPXQKyu7EkVN4/bHPL/yiKsticwQhyQ5EJJQS",
-	"FF5psRpQ2a9iTn5gmRRnxUKEO09tlIZV13hju/7Sc1xfH/ICFrxgHCYrwSHxpH+JX3/Aj4PVjvYa7hkR",
-	"BaK9Bmw/fBpIaC2gOfkQkr7rJiHJtM9+29KpvhHyWFZ2O+DgN8UAy/VOtw435aH2dVoUCZO0VT90uIga",
-	"B6dwJglVSmQMBcXzXI2d97m1Ylu39hb6X4XQqCMc4Pa4LdtrFIZlFflQlISSrGCo5hdcaVll+opT1PRF",
-	"S004C3rlQL9a+CvfJK2HTqiJ3VBXnKKjaND/JR2D5pDQQ30D4LXDqlosQOnWA2sOcMVdK8ZJxZnGuVbm",
-	"uEzseSlBosfe1LZc0Q2ZG5rQgvwOUpBZpZtPjlWlNFGaFYUzBJtpiJhfcapJAVRp8gPjl2sczvuR+CPL",
-	"Qd8KeR2wMB3OuBbAQTE1SXs6fmu/YlCJw8nSBZhgrIX97D2e69wQI7P2RtKK/3v/P569OZv8g05+P518",
-	"8W8nb989ef/gYefHx+///vf/1/zps/d/f/Af/5raPg97KhjcQX7+3L3Rz5/jQyyKE2nD/kcwyKwYnySJ",
-	"MnYoatEiuY/5MhzBPWjq/fQSrrhec0N4N7RgueFFRyOf9jXVOdD2iLWorLFxLTWeR8Cez6E7sCqS4FQt",
-	"/vpB5Ln2BFsdbuItb8UYOM6ojg6gGzgFV3vOlFvtvW+/viQnjhDUPSQWN3SUWiDxgnERjA0vH7NLcWDX",
-	"Fb/iz2GO70HBn13xnGp6Yk/TSaVAfkkLyjOYLgR55oMin1NNr3jnGupNIBUFNUcZpFKcgq7Sa7m6ekOL",
-	"hbi6etvxQ+jKVm6qmIu6c9ZVk/kpJ0ZuEJWeuCQuEwm3VKZsIT7Fh4uGxt5b4bAyiaisEssniXHjT4dC",
-	"WZaqneyhi6KyLAyKIlJVLl+B2VaitAiBY4aZu9hbQwM/CudUIumtf/JWChT5dUXLN4zrt2RyVZ2efoYh",
-	"eHWKg18dDzR0uylh8MO3NxlF+72LC7dyOTqVT0q6SNlMrq7eaKAlUggKHCt8aRYFwW6N8EAfCYBD1QsI",
-	"sch7bImFbO+4Xlzuhe3l03qlF4WfcFObsdN32sEoKv7gDdwRWU8rvZwYjpBclTLHwO+VTzBAF+bK8R4E",
-	"ii3wAaCWojJLBpItIbt2ma1gVerNuNHdO7q4u9gzHKZQZ+SCA+fM4C+j3AxYlTl1ggzlm3aKG2WDIXDQ",
-	"13ANm0thu08HZgeLstFFKVZU39FF2o3uWkO+8UF2Y7Q33/ld+RhRl44E4y49WTwLdOH79B9tKwAc4Vin",
-	"iKKR56MPEVQmEGGJvwcFByzUjHcn0k8tj/EMuGY3MIGCLdisSLDp/+raNTyshiolZMBufFRvGFARNifm",
-	"dTSz17F7MUnKF2AudXMRC0ULdNqfJg39KB0ugUo9A6q36mt5nGbCQ4cC+S0GTaPSZGyWAGuz30yjEoTD",
-	"rXng4dvbtnGOxNOD3KnsmiA/EFTfvQ6Snh7yiHAIT+Sz8/d92JPwXnD+aTF1Isj2+8rgcCHFrdlNA6Dw",
-	"qRsxwUt0T1WKLmDoddQwFQ1MidGwAOEgu6SfpLwj5m2xpiNjDFyE7T4xeElyBzBfDHtAM0DLxdHPbU2I",
-	"zqrwkhcbj9RZgQJ1cBC1pENlw87GF/sBm2ZjIHktrHrAmliLj/6SKn/083HE0Q+UFj9NKplt+fPOI+87",
-	"qrvZ8fw13WbtY6vPmQER3PTwWfR86jyfL2803iv33XjkQhxSeyc4StE5FLCwOLGNPZ3V+Znq3TRwvJzP",
-	"kelNUo58kTIykkzcHGAeYg8JsRpzMniE1CmIwEbLOg5MfhTxYeeLfYDkLr8U9WPj3RX9DelgQeuNb6Rk",
-	"UZpbn/VYrTLPUlx6i1rkabk44zCE8TExnPSGFoaTusDTepBOrjZ8+7Qysznfjgd9b6KBB82tEaWTvVZp",
-	"5ZlD1hcL3n4Z6VfBXmuYifXERkYnn1az9cyciWS8AsZppw6vzZx3T5GZWKNPEd5w1sF9b+j6IfOARW4g",
-	"a6aQyrFfn9howdsPkO2CfIqaFZKe06sFsuuTZA8Dpkec7iO7+1EKvSOB1FJg1mnAnUZnp56lKW11JZH6",
-	"uh2H7LAhTC3FavoOZ3InezDaVZ42c919V6c77E+O5s/qR0ny11XK3SUvo+1c2lyL+6RlbJNDA4gtWH3V",
-	"FmKTaG06LjXxGmEtxZIMo+8au7poU1AAagImDbl6cp0yS19dvVGAMsOF7xbpOXH3KN88iLzhJCyY0lAb",
-	"F7yTy8e3/aA60Ty2xLx/dbqUc7O+10IEQcOaY7FjY5kffQXouj5nUukJWmaSSzCNvlGoSfvGNE0Lwk1/",
-	"O6asqWdvORghuobNJGdFlSZlB9L3zw1EP4abS1UzvCgZt95GM0yFn3TQ3cM2ifBYx+6tCHphEfSCfgz8",
-	"DDtYpqmBSRrKa07/JzliLV64jbMkaDlFTN0N7UXpFl4bxdJ3GW0kREduF9NtNp/Oucz92Du9sXxEf58Q",
-	"YUdKriXKiJgOIBSLBeQ+05sLCrVZr1w+vULwRZ1L0Py+JX3glNgsfpiEb0v+PueeDn3O6Y1yIlgVIwl9",
-	"/JhByOvoOsw9iJMsgNvMLaP9640UScTFjvHYItKMflze3nGbT7oOX7bchWufXruHYbNxewqguXtWKfDr",
-	"235ou9vlUDfuczpupIjdfsBwQKQ4plUkwHSIpodz07Jk+bpl+LOjTg8giYHiXjcTfAtnyJbcYDvw03Qs",
-	"3lGr5565HbG9M3ac4DP/xDwyrT+z88g1Z4NmLttAXkm0JjW8hbv59MNDc+Dav//5QgtJF+AsghML0p2G",
-	"wOXsg4YoJb0imlkH6ZzN5xBbwtQhVpwGcB17Rz6AsHtIsGsuC2/LrfTZJbIdtFWvYDdC0/SUoJQ+n4vL",
-	"rj3SPzwi3Vq4bKKNO8ComEwo8D1sJj/TojIvISZV7ZvqDITNa30PmrhZfQ8bHHmny6cBbMeuoCruNSCF",
-	"pqwr4ZOKsoTfU43qC/gGbmzhHjt1lt6lI22NK6XRfzTqG6pRT6K5lA93bGoXGQPpkL26SHudmLMFzW1p",
-	"E/quLWL5btkneoLEUzH03jjkkguZNnZ6lwEtPOHjYkfvx6O7+Xuk7kk34o6deBWu5uQuoDemtf83nL72",
-	"3BBallLc0GLi/GT6hA4pbpzQgc29W81Hfl+lT8Xl12cvXjnw349HWQFUToKqo3dV2K7806zKluDYfg3Z",
-	"dOxOt2tVYdHmh5TZsSfNLaZeb2nTOrVuar+p6KA6z5p52lN8J990Ll52iVtcvaAMnl61Rdo6ejWdu+gN",
-	"ZYU3/Hpoh2rZ7XKHVVdK8ol4gDs7iUXef3ceqzdO4OrqzY3HbG1PsY5SISV+wpdOHejp3OE16bNa0/oO",
-	"DonrfImZTNPvLu7ynCJjdA5n9Ohy4DdCNi4qF9WYdFj7cAKieUxYPKaN8pfOCt8RC6fEipC/Ln41vOHh",
-	"w/jgP3w4Jr8W7kMEIP4+c7/jO+rhw6RhOKnqMywLNXmcruBBiIvo3YiPq4bgcDtMXDi7WQUZWfSTYaBQ",
-	"63nm0X3rsHcrmcNn7n7JoQDz03SIqiLedIvuGJghJ+iiLyoxOD+vbDlPRQRvx+BjlKwhLbx6XAUPa2fv",
-	"HiFerdDuPFEFy9JOP3ymDEvi1qXXNCbYeLAN2cxRsR6/cl6xaHTTTB1k8mwtJJo1iXCVzARc43cmHAuo",
-	"OPutgqisL97ErcvZP4Vw1I6AndYvuoHbVYNHhxT8vbuJ0GvVtimMtppcnwczoEdEqs7UnvEO8Ywd5r8l",
-	"VsFRlL8+MbBt6VyHd1LW1nfe9iLQzgzs2aezuPY/kFw5TLuZz4fsNFOTuRS/Q1p2QCNhInWHt24zVMD/",
-	"Djzlo9pmZMFzoC5YXc++i0CG6xb6SOXOugS/6FA175ArPM0n9tvoPZUG0X73qw1UOr2424S+h2rseNIM",
-	"pOlhZnhgI7dwrOXj3d0otyfU5rVoRJ6lz3kcKHpix6/PuYO5E1xb0NsZTRU6Mu9FA1O0/Q3HPC2I7+w3",
-	"SIXUDHZ2EsUyhLbMJvsrQdbWo26q5APffnbawa+++pGHFBc/78bWV6VQIjFMxW8pRz9C7Gc5oOutwPph",
-	"mF63QmKCT5X2IcwhY6ukMvzq6k2edT2/crZgtqR4pYDQuXZ5Ht1Atqi8pSJXzTvkInGoOZ+T03F9Zv1u",
-	"5OyGKTYrAFs8si1mVOEFHXwiQhezPOB6qbD54wHNlxXPJeR6qSxilSDhfY6iZ/CEnYG+BeDkFNs9+oLc",
-	"R4dhxW7gQfqCccLa6NmjL8bbKmcjxrFI/DYmnyOX94EMacpGr2o7hmGrbtR0ZMJcAvwO/ffJlvNluw45",
-	"XdjSXUG7T9eKcmoQkoJptQMm2xf3F105Wnjh1joDSkuxIUyn5wdNDcfqiSY3DNGCQTKxWjG9cp6iSqwM",
-	"hdVlyO2kfjisr+fLoHm4/Ed0wS4Tb/xP8Nyiq54IR/Sq/xHt7TFax4TajK0Fq+MvfIVacu4zU2NduFAO",
-	"zuLGzGWWjvIqhmPMSSkZ16g1qvR88jfzfJc0Mwxx2gfuZPb5k0R9tWYJIr4f4B8d7xIUyJs06mUP2Xsp",
-	"x/Ul97ngk5XhKPmDOqVDdCp7fcXT/r19bsc9Q99ZujbjTnoJsGoQII24+Z1IkW8Z8I7EGdazF4XuvbKP",
-	"TquVTBMMrcwO/fT6hZNEVkKmKl3UDMBJJRK0ZHCD8aXpTTJj3nEvZDFoF+4C/af1bvNiaSS6+dOdfCxE",
-	"VuXEOy2kVTKS/s8/1Pnx0bht43Zb2kshE3pap3H8yG6p++kL2zZ06w6I33owNxhtOEoXKz3hHjaeI/T5",
-	"FP5ebZDsnjdUpY9+JdK841HWf/gQgX74cOxE5V8fNz9b9v7w4XCX2bS+0PyaQM1hd007e6Xpm9rqL0VC",
-	"e+ereAa/MZeqJKFhTd5l5kqduTHGpFkq8ePLHceJV9zbDTl9gDxq8HMbN5+Yv+Jm1hEw/fyhWT02ST55",
-	"+B7FUFDypVgPJaLWteXp6Q+Aoh6UDNQK4ko61XGTnhI73XwisjWjzqAQ5qUaF8Aa7LXyJ9oFg5rxlr2o",
-	"WJH/XFuhWzeTpDxbJp3KZ6bjL/YZEDWINBjZknIORbK3fS3/4l/ViXf/P0XPsCvG05/ahZgt7C1Ia7Ca",
-	"QPgp/fgGV0wXZoIYRc2EXCHFSbEQOcF56solNWvsVjRPVZJNxPjjsKtKO69kTJ7gCorMWYFutGl7OLac",
-	"SKp7uCqW/fclrsw4WIVfWbWEHR0koWyF17aiq7IAPIQ3IOkCuwoOre6YsQ1HjsqSEFWaT9gSk78IoivJ",
-	"iZjPo2UA10xCsRmTkiplBzk1y4I1zj169uj09HSYkRHxNWDtFq9+4S/rxT06wSb2i6v8ZQsm7AX+IdC/",
-	"r6lun83vEpcrv/pbBUqnWCx+sAHZaCE297otvRrKBE/Jt5ifzBB6o0QAKkV9huVmTtCqLATNx5gU+vLr",
-	"sxfEzmr7SEDUYenXBWoAm0ckaeQZniPV51/ryV01fJztqXPMqpWehKKsqUyKpkVdS5a1vJ9QNxhjZ0qe",
-	"W7VscOyxkxBMLS5XkEc1YK0aAInD/Edrmi1R3zkdbVUp91QDGl7C2HPA2lwUxb2GglnIwc0yXBVjW8R4",
-	"TIRegrxlCjDvBNxAM2FjyHbqFPI+gWNztbLi3BLOdA/pNZTH2ncXPHBW9PX+FUnIWvtwZ9tfnckDi5zv",
-	"W+z5Anul43ZalaNbfg+2ZMbaF92Ykh+csSOjXHCWYbGJlAiOqRiHmVUH1OVI2zvVyJ3lxDFM1qsOAeoO",
-	"i70VrD3LdIjrOjVEX81+W8Kxf2pYuyKAC9DK8UDIx758vDPQMa7AFUAz9BVzVCETrl/JsJjgQnJEl/Tx",
-	"CLOp9ehavzHffnS6ecwZc8046twcUt1L0BrYCsXQzs4J02QhQLnVNuPC1BvTZ3q55gjC2+kLsWDZBVvg",
-	"GNYV0SDFegF3hzrzPsHOB9e0/cq0dbULws8Nlzo7qV/32yQLUWH/UzXXe9Gf8v3yjjQRcsP48WhbiHGr",
-	"qz/ey4YM4QY9/6DE+7xDNqF8fXOUr82T1dIbtiA2cjeZNpjxBBgvGPcG33QerCx5l+DG4Gnu6acySbV9",
-	"dAzieJdAi55wGAyqtx4Ddx2qXYnBoATX6Ofo38a68n4PWwkN6tcF5RviD4Wh7kgo+YoWwRk+UUcfpTMn",
-	"jFln4VZl/RRbMWx94kNzG+jaGQgaumM1lH3vqb5so7MqX4Ce0DxP5Z37Er8S/OoDCmENWRWKgIU402a6",
-	"9i61uYkywVW12jKXb3DH6XKmqFKwmhUJ19vn4SPkYYcxEdVsg/+mKmD174xzet87+tt7uOf71SjoRrOn",
-	"pGdD0xPFFpPhmMA75e7oqKc+jNDr/keldB/4/YeI625xuXiPUvzta3NxxGm6Oz7+9moJWbTRn17gd58P",
-	"LGRybXIlvMo6dd7QIwM3L7FlLeB9wyTgN7ToybgQW23s/WotGX15F7LetCJUu+x1mpKaJwxRYfTn/7Ie",
-	"2C3LUNe82edjbV2sP6TxxOFjK9L7LY3fN+yK1uutZii99sTDTH41Eexr83OlGLr6UloUIhvMGdwwZ6ZT",
-	"f6pesVq5zPcJr7yblcjjsxB7cwGkGZt1WE6EVuDDNvkNn1bJL/I2PVpDPxKIZmjWMkSjW8LYBmZ68Dww",
-	"dup4okhl6zBLvmEFFof6z4uXP476NzLage6WutTZSRV238aESLU2eSxEAx9beIDgRVr/rXpU6pgbKn0a",
-	"XHXi5IdvrIJwCEg2T9I+rV8MHbxDAAthq0Kl6mZ0s9OM6u3wyI+ood5ey1Fi6khRRbvaUuLtY5WedRMS",
-	"CpEOKkzakJGGFHdK1RFyLwWvgbUXjctHZ4srdeoydRjo8yHCYQcf78ej83wv8SlVi2pkR0kx2BdssdRf",
-	"FiK7/g5oDtLWE0k9J201kRWYZ6hashLfP6VQrK4HXJjBXCLvJQ43HRqac7kElxXGJwnojOUdqG8g01gf",
-	"unYDlQDD/RzK9BINBN6giE0+gSuIBMih1MutwpJ17i71si4bCi7yjCkyA2e6uAE+JmwK03awWl4nhSIF",
-	"0LlXwkoh9IC6uiFsCdEYA52ir06N5u1iYCfnW5TS0JbSnQ4vwnIWYgJsoOUtVXXmqFYahcHh2vM5ZJjw",
-	"fmv6vf9aAo/ysY296g5hmUfZ+FgIF8SSDUfVaNewbkuEtxXUqCbVh4S0LyHGNWzuKdKgoWRF4BBhe0gG",
-	"eESOteP6ogJ9pg3nGMlUoCdEkPeDdwn46xpLhxQBiLJTHgiGp3FzPdUZKw+Dxks0B4Bhuk7vVLS/ToeH",
-	"gmlfdr9udfX+l/JzLGavnFMpDenmY30SOe+WY7516eox0WKwFvrE9aD8bz5Bq52lYNeuQg0izNpmb6nM",
-	"fYujpMmz9yZLAz0PM7M6MKrr5bOvX46NUMwKYQSgSV9gaDNSKbjw3lPW17pOWoZQz0FKyINNsBAKJlr4",
-	"MKs9kn+68Mkt2LNe5gfhreXRv0fIsF1Rbw2F13UhCSwHSbFmAnXO5zFWiIQVNdDLqLhDWg26a4e+st99",
-	"ThFf3m+7erUP7+Fc7K6Q7UPvmOpgPj5dc+KEg725VyMRyQGaWcY5yIk34rZLO/BmmkzMq5xXmRVV4rMZ",
-	"tNeD045t4WZJpWbWXWXrCRVl5biGzYlV+/iq437HY6CtDGlBjxJKt4jiqLpqlYJ7cRTwPm36zlKIYtJj",
-	"GTzv1qNoH4Zrll0DJmYNkSlGCr7XPDZmEnIfDVLBZ+R2ufHVFsoSOOQPpoSccRsd6N1HmhVIW5Pze3rb",
-	"/GucNa9shRmngZ5e8XSYFVZ6kXfkfn6YLTyvjzcpMPzyjvPbQQ6YXa95n4/cLZaEadYJng5Vb3T9O1oi",
-	"VER+FoqUAHVhDcFfIUtIvKMIZmeJ0gihfwAlzoBMVCFSXviHZJAxQ6UxFU+GAGngA56rNRRu8CQCnJPd",
-	"jqys7rPPOyrmRELtm3FoAlaX09QycdWnGmnPHGZpcsa5kBDPiH6mNlFziGzDPMf4nxnTksrNIWlSm6hK",
-	"qaF6sbzTWzI4StYLqZ0luzgsCnE7QbY2CdWVUuoA0041r21fp7TuZ476DCK3S6qciLghS5qTTEgJWdwj",
-	"HeJtoVoJCZNCoBdmyrFjrs0jYYVxnZwUYkFEmYkcbCG0NAX1zVVxTlH2gsiVLYkCSzuYMsD2ieh44JTm",
-	"9rXm2QnKazsLbfjNvzR9bPqKOv2dXfTEugj0xBeAcunuHIZs4y68SDg2I1NbKZsWkedsjXQDMnXk50TL",
-	"CsbEtWhX4XcHn0ogK6aUBSXQ0i0rCswewdaRQ0PwB0qjtkd2Pkc/6BuGDm/NTCJWpC7N7RjSr8Q84CLO",
-	"yEb0UopqsYzqAwQ4/dNdVu5hH4/yk6rQJxFDRM0UT8hKKO2exXakesm1C+j9THAtRVE0FXlWzl84o+8P",
-	"dH2WZfqFENczml0/wEc4FzqsNB/7lApt3916JtnKwTjspaDXfILkoXanWbft0KvV0fNg3tnifh3Dwy5N",
-	"fgTm293Mdbdd46y7sPa6mnw2/RY644RqsWJZ+rj9ubxfe31WU9wrmWnRViG2WWiwGfKB+B4L7kzIPbto",
-	"Bk6TZVTPiOMRzq0DOZH5L4rx7XHJHBwP6rlDu3zHCViTrFcMbAGAkNpECLqStnRxLKQFhiMWNnEKOqW0",
-	"AR144aDv391gMyMcHSgNdwKq440cALxvNRhjmxHTejbPxNp/f1CnzDwI+PfbqbzBPPqcKi9q0pLWrdIn",
-	"surhCOkCBFs9EC8xCcZsqB9iKEU/8PKPAOj3TGzAMMg/cV8w5pQVkE9SVYrPgw5sHD3XXYxlNLqv52g5",
-	"eUYrXwnYjF1JcImVrPQvm+bEkhpSEqF5VyPOc1iDjdH6HaSwdXzHkTkLClvmt6VREOWkgBtoOGy6bE8V",
-	"SqHsBnxfFTqTHKBEi29b0ZbyRIyrBLa0L27tk8iXbQh2k+oYi1i7U2SHriWpGVrziT0mauhRMhDdsLyi",
-	"DfypfUWOpi7RHOUEqjrPh4l/Yg6d5ic7wms/wJnvnxJlPCbeDuNDe7OgNOq2MaCdnsmV6jv1PO2YHKcy",
-	"C4YinC0Pdm1L4jXfUCW95f1azS7J1y+xgfvEBI8Q+/UaMpRq3FMIcvcY6rGcuBxISO0cILcPBtMloc1f",
-	"AidcRDWPb6kKr5g6q6v/wU6MjRh3D+0DbPS1//Ddd5bgYES1ki2mS5QGsr6bjv+TnMStB7F3vBSNKHCh",
-	"vFtUY5663bMDG4iqyAk3+2lkf6wR7G4xx8XHZFb5gYpC3NoixvET9Tl4e66lPm9icmI5C9ey95Meu4TD",
-	"bS0IiyJEVnRDhMR/zIP0t4oWbL5BPmPB992IWlJDQs6AbL0onN+1mXi7eDX2gHlFjPBT2XWzoWNGw23M",
-	"KBHQ5iL3ZdsEWdFriLcBHUQs/8y0YZyqmqFSw1zZre3sYsEt3qdnWtE8VgJgotlNgzv4hOem9/+qw1bj",
-	"qXz+x7KgmS9Z7YrPNfkMVrX3xKWXsNoe5tzla54EQqX8mmilT5ORH6BN3ZN1pWJ++opjNcDulADv1AW7",
-	"0zIGKoVbNY62BIgPWsqxd+E4MZydJcWlfnctLq58/HF2J5khum8ZQ8D/A+1Kw72iE9mWrqAer8cWS/8I",
-	"u9BIxJOA1arBZ2I9kTBXuxxprB58JtY1wCrobhnPJFBl/Y7OX7pna50AmXHzjLZeu8GsGkbJYc54zWoZ",
-	"LyudeAVhHmS+iRAWWxMQrT22uT4Zw4iiN7R4eQNSsrxv48zpsaWB4yI93oLi+iYUIOFG7g7AVP0CxHjq",
-	"Wj8fNzPXvy0waH1nlaY8pzKPmzNOMpBGaiC3dKMON1UFq8MuYxWNZKFmtpDIbIWkbQEpNs7afEdDUgCQ",
-	"HtGiNMAShE7aCSuQVQxp0WP46cLwp7AEreh6UogFRv32HAiX5xpNh/YBKTgq0a10N2zdfh7Ffoft02Ap",
-	"EseItMBZh0yx/dy/xK3ER+hPnOmtJ99qONth2NbT2R5Mj1S+qMMzLLF0z2Mqct4lZoqj572o6tOUeNqD",
-	"aBOTLtEdrXrPLqJ/hUu7EKvQhxerbLpwpOLzrV5hgvoGtSUAA1QdV0Az5yHWVcR1FBUWKWOX3WBPPZ3V",
-	"7vt7qQc8VKQod9ab0wYHHTPOPhU+t+czmJSinGRDfFtttaLcGRkcpE0Ye+gjMiH0rDv43ahQv6uRE61R",
-	"yGvfIqe9hcR22crKbJvKoE/J1MPRmwYMMUdehkfYqtYw1iqoYsb+ce6N3U0lWmAShBIJWSVRyXxLN7sL",
-	"P/Zkn7/47uzpo8e/PH76OTENSM4WoOqaBq3CibVrIuNtrdHHdUbsLE+nN8FnC7GI89ZLH/YWNsWdNctt",
-	"VZ2MuFM2ch/tdOICSAXndkvkHbRXOE4dFvHH2q7UIo++YykUfPg9k6Io0jVlglyVML+kdisywJgXSAlS",
-	"MaUNI2zaT5munbLVEpWLmDX8xuaGEjwDr312VMB0jy9XaiF9Pr3IzzAXg7M5EViXheNV1k60bV3unWb1",
-	"eyg0orvNDEgpSifaszlJQYQxW7KCoFd3alPUp0duuoHZWofdFCE65/c06Z1x9xIWc7Kd2zdLces0pzeb",
-	"mBAv/KE8gDT7rBv9eUYO4SS1YeAPwz8SiVOOxjXCcj8Er0i+D7ZEhZ91vCZC0pBBoHUTZCTIAwHoiYdu",
-	"BK1GQXZRbnJpbQxojfDm57b48UNtlt4ZmYKQ+A47wItjmet2IZjCgfOJE3v/EJASLeVtHyU0lr8rPNqz",
-	"3nCRRFvklCZag7JsSXTFwiggXn0V4sx7XiWdcHQphCbmZVoUiTB2q8fBMxUTjnkSyBtafHyu8Q2TSp8h",
-	"PiB/3R+4FYctx0i2qFRHT8j5gg4CKwpR/ihQ8VcYW/9fYHY2eTu6WZzhv3MHokqIFtbbex4s4MDJLY5p",
-	"HbsefU5mrtxPKSFjqu1QcOtFmhBvC5LNnX8trHU79vfOZYJ+FvoOx2Hu/YHIj5GRLXgOOJjro/6JmVMP",
-	"B0ielhSpdgglgb8Ur4uLqu+4du5YGuawVE5R4sY9Uzl1y8UPXR6uAy+vSkF3nYNv/QZuExd+vbahucoG",
-	"V5i5unqjZ0MSiqWrwZjumOPsKGVh7l4U5qMkOLOodGM4SJKEVYvcu7LXtPwlozwNzV004n5PAfmlRb8Z",
-	"DR8F84rb8UIBVIwV92xdzMfBi0Fw0+0ZueIPiVpS/7Zwfz5++vloPAJerczi6++j8ch9fZt6qeXrZFxp",
-	"nUin4yPqqgncU6Skm6E15Prz5iSRW6cJ+vjyjNJsln7QfWc2DF+tLvrgnCOfR95ir0+XPOd/bvafvTOI",
-	"hbNiibFODBT2YVeOoJ/7EuLbpO89dT5afLdixU73uEYJlvfj0cKmJ8O6JL+4KnUfd889BD2ZAt3S75IA",
-	"zCImsdbG5NFUUTq3AaVYXLdEbQyMuc4qyfTmwuDfK9zZL9epNFDfhsRMLttXsL07qVeLa+Deu6xO41Qp",
-	"L1d/K2iBcqd1CeBG2hTFlHxta4O4C/Hv92b/Dp/97Ul++tmjf5/97fTpaQZPnn5xekq/eEIfffHZI3j8",
-	"t6dPTuHR/PMvZo/zx08ez548fvL50y+yz548mj35/It/v2co3YBsAfU1f56N/s/krFiIydmr88mlAbbG",
-	"CS3Z92D2BnVrc0xNiEjN8HKFFWXF6Jn/6X/7K3KaiVU9vP915CpBjpZal+rZycnt7e007nKywOwnEy2q",
-	"bHni58Eslo2XyqvzEBFkvf5wR2trE25qyOxnvr3++uKSnL06n9YEM3o2Op2eTh9hJsUSOC3Z6NnoM/wJ",
-	"T88S9/0E82efKFeG56QOGk3a+V9jgIx/zMsF5OR+CP/7t+DpoR74KMK5yz/5T2WJMaziPEfichXTR1jx",
-	"FV0/EazHp6d+L9yLJhIsTzDW7Nm7keUfqUS4HaRe1gAnIavrTXcX/RO/5uKWE0z2aw9QtVpRubEraGAj",
-	"Ghy3iS4UGuUku8GcjKZ3G+dl6Yof9aEc62k2T7nvjAQSKuOYE2YL5rgSRiqF8m7hpTtif2vy585kid3B",
-	"Rq8MzD7BWUiY7K5BhzP0MbEIC2fEqik7iB6PyiqBzq8xjE9tw9k4KtZjoRFFHjDeweir6n8IRg3pLkLi",
-	"X/PXEmiBcpH5Y2UINfOfJNB84/6vbuliAXLq1ml+unl84rUNJ+9cJqn3276dxP6nJ+8a6bjyHT29B+Wu",
-	"JifvXIaqHQPGBpET59kedRgI6LZmJzOstDm0KcSr618K0rw6eYdaud7fT5yQnv6IilN7w574l0dPS5s9",
-	"KP2xgcJ3em0Wsn040yYaL6M6W1blyTv8D5JttCKbuf9Er/kJOpqdvGsgwn3uIKL5e909boEJpz1wYj5X",
-	"yLS3fT55Z/+NJoJ1CZKtgNti4+5Xm8f2BAtbb7o/b3iW/LG7jka6zh2XOeaHVd77spnlM3l9tFOHqrsy",
-	"u2Hpt9oJS7sCdleS2ray9+PRkyNy5WYlgAQwX9Kc+AwrOPejjzf3ObcxJEa0tCIwQvDk40HQ2D7yPWzI",
-	"j0KTb7z2/unH3Ilzbl6OtPAC3YGi37Dj075GjewdmvGFFVSEzbrTPGpned4hevuGBKW/FHi79mFspRal",
-	"8+qokVY/oRk3SxgPE5u7uX9t8kcvSHCRwyh+3GpZwfs78oSWPyiV+jxhZ0JbKoaVOXtNA9RkStq2t5wd",
-	"OZFjfQcJnz/3k9bRWH/xlL94SuApT08/+3jTX4C8YRmQS1iVQlLJig35iYcwv4N53FmeJ7N/N4/+Th43",
-	"Hq0nmchhAXziGNhkJvKNq7g3akxwDVZb1hFkTrx2qfFi6OGeXm+Vklbq8JHRszcpNyoXTF1Ws4JlZsFT",
-	"r1sqqV5Gqp+QCLnJ/cYxJwuKyjdnk3+cTr54++7p394no6e7gVR1BOLWr4kqMiRnRRXy0ehb4fI9dC+p",
-	"SIOjBVG/SbzM8HAzvSG3jOfi9kHAwG8V4N3hUOCnGY1TN80WZXW3zGLtxmBA7gDaBwH6P2zdgkH2r37X",
-	"gi3fupVvD1tDQT/VEt5+aM1bSG36nxcvf4wivq1+xbpNYryxPbAY3iUFhi3dUvSbt4Wnv7Kar2KDmQs0",
-	"1ZVqlLSd/nX7/nXj3f3G+zYk6bcVazUWm+wyzegGnA4S85M32rvGn05bM7JBK6kk+eZ3QskC65J3r+XZ",
-	"hpw/77zZbbf2RfjlBpu27sLEJdcGcSufarODHvayTZAzC1kIHUJ37KL+Eq3/Eq3v9FwffHiGvNiT+rRv",
-	"cWDaeYWOfeH/RngkFrpA/4AOKEO0bp/0+B5l47savZQGzxbkgJxEH2wGoDaa/2IRf7GIu7GIbyFxGPHU",
-	"OqaRILr9NHxDGQamq8sbjuhe6vDNq4LKKG3CLsX9GY6YfgB/EK7xsdWUSVxZLSVGVzEbVpDYwONqLv9i",
-	"eX+xvD8PyzvbzWiagsmddX3XsFnRMmj41LLSubiN/AIQFhsS1LVs2od/+++TW8r0ZC6kqxdH5xpkt7MG",
-	"WiCyGSZyjn+tC313vmD18ujHOOFn8tcT2jTVNt0FDOvt69jxJUh9debynkY+04z/XHsqxp5/yPaDz9+b",
-	"t4ZlK5A3/kaoHdmenZxg4rKlUPoENV5NJ7f449tAHu/CPeLI5D3ShZBswTgtJs4jZFI7qz2eno7e//8A",
-	"AAD//0rsuowXIgEA",
+	"H4sIAAAAAAACA+19aZPbxpLgX0H0TISOIdgtWfKztfFitq3D1li2FGrZs7OW1gaJIoknEMDD0d20Rv99",
+	"86gLQBUIHmrbMfpiq4k6srKysrLy/HAyz9dFnomsrk4efTgpojJai1qU9FcUx6Wo6J+xqOZlUtRJnp08",
+	"OjnPgmg+z5usDopmlibz4L3YTE8mJwl+LaJ6Bf/OYCT4Sw0yOSnFP5ukFPHJo7psxOSkmq/EOuJpa5gT",
+	"+/5yHv7fs/Drdx8efvURutSbAseo6jLJlvD3dbjMQ/njLKqSeTU9l+N/3PY1KgqANMIlhEnsXpRpEiQx",
+	"ICVZJKL0Law93tD61kmWrJv1yaMzvaQkq8VSlJ41FcXzLBbXvkVZn6OqErV3PfhxxErUGEddAw46uIpW",
+	"A0DkfFXkMKRjJQF9DfizcwlW96FFLPJyHdXd9hb5Ee3dm9w7+/gvmhTvTR5+4SbGKF3mZZTFoR73sR43",
+	"uOB2H3doqL52EfA4zxbJsgFKDq5Wol6JMoD/BPA3nN1KBPnsH2IOG10F/3Hx8scgL4MfgOijpXgVzd8H",
+	"IpvnsYinwfNFkOVwZMv8EmgingSxWERNWldBnVNPTR//bES5MdiVcNmYFBnSwi8n/6gAwsnJuloWMNfJ",
+	"uy6aPsKy0mSdOFb1Q3SNFBXASDNYUb7ABSlwSlE3ZeYDiEe04RkkyQZ+/vJBlw7Nr+voug/em7LJgExE",
+	"bAFYwyZW0RxbEJRxUhVptCHUwiB/P5tIwKsgStOgEFkMSAjq66zyLQXnPtpCMnHtQPQboBX8EhRAEhae",
+	"p8FPQDy1+lrn70WmqSOYbehTUYrLJG8q3cmzDprasRCLDkq4MVyMKqAPEs0eHsV9j8mgXtOIH4e/VclS",
+	"fupCfZEs38CHYJGkeF8G/2iqWhNwU9G2A/qqQsyR98YBDoPIhyGzCGhEPHqb3cW/ghBYADCHqIzxlzX/",
+	"9AMMlMAk+FPKP73Il8kcfvLsgIbVdU4r6rbm/+F47qNaXzvvkhd5/r4p7AXN7bOAtPL8iY8yeEw/abgZ",
+	"5LmWG2h/5Fhvrp8/8bHU4R4AhdpID5Be3BURNgQRpxQIbTRf0P+uF0Ra0aL8/YTFC+xdFwsXapH8Jbsm",
+	"geqc5adzI0S8lp/x6zwHyuWr0BIzTonZwm+W5FTmhSjrhAeFtmGaz6M0rGrgXPjTv5ZiAXD8y6kR9E65",
+	"e3VqTf4Ce11QJ7yMS4GML4TxdhjjFQqPJGp5DjryIT7qsGdwkyVwp9cruLWSjDeR5C7kNKm4jLJ6erLT",
+	"Sf5oc4dfJBBmK/iS5K3oMCDvXgTccAYXL9K+FHpvVS1JkTAeEMYDIMhgmeYz/cNtGNUgl77DL4yqSZAs",
+	"ApHQfS6uk6qu7hBmInPI7HnghAXf2mNfJXDH5Fm6CWZC3jvAZ2BM5tuSj0sBHBFLazAjwjpop3NguoAU",
+	"hQaUy45BjCRVrvIUr8CtZISNv5NtbQrE30d1/stTn412P92RRC+RStTEv5iHW3C7Q1R9mqIeSE3n3b77",
+	"URSOMkBL1XOD4GPTFf2S1GJdbSUSCyKL0OT2RGUJTF5KUCFJQn0KAmmJiQfkqCQjaCcokGcg+73n/cgJ",
+	"70gIotKSNpMZi1dXsDNG5NKon/beF39tQnbteYAbHiUoGwcpECYKQ7SZVbASKQmckVYs2FT0HTTOy80x",
+	"aMen0UCcKrLOF/ahc+5MtMZP/WHevv0F5ZK3b9/BdtfAqM3T4YdkXubn8BH3yZ7gxLz7lCTf2y89ZYj0",
+	"kzd1KJ8WYSmuQG50rEgJnvKMUu9BOCaBHJsPu3y6yPGnI6HcSrLWhMFVVMHlCcciDkC4jHYlVBS2QJCu",
+	"nNsATAx3IYYzsOQTwY07uwtsyyAERe2Xi0WaZAKk7QQQgO8/RGBUK06XzxN6E6o1wDmTc8ALGwcIXmY0",
+	"wOgRGuQqgAngBbWCzgK7yPOUBw5+zPGWq5N5Am8j3JwdgMzklRCpsYF1ZLn1t3AQeocVGFWepP+tVDnR",
+	"7za5VTvwkc6pN9wDFwlCUJTN6T1leAaQEKyniPAhhtPaPORVmeeLY3AQeWidJC61IKxxwQ2S26mgLcU8",
+	"L2MHg9FHa7apRUsj9f9u//sj1ERF4e9n4df/dvruw4OPd+72frz/8e9//+/2T198/Pudf/9XJ/c6Khf8",
+	"s7OkAnfevdYqmaUoRKjFZjm0AfmHp4vgpl6U+Zp1bXled3BSBWtRvk/hei8TOLL5VYYqof5+TwK4h0WK",
+	"9xv9g97JSmTZRXYhGn68StLYJbl0/0aIfZx4eC03T5Fbr40hzEvZFJoAn1vk5WHyjrmVu+xugMsxjUmc",
+	"T3aXmVrcyc3pDO+wGR6gJgF00Pw2u9uL040gwYE1aPCvyqhg2OUXVn3B0Y60ypphPVD5MVIv4YTZtvQY",
+	"UZWg2vv9u/WN6oSEbTRtGL5J8/n776JqdYQba6bG6p8vmgaE7ygGyWAFTbbLAGa0MeSNDYlkg5k11VQv",
+	"8UW+rI6wxDTf5SFYFI+jNMWp+2yzs1oaeNQ5hnczNg7EOqlR9JIX2TK5hEcfSyPB0whearCuYA7zT4wp",
+	"Jy9CviFAHkuyTJQTluY0H6CRlW6ZzlEl8OlYi8BajTQDTQNgm7D+vCTWCP9dR/SeX6NGuUjbffR7tIKH",
+	"aEfdROwFGB7CaCl74YNcHQDNN7gemsDXa6zUhagGn+Lc8hPNnOW8uKgUZJtKsnnaxAZ/ml+0gMbWRjuR",
+	"mSmAQ5JtjEXhpAQUljwE60vk5PgPAYPozkydt4tShHKIMroUZQUvOLxW2ou6o8n3WKdzy8mMozqyTqak",
+	"QrcSnDkH9SM9GszUH/0l/QMWh59RJ4SUZKgnIdUOqYH0fpCaA1HFM2ED5Fuwv2s2NQYo+e4E5WMzuZvN",
+	"jDp5T9m6KbdQLkLv0JvrJK6OtU00mG+v2iekagl5PXlnkOlYc41BwJu8kAJmBwTmFDQaIyS/Pvq1BmO6",
+	"YIKfe1dafi2OshM4zmhmD7M+kZDl5V9e34fjEB6DK0EsMII3fj3Rh3PT5owRG3zpVVwna3GYYMyIH0OR",
+	"uPtoVquUtGrLYhPL9eF8lpf7iVo9Vxfj0BFEOKolaU46FERNmyKUjMvhbsENOgMF2lw5LCF1h3dhrIWF",
+	"izr6BFiocNRjYKE90LGxAEc2ScUR+MLKKeECRYsv7gcX350/vHf/1/sPv0SShI5LOIcBPm2r4La0G8PK",
+	"Nqm44zyYJHq5R//ygXKwaY/rGqfKm3IO0Bf9odhxh1+53CzAdn2stdFMq9YAjrouBN77jPbgNfeDRk/E",
+	"rFleiBr1ihU8RxdHvyp6M7igo0avAJELZV3ShCdFydMYm5wCNy2j04JawnOcXblwHUmFNoX17ChE5dv4",
+	"2MwSBxKjsdh6KHbdJjPNxt6qclM2x7CkibKES9Eln0C7Op/naYhCcJI77sZXskUgW6jtKrq/M7Sk68e5",
+	"SS8N14vnCkRPqdGXOw/95jozuBkUrHi9jtXJecfsSxv55okGSwthkICos2WJIxViFMTUkQSxb0XNwinc",
+	"ycD818XLxeI4NvecBnKIEDBThTMF3AJFw0rAJKxGHeVz1kGmnGoMzrrYUr5RtR8qiaaLTTYnaeQYZ9kv",
+	"XUnXsaCC6SzTKsIIB3wpypsyofowxVDcqhyQIqZewKmCA1nkFRD0EVBVqLFGH0Ubgq3n0Aw/ihMyV+Ee",
+	"IOrmiB4YCZ2ao1SKwnAZzN8LRgbhitxtnoi0jp7l5RvzMPoWkFYc/W7rzjl2byO5s9KhJ8a+yl0DvpOG",
+	"27zplgj71LXGP2RBj7V6itdA0NPJfZEsV7Wlidjf+DYIo2sWF6D0gdWQKfbpKyN/hNv7giyUR5DDzWBt",
+	"Tb59KcDTosFnGVqcpGXaLaF7XOLxUMybskT9myX0k+YLbuKZQOqaRw2uFh03c9dlazqG0ZyPZ0io8ZgG",
+	"jTMDt+LpVtElvDFTfIyimhFeovkMF21ciGmRHQu3fB+MvXxawAKa5iCwo3uYtAdtg1fbjbQ9z4c8Wg2t",
+	"Qs8C8niwiMpPs4L3l1uBfy824WWUNvhW+f5n9BH8cyyCPFy2bEHXC0ZvRFfR21/KATANEXEXIpuUWXvC",
+	"JwHfG8h0UlELH7IPx553+7tg9ojgEyEQRGJyV/+kR0tN8gmIUsP/iQ/WJ1lCU4QoE3t1MSjG435nUZYr",
+	"QXnLDHqCNKrqcNuVgo1aSiRcqsXFXbcIDewRrl/AN5KJW45Kch4WtHGKXf2+aErv0xQn/Vm9SvvTzvF6",
+	"zyq4ndUTtWqKIi/hYepaHimIvXP9CF/VXLD1Zmz9DgY20lRi28g+BFrjSzxKrQj9ARSp3D+lgrm/OHLp",
+	"RfFlsyuWW/AZHA3BeKFaWYi3I9Y8MKIxSfckckOPtRa9zfI8FVHGXn95USCHqsMm0/18GLzg1uf1T6Zt",
+	"nySl7xxJKnEuKjJGyvYS8itGekVW0VWE+kIaWRkDSPvH/iJ9mPFYhyDSz0U4dF5II4Ct7IOz13FvimUJ",
+	"4m0IQjm8wPqmDf4c8OcdCUONTQRilCl5LcIZ2Z3dNGLOhHIK3G/WnKaqXIJ3QF+Ag8E5x2eUITXZe/9J",
+	"4T84uItvSmK9pWchMJx0oMYjZDE9OUakux+akLcbEx2tRt5KB67Fgz096ydBII0bGi1Ad/b/gll5bi2A",
+	"HXX+DczuWbiZ+ljL9thC6G5vXZidq6xz2zivCC9f3sIYfTzIY5ixHI3z7HuxOfrrvTuB06sG+BM8JVHJ",
+	"bn3gl3xh9w84xq875n6v+VEKrz74PaWXYzkq7KENPMihpDZ5xX6FlrbqGOoIx6h44aJdFgFVIan44rGb",
+	"iGv4V7pBwZYsy6Rmq5oZ+zf17YnoxWQP4E5I4J9Rum44HScGfUkuaChreU7nWHptDcP3pvPkaqFDvrLI",
+	"G9/hZtw58T1kOCEY5VgGU9as6oTNqHVMuqKkFpDygiC/HS3PwLVko5lWEPxX3gC3y+iF22AooRTSKCqA",
+	"JR6aAcVNPaeMAzMYEqlYC37N05e7d7sLv3tX7jm65oords7KqGEXHXfvkiru1QpOGtyY71+LdX55HCMe",
+	"DhQPuruTnIqSNJG52mwFygHOPGrysZpuA4/saR6lmaiv8vK9AauDrsPtgRn6U49X+uu5n0LHzXbzmxx+",
+	"LCpke+2c71x+XtUtVnwM6weM99xBLt2oju4NtN15Vo48BgGvOoNr3wDkwFUl2Rwu/+DrosPHr8es3eYo",
+	"4xyHadxRW992Ne2tm7jEa3y3PCmj5BgbHpdsjukv+z9b2VZS5mOq+dQp4YN8la8xOqAQ0oA2pIJSrQNq",
+	"DU9KfK3DMjJADt+yY0JcqmghwjoPq1VTYxyKfyHo/Mq2iNa8qVjUk0CaPGl9ZJxFG8WKNFuoQnavlwRK",
+	"jw4T1VV6RJyNfIkw84yx9AY0ALsTF/l8NQ1eShdq7XKqMY9Xk4397bjpEKHe6d4+OZC4g3FyaYdM6dXK",
+	"vwl8RNVFsm5SuEmPwaov4faE66Esk1hsZdRyYhj4KfR7qbsBTOJazPEahkfBnLIMjRxLvME+nJiIyT5B",
+	"GYUTT4wFSDznXhfcaYsy0fhBJuu1iDHEESSdohRzwVl28CFe6aVOA065MAeBY0lKHui8lKHSPA5d9hR/",
+	"iRmHmqw3xK6vzfo6C8lKWznT3JCbisrWhO9MgREBPRMv66PQY0aCwmdv1J1sbU/X5O10kZmceHWbiO9L",
+	"o9tkvLVTTu3rPNJ6AltIM9CM9JYgfOJzsI9Eexvx8NXsovAJDNFmaBeU/YmtCCnz0RckhSrVdHOEdyAP",
+	"BIPDialIarctHRV/dUZjVpsKSK9vn+auv3qO6+t9lHw5BU+Ha8CwQ2vJodU/0MfRlhV+aXhGpDffTgN2",
+	"dTstJHQW0J58DEkfuklEMt2z33XmqJ7l5bG8qnjA8X5C251ztr4j5JT7+lOhCNT3umENa4+LVBPtwZ+U",
+	"dmj987iayFAsdtQxEefWgl7p1CpHOMDdcTvuJVYaF7ZVirQA8OZpQpZMmBxe8vP6bRaRMcNaqsM5XOk/",
+	"/Zavx6qJ29TmsITJoQAAEpW0icPpCLoQDqHymRDKAFY1S7jU644OCXq9zWQr2Jwmw+BYjE/D4xLyeYFl",
+	"kof2lFticNyCxOI8+F2UeTDDcHNbq7LGzG4smbOvC04Do8JCaqAk1Bn/kKAbKg6n/AbVkdWvVomF6XjG",
+	"tRSZqJIqdHu2f8tfKcJS4mQloy0p8JA/q/Cfmw7oVrC7kslJyDGMkNSQ8A/UNVlBk13Y/ww2Z3grhE6i",
+	"tB1IO7QY3KZ8m5Lg7rRNGwDT2wxdhoHwQCpPYuRFRyOf7jXVO9B8xDpU1tq4jqVCIWDHN/wBrCpwcKoO",
+	"f/0k8lx3gkGfQnvLOwF3kjNWRwdQDuyCqzunK4zi1rdP3wSnkhCqW0QscmgrNaHjBaPSydiOjLhLdpTz",
+	"W2DwT8SC3oN59uhthtGrp3yaTuGtVX7DCQ2myzx4pDIEPIE2b7PeNeRNVGJnETIZqD9natohLQpQX9VN",
+	"FtlHEZAoosgi1UrmO8RtRRcIHUWNzFwmi0Ea+DGXfnNldKWevA3qtX9bR8UvAMi7IHzbnJ19QfHoJkXi",
+	"b5IHIt0C0KMfvt5klt33Li2c5XIKIgoxSNKdRKoWUUEUQgLHml6aIAVQt1asvIr8oqHMAlwZfrZtCUO2",
+	"c5ILWu4F91Jpwd2Lok+0qe3cawftoJVVb+8N3JKZL2rqVYgcwbmqCo+B2iuVwyha4pWjnKTQ5khKSDg6",
+	"uGRUDQmMGqD0zWJd1JtJq7vy5ZN3sZVNC3VGMlIeDi4MhrY0GLAp4kgKMlG26abIrTj4jQZ9LYBhvcm5",
+	"+3RkdnErm72VorXyHV2iXeuuRfK1D7Ico7v50rVUJUyQ6UwpCYEii0eaLqwsZp6jzQLAEY61iyhaeUJ9",
+	"iIhKByKY+D0o2GOhON5BpO9aHqrGsxpu11CkyTKZpcKv2rdMtwpWpEpUjyaXKsWFHrBCay6+jlR+IX4x",
+	"lagrxUudI25g3zuB45bmn6TDlYjKeiaielBfm9lpKhV0JJBfUQYRUpqQAUJc434nNSlBQPoTsXx7cxsZ",
+	"KzHdy2NURhHFe4KqupuMIdN9HhES4Y58+Oq+t3I+yfeCdMG1qZNA5u9og0d1xRXuJgKYq9IPlCDWuqca",
+	"jMUenTjNNkGOTVHW6oODbJN+nPIOusi0xZqejDE2ISV1DxEvTu4g8AuyB1caRjU3e0lIq8JLzIsikTpL",
+	"SaDWPvBMOhhGUNiJGncD1s3G4K1uhFUFWBtr9tFHs508+mRuUxz9U+X1/CSpaIfy7z+3HIyjup9dX13T",
+	"XdY+YX0OXNZAwdBDZeFXqfdVvn0AbJfc+Z+TkX7qZKRKmU5Scl7grZ94rFZzxVJkricj8nSiOGgYgHsS",
+	"ICe9jFLkpDLRgBmkl+ud3j6dzO7Sfe2O70008qDJNZJ0stMqWZ7ZZ3224K2W4X4V7LSGWX4dciYM59Nq",
+	"dj3DM+EMyaK8HK7Dy5n34b8wOLlN0g3HMTw7Q+eHTAFmebphJnXED/XziY0M3m6ADAvyLmquiPSkXk2T",
+	"nU+S3Q8YjzjtI7vbVgr+I4F0hNzDtrTVl0TMddvLUuxmNb7D6dxJD0b7ytN2rvzvTLkEf3J1dVZvpEhA",
+	"Xyl3SF0H7lxwrYZdyjp0yaEFxABWX3WFWHf+1Za3XRuvFtZcLAkZfd/Y1UdbBTcbaQLCllwdvneZpVGh",
+	"IUhmuFDdLD0n7V6Ube5YDr+lWKINxRgXlJPLzdt+SJ0YUkpa/+rqolzg+l5buYjZHMupfO1l3vgKKDpn",
+	"kZQYmoGWGecSsNGzijRpz7CpWxBuO4nCDzTgznIwQYTxqnGSNm5SliB9/wQh+lHfXFUzo4sSyJS8jWZU",
+	"Ss8Zg7CDbZLg4diVQQS9YAS9iG4CP+MOFjZFmCgLdnv6v8gR6/DCIc7ioGUXMfU31IvSIV5r8pM7vN9k",
+	"snV216JASzvRejuvtcxnPRmV4+2NZfrGkbMwFdEimCMg2qm1hCslwbRTitnI2AVTeiQwvW6eZyZUB9O5",
+	"NPqkddEIHp5lyvodIWVOnfIcLmbYn1kOVcmVu3R87mRzDKucYoAcrOwxfXKw3lSWF850yATYQ1qsxt7q",
+	"nKdy2PhkSh7JuRYrW7Q7ZD5fLjEImPMcyjQInPRS5hpOc6B6nQcUfx9IrTwNOMMxJSgeyG0sA7KELxyr",
+	"VZ3WT1z225YgN/HklJeZJkGfAErcdrJ7+drUiTg7FIxaWIrymz14vUAxZ/jDm07Ig4lL4D3Um03bk2Je",
+	"Vn5lV0Ktb0tpld52SdRNfIETrfT5wweMOYiuFWBqPHeJxnORA3BJfN2xA/Oo0z1IYqT03y8s2MEZ3VJy",
+	"sC34afuZbyn9fAuFJWovbV+npPU5RZ0Du7dLB208G3BjcX6duCnJuNhyHu+XZ9R6h5Fr//7nizovMYMq",
+	"G4hDBumgIWg5u6DBqnAIa0/YXz5OFgthG0arfYx6LeB65q94BGF7SLBvPdWqhkH67BPZFtoyK9iOUDc9",
+	"OShlqMqQu0KPrWrVl421cXvYmJ0pdL4HufFnVLgBIwGp0rgqS3tx+1rfgSYu1zA0jbzVAxgB27IrpJl9",
+	"LYhCXcY2/amy5M5bVauYJ6lEWlu4w06du3fpSFsjK7P6j4a5oVrlSdtL+XTHxqqiA5CO2asLtxMSni3R",
+	"3pYuoW/boiTeLvtYL1J7qt0KCtmXnM4ttdXZUESpInxa7MnHyclh7j+ue1KOuGUnXumr2bkL5JzL7iAt",
+	"H8AdNyTCtM0YwCbdpnxCBzSSQgc1V15WN/w6c5+KN0/PX7yS4KMfCsh8Zag1X95VUbviL7Mqrug6fA1x",
+	"qRqp6mfNqLX5upyI7Vh1RWVpOsrVXulk40ZnHVTpaLVwBw5s5ZvS44+XOOD5Jwrt+GccFNjvr+3rF11G",
+	"Sar8ABS0Y40uvNxxxbqdfMIe4GCfQcsZ9OCxvGEjqIBTmDXmNfab0+WCHK6V1Z6O7z1e4z6rhta3cEha",
+	"50tKZO5+d2UyzTkxRul/GB1dDnwGZ8O+qGSQq9N/8dMJiPiYYDy6fTTeSKeMnlg4DViE/G35G/KGu3ft",
+	"g3/37iT4LZUfLADp95n8nd5RmDLE8aZ3an6RZZFiFyuT3NFhMt6NuFk1RCauxokLICZrGTn3k6GmUHZE",
+	"VOi+kti7KhOJz1j+go4X+NN0jKrC3nRGtw3MmBN04QtS1b7w6+gaQ2p0OU7LFk9B00hadPXI6mbsdtE/",
+	"QtCP3BDCCgBw+4BlswpZUsYe3tg4oMajXQpwjibxhBlkTWKNjs2qvSzgnYVYszoRXjkLARj8znLJApos",
+	"+SfQRkIJ1eFTSTdx53JWTyEatSdgu/WLcmC2JJvhxwrT2G1XndGAxVhp1YYURoMW+CfaKqwQ4SpbvmP4",
+	"iz1jj/kPhK5IilLXJ8U5rkQ6LmPI4DtPG+mdyhfpFaDYpzTA+x9IyGxVv+dPxux0UoWLMv9duGUHshk7",
+	"klUpZ4eEFPDQe4Q5wziSqPXas28jkPG6BR+pHKxLUIuWjnai3ucKd/OJ3TZ6R6WBtd9+tUHlri4iN8H3",
+	"ULX9kNpxVR5mRgfWihKgpDPK+xEa0YCc5qQViOg+53bc8CmPb865hLkXa51GV7PIVQQS34sIk7X9LT9N",
+	"zFAuO6sNqnSmDp49sEJbdFuZSQdgMNajfnGAPd9+PO3oV5955BHF2c+7CbsupVXuGKbJrqKM3EqpH3NA",
+	"2Ru1kcp0dpWXlNK6cruUxkAia6cyHJAfz/uOgHGyxJk4q3MQLWppTZUDBZw3m6goTqoijTY6NY1EDWzI",
+	"2cScWZ3XKLlM0EQuqMW9iSz+XNEFbcp1qy64PFjmqqLm90c0XwFK4ZhBF0YsoFW/z0n01I7RM1Ffoffo",
+	"GbW793Vwm/zHq+RS3HFfMFJYO3l072tyu+M/zlyyUiwWUZPWQ0w+Ji6vbNVuyiYnex4D2aoc1R2osiiF",
+	"+F3475OB88Vdx5wuaimvoO2nax1lESLEBdN6C0zcl/aXPHs6eMnYOiNgsnwTJLV7flFHyLE8yQWQITIY",
+	"GPsA61hLx+EqXyOFKdaqjp8ajmoPqxKxCi71kTzyC8cb/w94bkVrT8ArBVn8SPZ2G60TdIqn9CuJCceR",
+	"LBJOoKrFQDVzdd4yxg3OhUsneZWic7ACIZwI0ho19SL8Cp/vJVwbwBCnPnDDGZy0fu3ZdgXCbDfAbxzv",
+	"aCkqL92oLz1kr6Qc2RdzKmThGjlKfMdk+LBOpTd0wO3u7fNC9wx9sHSN44ZeAmxaBBhZ3PwgUswGBjyQ",
+	"OPV6dqLQnVd247TalG6CiRrcoZ9ev5CSyDovXbWdDAOQUkkpMJ3pJYUbuzcJxzxwL8p01C4cAv0f6+yo",
+	"xFJLdFOn2/lYsKzKjneazrKFkv7PP5iKMGTc5jDujvYS8NV/uUmN4w17Ke+mL+za0Nk7lL55MDcabTRK",
+	"Hyue6B8O79F9/gh/ry5IvOctVem934DmF5SiJkd9MwKNGlNu+tv99mdm73fvjvegdusL8VcHava7a7oZ",
+	"eLGva6uxiHufY8gi3tpvTGaucWhYnXcZXqkzOcYkaFdKvnm54zjhqzt7pbsPkEINfe7i5g/mr7SZJiDK",
+	"zx+APp7IVbm0BEg+sf5uhdREWMF+LBF1ri1FTzcfEOLeSAd4ck/pTpe1xVRVOUpX92fYXs92jtRo0jKl",
+	"u/4WL4+tLkrWkcNRZwJ9patWucrRHjd/Zgrqm9BOJgN70SRp/LOxoHduVWD285XTIX6GHX/lJ4zVwNK+",
+	"oJ04E6mzN7/0f1UaAYfO4h+5Z1h4jrk/dRYuYe9AasBqA6GmVOMjrpIac6C0UNTOLaez9cC1CPuN7Uyd",
+	"McPWLfHZIL5for6froKGXTe19KimPCCy/NciSWWSc5ctn1qGZVR7boSSosgXZkSQttFWGMgc4zA62uaS",
+	"NYkcVYSlKekQwupQH4Tp6DLR6U7JB2lkq4gYasYzWQWX8hjlQd2UmOR5YS0D7XVw8W0mlP6dBznDZYlr",
+	"mvvk0b2zs7NxBlLC14i1M17Vwl+axd07pSb8RXJULm+0E/j7QP/RUN0um98nLlk5ni4CF4ulD5xbgKzb",
+	"KJNw1XhYTEyK5WnwLaXaQ0JvFfQhha5KFt5Ob9sUaR7FE8pvjv5dAc/KfeBZh6ijqvVL0l62j4jTQDU+",
+	"3a9KJehJwzZ+nOEsULjqqg51PXlXUlBs8UYXnE86nluk17SxMw2esEpZOyXxJAFlyS/XqIrVo7EKg4gD",
+	"/1HXEcCNatjpyaA63FO7z5TU83lRvZItFAc0pi4rhFuXtyQOjstgHw1U4MainAQ56tevEkxIvoKfL0U7",
+	"96hO3NspwNJeLZBVxoQz3UHy1sUsd90FBRyL7co3xAlZZx8OtluapDR5U853qJPDJ/+CerljjrL2YB2f",
+	"DS5wda1KZE2DH6ShZg48PUvmVBrK9XygrKLjTMIjqmi5bbXViTzLjmPoIGUr14LEolz/Oy/LlIjrO2RY",
+	"X3G/mXD4zxrrTZJ1con5KZgHYiYk3B4sKMc2MBAahCxXivRlc9S8dLitOUN6tPvLEd3pYRMxMaBHT/wM",
+	"v/0o7QqU/ghuIdIXSqTKVywbBzFjER4TDBoNlljclFfbjmmrfsE+UyAzAuHd9EW+TOZAFjQGu1EiUtiD",
+	"uT/UufJnlv7D2PYxtpVlOPTPLXdAnlSt+52ThVR6//vanOvMi36X35pyArKQq8e3RxsgxsEwBbqXkQyx",
+	"PgvQjCjoPu+RjShL16MZq7M0TG/UIuAgdGcG7CRzgPECkz1pqdqR0m3uvEtoY+g0e/pBe0wbMJrjobOy",
+	"J5SH8kOwt8OhQ3WLiiBKaI1qDv82ApnLiigetqIbmNcFZvRUhwKp2xJKMERYO4aTMNXWqaN0JoUxdnTm",
+	"KGEp3rnZCrL1UIUVt9C1NYhVd6fCPrveU77EubMGpMoaU7C6Uih+Q18D+qqCIbG4UKNLduoY2XblgT61",
+	"yYkwq0qzHphLNThwujip0NSxnqUOt+En+iPMo3aYcqrNNvT/XWoDaof9nSPXlXd+vFu5jX4kvkt6RpoO",
+	"MdPeeEzQnXI4OszU+xG66X9USldB63+KmPRuCTNrj1z87SleHHbG+V58Al8tOiE8xQLk9F2lttNJiTt1",
+	"8iIm2t6ccvMcW9YBXjV0Ag6XnydbhG1x4vuVrTC+nBFzb4acqJaJGGGVhieMUWH4U9mx93jHqtU3zfr8",
+	"w9k9/FMafiQ+BpHut5J+37KJsseeYSheW+h+5kpDBLvaK2VVkb6+FO6AfD6aM8hhzrGTP+t0vl7LIg4O",
+	"j8LLNTzErG+2J5oQbsbGztaOsBB62Dq/0dPK+aW8co/W0o9oohmbgI/QKJcw4aBSBZ4Chqe2J7JUthKz",
+	"wTN4fqFl/T8uXv544t9Iawf6WyqzwDtV2L6N0VF2XfJY5i18DCbojxxS+6tWYnHtOeHNEjlBNwP+Wfrf",
+	"dNIYGFzgS8kh5dvpGNSU7SynVn46lQO0zu2JB7IotKYvRq13RFb53Scf8Ex35yfdAbGe9J/fUHZP7ZRk",
+	"wWk57Ws+0rFgurne9kvRzZm7PCfPUrftpfKYcyjFnpsPzK7HUjymaR3dVkRFr+0X991tK35NdlA4q8ZO",
+	"ljXJuKYfHbjF1Gbu3eKcfmOB4Hx7u7R+MXbwHvdd5lxd0FV/qZ/W6sTwQsX5LFZseCtf5zZrdp2WF1yk",
+	"Er3SSldqN5kZX7lQ0Nnk6NIrUpkDYFzCHo8WZiVXCtxqOr7mjyP1iZrXUyOMLBpbkqBp0HqD0vMSE/5R",
+	"8j84h1hFwTj8L5KU/sllkgcqJFBPLAJZDVRJNkWQqUSyzt1h8iZGtZWby8IoA8YllddJmibSyNcSI/1E",
+	"ua3grgWBVRrBwpJ/s0cLskNlG2rB0G2tpF2vABxJVW1Q+8ga3MZR9szoOkTY4NhU3o1NoxJLFKoEArPN",
+	"fjDtu7Pj0OZC2LH21p9vT53N/hHpFJ2zCaCPdr1I1wXaLTXqkKPYTGqaSANLjwV6TCYtrcqYyqauIppS",
+	"t6hstvw0lcmYubJoryhpj2c+GaNO6uEDgH4e76RwcRViPeFRnDuQLFf1N2gj/05EsSi5mJ5LAc2l9NYC",
+	"qbJaJQVpTIEGE63NC1IcTFaxWdFw07GByL0smv2xVLjYJYCORg4r6KUUYrxXZ+FeIkKgXJB0btMbVirB",
+	"OmJR1KtB9QqHshW1riOK3diQhj5aQjo7XIoM2NJUTLuh+bFJgcn5VKV1CJPtTrdzCB2kTWi0gXbRVytr",
+	"9/eupA8txVEv4bGVz1tQRtodpJFzHQHJaSWwWrvOk9lJGjU6Oc1igYl8L7fknv5PNOWZZMQTZeyTd4dJ",
+	"RZ3o5AhUr+yoNnAD61AW6EFQbdHiE0LqS/8Fu3arClo0xOnufflE9il/RMhhzy9VUcvnDCHDQAA5ip4I",
+	"QSrqT17KpsDoPhWwrNTse4KhaByvJ5OufT9o1DNsDzCw646TeoURUmX5Ulu/4qoR1lXu160/EXCZw0OC",
+	"Q2giXWvJtkChMb1jeafV8asIHhrav0hVbRKV+k1VJ+BZ0uS9LM/I7wPy5sKCFqrFUZIC872ZuIFe6JkT",
+	"Ewbe9wve1ZOX8zHM0xwFoNCXBqMr/ku1G5xpiiwzKVoJ6oUoSxFrLyIYW4RYuYupYIfM9zJZxAD2jOpp",
+	"Z7x14hd3SJDCK/IWEHttqqhRLfSICoZFMtTOxoqVKd1UNnMbTrft0GP+rh5Aqrb1sEHWh3d9LsKtwRwq",
+	"0QDeMx3M26cLvUVJONiZe7XSru1hy00yYKKhcvvq1jXL2knBqahI3Mz7ulNt7x6dZHWAmznNoPP+Kr2q",
+	"aGTUp2woktnI9I7bQLMMyaBbWuIOURzVul254F4eBbw/Nlk5lmMLPb5Ez/vF2LqH4X2C/t+YwlyrvFEK",
+	"vtU+NjhJcJtcWLSX6dVqo0qNFXDLifjONAjQtIy5EJTDqV0Orjd5dqsemv+aZo0bLq8obdbTt5k7qJzK",
+	"HJYHcj81zADP8/EmYCLxwfPzIHvMDnzE51V/RfUQcQ4nzx1Wb/Q9QjsilEV+DIVTgFrBqZ3l+funWV1u",
+	"3Dmy21pd5c1bqJ7TgBy3ye0fUKijGLTOVBT5fHWgKll41MiYMSdfLELYkyQd0ODSd1uJJlCrwLlAAN4M",
+	"0MF7zXo+jKhcROiJqr6SlQm4x7qoRyeem2HYTLw3bNx97GQIblOKrSpFqoaFF9OlGFiiInuF+DEQ8Bum",
+	"oZT7A8u1leey9aJJbSD2mFtSZSjpxosGSby6WTt3EUn6VZ5earf08c5OeZksk2zLvOjTWlHVmyheY+HH",
+	"7vT5DDWORkcxfv4CXbjJHgIiWOpDAH1qxdNKesPJ2TswIm2MHo0+T2RzfegxvBqAXsFgcY63Bcil+eWO",
+	"/mX8qgrNzrNzup92KlP6l6xUpmePYPsywJBttAcYMMOQWMGu5zaq8NKkxHc5BQFYzGV8Od8t+2dxxUkg",
+	"psspxkBjvRaYv5yvUFu/y054396KphVIgzfIK4Cm2hpAxa+6Ln3p7evfLr57QwzfHG0sVTsS5g4bUHl3",
+	"oBUdQ58P3xTPJlywB/hjkuxdNmBKKWvlPqbAgCiQnuNBleau1AH7pL3FodyosycjgGqRjdA6Gyjk4E4E",
+	"yOi6LaVk5GfLOwf5vQrK2LdqjCzEwm+xymfh6M6sZ2k/cBaY8sWakQJMubqU9nyh4kz0j1kCsmO52ae2",
+	"SxtVLvrzYnn7KVcRkmYhJkqyj8M0za9Cep2EukK4S6uP7ar261vWlzWVxSvJeE28JVxorOnZwIMIpZ0S",
+	"rg+7h9s9gKHCDDwhVhFzOiu8SBY16vrWlIwKC1Av4ZChJSlo0JXWTUG+uZoM5YM41DTpRQHTDuU55D4W",
+	"HY+cEh/R7Jcdktpla7FYtflvsA/n3DQ5+3nRIccGeBILAGyco19iiBv34SXC4TTSXduqW9O1SK6JbrBo",
+	"Vv/Iw9aXmPRBtmC9gk1CdPDx9bJOqopB0bR0laQppbxMrq1IBh0I5EatRwX2nAKgLxOKdGunP2XNWIFi",
+	"jc4Za/OACzuNPHyF9suVVeNSw6k08BhOTJ/tUX6qGgpGpLxWOMWDYJ2jlcf2+dFDmdjP2xhkAxdf2rbH",
+	"sbpuKb29f4iuz+fz+gXc2fgou0O6dJSDdDbCicoD2Q3aNTOVncIR4xR+GBlG5FFtrw3H7SicVdLzaN7Z",
+	"4X49/4FtV7gF5rvtzHW7e8J5f2HddbX5rFuliU/8Ol8nc/dx+2uFvXqDVV3cy1kegnrI1LnUjPiAfY/p",
+	"OCbinn00iwxp2bVfkkfIeA7iRPhP0sZ1xw0WQvIgzx3a5ztSwArnXjGwAwBBytkbMdEA8T5bSNMMJ1+y",
+	"Ux5Fo3QBHXnhUNDfYbDhCEcHqhYHAdULQ9YA3mZDxITLeHBIM2a4kd/vmDofewH/cZjKW8zDF015YUir",
+	"5HhKlX3bwxHcVRMHQw/fUObO2dgAxEo5+4y8/C0A/CGJLRhGBSbuCgar0sKo9tz7ZMqaWFp3qTewRk/k",
+	"lc2cfB7xXb5iNR1wApkNmqX/su0VRFWa5a2KzfuGbTRFSi3t76LMKa9ZPLG8UlTJ5Y5hIC/CVFyKVqSm",
+	"TFHNyjtUJMq+le4MV70oyHGray9zvYEHVDFy7aEVxDYGu06rCiNWKj23mEycBh64wPmYVGOPEkIEEh/I",
+	"XS0k7CpytE2CeJQdqOo9H0L1xBw7zU88wms1wLnq7xJlFCbejeNDO7MgN+qGGNDWkOSm8p36zB2RbOdf",
+	"1/4eNFus3dOYxA3fqIroKvMbJ/skb15iI/cJRrIQ+xS6k1Qjn0JAAfzU8ejHZOARUXuGDnwxS43LzGGU",
+	"Rz+fLDcvItITq1eMKUWjfuCJqRGgix/ae7jamcDhw3c2oMGCqlMhwq0H1mR9mKn+DzmJgwfRO56LRtCP",
+	"jXJ4DajGFHXLZwc1yJsUVd+wnyj7r6JLoW4xycUncHbUQKjIoMi31hP1iVBuWUx9ylNEiuWJvpZVgPRE",
+	"VknqakESKzXEmhWz+D98kP4TWEqy2BCfYfBVt6BaRUhC0g+MnSFlwDVOPCxeTRRgShGTq6l43cnYMa3h",
+	"NjiKBTRe5KrWPNYaeC/sbSA/T+af8xoZZ9XMSKmBV3ZnO/tYkItXOaXXUWwrAag6zqbFHVSVNuz9v0y+",
+	"KnsqVbSiSKM57zapNjA5TpvPoDCkiQvarIfzm/X5miIB1coi2lLlx4z30KbuyLpcyT58Fb1bYFvPiHZB",
+	"7+MsY6RSuFOYeSAz3KilHHsXjpO8qbckchpUVUS2LI7rRamKIzexO86yVr5ljAH/T7QrLS/JXkobdxiw",
+	"vR5qchO70MrA64CV1eAADtzGi61OGKwHR2VAaXL3Kt0tSE6lwFpByCqfv5TPVlO1KSGfnMR2lbBGibHs",
+	"lWG1SVZgwYDeK4iKN2UbC2G2NYHQOh0Zr2ukUswP8fJSlCUIgx4c4OnBygntysLKgiL7OhQg+kbuD5BU",
+	"5gVIidSMft5uhtd/nCxguRwCA/w1i9Eh22oOSJvDhRNhwF20qfY3VWmrwzZjVWTJQu00oZbZikibAQHB",
+	"ip3GDjQkaQCjI1qURliCKNbKYQVixRBM7zb89GH4S1iCKCQyX1K6L8+BkMW5yHTID0jME4wyGEl349at",
+	"5qmS38XwNFQ/VTIiwDbOOmaK4XP/kraSHqE/ZUk9ePJZw9nNv8YBS3wwFVJRuaqiLJlY+ufRlTJPZmS2",
+	"0+YpUVXlJ1W0J6xNdEY29bTqnl0k/wqZb9FWoVfjrUstFw5XYj7WK4Skb6gG4iiNfwrhupKKqJ7jeldR",
+	"wUiZyLSGO+rpWLuv7iUPeCrlPp319rTazxbHGS8bWY4nboiKvAjnY0JUuMRyLI0MEtI2jB76sEwInnVr",
+	"v5tKFx1vJUNvVR9nuX8f4b1T/XybrQzOzrvBY+1UMnk4etuAgX6mwMvoCLNqjUKmtSpmoh7nytjdVqJp",
+	"JgF9Shi5JCXzFTtQ9dR/rQrynpJ5F9+dP7x3/9f7D78MsAEWikTLs8mQw9lRFdvQEQZJ1tUa3WxMQW95",
+	"tXsTVJpQRpyyXqrodb0p8qwxt61MBaXW6nc1iDsuAFdWLsw2a0Ic994rGsdEN/65tsu1yKPvmAsFn37P",
+	"0P/DXQhXy1UO84trtywDDL5ALFfQtv00qU1sVbUi5SKVOrvkpNC5ii8wVJDUHl8u10J8oTnEzygJo7Q5",
+	"wcBFKnkV24mG1iXfaazfI6GR3G1QB5YXUrSHG9YFEYVel43QenWpNiV9uhVto5ktx924CFHGsLlJDz0+",
+	"6CUM9DXM7Y2ZUTFqB6fHTXSIF+pQ7kGaPuuGP8HoPpzEGAb+NPzDkTH1aFxDL/dT8Arn+2Aguct5z2tC",
+	"ZwsdBVo/M6aDPAgAT1qTVu4JK1beKqhWso2BrBHK/NwVP34wZumtAaYEieqwBTw7JYlpp2MiJTh/cLmq",
+	"HzRSrKW881FCa/nbspwo1qsvEmuLpNKkRt9BLp3RFwutvDbVY50uxvMq6WWVwXwoaIBCUbSfjYb1OHSm",
+	"bMLBJ0F52Um+dCNc4xn6b5wTPkT82h9/bWcfsZHMqKyOXonjRTQKLCvTyI1Alb2iFDn/KXBnnbejnEUa",
+	"/nt3IKmEQF4mb2+dlg4r/1zRmOzYde/LYCZrFKNjb1J1HQqulEij02aIEi1yHAdzXXdTeBxc2/jnvD7g",
+	"OCyUP1Dwo2Vk054DEmZz1P9g5uThAM7T4iLVHqE48OfidVgRYVxR20Pr2e6Xw9mq2LBjDmd7ZVRRY/Ty",
+	"aB10eQGp99c5+tZv4dZx4Zu1jU1SProsLtYin43JJO4uYYvdKbn5UWrZHl7J9kYymzMq5RgSEidhGZF7",
+	"WxK6jr+klW6pvYso7rt3ggICMDwJRqNHwaLJeDzFhjnli2Lr+WKivRhQM58vHgVvs7voLaHeFvJP+CdW",
+	"sMuwmtgvJ+Y7xq3x13eul1p87UwPYfLh9XxEZRnBWxXwjc3Ywvf+9HdO5Jpsfzcvz4BYN3M/6L7DDaNX",
+	"q4w+eJ4RnyfewtenzIH3PzeJ386JP/VZYWI0+f30PmxL9fezrxIeV3vzFPjs8F2sBbrVCm/XXsVUP5wa",
+	"mQqS/jqDxd540hcFgadEgFz6IXk8GTGOtbYmt6ayUkmPqMEquznysFPqFGic1JsLxL9SuCe/vndlc/xW",
+	"51eUSTu17V1KvXX+HkRk6V1msjE2lZKrv82jlOROdgnIUNrM02nwlIuCygvx77dmfxNffPUgPvvi3t9m",
+	"X509PJuLBw+/PjuLvn4Q3fv6i3vi/lcPH5yJe4svv57dj+8/uD97cP/Blw+/nn/x4N7swZdf/+0WUjqC",
+	"zICqYr+PTv5PeA44Cc9fPQ/fILAGJ7BqTGH58SPp1hZUk4CQOqfLFZNypdBM/vS/1RU5hdWY4dWveBeW",
+	"2HxV10X16PT06upqanc5XVISs7DOm/nqVM1D5StaL5VXz3VEEHv90Y4aaxNtqs4qjt9eP714E0C/qSEY",
+	"+HY2PZveoyQWhchgqfDTF/QTnZ4V7fspFc46jZbACVD4PU2lkpuSEVMTp8H/NUXKVK3UxdXuqcgn7MrD",
+	"dsNVfgUNai61CpLzjjmkNYKex0S3tZ1BnbIdSOdSWtX9szO12/LNZImupxTNBr8xh+onzW8haJSQ20rn",
+	"vtWGp4d3JJ7vEcybzh5gQjk7x7pKyIeJn3DqB2f3dlr6oMauVfnIAdzzjN1Xkar59FETihjtk9VP2fss",
+	"v8oCGpZ5VQOMA3MYnbxIUG8wtNAx+eIjdAVEDCeXkbxIdPpQYNvvcE55KCpZlPrURFJvPQtrUWIUwW0d",
+	"E/tv2v2puqNCaxeyGgui2km5MpLxQJLtvqSdhCMBdkK27059K+oWNqzBXXvgxDlaLxdDKC8TcSnaV5/q",
+	"TFxT14nGa4fLR8uC3pUL5f0y5Adif7AUWm8yx+5Qo1cIs0req8uHSdlQ4owcrxhh+uJg3X0P0UDujQOd",
+	"Tym2tRrC2cQqXc3Q5MDlFcZ7GH3V/A/BKJLuUpfBwr9A/EjpsYB/rJFQ5+oTPEHjjfx3dRUtQW6fynXi",
+	"T5f3T5UK7vSDTA3zcejbqe2UDT/bqWbjLT2VW/G2JvADZ1/dMiDIgnVebgbbqKeqv4VtazyVQSNWB0yv",
+	"dRqXUZJ1f+RU/qcVCDrVinLVtD7rzEPWh5G4G2p2Osuvd2gqbIT7sUvHED6RjOT9/VQ+pt0fycDBknAX",
+	"7d2WnKzT/bG1Hx/qa1zI8HDYxhpvju5vTXH6gf5BJ8laEZfWhD7ZKTmEnn5oIUJ+7iGi/bvpbreginAK",
+	"uHyx4MJWQ59PP/D/rYnENXC1BAVjyiEuf1W01sAOb/o/b7K588f+OlrZ8bfIF1SOoVJe0u2k+s4brZup",
+	"/2AReFy22259gL6s23/xDK2MBNazmxNYv4ngPSUzIf0phOUHZw9uDoLW9gWwf8GPeR08U1a2hze5E8/R",
+	"9Ir1UaWMuac0Ou74bH8YYOpfzo7VPmrncdwjen7OAQl9k9OF78PYuloW0vvKIM2oupIMlzAZJ8n3S23I",
+	"rHwqCR9WyLTfmeiU9PG4z2IA4bnDHkw+DxT+Ke2qLVCdFSC6L2Ieecxz+FVncDWpiZr8zFM+8xTNUx6e",
+	"fXFz01+I8jKZi+CNgL5lVCbpJvgp0+G4e/M44EHOYjvto7+Vx6EdEL2T4EETSgYWzoCDhVLV2ZrgvWDF",
+	"VU+QOVVa4NYjxsM9lX7ZJa2YMC+A2uXuKJMeFM0MNgwXPFU6YFRwWipanfu0zf0mNifTBgU0EpyFX7/7",
+	"8PCrj84sB/2ARxMpPPjVUeY5iJO00Xmj6qtc5mXpX1KWUgn9M/7JFQvpcCf1JrhKsji/uqMxALtHd4dE",
+	"gZrmZOK6aQaMSl2Yn1nuRghyD1AfBOSnNLgFBxYuHPjWy/AY7bcGdGr6Y5bw7lMrA3UlAawRbWVmYJUP",
+	"uzdTXgA+sBSGWeZzleKaSmiin/RjVsalG8owUkd1w7kRFI+bfr59P994h9943+qaWFQlFQgtdTJN6wYc",
+	"r//v3WgfWn9Kbc0JB5e5alLh73AhLxN0H+xfy7MNyKW9Nzt3616E32yoaecudFxyXRAH+VSXHXjYy5Ag",
+	"hwtZgiCnQux4UZ9F68+i9UHP9dGHZ8yL3alP+5YGjnqv0Im869phzFRXjvx4eqCM0br9ocf3KBvf1+i5",
+	"NHhc/w5j6M0HztTVRfNnFvGZRRzGIuCYOfgCnlrJNBxEt5uGbyzDoLSScStgREkdqnmTYkihGKu4P6cR",
+	"3Q/gT8I1blpN6cQVaykpCjLh8B/HBh5Xc/mZ5X1meX8dlne+ndG0BZODdX0wzDoqtIavWjV1DNAZ4yXB",
+	"wqF7fcsmP/y7f59eRUmNAQayPDN5avU71yJKCdkJJVy3f42TCg3m61n/S7kpGwu8VmJe56+nUdtU2/Y9",
+	"QNbr69hzTHB9leZyTyOVEUp9Nh7FtocusX3tm/vLO2TZFbyo1Y1gHE4fnZ5SgsEV3GCnpPFqO6PaH99p",
+	"8vig7xFJJh+JLrhCFNa+YSeV0DiV3p+enXz8/6En+crDSAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -178,237 +178,263 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3fbNpM4/FXwavecNF5JdtK0+zTv6dnXbXrxNmlyard7ifO2EDmS8JgC+ACgLDW/",
-	"fPffweBCkAQlylaSdrd/JRZJYDAYDOY+b0eZWJWCA9dq9PTtqKSSrkCDxL9onktQ+N8cVCZZqZngo6ej",
-	"c05olomKa1JWs4Jl5Aa209F4xMzTkurlaDzidAWjp2GQ8UjCPyomIR891bKC8UhlS1hRO63WIM23r88n",
-	"/302+eLN28/+9m40HultacZQWjK+GI1Hm8lCTNyPM6pYpqbnbvx3+57SsixYRs0SJixPL6p+hbAcuGZz",
-	"BrJvYc3xdq1vxThbVavR07OwJMY1LED2rKksL3gOm75FRY+pUqB712MeDliJH+OoazCD7lxF44WM6mxZ",
-	"CsZ1YiUEnxL7OLmE6PNdi5gLuaK6/X5Efkh7j8aPzt79UyDFR+PPPk0TIy0WQlKeT8K4X4dxyaV9790B",
-	"L/qnbQR8LficLSoJitwuQS9BEr0EIkGVgisgYvZ3yDRhivz75csfiZDkBShFF/CKZjcEeCZyyKfkYk64",
-	"0KSUYs1yyMckhzmtCq2IFvhloI9/VCC3NXYdXDEmgRtaeD36uxJ8NB6t1KKk2c3oTRtN796NRwVbscSq",
-	"XtCNoSjCq9UMJBFzsyAPjgRdSd4HkB0xhmcnSVaM68+ftOmw/nVFN13wrmTFM6ohjwDUknJFM/MGQpkz",
-	"VRZ0i6hd0c2XZ2MHuCK0KEgJPGd8QfSGq76lmLmPthAOmwSir5ZAzBNS0gVEeJ6SnxUgJeFTLW6AB+og",
-	"sy0+KiWsmahU+KhnHTh1YiERHUhR8RSjIvjAobmHR9lvj8mgfsIR3+1+ptjCPWpDfckWV9sSyJwV5r4k",
-	"f6+UDgRcKdz2JRBVQmZ4b07MMAb5ii041ZWEp9f8xPxFJuRSU55TmZtfVvanF1Wh2SVbmJ8K+9NzsWDZ",
-	"JVv07ECANXVOFX62sv+Y8dJHVW+Sd8lzIW6qMl5QFp8FQysXz/oow47ZTxppBnke5AbcHzfW1ebiWR9L",
-	"3f2F3oSN7AGyF3clNS/ewFaCgZZmc/xnM0fSonP5+8iKF+ZrXc5TqDXk79g1ClTnVn46r4WIn9xj8zQT",
-	"XIO9CiMx4xSZ7dO3seQkRQlSMzsoLctJITJaTJSmGkf6Zwnz0dPRP53Wgt6p/VydRpM/N19d4kfmMpZg",
-	"GN+EluUBY7wywiOKWj0H3fAhe9TnQpLbJcuWRC+ZIozbTUS5y3CaAtaU6+nooJP8LuYOrx0Q9VbYS9Ju",
-	"RYsB9e4FsS/OQCHtO6H3gWpIiohxghgnlOdkUYhZ+OGT87KskYvPz8vSompM2JwAw/scNkxp9RAxQ+tD",
-	"Fs9z8WxKvovHvmVFQQQvtmQG7t6B3Ixp+bbj404AN4jFNdQjPlAEd1rIqdk1jwYjlx2DGFGqXIrCXIF7",
-	"yci8/L17N6ZA8/ugj//01BejvZ/uUKJ3SEVqsr/Uihv5pEVUXZrCLww1nbe/vRtFmVF20JK6qBF8bLrC",
-	"X5iGldpLJBFEEaG57aFS0q2XoCYoCXUp6GcFlnhKumAcoR0bgZyTFb2x+yEQ74YQQAVJ25KZFa9umV7W",
-	"IldA/bSjX/y5CTm158RsOGVGNiYFU9oIQ7iZiiyhQIGTBsNCTEV3IpoBtLBjEQHmW0lLS+buiZXjGCc0",
-	"6F8W1nve5AMv2STMsdmixjtCdWdmvpfhJiGxBocmDF8VIrv5nqrlEQ7/zI/VPRY4DVkCzUGSJVXLxJlq",
-	"0XY92hD6Ni8izZJZNNU0LPG5WKgjLLEQh3C1svyaFoWZusvNWqvFgQcd5KIg5mUCK6aNAsw4noAFWwO3",
-	"rGdKvqHZ0ggTJKNFMa7tEqKcFLCGgghJGOcgx0Qvqa4PP47sFSU8RwoMH9RAotU4m8aUXC1BwlxIVFQl",
-	"kBXFy2ll1KOyaH4TmKuiK2jJTnhZikobGCPN5eKZXx2sgSNPCkMj+GGNqPDHg0/N3O4RzsyFXRyVgIYW",
-	"xrOiymv8BX7RANq8XV+1vJ5CyBwNPVSb35gkmZB2CHv5u8nNf4DK+mNLnZ+UEiZuCEnXIBUtzOpai3oY",
-	"yPdYp3PPycypptHJdFSY1ugs58DvUCgEmbBuvMT/0IKYx0bAMZRUUw9DOQVlmrAfeGcbVNmZzAuGb2lB",
-	"VtZuRkqa3RwE5df15Gk2M+jkfWNNdW4L3SLCDl1tWK6OtU04WN9eNU+Itfl4dtQRU3YynWiuIQi4EiWx",
-	"7KMFguUUOJpFiNgc/Vr7SmxSMH0lNp0rTWzgKDthxhnM7L8Sm2cOMiH3Yx7HHoJ0s0BOV6Dwdmu4Qcws",
-	"tan6fCbk3aSJjmuiNsATakaNhKlxC0n4alVO3NlMmMftC62BSDAv7RYC2sOnMNbAwqWm7wELyox6DCw0",
-	"Bzo2FsSqZAUcgfSXSSFuRhV8+phcfn/+2aPHvz7+7HNDkqUUC0lXZLbVoMgnzs5HlN4W8DCpOKF0kR79",
-	"8yfeIdIcNzWOEpXMYEXL7lDW0WIVY/saMe91sdZEM646ADiII4K52izayU/2u3fj0TOYVYtL0Noowa+k",
-	"mB+dG3ZmSEGHL70qpREsVNMp5aSl09y8cgobLelpiW8Cz63rzayDKaMDrmZHIaq+jc/rWXLiMJrD3kNx",
-	"6DbV02zjrZJbWR3D8gFSCpm8gksptMhEMTFyHhMJ28Ur9wZxb/jtKtu/W2jJLVXEzI0OsIrnPSYKveHD",
-	"7y879NWG17jZeYPZ9SZW5+Ydsi9N5NdaSAlyojecIHU2LCdzKVaEkhw/RFnjO9BW/mIruNR0Vb6cz49j",
-	"IxU4UMLEw1agzEzEvmGkHwWZ4Lnaa83x3sAWMt1UQ3DWxpb3Zel+qByaLrc8QzPSMc5yv/XLufqI2vIs",
-	"MoUZGAvIFw1afa8mrz5MWSgeqASkBlPP8TF6BJ5Boem3Ql7V4u53UlTl0dl5e86hy6FuMc7nkJtvvUWZ",
-	"8UUBDUl9YWCfptb4URb0dTA62DUg9Eisz9liqSP98pUU7+EOTc6SAhQfWONSYb7pmph+FLlhPrpSRxA9",
-	"68FqjmjoNuaDdCYqTSjhIgfc/EqlhdKeqB1zULNKSuA6lnPRnsEUmYGhroxWZrVVSbRI3S/1hxOa2RM6",
-	"QdSonjCHEKph37LTLekaCC0k0HxLZgCciJlZdB3lgIukipRGdnZinROJh/LbBrClFBkoBfnE2bP3wuvf",
-	"s/eP3oE8XA2uIsxClCBzKt/PCm7We4G/ge1kTYvKiOc//KIe/lEWoYWmxZ4twHdSG9E233WXcg+YdhFx",
-	"G6KYlK210J4EI2IbplOAhj5k3x97vdvfBrNDBO8JgWuQGFHzXo+Wn+Q9EGWA/z0frPeyhKqcGDGw1/xg",
-	"JFez35xy4WXDPTOECQqq9GTflWJeathNzFIjLp66RXDgHnnyOVUaxUDCeI72W3sV4jxWtjRTjA4MKsMp",
-	"e7UxM+kvXhHrTpuZ652rSgWtTFVlKaSGPLU89Fn3zvUjbMJcYh6NHVQ/LUilYN/IfQiMxnd4dIYA/IPq",
-	"4KF2Pu/u4jDqwIgv20Ox3ICvxtEuGC/9WxHi46DaHhiZqvfAkhtTLXqbCVEARZOp0qIsDYfSk4qH7/ow",
-	"eGnfPtc/1+92SdK6gaykkgtQ6GJy7zvIby3SFfq6llQRB4ePT0CDlw2R68JsjvVEMZ7BZNd5QSXYvBUf",
-	"nDsd96pcSJrDJIeCbhPRFvYxsY8PJAw/NhJIbT8QGiYz9CamaaQ+Ez7e9G6zCpxKpQRvgk9IZs65UaNq",
-	"UnNf333SHHDaFN90xPogzIJgJOnAj4fIsvSUGBHv/rXQhqwc0eFq3K10z7X0YC/M+l4QiONOakNAe/b/",
-	"AuXmDgLYUeffgupbeD31sZbdY/7Hu71xYbaustZtk7wievnyHsbYx4N6fBGvqNQsYyWqqz/A9ujae3uC",
-	"ZKwEyUFTVkBOogdWky/j74kNQ26PeTdtfpC5tQt+x96aWI6PzGoCfwNbNJu8shkNkbXqGOaIxKjmwqWc",
-	"IKA+at5oPPErsKGZLrZGsNVL2JJbkEBUNbNRK10XmhblJB4gnTPVP6NzyCfd4TsjBC5xqGh5qchDq23t",
-	"hu+qpXI10OG0rFKIImH/bJ/4DjKSEAwKFyKlMLvOaFFsiQ5pM56SGkC6CwKjMYI880A10IwrIP8lKpJR",
-	"jhpupSEIaUKi5IPCspnBiJthTheqWmMICliB1ebxyclJe+EnJ27PmSJzuLUhNxxfbKPj5ARNca+E0o3D",
-	"dQRrtzluF4lLB32V5pJ1Wlubp+wPcnMjD9nJV63Bg4PTnCmlHOGa5d+bAbRO5mbI2mMaGRbgh+MOct81",
-	"Q8I668Z9v2SrqqD6GI5KWNNiItYgJcthLyd3EzPBv1nT4mX47N14BBvIDI1mMMkwS3DgWHBlvrGJhWYc",
-	"xpk5wDZxZChAcGG/urQf7dG067hltlpBzqiGYktKCRnYLDkjpaqw1CmxKRPZkvIFakBSVAsX6mzHQYZf",
-	"KWsJkxXvDHGoKKY3fIIuDJVMU0O3pc+2NEIYUKPZtv0fVlm7pQEUexkNurSj7Wn7g5Iu0/GoV/E3+F7X",
-	"ir/FWzNl9K7OxIZ8GCGthmag9wzxaWSlLhLjbTSHzxDD+/HS1EOnoOxOHAWF1w/74sIvq7IstkcQkuxA",
-	"REIpQeGVFpsBlX0q5uQFy6Q4LxYi3HlqqzSsus4b++mvPcf1p7towIIXjMNkJTgkVPqX+PQFPhxsdrTX",
-	"cM+IKBAdNGBb8WkgobWA5uRDSPq+m4Qk0z77bU+n+lbIY3nZ7YCDdYoBnuu9YR1uyrv612lRJFzS1vzQ",
-	"4SJqHILCmSRUKZExFBQvcjV20efWi23D2lvofxVSo45wgNvjtnyvURqWNeRDURJKsoKhmV9wpWWV6WtO",
-	"0dIXLTURLOiNA/1m4a/9K2k7dMJM7Ia65hQDRYP9LxkYNIeEHepbAG8dVtViAUq3FKw5wDV3bzFOKs40",
-	"zrUyx2Viz0sJEiP2pvbNFd2SuaEJLcjvIAWZVbqpcqwqpYnSrCicI9hMQ8T8mlNNCqBKkxeMX21wOB9H",
-	"4o8sB30r5E3AwnQ441oAB8XUJB3p+J19ikklDidLl2CCuRb2sY94rmtDjMzaG0Ur/v9P/u3p6/PJf9PJ",
-	"72eTL/7l9M3bJ+8ennR+fPzuyy//T/OnT999+fDf/jm1fR72VDK4g/zimdPRL56hIhblibRh/yM4ZFaM",
-	"T5JEGQcUtWiRfIL1MhzBPWza/fQSrrnecEN4a1qw3PCio5FP+5rqHGh7xFpU1ti4lhnPI+BAdegerIok",
-	"OFWLv74Xea49wc6Am3jLWzkGjjOqowPoBk7B1Z4zFVb74LtvrsipIwT1AInFDR2VFkhoMC6DsRHlY3Yp",
-	"Tuy65tf8GcxRHxT86TXPqaan9jSdVgrkV7SgPIPpQpCnPinyGdX0mneuod4CUlFSc1RBKsUp6Cq9luvr",
-	"17RYiOvrN504hK5s5aaKuag7Z10zmZ9yYuQGUemJK+IykXBLZcoX4kt8uGxo/HonHFYmEZU1YvkiMW78",
-	"6VAoy1K1iz10UVSWhUFRRKrK1Ssw20qUFiFxzDBzl3traOBH4YJKJL31Km+lQJHfVrR8zbh+QybX1dnZ",
-	"p5iCV5c4+M3xQEO32xIGK769xSja+i4u3MrlGFQ+Keki5TO5vn6tgZZIIShwrFDTLAqCnzXSA30mAA5V",
-	"LyDkIh+wJRayg/N6cbmX9itf1iu9KHyEm9rMnb7XDkZZ8XfewD2Z9bTSy4nhCMlVKXMM/F75AgN0Ya4c",
-	"H0Gg2AIVALUUlVkykGwJ2Y2rbAWrUm/Hjc99oIu7iz3DYQptRi45cM4M/jLKzYBVmVMnyFC+bZe4UTYZ",
-	"Agf9CW5geyXs59OB1cGianRRiRXVd3SRdqO71pBvfJDdGO3Nd3FXPkfUlSPBvEtPFk8DXfhv+o+2FQCO",
-	"cKxTRNGo89GHCCoTiLDE34OCOyzUjHcv0k8tj/EMuGZrmEDBFmxWJNj0f3T9Gh5WQ5USMmBrn9UbBlSE",
-	"zYnRjmb2OnYak6R8AeZSNxexULTAoP1p0tGP0uESqNQzoHqnvZbHZSY8dCiQ32LSNBpNxmYJsDH7zTQa",
-	"QTjcGgUPdW/7jgsknt4pnMquCfI7guo/r5Okp3dRIhzCE/Xs/H0f9iToCy4+LaZOBNk+XxkcLqS4Nbtp",
-	"ABS+dCMWeInuqUrRBQy9jhquooElMRoeIBxkn/STlHfEvC3WdGSMgYuwn08MXpLcAcwTwx7QDdAKcfRz",
-	"Wxei8yq85MXWI3VWoEAdAkQt6VDZ8LPxxWHAptkYSF4Lqx6wJtbio7+kyh/9fBxx9DtKix+nlMyu+nkX",
-	"UfQd1d3qeP6abrP2sbXnzIAIbr7wVfR86TxfL280Pqj23XjkUhxSeyc4StE5FLCwOLEvezqr6zPVu2ng",
-	"eDmfI9ObpAL5ImNkJJm4OcAoYieEWIs5GTxC6hREYKNnHQcmP4r4sPPFIUByV1+K+rHx7or+hnSyoI3G",
-	"N1KyKM2tz3q8VplnKa68RS3ytEKccRjC+JgYTrqmheGkLvG0HqRTqw11n1ZlNhfb8bBPJxp40NwaUTo5",
-	"aJVWnrnL+mLB2y8jrRUctIaZ2ExsZnRStZptZuZMJPMVME87dXht5bwHiszEBmOK8IazAe4HQ9cPmQcs",
-	"CgPZMIVUjt/1iY0WvMMA2S3Ip6hZIek5u1oguz5J9m7A9IjTfWT3SVRC70ggtQyYdRlwZ9HZa2dpSltd",
-	"SaS+bsehOmxIU0uxmr7DmdzJHox2jafNWnff1+UO+4uj+bP6QYr8dY1y96nLaD8uba3FQ8oytsmhAcQO",
-	"rL5qC7FJtDYDl5p4jbCWYkmG0XedXV20KSgALQGThlw9uUm5pa+vXytAmeHSfxbZOXH3KN8+jKLhJCyY",
-	"0lA7F3yQy4f3/aA50ShbYt6/Ol3KuVnfT0IEQcO6Y/HDxjI/+AowdH3OpNIT9Mwkl2Be+lahJe1b82pa",
-	"EG7G2zFlXT0Hy8EI0Q1sJzkrqjQpO5B+eGYg+jHcXKqa4UXJuI02mmEp/GSA7gG+SYTHBnbvRNBzi6Dn",
-	"9EPgZ9jBMq8amKShvOb0f5Ij1uKFuzhLgpZTxNTd0F6U7uC1US59l9FGQnQUdjHd5fPpnMvcj703Gstn",
-	"9PcJEXak5FqiiojpBEKxWEDuK725pFBb9crV0ysEX9S1BM3vO8oHTomt4odF+HbU73Ph6dAXnN5oJ4Jd",
-	"MZLQx8oMQl5n12HtQZxkAdxWbhkd3m+kSCIuDozHNyLL6Ifl7Z2w+WTo8FUrXLiO6bV7GDYbt6cAmju1",
-	"SoFf3+5D290uh7pxX9Bxo0Ts7gOGAyLFMa0iAaZDND2cm5Ylyzctx58ddXoHkhgo7nUrwbdwhmzJDbYH",
-	"P83A4j29eh6Y2xHfd86OU1TzT42SaeOZXUSuORs0c9UG8kqiN6kRLdytpx8UzYFr/+GXSy0kXYDzCE4s",
-	"SPcaApdzCBqikvSKaGYDpHM2n0PsCVN38eI0gOv4O/IBhN1Dgl13WdAtd9Jnl8j20Fa9gv0ITdNTglL6",
-	"Yi6uuv5Ir3hEtrVw2UQbdwenYrKgwA+wnfxCi8poQkyqOjbVOQib1/oBNLFe/QBbHHlvyKcBbM+uoCnu",
-	"J0AKTXlXwiMVVQl/oBrdF1AHbmzhATt1nt6lI22Na6XRfzTqG6rRT6K5lPd3bOoQGQPpkL26TEedmLMF",
-	"zW1pE/q+LWL5ftknUkHiqRhGb9zlkguVNvZGlwEtPOHjYkfvxqP7xXuk7kk34p6deBWu5uQuYDSm9f83",
-	"gr4O3BBallKsaTFxcTJ9QocUayd04Os+rOYD61fpU3H1zfnzVw78d+NRVgCVk2Dq6F0Vvlf+aVZlW3Ds",
-	"voZsOXZn27WmsGjzQ8nsOJLmFkuvt6xpnV43ddxUdFBdZM08HSm+l2+6EC+7xB2hXlCGSK/aI20DvZrB",
-	"XXRNWeEdvx7aoVZ2u9xh3ZWSfCIe4N5BYlH0373H6s0TuL5+vfaYrf0pNlAqlMRPxNKpO0Y6d3hN+qzW",
-	"tL6HQ+I6X2Il07TexV2dU2SMLuCMHl0O/FbIxkXlshqTAWvvT0A0yoTFY9opf+W88B2xcEqsCPnb4jfD",
-	"G05O4oN/cjImvxXuQQQg/j5zv6MedXKSdAwnTX2GZaElj9MVPAx5Eb0b8WHNEBxuh4kL5+tVkJFFPxkG",
-	"CrWRZx7dtw57t5I5fObulxwKMD9Nh5gq4k236I6BGXKCLvuyEkPw88q281RE8HYOPmbJGtLCq8d18LB+",
-	"9u4R4tUK/c4TVbAsHfTDZ8qwJG5Des3LBF8e7EM2c1SsJ66cVywa3bym7uTybC0kmjWJcJWsBFzjdyYc",
-	"C6g4+0cFUVtfvIlbl7NXhXDUjoCdti+6gdtdg0d3afh7fxeht6rtMhjtdLk+C25Aj4hUn6kD8x3iGTvM",
-	"f0eugqMof31iYtvShQ7vpaydet7uJtDODezZp/O49itIrh2m3cxnQ3aaqclcit8hLTugkzBRusN7txka",
-	"4H8HnopRbTOyEDlQN6yuZ99HIMNtC32kcm9bgl906Jp3lys8zScO2+gDjQbRfvebDVS6vLjbhD5FNQ48",
-	"aSbS9DAzPLBRWDj28vHhbpTbE2rrWjQyz9LnPE4UPbXj1+fcwdxJri3o7YymGh0ZfdHAFG1/IzBPC+I/",
-	"9hukQmkGOzuJchnCu8wW+ytB1t6jbqnkO+p+dtrBWl+t5CHFxerd2MaqFEokhqn4LeUYR4jfWQ7ovlZg",
-	"4zDMV7dCYoFPlY4hzCFjq6Qx/Pr6dZ51I79ytmC2pXilgNC5dnUe3UC2qbylItfNO9Qicai5mJOzcX1m",
-	"/W7kbM0UmxWAbzyyb8yowgs6xESET8zygOulwtcfD3h9WfFcQq6XyiJWCRL0cxQ9QyTsDPQtACdn+N6j",
-	"L8gnGDCs2Boepi8YJ6yNnj76YryrczZiHJvE72LyOXJ5n8iQpmyMqrZjGLbqRk1nJswlwO/Qf5/sOF/2",
-	"0yGnC990V9D+07WinBqEpGBa7YHJfov7i6EcLbxw650BpaXYEqbT84OmhmP1ZJMbhmjBIJlYrZheuUhR",
-	"JVaGwuo25HZSPxz21/Nt0Dxc/iGGYJcJHf8jqFt01ZPhiFH1P6K/PUbrmFBbsbVgdf6F71BLLnxlauwL",
-	"F9rBWdyYuczSUV7FdIw5KSXjGq1GlZ5P/mbUd0kzwxCnfeBOZp8/SfRXa7Yg4ocB/sHxLkGBXKdRL3vI",
-	"3ks57lvyCRd8sjIcJX9Yl3SITmVvrHg6vrcv7Lhn6HtL12bcSS8BVg0CpBE3vxcp8h0D3pM4w3oOotCD",
-	"V/bBabWSaYKhldmhn3967iSRlZCpThc1A3BSiQQtGawxvzS9SWbMe+6FLAbtwn2g/7jRbV4sjUQ3f7qT",
-	"ykLkVU7oaaGskpH0f3lR18dH57bN221ZL4VM2GmdxfEDh6UeZi9s+9BtOCA+68HcYLThKF2s9KR72HyO",
-	"8M3HiPdqg2T3vGEqffQbkUaPR1n/5ASBPjkZO1H5t8fNx5a9n5wMD5lN2wvNrwnU3O2uaVevNN+mtvor",
-	"kbDe+S6eIW7MlSpJWFiTd5m5UmdujDFptkr88HLHcfIVDw5DTh8gjxp83MbNR+avuJl1Bkw/f2h2j02S",
-	"Tx6eRzkUlHwlNkOJqHVteXr6A6CoByUDrYK4kk533GSkxN4wn4hszagzKITRVOMGWIOjVv5Eu2BQM96x",
-	"FxUr8l9qL3TrZpKUZ8tkUPnMfPirVQOiFyILRraknEOR/Npqy796rTqh9/9d9Ay7Yjz9qN2I2cLegrQG",
-	"qwmEn9KPb3DFdGEmiFHULMgVSpwUC5ETnKfuXFKzxm5H81Qn2USOPw67qrSLSsbiCa6hyJwVGEab9ofj",
-	"mxNJdQ9Xxbb/vsWVGQe78CtrlrCjgySUrfDaVnRVFoCHcA2SLvBTwaH1OVZsw5GjtiREleYRvonFXwTR",
-	"leREzOfRMoBrJqHYjklJlbKDnJllwQbnHj19dHZ2NszJiPgasHaLV7/wl/XiHp3iK/aJ6/xlGyYcBP5d",
-	"oH9XU90hm98lLtd+9R8VKJ1isfjAJmSjh9jc67b1amgTPCXfYX0yQ+iNFgFoFPUVlps1QauyEDQfY1Ho",
-	"q2/OnxM7q/1GAqIOW78u0ALYPCJJJ8/wGqm+/lpP7arh4+wunWNWrfQkNGVNVVI0b9S9ZFkr+gltgzF2",
-	"puSZNcuGwB47CcHS4nIFedQD1poBkDjMf7Sm2RLtndPRTpNyTzeg4S2MPQes3UVR3mtomIUc3CzDdTG2",
-	"TYzHROglyFumAOtOwBqaBRtDtVNnkPcFHJurlRXnlnCmB0ivoT3WobvggbOir4+vSELW2od7+/7qSh7Y",
-	"5PzQZs+X+FU6b6fVOboV92BbZmx8040peeGcHRnlgrMMm02kRHAsxTjMrTqgL0fa36lG7iwnjmGyX3VI",
-	"UHdY7O1g7VmmQ1w3qCF6avbbEo79U8PGNQFcgFaOB0I+9u3jnYOOcQWuAZqhr5ijCpkI/UqmxYQQkiOG",
-	"pI9HWE2tx9b6rXn2o7PNY82YG8bR5uaQ6jRB62ArFEM/OydMk4UA5VbbzAtTr80306sNRxDeTJ+LBcsu",
-	"2QLHsKGIBik2Crg71LmPCXYxuObdr827rndB+LkRUmcn9et+k2QhKux/qud6L/pTsV8+kCZCbhg/Hm0H",
-	"Me4M9cd72ZAhrDHyD0q8zztkE9rXN0f5xqislt7wDWIzd5NlgxlPgPGcce/wTdfBypJ3CW4Mnuae71Qm",
-	"qbZKxyCOdwW06EmHwaR6GzFw36HanRgMSnCNfo7+baw77/ewlfBCrV1QviX+UBjqjoSSr2kRguETffRR",
-	"OnPCmA0WbnXWT7EVw9YnPjW3ga69iaDhc+yGcug91VdtdFblC9ATmuepunNf4VOCT31CIWwgq0ITsJBn",
-	"2izX3qU2N1EmuKpWO+byL9xzupwpqhSsZkUi9PZZeAh52GEsRDXb4r+pDlj9O+OC3g/O/vYR7vlhPQq6",
-	"2ewp6dnQ9ESxxWQ4JvBOuT866qnvRuj190eldJ/4/YfI625xuXiPUvztG3NxxGW6OzH+9moJVbQxnl7g",
-	"c18PLFRybXIlvMo6fd4wIgM3L7FlLeD9i0nA17ToqbgQe23s/Wo9GX11F7LesiJUu+p1mpKaJwwxYfTX",
-	"/7IR2C3PUNe92RdjbUOs36fzxOFjJ9L7PY0/NPyKNuqtZii9/sS7ufxqIjjU5+daMXTtpbQoRDaYM7hh",
-	"zs1H/aV6xWrlKt8novLWK5HHZyGO5gJIMzYbsJxIrUDFNvkMVavkE3mbHq1hHwlEM7RqGaLRLWFsEzM9",
-	"eB4YO3U8UWSydZgl37ICm0P9++XLH0f9GxntQHdLXenspAm7b2NCplqbPBaigY8dPEDwIm3/Vj0mdawN",
-	"lT4Nrjtx8sG31kA4BCRbJ+mQt58PHbxDAAthu0Kl+mZ0q9OM6u3wyI+ood5ey1Fi6khRRbvbUkL3sUbP",
-	"+hUSGpEOakzakJGGNHdK9RFymoK3wNqLxtWjs82VOn2ZOgz02RDhsIOPd+PRRX6Q+JTqRTWyo6QY7HO2",
-	"WOqvCpHdfA80B2n7iaTUSdtNZAVGDVVLVqL+UwrF6n7AhRnMFfJe4nDToak5V0twVWF8kYDOWD6Aeg2Z",
-	"xv7QdRioBBge51Cml2gg8A5FfOUjhIJIgBxKvdwpLNng7lIv67ah4DLPmCIzcK6LNfAxYVOYtpPV8roo",
-	"FCmAzr0RVgqhB/TVDWlLiMYY6BR9dXo07xYDOzXfopKGtpXudHgTlvOQE2ATLW+pqitHtcooDE7Xns8h",
-	"w4L3O8vv/ccSeFSPbexNdwjLPKrGx0K6ILZsOKpFu4Z1VyG8naBGPaneJ6R9BTFuYPtAkQYNJTsChwzb",
-	"u1SAR+RYP65vKtDn2nCBkUwFekIE+Th4V4C/7rF0lyYAUXXKO4LhadxcT3XFyrtB4yWaO4BhPp3eq2l/",
-	"XQ4PBdO+6n7d7ur9mvIzbGavXFApDeXmY3sSuei2Y7515eqx0GLwFvrC9aD8b75Aq52lYDeuQw0izPpm",
-	"b6nM/RtHKZNn702WBnoeZmZ1YlQ3yufQuByboZgVwghAk77E0GamUgjhfaBsrHVdtAyhnoOUkAefYCEU",
-	"TLTwaVYHFP906ZM7sGejzO+Et1ZE/wEpw3ZFvT0UfqobSWA7SIo9E6gLPo+xQiSsqIFeRs0d0mbQfTv0",
-	"tX3ua4r49n67zat9eA/nYn+HbJ96x1QH8/HpmhMnHBzMvRqFSO5gmWWcg5x4J267tQNvlsnEusp5lVlR",
-	"JT6bwXo9uOzYDm6WNGpm3VW2VKioKscNbE+t2cd3Hfc7HgNtZUgLelRQukUUR7VVqxTci6OA93HLd5ZC",
-	"FJMez+BFtx9F+zDcsOwGsDBryEwxUvCD5rExk5BP0CEVYkZul1vfbaEsgUP+cErIObfZgT58pNmBtDU5",
-	"f6B3zb/BWfPKdphxFujpNU+nWWGnF3lP7ueH2cHz+niTAsMv7zm/HeQOs+sN74uRu8WWMM0+wdOh5o1u",
-	"fEdLhIrIz0KREqAurSP4a2QJCT2KYHWWqIwQxgdQ4hzIRBUiFYV/lwoyZqg0puLJECANfIC6WkPhBk8i",
-	"wAXZ7anK6h77uqNiTiTUsRl3LcDqappaJq76TCPtmcMsTc44FxLiGTHO1BZqDpltWOcY/zNjWlK5vUuZ",
-	"1CaqUmaoXizvjZYMgZL1QupgyS4Oi0LcTpCtTUJ3pZQ5wLynmte271Naf2eO+gyisEuqnIi4JUuak0xI",
-	"CVn8RTrF20K1EhImhcAozFRgx1wbJWGFeZ2cFGJBRJmJHGwjtDQF9c1VcU5R9oIolC2JAks7WDLAfhPR",
-	"8cApze1r3bMTlNf2Ntrwm39lvrHlK+ryd3bRExsi0JNfAMqVu3MYsi934UXCsRWZ2kbZtIg8ZxukG5Cp",
-	"Iz8nWlYwJu6Ndhd+d/CpBLJiSllQAi3dsqLA6hFsEwU0hHigNGp7ZOcLjINeMwx4a1YSsSJ1aW7HUH4l",
-	"5gGXcUU2opdSVItl1B8gwOlVd1k5xT4e5WdVYUwipoiaKZ6QlVDaqcV2pHrJdQjoJ5ngWoqiaBryrJy/",
-	"cE7fF3RznmX6uRA3M5rdPEQlnAsdVpqPfUmFduxuPZNs1WAcpinoDZ8geaj9ZdbtexjV6uh5MO9scb+O",
-	"42GfJT8C881+5rrfr3HeXVh7XU0+m9aFzjmhWqxYlj5uf67o196Y1RT3SlZatF2IbRUafA35QHyPhXAm",
-	"5J5dNAOnyTaq58TxCBfWgZzI/BfF+Pa4ZA6OB/XcoV2+4wSsSdYrBrYAQEhtIQRdSdu6OBbSAsMRC1s4",
-	"BYNS2oAOvHAw9u9+sJkRjg6UhnsB1YlGDgB+Yi0YY1sR00Y2z8TGP39Yl8y8E/DvdlN5g3n0BVVe1qQl",
-	"bVilL2TVwxHSDQh2RiBeYRGM2dA4xNCKfuDlHwHQH5nYgGFQfOKhYMwpKyCfpLoUXwQb2DhS112OZTS6",
-	"7+doOXlGK98J2IxdSXCFlaz0L5vuxJIaUhLh9a5FnOewAZuj9TtIYfv4jiN3FhS2zW/LoiDKSQFraARs",
-	"umpPFUqhbA3+WxU+JjlAiR7ftqEtFYkYdwlsWV/c2idRLNsQ7CbNMRaxdqfIHltL0jK04RN7TNTQo2Qg",
-	"WrO8og38qUNFjqYt0RzlBKo66sPEq5hDp/nZjvCTH+Dcf58SZTwm3gzjQwezoDTqdjGgvZHJleo79Twd",
-	"mByXMguOIpwtD35tS+I131AlveX9Vs0uydea2MB9YoJHiP1mAxlKNU4VgtwpQz2eE1cDCamdA+RWYTCf",
-	"JKz5S+CEi6jn8S1VQYupq7r6H+zE+BLjTtG+g4++jh++/84SHIyoVrHFdIvSQNb3s/F/lJO48yD2jpei",
-	"EQUulXeHacxTt1M78AVRFTnhZj+N7I89gt0t5rj4mMwqP1BRiFvbxDhWUZ+B9+da6vMuJieWs3At+zjp",
-	"sSs43LaCsChDZEW3REj8xyik/6howeZb5DMWfP8ZUUtqSMg5kG0UhYu7NhPvFq/GHjBviBF+KrtuNnTM",
-	"aLitGSUC2lzkvm2bICt6A/E2YICI5Z+ZNoxTVTM0apgru7WdXSy4xfvyTCuax0YALDS7bXAHX/DcfP3/",
-	"1mmr8VS+/mNZ0My3rHbN55p8Brvae+LSS1jtTnPu8jVPAqFTfk200pfJyO9gTT2QdaVyfvqaYzXA7rQA",
-	"7/QFu9cyBhqFWz2OdiSID1rKsXfhODmcnSXFrX73LS7ufPxhdidZIbpvGUPA/wPtSiO8opPZlu6gHq/H",
-	"Nkv/ALvQKMSTgNWawWdiM5EwV/sCaawdfCY2NcAq2G4ZzyRQZeOOLl46tbUugMy4UaNt1G5wq4ZRcpgz",
-	"XrNaxstKJ7QgrIPMtxHCYm8CorXHN9cnYxhRdE2Ll2uQkuV9G2dOj20NHDfp8R4U923CABJu5O4ATNUa",
-	"IOZT1/b5+DVz/dsGgzZ2VmnKcyrz+HXGSQbSSA3klm7V3V1Vweuwz1lFI1moWS0kclshaVtAiq3zNt/T",
-	"kRQApEf0KA3wBGGQdsILZA1DWvQ4frow/Ck8QSu6mRRigVm/PQfC1blG16FVIAVHI7qV7oat28+j2O+w",
-	"expsReIYkRY465Apdp/7l7iVqIT+zJneefKthbOdhm0jne3B9Ejlizo9wxJL9zymMuddYaY4e96Lqr5M",
-	"iac9iDYxGRLdsar37CLGV7iyC7EJfXizymYIRyo/39oVJmhvUDsSMEDVeQU0cxFiXUNcx1BhkTJ21Q0O",
-	"tNNZ676/l3rAQ0OKcme9OW0I0DHjHNLhc3c9g0kpykk2JLbVdivKnZPBQdqEsYc+IhdCz7pD3I0K/bsa",
-	"NdEajbwObXLa20hsn6+szHaZDPqMTD0cvenAEHPkZXiErWkNc62CKWbslXPv7G4a0QKTIJRIyCqJRuZb",
-	"ut3f+LGn+vzl9+efPXr86+PPPifmBZKzBai6p0GrcWIdmsh422r0YYMRO8vT6U3w1UIs4rz30qe9hU1x",
-	"Z81yW1UXI+60jTzEOp24AFLJud0WeXfaKxynTov4Y21XapFH37EUCt7/nklRFOmeMkGuSrhfUrsVOWCM",
-	"BlKCVExpwwib/lOm66BstUTjIlYNX9vaUIJn4K3PjgqY7onlSi2kL6YX+RnWYnA+JwKbsnC8yvqJdq3L",
-	"6WnWvodCI4bbzICUonSiPZuTFESYsyUrCHZ1ZzZFe3oUphuYrQ3YTRGiC35Pk945d5qwmJPd3L7Zilun",
-	"Ob3ZxIR44Q/lHUizz7vRX2fkLpykdgz8YfhHonDK0bhGWO774BVJ/WBHVvh5J2oiFA0ZBFq3QEaCPBCA",
-	"nnzoRtJqlGQX1SaX1seA3gjvfm6LHy9qt/TezBSExH+wB7w4l7l+LyRTOHA+cmHvFwEp0VLe9FFCY/n7",
-	"0qM96w0XSbRFzmiiNSjLlkRXLIwS4tXXIc+8RyvppKNLITQxmmlRJNLYrR0Hz1RMOEYlkGtafHiu8S2T",
-	"Sp8jPiD/qT9xK05bjpFsUamOXpDzOR0EVpSi/EGg4q8wt/4/wOxs8nZ0szjHf+cORJMQLWy09zx4wIGT",
-	"WxzTBnY9+pzMXLufUkLGVDug4NaLNCHfFiSbu/ha2Oh27u+92wT9IvQ9jsPcxwORHyMnW4gccDDXR/0j",
-	"M6ceDpA8LSlS7RBKAn8pXhc3Vd9z7dyzNczdSjlFhRsPLOXUbRc/dHm4Dry8KgXddQ6+9Ru4TVz49dqG",
-	"1iob3GHm+vq1ng0pKJbuBmM+xxpnR2kLc/+mMB+kwJlFpRvDQZIkrFrk3le9phUvGdVpaO6iEfd7Gsgv",
-	"LfrNaKgUzCtuxwsNUDFX3LN1MR+HKAbBzWdPyTU/IWpJvW7h/nz82eej8Qh4tTKLr5+PxiP39E1KU8s3",
-	"ybzSupBOJ0bUdRN4oEhJt0N7yPXXzUkity4T9OHlGaXZLK3QfW82DLVWl31wwZHPI2+x16crnvO/t/rP",
-	"wRXEwlmxxFgXBgr7sK9G0C99BfFt0feePh8tvluxYm94XKMFy7vxaGHLk2Ffkl9dl7oPu+cegp5KgW7p",
-	"9ykAZhGTWGtj8miqqJzbgFYs7rNEbwzMuc4qyfT20uDfG9zZrzepMlDfhcJMrtpX8L07qVeLG+A+uqwu",
-	"41QpL1d/J2iBcqcNCeBG2hTFlHxje4O4C/HLB7N/hU//9iQ/+/TRv87+dvbZWQZPPvvi7Ix+8YQ++uLT",
-	"R/D4b589OYNH88+/mD3OHz95PHvy+Mnnn32Rffrk0ezJ51/86wND6QZkC6jv+fN09J+T82IhJuevLiZX",
-	"BtgaJ7RkP4DZG7StzbE0ISI1w8sVVpQVo6f+p//PX5HTTKzq4f2vI9cJcrTUulRPT09vb2+n8SenC6x+",
-	"MtGiypanfh6sYtnQVF5dhIwgG/WHO1p7m3BTQ2U/8+ynby6vyPmri2lNMKOno7Pp2fQRVlIsgdOSjZ6O",
-	"PsWf8PQscd9PsX72qXJteE5D0ui7cedZWdomPebRIhQANX8tgRbIH80fK9CSZf6RBJpv3f/VLV0sQE4x",
-	"V8z+tH586rWO07euosy7Xc9O4zi007eNsjz5ni99JNW+V07f+kb/uwdsNHF3Ea4GqckQie9AuwJ61laZ",
-	"qHCEnkk3+pgo7LhjfiolE+a8jo2gkAPGEWEwrcTOIlpWPLPBJXYK4PjfF+f/iQE2L87/k3xJzsYuvUmh",
-	"ASQ1vS0SEQjtIrdgd6Oi1Vfb81ADqg7GGT19nTJKu9D0spoVLDP6w9SfVEOG0UEKQ9aMEl0QI3tRYGRA",
-	"YPuGlZ9Nvnjz9rO/vUvGonfD0up4zp1PO7JlwG9UM6kRYCJ8C3fE94puvuzD9salyphx/1GB3NbLX1Fz",
-	"S9dLHagJJH9NFMv0OY+3rlt6HG4dBWL/++XLH4mQxJn0XtHsJuR7+tzfOt85Tv01X/atzd3e8fK8RO0S",
-	"R1dqUTa7CAQLwBtsB42AIs96fHbmGbUzd0Rc4NQxl2imllzcpWaMHYy8J93CH4rAhma62BKqouAtDMT2",
-	"rdtbWbminDRyg3b6a7ozui1JJlYdWnsk0QRHaFrsge+q1ea6gQ6nsZTmJt8vi3aQkYQgWeg23lpPI3/t",
-	"7v+M3e2KPqQU5kwzTDWp7zV/ZzaAdAJvsfXg9hRgmpL/EhUKqEb1qDQEFigksrNwK1tHrZvT1Z+LVLQ6",
-	"GxKfnJy0F35yUsciz+EWmSzl+GIbHScnU7NTTw5kZTudZ41eBIPOziHDdTbrBd0EEwolXPAJhwXVbA0k",
-	"sk48OXv0p13hBbepN0Yit5rDu/Hosz/xll1wIzzRguCbdjWf/mlXcwlyzTIgV7AqhaSSFVvyMw+5TVaz",
-	"Qvmky/5+5jdc3HKPCKMUV6sVlVsnqdPAcyoeNSHcyX86ld9qaR65KF0oDNBDOdgKzr7iLV+M3rzzisZA",
-	"BWfXa6cz7NQ99FWItaJ+FQjtk+r0LXr1en8/dUa+9EN0vFoN/dRbLnvetNUH0w8bqtdbvTEL2T2ceSca",
-	"L6M6W1bl6Vv8Dyrb0Yps559TveGnGKh++raBCPe4g4jm7/Xn8RvYsMIDJ+Zzhcrirsenb+2/0USwKUEy",
-	"cx1hUWT3q62Df6qqsiy23Z+3PEv+2F1Ho9x3z8+n3taT0tubb75t/NmkKbWsdC5uo1nQP2pDArqQmYeV",
-	"av99ekuZNkKSqxdN5xpk92MNtDh1PQlbv9aNfjpPsHtR9GNLrCqFLZnWVJt/ordXjfR2aev/fCXQGtLH",
-	"cDeTGePIhWIuWds+7cOuitThjdg5fqvrcJOEDKoFmUlB84wqbf6oG4w09e9399S/2uWKLhIRAwgm2jS6",
-	"3hHDT6Z7PcM47hAhM9oXcvHMT1jn1L53wawD0Vc0J77G3oS8oIXZcMjJuRP/G9h430LVx5eCPrLY8sHk",
-	"jK/84VOEYsHRhoIo03XAog68Q4QKo0UaBrAAPnEsaDIT+db1Oh1Jeqs3tuxQm7md0uaN0bR2UklXqu/h",
-	"EUyhf2z75z6z518mw79Mhn8Zlf4yGf61u3+ZDI9uMvzLoPaXQe1/pUHtECtaSiB1hqJ+uZStgduEm5aG",
-	"SOsmQ4HFN0snMh2kt0YWPPYzYnpKyBXWvaLmloA1SFqQjCorXbkabSsMM8cCjJA/veaTBiQ2dNtM/En9",
-	"Xxtgf12dnX0K5Oxh+xulWVHEvLn7LUrG+Mimx31JrkfXo85IElZiDbkNUYs7TNiv9g77/4RxX3Za2WAR",
-	"Dyws5us0ElXN5yxjFuWF4AtCF6LOG8FC1VzgE5AGOBvYSJgeuzw75oo72F1pNcJoyvhdCeCi3sK9EQ4t",
-	"cknHNhjCOzCw4V9SJo+/pPSBzG5XKb/7MtKdY3e46l9c5UNwlY/OV/7s7tzICPk/Usx8cvbkT7ug2GT9",
-	"o9DkW5/1dg9xzFVJzpKtFu8qaPk6V94wWMdJx3HHeIuGiOPXb8xFoECu/QVbh9E+PT3FsolLofQpGqma",
-	"IbbxwzcB5rf+diolWxto3qEdVEi2YJwWExeHOqlDZR9Pz0bv/m8AAAD//3JaYsuVJgEA",
+	"H4sIAAAAAAACA+19aZfbRpLgX8HWzHuyNASrdLnb2uc3W7Z8aCxZeirZPb2W1gaJJIkWCKCRYB3W6L9v",
+	"HHkByARBFlWyZ/TFVhF5REZGRkbG+e5oXq6rshBFI48evTuqkjpZi0bU9FeSprWQ9M9UyHmdVU1WFkeP",
+	"jk6LKJnPy03RRNVmlmfz6K24mh5NjjL8WiXNCv5dwEjwlx5kclSLf26yWqRHj5p6IyZHcr4S64SnbWBO",
+	"7PvLafx/T+Iv3rx7+Nf30KW5qnAM2dRZsYS/L+NlGasfZ4nM5nJ6qsZ/v+1rUlUAaYJLiLPUvyjbJMpS",
+	"QEq2yEQdWlh7vKH1rbMiW2/WR49OzJKyohFLUQfWVFVPilRchhblfE6kFE1wPfhxxEr0GAddAw46uIpW",
+	"A0DkfFWVMKRnJRF9jfizdwlO96FFLMp6nTTd9g75Ee3dndw9ef8vhhTvTh7e9xNjki/LOinS2Iz7tRk3",
+	"OuN273doqL92EfB1WSyy5QYoObpYiWYl6gj+E8HfcHaliMrZP8QcNlpG/3H2/MeorKNnQPTJUrxI5m8j",
+	"UczLVKTT6MkiKko4snV5DjSRTqJULJJN3sioKamnoY9/bkR9ZbGr4HIxKQqkhV+O/iEBwsnRWi4rmOvo",
+	"TRdN72FZebbOPKt6llwiRUUw0gxWVC5wQRqcWjSbuggBxCO68AyS5AZ+/vxBlw7tr+vksg/eq3pTAJmI",
+	"1AGwgU2UyRxbEJRpJqs8uSLUwiBfnkwU4DJK8jyqRJECEqLmspChpeDcB1tIIS49iH4FtIJfogpIwsHz",
+	"NPoJiKfRX5vyrSgMdUSzK/pU1eI8KzfSdAqsg6b2LMShgxpuDB+jiuiDQnOAR3HfQzKolzTi++FvMluq",
+	"T12oz7LlK/gQLbIc78voHxvZGALeSNp2QJ+sxBx5bxrhMIh8GLJIgEbEo9fFHfwrioEFAHNI6hR/WfNP",
+	"z2CgDCbBn3L+6Wm5zObwU2AHDKy+cyqp25r/h+P5j2pz6b1Lnpbl203lLmjungWklSePQ5TBY4ZJw88g",
+	"T43cQPujxnp1+eRxiKUO9wAo9EYGgAzirkqwIYg4tUBok/mC/ne5INJKFvXvRyxeYO+mWvhQi+Sv2DUJ",
+	"VKcsP51aIeKl+oxf5yVQLl+FjphxTMwWfnMkp7qsRN1kPCi0jfNynuSxbIBz4U//WosFwPEvx1bQO+bu",
+	"8tiZ/Cn2OqNOeBnXAhlfDOPtMMYLFB5J1AocdORDfNRhz+Amy+BOb1Zwa2UFbyLJXchpcnGeFM30aKeT",
+	"/N7lDr8oIOxW8CXJW9FhQMG9iLjhDC5epH0l9N6SLUmRMB4RxiMgyGiZlzPzw2cwqkUufYdfGFWTKFtE",
+	"IqP7XFxmspG3CTOJPWTuPHDCou/csS8yuGPKIr+KZkLdO8BnYEzm24qPKwEcEUtrsCPCOminS2C6gBSN",
+	"BpTLDkGMJFWuyhyvwK1khI2/V21dCsTfR3X+01Ofi/Yw3ZFEr5BK1MS/2Idb9FmHqPo0RT2Qmk67ffej",
+	"KBxlgJbkE4vgQ9MV/ZI1Yi23EokDkUNoanuSugYmrySomCShPgWBtMTEA3JUVhC0ExTIC5D93vJ+lIR3",
+	"JAQhjaTNZMbi1QXsjBW5DOqnvffFn5uQfXse4YYnGcrGUQ6EicIQbaaMViIngTMxigWXir6HxmV9dQja",
+	"CWk0EKearMuFe+i8O5Os8VN/mNevf0G55PXrN7DdDTBq+3R4ls3r8hQ+4j65ExzZd5+W5Hv7ZaaMkX7K",
+	"TROrp0VciwuQGz0r0oKnOqPUexCOSaTG5sOuni5q/OlIKLeSrDNhdJFIuDzhWKQRCJfJroSKwhYI0tK7",
+	"DcDEcBdSOANLPhHcuLO7wLYsQlDUfr5Y5FkhQNrOAAH4/kMEJo3mdOU8ozehXgOcMzUHvLBxgOh5QQOM",
+	"HmGDXAUwAbyg0dA5YFdlmfPA0Y8l3nJNNs/gbYSbswOQhboSEj02sI6idP4WHkLvsAKrylP0v5UqJ+bd",
+	"prZqBz7SOfWWe+AiQQhKijm9pyzPABKC9VQJPsRwWpeHvKjLcnEIDqIOrZfElRaENS64QWo7NbS1mJd1",
+	"6mEw5mjNrhrR0kj9v8/+/RFqopL495P4i387fvPuwfvbd3o/3nv/5Zf/1f7p/vsvb//7v3q510G54B+d",
+	"JVW48/61ymyWoxChF1uU0AbkH54ugZt6UZdr1rWVZdPBiYzWon6bw/VeZ3Bky4sCVUL9/Z5EcA+LHO83",
+	"+ge9k7XIsovsQjT89SrLU5/k0v0bIQ5x4uG13DxFbr02hjCvZFNoAnxuUdbXk3fsrdxldwNcjmlM4Xyy",
+	"u8zU4k5+Tmd5h8vwADUZoIPmd9ndXpxuBAkOrMGAf1EnFcOuvrDqC452YlTWDOs1lR8j9RJemF1LjxVV",
+	"Caq9379b36heSNhG04bhq7ycv/0+kasD3FgzPVb/fNE0IHwnKUgGK2iyXQawo40hb2xIJBvNnKmmZolP",
+	"y6U8wBLzcpeHYFV9neQ5Tt1nm53V0sCjzjG8m7FxJNZZg6KXusiW2Tk8+lgaib5J4KUG64rmMP/EmnLK",
+	"KuYbAuSxrChEPWFpzvABGlnrlukcSYFPx0ZEzmqUGWgaAduE9Zc1sUb47zqh9/waNcpV3u5j3qMSHqId",
+	"dROxF2B4CKOj7IUPanUANN/gZmgC36xR6gtRDz7FudUnmrkoeXFJLcg2lRXzfJNa/Bl+0QIaW1vtRGGn",
+	"AA5JtjEWhbMaUFjzEKwvUZPjPwQMYjozdX5W1SJWQ9TJuaglvODwWmkv6rYh30Odzi0nM02axDmZigr9",
+	"SnDmHNSP9GgwU3/05/QPWBx+Rp0QUpKlnoxUO6QGMvtBag5EFc+EDZBvwf6u2dQYoeS7E5Rf28n9bGbU",
+	"yfuGrZtqC9UizA69usxSeahtosFCe9U+IbIl5PXknUGm48w1BgGvykoJmB0QmFPQaIyQ8vLg1xqM6YMJ",
+	"fu5daeWlOMhO4DijmT3M+lhBVtZ/en0fjkN4jC4EscAE3vjNxBzOqzZnTNjgS6/iJluL6wnGjPgxFIm7",
+	"j2Y1qaVVVxabOK4Pp7Oy3k/U6rm6WIeOKMFRHUlz0qEgarqpYsW4PO4W3KAzUGTMlcMSUnd4H8ZaWDhr",
+	"kg+ABYmjHgIL7YEOjQU4slkuDsAXVl4JFyha3L8XnX1/+vDuvV/vPfwcSRI6LuEcRvi0ldFnym4MK7vK",
+	"xW3vwSTRyz/65w+0g017XN84stzUc4C+6g/Fjjv8yuVmEbbrY62NZlq1AXDUdSHw3me0Ry+5HzR6LGab",
+	"5ZloUK8o4Tm6OPhV0ZvBBx01egGIXGjrkiE8JUoep9jkGLhpnRxX1BKe4+zKhevIJNoU1rODEFVo41M7",
+	"SxopjKZi66HYdZvsNFfuVtVX9eYQljRR13Ap+uQTaNeU8zKPUQjOSs/d+EK1iFQLvV1V93eGlnT9ODfp",
+	"peF6CVyB6Ck1+nLnoV9dFhY3g4IVr9ezOjXvmH1pI98+0WBpMQwSEXW2LHGkQkyilDqSIPadaFg4hTsZ",
+	"mP+6er5YHMbmXtJAHhECZpI4U8QtUDSUAiZhNeoon7MOMtVUY3DWxZb2jWrCUCk0nV0Vc5JGDnGWw9KV",
+	"ch2LJEznmFYRRjjgS1HflAk1hCmG4pb0QIqYegqnCg5kVUog6AOgqtJjjT6KLgRbz6EdfhQnZK7CPUDU",
+	"LRE9MBI6NSe5EoXhMpi/FYwMwhW52zwWeZN8W9av7MPoO0BadfC7rTvn2L1N1M4qh54U+2p3DfhOGm77",
+	"plsi7FPfGj/Kgr426ileA0FPJ/dptlw1jiZif+PbIIy+WXyA0gdWQ+bYp6+M/BFu7zOyUB5ADreDtTX5",
+	"7qUAT4sNPsvQ4qQs034JPeASj4divqlr1L85Qj9pvuAmngmkrnmywdWi42bpu2xtxziZ8/GMCTUB06B1",
+	"ZuBWPN0qOYc3Zo6PUVQzwku0nOGirQsxLbJj4Vbvg7GXTwtYQNMcBHZ0D1P2oG3wGruRseeFkEeroVWY",
+	"WUAejxZJ/WFW8PZ8K/BvxVV8nuQbfKv88DP6CP4xFkEeLlu2oOsFYzaiq+jtL+UaMA0RcRcil5RZe8In",
+	"Ad8byHRy0YgQsq+PveD2d8HsEcEHQiCIxOSu/kGPlp7kAxClgf8DH6wPsoRNFaNMHNTFoBiP+10kRakF",
+	"5S0zmAnyRDbxtisFG7WUSLhUh4v7bhEaOCBcP4VvJBO3HJXUPCxo4xS7+n3RlMGnKU76s36V9qed4/Ve",
+	"SLid9RNVbqqqrOFh6lseKYiDc/0IX/VcsPV2bPMOBjaykWLbyCEEOuMrPCqtCP0BFKndP5WCub84culF",
+	"8eVqVyy34LM4GoLxTLdyEO9GrAVgRGOS6Unkhh5rLXqblWUukoK9/sqqQg7VxJvC9Ath8IxbnzY/2bZ9",
+	"klS+cySppKWQZIxU7RXkF4x0SVbRVYL6QhpZGwNI+8f+In2Y8VjHINLPRTx0XkgjgK3cg7PXcd9UyxrE",
+	"2xiEcniB9U0b/DnizzsShh6bCMQqU8pGxDOyO/tpxJ4J7RS436wlTSV9gndEX4CDwTnHZ5QlNdV7/0nh",
+	"Pzi4j28qYr1lZiEwvHSgxyNkMT15RqS7H5qQtxsTHa1G3UrXXEsAe2bWD4JAGje2WoDu7H+HWXluI4Ad",
+	"dP4rmD2wcDv1oZYdsIXQ3d66MDtXWee28V4RQb68hTGGeFDAMOM4GpfFD+Lq4K/37gRerxrgT/CURCW7",
+	"84Ff8pXbP+IYv+6Y+73mRym8+uD3lF6e5eiwhzbwIIeS2uQF+xU62qpDqCM8o+KFi3ZZBFSHpOKLx20i",
+	"LuFf+RUKtmRZJjWb3MzYv6lvT0QvJncAf0KC8IzKdcPrODHoS3JGQznL8zrH0mtrGL5XnSdXCx3qlUXe",
+	"+B43486J7yHDC8EoxzKYsmFVJ2xGY2LSNSW1gFQXBPntGHkGriUXzbSC6O/lBrhdQS/cDYYSKiGNogJY",
+	"4qEZUNw0c6o4MIshkYu14Nc8fblzp7vwO3fUnqNrrrhg56yCGnbRcecOqeJerOCkwY359qVYl+eHMeLh",
+	"QOmguzvJqShJE5nrzdagXMOZR08+VtNt4VE97aO0EM1FWb+1YHXQdX17YIH+1OOV/mbub6Dj1Xbzmxp+",
+	"LCpUe+Oc711+KZsWKz6E9QPGe+Ihl25UR/cG2u48q0Yeg4AXncGNbwByYCkVm8PlX/u66PDxyzFrdznK",
+	"OMdhGnfU1rddTXvrJi7xEt8tj+skO8SGpzWbY/rL/lsr20rOfEw3n3olfJCvyjVGB1RCGdCGVFC6dUSt",
+	"4UmJr3VYRgHI4Vt2TIiLTBYibspYrjYNxqGEF4LOr2yLaM2bi0UziZTJk9ZHxlm0UaxIs4UqZP96SaAM",
+	"6DBRXWVGxNnIlwgzz1hLb0QDsDtxVc5X0+i5cqE2LqcG83g1udjfjpsOEZqd7u2TB4k7GCeXbsiUWa36",
+	"m8BHVJ1l600ON+khWPU53J5wPdR1loqtjFpNDAN/A/2em24Ak7gUc7yG4VEwpyxDI8cSr7APJyZiss9Q",
+	"RuHEE2MBEk+41xl32qJMtH6Q2XotUgxxBEmnqsVccJYdfIhLs9RpxCkX5iBwLEnJA52XKlSax6HLnuIv",
+	"MePQpugNsetrs7ksYrLSSm+aG3JT0dma8J0pMCKgZ+JlfRR6zChQ+OyNupOd7emavL0uMpOjoG4T8X1u",
+	"dZuMt3bKqX2dR1pPYAdpFpqR3hKET3wO9pHobiMevoZdFD6AIdoO7YOyP7ETIWU/hoKkUKWaXx3gHcgD",
+	"weBwYiRJ7a6lQ/JXbzSmvJJAen37NHf9NXBcX+6j5CspeDpeA4Y9WksOrX5GH0dbVvilERiR3nw7DdjV",
+	"7bSQ0FlAe/IxJH3dTSKS6Z79rjOH/LasD+VVxQOO9xPa7pyz9R2hptzXnwpFoL7XDWtYe1xETowHf1a7",
+	"ofVPUjlRoVjsqGMjzp0FvTCpVQ5wgLvjdtxLnDQubKsUeQXgzfOMLJkwObzk583rIiFjhrNUj3O41n+G",
+	"LV9f6yZ+U5vHEqaGAgBIVDImDq8j6EJ4hMpvhdAGMLlZwqXedHRI0Ot1oVrB5mwKDI7F+DQ8LjGfF1gm",
+	"eWhPuSUGxy1ILC6j30VdRjMMN3e1KmvM7MaSOfu64DQwKiykAUpCnfGzDN1QcTjtN6iPrHm1KixMxzOu",
+	"pSiEzGTs92z/jr9ShKXCyUpFW1LgIX/W4T83HdCtYfclk1OQYxghqSHhH6hrcoImu7D/EWzO8FaIvUTp",
+	"OpB2aDH6jPJtKoK73TZtAEyvC3QZBsIDqTxLkRcdjHy611TvQPMR61BZa+M6lgqNgB3f8NdgVZGHU3X4",
+	"6weR57oTDPoUulveCbhTnFEeHEA1sA+u7py+MIpb333zKjpWhCBvEbGooZ3UhJ4XjE4n4zoy4i65Uc6v",
+	"gcE/Fgt6D5bFo9cFRq8e82k6hrdW/RUnNJguy+iRzhDwGNq8LnrXUDBRiZtFyGag/pSpaYe0KEB9spss",
+	"so8iIFFEkUOqUuU7xG1FFwgTRY3MXCWLQRr4sVR+c3VyoZ+8G9Rr/7ZOql8AkDdR/HpzcnKf4tFtisTf",
+	"FA9EugWgRz98g8ksu+9dWjjL5RREFGOQpD+JVCOSiiiEBI41vTRBCqBurVh5HflFQ9kF+DL8bNsShmzn",
+	"JBe03DPupdOC+xdFn2hT27nXrrWDTla9vTdwS2a+ZNOsYuQI3lVJPAZ6r3QOo2SJV452kkKbIykh4ejg",
+	"klE1JDBqgNI3i3XVXE1a3bUvn7qLnWxaqDNSkfJwcGEwtKXBgJsqTZQgkxRX3RS5koPfaNCXAhjWq5K7",
+	"T0dmF3ey2TspWmXo6BLtOnctkq97kNUY3c1XrqU6YYJKZ0pJCDRZPDJ04WQxCxxtFgAOcKx9RNHKExpC",
+	"RFJ7EMHEH0DBHgvF8a5F+r7loWq8aOB2jUWeLbNZLsKqfcd0q2FFqkT1aHauU1yYASVac/F1pPML8Yup",
+	"Rl0pXuoccQP73gkcdzT/JB2uRFI3M5E0g/rawk1TqaEjgfyCMoiQ0oQMEOIS9ztrSAkC0p9I1dub26hY",
+	"ieleHqMqiijdE1Td3WYMme7ziFAI9+TD1/e9k/NJvReUC65LnQQyf0cbPKorLnA3EcBSl36gBLHOPbXB",
+	"WOzRidNcE+TYFGWtPjjINunHK++gi0xbrOnJGGMTUlL3GPHi5Q4CvyB78KVh1HOzl4SyKjzHvCgKqbOc",
+	"BGrjA8+kg2EElZuocTdg/WwM3upWWNWAtbHmHn0026mjT+Y2zdE/VF7PD5KKdij//hPHwThp+tn19TXd",
+	"Ze0T1ufAZQ0UDD10Fn6del/n2wfAdsmd/ykZ6YdORqqV6SQllxXe+lnAajXXLEXlerIiTyeKg4YBuCcR",
+	"ctLzJEdOqhIN2EF6ud7p7dPJ7K7c126H3kQjD5paI0knO62S5Zl91ucK3noZ/lfBTmuYlZcxZ8LwPq1m",
+	"lzM8E96QLMrL4Tu8nHkf/guDk9sk3XAcw7MzdGHINGCOpxtmUkf8UL+Q2Mjg7QbIsCDvo2ZJpKf0aobs",
+	"QpLsfsAExOkQ2X3mpOA/EEgHyD3sSlt9ScRet70sxX5WEzqc3p0MYLSvPG3nyv/elksIJ1fXZ/VGigT0",
+	"lXLXqevAnSuu1bBLWYcuObSAGMDqi64Q68+/2vK2a+PVwZqPJSGj7xu7+miTcLORJiBuydXxW59ZGhUa",
+	"gmSGM93N0XPS7iXF1W3H4bcWS7ShWOOCdnK5edsPqRNjSkkbXl1T1Qtc30snFzGbYzmVr7vMG18BRecs",
+	"shpDM9Ay410CNvpWkibtW2zqF4TbTqLwAw24sxxMEGG8aprlGz8pK5B+eIwQ/WhuLrmZ0UUJZEreRjMq",
+	"peeNQdjBNknwcOzKIIKeMoKeJjeBn3EHC5siTJQFuz39n+SIdXjhEGfx0LKPmPobGkTpEK+1+ck93m8q",
+	"2Tq7a1GgpZtovZ3XWuWznozK8fbKMX3jyEWci2QRzREQ49Raw5WSYdopzWxU7IItPRLZXjfPMzOqg+ld",
+	"Gn0yumgED88yZf1OkDKnXnkOFzPsz6yGkmrlPh2fP9kcw6qmGCAHJ3tMnxycN5XjhTMdMgH2kJbqsbc6",
+	"5+kcNiGZkkfyrsXJFu0PmS+XSwwC5jyHKg0CJ71UuYbzEqje5AHF3wdSK08jznBMCYoHchurgCwRCsdq",
+	"VacNE5f7tiXIbTw55WWmSdAngBK3He1evjb3Is4NBaMWjqL8Zg9eL1DMG/7wqhPyYOMSeA/NZtP25JiX",
+	"lV/ZUuj1bSmt0tsuhbpJKHCilT5/+IAxBzG1AmyN5y7RBC5yAC5LLzt2YB51ugdJjJT++4UFOzijW0oN",
+	"tgU/bT/zLaWfb6GwRO2V7euYtD7HqHNg93bloI1nA24szq+TbmoyLracx/vlGY3eYeTaf/j5rClrzKDK",
+	"BuKYQbrWELScXdDgVDiEtWfsL59mi4VwDaNyH6NeC7ie+SsdQdgBEuxbT42qYZA++0S2hbbsCrYj1E9P",
+	"HkoZqjLkr9DjqlrNZeNs3B42Zm8KnR9AbvwZFW7ASECqtK7Kyl7cvtZ3oInzNQxNI2/1AEbAtuwKaWZf",
+	"CqJQn7HNfJKO3HlLtop5kkqktYU77NSpf5cOtDWqMmv4aNgbqlWetL2UD3dsnCo6AOmYvTrzOyHh2RLt",
+	"bekS+rYtytLtso/zInWn2q2gkHvJmdxSW50NRZJrwqfFHr2fHF3P/cd3T6oRt+zEC3M1e3eBnHPZHaTl",
+	"A7jjhiSYthkD2JTbVEjogEZK6KDm2svqhl9n/lPx6pvTpy8U+OiHAjJfHRvNV3BV1K7606yKK7oOX0Nc",
+	"qkap+lkz6my+KSfiOlZdUFmajnK1VzrZutE5B1U5Wi38gQNb+aby+OMlDnj+ico4/lkHBfb7a/v6JedJ",
+	"lms/AA3tWKMLL3dcsW4vn3AHuLbPoOMMeu2xgmEjqIDTmLXmNfabM+WCPK6Vck/H9x6v8Z9VS+tbOCSt",
+	"8zklMve/uwqV5pwYo/I/TA4uB34LZ8O9qFSQq9d/8cMJiPiYYDz6fTReKaeMnlg4jViE/G35G/KGO3fc",
+	"g3/nziT6LVcfHADp95n6nd5RmDLE86b3an6RZZFiFyuT3DZhMsGNuFk1RCEuxokLICYbGbkMk6GhUHZE",
+	"1Oi+UNi7qDOFz1T9go4X+NN0jKrC3XRGtwvMmBN0FgpSNb7w6+QSQ2pMOU7HFk9B00hadPWo6mbsdtE/",
+	"QtCP3BBiCQD4fcCKmUSWVLCHNzaOqPFolwKcY5MFwgyKTeaMjs3kXhbwzkKcWb0Il95CABa/s1KxgE2R",
+	"/RNoI6OE6vCpppu4cznrpxCN2hOw/fpFNTBbku3wY4Vp7LarzmjAYqy1akMKo0EL/GNjFdaI8JUt3zH8",
+	"xZ2xx/wHQlcURenrk+IcVyIflzFk8J1njPRe5YvyCtDsUxngww8kZLa635PHY3Y6k/GiLn8XftmBbMae",
+	"ZFXa2SEjBTz0HmHOsI4ker3u7NsIZLxuIUQq19Yl6EUrRzvR7HOF+/nEbhu9o9LA2e+w2kD6q4uoTQg9",
+	"VF0/pHZcVYCZ0YF1ogQo6Yz2foRGNCCnOWkFIvrPuRs3fMzj23OuYO7FWufJxSzxFYHE9yLC5Gx/y08T",
+	"M5SrznqDpMnUwbNHTmiLaasy6QAM1nrULw6w59uPpx396rOPPKI493k3YdelXJaeYTbFRVKQWyn1Yw6o",
+	"eqM2UpvOLsqaUlpLv0tpCiSy9irDAfnpvO8ImGZLnImzOkfJolHWVDVQxHmziYrSTFZ5cmVS0yjUwIac",
+	"TOyZNXmNsvMMTeSCWtydqOLPki5oW65bd8HlwTJXkprfG9F8BSiFYwZdGLGAVvM+J9HTOEbPRHOB3qMn",
+	"1O7uF9Fn5D8us3Nx23/BKGHt6NHdL8jtjv848clKqVgkm7wZYvIpcXltq/ZTNjnZ8xjIVtWo/kCVRS3E",
+	"7yJ8nwycL+465nRRS3UFbT9d66RIECE+mNZbYOK+tL/k2dPBS8HWGQGTlVdR1vjnF02CHCuQXAAZIoOB",
+	"sQ+wjrVyHJblGilMs1Z9/PRwVHtYl4jVcOmP5JFfed74H+G5lawDAa8UZPEj2dtdtE7QKZ7Sr2Q2HEex",
+	"SDiBuhYD1cw1ecsYNzgXLp3kVYrOwQqEcCJIa7RpFvFf8flew7UBDHEaAjeewUnr155tVyAsdgP8xvGO",
+	"lqL63I/6OkD2WspRfTGnQhGvkaOkt22GD+dUBkMH/O7eIS/0wNDXlq5x3DhIgJsWASYON78WKRYDA16T",
+	"OM16dqLQnVd247S6qf0Ek2xwh356+VRJIuuy9tV2sgxASSW1wHSm5xRu7N8kHPOae1Hno3bhOtB/XGdH",
+	"LZY6ops+3d7HgmNV9rzTTJYtlPR/fmYrwpBxm8O4O9pLwFf/5aY0jjfspbybvrBrQ2fvUPoWwNxotNEo",
+	"fawEon84vMf0+Rj+Xl2QeM9bqtK7vwHNLyhFTYn6ZgQaNabc9Ld77c/M3u/cGe9B7dcX4q8e1Ox313Qz",
+	"8GJf31ZjEfc+x1BFvI3fmMpc49Gweu8yvFJnaoxJ1K6UfPNyx2HCV3f2SvcfII0a+tzFzUfmr7SZNiAq",
+	"zB+APh6rVfm0BEg+qfnuhNQkWMF+LBF1ri1NTzcfEOLfSA94ak/pTle1xXRVOUpX90fY3sB2jtRo0jKV",
+	"u/4WL4+tLkrOkcNRZwJ9pWWrXOVoj5s/MgX1TWhHk4G92GR5+rO1oHduVWD285XXIX6GHX/lJ4zTwNG+",
+	"oJ24ELm3N7/0f9UaAY/O4h9lYFh4jvk/dRauYO9AasFqA6Gn1OMjrrIGc6C0UNTOLWey9cC1CPuN7Wyd",
+	"McvWHfHZIr5for6froKGXW8a5VFNeUBU+a9Flqsk5z5bPrWM66QJ3Ag1RZEv7IggbaOtMFI5xmF0tM1l",
+	"axI5ZIKlKekQwupQH4Tp6ArR6U7JB2lkp4gYasYLVQWX8hiVUbOpMcnzwlkG2uvg4ruaUPp3HuQElyUu",
+	"ae6jR3dPTk7GGUgJXyPWznjVC39uF3f3mJrwF8VRubzRTuDvA/17S3W7bH6fuFTleLoIfCyWPnBuAbJu",
+	"o0zCVeNhMSkplqfRd5RqDwm9VdCHFLo6WXg7ve2myssknVB+c/TvinhW7gPPOkQdVa1fkvayfUS8Bqrx",
+	"6X51KsFAGrbx4wxngcJVyyY29eR9SUGxxStTcD7reG6RXtPFzjR6zCpl45TEk0SUJb9eoyrWjMYqDCIO",
+	"/EfTJAA3qmGnR4Pq8EDtPltSL+RF9UK10BzQmrqcEG5T3pI4OC6DfTRQgZuKehKVqF+/yDAh+Qp+Phft",
+	"3KMmcW+nAEt7tUBWBRPOdAfJ2xSz3HUXNHAstmvfEC9knX24tt3SJqUpN/V8hzo5fPLPqJc/5qhoD9bx",
+	"2eACV5e6RNY0eqYMNXPg6UU2p9JQvucDZRUdZxIeUUXLb6uVR+ose46hh5SdXAsKi2r9b4IsUyGu75Dh",
+	"fMX9ZsLhPxusN0nWySXmp2AeiJmQcHuwoBzbwEBoEKpcKdKXy1HL2uO25g3pMe4vB3Snh03ExIABPfG3",
+	"+O1HZVeg9EdwC5G+UCFVvWLZOIgZi/CYYNBotMTiprzadkyb/AX7TIHMCIQ306flMpsDWdAY7EaJSGEP",
+	"5v5Qp9qfWfkPY9uvsa0qw2F+brkD8qR63W+8LESa/e9rcy6LIPp9fmvaCchBrhnfHW2AGAfDFOheRjLE",
+	"+ixAM6Ki+7xHNqKufY9mrM6yYXqjFhEHoXszYGeFB4ynmOzJSNWelG5z711CG0OnOdAP2mPagNEcD52V",
+	"A6E8lB+CvR2uO1S3qAiihNao5whvI5C5qogSYCumgX1dYEZPfSiQuh2hBEOEjWM4CVNtnTpKZ0oYY0dn",
+	"jhJW4p2frSBbj3VYcQtdW4NYTXcq7LPrPRVKnDvbgFTZYApWXwrFr+hrRF91MCQWF9qYkp0mRrZdeaBP",
+	"bWoizKqyWQ/MpRtcc7o0k2jqWM9yj9vwY/MR5tE7TDnVZlf0/11qAxqH/Z0j17V3frpbuY1+JL5Pekaa",
+	"jjHT3nhM0J1yfXTYqfcjdNv/oJSug9b/EDHp3RJmzh75+Ns3eHG4Ged78Ql8tZiE8BQLUNJ3ndrOJCXu",
+	"1MlLmGh7c6rN82xZB3jd0As4XH6BbBGuxYnvV7bChHJGzIMZcpJGJWKEVVqeMEaFEU5lx97jHatW3zQb",
+	"8g9n9/APafhR+BhEethK+kPLJsoee5ahBG2h+5krLRHsaq9UVUX6+lK4A8r5aM6ghjnFTuGs0+V6rYo4",
+	"eDwKz9fwEHO+uZ5oQvgZGztbe8JC6GHr/UZPK++X+sI/Wks/YohmbAI+QqNawoSDSjV4Ghie2p3IUdkq",
+	"zEbfwvMLLev/cfb8x6PwRjo70N9SlQXeq8IObYyJsuuSx7Js4WMwQX/ikdpftBKLG8+JYJbICboZ8M/K",
+	"/6aTxsDiAl9KHinfTcegp2xnOXXy0+kcoE3pTjyQRaE1fTVqvSOyyu8++YBnuj8/6Q6IDaT//Iqyexqn",
+	"JAdOx2nf8JGOBdPP9bZfin7O3OU5ZZH7bS8yYM6hFHt+PjC7HEvxmKZ1dFuRVL229+/520p+TXZQOJNj",
+	"Jys22bim7z24xdRm/t3inH5jgeB8e7u0fjp28B73XZZcXdBXf6mf1urI8kLN+RxWbHkrX+cua/adlqdc",
+	"pBK90mpfajeVGV+7UNDZ5OjSC1KZA2Bcwh6PFmYl1wpcOR1f88eT+kTPG6gRRhaNLUnQDGi9Qel5iQn/",
+	"KPkfnEOsomAd/hdZTv/kMskDFRKoJxaBlANVkm0RZCqRbHJ32LyJSePk5nIwyoBxSeV1lueZMvK1xMgw",
+	"UW4ruOtA4JRGcLAU3uzRguxQ2YZGMHRbK2k3KwBHUVUb1D6yBrdxlD0zuYwRNjg2MrixeVJjiUKdQGB2",
+	"tR9M++7sOLT5EHaovQ3n29Nns39EOkXnXALoo90s0neBdkuNeuQoNpPaJsrA0mOBAZNJS6syprKpr4im",
+	"0i1qmy0/TVUyZq4s2itK2uOZj8eok3r4AKCfpDspXHyFWI94FO8OZMtV8xXayL8XSSpqLqbnU0BzKb21",
+	"QKqUq6wijSnQYGa0eVGOg6kqNisabjo2ELmXRbM/lg4XOwfQ0cjhBL3UQoz36qz8S0QItAuSyW16w0ol",
+	"WEcqqmY1qF7hULaqMXVEsRsb0tBHSyhnh3NRAFuaimk3ND+1KTA5n6qyDmGy3el2DmGCtAmNLtA++mpl",
+	"7f7Bl/ShpTjqJTx28nkLyki7gzRyaiIgOa0EVms3eTI7SaNGJ6dZLDCR7/mW3NN/Q1OeTUY80cY+dXfY",
+	"VNSZSY5A9coOagO3sA5lgR4E1RUtPiCkofRfsGu3ZNSiIU53H8onsk/5I0IOe37pilohZwgVBgLI0fRE",
+	"CNJRf+pStgVG96mA5aRm3xMMTeN4Pdl07ftBo59he4CBXXecNCiMkCorlNr6BVeNcK7ysG79sYDLHB4S",
+	"HEKTmFpLrgUKjekdyzutjl9F8NAw/kW6apOQ+jddnYBnybO3qjwjvw/ImwsLWugWB0kKzPdm5gd6YWbO",
+	"bBh43y94V09ezscwz0sUgOJQGoyu+K/UbnCmKbLMpmglqBeirkVqvIhgbBFj5S6mgh0y36tkEQPYs6qn",
+	"nfHWiV/cIUEKryhYQOylraJGtdATKhiWqFA7FytOpnRb2cxvON22Q1/zd/0A0rWthw2yIbybcxFvDebQ",
+	"iQbwnulg3j1d6C1KwsHO3KuVdm0PW25WABONtdtXt65Z0U4KTkVF0s28rzs19u7RSVYHuJnXDDrvrzKo",
+	"ikZGfcyGIpWNzOy4CzTLkAy6oyXuEMVBrdvSB/fyIOB93GTlWI4tDvgSPekXY+sehrcZ+n9jCnOj8kYp",
+	"+Fb72OAk0WfkwmK8TC9WV7rUWAW3nEhvT6MITcuYC0E7nLrl4HqTF7eaofkvadZ0w+UVlc16+rrwB5VT",
+	"mcP6mtxPDzPA80K8CZhIeu35eZA9Zgc+EvKqv6B6iDiHl+cOqzf6HqEdEcohP4bCK0Ct4NTOyvLtN0VT",
+	"X/lzZLe1utqbt9I9pxE5bpPbP6DQRDEYnamoyvnqmqpkEVAjY8accrGIYU+yfECDS99dJZpArQLnAgF4",
+	"C0AH7zXr+TCicpGgJ6r+SlYm4B7rqhmdeG6GYTPp3rBx97GTIbibWmxVKVI1LLyYzsXAEjXZa8SPgYDf",
+	"MBtKuT+wXFd5rlovNrkLxB5zK6qMFd0E0aCI1zRr5y4iSV+W+blxSx/v7FTW2TIrtsyLPq2Sqt4k6RoL",
+	"P3anL2eocbQ6ivHzV+jCTfYQEMHyEALoUyueVtEbTs7egQlpY8xo9HmimptDj+HVAPQKBktLvC1ALi3P",
+	"d/Qv41dVbHeendPDtCNt6V+yUtmePYLtywBDttEeYMAMY2IFu57bROKlSYnvSgoCcJjL+HK+W/bP4YqT",
+	"SEyXU4yBxnotMH89X6G2fpedCL69NU1rkAZvkBcAjdwaQMWvui59me3r3y6he0MM3xxtLMkdCXOHDZDB",
+	"HWhFx9Dn629KYBPO2AP8a5LsfTZgSinr5D6mwIAkUp7jkcxLX+qAfdLe4lB+1LmTEUCNKEZonS0UanAv",
+	"AlR03ZZSMuqz452D/F4HZexbNUYVYuG3mAxZOLozm1naD5wFpnxxZqQAU64uZTxfqDgT/WOWgexYX+1T",
+	"26WNKh/9BbG8/ZTrCEm7EBsl2cdhnpcXMb1OYlMh3KfVx3ay/fpW9WVtZXGpGK+Nt4QLjTU9V/AgQmmn",
+	"huvD7eF3D2CoMANPjFXEvM4KT7NFg7q+NSWjwgLUSzhkaEmKNuhK66eg0FybAuWDNDY0GUQB0w7lOeQ+",
+	"Dh2PnBIf0eyXHZPaZWuxWL35r7AP59y0Oft50THHBgQSCwBsnKNfYYgb9+ElwuE00l3bql/TtcguiW6w",
+	"aFb/yMPW15j0QbVgvYJLQnTw8fWyzqRkUAwtXWR5Tikvs0snksEEAvlRG1CBPaEA6POMIt3a6U9ZM1ah",
+	"WGNyxro84MxNIw9fof1y5dS4NHBqDTyGE9Nnd5Sf5IaCESmvFU7xIFqXaOVxfX7MUDb28zMMsoGLL2/b",
+	"41hdt1Te3s+Sy9P5vHkKdzY+ym6TLh3lIJONcKLzQHaDdu1MdadwxDiFH0aGEXnI7bXhuB2Fsyp6Hs07",
+	"O9yv5z+w7Qp3wHyznblud0847S+su642n/WrNPGJ35TrbO4/bn+usNdgsKqPe3nLQ1APlTqXmhEfcO8x",
+	"E8dE3LOPZlEgLfv2S/EIFc9BnAj/Sdq47rjRQigeFLhD+3xHCVjxPCgGdgAgSDl7IyYaIN7nCmmG4ZRL",
+	"dsqjaJQuoCMvHAr6ux5sOMLBgWrEtYDqhSEbAD9jQ8SEy3hwSDNmuFHfb9s6H3sB/36YylvMIxRNeWZJ",
+	"q+Z4Sp19O8AR/FUTB0MPX1HmztnYAESpnX1GXv4OAOGQxBYMowITdwWDVWlx0gTufTJlTRytu9IbOKNn",
+	"6spmTj5P+C5fsZoOOIHKBs3Sf932CqIqzepWxeZ9wzaaIpWW9ndRl5TXLJ04Xim65HLHMFBWcS7ORStS",
+	"U6WoZuUdKhJVX2k6w1UvKnLc6trLfG/gAVWMWnvsBLGNwa7XqsKIVUrPLSYTr4EHLnA+JnLsUUKIQOID",
+	"uauFhF1FjrZJEI+yB1W950Osn5hjp/mJR3ipBzjV/X2ijMbEm3F8aGcW5EfdEAPaGpK8kaFTX/gjkt38",
+	"68bfg2ZLjXsak7jlG7JKLoqwcbJP8vYlNnKfYCQHsd9Ad5Jq1FMIKICfOgH9mAo8Imov0IEvZalxWXiM",
+	"8ujnU5T2RUR6Yv2KsaVo9A88MTUCdPFDew9XOxs4fP2djWiwSHYqRPj1wIasr2eq/ygncfAgBsfz0Qj6",
+	"sVEOrwHVmKZu9eygBuUmR9U37CfK/qvkXOhbTHHxCZwdPRAqMijyrfVEfSy0WxZTn/YUUWJ5Zq5lHSA9",
+	"UVWSulqQzEkNsWbFLP4PH6T/BJaSLa6IzzD4ulskVwmSkPIDY2dIFXCNEw+LVxMNmFbElHoqXnc2dkxn",
+	"uCscxQEaL3Jdax5rDbwV7jaQnyfzz3mDjFNuZqTUwCu7s519LKjF65zS6yR1lQBUHeeqxR10lTbs/b9t",
+	"vip3Kl20osqTOe82qTYwOU6bz6AwZIgL2qyH85v1+ZomAd3KIdpa58dM99Cm7si6fMk+QhW9W2A7z4h2",
+	"Qe/DLGOkUrhTmHkgM9yopRx6Fw6TvKm3JHIa1FVEtiyO60XpiiM3sTveslahZYwB/w+0Ky0vyV5KG38Y",
+	"sLseanITu9DKwOuBldXgAA7cxoutThisB0dlQG1z92rdLUhOtcBaQcgqnzxXz1ZbtSkjn5zMdZVwRkmx",
+	"7JVltVlRYcGA3iuIijcVVw7CXGsCoXU6Ml7XSqWYH+L5uahrEAYDOMDTg5UT2pWFtQVF9fUoQMyN3B8g",
+	"k/YFSInUrH7ebYbXf5otYLkcAgP8tUjRIdtpDkibw4WTYMBdciX3N1UZq8M2Y1XiyELtNKGO2YpImwEB",
+	"wYqdxq5pSDIAJge0KI2wBFGslccKxIohmN5v+OnD8KewBFFIZLmkdF+BA6GKc5HpkB+QmCcYZTCS7sat",
+	"W88js9/F8DRUP1UxIsA2zjpmiuFz/5y2kh6hPxVZM3jyWcPZzb/GAUt8MDVSUbmqoyyZWPrn0ZcyT2Vk",
+	"dtPmaVFV5yfVtCecTfRGNvW06oFdJP8KlW/RVaHL8dallguHLzEf6xVi0jfIgThK659CuJZKEdVzXO8q",
+	"KhgpE5XWcEc9HWv39b0UAE+n3Kez3p7W+NniOONlI8fxxA9RVVbxfEyICpdYTpWRQUHahjFAH44JIbBu",
+	"43cjTdHxVjL0VvVxlvv3Ed471c+32crg7LwZPNZeJVOAo7cNGOhnCryMjjCr1ihk2qhiJvpxro3dbSWa",
+	"YRLQp4aRa1IyX7ADVU/916ogHyiZd/b96cO793699/DzCBtgoUi0PNsMOZwdVbMNE2GQFV2t0c3GFPSW",
+	"1/g3QacJZcRp66WOXjebos4ac1tpKyi1Vr+rQdxzAfiycmG2WRviuPde0Tg2uvGPtV2+RR58x3wo+PB7",
+	"hv4f/kK4Rq7ymF98u+UYYPAF4riCtu2nWWNjq+SKlItU6uyck0KXOr7AUkHWBHy5fAsJheYQP6MkjMrm",
+	"BANXueJVbCcaWpd6p7F+j4RGcrdBHVhZKdEeblgfRBR6XW+E0asrtSnp051oG8NsOe7GR4gqhs1Peujx",
+	"QS9hoK9hbm/NjJpRezg9bqJHvNCHcg/SDFk3wglG9+Ek1jDwh+EfnoypB+MaZrkfgld43wcDyV1Oe14T",
+	"JlvoKND6mTE95EEABNKatHJPOLHyTkG1mm0MZI3Q5ueu+PHMmqW3BpgSJLrDFvDclCS2nYmJVOB85HJV",
+	"zwxSnKW8CVFCa/nbspxo1msuEmeLlNKkQd9BLp3RFwudvDbya5MuJvAq6WWVwXwoaIBCUbSfjYb1OHSm",
+	"XMLBJ0F93km+dCNc41v03zglfIj0ZTj+2s0+4iKZUSkPXonjaTIKLCfTyI1AVbygFDl/E7iz3ttRzaIM",
+	"/707kFRCIC+Tt7dJS4eVfy5oTHbsuvt5NFM1itGxN5Ndh4ILLdKYtBmiRoscx8FcNt0UHteubfxz2Vzj",
+	"OCy0P1D0o2NkM54DCmZ71D8ycwpwAO9p8ZFqj1A8+PPxOqyIMK6o7XXr2e6Xw9mp2LBjDmd3ZVRRY/Ty",
+	"aB10eQGp99c5+tZv4dZz4du1jU1SProsLtYin43JJO4vYYvdKbn5QWrZXr+S7Y1kNmdUqjEUJF7CsiL3",
+	"tiR0HX9JJ91SexdR3PfvBAUEYHgSjEaPgsWm4PE0G+aUL5qtl4uJ8WJAzXy5eBS9Lu6gt4R+W6g/4Z9Y",
+	"wa7AamK/HNnvGLfGX9/4XmrppTc9hM2H1/MRVWUEb0ngG1djC9+H0995kWuz/d28PANi3cz/oPseN4xe",
+	"rSr64ElBfJ54C1+fKgfe/9wkfjsn/jRnhYnR5vcz+7At1d/PoUp4XO0tUOCzw3exFuhWK7xbexVT/XBq",
+	"ZCpI+usMFnvjSV80BIESAWrp18njyYjxrLU1uTOVk0p6RA1W1c2Th51Sp0DjrLk6Q/xrhXv261tfNsfv",
+	"TH5FlbTT2N6V1NuUb0FEVt5lNhvjRmq5+rsyyUnuZJeAAqXNMp9G33BRUHUhfnlr9hdx/68P0pP7d/8y",
+	"++vJw5O5ePDwi5OT5IsHyd0v7t8V9/768MGJuLv4/IvZvfTeg3uzB/cefP7wi/n9B3dnDz7/4i+3kNIR",
+	"ZAZUF/t9dPSf8SngJD598SR+hcBanMCqMYXl+/ekW1tQTQJC6pwuV0zKlUMz9dP/0VfkFFZjh9e/4l1Y",
+	"Y/NV01Ty0fHxxcXF1O1yvKQkZnFTbuarYz0Pla9ovVRePDERQez1RztqrU20qSarOH57+c3Zqwj6TS3B",
+	"wLeT6cn0LiWxqEQBS4Wf7tNPdHpWtO/HVDjrOFkCJ0Dh9zhXSm5KRoxNoL9qJFWR3mMTWer5hlaHhfq0",
+	"NOVB8C/YlpyYKP4BFFTD+039BVdzeqX+LS+SJfCzKQWU8U/n94710+T4nQqZfz/07dh1VoOf3RR86Zae",
+	"2t1qWxP4gbPSbRkQzgjIyVeDbfQVHm7h6mCPlTMt7p/XG+M70aiUu6wW9eREJCOoGn0SSarqiz9VdVYi",
+	"a6AE2KkglyXy262pemlTg1jFfiw8heAs6c9O/5N8eeD/0ZfRyURFUknStfim57RShqafpAx23wFbfnV1",
+	"ajJXWL8fYKE+/bfygq82M9hwfKpMNVNAinfOrBnS8mSydhzxnUROCOaGwVsDrow37x7+9b3X7b3vAWdd",
+	"Rwe/9sRYg18ny2LLl6WM0kxWmHUC8b1OLr8MYftSReXguP/ciPrKLh+6HblLHfno8P7qSa+twysvnKT/",
+	"pvSV9fnGYjRoQVbawxdoK9GhpTrM2IZWu1HG2DO0NiUouMvTwruKUV3LZdWuVGiUDW+QIBhQYo/3Tk70",
+	"naA0Kw4vOVYsypmpI4L3qZncFB1DTT9VGJpY4F9Y/kA6fmLk890ow0snALis4lYY0qBpqD+j2hJvDNeu",
+	"2co8hXYxSG8LfBzIFyB59TjCxGcj8or0kOGFwFvPw91aTSOfdve/x+72pSyYsuG6Cpg2wfBdfWe2gFSy",
+	"dX6lwQ2kbJxGfy83JAvjK2fTCMMCgc1R9ih9K7NNWM2pMtY6r0EbeElf7tzpLvzOHev2vBAXxGRhWmzY",
+	"RcedO1PcqQc7srJBO12r3uGos7PLcL3NepZcGm1NAogs4kIsE0p25yhCHpzc/dOu8EnBUT4o/PMjBZo8",
+	"/BNv2RO0m2FxS2rJq7n/p13NmajPs7mIXgnoWyd1Bqzgp8KEUfEjjuSTPvv7qXhblBeFRgS+vzfwGMYn",
+	"AUnqieE5wA90quQt/KeXK9ZK88RFEwx4+eWI5WAWnHWOfJBz3rzXDw1MV3ic1knmPrXoRy6Ncizh4ShX",
+	"lPur9dlkcnM+jHxzDTU7npWXOzQV7kMt/CojvSp8Imtk8PdjpZz0fySDMWsWus+1bktOfuz/2HrHvWsu",
+	"cSHDw2EbZ7w5uhNvquN39A9SEjgr4lLF0Kc4Jgf743ctRKjPPUS0f7fd3RZUYVMDVy4WXChw6PPxO/6/",
+	"M5G4BMkmw7uNajKoXzWtbWCHr/o/XxVz74/9dbSqjQR+PtY6Kp8qod3yXevPNk3J1aZJ4Vg7v6CNlF0Z",
+	"+pDhx43s/n18kWQNSlyqXAXlpux3bkSSE9vKKAGN+6utTNz7QuWWnR87MlpVcqq39hv8ZXLxqhWWX3Pe",
+	"oq9KUtCEuPdlPMuKhFMNW5Zrdbb8sf/e6jFatONQMIN2k/EItJgJri6TdJ5w5klbEbX9mH9/zcdcN83S",
+	"E4+nA4FJCpK+VQf5yXSrRZvGHSOxOvsSPXmsJ7SxwB9cyutB9FWSRjo3YBw9S3LccNisU/WWaGHjQ0to",
+	"H1+k+sgy0I0JLV/pw4dGd8x33npt1v78ZU4a2DESCj5JkQEAs44VC4pnwINipeWBaTH5+XsPcwNJoHVj",
+	"tFWnqEGUoY8H0Kv+sZWp23Son/SPn/SPnzRUn/SPn3b3k/7x4PrHT9q5T9q5/5HauV1Ucj6BVCmKwnJp",
+	"hl7qSWtefiEmtsahYfHtlI9ZY6S3VvQ+lVPMmmmECVdqTh0mMUgPnXkSydKVyi23Jvd4Shwp0kevi7gF",
+	"Cbuc48Sf2X9yYMDrzcnJfRGd3O72kQ2G2Dm8ud+XJGP6xGF9X0avj14f9UbiCiYpu9a5Ba6419Zh/5cZ",
+	"93mvkh4lH6GEaDq/JCBhsYBdZZTnJUZKL0sb70IJtouSvgDPBODYIRMwPVHxgZlKSsG70qnD1Zbx+xLA",
+	"E7uFW90lOuTid5RAwtvRS+LffCqPT1L6SGY3lILwuox0cOweV/3EVW6Cq3x0vvJntw07Ssj/lmLmg5MH",
+	"f9oFuSrrH4FPf6uj9a4hjqnsznNvped9BS2dn0srBq1/t+svTbeo8ZT+5Q1eBBJkT33BWvffR8fHlO5x",
+	"VcrmmJRUbddg9+MbA/M7fTtVdXaO0LwnPSjX68JKROwaG1sX33vTk6P3/x+fCBjZUUoBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -45,17 +45,21 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklearray"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
 	specv2 "github.com/algorand/go-algorand/daemon/algod/api/spec/v2"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/libgoal/participation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
@@ -81,6 +85,14 @@ const MaxAssetResults = 1000
 // /v2/accounts/{address}/assets endpoint
 const DefaultAssetResults = uint64(1000)
 
+// MaxBoxResults sets a size limit for the number of boxes returned in a single page of the
+// /v2/applications/{application-id}/boxes endpoint
+const MaxBoxResults = 1000
+
+// DefaultBoxResults sets a default size limit for the number of boxes returned in a single page of the
+// /v2/applications/{application-id}/boxes endpoint
+const DefaultBoxResults = uint64(100)
+
 const (
 	errInvalidLimit      = "limit parameter must be a positive integer"
 	errUnableToParseNext = "unable to parse next token"
@@ -105,6 +117,7 @@ type LedgerForAPI interface {
 	LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error)
 	LookupKv(round basics.Round, key string) ([]byte, error)
 	LookupKeysByPrefix(round basics.Round, keyPrefix string, maxKeyNum uint64) ([]string, error)
+	LookupKvsByPrefix(round basics.Round, keyPrefix string, after string, maxKeyNum uint64) ([]ledgercore.KvPair, error)
 	ConsensusParams(r basics.Round) (config.ConsensusParams, error)
 	Latest() basics.Round
 	LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error)
@@ -148,6 +161,16 @@ type NodeInterface interface {
 	UnsetSyncRound()
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	PhonebookEntries() ([]phonebook.Entry, error)
+	AddPhonebookPeers(addrs []string, role phonebook.Role) error
+	RemovePhonebookPeer(addr string) (bool, error)
+	StartRelayDrain() error
+	StopRelayDrain() error
+	RelayDrainStatus() (network.RelayDrainStatus, error)
+	ExportLedgerSnapshot(ctx context.Context, w io.Writer) (ledger.SnapshotHeader, error)
+	LookupAccountHistory(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.MicroAlgos, error)
+	AccountProof(addr basics.Address) (ledger.AccountProof, error)
+	LateProposers() []agreement.LateProposer
 }
 
 func convertParticipationRecord(record account.ParticipationRecord) model.ParticipationKey {
@@ -1135,10 +1158,6 @@ func (v2 *Handlers) RawTransactionAsync(ctx echo.Context) error {
 // AccountAssetsInformation looks up an account's asset holdings.
 // (GET /v2/accounts/{address}/assets)
 func (v2 *Handlers) AccountAssetsInformation(ctx echo.Context, address basics.Address, params model.AccountAssetsInformationParams) error {
-	if !v2.Node.Config().EnableExperimentalAPI {
-		return ctx.String(http.StatusNotFound, "/v2/accounts/{address}/assets was not enabled in the configuration file by setting the EnableExperimentalAPI to true")
-	}
-
 	var assetGreaterThan uint64 = 0
 	if params.Next != nil {
 		agt, err0 := strconv.ParseUint(*params.Next, 10, 64)
@@ -1217,6 +1236,60 @@ func (v2 *Handlers) AccountAssetsInformation(ctx echo.Context, address basics.Ad
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// AccountHistory looks up the balance of an account at a past round.
+// (GET /v2/accounts/{address}/history)
+func (v2 *Handlers) AccountHistory(ctx echo.Context, address basics.Address, params model.AccountHistoryParams) error {
+	record, withoutRewards, err := v2.Node.LookupAccountHistory(params.Round, address)
+	switch {
+	case errors.Is(err, ledger.ErrAccountHistoryDisabled):
+		return notFound(ctx, err, err.Error(), v2.Log)
+	case errors.Is(err, node.ErrAccountHistoryRoundUnavailable):
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	case err != nil:
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.AccountHistoryResponse{
+		Address:                     address.String(),
+		Round:                       params.Round,
+		Amount:                      record.MicroAlgos.Raw,
+		AmountWithoutPendingRewards: withoutRewards.Raw,
+		Status:                      record.Status.String(),
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountProof returns a merkle proof of the balance record of an account.
+// (GET /v2/accounts/{address}/proof)
+func (v2 *Handlers) AccountProof(ctx echo.Context, address basics.Address) error {
+	proof, err := v2.Node.AccountProof(address)
+	if errors.Is(err, ledger.ErrAccountCommitmentDisabled) || errors.Is(err, ledger.ErrNoBalanceRecord) {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	} else if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	record, err := proof.Verify()
+	if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.AccountProofResponse{
+		Address:                     address.String(),
+		Round:                       proof.Round,
+		Root:                        proof.Root[:],
+		Account:                     proof.EncodedAccountData,
+		AmountWithoutPendingRewards: record.MicroAlgos.Raw,
+		Proof:                       make([][]model.AccountProofChild, len(proof.Proof.Path)),
+	}
+	for i, level := range proof.Proof.Path {
+		response.Proof[i] = make([]model.AccountProofChild, len(level.Siblings))
+		for j, sibling := range level.Siblings {
+			response.Proof[i][j] = model.AccountProofChild{Index: int(sibling.Index), Leaf: sibling.Leaf, Hash: sibling.Hash}
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // PreEncodedSimulateTxnResult mirrors model.SimulateTransactionResult
 type PreEncodedSimulateTxnResult struct {
 	Txn                      PreEncodedTxInfo                        `codec:"txn-result"`
@@ -1730,6 +1803,10 @@ func applicationBoxesMaxKeys(requestedMax uint64, algodMax uint64) uint64 {
 // GetApplicationBoxes returns the boxes of an application
 // (GET /v2/applications/{application-id}/boxes)
 func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID basics.AppIndex, params model.GetApplicationBoxesParams) error {
+	if params.Prefix != nil || params.Next != nil || params.Limit != nil || params.Round != nil || params.Values != nil {
+		return v2.applicationBoxesPage(ctx, applicationID, params)
+	}
+
 	ledger := v2.Node.LedgerForAPI()
 	lastRound := ledger.Latest()
 	keyPrefix := apps.MakeBoxKey(uint64(applicationID), "")
@@ -1770,6 +1847,82 @@ func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID basics.A
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// applicationBoxesPage returns a page of the boxes of an application, in the order of their names.
+func (v2 *Handlers) applicationBoxesPage(ctx echo.Context, applicationID basics.AppIndex, params model.GetApplicationBoxesParams) error {
+	var namePrefix []byte
+	if params.Prefix != nil {
+		prefixBytes, err := apps.NewAppCallBytes(*params.Prefix)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		namePrefix, err = prefixBytes.Raw()
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
+	afterKey := ""
+	if params.Next != nil {
+		after, err := base64.URLEncoding.DecodeString(*params.Next)
+		if err != nil {
+			return badRequest(ctx, err, fmt.Sprintf("%s: %v", errUnableToParseNext, err), v2.Log)
+		}
+		afterKey = apps.MakeBoxKey(uint64(applicationID), string(after))
+	}
+
+	limit := DefaultBoxResults
+	if params.Limit != nil {
+		if *params.Limit <= 0 {
+			return badRequest(ctx, errors.New(errInvalidLimit), errInvalidLimit, v2.Log)
+		}
+		if *params.Limit > MaxBoxResults {
+			limitErrMsg := fmt.Sprintf("limit %d exceeds max boxes single batch limit %d", *params.Limit, MaxBoxResults)
+			return badRequest(ctx, errors.New(limitErrMsg), limitErrMsg, v2.Log)
+		}
+		limit = *params.Limit
+	}
+
+	myLedger := v2.Node.LedgerForAPI()
+	round := myLedger.Latest()
+	if params.Round != nil {
+		if *params.Round > round {
+			err := fmt.Errorf("round %d is after the latest round %d", *params.Round, round)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		round = *params.Round
+	}
+
+	// We intentionally request one more than the limit to determine if there are more boxes.
+	kvs, err := myLedger.LookupKvsByPrefix(round, apps.MakeBoxKey(uint64(applicationID), string(namePrefix)), afterKey, limit+1)
+	var roundOffsetError *ledger.RoundOffsetError
+	if errors.As(err, &roundOffsetError) {
+		return badRequest(ctx, err, fmt.Sprintf("round %d is no longer available: %v", round, err), v2.Log)
+	} else if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.BoxesResponse{Round: &round}
+	more := uint64(len(kvs)) > limit
+	if more {
+		// we do not include the last box in the response
+		kvs = kvs[:limit]
+	}
+	keyPrefixLen := len(apps.MakeBoxKey(uint64(applicationID), ""))
+	response.Boxes = make([]model.BoxDescriptor, len(kvs))
+	for i, kv := range kvs {
+		response.Boxes[i].Name = []byte(kv.Key[keyPrefixLen:])
+		if params.Values != nil && *params.Values {
+			value := kv.Value
+			response.Boxes[i].Value = &value
+		}
+	}
+	if more {
+		nextTk := base64.URLEncoding.EncodeToString(response.Boxes[len(kvs)-1].Name)
+		response.NextToken = &nextTk
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetApplicationBoxByName returns the value of an application's box
 // (GET /v2/applications/{application-id}/box)
 func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID basics.AppIndex, params model.GetApplicationBoxByNameParams) error {
@@ -2123,6 +2276,26 @@ func (v2 *Handlers) GetConfig(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, v2.Node.Config())
 }
 
+// GetLateProposers returns the proposers which held the lowest credential of recent rounds,
+// ordered by how often their credential arrived after the filter timeout.
+// (GET /debug/agreement/late-proposers)
+func (v2 *Handlers) GetLateProposers(ctx echo.Context) error {
+	proposers := v2.Node.LateProposers()
+	response := model.LateProposersResponse{Proposers: make([]model.LateProposer, len(proposers))}
+	for i, p := range proposers {
+		response.Proposers[i] = model.LateProposer{
+			Address:       p.Address.String(),
+			Rounds:        p.Rounds,
+			LateRounds:    p.LateRounds,
+			LastRound:     p.LastRound,
+			LastArrivalMs: p.LastArrival.Milliseconds(),
+			MaxLatenessMs: p.MaxLateness.Milliseconds(),
+			Lagging:       p.Lagging(),
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // PutDebugSettingsProf sets the mutex and blocking rates and returns the old values.
 func (v2 *Handlers) PutDebugSettingsProf(ctx echo.Context) error {
 	req := ctx.Request()
@@ -2169,3 +2342,217 @@ func (v2 *Handlers) PutDebugSettingsProf(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, response)
 }
+
+// maxPhonebookRequestBytes bounds the size of the body of phonebook edit requests.
+const maxPhonebookRequestBytes = 1 << 20
+
+// unixOrNil returns the seconds since the epoch of t, or nil if t is unset.
+func unixOrNil(t time.Time) *uint64 {
+	if t.IsZero() {
+		return nil
+	}
+	return omitEmpty(uint64(t.Unix()))
+}
+
+// phonebookError maps the errors of the phonebook administration methods to responses.
+func phonebookError(ctx echo.Context, err error, logger logging.Logger) error {
+	if errors.Is(err, node.ErrPhonebookUnavailable) {
+		return notFound(ctx, err, err.Error(), logger)
+	}
+	return internalError(ctx, err, err.Error(), logger)
+}
+
+// GetPhonebook lists the entries of the network phonebook.
+// (GET /v2/admin/phonebook)
+func (v2 *Handlers) GetPhonebook(ctx echo.Context) error {
+	entries, err := v2.Node.PhonebookEntries()
+	if err != nil {
+		return phonebookError(ctx, err, v2.Log)
+	}
+
+	response := model.PhonebookResponse{Entries: make([]model.PhonebookEntry, len(entries))}
+	for i, e := range entries {
+		entry := model.PhonebookEntry{
+			Address:      e.Address,
+			Roles:        phonebook.RoleNames(e.Roles),
+			Origins:      e.Origins,
+			RetryAfter:   unixOrNil(e.RetryAfter),
+			LastSuccess:  unixOrNil(e.LastSuccess),
+			Failures:     omitEmpty(uint64(e.Failures)),
+			BackoffUntil: unixOrNil(e.BackoffUntil),
+			BannedUntil:  unixOrNil(e.BannedUntil),
+		}
+		if names := phonebook.RoleNames(e.PersistentRoles); len(names) > 0 {
+			entry.PersistentRoles = &names
+		}
+		if len(e.NetworkAddresses) > 0 {
+			entry.NetworkAddresses = &e.NetworkAddresses
+		}
+		if len(e.RecentConnectionTimes) > 0 {
+			times := make([]uint64, len(e.RecentConnectionTimes))
+			for j, t := range e.RecentConnectionTimes {
+				times[j] = uint64(t.Unix())
+			}
+			entry.RecentConnectionTimes = &times
+		}
+		response.Entries[i] = entry
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AddPhonebookPeers adds persistent peers to the network phonebook.
+// (POST /v2/admin/phonebook)
+func (v2 *Handlers) AddPhonebookPeers(ctx echo.Context) error {
+	req := ctx.Request()
+	buf := new(bytes.Buffer)
+	req.Body = http.MaxBytesReader(nil, req.Body, maxPhonebookRequestBytes)
+	_, err := buf.ReadFrom(req.Body)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	var request model.PhonebookPeersRequest
+	err = decode(protocol.JSONStrictHandle, buf.Bytes(), &request)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if len(request.Addresses) == 0 {
+		err = errors.New("no addresses were given")
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	role := phonebook.RelayRole
+	if request.Roles != nil && len(*request.Roles) > 0 {
+		role = 0
+		for _, name := range *request.Roles {
+			r, err := phonebook.ParseRole(name)
+			if err != nil {
+				return badRequest(ctx, err, err.Error(), v2.Log)
+			}
+			role |= r
+		}
+	}
+
+	err = v2.Node.AddPhonebookPeers(request.Addresses, role)
+	if errors.Is(err, node.ErrPhonebookUnavailable) {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	} else if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	v2.Log.Infof("added phonebook peers %v with roles %v", request.Addresses, phonebook.RoleNames(role))
+	return ctx.NoContent(http.StatusOK)
+}
+
+// RemovePhonebookPeers removes peers from the network phonebook.
+// (DELETE /v2/admin/phonebook)
+func (v2 *Handlers) RemovePhonebookPeers(ctx echo.Context, params model.RemovePhonebookPeersParams) error {
+	if len(params.Address) == 0 {
+		err := errors.New("no address was given")
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.PhonebookRemoveResponse{Removed: []string{}}
+	for _, addr := range params.Address {
+		removed, err := v2.Node.RemovePhonebookPeer(addr)
+		if err != nil {
+			return phonebookError(ctx, err, v2.Log)
+		}
+		if removed {
+			response.Removed = append(response.Removed, addr)
+		}
+	}
+	v2.Log.Infof("removed phonebook peers %v", response.Removed)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// relayDrainResponse runs the given action on the node, and returns the resulting drain status.
+func (v2 *Handlers) relayDrainResponse(ctx echo.Context, action func() error) error {
+	var err error
+	if action != nil {
+		err = action()
+	}
+	var status network.RelayDrainStatus
+	if err == nil {
+		status, err = v2.Node.RelayDrainStatus()
+	}
+	if errors.Is(err, node.ErrRelayDrainUnavailable) {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	} else if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.RelayDrainResponse{
+		Draining:       status.Draining,
+		Since:          unixOrNil(status.Since),
+		IncomingPeers:  uint64(status.IncomingPeers),
+		SafeToShutdown: status.SafeToShutdown,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetRelayDrain returns the progress of the draining of the relay.
+// (GET /v2/admin/drain)
+func (v2 *Handlers) GetRelayDrain(ctx echo.Context) error {
+	return v2.relayDrainResponse(ctx, nil)
+}
+
+// StartRelayDrain starts draining the relay.
+// (POST /v2/admin/drain)
+func (v2 *Handlers) StartRelayDrain(ctx echo.Context) error {
+	return v2.relayDrainResponse(ctx, func() error {
+		if err := v2.Node.StartRelayDrain(); err != nil {
+			return err
+		}
+		v2.Log.Info("started draining the relay")
+		return nil
+	})
+}
+
+// StopRelayDrain stops draining the relay.
+// (DELETE /v2/admin/drain)
+func (v2 *Handlers) StopRelayDrain(ctx echo.Context) error {
+	return v2.relayDrainResponse(ctx, func() error {
+		if err := v2.Node.StopRelayDrain(); err != nil {
+			return err
+		}
+		v2.Log.Info("stopped draining the relay")
+		return nil
+	})
+}
+
+// snapshotResponseWriter sends the response header on the first write, so that the errors happening
+// before the snapshot is streamed can still be reported with an error status.
+type snapshotResponseWriter struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (s *snapshotResponseWriter) Write(p []byte) (int, error) {
+	if !s.started {
+		s.started = true
+		s.w.Header().Set(echo.HeaderContentType, "application/gzip")
+		s.w.Header().Set("Trailer", common.LedgerSnapshotErrorTrailer)
+		s.w.WriteHeader(http.StatusOK)
+	}
+	return s.w.Write(p)
+}
+
+// ExportLedgerSnapshot streams a snapshot of the ledger.
+// (GET /v2/admin/ledger/snapshot)
+func (v2 *Handlers) ExportLedgerSnapshot(ctx echo.Context) error {
+	w := &snapshotResponseWriter{w: ctx.Response().Writer}
+	header, err := v2.Node.ExportLedgerSnapshot(ctx.Request().Context(), w)
+	switch {
+	case err != nil && w.started:
+		// the status was already sent, so the error can only be reported in the trailer
+		v2.Log.Warnf("unable to export the ledger snapshot: %v", err)
+		ctx.Response().Header().Set(common.LedgerSnapshotErrorTrailer, err.Error())
+		return nil
+	case errors.Is(err, node.ErrLedgerSnapshotDuringCatchup):
+		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+	case err != nil:
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+	v2.Log.Infof("exported the ledger snapshot of the tracker database at round %d and the blocks %d...%d", header.TrackerRound, header.FirstBlock, header.LatestBlock)
+	return nil
+}
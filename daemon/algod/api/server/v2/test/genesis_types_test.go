@@ -181,10 +181,12 @@ func verifyTypeCompatibility(t *testing.T, bkType, modelType reflect.Type, tag s
 	case reflect.Ptr:
 		switch modelType.Elem().Kind() {
 		case reflect.String:
-			// Special case: if the model uses *string for string or crypto types, that's okay
+			// Special case: if the model uses *string for string, byte slice or crypto types, that's okay
 			switch {
 			case bkType.Kind() == reflect.String:
 				return
+			case bkType.Kind() == reflect.Slice && bkType.Elem().Kind() == reflect.Uint8:
+				return
 			case bkType.String() == "crypto.OneTimeSignatureVerifier",
 				bkType.String() == "merklesignature.Commitment",
 				bkType.String() == "crypto.VRFVerifier",
@@ -212,12 +214,21 @@ func verifyTypeCompatibility(t *testing.T, bkType, modelType reflect.Type, tag s
 				return
 			}
 
+		case reflect.Map:
+			// Special case: maps of resources are left as untyped objects in the model
+			if bkType.Kind() == reflect.Map {
+				return
+			}
+
 		default:
 			// For other pointer types, check the underlying type
 			if bkType.Kind() == reflect.Ptr {
 				verifyTypeCompatibility(t, bkType.Elem(), modelType.Elem(), tag)
 				return
 			}
+			// Optional fields are pointers in the model
+			verifyTypeCompatibility(t, bkType, modelType.Elem(), tag)
+			return
 		}
 
 	case reflect.Slice:
@@ -228,6 +239,10 @@ func verifyTypeCompatibility(t *testing.T, bkType, modelType reflect.Type, tag s
 				strings.HasSuffix(modelType.Elem().String(), "GenesisAllocation") {
 				return
 			}
+			if isStructOrPtrToStruct(bkType.Elem()) && isStructOrPtrToStruct(modelType.Elem()) {
+				verifyStructCompatibility(t, bkType.Elem(), modelType.Elem())
+				return
+			}
 			verifyTypeCompatibility(t, bkType.Elem(), modelType.Elem(), tag)
 			return
 		}
@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -31,6 +33,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/avm-abi/apps"
+
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
@@ -89,6 +93,20 @@ func (l *mockLedger) LookupKeysByPrefix(round basics.Round, keyPrefix string, ma
 	panic("not implemented")
 }
 
+func (l *mockLedger) LookupKvsByPrefix(round basics.Round, keyPrefix string, after string, maxKeyNum uint64) ([]ledgercore.KvPair, error) {
+	var kvs []ledgercore.KvPair
+	for key, value := range l.kvstore {
+		if key > after && strings.HasPrefix(key, keyPrefix) {
+			kvs = append(kvs, ledgercore.KvPair{Key: key, Value: value})
+		}
+	}
+	slices.SortFunc(kvs, func(a, b ledgercore.KvPair) int { return strings.Compare(a.Key, b.Key) })
+	if uint64(len(kvs)) > maxKeyNum {
+		kvs = kvs[:maxKeyNum]
+	}
+	return kvs, nil
+}
+
 func (l *mockLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
 	return config.Consensus[protocol.ConsensusFuture], nil
 }
@@ -277,7 +295,6 @@ func setupTestForLargeResources(t *testing.T, acctSize, maxResults int, accountM
 
 	mockNode := makeMockNode(&ml, t.Name(), nil, cannedStatusReportGolden, false)
 	mockNode.config.MaxAPIResourcesPerAccount = uint64(maxResults)
-	mockNode.config.EnableExperimentalAPI = true
 	dummyShutdownChan := make(chan struct{})
 	handlers = v2.Handlers{
 		Node:     mockNode,
@@ -548,3 +565,73 @@ func TestAccountInformationResourceLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetApplicationBoxesPages(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	appID := basics.AppIndex(7)
+	ml := mockLedger{
+		kvstore: map[string][]byte{
+			apps.MakeBoxKey(uint64(appID), "a1"):   []byte("v1"),
+			apps.MakeBoxKey(uint64(appID), "a2"):   []byte("v2"),
+			apps.MakeBoxKey(uint64(appID), "a3"):   []byte("v3"),
+			apps.MakeBoxKey(uint64(appID), "b1"):   []byte("v4"),
+			apps.MakeBoxKey(uint64(appID+1), "a4"): []byte("v5"),
+		},
+		latest: basics.Round(10),
+	}
+	handlers := v2.Handlers{
+		Node: makeMockNode(&ml, t.Name(), nil, cannedStatusReportGolden, false),
+		Log:  logging.Base(),
+	}
+	getPage := func(params model.GetApplicationBoxesParams, expectedCode int) model.BoxesResponse {
+		c, rec := newReq(t)
+		require.NoError(t, handlers.GetApplicationBoxes(c, appID, params))
+		require.Equal(t, expectedCode, rec.Code)
+		var response model.BoxesResponse
+		if expectedCode == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		}
+		return response
+	}
+	names := func(response model.BoxesResponse) []string {
+		var out []string
+		for _, box := range response.Boxes {
+			out = append(out, string(box.Name))
+		}
+		return out
+	}
+
+	prefix := "str:a"
+	limit := uint64(2)
+	values := true
+	response := getPage(model.GetApplicationBoxesParams{Prefix: &prefix, Limit: &limit, Values: &values}, http.StatusOK)
+	require.Equal(t, []string{"a1", "a2"}, names(response))
+	require.Equal(t, []byte("v1"), *response.Boxes[0].Value)
+	require.Equal(t, basics.Round(10), *response.Round)
+	require.NotNil(t, response.NextToken)
+
+	response = getPage(model.GetApplicationBoxesParams{Prefix: &prefix, Limit: &limit, Next: response.NextToken, Round: response.Round}, http.StatusOK)
+	require.Equal(t, []string{"a3"}, names(response))
+	require.Nil(t, response.Boxes[0].Value)
+	require.Nil(t, response.NextToken)
+
+	// without a prefix, all the boxes of the application are returned
+	response = getPage(model.GetApplicationBoxesParams{Limit: &limit}, http.StatusOK)
+	require.Equal(t, []string{"a1", "a2"}, names(response))
+	response = getPage(model.GetApplicationBoxesParams{Limit: &limit, Next: response.NextToken}, http.StatusOK)
+	require.Equal(t, []string{"a3", "b1"}, names(response))
+	require.Nil(t, response.NextToken)
+
+	badPrefix := "bogus:a"
+	getPage(model.GetApplicationBoxesParams{Prefix: &badPrefix}, http.StatusBadRequest)
+	badNext := "!"
+	getPage(model.GetApplicationBoxesParams{Next: &badNext}, http.StatusBadRequest)
+	zero := uint64(0)
+	getPage(model.GetApplicationBoxesParams{Limit: &zero}, http.StatusBadRequest)
+	tooMany := uint64(v2.MaxBoxResults + 1)
+	getPage(model.GetApplicationBoxesParams{Limit: &tooMany}, http.StatusBadRequest)
+	future := basics.Round(11)
+	getPage(model.GetApplicationBoxesParams{Round: &future}, http.StatusBadRequest)
+}
@@ -36,8 +36,10 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -52,8 +54,10 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklearray"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
@@ -65,6 +69,8 @@ import (
 	"github.com/algorand/go-algorand/data/txntest"
 	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/stateproof"
@@ -2611,3 +2617,291 @@ func TestGetConfigEndpoint(t *testing.T) {
 
 	require.Equal(t, handler.Node.Config(), responseConfig)
 }
+
+func TestPhonebookEndpoints(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.phonebook = phonebook.MakePhonebook(1, time.Millisecond)
+	mockNode.phonebook.ReplacePeerList([]string{"a:4160", "b:4160"}, "mainnet", phonebook.RelayRole)
+
+	submit := func(t *testing.T, n *mockNode, method string, target string, body string, expectedCode int) []byte {
+		handler := v2.Handlers{
+			Node: n,
+			Log:  logging.Base(),
+		}
+		e := echo.New()
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		var err error
+		switch method {
+		case http.MethodGet:
+			err = handler.GetPhonebook(c)
+		case http.MethodPost:
+			err = handler.AddPhonebookPeers(c)
+		case http.MethodDelete:
+			err = handler.RemovePhonebookPeers(c, model.RemovePhonebookPeersParams{Address: c.QueryParams()["address"]})
+		}
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		return rec.Body.Bytes()
+	}
+
+	submit(t, mockNode, http.MethodPost, "/v2/admin/phonebook", `{"addresses":["c:4160"],"roles":["relay","archival"]}`, http.StatusOK)
+	submit(t, mockNode, http.MethodPost, "/v2/admin/phonebook", `{"addresses":["d:4160"],"roles":["bogus"]}`, http.StatusBadRequest)
+	submit(t, mockNode, http.MethodPost, "/v2/admin/phonebook", `{"addresses":[]}`, http.StatusBadRequest)
+
+	body := submit(t, mockNode, http.MethodGet, "/v2/admin/phonebook", "", http.StatusOK)
+	var response model.PhonebookResponse
+	require.NoError(t, json.Unmarshal(body, &response))
+	entries := response.Entries
+	require.Len(t, entries, 3)
+	require.Equal(t, "a:4160", entries[0].Address)
+	require.Equal(t, []string{"relay"}, entries[0].Roles)
+	require.Nil(t, entries[0].PersistentRoles)
+	require.Equal(t, []string{"mainnet"}, entries[0].Origins)
+	require.Equal(t, "c:4160", entries[2].Address)
+	require.Equal(t, []string{"relay", "archival"}, entries[2].Roles)
+	require.Equal(t, &[]string{"relay", "archival"}, entries[2].PersistentRoles)
+	require.Equal(t, []string{"admin"}, entries[2].Origins)
+
+	body = submit(t, mockNode, http.MethodDelete, "/v2/admin/phonebook?address=a:4160&address=c:4160&address=x:4160", "", http.StatusOK)
+	var removed model.PhonebookRemoveResponse
+	require.NoError(t, json.Unmarshal(body, &removed))
+	require.Equal(t, []string{"a:4160", "c:4160"}, removed.Removed)
+	require.Equal(t, []string{"b:4160"}, mockNode.phonebook.GetAddresses(10, phonebook.RelayRole))
+
+	submit(t, mockNode, http.MethodDelete, "/v2/admin/phonebook", "", http.StatusBadRequest)
+
+	// nodes without a phonebook
+	noPhonebook := makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false)
+	submit(t, noPhonebook, http.MethodGet, "/v2/admin/phonebook", "", http.StatusNotFound)
+	submit(t, noPhonebook, http.MethodPost, "/v2/admin/phonebook", `{"addresses":["c:4160"]}`, http.StatusNotFound)
+}
+
+func TestRelayDrainEndpoints(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.drainStatus = &network.RelayDrainStatus{IncomingPeers: 3}
+
+	submit := func(t *testing.T, n *mockNode, method string, expectedCode int) model.RelayDrainResponse {
+		handler := v2.Handlers{
+			Node: n,
+			Log:  logging.Base(),
+		}
+		e := echo.New()
+		req := httptest.NewRequest(method, "/v2/admin/drain", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		var err error
+		switch method {
+		case http.MethodGet:
+			err = handler.GetRelayDrain(c)
+		case http.MethodPost:
+			err = handler.StartRelayDrain(c)
+		case http.MethodDelete:
+			err = handler.StopRelayDrain(c)
+		}
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+
+		var response model.RelayDrainResponse
+		if expectedCode == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		}
+		return response
+	}
+
+	response := submit(t, mockNode, http.MethodGet, http.StatusOK)
+	require.Equal(t, model.RelayDrainResponse{IncomingPeers: 3}, response)
+
+	response = submit(t, mockNode, http.MethodPost, http.StatusOK)
+	require.Equal(t, model.RelayDrainResponse{Draining: true, Since: omitEmpty(uint64(1700000000)), IncomingPeers: 3}, response)
+
+	response = submit(t, mockNode, http.MethodDelete, http.StatusOK)
+	require.Equal(t, model.RelayDrainResponse{IncomingPeers: 3}, response)
+
+	// nodes which can't be drained
+	submit(t, makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false), http.MethodPost, http.StatusNotFound)
+}
+
+func TestExportLedgerSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	submit := func(t *testing.T, n *mockNode, expectedCode int) *httptest.ResponseRecorder {
+		handler := v2.Handlers{
+			Node: n,
+			Log:  logging.Base(),
+		}
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/v2/admin/ledger/snapshot", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, handler.ExportLedgerSnapshot(c))
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+
+	mockNode := makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.snapshot = []byte("snapshot")
+	rec := submit(t, mockNode, http.StatusOK)
+	require.Equal(t, "application/gzip", rec.Header().Get(echo.HeaderContentType))
+	require.Equal(t, "snapshot", rec.Body.String())
+	require.Empty(t, rec.Header().Get(common.LedgerSnapshotErrorTrailer))
+
+	// errors happening before the snapshot is streamed are reported with their status
+	mockNode = makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.snapshotErr = node.ErrLedgerSnapshotDuringCatchup
+	submit(t, mockNode, http.StatusServiceUnavailable)
+
+	mockNode.snapshotErr = errors.New("disk failure")
+	submit(t, mockNode, http.StatusInternalServerError)
+
+	// errors happening once the snapshot is streamed are reported in the trailer
+	mockNode.snapshot = []byte("snap")
+	rec = submit(t, mockNode, http.StatusOK)
+	require.Equal(t, "snap", rec.Body.String())
+	require.Equal(t, "disk failure", rec.Header().Get(common.LedgerSnapshotErrorTrailer))
+}
+
+func TestAccountHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node: mockNode,
+		Log:  logging.Base(),
+	}
+	submit := func(t *testing.T, round basics.Round, expectedCode int) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, handler.AccountHistory(c, basics.Address{1}, model.AccountHistoryParams{Round: round}))
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+
+	record := ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{Status: basics.Online, MicroAlgos: basics.MicroAlgos{Raw: 1500}}}
+	mockNode.On("LookupAccountHistory", basics.Round(5), basics.Address{1}).Return(record, basics.MicroAlgos{Raw: 1000}, nil)
+	mockNode.On("LookupAccountHistory", basics.Round(6), basics.Address{1}).Return(ledgercore.AccountData{}, basics.MicroAlgos{}, node.ErrAccountHistoryRoundUnavailable)
+	mockNode.On("LookupAccountHistory", basics.Round(7), basics.Address{1}).Return(ledgercore.AccountData{}, basics.MicroAlgos{}, ledger.ErrAccountHistoryDisabled)
+
+	rec := submit(t, 5, http.StatusOK)
+	var response model.AccountHistoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.AccountHistoryResponse{
+		Address:                     basics.Address{1}.String(),
+		Round:                       5,
+		Amount:                      1500,
+		AmountWithoutPendingRewards: 1000,
+		Status:                      basics.Online.String(),
+	}, response)
+
+	submit(t, 6, http.StatusBadRequest)
+	submit(t, 7, http.StatusNotFound)
+	mock.AssertExpectationsForObjects(t, mockNode)
+}
+
+func TestAccountProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node: mockNode,
+		Log:  logging.Base(),
+	}
+	submit := func(t *testing.T, address basics.Address, expectedCode int) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, handler.AccountProof(c, address))
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+
+	mockNode.On("AccountProof", basics.Address{1}).Return(ledger.AccountProof{}, ledger.ErrAccountCommitmentDisabled)
+	mockNode.On("AccountProof", basics.Address{2}).Return(ledger.AccountProof{}, ledger.ErrNoBalanceRecord)
+	// a proof which doesn't verify against its root
+	mockNode.On("AccountProof", basics.Address{3}).Return(ledger.AccountProof{Address: basics.Address{3}, EncodedAccountData: []byte{0x80}}, nil)
+
+	// a valid proof from a trie holding two accounts
+	trie, err := merkletrie.MakeTrie(&merkletrie.InMemoryCommitter{}, trackerdb.TrieMemoryConfig)
+	require.NoError(t, err)
+	var elements [][]byte
+	var encoded []byte
+	for i := byte(4); i <= 5; i++ {
+		ad := trackerdb.BaseAccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000 * uint64(i)}, UpdateRound: 1}
+		encoded = protocol.Encode(&ad)
+		elements = append(elements, trackerdb.AccountHashBuilderV6(basics.Address{i}, &ad, encoded))
+		_, err = trie.Add(elements[len(elements)-1])
+		require.NoError(t, err)
+	}
+	root, err := trie.RootHash()
+	require.NoError(t, err)
+	proof, err := trie.Prove(elements[1])
+	require.NoError(t, err)
+	mockNode.On("AccountProof", basics.Address{5}).Return(ledger.AccountProof{Round: 7, Root: root, Address: basics.Address{5}, EncodedAccountData: encoded, Proof: *proof}, nil)
+
+	submit(t, basics.Address{1}, http.StatusNotFound)
+	submit(t, basics.Address{2}, http.StatusNotFound)
+	submit(t, basics.Address{3}, http.StatusInternalServerError)
+	rec := submit(t, basics.Address{5}, http.StatusOK)
+	var response model.AccountProofResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, basics.Address{5}.String(), response.Address)
+	require.Equal(t, basics.Round(7), response.Round)
+	require.Equal(t, root[:], response.Root)
+	require.Equal(t, encoded, response.Account)
+	require.Equal(t, uint64(5000), response.AmountWithoutPendingRewards)
+	require.Len(t, response.Proof, len(proof.Path))
+	mock.AssertExpectationsForObjects(t, mockNode)
+}
+
+func TestGetLateProposers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node: mockNode,
+		Log:  logging.Base(),
+	}
+
+	get := func(t *testing.T) model.LateProposersResponse {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/debug/agreement/late-proposers", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, handler.GetLateProposers(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response model.LateProposersResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response
+	}
+
+	// the proposers are always reported as a list, even if none were tracked
+	require.Equal(t, model.LateProposersResponse{Proposers: []model.LateProposer{}}, get(t))
+
+	lagging := basics.Address(crypto.Hash([]byte("lagging")))
+	punctual := basics.Address(crypto.Hash([]byte("punctual")))
+	mockNode.lateProposers = []agreement.LateProposer{
+		{Address: lagging, Rounds: 4, LateRounds: 3, LastRound: 120, LastArrival: 5300 * time.Millisecond, MaxLateness: 1800 * time.Millisecond},
+		{Address: punctual, Rounds: 6, LateRounds: 1, LastRound: 118, LastArrival: 2100 * time.Millisecond, MaxLateness: 200 * time.Millisecond},
+	}
+	require.Equal(t, model.LateProposersResponse{Proposers: []model.LateProposer{
+		{Address: lagging.String(), Rounds: 4, LateRounds: 3, LastRound: 120, LastArrivalMs: 5300, MaxLatenessMs: 1800, Lagging: true},
+		{Address: punctual.String(), Rounds: 6, LateRounds: 1, LastRound: 118, LastArrivalMs: 2100, MaxLatenessMs: 200, Lagging: false},
+	}}, get(t))
+}
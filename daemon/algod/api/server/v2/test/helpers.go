@@ -17,13 +17,17 @@
 package test
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
@@ -35,8 +39,12 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
@@ -97,6 +105,11 @@ type mockNode struct {
 	devmode         bool
 	timestampOffset *int64
 	PartKeyBinary   []byte
+	phonebook       phonebook.Phonebook
+	drainStatus     *network.RelayDrainStatus
+	snapshot        []byte
+	snapshotErr     error
+	lateProposers   []agreement.LateProposer
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -221,6 +234,75 @@ func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	return m.timestampOffset, nil
 }
 
+func (m *mockNode) PhonebookEntries() ([]phonebook.Entry, error) {
+	if m.phonebook == nil {
+		return nil, node.ErrPhonebookUnavailable
+	}
+	return m.phonebook.Entries(), nil
+}
+
+func (m *mockNode) AddPhonebookPeers(addrs []string, role phonebook.Role) error {
+	if m.phonebook == nil {
+		return node.ErrPhonebookUnavailable
+	}
+	m.phonebook.AddPersistentPeers(addrs, "admin", role)
+	return nil
+}
+
+func (m *mockNode) RemovePhonebookPeer(addr string) (bool, error) {
+	if m.phonebook == nil {
+		return false, node.ErrPhonebookUnavailable
+	}
+	return m.phonebook.RemovePeer(addr), nil
+}
+
+func (m *mockNode) StartRelayDrain() error {
+	if m.drainStatus == nil {
+		return node.ErrRelayDrainUnavailable
+	}
+	m.drainStatus.Draining = true
+	m.drainStatus.Since = time.Unix(1700000000, 0)
+	return nil
+}
+
+func (m *mockNode) StopRelayDrain() error {
+	if m.drainStatus == nil {
+		return node.ErrRelayDrainUnavailable
+	}
+	*m.drainStatus = network.RelayDrainStatus{IncomingPeers: m.drainStatus.IncomingPeers}
+	return nil
+}
+
+func (m *mockNode) RelayDrainStatus() (network.RelayDrainStatus, error) {
+	if m.drainStatus == nil {
+		return network.RelayDrainStatus{}, node.ErrRelayDrainUnavailable
+	}
+	return *m.drainStatus, nil
+}
+
+func (m *mockNode) ExportLedgerSnapshot(ctx context.Context, w io.Writer) (ledger.SnapshotHeader, error) {
+	if len(m.snapshot) > 0 {
+		if _, err := w.Write(m.snapshot); err != nil {
+			return ledger.SnapshotHeader{}, err
+		}
+	}
+	return ledger.SnapshotHeader{}, m.snapshotErr
+}
+
+func (m *mockNode) LookupAccountHistory(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.MicroAlgos, error) {
+	args := m.Called(round, addr)
+	return args.Get(0).(ledgercore.AccountData), args.Get(1).(basics.MicroAlgos), args.Error(2)
+}
+
+func (m *mockNode) AccountProof(addr basics.Address) (ledger.AccountProof, error) {
+	args := m.Called(addr)
+	return args.Get(0).(ledger.AccountProof), args.Error(1)
+}
+
+func (m *mockNode) LateProposers() []agreement.LateProposer {
+	return m.lateProposers
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
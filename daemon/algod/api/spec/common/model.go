@@ -56,3 +56,7 @@ type BuildVersion struct {
 	// Branch-derived release channel the build is based on
 	Channel string `json:"channel"`
 }
+
+// LedgerSnapshotErrorTrailer is the HTTP trailer of the ledger snapshot export holding the error which
+// interrupted it once its streaming had started. It is empty when the snapshot is complete.
+const LedgerSnapshotErrorTrailer = "X-Algorand-Snapshot-Error"
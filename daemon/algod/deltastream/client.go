@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package deltastream
+
+import (
+	"errors"
+	"net"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Client reads the state deltas streamed by a Server.
+type Client struct {
+	conn net.Conn
+	dec  protocol.Decoder
+}
+
+// Dial connects to the stream served on the unix socket at path, starting from round rnd.
+func Dial(path string, rnd basics.Round) (*Client, error) {
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	return Subscribe(conn, rnd)
+}
+
+// Subscribe requests the stream starting from round rnd on an established connection.
+func Subscribe(conn net.Conn, rnd basics.Round) (*Client, error) {
+	err := protocol.NewEncoder(conn).Encode(Request{Round: rnd})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &Client{conn: conn, dec: protocol.NewDecoder(conn)}, nil
+}
+
+// Next returns the state delta of the next round. It blocks until the round is committed.
+func (c *Client) Next() (basics.Round, ledgercore.StateDelta, error) {
+	var msg Message
+	err := c.dec.Decode(&msg)
+	if err != nil {
+		return 0, ledgercore.StateDelta{}, err
+	}
+	if msg.Error != "" {
+		return msg.Round, ledgercore.StateDelta{}, errors.New(msg.Error)
+	}
+	if msg.Delta == nil {
+		return msg.Round, ledgercore.StateDelta{}, errors.New("deltastream: message without a delta")
+	}
+	return msg.Round, *msg.Delta, nil
+}
+
+// Close closes the connection to the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
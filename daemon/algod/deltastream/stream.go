@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package deltastream pushes the state delta of every committed round to subscribers connected
+// to a local socket, so that indexing pipelines don't need to poll the deltas REST endpoint.
+//
+// A subscriber sends a single Request with the round to start from, then receives a Message
+// for every round, in order, as soon as the round is committed. The stream ends with a Message
+// holding an Error if the deltas of a round are no longer available, for example because the
+// subscriber fell behind on a node which doesn't hold them with a sync round. The subscriber can
+// then resume from the round following the last one it received.
+package deltastream
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Request is sent by a subscriber when it connects.
+type Request struct {
+	// Round is the first round to stream the delta of. Zero starts from the round following the latest one.
+	Round basics.Round `codec:"round"`
+}
+
+// Message holds the state delta of a round, or the reason why the stream ended.
+type Message struct {
+	Round basics.Round           `codec:"round"`
+	Delta *ledgercore.StateDelta `codec:"delta,omitempty"`
+	Error string                 `codec:"error,omitempty"`
+}
+
+// LedgerForStream is the subset of the ledger the stream reads the deltas from.
+type LedgerForStream interface {
+	Latest() basics.Round
+	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
+	WaitWithCancel(r basics.Round) (chan struct{}, func())
+}
+
+// Server streams the state deltas to the subscribers accepted on a listener.
+type Server struct {
+	ledger LedgerForStream
+	log    logging.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+
+	wg sync.WaitGroup
+}
+
+// MakeServer creates a Server streaming the deltas of the given ledger.
+func MakeServer(ledger LedgerForStream, log logging.Logger) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Server{
+		ledger: ledger,
+		log:    log,
+		ctx:    ctx,
+		cancel: cancel,
+		conns:  make(map[net.Conn]struct{}),
+	}
+}
+
+// Serve accepts subscribers on the listener until the server is stopped.
+func (s *Server) Serve(listener net.Listener) error {
+	s.mu.Lock()
+	if s.ctx.Err() != nil {
+		s.mu.Unlock()
+		listener.Close()
+		return net.ErrClosed
+	}
+	s.listener = listener
+	s.mu.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		s.mu.Lock()
+		if s.ctx.Err() != nil {
+			s.mu.Unlock()
+			conn.Close()
+			return nil
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go s.serveConn(conn)
+	}
+}
+
+// Stop closes the listener and the connections of the subscribers, and waits for their streams to end.
+func (s *Server) Stop() {
+	s.mu.Lock()
+	s.cancel()
+	if s.listener != nil {
+		s.listener.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		conn.Close()
+		s.wg.Done()
+	}()
+
+	var req Request
+	err := protocol.NewDecoder(conn).Decode(&req)
+	if err != nil {
+		s.log.Debugf("deltastream: unable to read the request of %v : %v", conn.RemoteAddr(), err)
+		return
+	}
+
+	err = s.stream(conn, req.Round)
+	if err != nil && s.ctx.Err() == nil {
+		s.log.Infof("deltastream: stream to %v ended : %v", conn.RemoteAddr(), err)
+	}
+}
+
+// stream writes the deltas from round rnd until the server is stopped or the connection fails.
+func (s *Server) stream(conn net.Conn, rnd basics.Round) error {
+	if rnd == 0 {
+		rnd = s.ledger.Latest() + 1
+	}
+	enc := protocol.NewEncoder(conn)
+	for {
+		done, cancel := s.ledger.WaitWithCancel(rnd)
+		select {
+		case <-done:
+		case <-s.ctx.Done():
+			cancel()
+			return s.ctx.Err()
+		}
+
+		delta, err := s.ledger.GetStateDeltaForRound(rnd)
+		if err != nil {
+			err = fmt.Errorf("the delta of round %d is unavailable: %w", rnd, err)
+			_ = enc.Encode(Message{Round: rnd, Error: err.Error()})
+			return err
+		}
+		err = enc.Encode(Message{Round: rnd, Delta: &delta})
+		if err != nil {
+			return err
+		}
+		rnd++
+	}
+}
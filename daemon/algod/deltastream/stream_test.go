@@ -0,0 +1,195 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package deltastream
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type mockLedger struct {
+	mu      sync.Mutex
+	latest  basics.Round
+	deltas  map[basics.Round]ledgercore.StateDelta
+	waiters map[basics.Round]chan struct{}
+}
+
+func makeMockLedger() *mockLedger {
+	return &mockLedger{
+		deltas:  make(map[basics.Round]ledgercore.StateDelta),
+		waiters: make(map[basics.Round]chan struct{}),
+	}
+}
+
+// addRound adds the next round, using its number as the PrevTimestamp of the delta.
+func (ml *mockLedger) addRound() {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	ml.latest++
+	ml.deltas[ml.latest] = ledgercore.StateDelta{PrevTimestamp: int64(ml.latest)}
+	if ch, ok := ml.waiters[ml.latest]; ok {
+		close(ch)
+		delete(ml.waiters, ml.latest)
+	}
+}
+
+func (ml *mockLedger) forget(rnd basics.Round) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	delete(ml.deltas, rnd)
+}
+
+func (ml *mockLedger) Latest() basics.Round {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	return ml.latest
+}
+
+func (ml *mockLedger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	delta, ok := ml.deltas[rnd]
+	if !ok {
+		return ledgercore.StateDelta{}, fmt.Errorf("no delta for round %d", rnd)
+	}
+	return delta, nil
+}
+
+func (ml *mockLedger) WaitWithCancel(r basics.Round) (chan struct{}, func()) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	if r <= ml.latest {
+		ch := make(chan struct{})
+		close(ch)
+		return ch, func() {}
+	}
+	ch, ok := ml.waiters[r]
+	if !ok {
+		ch = make(chan struct{})
+		ml.waiters[r] = ch
+	}
+	return ch, func() {}
+}
+
+// connect serves a new subscriber over an in-memory connection.
+func connect(t *testing.T, s *Server, rnd basics.Round) *Client {
+	serverConn, clientConn := net.Pipe()
+	s.mu.Lock()
+	s.conns[serverConn] = struct{}{}
+	s.wg.Add(1)
+	s.mu.Unlock()
+	go s.serveConn(serverConn)
+
+	c, err := Subscribe(clientConn, rnd)
+	require.NoError(t, err)
+	return c
+}
+
+func requireNext(t *testing.T, c *Client, rnd basics.Round) {
+	t.Helper()
+	r, delta, err := c.Next()
+	require.NoError(t, err)
+	require.Equal(t, rnd, r)
+	require.Equal(t, int64(rnd), delta.PrevTimestamp)
+}
+
+func TestStreamResumeAndFollow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ml := makeMockLedger()
+	for i := 0; i < 5; i++ {
+		ml.addRound()
+	}
+	s := MakeServer(ml, logging.TestingLog(t))
+	defer s.Stop()
+
+	c := connect(t, s, 3)
+	defer c.Close()
+	requireNext(t, c, 3)
+	requireNext(t, c, 4)
+	requireNext(t, c, 5)
+
+	// the next rounds are pushed as they are added
+	ml.addRound()
+	requireNext(t, c, 6)
+	ml.addRound()
+	requireNext(t, c, 7)
+
+	// round zero starts after the latest round
+	latest := connect(t, s, 0)
+	defer latest.Close()
+	ml.addRound()
+	requireNext(t, latest, 8)
+	requireNext(t, c, 8)
+}
+
+func TestStreamDeltaUnavailable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ml := makeMockLedger()
+	for i := 0; i < 3; i++ {
+		ml.addRound()
+	}
+	ml.forget(1)
+	s := MakeServer(ml, logging.TestingLog(t))
+	defer s.Stop()
+
+	c := connect(t, s, 1)
+	defer c.Close()
+	rnd, _, err := c.Next()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the delta of round 1 is unavailable")
+	require.Equal(t, basics.Round(1), rnd)
+
+	// the subscriber can resume from a later round
+	c2 := connect(t, s, 2)
+	defer c2.Close()
+	requireNext(t, c2, 2)
+}
+
+func TestStreamStop(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ml := makeMockLedger()
+	ml.addRound()
+	s := MakeServer(ml, logging.TestingLog(t))
+
+	c := connect(t, s, 1)
+	defer c.Close()
+	requireNext(t, c, 1)
+
+	// the subscriber waits for round 2 when the server stops
+	s.Stop()
+	_, _, err := c.Next()
+	require.Error(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.ErrorIs(t, s.Serve(listener), net.ErrClosed)
+}
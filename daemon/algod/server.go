@@ -38,6 +38,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	apiServer "github.com/algorand/go-algorand/daemon/algod/api/server"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	"github.com/algorand/go-algorand/daemon/algod/deltastream"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/logging"
@@ -74,6 +75,7 @@ type Server struct {
 	node                 ServerNode
 	metricCollector      *metrics.MetricService
 	metricServiceStarted bool
+	deltaStream          *deltastream.Server
 	stopping             chan struct{}
 }
 
@@ -139,7 +141,7 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 	}
 	// TODO: remove this after making pebble support official
 	// and integrate the value into ReservedFDs config parameter.
-	if cfg.StorageEngine == "pebbledb" {
+	if cfg.StorageEngine == "pebbledb" || cfg.BlockStorageEngine == "pebbledb" {
 		fdRequired = ot.Add(fdRequired, 1000)
 		if ot.Overflowed {
 			return errors.New(
@@ -364,6 +366,14 @@ func (s *Server) Start() {
 
 	s.stopping = make(chan struct{})
 
+	if cfg.DeltaStreamSocket != "" {
+		err = s.startDeltaStream(cfg.DeltaStreamSocket)
+		if err != nil {
+			fmt.Printf("Could not start the delta stream: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	addr := cfg.EndpointAddress
 	if addr == "" {
 		addr = ":http"
@@ -456,6 +466,32 @@ func (s *Server) Start() {
 	}
 }
 
+// startDeltaStream serves the state deltas stream on the unix socket at socketPath.
+func (s *Server) startDeltaStream(socketPath string) error {
+	if !filepath.IsAbs(socketPath) {
+		socketPath = filepath.Join(s.RootPath, socketPath)
+	}
+	// remove the socket left over by a node which didn't shut down cleanly
+	err := os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return err
+	}
+
+	s.deltaStream = deltastream.MakeServer(s.node.LedgerForAPI(), s.log)
+	go func() {
+		err := s.deltaStream.Serve(listener)
+		if err != nil {
+			s.log.Warnf("delta stream on %s stopped : %v", socketPath, err)
+		}
+	}()
+	s.log.Infof("streaming the state deltas on %s", socketPath)
+	return nil
+}
+
 // Stop initiates a graceful shutdown of the node by shutting down the network server.
 func (s *Server) Stop() {
 	// close the s.stopping, which would signal the rest api router that any pending commands
@@ -465,6 +501,10 @@ func (s *Server) Stop() {
 	// Attempt to log a shutdown event before we exit...
 	s.log.Event(telemetryspec.ApplicationState, telemetryspec.ShutdownEvent)
 
+	if s.deltaStream != nil {
+		s.deltaStream.Stop()
+	}
+
 	s.node.Stop()
 
 	err := server.Shutdown(context.Background())
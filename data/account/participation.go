@@ -223,6 +223,45 @@ func DefaultKeyDilution(first, last basics.Round) uint64 {
 
 // FillDBWithParticipationKeys initializes the passed database with participation keys
 func FillDBWithParticipationKeys(store db.Accessor, address basics.Address, firstValid, lastValid basics.Round, keyDilution uint64) (part PersistedParticipation, err error) {
+	return fillDBWithParticipationKeys(store, address, firstValid, lastValid, keyDilution,
+		crypto.GenerateOneTimeSignatureSecrets, crypto.GenerateVRFSecrets, merklesignature.New)
+}
+
+// FillDBWithParticipationKeysWithoutStateProof is a version of FillDBWithParticipationKeys that doesn't
+// generate the state proof keys, which take most of the generation time. The account can't sign state proofs.
+func FillDBWithParticipationKeysWithoutStateProof(store db.Accessor, address basics.Address, firstValid, lastValid basics.Round, keyDilution uint64) (part PersistedParticipation, err error) {
+	return fillDBWithParticipationKeys(store, address, firstValid, lastValid, keyDilution,
+		crypto.GenerateOneTimeSignatureSecrets, crypto.GenerateVRFSecrets, nil)
+}
+
+// FillDBWithParticipationKeysRNG is a version of FillDBWithParticipationKeys drawing all the keys
+// from rng, so the same rng state always gives the same keys. It is meant for reproducible test networks.
+// The state proof keys are only generated when stateProof is set.
+func FillDBWithParticipationKeysRNG(store db.Accessor, address basics.Address, firstValid, lastValid basics.Round, keyDilution uint64, rng crypto.RNG, stateProof bool) (part PersistedParticipation, err error) {
+	var generateStateProof func(firstValid, lastValid, keyLifetime uint64) (*merklesignature.Secrets, error)
+	if stateProof {
+		generateStateProof = func(firstValid, lastValid, keyLifetime uint64) (*merklesignature.Secrets, error) {
+			return merklesignature.NewFromRNG(firstValid, lastValid, keyLifetime, rng)
+		}
+	}
+	return fillDBWithParticipationKeys(store, address, firstValid, lastValid, keyDilution,
+		func(startBatch uint64, numBatches uint64) *crypto.OneTimeSignatureSecrets {
+			return crypto.GenerateOneTimeSignatureSecretsRNG(startBatch, numBatches, rng)
+		},
+		func() *crypto.VRFSecrets {
+			var seed [32]byte
+			rng.RandBytes(seed[:])
+			pk, sk := crypto.VrfKeygenFromSeed(seed)
+			return &crypto.VRFSecrets{PK: pk, SK: sk}
+		},
+		generateStateProof)
+}
+
+func fillDBWithParticipationKeys(store db.Accessor, address basics.Address, firstValid, lastValid basics.Round, keyDilution uint64,
+	generateVoting func(startBatch uint64, numBatches uint64) *crypto.OneTimeSignatureSecrets,
+	generateVRF func() *crypto.VRFSecrets,
+	generateStateProof func(firstValid, lastValid, keyLifetime uint64) (*merklesignature.Secrets, error),
+) (part PersistedParticipation, err error) {
 	if lastValid < firstValid {
 		err = fmt.Errorf("FillDBWithParticipationKeys: firstValid %d is after lastValid %d", firstValid, lastValid)
 		return
@@ -239,15 +278,18 @@ func FillDBWithParticipationKeys(store db.Accessor, address basics.Address, firs
 	numBatches := lastID.Batch - firstID.Batch + 1
 
 	// Generate them
-	v := crypto.GenerateOneTimeSignatureSecrets(firstID.Batch, numBatches)
+	v := generateVoting(firstID.Batch, numBatches)
 
 	// Generate a new VRF key, which lives in the participation keys db
-	vrf := crypto.GenerateVRFSecrets()
+	vrf := generateVRF()
 
-	// Generate a new key which signs the state proof
-	stateProofSecrets, err := merklesignature.New(uint64(firstValid), uint64(lastValid), merklesignature.KeyLifetimeDefault)
-	if err != nil {
-		return PersistedParticipation{}, err
+	// Generate a new key which signs the state proof, unless the account won't sign state proofs
+	var stateProofSecrets *merklesignature.Secrets
+	if generateStateProof != nil {
+		stateProofSecrets, err = generateStateProof(uint64(firstValid), uint64(lastValid), merklesignature.KeyLifetimeDefault)
+		if err != nil {
+			return PersistedParticipation{}, err
+		}
 	}
 
 	// Construct the Participation containing these keys to be persisted
@@ -274,6 +316,9 @@ func (part PersistedParticipation) PersistWithSecrets() error {
 	if err != nil {
 		return err
 	}
+	if part.StateProofSecrets == nil {
+		return nil
+	}
 	return part.StateProofSecrets.Persist(part.Store) // must be called after part.Persist()
 }
 
@@ -282,7 +327,10 @@ func (part PersistedParticipation) Persist() error {
 	rawVRF := protocol.Encode(part.VRF)
 	voting := part.Voting.Snapshot()
 	rawVoting := protocol.Encode(&voting)
-	rawStateProof := protocol.Encode(part.StateProofSecrets)
+	var rawStateProof []byte
+	if part.StateProofSecrets != nil {
+		rawStateProof = protocol.Encode(part.StateProofSecrets)
+	}
 
 	err := part.Store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		err := partInstallDatabase(tx)
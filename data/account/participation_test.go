@@ -616,3 +616,49 @@ func BenchmarkID(b *testing.B) {
 		}
 	})
 }
+
+func TestFillDBWithParticipationKeysRNG(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	dilution := config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution
+	address := basics.Address{1}
+	fill := func(seed string) Participation {
+		store := createMerkleSignatureSchemeTestDB(a)
+		defer store.Close()
+		part, err := FillDBWithParticipationKeysRNG(*store, address, 0, 1000, dilution, crypto.MakePRNG([]byte(seed)), true)
+		a.NoError(err)
+		return part.Participation
+	}
+
+	// the same rng state gives the same keys
+	part := fill("seed")
+	again := fill("seed")
+	a.Equal(part.VRF.PK, again.VRF.PK)
+	a.Equal(part.Voting.OneTimeSignatureVerifier, again.Voting.OneTimeSignatureVerifier)
+	a.Equal(part.StateProofSecrets.GetVerifier().Commitment, again.StateProofSecrets.GetVerifier().Commitment)
+
+	other := fill("other seed")
+	a.NotEqual(part.VRF.PK, other.VRF.PK)
+	a.NotEqual(part.Voting.OneTimeSignatureVerifier, other.Voting.OneTimeSignatureVerifier)
+	a.NotEqual(part.StateProofSecrets.GetVerifier().Commitment, other.StateProofSecrets.GetVerifier().Commitment)
+}
+
+func TestFillDBWithParticipationKeysWithoutStateProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	dilution := config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution
+	store := createMerkleSignatureSchemeTestDB(a)
+	defer store.Close()
+	part, err := FillDBWithParticipationKeysWithoutStateProof(*store, basics.Address{1}, 0, 1000, dilution)
+	a.NoError(err)
+	a.Nil(part.StateProofSecrets)
+
+	restored, err := RestoreParticipationWithSecrets(*store)
+	a.NoError(err)
+	a.Nil(restored.StateProofSecrets)
+	a.Equal(part.VRF.PK, restored.VRF.PK)
+	a.Equal(part.Voting.OneTimeSignatureVerifier, restored.Voting.OneTimeSignatureVerifier)
+	a.True(restored.GenerateRegistrationTransaction(basics.MicroAlgos{}, 0, 100, [32]byte{}, true).StateProofPK.MsgIsZero())
+}
@@ -21,6 +21,8 @@ import (
 	"os"
 	"time"
 
+	"github.com/algorand/avm-abi/apps"
+
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
@@ -36,6 +38,10 @@ const (
 	// This value is used exclusively for the messagepack decoder, and has no affect on the network
 	// capabilities/capacity in any way.
 	MaxInitialGenesisAllocationSize = 100000000
+
+	// encodedMaxGenesisBoxes is the decoder limit for the number of boxes an application account
+	// can be given in the genesis file.
+	encodedMaxGenesisBoxes = 16384
 )
 
 // A Genesis object defines an Algorand "universe" -- a set of nodes that can
@@ -110,6 +116,8 @@ func (genesis Genesis) Hash() crypto.Digest {
 // Balances returns the genesis account balances.
 func (genesis Genesis) Balances() (GenesisBalances, error) {
 	genalloc := make(map[basics.Address]basics.AccountData)
+	creators := make(map[basics.CreatableIndex]basics.Address)
+	appAccounts := make(map[basics.Address]basics.AppIndex)
 	for _, entry := range genesis.Allocation {
 		addr, err := basics.UnmarshalChecksumAddress(entry.Address)
 		if err != nil {
@@ -122,6 +130,25 @@ func (genesis Genesis) Balances() (GenesisBalances, error) {
 		}
 
 		genalloc[addr] = entry.State.AccountData()
+
+		for aidx := range entry.State.AssetParams {
+			if creator, ok := creators[basics.CreatableIndex(aidx)]; ok {
+				return GenesisBalances{}, fmt.Errorf("creatable %d created by both %s and %s", aidx, creator, addr)
+			}
+			creators[basics.CreatableIndex(aidx)] = addr
+		}
+		for aidx := range entry.State.AppParams {
+			if creator, ok := creators[basics.CreatableIndex(aidx)]; ok {
+				return GenesisBalances{}, fmt.Errorf("creatable %d created by both %s and %s", aidx, creator, addr)
+			}
+			creators[basics.CreatableIndex(aidx)] = addr
+			appAccounts[aidx.Address()] = aidx
+		}
+	}
+
+	boxes, err := genesis.boxes(appAccounts)
+	if err != nil {
+		return GenesisBalances{}, err
 	}
 
 	feeSink, err := basics.UnmarshalChecksumAddress(genesis.FeeSink)
@@ -134,7 +161,47 @@ func (genesis Genesis) Balances() (GenesisBalances, error) {
 		return GenesisBalances{}, fmt.Errorf("cannot parse rewards pool addr %s: %w", genesis.RewardsPool, err)
 	}
 
-	return MakeTimestampedGenesisBalances(genalloc, feeSink, rewardsPool, genesis.Timestamp), nil
+	balances := MakeTimestampedGenesisBalances(genalloc, feeSink, rewardsPool, genesis.Timestamp)
+	balances.Boxes = boxes
+	return balances, nil
+}
+
+// boxes returns the genesis boxes keyed by their ledger key. Boxes are allocated to the accounts of the
+// applications created in genesis, and their number and size must match the account totals.
+func (genesis Genesis) boxes(appAccounts map[basics.Address]basics.AppIndex) (map[string][]byte, error) {
+	var boxes map[string][]byte
+	for _, entry := range genesis.Allocation {
+		if len(entry.State.Boxes) == 0 {
+			continue
+		}
+		addr, err := basics.UnmarshalChecksumAddress(entry.Address)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse genesis addr %s: %w", entry.Address, err)
+		}
+		appIdx, ok := appAccounts[addr]
+		if !ok {
+			return nil, fmt.Errorf("genesis allocation to %s has boxes but is not the account of a genesis application", entry.Address)
+		}
+
+		if boxes == nil {
+			boxes = make(map[string][]byte)
+		}
+		var totalBoxBytes uint64
+		for _, box := range entry.State.Boxes {
+			key := apps.MakeBoxKey(uint64(appIdx), string(box.Name))
+			if _, present := boxes[key]; present {
+				return nil, fmt.Errorf("repeated box %q of application %d", box.Name, appIdx)
+			}
+			// boxes are never stored with a nil value
+			boxes[key] = append([]byte{}, box.Value...)
+			totalBoxBytes += uint64(len(box.Name) + len(box.Value))
+		}
+		if entry.State.TotalBoxes != uint64(len(entry.State.Boxes)) || entry.State.TotalBoxBytes != totalBoxBytes {
+			return nil, fmt.Errorf("application %d has %d boxes of %d bytes but its account totals are %d boxes of %d bytes",
+				appIdx, len(entry.State.Boxes), totalBoxBytes, entry.State.TotalBoxes, entry.State.TotalBoxBytes)
+		}
+	}
+	return boxes, nil
 }
 
 // Block computes the genesis block.
@@ -174,6 +241,8 @@ type GenesisBalances struct {
 	FeeSink     basics.Address
 	RewardsPool basics.Address
 	Timestamp   int64
+	// Boxes holds the values of the genesis boxes, keyed by their ledger key
+	Boxes map[string][]byte
 }
 
 // GenesisAccountData contains a subset of account information that is present in the genesis file.
@@ -188,19 +257,49 @@ type GenesisAccountData struct {
 	VoteFirstValid  basics.Round                    `codec:"voteFst"`
 	VoteLastValid   basics.Round                    `codec:"voteLst"`
 	VoteKeyDilution uint64                          `codec:"voteKD"`
+
+	// The assets and applications created by, held by or opted in by this account at genesis,
+	// and the totals the minimum balance is computed from. They match the fields of basics.AccountData.
+	AssetParams        map[basics.AssetIndex]basics.AssetParams  `codec:"apar,allocbound=bounds.EncodedMaxAssetsPerAccount"`
+	Assets             map[basics.AssetIndex]basics.AssetHolding `codec:"asset,allocbound=bounds.EncodedMaxAssetsPerAccount"`
+	AppLocalStates     map[basics.AppIndex]basics.AppLocalState  `codec:"appl,allocbound=bounds.EncodedMaxAppLocalStates"`
+	AppParams          map[basics.AppIndex]basics.AppParams      `codec:"appp,allocbound=bounds.EncodedMaxAppParams"`
+	TotalAppSchema     basics.StateSchema                        `codec:"tsch"`
+	TotalExtraAppPages uint32                                    `codec:"teap"`
+	TotalBoxes         uint64                                    `codec:"tbx"`
+	TotalBoxBytes      uint64                                    `codec:"tbxb"`
+
+	// Boxes are the boxes of the application whose account this is.
+	Boxes []GenesisBox `codec:"boxes,allocbound=encodedMaxGenesisBoxes"`
+}
+
+// GenesisBox is a box of an application created in genesis.
+type GenesisBox struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Name  []byte `codec:"n,allocbound=bounds.MaxBytesKeyValueLen"`
+	Value []byte `codec:"v,allocbound=bounds.MaxBoxSize"`
 }
 
 // AccountData returns a basics.AccountData type for this genesis account.
 func (ga *GenesisAccountData) AccountData() basics.AccountData {
 	return basics.AccountData{
-		Status:          ga.Status,
-		MicroAlgos:      ga.MicroAlgos,
-		VoteID:          ga.VoteID,
-		StateProofID:    ga.StateProofID,
-		SelectionID:     ga.SelectionID,
-		VoteFirstValid:  ga.VoteFirstValid,
-		VoteLastValid:   ga.VoteLastValid,
-		VoteKeyDilution: ga.VoteKeyDilution,
+		Status:             ga.Status,
+		MicroAlgos:         ga.MicroAlgos,
+		VoteID:             ga.VoteID,
+		StateProofID:       ga.StateProofID,
+		SelectionID:        ga.SelectionID,
+		VoteFirstValid:     ga.VoteFirstValid,
+		VoteLastValid:      ga.VoteLastValid,
+		VoteKeyDilution:    ga.VoteKeyDilution,
+		AssetParams:        ga.AssetParams,
+		Assets:             ga.Assets,
+		AppLocalStates:     ga.AppLocalStates,
+		AppParams:          ga.AppParams,
+		TotalAppSchema:     ga.TotalAppSchema,
+		TotalExtraAppPages: ga.TotalExtraAppPages,
+		TotalBoxes:         ga.TotalBoxes,
+		TotalBoxBytes:      ga.TotalBoxBytes,
 	}
 }
 
@@ -258,6 +357,17 @@ func MakeGenesisBlock(proto protocol.ConsensusVersion, genesisBal GenesisBalance
 		blk.TxnCounter = 1000
 	}
 
+	// Assets and applications created in genesis must not collide with the ones
+	// created later, whose indices are taken from the TxnCounter.
+	for _, ad := range genesisBal.Balances {
+		for aidx := range ad.AssetParams {
+			blk.TxnCounter = max(blk.TxnCounter, uint64(aidx))
+		}
+		for aidx := range ad.AppParams {
+			blk.TxnCounter = max(blk.TxnCounter, uint64(aidx))
+		}
+	}
+
 	if params.SupportGenesisHash {
 		blk.BlockHeader.GenesisHash = genesisHash
 	}
@@ -20,11 +20,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/algorand/avm-abi/apps"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -60,6 +62,20 @@ func TestGenesis_Balances(t *testing.T) {
 	allocation1 := acctWith(1000, makeAddr(1).String())
 	allocation2 := acctWith(2000, makeAddr(2).String())
 	badAllocation := acctWith(1234, "El Toro Loco")
+	creator := acctWith(3000, makeAddr(3).String())
+	creator.State.AppParams = map[basics.AppIndex]basics.AppParams{1001: {}}
+	assetCreator := acctWith(3000, makeAddr(4).String())
+	assetCreator.State.AssetParams = map[basics.AssetIndex]basics.AssetParams{1001: {Total: 1}}
+	appAccount := acctWith(4000, basics.AppIndex(1001).Address().String())
+	appAccount.State.Boxes = []GenesisBox{{Name: []byte("a"), Value: []byte("xy")}, {Name: []byte("b")}}
+	appAccount.State.TotalBoxes = 2
+	appAccount.State.TotalBoxBytes = 4
+	badTotals := appAccount
+	badTotals.State.TotalBoxBytes = 3
+	repeatedBox := appAccount
+	repeatedBox.State.Boxes = []GenesisBox{{Name: []byte("a")}, {Name: []byte("a")}}
+	notAppAccount := allocation2
+	notAppAccount.State.Boxes = appAccount.State.Boxes
 	type fields struct {
 		Allocation  []GenesisAllocation
 		FeeSink     string
@@ -140,6 +156,73 @@ func TestGenesis_Balances(t *testing.T) {
 			},
 			wantErr: containsErrorFunc("repeated allocation to"),
 		},
+		{
+			name: "application boxes",
+			fields: fields{
+				Allocation:  []GenesisAllocation{creator, appAccount},
+				FeeSink:     goodAddr.String(),
+				RewardsPool: goodAddr.String(),
+			},
+			want: GenesisBalances{
+				Balances: map[basics.Address]basics.AccountData{
+					mustAddr(creator.Address):    creator.State.AccountData(),
+					mustAddr(appAccount.Address): appAccount.State.AccountData(),
+				},
+				FeeSink:     goodAddr,
+				RewardsPool: goodAddr,
+				Timestamp:   0,
+				Boxes: map[string][]byte{
+					apps.MakeBoxKey(1001, "a"): []byte("xy"),
+					apps.MakeBoxKey(1001, "b"): {},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "boxes of a non application account",
+			fields: fields{
+				Allocation:  []GenesisAllocation{creator, notAppAccount},
+				FeeSink:     goodAddr.String(),
+				RewardsPool: goodAddr.String(),
+			},
+			wantErr: containsErrorFunc("is not the account of a genesis application"),
+		},
+		{
+			name: "boxes of an application not created",
+			fields: fields{
+				Allocation:  []GenesisAllocation{appAccount},
+				FeeSink:     goodAddr.String(),
+				RewardsPool: goodAddr.String(),
+			},
+			wantErr: containsErrorFunc("is not the account of a genesis application"),
+		},
+		{
+			name: "box totals mismatch",
+			fields: fields{
+				Allocation:  []GenesisAllocation{creator, badTotals},
+				FeeSink:     goodAddr.String(),
+				RewardsPool: goodAddr.String(),
+			},
+			wantErr: containsErrorFunc("but its account totals are 2 boxes of 3 bytes"),
+		},
+		{
+			name: "repeated box",
+			fields: fields{
+				Allocation:  []GenesisAllocation{creator, repeatedBox},
+				FeeSink:     goodAddr.String(),
+				RewardsPool: goodAddr.String(),
+			},
+			wantErr: containsErrorFunc("repeated box"),
+		},
+		{
+			name: "creatable created twice",
+			fields: fields{
+				Allocation:  []GenesisAllocation{creator, assetCreator},
+				FeeSink:     goodAddr.String(),
+				RewardsPool: goodAddr.String(),
+			},
+			wantErr: containsErrorFunc("creatable 1001 created by both"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -157,6 +240,31 @@ func TestGenesis_Balances(t *testing.T) {
 	}
 }
 
+func TestGenesisBlockTxnCounter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var sink, creator basics.Address
+	sink[0] = 1
+	creator[0] = 2
+	genesisBal := MakeGenesisBalances(map[basics.Address]basics.AccountData{
+		sink: {MicroAlgos: basics.MicroAlgos{Raw: 1_000_000}},
+	}, sink, sink)
+
+	blk, err := MakeGenesisBlock(protocol.ConsensusCurrentVersion, genesisBal, "test", crypto.Digest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, blk.TxnCounter)
+
+	// the next creatable must come after the ones created in genesis
+	genesisBal.Balances[creator] = basics.AccountData{
+		MicroAlgos:  basics.MicroAlgos{Raw: 1_000_000},
+		AssetParams: map[basics.AssetIndex]basics.AssetParams{1500: {Total: 1}},
+		AppParams:   map[basics.AppIndex]basics.AppParams{2000: {}},
+	}
+	blk, err = MakeGenesisBlock(protocol.ConsensusCurrentVersion, genesisBal, "test", crypto.Digest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 2000, blk.TxnCounter)
+}
+
 func (genesis Genesis) hashOld() crypto.Digest {
 	return hashObjOld(genesis)
 }
@@ -75,6 +75,16 @@ import (
 //         |-----> (*) MsgIsZero
 //         |-----> GenesisAllocationMaxSize()
 //
+// GenesisBox
+//      |-----> (*) MarshalMsg
+//      |-----> (*) CanMarshalMsg
+//      |-----> (*) UnmarshalMsg
+//      |-----> (*) UnmarshalMsgWithState
+//      |-----> (*) CanUnmarshalMsg
+//      |-----> (*) Msgsize
+//      |-----> (*) MsgIsZero
+//      |-----> GenesisBoxMaxSize()
+//
 // LightBlockHeader
 //         |-----> (*) MarshalMsg
 //         |-----> (*) CanMarshalMsg
@@ -2578,79 +2588,227 @@ func GenesisMaxSize() (s int) {
 func (z *GenesisAccountData) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(8)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0010Len := uint32(17)
+	var zb0010Mask uint32 /* 18 bits */
 	if (*z).MicroAlgos.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x2
+		zb0010Len--
+		zb0010Mask |= 0x2
+	}
+	if len((*z).AssetParams) == 0 {
+		zb0010Len--
+		zb0010Mask |= 0x4
+	}
+	if len((*z).AppLocalStates) == 0 {
+		zb0010Len--
+		zb0010Mask |= 0x8
+	}
+	if len((*z).AppParams) == 0 {
+		zb0010Len--
+		zb0010Mask |= 0x10
+	}
+	if len((*z).Assets) == 0 {
+		zb0010Len--
+		zb0010Mask |= 0x20
+	}
+	if len((*z).Boxes) == 0 {
+		zb0010Len--
+		zb0010Mask |= 0x40
 	}
 	if (*z).Status.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x4
+		zb0010Len--
+		zb0010Mask |= 0x80
 	}
 	if (*z).SelectionID.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x8
+		zb0010Len--
+		zb0010Mask |= 0x100
 	}
 	if (*z).StateProofID.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x10
+		zb0010Len--
+		zb0010Mask |= 0x200
+	}
+	if (*z).TotalBoxes == 0 {
+		zb0010Len--
+		zb0010Mask |= 0x400
+	}
+	if (*z).TotalBoxBytes == 0 {
+		zb0010Len--
+		zb0010Mask |= 0x800
+	}
+	if (*z).TotalExtraAppPages == 0 {
+		zb0010Len--
+		zb0010Mask |= 0x1000
+	}
+	if (*z).TotalAppSchema.MsgIsZero() {
+		zb0010Len--
+		zb0010Mask |= 0x2000
 	}
 	if (*z).VoteID.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x20
+		zb0010Len--
+		zb0010Mask |= 0x4000
 	}
 	if (*z).VoteFirstValid.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x40
+		zb0010Len--
+		zb0010Mask |= 0x8000
 	}
 	if (*z).VoteKeyDilution == 0 {
-		zb0001Len--
-		zb0001Mask |= 0x80
+		zb0010Len--
+		zb0010Mask |= 0x10000
 	}
 	if (*z).VoteLastValid.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x100
+		zb0010Len--
+		zb0010Mask |= 0x20000
 	}
-	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
-	if zb0001Len != 0 {
-		if (zb0001Mask & 0x2) == 0 { // if not empty
+	// variable map header, size zb0010Len
+	o = msgp.AppendMapHeader(o, zb0010Len)
+	if zb0010Len != 0 {
+		if (zb0010Mask & 0x2) == 0 { // if not empty
 			// string "algo"
 			o = append(o, 0xa4, 0x61, 0x6c, 0x67, 0x6f)
 			o = (*z).MicroAlgos.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x4) == 0 { // if not empty
+		if (zb0010Mask & 0x4) == 0 { // if not empty
+			// string "apar"
+			o = append(o, 0xa4, 0x61, 0x70, 0x61, 0x72)
+			if (*z).AssetParams == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendMapHeader(o, uint32(len((*z).AssetParams)))
+			}
+			zb0001_keys := make([]basics.AssetIndex, 0, len((*z).AssetParams))
+			for zb0001 := range (*z).AssetParams {
+				zb0001_keys = append(zb0001_keys, zb0001)
+			}
+			sort.Sort(basics.SortAssetIndex(zb0001_keys))
+			for _, zb0001 := range zb0001_keys {
+				zb0002 := (*z).AssetParams[zb0001]
+				_ = zb0002
+				o = zb0001.MarshalMsg(o)
+				o = zb0002.MarshalMsg(o)
+			}
+		}
+		if (zb0010Mask & 0x8) == 0 { // if not empty
+			// string "appl"
+			o = append(o, 0xa4, 0x61, 0x70, 0x70, 0x6c)
+			if (*z).AppLocalStates == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendMapHeader(o, uint32(len((*z).AppLocalStates)))
+			}
+			zb0005_keys := make([]basics.AppIndex, 0, len((*z).AppLocalStates))
+			for zb0005 := range (*z).AppLocalStates {
+				zb0005_keys = append(zb0005_keys, zb0005)
+			}
+			sort.Sort(basics.SortAppIndex(zb0005_keys))
+			for _, zb0005 := range zb0005_keys {
+				zb0006 := (*z).AppLocalStates[zb0005]
+				_ = zb0006
+				o = zb0005.MarshalMsg(o)
+				o = zb0006.MarshalMsg(o)
+			}
+		}
+		if (zb0010Mask & 0x10) == 0 { // if not empty
+			// string "appp"
+			o = append(o, 0xa4, 0x61, 0x70, 0x70, 0x70)
+			if (*z).AppParams == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendMapHeader(o, uint32(len((*z).AppParams)))
+			}
+			zb0007_keys := make([]basics.AppIndex, 0, len((*z).AppParams))
+			for zb0007 := range (*z).AppParams {
+				zb0007_keys = append(zb0007_keys, zb0007)
+			}
+			sort.Sort(basics.SortAppIndex(zb0007_keys))
+			for _, zb0007 := range zb0007_keys {
+				zb0008 := (*z).AppParams[zb0007]
+				_ = zb0008
+				o = zb0007.MarshalMsg(o)
+				o = zb0008.MarshalMsg(o)
+			}
+		}
+		if (zb0010Mask & 0x20) == 0 { // if not empty
+			// string "asset"
+			o = append(o, 0xa5, 0x61, 0x73, 0x73, 0x65, 0x74)
+			if (*z).Assets == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendMapHeader(o, uint32(len((*z).Assets)))
+			}
+			zb0003_keys := make([]basics.AssetIndex, 0, len((*z).Assets))
+			for zb0003 := range (*z).Assets {
+				zb0003_keys = append(zb0003_keys, zb0003)
+			}
+			sort.Sort(basics.SortAssetIndex(zb0003_keys))
+			for _, zb0003 := range zb0003_keys {
+				zb0004 := (*z).Assets[zb0003]
+				_ = zb0004
+				o = zb0003.MarshalMsg(o)
+				o = zb0004.MarshalMsg(o)
+			}
+		}
+		if (zb0010Mask & 0x40) == 0 { // if not empty
+			// string "boxes"
+			o = append(o, 0xa5, 0x62, 0x6f, 0x78, 0x65, 0x73)
+			if (*z).Boxes == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Boxes)))
+			}
+			for zb0009 := range (*z).Boxes {
+				o = (*z).Boxes[zb0009].MarshalMsg(o)
+			}
+		}
+		if (zb0010Mask & 0x80) == 0 { // if not empty
 			// string "onl"
 			o = append(o, 0xa3, 0x6f, 0x6e, 0x6c)
 			o = (*z).Status.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x8) == 0 { // if not empty
+		if (zb0010Mask & 0x100) == 0 { // if not empty
 			// string "sel"
 			o = append(o, 0xa3, 0x73, 0x65, 0x6c)
 			o = (*z).SelectionID.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x10) == 0 { // if not empty
+		if (zb0010Mask & 0x200) == 0 { // if not empty
 			// string "stprf"
 			o = append(o, 0xa5, 0x73, 0x74, 0x70, 0x72, 0x66)
 			o = (*z).StateProofID.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x20) == 0 { // if not empty
+		if (zb0010Mask & 0x400) == 0 { // if not empty
+			// string "tbx"
+			o = append(o, 0xa3, 0x74, 0x62, 0x78)
+			o = msgp.AppendUint64(o, (*z).TotalBoxes)
+		}
+		if (zb0010Mask & 0x800) == 0 { // if not empty
+			// string "tbxb"
+			o = append(o, 0xa4, 0x74, 0x62, 0x78, 0x62)
+			o = msgp.AppendUint64(o, (*z).TotalBoxBytes)
+		}
+		if (zb0010Mask & 0x1000) == 0 { // if not empty
+			// string "teap"
+			o = append(o, 0xa4, 0x74, 0x65, 0x61, 0x70)
+			o = msgp.AppendUint32(o, (*z).TotalExtraAppPages)
+		}
+		if (zb0010Mask & 0x2000) == 0 { // if not empty
+			// string "tsch"
+			o = append(o, 0xa4, 0x74, 0x73, 0x63, 0x68)
+			o = (*z).TotalAppSchema.MarshalMsg(o)
+		}
+		if (zb0010Mask & 0x4000) == 0 { // if not empty
 			// string "vote"
 			o = append(o, 0xa4, 0x76, 0x6f, 0x74, 0x65)
 			o = (*z).VoteID.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x40) == 0 { // if not empty
+		if (zb0010Mask & 0x8000) == 0 { // if not empty
 			// string "voteFst"
 			o = append(o, 0xa7, 0x76, 0x6f, 0x74, 0x65, 0x46, 0x73, 0x74)
 			o = (*z).VoteFirstValid.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x80) == 0 { // if not empty
+		if (zb0010Mask & 0x10000) == 0 { // if not empty
 			// string "voteKD"
 			o = append(o, 0xa6, 0x76, 0x6f, 0x74, 0x65, 0x4b, 0x44)
 			o = msgp.AppendUint64(o, (*z).VoteKeyDilution)
 		}
-		if (zb0001Mask & 0x100) == 0 { // if not empty
+		if (zb0010Mask & 0x20000) == 0 { // if not empty
 			// string "voteLst"
 			o = append(o, 0xa7, 0x76, 0x6f, 0x74, 0x65, 0x4c, 0x73, 0x74)
 			o = (*z).VoteLastValid.MarshalMsg(o)
@@ -2673,81 +2831,286 @@ func (z *GenesisAccountData) UnmarshalMsgWithState(bts []byte, st msgp.Unmarshal
 	st.AllowableDepth--
 	var field []byte
 	_ = field
-	var zb0001 int
-	var zb0002 bool
-	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	var zb0010 int
+	var zb0011 bool
+	zb0010, zb0011, bts, err = msgp.ReadMapHeaderBytes(bts)
 	if _, ok := err.(msgp.TypeError); ok {
-		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		zb0010, zb0011, bts, err = msgp.ReadArrayHeaderBytes(bts)
 		if err != nil {
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0010 > 0 {
+			zb0010--
 			bts, err = (*z).Status.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Status")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0010 > 0 {
+			zb0010--
 			bts, err = (*z).MicroAlgos.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "MicroAlgos")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0010 > 0 {
+			zb0010--
 			bts, err = (*z).VoteID.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "VoteID")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0010 > 0 {
+			zb0010--
 			bts, err = (*z).StateProofID.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "StateProofID")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0010 > 0 {
+			zb0010--
 			bts, err = (*z).SelectionID.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "SelectionID")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0010 > 0 {
+			zb0010--
 			bts, err = (*z).VoteFirstValid.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "VoteFirstValid")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0010 > 0 {
+			zb0010--
 			bts, err = (*z).VoteLastValid.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "VoteLastValid")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0010 > 0 {
+			zb0010--
 			(*z).VoteKeyDilution, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "VoteKeyDilution")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			err = msgp.ErrTooManyArrayFields(zb0001)
+		if zb0010 > 0 {
+			zb0010--
+			var zb0012 int
+			var zb0013 bool
+			zb0012, zb0013, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "AssetParams")
+				return
+			}
+			if zb0012 > bounds.EncodedMaxAssetsPerAccount {
+				err = msgp.ErrOverflow(uint64(zb0012), uint64(bounds.EncodedMaxAssetsPerAccount))
+				err = msgp.WrapError(err, "struct-from-array", "AssetParams")
+				return
+			}
+			if zb0013 {
+				(*z).AssetParams = nil
+			} else if (*z).AssetParams == nil {
+				(*z).AssetParams = make(map[basics.AssetIndex]basics.AssetParams, zb0012)
+			}
+			for zb0012 > 0 {
+				var zb0001 basics.AssetIndex
+				var zb0002 basics.AssetParams
+				zb0012--
+				bts, err = zb0001.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "AssetParams")
+					return
+				}
+				bts, err = zb0002.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "AssetParams", zb0001)
+					return
+				}
+				(*z).AssetParams[zb0001] = zb0002
+			}
+		}
+		if zb0010 > 0 {
+			zb0010--
+			var zb0014 int
+			var zb0015 bool
+			zb0014, zb0015, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Assets")
+				return
+			}
+			if zb0014 > bounds.EncodedMaxAssetsPerAccount {
+				err = msgp.ErrOverflow(uint64(zb0014), uint64(bounds.EncodedMaxAssetsPerAccount))
+				err = msgp.WrapError(err, "struct-from-array", "Assets")
+				return
+			}
+			if zb0015 {
+				(*z).Assets = nil
+			} else if (*z).Assets == nil {
+				(*z).Assets = make(map[basics.AssetIndex]basics.AssetHolding, zb0014)
+			}
+			for zb0014 > 0 {
+				var zb0003 basics.AssetIndex
+				var zb0004 basics.AssetHolding
+				zb0014--
+				bts, err = zb0003.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Assets")
+					return
+				}
+				bts, err = zb0004.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Assets", zb0003)
+					return
+				}
+				(*z).Assets[zb0003] = zb0004
+			}
+		}
+		if zb0010 > 0 {
+			zb0010--
+			var zb0016 int
+			var zb0017 bool
+			zb0016, zb0017, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "AppLocalStates")
+				return
+			}
+			if zb0016 > bounds.EncodedMaxAppLocalStates {
+				err = msgp.ErrOverflow(uint64(zb0016), uint64(bounds.EncodedMaxAppLocalStates))
+				err = msgp.WrapError(err, "struct-from-array", "AppLocalStates")
+				return
+			}
+			if zb0017 {
+				(*z).AppLocalStates = nil
+			} else if (*z).AppLocalStates == nil {
+				(*z).AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState, zb0016)
+			}
+			for zb0016 > 0 {
+				var zb0005 basics.AppIndex
+				var zb0006 basics.AppLocalState
+				zb0016--
+				bts, err = zb0005.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "AppLocalStates")
+					return
+				}
+				bts, err = zb0006.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "AppLocalStates", zb0005)
+					return
+				}
+				(*z).AppLocalStates[zb0005] = zb0006
+			}
+		}
+		if zb0010 > 0 {
+			zb0010--
+			var zb0018 int
+			var zb0019 bool
+			zb0018, zb0019, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "AppParams")
+				return
+			}
+			if zb0018 > bounds.EncodedMaxAppParams {
+				err = msgp.ErrOverflow(uint64(zb0018), uint64(bounds.EncodedMaxAppParams))
+				err = msgp.WrapError(err, "struct-from-array", "AppParams")
+				return
+			}
+			if zb0019 {
+				(*z).AppParams = nil
+			} else if (*z).AppParams == nil {
+				(*z).AppParams = make(map[basics.AppIndex]basics.AppParams, zb0018)
+			}
+			for zb0018 > 0 {
+				var zb0007 basics.AppIndex
+				var zb0008 basics.AppParams
+				zb0018--
+				bts, err = zb0007.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "AppParams")
+					return
+				}
+				bts, err = zb0008.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "AppParams", zb0007)
+					return
+				}
+				(*z).AppParams[zb0007] = zb0008
+			}
+		}
+		if zb0010 > 0 {
+			zb0010--
+			bts, err = (*z).TotalAppSchema.UnmarshalMsgWithState(bts, st)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalAppSchema")
+				return
+			}
+		}
+		if zb0010 > 0 {
+			zb0010--
+			(*z).TotalExtraAppPages, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalExtraAppPages")
+				return
+			}
+		}
+		if zb0010 > 0 {
+			zb0010--
+			(*z).TotalBoxes, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalBoxes")
+				return
+			}
+		}
+		if zb0010 > 0 {
+			zb0010--
+			(*z).TotalBoxBytes, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalBoxBytes")
+				return
+			}
+		}
+		if zb0010 > 0 {
+			zb0010--
+			var zb0020 int
+			var zb0021 bool
+			zb0020, zb0021, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Boxes")
+				return
+			}
+			if zb0020 > encodedMaxGenesisBoxes {
+				err = msgp.ErrOverflow(uint64(zb0020), uint64(encodedMaxGenesisBoxes))
+				err = msgp.WrapError(err, "struct-from-array", "Boxes")
+				return
+			}
+			if zb0021 {
+				(*z).Boxes = nil
+			} else if (*z).Boxes != nil && cap((*z).Boxes) >= zb0020 {
+				(*z).Boxes = ((*z).Boxes)[:zb0020]
+			} else {
+				(*z).Boxes = make([]GenesisBox, zb0020)
+			}
+			for zb0009 := range (*z).Boxes {
+				bts, err = (*z).Boxes[zb0009].UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0009)
+					return
+				}
+			}
+		}
+		if zb0010 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0010)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array")
 				return
@@ -2758,11 +3121,11 @@ func (z *GenesisAccountData) UnmarshalMsgWithState(bts []byte, st msgp.Unmarshal
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0002 {
+		if zb0011 {
 			(*z) = GenesisAccountData{}
 		}
-		for zb0001 > 0 {
-			zb0001--
+		for zb0010 > 0 {
+			zb0010--
 			field, bts, err = msgp.ReadMapKeyZC(bts)
 			if err != nil {
 				err = msgp.WrapError(err)
@@ -2817,6 +3180,193 @@ func (z *GenesisAccountData) UnmarshalMsgWithState(bts []byte, st msgp.Unmarshal
 					err = msgp.WrapError(err, "VoteKeyDilution")
 					return
 				}
+			case "apar":
+				var zb0022 int
+				var zb0023 bool
+				zb0022, zb0023, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AssetParams")
+					return
+				}
+				if zb0022 > bounds.EncodedMaxAssetsPerAccount {
+					err = msgp.ErrOverflow(uint64(zb0022), uint64(bounds.EncodedMaxAssetsPerAccount))
+					err = msgp.WrapError(err, "AssetParams")
+					return
+				}
+				if zb0023 {
+					(*z).AssetParams = nil
+				} else if (*z).AssetParams == nil {
+					(*z).AssetParams = make(map[basics.AssetIndex]basics.AssetParams, zb0022)
+				}
+				for zb0022 > 0 {
+					var zb0001 basics.AssetIndex
+					var zb0002 basics.AssetParams
+					zb0022--
+					bts, err = zb0001.UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "AssetParams")
+						return
+					}
+					bts, err = zb0002.UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "AssetParams", zb0001)
+						return
+					}
+					(*z).AssetParams[zb0001] = zb0002
+				}
+			case "asset":
+				var zb0024 int
+				var zb0025 bool
+				zb0024, zb0025, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Assets")
+					return
+				}
+				if zb0024 > bounds.EncodedMaxAssetsPerAccount {
+					err = msgp.ErrOverflow(uint64(zb0024), uint64(bounds.EncodedMaxAssetsPerAccount))
+					err = msgp.WrapError(err, "Assets")
+					return
+				}
+				if zb0025 {
+					(*z).Assets = nil
+				} else if (*z).Assets == nil {
+					(*z).Assets = make(map[basics.AssetIndex]basics.AssetHolding, zb0024)
+				}
+				for zb0024 > 0 {
+					var zb0003 basics.AssetIndex
+					var zb0004 basics.AssetHolding
+					zb0024--
+					bts, err = zb0003.UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "Assets")
+						return
+					}
+					bts, err = zb0004.UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "Assets", zb0003)
+						return
+					}
+					(*z).Assets[zb0003] = zb0004
+				}
+			case "appl":
+				var zb0026 int
+				var zb0027 bool
+				zb0026, zb0027, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AppLocalStates")
+					return
+				}
+				if zb0026 > bounds.EncodedMaxAppLocalStates {
+					err = msgp.ErrOverflow(uint64(zb0026), uint64(bounds.EncodedMaxAppLocalStates))
+					err = msgp.WrapError(err, "AppLocalStates")
+					return
+				}
+				if zb0027 {
+					(*z).AppLocalStates = nil
+				} else if (*z).AppLocalStates == nil {
+					(*z).AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState, zb0026)
+				}
+				for zb0026 > 0 {
+					var zb0005 basics.AppIndex
+					var zb0006 basics.AppLocalState
+					zb0026--
+					bts, err = zb0005.UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "AppLocalStates")
+						return
+					}
+					bts, err = zb0006.UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "AppLocalStates", zb0005)
+						return
+					}
+					(*z).AppLocalStates[zb0005] = zb0006
+				}
+			case "appp":
+				var zb0028 int
+				var zb0029 bool
+				zb0028, zb0029, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AppParams")
+					return
+				}
+				if zb0028 > bounds.EncodedMaxAppParams {
+					err = msgp.ErrOverflow(uint64(zb0028), uint64(bounds.EncodedMaxAppParams))
+					err = msgp.WrapError(err, "AppParams")
+					return
+				}
+				if zb0029 {
+					(*z).AppParams = nil
+				} else if (*z).AppParams == nil {
+					(*z).AppParams = make(map[basics.AppIndex]basics.AppParams, zb0028)
+				}
+				for zb0028 > 0 {
+					var zb0007 basics.AppIndex
+					var zb0008 basics.AppParams
+					zb0028--
+					bts, err = zb0007.UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "AppParams")
+						return
+					}
+					bts, err = zb0008.UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "AppParams", zb0007)
+						return
+					}
+					(*z).AppParams[zb0007] = zb0008
+				}
+			case "tsch":
+				bts, err = (*z).TotalAppSchema.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalAppSchema")
+					return
+				}
+			case "teap":
+				(*z).TotalExtraAppPages, bts, err = msgp.ReadUint32Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalExtraAppPages")
+					return
+				}
+			case "tbx":
+				(*z).TotalBoxes, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalBoxes")
+					return
+				}
+			case "tbxb":
+				(*z).TotalBoxBytes, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalBoxBytes")
+					return
+				}
+			case "boxes":
+				var zb0030 int
+				var zb0031 bool
+				zb0030, zb0031, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Boxes")
+					return
+				}
+				if zb0030 > encodedMaxGenesisBoxes {
+					err = msgp.ErrOverflow(uint64(zb0030), uint64(encodedMaxGenesisBoxes))
+					err = msgp.WrapError(err, "Boxes")
+					return
+				}
+				if zb0031 {
+					(*z).Boxes = nil
+				} else if (*z).Boxes != nil && cap((*z).Boxes) >= zb0030 {
+					(*z).Boxes = ((*z).Boxes)[:zb0030]
+				} else {
+					(*z).Boxes = make([]GenesisBox, zb0030)
+				}
+				for zb0009 := range (*z).Boxes {
+					bts, err = (*z).Boxes[zb0009].UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "Boxes", zb0009)
+						return
+					}
+				}
 			default:
 				err = msgp.ErrNoField(string(field))
 				if err != nil {
@@ -2840,18 +3390,79 @@ func (_ *GenesisAccountData) CanUnmarshalMsg(z interface{}) bool {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *GenesisAccountData) Msgsize() (s int) {
-	s = 1 + 4 + (*z).Status.Msgsize() + 5 + (*z).MicroAlgos.Msgsize() + 5 + (*z).VoteID.Msgsize() + 6 + (*z).StateProofID.Msgsize() + 4 + (*z).SelectionID.Msgsize() + 8 + (*z).VoteFirstValid.Msgsize() + 8 + (*z).VoteLastValid.Msgsize() + 7 + msgp.Uint64Size
+	s = 3 + 4 + (*z).Status.Msgsize() + 5 + (*z).MicroAlgos.Msgsize() + 5 + (*z).VoteID.Msgsize() + 6 + (*z).StateProofID.Msgsize() + 4 + (*z).SelectionID.Msgsize() + 8 + (*z).VoteFirstValid.Msgsize() + 8 + (*z).VoteLastValid.Msgsize() + 7 + msgp.Uint64Size + 5 + msgp.MapHeaderSize
+	if (*z).AssetParams != nil {
+		for zb0001, zb0002 := range (*z).AssetParams {
+			_ = zb0001
+			_ = zb0002
+			s += 0 + zb0001.Msgsize() + zb0002.Msgsize()
+		}
+	}
+	s += 6 + msgp.MapHeaderSize
+	if (*z).Assets != nil {
+		for zb0003, zb0004 := range (*z).Assets {
+			_ = zb0003
+			_ = zb0004
+			s += 0 + zb0003.Msgsize() + zb0004.Msgsize()
+		}
+	}
+	s += 5 + msgp.MapHeaderSize
+	if (*z).AppLocalStates != nil {
+		for zb0005, zb0006 := range (*z).AppLocalStates {
+			_ = zb0005
+			_ = zb0006
+			s += 0 + zb0005.Msgsize() + zb0006.Msgsize()
+		}
+	}
+	s += 5 + msgp.MapHeaderSize
+	if (*z).AppParams != nil {
+		for zb0007, zb0008 := range (*z).AppParams {
+			_ = zb0007
+			_ = zb0008
+			s += 0 + zb0007.Msgsize() + zb0008.Msgsize()
+		}
+	}
+	s += 5 + (*z).TotalAppSchema.Msgsize() + 5 + msgp.Uint32Size + 4 + msgp.Uint64Size + 5 + msgp.Uint64Size + 6 + msgp.ArrayHeaderSize
+	for zb0009 := range (*z).Boxes {
+		s += (*z).Boxes[zb0009].Msgsize()
+	}
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *GenesisAccountData) MsgIsZero() bool {
-	return ((*z).Status.MsgIsZero()) && ((*z).MicroAlgos.MsgIsZero()) && ((*z).VoteID.MsgIsZero()) && ((*z).StateProofID.MsgIsZero()) && ((*z).SelectionID.MsgIsZero()) && ((*z).VoteFirstValid.MsgIsZero()) && ((*z).VoteLastValid.MsgIsZero()) && ((*z).VoteKeyDilution == 0)
+	return ((*z).Status.MsgIsZero()) && ((*z).MicroAlgos.MsgIsZero()) && ((*z).VoteID.MsgIsZero()) && ((*z).StateProofID.MsgIsZero()) && ((*z).SelectionID.MsgIsZero()) && ((*z).VoteFirstValid.MsgIsZero()) && ((*z).VoteLastValid.MsgIsZero()) && ((*z).VoteKeyDilution == 0) && (len((*z).AssetParams) == 0) && (len((*z).Assets) == 0) && (len((*z).AppLocalStates) == 0) && (len((*z).AppParams) == 0) && ((*z).TotalAppSchema.MsgIsZero()) && ((*z).TotalExtraAppPages == 0) && ((*z).TotalBoxes == 0) && ((*z).TotalBoxBytes == 0) && (len((*z).Boxes) == 0)
 }
 
 // MaxSize returns a maximum valid message size for this message type
 func GenesisAccountDataMaxSize() (s int) {
-	s = 1 + 4 + basics.StatusMaxSize() + 5 + basics.MicroAlgosMaxSize() + 5 + crypto.OneTimeSignatureVerifierMaxSize() + 6 + merklesignature.CommitmentMaxSize() + 4 + crypto.VRFVerifierMaxSize() + 8 + basics.RoundMaxSize() + 8 + basics.RoundMaxSize() + 7 + msgp.Uint64Size
+	s = 3 + 4 + basics.StatusMaxSize() + 5 + basics.MicroAlgosMaxSize() + 5 + crypto.OneTimeSignatureVerifierMaxSize() + 6 + merklesignature.CommitmentMaxSize() + 4 + crypto.VRFVerifierMaxSize() + 8 + basics.RoundMaxSize() + 8 + basics.RoundMaxSize() + 7 + msgp.Uint64Size + 5
+	s += msgp.MapHeaderSize
+	// Adding size of map keys for z.AssetParams
+	s += bounds.EncodedMaxAssetsPerAccount * (basics.AssetIndexMaxSize())
+	// Adding size of map values for z.AssetParams
+	s += bounds.EncodedMaxAssetsPerAccount * (basics.AssetParamsMaxSize())
+	s += 6
+	s += msgp.MapHeaderSize
+	// Adding size of map keys for z.Assets
+	s += bounds.EncodedMaxAssetsPerAccount * (basics.AssetIndexMaxSize())
+	// Adding size of map values for z.Assets
+	s += bounds.EncodedMaxAssetsPerAccount * (basics.AssetHoldingMaxSize())
+	s += 5
+	s += msgp.MapHeaderSize
+	// Adding size of map keys for z.AppLocalStates
+	s += bounds.EncodedMaxAppLocalStates * (basics.AppIndexMaxSize())
+	// Adding size of map values for z.AppLocalStates
+	s += bounds.EncodedMaxAppLocalStates * (basics.AppLocalStateMaxSize())
+	s += 5
+	s += msgp.MapHeaderSize
+	// Adding size of map keys for z.AppParams
+	s += bounds.EncodedMaxAppParams * (basics.AppIndexMaxSize())
+	// Adding size of map values for z.AppParams
+	s += bounds.EncodedMaxAppParams * (basics.AppParamsMaxSize())
+	s += 5 + basics.StateSchemaMaxSize() + 5 + msgp.Uint32Size + 4 + msgp.Uint64Size + 5 + msgp.Uint64Size + 6
+	// Calculating size of slice: z.Boxes
+	s += msgp.ArrayHeaderSize + ((encodedMaxGenesisBoxes) * (GenesisBoxMaxSize()))
 	return
 }
 
@@ -3001,6 +3612,189 @@ func GenesisAllocationMaxSize() (s int) {
 	return
 }
 
+// MarshalMsg implements msgp.Marshaler
+func (z *GenesisBox) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 3 bits */
+	if len((*z).Name) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if len((*z).Value) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "n"
+			o = append(o, 0xa1, 0x6e)
+			o = msgp.AppendBytes(o, (*z).Name)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "v"
+			o = append(o, 0xa1, 0x76)
+			o = msgp.AppendBytes(o, (*z).Value)
+		}
+	}
+	return
+}
+
+func (_ *GenesisBox) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*GenesisBox)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *GenesisBox) UnmarshalMsgWithState(bts []byte, st msgp.UnmarshalState) (o []byte, err error) {
+	if st.AllowableDepth == 0 {
+		err = msgp.ErrMaxDepthExceeded{}
+		return
+	}
+	st.AllowableDepth--
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0003 int
+			zb0003, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+			if zb0003 > bounds.MaxBytesKeyValueLen {
+				err = msgp.ErrOverflow(uint64(zb0003), uint64(bounds.MaxBytesKeyValueLen))
+				return
+			}
+			(*z).Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Name)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0004 int
+			zb0004, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Value")
+				return
+			}
+			if zb0004 > bounds.MaxBoxSize {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(bounds.MaxBoxSize))
+				return
+			}
+			(*z).Value, bts, err = msgp.ReadBytesBytes(bts, (*z).Value)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Value")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = GenesisBox{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "n":
+				var zb0005 int
+				zb0005, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+				if zb0005 > bounds.MaxBytesKeyValueLen {
+					err = msgp.ErrOverflow(uint64(zb0005), uint64(bounds.MaxBytesKeyValueLen))
+					return
+				}
+				(*z).Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Name)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+			case "v":
+				var zb0006 int
+				zb0006, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Value")
+					return
+				}
+				if zb0006 > bounds.MaxBoxSize {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(bounds.MaxBoxSize))
+					return
+				}
+				(*z).Value, bts, err = msgp.ReadBytesBytes(bts, (*z).Value)
+				if err != nil {
+					err = msgp.WrapError(err, "Value")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (z *GenesisBox) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	return z.UnmarshalMsgWithState(bts, msgp.DefaultUnmarshalState)
+}
+func (_ *GenesisBox) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*GenesisBox)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *GenesisBox) Msgsize() (s int) {
+	s = 1 + 2 + msgp.BytesPrefixSize + len((*z).Name) + 2 + msgp.BytesPrefixSize + len((*z).Value)
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *GenesisBox) MsgIsZero() bool {
+	return (len((*z).Name) == 0) && (len((*z).Value) == 0)
+}
+
+// MaxSize returns a maximum valid message size for this message type
+func GenesisBoxMaxSize() (s int) {
+	s = 1 + 2 + msgp.BytesPrefixSize + bounds.MaxBytesKeyValueLen + 2 + msgp.BytesPrefixSize + bounds.MaxBoxSize
+	return
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *LightBlockHeader) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
@@ -106,6 +106,7 @@ func LoadLedger[T string | ledger.DirsAndPrefix](
 		Block:       genBlock,
 		Accounts:    genesisBal.Balances,
 		GenesisHash: genesisHash,
+		Boxes:       genesisBal.Boxes,
 	}
 	l.log.Debugf("Initializing Ledger(%v)", dir)
 
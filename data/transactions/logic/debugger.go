@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 
 	"github.com/algorand/go-algorand/config"
@@ -51,9 +52,14 @@ type Debugger interface {
 type debuggerEvalTracerAdaptor struct {
 	NullEvalTracer
 
-	debugger   Debugger
-	txnDepth   int
-	debugState *DebugState
+	debugger Debugger
+	txnDepth int
+	// inners is set when the programs of inner transactions are reported as well
+	inners bool
+	// debugStates are the states of the programs being evaluated, the current one is the last,
+	// the others wait for the inner transactions they issued
+	debugStates   []*DebugState
+	innerPrograms int
 }
 
 // MakeEvalTracerDebuggerAdaptor creates an adaptor that externally adheres to the EvalTracer
@@ -68,6 +74,13 @@ func MakeEvalTracerDebuggerAdaptor(debugger Debugger) EvalTracer {
 	return &debuggerEvalTracerAdaptor{debugger: debugger}
 }
 
+// MakeEvalTracerDebuggerAdaptorWithInners creates an adaptor like MakeEvalTracerDebuggerAdaptor
+// that also drives the Debugger through the programs of inner transactions. Their DebugState
+// has a unique ExecID and the Callers waiting for them.
+func MakeEvalTracerDebuggerAdaptorWithInners(debugger Debugger) EvalTracer {
+	return &debuggerEvalTracerAdaptor{debugger: debugger, inners: true}
+}
+
 // BeforeTxnGroup updates inner txn depth
 func (a *debuggerEvalTracerAdaptor) BeforeTxnGroup(ep *EvalParams) {
 	a.txnDepth++
@@ -80,35 +93,52 @@ func (a *debuggerEvalTracerAdaptor) AfterTxnGroup(ep *EvalParams, deltas *ledger
 
 // BeforeProgram invokes the debugger's Register hook
 func (a *debuggerEvalTracerAdaptor) BeforeProgram(cx *EvalContext) {
-	if a.txnDepth > 0 {
+	if a.txnDepth > 0 && !a.inners {
 		// only report updates for top-level transactions, for backwards compatibility
 		return
 	}
-	a.debugState = makeDebugState(cx)
+	ds := makeDebugState(cx)
+	if len(a.debugStates) > 0 {
+		// the same program may run in several inner transactions, tell their executions apart
+		a.innerPrograms++
+		ds.ExecID = fmt.Sprintf("%s-%d", ds.ProgramID, a.innerPrograms)
+		ds.Callers = make([]CallerFrame, len(a.debugStates))
+		for i, caller := range a.debugStates {
+			ds.Callers[i] = CallerFrame{ExecID: caller.ExecID, Line: caller.Line, CallStack: caller.CallStack}
+		}
+	}
+	a.debugStates = append(a.debugStates, ds)
 	a.debugger.Register(a.refreshDebugState(cx, nil))
 }
 
 // BeforeOpcode invokes the debugger's Update hook
 func (a *debuggerEvalTracerAdaptor) BeforeOpcode(cx *EvalContext) {
-	if a.txnDepth > 0 {
+	if a.txnDepth > 0 && !a.inners {
 		// only report updates for top-level transactions, for backwards compatibility
 		return
 	}
-	a.debugger.Update(a.refreshDebugState(cx, nil))
+	ds := a.refreshDebugState(cx, nil)
+	ds.StateChange = explainStateChange(cx)
+	a.debugger.Update(ds)
 }
 
 // AfterProgram invokes the debugger's Complete hook
 func (a *debuggerEvalTracerAdaptor) AfterProgram(cx *EvalContext, pass bool, evalError error) {
-	if a.txnDepth > 0 {
+	if a.txnDepth > 0 && !a.inners {
 		// only report updates for top-level transactions, for backwards compatibility
 		return
 	}
 	a.debugger.Complete(a.refreshDebugState(cx, evalError))
+	a.debugStates = a.debugStates[:len(a.debugStates)-1]
 }
 
 // WebDebugger represents a connection to tealdbg
 type WebDebugger struct {
 	URL string
+	// Token authenticates to a named session of a shared tealdbg, whose URL ends with /sessions/<name>
+	Token string
+	// Diffs sends the stack and scratch space changes of every step instead of their full contents
+	Diffs bool
 }
 
 // PCOffset stores the mapping from a program counter value to an offset in the
@@ -125,17 +155,29 @@ type CallFrame struct {
 	LabelName string `codec:"labelname"`
 }
 
+// CallerFrame stores the program waiting for the inner transaction it issued
+// at Line to complete.
+type CallerFrame struct {
+	ExecID    string      `codec:"execid"`
+	Line      int         `codec:"line"`
+	CallStack []CallFrame `codec:"callstack"`
+}
+
 // DebugState is a representation of the evaluation context that we encode
 // to json and send to tealdbg
 type DebugState struct {
 	// fields set once on Register
 	ExecID      string                         `codec:"execid"`
+	ProgramID   string                         `codec:"progid"`
 	Disassembly string                         `codec:"disasm"`
 	PCOffset    []PCOffset                     `codec:"pctooffset"`
 	TxnGroup    []transactions.SignedTxnWithAD `codec:"txngroup"`
 	GroupIndex  int                            `codec:"gindex"`
 	Proto       *config.ConsensusParams        `codec:"proto"`
 	Globals     []basics.TealValue             `codec:"globals"`
+	// Callers are the programs, outermost first, waiting for the inner transaction
+	// this program is evaluated for. Empty for top-level programs.
+	Callers []CallerFrame `codec:"callers"`
 
 	// fields updated every step
 	PC           int                `codec:"pc"`
@@ -148,6 +190,41 @@ type DebugState struct {
 
 	// global/local state changes are updated every step. Stateful TEAL only.
 	transactions.EvalDelta
+
+	// StateChange describes the app state modification the opcode at PC is
+	// about to make, if any. Stateful TEAL only.
+	StateChange *AppStateChange `codec:"statechange"`
+
+	// StackDiff and ScratchChanges describe the changes of the stack and
+	// scratch space since the previous step.
+	StackDiff      StackDiff       `codec:"stackdiff"`
+	ScratchChanges []ScratchChange `codec:"scratchchanges"`
+	// Diff is set when Stack and Scratch are left out, to be rebuilt
+	// from the previous step with StackDiff and ScratchChanges.
+	Diff bool `codec:"diff"`
+}
+
+// StackDiff describes a stack as the values pushed on the bottom Height
+// values of the previous stack.
+type StackDiff struct {
+	Height int                `codec:"height"`
+	Pushed []basics.TealValue `codec:"pushed"`
+}
+
+// ScratchChange describes a scratch slot written with Value.
+type ScratchChange struct {
+	Slot  int              `codec:"slot"`
+	Value basics.TealValue `codec:"value"`
+}
+
+// AppStateChange describes a write or delete of a global key, a local key of
+// an account, or a box, as performed by a single opcode.
+type AppStateChange struct {
+	State   AppStateEnum    `codec:"state"`
+	Op      AppStateOpEnum  `codec:"op"`
+	AppID   basics.AppIndex `codec:"appid"`
+	Account basics.Address  `codec:"account"`
+	Key     string          `codec:"key"`
 }
 
 // GetProgramID returns program or execution ID that is string representation of sha256 checksum.
@@ -157,22 +234,44 @@ func GetProgramID(program []byte) string {
 	return hex.EncodeToString(hash[:])
 }
 
-func makeDebugState(cx *EvalContext) *DebugState {
-	disasm, dsInfo, err := disassembleInstrumented(cx.program, nil)
+// MakeProgramDebugState returns the debug state of program evaluated for the txn at
+// groupIndex before its first opcode, with the fields set once on Register but Globals.
+// Debuggers replaying an execution traced elsewhere, e.g. by the simulate endpoint of
+// algod, go through its steps with SetStep.
+func MakeProgramDebugState(program []byte, txnGroup []transactions.SignedTxnWithAD, groupIndex int, proto *config.ConsensusParams) *DebugState {
+	disasm, dsInfo, err := disassembleInstrumented(program, nil)
 	if err != nil {
 		// Report disassembly error as program text
 		disasm = err.Error()
 	}
 
 	// initialize DebuggerState with immutable fields
-	ds := &DebugState{
-		ExecID:      GetProgramID(cx.program),
+	return &DebugState{
+		ExecID:      GetProgramID(program),
+		ProgramID:   GetProgramID(program),
 		Disassembly: disasm,
 		PCOffset:    dsInfo.pcOffset,
-		GroupIndex:  int(cx.groupIndex),
-		TxnGroup:    cx.TxnGroup,
-		Proto:       cx.Proto,
+		GroupIndex:  groupIndex,
+		TxnGroup:    txnGroup,
+		Proto:       proto,
 	}
+}
+
+// SetStep updates the fields of d updated every step to the ones of the opcode at pc
+// about to run on stack and scratch within the subroutines of callStack
+func (d *DebugState) SetStep(pc int, stack []basics.TealValue, scratch []basics.TealValue, callStack []CallFrame) {
+	d.PC = pc
+	d.Line = d.PCToLine(pc)
+	d.StackDiff = diffStack(d.Stack, stack)
+	d.ScratchChanges = diffScratch(d.Scratch, scratch)
+	d.Stack = stack
+	d.Scratch = scratch
+	d.CallStack = callStack
+	d.StateChange = nil
+}
+
+func makeDebugState(cx *EvalContext) *DebugState {
+	ds := MakeProgramDebugState(cx.program, cx.TxnGroup, int(cx.groupIndex), cx.Proto)
 
 	globals := make([]basics.TealValue, len(globalFieldSpecs))
 	for _, fs := range globalFieldSpecs {
@@ -253,6 +352,77 @@ func (sv stackValue) toEncodedTealValue() basics.TealValue {
 	return basics.TealValue{Type: basics.TealUintType, Uint: sv.Uint}
 }
 
+// zeroTealValue is the encoded value of the scratch slots never written
+var zeroTealValue = basics.TealValue{Type: basics.TealUintType}
+
+func diffStack(prev, stack []basics.TealValue) StackDiff {
+	height := 0
+	for height < len(prev) && height < len(stack) && prev[height] == stack[height] {
+		height++
+	}
+	return StackDiff{Height: height, Pushed: stack[height:]}
+}
+
+func diffScratch(prev, scratch []basics.TealValue) (changes []ScratchChange) {
+	for i, tv := range scratch {
+		old := zeroTealValue
+		if i < len(prev) {
+			old = prev[i]
+		}
+		if tv != old {
+			changes = append(changes, ScratchChange{Slot: i, Value: tv})
+		}
+	}
+	return
+}
+
+// Compact returns a copy of the state with only the changes of the stack and
+// scratch space since the previous step rather than their full contents.
+func (d *DebugState) Compact() *DebugState {
+	compact := *d
+	compact.Stack = nil
+	compact.Scratch = nil
+	compact.Diff = true
+	return &compact
+}
+
+// ApplyDiff rebuilds the stack and scratch space of a compact state from the
+// ones of the previous state of the execution, nil before the first step.
+func (d *DebugState) ApplyDiff(prev *DebugState) error {
+	if !d.Diff {
+		return nil
+	}
+	var prevStack, prevScratch []basics.TealValue
+	if prev != nil {
+		prevStack, prevScratch = prev.Stack, prev.Scratch
+	}
+	if d.StackDiff.Height < 0 || d.StackDiff.Height > len(prevStack) {
+		return fmt.Errorf("stack diff keeps %d values of %d", d.StackDiff.Height, len(prevStack))
+	}
+	stack := make([]basics.TealValue, 0, d.StackDiff.Height+len(d.StackDiff.Pushed))
+	stack = append(stack, prevStack[:d.StackDiff.Height]...)
+	stack = append(stack, d.StackDiff.Pushed...)
+
+	scratch := make([]basics.TealValue, len(scratchSpace{}))
+	for i := range scratch {
+		scratch[i] = zeroTealValue
+		if i < len(prevScratch) {
+			scratch[i] = prevScratch[i]
+		}
+	}
+	for _, change := range d.ScratchChanges {
+		if change.Slot < 0 || change.Slot >= len(scratch) {
+			return fmt.Errorf("invalid scratch slot %d", change.Slot)
+		}
+		scratch[change.Slot] = change.Value
+	}
+
+	d.Stack = stack
+	d.Scratch = scratch
+	d.Diff = false
+	return nil
+}
+
 // parseCallStack initializes an array of CallFrame objects from the raw
 // callstack.
 func (d *DebugState) parseCallstack(callstack []frame) []CallFrame {
@@ -275,12 +445,10 @@ func (d *DebugState) parseCallstack(callstack []frame) []CallFrame {
 }
 
 func (a *debuggerEvalTracerAdaptor) refreshDebugState(cx *EvalContext, evalError error) *DebugState {
-	ds := a.debugState
+	ds := a.debugStates[len(a.debugStates)-1]
 
 	// Update pc, line, error, stack, scratch space, callstack,
 	// and opcode budget
-	ds.PC = cx.pc
-	ds.Line = ds.PCToLine(cx.pc)
 	if evalError != nil {
 		ds.Error = evalError.Error()
 	}
@@ -295,10 +463,8 @@ func (a *debuggerEvalTracerAdaptor) refreshDebugState(cx *EvalContext, evalError
 		scratch[i] = sv.toEncodedTealValue()
 	}
 
-	ds.Stack = stack
-	ds.Scratch = scratch
+	ds.SetStep(cx.pc, stack, scratch, ds.parseCallstack(cx.callstack))
 	ds.OpcodeBudget = cx.remainingBudget()
-	ds.CallStack = ds.parseCallstack(cx.callstack)
 
 	if cx.runMode == ModeApp {
 		ds.EvalDelta = cx.txn.EvalDelta
@@ -307,6 +473,24 @@ func (a *debuggerEvalTracerAdaptor) refreshDebugState(cx *EvalContext, evalError
 	return ds
 }
 
+// explainStateChange reports the app state the opcode at the current PC is
+// about to modify, or nil if it does not modify any.
+func explainStateChange(cx *EvalContext) *AppStateChange {
+	if cx.runMode != ModeApp || cx.pc >= len(cx.program) {
+		return nil
+	}
+	spec := cx.GetOpSpec()
+	if spec.AppStateExplain == nil || len(cx.Stack) < len(spec.Arg.Types) {
+		// too few arguments: the opcode fails before touching any state
+		return nil
+	}
+	state, op, appID, addr, key := spec.AppStateExplain(cx)
+	if op == AppStateRead {
+		return nil
+	}
+	return &AppStateChange{State: state, Op: op, AppID: appID, Account: addr, Key: key}
+}
+
 func (dbg *WebDebugger) postState(state *DebugState, endpoint string) error {
 	var body bytes.Buffer
 	enc := protocol.NewJSONEncoder(&body)
@@ -319,12 +503,15 @@ func (dbg *WebDebugger) postState(state *DebugState, endpoint string) error {
 	if err != nil {
 		return err
 	}
-	u.Path = endpoint
+	u.Path = path.Join("/", u.Path, endpoint)
 
 	req, err := http.NewRequest(http.MethodPost, u.String(), &body)
 	if err != nil {
 		return err
 	}
+	if len(dbg.Token) != 0 {
+		req.Header.Set("X-Tealdbg-Token", dbg.Token)
+	}
 
 	httpClient := &http.Client{}
 	r, err := httpClient.Do(req)
@@ -356,6 +543,9 @@ func (dbg *WebDebugger) Register(state *DebugState) {
 
 // Update sends state to remote debugger
 func (dbg *WebDebugger) Update(state *DebugState) {
+	if dbg.Diffs {
+		state = state.Compact()
+	}
 	err := dbg.postState(state, "exec/update")
 	if err != nil {
 		logging.Base().Errorf("Failed to post state to exec/update: %s", err.Error())
@@ -364,6 +554,9 @@ func (dbg *WebDebugger) Update(state *DebugState) {
 
 // Complete sends state to remote debugger
 func (dbg *WebDebugger) Complete(state *DebugState) {
+	if dbg.Diffs {
+		state = state.Compact()
+	}
 	err := dbg.postState(state, "exec/complete")
 	if err != nil {
 		logging.Base().Errorf("Failed to post state to exec/complete: %s", err.Error())
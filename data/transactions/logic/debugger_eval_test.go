@@ -85,7 +85,7 @@ func TestWebDebuggerManual(t *testing.T) { //nolint:paralleltest // Manual test
 		tx.SelectionPK[:],
 		tx.Note,
 	}
-	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&WebDebugger{URL: debugURL})
+	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&WebDebugger{URL: debugURL, Token: os.Getenv("TEAL_DEBUGGER_TOKEN")})
 	TestLogic(t, debuggerTestProgramApprove, AssemblerMaxVersion, ep)
 }
 
@@ -94,21 +94,32 @@ type testDebugger struct {
 	update   int
 	complete int
 	state    *DebugState
+	changes  []AppStateChange
+	// registered are the states of the programs on registration
+	registered []DebugState
+	// steps are the states of the updates and completion
+	steps []DebugState
 }
 
 func (d *testDebugger) Register(state *DebugState) {
 	d.register++
 	d.state = state
+	d.registered = append(d.registered, *state)
 }
 
 func (d *testDebugger) Update(state *DebugState) {
 	d.update++
 	d.state = state
+	d.steps = append(d.steps, *state)
+	if state.StateChange != nil {
+		d.changes = append(d.changes, *state.StateChange)
+	}
 }
 
 func (d *testDebugger) Complete(state *DebugState) {
 	d.complete++
 	d.state = state
+	d.steps = append(d.steps, *state)
 }
 
 var debuggerTestCases = []struct {
@@ -271,6 +282,44 @@ func TestDebuggerInnerAppEval(t *testing.T) {
 	}
 }
 
+func TestDebuggerInnerPrograms(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testDbg := testDebugger{}
+	ep, tx, ledger := MakeSampleEnv()
+	ledger.NewApp(tx.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(basics.AppIndex(888).Address(), 200_000)
+
+	scenario := mocktracer.GetTestScenarios()["none"](mocktracer.TestScenarioInfo{
+		CallingTxn:   *tx,
+		CreatedAppID: basics.AppIndex(888),
+	})
+
+	ep.Tracer = MakeEvalTracerDebuggerAdaptorWithInners(&testDbg)
+	ops := TestProg(t, scenario.Program, AssemblerNoVersion)
+	TestAppBytes(t, ops.Program, ep)
+
+	// the inner app call program runs 3 opcodes, the payments have none
+	require.Equal(t, 2, testDbg.register)
+	require.Equal(t, 2, testDbg.complete)
+	require.Equal(t, 32+3, testDbg.update)
+	require.Equal(t, []basics.TealValue{{Type: basics.TealUintType, Uint: 1}}, testDbg.state.Stack)
+
+	outer, inner := testDbg.registered[0], testDbg.registered[1]
+	require.Equal(t, GetProgramID(ops.Program), outer.ProgramID)
+	require.Equal(t, outer.ProgramID, outer.ExecID)
+	require.Empty(t, outer.Callers)
+
+	require.NotEqual(t, inner.ProgramID, inner.ExecID)
+	require.Len(t, inner.Callers, 1)
+	require.Equal(t, outer.ExecID, inner.Callers[0].ExecID)
+	lines := strings.Split(outer.Disassembly, "\n")
+	require.Equal(t, "itxn_submit", lines[inner.Callers[0].Line])
+	// the inner program sees the inner transaction
+	require.Equal(t, basics.AppIndex(888).Address(), inner.TxnGroup[inner.GroupIndex].Txn.Sender)
+}
+
 func TestCallStackUpdate(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -297,3 +346,80 @@ func TestCallStackUpdate(t *testing.T) {
 	require.Len(t, testDbg.state.Stack, 1)
 	require.Equal(t, testDbg.state.CallStack, expectedCallFrames)
 }
+
+func TestDebuggerStateChange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := MakeSampleEnv()
+	ledger.NewApp(tx.Sender, 888, basics.AppParams{
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+		LocalStateSchema:  basics.StateSchema{NumUint: 1},
+	})
+	ledger.NewLocals(tx.Sender, 888)
+
+	testDbg := testDebugger{}
+	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&testDbg)
+	TestApp(t, `byte "g"; app_global_get; pop
+byte "g"; int 1; app_global_put
+txn Sender; byte "l"; int 2; app_local_put
+byte "self"; int 8; box_create; pop
+byte "self"; box_del; pop
+byte "g"; app_global_del
+int 1`, ep)
+
+	// reads are not reported, writes and deletes are
+	require.Equal(t, []AppStateChange{
+		{State: GlobalState, Op: AppStateWrite, AppID: 888, Key: "g"},
+		{State: LocalState, Op: AppStateWrite, AppID: 888, Account: tx.Sender, Key: "l"},
+		{State: BoxState, Op: AppStateWrite, AppID: 888, Key: "self"},
+		{State: BoxState, Op: AppStateDelete, AppID: 888, Key: "self"},
+		{State: GlobalState, Op: AppStateDelete, AppID: 888, Key: "g"},
+	}, testDbg.changes)
+	require.Nil(t, testDbg.state.StateChange)
+}
+
+func TestDebuggerStateDiff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testDbg := testDebugger{}
+	ep := DefaultSigParams()
+	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&testDbg)
+	TestLogic(t, `pushint 1; pushint 2; store 3; pushint 4; +; store 200; pushint 1`, AssemblerMaxVersion, ep)
+
+	// a step for each opcode, then completion
+	require.Len(t, testDbg.steps, 8)
+	one := basics.TealValue{Type: basics.TealUintType, Uint: 1}
+	two := basics.TealValue{Type: basics.TealUintType, Uint: 2}
+	five := basics.TealValue{Type: basics.TealUintType, Uint: 5}
+	require.Equal(t, StackDiff{Height: 1, Pushed: []basics.TealValue{two}}, testDbg.steps[2].StackDiff)
+	require.Empty(t, testDbg.steps[2].ScratchChanges)
+	require.Equal(t, StackDiff{Height: 1, Pushed: []basics.TealValue{}}, testDbg.steps[3].StackDiff)
+	require.Equal(t, []ScratchChange{{Slot: 3, Value: two}}, testDbg.steps[3].ScratchChanges)
+	require.Equal(t, StackDiff{Height: 0, Pushed: []basics.TealValue{five}}, testDbg.steps[5].StackDiff)
+	require.Equal(t, []ScratchChange{{Slot: 200, Value: five}}, testDbg.steps[6].ScratchChanges)
+	require.Equal(t, StackDiff{Height: 0, Pushed: []basics.TealValue{one}}, testDbg.steps[7].StackDiff)
+
+	// the full stack and scratch space are rebuilt from the compact states
+	var prev *DebugState
+	for i := range testDbg.steps {
+		step := &testDbg.steps[i]
+		compact := step.Compact()
+		require.True(t, compact.Diff)
+		require.Nil(t, compact.Stack)
+		require.Nil(t, compact.Scratch)
+		require.NoError(t, compact.ApplyDiff(prev))
+		require.False(t, compact.Diff)
+		require.Equal(t, step.Stack, compact.Stack, "step %d", i)
+		require.Equal(t, step.Scratch, compact.Scratch, "step %d", i)
+		prev = compact
+	}
+
+	// diffs must fit the previous state
+	compact := testDbg.steps[3].Compact()
+	require.ErrorContains(t, compact.ApplyDiff(nil), "stack diff keeps 1 values of 0")
+	compact.StackDiff.Height = 0
+	compact.ScratchChanges = []ScratchChange{{Slot: 256}}
+	require.ErrorContains(t, compact.ApplyDiff(nil), "invalid scratch slot 256")
+}
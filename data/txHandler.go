@@ -867,6 +867,7 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 			}
 		}
 		// this TX message was rate-limited by ERL
+		network.CountDroppedMessage(protocol.TxnTag, network.DropRateLimited, rawmsg.Sender)
 		return network.OutgoingMessage{Action: network.Ignore}
 	}
 
@@ -884,6 +885,7 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 	}
 
 	if handler.incomingTxGroupAppRateLimit(unverifiedTxGroup, rawmsg.Sender) {
+		network.CountDroppedMessage(protocol.TxnTag, network.DropRateLimited, rawmsg.Sender)
 		return network.OutgoingMessage{Action: network.Ignore}
 	}
 
@@ -922,6 +924,7 @@ func (handler *TxHandler) validateIncomingTxMessage(rawmsg network.IncomingMessa
 	}
 
 	if handler.incomingTxGroupAppRateLimit(unverifiedTxGroup, rawmsg.Sender) {
+		network.CountDroppedMessage(protocol.TxnTag, network.DropRateLimited, rawmsg.Sender)
 		return network.OutgoingMessage{Action: network.Ignore}
 	}
 
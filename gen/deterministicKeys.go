@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding/binary"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// deterministicSeed derives the seed of the keys of kind of a wallet from a master seed.
+func deterministicSeed(kind, masterSeed, wallet string) crypto.Seed {
+	data := []byte("gen " + kind)
+	for _, s := range []string{masterSeed, wallet} {
+		data = binary.BigEndian.AppendUint32(data, uint32(len(s)))
+		data = append(data, s...)
+	}
+	return crypto.Seed(crypto.Hash(data))
+}
+
+// deriveDeterministicRootKeys derives the root keys of wallets from a master seed. Keys depend on
+// the wallet names only, so reordering the wallets keeps their keys.
+func deriveDeterministicRootKeys(masterSeed string, wallets []WalletData) map[string]derivedRootKey {
+	keys := make(map[string]derivedRootKey, len(wallets))
+	for _, wallet := range wallets {
+		key := derivedRootKey{
+			Wallet: wallet.Name,
+			seed:   deterministicSeed("root", masterSeed, wallet.Name),
+			origin: "the key derived from the deterministic seed",
+		}
+		key.Address = basics.Address(crypto.GenerateSignatureSecrets(key.seed).SignatureVerifier).String()
+		keys[wallet.Name] = key
+	}
+	return keys
+}
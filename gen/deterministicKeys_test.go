@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDeterministicGenesis(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "deterministic"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.DeterministicSeed = "benchmark network"
+	genesisData.Wallets = []WalletData{
+		{Name: "Wallet1", Stake: 50, Online: true},
+		{Name: "Wallet2", Stake: 50},
+	}
+
+	generate := func(outDir string) []byte {
+		require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, filepath.Join(tempDir, outDir), nil))
+		genesisJSON, err := os.ReadFile(filepath.Join(tempDir, outDir, config.GenesisJSONFile))
+		require.NoError(t, err)
+		return genesisJSON
+	}
+
+	// the same seed gives the same root, participation and VRF keys
+	genesis1 := generate("net1")
+	require.Equal(t, string(genesis1), string(generate("net2")))
+
+	part1, db1, err := loadPartKeys(filepath.Join(tempDir, "net1", config.PartKeyFilename("Wallet1", 0, 100)))
+	require.NoError(t, err)
+	defer db1.Close()
+	part2, db2, err := loadPartKeys(filepath.Join(tempDir, "net2", config.PartKeyFilename("Wallet1", 0, 100)))
+	require.NoError(t, err)
+	defer db2.Close()
+	require.Equal(t, part1.VRF, part2.VRF)
+	require.Equal(t, part1.Voting.Snapshot(), part2.Voting.Snapshot())
+	require.Equal(t, part1.StateProofSecrets.GetVerifier(), part2.StateProofSecrets.GetVerifier())
+
+	// reordering the wallets keeps their keys
+	genesisData.Wallets[0], genesisData.Wallets[1] = genesisData.Wallets[1], genesisData.Wallets[0]
+	require.Equal(t, string(genesis1), string(generate("net3")))
+
+	genesisData.DeterministicSeed = "another network"
+	require.NotEqual(t, string(genesis1), string(generate("net4")))
+
+	// existing root keys must match the seed
+	err = GenerateGenesisFiles(genesisData, config.Consensus, filepath.Join(tempDir, "net1"), nil)
+	require.ErrorContains(t, err, "doesn't match the key derived from the deterministic seed")
+
+	genesisData.RootKeyMnemonicFile = filepath.Join(tempDir, "mnemonic")
+	err = GenerateGenesisFiles(genesisData, config.Consensus, filepath.Join(tempDir, "net5"), nil)
+	require.ErrorContains(t, err, "both a mnemonic and a deterministic seed")
+}
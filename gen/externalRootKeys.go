@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	kmdconfig "github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// The external signers a wallet root key can be held by
+const (
+	ExternalSignerLedger = "ledger"
+	ExternalSignerPKCS11 = "pkcs11"
+)
+
+// ExternalRootKeyData describes the root key of a wallet held by a hardware signer instead of a rootkey file
+type ExternalRootKeyData struct {
+	// Signer is ExternalSignerLedger or ExternalSignerPKCS11
+	Signer string
+	// Address is the address of the key. It is required for PKCS#11 keys, and read from the
+	// device for Ledger keys when empty.
+	Address string `json:",omitempty"`
+	// Device selects the Ledger by its kmd wallet name or ID when more than one is attached
+	Device string `json:",omitempty"`
+}
+
+// readLedgerAddress reads the address of the key of a Ledger device, set apart so tests can run without one
+var readLedgerAddress = readLedgerDeviceAddress
+
+// readLedgerDeviceAddress reads the address of the key of the attached Ledger device, the one matching
+// device if not empty.
+func readLedgerDeviceAddress(device string) (basics.Address, error) {
+	var ledgerDriver driver.LedgerWalletDriver
+	err := ledgerDriver.InitWithConfig(kmdconfig.DefaultConfig(""), logging.Base())
+	if err != nil {
+		return basics.Address{}, err
+	}
+	metadatas, err := ledgerDriver.ListWalletMetadatas()
+	if err != nil {
+		return basics.Address{}, err
+	}
+
+	var ids [][]byte
+	for _, metadata := range metadatas {
+		if device == "" || device == string(metadata.Name) || device == string(metadata.ID) {
+			ids = append(ids, metadata.ID)
+		}
+	}
+	switch {
+	case len(ids) == 0 && device != "":
+		return basics.Address{}, fmt.Errorf("no Ledger device %s is attached", device)
+	case len(ids) == 0:
+		return basics.Address{}, fmt.Errorf("no Ledger device is attached")
+	case len(ids) > 1:
+		return basics.Address{}, fmt.Errorf("%d Ledger devices are attached, Device must select one", len(ids))
+	}
+
+	ledgerWallet, err := ledgerDriver.FetchWallet(ids[0])
+	if err != nil {
+		return basics.Address{}, err
+	}
+	keys, err := ledgerWallet.ListKeys()
+	if err != nil {
+		return basics.Address{}, err
+	}
+	if len(keys) == 0 {
+		return basics.Address{}, fmt.Errorf("the Ledger device has no key")
+	}
+	return basics.Address(keys[0]), nil
+}
+
+// resolveExternalRootKeys returns the addresses of the wallets with an external root key, by wallet name
+func resolveExternalRootKeys(wallets []WalletData) (map[string]basics.Address, error) {
+	addrs := make(map[string]basics.Address)
+	seen := make(map[basics.Address]string)
+	for _, wallet := range wallets {
+		external := wallet.ExternalRootKey
+		if external == nil {
+			continue
+		}
+
+		var addr basics.Address
+		var err error
+		switch strings.ToLower(external.Signer) {
+		case ExternalSignerLedger:
+			if external.Address == "" {
+				addr, err = readLedgerAddress(external.Device)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't read the root key of wallet %s from its Ledger: %v", wallet.Name, err)
+				}
+				break
+			}
+			addr, err = basics.UnmarshalChecksumAddress(external.Address)
+		case ExternalSignerPKCS11:
+			if external.Address == "" {
+				return nil, fmt.Errorf("wallet %s has a PKCS#11 root key without an address", wallet.Name)
+			}
+			addr, err = basics.UnmarshalChecksumAddress(external.Address)
+		default:
+			return nil, fmt.Errorf("wallet %s has a root key held by an unknown signer %q", wallet.Name, external.Signer)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("wallet %s has an invalid root key address %s: %v", wallet.Name, external.Address, err)
+		}
+
+		if other, ok := seen[addr]; ok {
+			return nil, fmt.Errorf("wallets %s and %s have the same root key %s", other, wallet.Name, addr)
+		}
+		seen[addr] = wallet.Name
+		addrs[wallet.Name] = addr
+	}
+	return addrs, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util"
+)
+
+func TestResolveExternalRootKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledgerAddr := basics.Address{1}
+	hsmAddr := basics.Address{2}
+	readLedgerAddress = func(device string) (basics.Address, error) {
+		if device != "nano" {
+			return basics.Address{}, fmt.Errorf("no Ledger device %s is attached", device)
+		}
+		return ledgerAddr, nil
+	}
+	defer func() { readLedgerAddress = readLedgerDeviceAddress }()
+
+	addrs, err := resolveExternalRootKeys([]WalletData{
+		{Name: "Local"},
+		{Name: "Ledger", ExternalRootKey: &ExternalRootKeyData{Signer: ExternalSignerLedger, Device: "nano"}},
+		{Name: "HSM", ExternalRootKey: &ExternalRootKeyData{Signer: ExternalSignerPKCS11, Address: hsmAddr.String()}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]basics.Address{"Ledger": ledgerAddr, "HSM": hsmAddr}, addrs)
+
+	for _, external := range []ExternalRootKeyData{
+		{Signer: ExternalSignerLedger, Device: "other"},
+		{Signer: ExternalSignerPKCS11},
+		{Signer: ExternalSignerPKCS11, Address: "not an address"},
+		{Signer: "yubikey", Address: hsmAddr.String()},
+	} {
+		_, err = resolveExternalRootKeys([]WalletData{{Name: "Wallet", ExternalRootKey: &external}})
+		require.Error(t, err, external)
+	}
+
+	_, err = resolveExternalRootKeys([]WalletData{
+		{Name: "HSM1", ExternalRootKey: &ExternalRootKeyData{Signer: ExternalSignerPKCS11, Address: hsmAddr.String()}},
+		{Name: "HSM2", ExternalRootKey: &ExternalRootKeyData{Signer: ExternalSignerLedger, Address: hsmAddr.String()}},
+	})
+	require.ErrorContains(t, err, "same root key")
+}
+
+func TestGenesisExternalRootKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	hsmAddr := basics.Address{3}
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "external"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.Resume = true
+	genesisData.Wallets = []WalletData{
+		{Name: "Local", Stake: 50, Online: true},
+		{Name: "HSM", Stake: 50, Online: true, ExternalRootKey: &ExternalRootKeyData{Signer: ExternalSignerPKCS11, Address: hsmAddr.String()}},
+	}
+
+	outDir := t.TempDir()
+	generate := func() map[string]bookkeeping.GenesisAllocation {
+		require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+		genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+		require.NoError(t, err)
+		allocations := make(map[string]bookkeeping.GenesisAllocation)
+		for _, alloc := range genesis.Allocation {
+			allocations[alloc.Comment] = alloc
+		}
+		return allocations
+	}
+
+	allocations := generate()
+	require.Equal(t, hsmAddr.String(), allocations["HSM"].Address)
+	require.False(t, allocations["HSM"].State.VoteID.MsgIsZero())
+	require.False(t, util.FileExists(filepath.Join(outDir, config.RootKeyFilename("HSM"))))
+	require.True(t, util.FileExists(filepath.Join(outDir, config.PartKeyFilename("HSM", 0, 100))))
+	require.True(t, util.FileExists(filepath.Join(outDir, config.RootKeyFilename("Local"))))
+
+	// the resumed run takes the external wallet from the manifest, without a rootkey file
+	require.Equal(t, allocations, generate())
+}
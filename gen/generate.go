@@ -17,6 +17,7 @@
 package gen
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"math"
@@ -25,12 +26,12 @@ import (
 	"runtime"
 	"sort"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -55,6 +56,25 @@ type genesisAllocation struct {
 	Name   string
 	Stake  uint64
 	Online basics.Status
+	// FirstPartKeyRound, LastPartKeyRound and PartKeyDilution override the GenesisData ones when set
+	FirstPartKeyRound *basics.Round
+	LastPartKeyRound  *basics.Round
+	PartKeyDilution   uint64
+	NoStateProofKeys  bool
+}
+
+// partKeyParams returns the validity and dilution of the wallet participation keys, given the genesis ones.
+func (wallet genesisAllocation) partKeyParams(firstValid, lastValid basics.Round, keyDilution uint64) (basics.Round, basics.Round, uint64) {
+	if wallet.FirstPartKeyRound != nil {
+		firstValid = *wallet.FirstPartKeyRound
+	}
+	if wallet.LastPartKeyRound != nil {
+		lastValid = *wallet.LastPartKeyRound
+	}
+	if wallet.PartKeyDilution != 0 {
+		keyDilution = wallet.PartKeyDilution
+	}
+	return firstValid, lastValid, keyDilution
 }
 
 func u64absDiff(a, b uint64) uint64 {
@@ -98,9 +118,13 @@ func setupGenerateGenesisFiles(genesisData *GenesisData, consensus config.Consen
 
 	for i, wallet := range genesisData.Wallets {
 		acct := genesisAllocation{
-			Name:   wallet.Name,
-			Stake:  uint64(float64(TotalMoney/100)*wallet.Stake + .5),
-			Online: basics.Online,
+			Name:              wallet.Name,
+			Stake:             uint64(float64(TotalMoney/100)*wallet.Stake + .5),
+			Online:            basics.Online,
+			FirstPartKeyRound: wallet.FirstPartKeyRound,
+			LastPartKeyRound:  wallet.LastPartKeyRound,
+			PartKeyDilution:   wallet.PartKeyDilution,
+			NoStateProofKeys:  wallet.NoStateProofKeys,
 		}
 		if !wallet.Online {
 			acct.Online = basics.Offline
@@ -139,6 +163,12 @@ func setupGenerateGenesisFiles(genesisData *GenesisData, consensus config.Consen
 
 // GenerateGenesisFiles generates the genesis.json file and wallet files for a give genesis configuration.
 func GenerateGenesisFiles(genesisData GenesisData, consensus config.ConsensusProtocols, outDir string, verboseOut io.Writer) error {
+	return GenerateGenesisFilesWithProgress(context.Background(), genesisData, consensus, outDir, verboseOut, nil)
+}
+
+// GenerateGenesisFilesWithProgress is GenerateGenesisFiles reporting the progress of the wallet creation to
+// progress, which may be nil. Cancelling ctx stops the creation of the wallets not started yet.
+func GenerateGenesisFilesWithProgress(ctx context.Context, genesisData GenesisData, consensus config.ConsensusProtocols, outDir string, verboseOut io.Writer, progress ProgressFunc) error {
 	proto, consensusParams, allocation, err := setupGenerateGenesisFiles(&genesisData, consensus, verboseOut)
 	if err != nil {
 		return err
@@ -150,11 +180,12 @@ func GenerateGenesisFiles(genesisData GenesisData, consensus config.ConsensusPro
 	}
 
 	return generateGenesisFiles(
-		proto, consensusParams, allocation, genesisData, outDir, verboseOut,
+		ctx, proto, consensusParams, allocation, genesisData, outDir, verboseOut, progress,
 	)
 }
 
-func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams config.ConsensusParams, allocation []genesisAllocation, genData GenesisData, outDir string, verboseOut io.Writer) (err error) {
+func generateGenesisFiles(ctx context.Context, protoVersion protocol.ConsensusVersion, protoParams config.ConsensusParams, allocation []genesisAllocation, genData GenesisData, outDir string, verboseOut io.Writer, progressFunc ProgressFunc) (err error) {
+	generateStart := time.Now()
 
 	var (
 		netName               = genData.NetworkName
@@ -176,26 +207,83 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		partKeyDilution = protoParams.DefaultKeyDilution
 	}
 
+	err = checkSystemAccounts(genData)
+	if err != nil {
+		return err
+	}
+
+	// the shortest validity of the online wallets keys, which is when the network stalls
+	shortestValidity := basics.Round(math.MaxUint64)
+	for _, wallet := range allocation {
+		firstValid, lastValid, _ := wallet.partKeyParams(firstWalletValid, lastWalletValid, partKeyDilution)
+		if lastValid < firstValid {
+			return fmt.Errorf("wallet %s participation keys would be valid from round %d to round %d", wallet.Name, firstValid, lastValid)
+		}
+		if wallet.Online == basics.Online && lastValid-firstValid < shortestValidity {
+			shortestValidity = lastValid - firstValid
+		}
+	}
+
+	var derivedKeys map[string]derivedRootKey
+	switch {
+	case genData.RootKeyMnemonicFile != "" && genData.DeterministicSeed != "":
+		return fmt.Errorf("root keys can't be derived from both a mnemonic and a deterministic seed")
+	case genData.RootKeyMnemonicFile != "":
+		derivedKeys, err = deriveRootKeys(genData.RootKeyMnemonicFile, genData.Wallets)
+		if err != nil {
+			return err
+		}
+	case genData.DeterministicSeed != "":
+		derivedKeys = deriveDeterministicRootKeys(genData.DeterministicSeed, genData.Wallets)
+	}
+
+	// wallets with a root key held by an external signer only get their address into genesis.json
+	externalAddrs, err := resolveExternalRootKeys(genData.Wallets)
+	if err != nil {
+		return err
+	}
+	for name := range externalAddrs {
+		delete(derivedKeys, name)
+	}
+
+	if genData.Streaming && (len(genData.Assets) > 0 || len(genData.Applications) > 0) {
+		return fmt.Errorf("streaming genesis generation doesn't support assets and applications")
+	}
+
+	if genData.Resume && genData.KMDDir != "" {
+		return fmt.Errorf("genesis generation can't be resumed when the root keys are moved to kmd")
+	}
+
 	// Sort account names alphabetically
 	sort.SliceStable(allocation, func(i, j int) bool {
 		return allocation[i].Name < allocation[j].Name
 	})
-	rootKeyCreated := int64(0)
-	partKeyCreated := int64(0)
+	reporter := makeProgressReporter(len(allocation), progressFunc, verboseOut)
 
-	pendingWallets := make(chan genesisAllocation, len(allocation))
+	var manifest *genesisProgress
+	if genData.Resume {
+		manifest, err = openGenesisProgress(filepath.Join(outDir, GenesisProgressFilename))
+		if err != nil {
+			return err
+		}
+		defer manifest.close()
+	}
 
 	concurrentWalletGenerators := runtime.NumCPU() * 2
 	errorsChannel := make(chan error, concurrentWalletGenerators)
 	verbose := verboseOut != nil
-	verbosedOutput := make(chan string)
 	var creatingWalletsWaitGroup sync.WaitGroup
 	var writeMu deadlock.Mutex
 
-	createWallet := func() {
+	createWallet := func(pendingWallets <-chan genesisAllocation) {
 		var err1 error
 		defer creatingWalletsWaitGroup.Done()
 		for {
+			err1 = ctx.Err()
+			if err1 != nil {
+				errorsChannel <- err1
+				return
+			}
 			var wallet genesisAllocation
 			select {
 			case wallet = <-pendingWallets:
@@ -205,12 +293,57 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 			var root account.Root
 			var part account.PersistedParticipation
 
-			wfilename := filepath.Join(outDir, config.RootKeyFilename(wallet.Name))
-			pfilename := filepath.Join(outDir, config.PartKeyFilename(wallet.Name, uint64(firstWalletValid), uint64(lastWalletValid)))
+			walletDir := outDir
+			if genData.Streaming {
+				walletDir = filepath.Join(outDir, WalletShardDir(wallet.Name))
+				err1 = os.MkdirAll(walletDir, os.ModeDir|os.FileMode(0777))
+				if err1 != nil {
+					errorsChannel <- fmt.Errorf("couldn't make wallet directory '%s': %v", walletDir, err1)
+					return
+				}
+			}
+			firstValid, lastValid, keyDilution := wallet.partKeyParams(firstWalletValid, lastWalletValid, partKeyDilution)
+			// whether the genesis record of the wallet holds its state proof key
+			stateProofID := protoParams.EnableStateProofKeyregCheck && !wallet.NoStateProofKeys
+			wfilename := filepath.Join(walletDir, config.RootKeyFilename(wallet.Name))
+			pfilename := filepath.Join(walletDir, config.PartKeyFilename(wallet.Name, uint64(firstValid), uint64(lastValid)))
+
+			derived, isDerived := derivedKeys[wallet.Name]
+			externalAddr, isExternal := externalAddrs[wallet.Name]
+
+			// wallets completed by an earlier run are taken from the manifest, as long as their files are still there
+			if entry, ok := manifest.lookup(wallet.Name); ok &&
+				entry.matches(wallet, firstValid, lastValid, keyDilution, stateProofID) &&
+				(!isDerived || entry.Address.String() == derived.Address) &&
+				(!isExternal || entry.Address == externalAddr) &&
+				(isExternal || util.FileExists(wfilename)) && (!entry.Online || util.FileExists(pfilename)) {
+				writeMu.Lock()
+				records[wallet.Name] = entry.record(wallet.Stake, stateProofID)
+				genesisAddrs[wallet.Name] = entry.Address
+				writeMu.Unlock()
+				reporter.walletDone(wallet.Name, true)
+				continue
+			}
 
-			root, rootDB, rootkeyErr := loadRootKey(wfilename)
-			if rootkeyErr != nil && !os.IsNotExist(rootkeyErr) {
-				errorsChannel <- rootkeyErr
+			var rootDB db.Accessor
+			var rootkeyErr error
+			if !isExternal {
+				root, rootDB, rootkeyErr = loadRootKey(wfilename)
+				if rootkeyErr != nil && !os.IsNotExist(rootkeyErr) {
+					errorsChannel <- rootkeyErr
+					return
+				}
+			}
+			address := func() basics.Address {
+				if isExternal {
+					return externalAddr
+				}
+				return root.Address()
+			}
+
+			if rootkeyErr == nil && isDerived && root.Address().String() != derived.Address {
+				rootDB.Close()
+				errorsChannel <- fmt.Errorf("existing root key %s doesn't match %s", wfilename, derived.origin)
 				return
 			}
 
@@ -219,11 +352,14 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 				errorsChannel <- partkeyErr
 				return
 			}
+			if partkeyErr == nil && part.StateProofSecrets == nil && !wallet.NoStateProofKeys {
+				// the existing keys were generated without the state proof keys the wallet now needs
+				partDB.Close()
+				partkeyErr = os.ErrNotExist
+			}
 
 			if rootkeyErr == nil && partkeyErr == nil {
-				if verbose {
-					verbosedOutput <- fmt.Sprintln("Reusing existing wallet:", wfilename, pfilename)
-				}
+				reporter.message(wallet.Name, "Reusing existing wallet: %s %s", wfilename, pfilename)
 			} else {
 				// At this point either rootKeys is valid or rootkeyErr != nil
 				// Likewise, either partkey is valid or partkeyErr != nil
@@ -233,6 +369,8 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 					rootDB, err1 = db.MakeErasableAccessor(wfilename)
 					if err1 != nil {
 						err1 = fmt.Errorf("couldn't open root DB accessor %s: %v", wfilename, err1)
+					} else if isDerived {
+						root, err1 = account.ImportRoot(rootDB, derived.seed)
 					} else {
 						root, err1 = account.GenerateRoot(rootDB)
 					}
@@ -241,10 +379,7 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 						errorsChannel <- err1
 						return
 					}
-					if verbose {
-						verbosedOutput <- fmt.Sprintf("Created new rootkey: %s", wfilename)
-					}
-					atomic.AddInt64(&rootKeyCreated, 1)
+					reporter.rootKeyCreated(wallet.Name, wfilename)
 				}
 
 				if partkeyErr != nil && wallet.Online == basics.Online {
@@ -257,21 +392,23 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 						errorsChannel <- err1
 						return
 					}
-					if verbose {
-						verbosedOutput <- fmt.Sprintf("Generating %s's keys for a period of %d rounds", wallet.Name, lastWalletValid.SubSaturate(firstWalletValid))
-					}
+					reporter.message(wallet.Name, "Generating %s's keys for a period of %d rounds", wallet.Name, lastValid.SubSaturate(firstValid))
 
-					part, err1 = account.FillDBWithParticipationKeys(partDB, root.Address(), firstWalletValid, lastWalletValid, partKeyDilution)
+					if genData.DeterministicSeed != "" {
+						rng := crypto.MakePRNG(deterministicSeed("participation", genData.DeterministicSeed, wallet.Name)[:])
+						part, err1 = account.FillDBWithParticipationKeysRNG(partDB, address(), firstValid, lastValid, keyDilution, rng, !wallet.NoStateProofKeys)
+					} else if wallet.NoStateProofKeys {
+						part, err1 = account.FillDBWithParticipationKeysWithoutStateProof(partDB, address(), firstValid, lastValid, keyDilution)
+					} else {
+						part, err1 = account.FillDBWithParticipationKeys(partDB, address(), firstValid, lastValid, keyDilution)
+					}
 					if err1 != nil {
 						err1 = fmt.Errorf("could not generate new participation file %s: %v", pfilename, err1)
 						os.Remove(pfilename)
 						errorsChannel <- err1
 						return
 					}
-					if verbose {
-						verbosedOutput <- fmt.Sprintf("participation key generation for %s completed successfully", wallet.Name)
-					}
-					atomic.AddInt64(&partKeyCreated, 1)
+					reporter.partKeyCreated(wallet.Name)
 				}
 			}
 
@@ -284,7 +421,7 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 				data.VoteFirstValid = part.FirstValid
 				data.VoteLastValid = part.LastValid
 				data.VoteKeyDilution = part.KeyDilution
-				if protoParams.EnableStateProofKeyregCheck {
+				if stateProofID && part.StateProofSecrets != nil {
 					data.StateProofID = part.StateProofVerifier().Commitment
 				}
 			}
@@ -292,46 +429,72 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 			writeMu.Lock()
 			records[wallet.Name] = data
 
-			genesisAddrs[wallet.Name] = root.Address()
+			genesisAddrs[wallet.Name] = address()
 			writeMu.Unlock()
 
-			rootDB.Close()
+			if !isExternal {
+				rootDB.Close()
+			}
 			if wallet.Online == basics.Online {
 				partDB.Close()
 			}
+
+			err1 = manifest.add(makeGenesisProgressEntry(wallet.Name, address(), data))
+			if err1 != nil {
+				errorsChannel <- fmt.Errorf("couldn't record the progress of wallet %s: %v", wallet.Name, err1)
+				return
+			}
+			reporter.walletDone(wallet.Name, false)
 		}
 	}
 
-	for _, wallet := range allocation {
-		pendingWallets <- wallet
-	}
+	// createWallets creates the wallets concurrently, adding their records
+	createWallets := func(wallets []genesisAllocation) error {
+		pendingWallets := make(chan genesisAllocation, len(wallets))
+		for _, wallet := range wallets {
+			pendingWallets <- wallet
+		}
 
-	if verbose {
-		// create a listener for the verbosedOutput
-		go func() {
-			for textOut := range verbosedOutput {
-				fmt.Fprintf(verboseOut, "%s\n", textOut)
-			}
-		}()
-	}
+		creatingWalletsWaitGroup.Add(concurrentWalletGenerators)
+		for routinesCounter := 0; routinesCounter < concurrentWalletGenerators; routinesCounter++ {
+			go createWallet(pendingWallets)
+		}
 
-	createStart := time.Now()
-	creatingWalletsWaitGroup.Add(concurrentWalletGenerators)
-	for routinesCounter := 0; routinesCounter < concurrentWalletGenerators; routinesCounter++ {
-		go createWallet()
+		// wait until all goroutines are done.
+		creatingWalletsWaitGroup.Wait()
+
+		// check to see if we had any errors.
+		select {
+		case err1 := <-errorsChannel:
+			return err1
+		default:
+		}
+		return nil
 	}
 
-	// wait until all goroutines are done.
-	creatingWalletsWaitGroup.Wait()
+	defer func() {
+		done := reporter.snapshot()
+		if verbose && done.WalletsResumed > 0 {
+			fmt.Printf("Resumed %d wallets completed by an earlier run.\n", done.WalletsResumed)
+		}
+		if (verbose) && (done.RootKeysCreated > 0 || done.PartKeysCreated > 0) {
+			fmt.Printf("Created %d new rootkeys and %d new partkeys in %s.\n", done.RootKeysCreated, done.PartKeysCreated, done.Elapsed)
+			fmt.Printf("NOTICE: Participation keys are valid for a period of %d rounds. After this many rounds the network will stall unless new keys are registered.\n", shortestValidity)
+		}
+	}()
 
-	close(verbosedOutput)
+	if !genData.Streaming {
+		err = createWallets(allocation)
+		if err != nil {
+			return err
+		}
+	}
 
-	// check to see if we had any errors.
-	select {
-	case err1 := <-errorsChannel:
-		return err1
-	default:
+	appAllocations, err := addGenesisResources(genData, protoParams, records, genesisAddrs)
+	if err != nil {
+		return err
 	}
+	allocation = append(allocation, appAllocations...)
 
 	genesisAddrs["FeeSink"] = feeSink
 	genesisAddrs["RewardsPool"] = rewardsPool
@@ -340,14 +503,18 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		fmt.Fprintln(verboseOut, protoVersion, protoParams.MinBalance)
 	}
 
-	if rewardsBalance < protoParams.MinBalance {
-		// Needs to at least have min balance
-		rewardsBalance = protoParams.MinBalance
+	rewardsBalance, err = systemBalance("rewards pool", rewardsBalance, protoParams.MinBalance, genData.StrictSystemBalances)
+	if err != nil {
+		return err
+	}
+	feeSinkBalance, err := systemBalance("fee sink", genData.FeeSinkBalance, protoParams.MinBalance, genData.StrictSystemBalances)
+	if err != nil {
+		return err
 	}
 
 	records["FeeSink"] = bookkeeping.GenesisAccountData{
 		Status:     basics.NotParticipating,
-		MicroAlgos: basics.MicroAlgos{Raw: protoParams.MinBalance},
+		MicroAlgos: basics.MicroAlgos{Raw: feeSinkBalance},
 	}
 
 	records["RewardsPool"] = bookkeeping.GenesisAccountData{
@@ -363,8 +530,20 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		Name: "RewardsPool",
 	}
 
-	alloc2 := make([]genesisAllocation, 0, len(allocation)+2)
+	alloc2 := make([]genesisAllocation, 0, len(allocation)+2+len(genData.SystemAccounts))
 	alloc2 = append(alloc2, poolAcct, sinkAcct)
+	for _, account := range genData.SystemAccounts {
+		balance, err := systemBalance(account.Name, account.Balance, protoParams.MinBalance, genData.StrictSystemBalances)
+		if err != nil {
+			return err
+		}
+		records[account.Name] = bookkeeping.GenesisAccountData{
+			Status:     basics.NotParticipating,
+			MicroAlgos: basics.MicroAlgos{Raw: balance},
+		}
+		genesisAddrs[account.Name] = account.Address
+		alloc2 = append(alloc2, genesisAllocation{Name: account.Name})
+	}
 	alloc2 = append(alloc2, allocation...)
 	allocation = alloc2
 
@@ -379,24 +558,67 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		DevMode:     devmode,
 	}
 
-	for _, wallet := range allocation {
-		walletData := records[wallet.Name]
+	if genData.Streaming {
+		err = streamGenesis(g, allocation, records, genesisAddrs, createWallets, filepath.Join(outDir, config.GenesisJSONFile))
+	} else {
+		for _, wallet := range allocation {
+			walletData := records[wallet.Name]
 
-		g.Allocation = append(g.Allocation, bookkeeping.GenesisAllocation{
-			Address: genesisAddrs[wallet.Name].String(),
-			Comment: wallet.Name,
-			State:   walletData,
-		})
+			g.Allocation = append(g.Allocation, bookkeeping.GenesisAllocation{
+				Address: genesisAddrs[wallet.Name].String(),
+				Comment: wallet.Name,
+				State:   walletData,
+			})
+		}
+
+		jsonData := protocol.EncodeJSON(g)
+		err = os.WriteFile(filepath.Join(outDir, config.GenesisJSONFile), append(jsonData, '\n'), 0666)
+	}
+	if err != nil {
+		return err
 	}
 
-	jsonData := protocol.EncodeJSON(g)
-	err = os.WriteFile(filepath.Join(outDir, config.GenesisJSONFile), append(jsonData, '\n'), 0666)
+	if genData.RootKeyMnemonicFile != "" {
+		err = writeRootKeyMnemonicMapping(outDir, derivedKeys)
+		if err != nil {
+			return fmt.Errorf("couldn't write the root key mnemonic mapping: %w", err)
+		}
+	}
 
-	if (verbose) && (rootKeyCreated > 0 || partKeyCreated > 0) {
-		fmt.Printf("Created %d new rootkeys and %d new partkeys in %s.\n", rootKeyCreated, partKeyCreated, time.Since(createStart))
-		fmt.Printf("NOTICE: Participation keys are valid for a period of %d rounds. After this many rounds the network will stall unless new keys are registered.\n", lastWalletValid-firstWalletValid)
+	wallets := make([]string, len(genData.Wallets))
+	for i, wallet := range genData.Wallets {
+		wallets[i] = wallet.Name
+	}
+	if genData.KMDDir != "" {
+		var kmdWallets []string
+		for _, name := range wallets {
+			if _, ok := externalAddrs[name]; !ok {
+				kmdWallets = append(kmdWallets, name)
+			}
+		}
+		rootKeyFile := func(wallet string) string {
+			if genData.Streaming {
+				return filepath.Join(outDir, WalletShardDir(wallet), config.RootKeyFilename(wallet))
+			}
+			return filepath.Join(outDir, config.RootKeyFilename(wallet))
+		}
+		err = importWalletsToKMD(genData.KMDDir, kmdWallets, rootKeyFile, verboseOut)
+		if err != nil {
+			return err
+		}
 	}
 
+	if genData.Streaming {
+		// the allocations were only written to genesis.json
+		g, err = bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+		if err != nil {
+			return err
+		}
+	}
+	err = writeGenerationReport(outDir, makeGenerationReport(g, wallets, reporter.snapshot(), time.Since(generateStart)))
+	if err != nil {
+		return fmt.Errorf("couldn't write the generation report: %w", err)
+	}
 	return
 }
 
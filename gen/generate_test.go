@@ -275,3 +275,143 @@ func TestGenesisJsonCreation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisWalletPartKeyOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	firstRound, lastRound := basics.Round(5), basics.Round(200)
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "overrides"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.PartKeyDilution = 10
+	genesisData.Wallets = []WalletData{
+		{Name: "Infra", Stake: 50, Online: true, FirstPartKeyRound: &firstRound, LastPartKeyRound: &lastRound, PartKeyDilution: 20},
+		{Name: "Test", Stake: 50, Online: true},
+	}
+
+	outDir := t.TempDir()
+	require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+	require.FileExists(t, filepath.Join(outDir, config.PartKeyFilename("Infra", 5, 200)))
+	require.FileExists(t, filepath.Join(outDir, config.PartKeyFilename("Test", 0, 100)))
+
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+	states := make(map[string]bookkeeping.GenesisAccountData)
+	for _, alloc := range genesis.Allocation {
+		states[alloc.Comment] = alloc.State
+	}
+	require.Equal(t, basics.Round(5), states["Infra"].VoteFirstValid)
+	require.Equal(t, basics.Round(200), states["Infra"].VoteLastValid)
+	require.Equal(t, uint64(20), states["Infra"].VoteKeyDilution)
+	require.Equal(t, basics.Round(0), states["Test"].VoteFirstValid)
+	require.Equal(t, basics.Round(100), states["Test"].VoteLastValid)
+	require.Equal(t, uint64(10), states["Test"].VoteKeyDilution)
+
+	lastRound = 1
+	err = GenerateGenesisFiles(genesisData, config.Consensus, t.TempDir(), nil)
+	require.ErrorContains(t, err, "wallet Infra participation keys would be valid from round 5 to round 1")
+}
+
+func TestGenesisNoStateProofKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "nostateproof"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.Wallets = []WalletData{
+		{Name: "Voter", Stake: 50, Online: true, NoStateProofKeys: true},
+		{Name: "Signer", Stake: 50, Online: true},
+	}
+	require.True(t, config.Consensus[protocol.ConsensusCurrentVersion].EnableStateProofKeyregCheck)
+
+	outDir := t.TempDir()
+	states := func() map[string]bookkeeping.GenesisAccountData {
+		require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+		genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+		require.NoError(t, err)
+		states := make(map[string]bookkeeping.GenesisAccountData)
+		for _, alloc := range genesis.Allocation {
+			states[alloc.Comment] = alloc.State
+		}
+		return states
+	}
+	restorePart := func(wallet string) account.PersistedParticipation {
+		partDB, err := db.MakeAccessor(filepath.Join(outDir, config.PartKeyFilename(wallet, 0, 100)), true, false)
+		require.NoError(t, err)
+		defer partDB.Close()
+		part, err := account.RestoreParticipation(partDB)
+		require.NoError(t, err)
+		return part
+	}
+
+	generated := states()
+	voter, signer := generated["Voter"], generated["Signer"]
+	require.True(t, voter.StateProofID.MsgIsZero())
+	require.False(t, voter.VoteID.MsgIsZero())
+	require.False(t, signer.StateProofID.MsgIsZero())
+	require.Nil(t, restorePart("Voter").StateProofSecrets)
+	require.NotNil(t, restorePart("Signer").StateProofSecrets)
+
+	// keys generated without state proof keys are replaced once the wallet needs them
+	genesisData.Wallets[0].NoStateProofKeys = false
+	regenerated := states()
+	regeneratedVoter := regenerated["Voter"]
+	require.False(t, regeneratedVoter.StateProofID.MsgIsZero())
+	require.NotEqual(t, voter.VoteID, regeneratedVoter.VoteID)
+	require.Equal(t, signer, regenerated["Signer"])
+	require.NotNil(t, restorePart("Voter").StateProofSecrets)
+}
+
+func TestGenesisSystemAccounts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	treasury := basics.Address{1}
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "system"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.FeeSinkBalance = 5 * proto.MinBalance
+	genesisData.SystemAccounts = []SystemAccountData{
+		{Name: "Treasury", Address: treasury, Balance: 1e12},
+		{Name: "Faucet", Address: basics.Address{2}},
+	}
+	genesisData.Wallets = []WalletData{{Name: "Wallet", Stake: 100}}
+
+	outDir := t.TempDir()
+	require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+	require.NoError(t, ValidateGenesis(genesis, config.Consensus))
+
+	allocs := make(map[string]bookkeeping.GenesisAllocation)
+	for _, alloc := range genesis.Allocation {
+		allocs[alloc.Comment] = alloc
+	}
+	require.Equal(t, 5*proto.MinBalance, allocs["FeeSink"].State.MicroAlgos.Raw)
+	require.Equal(t, treasury.String(), allocs["Treasury"].Address)
+	require.Equal(t, uint64(1e12), allocs["Treasury"].State.MicroAlgos.Raw)
+	require.Equal(t, basics.NotParticipating, allocs["Treasury"].State.Status)
+	require.Equal(t, proto.MinBalance, allocs["Faucet"].State.MicroAlgos.Raw)
+	require.Equal(t, TotalMoney, allocs["Wallet"].State.MicroAlgos.Raw)
+
+	strict := genesisData
+	strict.StrictSystemBalances = true
+	strict.SystemAccounts = []SystemAccountData{{Name: "Treasury", Address: treasury, Balance: 1}}
+	err = GenerateGenesisFiles(strict, config.Consensus, t.TempDir(), nil)
+	require.ErrorContains(t, err, "Treasury balance 1 is below the minimum balance")
+
+	for _, accounts := range [][]SystemAccountData{
+		{{Name: "Wallet", Address: treasury}},
+		{{Name: "Treasury", Address: treasury}, {Name: "Other", Address: treasury}},
+		{{Name: "Treasury", Address: defaultSinkAddr}},
+	} {
+		clashing := genesisData
+		clashing.SystemAccounts = accounts
+		require.Error(t, GenerateGenesisFiles(clashing, config.Consensus, t.TempDir(), nil))
+	}
+}
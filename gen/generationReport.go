@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+)
+
+// GenerationReportFilename is the name of the report of genesis generation, written next to genesis.json
+const GenerationReportFilename = "report.json"
+
+// GenerationReport summarizes a generated genesis for deployment pipelines.
+type GenerationReport struct {
+	Network     string
+	GenesisID   string
+	GenesisHash string
+	Proto       string
+	Wallets     []WalletReport
+	Stake       StakeReport
+	// ShortestValidity is the number of rounds the participation keys of every online wallet are valid for
+	ShortestValidity basics.Round `json:",omitempty"`
+	Timing           TimingReport
+}
+
+// WalletReport describes a genesis wallet and its participation keys.
+type WalletReport struct {
+	Name        string
+	Address     string
+	MicroAlgos  uint64
+	Online      bool
+	FirstValid  basics.Round `json:",omitempty"`
+	LastValid   basics.Round `json:",omitempty"`
+	KeyDilution uint64       `json:",omitempty"`
+}
+
+// StakeReport describes how the stake is distributed among the genesis wallets, in microAlgos.
+type StakeReport struct {
+	Total        uint64
+	Online       uint64
+	Min          uint64
+	Percentile25 uint64
+	Median       uint64
+	Percentile75 uint64
+	Percentile90 uint64
+	Percentile99 uint64
+	Max          uint64
+}
+
+// TimingReport tells how long the generation took, and how many keys it created.
+type TimingReport struct {
+	TotalSeconds    float64
+	WalletsResumed  int
+	RootKeysCreated int
+	PartKeysCreated int
+}
+
+// makeGenerationReport builds the report of the genesis g, whose wallets are named in wallets.
+func makeGenerationReport(g bookkeeping.Genesis, wallets []string, progress GenerateProgress, elapsed time.Duration) GenerationReport {
+	report := GenerationReport{
+		Network:     string(g.Network),
+		GenesisID:   g.ID(),
+		GenesisHash: g.Hash().String(),
+		Proto:       string(g.Proto),
+		Timing: TimingReport{
+			TotalSeconds:    elapsed.Seconds(),
+			WalletsResumed:  progress.WalletsResumed,
+			RootKeysCreated: progress.RootKeysCreated,
+			PartKeysCreated: progress.PartKeysCreated,
+		},
+	}
+
+	allocations := make(map[string]bookkeeping.GenesisAllocation, len(g.Allocation))
+	for _, entry := range g.Allocation {
+		allocations[entry.Comment] = entry
+	}
+	stakes := make([]uint64, 0, len(wallets))
+	for _, name := range wallets {
+		entry, ok := allocations[name]
+		if !ok {
+			continue
+		}
+		wallet := WalletReport{
+			Name:       name,
+			Address:    entry.Address,
+			MicroAlgos: entry.State.MicroAlgos.Raw,
+			Online:     entry.State.Status == basics.Online,
+		}
+		report.Stake.Total += wallet.MicroAlgos
+		if wallet.Online {
+			wallet.FirstValid = entry.State.VoteFirstValid
+			wallet.LastValid = entry.State.VoteLastValid
+			wallet.KeyDilution = entry.State.VoteKeyDilution
+			report.Stake.Online += wallet.MicroAlgos
+			validity := wallet.LastValid - wallet.FirstValid
+			if report.ShortestValidity == 0 || validity < report.ShortestValidity {
+				report.ShortestValidity = validity
+			}
+		}
+		report.Wallets = append(report.Wallets, wallet)
+		stakes = append(stakes, wallet.MicroAlgos)
+	}
+
+	if len(stakes) > 0 {
+		sort.Slice(stakes, func(i, j int) bool { return stakes[i] < stakes[j] })
+		// nearest-rank percentiles
+		percentile := func(p int) uint64 {
+			rank := (p*len(stakes) + 99) / 100
+			return stakes[max(rank, 1)-1]
+		}
+		report.Stake.Min = stakes[0]
+		report.Stake.Percentile25 = percentile(25)
+		report.Stake.Median = percentile(50)
+		report.Stake.Percentile75 = percentile(75)
+		report.Stake.Percentile90 = percentile(90)
+		report.Stake.Percentile99 = percentile(99)
+		report.Stake.Max = stakes[len(stakes)-1]
+	}
+	return report
+}
+
+func writeGenerationReport(outDir string, report GenerationReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outDir, GenerationReportFilename), append(data, '\n'), 0666)
+}
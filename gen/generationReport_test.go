@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGenerationReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, streaming := range []bool{false, true} {
+		genesisData := DefaultGenesis
+		genesisData.NetworkName = "report"
+		genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+		genesisData.LastPartKeyRound = 100
+		genesisData.Streaming = streaming
+		genesisData.Wallets = []WalletData{
+			{Name: "Wallet1", Stake: 10},
+			{Name: "Wallet2", Stake: 20, Online: true},
+			{Name: "Wallet3", Stake: 30},
+			{Name: "Wallet4", Stake: 40},
+		}
+		outDir := t.TempDir()
+		require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+
+		genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+		require.NoError(t, err)
+		data, err := os.ReadFile(filepath.Join(outDir, GenerationReportFilename))
+		require.NoError(t, err)
+		var report GenerationReport
+		require.NoError(t, json.Unmarshal(data, &report))
+
+		require.Equal(t, genesis.Hash().String(), report.GenesisHash)
+		require.Equal(t, genesis.ID(), report.GenesisID)
+		require.Len(t, report.Wallets, 4)
+		for _, wallet := range report.Wallets {
+			for _, alloc := range genesis.Allocation {
+				if alloc.Comment == wallet.Name {
+					require.Equal(t, alloc.Address, wallet.Address)
+				}
+			}
+		}
+		require.Equal(t, WalletReport{
+			Name:        "Wallet2",
+			Address:     report.Wallets[1].Address,
+			MicroAlgos:  TotalMoney / 5,
+			Online:      true,
+			LastValid:   100,
+			KeyDilution: config.Consensus[protocol.ConsensusCurrentVersion].DefaultKeyDilution,
+		}, report.Wallets[1])
+		require.Equal(t, basics.Round(100), report.ShortestValidity)
+
+		require.Equal(t, StakeReport{
+			Total:        TotalMoney,
+			Online:       TotalMoney / 5,
+			Min:          TotalMoney / 10,
+			Percentile25: TotalMoney / 10,
+			Median:       TotalMoney / 5,
+			Percentile75: TotalMoney * 3 / 10,
+			Percentile90: TotalMoney * 4 / 10,
+			Percentile99: TotalMoney * 4 / 10,
+			Max:          TotalMoney * 4 / 10,
+		}, report.Stake)
+		require.Equal(t, 4, report.Timing.RootKeysCreated)
+		require.Equal(t, 1, report.Timing.PartKeysCreated)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+)
+
+// ValidateGenesis checks a genesis for the problems that would keep a network from starting from it:
+// a protocol consensus doesn't know, a stake that doesn't add up to TotalMoney, accounts below their
+// minimum balance, and repeated or malformed addresses. All the problems found are joined in the error.
+func ValidateGenesis(genesis bookkeeping.Genesis, consensus config.ConsensusProtocols) error {
+	var errs []error
+	proto, protoOK := consensus[genesis.Proto]
+	if !protoOK {
+		errs = append(errs, fmt.Errorf("protocol %s is not supported", genesis.Proto))
+	}
+
+	seen := make(map[basics.Address]string, len(genesis.Allocation))
+	var stake basics.MicroAlgos
+	for _, entry := range genesis.Allocation {
+		name := allocationName(entry)
+		addr, err := basics.UnmarshalChecksumAddress(entry.Address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: cannot parse address: %w", name, err))
+			continue
+		}
+		if other, ok := seen[addr]; ok {
+			errs = append(errs, fmt.Errorf("%s: address already allocated to %s", name, other))
+			continue
+		}
+		seen[addr] = name
+
+		state := entry.State
+		// the fee sink and the rewards pool don't count in the stake
+		if state.Status != basics.NotParticipating {
+			var overflowed bool
+			stake, overflowed = basics.OAddA(stake, state.MicroAlgos)
+			if overflowed {
+				errs = append(errs, fmt.Errorf("%s: stake overflows", name))
+			}
+		}
+		if protoOK {
+			data := state.AccountData()
+			if minBalance := data.MinBalance(&proto); state.MicroAlgos.Raw < minBalance.Raw {
+				errs = append(errs, fmt.Errorf("%s: balance %d is below the minimum balance %d", name, state.MicroAlgos.Raw, minBalance.Raw))
+			}
+		}
+		if state.Status == basics.Online && state.VoteLastValid < state.VoteFirstValid {
+			errs = append(errs, fmt.Errorf("%s: participation keys are valid from round %d to round %d", name, state.VoteFirstValid, state.VoteLastValid))
+		}
+	}
+	if stake.Raw != TotalMoney {
+		errs = append(errs, fmt.Errorf("stake adds up to %d instead of %d", stake.Raw, uint64(TotalMoney)))
+	}
+
+	for _, special := range []struct{ name, addr string }{{"fee sink", genesis.FeeSink}, {"rewards pool", genesis.RewardsPool}} {
+		addr, err := basics.UnmarshalChecksumAddress(special.addr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cannot parse %s address: %w", special.name, err))
+		} else if _, ok := seen[addr]; !ok {
+			errs = append(errs, fmt.Errorf("%s %s has no allocation", special.name, special.addr))
+		}
+	}
+
+	// the remaining checks of the genesis balances, on creatables and boxes
+	if len(errs) == 0 {
+		if _, err := genesis.Balances(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DiffGenesis describes the differences between the genesis a and the genesis b, a line per difference.
+// Allocations are matched by address, and their state is compared field by field.
+func DiffGenesis(a, b bookkeeping.Genesis) []string {
+	var diff []string
+	diff = append(diff, diffFields("", reflect.ValueOf(a), reflect.ValueOf(b), "Allocation")...)
+
+	allocations := make(map[string]bookkeeping.GenesisAllocation, len(b.Allocation))
+	for _, entry := range b.Allocation {
+		allocations[entry.Address] = entry
+	}
+	for _, entry := range a.Allocation {
+		other, ok := allocations[entry.Address]
+		if !ok {
+			diff = append(diff, fmt.Sprintf("- %s: %d microAlgos, %s", allocationName(entry), entry.State.MicroAlgos.Raw, entry.State.Status))
+			continue
+		}
+		delete(allocations, entry.Address)
+		prefix := fmt.Sprintf("~ %s: ", allocationName(other))
+		if entry.Comment != other.Comment {
+			diff = append(diff, fmt.Sprintf("%scomment %q -> %q", prefix, entry.Comment, other.Comment))
+		}
+		diff = append(diff, diffFields(prefix, reflect.ValueOf(entry.State), reflect.ValueOf(other.State))...)
+	}
+	for _, entry := range b.Allocation {
+		if _, ok := allocations[entry.Address]; ok {
+			diff = append(diff, fmt.Sprintf("+ %s: %d microAlgos, %s", allocationName(entry), entry.State.MicroAlgos.Raw, entry.State.Status))
+		}
+	}
+	return diff
+}
+
+// diffFields describes the exported fields of the structs a and b that differ, except the skipped ones.
+func diffFields(prefix string, a, b reflect.Value, skip ...string) []string {
+	var diff []string
+fields:
+	for i := 0; i < a.NumField(); i++ {
+		field := a.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		for _, name := range skip {
+			if field.Name == name {
+				continue fields
+			}
+		}
+		x, y := a.Field(i), b.Field(i)
+		if reflect.DeepEqual(x.Interface(), y.Interface()) {
+			continue
+		}
+		switch {
+		case x.Kind() == reflect.Struct && x.Type() != reflect.TypeOf(basics.MicroAlgos{}):
+			diff = append(diff, diffFields(prefix+field.Name+".", x, y)...)
+		case x.Kind() == reflect.Map || x.Kind() == reflect.Slice:
+			diff = append(diff, fmt.Sprintf("%s%s changed (%d -> %d entries)", prefix, field.Name, x.Len(), y.Len()))
+		default:
+			diff = append(diff, fmt.Sprintf("%s%s %s -> %s", prefix, field.Name, diffValue(x), diffValue(y)))
+		}
+	}
+	return diff
+}
+
+// diffValue formats a field value the way genesis.json shows it, with keys in base64.
+func diffValue(v reflect.Value) string {
+	switch {
+	case v.Type() == reflect.TypeOf(basics.MicroAlgos{}):
+		return fmt.Sprint(v.Interface().(basics.MicroAlgos).Raw)
+	case v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// allocationName names an allocation by its address, and its comment when it has one.
+func allocationName(entry bookkeeping.GenesisAllocation) string {
+	if entry.Comment == "" {
+		return entry.Address
+	}
+	return fmt.Sprintf("%s (%s)", entry.Address, entry.Comment)
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func generateCheckedGenesis(t *testing.T) bookkeeping.Genesis {
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "check"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.Wallets = []WalletData{
+		{Name: "Wallet1", Stake: 50, Online: true},
+		{Name: "Wallet2", Stake: 50},
+	}
+	outDir := t.TempDir()
+	require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+	return genesis
+}
+
+// allocationIndex returns the index of the allocation with comment in genesis.
+func allocationIndex(t *testing.T, genesis bookkeeping.Genesis, comment string) int {
+	for i, entry := range genesis.Allocation {
+		if entry.Comment == comment {
+			return i
+		}
+	}
+	require.Failf(t, "missing allocation", "no allocation for %s", comment)
+	return -1
+}
+
+func TestValidateGenesis(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis := generateCheckedGenesis(t)
+	require.NoError(t, ValidateGenesis(genesis, config.Consensus))
+	wallet1 := allocationIndex(t, genesis, "Wallet1")
+	wallet2 := allocationIndex(t, genesis, "Wallet2")
+
+	broken := genesis
+	broken.Proto = "unknown"
+	require.ErrorContains(t, ValidateGenesis(broken, config.Consensus), "protocol unknown is not supported")
+
+	broken.Proto = genesis.Proto
+	broken.Allocation = append([]bookkeeping.GenesisAllocation{}, genesis.Allocation...)
+	broken.Allocation[wallet1].State.MicroAlgos.Raw++
+	err := ValidateGenesis(broken, config.Consensus)
+	require.ErrorContains(t, err, "stake adds up to 10000000000000001 instead of 10000000000000000")
+
+	broken.Allocation[wallet1].State.MicroAlgos.Raw = TotalMoney - 1
+	broken.Allocation[wallet2].State.MicroAlgos.Raw = 1
+	err = ValidateGenesis(broken, config.Consensus)
+	require.ErrorContains(t, err, "(Wallet2): balance 1 is below the minimum balance")
+	require.NotContains(t, err.Error(), "stake adds up")
+
+	broken.Allocation = append([]bookkeeping.GenesisAllocation{}, genesis.Allocation...)
+	broken.Allocation[wallet2].Address = broken.Allocation[wallet1].Address
+	err = ValidateGenesis(broken, config.Consensus)
+	require.ErrorContains(t, err, "(Wallet2): address already allocated to "+genesis.Allocation[wallet1].Address+" (Wallet1)")
+
+	broken.Allocation = append([]bookkeeping.GenesisAllocation{}, genesis.Allocation...)
+	broken.Allocation[wallet2].Address = "not an address"
+	broken.FeeSink = basics.Address{}.String()
+	err = ValidateGenesis(broken, config.Consensus)
+	require.ErrorContains(t, err, "not an address (Wallet2): cannot parse address")
+	require.ErrorContains(t, err, "fee sink "+basics.Address{}.String()+" has no allocation")
+}
+
+func TestDiffGenesis(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis := generateCheckedGenesis(t)
+	require.Empty(t, DiffGenesis(genesis, genesis))
+
+	wallet1 := allocationIndex(t, genesis, "Wallet1")
+	wallet2 := allocationIndex(t, genesis, "Wallet2")
+	changed := genesis
+	changed.Network = "other"
+	changed.Allocation = append([]bookkeeping.GenesisAllocation{}, genesis.Allocation...)
+	changed.Allocation[wallet1].State.MicroAlgos.Raw -= 5
+	changed.Allocation[wallet1].State.VoteLastValid = 200
+	changed.Allocation[wallet1].State.TotalAppSchema.NumUint = 2
+	changed.Allocation[wallet1].State.Assets = map[basics.AssetIndex]basics.AssetHolding{1001: {Amount: 1}}
+	changed.Allocation[wallet2].Comment = "Renamed"
+	added := genesis.Allocation[wallet2]
+	added.Address = basics.Address{1}.String()
+	added.Comment = "Added"
+	removed := genesis.Allocation[wallet2]
+	removed.Address = basics.Address{2}.String()
+	removed.Comment = "Removed"
+	changed.Allocation = append(changed.Allocation, added)
+	genesis.Allocation = append(genesis.Allocation, removed)
+
+	wallet1Name := genesis.Allocation[wallet1].Address + " (Wallet1)"
+	require.Equal(t, []string{
+		"Network check -> other",
+		"~ " + wallet1Name + ": MicroAlgos 5000000000000000 -> 4999999999999995",
+		"~ " + wallet1Name + ": VoteLastValid 100 -> 200",
+		"~ " + wallet1Name + ": Assets changed (0 -> 1 entries)",
+		"~ " + wallet1Name + ": TotalAppSchema.NumUint 0 -> 2",
+		"~ " + genesis.Allocation[wallet2].Address + " (Renamed): comment \"Wallet2\" -> \"Renamed\"",
+		"- " + removed.Address + " (Removed): 5000000000000000 microAlgos, Offline",
+		"+ " + added.Address + " (Added): 5000000000000000 microAlgos, Offline",
+	}, DiffGenesis(genesis, changed))
+}
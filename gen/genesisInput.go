@@ -0,0 +1,275 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// genesisInput.go loads GenesisData from YAML files. They are parsed into a tree of genesisValue,
+// which is checked against the fields of GenesisData, so that the errors point to the line and column
+// of the offending value in the file.
+
+type genesisValueKind int
+
+const (
+	genesisScalar genesisValueKind = iota
+	genesisMapping
+	genesisList
+)
+
+type genesisScalarType int
+
+const (
+	genesisString genesisScalarType = iota
+	genesisInt
+	genesisFloat
+	genesisBool
+	genesisNull
+)
+
+// genesisValue is a value read from a YAML file, with its position in the file.
+type genesisValue struct {
+	line, column int
+	kind         genesisValueKind
+
+	// scalar values
+	scalar     string
+	scalarType genesisScalarType
+
+	// mapping values
+	keys   []genesisKey
+	values []*genesisValue
+
+	// list values
+	items []*genesisValue
+}
+
+type genesisKey struct {
+	name         string
+	line, column int
+}
+
+// genesisInputError is an error at a position of a genesis data file.
+type genesisInputError struct {
+	file         string
+	line, column int
+	msg          string
+}
+
+func (e *genesisInputError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.file, e.line, e.column, e.msg)
+}
+
+// genesisInputDecoder assigns the genesisValue tree read from file to a GenesisData.
+type genesisInputDecoder struct {
+	file string
+}
+
+func (d genesisInputDecoder) errorf(line, column int, format string, args ...interface{}) error {
+	return &genesisInputError{file: d.file, line: line, column: column, msg: fmt.Sprintf(format, args...)}
+}
+
+// valueErrorf reports an error at the value v of the field path.
+func (d genesisInputDecoder) valueErrorf(v *genesisValue, path string, format string, args ...interface{}) error {
+	if path != "" {
+		format = path + ": " + format
+	}
+	return d.errorf(v.line, v.column, format, args...)
+}
+
+// keyErrorf reports an error at the key of a mapping at the field path.
+func (d genesisInputDecoder) keyErrorf(key genesisKey, path string, format string, args ...interface{}) error {
+	if path != "" {
+		format = path + ": " + format
+	}
+	return d.errorf(key.line, key.column, format, args...)
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// decode assigns v to the value pointed to by out; path names the value in errors.
+func (d genesisInputDecoder) decode(v *genesisValue, out reflect.Value, path string) error {
+	if reflect.PointerTo(out.Type()).Implements(textUnmarshalerType) {
+		if v.kind != genesisScalar || v.scalarType != genesisString {
+			return d.valueErrorf(v, path, "expected a string")
+		}
+		if err := out.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(v.scalar)); err != nil {
+			return d.valueErrorf(v, path, "%v", err)
+		}
+		return nil
+	}
+
+	switch out.Kind() {
+	case reflect.Struct:
+		if v.kind != genesisMapping {
+			return d.valueErrorf(v, path, "expected a mapping")
+		}
+		seen := make(map[string]bool, len(v.keys))
+		for i, key := range v.keys {
+			field, ok := out.Type().FieldByNameFunc(func(name string) bool {
+				return strings.EqualFold(name, key.name)
+			})
+			if !ok || !field.IsExported() {
+				return d.keyErrorf(key, path, "unknown field %s", key.name)
+			}
+			if seen[field.Name] {
+				return d.keyErrorf(key, path, "duplicate field %s", key.name)
+			}
+			seen[field.Name] = true
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			if err := d.decode(v.values[i], out.FieldByIndex(field.Index), fieldPath); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Pointer:
+		p := reflect.New(out.Type().Elem())
+		if err := d.decode(v, p.Elem(), path); err != nil {
+			return err
+		}
+		out.Set(p)
+		return nil
+
+	case reflect.Slice:
+		if v.kind != genesisList {
+			return d.valueErrorf(v, path, "expected a list")
+		}
+		s := reflect.MakeSlice(out.Type(), len(v.items), len(v.items))
+		for i, item := range v.items {
+			if err := d.decode(item, s.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		out.Set(s)
+		return nil
+	}
+
+	if v.kind != genesisScalar {
+		return d.valueErrorf(v, path, "expected a scalar value")
+	}
+	switch out.Kind() {
+	case reflect.String:
+		if v.scalarType != genesisString {
+			return d.valueErrorf(v, path, "expected a string")
+		}
+		out.SetString(v.scalar)
+	case reflect.Bool:
+		if v.scalarType != genesisBool {
+			return d.valueErrorf(v, path, "expected true or false")
+		}
+		out.SetBool(v.scalar == "true")
+	case reflect.Uint64, reflect.Uint32:
+		if v.scalarType != genesisInt {
+			return d.valueErrorf(v, path, "expected a non-negative integer")
+		}
+		n, err := strconv.ParseUint(strings.ReplaceAll(v.scalar, "_", ""), 0, 64)
+		if err != nil || out.OverflowUint(n) {
+			return d.valueErrorf(v, path, "invalid non-negative integer %s", v.scalar)
+		}
+		out.SetUint(n)
+	case reflect.Float64:
+		if v.scalarType != genesisInt && v.scalarType != genesisFloat {
+			return d.valueErrorf(v, path, "expected a number")
+		}
+		f, err := strconv.ParseFloat(strings.ReplaceAll(v.scalar, "_", ""), 64)
+		if err != nil {
+			return d.valueErrorf(v, path, "invalid number %s", v.scalar)
+		}
+		out.SetFloat(f)
+	default:
+		return d.valueErrorf(v, path, "unsupported field type %s", out.Type())
+	}
+	return nil
+}
+
+// decodeGenesisInput assigns the genesisValue tree to gen, which holds the default values.
+func decodeGenesisInput(file string, root *genesisValue, gen *GenesisData) error {
+	return genesisInputDecoder{file: file}.decode(root, reflect.ValueOf(gen).Elem(), "")
+}
+
+// parseGenesisYAML parses a YAML document into a genesisValue tree.
+func parseGenesisYAML(file string, data []byte) (*genesisValue, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 {
+		return nil, fmt.Errorf("%s: expected a single YAML document", file)
+	}
+	return yamlGenesisValue(file, doc.Content[0])
+}
+
+func yamlGenesisValue(file string, n *yaml.Node) (*genesisValue, error) {
+	v := &genesisValue{line: n.Line, column: n.Column}
+	switch n.Kind {
+	case yaml.AliasNode:
+		return yamlGenesisValue(file, n.Alias)
+	case yaml.MappingNode:
+		v.kind = genesisMapping
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k := n.Content[i]
+			if k.Kind != yaml.ScalarNode {
+				return nil, &genesisInputError{file: file, line: k.Line, column: k.Column, msg: "expected a scalar key"}
+			}
+			value, err := yamlGenesisValue(file, n.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			v.keys = append(v.keys, genesisKey{name: k.Value, line: k.Line, column: k.Column})
+			v.values = append(v.values, value)
+		}
+	case yaml.SequenceNode:
+		v.kind = genesisList
+		for _, c := range n.Content {
+			item, err := yamlGenesisValue(file, c)
+			if err != nil {
+				return nil, err
+			}
+			v.items = append(v.items, item)
+		}
+	case yaml.ScalarNode:
+		v.kind = genesisScalar
+		v.scalar = n.Value
+		switch n.ShortTag() {
+		case "!!int":
+			v.scalarType = genesisInt
+		case "!!float":
+			v.scalarType = genesisFloat
+		case "!!bool":
+			v.scalarType = genesisBool
+			v.scalar = strconv.FormatBool(strings.EqualFold(n.Value, "true"))
+		case "!!null":
+			v.scalarType = genesisNull
+		default:
+			v.scalarType = genesisString
+		}
+	default:
+		return nil, &genesisInputError{file: file, line: n.Line, column: n.Column, msg: "unexpected YAML node"}
+	}
+	return v, nil
+}
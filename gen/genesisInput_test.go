@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testGenesisYAML = `# test network
+NetworkName: testnet
+ConsensusProtocol: future
+LastPartKeyRound: 3000
+PartKeyDilution: 10
+FeeSink: A7NMWS3NT3IUDMLVO26ULGXGIIOUQ3ND2TXSER6EBGRZNOBOUIQXHIBGDE
+DevMode: true
+Wallets:
+  - Name: Wallet1
+    Stake: 60
+    Online: true
+  - Name: Wallet2
+    Stake: 40.0
+    Online: false
+`
+
+const testGenesisTOML = `# test network
+NetworkName = "testnet"
+ConsensusProtocol = 'future'
+LastPartKeyRound = 3_000
+PartKeyDilution = 10 # comment
+FeeSink = "A7NMWS3NT3IUDMLVO26ULGXGIIOUQ3ND2TXSER6EBGRZNOBOUIQXHIBGDE"
+DevMode = true
+
+[[Wallets]]
+Name = "Wallet1"
+Stake = 60
+Online = true
+
+[[Wallets]]
+Name = "Wallet2"
+Stake = 40.0
+Online = false
+`
+
+func writeGenesisInput(t *testing.T, name, data string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+	return path
+}
+
+func TestLoadGenesisDataFormats(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	expected, err := LoadGenesisData(writeGenesisInput(t, "genesis.json",
+		`{"NetworkName":"testnet","ConsensusProtocol":"future","LastPartKeyRound":3000,"PartKeyDilution":10,`+
+			`"FeeSink":"A7NMWS3NT3IUDMLVO26ULGXGIIOUQ3ND2TXSER6EBGRZNOBOUIQXHIBGDE","DevMode":true,`+
+			`"Wallets":[{"Name":"Wallet1","Stake":60,"Online":true},{"Name":"Wallet2","Stake":40.0,"Online":false}]}`))
+	require.NoError(t, err)
+	require.Equal(t, "testnet", expected.NetworkName)
+	require.Len(t, expected.Wallets, 2)
+	require.Equal(t, DefaultGenesis.RewardsPoolBalance, expected.RewardsPoolBalance)
+
+	for _, name := range []string{"genesis.yaml", "genesis.YML"} {
+		gen, err := LoadGenesisData(writeGenesisInput(t, name, testGenesisYAML))
+		require.NoError(t, err, name)
+		require.Equal(t, expected, gen, name)
+	}
+
+	gen, err := LoadGenesisData(writeGenesisInput(t, "genesis.toml", testGenesisTOML))
+	require.NoError(t, err)
+	require.Equal(t, expected, gen)
+}
+
+func TestLoadGenesisDataTOMLTables(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	input := `NetworkName = "testnet"
+
+[[Wallets]]
+Name = "Wallet1"
+Stake = 100.0
+Online = true
+
+[[SystemAccounts]]
+Name = "treasury"
+Address = "A7NMWS3NT3IUDMLVO26ULGXGIIOUQ3ND2TXSER6EBGRZNOBOUIQXHIBGDE"
+Balance = 1_000_000
+
+[[Assets]]
+Creator = "Wallet1"
+Total = 1000
+UnitName = "TST"
+
+  [[Assets.Holders]]
+  Wallet = "Wallet2"
+  Amount = 10
+  Frozen = true
+
+[[Applications]]
+Creator = "Wallet1"
+ApprovalProgram = "#pragma version 8\nint 1"
+ClearStateProgram = "#pragma version 8\nint 1"
+GlobalNumUint = 1
+
+  [[Applications.GlobalState]]
+  Key = "counter"
+  Type = "uint"
+  Uint = 7
+
+  [[Applications.Boxes]]
+  Name = "box"
+  Value = "value"
+`
+	gen, err := LoadGenesisData(writeGenesisInput(t, "genesis.toml", input))
+	require.NoError(t, err)
+	require.Equal(t, []WalletData{{Name: "Wallet1", Stake: 100, Online: true}}, gen.Wallets)
+
+	require.Len(t, gen.SystemAccounts, 1)
+	require.Equal(t, "treasury", gen.SystemAccounts[0].Name)
+	require.Equal(t, "A7NMWS3NT3IUDMLVO26ULGXGIIOUQ3ND2TXSER6EBGRZNOBOUIQXHIBGDE", gen.SystemAccounts[0].Address.String())
+	require.Equal(t, uint64(1000000), gen.SystemAccounts[0].Balance)
+
+	require.Len(t, gen.Assets, 1)
+	require.Equal(t, "TST", gen.Assets[0].UnitName)
+	require.Equal(t, []AssetHolderData{{Wallet: "Wallet2", Amount: 10, Frozen: true}}, gen.Assets[0].Holders)
+
+	require.Len(t, gen.Applications, 1)
+	require.Equal(t, "#pragma version 8\nint 1", gen.Applications[0].ApprovalProgram)
+	require.Equal(t, []StateData{{Key: "counter", Type: "uint", Uint: 7}}, gen.Applications[0].GlobalState)
+	require.Equal(t, []BoxData{{Name: "box", Value: "value"}}, gen.Applications[0].Boxes)
+}
+
+func TestLoadGenesisDataErrorPositions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"unknown.yaml", "NetworkName: x\nNetworkNam: y\n", "unknown.yaml:2:1: unknown field NetworkNam"},
+		{"type.yaml", "Wallets:\n  - Name: a\n    Stake: lots\n", "type.yaml:3:12: Wallets[0].Stake: expected a number"},
+		{"negative.yaml", "PartKeyDilution: -1\n", "negative.yaml:1:18: PartKeyDilution: invalid non-negative integer -1"},
+		{"address.yaml", "FeeSink: nope\n", "address.yaml:1:10: FeeSink: "},
+		{"list.yaml", "Wallets: 3\n", "list.yaml:1:10: Wallets: expected a list"},
+		{"unknown.toml", "NetworkName = \"x\"\n\n[[Wallets]]\nName = \"a\"\nStak = 1\n", "unknown.toml: unknown fields Wallets.Stak"},
+		{"type.toml", "DevMode = \"yes\"\n", "type.toml: "},
+		{"duplicate.toml", "NetworkName = \"x\"\nNetworkName = \"y\"\n", "duplicate.toml: toml: line 2"},
+		{"string.toml", "NetworkName = \"x\n", "string.toml: toml: line 1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := LoadGenesisData(writeGenesisInput(t, test.name, test.input))
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+)
+
+// GenesisProgressFilename is the manifest of the wallets done so far, written to the output
+// directory when resuming is enabled. It has one JSON line per completed wallet.
+const GenesisProgressFilename = "genesis.progress"
+
+// genesisProgressEntry is the public part of a completed wallet, enough to build its genesis
+// allocation without opening its root and participation key files.
+type genesisProgressEntry struct {
+	Name        string
+	Address     basics.Address
+	Online      bool         `json:",omitempty"`
+	FirstValid  basics.Round `json:",omitempty"`
+	LastValid   basics.Round `json:",omitempty"`
+	KeyDilution uint64       `json:",omitempty"`
+	VoteID      []byte       `json:",omitempty"`
+	SelectionID []byte       `json:",omitempty"`
+	// StateProofID is empty when the protocol doesn't check state proof keys
+	StateProofID []byte `json:",omitempty"`
+}
+
+func makeGenesisProgressEntry(name string, addr basics.Address, data bookkeeping.GenesisAccountData) genesisProgressEntry {
+	entry := genesisProgressEntry{Name: name, Address: addr}
+	if data.Status == basics.Online {
+		entry.Online = true
+		entry.FirstValid = data.VoteFirstValid
+		entry.LastValid = data.VoteLastValid
+		entry.KeyDilution = data.VoteKeyDilution
+		entry.VoteID = data.VoteID[:]
+		entry.SelectionID = data.SelectionID[:]
+		if !data.StateProofID.IsEmpty() {
+			entry.StateProofID = data.StateProofID[:]
+		}
+	}
+	return entry
+}
+
+// matches tells whether the entry was made for the same wallet settings.
+func (entry genesisProgressEntry) matches(wallet genesisAllocation, firstValid, lastValid basics.Round, keyDilution uint64, stateProof bool) bool {
+	if entry.Online != (wallet.Online == basics.Online) {
+		return false
+	}
+	if !entry.Online {
+		return true
+	}
+	return entry.FirstValid == firstValid && entry.LastValid == lastValid && entry.KeyDilution == keyDilution &&
+		(!stateProof || len(entry.StateProofID) != 0)
+}
+
+// record returns the genesis record of the wallet, holding stake.
+func (entry genesisProgressEntry) record(stake uint64, stateProof bool) bookkeeping.GenesisAccountData {
+	data := bookkeeping.GenesisAccountData{
+		Status:     basics.Offline,
+		MicroAlgos: basics.MicroAlgos{Raw: stake},
+	}
+	if entry.Online {
+		data.Status = basics.Online
+		data.VoteFirstValid = entry.FirstValid
+		data.VoteLastValid = entry.LastValid
+		data.VoteKeyDilution = entry.KeyDilution
+		data.VoteID = crypto.OneTimeSignatureVerifier(entry.VoteID)
+		data.SelectionID = crypto.VRFVerifier(entry.SelectionID)
+		if stateProof {
+			data.StateProofID = merklesignature.Commitment(entry.StateProofID)
+		}
+	}
+	return data
+}
+
+// valid tells whether the key sizes of the entry are right, since the manifest can be edited by hand.
+func (entry genesisProgressEntry) valid() bool {
+	if !entry.Online {
+		return true
+	}
+	return len(entry.VoteID) == len(crypto.OneTimeSignatureVerifier{}) &&
+		len(entry.SelectionID) == len(crypto.VRFVerifier{}) &&
+		(len(entry.StateProofID) == 0 || len(entry.StateProofID) == len(merklesignature.Commitment{}))
+}
+
+// genesisProgress is the manifest of a resumable genesis generation.
+type genesisProgress struct {
+	mu      deadlock.Mutex
+	file    *os.File
+	entries map[string]genesisProgressEntry
+}
+
+// openGenesisProgress loads the entries of the manifest in filename, if any, and opens it for appending.
+// Lines that can't be parsed, like one cut short by an interruption, are ignored.
+func openGenesisProgress(filename string) (*genesisProgress, error) {
+	p := &genesisProgress{entries: make(map[string]genesisProgressEntry)}
+
+	existing, err := os.Open(filename)
+	if err == nil {
+		scanner := bufio.NewScanner(existing)
+		for scanner.Scan() {
+			var entry genesisProgressEntry
+			if json.Unmarshal(scanner.Bytes(), &entry) == nil && entry.valid() {
+				p.entries[entry.Name] = entry
+			}
+		}
+		err = scanner.Err()
+		existing.Close()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read genesis progress manifest %s: %w", filename, err)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	p.file, err = os.OpenFile(filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, err
+	}
+	// a line cut short by an interruption must not swallow the next one
+	if info, statErr := p.file.Stat(); statErr == nil && info.Size() > 0 {
+		if _, err = p.file.WriteString("\n"); err != nil {
+			p.file.Close()
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// lookup returns the entry of a wallet completed by an earlier run.
+func (p *genesisProgress) lookup(name string) (genesisProgressEntry, bool) {
+	if p == nil {
+		return genesisProgressEntry{}, false
+	}
+	entry, ok := p.entries[name]
+	return entry, ok
+}
+
+// add appends the entry of a completed wallet to the manifest.
+func (p *genesisProgress) add(entry genesisProgressEntry) error {
+	if p == nil {
+		return nil
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err = p.file.Write(append(line, '\n'))
+	return err
+}
+
+func (p *genesisProgress) close() error {
+	if p == nil {
+		return nil
+	}
+	return p.file.Close()
+}
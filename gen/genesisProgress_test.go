@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestResumeGenesis(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	outDir := t.TempDir()
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "resume"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.DeterministicSeed = "resume"
+	genesisData.Resume = true
+	genesisData.Wallets = []WalletData{
+		{Name: "Wallet1", Stake: 25, Online: true},
+		{Name: "Wallet2", Stake: 25},
+		{Name: "Wallet3", Stake: 50, Online: true},
+	}
+
+	generate := func() []byte {
+		require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+		genesisJSON, err := os.ReadFile(filepath.Join(outDir, config.GenesisJSONFile))
+		require.NoError(t, err)
+		return genesisJSON
+	}
+	readManifest := func() map[string]genesisProgressEntry {
+		data, err := os.ReadFile(filepath.Join(outDir, GenesisProgressFilename))
+		require.NoError(t, err)
+		entries := make(map[string]genesisProgressEntry)
+		for _, line := range bytes.Split(data, []byte("\n")) {
+			var entry genesisProgressEntry
+			if json.Unmarshal(line, &entry) == nil {
+				entries[entry.Name] = entry
+			}
+		}
+		return entries
+	}
+
+	expected := generate()
+	manifest := readManifest()
+	require.Len(t, manifest, 3)
+	require.True(t, manifest["Wallet1"].Online)
+	require.False(t, manifest["Wallet2"].Online)
+	require.Len(t, manifest["Wallet3"].SelectionID, 32)
+
+	// simulate an interrupted run: the last line is cut short, and a wallet was never completed
+	data, err := os.ReadFile(filepath.Join(outDir, GenesisProgressFilename))
+	require.NoError(t, err)
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	var kept [][]byte
+	var dropped string
+	for _, line := range lines {
+		var entry genesisProgressEntry
+		require.NoError(t, json.Unmarshal(line, &entry))
+		if entry.Name == "Wallet3" {
+			dropped = string(line[:len(line)/2])
+			continue
+		}
+		kept = append(kept, line)
+	}
+	truncated := append(bytes.Join(kept, []byte("\n")), '\n')
+	truncated = append(truncated, dropped...)
+	require.NoError(t, os.WriteFile(filepath.Join(outDir, GenesisProgressFilename), truncated, 0666))
+	require.NoError(t, os.Remove(filepath.Join(outDir, config.PartKeyFilename("Wallet3", 0, 100))))
+
+	// the wallets in the manifest are not reloaded: a broken root key file goes unnoticed
+	require.NoError(t, os.WriteFile(filepath.Join(outDir, config.RootKeyFilename("Wallet2")), []byte("broken"), 0600))
+
+	require.Equal(t, string(expected), string(generate()))
+	require.Equal(t, manifest, readManifest())
+	require.FileExists(t, filepath.Join(outDir, config.PartKeyFilename("Wallet3", 0, 100)))
+
+	// wallets whose settings changed are not taken from the manifest
+	genesisData.Wallets[1].Online = true
+	err = GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil)
+	require.Error(t, err)
+}
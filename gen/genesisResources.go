@@ -0,0 +1,346 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// firstGenesisCreatable is the index given to the first asset or application without an explicit Index.
+// Indices up to 1000 are avoided since they can't be accessed by the AVM in newer protocols.
+const firstGenesisCreatable = 1001
+
+// genesisResources adds the assets and applications of a GenesisData to the records of the
+// wallets creating and holding them.
+type genesisResources struct {
+	proto   config.ConsensusParams
+	records map[string]bookkeeping.GenesisAccountData
+	addrs   map[string]basics.Address
+
+	used map[uint64]bool
+	next uint64
+
+	// touched are the wallets whose records were changed
+	touched map[string]bool
+
+	// appAllocations are the accounts of the applications with boxes
+	appAllocations []genesisAllocation
+}
+
+// addGenesisResources adds the assets and applications of genData to records, and returns the
+// allocations of the application accounts that were added to records and addrs.
+func addGenesisResources(genData GenesisData, proto config.ConsensusParams, records map[string]bookkeeping.GenesisAccountData, addrs map[string]basics.Address) ([]genesisAllocation, error) {
+	r := genesisResources{
+		proto:   proto,
+		records: records,
+		addrs:   addrs,
+		used:    make(map[uint64]bool),
+		next:    firstGenesisCreatable,
+		touched: make(map[string]bool),
+	}
+	for _, asset := range genData.Assets {
+		if err := r.reserve(asset.Index); err != nil {
+			return nil, err
+		}
+	}
+	for _, app := range genData.Applications {
+		if err := r.reserve(app.Index); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, asset := range genData.Assets {
+		if err := r.addAsset(asset); err != nil {
+			return nil, fmt.Errorf("asset %d (%s): %w", i, asset.AssetName, err)
+		}
+	}
+	for i, app := range genData.Applications {
+		if err := r.addApplication(app); err != nil {
+			return nil, fmt.Errorf("application %d: %w", i, err)
+		}
+	}
+
+	for wallet := range r.touched {
+		rec := r.records[wallet]
+		minBalance := basics.MinBalance(&proto,
+			uint64(len(rec.Assets)), rec.TotalAppSchema,
+			uint64(len(rec.AppParams)), uint64(len(rec.AppLocalStates)),
+			uint64(rec.TotalExtraAppPages), rec.TotalBoxes, rec.TotalBoxBytes)
+		if rec.MicroAlgos.Raw < minBalance.Raw {
+			return nil, fmt.Errorf("wallet %s has %d microalgos, below its minimum balance of %d", wallet, rec.MicroAlgos.Raw, minBalance.Raw)
+		}
+	}
+	return r.appAllocations, nil
+}
+
+func (r *genesisResources) reserve(index uint64) error {
+	if index == 0 {
+		return nil
+	}
+	if r.used[index] {
+		return fmt.Errorf("index %d is used by more than one asset or application", index)
+	}
+	r.used[index] = true
+	return nil
+}
+
+// index returns the explicit index of a creatable, or the next one not reserved.
+func (r *genesisResources) index(explicit uint64) uint64 {
+	if explicit != 0 {
+		return explicit
+	}
+	for r.used[r.next] {
+		r.next++
+	}
+	r.used[r.next] = true
+	return r.next
+}
+
+// record returns the record of a wallet about to be changed.
+func (r *genesisResources) record(wallet string) (bookkeeping.GenesisAccountData, error) {
+	rec, ok := r.records[wallet]
+	if !ok {
+		return rec, fmt.Errorf("unknown wallet %q", wallet)
+	}
+	r.touched[wallet] = true
+	return rec, nil
+}
+
+func setHolding(rec *bookkeeping.GenesisAccountData, aidx basics.AssetIndex, holding basics.AssetHolding) {
+	if rec.Assets == nil {
+		rec.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+	}
+	rec.Assets[aidx] = holding
+}
+
+// address resolves a wallet name or an address; an empty string is the zero address.
+func (r *genesisResources) address(nameOrAddr string) (basics.Address, error) {
+	if nameOrAddr == "" {
+		return basics.Address{}, nil
+	}
+	if addr, ok := r.addrs[nameOrAddr]; ok {
+		return addr, nil
+	}
+	addr, err := basics.UnmarshalChecksumAddress(nameOrAddr)
+	if err != nil {
+		return basics.Address{}, fmt.Errorf("%q is neither a wallet nor an address", nameOrAddr)
+	}
+	return addr, nil
+}
+
+func (r *genesisResources) addAsset(asset AssetData) error {
+	switch {
+	case asset.Decimals > r.proto.MaxAssetDecimals:
+		return fmt.Errorf("too many decimals %d > %d", asset.Decimals, r.proto.MaxAssetDecimals)
+	case len(asset.UnitName) > r.proto.MaxAssetUnitNameBytes:
+		return fmt.Errorf("unit name too long %d > %d", len(asset.UnitName), r.proto.MaxAssetUnitNameBytes)
+	case len(asset.AssetName) > r.proto.MaxAssetNameBytes:
+		return fmt.Errorf("asset name too long %d > %d", len(asset.AssetName), r.proto.MaxAssetNameBytes)
+	case len(asset.URL) > r.proto.MaxAssetURLBytes:
+		return fmt.Errorf("url too long %d > %d", len(asset.URL), r.proto.MaxAssetURLBytes)
+	}
+
+	params := basics.AssetParams{
+		Total:         asset.Total,
+		Decimals:      asset.Decimals,
+		DefaultFrozen: asset.DefaultFrozen,
+		UnitName:      asset.UnitName,
+		AssetName:     asset.AssetName,
+		URL:           asset.URL,
+	}
+	for _, role := range []struct {
+		name string
+		addr *basics.Address
+	}{
+		{asset.Manager, &params.Manager},
+		{asset.Reserve, &params.Reserve},
+		{asset.Freeze, &params.Freeze},
+		{asset.Clawback, &params.Clawback},
+	} {
+		addr, err := r.address(role.name)
+		if err != nil {
+			return err
+		}
+		*role.addr = addr
+	}
+
+	creator, err := r.record(asset.Creator)
+	if err != nil {
+		return err
+	}
+	aidx := basics.AssetIndex(r.index(asset.Index))
+	if creator.AssetParams == nil {
+		creator.AssetParams = make(map[basics.AssetIndex]basics.AssetParams)
+	}
+	creator.AssetParams[aidx] = params
+	r.records[asset.Creator] = creator
+
+	remaining := asset.Total
+	for _, holder := range asset.Holders {
+		if holder.Wallet == asset.Creator {
+			return fmt.Errorf("creator %s can't be a holder, it holds the units not given to other holders", holder.Wallet)
+		}
+		rec, err := r.record(holder.Wallet)
+		if err != nil {
+			return err
+		}
+		if _, ok := rec.Assets[aidx]; ok {
+			return fmt.Errorf("wallet %s is listed as a holder more than once", holder.Wallet)
+		}
+		if holder.Amount > remaining {
+			return fmt.Errorf("holders are given more than the %d units of the asset", asset.Total)
+		}
+		remaining -= holder.Amount
+		setHolding(&rec, aidx, basics.AssetHolding{Amount: holder.Amount, Frozen: holder.Frozen || asset.DefaultFrozen})
+		r.records[holder.Wallet] = rec
+	}
+
+	creator = r.records[asset.Creator]
+	setHolding(&creator, aidx, basics.AssetHolding{Amount: remaining})
+	r.records[asset.Creator] = creator
+	return nil
+}
+
+func (r *genesisResources) addApplication(app ApplicationData) error {
+	approval, err := logic.AssembleString(app.ApprovalProgram)
+	if err != nil {
+		return fmt.Errorf("approval program: %w", err)
+	}
+	clearState, err := logic.AssembleString(app.ClearStateProgram)
+	if err != nil {
+		return fmt.Errorf("clear state program: %w", err)
+	}
+	if int(app.ExtraProgramPages) > r.proto.MaxExtraAppProgramPages {
+		return fmt.Errorf("too many extra program pages %d > %d", app.ExtraProgramPages, r.proto.MaxExtraAppProgramPages)
+	}
+	pages := 1 + int(app.ExtraProgramPages)
+	if len(approval.Program) > pages*r.proto.MaxAppProgramLen || len(clearState.Program) > pages*r.proto.MaxAppProgramLen {
+		return fmt.Errorf("program too long, max len %d bytes", pages*r.proto.MaxAppProgramLen)
+	}
+	if len(approval.Program)+len(clearState.Program) > pages*r.proto.MaxAppTotalProgramLen {
+		return fmt.Errorf("programs too long, max total len %d bytes", pages*r.proto.MaxAppTotalProgramLen)
+	}
+
+	params := basics.AppParams{
+		ApprovalProgram:   approval.Program,
+		ClearStateProgram: clearState.Program,
+		StateSchemas: basics.StateSchemas{
+			LocalStateSchema:  basics.StateSchema{NumUint: app.LocalNumUint, NumByteSlice: app.LocalNumByteSlice},
+			GlobalStateSchema: basics.StateSchema{NumUint: app.GlobalNumUint, NumByteSlice: app.GlobalNumByteSlice},
+		},
+		ExtraProgramPages: app.ExtraProgramPages,
+	}
+	if params.LocalStateSchema.NumEntries() > r.proto.MaxLocalSchemaEntries {
+		return fmt.Errorf("local schema too large %d > %d", params.LocalStateSchema.NumEntries(), r.proto.MaxLocalSchemaEntries)
+	}
+	if params.GlobalStateSchema.NumEntries() > r.proto.MaxGlobalSchemaEntries {
+		return fmt.Errorf("global schema too large %d > %d", params.GlobalStateSchema.NumEntries(), r.proto.MaxGlobalSchemaEntries)
+	}
+
+	if len(app.GlobalState) > 0 {
+		params.GlobalState = make(basics.TealKeyValue, len(app.GlobalState))
+	}
+	var used basics.StateSchema
+	for _, kv := range app.GlobalState {
+		if _, ok := params.GlobalState[kv.Key]; ok {
+			return fmt.Errorf("global state key %q is repeated", kv.Key)
+		}
+		if len(kv.Key) > r.proto.MaxAppKeyLen {
+			return fmt.Errorf("global state key %q too long %d > %d", kv.Key, len(kv.Key), r.proto.MaxAppKeyLen)
+		}
+		var value basics.TealValue
+		switch {
+		case kv.Type == "bytes" || (kv.Type == "" && kv.Bytes != ""):
+			if len(kv.Key)+len(kv.Bytes) > r.proto.MaxAppSumKeyValueLens {
+				return fmt.Errorf("global state key %q and value too long %d > %d", kv.Key, len(kv.Key)+len(kv.Bytes), r.proto.MaxAppSumKeyValueLens)
+			}
+			value = basics.TealValue{Type: basics.TealBytesType, Bytes: kv.Bytes}
+			used.NumByteSlice++
+		case kv.Type == "uint" || kv.Type == "":
+			value = basics.TealValue{Type: basics.TealUintType, Uint: kv.Uint}
+			used.NumUint++
+		default:
+			return fmt.Errorf("global state key %q has unknown type %q", kv.Key, kv.Type)
+		}
+		params.GlobalState[kv.Key] = value
+	}
+	if used.NumUint > params.GlobalStateSchema.NumUint || used.NumByteSlice > params.GlobalStateSchema.NumByteSlice {
+		return fmt.Errorf("global state doesn't fit the global schema")
+	}
+
+	creator, err := r.record(app.Creator)
+	if err != nil {
+		return err
+	}
+	aidx := basics.AppIndex(r.index(app.Index))
+	if creator.AppParams == nil {
+		creator.AppParams = make(map[basics.AppIndex]basics.AppParams)
+	}
+	creator.AppParams[aidx] = params
+	creator.TotalAppSchema = creator.TotalAppSchema.AddSchema(params.GlobalStateSchema)
+	creator.TotalExtraAppPages += app.ExtraProgramPages
+
+	if len(app.Boxes) > 0 {
+		appAccount, err := r.appAccount(aidx, app.Boxes)
+		if err != nil {
+			return err
+		}
+		if creator.MicroAlgos.Raw < appAccount.MicroAlgos.Raw {
+			return fmt.Errorf("creator %s can't fund the %d microalgos of the application boxes", app.Creator, appAccount.MicroAlgos.Raw)
+		}
+		creator.MicroAlgos.Raw -= appAccount.MicroAlgos.Raw
+
+		name := fmt.Sprintf("App%d", aidx)
+		if _, ok := r.records[name]; ok {
+			return fmt.Errorf("the application account name %s is already used by a wallet", name)
+		}
+		r.records[name] = appAccount
+		r.addrs[name] = aidx.Address()
+		r.appAllocations = append(r.appAllocations, genesisAllocation{Name: name, Stake: appAccount.MicroAlgos.Raw, Online: basics.Offline})
+	}
+
+	r.records[app.Creator] = creator
+	return nil
+}
+
+// appAccount returns the record of an application account holding boxes, funded with their minimum balance.
+func (r *genesisResources) appAccount(aidx basics.AppIndex, boxes []BoxData) (bookkeeping.GenesisAccountData, error) {
+	var rec bookkeeping.GenesisAccountData
+	names := make(map[string]bool, len(boxes))
+	for _, box := range boxes {
+		switch {
+		case names[box.Name]:
+			return rec, fmt.Errorf("box %q is repeated", box.Name)
+		case len(box.Name) == 0 || len(box.Name) > r.proto.MaxAppKeyLen:
+			return rec, fmt.Errorf("box name %q must be 1 to %d bytes", box.Name, r.proto.MaxAppKeyLen)
+		case uint64(len(box.Value)) > r.proto.MaxBoxSize:
+			return rec, fmt.Errorf("box %q too large %d > %d", box.Name, len(box.Value), r.proto.MaxBoxSize)
+		}
+		names[box.Name] = true
+		rec.Boxes = append(rec.Boxes, bookkeeping.GenesisBox{Name: []byte(box.Name), Value: []byte(box.Value)})
+		rec.TotalBoxes++
+		rec.TotalBoxBytes += uint64(len(box.Name) + len(box.Value))
+	}
+	rec.Status = basics.Offline
+	rec.MicroAlgos = basics.MinBalance(&r.proto, 0, basics.StateSchema{}, 0, 0, 0, rec.TotalBoxes, rec.TotalBoxBytes)
+	return rec, nil
+}
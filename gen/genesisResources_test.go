@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"testing"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAddGenesisResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	makeRecords := func() (map[string]bookkeeping.GenesisAccountData, map[string]basics.Address) {
+		records := map[string]bookkeeping.GenesisAccountData{
+			"Alice": {Status: basics.Online, MicroAlgos: basics.MicroAlgos{Raw: 10_000_000}},
+			"Bob":   {Status: basics.Offline, MicroAlgos: basics.MicroAlgos{Raw: 10_000_000}},
+		}
+		addrs := map[string]basics.Address{"Alice": {1}, "Bob": {2}}
+		return records, addrs
+	}
+
+	genData := GenesisData{
+		Assets: []AssetData{{
+			Creator:   "Alice",
+			Total:     1000,
+			Decimals:  2,
+			UnitName:  "TST",
+			AssetName: "Test",
+			Manager:   "Alice",
+			Clawback:  basics.Address{3}.String(),
+			Holders:   []AssetHolderData{{Wallet: "Bob", Amount: 300}},
+		}, {
+			Index:         2000,
+			Creator:       "Bob",
+			Total:         1,
+			DefaultFrozen: true,
+			Holders:       []AssetHolderData{{Wallet: "Alice", Amount: 1}},
+		}},
+		Applications: []ApplicationData{{
+			Creator:            "Bob",
+			ApprovalProgram:    "#pragma version 8\nint 1",
+			ClearStateProgram:  "#pragma version 8\nint 1",
+			GlobalNumUint:      1,
+			GlobalNumByteSlice: 1,
+			GlobalState: []StateData{
+				{Key: "count", Type: "uint", Uint: 7},
+				{Key: "owner", Type: "bytes", Bytes: "bob"},
+			},
+			Boxes: []BoxData{{Name: "a", Value: "hello"}, {Name: "b"}},
+		}},
+	}
+
+	records, addrs := makeRecords()
+	appAllocs, err := addGenesisResources(genData, proto, records, addrs)
+	require.NoError(t, err)
+
+	// indices are assigned from 1001, skipping the explicit ones
+	alice, bob := records["Alice"], records["Bob"]
+	require.Equal(t, basics.AssetParams{
+		Total:     1000,
+		Decimals:  2,
+		UnitName:  "TST",
+		AssetName: "Test",
+		Manager:   basics.Address{1},
+		Clawback:  basics.Address{3},
+	}, alice.AssetParams[1001])
+	require.Equal(t, basics.AssetHolding{Amount: 700}, alice.Assets[1001])
+	require.Equal(t, basics.AssetHolding{Amount: 1, Frozen: true}, alice.Assets[2000])
+	require.Equal(t, basics.AssetHolding{Amount: 300}, bob.Assets[1001])
+	require.Equal(t, basics.AssetHolding{}, bob.Assets[2000])
+
+	app, ok := bob.AppParams[1002]
+	require.True(t, ok)
+	require.Equal(t, basics.TealKeyValue{
+		"count": {Type: basics.TealUintType, Uint: 7},
+		"owner": {Type: basics.TealBytesType, Bytes: "bob"},
+	}, app.GlobalState)
+	require.Equal(t, basics.StateSchema{NumUint: 1, NumByteSlice: 1}, bob.TotalAppSchema)
+
+	// the application account holds the boxes, funded by the creator
+	require.Equal(t, []genesisAllocation{{Name: "App1002", Stake: records["App1002"].MicroAlgos.Raw, Online: basics.Offline}}, appAllocs)
+	appAccount := records["App1002"]
+	boxMinBalance := basics.MinBalance(&proto, 0, basics.StateSchema{}, 0, 0, 0, 2, 7)
+	require.Equal(t, boxMinBalance, appAccount.MicroAlgos)
+	require.Equal(t, 10_000_000-boxMinBalance.Raw, bob.MicroAlgos.Raw)
+	require.Equal(t, basics.AppIndex(1002).Address(), addrs["App1002"])
+	require.EqualValues(t, 2, appAccount.TotalBoxes)
+	require.EqualValues(t, 7, appAccount.TotalBoxBytes)
+
+	// the records make valid genesis balances
+	g := bookkeeping.Genesis{FeeSink: basics.Address{1}.String(), RewardsPool: basics.Address{1}.String()}
+	for name, rec := range records {
+		g.Allocation = append(g.Allocation, bookkeeping.GenesisAllocation{Address: addrs[name].String(), Comment: name, State: rec})
+	}
+	balances, err := g.Balances()
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{
+		apps.MakeBoxKey(1002, "a"): []byte("hello"),
+		apps.MakeBoxKey(1002, "b"): {},
+	}, balances.Boxes)
+
+	errorCases := []struct {
+		name    string
+		change  func(*GenesisData)
+		message string
+	}{
+		{"unknown creator", func(gd *GenesisData) { gd.Assets[0].Creator = "Carol" }, `unknown wallet "Carol"`},
+		{"bad address", func(gd *GenesisData) { gd.Assets[0].Reserve = "Carol" }, `"Carol" is neither a wallet nor an address`},
+		{"repeated index", func(gd *GenesisData) { gd.Applications[0].Index = 2000 }, "index 2000 is used by more than one"},
+		{"too many units", func(gd *GenesisData) { gd.Assets[0].Holders[0].Amount = 1001 }, "more than the 1000 units"},
+		{"creator holder", func(gd *GenesisData) { gd.Assets[0].Holders[0].Wallet = "Alice" }, "can't be a holder"},
+		{"long unit name", func(gd *GenesisData) { gd.Assets[0].UnitName = "TOOLONGNAME" }, "unit name too long"},
+		{"bad program", func(gd *GenesisData) { gd.Applications[0].ApprovalProgram = "nope" }, "approval program"},
+		{"schema", func(gd *GenesisData) { gd.Applications[0].GlobalNumUint = 0 }, "doesn't fit the global schema"},
+		{"state type", func(gd *GenesisData) { gd.Applications[0].GlobalState[0].Type = "float" }, `unknown type "float"`},
+		{"repeated box", func(gd *GenesisData) { gd.Applications[0].Boxes[1].Name = "a" }, `box "a" is repeated`},
+		{"empty box name", func(gd *GenesisData) { gd.Applications[0].Boxes[1].Name = "" }, "box name"},
+		{"box funding", func(gd *GenesisData) {
+			gd.Applications[0].Boxes = append(gd.Applications[0].Boxes, BoxData{Name: "big", Value: string(make([]byte, proto.MaxBoxSize))})
+		}, "can't fund"},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gd := genData
+			gd.Assets = []AssetData{genData.Assets[0], genData.Assets[1]}
+			gd.Assets[0].Holders = []AssetHolderData{genData.Assets[0].Holders[0]}
+			app := genData.Applications[0]
+			app.GlobalState = append([]StateData{}, app.GlobalState...)
+			app.Boxes = append([]BoxData{}, app.Boxes...)
+			gd.Applications = []ApplicationData{app}
+			tc.change(&gd)
+
+			records, addrs := makeRecords()
+			_, err := addGenesisResources(gd, proto, records, addrs)
+			require.ErrorContains(t, err, tc.message)
+		})
+	}
+}
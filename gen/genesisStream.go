@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// genesisStreamBatchSize is the number of wallets created, and kept in memory, at once when streaming
+var genesisStreamBatchSize = 4096
+
+// WalletShardDir returns the subdirectory of the output directory holding the root and
+// participation keys of a wallet when the genesis is generated in streaming mode.
+func WalletShardDir(name string) string {
+	h := crypto.Hash([]byte(name))
+	return hex.EncodeToString(h[:1])
+}
+
+// streamGenesis creates the wallets of allocation in batches, writing their genesis allocations
+// to filename as soon as a batch is done, and forgetting their records and addresses.
+// Allocations whose records exist already are only written.
+func streamGenesis(g bookkeeping.Genesis, allocation []genesisAllocation, records map[string]bookkeeping.GenesisAccountData, addrs map[string]basics.Address,
+	createWallets func([]genesisAllocation) error, filename string) (err error) {
+	gw, err := makeGenesisWriter(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			gw.abort()
+		}
+	}()
+
+	for len(allocation) > 0 {
+		batch := allocation[:min(len(allocation), genesisStreamBatchSize)]
+		allocation = allocation[len(batch):]
+
+		var wallets []genesisAllocation
+		for _, wallet := range batch {
+			if _, ok := records[wallet.Name]; !ok {
+				wallets = append(wallets, wallet)
+			}
+		}
+		err = createWallets(wallets)
+		if err != nil {
+			return err
+		}
+
+		for _, wallet := range batch {
+			err = gw.writeAllocation(bookkeeping.GenesisAllocation{
+				Address: addrs[wallet.Name].String(),
+				Comment: wallet.Name,
+				State:   records[wallet.Name],
+			})
+			if err != nil {
+				return err
+			}
+			delete(records, wallet.Name)
+			delete(addrs, wallet.Name)
+		}
+	}
+	return gw.close(g)
+}
+
+// genesisWriter writes a genesis.json one allocation at a time. The file is the same as the
+// protocol.EncodeJSON encoding of the whole bookkeeping.Genesis, and only appears once complete.
+type genesisWriter struct {
+	filename string
+	file     *os.File
+	w        *bufio.Writer
+	entries  int
+}
+
+func makeGenesisWriter(filename string) (*genesisWriter, error) {
+	file, err := os.Create(filename + ".tmp")
+	if err != nil {
+		return nil, err
+	}
+	gw := &genesisWriter{filename: filename, file: file, w: bufio.NewWriter(file)}
+	// alloc is the first field of the encoded Genesis
+	_, err = gw.w.WriteString("{\n  \"alloc\": [\n")
+	if err != nil {
+		gw.abort()
+		return nil, err
+	}
+	return gw, nil
+}
+
+// writeAllocation appends an allocation, indented as an element of the alloc array.
+func (gw *genesisWriter) writeAllocation(alloc bookkeeping.GenesisAllocation) error {
+	if gw.entries > 0 {
+		if _, err := gw.w.WriteString(",\n"); err != nil {
+			return err
+		}
+	}
+	gw.entries++
+
+	// JSON strings never hold raw newlines, so every line of the encoding gets the indentation
+	encoded := protocol.EncodeJSON(alloc)
+	for i, line := range bytes.Split(encoded, []byte("\n")) {
+		if i > 0 {
+			if err := gw.w.WriteByte('\n'); err != nil {
+				return err
+			}
+		}
+		if _, err := gw.w.WriteString("    "); err != nil {
+			return err
+		}
+		if _, err := gw.w.Write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close writes the fields of g other than its allocation, which must be empty, and moves the file in place.
+func (gw *genesisWriter) close(g bookkeeping.Genesis) error {
+	if len(g.Allocation) != 0 || gw.entries == 0 {
+		return fmt.Errorf("streamed genesis must have its allocations written one by one")
+	}
+	rest := protocol.EncodeJSON(g)
+	if !bytes.HasPrefix(rest, []byte("{\n")) {
+		return fmt.Errorf("unexpected genesis encoding")
+	}
+
+	if _, err := gw.w.WriteString("\n  ],\n"); err != nil {
+		return err
+	}
+	if _, err := gw.w.Write(rest[len("{\n"):]); err != nil {
+		return err
+	}
+	if err := gw.w.WriteByte('\n'); err != nil {
+		return err
+	}
+	if err := gw.w.Flush(); err != nil {
+		return err
+	}
+	if err := gw.file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(gw.filename+".tmp", gw.filename)
+}
+
+// abort removes the incomplete file.
+func (gw *genesisWriter) abort() {
+	gw.file.Close()
+	os.Remove(gw.filename + ".tmp")
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestStreamingGenesis(t *testing.T) { //nolint:paralleltest // Not parallel because it changes genesisStreamBatchSize
+	partitiontest.PartitionTest(t)
+
+	oldBatchSize := genesisStreamBatchSize
+	genesisStreamBatchSize = 3
+	defer func() { genesisStreamBatchSize = oldBatchSize }()
+
+	tempDir := t.TempDir()
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "streaming"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.DeterministicSeed = "streaming"
+	genesisData.Wallets = make([]WalletData, 8)
+	for i := range genesisData.Wallets {
+		genesisData.Wallets[i].Name = fmt.Sprintf("Wallet%d", i)
+		genesisData.Wallets[i].Stake = 100.0 / float64(len(genesisData.Wallets))
+	}
+	genesisData.Wallets[5].Online = true
+
+	generate := func(outDir string) []byte {
+		require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, filepath.Join(tempDir, outDir), nil))
+		genesisJSON, err := os.ReadFile(filepath.Join(tempDir, outDir, config.GenesisJSONFile))
+		require.NoError(t, err)
+		return genesisJSON
+	}
+
+	// streaming writes the same genesis.json
+	expected := generate("flat")
+	genesisData.Streaming = true
+	streamed := generate("streamed")
+	require.Equal(t, string(expected), string(streamed))
+
+	var genesis bookkeeping.Genesis
+	require.NoError(t, protocol.DecodeJSON(streamed, &genesis))
+	require.Len(t, genesis.Allocation, len(genesisData.Wallets)+2)
+	_, err := genesis.Balances()
+	require.NoError(t, err)
+
+	// the wallet files are sharded
+	for _, wallet := range genesisData.Wallets {
+		dir := filepath.Join(tempDir, "streamed", WalletShardDir(wallet.Name))
+		require.FileExists(t, filepath.Join(dir, config.RootKeyFilename(wallet.Name)))
+		require.NoFileExists(t, filepath.Join(tempDir, "streamed", config.RootKeyFilename(wallet.Name)))
+		if wallet.Online {
+			require.FileExists(t, filepath.Join(dir, config.PartKeyFilename(wallet.Name, 0, 100)))
+		}
+	}
+	require.NoFileExists(t, filepath.Join(tempDir, "streamed", config.GenesisJSONFile+".tmp"))
+
+	// existing wallets are reused
+	require.Equal(t, string(expected), string(generate("streamed")))
+
+	genesisData.Assets = []AssetData{{Creator: "Wallet0", Total: 1}}
+	err = GenerateGenesisFiles(genesisData, config.Consensus, filepath.Join(tempDir, "assets"), nil)
+	require.ErrorContains(t, err, "doesn't support assets and applications")
+}
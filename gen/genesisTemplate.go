@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LoadGenesisTemplate loads a GenesisData from a template, a genesis data file of any of the formats of
+// LoadGenesisData whose ${NAME} references are replaced before it gets parsed. A reference to a variable
+// that isn't set is an error, unless it gives a default as ${NAME:-default}, and $$ stands for a $.
+// Variables are looked up in values first, then in the environment when useEnv is set.
+func LoadGenesisTemplate(file string, values map[string]string, useEnv bool) (GenesisData, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return DefaultGenesis, err
+	}
+	lookup := func(name string) (string, bool) {
+		if value, ok := values[name]; ok {
+			return value, true
+		}
+		if useEnv {
+			return os.LookupEnv(name)
+		}
+		return "", false
+	}
+	expanded, err := expandGenesisTemplate(file, string(data), lookup)
+	if err != nil {
+		return DefaultGenesis, err
+	}
+	return parseGenesisData(file, []byte(expanded))
+}
+
+// LoadTemplateValues loads the values of template variables from a file of NAME=value lines. Blank
+// lines and lines starting with # are skipped, and the values are taken as they are, without quotes.
+func LoadTemplateValues(file string) (map[string]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(text, "=")
+		name = strings.TrimSpace(name)
+		if !ok || !validTemplateName(name) {
+			return nil, &genesisInputError{file: file, line: line, column: 1, msg: fmt.Sprintf("expected NAME=value, found %q", text)}
+		}
+		values[name] = strings.TrimSpace(value)
+	}
+	return values, scanner.Err()
+}
+
+// expandGenesisTemplate replaces the variable references of the template text.
+func expandGenesisTemplate(file string, text string, lookup func(name string) (string, bool)) (string, error) {
+	var out strings.Builder
+	line, lineStart := 1, 0
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c == '\n' {
+			line, lineStart = line+1, i+1
+		}
+		if c != '$' || i+1 == len(text) || (text[i+1] != '$' && text[i+1] != '{') {
+			out.WriteByte(c)
+			continue
+		}
+		if text[i+1] == '$' {
+			out.WriteByte('$')
+			i++
+			continue
+		}
+
+		column := i - lineStart + 1
+		end := strings.IndexByte(text[i:], '}')
+		if end < 0 || strings.ContainsRune(text[i:i+end], '\n') {
+			return "", &genesisInputError{file: file, line: line, column: column, msg: "unterminated variable reference"}
+		}
+		reference := text[i+2 : i+end]
+		name, fallback, hasFallback := strings.Cut(reference, ":-")
+		if !validTemplateName(name) {
+			return "", &genesisInputError{file: file, line: line, column: column, msg: fmt.Sprintf("invalid variable name %q", name)}
+		}
+		value, ok := lookup(name)
+		if !ok {
+			if !hasFallback {
+				return "", &genesisInputError{file: file, line: line, column: column, msg: fmt.Sprintf("variable %s is not set", name)}
+			}
+			value = fallback
+		}
+		out.WriteString(value)
+		i += end
+	}
+	return out.String(), nil
+}
+
+// validTemplateName tells whether name is a valid variable name: letters, digits and underscores,
+// not starting with a digit.
+func validTemplateName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case '0' <= c && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLoadGenesisTemplate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	templates := map[string]string{
+		"net.json": `{"NetworkName": "${NETWORK}", "LastPartKeyRound": ${LAST_ROUND:-3000}, "Comment": "costs $$5",
+"Wallets": [{"Name": "Wallet1", "Stake": ${STAKE}, "Online": true}, {"Name": "Wallet2", "Stake": ${OTHER_STAKE:-50}}]}`,
+		"net.yaml": `NetworkName: ${NETWORK}
+LastPartKeyRound: ${LAST_ROUND:-3000}
+Comment: costs $$5
+Wallets:
+  - {Name: Wallet1, Stake: ${STAKE}, Online: true}
+  - {Name: Wallet2, Stake: ${OTHER_STAKE:-50}}
+`,
+	}
+	valuesFile := filepath.Join(dir, "staging.env")
+	require.NoError(t, os.WriteFile(valuesFile, []byte("# staging network\nNETWORK=staging\n\nSTAKE = 50\n"), 0666))
+	values, err := LoadTemplateValues(valuesFile)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"NETWORK": "staging", "STAKE": "50"}, values)
+
+	t.Setenv("LAST_ROUND", "1000")
+	t.Setenv("NETWORK", "fromenv")
+	for name, template := range templates {
+		file := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(file, []byte(template), 0666))
+
+		genesisData, err := LoadGenesisTemplate(file, values, true)
+		require.NoError(t, err, name)
+		require.Equal(t, "staging", genesisData.NetworkName, name)
+		require.Equal(t, basics.Round(1000), genesisData.LastPartKeyRound, name)
+		require.Equal(t, "costs $5", genesisData.Comment, name)
+		require.Equal(t, []WalletData{{Name: "Wallet1", Stake: 50, Online: true}, {Name: "Wallet2", Stake: 50}}, genesisData.Wallets, name)
+		require.Equal(t, DefaultGenesis.RewardsPoolBalance, genesisData.RewardsPoolBalance, name)
+
+		genesisData, err = LoadGenesisTemplate(file, values, false)
+		require.NoError(t, err, name)
+		require.Equal(t, basics.Round(3000), genesisData.LastPartKeyRound, name)
+
+		_, err = LoadGenesisTemplate(file, nil, false)
+		require.ErrorContains(t, err, name+":1:", name)
+		require.ErrorContains(t, err, "variable NETWORK is not set", name)
+	}
+}
+
+func TestExpandGenesisTemplateErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	lookup := func(name string) (string, bool) { return "", false }
+	for _, tc := range []struct {
+		text string
+		err  string
+	}{
+		{"a: 1\nb: ${B", "t.yaml:2:4: unterminated variable reference"},
+		{"a: ${A\n}", "t.yaml:1:4: unterminated variable reference"},
+		{"a: ${1A}", `t.yaml:1:4: invalid variable name "1A"`},
+		{"a: ${}", `t.yaml:1:4: invalid variable name ""`},
+		{"a: 1\n  b: ${MISSING}", "t.yaml:2:6: variable MISSING is not set"},
+	} {
+		_, err := expandGenesisTemplate("t.yaml", tc.text, lookup)
+		require.EqualError(t, err, tc.err, tc.text)
+	}
+
+	expanded, err := expandGenesisTemplate("t.yaml", "a: $HOME ${X:-} $ $$ ${Y:-a:-b}", lookup)
+	require.NoError(t, err)
+	require.Equal(t, "a: $HOME  $ $ a:-b", expanded)
+}
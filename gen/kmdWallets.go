@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/algorand/go-algorand/crypto"
+	kmdconfig "github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// kmdDirPermissions are the permissions of a kmd data directory created by gen, the ones kmd expects
+const kmdDirPermissions = 0700
+
+// importWalletsToKMD moves the root keys of the wallets into the sqlite wallets of the kmd data directory
+// kmdDir, one kmd wallet per genesis wallet, named after it and with a blank password. kmd wallets that
+// already exist are populated, and the root key files are removed once their key is imported.
+func importWalletsToKMD(kmdDir string, wallets []string, rootKeyFile func(wallet string) string, verboseOut io.Writer) error {
+	err := os.MkdirAll(kmdDir, kmdDirPermissions)
+	if err != nil {
+		return fmt.Errorf("couldn't make kmd directory '%s': %v", kmdDir, err)
+	}
+	cfg, err := kmdconfig.LoadKMDConfig(kmdDir)
+	if err != nil {
+		return fmt.Errorf("couldn't load the kmd configuration of '%s': %v", kmdDir, err)
+	}
+	var sqliteDriver driver.SQLiteWalletDriver
+	err = sqliteDriver.InitWithConfig(cfg, logging.Base())
+	if err != nil {
+		return err
+	}
+
+	metadatas, err := sqliteDriver.ListWalletMetadatas()
+	if err != nil {
+		return err
+	}
+	walletIDs := make(map[string][]byte, len(metadatas))
+	for _, metadata := range metadatas {
+		walletIDs[string(metadata.Name)] = metadata.ID
+	}
+
+	for _, name := range wallets {
+		filename := rootKeyFile(name)
+		root, rootDB, err := loadRootKey(filename)
+		if err != nil {
+			return err
+		}
+		secrets := root.Secrets()
+		rootDB.Close()
+
+		id, ok := walletIDs[name]
+		if !ok {
+			id, err = wallet.GenerateWalletID()
+			if err != nil {
+				return err
+			}
+			err = sqliteDriver.CreateWallet([]byte(name), id, nil, crypto.MasterDerivationKey{})
+			if err != nil {
+				return fmt.Errorf("couldn't create kmd wallet %s: %v", name, err)
+			}
+			walletIDs[name] = id
+		}
+		err = importKMDKey(&sqliteDriver, id, secrets)
+		if err != nil {
+			return fmt.Errorf("couldn't import the root key of %s into kmd: %v", name, err)
+		}
+		if verboseOut != nil {
+			fmt.Fprintf(verboseOut, "Imported %s into kmd wallet %s\n", filename, name)
+		}
+
+		err = os.Remove(filename)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importKMDKey imports the key of secrets into the kmd wallet id, unless the wallet already holds it.
+func importKMDKey(sqliteDriver *driver.SQLiteWalletDriver, id []byte, secrets *crypto.SignatureSecrets) error {
+	kmdWallet, err := sqliteDriver.FetchWallet(id)
+	if err != nil {
+		return err
+	}
+	err = kmdWallet.Init(nil)
+	if err != nil {
+		return err
+	}
+	keys, err := kmdWallet.ListKeys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if bytes.Equal(key[:], secrets.SignatureVerifier[:]) {
+			return nil
+		}
+	}
+	_, err = kmdWallet.ImportKey(secrets.SK)
+	return err
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	kmdconfig "github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGenesisWalletsInKMD(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	kmdDir := filepath.Join(t.TempDir(), "kmd-v0.5")
+	require.NoError(t, os.Mkdir(kmdDir, kmdDirPermissions))
+	// keep the test fast with cheap wallet password hashing
+	kmdConfig := `{"drivers":{"sqlite":{"scrypt":{"scrypt_n":2},"allow_unsafe_scrypt":true}}}`
+	require.NoError(t, os.WriteFile(filepath.Join(kmdDir, "kmd_config.json"), []byte(kmdConfig), 0600))
+
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "kmd"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.KMDDir = kmdDir
+	genesisData.Wallets = []WalletData{
+		{Name: "Wallet1", Stake: 50, Online: true},
+		{Name: "Wallet2", Stake: 50},
+	}
+
+	kmdKeys := func() map[string][]basics.Address {
+		cfg, err := kmdconfig.LoadKMDConfig(kmdDir)
+		require.NoError(t, err)
+		var sqliteDriver driver.SQLiteWalletDriver
+		require.NoError(t, sqliteDriver.InitWithConfig(cfg, logging.TestingLog(t)))
+		metadatas, err := sqliteDriver.ListWalletMetadatas()
+		require.NoError(t, err)
+
+		keys := make(map[string][]basics.Address)
+		for _, metadata := range metadatas {
+			kmdWallet, err := sqliteDriver.FetchWallet(metadata.ID)
+			require.NoError(t, err)
+			require.NoError(t, kmdWallet.Init(nil))
+			digests, err := kmdWallet.ListKeys()
+			require.NoError(t, err)
+			for _, digest := range digests {
+				keys[string(metadata.Name)] = append(keys[string(metadata.Name)], basics.Address(digest))
+			}
+		}
+		return keys
+	}
+	genesisAddresses := func(outDir string) map[string]basics.Address {
+		genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+		require.NoError(t, err)
+		addrs := make(map[string]basics.Address)
+		for _, alloc := range genesis.Allocation {
+			addr, err := basics.UnmarshalChecksumAddress(alloc.Address)
+			require.NoError(t, err)
+			addrs[alloc.Comment] = addr
+		}
+		return addrs
+	}
+
+	outDir := t.TempDir()
+	require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+	addrs := genesisAddresses(outDir)
+	require.Equal(t, map[string][]basics.Address{
+		"Wallet1": {addrs["Wallet1"]},
+		"Wallet2": {addrs["Wallet2"]},
+	}, kmdKeys())
+	require.NoFileExists(t, filepath.Join(outDir, config.RootKeyFilename("Wallet1")))
+	require.NoFileExists(t, filepath.Join(outDir, config.RootKeyFilename("Wallet2")))
+	require.FileExists(t, filepath.Join(outDir, config.PartKeyFilename("Wallet1", 0, 100)))
+
+	// a second network populates the existing kmd wallets
+	outDir2 := t.TempDir()
+	require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir2, nil))
+	addrs2 := genesisAddresses(outDir2)
+	keys := kmdKeys()
+	require.Len(t, keys, 2)
+	require.ElementsMatch(t, []basics.Address{addrs["Wallet1"], addrs2["Wallet1"]}, keys["Wallet1"])
+	require.ElementsMatch(t, []basics.Address{addrs["Wallet2"], addrs2["Wallet2"]}, keys["Wallet2"])
+
+	genesisData.Resume = true
+	require.ErrorContains(t, GenerateGenesisFiles(genesisData, config.Consensus, t.TempDir(), nil), "can't be resumed")
+}
+
+func TestImportKMDKeyTwice(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	kmdDir := t.TempDir()
+	kmdConfig := `{"drivers":{"sqlite":{"scrypt":{"scrypt_n":2},"allow_unsafe_scrypt":true}}}`
+	require.NoError(t, os.WriteFile(filepath.Join(kmdDir, "kmd_config.json"), []byte(kmdConfig), 0600))
+	cfg, err := kmdconfig.LoadKMDConfig(kmdDir)
+	require.NoError(t, err)
+	var sqliteDriver driver.SQLiteWalletDriver
+	require.NoError(t, sqliteDriver.InitWithConfig(cfg, logging.TestingLog(t)))
+	require.NoError(t, sqliteDriver.CreateWallet([]byte("wallet"), []byte("id"), nil, crypto.MasterDerivationKey{}))
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	require.NoError(t, importKMDKey(&sqliteDriver, []byte("id"), secrets))
+	require.NoError(t, importKMDKey(&sqliteDriver, []byte("id"), secrets))
+
+	kmdWallet, err := sqliteDriver.FetchWallet([]byte("id"))
+	require.NoError(t, err)
+	keys, err := kmdWallet.ListKeys()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{crypto.Digest(secrets.SignatureVerifier)}, keys)
+}
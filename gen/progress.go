@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// GenerateProgress is a report of the progress of the wallet creation of genesis generation.
+type GenerateProgress struct {
+	// Wallet is the wallet the report is about
+	Wallet string
+	// Message tells what happened to Wallet, and is empty when the report only tells that Wallet is done
+	Message string
+
+	WalletsDone     int
+	WalletsTotal    int
+	WalletsResumed  int
+	RootKeysCreated int
+	PartKeysCreated int
+
+	Elapsed time.Duration
+	// ETA is the estimated time left to create the remaining wallets, zero until a wallet gets created
+	ETA time.Duration
+}
+
+// ProgressFunc receives the progress reports of genesis generation. Reports come from the goroutines
+// creating the wallets, one at a time.
+type ProgressFunc func(GenerateProgress)
+
+// progressReporter keeps track of the wallet creation, and reports its progress to a ProgressFunc and,
+// as text, to a verbose output.
+type progressReporter struct {
+	mu         deadlock.Mutex
+	report     ProgressFunc
+	verboseOut io.Writer
+	start      time.Time
+	state      GenerateProgress
+}
+
+func makeProgressReporter(walletsTotal int, report ProgressFunc, verboseOut io.Writer) *progressReporter {
+	return &progressReporter{
+		report:     report,
+		verboseOut: verboseOut,
+		start:      time.Now(),
+		state:      GenerateProgress{WalletsTotal: walletsTotal},
+	}
+}
+
+// enabled tells whether the reports are consumed, so callers can skip formatting messages.
+func (r *progressReporter) enabled() bool {
+	return r.report != nil || r.verboseOut != nil
+}
+
+// event updates the progress with update, and reports it along with message.
+func (r *progressReporter) event(wallet string, message string, update func(*GenerateProgress)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if update != nil {
+		update(&r.state)
+	}
+	if r.verboseOut != nil && message != "" {
+		fmt.Fprintln(r.verboseOut, message)
+	}
+	if r.report == nil {
+		return
+	}
+
+	progress := r.state
+	progress.Wallet = wallet
+	progress.Message = message
+	progress.Elapsed = time.Since(r.start)
+	// the resumed wallets took no time, so they don't count in the rate
+	if created := progress.WalletsDone - progress.WalletsResumed; created > 0 {
+		progress.ETA = progress.Elapsed * time.Duration(progress.WalletsTotal-progress.WalletsDone) / time.Duration(created)
+	}
+	r.report(progress)
+}
+
+func (r *progressReporter) message(wallet string, format string, args ...interface{}) {
+	if r.enabled() {
+		r.event(wallet, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+func (r *progressReporter) rootKeyCreated(wallet string, filename string) {
+	r.event(wallet, fmt.Sprintf("Created new rootkey: %s", filename), func(p *GenerateProgress) { p.RootKeysCreated++ })
+}
+
+func (r *progressReporter) partKeyCreated(wallet string) {
+	r.event(wallet, fmt.Sprintf("participation key generation for %s completed successfully", wallet), func(p *GenerateProgress) { p.PartKeysCreated++ })
+}
+
+func (r *progressReporter) walletDone(wallet string, resumed bool) {
+	r.event(wallet, "", func(p *GenerateProgress) {
+		p.WalletsDone++
+		if resumed {
+			p.WalletsResumed++
+		}
+	})
+}
+
+// snapshot returns the current progress.
+func (r *progressReporter) snapshot() GenerateProgress {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	progress := r.state
+	progress.Elapsed = time.Since(r.start)
+	return progress
+}
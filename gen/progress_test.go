@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGenerateProgress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "progress"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.LastPartKeyRound = 100
+	genesisData.Wallets = []WalletData{
+		{Name: "Wallet1", Stake: 25, Online: true},
+		{Name: "Wallet2", Stake: 25},
+		{Name: "Wallet3", Stake: 50, Online: true},
+	}
+
+	var reports []GenerateProgress
+	progress := func(p GenerateProgress) { reports = append(reports, p) }
+	err := GenerateGenesisFilesWithProgress(context.Background(), genesisData, config.Consensus, t.TempDir(), nil, progress)
+	require.NoError(t, err)
+
+	done := make(map[string]bool)
+	walletsDone := 0
+	for _, report := range reports {
+		require.Equal(t, 3, report.WalletsTotal)
+		require.GreaterOrEqual(t, report.WalletsDone, walletsDone)
+		walletsDone = report.WalletsDone
+		if report.Message == "" {
+			done[report.Wallet] = true
+		}
+	}
+	require.Equal(t, map[string]bool{"Wallet1": true, "Wallet2": true, "Wallet3": true}, done)
+
+	last := reports[len(reports)-1]
+	require.Equal(t, 3, last.WalletsDone)
+	require.Equal(t, 3, last.RootKeysCreated)
+	require.Equal(t, 2, last.PartKeysCreated)
+	require.Zero(t, last.WalletsResumed)
+	require.Zero(t, last.ETA)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reports = nil
+	err = GenerateGenesisFilesWithProgress(ctx, genesisData, config.Consensus, t.TempDir(), nil, progress)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, reports)
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// RootKeyMnemonicMappingFilename is the file written next to root keys derived from a mnemonic.
+// It maps every wallet to the derivation path and address of its root key, so the wallets
+// can be recovered from the mnemonic alone.
+const RootKeyMnemonicMappingFilename = "rootkeys.mnemonic.json"
+
+// algorandCoinType is the SLIP-0044 coin type of Algorand
+const algorandCoinType = 283
+
+// derivedRootKey is the root key of a wallet derived from a mnemonic, at m/44'/283'/Index'/0'/0'.
+type derivedRootKey struct {
+	Wallet  string
+	Index   uint32
+	Path    string
+	Address string
+
+	seed crypto.Seed
+	// origin names where the key comes from in errors
+	origin string
+}
+
+// deriveRootKeys derives the root keys of wallets from the BIP39 mnemonic held in mnemonicFile.
+func deriveRootKeys(mnemonicFile string, wallets []WalletData) (map[string]derivedRootKey, error) {
+	mnemonic, err := os.ReadFile(mnemonicFile)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read root key mnemonic file: %w", err)
+	}
+	seed, err := passphrase.Bip39MnemonicToSeed(string(mnemonic))
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic in %s: %w", mnemonicFile, err)
+	}
+
+	keys := make(map[string]derivedRootKey, len(wallets))
+	indices := make(map[uint32]string, len(wallets))
+	for i, wallet := range wallets {
+		index := uint32(i)
+		if wallet.DerivationIndex != nil {
+			index = *wallet.DerivationIndex
+		}
+		if other, ok := indices[index]; ok {
+			return nil, fmt.Errorf("wallets %s and %s have the same derivation index %d", other, wallet.Name, index)
+		}
+		indices[index] = wallet.Name
+
+		key := derivedRootKey{
+			Wallet: wallet.Name,
+			Index:  index,
+			Path:   fmt.Sprintf("m/44'/%d'/%d'/0'/0'", algorandCoinType, index),
+		}
+		key.origin = "the key derived from the mnemonic at " + key.Path
+		key.seed, err = passphrase.DeriveEd25519Seed(seed, []uint32{44, algorandCoinType, index, 0, 0})
+		if err != nil {
+			return nil, fmt.Errorf("wallet %s: %w", wallet.Name, err)
+		}
+		key.Address = basics.Address(crypto.GenerateSignatureSecrets(key.seed).SignatureVerifier).String()
+		keys[wallet.Name] = key
+	}
+	return keys, nil
+}
+
+// writeRootKeyMnemonicMapping writes the mapping of the derived root keys to outDir,
+// readable by the owner only.
+func writeRootKeyMnemonicMapping(outDir string, keys map[string]derivedRootKey) error {
+	mapping := make([]derivedRootKey, 0, len(keys))
+	for _, key := range keys {
+		mapping = append(mapping, key)
+	}
+	sort.Slice(mapping, func(i, j int) bool {
+		return mapping[i].Index < mapping[j].Index
+	})
+
+	data, err := json.MarshalIndent(mapping, "", "  ")
+	if err != nil {
+		return err
+	}
+	filename := filepath.Join(outDir, RootKeyMnemonicMappingFilename)
+	err = os.WriteFile(filename, append(data, '\n'), 0600)
+	if err != nil {
+		return err
+	}
+	// WriteFile keeps the mode of an existing file
+	return os.Chmod(filename, 0600)
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGenesisRootKeysFromMnemonic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	mnemonicFile := filepath.Join(tempDir, "mnemonic")
+	require.NoError(t, os.WriteFile(mnemonicFile, []byte(strings.Repeat("abandon ", 11)+"about\n"), 0600))
+
+	seven := uint32(7)
+	genesisData := DefaultGenesis
+	genesisData.NetworkName = "mnemonic"
+	genesisData.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	genesisData.RootKeyMnemonicFile = mnemonicFile
+	genesisData.Wallets = []WalletData{
+		{Name: "Wallet1", Stake: 50},
+		{Name: "Wallet2", Stake: 50, DerivationIndex: &seven},
+	}
+
+	generate := func(outDir string) []derivedRootKey {
+		require.NoError(t, GenerateGenesisFiles(genesisData, config.Consensus, outDir, nil))
+
+		info, err := os.Stat(filepath.Join(outDir, RootKeyMnemonicMappingFilename))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		data, err := os.ReadFile(filepath.Join(outDir, RootKeyMnemonicMappingFilename))
+		require.NoError(t, err)
+		var mapping []derivedRootKey
+		require.NoError(t, json.Unmarshal(data, &mapping))
+		require.NotContains(t, string(data), "abandon")
+
+		genesisJSON, err := os.ReadFile(filepath.Join(outDir, config.GenesisJSONFile))
+		require.NoError(t, err)
+		var genesis bookkeeping.Genesis
+		require.NoError(t, protocol.DecodeJSON(genesisJSON, &genesis))
+		for _, key := range mapping {
+			root, rootDB, err := loadRootKey(filepath.Join(outDir, config.RootKeyFilename(key.Wallet)))
+			require.NoError(t, err)
+			rootDB.Close()
+			require.Equal(t, key.Address, root.Address().String())
+			require.Contains(t, string(genesisJSON), key.Address)
+		}
+		return mapping
+	}
+
+	mapping := generate(filepath.Join(tempDir, "net1"))
+	require.Equal(t, []derivedRootKey{
+		{Wallet: "Wallet1", Index: 0, Path: "m/44'/283'/0'/0'/0'", Address: mapping[0].Address},
+		{Wallet: "Wallet2", Index: 7, Path: "m/44'/283'/7'/0'/0'", Address: mapping[1].Address},
+	}, mapping)
+	require.NotEqual(t, mapping[0].Address, mapping[1].Address)
+
+	// the same mnemonic gives the same wallets
+	require.Equal(t, mapping, generate(filepath.Join(tempDir, "net2")))
+
+	// existing root keys must match the mnemonic
+	genesisData.Wallets[1].DerivationIndex = nil
+	err := GenerateGenesisFiles(genesisData, config.Consensus, filepath.Join(tempDir, "net1"), nil)
+	require.ErrorContains(t, err, "doesn't match the key derived from the mnemonic at m/44'/283'/1'/0'/0'")
+
+	genesisData.Wallets[1].DerivationIndex = new(uint32)
+	err = GenerateGenesisFiles(genesisData, config.Consensus, filepath.Join(tempDir, "net3"), nil)
+	require.ErrorContains(t, err, "wallets Wallet1 and Wallet2 have the same derivation index 0")
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// checkSystemAccounts checks that the system accounts have names and addresses of their own.
+func checkSystemAccounts(genData GenesisData) error {
+	names := map[string]bool{"FeeSink": true, "RewardsPool": true}
+	for _, wallet := range genData.Wallets {
+		names[wallet.Name] = true
+	}
+	addrs := map[basics.Address]string{genData.FeeSink: "the fee sink", genData.RewardsPool: "the rewards pool"}
+	for _, account := range genData.SystemAccounts {
+		if account.Name == "" {
+			return fmt.Errorf("system account %s has no name", account.Address)
+		}
+		if names[account.Name] {
+			return fmt.Errorf("system account name %s is already in use", account.Name)
+		}
+		names[account.Name] = true
+		if other, ok := addrs[account.Address]; ok {
+			return fmt.Errorf("system account %s has the address of %s", account.Name, other)
+		}
+		addrs[account.Address] = account.Name
+	}
+	return nil
+}
+
+// systemBalance returns the starting balance of a system account. Zero stands for the minimum balance,
+// and other balances below it are raised to it unless the balances are strict.
+func systemBalance(name string, balance uint64, minBalance uint64, strict bool) (uint64, error) {
+	if balance >= minBalance {
+		return balance, nil
+	}
+	if strict && balance != 0 {
+		return 0, fmt.Errorf("%s balance %d is below the minimum balance %d", name, balance, minBalance)
+	}
+	// Needs to at least have min balance
+	return minBalance, nil
+}
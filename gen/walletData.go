@@ -17,8 +17,14 @@
 package gen
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
 
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/protocol"
@@ -37,6 +43,20 @@ type WalletData struct {
 	Name   string
 	Stake  float64
 	Online bool
+	// DerivationIndex is the account index of the wallet root key when root keys are derived
+	// from a mnemonic. Wallets without one use their position in GenesisData.Wallets.
+	DerivationIndex *uint32 `json:",omitempty"`
+	// FirstPartKeyRound, LastPartKeyRound and PartKeyDilution override the GenesisData ones for the
+	// participation keys of this wallet, so long-lived accounts can get keys valid longer than the others.
+	FirstPartKeyRound *basics.Round `json:",omitempty"`
+	LastPartKeyRound  *basics.Round `json:",omitempty"`
+	PartKeyDilution   uint64        `json:",omitempty"`
+	// NoStateProofKeys skips the generation of state proof keys, which takes most of the participation
+	// key generation time, for online wallets that will never sign state proofs.
+	NoStateProofKeys bool `json:",omitempty"`
+	// ExternalRootKey is a root key held by a hardware signer. genesis.json gets its address, and the
+	// wallet gets no rootkey file.
+	ExternalRootKey *ExternalRootKeyData `json:",omitempty"`
 }
 
 // GenesisData represents the genesis data for creating a genesis.json and wallets
@@ -53,18 +73,152 @@ type GenesisData struct {
 	RewardsPoolBalance uint64 // Values < `ConsensusParams.MinBalance` are adjusted to `ConsensusParams.MinBalance`
 	DevMode            bool
 	Comment            string
+	Assets             []AssetData
+	Applications       []ApplicationData
+	// RootKeyMnemonicFile is a file holding a BIP39 mnemonic the wallet root keys are derived from,
+	// instead of being random. It is read when generating, and its content never gets into the outputs.
+	RootKeyMnemonicFile string `json:",omitempty"`
+	// DeterministicSeed, when set, is the master seed the root and participation keys of the wallets are
+	// derived from, so the same genesis data always gives the same genesis.json and keys. The keys are as
+	// secret as the seed, so it is only meant for test and benchmark networks.
+	DeterministicSeed string `json:",omitempty"`
+	// Streaming creates the wallets in batches and writes their allocations to genesis.json as soon as a
+	// batch is done, so networks with millions of accounts don't need all their records in memory. The
+	// wallet files go to subdirectories of the output directory, see WalletShardDir. Assets and
+	// Applications aren't supported when streaming.
+	Streaming bool `json:",omitempty"`
+	// Resume records every completed wallet in a manifest of the output directory, and takes the
+	// wallets recorded there by an interrupted run without reloading their keys.
+	Resume bool `json:",omitempty"`
+	// KMDDir is a kmd data directory the wallet root keys are moved to once genesis.json is written, each
+	// into a blank-password kmd wallet named after the genesis wallet, instead of staying in rootkey files.
+	KMDDir string `json:",omitempty"`
+	// FeeSinkBalance is the starting balance of the fee sink, adjusted like RewardsPoolBalance
+	FeeSinkBalance uint64 `json:",omitempty"`
+	// SystemAccounts are funded accounts with fixed addresses, which like the fee sink and the rewards
+	// pool don't participate and don't count in the wallets stake.
+	SystemAccounts []SystemAccountData `json:",omitempty"`
+	// StrictSystemBalances rejects the balances of the rewards pool, the fee sink and the system accounts
+	// that are below the minimum balance, instead of raising them. A zero balance still stands for the minimum.
+	StrictSystemBalances bool `json:",omitempty"`
+}
+
+// SystemAccountData describes a system account of the genesis, whose Balance is adjusted like
+// GenesisData.RewardsPoolBalance.
+type SystemAccountData struct {
+	Name    string
+	Address basics.Address
+	Balance uint64
+}
+
+// AssetData describes an asset created in genesis by one of the wallets. Assets without an
+// Index are numbered after the highest index in use, starting at 1001.
+type AssetData struct {
+	Index         uint64
+	Creator       string // name of the creating wallet
+	Total         uint64
+	Decimals      uint32
+	DefaultFrozen bool
+	UnitName      string
+	AssetName     string
+	URL           string
+	// Manager, Reserve, Freeze and Clawback are wallet names or addresses, and may be empty
+	Manager  string
+	Reserve  string
+	Freeze   string
+	Clawback string
+	// Holders are the wallets given units of the asset; the creator holds the rest
+	Holders []AssetHolderData
+}
+
+// AssetHolderData describes the units of a genesis asset held by a wallet.
+type AssetHolderData struct {
+	Wallet string
+	Amount uint64
+	Frozen bool
+}
+
+// ApplicationData describes an application created in genesis by one of the wallets. Applications
+// without an Index are numbered after the highest index in use, starting at 1001.
+type ApplicationData struct {
+	Index   uint64
+	Creator string // name of the creating wallet
+	// ApprovalProgram and ClearStateProgram are TEAL sources
+	ApprovalProgram    string
+	ClearStateProgram  string
+	GlobalNumUint      uint64
+	GlobalNumByteSlice uint64
+	LocalNumUint       uint64
+	LocalNumByteSlice  uint64
+	ExtraProgramPages  uint32
+	GlobalState        []StateData
+	// Boxes are funded by the creator, which transfers their minimum balance to the application account
+	Boxes []BoxData
+}
+
+// StateData is a key/value pair of an application's global state. Bytes values are used when
+// Type is "bytes", or when Type is empty and Bytes isn't.
+type StateData struct {
+	Key   string
+	Type  string // "uint" or "bytes"
+	Uint  uint64
+	Bytes string
+}
+
+// BoxData is a box of a genesis application.
+type BoxData struct {
+	Name  string
+	Value string
 }
 
-// LoadGenesisData loads a GenesisData structure from a json file
+// LoadGenesisData loads a GenesisData structure from a json, yaml or toml file, chosen by the file extension.
+// Errors in yaml files report the line and column of the offending value, and syntax errors in toml files their line.
 func LoadGenesisData(file string) (gen GenesisData, err error) {
-	gen = DefaultGenesis
-	f, err := os.Open(file)
+	data, err := os.ReadFile(file)
 	if err != nil {
-		return
+		return DefaultGenesis, err
+	}
+	return parseGenesisData(file, data)
+}
+
+// parseGenesisData parses data, the content of the genesis data file, in the format of its extension.
+func parseGenesisData(file string, data []byte) (gen GenesisData, err error) {
+	gen = DefaultGenesis
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		return decodeGenesisData(file, data, parseGenesisYAML)
+	case ".toml":
+		return decodeGenesisTOML(file, data)
 	}
-	defer f.Close()
 
-	dec := json.NewDecoder(f)
+	dec := json.NewDecoder(bytes.NewReader(data))
 	err = dec.Decode(&gen)
 	return gen, err
 }
+
+// decodeGenesisTOML decodes data straight into a GenesisData holding the default values.
+func decodeGenesisTOML(file string, data []byte) (gen GenesisData, err error) {
+	gen = DefaultGenesis
+	md, err := toml.Decode(string(data), &gen)
+	if err != nil {
+		return gen, fmt.Errorf("%s: %w", file, err)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		fields := make([]string, len(undecoded))
+		for i, key := range undecoded {
+			fields[i] = key.String()
+		}
+		return gen, fmt.Errorf("%s: unknown fields %s", file, strings.Join(fields, ", "))
+	}
+	return gen, nil
+}
+
+func decodeGenesisData(file string, data []byte, parse func(file string, data []byte) (*genesisValue, error)) (gen GenesisData, err error) {
+	gen = DefaultGenesis
+	root, err := parse(file, data)
+	if err != nil {
+		return
+	}
+	err = decodeGenesisInput(file, root, &gen)
+	return gen, err
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrAccountCommitmentDisabled is returned by AccountProof when the ledger doesn't maintain the accounts
+// merkle trie, which is only maintained by nodes tracking catchpoints (see CatchpointTracking and CatchpointInterval).
+var ErrAccountCommitmentDisabled = errors.New("the accounts commitment is not maintained when catchpoints are disabled")
+
+// ErrNoBalanceRecord is returned by AccountProof when the account has no balance record, such as empty accounts.
+var ErrNoBalanceRecord = errors.New("the account has no balance record")
+
+// errAccountCommitmentNotBuilt is returned by AccountProof until the accounts merkle trie has been built.
+var errAccountCommitmentNotBuilt = errors.New("the accounts commitment has not been built yet")
+
+// AccountProof proves that the balance record of an account is committed to by the root of the accounts
+// merkle trie, which the catchpoint labels commit to. The proof holds for the round of the trie, which is
+// the latest round the ledger has written to its database.
+type AccountProof struct {
+	Round   basics.Round
+	Root    crypto.Digest
+	Address basics.Address
+	// EncodedAccountData is the msgpack encoding of the balance record of the account, as hashed into the trie.
+	EncodedAccountData []byte
+	Proof              merkletrie.Proof
+}
+
+// Verify checks the proof against its root, and returns the balance record it proves.
+func (p *AccountProof) Verify() (ledgercore.AccountData, error) {
+	var data trackerdb.BaseAccountData
+	err := protocol.Decode(p.EncodedAccountData, &data)
+	if err != nil {
+		return ledgercore.AccountData{}, err
+	}
+	element := trackerdb.AccountHashBuilderV6(p.Address, &data, p.EncodedAccountData)
+	err = merkletrie.VerifyProof(p.Root, element, &p.Proof)
+	if err != nil {
+		return ledgercore.AccountData{}, err
+	}
+	return data.GetLedgerCoreAccountData(), nil
+}
+
+// accountProof builds the proof of the balance record of an account. The trie used by the tracker is updated
+// by the commits without locking, so the proof is built from another trie loaded from the database, in the
+// same transaction as the balance record.
+func (ct *catchpointTracker) accountProof(addr basics.Address) (AccountProof, error) {
+	if !ct.catchpointEnabled() {
+		return AccountProof{}, ErrAccountCommitmentDisabled
+	}
+
+	proof := AccountProof{Address: addr}
+	err := ct.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		ar, err := tx.MakeAccountsReader()
+		if err != nil {
+			return err
+		}
+		proof.Round, err = ar.AccountsHashRound(ctx)
+		if err != nil {
+			return err
+		}
+		if proof.Round == 0 {
+			return errAccountCommitmentNotBuilt
+		}
+
+		aor, err := tx.MakeAccountsOptimizedReader()
+		if err != nil {
+			return err
+		}
+		defer aor.Close()
+		pad, err := aor.LookupAccount(addr)
+		if err != nil {
+			return err
+		}
+		if pad.Ref == nil {
+			return fmt.Errorf("%w: %v at round %d", ErrNoBalanceRecord, addr, proof.Round)
+		}
+
+		mc, err := tx.MakeMerkleCommitter(false)
+		if err != nil {
+			return err
+		}
+		trie, err := merkletrie.MakeTrie(mc, trackerdb.TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+		proof.Root, err = trie.RootHash()
+		if err != nil {
+			return err
+		}
+		proof.EncodedAccountData = protocol.Encode(&pad.AccountData)
+		p, err := trie.Prove(trackerdb.AccountHashBuilderV6(addr, &pad.AccountData, proof.EncodedAccountData))
+		if err != nil {
+			return fmt.Errorf("unable to prove the balance record of %v: %w", addr, err)
+		}
+		proof.Proof = *p
+		return nil
+	})
+	if err != nil {
+		return AccountProof{}, err
+	}
+	return proof, nil
+}
+
+// AccountProof returns a proof that the balance record of the account is committed to by the root of the
+// accounts merkle trie at the latest round written to the database.
+func (l *Ledger) AccountProof(addr basics.Address) (AccountProof, error) {
+	return l.catchpoint.accountProof(addr)
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAccountProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.CatchpointTracking = 1
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	for i := 1; i <= 5; i++ {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   addrs[0],
+			Receiver: addrs[1],
+			Amount:   uint64(1000 * i),
+		})
+		endBlock(t, l, eval)
+	}
+	commitRoundLookback(0, l)
+
+	proof, err := l.AccountProof(addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, l.Latest(), proof.Round)
+	root, err := l.catchpoint.balancesTrie.RootHash()
+	require.NoError(t, err)
+	require.Equal(t, root, proof.Root)
+
+	data, err := proof.Verify()
+	require.NoError(t, err)
+	expected, _, err := l.LookupWithoutRewards(proof.Round, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+
+	// the proof doesn't hold for another account, nor another balance.
+	other := proof
+	other.Address = addrs[2]
+	_, err = other.Verify()
+	require.ErrorIs(t, err, merkletrie.ErrProofMismatch)
+
+	var base trackerdb.BaseAccountData
+	require.NoError(t, protocol.Decode(proof.EncodedAccountData, &base))
+	base.MicroAlgos.Raw++
+	other = proof
+	other.EncodedAccountData = protocol.Encode(&base)
+	_, err = other.Verify()
+	require.ErrorIs(t, err, merkletrie.ErrProofMismatch)
+
+	var empty basics.Address
+	empty[0] = 1
+	_, err = l.AccountProof(empty)
+	require.ErrorIs(t, err, ErrNoBalanceRecord)
+}
+
+func TestAccountProofDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointInterval = 0
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	_, err := l.AccountProof(addrs[0])
+	require.ErrorIs(t, err, ErrAccountCommitmentDisabled)
+}
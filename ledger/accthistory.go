@@ -0,0 +1,332 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ErrAccountHistoryDisabled is returned when looking up the history of an account
+// on a ledger which does not keep the account history.
+var ErrAccountHistoryDisabled = errors.New("the ledger does not keep the account history, see AccountHistoryRounds")
+
+// errAccountHistoryBehind is returned when looking up the history at a round which was committed
+// by the accountUpdates tracker, but not yet by the account history tracker.
+var errAccountHistoryBehind = errors.New("the account history is behind the committed round")
+
+var accountHistorySchema = []string{
+	`CREATE TABLE IF NOT EXISTS accounthistory (
+		address blob,
+		rnd integer,
+		data blob,
+		PRIMARY KEY (address, rnd))`,
+	`CREATE INDEX IF NOT EXISTS accounthistory_rnd_idx ON accounthistory (rnd)`,
+	`CREATE TABLE IF NOT EXISTS accounthistoryround (
+		id integer primary key,
+		startrnd integer,
+		rnd integer)`,
+}
+
+// accountHistoryTracker keeps the state of the accounts modified in every committed round
+// within the configured retention, so that accounts can be looked up at rounds which are
+// older than the deltas kept in memory by the accountUpdates tracker.
+//
+// Every row holds the state of an account after a round. The first row of an account holds
+// its state before its first modification since the history started, which makes it valid
+// back to the start of the history. Accounts without rows were not modified since the history
+// started, so their state is the one of the latest committed round.
+type accountHistoryTracker struct {
+	// dbs is the account history database, it is not opened when the history is disabled.
+	dbs db.Pair
+	// retention is the number of committed rounds the history is kept for.
+	retention basics.Round
+
+	log logging.Logger
+
+	// mu protects deltas.
+	mu deadlock.RWMutex
+
+	// dbMu serializes the history lookups with its writes, and protects startRound and dbRound.
+	dbMu deadlock.RWMutex
+
+	// deltas holds the account deltas of the rounds following dbRound.
+	deltas []ledgercore.AccountDeltas
+
+	// startRound is the earliest round the history can look accounts up at.
+	startRound basics.Round
+
+	// dbRound is the round up to which the history was committed.
+	dbRound basics.Round
+}
+
+// openAccountHistoryDB opens the account history database, next to the block database.
+func openAccountHistoryDB(dbPrefixes DirsAndPrefix, dbMem bool, log logging.Logger) (db.Pair, error) {
+	historyDBPrefix := filepath.Join(dbPrefixes.ResolvedGenesisDirs.ColdGenesisDir, dbPrefixes.DBFilePrefix)
+	dbs, err := db.OpenPair(historyDBPrefix+".history.sqlite", dbMem)
+	if err != nil {
+		return db.Pair{}, err
+	}
+	dbs.Rdb.SetLogger(log)
+	dbs.Wdb.SetLogger(log)
+	return dbs, nil
+}
+
+func (ah *accountHistoryTracker) initialize(cfg config.Local, dbs db.Pair) {
+	ah.dbs = dbs
+	ah.retention = basics.Round(cfg.AccountHistoryRounds)
+}
+
+func (ah *accountHistoryTracker) enabled() bool {
+	return ah.retention > 0 && ah.dbs.Wdb.Handle != nil
+}
+
+func (ah *accountHistoryTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	ah.dbMu.Lock()
+	defer ah.dbMu.Unlock()
+	ah.mu.Lock()
+	defer ah.mu.Unlock()
+
+	ah.log = l.trackerLog()
+	ah.deltas = nil
+	ah.dbRound = dbRound
+	ah.startRound = dbRound
+	if !ah.enabled() {
+		return nil
+	}
+
+	return ah.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range accountHistorySchema {
+			_, err := tx.Exec(stmt)
+			if err != nil {
+				return err
+			}
+		}
+
+		var startRound, historyRound basics.Round
+		err := tx.QueryRow("SELECT startrnd, rnd FROM accounthistoryround WHERE id=1").Scan(&startRound, &historyRound)
+		switch {
+		case err == sql.ErrNoRows:
+		case err != nil:
+			return err
+		case historyRound == dbRound:
+			ah.startRound = startRound
+			return nil
+		default:
+			// the history missed some rounds, for example because of a fast catchup or of a crash
+			// before the history was written, so it can only be kept from now on.
+			ah.log.Warnf("accountHistoryTracker: history round %d does not match the accounts round %d, restarting the history", historyRound, dbRound)
+		}
+		_, err = tx.Exec("DELETE FROM accounthistory")
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT OR REPLACE INTO accounthistoryround (id, startrnd, rnd) VALUES (1, ?, ?)", dbRound, dbRound)
+		return err
+	})
+}
+
+func (ah *accountHistoryTracker) close() {
+}
+
+func (ah *accountHistoryTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !ah.enabled() {
+		return
+	}
+	ah.mu.Lock()
+	defer ah.mu.Unlock()
+	ah.deltas = append(ah.deltas, delta.Accts)
+}
+
+func (ah *accountHistoryTracker) committedUpTo(committedRnd basics.Round) (retRound, lookback basics.Round) {
+	return committedRnd, basics.Round(0)
+}
+
+func (ah *accountHistoryTracker) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (ah *accountHistoryTracker) prepareCommit(dcc *deferredCommitContext) error {
+	if !ah.enabled() {
+		return nil
+	}
+	ah.dbMu.RLock()
+	defer ah.dbMu.RUnlock()
+	ah.mu.RLock()
+	defer ah.mu.RUnlock()
+	if dcc.oldBase != ah.dbRound || dcc.offset > uint64(len(ah.deltas)) {
+		return fmt.Errorf("accountHistoryTracker: unable to commit rounds (%d-%d), history is at round %d with %d deltas", dcc.oldBase, dcc.newBase(), ah.dbRound, len(ah.deltas))
+	}
+	dcc.accountHistoryDeltas = ah.deltas[:dcc.offset]
+	return nil
+}
+
+func (ah *accountHistoryTracker) commitRound(context.Context, trackerdb.TransactionScope, *deferredCommitContext) error {
+	return nil
+}
+
+// postCommit writes the history of the committed rounds. It relies on the accountUpdates tracker
+// having loaded the state of the modified accounts before the commit into dcc.compactAccountDeltas.
+// The history is not part of the tracker database transaction: if it can't be written, it is
+// restarted from the committed round rather than failing the commit.
+func (ah *accountHistoryTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+	if !ah.enabled() {
+		return
+	}
+	// hold the lock while writing, so that lookups never see rows pruned past the start of the history.
+	ah.dbMu.Lock()
+	defer ah.dbMu.Unlock()
+
+	newBase := dcc.newBase()
+	startRound := ah.startRound
+	if newBase > ah.retention && newBase-ah.retention > startRound {
+		startRound = newBase - ah.retention
+	}
+
+	err := ah.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return ah.writeHistory(tx, dcc, startRound)
+	})
+	if err != nil {
+		ah.log.Warnf("accountHistoryTracker: unable to write the history of rounds (%d-%d), restarting the history : %v", dcc.oldBase, newBase, err)
+		startRound = newBase
+		err = ah.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			_, err0 := tx.Exec("DELETE FROM accounthistory")
+			if err0 != nil {
+				return err0
+			}
+			_, err0 = tx.Exec("UPDATE accounthistoryround SET startrnd=?, rnd=? WHERE id=1", newBase, newBase)
+			return err0
+		})
+		if err != nil {
+			// loadFromDisk would restart the history on the next reload, since its round is behind.
+			ah.log.Warnf("accountHistoryTracker: unable to restart the history at round %d : %v", newBase, err)
+		}
+	}
+
+	ah.mu.Lock()
+	ah.deltas = ah.deltas[dcc.offset:]
+	ah.mu.Unlock()
+	ah.dbRound = newBase
+	ah.startRound = startRound
+}
+
+func (ah *accountHistoryTracker) writeHistory(tx *sql.Tx, dcc *deferredCommitContext, startRound basics.Round) error {
+	insertStmt, err := tx.Prepare("INSERT OR REPLACE INTO accounthistory (address, rnd, data) VALUES (?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertStmt.Close()
+
+	// record the state before the commit of the accounts modified for the first time.
+	for i := 0; i < dcc.compactAccountDeltas.len(); i++ {
+		delta := dcc.compactAccountDeltas.getByIdx(i)
+		var exists int
+		err = tx.QueryRow("SELECT 1 FROM accounthistory WHERE address=? LIMIT 1", delta.address[:]).Scan(&exists)
+		if err == nil {
+			continue
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+		_, err = insertStmt.Exec(delta.address[:], dcc.oldBase, protocol.Encode(&delta.oldAcct.AccountData))
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, deltas := range dcc.accountHistoryDeltas {
+		rnd := dcc.oldBase + basics.Round(i+1)
+		for j := 0; j < deltas.Len(); j++ {
+			addr, acctData := deltas.GetByIdx(j)
+			var data trackerdb.BaseAccountData
+			data.SetCoreAccountData(&acctData)
+			_, err = insertStmt.Exec(addr[:], rnd, protocol.Encode(&data))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// drop the rows which are superseded by a later row at or before the start of the history.
+	_, err = tx.Exec(`DELETE FROM accounthistory WHERE rnd < ? AND rnd < (
+		SELECT MAX(h.rnd) FROM accounthistory h WHERE h.address = accounthistory.address AND h.rnd <= ?)`, startRound, startRound)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE accounthistoryround SET startrnd=?, rnd=? WHERE id=1", startRound, dcc.newBase())
+	return err
+}
+
+// lookup returns the state of an account after the given round, which must be committed.
+// If the account was not modified between rnd and the round up to which the history was
+// committed, found is false and the account state has to be looked up at unchangedThrough.
+func (ah *accountHistoryTracker) lookup(rnd basics.Round, addr basics.Address) (data ledgercore.AccountData, found bool, unchangedThrough basics.Round, err error) {
+	if !ah.enabled() {
+		return ledgercore.AccountData{}, false, 0, ErrAccountHistoryDisabled
+	}
+	ah.dbMu.RLock()
+	defer ah.dbMu.RUnlock()
+	if rnd < ah.startRound {
+		return ledgercore.AccountData{}, false, 0, &RoundOffsetError{round: rnd, dbRound: ah.startRound}
+	}
+	if rnd > ah.dbRound {
+		return ledgercore.AccountData{}, false, 0, errAccountHistoryBehind
+	}
+
+	var buf []byte
+	err = ah.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err0 := tx.QueryRow("SELECT data FROM accounthistory WHERE address=? AND rnd<=? ORDER BY rnd DESC LIMIT 1", addr[:], rnd).Scan(&buf)
+		if err0 != sql.ErrNoRows {
+			return err0
+		}
+		// the first row of the account holds its state since the start of the history
+		err0 = tx.QueryRow("SELECT data FROM accounthistory WHERE address=? ORDER BY rnd ASC LIMIT 1", addr[:]).Scan(&buf)
+		if err0 == sql.ErrNoRows {
+			buf = nil
+			return nil
+		}
+		return err0
+	})
+	if err != nil {
+		return ledgercore.AccountData{}, false, 0, err
+	}
+	if buf == nil {
+		return ledgercore.AccountData{}, false, ah.dbRound, nil
+	}
+
+	var persisted trackerdb.BaseAccountData
+	err = protocol.Decode(buf, &persisted)
+	if err != nil {
+		return ledgercore.AccountData{}, false, 0, err
+	}
+	return persisted.GetLedgerCoreAccountData(), true, 0, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestAccountHistoryLookup checks that the balances of accounts can be looked up at any round
+// within the history retention, including rounds older than the in-memory deltas.
+func TestAccountHistoryLookup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.AccountHistoryRounds = 10
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	microsWithoutRewards := func(addr basics.Address) uint64 {
+		_, _, withoutRewards, err := l.LookupLatest(addr)
+		require.NoError(t, err)
+		return withoutRewards.Raw
+	}
+
+	// addrs[1] receives a payment every round, addrs[2] is never modified
+	received := map[basics.Round]uint64{0: microsWithoutRewards(addrs[1])}
+	untouched := microsWithoutRewards(addrs[2])
+	for i := 1; i <= 20; i++ {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   addrs[0],
+			Receiver: addrs[1],
+			Amount:   uint64(1000 * i),
+		})
+		endBlock(t, l, eval)
+		received[l.Latest()] = microsWithoutRewards(addrs[1])
+		if i%3 == 0 {
+			commitRoundLookback(0, l)
+		}
+	}
+	commitRoundLookback(0, l)
+
+	historyRound := l.acctsHistory.dbRound
+	require.Greater(t, historyRound, basics.Round(10))
+	require.Less(t, l.acctsHistory.startRound, historyRound)
+
+	for rnd := l.Latest(); rnd >= l.acctsHistory.startRound; rnd-- {
+		data, withoutRewards, err := l.LookupAccountHistory(rnd, addrs[1])
+		require.NoError(t, err, "round %d", rnd)
+		require.Equal(t, received[rnd], withoutRewards.Raw, "round %d", rnd)
+		require.GreaterOrEqual(t, data.MicroAlgos.Raw, withoutRewards.Raw)
+
+		_, withoutRewards, err = l.LookupAccountHistory(rnd, addrs[2])
+		require.NoError(t, err, "round %d", rnd)
+		require.Equal(t, untouched, withoutRewards.Raw, "round %d", rnd)
+		if rnd == 0 {
+			break
+		}
+	}
+
+	if start := l.acctsHistory.startRound; start > 0 {
+		_, _, err := l.LookupAccountHistory(start-1, addrs[1])
+		var roundOffsetError *RoundOffsetError
+		require.True(t, errors.As(err, &roundOffsetError))
+	}
+}
+
+// TestAccountHistoryDisabled checks that rounds older than the in-memory deltas can't be looked up
+// without the account history.
+func TestAccountHistoryDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	for i := 0; i < 5; i++ {
+		eval := nextBlock(t, l)
+		endBlock(t, l, eval)
+	}
+	commitRoundLookback(0, l)
+
+	_, _, err := l.LookupAccountHistory(l.Latest(), addrs[1])
+	require.NoError(t, err)
+	_, _, err = l.LookupAccountHistory(1, addrs[1])
+	require.ErrorIs(t, err, ErrAccountHistoryDisabled)
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"sort"
 	"strings"
 	"sync"
@@ -46,7 +47,7 @@ const (
 	pendingDeltasFlushThreshold = 128
 )
 
-// baseAccountsPendingAccountsBufferSize defines the size of the base account pending accounts buffer size.
+// baseAccountsPendingAccountsBufferSize defines the default size of the base account pending accounts buffer size.
 // At the beginning of a new round, the entries from this buffer are being flushed into the base accounts map.
 const baseAccountsPendingAccountsBufferSize = 100000
 
@@ -55,6 +56,13 @@ const baseAccountsPendingAccountsBufferSize = 100000
 // is being flushed into the main base account cache.
 const baseAccountsPendingAccountsWarnThreshold = 85000
 
+// minBaseAccountsCacheSize and maxBaseAccountsCacheSize are the bounds of LedgerAccountsCacheSize, which overrides
+// baseAccountsPendingAccountsBufferSize.
+const (
+	minBaseAccountsCacheSize = 1000
+	maxBaseAccountsCacheSize = 10000000
+)
+
 // baseResourcesPendingAccountsBufferSize defines the size of the base resources pending accounts buffer size.
 // At the beginning of a new round, the entries from this buffer are being flushed into the base resources map.
 const baseResourcesPendingAccountsBufferSize = 10000
@@ -225,6 +233,11 @@ type accountUpdates struct {
 
 	// disableCache (de)activates the LRU cache use in accountUpdates
 	disableCache bool
+
+	// baseAccountsCacheSize is the number of accounts kept in baseAccounts on top of the modified ones,
+	// and baseAccountsCacheWarnThreshold the pending accounts count above which baseAccounts warns.
+	baseAccountsCacheSize          int
+	baseAccountsCacheWarnThreshold int
 }
 
 // RoundOffsetError is an error for when requested round is behind earliest stored db entry
@@ -263,26 +276,63 @@ func (e *MismatchingDatabaseRoundError) Error() string {
 // ErrLookupLatestResources is returned if there is an error retrieving an account along with its resources.
 var ErrLookupLatestResources = errors.New("couldn't find latest resources")
 
+// resourcesUpdates tracks the resources modified in the in-memory deltas. The entries are
+// indexed by address first, so that looking up the resources of a single account does not
+// require scanning the modified resources of every other account; accounts holding a very
+// large number of assets would otherwise make each lookup proportional to the total size
+// of the deltas.
+//
 //msgp:ignore resourcesUpdates
-type resourcesUpdates map[accountCreatable]modifiedResource
+type resourcesUpdates struct {
+	byAddress map[basics.Address]map[basics.CreatableIndex]modifiedResource
+	count     int
+}
 
-func (r resourcesUpdates) set(ac accountCreatable, m modifiedResource) { r[ac] = m }
+func makeResourcesUpdates() resourcesUpdates {
+	return resourcesUpdates{byAddress: make(map[basics.Address]map[basics.CreatableIndex]modifiedResource)}
+}
 
-func (r resourcesUpdates) get(ac accountCreatable) (m modifiedResource, ok bool) {
-	m, ok = r[ac]
-	return
+func (r *resourcesUpdates) set(ac accountCreatable, m modifiedResource) {
+	resources, ok := r.byAddress[ac.address]
+	if !ok {
+		resources = make(map[basics.CreatableIndex]modifiedResource)
+		r.byAddress[ac.address] = resources
+	}
+	if _, has := resources[ac.index]; !has {
+		r.count++
+	}
+	resources[ac.index] = m
 }
 
-func (r resourcesUpdates) getForAddress(addr basics.Address) map[basics.CreatableIndex]modifiedResource {
-	res := make(map[basics.CreatableIndex]modifiedResource)
+func (r *resourcesUpdates) get(ac accountCreatable) (m modifiedResource, ok bool) {
+	m, ok = r.byAddress[ac.address][ac.index]
+	return
+}
 
-	for k, v := range r {
-		if k.address == addr {
-			res[k.index] = v
-		}
+func (r *resourcesUpdates) delete(ac accountCreatable) {
+	resources, ok := r.byAddress[ac.address]
+	if !ok {
+		return
 	}
+	if _, has := resources[ac.index]; !has {
+		return
+	}
+	delete(resources, ac.index)
+	r.count--
+	if len(resources) == 0 {
+		delete(r.byAddress, ac.address)
+	}
+}
 
-	return res
+// getForAddress returns the modified resources of the given address. The returned map
+// is owned by resourcesUpdates and must not be modified by the caller.
+func (r *resourcesUpdates) getForAddress(addr basics.Address) map[basics.CreatableIndex]modifiedResource {
+	return r.byAddress[addr]
+}
+
+// len returns the total number of modified resources, across all the addresses.
+func (r *resourcesUpdates) len() int {
+	return r.count
 }
 
 // initialize initializes the accountUpdates structure
@@ -296,6 +346,14 @@ func (au *accountUpdates) initialize(cfg config.Local) {
 	au.logAccountUpdatesInterval = cfg.AccountUpdatesStatsInterval
 
 	au.disableCache = cfg.DisableLedgerLRUCache
+
+	au.baseAccountsCacheSize = baseAccountsPendingAccountsBufferSize
+	au.baseAccountsCacheWarnThreshold = baseAccountsPendingAccountsWarnThreshold
+	if cfg.LedgerAccountsCacheSize > 0 {
+		au.baseAccountsCacheSize = cfg.LedgerAccountsCacheSize
+		// warn at the same ratio of the cache size as the default threshold does
+		au.baseAccountsCacheWarnThreshold = baseAccountsPendingAccountsWarnThreshold * cfg.LedgerAccountsCacheSize / baseAccountsPendingAccountsBufferSize
+	}
 }
 
 // loadFromDisk is the 2nd level initialization, and is required before the accountUpdates becomes functional
@@ -585,6 +643,93 @@ func (au *accountUpdates) lookupKeysByPrefix(round basics.Round, keyPrefix strin
 	}
 }
 
+// LookupKvsByPrefix returns, in key order, up to maxKeyNum keys with the given prefix which sort after the
+// `after` key, along with their values, as of the given round.
+func (au *accountUpdates) LookupKvsByPrefix(round basics.Round, keyPrefix string, after string, maxKeyNum uint64) ([]ledgercore.KvPair, error) {
+	return au.lookupKvsByPrefix(round, keyPrefix, after, maxKeyNum)
+}
+
+func (au *accountUpdates) lookupKvsByPrefix(round basics.Round, keyPrefix string, after string, maxKeyNum uint64) (kvs []ledgercore.KvPair, err error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	for {
+		currentDBRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		offset, rndErr := au.roundOffset(round)
+		if rndErr != nil {
+			return nil, rndErr
+		}
+
+		// the latest modification of every matching key in the deltas up to the round, deletions having a nil value
+		mods := make(map[string][]byte)
+		var deleted uint64
+		for offset > 0 {
+			offset--
+			for key, mv := range au.deltas[offset].KvMods {
+				if key <= after || !strings.HasPrefix(key, keyPrefix) {
+					continue
+				}
+				if _, ok := mods[key]; ok {
+					continue
+				}
+				mods[key] = mv.Data
+				if mv.Data == nil {
+					deleted++
+				}
+			}
+		}
+
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		// every key deleted in the deltas may hide one of the keys read from the database, so read as many
+		// more keys to still have maxKeyNum of them.
+		dbMaxKeyNum := maxKeyNum + deleted
+		if dbMaxKeyNum < maxKeyNum {
+			dbMaxKeyNum = math.MaxUint64
+		}
+		dbKvs, dbRound, dbErr := au.accountsq.LookupKeyValuesByPrefix(keyPrefix, after, dbMaxKeyNum)
+		if dbErr != nil {
+			return nil, dbErr
+		}
+		if dbRound == currentDBRound {
+			kvs = make([]ledgercore.KvPair, 0, len(dbKvs)+len(mods))
+			for _, kv := range dbKvs {
+				if _, ok := mods[kv.Key]; !ok {
+					kvs = append(kvs, kv)
+				}
+			}
+			for key, value := range mods {
+				if value != nil {
+					kvs = append(kvs, ledgercore.KvPair{Key: key, Value: value})
+				}
+			}
+			sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+			if uint64(len(kvs)) > maxKeyNum {
+				kvs = kvs[:maxKeyNum]
+			}
+			return kvs, nil
+		}
+
+		if dbRound < currentDBRound {
+			au.log.Errorf("accountUpdates.lookupKvsByPrefix: database round %d is behind in-memory round %d", dbRound, currentDBRound)
+			return nil, &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDBRound}
+		}
+		// the database was written to in the meantime, wait for the in-memory state to catch up and start over.
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDBRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
 // LookupWithoutRewards returns the account data for a given address at a given round.
 func (au *accountUpdates) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (data ledgercore.AccountData, validThrough basics.Round, err error) {
 	data, validThrough, _, _, err = au.lookupWithoutRewards(rnd, addr, true /* take lock*/)
@@ -784,13 +929,13 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 	au.versions = []protocol.ConsensusVersion{hdr.CurrentProtocol}
 	au.deltas = nil
 	au.accounts = make(map[basics.Address]modifiedAccount)
-	au.resources = make(resourcesUpdates)
+	au.resources = makeResourcesUpdates()
 	au.kvStore = make(map[string]modifiedKvValue)
 	au.creatables = make(map[basics.CreatableIndex]ledgercore.ModifiedCreatable)
 	au.deltasAccum = []int{0}
 
 	if !au.disableCache {
-		au.baseAccounts.init(au.log, baseAccountsPendingAccountsBufferSize, baseAccountsPendingAccountsWarnThreshold)
+		au.baseAccounts.init(au.log, au.baseAccountsCacheSize, au.baseAccountsCacheWarnThreshold)
 		au.baseResources.init(au.log, baseResourcesPendingAccountsBufferSize, baseResourcesPendingAccountsWarnThreshold)
 		au.baseKVs.init(au.log, baseKVPendingBufferSize, baseKVPendingWarnThreshold)
 	} else {
@@ -872,9 +1017,9 @@ func (au *accountUpdates) newBlockImpl(blk bookkeeping.Block, delta ledgercore.S
 	au.roundTotals = append(au.roundTotals, delta.Totals)
 
 	// calling prune would drop old entries from the base accounts.
-	newBaseAccountSize := (len(au.accounts) + 1) + baseAccountsPendingAccountsBufferSize
+	newBaseAccountSize := (len(au.accounts) + 1) + au.baseAccountsCacheSize
 	au.baseAccounts.prune(newBaseAccountSize)
-	newBaseResourcesSize := (len(au.resources) + 1) + baseResourcesPendingAccountsBufferSize
+	newBaseResourcesSize := (au.resources.len() + 1) + baseResourcesPendingAccountsBufferSize
 	au.baseResources.prune(newBaseResourcesSize)
 	newBaseKVSize := (len(au.kvStore) + 1) + baseKVPendingBufferSize
 	au.baseKVs.prune(newBaseKVSize)
@@ -1212,53 +1357,181 @@ func (au *accountUpdates) lookupResource(rnd basics.Round, addr basics.Address,
 	}
 }
 
-// lookupAllResources returns all the resources for a given address, solely based on what is persisted to disk. It does not
-// take into account any in-memory deltas; the round number returned is the latest round number that is known to the database.
+// lookupAssetResources returns up to limit asset holdings of the given address with an index greater than
+// assetIDGT, ordered by asset index. The holdings persisted to disk are paged through in index order, and
+// merged with the holdings opted into, modified or opted out of in the in-memory deltas, so the holdings
+// and the round returned are those of the latest round known to the deltas.
 func (au *accountUpdates) lookupAssetResources(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64) (data []ledgercore.AssetResourceWithIDs, validThrough basics.Round, err error) {
-	// Look for resources on disk
-	persistedResources, resourceDbRound, err0 := au.accountsq.LookupLimitedResources(addr, basics.CreatableIndex(assetIDGT), limit, basics.AssetCreatable)
-	if err0 != nil {
-		return nil, basics.Round(0), err0
+	for {
+		au.accountsMu.RLock()
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		// the resources of the address modified in the deltas take precedence over the persisted ones;
+		// the address index of au.resources keeps this proportional to the changes of this address only.
+		modified := make(map[basics.AssetIndex]ledgercore.AccountResource)
+		for cidx, mres := range au.resources.getForAddress(addr) {
+			if aidx := basics.AssetIndex(cidx); aidx > assetIDGT {
+				modified[aidx] = mres.resource
+			}
+		}
+		au.accountsMu.RUnlock()
+
+		var dbRound basics.Round
+		data, dbRound, err = au.lookupPersistedAssetResources(addr, assetIDGT, limit, modified)
+		if err != nil {
+			return nil, basics.Round(0), err
+		}
+
+		au.accountsMu.RLock()
+		if (dbRound == 0 || dbRound == currentDbRound) && currentDbRound == au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.applyModifiedAssetCreators(data)
+			au.accountsMu.RUnlock()
+			return data, currentDbRound + basics.Round(currentDeltaLen), nil
+		}
+		if dbRound != 0 && dbRound < currentDbRound {
+			au.accountsMu.RUnlock()
+			au.log.Errorf("accountUpdates.lookupAssetResources: database round %d is behind in-memory round %d", dbRound, currentDbRound)
+			return nil, basics.Round(0), &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+		}
+		// a new block was added, or the deltas were committed, while the holdings were being read.
+		// wait for the in-memory state to catch up with the database and try again.
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+		au.accountsMu.RUnlock()
 	}
+}
 
-	data = make([]ledgercore.AssetResourceWithIDs, 0, len(persistedResources))
-	for _, pd := range persistedResources {
-		ah := pd.Data.GetAssetHolding()
+// lookupPersistedAssetResources pages through the asset holdings persisted to disk, merging in the modified
+// resources of the address, until limit holdings are collected or the persisted holdings are exhausted.
+// The creator and params of the holdings which only appear in the modified resources are read from disk as
+// well. The returned round is the highest database round seen, or 0 if no rows were read from the database.
+func (au *accountUpdates) lookupPersistedAssetResources(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64, modified map[basics.AssetIndex]ledgercore.AccountResource) (data []ledgercore.AssetResourceWithIDs, dbRound basics.Round, err error) {
+	// the holdings present in the modified resources, ordered by asset index
+	held := make([]basics.AssetIndex, 0, len(modified))
+	for aidx, res := range modified {
+		if res.AssetHolding != nil {
+			held = append(held, aidx)
+		}
+	}
+	sort.Slice(held, func(i, j int) bool { return held[i] < held[j] })
 
-		var arwi ledgercore.AssetResourceWithIDs
-		if !pd.Creator.IsZero() {
-			ap := pd.Data.GetAssetParams()
+	cursor := assetIDGT
+	for uint64(len(data)) < limit {
+		persistedResources, rnd, err0 := au.accountsq.LookupLimitedResources(addr, basics.CreatableIndex(cursor), limit, basics.AssetCreatable)
+		if err0 != nil {
+			return nil, 0, err0
+		}
+		dbRound = max(dbRound, rnd)
 
-			arwi = ledgercore.AssetResourceWithIDs{
-				AssetID: basics.AssetIndex(pd.Aidx),
-				Creator: pd.Creator,
+		// the persisted holdings are complete up to the last one read, unless all of them were read
+		exhausted := uint64(len(persistedResources)) < limit
+		upper := basics.AssetIndex(math.MaxUint64)
+		if !exhausted {
+			upper = basics.AssetIndex(persistedResources[len(persistedResources)-1].Aidx)
+		}
 
-				AssetResource: ledgercore.AssetResource{
-					AssetHolding: &ah,
-					AssetParams:  &ap,
-				},
+		page := make([]ledgercore.AssetResourceWithIDs, 0, len(persistedResources))
+		for _, pd := range persistedResources {
+			if _, ok := modified[basics.AssetIndex(pd.Aidx)]; ok {
+				// opted out of, or modified in the deltas
+				continue
 			}
-		} else {
-			arwi = ledgercore.AssetResourceWithIDs{
-				AssetID: basics.AssetIndex(pd.Aidx),
-
-				AssetResource: ledgercore.AssetResource{
-					AssetHolding: &ah,
-				},
+			page = append(page, persistedAssetResource(pd))
+		}
+		for len(held) > 0 && held[0] <= upper {
+			aidx := held[0]
+			held = held[1:]
+			res := modified[aidx]
+			arwi := ledgercore.AssetResourceWithIDs{
+				AssetID:       aidx,
+				AssetResource: ledgercore.AssetResource{AssetHolding: res.AssetHolding, AssetParams: res.AssetParams},
+			}
+			if res.AssetParams != nil {
+				arwi.Creator = addr
+			} else {
+				rnd, err0 = au.lookupPersistedAssetCreator(&arwi)
+				if err0 != nil {
+					return nil, 0, err0
+				}
+				dbRound = max(dbRound, rnd)
 			}
+			page = append(page, arwi)
 		}
+		sort.Slice(page, func(i, j int) bool { return page[i].AssetID < page[j].AssetID })
+		data = append(data, page...)
 
-		data = append(data, arwi)
+		if exhausted {
+			break
+		}
+		cursor = upper
 	}
-	// We've found all the resources we could find for this address.
-	currentDbRound := resourceDbRound
-	// The resourceDbRound will not be set if there are no persisted resources
-	if len(data) == 0 {
-		au.accountsMu.RLock()
-		currentDbRound = au.cachedDBRound
-		au.accountsMu.RUnlock()
+	if uint64(len(data)) > limit {
+		data = data[:limit]
+	}
+	return data, dbRound, nil
+}
+
+// lookupPersistedAssetCreator sets the creator and the params of the asset of the holding, as persisted to disk.
+func (au *accountUpdates) lookupPersistedAssetCreator(arwi *ledgercore.AssetResourceWithIDs) (dbRound basics.Round, err error) {
+	cidx := basics.CreatableIndex(arwi.AssetID)
+	creator, ok, dbRound, err := au.accountsq.LookupCreator(cidx, basics.AssetCreatable)
+	if err != nil || !ok {
+		return dbRound, err
+	}
+	persistedData, err := au.accountsq.LookupResources(creator, cidx, basics.AssetCreatable)
+	if err != nil {
+		return dbRound, err
+	}
+	if persistedData.AcctRef != nil && persistedData.Data.IsOwning() {
+		ap := persistedData.Data.GetAssetParams()
+		arwi.Creator = creator
+		arwi.AssetParams = &ap
+	}
+	return max(dbRound, persistedData.Round), nil
+}
+
+// applyModifiedAssetCreators updates the creator and params of the holdings with the assets created,
+// destroyed or reconfigured in the deltas. The caller must hold accountsMu.
+func (au *accountUpdates) applyModifiedAssetCreators(data []ledgercore.AssetResourceWithIDs) {
+	for i := range data {
+		cidx := basics.CreatableIndex(data[i].AssetID)
+		if mcreat, ok := au.creatables[cidx]; ok {
+			if !mcreat.Created {
+				data[i].Creator = basics.Address{}
+				data[i].AssetParams = nil
+				continue
+			}
+			data[i].Creator = mcreat.Creator
+		}
+		if data[i].Creator.IsZero() {
+			continue
+		}
+		if mres, ok := au.resources.get(accountCreatable{address: data[i].Creator, index: cidx}); ok {
+			data[i].AssetParams = mres.resource.AssetParams
+			if data[i].AssetParams == nil {
+				data[i].Creator = basics.Address{}
+			}
+		}
 	}
-	return data, currentDbRound, nil
+}
+
+// persistedAssetResource converts an asset holding read by LookupLimitedResources, along with the
+// params of its creator when the asset still exists.
+func persistedAssetResource(pd trackerdb.PersistedResourcesDataWithCreator) ledgercore.AssetResourceWithIDs {
+	ah := pd.Data.GetAssetHolding()
+	arwi := ledgercore.AssetResourceWithIDs{
+		AssetID: basics.AssetIndex(pd.Aidx),
+		AssetResource: ledgercore.AssetResource{
+			AssetHolding: &ah,
+		},
+	}
+	if !pd.Creator.IsZero() {
+		ap := pd.Data.GetAssetParams()
+		arwi.Creator = pd.Creator
+		arwi.AssetParams = &ap
+	}
+	return arwi
 }
 
 func (au *accountUpdates) lookupStateDelta(rnd basics.Round) (ledgercore.StateDelta, error) {
@@ -1632,7 +1905,7 @@ func (au *accountUpdates) postCommit(ctx context.Context, dcc *deferredCommitCon
 		resUpdate := dcc.compactResourcesDeltas.getByIdx(i)
 		cnt := resUpdate.nAcctDeltas
 		key := accountCreatable{resUpdate.address, resUpdate.oldResource.Aidx}
-		macct, ok := au.resources[key]
+		macct, ok := au.resources.get(key)
 		if !ok {
 			au.log.Panicf("inconsistency: flushed %d changes to (%s, %d), but not in au.resources", cnt, resUpdate.address, resUpdate.oldResource.Aidx)
 		}
@@ -1640,10 +1913,10 @@ func (au *accountUpdates) postCommit(ctx context.Context, dcc *deferredCommitCon
 		if cnt > macct.ndeltas {
 			au.log.Panicf("inconsistency: flushed %d changes to (%s, %d), but au.resources had %d", cnt, resUpdate.address, resUpdate.oldResource.Aidx, macct.ndeltas)
 		} else if cnt == macct.ndeltas {
-			delete(au.resources, key)
+			au.resources.delete(key)
 		} else {
 			macct.ndeltas -= cnt
-			au.resources[key] = macct
+			au.resources.set(key, macct)
 		}
 	}
 
@@ -1803,6 +2076,33 @@ func (au *accountUpdates) latest() basics.Round {
 }
 
 // the vacuumDatabase performs a full vacuum of the accounts database.
+// enableIncrementalVacuum switches the accounts database to incremental vacuum, unless it already uses it or
+// its storage engine doesn't support it. Like vacuumDatabase, it may only be called on startup.
+func (au *accountUpdates) enableIncrementalVacuum(ctx context.Context) error {
+	enabler, ok := au.dbs.(incrementalVacuumEnabler)
+	if !ok {
+		return nil
+	}
+	pageStats, err := enabler.GetPageStats(ctx)
+	if err != nil || pageStats.IncrementalVacuum {
+		return err
+	}
+
+	// like vacuuming, switching the vacuum mode may modify the tables rowid, so the in-memory ones are flushed.
+	au.baseAccounts.prune(0)
+	au.baseResources.prune(0)
+	au.baseKVs.prune(0)
+
+	au.log.Infof("Enabling incremental vacuuming of the accounts database, which requires vacuuming it")
+	vacuumStats, err := enabler.EnableIncrementalVacuum(ctx)
+	if err != nil {
+		au.log.Warnf("Enabling incremental vacuuming of the accounts database failed : %v", err)
+		return err
+	}
+	au.log.Infof("Enabled incremental vacuuming of the accounts database, reducing number of pages from %d to %d", vacuumStats.PagesBefore, vacuumStats.PagesAfter)
+	return nil
+}
+
 func (au *accountUpdates) vacuumDatabase(ctx context.Context) (err error) {
 	// vaccumming the database would modify the some of the tables rowid, so we need to make sure any stored in-memory
 	// rowid are flushed.
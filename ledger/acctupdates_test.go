@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -37,6 +38,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
@@ -268,8 +270,8 @@ func (ml *mockLedgerForTracker) trackerDB() trackerdb.Store {
 	return ml.dbs
 }
 
-func (ml *mockLedgerForTracker) blockDB() db.Pair {
-	return db.Pair{}
+func (ml *mockLedgerForTracker) blockDB() blockdb.Store {
+	return nil
 }
 
 func (ml *mockLedgerForTracker) trackerLog() logging.Logger {
@@ -450,7 +452,7 @@ func checkAcctUpdates(t *testing.T, au *accountUpdates, ao *onlineAccounts, base
 
 func checkAcctUpdatesConsistency(t *testing.T, au *accountUpdates, rnd basics.Round) {
 	accounts := make(map[basics.Address]modifiedAccount)
-	resources := make(resourcesUpdates)
+	resources := makeResourcesUpdates()
 
 	for _, sdelta := range au.deltas {
 		rdelta := sdelta.Accts
@@ -468,7 +470,7 @@ func checkAcctUpdatesConsistency(t *testing.T, au *accountUpdates, rnd basics.Ro
 			entry.resource.AppLocalState = rec.State.LocalState
 			entry.resource.AppParams = rec.Params.Params
 			entry.ndeltas++
-			resources[key] = entry
+			resources.set(key, entry)
 		}
 		for _, rec := range rdelta.GetAllAssetResources() {
 			key := accountCreatable{rec.Addr, basics.CreatableIndex(rec.Aidx)}
@@ -476,7 +478,7 @@ func checkAcctUpdatesConsistency(t *testing.T, au *accountUpdates, rnd basics.Ro
 			entry.resource.AssetHolding = rec.Holding.Holding
 			entry.resource.AssetParams = rec.Params.Params
 			entry.ndeltas++
-			resources[key] = entry
+			resources.set(key, entry)
 		}
 	}
 
@@ -1054,6 +1056,91 @@ func TestBoxNamesByAppIDs(t *testing.T) {
 	}
 }
 
+func TestKvsByPrefixPages(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const initialBlocksCount = 1
+	accts := make(map[basics.Address]basics.AccountData)
+
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	ml := makeMockLedgerForTracker(t, true, initialBlocksCount, protocol.ConsensusCurrentVersion,
+		[]map[basics.Address]basics.AccountData{accts},
+	)
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	au, _ := newAcctUpdates(t, ml, conf)
+
+	knownCreatables := make(map[basics.CreatableIndex]bool)
+	opts := auNewBlockOpts{ledgercore.AccountDeltas{}, protocol.ConsensusCurrentVersion, protoParams, knownCreatables}
+
+	const appID = 1000
+	boxKey := func(i int) string {
+		return apps.MakeBoxKey(appID, fmt.Sprintf("box-%02d", i))
+	}
+
+	// add a box every round, then delete and update some of them, so that the boxes are partly
+	// in the database and partly in the deltas
+	expected := make(map[basics.Round][]ledgercore.KvPair)
+	boxes := make(map[string][]byte)
+	snapshot := func(rnd basics.Round) {
+		var kvs []ledgercore.KvPair
+		for key, value := range boxes {
+			kvs = append(kvs, ledgercore.KvPair{Key: key, Value: value})
+		}
+		sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+		expected[rnd] = kvs
+	}
+	var currentRound basics.Round
+	for i := 1; i <= 12; i++ {
+		currentRound = basics.Round(i)
+		mods := map[string]ledgercore.KvValueDelta{
+			boxKey(i): {Data: []byte(fmt.Sprintf("value-%d", i))},
+			apps.MakeBoxKey(appID+1, fmt.Sprintf("%d", i)): {Data: []byte("other application")},
+		}
+		boxes[boxKey(i)] = mods[boxKey(i)].Data
+		switch i {
+		case 9:
+			mods[boxKey(3)] = ledgercore.KvValueDelta{}
+			delete(boxes, boxKey(3))
+		case 10:
+			mods[boxKey(5)] = ledgercore.KvValueDelta{Data: []byte("updated")}
+			boxes[boxKey(5)] = []byte("updated")
+			mods[boxKey(10)] = ledgercore.KvValueDelta{}
+			delete(boxes, boxKey(10))
+		}
+		auNewBlock(t, currentRound, au, accts, opts, mods)
+		auCommitSync(t, currentRound, au, ml)
+		snapshot(currentRound)
+	}
+	require.Less(t, au.cachedDBRound, currentRound)
+	require.Greater(t, au.cachedDBRound, basics.Round(0))
+
+	prefix := apps.MakeBoxKey(appID, "")
+	for rnd := au.cachedDBRound; rnd <= currentRound; rnd++ {
+		for _, pageSize := range []uint64{1, 2, 3, 100} {
+			var kvs []ledgercore.KvPair
+			after := ""
+			for {
+				page, err := au.LookupKvsByPrefix(rnd, prefix, after, pageSize)
+				require.NoError(t, err)
+				require.LessOrEqual(t, uint64(len(page)), pageSize)
+				kvs = append(kvs, page...)
+				if uint64(len(page)) < pageSize {
+					break
+				}
+				after = page[len(page)-1].Key
+			}
+			require.Equal(t, expected[rnd], kvs, "round %d page size %d", rnd, pageSize)
+		}
+	}
+
+	_, err := au.LookupKvsByPrefix(au.cachedDBRound-1, prefix, "", 10)
+	var roundOffsetError *RoundOffsetError
+	require.ErrorAs(t, err, &roundOffsetError)
+}
+
 func TestKVCache(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -2569,6 +2656,103 @@ func TestAcctUpdatesLookupResources(t *testing.T) {
 	require.NotContains(t, data.Assets, aidx2)
 }
 
+// TestAcctUpdatesLookupAssetResources checks the paged asset holdings lookup merges the persisted
+// holdings with the ones opted into, modified and opted out of in the in-memory deltas.
+func TestAcctUpdatesLookupAssetResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := setupAccts(1)
+
+	testProtocolVersion := protocol.ConsensusVersion("test-protocol-TestAcctUpdatesLookupAssetResources")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.MaxBalLookback = 2
+	protoParams.SeedLookback = 1
+	protoParams.SeedRefreshInterval = 1
+	config.Consensus[testProtocolVersion] = protoParams
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	ml := makeMockLedgerForTracker(t, true, 1, testProtocolVersion, accts)
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	au, _ := newAcctUpdates(t, ml, conf)
+
+	var addr1 basics.Address
+	for addr := range accts[0] {
+		if addr != testSinkAddr && addr != testPoolAddr {
+			addr1 = addr
+			break
+		}
+	}
+
+	knownCreatables := make(map[basics.CreatableIndex]bool)
+	lastRound := basics.Round(protoParams.MaxBalLookback + 2)
+	for i := basics.Round(1); i <= lastRound; i++ {
+		var updates ledgercore.AccountDeltas
+
+		// persist holdings of 1, 2, 4, 5 and 7
+		if i == 1 {
+			updates.Upsert(addr1, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 1000000}, TotalAssets: 5}})
+			for _, aidx := range []basics.AssetIndex{1, 2, 4, 5, 7} {
+				updates.UpsertAssetResource(addr1, aidx, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Holding: &basics.AssetHolding{Amount: uint64(aidx) * 100}})
+			}
+		}
+		// keep opting into 3 and 6, modifying 4 and opting out of 2 and 5 in memory
+		if i == lastRound {
+			updates.Upsert(addr1, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 1000000}, TotalAssets: 5}})
+			updates.UpsertAssetResource(addr1, 2, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Deleted: true})
+			updates.UpsertAssetResource(addr1, 3, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Holding: &basics.AssetHolding{Amount: 300}})
+			updates.UpsertAssetResource(addr1, 4, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Holding: &basics.AssetHolding{Amount: 444}})
+			updates.UpsertAssetResource(addr1, 5, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Deleted: true})
+			updates.UpsertAssetResource(addr1, 6, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Holding: &basics.AssetHolding{Amount: 600}})
+		}
+
+		base := accts[i-1]
+		newAccts := applyPartialDeltas(base, updates)
+		accts = append(accts, newAccts)
+
+		opts := auNewBlockOpts{updates, testProtocolVersion, protoParams, knownCreatables}
+		auNewBlock(t, i, au, base, opts, nil)
+
+		if i < lastRound {
+			auCommitSync(t, i, au, ml)
+		}
+	}
+
+	expected := []basics.AssetIndex{1, 3, 4, 6, 7}
+	amounts := map[basics.AssetIndex]uint64{1: 100, 3: 300, 4: 444, 6: 600, 7: 700}
+
+	// page through the holdings two at a time
+	var held []basics.AssetIndex
+	next := basics.AssetIndex(0)
+	for {
+		data, rnd, err := au.lookupAssetResources(addr1, next, 2)
+		require.NoError(t, err)
+		require.Equal(t, lastRound, rnd)
+		require.LessOrEqual(t, len(data), 2)
+		for _, arwi := range data {
+			require.NotNil(t, arwi.AssetHolding)
+			require.Equal(t, amounts[arwi.AssetID], arwi.AssetHolding.Amount)
+			held = append(held, arwi.AssetID)
+		}
+		if len(data) < 2 {
+			break
+		}
+		next = data[len(data)-1].AssetID
+	}
+	require.Equal(t, expected, held)
+
+	// a single page holds all of them
+	data, _, err := au.lookupAssetResources(addr1, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, data, len(expected))
+	for i, arwi := range data {
+		require.Equal(t, expected[i], arwi.AssetID)
+	}
+}
+
 // TestAcctUpdatesLookupStateDelta simulates rounds w/ both account and kv changes in them,
 // validating that a StateDelta can be retrieved for expected rounds containing the same updates.
 func TestAcctUpdatesLookupStateDelta(t *testing.T) {
@@ -2727,3 +2911,43 @@ func TestAcctUpdatesLookupStateDelta(t *testing.T) {
 	require.Contains(t, data.Assets, aidx3)
 	require.NotContains(t, data.Assets, aidx2)
 }
+
+// TestResourcesUpdatesAddressIndex checks that resourcesUpdates keeps its per-address index
+// and total count in sync as entries are set and removed.
+func TestResourcesUpdatesAddressIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	r := makeResourcesUpdates()
+	addr1 := ledgertesting.RandomAddress()
+	addr2 := ledgertesting.RandomAddress()
+
+	for i := 1; i <= 10; i++ {
+		r.set(accountCreatable{addr1, basics.CreatableIndex(i)}, modifiedResource{ndeltas: i})
+	}
+	r.set(accountCreatable{addr2, 1}, modifiedResource{ndeltas: 1})
+	// overwriting an entry does not change the count
+	r.set(accountCreatable{addr1, 1}, modifiedResource{ndeltas: 2})
+	require.Equal(t, 11, r.len())
+	require.Len(t, r.getForAddress(addr1), 10)
+	require.Len(t, r.getForAddress(addr2), 1)
+	require.Empty(t, r.getForAddress(ledgertesting.RandomAddress()))
+
+	mr, ok := r.get(accountCreatable{addr1, 1})
+	require.True(t, ok)
+	require.Equal(t, 2, mr.ndeltas)
+
+	r.delete(accountCreatable{addr2, 1})
+	// deleting a missing entry is a no-op
+	r.delete(accountCreatable{addr2, 1})
+	require.Equal(t, 10, r.len())
+	require.NotContains(t, r.byAddress, addr2)
+
+	for i := 1; i <= 10; i++ {
+		r.delete(accountCreatable{addr1, basics.CreatableIndex(i)})
+	}
+	require.Zero(t, r.len())
+	require.Empty(t, r.byAddress)
+	_, ok = r.get(accountCreatable{addr1, 1})
+	require.False(t, ok)
+}
@@ -19,7 +19,6 @@ package ledger
 import (
 	"context"
 	"crypto/rand"
-	"database/sql"
 	"fmt"
 	mathrand "math/rand"
 	"path/filepath"
@@ -44,7 +43,6 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
-	"github.com/algorand/go-algorand/util/db"
 )
 
 type wrappedLedger struct {
@@ -80,7 +78,7 @@ func (wl *wrappedLedger) trackerDB() trackerdb.Store {
 	return wl.l.trackerDB()
 }
 
-func (wl *wrappedLedger) blockDB() db.Pair {
+func (wl *wrappedLedger) blockDB() blockdb.Store {
 	return wl.l.blockDB()
 }
 
@@ -204,11 +202,11 @@ func TestArchivalRestart(t *testing.T) {
 	l.WaitForCommit(blk.Round())
 
 	var latest, earliest basics.Round
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err = blockdb.BlockLatest(tx)
+	err = l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
+		latest, err = tx.BlockLatest()
 		require.NoError(t, err)
 
-		earliest, err = blockdb.BlockEarliest(tx)
+		earliest, err = tx.BlockEarliest()
 		require.NoError(t, err)
 		return err
 	})
@@ -221,11 +219,11 @@ func TestArchivalRestart(t *testing.T) {
 	require.NoError(t, err)
 	defer l.Close()
 
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err = blockdb.BlockLatest(tx)
+	err = l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
+		latest, err = tx.BlockLatest()
 		require.NoError(t, err)
 
-		earliest, err = blockdb.BlockEarliest(tx)
+		earliest, err = tx.BlockEarliest()
 		require.NoError(t, err)
 		return err
 	})
@@ -727,11 +725,11 @@ func TestArchivalFromNonArchival(t *testing.T) {
 	l.WaitForCommit(blk.Round())
 
 	var latest, earliest basics.Round
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err = blockdb.BlockLatest(tx)
+	err = l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
+		latest, err = tx.BlockLatest()
 		require.NoError(t, err)
 
-		earliest, err = blockdb.BlockEarliest(tx)
+		earliest, err = tx.BlockEarliest()
 		require.NoError(t, err)
 		return err
 	})
@@ -747,11 +745,11 @@ func TestArchivalFromNonArchival(t *testing.T) {
 	require.NoError(t, err)
 	defer l.Close()
 
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err = blockdb.BlockLatest(tx)
+	err = l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
+		latest, err = tx.BlockLatest()
 		require.NoError(t, err)
 
-		earliest, err = blockdb.BlockEarliest(tx)
+		earliest, err = tx.BlockEarliest()
 		require.NoError(t, err)
 		return err
 	})
@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"sync"
 	"time"
@@ -79,9 +78,9 @@ func (bq *blockQueue) start() error {
 	bq.closed = make(chan struct{})
 	ledgerBlockqInitCount.Inc(nil)
 	start := time.Now()
-	err := bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err := bq.l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 		var err0 error
-		bq.lastCommitted, err0 = blockdb.BlockLatest(tx)
+		bq.lastCommitted, err0 = tx.BlockLatest()
 		return err0
 	})
 	ledgerBlockqInitMicros.AddMicrosecondsSince(start, nil)
@@ -113,6 +112,14 @@ func (bq *blockQueue) stop() {
 
 const maxDeletionBatchSize = 10_000
 
+// deletionBatchSize returns the maximum number of blocks deleted after each flush.
+func (bq *blockQueue) deletionBatchSize() basics.Round {
+	if bq.l.cfg.BlockPruningBatchSize == 0 {
+		return maxDeletionBatchSize
+	}
+	return basics.Round(bq.l.cfg.BlockPruningBatchSize)
+}
+
 func (bq *blockQueue) syncer() {
 	bq.mu.Lock()
 	for {
@@ -132,9 +139,9 @@ func (bq *blockQueue) syncer() {
 
 		start := time.Now()
 		ledgerSyncBlockputCount.Inc(nil)
-		err := bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err := bq.l.blockDBs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) error {
 			for _, e := range workQ {
-				err0 := blockdb.BlockPut(tx, e.block, e.cert)
+				err0 := tx.BlockPut(e.block, e.cert)
 				if err0 != nil {
 					return err0
 				}
@@ -166,29 +173,32 @@ func (bq *blockQueue) syncer() {
 			bq.mu.Unlock()
 
 			minToSave := bq.l.notifyCommit(committed)
+			pruneTarget := minToSave
 			var earliest basics.Round
-			err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			earliestErr := bq.l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 				var err0 error
-				earliest, err0 = blockdb.BlockEarliest(tx)
+				earliest, err0 = tx.BlockEarliest()
 				if err0 != nil {
 					bq.l.log.Warnf("blockQueue.syncer: BlockEarliest(): %v", err0)
 				}
 				return err0
 			})
-			if err == nil {
-				if basics.SubSaturate(minToSave, earliest) > maxDeletionBatchSize {
-					minToSave = basics.AddSaturate(earliest, maxDeletionBatchSize)
+			if earliestErr == nil {
+				if batchSize := bq.deletionBatchSize(); basics.SubSaturate(minToSave, earliest) > batchSize {
+					minToSave = basics.AddSaturate(earliest, batchSize)
 				}
 			}
 
 			bfstart := time.Now()
 			ledgerSyncBlockforgetCount.Inc(nil)
-			err = bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-				return blockdb.BlockForgetBefore(tx, minToSave)
+			err = bq.l.blockDBs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) error {
+				return tx.BlockForgetBefore(minToSave)
 			})
 			ledgerSyncBlockforgetMicros.AddMicrosecondsSince(bfstart, nil)
 			if err != nil {
 				bq.l.log.Warnf("blockQueue.syncer: blockForgetBefore(%d): %v", minToSave, err)
+			} else if earliestErr == nil {
+				updateBlockPruningMetrics(earliest, minToSave, pruneTarget)
 			}
 
 			bq.mu.Lock()
@@ -196,6 +206,17 @@ func (bq *blockQueue) syncer() {
 	}
 }
 
+// updateBlockPruningMetrics reports the progress of the block pruning, once the blocks before
+// forgotBefore were deleted on the way to deleting all the blocks before target.
+func updateBlockPruningMetrics(earliest, forgotBefore, target basics.Round) {
+	if forgotBefore > earliest {
+		ledgerBlockdbPrunedBlocks.AddUint64(uint64(forgotBefore-earliest), nil)
+		earliest = forgotBefore
+	}
+	ledgerBlockdbEarliestRound.Set(uint64(earliest))
+	ledgerBlockdbPruneBacklog.Set(uint64(target.SubSaturate(earliest)))
+}
+
 func (bq *blockQueue) waitCommit(r basics.Round) {
 	bq.mu.Lock()
 	defer bq.mu.Unlock()
@@ -297,9 +318,9 @@ func (bq *blockQueue) getBlock(r basics.Round) (blk bookkeeping.Block, err error
 
 	start := time.Now()
 	ledgerGetblockCount.Inc(nil)
-	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err = bq.l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 		var err0 error
-		blk, err0 = blockdb.BlockGet(tx, r)
+		blk, err0 = tx.BlockGet(r)
 		return err0
 	})
 	ledgerGetblockMicros.AddMicrosecondsSince(start, nil)
@@ -319,9 +340,9 @@ func (bq *blockQueue) getBlockHdr(r basics.Round) (hdr bookkeeping.BlockHeader,
 
 	start := time.Now()
 	ledgerGetblockhdrCount.Inc(nil)
-	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err = bq.l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 		var err0 error
-		hdr, err0 = blockdb.BlockGetHdr(tx, r)
+		hdr, err0 = tx.BlockGetHdr(r)
 		return err0
 	})
 	ledgerGetblockhdrMicros.AddMicrosecondsSince(start, nil)
@@ -345,9 +366,9 @@ func (bq *blockQueue) getEncodedBlockCert(r basics.Round) (blk []byte, cert []by
 
 	start := time.Now()
 	ledgerGeteblockcertCount.Inc(nil)
-	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err = bq.l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 		var err0 error
-		blk, cert, err0 = blockdb.BlockGetEncodedCert(tx, r)
+		blk, cert, err0 = tx.BlockGetEncodedCert(r)
 		return err0
 	})
 	ledgerGeteblockcertMicros.AddMicrosecondsSince(start, nil)
@@ -367,9 +388,9 @@ func (bq *blockQueue) getBlockCert(r basics.Round) (blk bookkeeping.Block, cert
 
 	start := time.Now()
 	ledgerGetblockcertCount.Inc(nil)
-	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err = bq.l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 		var err0 error
-		blk, cert, err0 = blockdb.BlockGetCert(tx, r)
+		blk, cert, err0 = tx.BlockGetCert(r)
 		return err0
 	})
 	ledgerGetblockcertMicros.AddMicrosecondsSince(start, nil)
@@ -383,6 +404,9 @@ var ledgerSyncBlockputCount = metrics.NewCounter("ledger_blockq_sync_put_count",
 var ledgerSyncBlockputMicros = metrics.NewCounter("ledger_blockq_sync_put_micros", "µs spent to sync block queue")
 var ledgerSyncBlockforgetCount = metrics.NewCounter("ledger_blockq_sync_forget_count", "calls")
 var ledgerSyncBlockforgetMicros = metrics.NewCounter("ledger_blockq_sync_forget_micros", "µs spent")
+var ledgerBlockdbPrunedBlocks = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_blockdb_pruned_blocks", Description: "Number of blocks pruned from the block database"})
+var ledgerBlockdbEarliestRound = metrics.MakeGauge(metrics.MetricName{Name: "algod_ledger_blockdb_earliest_round", Description: "Earliest round stored in the block database"})
+var ledgerBlockdbPruneBacklog = metrics.MakeGauge(metrics.MetricName{Name: "algod_ledger_blockdb_prune_backlog", Description: "Number of blocks outside of the retention window not pruned yet"})
 var ledgerGetblockCount = metrics.NewCounter("ledger_blockq_getblock_count", "calls")
 var ledgerGetblockMicros = metrics.NewCounter("ledger_blockq_getblock_micros", "µs spent")
 var ledgerGetblockhdrCount = metrics.NewCounter("ledger_blockq_getblockhdr_count", "calls")
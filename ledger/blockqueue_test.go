@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"testing"
@@ -37,7 +36,6 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
-	"github.com/algorand/go-algorand/util/db"
 )
 
 func randomBlock(r basics.Round) blockEntry {
@@ -153,30 +151,32 @@ func TestBlockQueueSyncerDeletion(t *testing.T) {
 		name             string
 		expectedEarliest basics.Round
 		tracker          ledgerTracker
+		batchSize        uint64
 	}{
-		{"max_batch", maxDeletionBatchSize, nil}, // no trackers, max deletion
-		{"5k_tracker", 5_000, &uptoTracker{}},    // tracker sets minToSave to 5k
+		{"max_batch", maxDeletionBatchSize, nil, 0},                 // no trackers, max deletion
+		{"5k_tracker", 5_000, &uptoTracker{}, 0},                    // tracker sets minToSave to 5k
+		{"configured_batch", 2_000, nil, 2_000},                     // configured batch size limits the deletion
+		{"configured_batch_tracker", 5_000, &uptoTracker{}, 12_000}, // tracker limits the deletion below the batch size
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 
 			const dbMem = true
-			blockDBs, err := db.OpenPair(t.Name()+".block.sqlite", dbMem)
+			log := logging.TestingLog(t)
+			blockDBs, err := blockdb.OpenSQLite(t.Name()+".block.sqlite", dbMem, log)
 			require.NoError(t, err)
 
-			log := logging.TestingLog(t)
-			err = blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			err = blockDBs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) error {
 				return initBlocksDB(tx, log, []bookkeeping.Block{}, false)
 			})
 			require.NoError(t, err)
 
 			// add 15k blocks
 			const maxBlocks = maxDeletionBatchSize + maxDeletionBatchSize/2 // 15_000
-			err = blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			err = blockDBs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) error {
 				for i := 0; i < maxBlocks; i++ {
-					err0 := blockdb.BlockPut(
-						tx,
+					err0 := tx.BlockPut(
 						bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: basics.Round(i)}},
 						agreement.Certificate{})
 					if err0 != nil {
@@ -188,13 +188,13 @@ func TestBlockQueueSyncerDeletion(t *testing.T) {
 			require.NoError(t, err)
 
 			var earliest, latest basics.Round
-			err = blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			err = blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 				var err0 error
-				earliest, err0 = blockdb.BlockEarliest(tx)
+				earliest, err0 = tx.BlockEarliest()
 				if err0 != nil {
 					return err0
 				}
-				latest, err0 = blockdb.BlockLatest(tx)
+				latest, err0 = tx.BlockLatest()
 				return err0
 			})
 			require.NoError(t, err)
@@ -208,6 +208,7 @@ func TestBlockQueueSyncerDeletion(t *testing.T) {
 				log:      log,
 				blockDBs: blockDBs,
 				trackers: trackerRegistry{log: log},
+				cfg:      config.Local{BlockPruningBatchSize: test.batchSize},
 			}
 			if test.tracker != nil {
 				l.trackers.trackers = append(l.trackers.trackers, test.tracker)
@@ -223,9 +224,9 @@ func TestBlockQueueSyncerDeletion(t *testing.T) {
 
 			require.Eventually(t, func() bool {
 				var latest basics.Round
-				err = blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+				err = blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 					var err0 error
-					latest, err0 = blockdb.BlockLatest(tx)
+					latest, err0 = tx.BlockLatest()
 					return err0
 				})
 				require.NoError(t, err)
@@ -234,9 +235,9 @@ func TestBlockQueueSyncerDeletion(t *testing.T) {
 
 			blockq.stop()
 
-			err = blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			err = blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
 				var err0 error
-				earliest, err0 = blockdb.BlockEarliest(tx)
+				earliest, err0 = tx.BlockEarliest()
 				return err0
 			})
 			require.NoError(t, err)
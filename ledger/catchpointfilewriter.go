@@ -67,6 +67,7 @@ type catchpointFileWriter struct {
 	chunk                  CatchpointSnapshotChunkV6
 	chunkNum               uint64
 	writtenBytes           int64
+	encodedBytes           uint64
 	biggestChunkLen        uint64
 	accountsIterator       trackerdb.EncodedAccountsBatchIter
 	maxResourcesPerChunk   int
@@ -332,6 +333,7 @@ func (cw *catchpointFileWriter) asyncWriter(chunks chan CatchpointSnapshotChunkV
 		if chunkLen := uint64(len(encodedChunk)); cw.biggestChunkLen < chunkLen {
 			cw.biggestChunkLen = chunkLen
 		}
+		cw.encodedBytes += uint64(len(encodedChunk))
 	}
 }
 
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+)
+
+// catchpointWriteWindow is a daily time window, given as offsets from the local midnight, during which the
+// catchpoint data file may be written. A window whose end precedes its start spans midnight.
+type catchpointWriteWindow struct {
+	start time.Duration
+	end   time.Duration
+}
+
+// contains returns true if the given offset from midnight falls within the window.
+func (w catchpointWriteWindow) contains(offset time.Duration) bool {
+	if w.start <= w.end {
+		return offset >= w.start && offset < w.end
+	}
+	return offset >= w.start || offset < w.end
+}
+
+// catchpointWriteThrottle paces the writing of the catchpoint data file between its chunks, so that the
+// writing stays within the rate, the CPU share and the time windows configured for it.
+type catchpointWriteThrottle struct {
+	maxBytesPerSecond uint64
+	maxCPUPercent     uint64
+	windows           []catchpointWriteWindow
+
+	// now returns the current time, and is overridden by tests.
+	now func() time.Time
+}
+
+// makeCatchpointWriteThrottle creates the throttle from the configuration. The time windows which
+// couldn't be parsed are dropped, and the returned error describes the first of them.
+func makeCatchpointWriteThrottle(cfg config.Local) (catchpointWriteThrottle, error) {
+	t := catchpointWriteThrottle{
+		maxBytesPerSecond: cfg.CatchpointWritingMaxBytesPerSecond,
+		maxCPUPercent:     cfg.CatchpointWritingMaxCPUPercent,
+		now:               time.Now,
+	}
+	var err error
+	t.windows, err = parseCatchpointWriteWindows(cfg.CatchpointWritingWindows)
+	return t, err
+}
+
+// parseCatchpointWriteWindows parses a comma separated list of HH:MM-HH:MM time windows.
+func parseCatchpointWriteWindows(s string) (windows []catchpointWriteWindow, err error) {
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		startText, endText, found := strings.Cut(item, "-")
+		if !found {
+			if err == nil {
+				err = fmt.Errorf("catchpoint writing window %q is not of the HH:MM-HH:MM form", item)
+			}
+			continue
+		}
+		start, startErr := parseTimeOfDay(startText)
+		end, endErr := parseTimeOfDay(endText)
+		if startErr != nil || endErr != nil || start == end {
+			if err == nil {
+				err = fmt.Errorf("catchpoint writing window %q is not of the HH:MM-HH:MM form", item)
+			}
+			continue
+		}
+		windows = append(windows, catchpointWriteWindow{start: start, end: end})
+	}
+	return windows, err
+}
+
+// parseTimeOfDay parses a HH:MM time of day into its offset from midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// untilWindow returns how long it takes until one of the time windows opens, or zero if one is open.
+func (t *catchpointWriteThrottle) untilWindow() time.Duration {
+	if len(t.windows) == 0 {
+		return 0
+	}
+	now := t.now()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	offset := now.Sub(midnight)
+	wait := 24 * time.Hour
+	for _, w := range t.windows {
+		if w.contains(offset) {
+			return 0
+		}
+		untilStart := w.start - offset
+		if untilStart < 0 {
+			untilStart += 24 * time.Hour
+		}
+		if untilStart < wait {
+			wait = untilStart
+		}
+	}
+	return wait
+}
+
+// delay returns how long the writing should pause after a step which took stepDuration to write stepBytes.
+func (t *catchpointWriteThrottle) delay(stepDuration time.Duration, stepBytes uint64) time.Duration {
+	var d time.Duration
+	if t.maxBytesPerSecond > 0 {
+		// the time the step should have taken at the configured rate, minus the time it did take
+		d = time.Duration(float64(stepBytes)/float64(t.maxBytesPerSecond)*float64(time.Second)) - stepDuration
+	}
+	if t.maxCPUPercent > 0 && t.maxCPUPercent < 100 {
+		idle := stepDuration * time.Duration(100-t.maxCPUPercent) / time.Duration(t.maxCPUPercent)
+		if idle > d {
+			d = idle
+		}
+	}
+	if wait := t.untilWindow(); wait > d {
+		d = wait
+	}
+	return d
+}
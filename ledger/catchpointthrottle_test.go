@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCatchpointWriteWindowsParsing(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	windows, err := parseCatchpointWriteWindows("")
+	require.NoError(t, err)
+	require.Empty(t, windows)
+
+	windows, err = parseCatchpointWriteWindows("01:00-05:30, 22:15-02:00")
+	require.NoError(t, err)
+	require.Equal(t, []catchpointWriteWindow{
+		{start: time.Hour, end: 5*time.Hour + 30*time.Minute},
+		{start: 22*time.Hour + 15*time.Minute, end: 2 * time.Hour},
+	}, windows)
+
+	// invalid windows are reported and dropped, the valid ones are kept
+	for _, s := range []string{"01:00", "25:00-02:00", "01:00-01:00", "a-b"} {
+		windows, err = parseCatchpointWriteWindows(s + ",03:00-04:00")
+		require.Error(t, err, s)
+		require.Equal(t, []catchpointWriteWindow{{start: 3 * time.Hour, end: 4 * time.Hour}}, windows, s)
+	}
+}
+
+func TestCatchpointWriteThrottleDelay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	throttle, err := makeCatchpointWriteThrottle(cfg)
+	require.NoError(t, err)
+	require.Zero(t, throttle.delay(time.Second, 1<<30))
+
+	// 1MB written in 100ms at 1MB/s leaves 900ms to wait
+	cfg.CatchpointWritingMaxBytesPerSecond = 1 << 20
+	throttle, err = makeCatchpointWriteThrottle(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 900*time.Millisecond, throttle.delay(100*time.Millisecond, 1<<20))
+	require.LessOrEqual(t, throttle.delay(2*time.Second, 1<<20), time.Duration(0))
+
+	// writing 25% of the time waits three times as long as it writes
+	cfg.CatchpointWritingMaxBytesPerSecond = 0
+	cfg.CatchpointWritingMaxCPUPercent = 25
+	throttle, err = makeCatchpointWriteThrottle(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 300*time.Millisecond, throttle.delay(100*time.Millisecond, 1<<20))
+
+	cfg.CatchpointWritingMaxCPUPercent = 100
+	throttle, err = makeCatchpointWriteThrottle(cfg)
+	require.NoError(t, err)
+	require.Zero(t, throttle.delay(100*time.Millisecond, 1<<20))
+
+	// outside the windows, the writing waits for the next one to open
+	cfg.CatchpointWritingMaxCPUPercent = 0
+	cfg.CatchpointWritingWindows = "02:00-04:00,23:00-01:00"
+	throttle, err = makeCatchpointWriteThrottle(cfg)
+	require.NoError(t, err)
+	at := func(hour, minute int) func() time.Time {
+		return func() time.Time {
+			return time.Date(2024, time.March, 1, hour, minute, 0, 0, time.Local)
+		}
+	}
+	throttle.now = at(3, 0)
+	require.Zero(t, throttle.delay(100*time.Millisecond, 1<<20))
+	throttle.now = at(0, 30)
+	require.Zero(t, throttle.delay(100*time.Millisecond, 1<<20))
+	throttle.now = at(1, 30)
+	require.Equal(t, 30*time.Minute, throttle.delay(100*time.Millisecond, 1<<20))
+	throttle.now = at(12, 0)
+	require.Equal(t, 11*time.Hour, throttle.delay(100*time.Millisecond, 1<<20))
+	throttle.now = at(23, 30)
+	require.Zero(t, throttle.delay(100*time.Millisecond, 1<<20))
+}
@@ -109,6 +109,22 @@ type catchpointTracker struct {
 	// enableGeneratingCatchpointFiles determines whether catchpoints files should be generated by the trackers.
 	enableGeneratingCatchpointFiles bool
 
+	// writeThrottle paces the writing of the catchpoint data file, unless it is done as fast as possible.
+	writeThrottle catchpointWriteThrottle
+
+	// writeThrottleErr is the error found in the configuration of the writeThrottle, reported when loading from disk.
+	writeThrottleErr error
+
+	// maxDeferredRounds is the number of rounds awaiting commit after which the catchpoint data file is written as fast as possible.
+	maxDeferredRounds uint64
+
+	// discardHashes is set in participation storage mode, where the accounts merkle trie isn't kept at all.
+	discardHashes bool
+
+	// hashesDiscarded is set when loading from disk dropped a merkle trie left by an earlier catchpoint tracking
+	// or by a fast catchup, so that the ledger could reclaim the space it used.
+	hashesDiscarded bool
+
 	// log copied from ledger
 	log logging.Logger
 
@@ -187,6 +203,11 @@ func (ct *catchpointTracker) initialize(cfg config.Local, paths DirsAndPrefix) {
 	if cfg.CatchpointFileHistoryLength < -1 {
 		ct.catchpointFileHistoryLength = -1
 	}
+
+	ct.writeThrottle, ct.writeThrottleErr = makeCatchpointWriteThrottle(cfg)
+	ct.maxDeferredRounds = cfg.CatchpointWritingMaxDeferredRounds
+
+	ct.discardHashes = cfg.ParticipationStorageMode && !ct.catchpointEnabled()
 }
 
 // GetLastCatchpointLabel retrieves the last catchpoint label that was stored to the database.
@@ -399,6 +420,9 @@ func (ct *catchpointTracker) recoverFromCrash(dbRound basics.Round, blockProto p
 func (ct *catchpointTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) (err error) {
 	ct.log = l.trackerLog()
 	ct.dbs = l.trackerDB()
+	if ct.writeThrottleErr != nil {
+		ct.log.Warnf("catchpointTracker: ignoring invalid CatchpointWritingWindows: %v", ct.writeThrottleErr)
+	}
 	ct.catchpointStore, err = l.trackerDB().MakeCatchpointReaderWriter()
 	if err != nil {
 		return err
@@ -525,8 +549,8 @@ func (ct *catchpointTracker) produceCommittingTask(committedRound basics.Round,
 	// if we're still writing the previous balances, we can't move forward yet.
 	if ct.isWritingCatchpointDataFile() {
 		// if we hit this path, it means that we're still writing a catchpoint.
-		// see if the new delta range contains another catchpoint.
-		if hasIntermediateFirstStageRound {
+		// see if the new delta range contains another catchpoint, or if too many rounds are waiting for it.
+		if hasIntermediateFirstStageRound || (ct.maxDeferredRounds > 0 && dcr.offset >= ct.maxDeferredRounds) {
 			// check if we're already attempting to perform fast-writing.
 			select {
 			case <-ct.catchpointDataSlowWriting:
@@ -1274,20 +1298,31 @@ func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, params
 		for more {
 			stepCtx, stepCancelFunction := context.WithTimeout(dbCtx, chunkExecutionDuration)
 			writeStepStartTime := time.Now()
+			writeStepStartBytes := catchpointWriter.encodedBytes
 			more, err = catchpointWriter.FileWriteStep(stepCtx)
 			// accumulate the actual time we've spent writing in this step.
-			catchpointGenerationStats.CPUTime += uint64(time.Since(writeStepStartTime).Nanoseconds())
+			writeStepDuration := time.Since(writeStepStartTime)
+			catchpointGenerationStats.CPUTime += uint64(writeStepDuration.Nanoseconds())
 			stepCancelFunction()
 			if more && err == nil {
 				// we just wrote some data, but there is more to be written.
-				// go to sleep for while.
+				// go to sleep for while, or longer if the writing is throttled and not yet hurried.
+				sleepDuration := 100 * time.Millisecond
+				select {
+				case <-ct.catchpointDataSlowWriting:
+				default:
+					throttleDelay := ct.writeThrottle.delay(writeStepDuration, catchpointWriter.encodedBytes-writeStepStartBytes)
+					if throttleDelay > sleepDuration {
+						sleepDuration = throttleDelay
+					}
+				}
 				// before going to sleep, extend the transaction timeout so that we won't get warnings:
-				_, err0 := tx.ResetTransactionWarnDeadline(dbCtx, time.Now().Add(1*time.Second))
+				_, err0 := tx.ResetTransactionWarnDeadline(dbCtx, time.Now().Add(sleepDuration+900*time.Millisecond))
 				if err0 != nil {
 					ct.log.Warnf("catchpointTracker: generateCatchpoint: failed to reset transaction warn deadline : %v", err0)
 				}
 				select {
-				case <-time.After(100 * time.Millisecond):
+				case <-time.After(sleepDuration):
 					// increase the time slot allocated for writing the catchpoint, but stop when we get to the longChunkExecutionDuration limit.
 					// this would allow the catchpoint writing speed to ramp up while still leaving some cpu available.
 					chunkExecutionDuration *= 2
@@ -1534,6 +1569,36 @@ func (ct *catchpointTracker) catchpointEnabled() bool {
 	return ct.catchpointInterval != 0
 }
 
+// discardAccountHashes drops the merkle trie of the accounts, if any, when the node doesn't keep it at all.
+func (ct *catchpointTracker) discardAccountHashes(ctx context.Context, tx trackerdb.TransactionScope, aw trackerdb.AccountsWriterExt, hashRound basics.Round) error {
+	committer, err := tx.MakeMerkleCommitter(false)
+	if err != nil {
+		return fmt.Errorf("discardAccountHashes was unable to makeMerkleCommitter: %v", err)
+	}
+
+	trie, err := merkletrie.MakeTrie(committer, trackerdb.TrieMemoryConfig)
+	if err != nil {
+		return fmt.Errorf("discardAccountHashes was unable to MakeTrie: %v", err)
+	}
+
+	rootHash, err := trie.RootHash()
+	if err != nil {
+		return fmt.Errorf("discardAccountHashes was unable to retrieve trie root hash: %v", err)
+	}
+
+	if rootHash.IsZero() {
+		return nil
+	}
+
+	ct.log.Infof("discardAccountHashes dropping the merkle trie of round %d", hashRound)
+	err = aw.ResetAccountHashes(ctx)
+	if err != nil {
+		return err
+	}
+	ct.hashesDiscarded = true
+	return nil
+}
+
 // initializeHashes initializes account/resource/kv hashes.
 // as part of the initialization, it tests if a hash table matches to account base and updates the former.
 func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx trackerdb.TransactionScope, rnd basics.Round) error {
@@ -1552,6 +1617,11 @@ func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx trackerdb.
 		return err
 	}
 
+	ct.hashesDiscarded = false
+	if ct.discardHashes {
+		return ct.discardAccountHashes(ctx, tx, aw, hashRound)
+	}
+
 	if hashRound != rnd {
 		// if the hashed round is different then the base round, something was modified, and the accounts aren't in sync
 		// with the hashes.
@@ -37,6 +37,7 @@ import (
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -221,6 +222,72 @@ func TestCatchpointsDeleteStored(t *testing.T) {
 	require.Equal(t, 0, len(fileNames))
 }
 
+// TestCatchpointDiscardHashes checks that in participation storage mode the merkle trie built by an earlier
+// catchpoint tracking is dropped when loading from disk, and that it is not rebuilt afterwards.
+func TestCatchpointDiscardHashes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, true)}
+
+	ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion, accts)
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.CatchpointTracking = config.CatchpointTrackingModeTracked
+	ct := newCatchpointTracker(t, ml, conf, ".")
+	defer ct.close()
+	require.False(t, ct.discardHashes)
+
+	trieRootHash := func() (rootHash crypto.Digest) {
+		err := ml.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			committer, err := tx.MakeMerkleCommitter(false)
+			if err != nil {
+				return err
+			}
+			trie, err := merkletrie.MakeTrie(committer, trackerdb.TrieMemoryConfig)
+			if err != nil {
+				return err
+			}
+			rootHash, err = trie.RootHash()
+			return err
+		})
+		require.NoError(t, err)
+		return
+	}
+	require.False(t, trieRootHash().IsZero())
+
+	initializeHashes := func(ct *catchpointTracker) {
+		err := ml.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+			return ct.initializeHashes(ctx, tx, ct.cachedDBRound)
+		})
+		require.NoError(t, err)
+	}
+
+	// the participation storage mode disables the catchpoint tracking before the tracker is initialized
+	conf.CatchpointTracking = config.CatchpointTrackingModeUntracked
+	conf.ParticipationStorageMode = true
+	pct := &catchpointTracker{log: ml.log, cachedDBRound: ct.cachedDBRound}
+	pct.initialize(conf, DirsAndPrefix{})
+	require.True(t, pct.discardHashes)
+
+	initializeHashes(pct)
+	require.True(t, pct.hashesDiscarded)
+	require.True(t, trieRootHash().IsZero())
+
+	// there is nothing left to drop on the next load
+	initializeHashes(pct)
+	require.False(t, pct.hashesDiscarded)
+	require.True(t, trieRootHash().IsZero())
+
+	// the mode has no effect when catchpoints are tracked
+	conf.CatchpointTracking = config.CatchpointTrackingModeTracked
+	tct := &catchpointTracker{}
+	tct.initialize(conf, DirsAndPrefix{})
+	require.False(t, tct.discardHashes)
+}
+
 // The test validate that when algod boots up it cleans empty catchpoint directories.
 // It is done by creating empty directories in the catchpoint root directory.
 // When algod boots up it should remove those directories.
@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -1176,8 +1175,8 @@ func (c *catchpointCatchupAccessorImpl) StoreFirstBlock(ctx context.Context, blk
 	blockDbs := c.ledger.blockDB()
 	start := time.Now()
 	ledgerStorefirstblockCount.Inc(nil)
-	err = blockDbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		return blockdb.BlockStartCatchupStaging(tx, *blk, *cert)
+	err = blockDbs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) (err error) {
+		return tx.BlockStartCatchupStaging(*blk, *cert)
 	})
 	ledgerStorefirstblockMicros.AddMicrosecondsSince(start, nil)
 	if err != nil {
@@ -1191,8 +1190,8 @@ func (c *catchpointCatchupAccessorImpl) StoreBlock(ctx context.Context, blk *boo
 	blockDbs := c.ledger.blockDB()
 	start := time.Now()
 	ledgerCatchpointStoreblockCount.Inc(nil)
-	err = blockDbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		return blockdb.BlockPutStaging(tx, *blk, *cert)
+	err = blockDbs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) (err error) {
+		return tx.BlockPutStaging(*blk, *cert)
 	})
 	ledgerCatchpointStoreblockMicros.AddMicrosecondsSince(start, nil)
 	if err != nil {
@@ -1206,12 +1205,12 @@ func (c *catchpointCatchupAccessorImpl) FinishBlocks(ctx context.Context, applyC
 	blockDbs := c.ledger.blockDB()
 	start := time.Now()
 	ledgerCatchpointFinishblocksCount.Inc(nil)
-	err = blockDbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+	err = blockDbs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) (err error) {
 		if applyChanges {
-			return blockdb.BlockCompleteCatchup(tx)
+			return tx.BlockCompleteCatchup()
 		}
 		// TODO: unused, either actually implement cleanup on catchpoint failure, or delete this
-		return blockdb.BlockAbortCatchup(tx)
+		return tx.BlockAbortCatchup()
 	})
 	ledgerCatchpointFinishblocksMicros.AddMicrosecondsSince(start, nil)
 	if err != nil {
@@ -1225,8 +1224,8 @@ func (c *catchpointCatchupAccessorImpl) EnsureFirstBlock(ctx context.Context) (b
 	blockDbs := c.ledger.blockDB()
 	start := time.Now()
 	ledgerCatchpointEnsureblock1Count.Inc(nil)
-	err = blockDbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		blk, err = blockdb.BlockEnsureSingleBlock(tx)
+	err = blockDbs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) (err error) {
+		blk, err = tx.BlockEnsureSingleBlock()
 		return
 	})
 	ledgerCatchpointEnsureblock1Micros.AddMicrosecondsSince(start, nil)
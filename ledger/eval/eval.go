@@ -1039,10 +1039,6 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 		}
 	}
 
-	var txibs []transactions.SignedTxnInBlock
-	var group transactions.TxGroup
-	var groupTxBytes int
-
 	cow := eval.state.child(len(txgroup))
 	defer cow.recycle()
 
@@ -1058,8 +1054,27 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 		}()
 	}
 
+	txibs, groupTxBytes, err := eval.transactionGroup(txgroup, evalParams, cow, eval.blockTxBytes)
+	if err != nil {
+		return err
+	}
+
+	eval.block.Payset = append(eval.block.Payset, txibs...)
+	eval.blockTxBytes += groupTxBytes
+	cow.commitToParent()
+
+	return nil
+}
+
+// transactionGroup evaluates a transaction group into cow, which must be a child of the evaluator state,
+// and returns the transactions to add to the block and their encoded length. blockTxBytes is the length of the
+// transactions which precede the group in the block.
+func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWithAD, evalParams *logic.EvalParams, cow *roundCowState, blockTxBytes int) ([]transactions.SignedTxnInBlock, int, error) {
+	var group transactions.TxGroup
+	var groupTxBytes int
+
 	// Evaluate each transaction in the group
-	txibs = make([]transactions.SignedTxnInBlock, 0, len(txgroup))
+	txibs := make([]transactions.SignedTxnInBlock, 0, len(txgroup))
 	for gi, txad := range txgroup {
 		var txib transactions.SignedTxnInBlock
 
@@ -1074,21 +1089,21 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 		}
 
 		if err != nil {
-			return err
+			return nil, 0, err
 		}
 
 		txibs = append(txibs, txib)
 
 		if eval.validate {
 			groupTxBytes += txib.GetEncodedLength()
-			if eval.blockTxBytes+groupTxBytes > eval.maxTxnBytesPerBlock {
-				return ledgercore.ErrNoSpace
+			if blockTxBytes+groupTxBytes > eval.maxTxnBytesPerBlock {
+				return nil, 0, ledgercore.ErrNoSpace
 			}
 		}
 
 		// Make sure all transactions in group have the same group value
 		if txad.SignedTxn.Txn.Group != txgroup[0].SignedTxn.Txn.Group {
-			return &ledgercore.TxGroupMalformedError{
+			return nil, 0, &ledgercore.TxGroupMalformedError{
 				Msg: fmt.Sprintf("transactionGroup: inconsistent group values: %v != %v",
 					txad.SignedTxn.Txn.Group, txgroup[0].SignedTxn.Txn.Group),
 				Reason: ledgercore.TxGroupMalformedErrorReasonInconsistentGroupID,
@@ -1101,7 +1116,7 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 
 			group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(txWithoutGroup.ID()))
 		} else if len(txgroup) > 1 {
-			return &ledgercore.TxGroupMalformedError{
+			return nil, 0, &ledgercore.TxGroupMalformedError{
 				Msg:    fmt.Sprintf("transactionGroup: [%d] had zero Group but was submitted in a group of %d", gi, len(txgroup)),
 				Reason: ledgercore.TxGroupMalformedErrorReasonEmptyGroupID,
 			}
@@ -1111,7 +1126,7 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 	// If we had a non-zero Group value, check that all group members are present.
 	if group.TxGroupHashes != nil {
 		if txgroup[0].SignedTxn.Txn.Group != crypto.HashObj(group) {
-			return &ledgercore.TxGroupMalformedError{
+			return nil, 0, &ledgercore.TxGroupMalformedError{
 				Msg: fmt.Sprintf("transactionGroup: incomplete group: %v != %v (%v)",
 					txgroup[0].SignedTxn.Txn.Group, crypto.HashObj(group), group),
 				Reason: ledgercore.TxGroupMalformedErrorReasonIncompleteGroup,
@@ -1119,11 +1134,7 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 		}
 	}
 
-	eval.block.Payset = append(eval.block.Payset, txibs...)
-	eval.blockTxBytes += groupTxBytes
-	cow.commitToParent()
-
-	return nil
+	return txibs, groupTxBytes, nil
 }
 
 // Check the minimum balance requirement for the modified accounts in `cow`.
@@ -2058,6 +2069,13 @@ func (validator *evalTxValidator) run() {
 // AddBlock: Eval(context.Background(), l, blk, false, txcache, nil)
 // tracker:  Eval(context.Background(), l, blk, false, txcache, nil)
 func Eval(ctx context.Context, l LedgerForEvaluator, blk bookkeeping.Block, validate bool, txcache verify.VerifiedTransactionCache, executionPool execpool.BacklogPool, tracer logic.EvalTracer) (ledgercore.StateDelta, error) {
+	return EvalWithParallelism(ctx, l, blk, validate, txcache, executionPool, tracer, 1)
+}
+
+// EvalWithParallelism is like Eval, but evaluates the non-conflicting transaction groups of the block
+// on up to parallelism goroutines. The groups are evaluated sequentially if parallelism is lower
+// than 2, or when tracing.
+func EvalWithParallelism(ctx context.Context, l LedgerForEvaluator, blk bookkeeping.Block, validate bool, txcache verify.VerifiedTransactionCache, executionPool execpool.BacklogPool, tracer logic.EvalTracer, parallelism int) (ledgercore.StateDelta, error) {
 	// flush the pending writes in the cache to make everything read so far available during eval
 	l.FlushCaches()
 
@@ -2112,6 +2130,11 @@ func Eval(ctx context.Context, l LedgerForEvaluator, blk bookkeeping.Block, vali
 		go txvalidator.run()
 	}
 
+	var pe *parallelEvaluator
+	if parallelism > 1 && tracer == nil && !eval.state.compatibilityMode {
+		pe = makeParallelEvaluator(eval, parallelism)
+	}
+
 	base := eval.state.lookupParent.(*roundCowBase)
 transactionGroupLoop:
 	for {
@@ -2174,7 +2197,11 @@ transactionGroupLoop:
 					}
 				}
 			}
-			err = eval.TransactionGroup(txgroup.TxnGroup)
+			if pe != nil {
+				err = pe.transactionGroup(txgroup.TxnGroup)
+			} else {
+				err = eval.TransactionGroup(txgroup.TxnGroup)
+			}
 			if err != nil {
 				return ledgercore.StateDelta{}, err
 			}
@@ -2188,6 +2215,13 @@ transactionGroupLoop:
 		}
 	}
 
+	if pe != nil {
+		err = pe.flush()
+		if err != nil {
+			return ledgercore.StateDelta{}, err
+		}
+	}
+
 	// Finally, process any pending end-of-block state changes.
 	err = eval.endOfBlock()
 	if err != nil {
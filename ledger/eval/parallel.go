@@ -0,0 +1,585 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package eval
+
+import (
+	"sync"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Block validation evaluates the transaction groups of consecutive batches concurrently when the groups
+// of a batch can be statically shown not to conflict: each group is evaluated into its own child of the
+// evaluator state, and the children are then committed to the evaluator state in the block order.
+//
+// A group footprint is a superset of the state the group may read or write. Applications may only
+// access the accounts, applications and assets referenced by the transactions of their group, so the
+// footprint of a group is derived from the fields of its transactions. Every transaction pays its fee
+// to the fee sink, so the fee sink is left out of the footprints, and its balance is merged as the sum
+// of the fees collected by each group. Groups which reference the fee sink in any other way, as well as
+// state proof and heartbeat transactions, are evaluated on their own.
+//
+// Assets and applications created by a group get their index from the transaction counter. The groups
+// of a batch are evaluated with the counter offset by the number of transactions (inner ones included)
+// of the preceding groups, as recorded in their ApplyData, and the prediction is checked when committing.
+// Groups which reference an index created in the same batch are not batched with the groups that may
+// create it.
+//
+// Whenever the evaluation of a group fails, or doesn't match the predictions, the groups of the batch
+// which follow the last committed one are evaluated again sequentially, which reports the same outcome
+// as a sequential evaluation of the block.
+
+// groupFootprint is the state a transaction group may access.
+type groupFootprint struct {
+	// exclusive is set for the groups which are always evaluated on their own.
+	exclusive bool
+
+	readAccounts  map[basics.Address]struct{}
+	writeAccounts map[basics.Address]struct{}
+	readAssets    map[basics.AssetIndex]struct{}
+	writeAssets   map[basics.AssetIndex]struct{}
+	apps          map[basics.AppIndex]struct{}
+
+	// mayCreate is set if the group may create assets or applications.
+	mayCreate bool
+	// maxIndex is the largest asset or application index the group references.
+	maxIndex basics.CreatableIndex
+
+	// txnCount is the number of transactions of the group, inner transactions included.
+	txnCount uint64
+}
+
+// readAccount adds an account the group may read. The zero address is an account like any other:
+// the callers leave out the optional fields which are unset.
+func (fp *groupFootprint) readAccount(addr basics.Address) {
+	fp.readAccounts[addr] = struct{}{}
+}
+
+// writeAccount adds an account the group may modify.
+func (fp *groupFootprint) writeAccount(addr basics.Address) {
+	fp.writeAccounts[addr] = struct{}{}
+}
+
+// writeOptionalAccount adds an account the group may modify, unless addr is zero and the field unset.
+func (fp *groupFootprint) writeOptionalAccount(addr basics.Address) {
+	if !addr.IsZero() {
+		fp.writeAccount(addr)
+	}
+}
+
+func (fp *groupFootprint) reference(cidx basics.CreatableIndex) {
+	if cidx > fp.maxIndex {
+		fp.maxIndex = cidx
+	}
+}
+
+// readAsset adds an asset whose parameters the group may read.
+func (eval *BlockEvaluator) readAsset(fp *groupFootprint, aidx basics.AssetIndex) error {
+	fp.reference(basics.CreatableIndex(aidx))
+	fp.readAssets[aidx] = struct{}{}
+	creator, ok, err := eval.state.getCreator(basics.CreatableIndex(aidx), basics.AssetCreatable)
+	if err != nil {
+		return err
+	}
+	if ok {
+		fp.readAccount(creator)
+	}
+	return nil
+}
+
+// writeAsset adds an asset whose parameters the group may modify.
+func (eval *BlockEvaluator) writeAsset(fp *groupFootprint, aidx basics.AssetIndex) error {
+	fp.reference(basics.CreatableIndex(aidx))
+	fp.writeAssets[aidx] = struct{}{}
+	creator, ok, err := eval.state.getCreator(basics.CreatableIndex(aidx), basics.AssetCreatable)
+	if err != nil {
+		return err
+	}
+	if ok {
+		fp.writeAccount(creator)
+	}
+	return nil
+}
+
+// writeApp adds an application whose state the group may read or modify, along with its account and creator.
+func (eval *BlockEvaluator) writeApp(fp *groupFootprint, aidx basics.AppIndex) error {
+	fp.reference(basics.CreatableIndex(aidx))
+	fp.apps[aidx] = struct{}{}
+	fp.writeAccount(aidx.Address())
+	creator, ok, err := eval.state.getCreator(basics.CreatableIndex(aidx), basics.AppCreatable)
+	if err != nil {
+		return err
+	}
+	if ok {
+		fp.writeAccount(creator)
+	}
+	return nil
+}
+
+// countTxns returns the number of transactions in txns and in their inner transactions.
+func countTxns(txns []transactions.SignedTxnWithAD) uint64 {
+	count := uint64(len(txns))
+	for i := range txns {
+		count += countTxns(txns[i].ApplyData.EvalDelta.InnerTxns)
+	}
+	return count
+}
+
+// makeGroupFootprint returns the footprint of a transaction group, looking up the creators of the
+// referenced assets and applications in the evaluator state.
+func (eval *BlockEvaluator) makeGroupFootprint(txgroup []transactions.SignedTxnWithAD) (groupFootprint, error) {
+	fp := groupFootprint{
+		readAccounts:  make(map[basics.Address]struct{}),
+		writeAccounts: make(map[basics.Address]struct{}),
+		readAssets:    make(map[basics.AssetIndex]struct{}),
+		writeAssets:   make(map[basics.AssetIndex]struct{}),
+		apps:          make(map[basics.AppIndex]struct{}),
+		txnCount:      countTxns(txgroup),
+	}
+
+	var err error
+	for i := range txgroup {
+		txn := &txgroup[i].SignedTxn.Txn
+		fp.writeAccount(txn.Sender)
+
+		switch txn.Type {
+		case protocol.PaymentTx:
+			// the receiver is credited, even the zero address, unless both the amount and receiver are unset
+			if !txn.Amount.IsZero() || !txn.Receiver.IsZero() {
+				fp.writeAccount(txn.Receiver)
+			}
+			fp.writeOptionalAccount(txn.CloseRemainderTo)
+
+		case protocol.KeyRegistrationTx:
+
+		case protocol.AssetConfigTx:
+			if txn.ConfigAsset == 0 {
+				fp.mayCreate = true
+			} else {
+				err = eval.writeAsset(&fp, txn.ConfigAsset)
+			}
+
+		case protocol.AssetTransferTx:
+			fp.writeOptionalAccount(txn.AssetSender)
+			fp.writeAccount(txn.AssetReceiver)
+			fp.writeOptionalAccount(txn.AssetCloseTo)
+			err = eval.readAsset(&fp, txn.XferAsset)
+
+		case protocol.AssetFreezeTx:
+			fp.writeAccount(txn.FreezeAccount)
+			err = eval.readAsset(&fp, txn.FreezeAsset)
+
+		case protocol.ApplicationCallTx:
+			// inner transactions may create assets and applications, and modify the referenced assets.
+			fp.mayCreate = fp.mayCreate || txn.ApplicationID == 0 || eval.proto.MaxInnerTransactions > 0
+			if txn.ApplicationID != 0 {
+				err = eval.writeApp(&fp, txn.ApplicationID)
+			}
+			for _, addr := range txn.Accounts {
+				fp.writeAccount(addr)
+			}
+			for j := 0; err == nil && j < len(txn.ForeignApps); j++ {
+				err = eval.writeApp(&fp, txn.ForeignApps[j])
+			}
+			for j := 0; err == nil && j < len(txn.ForeignAssets); j++ {
+				err = eval.writeAsset(&fp, txn.ForeignAssets[j])
+			}
+
+		default:
+			fp.exclusive = true
+		}
+		if err != nil {
+			return groupFootprint{}, err
+		}
+	}
+
+	// the fee sink may only be accessed to collect the fees.
+	_, read := fp.readAccounts[eval.block.FeeSink]
+	_, written := fp.writeAccounts[eval.block.FeeSink]
+	if read || written {
+		fp.exclusive = true
+	}
+	return fp, nil
+}
+
+func overlaps[K comparable](a, b map[K]struct{}) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	for k := range a {
+		if _, ok := b[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// conflicts returns whether two groups may not be evaluated concurrently.
+func (fp *groupFootprint) conflicts(other *groupFootprint) bool {
+	return overlaps(fp.writeAccounts, other.writeAccounts) ||
+		overlaps(fp.writeAccounts, other.readAccounts) ||
+		overlaps(fp.readAccounts, other.writeAccounts) ||
+		overlaps(fp.writeAssets, other.writeAssets) ||
+		overlaps(fp.writeAssets, other.readAssets) ||
+		overlaps(fp.readAssets, other.writeAssets) ||
+		overlaps(fp.apps, other.apps)
+}
+
+// groupBatch holds consecutive transaction groups which don't conflict with each other.
+type groupBatch struct {
+	groups     [][]transactions.SignedTxnWithAD
+	footprints []groupFootprint
+
+	// counter is the transaction counter before the first group of the batch.
+	counter   uint64
+	mayCreate bool
+}
+
+// parallelEvaluator feeds the transaction groups of a block to a BlockEvaluator, evaluating
+// batches of groups concurrently.
+type parallelEvaluator struct {
+	eval        *BlockEvaluator
+	parallelism int
+	maxGroups   int
+	batch       groupBatch
+}
+
+func makeParallelEvaluator(eval *BlockEvaluator, parallelism int) *parallelEvaluator {
+	return &parallelEvaluator{
+		eval:        eval,
+		parallelism: parallelism,
+		maxGroups:   4 * parallelism,
+	}
+}
+
+// transactionGroup adds the next transaction group of the block. It may be evaluated later on,
+// with the batch it is added to.
+func (pe *parallelEvaluator) transactionGroup(txgroup []transactions.SignedTxnWithAD) error {
+	if len(txgroup) == 0 {
+		return nil
+	}
+	if len(txgroup) > pe.eval.proto.MaxTxGroupSize {
+		// let TransactionGroup report the error
+		return pe.exclusive(txgroup)
+	}
+	fp, err := pe.eval.makeGroupFootprint(txgroup)
+	if err != nil {
+		return err
+	}
+	if fp.exclusive {
+		return pe.exclusive(txgroup)
+	}
+
+	b := &pe.batch
+	full := len(b.groups) >= pe.maxGroups
+	if !full && len(b.groups) > 0 {
+		// groups which may reference indices created in the batch are batched separately.
+		full = b.mayCreate && uint64(fp.maxIndex) > b.counter
+		for i := 0; !full && i < len(b.footprints); i++ {
+			full = fp.conflicts(&b.footprints[i])
+		}
+	}
+	if full {
+		err = pe.flush()
+		if err != nil {
+			return err
+		}
+		// the creators of the assets and applications created by the flushed batch are known now.
+		fp, err = pe.eval.makeGroupFootprint(txgroup)
+		if err != nil {
+			return err
+		}
+		if fp.exclusive {
+			return pe.exclusive(txgroup)
+		}
+	}
+
+	if len(b.groups) == 0 {
+		b.counter = pe.eval.state.Counter()
+	}
+	b.groups = append(b.groups, txgroup)
+	b.footprints = append(b.footprints, fp)
+	b.mayCreate = b.mayCreate || fp.mayCreate
+	return nil
+}
+
+// exclusive evaluates a transaction group on its own.
+func (pe *parallelEvaluator) exclusive(txgroup []transactions.SignedTxnWithAD) error {
+	err := pe.flush()
+	if err != nil {
+		return err
+	}
+	return pe.eval.TransactionGroup(txgroup)
+}
+
+// groupResult is the outcome of the evaluation of a group of a batch.
+type groupResult struct {
+	cow     *roundCowState
+	txibs   []transactions.SignedTxnInBlock
+	txBytes int
+	err     error
+}
+
+// flush evaluates the pending batch.
+func (pe *parallelEvaluator) flush() error {
+	b := &pe.batch
+	groups, footprints := b.groups, b.footprints
+	pe.batch = groupBatch{}
+	switch len(groups) {
+	case 0:
+		return nil
+	case 1:
+		return pe.eval.TransactionGroup(groups[0])
+	}
+
+	eval := pe.eval
+	feeSink := eval.block.FeeSink
+	feeSinkBefore, err := eval.state.lookup(feeSink)
+	if err != nil {
+		return err
+	}
+	feeSinkBefore = feeSinkBefore.WithUpdatedRewards(eval.proto, eval.state.rewardsLevel())
+
+	results := make([]groupResult, len(groups))
+	parent := &lockedCowParent{roundCowParent: eval.state}
+	var counterOffset uint64
+	for i := range groups {
+		cow := eval.state.child(len(groups[i]))
+		cow.lookupParent = &offsetCowParent{lockedCowParent: parent, counterOffset: counterOffset}
+		results[i].cow = cow
+		counterOffset += footprints[i].txnCount
+	}
+
+	var wg sync.WaitGroup
+	next := make(chan int, len(groups))
+	for i := range groups {
+		next <- i
+	}
+	close(next)
+	workers := min(pe.parallelism, len(groups))
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				evalParams := logic.NewAppEvalParams(groups[i], &eval.proto, &eval.specials)
+				r := &results[i]
+				r.txibs, r.txBytes, r.err = eval.transactionGroup(groups[i], evalParams, r.cow, 0)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// commit the groups in the block order, as long as they were evaluated as predicted.
+	committed := 0
+	for ; committed < len(groups); committed++ {
+		r := &results[committed]
+		if r.err != nil || r.cow.txnCount != footprints[committed].txnCount ||
+			(eval.validate && eval.blockTxBytes+r.txBytes > eval.maxTxnBytesPerBlock) {
+			break
+		}
+		if !pe.commit(r.cow, feeSink, feeSinkBefore) {
+			break
+		}
+		eval.block.Payset = append(eval.block.Payset, r.txibs...)
+		eval.blockTxBytes += r.txBytes
+	}
+	for i := range results {
+		results[i].cow.recycle()
+	}
+
+	// evaluate the rest of the batch again to report the outcome of a sequential evaluation.
+	for i := committed; i < len(groups); i++ {
+		err = eval.TransactionGroup(groups[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commit commits the state of a group evaluated concurrently to the evaluator state. The child
+// credited the fees of the group to the fee sink balance from before the batch, so the balance of
+// the fee sink is adjusted to add up the fees of all the groups. commit returns false, without
+// modifying the evaluator state, if the fee sink was modified in any other way.
+func (pe *parallelEvaluator) commit(cow *roundCowState, feeSink basics.Address, feeSinkBefore ledgercore.AccountData) bool {
+	eval := pe.eval
+	feeSinkAfter, modified := cow.mods.Accts.GetData(feeSink)
+	if !modified {
+		cow.commitToParent()
+		return true
+	}
+
+	expected := feeSinkBefore
+	expected.MicroAlgos = feeSinkAfter.MicroAlgos
+	if expected != feeSinkAfter || feeSinkAfter.MicroAlgos.Raw < feeSinkBefore.MicroAlgos.Raw {
+		return false
+	}
+	current, err := eval.state.lookup(feeSink)
+	if err != nil {
+		return false
+	}
+	current = current.WithUpdatedRewards(eval.proto, eval.state.rewardsLevel())
+	var overflowed bool
+	current.MicroAlgos.Raw, overflowed = basics.OAdd(current.MicroAlgos.Raw, feeSinkAfter.MicroAlgos.Raw-feeSinkBefore.MicroAlgos.Raw)
+	if overflowed {
+		return false
+	}
+
+	cow.commitToParent()
+	return eval.state.putAccount(feeSink, current) == nil
+}
+
+// lockedCowParent serializes the lookups of the children evaluated concurrently into the evaluator
+// state, whose caches are not safe for concurrent use.
+type lockedCowParent struct {
+	roundCowParent
+	mu sync.Mutex
+}
+
+// offsetCowParent offsets the transaction counter of a child by the transactions of the groups
+// which precede it in its batch.
+type offsetCowParent struct {
+	*lockedCowParent
+	counterOffset uint64
+}
+
+func (p *offsetCowParent) Counter() uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.Counter() + p.counterOffset
+}
+
+func (p *lockedCowParent) lookup(addr basics.Address) (ledgercore.AccountData, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.lookup(addr)
+}
+
+func (p *lockedCowParent) lookupAgreement(addr basics.Address) (basics.OnlineAccountData, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.lookupAgreement(addr)
+}
+
+func (p *lockedCowParent) onlineStake() (basics.MicroAlgos, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.onlineStake()
+}
+
+func (p *lockedCowParent) lookupAppParams(addr basics.Address, aidx basics.AppIndex, cacheOnly bool) (ledgercore.AppParamsDelta, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.lookupAppParams(addr, aidx, cacheOnly)
+}
+
+func (p *lockedCowParent) lookupAssetParams(addr basics.Address, aidx basics.AssetIndex, cacheOnly bool) (ledgercore.AssetParamsDelta, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.lookupAssetParams(addr, aidx, cacheOnly)
+}
+
+func (p *lockedCowParent) lookupAppLocalState(addr basics.Address, aidx basics.AppIndex, cacheOnly bool) (ledgercore.AppLocalStateDelta, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.lookupAppLocalState(addr, aidx, cacheOnly)
+}
+
+func (p *lockedCowParent) lookupAssetHolding(addr basics.Address, aidx basics.AssetIndex, cacheOnly bool) (ledgercore.AssetHoldingDelta, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.lookupAssetHolding(addr, aidx, cacheOnly)
+}
+
+func (p *lockedCowParent) checkDup(firstValid, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.checkDup(firstValid, lastValid, txid, txl)
+}
+
+func (p *lockedCowParent) Counter() uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.Counter()
+}
+
+func (p *lockedCowParent) getCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.getCreator(cidx, ctype)
+}
+
+func (p *lockedCowParent) GetStateProofNextRound() basics.Round {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.GetStateProofNextRound()
+}
+
+func (p *lockedCowParent) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.BlockHdr(rnd)
+}
+
+func (p *lockedCowParent) getStorageCounts(addr basics.Address, aidx basics.AppIndex, global bool) (basics.StateSchema, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.getStorageCounts(addr, aidx, global)
+}
+
+func (p *lockedCowParent) getStorageLimits(addr basics.Address, aidx basics.AppIndex, global bool) (basics.StateSchema, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.getStorageLimits(addr, aidx, global)
+}
+
+func (p *lockedCowParent) allocated(addr basics.Address, aidx basics.AppIndex, global bool) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.allocated(addr, aidx, global)
+}
+
+func (p *lockedCowParent) getKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, accountIdx uint64) (basics.TealValue, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.getKey(addr, aidx, global, key, accountIdx)
+}
+
+func (p *lockedCowParent) kvGet(key string) ([]byte, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.kvGet(key)
+}
+
+func (p *lockedCowParent) GetStateProofVerificationContext(stateProofLastAttestedRound basics.Round) (*ledgercore.StateProofVerificationContext, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.GetStateProofVerificationContext(stateProofLastAttestedRound)
+}
+
+func (p *lockedCowParent) GenesisHash() crypto.Digest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roundCowParent.GenesisHash()
+}
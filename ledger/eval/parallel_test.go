@@ -0,0 +1,332 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package eval
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGroupFootprintConflicts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, addrs, _ := ledgertesting.Genesis(10)
+	l := newTestLedger(t, bookkeeping.GenesisBalances{
+		Balances:    genesisInitState.Accounts,
+		FeeSink:     testSinkAddr,
+		RewardsPool: testPoolAddr,
+	})
+	eval := l.nextBlock(t)
+
+	footprint := func(txns ...txntest.Txn) groupFootprint {
+		var group []transactions.SignedTxnWithAD
+		for _, txn := range txns {
+			group = append(group, txn.SignedTxnWithAD())
+		}
+		fp, err := eval.makeGroupFootprint(group)
+		require.NoError(t, err)
+		return fp
+	}
+	pay := func(sender, receiver basics.Address) txntest.Txn {
+		return txntest.Txn{Type: protocol.PaymentTx, Sender: sender, Receiver: receiver, Amount: 1}
+	}
+	axfer := func(sender, receiver basics.Address, asset basics.AssetIndex) txntest.Txn {
+		return txntest.Txn{Type: protocol.AssetTransferTx, Sender: sender, AssetReceiver: receiver, XferAsset: asset}
+	}
+	appl := func(sender basics.Address, app basics.AppIndex) txntest.Txn {
+		return txntest.Txn{Type: protocol.ApplicationCallTx, Sender: sender, ApplicationID: app}
+	}
+
+	tests := []struct {
+		name      string
+		a, b      groupFootprint
+		conflicts bool
+	}{
+		{"disjoint payments", footprint(pay(addrs[0], addrs[1])), footprint(pay(addrs[2], addrs[3])), false},
+		{"same receiver", footprint(pay(addrs[0], addrs[1])), footprint(pay(addrs[2], addrs[1])), true},
+		{"receiver sends", footprint(pay(addrs[0], addrs[1])), footprint(pay(addrs[1], addrs[2])), true},
+		{"same asset", footprint(axfer(addrs[0], addrs[1], 1000)), footprint(axfer(addrs[2], addrs[3], 1000)), false},
+		{"asset reconfigured", footprint(axfer(addrs[0], addrs[1], 1000)),
+			footprint(txntest.Txn{Type: protocol.AssetConfigTx, Sender: addrs[2], ConfigAsset: 1000}), true},
+		{"same application", footprint(appl(addrs[0], 1000)), footprint(appl(addrs[1], 1000)), true},
+		{"foreign application", footprint(appl(addrs[0], 1000)),
+			footprint(txntest.Txn{Type: protocol.ApplicationCallTx, Sender: addrs[1], ApplicationID: 2000, ForeignApps: []basics.AppIndex{1000}}), true},
+		{"application account", footprint(appl(addrs[0], 1000)), footprint(pay(addrs[1], basics.AppIndex(1000).Address())), true},
+		{"distinct applications", footprint(appl(addrs[0], 1000)), footprint(appl(addrs[1], 2000)), false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.conflicts, test.a.conflicts(&test.b), test.name)
+		require.Equal(t, test.conflicts, test.b.conflicts(&test.a), test.name)
+	}
+
+	require.False(t, footprint(pay(addrs[0], addrs[1])).exclusive)
+	require.True(t, footprint(pay(addrs[0], testSinkAddr)).exclusive)
+	require.True(t, footprint(txntest.Txn{Type: protocol.StateProofTx, Sender: transactions.StateProofSender}).exclusive)
+
+	fp := footprint(pay(addrs[0], addrs[1]), txntest.Txn{Type: protocol.AssetConfigTx, Sender: addrs[0]})
+	require.True(t, fp.mayCreate)
+	require.Equal(t, uint64(2), fp.txnCount)
+}
+
+func TestEvalWithParallelism(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, addrs, _ := ledgertesting.Genesis(10)
+	l := newTestLedger(t, bookkeeping.GenesisBalances{
+		Balances:    genesisInitState.Accounts,
+		FeeSink:     testSinkAddr,
+		RewardsPool: testPoolAddr,
+	})
+	eval := l.nextBlock(t)
+
+	genHash := l.GenesisHash()
+	txn := func(txn txntest.Txn) {
+		txn.Fee = minFee
+		txn.FirstValid = eval.Round()
+		txn.LastValid = eval.Round() + 1000
+		txn.GenesisHash = genHash
+		require.NoError(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{txn.SignedTxnWithAD()}))
+	}
+	for i := 0; i < 4; i++ {
+		txn(txntest.Txn{Type: protocol.PaymentTx, Sender: addrs[i], Receiver: addrs[i+5], Amount: 1000})
+	}
+	txn(txntest.Txn{Type: protocol.PaymentTx, Sender: addrs[0], Receiver: addrs[9], Amount: 2000})
+	txn(txntest.Txn{Type: protocol.AssetConfigTx, Sender: addrs[4], AssetParams: basics.AssetParams{Total: 10}})
+	txn(txntest.Txn{Type: protocol.AssetConfigTx, Sender: addrs[8], AssetParams: basics.AssetParams{Total: 20}})
+	txn(txntest.Txn{Type: protocol.PaymentTx, Sender: addrs[8], Receiver: addrs[3], Amount: 3000})
+
+	unfinishedBlock, err := eval.GenerateBlock(nil)
+	require.NoError(t, err)
+	vb := ledgercore.MakeValidatedBlock(unfinishedBlock.UnfinishedBlock().WithProposer(committee.Seed{}, testPoolAddr, true), unfinishedBlock.UnfinishedDeltas())
+	blk := vb.Block()
+
+	sequential, err := Eval(context.Background(), l, blk, true, verify.GetMockedCache(true), nil, nil)
+	require.NoError(t, err)
+	parallel, err := EvalWithParallelism(context.Background(), l, blk, true, verify.GetMockedCache(true), nil, nil, 4)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, sequential.Accts.ModifiedAccounts(), parallel.Accts.ModifiedAccounts())
+	for _, addr := range sequential.Accts.ModifiedAccounts() {
+		expected, _ := sequential.Accts.GetData(addr)
+		actual, _ := parallel.Accts.GetData(addr)
+		require.Equal(t, expected, actual, addr.String())
+	}
+	require.Equal(t, sequential.Accts.GetAllAssetResources(), parallel.Accts.GetAllAssetResources())
+	require.Equal(t, sequential.Txids, parallel.Txids)
+	require.Equal(t, sequential.Creatables, parallel.Creatables)
+	require.Equal(t, sequential.Totals, parallel.Totals)
+
+	// a block failing the evaluation fails the same way
+	badBlock := blk
+	badBlock.Payset = append(append(transactions.Payset{}, blk.Payset...), blk.Payset[2])
+	_, sequentialErr := Eval(context.Background(), l, badBlock, true, verify.GetMockedCache(true), nil, nil)
+	require.Error(t, sequentialErr)
+	_, parallelErr := EvalWithParallelism(context.Background(), l, badBlock, true, verify.GetMockedCache(true), nil, nil, 4)
+	require.Equal(t, sequentialErr, parallelErr)
+}
+
+// requireSameEval evaluates blk sequentially and on parallel goroutines, and checks both report
+// the same error, or identical state deltas.
+func requireSameEval(t *testing.T, l *evalTestLedger, blk bookkeeping.Block, validate bool) (ledgercore.StateDelta, error) {
+	sequential, sequentialErr := EvalWithParallelism(context.Background(), l, blk, validate, verify.GetMockedCache(true), nil, nil, 1)
+	parallel, parallelErr := EvalWithParallelism(context.Background(), l, blk, validate, verify.GetMockedCache(true), nil, nil, 4)
+	require.Equal(t, sequentialErr, parallelErr)
+	if sequentialErr != nil {
+		return ledgercore.StateDelta{}, sequentialErr
+	}
+	sequential.Dehydrate()
+	parallel.Dehydrate()
+	require.Equal(t, sequential, parallel)
+	return sequential, nil
+}
+
+func TestEvalWithParallelismFallback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, addrs, _ := ledgertesting.Genesis(10)
+	l := newTestLedger(t, bookkeeping.GenesisBalances{
+		Balances:    genesisInitState.Accounts,
+		FeeSink:     testSinkAddr,
+		RewardsPool: testPoolAddr,
+	})
+
+	genHash := l.GenesisHash()
+	makeBlock := func(txns ...txntest.Txn) bookkeeping.Block {
+		eval := l.nextBlock(t)
+		for _, txn := range txns {
+			txn.Fee = minFee
+			txn.FirstValid = eval.Round()
+			txn.LastValid = eval.Round() + 1000
+			txn.GenesisHash = genHash
+			require.NoError(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{txn.SignedTxnWithAD()}))
+		}
+		unfinishedBlock, err := eval.GenerateBlock(nil)
+		require.NoError(t, err)
+		vb := ledgercore.MakeValidatedBlock(unfinishedBlock.UnfinishedBlock().WithProposer(committee.Seed{}, testPoolAddr, true), unfinishedBlock.UnfinishedDeltas())
+		return vb.Block()
+	}
+	pay := func(sender, receiver basics.Address) txntest.Txn {
+		return txntest.Txn{Type: protocol.PaymentTx, Sender: sender, Receiver: receiver, Amount: 1000}
+	}
+	create := func(sender basics.Address, total uint64) txntest.Txn {
+		return txntest.Txn{Type: protocol.AssetConfigTx, Sender: sender, AssetParams: basics.AssetParams{Total: total}}
+	}
+
+	// the groups don't conflict, so they are batched together, and the assets are numbered from the
+	// transaction counter predicted from the ApplyData of the groups before them.
+	blk := makeBlock(
+		pay(addrs[0], addrs[5]),
+		pay(addrs[1], addrs[6]),
+		create(addrs[2], 10),
+		create(addrs[3], 20),
+		pay(addrs[4], addrs[7]),
+	)
+	delta, err := requireSameEval(t, l, blk, true)
+	require.NoError(t, err)
+	require.Len(t, delta.Creatables, 2)
+
+	// an inner transaction recorded in the ApplyData of the second group, which its evaluation doesn't
+	// produce, offsets the counter predicted for the following groups. Without validation, the batch is
+	// evaluated again sequentially from that group, and the assets get the same indices.
+	tampered := blk
+	tampered.Payset = append(transactions.Payset{}, blk.Payset...)
+	tampered.Payset[1].EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{{}}
+	tamperedDelta, err := requireSameEval(t, l, tampered, false)
+	require.NoError(t, err)
+	require.Equal(t, delta.Creatables, tamperedDelta.Creatables)
+	// with validation, both fail on the ApplyData mismatch
+	_, err = requireSameEval(t, l, tampered, true)
+	require.ErrorContains(t, err, "applyData mismatch")
+
+	// the groups paying the fee sink are evaluated on their own, between the batches around them,
+	// which create assets numbered after the fee sink payments.
+	blk = makeBlock(
+		pay(addrs[0], addrs[5]),
+		create(addrs[1], 10),
+		pay(addrs[2], testSinkAddr),
+		create(addrs[3], 20),
+		pay(addrs[4], addrs[6]),
+		pay(addrs[7], testSinkAddr),
+		create(addrs[8], 30),
+		pay(addrs[9], addrs[5]),
+	)
+	delta, err = requireSameEval(t, l, blk, true)
+	require.NoError(t, err)
+	require.Len(t, delta.Creatables, 3)
+	feeSink, ok := delta.Accts.GetData(testSinkAddr)
+	require.True(t, ok)
+	before, _, err := l.LookupWithoutRewards(l.Latest(), testSinkAddr)
+	require.NoError(t, err)
+	require.Greater(t, feeSink.MicroAlgos.Raw, before.MicroAlgos.Raw+2000)
+}
+
+func TestEvalWithParallelismZeroAddress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, addrs, _ := ledgertesting.Genesis(10)
+	l := newTestLedger(t, bookkeeping.GenesisBalances{
+		Balances:    genesisInitState.Accounts,
+		FeeSink:     testSinkAddr,
+		RewardsPool: testPoolAddr,
+	})
+	eval := l.nextBlock(t)
+
+	genHash := l.GenesisHash()
+	var zero basics.Address
+	for i := 0; i < 2; i++ {
+		txn := txntest.Txn{Type: protocol.PaymentTx, Sender: addrs[i], Receiver: zero, Amount: 1000000,
+			Fee: minFee, FirstValid: eval.Round(), LastValid: eval.Round() + 1000, GenesisHash: genHash}
+		require.NoError(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{txn.SignedTxnWithAD()}))
+	}
+	unfinishedBlock, err := eval.GenerateBlock(nil)
+	require.NoError(t, err)
+	vb := ledgercore.MakeValidatedBlock(unfinishedBlock.UnfinishedBlock().WithProposer(committee.Seed{}, testPoolAddr, true), unfinishedBlock.UnfinishedDeltas())
+
+	// both payments credit the zero address, so they conflict
+	groups, err := vb.Block().DecodePaysetGroups()
+	require.NoError(t, err)
+	a, err := eval.makeGroupFootprint(groups[0])
+	require.NoError(t, err)
+	b, err := eval.makeGroupFootprint(groups[1])
+	require.NoError(t, err)
+	require.True(t, a.conflicts(&b))
+
+	delta, err := requireSameEval(t, l, vb.Block(), true)
+	require.NoError(t, err)
+	zeroData, ok := delta.Accts.GetData(zero)
+	require.True(t, ok)
+	before, _, err := l.LookupWithoutRewards(l.Latest(), zero)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, zeroData.MicroAlgos.Raw, before.MicroAlgos.Raw+2000000)
+}
+
+func TestEvalWithParallelismCreatedAssetCreator(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, addrs, _ := ledgertesting.Genesis(10)
+	l := newTestLedger(t, bookkeeping.GenesisBalances{
+		Balances:    genesisInitState.Accounts,
+		FeeSink:     testSinkAddr,
+		RewardsPool: testPoolAddr,
+	})
+	eval := l.nextBlock(t)
+	asset := basics.AssetIndex(eval.state.Counter() + 1)
+
+	genHash := l.GenesisHash()
+	txn := func(txn txntest.Txn) {
+		txn.Fee = minFee
+		txn.FirstValid = eval.Round()
+		txn.LastValid = eval.Round() + 1000
+		txn.GenesisHash = genHash
+		require.NoError(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{txn.SignedTxnWithAD()}))
+	}
+	// the asset is created in a batch of its own. Its creator is only known once that batch is
+	// evaluated, and must then be part of the footprint of the destroy by the manager, which
+	// conflicts with the payment of the creator.
+	txn(txntest.Txn{Type: protocol.AssetConfigTx, Sender: addrs[2], AssetParams: basics.AssetParams{Total: 10, Manager: addrs[5]}})
+	txn(txntest.Txn{Type: protocol.AssetConfigTx, Sender: addrs[5], ConfigAsset: asset})
+	txn(txntest.Txn{Type: protocol.PaymentTx, Sender: addrs[2], Receiver: addrs[6], Amount: 1000})
+
+	unfinishedBlock, err := eval.GenerateBlock(nil)
+	require.NoError(t, err)
+	vb := ledgercore.MakeValidatedBlock(unfinishedBlock.UnfinishedBlock().WithProposer(committee.Seed{}, testPoolAddr, true), unfinishedBlock.UnfinishedDeltas())
+
+	sequential, err := Eval(context.Background(), l, vb.Block(), true, verify.GetMockedCache(true), nil, nil)
+	require.NoError(t, err)
+	delta, err := requireSameEval(t, l, vb.Block(), true)
+	require.NoError(t, err)
+	sequential.Dehydrate()
+	require.Equal(t, sequential, delta)
+}
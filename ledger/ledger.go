@@ -18,7 +18,7 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"time"
@@ -53,7 +53,11 @@ type Ledger struct {
 	// We use potentially different databases to avoid SQLite contention
 	// during catchup.
 	trackerDBs trackerdb.Store
-	blockDBs   db.Pair
+	blockDBs   blockdb.Store
+
+	// historyDBs stores the account history, it is only opened when
+	// AccountHistoryRounds is set.
+	historyDBs db.Pair
 
 	// blockQ is the buffer of added blocks that will be flushed to
 	// persistent storage
@@ -75,6 +79,7 @@ type Ledger struct {
 	genesisHash crypto.Digest
 
 	genesisAccounts map[basics.Address]basics.AccountData
+	genesisBoxes    map[string][]byte
 
 	genesisProto        config.ConsensusParams
 	genesisProtoVersion protocol.ConsensusVersion
@@ -82,6 +87,7 @@ type Ledger struct {
 	// State-machine trackers
 	accts          accountUpdates
 	acctsOnline    onlineAccounts
+	acctsHistory   accountHistoryTracker
 	catchpoint     catchpointTracker
 	txTail         txTail
 	bulletinDisk   bulletin
@@ -93,6 +99,9 @@ type Ledger struct {
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
 
+	// stateObservers delivers the balance and application state changes of the added blocks
+	stateObservers stateChangeNotifier
+
 	// verifiedTxnCache holds all the verified transactions state
 	verifiedTxnCache verify.VerifiedTransactionCache
 
@@ -109,6 +118,63 @@ type DirsAndPrefix struct {
 	DBFilePrefix string // the prefix of the database files, appended to genesis directories
 }
 
+// resolveDirsAndPrefix returns the directories and the database file prefix of the ledger from the path
+// given to OpenLedger.
+func resolveDirsAndPrefix[T string | DirsAndPrefix](dbPathPrefix T) (dirs DirsAndPrefix) {
+	// if only a string path has been supplied for the ledger, use it for all resources
+	// don't set the prefix, only tests provide a string for the path, and they manage paths explicitly
+	if s, ok := any(dbPathPrefix).(string); ok {
+		dirs.HotGenesisDir = s
+		dirs.TrackerGenesisDir = s
+		dirs.ColdGenesisDir = s
+		dirs.BlockGenesisDir = s
+		dirs.CatchpointGenesisDir = s
+	} else if ds, ok := any(dbPathPrefix).(DirsAndPrefix); ok {
+		// if a DirsAndPrefix has been supplied, use it.
+		dirs = ds
+	}
+	return dirs
+}
+
+// boundMemoryConfig returns the configuration with the settings trading the memory used by the ledger for its
+// latency brought within their supported bounds.
+func boundMemoryConfig(log logging.Logger, cfg config.Local) config.Local {
+	if cfg.MaxAcctLookback > maxAcctLookbackLimit {
+		log.Warnf("The MaxAcctLookback in the config file is too large; it was adjusted from %d to %d.", cfg.MaxAcctLookback, maxAcctLookbackLimit)
+		cfg.MaxAcctLookback = maxAcctLookbackLimit
+	}
+	if cfg.LedgerAccountsCacheSize < minBaseAccountsCacheSize {
+		log.Warnf("The LedgerAccountsCacheSize in the config file is too small; it was adjusted from %d to %d.", cfg.LedgerAccountsCacheSize, minBaseAccountsCacheSize)
+		cfg.LedgerAccountsCacheSize = minBaseAccountsCacheSize
+	} else if cfg.LedgerAccountsCacheSize > maxBaseAccountsCacheSize {
+		log.Warnf("The LedgerAccountsCacheSize in the config file is too large; it was adjusted from %d to %d.", cfg.LedgerAccountsCacheSize, maxBaseAccountsCacheSize)
+		cfg.LedgerAccountsCacheSize = maxBaseAccountsCacheSize
+	}
+	if cfg.TxTailRetainRounds > maxTxTailRetainRounds {
+		log.Warnf("The TxTailRetainRounds in the config file is too large; it was adjusted from %d to %d.", cfg.TxTailRetainRounds, maxTxTailRetainRounds)
+		cfg.TxTailRetainRounds = maxTxTailRetainRounds
+	}
+	return cfg
+}
+
+// participationStorageConfig returns the configuration with the settings keeping ledger data beyond what is
+// required to validate blocks and vote disabled, when ParticipationStorageMode is set.
+func participationStorageConfig(log logging.Logger, cfg config.Local) config.Local {
+	if !cfg.ParticipationStorageMode {
+		return cfg
+	}
+	if cfg.Archival {
+		log.Warnf("The ParticipationStorageMode in the config file is ignored since Archival is set.")
+		cfg.ParticipationStorageMode = false
+		return cfg
+	}
+	cfg.CatchpointTracking = config.CatchpointTrackingModeUntracked
+	cfg.AccountHistoryRounds = 0
+	cfg.MaxBlockHistoryLookback = 0
+	cfg.TxTailRetainRounds = 0
+	return cfg
+}
+
 // OpenLedger creates a Ledger object, using SQLite database filenames
 // based on dbPathPrefix (in-memory if dbMem is true). genesisInitState.Blocks and
 // genesisInitState.Accounts specify the initial blocks and accounts to use if the
@@ -122,30 +188,21 @@ func OpenLedger[T string | DirsAndPrefix](
 		verifiedCacheSize = cfg.TxPoolSize
 		log.Warnf("The VerifiedTranscationsCacheSize in the config file was misconfigured to have smaller size then the TxPoolSize; The verified cache size was adjusted from %d to %d.", cfg.VerifiedTranscationsCacheSize, cfg.TxPoolSize)
 	}
+	cfg = boundMemoryConfig(log, cfg)
+	cfg = participationStorageConfig(log, cfg)
 	var tracer logic.EvalTracer
 	if cfg.EnableTxnEvalTracer {
 		tracer = eval.MakeTxnGroupDeltaTracer(cfg.MaxAcctLookback)
 	}
 
-	var dirs DirsAndPrefix
-	// if only a string path has been supplied for the ledger, use it for all resources
-	// don't set the prefix, only tests provide a string for the path, and they manage paths explicitly
-	if s, ok := any(dbPathPrefix).(string); ok {
-		dirs.HotGenesisDir = s
-		dirs.TrackerGenesisDir = s
-		dirs.ColdGenesisDir = s
-		dirs.BlockGenesisDir = s
-		dirs.CatchpointGenesisDir = s
-	} else if ds, ok := any(dbPathPrefix).(DirsAndPrefix); ok {
-		// if a DirsAndPrefix has been supplied, use it.
-		dirs = ds
-	}
+	dirs := resolveDirsAndPrefix(dbPathPrefix)
 
 	l := &Ledger{
 		log:                            log,
 		archival:                       cfg.Archival,
 		genesisHash:                    genesisInitState.GenesisHash,
 		genesisAccounts:                genesisInitState.Accounts,
+		genesisBoxes:                   genesisInitState.Boxes,
 		genesisProto:                   config.Consensus[genesisInitState.Block.CurrentProtocol],
 		genesisProtoVersion:            genesisInitState.Block.CurrentProtocol,
 		synchronousMode:                db.SynchronousMode(cfg.LedgerSynchronousMode),
@@ -168,11 +225,19 @@ func OpenLedger[T string | DirsAndPrefix](
 		return nil, err
 	}
 
+	if cfg.AccountHistoryRounds > 0 {
+		l.historyDBs, err = openAccountHistoryDB(dirs, dbMem, log)
+		if err != nil {
+			err = fmt.Errorf("OpenLedger.openAccountHistoryDB %v", err)
+			return nil, err
+		}
+	}
+
 	l.setSynchronousMode(context.Background(), l.synchronousMode)
 
 	start := time.Now()
 	ledgerInitblocksdbCount.Inc(nil)
-	err = l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+	err = l.blockDBs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) error {
 		return initBlocksDB(tx, l.log, []bookkeeping.Block{genesisInitState.Block}, cfg.Archival)
 	})
 	ledgerInitblocksdbMicros.AddMicrosecondsSince(start, nil)
@@ -195,6 +260,8 @@ func OpenLedger[T string | DirsAndPrefix](
 		return nil, err
 	}
 
+	l.stateObservers.start()
+
 	return l, nil
 }
 
@@ -239,6 +306,7 @@ func (l *Ledger) reloadLedger() error {
 		&l.accts,          // update the balances
 		&l.catchpoint,     // catchpoints tracker : update catchpoint labels, create catchpoint files
 		&l.acctsOnline,    // update online account balances history
+		&l.acctsHistory,   // keep the history of the accounts balances
 		&l.txTail,         // update the transaction tail, tracking the recent 1000 txn
 		&l.bulletinDisk,   // provide closed channel signaling support for completed rounds on disk
 		&l.bulletinMem,    // provide closed channel signaling support for completed rounds in memory
@@ -249,6 +317,8 @@ func (l *Ledger) reloadLedger() error {
 
 	l.accts.initialize(l.cfg)
 	l.acctsOnline.initialize(l.cfg)
+	l.acctsHistory.initialize(l.cfg, l.historyDBs)
+	l.txTail.initialize(l.cfg)
 
 	l.catchpoint.initialize(l.cfg, l.dirsAndPrefix)
 
@@ -267,12 +337,18 @@ func (l *Ledger) reloadLedger() error {
 	l.notifier.register(blockListeners)
 
 	// post-init actions
-	if trackerDBInitParams.VacuumOnStartup || l.cfg.OptimizeAccountsDatabaseOnStartup {
+	if trackerDBInitParams.VacuumOnStartup || l.cfg.OptimizeAccountsDatabaseOnStartup || l.catchpoint.hashesDiscarded {
 		err = l.accts.vacuumDatabase(context.Background())
 		if err != nil {
 			return err
 		}
 	}
+	if l.cfg.DatabaseMaintenanceInterval > 0 {
+		err = l.enableIncrementalVacuum(context.Background())
+		if err != nil {
+			return err
+		}
+	}
 
 	// Check that the genesis hash, if present, matches.
 	err = l.verifyMatchingGenesisHash()
@@ -282,18 +358,66 @@ func (l *Ledger) reloadLedger() error {
 	return nil
 }
 
+// incrementalVacuumEnabler is implemented by the ledger databases which can be switched to incremental vacuum.
+type incrementalVacuumEnabler interface {
+	GetPageStats(ctx context.Context) (db.PageStats, error)
+	EnableIncrementalVacuum(ctx context.Context) (db.VacuumStats, error)
+}
+
+// enableIncrementalVacuum switches the ledger databases to incremental vacuum, so that the database maintenance
+// can reclaim their unused pages while the node runs.
+func (l *Ledger) enableIncrementalVacuum(ctx context.Context) error {
+	err := l.accts.enableIncrementalVacuum(ctx)
+	if err != nil {
+		return err
+	}
+
+	enabler, ok := l.blockDBs.(incrementalVacuumEnabler)
+	if !ok {
+		return nil
+	}
+	pageStats, err := enabler.GetPageStats(ctx)
+	if err != nil || pageStats.IncrementalVacuum {
+		return err
+	}
+	l.log.Infof("Enabling incremental vacuuming of the blocks database, which requires vacuuming it")
+	vacuumStats, err := enabler.EnableIncrementalVacuum(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to enable incremental vacuuming of the blocks database: %w", err)
+	}
+	l.log.Infof("Enabled incremental vacuuming of the blocks database, reducing number of pages from %d to %d", vacuumStats.PagesBefore, vacuumStats.PagesAfter)
+	return nil
+}
+
+// MaintainableDatabases returns the ledger databases which can be maintained while the node runs, by name.
+func (l *Ledger) MaintainableDatabases() map[string]db.Maintainable {
+	dbs := make(map[string]db.Maintainable)
+	if m, ok := l.trackerDBs.(db.Maintainable); ok {
+		dbs["tracker"] = m
+	}
+	if m, ok := l.blockDBs.(db.Maintainable); ok {
+		dbs["block"] = m
+	}
+	return dbs
+}
+
+// IsWritingAccounts returns true while the ledger is writing the state of committed rounds to its databases.
+func (l *Ledger) IsWritingAccounts() bool {
+	return l.trackers.accountsCommitting.Load()
+}
+
 // verifyMatchingGenesisHash tests to see that the latest block header pointing to the same genesis hash provided in genesisHash.
 func (l *Ledger) verifyMatchingGenesisHash() (err error) {
 	// Check that the genesis hash, if present, matches.
 	start := time.Now()
 	ledgerVerifygenhashCount.Inc(nil)
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err := blockdb.BlockLatest(tx)
+	err = l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) error {
+		latest, err := tx.BlockLatest()
 		if err != nil {
 			return err
 		}
 
-		hdr, err := blockdb.BlockGetHdr(tx, latest)
+		hdr, err := tx.BlockGetHdr(latest)
 		if err != nil {
 			return err
 		}
@@ -311,7 +435,7 @@ func (l *Ledger) verifyMatchingGenesisHash() (err error) {
 	return
 }
 
-func openLedgerDB(dbPrefixes DirsAndPrefix, dbMem bool, cfg config.Local, log logging.Logger) (trackerDBs trackerdb.Store, blockDBs db.Pair, err error) {
+func openLedgerDB(dbPrefixes DirsAndPrefix, dbMem bool, cfg config.Local, log logging.Logger) (trackerDBs trackerdb.Store, blockDBs blockdb.Store, err error) {
 	outErr := make(chan error, 2)
 	go func() {
 		trackerDBPrefix := filepath.Join(dbPrefixes.ResolvedGenesisDirs.TrackerGenesisDir, dbPrefixes.DBFilePrefix)
@@ -324,23 +448,16 @@ func openLedgerDB(dbPrefixes DirsAndPrefix, dbMem bool, cfg config.Local, log lo
 		case "sqlite":
 			fallthrough
 		default:
-			trackerDBs, lerr = sqlitedriver.Open(trackerDBPrefix+".tracker.sqlite", dbMem, log)
+			trackerDBs, lerr = sqlitedriver.Open(sqliteTrackerDBPath(dbPrefixes), dbMem, log)
 		}
 
 		outErr <- lerr
 	}()
 
 	go func() {
-		blockDBPrefix := filepath.Join(dbPrefixes.ResolvedGenesisDirs.BlockGenesisDir, dbPrefixes.DBFilePrefix)
 		var lerr error
-		blockDBs, lerr = db.OpenPair(blockDBPrefix+".block.sqlite", dbMem)
-		if lerr != nil {
-			outErr <- lerr
-			return
-		}
-		blockDBs.Rdb.SetLogger(log)
-		blockDBs.Wdb.SetLogger(log)
-		outErr <- nil
+		blockDBs, lerr = openBlockDB(dbPrefixes, dbMem, cfg, log)
+		outErr <- lerr
 	}()
 
 	err = <-outErr
@@ -351,6 +468,32 @@ func openLedgerDB(dbPrefixes DirsAndPrefix, dbMem bool, cfg config.Local, log lo
 	return
 }
 
+// sqliteTrackerDBPath returns the path of the tracker database of the sqlite storage engine.
+func sqliteTrackerDBPath(dbPrefixes DirsAndPrefix) string {
+	return filepath.Join(dbPrefixes.ResolvedGenesisDirs.TrackerGenesisDir, dbPrefixes.DBFilePrefix) + ".tracker.sqlite"
+}
+
+// blockDBPath returns the path of the block database of the configured storage engine.
+func blockDBPath(dbPrefixes DirsAndPrefix, cfg config.Local) string {
+	blockDBPrefix := filepath.Join(dbPrefixes.ResolvedGenesisDirs.BlockGenesisDir, dbPrefixes.DBFilePrefix)
+	if cfg.BlockStorageEngine == "pebbledb" {
+		return blockDBPrefix + ".block.pebbledb"
+	}
+	return blockDBPrefix + ".block.sqlite"
+}
+
+func openBlockDB(dbPrefixes DirsAndPrefix, dbMem bool, cfg config.Local, log logging.Logger) (blockdb.Store, error) {
+	switch cfg.BlockStorageEngine {
+	case "pebbledb":
+		return blockdb.OpenPebble(blockDBPath(dbPrefixes, cfg), dbMem, log)
+	// anything else will initialize a sqlite engine.
+	case "sqlite":
+		fallthrough
+	default:
+		return blockdb.OpenSQLite(blockDBPath(dbPrefixes, cfg), dbMem, log)
+	}
+}
+
 // setSynchronousMode sets the writing database connections synchronous mode to the specified mode
 func (l *Ledger) setSynchronousMode(ctx context.Context, synchronousMode db.SynchronousMode) {
 	if synchronousMode < db.SynchronousModeOff || synchronousMode > db.SynchronousModeExtra {
@@ -358,7 +501,7 @@ func (l *Ledger) setSynchronousMode(ctx context.Context, synchronousMode db.Sync
 		return
 	}
 
-	err := l.blockDBs.Wdb.SetSynchronousMode(ctx, synchronousMode, synchronousMode >= db.SynchronousModeFull)
+	err := l.blockDBs.SetSynchronousMode(ctx, synchronousMode, synchronousMode >= db.SynchronousModeFull)
 	if err != nil {
 		l.log.Warnf("ledger.setSynchronousMode unable to set synchronous mode on blocks db: %v", err)
 		return
@@ -374,8 +517,8 @@ func (l *Ledger) setSynchronousMode(ctx context.Context, synchronousMode db.Sync
 // initBlocksDB performs DB initialization:
 // - creates and populates it with genesis blocks
 // - ensures DB is in good shape for archival mode and resets it if not
-func initBlocksDB(tx *sql.Tx, log logging.Logger, initBlocks []bookkeeping.Block, isArchival bool) (err error) {
-	err = blockdb.BlockInit(tx, initBlocks)
+func initBlocksDB(tx blockdb.ReaderWriter, log logging.Logger, initBlocks []bookkeeping.Block, isArchival bool) (err error) {
+	err = tx.BlockInit(initBlocks)
 	if err != nil {
 		err = fmt.Errorf("initBlocksDB.blockInit %v", err)
 		return err
@@ -383,7 +526,7 @@ func initBlocksDB(tx *sql.Tx, log logging.Logger, initBlocks []bookkeeping.Block
 
 	// in archival mode check if DB contains all blocks up to the latest
 	if isArchival {
-		earliest, err := blockdb.BlockEarliest(tx)
+		earliest, err := tx.BlockEarliest()
 		if err != nil {
 			err = fmt.Errorf("initBlocksDB.blockEarliest %v", err)
 			return err
@@ -393,12 +536,12 @@ func initBlocksDB(tx *sql.Tx, log logging.Logger, initBlocks []bookkeeping.Block
 		// So reset the DB and init it again
 		if earliest != basics.Round(0) {
 			log.Warnf("resetting blocks DB (earliest block is %v)", earliest)
-			err := blockdb.BlockResetDB(tx)
+			err := tx.BlockResetDB()
 			if err != nil {
 				err = fmt.Errorf("initBlocksDB.blockResetDB %v", err)
 				return err
 			}
-			err = blockdb.BlockInit(tx, initBlocks)
+			err = tx.BlockInit(initBlocks)
 			if err != nil {
 				err = fmt.Errorf("initBlocksDB.blockInit 2 %v", err)
 				return err
@@ -418,6 +561,8 @@ func (l *Ledger) Close() {
 		l.blockQ.stop()
 	}
 
+	l.stateObservers.close()
+
 	// take the trackers lock. This would ensure that no other goroutine is using the trackers.
 	l.trackerMu.Lock()
 	defer l.trackerMu.Unlock()
@@ -428,6 +573,7 @@ func (l *Ledger) Close() {
 	// last, we close the underlying database connections.
 	l.blockDBs.Close()
 	l.trackerDBs.Close()
+	l.historyDBs.Close()
 }
 
 // RegisterBlockListeners registers listeners that will be called when a
@@ -438,6 +584,12 @@ func (l *Ledger) RegisterBlockListeners(listeners []ledgercore.BlockListener) {
 	l.notifier.register(listeners)
 }
 
+// RegisterStateChangeObservers registers observers that will be called with the balance
+// and application state changes of every new block added to the ledger.
+func (l *Ledger) RegisterStateChangeObservers(observers []StateChangeObserver) {
+	l.stateObservers.register(observers)
+}
+
 // RegisterVotersCommitListener registers a listener that will be called when a
 // commit is about to cover a round.
 func (l *Ledger) RegisterVotersCommitListener(listener ledgercore.VotersCommitListener) {
@@ -583,6 +735,55 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	return data, rnd, withoutRewards, nil
 }
 
+// LookupAccountHistory returns the account data for a given address at a given round,
+// which may be older than the rounds kept in memory when the ledger keeps the account history
+// (see AccountHistoryRounds). The account's rewards are added to the AccountData before returning.
+// Note that the function doesn't update the account with the rewards,
+// even while it does return the AccountData which represent the "rewarded" account data.
+func (l *Ledger) LookupAccountHistory(round basics.Round, addr basics.Address) (data ledgercore.AccountData, withoutRewards basics.MicroAlgos, err error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	for {
+		data, _, _, _, err = l.accts.lookupWithoutRewards(round, addr, true /* take lock */)
+		var roundOffsetError *RoundOffsetError
+		if err == nil || !errors.As(err, &roundOffsetError) {
+			break
+		}
+
+		var found bool
+		var unchangedThrough basics.Round
+		data, found, unchangedThrough, err = l.acctsHistory.lookup(round, addr)
+		if err == errAccountHistoryBehind {
+			continue
+		}
+		if err != nil || found {
+			break
+		}
+
+		// the account was not modified since round, so its state is the one of the round the history
+		// was committed up to. The account updates might have been committed past that round meanwhile,
+		// in which case the history is about to catch up.
+		data, _, _, _, err = l.accts.lookupWithoutRewards(unchangedThrough, addr, true /* take lock */)
+		if err == nil || !errors.As(err, &roundOffsetError) {
+			break
+		}
+	}
+	if err != nil {
+		return ledgercore.AccountData{}, basics.MicroAlgos{}, err
+	}
+
+	hdr, err := l.BlockHdr(round)
+	if err != nil {
+		return ledgercore.AccountData{}, basics.MicroAlgos{}, err
+	}
+
+	// Intentionally apply (pending) rewards up to round, remembering the old value
+	withoutRewards = data.MicroAlgos
+	data = data.WithUpdatedRewards(config.Consensus[hdr.CurrentProtocol], hdr.RewardsLevel)
+	return data, withoutRewards, nil
+}
+
 // LookupApplication loads an application resource that matches the request parameters from the ledger.
 func (l *Ledger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
 	r, err := l.lookupResource(rnd, addr, basics.CreatableIndex(aidx), basics.AppCreatable)
@@ -632,6 +833,16 @@ func (l *Ledger) LookupKeysByPrefix(round basics.Round, keyPrefix string, maxKey
 	return l.accts.LookupKeysByPrefix(round, keyPrefix, maxKeyNum)
 }
 
+// LookupKvsByPrefix returns, in key order, up to maxKeyNum keys with the given prefix which sort after the
+// `after` key, along with their values, as of the given round. Reading the pages of a prefix at the same
+// round gives a consistent view of its keys, as long as the round is kept in memory by the ledger.
+func (l *Ledger) LookupKvsByPrefix(round basics.Round, keyPrefix string, after string, maxKeyNum uint64) ([]ledgercore.KvPair, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	return l.accts.LookupKvsByPrefix(round, keyPrefix, after, maxKeyNum)
+}
+
 // LookupAgreement returns account data used by agreement.
 func (l *Ledger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
 	l.trackerMu.RLock()
@@ -794,7 +1005,7 @@ func (l *Ledger) BlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreem
 func (l *Ledger) AddBlock(blk bookkeeping.Block, cert agreement.Certificate) error {
 	// passing nil as the executionPool is ok since we've asking the evaluator to skip verification.
 
-	updates, err := eval.Eval(context.Background(), l, blk, false, l.verifiedTxnCache, nil, l.tracer)
+	updates, err := eval.EvalWithParallelism(context.Background(), l, blk, false, l.verifiedTxnCache, nil, l.tracer, l.cfg.BlockEvalParallelism)
 	if err != nil {
 		if errNSBE, ok := err.(ledgercore.ErrNonSequentialBlockEval); ok && errNSBE.EvaluatorRound <= errNSBE.LatestRound {
 			return ledgercore.BlockInLedgerError{
@@ -815,6 +1026,19 @@ func (l *Ledger) AddBlock(blk bookkeeping.Block, cert agreement.Certificate) err
 // the block has previously been validated.  Otherwise, AddValidatedBlock
 // behaves like AddBlock.
 func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) error {
+	// The state changes are resolved against the previous round before the block is added,
+	// so that the lookups do not need to be made while holding the tracker lock exclusively.
+	var stateChanges roundStateChanges
+	notifyObservers := l.stateObservers.hasObservers()
+	if notifyObservers {
+		var err error
+		stateChanges, err = collectStateChanges(l, vb.Block().Round(), vb.Delta())
+		if err != nil {
+			l.log.Warnf("ledger.AddValidatedBlock: unable to collect the state changes of round %d for the observers: %v", vb.Block().Round(), err)
+			notifyObservers = false
+		}
+	}
+
 	// Grab the tracker lock first, to ensure newBlock() is notified before committedUpTo().
 	t0 := time.Now()
 	l.trackerMu.Lock()
@@ -830,6 +1054,9 @@ func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.
 		return err
 	}
 	l.trackers.newBlock(blk, vb.Delta())
+	if notifyObservers {
+		l.stateObservers.notify(stateChanges)
+	}
 	l.log.Debugf("ledger.AddValidatedBlock: added blk %d", blk.Round())
 	return nil
 }
@@ -890,6 +1117,11 @@ func (l *Ledger) GenesisAccounts() map[basics.Address]basics.AccountData {
 	return l.genesisAccounts
 }
 
+// GenesisBoxes returns the initial boxes for this ledger, keyed by their ledger key.
+func (l *Ledger) GenesisBoxes() map[string][]byte {
+	return l.genesisBoxes
+}
+
 // GetCatchpointCatchupState returns the current state of the catchpoint catchup.
 func (l *Ledger) GetCatchpointCatchupState(ctx context.Context) (state CatchpointCatchupState, err error) {
 	return MakeCatchpointCatchupAccessor(l, l.log).GetState(ctx)
@@ -912,7 +1144,7 @@ func (l *Ledger) trackerDB() trackerdb.Store {
 }
 
 // ledgerForTracker methods
-func (l *Ledger) blockDB() db.Pair {
+func (l *Ledger) blockDB() blockdb.Store {
 	return l.blockDBs
 }
 
@@ -925,7 +1157,7 @@ func (l *Ledger) trackerLog() logging.Logger {
 // evaluator to shortcut the "main" ledger ( i.e. this struct ) and avoid taking the trackers lock a second time.
 func (l *Ledger) trackerEvalVerified(blk bookkeeping.Block, accUpdatesLedger eval.LedgerForEvaluator) (ledgercore.StateDelta, error) {
 	// passing nil as the executionPool is ok since we've asking the evaluator to skip verification.
-	return eval.Eval(context.Background(), accUpdatesLedger, blk, false, l.verifiedTxnCache, nil, l.tracer)
+	return eval.EvalWithParallelism(context.Background(), accUpdatesLedger, blk, false, l.verifiedTxnCache, nil, l.tracer, l.cfg.BlockEvalParallelism)
 }
 
 // IsWritingCatchpointDataFile returns true when a catchpoint file is being generated.
@@ -976,7 +1208,7 @@ func (l *Ledger) FlushCaches() {
 // not a valid block (e.g., it has duplicate transactions, overspends some
 // account, etc.).
 func (l *Ledger) Validate(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) (*ledgercore.ValidatedBlock, error) {
-	delta, err := eval.Eval(ctx, l, blk, true, l.verifiedTxnCache, executionPool, l.tracer)
+	delta, err := eval.EvalWithParallelism(ctx, l, blk, true, l.verifiedTxnCache, executionPool, l.tracer, l.cfg.BlockEvalParallelism)
 	if err != nil {
 		return nil, err
 	}
@@ -3523,3 +3523,63 @@ func TestLedgerRegisterBlockListeners(t *testing.T) {
 	}
 	require.Equal(t, []int{1, 2, 3}, ids)
 }
+
+func TestLedgerBoundMemoryConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	log := logging.TestingLog(t)
+
+	cfg := config.GetDefaultLocal()
+	require.Equal(t, cfg, boundMemoryConfig(log, cfg))
+
+	cfg.MaxAcctLookback = maxAcctLookbackLimit + 1
+	cfg.LedgerAccountsCacheSize = minBaseAccountsCacheSize - 1
+	cfg.TxTailRetainRounds = maxTxTailRetainRounds + 1
+	bounded := boundMemoryConfig(log, cfg)
+	require.Equal(t, uint64(maxAcctLookbackLimit), bounded.MaxAcctLookback)
+	require.Equal(t, minBaseAccountsCacheSize, bounded.LedgerAccountsCacheSize)
+	require.Equal(t, uint64(maxTxTailRetainRounds), bounded.TxTailRetainRounds)
+
+	cfg.LedgerAccountsCacheSize = maxBaseAccountsCacheSize + 1
+	require.Equal(t, maxBaseAccountsCacheSize, boundMemoryConfig(log, cfg).LedgerAccountsCacheSize)
+
+	// the bounds only apply to the ledger, the config itself is left as is
+	require.Equal(t, uint64(maxAcctLookbackLimit+1), cfg.MaxAcctLookback)
+
+	// the accounts cache warns at the same ratio of its size as it does by default
+	au := &accountUpdates{}
+	au.initialize(config.Local{LedgerAccountsCacheSize: 2000})
+	require.Equal(t, 2000, au.baseAccountsCacheSize)
+	require.Equal(t, 1700, au.baseAccountsCacheWarnThreshold)
+}
+
+func TestLedgerParticipationStorageConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	log := logging.TestingLog(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointTracking = config.CatchpointTrackingModeTracked
+	cfg.AccountHistoryRounds = 100
+	cfg.MaxBlockHistoryLookback = 1000
+	cfg.TxTailRetainRounds = 5000
+	require.Equal(t, cfg, participationStorageConfig(log, cfg))
+
+	cfg.ParticipationStorageMode = true
+	pcfg := participationStorageConfig(log, cfg)
+	require.True(t, pcfg.ParticipationStorageMode)
+	require.EqualValues(t, config.CatchpointTrackingModeUntracked, pcfg.CatchpointTracking)
+	require.False(t, pcfg.TracksCatchpoints())
+	require.Zero(t, pcfg.AccountHistoryRounds)
+	require.Zero(t, pcfg.MaxBlockHistoryLookback)
+	require.Zero(t, pcfg.TxTailRetainRounds)
+
+	// archival nodes keep everything
+	cfg.Archival = true
+	acfg := participationStorageConfig(log, cfg)
+	require.False(t, acfg.ParticipationStorageMode)
+	require.Equal(t, cfg.CatchpointTracking, acfg.CatchpointTracking)
+	require.Equal(t, cfg.AccountHistoryRounds, acfg.AccountHistoryRounds)
+}
@@ -27,6 +27,8 @@ type InitState struct {
 	Block       bookkeeping.Block
 	Accounts    map[basics.Address]basics.AccountData
 	GenesisHash crypto.Digest
+	// Boxes holds the genesis boxes keyed by their ledger key
+	Boxes map[string][]byte
 }
 
 // BlockListener represents an object that needs to get notified on new blocks.
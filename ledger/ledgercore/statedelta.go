@@ -74,6 +74,12 @@ type IncludedTransactions struct {
 	Intra     uint64 // the index of the transaction in the block
 }
 
+// KvPair is a key of the kvstore along with its value.
+type KvPair struct {
+	Key   string
+	Value []byte
+}
+
 // A KvValueDelta shows how the Data associated with a key in the kvstore has
 // changed.  However, OldData is elided during evaluation, and only filled in at
 // the conclusion of a block during the called to roundCowState.deltas()
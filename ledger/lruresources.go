@@ -42,6 +42,11 @@ type lruResources struct {
 	// if lruResources is set with pendingWrites 0, then resources is nil
 	resources map[accountCreatable]*util.ListNode[*cachedResourceData]
 
+	// addressResources indexes the entries of resources by their address, so that all the cached
+	// resources of a single account can be retrieved without scanning the entire cache.
+	// if lruResources is set with pendingWrites 0, then addressResources is nil
+	addressResources map[basics.Address]map[basics.CreatableIndex]*util.ListNode[*cachedResourceData]
+
 	// pendingResources are used as a way to avoid taking a write-lock. When the caller needs to "materialize" these,
 	// it would call flushPendingWrites and these would be merged into the resources/resourcesList
 	// if lruResources is set with pendingWrites 0, then pendingResources is nil
@@ -64,6 +69,7 @@ func (m *lruResources) init(log logging.Logger, pendingWrites int, pendingWrites
 	if pendingWrites > 0 {
 		m.resourcesList = util.NewList[*cachedResourceData]().AllocateFreeNodes(pendingWrites)
 		m.resources = make(map[accountCreatable]*util.ListNode[*cachedResourceData], pendingWrites)
+		m.addressResources = make(map[basics.Address]map[basics.CreatableIndex]*util.ListNode[*cachedResourceData])
 		m.pendingResources = make(chan cachedResourceData, pendingWrites)
 		m.notFound = make(map[accountCreatable]struct{}, pendingWrites)
 		m.pendingNotFound = make(chan accountCreatable, pendingWrites)
@@ -91,10 +97,8 @@ func (m *lruResources) readNotFound(addr basics.Address, idx basics.CreatableInd
 // read the persistedResourcesData object that the lruResources has for the given address.
 // thread locking semantics : read lock
 func (m *lruResources) readAll(addr basics.Address) (ret []trackerdb.PersistedResourcesData) {
-	for ac, pd := range m.resources {
-		if ac.address == addr {
-			ret = append(ret, pd.Value.PersistedResourcesData)
-		}
+	for _, pd := range m.addressResources[addr] {
+		ret = append(ret, pd.Value.PersistedResourcesData)
 	}
 	return
 }
@@ -167,7 +171,14 @@ func (m *lruResources) write(resData trackerdb.PersistedResourcesData, addr basi
 		m.resourcesList.MoveToFront(el)
 	} else {
 		// new entry.
-		m.resources[accountCreatable{address: addr, index: resData.Aidx}] = m.resourcesList.PushFront(&cachedResourceData{PersistedResourcesData: resData, address: addr})
+		el := m.resourcesList.PushFront(&cachedResourceData{PersistedResourcesData: resData, address: addr})
+		m.resources[accountCreatable{address: addr, index: resData.Aidx}] = el
+		addrResources, ok := m.addressResources[addr]
+		if !ok {
+			addrResources = make(map[basics.CreatableIndex]*util.ListNode[*cachedResourceData])
+			m.addressResources[addr] = addrResources
+		}
+		addrResources[resData.Aidx] = el
 	}
 }
 
@@ -184,6 +195,12 @@ func (m *lruResources) prune(newSize int) (removed int) {
 		}
 		back := m.resourcesList.Back()
 		delete(m.resources, accountCreatable{address: back.Value.address, index: back.Value.Aidx})
+		if addrResources := m.addressResources[back.Value.address]; addrResources != nil {
+			delete(addrResources, back.Value.Aidx)
+			if len(addrResources) == 0 {
+				delete(m.addressResources, back.Value.address)
+			}
+		}
 		m.resourcesList.Remove(back)
 		removed++
 	}
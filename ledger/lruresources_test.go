@@ -27,6 +27,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -90,6 +91,48 @@ func TestLRUBasicResources(t *testing.T) {
 	}
 }
 
+func TestLRUResourcesReadAll(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var baseRes lruResources
+	baseRes.init(logging.TestingLog(t), 10, 5)
+
+	addrs := []basics.Address{ledgertesting.RandomAddress(), ledgertesting.RandomAddress()}
+	resourcesNum := 20
+	// write the resources of the two addresses interleaved, so that pruning drops some of each.
+	for i := 0; i < resourcesNum; i++ {
+		for j, addr := range addrs {
+			res := trackerdb.PersistedResourcesData{
+				AcctRef: mockEntryRef{int64(j)},
+				Aidx:    basics.CreatableIndex(i),
+				Round:   basics.Round(i),
+			}
+			baseRes.write(res, addr)
+		}
+	}
+	for _, addr := range addrs {
+		require.Len(t, baseRes.readAll(addr), resourcesNum)
+	}
+	require.Empty(t, baseRes.readAll(ledgertesting.RandomAddress()))
+
+	baseRes.prune(resourcesNum)
+	for _, addr := range addrs {
+		prds := baseRes.readAll(addr)
+		require.Len(t, prds, resourcesNum/2)
+		for _, prd := range prds {
+			require.GreaterOrEqual(t, prd.Aidx, basics.CreatableIndex(resourcesNum/2))
+			cached, has := baseRes.read(addr, prd.Aidx)
+			require.True(t, has)
+			require.Equal(t, prd, cached)
+		}
+	}
+
+	baseRes.prune(0)
+	require.Empty(t, baseRes.resources)
+	require.Empty(t, baseRes.addressResources)
+}
+
 func TestLRUResourcesDisable(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
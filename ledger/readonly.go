@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// ErrReadOnlyStorageEngine is returned when opening read only a ledger whose storage engine doesn't support it.
+var ErrReadOnlyStorageEngine = errors.New("only the sqlite storage engine supports opening the ledger read only")
+
+// ReadOnlyLedger reads the databases of a ledger while a node may be writing them, either from another process
+// or from the node's own process. Nothing is ever written through it, so that its queries can't corrupt the
+// ledger, and since the readers don't hold the locks taken by the node's writes, heavy queries don't
+// block the node. Each snapshot reads a consistent state of its database, as of the snapshot's first read.
+// The tracker and the block databases are written independently, and a snapshot of one of them isn't
+// consistent with a snapshot of the other.
+type ReadOnlyLedger struct {
+	trackerDBs trackerdb.Store
+	blockDBs   blockdb.Store
+}
+
+// OpenReadOnlyLedger opens read only the existing ledger databases found at dbPathPrefix, with the storage
+// engines of cfg.
+func OpenReadOnlyLedger[T string | DirsAndPrefix](log logging.Logger, dbPathPrefix T, cfg config.Local) (*ReadOnlyLedger, error) {
+	if cfg.StorageEngine == "pebbledb" || cfg.BlockStorageEngine == "pebbledb" {
+		return nil, ErrReadOnlyStorageEngine
+	}
+	dirs := resolveDirsAndPrefix(dbPathPrefix)
+
+	trackerDBs, err := sqlitedriver.OpenReadOnly(sqliteTrackerDBPath(dirs), log)
+	if err != nil {
+		return nil, fmt.Errorf("OpenReadOnlyLedger unable to open the tracker database: %w", err)
+	}
+	blockDBs, err := blockdb.OpenSQLiteReadOnly(blockDBPath(dirs, cfg), log)
+	if err != nil {
+		trackerDBs.Close()
+		return nil, fmt.Errorf("OpenReadOnlyLedger unable to open the block database: %w", err)
+	}
+	return &ReadOnlyLedger{trackerDBs: trackerDBs, blockDBs: blockDBs}, nil
+}
+
+// Close closes the ledger databases.
+func (l *ReadOnlyLedger) Close() {
+	l.trackerDBs.Close()
+	l.blockDBs.Close()
+}
+
+// TrackerSnapshot runs fn on a snapshot of the tracker database, holding the accounts, resources and boxes
+// as of the round returned by AccountsRound.
+func (l *ReadOnlyLedger) TrackerSnapshot(ctx context.Context, fn trackerdb.SnapshotFn) error {
+	return l.trackerDBs.SnapshotContext(ctx, fn)
+}
+
+// BlockSnapshot runs fn on a snapshot of the block database.
+func (l *ReadOnlyLedger) BlockSnapshot(fn blockdb.SnapshotFn) error {
+	return l.blockDBs.Snapshot(fn)
+}
+
+// Latest returns the latest round of the block database.
+func (l *ReadOnlyLedger) Latest() (rnd basics.Round, err error) {
+	err = l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) (err error) {
+		rnd, err = tx.BlockLatest()
+		return err
+	})
+	return rnd, err
+}
+
+// Block returns the block of the given round.
+func (l *ReadOnlyLedger) Block(rnd basics.Round) (blk bookkeeping.Block, err error) {
+	err = l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) (err error) {
+		blk, err = tx.BlockGet(rnd)
+		return err
+	})
+	return blk, err
+}
+
+// BlockHdr returns the block header of the given round.
+func (l *ReadOnlyLedger) BlockHdr(rnd basics.Round) (hdr bookkeeping.BlockHeader, err error) {
+	err = l.blockDBs.Snapshot(func(ctx context.Context, tx blockdb.Reader) (err error) {
+		hdr, err = tx.BlockGetHdr(rnd)
+		return err
+	})
+	return hdr, err
+}
+
+// AccountsRound returns the round of the accounts held by the tracker database. The rounds after it are only
+// known by the node in memory, and they are written to the database as the node commits them.
+func (l *ReadOnlyLedger) AccountsRound() (rnd basics.Round, err error) {
+	err = l.trackerDBs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err0 := tx.MakeAccountsReader()
+		if err0 != nil {
+			return err0
+		}
+		rnd, err0 = ar.AccountsRound()
+		return err0
+	})
+	return rnd, err
+}
+
+// LookupAccount returns the account data, without its resources, as held by the tracker database, along with
+// the round it is held as of. The rewards accrued since the account was last modified are not added.
+func (l *ReadOnlyLedger) LookupAccount(addr basics.Address) (data ledgercore.AccountData, rnd basics.Round, err error) {
+	ar, err := l.trackerDBs.MakeAccountsOptimizedReader()
+	if err != nil {
+		return ledgercore.AccountData{}, 0, err
+	}
+	defer ar.Close()
+	pad, err := ar.LookupAccount(addr)
+	if err != nil {
+		return ledgercore.AccountData{}, 0, err
+	}
+	return pad.AccountData.GetLedgerCoreAccountData(), pad.Round, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestReadOnlyLedger(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState := getInitState()
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	dbPath := filepath.Join(t.TempDir(), "ledger")
+
+	l, err := OpenLedger(log, dbPath, false, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	addBlocks := func(n int) {
+		blk, err := l.Block(l.Latest())
+		require.NoError(t, err)
+		for i := 0; i < n; i++ {
+			blk.BlockHeader.Round++
+			blk.BlockHeader.TimeStamp += 1000
+			require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+		}
+		l.WaitForCommit(blk.Round())
+	}
+	addBlocks(10)
+
+	ro, err := OpenReadOnlyLedger(log, dbPath, cfg)
+	require.NoError(t, err)
+	defer ro.Close()
+
+	latest, err := ro.Latest()
+	require.NoError(t, err)
+	require.Equal(t, l.Latest(), latest)
+	hdr, err := ro.BlockHdr(latest)
+	require.NoError(t, err)
+	expectedHdr, err := l.BlockHdr(latest)
+	require.NoError(t, err)
+	require.Equal(t, expectedHdr, hdr)
+
+	accountsRound, err := ro.AccountsRound()
+	require.NoError(t, err)
+	require.LessOrEqual(t, accountsRound, latest)
+	for addr, ad := range genesisInitState.Accounts {
+		data, _, err := ro.LookupAccount(addr)
+		require.NoError(t, err)
+		require.Equal(t, ad.MicroAlgos, data.MicroAlgos)
+	}
+
+	// nothing can be written through the read only ledger
+	err = ro.blockDBs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) error {
+		return tx.BlockForgetBefore(latest)
+	})
+	require.Error(t, err)
+	var earliest basics.Round
+	err = ro.BlockSnapshot(func(ctx context.Context, tx blockdb.Reader) (err error) {
+		earliest, err = tx.BlockEarliest()
+		return err
+	})
+	require.NoError(t, err)
+	require.Zero(t, earliest)
+
+	// the rounds added by the node are seen by the read only ledger
+	addBlocks(5)
+	latest, err = ro.Latest()
+	require.NoError(t, err)
+	require.Equal(t, l.Latest(), latest)
+
+	_, err = OpenReadOnlyLedger(log, dbPath, config.Local{StorageEngine: "pebbledb"})
+	require.ErrorIs(t, err, ErrReadOnlyStorageEngine)
+}
@@ -0,0 +1,364 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// SnapshotVersion is the version of the ledger snapshot format written by ExportSnapshot.
+const SnapshotVersion = uint64(1)
+
+// A ledger snapshot is a gzipped tarball holding, in this order, the header, a copy of the
+// tracker database, and every block of the block database along with its certificate.
+const (
+	snapshotHeaderEntry    = "header.json"
+	snapshotTrackerDBEntry = "ledger.tracker.sqlite"
+	snapshotBlocksDir      = "blocks/"
+	snapshotBlockSuffix    = ".block"
+	snapshotCertSuffix     = ".cert"
+)
+
+// ErrSnapshotLedgerExists is returned by ImportSnapshot if a ledger database already exists.
+var ErrSnapshotLedgerExists = errors.New("the ledger database already exists")
+
+// SnapshotHeader describes the content of a ledger snapshot. The tracker database holds the state
+// at TrackerRound, and the blocks FirstBlock...LatestBlock are included.
+type SnapshotHeader struct {
+	Version      uint64        `codec:"version"`
+	GenesisHash  crypto.Digest `codec:"genesis-hash"`
+	TrackerRound basics.Round  `codec:"tracker-round"`
+	FirstBlock   basics.Round  `codec:"first-block"`
+	LatestBlock  basics.Round  `codec:"latest-block"`
+}
+
+// ExportSnapshot writes a snapshot of the ledger to w, which can be imported by ImportSnapshot on
+// another machine. The tracker database is copied within a single read transaction and the blocks
+// are read from a snapshot of the block database taken afterwards, so the ledger keeps running
+// meanwhile and the snapshot holds every block needed to replay the rounds after the tracker database.
+// The tracker database copy is written to a temporary file next to the tracker database.
+func (l *Ledger) ExportSnapshot(ctx context.Context, w io.Writer) (SnapshotHeader, error) {
+	header := SnapshotHeader{
+		Version:     SnapshotVersion,
+		GenesisHash: l.genesisHash,
+	}
+
+	tempDir, err := os.MkdirTemp(filepath.Dir(sqliteTrackerDBPath(l.dirsAndPrefix)), "snapshot-")
+	if err != nil {
+		return SnapshotHeader{}, err
+	}
+	defer os.RemoveAll(tempDir)
+	trackerFile := filepath.Join(tempDir, snapshotTrackerDBEntry)
+
+	err = l.trackerDBs.CopyTo(ctx, trackerFile)
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ExportSnapshot: unable to copy the tracker database: %w", err)
+	}
+	header.TrackerRound, err = snapshotTrackerRound(trackerFile, l.log)
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ExportSnapshot: unable to read the tracker database copy: %w", err)
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	err = l.blockDBs.Snapshot(func(_ context.Context, tx blockdb.Reader) error {
+		var err0 error
+		header.FirstBlock, err0 = tx.BlockEarliest()
+		if err0 != nil {
+			return err0
+		}
+		header.LatestBlock, err0 = tx.BlockLatest()
+		if err0 != nil {
+			return err0
+		}
+		// the tracker database is never ahead of the block database, but older blocks could have been
+		// deleted since it was copied.
+		if header.FirstBlock > header.TrackerRound || header.LatestBlock < header.TrackerRound {
+			return fmt.Errorf("the blocks %d...%d do not cover the tracker database round %d", header.FirstBlock, header.LatestBlock, header.TrackerRound)
+		}
+
+		err0 = writeSnapshotEntry(tw, snapshotHeaderEntry, protocol.EncodeJSON(&header))
+		if err0 != nil {
+			return err0
+		}
+		err0 = writeSnapshotFile(tw, snapshotTrackerDBEntry, trackerFile)
+		if err0 != nil {
+			return err0
+		}
+		for rnd := header.FirstBlock; rnd <= header.LatestBlock; rnd++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var blk, cert []byte
+			blk, cert, err0 = tx.BlockGetEncodedCert(rnd)
+			if err0 != nil {
+				return err0
+			}
+			err0 = writeSnapshotEntry(tw, fmt.Sprintf("%s%d%s", snapshotBlocksDir, rnd, snapshotBlockSuffix), blk)
+			if err0 != nil {
+				return err0
+			}
+			err0 = writeSnapshotEntry(tw, fmt.Sprintf("%s%d%s", snapshotBlocksDir, rnd, snapshotCertSuffix), cert)
+			if err0 != nil {
+				return err0
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ExportSnapshot: unable to write the snapshot: %w", err)
+	}
+	err = tw.Close()
+	if err != nil {
+		return SnapshotHeader{}, err
+	}
+	err = gzw.Close()
+	if err != nil {
+		return SnapshotHeader{}, err
+	}
+	return header, nil
+}
+
+// snapshotTrackerRound returns the round of the tracker database in the given file.
+func snapshotTrackerRound(filename string, log logging.Logger) (rnd basics.Round, err error) {
+	trackerDBs, err := sqlitedriver.Open(filename, false, log)
+	if err != nil {
+		return 0, err
+	}
+	defer trackerDBs.Close()
+	err = trackerDBs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err0 := tx.MakeAccountsReader()
+		if err0 != nil {
+			return err0
+		}
+		rnd, err0 = ar.AccountsRound()
+		return err0
+	})
+	return rnd, err
+}
+
+func writeSnapshotEntry(tw *tar.Writer, name string, data []byte) error {
+	err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data))})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+func writeSnapshotFile(tw *tar.Writer, name string, filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	err = tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: stat.Size()})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// ImportSnapshot creates the ledger databases from a snapshot written by ExportSnapshot, so that the
+// ledger opened from the same directories resumes from the state of the snapshot. The ledger must not
+// be open, and its databases must not exist yet. The tracker database must use the sqlite storage
+// engine, while the blocks are written to the block database of the configured engine. An archival
+// ledger can only be imported from a snapshot holding all the blocks since the genesis.
+func ImportSnapshot(log logging.Logger, dirs DirsAndPrefix, cfg config.Local, genesisHash crypto.Digest, r io.Reader) (header SnapshotHeader, err error) {
+	if cfg.StorageEngine == "pebbledb" {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: the snapshot tracker database cannot be imported into a pebbledb storage engine")
+	}
+	trackerPath := sqliteTrackerDBPath(dirs)
+	blockPath := blockDBPath(dirs, cfg)
+	for _, path := range []string{trackerPath, blockPath} {
+		_, err = os.Stat(path)
+		if err == nil {
+			return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: %w: %s", ErrSnapshotLedgerExists, path)
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return SnapshotHeader{}, err
+		}
+	}
+
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: %w", err)
+	}
+	tr := tar.NewReader(gzr)
+
+	data, err := readSnapshotEntry(tr, snapshotHeaderEntry)
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: %w", err)
+	}
+	err = protocol.DecodeJSON(data, &header)
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: unable to decode the header: %w", err)
+	}
+	if header.Version != SnapshotVersion {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: unsupported snapshot version %d", header.Version)
+	}
+	if header.GenesisHash != genesisHash {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: the snapshot genesis hash %v does not match the ledger genesis hash %v", header.GenesisHash, genesisHash)
+	}
+	if cfg.Archival && header.FirstBlock != 0 {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: an archival ledger needs all the blocks, but the snapshot starts at block %d", header.FirstBlock)
+	}
+
+	// the databases are removed if the snapshot can't be fully imported, so that the import can be retried
+	trackerTempPath := trackerPath + ".import"
+	defer func() {
+		if err != nil {
+			os.Remove(trackerTempPath)
+			os.RemoveAll(blockPath)
+			os.Remove(blockPath + "-shm")
+			os.Remove(blockPath + "-wal")
+		}
+	}()
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: %w", err)
+	}
+	if hdr.Name != snapshotTrackerDBEntry {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: unexpected entry %s instead of %s", hdr.Name, snapshotTrackerDBEntry)
+	}
+	err = writeSnapshotTrackerDB(tr, trackerTempPath)
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: unable to write the tracker database: %w", err)
+	}
+	trackerRound, err := snapshotTrackerRound(trackerTempPath, log)
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: unable to read the tracker database: %w", err)
+	}
+	if trackerRound != header.TrackerRound {
+		err = fmt.Errorf("ImportSnapshot: the tracker database is at round %d instead of %d", trackerRound, header.TrackerRound)
+		return SnapshotHeader{}, err
+	}
+
+	blockDBs, err := openBlockDB(dirs, false, cfg, log)
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: unable to open the block database: %w", err)
+	}
+	err = blockDBs.Transaction(func(ctx context.Context, tx blockdb.ReaderWriter) error {
+		err0 := tx.BlockInit(nil)
+		if err0 != nil {
+			return err0
+		}
+		// the blocks are staged like the ones of a fast catchup, as they don't start at the genesis
+		for rnd := header.FirstBlock; rnd <= header.LatestBlock; rnd++ {
+			var blk bookkeeping.Block
+			var cert agreement.Certificate
+			blk, cert, err0 = readSnapshotBlock(tr, rnd)
+			if err0 != nil {
+				return err0
+			}
+			if rnd == header.FirstBlock {
+				err0 = tx.BlockStartCatchupStaging(blk, cert)
+			} else {
+				err0 = tx.BlockPutStaging(blk, cert)
+			}
+			if err0 != nil {
+				return err0
+			}
+		}
+		return tx.BlockCompleteCatchup()
+	})
+	blockDBs.Close()
+	if err != nil {
+		return SnapshotHeader{}, fmt.Errorf("ImportSnapshot: unable to write the blocks: %w", err)
+	}
+
+	err = os.Rename(trackerTempPath, trackerPath)
+	if err != nil {
+		return SnapshotHeader{}, err
+	}
+	log.Infof("ImportSnapshot: imported the tracker database at round %d and the blocks %d...%d", header.TrackerRound, header.FirstBlock, header.LatestBlock)
+	return header, nil
+}
+
+func readSnapshotEntry(tr *tar.Reader, name string) ([]byte, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, err
+	}
+	if hdr.Name != name {
+		return nil, fmt.Errorf("unexpected entry %s instead of %s", hdr.Name, name)
+	}
+	return io.ReadAll(tr)
+}
+
+func writeSnapshotTrackerDB(tr *tar.Reader, filename string) error {
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, tr)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Sync()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func readSnapshotBlock(tr *tar.Reader, rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
+	data, err := readSnapshotEntry(tr, fmt.Sprintf("%s%d%s", snapshotBlocksDir, rnd, snapshotBlockSuffix))
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(data, &blk)
+	if err != nil {
+		return
+	}
+	if blk.Round() != rnd {
+		err = fmt.Errorf("unexpected block %d instead of %d", blk.Round(), rnd)
+		return
+	}
+	data, err = readSnapshotEntry(tr, fmt.Sprintf("%s%d%s", snapshotBlocksDir, rnd, snapshotCertSuffix))
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(data, &cert)
+	return
+}
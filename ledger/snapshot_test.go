@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLedgerSnapshotExportImport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState := getInitState()
+	log := logging.TestingLog(t)
+	log.SetLevel(logging.Info)
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+
+	l, err := OpenLedger(log, filepath.Join(t.TempDir(), "source"), false, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	blk := genesisInitState.Block
+	for i := 0; i < 20; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += 1000
+		require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+	}
+	l.WaitForCommit(blk.Round())
+
+	var snapshot bytes.Buffer
+	header, err := l.ExportSnapshot(context.Background(), &snapshot)
+	require.NoError(t, err)
+	require.Equal(t, SnapshotVersion, header.Version)
+	require.Equal(t, genesisInitState.GenesisHash, header.GenesisHash)
+	require.Equal(t, blk.Round(), header.LatestBlock)
+	require.LessOrEqual(t, header.FirstBlock, header.TrackerRound)
+	require.LessOrEqual(t, header.TrackerRound, header.LatestBlock)
+
+	targetDir := t.TempDir()
+	dirs := DirsAndPrefix{
+		ResolvedGenesisDirs: config.ResolvedGenesisDirs{
+			HotGenesisDir:        targetDir,
+			ColdGenesisDir:       targetDir,
+			TrackerGenesisDir:    targetDir,
+			BlockGenesisDir:      targetDir,
+			CatchpointGenesisDir: targetDir,
+		},
+		DBFilePrefix: config.LedgerFilenamePrefix,
+	}
+
+	// the snapshot of another network is rejected, and leaves nothing behind
+	_, err = ImportSnapshot(log, dirs, cfg, crypto.Digest{1}, bytes.NewReader(snapshot.Bytes()))
+	require.ErrorContains(t, err, "genesis hash")
+	// an archival ledger needs all the blocks
+	if header.FirstBlock != 0 {
+		archivalCfg := cfg
+		archivalCfg.Archival = true
+		_, err = ImportSnapshot(log, dirs, archivalCfg, genesisInitState.GenesisHash, bytes.NewReader(snapshot.Bytes()))
+		require.ErrorContains(t, err, "archival")
+	}
+
+	imported, err := ImportSnapshot(log, dirs, cfg, genesisInitState.GenesisHash, bytes.NewReader(snapshot.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, header, imported)
+
+	_, err = ImportSnapshot(log, dirs, cfg, genesisInitState.GenesisHash, bytes.NewReader(snapshot.Bytes()))
+	require.ErrorIs(t, err, ErrSnapshotLedgerExists)
+
+	l2, err := OpenLedger(log, dirs, false, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l2.Close()
+
+	require.Equal(t, l.Latest(), l2.Latest())
+	for rnd := header.FirstBlock; rnd <= header.LatestBlock; rnd++ {
+		expected, err := l.BlockHdr(rnd)
+		require.NoError(t, err)
+		hdr, err := l2.BlockHdr(rnd)
+		require.NoError(t, err)
+		require.Equal(t, expected, hdr)
+	}
+	_, expectedTotals, err := l.LatestTotals()
+	require.NoError(t, err)
+	_, totals, err := l2.LatestTotals()
+	require.NoError(t, err)
+	require.Equal(t, expectedTotals, totals)
+	for addr := range genesisInitState.Accounts {
+		expected, _, _, err := l.LookupLatest(addr)
+		require.NoError(t, err)
+		data, _, _, err := l2.LookupLatest(addr)
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+	}
+}
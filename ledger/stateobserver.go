@@ -0,0 +1,258 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// BalanceChange describes the change of an account balance in a committed round.
+// Asset is zero for changes of the Algo balance.
+type BalanceChange struct {
+	Address basics.Address
+	Asset   basics.AssetIndex
+	Old     uint64
+	New     uint64
+	// OldExists and NewExists report whether the account held the asset before and after
+	// the round, which tells opt-ins and opt-outs apart from transfers of a zero amount.
+	// Both are always true for Algo balances.
+	OldExists bool
+	NewExists bool
+}
+
+// AppStateChange describes the change of an application state in a committed round.
+// When Global is set, the change is of the global state stored with the application
+// parameters of the creator Address; otherwise it is of the local state of Address.
+// A nil Old or New state means that the state did not exist before or after the round.
+type AppStateChange struct {
+	Address basics.Address
+	App     basics.AppIndex
+	Global  bool
+	Old     basics.TealKeyValue
+	New     basics.TealKeyValue
+}
+
+// StateChangeObserver receives the balance and application state changes of every
+// round added to the ledger, in round order. The observer is called from a dedicated
+// goroutine, so a slow observer delays the following notifications but not the ledger.
+type StateChangeObserver interface {
+	OnStateChanges(rnd basics.Round, balances []BalanceChange, apps []AppStateChange)
+}
+
+type roundStateChanges struct {
+	round    basics.Round
+	balances []BalanceChange
+	apps     []AppStateChange
+}
+
+// stateChangeNotifier delivers the state changes computed by the ledger to the registered
+// observers, the same way the blockNotifier delivers new blocks to the block listeners.
+type stateChangeNotifier struct {
+	mu        deadlock.Mutex
+	cond      *sync.Cond
+	observers []StateChangeObserver
+	pending   []roundStateChanges
+	running   bool
+	closing   sync.WaitGroup
+}
+
+func (sn *stateChangeNotifier) start() {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	if sn.running {
+		return
+	}
+	sn.cond = sync.NewCond(&sn.mu)
+	sn.running = true
+	sn.pending = nil
+	sn.closing.Add(1)
+	go sn.worker()
+}
+
+func (sn *stateChangeNotifier) worker() {
+	defer sn.closing.Done()
+	sn.mu.Lock()
+
+	for {
+		for sn.running && len(sn.pending) == 0 {
+			sn.cond.Wait()
+		}
+
+		if !sn.running {
+			sn.mu.Unlock()
+			return
+		}
+
+		pending := sn.pending
+		observers := sn.observers
+		sn.pending = nil
+		sn.mu.Unlock()
+
+		for _, changes := range pending {
+			for _, observer := range observers {
+				observer.OnStateChanges(changes.round, changes.balances, changes.apps)
+			}
+		}
+
+		sn.mu.Lock()
+	}
+}
+
+func (sn *stateChangeNotifier) close() {
+	sn.mu.Lock()
+	sn.pending = nil
+	if sn.running {
+		sn.running = false
+		sn.cond.Broadcast()
+	}
+	sn.mu.Unlock()
+	sn.closing.Wait()
+}
+
+func (sn *stateChangeNotifier) register(observers []StateChangeObserver) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	sn.observers = append(sn.observers, observers...)
+}
+
+func (sn *stateChangeNotifier) hasObservers() bool {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	return len(sn.observers) > 0
+}
+
+func (sn *stateChangeNotifier) notify(changes roundStateChanges) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	if !sn.running {
+		return
+	}
+	sn.pending = append(sn.pending, changes)
+	sn.cond.Broadcast()
+}
+
+// stateChangesLedger is the subset of the ledger used to look up the state preceding a round.
+type stateChangesLedger interface {
+	LookupWithoutRewards(basics.Round, basics.Address) (ledgercore.AccountData, basics.Round, error)
+	LookupAsset(basics.Round, basics.Address, basics.AssetIndex) (ledgercore.AssetResource, error)
+	LookupApplication(basics.Round, basics.Address, basics.AppIndex) (ledgercore.AppResource, error)
+}
+
+// collectStateChanges compares the state delta of round rnd with the state of the previous round,
+// and returns the balance and application state changes it makes.
+func collectStateChanges(l stateChangesLedger, rnd basics.Round, delta ledgercore.StateDelta) (changes roundStateChanges, err error) {
+	changes.round = rnd
+	prev := rnd.SubSaturate(1)
+
+	for i := 0; i < delta.Accts.Len(); i++ {
+		addr, data := delta.Accts.GetByIdx(i)
+		old, _, err := l.LookupWithoutRewards(prev, addr)
+		if err != nil {
+			return roundStateChanges{}, fmt.Errorf("account %s: %w", addr, err)
+		}
+		if old.MicroAlgos != data.MicroAlgos {
+			changes.balances = append(changes.balances, BalanceChange{
+				Address:   addr,
+				Old:       old.MicroAlgos.Raw,
+				New:       data.MicroAlgos.Raw,
+				OldExists: true,
+				NewExists: true,
+			})
+		}
+	}
+
+	for _, rec := range delta.Accts.GetAllAssetResources() {
+		if rec.Holding.Holding == nil && !rec.Holding.Deleted {
+			// only the asset parameters were modified
+			continue
+		}
+		old, err := l.LookupAsset(prev, rec.Addr, rec.Aidx)
+		if err != nil {
+			return roundStateChanges{}, fmt.Errorf("asset %d of %s: %w", rec.Aidx, rec.Addr, err)
+		}
+		change := BalanceChange{Address: rec.Addr, Asset: rec.Aidx}
+		if old.AssetHolding != nil {
+			change.Old = old.AssetHolding.Amount
+			change.OldExists = true
+		}
+		if rec.Holding.Holding != nil {
+			change.New = rec.Holding.Holding.Amount
+			change.NewExists = true
+		}
+		if change.Old != change.New || change.OldExists != change.NewExists {
+			changes.balances = append(changes.balances, change)
+		}
+	}
+
+	for _, rec := range delta.Accts.GetAllAppResources() {
+		localModified := rec.State.LocalState != nil || rec.State.Deleted
+		globalModified := rec.Params.Params != nil || rec.Params.Deleted
+		if !localModified && !globalModified {
+			continue
+		}
+		old, err := l.LookupApplication(prev, rec.Addr, rec.Aidx)
+		if err != nil {
+			return roundStateChanges{}, fmt.Errorf("application %d of %s: %w", rec.Aidx, rec.Addr, err)
+		}
+		if localModified {
+			change := AppStateChange{Address: rec.Addr, App: rec.Aidx}
+			if old.AppLocalState != nil {
+				change.Old = old.AppLocalState.KeyValue
+			}
+			if rec.State.LocalState != nil {
+				change.New = rec.State.LocalState.KeyValue
+			}
+			if stateChanged(old.AppLocalState != nil, rec.State.LocalState != nil, change.Old, change.New) {
+				changes.apps = append(changes.apps, change)
+			}
+		}
+		if globalModified {
+			change := AppStateChange{Address: rec.Addr, App: rec.Aidx, Global: true}
+			if old.AppParams != nil {
+				change.Old = old.AppParams.GlobalState
+			}
+			if rec.Params.Params != nil {
+				change.New = rec.Params.Params.GlobalState
+			}
+			if stateChanged(old.AppParams != nil, rec.Params.Params != nil, change.Old, change.New) {
+				changes.apps = append(changes.apps, change)
+			}
+		}
+	}
+	return changes, nil
+}
+
+// stateChanged returns true if the existence or any of the key-values of an application state changed.
+func stateChanged(oldExists, newExists bool, oldKV, newKV basics.TealKeyValue) bool {
+	if oldExists != newExists || len(oldKV) != len(newKV) {
+		return true
+	}
+	for k, v := range oldKV {
+		if nv, ok := newKV[k]; !ok || nv != v {
+			return true
+		}
+	}
+	return false
+}
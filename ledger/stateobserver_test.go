@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type stateChangesLedgerMock struct {
+	accounts map[basics.Address]ledgercore.AccountData
+	assets   map[accountCreatable]ledgercore.AssetResource
+	apps     map[accountCreatable]ledgercore.AppResource
+}
+
+func (m *stateChangesLedgerMock) LookupWithoutRewards(_ basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
+	return m.accounts[addr], 0, nil
+}
+
+func (m *stateChangesLedgerMock) LookupAsset(_ basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	return m.assets[accountCreatable{addr, basics.CreatableIndex(aidx)}], nil
+}
+
+func (m *stateChangesLedgerMock) LookupApplication(_ basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	return m.apps[accountCreatable{addr, basics.CreatableIndex(aidx)}], nil
+}
+
+type stateChangeObserverMock struct {
+	rounds chan basics.Round
+}
+
+func (o *stateChangeObserverMock) OnStateChanges(rnd basics.Round, balances []BalanceChange, apps []AppStateChange) {
+	o.rounds <- rnd
+}
+
+func TestCollectStateChanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender := ledgertesting.RandomAddress()
+	receiver := ledgertesting.RandomAddress()
+	untouched := ledgertesting.RandomAddress()
+	creator := ledgertesting.RandomAddress()
+
+	mock := &stateChangesLedgerMock{
+		accounts: map[basics.Address]ledgercore.AccountData{
+			sender:    {AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 1000}}},
+			untouched: {AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 500}}},
+		},
+		assets: map[accountCreatable]ledgercore.AssetResource{
+			{sender, 10}: {AssetHolding: &basics.AssetHolding{Amount: 7}},
+			{sender, 11}: {AssetHolding: &basics.AssetHolding{Amount: 0}},
+		},
+		apps: map[accountCreatable]ledgercore.AppResource{
+			{creator, 20}: {AppParams: &basics.AppParams{GlobalState: basics.TealKeyValue{"k": {Type: basics.TealUintType, Uint: 1}}}},
+			{sender, 20}:  {AppLocalState: &basics.AppLocalState{KeyValue: basics.TealKeyValue{"l": {Type: basics.TealUintType, Uint: 1}}}},
+		},
+	}
+
+	delta := ledgercore.MakeStateDelta(&bookkeeping.BlockHeader{}, 0, 4, 0)
+	delta.Accts.Upsert(sender, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 900}}})
+	delta.Accts.Upsert(receiver, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 100}}})
+	// the balance of untouched does not change, only its other fields do
+	delta.Accts.Upsert(untouched, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 500}, TotalAppSchema: basics.StateSchema{NumUint: 1}}})
+	// a transfer of asset 10, an opt-out of asset 11 and an opt-in to asset 12
+	delta.Accts.UpsertAssetResource(sender, 10, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Holding: &basics.AssetHolding{Amount: 2}})
+	delta.Accts.UpsertAssetResource(sender, 11, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Deleted: true})
+	delta.Accts.UpsertAssetResource(receiver, 12, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Holding: &basics.AssetHolding{}})
+	// an asset reconfiguration is not a balance change
+	delta.Accts.UpsertAssetResource(creator, 13, ledgercore.AssetParamsDelta{Params: &basics.AssetParams{Total: 1}}, ledgercore.AssetHoldingDelta{})
+	// a global state change, and a local state rewritten with the same key-values
+	delta.Accts.UpsertAppResource(creator, 20, ledgercore.AppParamsDelta{Params: &basics.AppParams{GlobalState: basics.TealKeyValue{"k": {Type: basics.TealUintType, Uint: 2}}}}, ledgercore.AppLocalStateDelta{})
+	delta.Accts.UpsertAppResource(sender, 20, ledgercore.AppParamsDelta{}, ledgercore.AppLocalStateDelta{LocalState: &basics.AppLocalState{KeyValue: basics.TealKeyValue{"l": {Type: basics.TealUintType, Uint: 1}}}})
+
+	changes, err := collectStateChanges(mock, 5, delta)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), changes.round)
+	require.ElementsMatch(t, []BalanceChange{
+		{Address: sender, Old: 1000, New: 900, OldExists: true, NewExists: true},
+		{Address: receiver, Old: 0, New: 100, OldExists: true, NewExists: true},
+		{Address: sender, Asset: 10, Old: 7, New: 2, OldExists: true, NewExists: true},
+		{Address: sender, Asset: 11, OldExists: true},
+		{Address: receiver, Asset: 12, NewExists: true},
+	}, changes.balances)
+	require.Equal(t, []AppStateChange{{
+		Address: creator,
+		App:     20,
+		Global:  true,
+		Old:     basics.TealKeyValue{"k": {Type: basics.TealUintType, Uint: 1}},
+		New:     basics.TealKeyValue{"k": {Type: basics.TealUintType, Uint: 2}},
+	}}, changes.apps)
+}
+
+func TestStateChangeNotifier(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var sn stateChangeNotifier
+	require.False(t, sn.hasObservers())
+	// notifications are dropped until the notifier is started
+	sn.notify(roundStateChanges{round: 1})
+
+	observer := &stateChangeObserverMock{rounds: make(chan basics.Round, 10)}
+	sn.register([]StateChangeObserver{observer})
+	require.True(t, sn.hasObservers())
+	sn.start()
+	for rnd := basics.Round(2); rnd <= 4; rnd++ {
+		sn.notify(roundStateChanges{round: rnd})
+	}
+	for rnd := basics.Round(2); rnd <= 4; rnd++ {
+		select {
+		case got := <-observer.rounds:
+			require.Equal(t, rnd, got)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "observer was not notified of round", "round %d", rnd)
+		}
+	}
+	sn.close()
+	require.Empty(t, observer.rounds)
+}
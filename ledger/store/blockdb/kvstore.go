@@ -0,0 +1,322 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrKvNotFound is returned by KvRead.Get when the key does not exist.
+var ErrKvNotFound = errors.New("blockdb: key not found")
+
+// KvRead is a low level KV db interface for reading.
+type KvRead interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+	NewIter(low, high []byte, reverse bool) KvIter
+}
+
+// KvIter is a low level KV iterator over the keys in [low, high).
+type KvIter interface {
+	Next() bool
+	Key() []byte
+	Close()
+}
+
+// KvWrite is a low level KV db interface for writing.
+type KvWrite interface {
+	Set(key, value []byte) error
+	Delete(key []byte) error
+	DeleteRange(start, end []byte) error
+}
+
+// The KV layout mirrors the sqlite blocks and catchpointblocks tables: every round
+// is stored under a table prefix followed by the big-endian round number, so rounds
+// sort in order, and a suffix selecting the header, the block or the certificate.
+const (
+	kvPrefixBlocks  = byte('B')
+	kvPrefixStaging = byte('S')
+
+	kvSuffixHdr  = byte('h')
+	kvSuffixBlk  = byte('b')
+	kvSuffixCert = byte('c')
+)
+
+func kvRoundKey(prefix byte, rnd basics.Round) []byte {
+	key := make([]byte, 9, 10)
+	key[0] = prefix
+	binary.BigEndian.PutUint64(key[1:], uint64(rnd))
+	return key
+}
+
+func kvBlockKey(prefix byte, rnd basics.Round, suffix byte) []byte {
+	return append(kvRoundKey(prefix, rnd), suffix)
+}
+
+// kvTableRange returns the [low, high) key range of all the rounds in a table.
+func kvTableRange(prefix byte) (low, high []byte) {
+	return []byte{prefix}, []byte{prefix + 1}
+}
+
+type kvReader struct {
+	kvr KvRead
+}
+
+type kvReaderWriter struct {
+	kvReader
+	kvw KvWrite
+}
+
+// MakeKvReader returns a kv db agnostic block db Reader.
+func MakeKvReader(kvr KvRead) Reader {
+	return kvReader{kvr}
+}
+
+// MakeKvReaderWriter returns a kv db agnostic block db ReaderWriter.
+// The reader must observe the writes made through the writer.
+func MakeKvReaderWriter(kvr KvRead, kvw KvWrite) ReaderWriter {
+	return kvReaderWriter{kvReader{kvr}, kvw}
+}
+
+func (r kvReader) get(prefix byte, rnd basics.Round, suffix byte) ([]byte, error) {
+	value, closer, err := r.kvr.Get(kvBlockKey(prefix, rnd, suffix))
+	if err != nil {
+		if err == ErrKvNotFound {
+			err = ledgercore.ErrNoEntry{Round: rnd}
+		}
+		return nil, err
+	}
+	defer closer.Close()
+	// the value is only valid until the closer is called
+	return append([]byte(nil), value...), nil
+}
+
+// bound returns the lowest (or highest) round present in a table.
+func (r kvReader) bound(prefix byte, highest bool) (rnd basics.Round, ok bool) {
+	low, high := kvTableRange(prefix)
+	iter := r.kvr.NewIter(low, high, highest)
+	defer iter.Close()
+	if !iter.Next() {
+		return 0, false
+	}
+	return basics.Round(binary.BigEndian.Uint64(iter.Key()[1:9])), true
+}
+
+func (r kvReader) BlockGet(rnd basics.Round) (blk bookkeeping.Block, err error) {
+	buf, err := r.get(kvPrefixBlocks, rnd, kvSuffixBlk)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(buf, &blk)
+	return
+}
+
+func (r kvReader) BlockGetHdr(rnd basics.Round) (hdr bookkeeping.BlockHeader, err error) {
+	buf, err := r.get(kvPrefixBlocks, rnd, kvSuffixHdr)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(buf, &hdr)
+	return
+}
+
+func (r kvReader) BlockGetEncodedCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
+	blk, err = r.get(kvPrefixBlocks, rnd, kvSuffixBlk)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err = r.get(kvPrefixBlocks, rnd, kvSuffixCert)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, cert, nil
+}
+
+func (r kvReader) BlockGetCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
+	blkbuf, certbuf, err := r.BlockGetEncodedCert(rnd)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(blkbuf, &blk)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(certbuf, &cert)
+	return
+}
+
+func (r kvReader) BlockNext() (basics.Round, error) {
+	latest, ok := r.bound(kvPrefixBlocks, true)
+	if !ok {
+		return 0, nil
+	}
+	return latest + 1, nil
+}
+
+func (r kvReader) BlockLatest() (basics.Round, error) {
+	latest, ok := r.bound(kvPrefixBlocks, true)
+	if !ok {
+		return 0, fmt.Errorf("no blocks present")
+	}
+	return latest, nil
+}
+
+func (r kvReader) BlockEarliest() (basics.Round, error) {
+	earliest, ok := r.bound(kvPrefixBlocks, false)
+	if !ok {
+		return 0, fmt.Errorf("no blocks present")
+	}
+	return earliest, nil
+}
+
+func (rw kvReaderWriter) put(prefix byte, blk bookkeeping.Block, cert agreement.Certificate) error {
+	rnd := blk.Round()
+	err := rw.kvw.Set(kvBlockKey(prefix, rnd, kvSuffixHdr), protocol.Encode(&blk.BlockHeader))
+	if err != nil {
+		return err
+	}
+	err = rw.kvw.Set(kvBlockKey(prefix, rnd, kvSuffixBlk), protocol.Encode(&blk))
+	if err != nil {
+		return err
+	}
+	return rw.kvw.Set(kvBlockKey(prefix, rnd, kvSuffixCert), protocol.Encode(&cert))
+}
+
+func (rw kvReaderWriter) deleteTable(prefix byte) error {
+	low, high := kvTableRange(prefix)
+	return rw.kvw.DeleteRange(low, high)
+}
+
+func (rw kvReaderWriter) BlockInit(initBlocks []bookkeeping.Block) error {
+	next, err := rw.BlockNext()
+	if err != nil {
+		return err
+	}
+
+	if next == 0 {
+		for _, blk := range initBlocks {
+			err = rw.BlockPut(blk, agreement.Certificate{})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rw kvReaderWriter) BlockResetDB() error {
+	return rw.deleteTable(kvPrefixBlocks)
+}
+
+func (rw kvReaderWriter) BlockPut(blk bookkeeping.Block, cert agreement.Certificate) error {
+	next, err := rw.BlockNext()
+	if err != nil {
+		return err
+	}
+	if blk.Round() != next {
+		return fmt.Errorf("inserting block %d but expected %d", blk.Round(), next)
+	}
+	return rw.put(kvPrefixBlocks, blk, cert)
+}
+
+func (rw kvReaderWriter) BlockForgetBefore(rnd basics.Round) error {
+	next, err := rw.BlockNext()
+	if err != nil {
+		return err
+	}
+
+	if rnd >= next {
+		return fmt.Errorf("forgetting too much: rnd %d >= next %d", rnd, next)
+	}
+
+	low, _ := kvTableRange(kvPrefixBlocks)
+	return rw.kvw.DeleteRange(low, kvRoundKey(kvPrefixBlocks, rnd))
+}
+
+func (rw kvReaderWriter) BlockStartCatchupStaging(blk bookkeeping.Block, cert agreement.Certificate) error {
+	// delete the old staged blocks, if there are any.
+	err := rw.deleteTable(kvPrefixStaging)
+	if err != nil {
+		return err
+	}
+	return rw.put(kvPrefixStaging, blk, cert)
+}
+
+func (rw kvReaderWriter) BlockPutStaging(blk bookkeeping.Block, cert agreement.Certificate) error {
+	return rw.put(kvPrefixStaging, blk, cert)
+}
+
+func (rw kvReaderWriter) BlockEnsureSingleBlock() (blk bookkeeping.Block, err error) {
+	// delete all the blocks that aren't the latest one.
+	round, ok := rw.bound(kvPrefixStaging, true)
+	if !ok {
+		return bookkeeping.Block{}, ledgercore.ErrNoEntry{}
+	}
+
+	low, _ := kvTableRange(kvPrefixStaging)
+	err = rw.kvw.DeleteRange(low, kvRoundKey(kvPrefixStaging, round))
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+
+	buf, err := rw.get(kvPrefixStaging, round, kvSuffixBlk)
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+	err = protocol.Decode(buf, &blk)
+	return blk, err
+}
+
+func (rw kvReaderWriter) BlockCompleteCatchup() error {
+	err := rw.deleteTable(kvPrefixBlocks)
+	if err != nil {
+		return err
+	}
+
+	// move the staged entries into the blocks table, keeping their round and suffix.
+	low, high := kvTableRange(kvPrefixStaging)
+	iter := rw.kvr.NewIter(low, high, false)
+	defer iter.Close()
+	for iter.Next() {
+		key := iter.Key()
+		value, closer, err := rw.kvr.Get(key)
+		if err != nil {
+			return err
+		}
+		target := append([]byte{kvPrefixBlocks}, key[1:]...)
+		err = rw.kvw.Set(target, value)
+		closer.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return rw.deleteTable(kvPrefixStaging)
+}
+
+func (rw kvReaderWriter) BlockAbortCatchup() error {
+	return rw.deleteTable(kvPrefixStaging)
+}
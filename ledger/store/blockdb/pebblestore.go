@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !arm
+
+package blockdb
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	"github.com/cockroachdb/pebble/vfs"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type pebbleStore struct {
+	pdb *pebble.DB
+	// writeMu serializes the transactions, like the single sqlite write connection does,
+	// so that the round checks in BlockPut and BlockForgetBefore see a consistent db.
+	writeMu sync.Mutex
+	// sync tells whether the transactions are synced to disk when committed.
+	sync atomic.Bool
+}
+
+// OpenPebble opens a Pebble block db in the given directory.
+func OpenPebble(dbdir string, inMem bool, log logging.Logger) (Store, error) {
+	opts := &pebble.Options{
+		Logger: log,
+		// blocks are written once, in round order, and mostly read back
+		// while they are recent, so a moderate cache is sufficient.
+		Cache:        pebble.NewCache(64 * 1024 * 1024),
+		MaxOpenFiles: 1000,
+		MemTableSize: 32 * 1024 * 1024,
+		// Sync sstables periodically in order to smooth out writes to disk.
+		BytesPerSync: 512 * 1024,
+		Levels:       make([]pebble.LevelOptions, 7),
+	}
+	for i := range opts.Levels {
+		l := &opts.Levels[i]
+		// blocks are large values, use larger data blocks than the tracker db.
+		l.BlockSize = 32 * 1024
+		l.IndexBlockSize = l.BlockSize
+		l.FilterPolicy = bloom.FilterPolicy(10)
+		l.FilterType = pebble.TableFilter
+		l.Compression = pebble.SnappyCompression
+	}
+	if inMem {
+		opts.FS = vfs.NewMem()
+	}
+	pdb, err := pebble.Open(dbdir, opts)
+	if err != nil {
+		return nil, err
+	}
+	s := &pebbleStore{pdb: pdb}
+	s.sync.Store(true)
+	return s, nil
+}
+
+// SetSynchronousMode implements Store.
+// Pebble either syncs its write-ahead log when committing a transaction or leaves it to the OS,
+// so anything below SynchronousModeFull disables syncing.
+func (s *pebbleStore) SetSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) (err error) {
+	s.sync.Store(mode >= db.SynchronousModeFull)
+	return nil
+}
+
+// Snapshot implements Store
+func (s *pebbleStore) Snapshot(fn SnapshotFn) (err error) {
+	snap := s.pdb.NewSnapshot()
+	defer snap.Close()
+	return fn(context.Background(), MakeKvReader(pebbleKv{r: snap}))
+}
+
+// Transaction implements Store
+func (s *pebbleStore) Transaction(fn TransactionFn) (err error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	// an indexed batch lets the transaction read its own writes
+	batch := s.pdb.NewIndexedBatch()
+	defer batch.Close()
+
+	kv := pebbleKv{r: batch, w: batch}
+	err = fn(context.Background(), MakeKvReaderWriter(kv, kv))
+	if err != nil {
+		return err
+	}
+
+	wo := pebble.NoSync
+	if s.sync.Load() {
+		wo = pebble.Sync
+	}
+	return batch.Commit(wo)
+}
+
+// Close implements Store
+func (s *pebbleStore) Close() {
+	s.pdb.Close()
+}
+
+// pebbleKv implements KvRead and KvWrite on top of a pebble snapshot or batch.
+type pebbleKv struct {
+	r pebble.Reader
+	w pebble.Writer
+}
+
+func (kv pebbleKv) Get(key []byte) ([]byte, io.Closer, error) {
+	value, closer, err := kv.r.Get(key)
+	if err == pebble.ErrNotFound {
+		err = ErrKvNotFound
+	}
+	return value, closer, err
+}
+
+func (kv pebbleKv) NewIter(low, high []byte, reverse bool) KvIter {
+	opts := pebble.IterOptions{LowerBound: low, UpperBound: high}
+	return &pebbleIter{iter: kv.r.NewIter(&opts), reverse: reverse, firstCall: true}
+}
+
+func (kv pebbleKv) Set(key, value []byte) error {
+	return kv.w.Set(key, value, nil)
+}
+
+func (kv pebbleKv) Delete(key []byte) error {
+	return kv.w.Delete(key, nil)
+}
+
+func (kv pebbleKv) DeleteRange(start, end []byte) error {
+	return kv.w.DeleteRange(start, end, nil)
+}
+
+type pebbleIter struct {
+	iter      *pebble.Iterator
+	reverse   bool
+	firstCall bool
+}
+
+func (i *pebbleIter) Next() bool {
+	if i.firstCall {
+		i.firstCall = false
+		if i.reverse {
+			return i.iter.Last()
+		}
+		return i.iter.First()
+	}
+	if i.reverse {
+		return i.iter.Prev()
+	}
+	return i.iter.Next()
+}
+
+func (i *pebbleIter) Key() []byte {
+	return append([]byte(nil), i.iter.Key()...)
+}
+
+func (i *pebbleIter) Close() {
+	i.iter.Close()
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !arm
+
+package blockdb
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	storetesting "github.com/algorand/go-algorand/ledger/store/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testStores returns an in-memory block store for every storage engine.
+func testStores(t *testing.T) map[string]Store {
+	dbs, _ := storetesting.DbOpenTest(t, true)
+	storetesting.SetDbLogging(t, dbs)
+
+	pebbleStore, err := OpenPebble(filepath.Join(t.TempDir(), "block.pebbledb"), true, logging.TestingLog(t))
+	require.NoError(t, err)
+
+	return map[string]Store{
+		"sqlite":   MakeStore(dbs),
+		"pebbledb": pebbleStore,
+	}
+}
+
+func checkStore(t *testing.T, s Store, blocks []testBlockEntry) {
+	err := s.Snapshot(func(ctx context.Context, r Reader) error {
+		next, err := r.BlockNext()
+		require.NoError(t, err)
+		require.Equal(t, blocks[len(blocks)-1].block.Round()+1, next)
+
+		latest, err := r.BlockLatest()
+		require.NoError(t, err)
+		require.Equal(t, blocks[len(blocks)-1].block.Round(), latest)
+
+		earliest, err := r.BlockEarliest()
+		require.NoError(t, err)
+		require.Equal(t, blocks[0].block.Round(), earliest)
+
+		for _, e := range blocks {
+			blk, cert, err := r.BlockGetCert(e.block.Round())
+			require.NoError(t, err)
+			require.Equal(t, e.block, blk)
+			require.Equal(t, e.cert, cert)
+
+			hdr, err := r.BlockGetHdr(e.block.Round())
+			require.NoError(t, err)
+			require.Equal(t, e.block.BlockHeader, hdr)
+		}
+
+		_, err = r.BlockGet(next)
+		require.ErrorAs(t, err, &ledgercore.ErrNoEntry{})
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestBlockStoreAppendForget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for name, s := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			defer s.Close()
+
+			blocks := randomInitChain(protocol.ConsensusCurrentVersion, 10)
+			err := s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				return rw.BlockInit(blockChainBlocks(blocks))
+			})
+			require.NoError(t, err)
+			checkStore(t, s, blocks)
+
+			for i := 0; i < 10; i++ {
+				blkent := randomBlock(basics.Round(len(blocks)))
+				err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+					return rw.BlockPut(blkent.block, blkent.cert)
+				})
+				require.NoError(t, err)
+				blocks = append(blocks, blkent)
+			}
+			checkStore(t, s, blocks)
+
+			// out of order blocks are rejected, and failed transactions are not applied
+			err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				return rw.BlockPut(randomBlock(basics.Round(len(blocks)+1)).block, randomBlock(0).cert)
+			})
+			require.Error(t, err)
+			errRollback := errors.New("rollback")
+			err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				blkent := randomBlock(basics.Round(len(blocks)))
+				require.NoError(t, rw.BlockPut(blkent.block, blkent.cert))
+				return errRollback
+			})
+			require.ErrorIs(t, err, errRollback)
+			checkStore(t, s, blocks)
+
+			err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				return rw.BlockForgetBefore(5)
+			})
+			require.NoError(t, err)
+			checkStore(t, s, blocks[5:])
+
+			err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				return rw.BlockForgetBefore(basics.Round(len(blocks)))
+			})
+			require.Error(t, err)
+			checkStore(t, s, blocks[5:])
+		})
+	}
+}
+
+func TestBlockStoreCatchup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for name, s := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			defer s.Close()
+
+			blocks := randomInitChain(protocol.ConsensusCurrentVersion, 3)
+			err := s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				return rw.BlockInit(blockChainBlocks(blocks))
+			})
+			require.NoError(t, err)
+
+			// stage the catchpoint block, then the blocks preceding it
+			staged := []testBlockEntry{randomBlock(1000), randomBlock(1001), randomBlock(1002)}
+			err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				return rw.BlockStartCatchupStaging(staged[1].block, staged[1].cert)
+			})
+			require.NoError(t, err)
+
+			err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				blk, err := rw.BlockEnsureSingleBlock()
+				require.NoError(t, err)
+				require.Equal(t, staged[1].block, blk)
+				return rw.BlockPutStaging(staged[0].block, staged[0].cert)
+			})
+			require.NoError(t, err)
+			// the blocks table is left alone until the catchup completes
+			checkStore(t, s, blocks)
+
+			err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				return rw.BlockCompleteCatchup()
+			})
+			require.NoError(t, err)
+			checkStore(t, s, staged[:2])
+
+			err = s.Transaction(func(ctx context.Context, rw ReaderWriter) error {
+				return rw.BlockPut(staged[2].block, staged[2].cert)
+			})
+			require.NoError(t, err)
+			checkStore(t, s, staged)
+		})
+	}
+}
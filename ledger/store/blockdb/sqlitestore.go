@@ -0,0 +1,173 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type sqliteStore struct {
+	pair db.Pair
+}
+
+// OpenSQLite opens a sqlite block db at the given path.
+func OpenSQLite(dbFilename string, dbMem bool, log logging.Logger) (Store, error) {
+	pair, err := db.OpenPair(dbFilename, dbMem)
+	if err != nil {
+		return nil, err
+	}
+	pair.Rdb.SetLogger(log)
+	pair.Wdb.SetLogger(log)
+	return MakeStore(pair), nil
+}
+
+// OpenSQLiteReadOnly opens an existing sqlite block db at the given path for reading only.
+// Any attempt to write to the store fails.
+func OpenSQLiteReadOnly(dbFilename string, log logging.Logger) (Store, error) {
+	pair, err := db.OpenReadOnlyPair(dbFilename)
+	if err != nil {
+		return nil, err
+	}
+	pair.Rdb.SetLogger(log)
+	pair.Wdb.SetLogger(log)
+	return MakeStore(pair), nil
+}
+
+// MakeStore creates a block db Store backed by an already opened sqlite pair.
+func MakeStore(pair db.Pair) Store {
+	return &sqliteStore{pair: pair}
+}
+
+// SetSynchronousMode implements Store
+func (s *sqliteStore) SetSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) (err error) {
+	return s.pair.Wdb.SetSynchronousMode(ctx, mode, fullfsync)
+}
+
+// GetPageStats returns the page statistics of the database
+func (s *sqliteStore) GetPageStats(ctx context.Context) (db.PageStats, error) {
+	return s.pair.Wdb.GetPageStats(ctx)
+}
+
+// IncrementalVacuum reclaims up to the given number of unused pages of the database
+func (s *sqliteStore) IncrementalVacuum(ctx context.Context, pages uint64) error {
+	return s.pair.Wdb.IncrementalVacuum(ctx, pages)
+}
+
+// Optimize refreshes the query planner statistics of the database
+func (s *sqliteStore) Optimize(ctx context.Context) error {
+	return s.pair.Wdb.Optimize(ctx)
+}
+
+// EnableIncrementalVacuum switches the database to incremental vacuum, vacuuming it
+func (s *sqliteStore) EnableIncrementalVacuum(ctx context.Context) (stats db.VacuumStats, err error) {
+	return s.pair.Wdb.EnableIncrementalVacuum(ctx)
+}
+
+// Snapshot implements Store
+func (s *sqliteStore) Snapshot(fn SnapshotFn) (err error) {
+	return s.pair.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return fn(ctx, sqliteReaderWriter{tx})
+	})
+}
+
+// Transaction implements Store
+func (s *sqliteStore) Transaction(fn TransactionFn) (err error) {
+	return s.pair.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return fn(ctx, sqliteReaderWriter{tx})
+	})
+}
+
+// Close implements Store
+func (s *sqliteStore) Close() {
+	s.pair.Close()
+}
+
+// sqliteReaderWriter implements ReaderWriter on top of the sqlite blocks table functions.
+type sqliteReaderWriter struct {
+	tx *sql.Tx
+}
+
+func (rw sqliteReaderWriter) BlockGet(rnd basics.Round) (bookkeeping.Block, error) {
+	return BlockGet(rw.tx, rnd)
+}
+
+func (rw sqliteReaderWriter) BlockGetHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	return BlockGetHdr(rw.tx, rnd)
+}
+
+func (rw sqliteReaderWriter) BlockGetEncodedCert(rnd basics.Round) ([]byte, []byte, error) {
+	return BlockGetEncodedCert(rw.tx, rnd)
+}
+
+func (rw sqliteReaderWriter) BlockGetCert(rnd basics.Round) (bookkeeping.Block, agreement.Certificate, error) {
+	return BlockGetCert(rw.tx, rnd)
+}
+
+func (rw sqliteReaderWriter) BlockNext() (basics.Round, error) {
+	return BlockNext(rw.tx)
+}
+
+func (rw sqliteReaderWriter) BlockLatest() (basics.Round, error) {
+	return BlockLatest(rw.tx)
+}
+
+func (rw sqliteReaderWriter) BlockEarliest() (basics.Round, error) {
+	return BlockEarliest(rw.tx)
+}
+
+func (rw sqliteReaderWriter) BlockInit(initBlocks []bookkeeping.Block) error {
+	return BlockInit(rw.tx, initBlocks)
+}
+
+func (rw sqliteReaderWriter) BlockResetDB() error {
+	return BlockResetDB(rw.tx)
+}
+
+func (rw sqliteReaderWriter) BlockPut(blk bookkeeping.Block, cert agreement.Certificate) error {
+	return BlockPut(rw.tx, blk, cert)
+}
+
+func (rw sqliteReaderWriter) BlockForgetBefore(rnd basics.Round) error {
+	return BlockForgetBefore(rw.tx, rnd)
+}
+
+func (rw sqliteReaderWriter) BlockStartCatchupStaging(blk bookkeeping.Block, cert agreement.Certificate) error {
+	return BlockStartCatchupStaging(rw.tx, blk, cert)
+}
+
+func (rw sqliteReaderWriter) BlockPutStaging(blk bookkeeping.Block, cert agreement.Certificate) error {
+	return BlockPutStaging(rw.tx, blk, cert)
+}
+
+func (rw sqliteReaderWriter) BlockEnsureSingleBlock() (bookkeeping.Block, error) {
+	return BlockEnsureSingleBlock(rw.tx)
+}
+
+func (rw sqliteReaderWriter) BlockCompleteCatchup() error {
+	return BlockCompleteCatchup(rw.tx)
+}
+
+func (rw sqliteReaderWriter) BlockAbortCatchup() error {
+	return BlockAbortCatchup(rw.tx)
+}
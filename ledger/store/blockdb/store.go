@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockdb
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// Store is the interface for the block db.
+type Store interface {
+	// settings
+	SetSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) (err error)
+	// snapshot support
+	Snapshot(fn SnapshotFn) (err error)
+	// transaction support
+	Transaction(fn TransactionFn) (err error)
+	// cleanup
+	Close()
+}
+
+// Reader is the interface for the block db read operations.
+type Reader interface {
+	BlockGet(rnd basics.Round) (blk bookkeeping.Block, err error)
+	BlockGetHdr(rnd basics.Round) (hdr bookkeeping.BlockHeader, err error)
+	BlockGetEncodedCert(rnd basics.Round) (blk []byte, cert []byte, err error)
+	BlockGetCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error)
+	BlockNext() (basics.Round, error)
+	BlockLatest() (basics.Round, error)
+	BlockEarliest() (basics.Round, error)
+}
+
+// Writer is the interface for the block db write operations.
+type Writer interface {
+	BlockInit(initBlocks []bookkeeping.Block) error
+	BlockResetDB() error
+	BlockPut(blk bookkeeping.Block, cert agreement.Certificate) error
+	BlockForgetBefore(rnd basics.Round) error
+	// catchpoint staging
+	BlockStartCatchupStaging(blk bookkeeping.Block, cert agreement.Certificate) error
+	BlockPutStaging(blk bookkeeping.Block, cert agreement.Certificate) error
+	BlockEnsureSingleBlock() (blk bookkeeping.Block, err error)
+	BlockCompleteCatchup() error
+	BlockAbortCatchup() error
+}
+
+// ReaderWriter is the interface for the block db operations within a transaction.
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+// SnapshotFn is the callback signature for running a snapshot (read-only) scope.
+type SnapshotFn func(ctx context.Context, r Reader) error
+
+// TransactionFn is the callback signature for running a transaction scope.
+type TransactionFn func(ctx context.Context, rw ReaderWriter) error
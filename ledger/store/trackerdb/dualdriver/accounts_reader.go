@@ -18,6 +18,7 @@ package dualdriver
 
 import (
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	"github.com/google/go-cmp/cmp"
 )
@@ -159,6 +160,24 @@ func (ar *accountsReader) LookupKeysByPrefix(prefix string, maxKeyNum uint64, re
 	return roundP, nil
 }
 
+// LookupKeyValuesByPrefix implements trackerdb.AccountsReader
+func (ar *accountsReader) LookupKeyValuesByPrefix(prefix string, after string, maxKeyNum uint64) (kvs []ledgercore.KvPair, round basics.Round, err error) {
+	kvsP, roundP, errP := ar.primary.LookupKeyValuesByPrefix(prefix, after, maxKeyNum)
+	kvsS, roundS, errS := ar.secondary.LookupKeyValuesByPrefix(prefix, after, maxKeyNum)
+	// coalesce errors
+	err = coalesceErrors(errP, errS)
+	if err != nil {
+		return
+	}
+	// check results match
+	if roundP != roundS || !cmp.Equal(kvsP, kvsS) {
+		err = ErrInconsistentResult
+		return
+	}
+	// return primary results
+	return kvsP, roundP, nil
+}
+
 // LookupResources implements trackerdb.AccountsReader
 func (ar *accountsReader) LookupResources(addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (data trackerdb.PersistedResourcesData, err error) {
 	dataP, errP := ar.primary.LookupResources(addr, aidx, ctype)
@@ -190,6 +190,11 @@ func (s *trackerStore) RunMigrations(ctx context.Context, params trackerdb.Param
 	return paramsP, nil
 }
 
+func (s *trackerStore) CopyTo(ctx context.Context, filename string) error {
+	// only the primary is copied, as a single file can only hold one of the databases
+	return s.primary.CopyTo(ctx, filename)
+}
+
 func (s *trackerStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err error) {
 	// ignore the stats
 	// Note: this is a SQL specific operation, so the are unlikely to match
@@ -17,12 +17,14 @@
 package generickv
 
 import (
-	"errors"
+	"bytes"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	"github.com/algorand/go-algorand/protocol"
 )
@@ -179,8 +181,129 @@ func (r *accountsReader) LookupAllResources(addr basics.Address) (data []tracker
 	return
 }
 
-func (r *accountsReader) LookupLimitedResources(_ basics.Address, _ basics.CreatableIndex, _ uint64, _ basics.CreatableType) ([]trackerdb.PersistedResourcesDataWithCreator, basics.Round, error) {
-	return nil, 0, errors.New("not supported")
+func (r *accountsReader) LookupLimitedResources(addr basics.Address, minIdx basics.CreatableIndex, maxCreatables uint64, ctype basics.CreatableType) (data []trackerdb.PersistedResourcesDataWithCreator, rnd basics.Round, err error) {
+	// SQL impl at time of writing:
+	//
+	// SELECT ab.rowid, ar.rnd, r.aidx, ac.creator, r.data, cr.data
+	// FROM acctrounds ar
+	// 		JOIN accountbase ab ON ab.address = ?
+	// 		JOIN resources r ON r.addrid = ab.addrid
+	// 		LEFT JOIN assetcreators ac ON r.aidx = ac.asset
+	// 		LEFT JOIN accountbase cab ON ac.creator = cab.address
+	// 		LEFT JOIN resources cr ON cr.addrid = cab.addrid AND cr.aidx = r.aidx
+	// WHERE ar.id = 'acctbase' AND r.ctype = ? AND r.aidx > ?
+	// ORDER BY r.aidx ASC
+	// LIMIT ?
+
+	if maxCreatables == 0 || uint64(minIdx) == math.MaxUint64 {
+		return
+	}
+
+	// read the current db round
+	rnd, err = r.AccountsRound()
+	if err != nil {
+		return
+	}
+
+	// the resources of an address are keyed by their big-endian index,
+	// so the iterator seeks straight to the first index after minIdx
+	low := resourceKey(addr, minIdx+1)
+	_, high := resourceAddrOnlyRangePrefix(addr)
+
+	iter := r.kvr.NewIter(low[:], high[:], false)
+	defer iter.Close()
+
+	var value []byte
+	for uint64(len(data)) < maxCreatables && iter.Next() {
+		pitem := trackerdb.PersistedResourcesDataWithCreator{
+			PersistedResourcesData: trackerdb.PersistedResourcesData{AcctRef: accountRef{addr}, Round: rnd},
+		}
+
+		// extract aidx from key
+		pitem.Aidx = extractResourceAidx(iter.Key())
+
+		// get value for current item in the iterator
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		// decode raw value
+		err = protocol.Decode(value, &pitem.Data)
+		if err != nil {
+			return
+		}
+
+		// Note: the ctype is not part of the key, so the resources of the other type are skipped here
+		if (ctype == basics.AssetCreatable && !pitem.Data.IsAsset()) || (ctype == basics.AppCreatable && !pitem.Data.IsApp()) {
+			continue
+		}
+
+		if ctype == basics.AssetCreatable {
+			err = r.fillAssetCreatorParams(&pitem)
+			if err != nil {
+				return
+			}
+		}
+
+		// append entry to accum
+		data = append(data, pitem)
+	}
+
+	// Note: the SQL implementation only reports the db round along with the matching resources
+	if len(data) == 0 {
+		rnd = 0
+	}
+
+	return
+}
+
+// fillAssetCreatorParams sets the asset params of the creator of the asset on the holding,
+// the same way the SQL impl does by joining the resource of the creator.
+// The holding is left untouched when the asset (or its creator resource) was deleted.
+func (r *accountsReader) fillAssetCreatorParams(item *trackerdb.PersistedResourcesDataWithCreator) error {
+	key := creatableKey(item.Aidx)
+	value, closer, err := r.kvr.Get(key[:])
+	if err == trackerdb.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var entry creatableEntry
+	err = protocol.Decode(value, &entry)
+	closer.Close()
+	if err != nil {
+		return err
+	}
+	if entry.Ctype != basics.AssetCreatable {
+		return nil
+	}
+
+	var creator basics.Address
+	copy(creator[:], entry.CreatorAddr)
+
+	crtKey := resourceKey(creator, item.Aidx)
+	value, closer, err = r.kvr.Get(crtKey[:])
+	if err == trackerdb.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	var crtResData trackerdb.ResourcesData
+	err = protocol.Decode(value, &crtResData)
+	if err != nil {
+		return err
+	}
+
+	// keep the holding of the account, along with the params of the creator
+	crtResData.Amount = item.Data.Amount
+	crtResData.Frozen = item.Data.Frozen
+	crtResData.ResourceFlags = item.Data.ResourceFlags
+
+	item.Data = crtResData
+	item.Creator = creator
+	return nil
 }
 
 func (r *accountsReader) LookupKeyValue(key string) (pv trackerdb.PersistedKVData, err error) {
@@ -268,6 +391,45 @@ func (r *accountsReader) LookupKeysByPrefix(prefix string, maxKeyNum uint64, res
 	return
 }
 
+func (r *accountsReader) LookupKeyValuesByPrefix(prefix string, after string, maxKeyNum uint64) (kvs []ledgercore.KvPair, round basics.Round, err error) {
+	// read the current db round
+	round, err = r.AccountsRound()
+	if err != nil {
+		return
+	}
+
+	start, end := keyPrefixIntervalPreprocessing([]byte(prefix))
+	if end == nil {
+		return nil, 0, fmt.Errorf("lookup by strange prefix %#v", prefix)
+	}
+	if after >= prefix {
+		// the smallest key sorting after `after`
+		start = append([]byte(after), 0)
+	}
+	low, high := appKvKey(string(start)), appKvKey(string(end))
+	if bytes.Compare(low, high) >= 0 {
+		return nil, round, nil
+	}
+
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	keyOffset := len(appKvKey(""))
+	for uint64(len(kvs)) < maxKeyNum && iter.Next() {
+		var value []byte
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		kvs = append(kvs, ledgercore.KvPair{
+			Key:   string(iter.Key()[keyOffset:]),
+			Value: bytes.Clone(value),
+		})
+	}
+
+	return
+}
+
 func (r *accountsReader) LookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, dbRound basics.Round, err error) {
 	// The old SQL impl:
 	//
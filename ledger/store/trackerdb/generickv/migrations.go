@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"maps"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
@@ -112,6 +113,13 @@ func (m *migrator) Migrate(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+		case 11:
+			// the resources are already keyed by address and index,
+			// so the paged lookups of holdings need no new index
+			err := m.setVersion(ctx, 12)
+			if err != nil {
+				return err
+			}
 		default:
 			// any other version we do nothing
 			return nil
@@ -136,7 +144,7 @@ func (m *migrator) initialVersion(ctx context.Context) error {
 
 	// TODO: make this a batch scope
 	err := m.db.TransactionContext(ctx, func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
-		aow, err := tx.MakeAccountsOptimizedWriter(true, false, false, false)
+		aow, err := tx.MakeAccountsOptimizedWriter(true, true, true, true)
 		if err != nil {
 			return err
 		}
@@ -168,7 +176,32 @@ func (m *migrator) initialVersion(ctx context.Context) error {
 			var bad trackerdb.BaseAccountData
 			bad.SetAccountData(&account)
 			// insert the account
-			_, err = aow.InsertAccount(addr, account.NormalizedOnlineBalance(proto), bad)
+			ref, err := aow.InsertAccount(addr, account.NormalizedOnlineBalance(proto), bad)
+			if err != nil {
+				return err
+			}
+
+			// insert the assets and applications of the account, and the creatables it created
+			for aidx := range account.AssetParams {
+				_, err = aow.InsertCreatable(basics.CreatableIndex(aidx), basics.AssetCreatable, addr[:])
+				if err != nil {
+					return err
+				}
+			}
+			for aidx := range account.AppParams {
+				_, err = aow.InsertCreatable(basics.CreatableIndex(aidx), basics.AppCreatable, addr[:])
+				if err != nil {
+					return err
+				}
+			}
+			// AccountDataResources consumes the params maps, so it is given a copy
+			resourcesAccount := account
+			resourcesAccount.AssetParams = maps.Clone(account.AssetParams)
+			resourcesAccount.AppParams = maps.Clone(account.AppParams)
+			err = trackerdb.AccountDataResources(ctx, &resourcesAccount, 0, func(ctx context.Context, _ int64, cidx basics.CreatableIndex, rd *trackerdb.ResourcesData) error {
+				_, err := aow.InsertResource(ref, cidx, *rd)
+				return err
+			})
 			if err != nil {
 				return err
 			}
@@ -192,6 +225,14 @@ func (m *migrator) initialVersion(ctx context.Context) error {
 			}
 		}
 
+		// insert the genesis boxes
+		for key, value := range m.params.InitBoxes {
+			err = aow.UpsertKvPair(key, value)
+			if err != nil {
+				return err
+			}
+		}
+
 		// make sure we didn't overflow
 		if ot.Overflowed {
 			return fmt.Errorf("overflow computing totals")
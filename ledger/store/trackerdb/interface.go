@@ -109,6 +109,8 @@ type AccountsReader interface {
 
 	LookupKeyValue(key string) (pv PersistedKVData, err error)
 	LookupKeysByPrefix(prefix string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error)
+	// LookupKeyValuesByPrefix returns, in key order, up to maxKeyNum key/values whose key has the prefix and sorts after the `after` key.
+	LookupKeyValuesByPrefix(prefix string, after string, maxKeyNum uint64) (kvs []ledgercore.KvPair, round basics.Round, err error)
 
 	LookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, dbRound basics.Round, err error)
 
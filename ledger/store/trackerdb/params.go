@@ -19,19 +19,20 @@ package trackerdb
 import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	"github.com/algorand/go-algorand/protocol"
-	"github.com/algorand/go-algorand/util/db"
 )
 
 // Params contains parameters for initializing trackerDB
 type Params struct {
 	InitAccounts      map[basics.Address]basics.AccountData
+	InitBoxes         map[string][]byte
 	InitProto         protocol.ConsensusVersion
 	GenesisHash       crypto.Digest
 	FromCatchpoint    bool
 	CatchpointEnabled bool
 	DbPathPrefix      string
-	BlockDb           db.Pair
+	BlockDb           blockdb.Store
 }
 
 // InitParams params used during db init
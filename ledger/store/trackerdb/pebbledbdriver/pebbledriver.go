@@ -20,6 +20,7 @@ package pebbledbdriver
 
 import (
 	"context"
+	"errors"
 	"io"
 	"runtime"
 	"time"
@@ -381,6 +382,11 @@ func (s *trackerStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err er
 	return db.VacuumStats{}, nil
 }
 
+// CopyTo implements trackerdb.Store
+func (s *trackerStore) CopyTo(ctx context.Context, filename string) error {
+	return errors.New("pebbledb tracker databases cannot be copied to a file")
+}
+
 // ResetToV6Test implements trackerdb.Store
 func (s *trackerStore) ResetToV6Test(ctx context.Context) error {
 	// TODO
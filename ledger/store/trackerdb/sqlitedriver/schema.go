@@ -159,6 +159,12 @@ const createVoteLastValidIndex = `
 	CREATE INDEX IF NOT EXISTS onlineaccounts_votelastvalid_idx
 	ON onlineaccounts ( votelastvalid )`
 
+// createResourcesCreatableTypeIndex lets the paged lookups of the holdings of an account seek
+// directly to the resources of one creatable type, without scanning the resources of the other.
+const createResourcesCreatableTypeIndex = `
+	CREATE INDEX IF NOT EXISTS resources_ctype_idx
+	ON resources ( addrid, ctype, aidx )`
+
 var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS acctrounds`,
 	`DROP TABLE IF EXISTS accounttotals`,
@@ -217,6 +223,19 @@ func accountsInit(e db.Executable, initAccounts map[basics.Address]basics.Accoun
 				return true, err
 			}
 
+			for aidx := range data.AssetParams {
+				_, err = e.Exec("INSERT INTO assetcreators (asset, creator, ctype) VALUES (?, ?, ?)", aidx, addr[:], basics.AssetCreatable)
+				if err != nil {
+					return true, err
+				}
+			}
+			for aidx := range data.AppParams {
+				_, err = e.Exec("INSERT INTO assetcreators (asset, creator, ctype) VALUES (?, ?, ?)", aidx, addr[:], basics.AppCreatable)
+				if err != nil {
+					return true, err
+				}
+			}
+
 			ad := ledgercore.ToAccountData(data)
 			totals.AddAccount(proto, ad, &ot)
 		}
@@ -355,6 +374,18 @@ func accountsCreateBoxTable(ctx context.Context, e db.Executable) error {
 	return nil
 }
 
+// accountsInitBoxes inserts the genesis boxes into the kvstore table.
+func accountsInitBoxes(ctx context.Context, e db.Executable, initBoxes map[string][]byte) error {
+	for key, value := range initBoxes {
+		// Cast to []byte to avoid interpretation as character string, see note in upsertKvPair
+		_, err := e.ExecContext(ctx, "INSERT INTO kvstore (key, value) VALUES (?, ?)", []byte(key), value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // performKVStoreNullBlobConversion scans keys with null blob value, and convert the value to `[]byte{}`.
 func performKVStoreNullBlobConversion(ctx context.Context, e db.Executable) error {
 	_, err := e.ExecContext(ctx, "UPDATE kvstore SET value = '' WHERE value is NULL")
@@ -533,7 +564,7 @@ func performResourceTableMigration(ctx context.Context, e db.Executable, log fun
 	return nil
 }
 
-func performTxTailTableMigration(ctx context.Context, e db.Executable, blockDb db.Accessor) (err error) {
+func performTxTailTableMigration(ctx context.Context, e db.Executable, blockDb blockdb.Store) (err error) {
 	if e == nil {
 		return nil
 	}
@@ -547,12 +578,12 @@ func performTxTailTableMigration(ctx context.Context, e db.Executable, blockDb d
 	// load the latest MaxTxnLife rounds in the txtail and store these in the txtail.
 	// when migrating there is only MaxTxnLife blocks in the block DB
 	// since the original txTail.commmittedUpTo preserved only (rnd+1)-MaxTxnLife = 1000 blocks back
-	err = blockDb.Atomic(func(ctx context.Context, blockTx *sql.Tx) error {
-		latestBlockRound, blockErr := blockdb.BlockLatest(blockTx)
+	err = blockDb.Snapshot(func(ctx context.Context, blockTx blockdb.Reader) error {
+		latestBlockRound, blockErr := blockTx.BlockLatest()
 		if blockErr != nil {
 			return fmt.Errorf("latest block number cannot be retrieved : %w", blockErr)
 		}
-		latestHdr, hdrErr := blockdb.BlockGetHdr(blockTx, dbRound)
+		latestHdr, hdrErr := blockTx.BlockGetHdr(dbRound)
 		if hdrErr != nil {
 			return fmt.Errorf("latest block header %d cannot be retrieved : %w", dbRound, hdrErr)
 		}
@@ -568,7 +599,7 @@ func performTxTailTableMigration(ctx context.Context, e db.Executable, blockDb d
 		if firstRound == basics.Round(0) {
 			firstRound++
 		}
-		if _, getErr := blockdb.BlockGet(blockTx, firstRound); getErr != nil {
+		if _, getErr := blockTx.BlockGet(firstRound); getErr != nil {
 			// looks like not catchpoint but a regular migration, start from maxTxnLife + deeperBlockHistory back
 			firstRound = (latestBlockRound + 1).SubSaturate(maxTxnLife + deeperBlockHistory)
 			if firstRound == basics.Round(0) {
@@ -577,7 +608,7 @@ func performTxTailTableMigration(ctx context.Context, e db.Executable, blockDb d
 		}
 		tailRounds := make([][]byte, 0, maxTxnLife)
 		for rnd := firstRound; rnd <= dbRound; rnd++ {
-			blk, getErr := blockdb.BlockGet(blockTx, rnd)
+			blk, getErr := blockTx.BlockGet(rnd)
 			if getErr != nil {
 				return fmt.Errorf("block for round %d ( %d - %d ) cannot be retrieved : %w", rnd, firstRound, dbRound, getErr)
 			}
@@ -597,7 +628,7 @@ func performTxTailTableMigration(ctx context.Context, e db.Executable, blockDb d
 	return err
 }
 
-func performOnlineRoundParamsTailMigration(ctx context.Context, e db.Executable, blockDb db.Accessor, newDatabase bool, initProto protocol.ConsensusVersion) (err error) {
+func performOnlineRoundParamsTailMigration(ctx context.Context, e db.Executable, blockDb blockdb.Store, newDatabase bool, initProto protocol.ConsensusVersion) (err error) {
 	arw := NewAccountsSQLReaderWriter(e)
 	totals, err := arw.AccountsTotals(ctx, false)
 	if err != nil {
@@ -611,8 +642,8 @@ func performOnlineRoundParamsTailMigration(ctx context.Context, e db.Executable,
 	if newDatabase {
 		currentProto = initProto
 	} else {
-		err = blockDb.Atomic(func(ctx context.Context, blockTx *sql.Tx) error {
-			hdr, hdrErr := blockdb.BlockGetHdr(blockTx, rnd)
+		err = blockDb.Snapshot(func(ctx context.Context, blockTx blockdb.Reader) error {
+			hdr, hdrErr := blockTx.BlockGetHdr(rnd)
 			if hdrErr != nil {
 				return hdrErr
 			}
@@ -943,6 +974,11 @@ func convertOnlineRoundParamsTail(ctx context.Context, e db.Executable) error {
 	return err
 }
 
+func accountsCreateResourcesCreatableTypeIndex(ctx context.Context, e db.Executable) error {
+	_, err := e.ExecContext(ctx, createResourcesCreatableTypeIndex)
+	return err
+}
+
 func accountsAddCreatableTypeColumn(ctx context.Context, e db.Executable, populateColumn bool) error {
 	// Run ctype resources migration if it hasn't run yet
 	var creatableTypeOnResourcesRun bool
@@ -32,6 +32,7 @@ import (
 	storetesting "github.com/algorand/go-algorand/ledger/store/testing"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util/db"
@@ -291,3 +292,42 @@ func TestRemoveStrayStateProofID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourcesCreatableTypeIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbs, _ := storetesting.DbOpenTest(t, true)
+	storetesting.SetDbLogging(t, dbs)
+	defer dbs.Close()
+
+	tx, err := dbs.Wdb.Handle.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	AccountsInitTest(t, tx, make(map[basics.Address]basics.AccountData), protocol.ConsensusCurrentVersion)
+
+	indexColumns := func() (columns []string) {
+		rows, err := tx.Query("SELECT name FROM pragma_index_info('resources_ctype_idx') ORDER BY seqno")
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			require.NoError(t, rows.Scan(&name))
+			columns = append(columns, name)
+		}
+		require.NoError(t, rows.Err())
+		return
+	}
+	require.Equal(t, []string{"addrid", "ctype", "aidx"}, indexColumns())
+
+	// a catchpoint catchup replaces the resources table along with its indexes,
+	// and the database is then upgraded again from an older schema
+	_, err = tx.Exec("DROP INDEX resources_ctype_idx")
+	require.NoError(t, err)
+	require.Empty(t, indexColumns())
+
+	tu := trackerDBSchemaInitializer{schemaVersion: 11, log: logging.TestingLog(t)}
+	require.NoError(t, tu.upgradeDatabaseSchema11(context.Background(), tx))
+	require.Equal(t, int32(12), tu.version())
+	require.Equal(t, []string{"addrid", "ctype", "aidx"}, indexColumns())
+}
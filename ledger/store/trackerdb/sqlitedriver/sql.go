@@ -19,6 +19,8 @@ package sqlitedriver
 import (
 	"database/sql"
 	"fmt"
+	"math"
+
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
@@ -35,6 +37,7 @@ type accountsDbQueries struct {
 	lookupLimitedResourcesStmt *sql.Stmt
 	lookupKvPairStmt           *sql.Stmt
 	lookupKeysByRangeStmt      *sql.Stmt
+	lookupKvPairsByRangeStmt   *sql.Stmt
 	lookupCreatorStmt          *sql.Stmt
 }
 
@@ -120,6 +123,11 @@ func AccountsInitDbQueries(q db.Queryable) (*accountsDbQueries, error) {
 		return nil, err
 	}
 
+	qs.lookupKvPairsByRangeStmt, err = q.Prepare("SELECT acctrounds.rnd, kvstore.key, kvstore.value FROM acctrounds LEFT JOIN kvstore ON kvstore.key >= ? AND kvstore.key < ? WHERE id='acctbase' ORDER BY kvstore.key LIMIT ?")
+	if err != nil {
+		return nil, err
+	}
+
 	qs.lookupCreatorStmt, err = q.Prepare("SELECT acctrounds.rnd, assetcreators.creator FROM acctrounds LEFT JOIN assetcreators ON asset = ? AND ctype = ? WHERE id='acctbase'")
 	if err != nil {
 		return nil, err
@@ -309,6 +317,48 @@ func (qs *accountsDbQueries) LookupKeysByPrefix(prefix string, maxKeyNum uint64,
 	return
 }
 
+// LookupKeyValuesByPrefix returns, in key order, up to maxKeyNum application boxed key/values matching the prefix
+// whose key sorts after the `after` key.
+func (qs *accountsDbQueries) LookupKeyValuesByPrefix(prefix string, after string, maxKeyNum uint64) (kvs []ledgercore.KvPair, round basics.Round, err error) {
+	start, end := keyPrefixIntervalPreprocessing([]byte(prefix))
+	if end == nil {
+		return nil, 0, fmt.Errorf("lookup by strange prefix %#v", prefix)
+	}
+	if after >= prefix {
+		// the smallest key sorting after `after`
+		start = append([]byte(after), 0)
+	}
+	limit := int64(math.MaxInt64)
+	if maxKeyNum < math.MaxInt64 {
+		limit = int64(maxKeyNum)
+	}
+	err = db.Retry(func() error {
+		kvs = nil
+		rows, err := qs.lookupKvPairsByRangeStmt.Query(start, end, limit)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var rawkey, val []byte
+			err = rows.Scan(&round, &rawkey, &val)
+			if err != nil {
+				return err
+			}
+			if rawkey == nil {
+				continue
+			}
+			if val == nil {
+				val = []byte{}
+			}
+			kvs = append(kvs, ledgercore.KvPair{Key: string(rawkey), Value: val})
+		}
+		return rows.Err()
+	})
+	return
+}
+
 // keyPrefixIntervalPreprocessing is implemented to generate an interval for DB queries that look up keys by prefix.
 // Such DB query was designed this way, to trigger the binary search optimization in SQLITE3.
 // The DB comparison for blob typed primary key is lexicographic, i.e., byte by byte.
@@ -650,6 +700,7 @@ func (qs *accountsDbQueries) Close() {
 		&qs.lookupLimitedResourcesStmt,
 		&qs.lookupKvPairStmt,
 		&qs.lookupKeysByRangeStmt,
+		&qs.lookupKvPairsByRangeStmt,
 		&qs.lookupCreatorStmt,
 	}
 	for _, preparedQuery := range preparedQueries {
@@ -51,6 +51,17 @@ func Open(dbFilename string, dbMem bool, log logging.Logger) (store trackerdb.St
 	return MakeStore(pair), nil
 }
 
+// OpenReadOnly opens an existing sqlite database store for reading only. Any attempt to write to the store fails.
+func OpenReadOnly(dbFilename string, log logging.Logger) (store trackerdb.Store, err error) {
+	pair, err := db.OpenReadOnlyPair(dbFilename)
+	if err != nil {
+		return
+	}
+	pair.Rdb.SetLogger(log)
+	pair.Wdb.SetLogger(log)
+	return MakeStore(pair), nil
+}
+
 // MakeStore crates a tracker SQL db from sql db handle.
 func MakeStore(pair db.Pair) trackerdb.Store {
 	return &trackerSQLStore{pair, &sqlReader{pair.Rdb.Handle}, &sqlWriter{pair.Wdb.Handle}, &sqlCatchpoint{pair.Wdb.Handle}}
@@ -143,6 +154,31 @@ func (s *trackerSQLStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err
 	return
 }
 
+// GetPageStats returns the page statistics of the database
+func (s *trackerSQLStore) GetPageStats(ctx context.Context) (db.PageStats, error) {
+	return s.pair.Wdb.GetPageStats(ctx)
+}
+
+// IncrementalVacuum reclaims up to the given number of unused pages of the database
+func (s *trackerSQLStore) IncrementalVacuum(ctx context.Context, pages uint64) error {
+	return s.pair.Wdb.IncrementalVacuum(ctx, pages)
+}
+
+// Optimize refreshes the query planner statistics of the database
+func (s *trackerSQLStore) Optimize(ctx context.Context) error {
+	return s.pair.Wdb.Optimize(ctx)
+}
+
+// EnableIncrementalVacuum switches the database to incremental vacuum, vacuuming it
+func (s *trackerSQLStore) EnableIncrementalVacuum(ctx context.Context) (stats db.VacuumStats, err error) {
+	return s.pair.Wdb.EnableIncrementalVacuum(ctx)
+}
+
+// CopyTo writes a consistent copy of the database to the given file
+func (s *trackerSQLStore) CopyTo(ctx context.Context, filename string) error {
+	return s.pair.Rdb.CopyTo(ctx, filename)
+}
+
 func (s *trackerSQLStore) ResetToV6Test(ctx context.Context) error {
 	var resetExprs = []string{
 		`DROP TABLE IF EXISTS onlineaccounts`,
@@ -86,13 +86,13 @@ func AccountsInitTest(tb testing.TB, e db.Executable, initAccounts map[basics.Ad
 	// since this is a test that starts from genesis, there is no tail that needs to be migrated.
 	// we'll pass a nil here in order to ensure we still call this method, although it would
 	// be a noop.
-	err = performTxTailTableMigration(context.Background(), nil, db.Accessor{})
+	err = performTxTailTableMigration(context.Background(), nil, nil)
 	require.NoError(tb, err)
 
 	err = accountsCreateOnlineRoundParamsTable(context.Background(), e)
 	require.NoError(tb, err)
 
-	err = performOnlineRoundParamsTailMigration(context.Background(), e, db.Accessor{}, true, proto)
+	err = performOnlineRoundParamsTailMigration(context.Background(), e, nil, true, proto)
 	require.NoError(tb, err)
 
 	err = accountsCreateBoxTable(context.Background(), e)
@@ -104,6 +104,9 @@ func AccountsInitTest(tb testing.TB, e db.Executable, initAccounts map[basics.Ad
 	err = accountsAddCreatableTypeColumn(context.Background(), e, false)
 	require.NoError(tb, err)
 
+	err = accountsCreateResourcesCreatableTypeIndex(context.Background(), e)
+	require.NoError(tb, err)
+
 	return newDB
 }
 
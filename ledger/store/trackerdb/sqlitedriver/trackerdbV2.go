@@ -142,6 +142,12 @@ func RunMigrations(ctx context.Context, e db.Executable, params trackerdb.Params
 					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (ledger.tracker.sqlite) from schema 10 : %v", err)
 					return
 				}
+			case 11:
+				err = tu.upgradeDatabaseSchema11(ctx, e)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (ledger.tracker.sqlite) from schema 11 : %v", err)
+					return
+				}
 			default:
 				return trackerdb.InitParams{}, fmt.Errorf("trackerDBInitialize unable to upgrade database from schema version %d", tu.schemaVersion)
 			}
@@ -437,13 +443,13 @@ func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema6(ctx context.Context
 	}
 
 	if !tu.newDatabase {
-		err = performTxTailTableMigration(ctx, e, tu.BlockDb.Rdb)
+		err = performTxTailTableMigration(ctx, e, tu.BlockDb)
 		if err != nil {
 			return fmt.Errorf("upgradeDatabaseSchema6 unable to complete transaction tail data migration : %w", err)
 		}
 	}
 
-	err = performOnlineRoundParamsTailMigration(ctx, e, tu.BlockDb.Rdb, tu.newDatabase, tu.InitProto)
+	err = performOnlineRoundParamsTailMigration(ctx, e, tu.BlockDb, tu.newDatabase, tu.InitProto)
 	if err != nil {
 		return fmt.Errorf("upgradeDatabaseSchema6 unable to complete online round params data migration : %w", err)
 	}
@@ -467,11 +473,18 @@ func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema6(ctx context.Context
 
 // upgradeDatabaseSchema7 upgrades the database schema from version 7 to version 8.
 // adding the kvstore table for box feature support.
+// For a new database, the genesis boxes are inserted into the new table.
 func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema7(ctx context.Context, e db.Executable) (err error) {
 	err = accountsCreateBoxTable(ctx, e)
 	if err != nil {
 		return fmt.Errorf("upgradeDatabaseSchema7 unable to create kvstore through createTables : %v", err)
 	}
+	if tu.newDatabase {
+		err = accountsInitBoxes(ctx, e, tu.InitBoxes)
+		if err != nil {
+			return fmt.Errorf("upgradeDatabaseSchema7 unable to insert genesis boxes : %v", err)
+		}
+	}
 	return tu.setVersion(ctx, e, 8)
 }
 
@@ -524,6 +537,17 @@ func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema10(ctx context.Contex
 	return tu.setVersion(ctx, e, 11)
 }
 
+// upgradeDatabaseSchema11 upgrades the database schema from version 11 to version 12,
+// indexing the resources table by (addrid, ctype, aidx) for the paged lookups of asset holdings.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema11(ctx context.Context, e db.Executable) (err error) {
+	err = accountsCreateResourcesCreatableTypeIndex(ctx, e)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema11 unable to create resources ctype index: %v", err)
+	}
+	// update version
+	return tu.setVersion(ctx, e, 12)
+}
+
 func removeEmptyDirsOnSchemaUpgrade(dbDirectory string) (err error) {
 	catchpointRootDir := filepath.Join(dbDirectory, trackerdb.CatchpointDirName)
 	if _, err := os.Stat(catchpointRootDir); os.IsNotExist(err) {
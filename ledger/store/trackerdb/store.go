@@ -48,6 +48,7 @@ type Store interface {
 	BeginTransaction(ctx context.Context) (Transaction, error)
 	// maintenance
 	Vacuum(ctx context.Context) (stats db.VacuumStats, err error)
+	CopyTo(ctx context.Context, filename string) (err error)
 	// testing
 	ResetToV6Test(ctx context.Context) error
 	// cleanup
@@ -18,6 +18,7 @@ package testsuite
 
 import (
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/stretchr/testify/require"
@@ -28,10 +29,9 @@ func init() {
 	registerTest("accounts-crud", CustomTestAccountsCrud)
 	registerTest("resources-crud", CustomTestResourcesCrud)
 	registerTest("resources-query-all", CustomTestResourcesQueryAll)
-	// NOTE: this test is disabled because it is not supported by the kv implementation,
-	//       it is only supported by the sqlite implementation and is enabled there (see sqlitedb_test.go)
-	// registerTest("resources-query-all-limited", CustomTestResourcesQueryAllLimited)
+	registerTest("resources-query-all-limited", CustomTestResourcesQueryAllLimited)
 	registerTest("kv-crud", CustomTestAppKVCrud)
+	registerTest("kv-query-prefix", CustomTestAppKVQueryPrefix)
 	registerTest("creatables-crud", CustomTestCreatablesCrud)
 }
 
@@ -501,6 +501,52 @@ func CustomTestAppKVCrud(t *customT) {
 	require.Equal(t, expectedRound, pv1.Round) // db round (this is present even if record does not exist)
 }
 
+func CustomTestAppKVQueryPrefix(t *customT) {
+	aow, err := t.db.MakeAccountsOptimizedWriter(true, true, true, false)
+	require.NoError(t, err)
+
+	aor, err := t.db.MakeAccountsOptimizedReader()
+	require.NoError(t, err)
+
+	aw, err := t.db.MakeAccountsWriter()
+	require.NoError(t, err)
+
+	expectedRound := basics.Round(3)
+	err = aw.UpdateAccountsRound(expectedRound)
+	require.NoError(t, err)
+
+	// insert the kvs, out of order
+	for _, key := range []string{"box-c", "box-a", "other-a", "box-b", "bow-z"} {
+		err = aow.UpsertKvPair(key, []byte("value-"+key))
+		require.NoError(t, err)
+	}
+
+	// all the kvs with the prefix, in key order
+	kvs, round, err := aor.LookupKeyValuesByPrefix("box-", "", 10)
+	require.NoError(t, err)
+	require.Equal(t, expectedRound, round)
+	require.Equal(t, []ledgercore.KvPair{
+		{Key: "box-a", Value: []byte("value-box-a")},
+		{Key: "box-b", Value: []byte("value-box-b")},
+		{Key: "box-c", Value: []byte("value-box-c")},
+	}, kvs)
+
+	// pages
+	kvs, _, err = aor.LookupKeyValuesByPrefix("box-", "", 2)
+	require.NoError(t, err)
+	require.Len(t, kvs, 2)
+	require.Equal(t, "box-b", kvs[1].Key)
+	kvs, _, err = aor.LookupKeyValuesByPrefix("box-", "box-b", 2)
+	require.NoError(t, err)
+	require.Equal(t, []ledgercore.KvPair{{Key: "box-c", Value: []byte("value-box-c")}}, kvs)
+
+	// nothing after the last key
+	kvs, round, err = aor.LookupKeyValuesByPrefix("box-", "box-c", 2)
+	require.NoError(t, err)
+	require.Empty(t, kvs)
+	require.Equal(t, expectedRound, round)
+}
+
 func CustomTestCreatablesCrud(t *customT) {
 	aow, err := t.db.MakeAccountsOptimizedWriter(true, true, false, true)
 	require.NoError(t, err)
@@ -255,6 +255,11 @@ func (db *mockDB) Vacuum(ctx context.Context) (stats db.VacuumStats, err error)
 	return stats, nil
 }
 
+func (db *mockDB) CopyTo(ctx context.Context, filename string) error {
+	// TODO
+	return nil
+}
+
 func (db *mockDB) ResetToV6Test(ctx context.Context) error {
 	// TODO
 	return nil
@@ -32,6 +32,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
@@ -155,7 +156,7 @@ type trackerCommitLifetimeHandlers interface {
 // access.  This is particularly useful for testing trackers in isolation.
 type ledgerForTracker interface {
 	trackerDB() trackerdb.Store
-	blockDB() db.Pair
+	blockDB() blockdb.Store
 	trackerLog() logging.Logger
 	trackerEvalVerified(bookkeeping.Block, eval.LedgerForEvaluator) (ledgercore.StateDelta, error)
 
@@ -222,6 +223,9 @@ type trackerRegistry struct {
 // defaultMaxAccountDeltas is a default value for maxAccountDeltas.
 const defaultMaxAccountDeltas = 256
 
+// maxAcctLookbackLimit is the largest MaxAcctLookback supported, as the deltas of all these rounds are kept in memory.
+const maxAcctLookbackLimit = 10000
+
 // deferredCommitRange is used during the calls to produceCommittingTask, and used as a data structure
 // to syncronize the various trackers and create a uniformity around which rounds need to be persisted
 // next.
@@ -295,6 +299,9 @@ type deferredCommitContext struct {
 	// txtail rounds deltas history size
 	txTailRetainSize uint64
 
+	// account deltas of the committed rounds, written by the account history tracker
+	accountHistoryDeltas []ledgercore.AccountDeltas
+
 	stats       telemetryspec.AccountsUpdateMetrics
 	updateStats bool
 
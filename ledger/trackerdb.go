@@ -40,6 +40,7 @@ func trackerDBInitialize(l ledgerForTracker, catchpointEnabled bool, dbPathPrefi
 
 	tp := trackerdb.Params{
 		InitAccounts:      l.GenesisAccounts(),
+		InitBoxes:         l.GenesisBoxes(),
 		InitProto:         l.GenesisProtoVersion(),
 		GenesisHash:       l.GenesisHash(),
 		FromCatchpoint:    false,
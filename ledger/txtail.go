@@ -38,6 +38,9 @@ const initialLastValidArrayLen = 256
 // enable by removing it as needed (phase 2 of the catchpoints re-work)
 const enableTxTailHashes = false
 
+// maxTxTailRetainRounds is the largest TxTailRetainRounds supported.
+const maxTxTailRetainRounds = 100000
+
 type roundLeases struct {
 	txleases map[ledgercore.Txlease]basics.Round // map of transaction lease to when it expires
 	proto    config.ConsensusParams
@@ -88,10 +91,19 @@ type txTail struct {
 	// lowWaterMark are not guaranteed to succeed
 	lowWaterMark basics.Round // the last round known to be committed to disk
 
+	// retainRounds is the configured number of rounds to keep, used when larger than the
+	// MaxTxnLife + DeeperBlockHeaderHistory rounds required by the protocol
+	retainRounds uint64
+
 	// log copied from ledger
 	log logging.Logger
 }
 
+// initialize initializes the txTail structure
+func (t *txTail) initialize(cfg config.Local) {
+	t.retainRounds = cfg.TxTailRetainRounds
+}
+
 func (t *txTail) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
 	t.tailMu.Lock()
 	defer t.tailMu.Unlock()
@@ -264,8 +276,8 @@ func (t *txTail) committedUpTo(rnd basics.Round) (retRound, lookback basics.Roun
 		delete(t.lastValid, t.lowWaterMark)
 	}
 
-	deeperHistory := basics.Round(proto.DeeperBlockHeaderHistory)
-	return (rnd + 1).SubSaturate(maxlife + deeperHistory), basics.Round(0)
+	retainSize := max(proto.MaxTxnLife+proto.DeeperBlockHeaderHistory, t.retainRounds)
+	return (rnd + 1).SubSaturate(basics.Round(retainSize)), basics.Round(0)
 }
 
 func (t *txTail) prepareCommit(dcc *deferredCommitContext) (err error) {
@@ -281,16 +293,18 @@ func (t *txTail) prepareCommit(dcc *deferredCommitContext) (err error) {
 		return fmt.Errorf("round %d not found in blockHeaderData: lowest=%d, base=%d", dcc.newBase(), lowest, dcc.oldBase)
 	}
 	// get the MaxTxnLife from the consensus params of the latest round in this commit range
-	// preserve data for MaxTxnLife + DeeperBlockHeaderHistory
-	dcc.txTailRetainSize = proto.MaxTxnLife + proto.DeeperBlockHeaderHistory
+	// preserve data for MaxTxnLife + DeeperBlockHeaderHistory, or for more rounds if configured
+	hashedSize := proto.MaxTxnLife + proto.DeeperBlockHeaderHistory
+	dcc.txTailRetainSize = max(hashedSize, t.retainRounds)
 
 	if !dcc.catchpointFirstStage {
 		return nil
 	}
 
 	if enableTxTailHashes {
-		// update the dcc with the hash we'll need.
-		dcc.txTailHash, err = t.recentTailHash(dcc.offset, dcc.txTailRetainSize)
+		// update the dcc with the hash we'll need. It only covers the rounds required by the protocol,
+		// so that it doesn't depend on the configuration.
+		dcc.txTailHash, err = t.recentTailHash(dcc.offset+dcc.txTailRetainSize-hashedSize, hashedSize)
 	}
 	return
 }
@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	storetesting "github.com/algorand/go-algorand/ledger/store/testing"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
@@ -159,7 +161,8 @@ func (t *txTailTestLedger) Block(r basics.Round) (bookkeeping.Block, error) {
 func (t *txTailTestLedger) initialize(ts *testing.T, protoVersion protocol.ConsensusVersion) error {
 	// create a corresponding blockdb.
 	inMemory := true
-	t.blockDBs, _ = storetesting.DbOpenTest(ts, inMemory)
+	blockDBs, _ := storetesting.DbOpenTest(ts, inMemory)
+	t.blockDBs = blockdb.MakeStore(blockDBs)
 	t.trackerDBs, _ = sqlitedriver.OpenForTesting(ts, inMemory)
 	t.protoVersion = protoVersion
 
@@ -340,6 +343,62 @@ func TestTxTailDeltaTracking(t *testing.T) {
 	}
 }
 
+func TestTxTailRetainRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	protoVersion := protocol.ConsensusFuture
+	proto := config.Consensus[protoVersion]
+	requiredSize := proto.MaxTxnLife + proto.DeeperBlockHeaderHistory
+
+	for _, retainRounds := range []uint64{0, requiredSize / 2, requiredSize + 100} {
+		t.Run(fmt.Sprintf("retain=%d", retainRounds), func(t *testing.T) {
+			var ledger txTailTestLedger
+			txtail := txTail{}
+			txtail.initialize(config.Local{TxTailRetainRounds: retainRounds})
+			require.NoError(t, ledger.initialize(t, protoVersion))
+			require.NoError(t, txtail.loadFromDisk(&ledger, ledger.Latest()))
+
+			retainSize := max(requiredSize, retainRounds)
+			firstLoaded := ledger.Latest() - basics.Round(proto.MaxTxnLife) + 1
+			for i := int(ledger.Latest()) + 1; i < int(retainSize)*2; i++ {
+				blk := bookkeeping.Block{
+					BlockHeader: bookkeeping.BlockHeader{
+						Round:        basics.Round(i),
+						UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protoVersion},
+					},
+				}
+				txtail.newBlock(blk, ledgercore.MakeStateDelta(&blk.BlockHeader, 0, 0, 0))
+				retRound, _ := txtail.committedUpTo(basics.Round(i))
+				require.Equal(t, basics.Round(i+1).SubSaturate(basics.Round(retainSize)), retRound)
+
+				dcc := &deferredCommitContext{
+					deferredCommitRange: deferredCommitRange{
+						oldBase: basics.Round(i - 1),
+						offset:  1,
+					},
+				}
+				require.NoError(t, txtail.prepareCommit(dcc))
+				require.Equal(t, retainSize, dcc.txTailRetainSize)
+				err := ledger.trackerDBs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
+					return txtail.commitRound(ctx, tx, dcc)
+				})
+				require.NoError(t, err)
+				txtail.postCommit(context.Background(), dcc)
+
+				// once the headers loaded from the disk are all gone, exactly retainSize of them are kept
+				if basics.Round(i+1).SubSaturate(basics.Round(retainSize)) >= firstLoaded {
+					require.Len(t, txtail.blockHeaderData, int(retainSize))
+					_, ok := txtail.blockHeader(basics.Round(i + 1 - int(retainSize)))
+					require.True(t, ok)
+					_, ok = txtail.blockHeader(basics.Round(i - int(retainSize)))
+					require.False(t, ok)
+				}
+			}
+		})
+	}
+}
+
 func TestTxTailCheckConfirmed(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
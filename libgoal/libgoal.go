@@ -17,9 +17,11 @@
 package libgoal
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -1127,6 +1129,15 @@ func (c *Client) Catchup(catchpointLabel string, min uint64) (model.CatchpointSt
 	return algod.Catchup(catchpointLabel, min)
 }
 
+// ExportLedgerSnapshot streams a snapshot of the ledger of the node into w
+func (c *Client) ExportLedgerSnapshot(ctx context.Context, w io.Writer) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.ExportLedgerSnapshot(ctx, w)
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
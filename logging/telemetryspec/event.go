@@ -96,11 +96,27 @@ type BlockAcceptedEventDetails struct {
 	ReceivedAt           time.Duration
 	VoteValidatedAt      time.Duration
 	DynamicFilterTimeout time.Duration
+	FilterTimeout        time.Duration
 	PreValidated         bool
 	PropBufLen           uint64
 	VoteBufLen           uint64
 }
 
+// LateCredentialEvent event
+const LateCredentialEvent Event = "LateCredential"
+
+// LateCredentialEventDetails contains details for the LateCredentialEvent, which is
+// reported when the lowest credential of a round arrived after the filter timeout
+// and its proposer's credentials consistently do so
+type LateCredentialEventDetails struct {
+	Address       string
+	Round         uint64
+	Arrival       time.Duration
+	FilterTimeout time.Duration
+	Rounds        uint64
+	LateRounds    uint64
+}
+
 // AccountRegisteredEvent event
 const AccountRegisteredEvent Event = "AccountRegistered"
 
@@ -225,6 +241,30 @@ type DisconnectPeerEventDetails struct {
 	TXCount, MICount, AVCount, PPCount uint64
 }
 
+// GossipMessageDroppedEvent event
+const GossipMessageDroppedEvent Event = "GossipMessageDropped"
+
+// GossipMessageDroppedEventDetails contains details for the GossipMessageDroppedEvent
+type GossipMessageDroppedEventDetails struct {
+	Tag      string
+	Reason   string
+	PeerRole string
+	Address  string `json:",omitempty"`
+	// Sampling is the number of dropped messages represented by this single event
+	Sampling uint64
+}
+
+// DNSBootstrapShrinkEvent event
+const DNSBootstrapShrinkEvent Event = "DNSBootstrapShrink"
+
+// DNSBootstrapShrinkEventDetails contains details for the DNSBootstrapShrinkEvent
+type DNSBootstrapShrinkEventDetails struct {
+	Origin   string
+	Role     string
+	Previous int
+	Current  int
+}
+
 // ErrorOutputEvent event
 const ErrorOutputEvent Event = "ErrorOutput"
 
@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+)
+
+// roleBudget is the target number of outgoing connections to the peers having
+// a phonebook role.
+type roleBudget struct {
+	role   phonebook.Role
+	target int
+}
+
+// parseConnectionBudgets parses config.OutgoingConnectionBudgets, which maps
+// the phonebook role names to their target number of outgoing connections.
+// The budgets are ordered by role, and the roles with no connections are
+// left out.
+func parseConnectionBudgets(budgets map[string]int) ([]roleBudget, error) {
+	parsed := make([]roleBudget, 0, len(budgets))
+	for name, target := range budgets {
+		role, err := phonebook.ParseRole(name)
+		if err != nil {
+			return nil, err
+		}
+		if target < 0 {
+			return nil, fmt.Errorf("negative connection budget %d for role %s", target, name)
+		}
+		if target > 0 {
+			parsed = append(parsed, roleBudget{role: role, target: target})
+		}
+	}
+	slices.SortFunc(parsed, func(a, b roleBudget) int { return int(a.role) - int(b.role) })
+	return parsed, nil
+}
+
+// checkConnectionBudgetsNeeded is the counterpart of checkNewConnectionsNeeded
+// used when connection budgets are configured: every role is given its own
+// target of outgoing connections, met with the phonebook addresses having this
+// role. A connection to an address having several roles counts for all of
+// them. It returns true if any role is short of connections.
+func (wn *WebsocketNetwork) checkConnectionBudgetsNeeded() bool {
+	roles := make(map[string]phonebook.Role)
+	for _, e := range wn.phonebook.Entries() {
+		roles[e.Address] = e.Roles
+	}
+	outgoing := wn.outgoingPeerAddresses()
+	connected := slices.Concat(outgoing, wn.pendingConnectAddresses())
+
+	needed := false
+	for _, budget := range wn.connectionBudgets {
+		have := 0
+		for _, a := range connected {
+			if roles[a]&budget.role != 0 {
+				have++
+			}
+		}
+		need := budget.target - have
+		if need <= 0 {
+			continue
+		}
+		needed = true
+
+		// get more than we need so that we can ignore duplicates
+		newAddrs := wn.phonebook.GetAddresses(budget.target+have, budget.role)
+		if wn.peerTagger != nil {
+			newAddrs = phonebook.DiversityOrder(newAddrs, outgoing, wn.peerTagger)
+		}
+		for _, na := range newAddrs {
+			if na == wn.config.PublicAddress {
+				continue
+			}
+			gossipAddr, ok := wn.tryConnectReserveAddr(na)
+			if !ok {
+				continue
+			}
+			wn.wg.Add(1)
+			go wn.tryConnect(na, gossipAddr)
+			// the connection counts for the next roles as well
+			connected = append(connected, na)
+			need--
+			if need == 0 {
+				break
+			}
+		}
+	}
+	return needed
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseConnectionBudgets(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	budgets, err := parseConnectionBudgets(nil)
+	require.NoError(t, err)
+	require.Empty(t, budgets)
+
+	budgets, err = parseConnectionBudgets(map[string]int{"txgossip": 2, "relay": 4, "archival": 2, "blockservice": 0})
+	require.NoError(t, err)
+	require.Equal(t, []roleBudget{
+		{role: phonebook.RelayRole, target: 4},
+		{role: phonebook.ArchivalRole, target: 2},
+		{role: phonebook.TxGossipRole, target: 2},
+	}, budgets)
+
+	_, err = parseConnectionBudgets(map[string]int{"relay": 4, "bogus": 1})
+	require.ErrorContains(t, err, "bogus")
+	_, err = parseConnectionBudgets(map[string]int{"relay": -1})
+	require.ErrorContains(t, err, "negative")
+}
+
+func TestConnectionBudgetsMet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	wn := makeTestWebsocketNode(t)
+	var err error
+	wn.connectionBudgets, err = parseConnectionBudgets(map[string]int{"relay": 1, "archival": 1})
+	require.NoError(t, err)
+	wn.phonebook.ReplacePeerList([]string{"a:4160"}, "test", phonebook.RelayRole)
+	wn.phonebook.ReplacePeerList([]string{"a:4160"}, "test", phonebook.ArchivalRole)
+
+	// a connection to an address having both roles meets both budgets
+	wn.tryConnectAddrs["a:4160"] = 0
+	require.False(t, wn.checkNewConnectionsNeeded())
+	delete(wn.tryConnectAddrs, "a:4160")
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"sync/atomic"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// DropReason describes why a gossip message was dropped instead of being delivered or handled.
+type DropReason string
+
+const (
+	// DropSendQueueFull is used when the outgoing queue of a peer had no room for the message
+	DropSendQueueFull DropReason = "send_queue_full"
+	// DropStale is used when a broadcast waited in the queue for longer than maxMessageQueueDuration
+	DropStale DropReason = "stale"
+	// DropRateLimited is used when a message was discarded by a rate limiter
+	DropRateLimited DropReason = "rate_limited"
+	// DropValidationFailed is used when a handler rejected the message and asked to disconnect the sender
+	DropValidationFailed DropReason = "validation_failed"
+	// DropUnknownTag is used when a message arrived with a tag we don't recognize
+	DropUnknownTag DropReason = "unknown_tag"
+	// DropDuplicate is used when an incoming message was filtered out as a duplicate
+	DropDuplicate DropReason = "duplicate"
+	// DropTooLong is used when an outgoing message exceeded MaxMessageLength
+	DropTooLong DropReason = "too_long"
+)
+
+var networkGossipMessagesDropped = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_gossip_messages_dropped_total", Description: "Number of gossip messages dropped, by tag, reason and peer role"})
+
+// dropTelemetrySampling is the number of dropped messages per emitted telemetry event; zero disables the event.
+var dropTelemetrySampling atomic.Uint64
+
+// droppedMessagesCount counts drops for the purpose of sampling the telemetry event.
+var droppedMessagesCount atomic.Uint64
+
+// setDropTelemetrySampling updates the telemetry sampling rate for dropped messages.
+func setDropTelemetrySampling(sampling uint64) {
+	dropTelemetrySampling.Store(sampling)
+}
+
+// dropPeerRole returns the role label of the peer a dropped message was exchanged with.
+func dropPeerRole(peer Peer) string {
+	switch p := peer.(type) {
+	case *wsPeer:
+		if p == nil {
+			return "none"
+		}
+		if p.outgoing {
+			return "relay"
+		}
+		return "node"
+	case *wsPeerCore:
+		if p == nil {
+			return "none"
+		}
+		return "node"
+	case nil:
+		return "none"
+	default:
+		return "node"
+	}
+}
+
+// CountDroppedMessage records a dropped gossip message in the dropped messages counter and,
+// when sampling is enabled, emits a telemetry event for every N-th drop.
+// peer may be nil when the drop is not attributable to a single peer.
+func CountDroppedMessage(tag protocol.Tag, reason DropReason, peer Peer) {
+	role := dropPeerRole(peer)
+	networkGossipMessagesDropped.Inc(map[string]string{"tag": string(tag), "reason": string(reason), "role": role})
+
+	sampling := dropTelemetrySampling.Load()
+	if sampling == 0 || droppedMessagesCount.Add(1)%sampling != 0 {
+		return
+	}
+	var address string
+	if hp, ok := peer.(HTTPPeer); ok && role != "none" {
+		address = hp.GetAddress()
+	}
+	logging.Base().EventWithDetails(telemetryspec.Network, telemetryspec.GossipMessageDroppedEvent,
+		telemetryspec.GossipMessageDroppedEventDetails{
+			Tag:      string(tag),
+			Reason:   string(reason),
+			PeerRole: role,
+			Address:  address,
+			Sampling: sampling,
+		})
+}
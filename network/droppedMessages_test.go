@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDropPeerRole(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, "none", dropPeerRole(nil))
+	require.Equal(t, "none", dropPeerRole((*wsPeer)(nil)))
+	require.Equal(t, "relay", dropPeerRole(&wsPeer{outgoing: true}))
+	require.Equal(t, "node", dropPeerRole(&wsPeer{}))
+	require.Equal(t, "node", dropPeerRole(&wsPeerCore{}))
+}
+
+func TestCountDroppedMessage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	labels := map[string]string{"tag": string(protocol.TxnTag), "reason": string(DropRateLimited), "role": "relay"}
+	before := networkGossipMessagesDropped.GetUint64ValueForLabels(labels)
+
+	peer := &wsPeer{wsPeerCore: wsPeerCore{rootURL: "http://relay.example.com:4160"}, outgoing: true}
+	CountDroppedMessage(protocol.TxnTag, DropRateLimited, peer)
+	CountDroppedMessage(protocol.TxnTag, DropRateLimited, peer)
+	require.Equal(t, before+2, networkGossipMessagesDropped.GetUint64ValueForLabels(labels))
+
+	// a different reason is counted separately
+	otherLabels := map[string]string{"tag": string(protocol.TxnTag), "reason": string(DropSendQueueFull), "role": "relay"}
+	otherBefore := networkGossipMessagesDropped.GetUint64ValueForLabels(otherLabels)
+	CountDroppedMessage(protocol.TxnTag, DropSendQueueFull, peer)
+	require.Equal(t, otherBefore+1, networkGossipMessagesDropped.GetUint64ValueForLabels(otherLabels))
+	require.Equal(t, before+2, networkGossipMessagesDropped.GetUint64ValueForLabels(labels))
+}
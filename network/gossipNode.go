@@ -164,7 +164,7 @@ type IncomingMessage struct {
 type Tag = protocol.Tag
 
 func highPriorityTag(tag protocol.Tag) bool {
-	if tag == protocol.AgreementVoteTag || tag == protocol.ProposalPayloadTag {
+	if tag == protocol.AgreementVoteTag || tag == protocol.ProposalPayloadTag || tag == protocol.ProposalChunkTag {
 		return true
 	}
 	return false
@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// subscribableTags are the gossip tags a connection can be restricted to with
+// the SubscribedTagsHeader. The other tags, which are used to manage the
+// connection itself, are always exchanged.
+var subscribableTags = map[protocol.Tag]bool{
+	protocol.AgreementVoteTag:   true,
+	protocol.ProposalChunkTag:   true,
+	protocol.ProposalPayloadTag: true,
+	protocol.StateProofSigTag:   true,
+	protocol.TxnTag:             true,
+	protocol.VoteBundleTag:      true,
+}
+
+// parseSubscribedTags parses a comma-separated list of gossip tags. The tags
+// which are unknown or can't be subscribed to are skipped. It returns nil if
+// no tag is left, meaning the connection isn't restricted.
+func parseSubscribedTags(list string) map[protocol.Tag]bool {
+	var tags map[protocol.Tag]bool
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if !subscribableTags[protocol.Tag(tag)] {
+			continue
+		}
+		if tags == nil {
+			tags = make(map[protocol.Tag]bool, len(subscribableTags))
+		}
+		tags[protocol.Tag(tag)] = true
+	}
+	return tags
+}
+
+// encodeSubscribedTags returns the sorted comma-separated list of tags, as
+// expected by parseSubscribedTags.
+func encodeSubscribedTags(tags map[protocol.Tag]bool) string {
+	list := make([]string, 0, len(tags))
+	for tag := range tags {
+		list = append(list, string(tag))
+	}
+	slices.Sort(list)
+	return strings.Join(list, ",")
+}
+
+// restrictSendTags returns the tags of sendTags which may be sent on a
+// connection subscribed to the subscribed gossip tags. It returns sendTags
+// itself if the connection isn't restricted.
+func restrictSendTags(sendTags, subscribed map[protocol.Tag]bool) map[protocol.Tag]bool {
+	if subscribed == nil {
+		return sendTags
+	}
+	restricted := make(map[protocol.Tag]bool, len(sendTags))
+	for tag, send := range sendTags {
+		if send && (!subscribableTags[tag] || subscribed[tag]) {
+			restricted[tag] = true
+		}
+	}
+	return restricted
+}
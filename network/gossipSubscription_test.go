@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseSubscribedTags(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Nil(t, parseSubscribedTags(""))
+	require.Nil(t, parseSubscribedTags("MI,zz"))
+
+	tags := parseSubscribedTags(" VB,AV ,MI,zz")
+	require.Equal(t, map[protocol.Tag]bool{protocol.AgreementVoteTag: true, protocol.VoteBundleTag: true}, tags)
+	require.Equal(t, "AV,VB", encodeSubscribedTags(tags))
+	require.Equal(t, tags, parseSubscribedTags(encodeSubscribedTags(tags)))
+}
+
+func TestRestrictSendTags(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, defaultSendMessageTags, restrictSendTags(defaultSendMessageTags, nil))
+
+	restricted := restrictSendTags(defaultSendMessageTags, parseSubscribedTags("AV,VB"))
+	require.True(t, restricted[protocol.AgreementVoteTag])
+	require.True(t, restricted[protocol.VoteBundleTag])
+	require.False(t, restricted[protocol.ProposalPayloadTag])
+	require.False(t, restricted[protocol.TxnTag])
+	// the tags managing the connection are always sent
+	require.True(t, restricted[protocol.MsgOfInterestTag])
+	require.True(t, restricted[protocol.TopicMsgRespTag])
+	require.True(t, restricted[protocol.UniEnsBlockReqTag])
+
+	// a message of interest can't extend the subscription
+	moi := map[protocol.Tag]bool{protocol.AgreementVoteTag: true, protocol.TxnTag: true, protocol.StateProofSigTag: false}
+	require.Equal(t, map[protocol.Tag]bool{protocol.AgreementVoteTag: true}, restrictSendTags(moi, parseSubscribedTags("AV,SP")))
+}
+
+func TestSubscribedPeerSendTags(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	peer := wsPeer{
+		subscribedTags: parseSubscribedTags("PP,TX"),
+		sendMessageTag: defaultSendMessageTags,
+	}
+	peer.log = logging.TestingLog(t)
+
+	// a message of interest received from the peer is restricted to the subscription
+	require.Equal(t, disconnectReasonNone, peer.writeLoopSendMsg(sendMessage{msgTags: map[protocol.Tag]bool{protocol.AgreementVoteTag: true, protocol.TxnTag: true}}))
+	require.Equal(t, map[protocol.Tag]bool{protocol.TxnTag: true}, peer.sendMessageTag)
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// hybridDedupTags are the tags deduplicated across the websocket and p2p stacks of a HybridP2PNetwork.
+// These are the messages every node gossips on both stacks, so a node running both would otherwise
+// verify and relay each vote and proposal twice.
+var hybridDedupTags = map[protocol.Tag]bool{
+	protocol.AgreementVoteTag:   true,
+	protocol.ProposalPayloadTag: true,
+}
+
+const (
+	hybridDedupBucketCount = 5
+	hybridDedupBucketSize  = 4096
+)
+
+var networkHybridCrossStackDuplicates = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_hybrid_cross_stack_duplicates_total", Description: "Number of messages dropped by the hybrid network since they were already received on the other network stack"})
+
+type hybridStack int
+
+const (
+	hybridStackWS hybridStack = iota
+	hybridStackP2P
+)
+
+func (s hybridStack) String() string {
+	if s == hybridStackP2P {
+		return "p2p"
+	}
+	return "ws"
+}
+
+// hybridDedup remembers the messages received on each stack of a HybridP2PNetwork, so that a message
+// that has already been received on one stack could be dropped when it arrives on the other one.
+// Repeated messages on the same stack are left for that stack to handle, as it would without the hybrid network.
+type hybridDedup struct {
+	mu      deadlock.Mutex
+	filters [2]*messageFilter
+}
+
+func makeHybridDedup() *hybridDedup {
+	return &hybridDedup{
+		filters: [2]*messageFilter{
+			makeMessageFilter(hybridDedupBucketCount, hybridDedupBucketSize),
+			makeMessageFilter(hybridDedupBucketCount, hybridDedupBucketSize),
+		},
+	}
+}
+
+// seenOnOtherStack records the message as received on the given stack, and returns true if it was
+// already received on the other stack.
+func (d *hybridDedup) seenOnOtherStack(stack hybridStack, tag protocol.Tag, data []byte) bool {
+	digest := generateMessageDigest(tag, data)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.filters[1-stack].CheckDigest(digest, false, false) {
+		return true
+	}
+	d.filters[stack].CheckDigest(digest, true, false)
+	return false
+}
+
+// wrapHandlers returns the dispatch handlers for the given stack; the handlers for the hybridDedupTags
+// ignore the messages that were already received on the other stack.
+func (d *hybridDedup) wrapHandlers(stack hybridStack, dispatch []TaggedMessageHandler) []TaggedMessageHandler {
+	wrapped := make([]TaggedMessageHandler, len(dispatch))
+	for i, h := range dispatch {
+		wrapped[i] = h
+		if !hybridDedupTags[h.Tag] {
+			continue
+		}
+		handler := h.MessageHandler
+		wrapped[i].MessageHandler = HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			if d.seenOnOtherStack(stack, msg.Tag, msg.Data) {
+				networkHybridCrossStackDuplicates.Inc(map[string]string{"tag": string(msg.Tag), "stack": stack.String()})
+				return OutgoingMessage{Action: Ignore}
+			}
+			return handler.Handle(msg)
+		})
+	}
+	return wrapped
+}
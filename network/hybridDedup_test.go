@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHybridDedupSeenOnOtherStack(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	d := makeHybridDedup()
+	msg := []byte("vote")
+
+	require.False(t, d.seenOnOtherStack(hybridStackWS, protocol.AgreementVoteTag, msg))
+	// repeated on the same stack: left for the stack itself to handle
+	require.False(t, d.seenOnOtherStack(hybridStackWS, protocol.AgreementVoteTag, msg))
+	require.True(t, d.seenOnOtherStack(hybridStackP2P, protocol.AgreementVoteTag, msg))
+
+	// same payload with a different tag is a different message
+	require.False(t, d.seenOnOtherStack(hybridStackP2P, protocol.ProposalPayloadTag, msg))
+	require.True(t, d.seenOnOtherStack(hybridStackWS, protocol.ProposalPayloadTag, msg))
+}
+
+func TestHybridDedupWrapHandlers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	handled := make(map[protocol.Tag]int)
+	handler := HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		handled[msg.Tag]++
+		return OutgoingMessage{Action: Broadcast}
+	})
+	dispatch := []TaggedMessageHandler{
+		{Tag: protocol.AgreementVoteTag, MessageHandler: handler},
+		{Tag: protocol.TxnTag, MessageHandler: handler},
+	}
+
+	d := makeHybridDedup()
+	wsHandlers := d.wrapHandlers(hybridStackWS, dispatch)
+	p2pHandlers := d.wrapHandlers(hybridStackP2P, dispatch)
+
+	labels := map[string]string{"tag": string(protocol.AgreementVoteTag), "stack": "p2p"}
+	before := networkHybridCrossStackDuplicates.GetUint64ValueForLabels(labels)
+
+	vote := IncomingMessage{Tag: protocol.AgreementVoteTag, Data: []byte("vote")}
+	require.Equal(t, Broadcast, wsHandlers[0].MessageHandler.Handle(vote).Action)
+	require.Equal(t, Ignore, p2pHandlers[0].MessageHandler.Handle(vote).Action)
+	require.Equal(t, 1, handled[protocol.AgreementVoteTag])
+	require.Equal(t, before+1, networkHybridCrossStackDuplicates.GetUint64ValueForLabels(labels))
+
+	// tags outside of hybridDedupTags are passed through on both stacks
+	txn := IncomingMessage{Tag: protocol.TxnTag, Data: []byte("txn")}
+	require.Equal(t, Broadcast, wsHandlers[1].MessageHandler.Handle(txn).Action)
+	require.Equal(t, Broadcast, p2pHandlers[1].MessageHandler.Handle(txn).Action)
+	require.Equal(t, 2, handled[protocol.TxnTag])
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network/addr"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/protocol"
 )
 
@@ -33,6 +34,7 @@ type HybridP2PNetwork struct {
 	p2pNetwork *P2PNetwork
 	wsNetwork  *WebsocketNetwork
 	genesisID  string
+	dedup      *hybridDedup
 
 	useP2PAddress bool
 }
@@ -65,6 +67,7 @@ func NewHybridP2PNetwork(log logging.Logger, cfg config.Local, datadir string, p
 		p2pNetwork: p2pnet,
 		wsNetwork:  wsnet,
 		genesisID:  genesisID,
+		dedup:      makeHybridDedup(),
 	}, nil
 }
 
@@ -191,9 +194,10 @@ func (n *HybridP2PNetwork) Stop() {
 }
 
 // RegisterHandlers adds to the set of given message handlers.
+// Votes and proposals already received on one network stack are not handled again when they arrive on the other one.
 func (n *HybridP2PNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
-	n.p2pNetwork.RegisterHandlers(dispatch)
-	n.wsNetwork.RegisterHandlers(dispatch)
+	n.p2pNetwork.RegisterHandlers(n.dedup.wrapHandlers(hybridStackP2P, dispatch))
+	n.wsNetwork.RegisterHandlers(n.dedup.wrapHandlers(hybridStackWS, dispatch))
 }
 
 // ClearHandlers deregisters all the existing message handlers.
@@ -234,6 +238,41 @@ func (n *HybridP2PNetwork) OnNetworkAdvance() {
 	})
 }
 
+// PhonebookEntries implements PhonebookAdmin for the websocket network phonebook
+func (n *HybridP2PNetwork) PhonebookEntries() []phonebook.Entry {
+	return n.wsNetwork.PhonebookEntries()
+}
+
+// AddPhonebookPeers implements PhonebookAdmin for the websocket network phonebook
+func (n *HybridP2PNetwork) AddPhonebookPeers(addrs []string, role phonebook.Role) error {
+	return n.wsNetwork.AddPhonebookPeers(addrs, role)
+}
+
+// RemovePhonebookPeer implements PhonebookAdmin for the websocket network phonebook
+func (n *HybridP2PNetwork) RemovePhonebookPeer(addr string) bool {
+	return n.wsNetwork.RemovePhonebookPeer(addr)
+}
+
+// PersistentPeers implements PersistentPeersReporter for the websocket network
+func (n *HybridP2PNetwork) PersistentPeers() []PersistentPeerStatus {
+	return n.wsNetwork.PersistentPeers()
+}
+
+// StartDrain implements RelayDrainer for the websocket network; the p2p network keeps accepting connections
+func (n *HybridP2PNetwork) StartDrain() {
+	n.wsNetwork.StartDrain()
+}
+
+// StopDrain implements RelayDrainer for the websocket network
+func (n *HybridP2PNetwork) StopDrain() {
+	n.wsNetwork.StopDrain()
+}
+
+// DrainStatus implements RelayDrainer for the websocket network
+func (n *HybridP2PNetwork) DrainStatus() RelayDrainStatus {
+	return n.wsNetwork.DrainStatus()
+}
+
 // GetGenesisID returns the network-specific genesisID.
 func (n *HybridP2PNetwork) GetGenesisID() string {
 	return n.genesisID
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package limitcaller
+
+import (
+	"context"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/util"
+)
+
+// DialPacer bounds the number of outgoing dials in flight and spaces out their
+// starts, so that a node which has just started, or lost its connections, does
+// not dial all the addresses in its phonebook at once.
+type DialPacer struct {
+	// slots holds a token for every dial in flight, it is nil if the number
+	// of dials is not limited.
+	slots chan struct{}
+	// interval is the minimal time between the starts of two dials.
+	interval time.Duration
+
+	mu deadlock.Mutex
+	// nextStart is the earliest time at which the next dial may start.
+	nextStart time.Time
+}
+
+// MakeDialPacer creates a DialPacer allowing up to maxInFlight concurrent dials, started
+// at least interval apart. A zero maxInFlight or interval disables the respective limit.
+func MakeDialPacer(maxInFlight int, interval time.Duration) *DialPacer {
+	p := &DialPacer{interval: interval}
+	if maxInFlight > 0 {
+		p.slots = make(chan struct{}, maxInFlight)
+	}
+	return p
+}
+
+// acquire waits until a dial may start. Every successful acquire must be followed by a release.
+func (p *DialPacer) acquire(ctx context.Context) error {
+	if p.slots != nil {
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if p.interval <= 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	now := time.Now()
+	start := p.nextStart
+	if start.Before(now) {
+		start = now
+	}
+	p.nextStart = start.Add(p.interval)
+	p.mu.Unlock()
+
+	if wait := start.Sub(now); wait > 0 {
+		select {
+		case <-ctx.Done():
+			p.release()
+			return ctx.Err()
+		case <-util.NanoAfter(wait):
+		}
+	}
+	return nil
+}
+
+func (p *DialPacer) release() {
+	if p.slots != nil {
+		<-p.slots
+	}
+}
+
+// InFlight returns the number of dials in flight, or waiting for their start time.
+func (p *DialPacer) InFlight() int {
+	return len(p.slots)
+}
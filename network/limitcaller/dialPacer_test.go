@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package limitcaller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDialPacerInFlight(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	p := MakeDialPacer(2, 0)
+	require.NoError(t, p.acquire(context.Background()))
+	require.NoError(t, p.acquire(context.Background()))
+	require.Equal(t, 2, p.InFlight())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, p.acquire(ctx), context.DeadlineExceeded)
+	require.Equal(t, 2, p.InFlight())
+
+	p.release()
+	require.NoError(t, p.acquire(context.Background()))
+	p.release()
+	p.release()
+	require.Zero(t, p.InFlight())
+
+	// no limits
+	p = MakeDialPacer(0, 0)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, p.acquire(context.Background()))
+	}
+	p.release()
+	require.Zero(t, p.InFlight())
+}
+
+func TestDialPacerInterval(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const interval = 20 * time.Millisecond
+	p := MakeDialPacer(0, interval)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, p.acquire(context.Background()))
+		p.release()
+	}
+	require.GreaterOrEqual(t, time.Since(start), 3*interval)
+
+	// a cancelled wait releases its slot
+	p = MakeDialPacer(1, time.Hour)
+	require.NoError(t, p.acquire(context.Background()))
+	p.release()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, p.acquire(ctx), context.DeadlineExceeded)
+	require.Zero(t, p.InFlight())
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/tools/network"
 	"github.com/algorand/go-algorand/tools/network/dnssec"
 	"github.com/algorand/go-algorand/util"
 )
@@ -34,6 +35,7 @@ type netDialer interface {
 type Dialer struct {
 	phonebook   phonebook.Phonebook
 	innerDialer netDialer
+	pacer       *DialPacer
 }
 
 // MakeRateLimitingDialer creates a rate limiting dialer that would limit the connections
@@ -60,6 +62,47 @@ func MakeRateLimitingDialer(phonebook phonebook.Phonebook, resolver dnssec.Resol
 	}
 }
 
+// MakeRateLimitingDialerWithPolicy creates a rate limiting dialer like MakeRateLimitingDialer,
+// which dials the addresses of peers having both IPv4 and IPv6 addresses according to policy.
+// The resolved addresses are stored in the phonebook, if it supports it, so that they may
+// be dialed when the name can't be resolved later.
+func MakeRateLimitingDialerWithPolicy(pb phonebook.Phonebook, resolver dnssec.ResolverIf, policy DialPolicy) Dialer {
+	d := &happyEyeballsDialer{
+		resolver: net.DefaultResolver,
+		dialer: &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		},
+		policy: policy,
+	}
+	if resolver != nil {
+		d.resolver = resolver
+	}
+	if addresses, ok := pb.(phonebook.PeerAddresses); ok {
+		d.addresses = addresses
+	}
+
+	return Dialer{
+		phonebook:   pb,
+		innerDialer: d,
+	}
+}
+
+// MakeRateLimitingProxyDialer creates a rate limiting dialer which establishes its
+// connections through proxyDialer. Addresses are handed to the proxy dialer unresolved.
+func MakeRateLimitingProxyDialer(phonebook phonebook.Phonebook, proxyDialer network.ContextDialer) Dialer {
+	return Dialer{
+		phonebook:   phonebook,
+		innerDialer: proxyDialer,
+	}
+}
+
+// SetPacer makes the dialer wait for the given DialPacer before every dial. The pacer may be
+// shared by several dialers so that they are paced together.
+func (d *Dialer) SetPacer(pacer *DialPacer) {
+	d.pacer = pacer
+}
+
 // Dial connects to the address on the named network.
 // It waits if needed not to exceed connectionsRateLimitingCount.
 func (d *Dialer) Dial(network, address string) (net.Conn, error) {
@@ -90,6 +133,12 @@ func (d *Dialer) DialContext(ctx context.Context, network, address string) (net.
 }
 
 func (d *Dialer) innerDialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if d.pacer != nil {
+		if err := d.pacer.acquire(ctx); err != nil {
+			return nil, err
+		}
+		defer d.pacer.release()
+	}
 	// this would be a good place to have the dnssec evaluated.
 	return d.innerDialer.DialContext(ctx, network, address)
 }
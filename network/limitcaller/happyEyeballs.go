@@ -0,0 +1,189 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package limitcaller
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+)
+
+// defaultFallbackDelay is how long a connection attempt is given before the
+// next address is tried, as recommended by RFC 8305.
+const defaultFallbackDelay = 250 * time.Millisecond
+
+// DialPolicy configures how the addresses of peers with both IPv4 and IPv6
+// addresses are dialed.
+type DialPolicy struct {
+	// PreferIPv6 makes the IPv6 addresses be tried first. Otherwise, the
+	// family of the first resolved address is tried first.
+	PreferIPv6 bool
+	// FallbackDelay is how long an attempt is given before the next address
+	// is tried concurrently. Zero means defaultFallbackDelay.
+	FallbackDelay time.Duration
+}
+
+type ipResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// happyEyeballsDialer resolves the host names itself and races connection
+// attempts to their addresses, alternating address families, as described in
+// RFC 8305. The resolved addresses are recorded in the phonebook, which
+// provides them when the name can't be resolved.
+type happyEyeballsDialer struct {
+	resolver  ipResolver
+	dialer    *net.Dialer
+	policy    DialPolicy
+	addresses phonebook.PeerAddresses
+}
+
+func (d *happyEyeballsDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if net.ParseIP(host) != nil {
+		return d.dialer.DialContext(ctx, network, address)
+	}
+
+	var addrs []string
+	ipAddrs, err := d.resolver.LookupIPAddr(ctx, host)
+	if err == nil && len(ipAddrs) > 0 {
+		addrs = make([]string, 0, len(ipAddrs))
+		for _, ip := range sortDialAddrs(ipAddrs, d.policy.PreferIPv6) {
+			addrs = append(addrs, net.JoinHostPort(ip.String(), port))
+		}
+		if d.addresses != nil {
+			d.addresses.SetNetworkAddresses(address, addrs)
+		}
+	} else if d.addresses != nil {
+		// fall back to the addresses the name resolved to last time
+		addrs = d.addresses.NetworkAddresses(address)
+	}
+	if len(addrs) == 0 {
+		if err == nil {
+			err = &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+		}
+		return nil, err
+	}
+
+	fallbackDelay := d.policy.FallbackDelay
+	if fallbackDelay <= 0 {
+		fallbackDelay = defaultFallbackDelay
+	}
+	return dialParallel(ctx, d.dialer, network, addrs, fallbackDelay)
+}
+
+// sortDialAddrs orders addresses so that the families alternate, starting with
+// IPv6 if preferIPv6 is set, or with the family of the first address otherwise.
+// The order of the addresses within each family is preserved.
+func sortDialAddrs(ipAddrs []net.IPAddr, preferIPv6 bool) []net.IPAddr {
+	var v4, v6 []net.IPAddr
+	for _, ip := range ipAddrs {
+		if ip.IP.To4() != nil {
+			v4 = append(v4, ip)
+		} else {
+			v6 = append(v6, ip)
+		}
+	}
+	first, second := v4, v6
+	if preferIPv6 || (len(ipAddrs) > 0 && ipAddrs[0].IP.To4() == nil) {
+		first, second = v6, v4
+	}
+	sorted := make([]net.IPAddr, 0, len(ipAddrs))
+	for i := 0; i < len(first) || i < len(second); i++ {
+		if i < len(first) {
+			sorted = append(sorted, first[i])
+		}
+		if i < len(second) {
+			sorted = append(sorted, second[i])
+		}
+	}
+	return sorted
+}
+
+type dialResult struct {
+	conn net.Conn
+	err  error
+}
+
+// dialParallel dials addrs in order, starting the next attempt when the
+// previous one fails or has not completed within fallbackDelay. It returns the
+// first established connection, and closes the ones completing afterwards.
+func dialParallel(ctx context.Context, dialer *net.Dialer, network string, addrs []string, fallbackDelay time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan dialResult, len(addrs))
+	fallback := time.NewTimer(0)
+	defer fallback.Stop()
+	<-fallback.C
+
+	var firstErr error
+	next, pending := 0, 0
+	startNext := true
+	for {
+		if startNext && next < len(addrs) {
+			go func(addr string) {
+				conn, err := dialer.DialContext(ctx, network, addr)
+				results <- dialResult{conn, err}
+			}(addrs[next])
+			next++
+			pending++
+			fallback.Reset(fallbackDelay)
+		}
+		startNext = false
+		if pending == 0 {
+			if firstErr == nil {
+				firstErr = errors.New("no address to dial")
+			}
+			return nil, firstErr
+		}
+
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				go closeLateConnections(results, pending)
+				return r.conn, nil
+			}
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			startNext = true
+		case <-fallback.C:
+			startNext = true
+		case <-ctx.Done():
+			go closeLateConnections(results, pending)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// closeLateConnections waits for the pending attempts, which have been
+// cancelled, and closes the connections they may have established anyway.
+func closeLateConnections(results <-chan dialResult, pending int) {
+	for ; pending > 0; pending-- {
+		if r := <-results; r.conn != nil {
+			r.conn.Close()
+		}
+	}
+}
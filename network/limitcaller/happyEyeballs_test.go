@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package limitcaller
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSortDialAddrs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ip := func(s string) net.IPAddr { return net.IPAddr{IP: net.ParseIP(s)} }
+	v4a, v4b, v4c := ip("10.0.0.1"), ip("10.0.0.2"), ip("10.0.0.3")
+	v6a, v6b := ip("fd00::1"), ip("fd00::2")
+
+	addrs := []net.IPAddr{v4a, v4b, v4c, v6a, v6b}
+	require.Equal(t, []net.IPAddr{v4a, v6a, v4b, v6b, v4c}, sortDialAddrs(addrs, false))
+	require.Equal(t, []net.IPAddr{v6a, v4a, v6b, v4b, v4c}, sortDialAddrs(addrs, true))
+
+	addrs = []net.IPAddr{v6a, v4a, v4b}
+	require.Equal(t, []net.IPAddr{v6a, v4a, v4b}, sortDialAddrs(addrs, false))
+
+	addrs = []net.IPAddr{v4a, v4b}
+	require.Equal(t, addrs, sortDialAddrs(addrs, true))
+	require.Empty(t, sortDialAddrs(nil, true))
+}
+
+// closedAddress returns the address of a local port nothing listens on.
+func closedAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	l.Close()
+	return addr
+}
+
+func TestDialParallel(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	// a refused attempt immediately starts the next one
+	start := time.Now()
+	conn, err := dialParallel(context.Background(), &net.Dialer{}, "tcp", []string{closedAddress(t), l.Addr().String()}, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, l.Addr().String(), conn.RemoteAddr().String())
+	require.Less(t, time.Since(start), time.Minute)
+	conn.Close()
+
+	_, err = dialParallel(context.Background(), &net.Dialer{}, "tcp", []string{closedAddress(t), closedAddress(t)}, time.Minute)
+	require.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = dialParallel(ctx, &net.Dialer{}, "tcp", []string{l.Addr().String()}, time.Minute)
+	require.Error(t, err)
+}
+
+type testResolver struct {
+	addrs []net.IPAddr
+	err   error
+}
+
+func (r *testResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	return r.addrs, r.err
+}
+
+type testPeerAddresses map[string][]string
+
+func (a testPeerAddresses) SetNetworkAddresses(addr string, netAddrs []string) {
+	a[addr] = netAddrs
+}
+
+func (a testPeerAddresses) NetworkAddresses(addr string) []string {
+	return a[addr]
+}
+
+func TestHappyEyeballsDialer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	_, port, err := net.SplitHostPort(l.Addr().String())
+	require.NoError(t, err)
+
+	resolver := &testResolver{addrs: []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}}}
+	addresses := testPeerAddresses{}
+	d := &happyEyeballsDialer{
+		resolver:  resolver,
+		dialer:    &net.Dialer{},
+		policy:    DialPolicy{PreferIPv6: true, FallbackDelay: 10 * time.Millisecond},
+		addresses: addresses,
+	}
+
+	relay := net.JoinHostPort("relay.test", port)
+	conn, err := d.DialContext(context.Background(), "tcp", relay)
+	require.NoError(t, err)
+	conn.Close()
+	require.Equal(t, []string{l.Addr().String()}, addresses[relay])
+
+	// the recorded addresses are dialed when the name can't be resolved
+	resolver.addrs, resolver.err = nil, errors.New("lookup failed")
+	conn, err = d.DialContext(context.Background(), "tcp", relay)
+	require.NoError(t, err)
+	require.Equal(t, l.Addr().String(), conn.RemoteAddr().String())
+	conn.Close()
+
+	_, err = d.DialContext(context.Background(), "tcp", net.JoinHostPort("other.test", port))
+	require.ErrorContains(t, err, "lookup failed")
+}
@@ -0,0 +1,246 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/miekg/dns"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// mdnsDiscovery advertises this node and discovers the other algod nodes of
+// the same network on the local network segment using multicast DNS.
+//
+// Every node periodically multicasts a PTR query for mdnsServiceName. The
+// nodes which accept incoming connections answer it with a PTR record naming
+// their own instance, and a TXT record carrying their genesis ID and gossip
+// port. The address of a discovered node is the source address of its answer
+// combined with the advertised port.
+type mdnsDiscovery struct {
+	log       logging.Logger
+	genesisID string
+	// port is the gossip port advertised by this node, or empty if it does
+	// not accept incoming connections.
+	port string
+	// instance is the name of this node's service instance, used to ignore
+	// our own answers.
+	instance string
+	// update is called with the current set of discovered addresses.
+	update func(addrs []string)
+
+	conn *net.UDPConn
+
+	mu    deadlock.Mutex
+	peers map[string]time.Time
+}
+
+// mdnsNetworkName is the phonebook network name of the addresses discovered through mDNS.
+const mdnsNetworkName = "local"
+
+const mdnsServiceName = "_algod._tcp.local."
+
+// mdnsQueryInterval is how often a query is multicast; a discovered node is
+// forgotten after mdnsPeerTTL without an answer from it.
+const mdnsQueryInterval = 30 * time.Second
+const mdnsPeerTTL = 3 * mdnsQueryInterval
+
+var mdnsGroupAddr = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: 5353}
+
+func makeMDNSDiscovery(log logging.Logger, genesisID string, port string, update func([]string)) *mdnsDiscovery {
+	return &mdnsDiscovery{
+		log:       log,
+		genesisID: genesisID,
+		port:      port,
+		instance:  fmt.Sprintf("%016x.%s", crypto.RandUint64(), mdnsServiceName),
+		update:    update,
+		peers:     make(map[string]time.Time),
+	}
+}
+
+// start joins the mDNS multicast group and runs the discovery until ctx is done.
+func (d *mdnsDiscovery) start(ctx context.Context, wg *sync.WaitGroup) error {
+	conn, err := net.ListenMulticastUDP("udp4", nil, mdnsGroupAddr)
+	if err != nil {
+		return err
+	}
+	d.conn = conn
+
+	wg.Add(2)
+	go d.readLoop(wg)
+	go d.queryLoop(ctx, wg)
+	return nil
+}
+
+func (d *mdnsDiscovery) readLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	buf := make([]byte, dns.MaxMsgSize)
+	for {
+		n, src, err := d.conn.ReadFromUDP(buf)
+		if err != nil {
+			// the connection was closed by queryLoop
+			return
+		}
+		reply := d.handlePacket(buf[:n], src.IP, time.Now())
+		if reply != nil {
+			d.send(reply)
+		}
+	}
+}
+
+func (d *mdnsDiscovery) queryLoop(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer d.conn.Close()
+	ticker := time.NewTicker(mdnsQueryInterval)
+	defer ticker.Stop()
+	for {
+		d.send(d.query())
+		if d.prune(time.Now()) {
+			d.update(d.addresses())
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *mdnsDiscovery) send(msg *dns.Msg) {
+	packet, err := msg.Pack()
+	if err != nil {
+		d.log.Warnf("mdns: could not pack message: %v", err)
+		return
+	}
+	_, err = d.conn.WriteToUDP(packet, mdnsGroupAddr)
+	if err != nil {
+		d.log.Debugf("mdns: could not send message: %v", err)
+	}
+}
+
+func (d *mdnsDiscovery) query() *dns.Msg {
+	msg := new(dns.Msg)
+	msg.SetQuestion(mdnsServiceName, dns.TypePTR)
+	msg.RecursionDesired = false
+	return msg
+}
+
+// answer returns the records advertising this node.
+func (d *mdnsDiscovery) answer() *dns.Msg {
+	msg := new(dns.Msg)
+	msg.Response = true
+	msg.Authoritative = true
+	msg.Answer = []dns.RR{
+		&dns.PTR{
+			Hdr: dns.RR_Header{Name: mdnsServiceName, Rrtype: dns.TypePTR, Class: dns.ClassINET, Ttl: uint32(mdnsPeerTTL.Seconds())},
+			Ptr: d.instance,
+		},
+		&dns.TXT{
+			Hdr: dns.RR_Header{Name: d.instance, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: uint32(mdnsPeerTTL.Seconds())},
+			Txt: []string{"genesis=" + d.genesisID, "port=" + d.port},
+		},
+	}
+	return msg
+}
+
+// handlePacket processes a packet received from src, and returns the message
+// to multicast in reply, if any.
+func (d *mdnsDiscovery) handlePacket(packet []byte, src net.IP, now time.Time) *dns.Msg {
+	var msg dns.Msg
+	if err := msg.Unpack(packet); err != nil {
+		return nil
+	}
+
+	if !msg.Response {
+		if d.port == "" {
+			return nil
+		}
+		for _, q := range msg.Question {
+			if q.Qtype == dns.TypePTR && strings.EqualFold(q.Name, mdnsServiceName) {
+				return d.answer()
+			}
+		}
+		return nil
+	}
+
+	added := false
+	for _, rr := range append(msg.Answer, msg.Extra...) {
+		txt, ok := rr.(*dns.TXT)
+		if !ok || txt.Hdr.Name == d.instance || !dns.IsSubDomain(mdnsServiceName, txt.Hdr.Name) {
+			continue
+		}
+		var genesisID, port string
+		for _, kv := range txt.Txt {
+			if v, ok := strings.CutPrefix(kv, "genesis="); ok {
+				genesisID = v
+			} else if v, ok := strings.CutPrefix(kv, "port="); ok {
+				port = v
+			}
+		}
+		if genesisID != d.genesisID || port == "" {
+			continue
+		}
+		addr := net.JoinHostPort(src.String(), port)
+		d.mu.Lock()
+		_, known := d.peers[addr]
+		d.peers[addr] = now
+		d.mu.Unlock()
+		if !known {
+			d.log.Infof("mdns: discovered %s", addr)
+			added = true
+		}
+	}
+	if added {
+		d.update(d.addresses())
+	}
+	return nil
+}
+
+// prune forgets the nodes which have not answered for mdnsPeerTTL, and
+// reports whether any were forgotten.
+func (d *mdnsDiscovery) prune(now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	pruned := false
+	for addr, seen := range d.peers {
+		if now.Sub(seen) > mdnsPeerTTL {
+			delete(d.peers, addr)
+			pruned = true
+		}
+	}
+	return pruned
+}
+
+func (d *mdnsDiscovery) addresses() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	addrs := make([]string, 0, len(d.peers))
+	for addr := range d.peers {
+		addrs = append(addrs, addr)
+	}
+	slices.Sort(addrs)
+	return addrs
+}
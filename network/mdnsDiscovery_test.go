@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestMDNSDiscovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var updates [][]string
+	update := func(addrs []string) { updates = append(updates, addrs) }
+	relay := makeMDNSDiscovery(logging.TestingLog(t), "test-v1", "4160", update)
+	node := makeMDNSDiscovery(logging.TestingLog(t), "test-v1", "", update)
+	other := makeMDNSDiscovery(logging.TestingLog(t), "other-v1", "4161", update)
+
+	pack := func(d *mdnsDiscovery, answer bool) []byte {
+		msg := d.query()
+		if answer {
+			msg = d.answer()
+		}
+		packet, err := msg.Pack()
+		require.NoError(t, err)
+		return packet
+	}
+
+	// only nodes accepting incoming connections answer queries
+	now := time.Now()
+	require.Nil(t, node.handlePacket(pack(relay, false), net.IPv4(10, 0, 0, 2), now))
+	reply := relay.handlePacket(pack(node, false), net.IPv4(10, 0, 0, 1), now)
+	require.NotNil(t, reply)
+	answer, err := reply.Pack()
+	require.NoError(t, err)
+
+	node.handlePacket(answer, net.IPv4(10, 0, 0, 2), now)
+	require.Equal(t, [][]string{{"10.0.0.2:4160"}}, updates)
+
+	// the relay ignores its own answer, and the node ignores other networks
+	relay.handlePacket(answer, net.IPv4(10, 0, 0, 2), now)
+	node.handlePacket(pack(other, true), net.IPv4(10, 0, 0, 3), now)
+	node.handlePacket([]byte("garbage"), net.IPv4(10, 0, 0, 4), now)
+	require.Len(t, updates, 1)
+
+	// known nodes are refreshed without updates, and forgotten once they stop answering
+	node.handlePacket(answer, net.IPv4(10, 0, 0, 2), now.Add(mdnsQueryInterval))
+	require.Len(t, updates, 1)
+	require.False(t, node.prune(now.Add(mdnsPeerTTL)))
+	require.True(t, node.prune(now.Add(mdnsQueryInterval+mdnsPeerTTL+time.Second)))
+	require.Empty(t, node.addresses())
+}
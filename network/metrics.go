@@ -100,6 +100,10 @@ var networkPeerIdentityDisconnect = metrics.MakeCounter(metrics.MetricName{Name:
 var networkPeerIdentityError = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_identity_error", Description: "number of times an error occurs (besides expected) when processing identity challenges"})
 var networkPeerAlreadyClosed = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_already_closed", Description: "number of times a peer would be added but the peer connection is already closed"})
 
+var networkTrafficShapingDelayMicrosTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_traffic_shaping_delay_micros_total", Description: "Total time messages to incoming peers were delayed to stay within the configured bandwidth caps"})
+
+var networkSendQueueDepth = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_send_queue_depth", Description: "Number of messages waiting in the peers send queues, by priority"})
+
 var networkSlowPeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_slow_drops_total", Description: "number of peers dropped for being slow to send to"})
 var networkIdlePeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_idle_drops_total", Description: "number of peers dropped due to idle connection"})
 
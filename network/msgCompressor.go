@@ -32,8 +32,24 @@ var zstdCompressionMagic = [4]byte{0x28, 0xb5, 0x2f, 0xfd}
 
 const zstdCompressionLevel = zstd.BestSpeed
 
+// zstdCompressedTags lists the messages which may be zstd compressed for the peers supporting
+// PeerFeatureMessageZstdCompression. Proposal payloads are compressed for all the peers.
+var zstdCompressedTags = map[protocol.Tag]bool{
+	protocol.VoteBundleTag: true,
+	protocol.TxnTag:        true,
+}
+
 // zstdCompressMsg returns a concatenation of a tag and compressed data
 func zstdCompressMsg(tbytes []byte, d []byte) ([]byte, string) {
+	return zstdCompressMsgLevel(tbytes, d, zstdCompressionLevel)
+}
+
+// zstdCompressMsgLevel is like zstdCompressMsg, but compresses at the given level.
+// Out of range levels are replaced by the default one.
+func zstdCompressMsgLevel(tbytes []byte, d []byte, level int) ([]byte, string) {
+	if level < zstd.BestSpeed || level > zstd.BestCompression {
+		level = zstdCompressionLevel
+	}
 	bound := zstd.CompressBound(len(d))
 	if bound < len(d) {
 		// although CompressBound allocated more than the src size, this is an implementation detail.
@@ -42,7 +58,7 @@ func zstdCompressMsg(tbytes []byte, d []byte) ([]byte, string) {
 	}
 	mbytesComp := make([]byte, len(tbytes)+bound)
 	copy(mbytesComp, tbytes)
-	comp, err := zstd.CompressLevel(mbytesComp[len(tbytes):], d, zstdCompressionLevel)
+	comp, err := zstd.CompressLevel(mbytesComp[len(tbytes):], d, level)
 	if err != nil {
 		// fallback and reuse non-compressed original data
 		logMsg := fmt.Sprintf("failed to compress into buffer of len %d: %v", len(d), err)
@@ -77,8 +93,8 @@ func vpackCompressVote(tbytes []byte, d []byte) ([]byte, string) {
 const MaxDecompressedMessageSize = 20 * 1024 * 1024 // some large enough value
 
 // wsPeerMsgDataDecoder performs optional incoming messages conversion.
-// At the moment it only supports zstd decompression for payload proposal,
-// and vpack decompression for votes.
+// At the moment it only supports zstd decompression for payload proposal and
+// the other zstdCompressedTags messages, and vpack decompression for votes.
 type wsPeerMsgDataDecoder struct {
 	log    logging.Logger
 	origin string
@@ -86,6 +102,9 @@ type wsPeerMsgDataDecoder struct {
 	// actual converter(s)
 	ppdec zstdProposalDecompressor
 	avdec vpackVoteDecompressor
+
+	// zstdMessages is set if both ends advertised support for zstd compressed messages
+	zstdMessages bool
 }
 
 type zstdProposalDecompressor struct{}
@@ -148,6 +167,15 @@ func (c *wsPeerMsgDataDecoder) convert(tag protocol.Tag, data []byte) ([]byte, e
 			}
 			return res, nil
 		}
+	} else if c.zstdMessages && zstdCompressedTags[tag] {
+		// small messages are not compressed, so only decompress the ones having the zstd header
+		if c.ppdec.accept(data) {
+			res, err := c.ppdec.convert(data)
+			if err != nil {
+				return nil, fmt.Errorf("peer %s: %w", c.origin, err)
+			}
+			return res, nil
+		}
 	}
 	return data, nil
 }
@@ -166,5 +194,6 @@ func makeWsPeerMsgDataDecoder(wp *wsPeer) *wsPeerMsgDataDecoder {
 			dec:     vpack.NewStatelessDecoder(),
 		}
 	}
+	c.zstdMessages = wp.enableMessageCompression && wp.zstdMessageCompressionSupported()
 	return &c
 }
@@ -105,3 +105,55 @@ func TestWsPeerMsgDataConverterConvert(t *testing.T) {
 	require.Equal(t, data, r)
 	require.Equal(t, 0, l.warnMsgCount)
 }
+
+func TestZstdMessageCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	wn := WebsocketNetwork{}
+	wn.broadcaster.log = logging.TestingLog(t)
+	wn.broadcaster.enableMessageCompression = true
+	wn.broadcaster.config.MessageCompressionThreshold = 1024
+	wn.broadcaster.config.MessageCompressionLevel = 3
+
+	large := []byte(strings.Repeat("bundle", 1024))
+	reqs := []broadcastRequest{
+		{tag: protocol.VoteBundleTag, data: large},
+		{tag: protocol.TxnTag, data: large},
+		{tag: protocol.VoteBundleTag, data: large[:512]}, // below the threshold
+		{tag: protocol.StateProofSigTag, data: large},    // not compressible
+	}
+	for i, req := range reqs {
+		data, compressed, _ := wn.broadcaster.preparePeerData(req, true)
+		require.Equal(t, append([]byte(req.tag), req.data...), data)
+		if i >= 2 {
+			require.Empty(t, compressed)
+			continue
+		}
+		require.Less(t, len(compressed), len(data))
+		require.Equal(t, append([]byte(req.tag), zstdCompressionMagic[:]...), compressed[:len(req.tag)+len(zstdCompressionMagic)])
+
+		// a peer which negotiated compression restores the message
+		c := wsPeerMsgDataDecoder{log: logging.TestingLog(t), zstdMessages: true}
+		r, err := c.convert(req.tag, compressed[len(req.tag):])
+		require.NoError(t, err)
+		require.Equal(t, req.data, r)
+
+		// uncompressed messages are passed as is
+		r, err = c.convert(req.tag, req.data)
+		require.NoError(t, err)
+		require.Equal(t, req.data, r)
+	}
+
+	// nothing is compressed when disabled
+	wn.broadcaster.enableMessageCompression = false
+	_, compressed, _ := wn.broadcaster.preparePeerData(reqs[0], true)
+	require.Empty(t, compressed)
+
+	// peers only get the compressed data for the negotiated features
+	wp := wsPeer{features: pfCompressedVoteVpack}
+	require.True(t, wp.compressionSupported(protocol.AgreementVoteTag))
+	require.False(t, wp.compressionSupported(protocol.VoteBundleTag))
+	wp.features = decodePeerFeatures("2.2", PeerFeatureProposalCompression+","+PeerFeatureMessageZstdCompression)
+	require.False(t, wp.compressionSupported(protocol.AgreementVoteTag))
+	require.True(t, wp.compressionSupported(protocol.VoteBundleTag))
+}
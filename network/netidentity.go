@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/protocol"
@@ -66,6 +67,7 @@ import (
 //   this is so that if an operator misconfigures PublicAddress, it does not decline well meaning peering attempts
 // - If the Message is malformed or cannot be decoded, the peering attempt is stopped
 // - If the Signature in the challenge does not verify to the included key, the peering attempt is stopped
+// - If the challenge was already received recently, it is a replay and the peering attempt is stopped
 //
 // Message 2
 // - If the Message is not included, assume the peer does not use identity exchange, and do not send Message 3
@@ -80,6 +82,12 @@ import (
 
 const maxAddressLen = 256 + 32 // Max DNS (255) + margin for port specification
 
+// the number and size of the buckets of recently received identity challenges, used to reject replayed challenges
+const (
+	identityChallengeReplayBucketCount = 4
+	identityChallengeReplayBucketSize  = 1024
+)
+
 // identityChallengeValue is 32 random bytes used for identity challenge exchange
 type identityChallengeValue [32]byte
 
@@ -104,6 +112,12 @@ type identityChallengeSigner interface {
 type identityOpts struct {
 	scheme  identityChallengeScheme
 	tracker identityTracker
+	keysDir string
+}
+
+// NetIdentityKeysDir returns the options of a websocket network which persists its identity keys in dir
+func NetIdentityKeysDir(dir string) *identityOpts {
+	return &identityOpts{keysDir: dir}
 }
 
 type identityChallengeLegacySigner struct {
@@ -128,6 +142,8 @@ func (s *identityChallengeLegacySigner) PublicKey() crypto.PublicKey {
 type identityChallengePublicKeyScheme struct {
 	dedupNames   map[string]struct{}
 	identityKeys identityChallengeSigner
+	// seenChallenges holds the recently received identity challenges
+	seenChallenges *messageFilter
 }
 
 type identityChallengeSchemeConfig struct {
@@ -180,18 +196,21 @@ func NewIdentityChallengeScheme(opts ...IdentityChallengeSchemeOption) *identity
 		return &identityChallengePublicKeyScheme{}
 	}
 
+	seenChallenges := makeMessageFilter(identityChallengeReplayBucketCount, identityChallengeReplayBucketSize)
 	if config.signer != nil {
 		return &identityChallengePublicKeyScheme{
-			dedupNames:   dedupNames,
-			identityKeys: config.signer,
+			dedupNames:     dedupNames,
+			identityKeys:   config.signer,
+			seenChallenges: seenChallenges,
 		}
 	}
 
 	var seed crypto.Seed
 	crypto.RandBytes(seed[:])
 	return &identityChallengePublicKeyScheme{
-		dedupNames:   dedupNames,
-		identityKeys: &identityChallengeLegacySigner{keys: crypto.GenerateSignatureSecrets(seed)},
+		dedupNames:     dedupNames,
+		identityKeys:   &identityChallengeLegacySigner{keys: crypto.GenerateSignatureSecrets(seed)},
+		seenChallenges: seenChallenges,
 	}
 }
 
@@ -203,13 +222,24 @@ func (i identityChallengePublicKeyScheme) AttachChallenge(attachTo http.Header,
 	if len(i.dedupNames) == 0 || addr == "" {
 		return identityChallengeValue{}
 	}
+	signer := i.identityKeys
+	kr, rotating := i.identityKeys.(*identityKeyring)
+	var key *identityKey
+	if rotating {
+		key = kr.currentKey(time.Now())
+		signer = key.signer
+	}
 	c := identityChallenge{
-		Key:           i.identityKeys.PublicKey(),
+		Key:           signer.PublicKey(),
 		Challenge:     newIdentityChallengeValue(),
 		PublicAddress: []byte(addr),
 	}
 
-	attachTo.Add(IdentityChallengeHeader, c.signAndEncodeB64(i.identityKeys))
+	attachTo.Add(IdentityChallengeHeader, c.signAndEncodeB64(signer))
+	if rotating {
+		// the identity verification message has to be signed with the same key, even if it gets rotated meanwhile
+		kr.recordChallenge(c.Challenge, key, time.Now())
+	}
 	return c.Challenge
 }
 
@@ -250,13 +280,18 @@ func (i identityChallengePublicKeyScheme) VerifyRequestAndAttachResponse(attachT
 	if _, ok := i.dedupNames[string(idChal.Msg.PublicAddress)]; !ok {
 		return identityChallengeValue{}, crypto.PublicKey{}, nil
 	}
+	// a signed challenge could be captured and sent again by anyone, so each challenge is only accepted once
+	if i.seenChallenges != nil && i.seenChallenges.CheckDigest(crypto.Digest(idChal.Msg.Challenge), true, false) {
+		return identityChallengeValue{}, crypto.PublicKey{}, fmt.Errorf("identity challenge replayed")
+	}
 	// make the response object, encode it and attach it to the header
+	signer := signerSnapshot(i.identityKeys)
 	r := identityChallengeResponse{
-		Key:               i.identityKeys.PublicKey(),
+		Key:               signer.PublicKey(),
 		Challenge:         idChal.Msg.Challenge,
 		ResponseChallenge: newIdentityChallengeValue(),
 	}
-	attachTo.Add(IdentityChallengeHeader, r.signAndEncodeB64(i.identityKeys))
+	attachTo.Add(IdentityChallengeHeader, r.signAndEncodeB64(signer))
 	return r.ResponseChallenge, idChal.Msg.Key, nil
 }
 
@@ -290,14 +325,18 @@ func (i identityChallengePublicKeyScheme) VerifyResponse(h http.Header, c identi
 	if !resp.Verify() {
 		return crypto.PublicKey{}, []byte{}, fmt.Errorf("challenge response incorrectly signed ")
 	}
-	return resp.Msg.Key, i.identityVerificationMessage(resp.Msg.ResponseChallenge), nil
+	signer := i.identityKeys
+	if kr, ok := i.identityKeys.(*identityKeyring); ok {
+		signer = kr.challengeSigner(c)
+	}
+	return resp.Msg.Key, i.identityVerificationMessage(resp.Msg.ResponseChallenge, signer), nil
 }
 
 // identityVerificationMessage generates the 3rd message of the challenge exchange,
 // which a wsNetwork can then send to a peer in order to verify their own identity.
 // It is prefixed with the ID Verification tag and returned ready-to-send
-func (i *identityChallengePublicKeyScheme) identityVerificationMessage(c identityChallengeValue) []byte {
-	signedMsg := identityVerificationMessage{ResponseChallenge: c}.Sign(i.identityKeys)
+func (i *identityChallengePublicKeyScheme) identityVerificationMessage(c identityChallengeValue, signer identityChallengeSigner) []byte {
+	signedMsg := identityVerificationMessage{ResponseChallenge: c}.Sign(signer)
 	return append([]byte(protocol.NetIDVerificationTag), protocol.Encode(&signedMsg)...)
 }
 
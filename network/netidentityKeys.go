@@ -0,0 +1,271 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util"
+)
+
+// netidentityKeys.go implements a keyring for the identity challenge exchange keys.
+// The keyring can be persisted in the data directory, so that a node keeps its identity across restarts,
+// and can rotate the identity key on a schedule. When the key is rotated, the retired key is kept for an
+// overlap period so that identity challenge exchanges that were started with it can still be completed.
+// To rotate the identity key immediately (e.g. after a compromise), stop the node and delete the keys file.
+
+// NetIdentityKeysFilename is the name of the file in the node's data directory which holds the identity keys.
+const NetIdentityKeysFilename = "netIdentityKeys.json"
+
+// identityChallengeIssuedTTL is how long the keyring remembers which key issued an identity challenge.
+// It only needs to be longer than an HTTP handshake.
+const identityChallengeIssuedTTL = time.Minute
+
+type identityKey struct {
+	signer  *identityChallengeLegacySigner
+	seed    crypto.Seed
+	created time.Time
+	retired time.Time
+}
+
+func makeIdentityKey(seed crypto.Seed, created time.Time) *identityKey {
+	return &identityKey{
+		signer:  &identityChallengeLegacySigner{keys: crypto.GenerateSignatureSecrets(seed)},
+		seed:    seed,
+		created: created,
+	}
+}
+
+func generateIdentityKey(created time.Time) *identityKey {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	return makeIdentityKey(seed, created)
+}
+
+type issuedChallenge struct {
+	key    *identityKey
+	issued time.Time
+}
+
+// identityKeyring implements identityChallengeSigner with the current identity key,
+// and keeps the retired identity key for the overlap period after a rotation.
+type identityKeyring struct {
+	mu deadlock.Mutex
+
+	log              logging.Logger
+	path             string
+	rotationInterval time.Duration
+	overlap          time.Duration
+
+	current  *identityKey
+	previous *identityKey
+	issued   map[identityChallengeValue]issuedChallenge
+}
+
+// identityKeysFile is the on-disk format of the identityKeyring.
+type identityKeysFile struct {
+	Current  identityKeysFileEntry  `json:"current"`
+	Previous *identityKeysFileEntry `json:"previous,omitempty"`
+}
+
+type identityKeysFileEntry struct {
+	Seed    []byte    `json:"seed"`
+	Created time.Time `json:"created"`
+	Retired time.Time `json:"retired,omitempty"`
+}
+
+// loadIdentityKeyring loads the identity keys from path, generating (and persisting) new ones if the file does not exist.
+// If path is empty, the keys are not persisted. A zero rotationInterval disables the key rotation.
+func loadIdentityKeyring(log logging.Logger, path string, rotationInterval, overlap time.Duration, now time.Time) (*identityKeyring, error) {
+	kr := &identityKeyring{
+		log:              log,
+		path:             path,
+		rotationInterval: rotationInterval,
+		overlap:          overlap,
+		issued:           make(map[identityChallengeValue]issuedChallenge),
+	}
+	if path != "" && util.FileExists(path) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var f identityKeysFile
+		if err = json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("unable to decode identity keys file %s: %w", path, err)
+		}
+		if kr.current, err = f.Current.identityKey(); err != nil {
+			return nil, fmt.Errorf("invalid current identity key in %s: %w", path, err)
+		}
+		if f.Previous != nil {
+			if kr.previous, err = f.Previous.identityKey(); err != nil {
+				return nil, fmt.Errorf("invalid previous identity key in %s: %w", path, err)
+			}
+		}
+		kr.mu.Lock()
+		defer kr.mu.Unlock()
+		kr.rotateIfDue(now)
+		return kr, nil
+	}
+
+	kr.current = generateIdentityKey(now)
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	if err := kr.save(); err != nil {
+		return nil, err
+	}
+	return kr, nil
+}
+
+func (e identityKeysFileEntry) identityKey() (*identityKey, error) {
+	var seed crypto.Seed
+	if len(e.Seed) != len(seed) {
+		return nil, fmt.Errorf("seed length %d, expected %d", len(e.Seed), len(seed))
+	}
+	copy(seed[:], e.Seed)
+	k := makeIdentityKey(seed, e.Created)
+	k.retired = e.Retired
+	return k, nil
+}
+
+func (k *identityKey) fileEntry() *identityKeysFileEntry {
+	return &identityKeysFileEntry{Seed: k.seed[:], Created: k.created, Retired: k.retired}
+}
+
+// save persists the keyring, if it has a path. The caller must hold kr.mu.
+func (kr *identityKeyring) save() error {
+	if kr.path == "" {
+		return nil
+	}
+	f := identityKeysFile{Current: *kr.current.fileEntry()}
+	if kr.previous != nil {
+		f.Previous = kr.previous.fileEntry()
+	}
+	data, err := json.Marshal(&f)
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first, so that a crash would not leave the node without its keys
+	tmp, err := os.CreateTemp(filepath.Dir(kr.path), filepath.Base(kr.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err = tmp.Chmod(0600); err == nil {
+		_, err = tmp.Write(data)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), kr.path)
+}
+
+// rotateIfDue rotates the current key if it is older than the rotation interval, and forgets the
+// previous key once the overlap period has passed. The caller must hold kr.mu.
+func (kr *identityKeyring) rotateIfDue(now time.Time) {
+	changed := false
+	if kr.previous != nil && now.Sub(kr.previous.retired) >= kr.overlap {
+		kr.previous = nil
+		changed = true
+	}
+	if kr.rotationInterval > 0 && now.Sub(kr.current.created) >= kr.rotationInterval {
+		kr.current.retired = now
+		kr.previous = kr.current
+		kr.current = generateIdentityKey(now)
+		kr.log.Infof("rotated the network identity key to %v", kr.current.signer.PublicKey())
+		changed = true
+	}
+	if !changed {
+		return
+	}
+	for c, ic := range kr.issued {
+		if ic.key != kr.current && ic.key != kr.previous {
+			delete(kr.issued, c)
+		}
+	}
+	if err := kr.save(); err != nil {
+		kr.log.Warnf("unable to persist the network identity keys: %v", err)
+	}
+}
+
+// currentKey returns the current key, rotating it first if it is due.
+func (kr *identityKeyring) currentKey(now time.Time) *identityKey {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	kr.rotateIfDue(now)
+	return kr.current
+}
+
+// recordChallenge remembers the key which issued the identity challenge c.
+func (kr *identityKeyring) recordChallenge(c identityChallengeValue, key *identityKey, now time.Time) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	for v, ic := range kr.issued {
+		if now.Sub(ic.issued) > identityChallengeIssuedTTL {
+			delete(kr.issued, v)
+		}
+	}
+	kr.issued[c] = issuedChallenge{key: key, issued: now}
+}
+
+// challengeSigner returns the signer of the key which issued the identity challenge c,
+// or the current key if the challenge is unknown.
+func (kr *identityKeyring) challengeSigner(c identityChallengeValue) identityChallengeSigner {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	if ic, ok := kr.issued[c]; ok {
+		delete(kr.issued, c)
+		if ic.key == kr.current || ic.key == kr.previous {
+			return ic.key.signer
+		}
+	}
+	return kr.current.signer
+}
+
+// Sign implements identityChallengeSigner with the current key
+func (kr *identityKeyring) Sign(message crypto.Hashable) crypto.Signature {
+	return kr.currentKey(time.Now()).signer.Sign(message)
+}
+
+// SignBytes implements identityChallengeSigner with the current key
+func (kr *identityKeyring) SignBytes(message []byte) crypto.Signature {
+	return kr.currentKey(time.Now()).signer.SignBytes(message)
+}
+
+// PublicKey implements identityChallengeSigner with the current key
+func (kr *identityKeyring) PublicKey() crypto.PublicKey {
+	return kr.currentKey(time.Now()).signer.PublicKey()
+}
+
+// signerSnapshot returns a signer which keeps using the same key, so that a public key and the signature
+// made with it match even if the identity key gets rotated in between.
+func signerSnapshot(s identityChallengeSigner) identityChallengeSigner {
+	if kr, ok := s.(*identityKeyring); ok {
+		return kr.currentKey(time.Now()).signer
+	}
+	return s
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestIdentityKeyringPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), NetIdentityKeysFilename)
+	now := time.Now()
+	kr, err := loadIdentityKeyring(logging.TestingLog(t), path, 0, time.Minute, now)
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// the same identity is loaded after a restart
+	kr2, err := loadIdentityKeyring(logging.TestingLog(t), path, 0, time.Minute, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, kr.PublicKey(), kr2.PublicKey())
+
+	// without a path, a new identity is generated each time
+	kr3, err := loadIdentityKeyring(logging.TestingLog(t), "", 0, time.Minute, now)
+	require.NoError(t, err)
+	require.NotEqual(t, kr.PublicKey(), kr3.PublicKey())
+
+	require.NoError(t, os.WriteFile(path, []byte("{\"current\":{\"seed\":\"AAAA\"}}"), 0600))
+	_, err = loadIdentityKeyring(logging.TestingLog(t), path, 0, time.Minute, now)
+	require.ErrorContains(t, err, "seed length")
+}
+
+func TestIdentityKeyringRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), NetIdentityKeysFilename)
+	now := time.Now()
+	kr, err := loadIdentityKeyring(logging.TestingLog(t), path, time.Hour, 10*time.Minute, now)
+	require.NoError(t, err)
+	first := kr.currentKey(now)
+	require.Same(t, first, kr.currentKey(now.Add(59*time.Minute)))
+
+	second := kr.currentKey(now.Add(time.Hour))
+	require.NotEqual(t, first.signer.PublicKey(), second.signer.PublicKey())
+	require.Same(t, first, kr.previous)
+
+	// the rotation is persisted, including the previous key
+	kr2, err := loadIdentityKeyring(logging.TestingLog(t), path, time.Hour, 10*time.Minute, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, second.signer.PublicKey(), kr2.PublicKey())
+	require.NotNil(t, kr2.previous)
+	require.Equal(t, first.signer.PublicKey(), kr2.previous.signer.PublicKey())
+
+	// the previous key is dropped after the overlap
+	require.Same(t, second, kr.currentKey(now.Add(time.Hour+10*time.Minute)))
+	require.Nil(t, kr.previous)
+}
+
+// TestIdentityKeyringRotationDuringExchange confirms that the identity verification message is signed
+// with the key the challenge was issued with, even if the key was rotated meanwhile.
+func TestIdentityKeyringRotationDuringExchange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Now()
+	kr, err := loadIdentityKeyring(logging.TestingLog(t), "", time.Hour, 10*time.Minute, now)
+	require.NoError(t, err)
+	i1 := NewIdentityChallengeScheme(NetIdentityDedupNames("i1"), NetIdentitySigner(kr))
+	i2 := NewIdentityChallengeScheme(NetIdentityDedupNames("i2"))
+
+	h := http.Header{}
+	origChal := i1.AttachChallenge(h, "i2")
+	r := http.Header{}
+	_, peerKey, err := i2.VerifyRequestAndAttachResponse(r, h)
+	require.NoError(t, err)
+
+	// rotate the key of i1 before it completes the exchange
+	oldKey := kr.currentKey(now)
+	require.Equal(t, oldKey.signer.PublicKey(), peerKey)
+	kr.currentKey(now.Add(time.Hour))
+	require.NotEqual(t, peerKey, kr.PublicKey())
+
+	_, verificationMsg, err := i1.VerifyResponse(r, origChal)
+	require.NoError(t, err)
+	var msg identityVerificationMessageSigned
+	require.NoError(t, protocol.Decode(verificationMsg[len(protocol.NetIDVerificationTag):], &msg))
+	require.True(t, msg.Verify(peerKey))
+}
+
+func TestIdentityChallengeReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	i1 := NewIdentityChallengeScheme(NetIdentityDedupNames("i1"))
+	i2 := NewIdentityChallengeScheme(NetIdentityDedupNames("i2"))
+
+	h := http.Header{}
+	i1.AttachChallenge(h, "i2")
+	_, _, err := i2.VerifyRequestAndAttachResponse(http.Header{}, h)
+	require.NoError(t, err)
+
+	// the same signed challenge is rejected the second time
+	r := http.Header{}
+	chal, key, err := i2.VerifyRequestAndAttachResponse(r, h)
+	require.ErrorContains(t, err, "replayed")
+	require.Empty(t, chal)
+	require.Empty(t, key)
+	require.Empty(t, r.Get(IdentityChallengeHeader))
+}
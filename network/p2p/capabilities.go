@@ -31,6 +31,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
 	algoDht "github.com/algorand/go-algorand/network/p2p/dht"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/protocol"
 )
 
@@ -46,6 +47,17 @@ const (
 	Gossip = "gossip"
 )
 
+// Roles returns the phonebook roles of the peers advertising the capability.
+func (c Capability) Roles() phonebook.Role {
+	switch c {
+	case Archival:
+		return phonebook.ArchivalRole | phonebook.BlockServiceRole
+	case Gossip:
+		return phonebook.RelayRole | phonebook.TxGossipRole
+	}
+	return 0
+}
+
 const operationTimeout = time.Second * 5
 const maxAdvertisementInterval = time.Hour * 22
 
@@ -34,6 +34,7 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	algodht "github.com/algorand/go-algorand/network/p2p/dht"
 	"github.com/algorand/go-algorand/network/p2p/peerstore"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -315,3 +316,16 @@ func TestCapabilities_ExcludesSelf(t *testing.T) {
 	require.NoError(t, err)
 	disc[0].wg.Wait()
 }
+
+func TestCapabilityRoles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.True(t, Capability(Gossip).Roles()&phonebook.RelayRole != 0)
+	require.True(t, Capability(Gossip).Roles()&phonebook.TxGossipRole != 0)
+	require.Zero(t, Capability(Gossip).Roles()&phonebook.ArchivalRole)
+	require.True(t, Archival.Roles()&phonebook.ArchivalRole != 0)
+	require.True(t, Archival.Roles()&phonebook.BlockServiceRole != 0)
+	require.Zero(t, Archival.Roles()&phonebook.RelayRole)
+	require.Zero(t, Capability(Catchpoints).Roles())
+}
@@ -58,6 +58,23 @@ func NewMultiaddrDNSResolveController(secure bool, fallbackDNSResolverAddress st
 	}
 }
 
+// NewProxiedMultiaddrDNSResolveController constructs a MultiaddrDNSResolveController which only
+// looks up dnsaddrs through the given proxy dialer.
+func NewProxiedMultiaddrDNSResolveController(dialer network.ContextDialer, fallbackDNSResolverAddress string) *MultiaddrDNSResolveController {
+	return &MultiaddrDNSResolveController{
+		nextResolvers: []func() *madns.Resolver{func() *madns.Resolver {
+			return MakeProxiedResolver(dialer, fallbackDNSResolverAddress)
+		}},
+	}
+}
+
+// MakeProxiedResolver returns a dnsaddr resolver which sends its queries over TCP through the given proxy dialer.
+func MakeProxiedResolver(dialer network.ContextDialer, fallbackDNSResolverAddress string) *madns.Resolver {
+	// ignore errors here since we madns.WithDefaultResolver can't error
+	mResv, _ := madns.NewResolver(madns.WithDefaultResolver(network.MakeProxiedResolver(dialer, fallbackDNSResolverAddress)))
+	return mResv
+}
+
 // NextResolver applies the nextResolvers functions in order and returns the most recent result
 func (c *MultiaddrDNSResolveController) NextResolver() Resolver {
 	if len(c.nextResolvers) == 0 {
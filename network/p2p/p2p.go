@@ -28,8 +28,10 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network/limitcaller"
+	"github.com/algorand/go-algorand/network/p2p/dnsaddr"
 	pstore "github.com/algorand/go-algorand/network/p2p/peerstore"
 	"github.com/algorand/go-algorand/network/phonebook"
+	tools_network "github.com/algorand/go-algorand/tools/network"
 	"github.com/algorand/go-algorand/util/metrics"
 	"github.com/algorand/go-deadlock"
 
@@ -150,10 +152,34 @@ func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.
 		return nil, "", err
 	}
 
+	transportOpts := []libp2p.Option{libp2p.Transport(tcp.NewTCPTransport)}
+	if cfg.OutgoingProxy != "" {
+		proxyDialer, err0 := tools_network.MakeProxyDialer(cfg.OutgoingProxy, nil)
+		if err0 != nil {
+			return nil, "", err0
+		}
+		if listenAddr != "" {
+			logging.Base().Warnf("p2p NetAddress %s is ignored since outgoing connections are made through a proxy", cfg.NetAddress)
+			listenAddr = ""
+		}
+		transportOpts = []libp2p.Option{
+			libp2p.Transport(makeProxyTransportConstructor(proxyDialer)),
+			libp2p.MultiaddrResolver(dnsaddr.MakeProxiedResolver(proxyDialer, cfg.FallbackDNSResolverAddress)),
+		}
+	}
+
+	// with port mapping, libp2p maps the listening port on the NAT gateway, renews the mapping,
+	// and advertises the external address to the peers through the identify protocol
+	portMapping := libp2p.ChainOptions()
+	if cfg.EnablePortMapping && listenAddr != "" {
+		portMapping = libp2p.NATPortMap()
+	}
+
 	host, err := libp2p.New(
 		libp2p.Identity(privKey),
 		libp2p.UserAgent(ua),
-		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.ChainOptions(transportOpts...),
+		portMapping,
 		libp2p.Muxer("/yamux/1.0.0", &ymx),
 		libp2p.Peerstore(pstore),
 		libp2p.NoListenAddrs,
@@ -272,7 +298,10 @@ func (s *serviceImpl) DialPeersUntilTargetCount(targetConnCount int) {
 		err := s.dialNode(context.Background(), peerInfo) // leaving the calls as blocking for now, to not over-connect beyond fanout
 		if err != nil {
 			s.log.Warnf("failed to connect to peer %s: %v", peerInfo.ID, err)
+			ps.MarkFailed(string(peerInfo.ID), time.Now())
+			continue
 		}
+		ps.MarkConnected(string(peerInfo.ID), time.Now())
 	}
 }
 
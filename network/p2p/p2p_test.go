@@ -241,3 +241,58 @@ func TestP2PMakeHostAddressFilter(t *testing.T) {
 		host.Close()
 	}
 }
+
+func TestProxyDialAddress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		input  string
+		output string
+		err    bool
+	}{
+		{input: "/ip4/192.168.1.1/tcp/4190", output: "192.168.1.1:4190"},
+		{input: "/ip6/::1/tcp/4190", output: "[::1]:4190"},
+		{input: "/dns4/relay.example.com/tcp/4190", output: "relay.example.com:4190"},
+		{input: "/dns/relay.example.com/tcp/4190", output: "relay.example.com:4190"},
+		{input: "/ip4/192.168.1.1/udp/4190", err: true},
+		{input: "/ip4/192.168.1.1/tcp/4190/ws", err: true},
+		{input: "/ip4/192.168.1.1", err: true},
+	}
+
+	var tr proxyTransport
+	for _, test := range tests {
+		ma := multiaddr.StringCast(test.input)
+		res, err := proxyDialAddress(ma)
+		if test.err {
+			require.Error(t, err, test.input)
+			require.False(t, tr.CanDial(ma), test.input)
+			continue
+		}
+		require.NoError(t, err, test.input)
+		require.Equal(t, test.output, res)
+		require.True(t, tr.CanDial(ma), test.input)
+	}
+}
+
+func TestMakeHostWithProxy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	td := t.TempDir()
+	pstore, err := peerstore.NewPeerStore(nil, "test")
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultLocal()
+	cfg.NetAddress = "127.0.0.1:0"
+	cfg.OutgoingProxy = "socks5://127.0.0.1:1080"
+	host, la, err := MakeHost(cfg, td, pstore)
+	require.NoError(t, err)
+	defer host.Close()
+	// a host behind a proxy does not listen
+	require.Empty(t, la)
+	require.Error(t, host.Network().Listen(multiaddr.StringCast("/ip4/127.0.0.1/tcp/0")))
+
+	cfg.OutgoingProxy = "ftp://127.0.0.1:1080"
+	_, _, err = MakeHost(cfg, td, pstore)
+	require.Error(t, err)
+}
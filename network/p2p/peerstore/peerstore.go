@@ -18,9 +18,6 @@ package peerstore
 
 import (
 	"fmt"
-	"math"
-	"math/rand"
-	"slices"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -31,34 +28,18 @@ import (
 	"github.com/algorand/go-deadlock"
 )
 
-// when using GetAddresses with getAllAddresses, all the addresses will be retrieved, regardless
-// of how many addresses the phonebook actually has. ( with the retry-after logic applied )
-const getAllAddresses = math.MaxInt32
-
-const addressDataKey string = "addressData"
-
 // PeerStore implements Peerstore and CertifiedAddrBook.
+//
+// The phonebook bookkeeping of the peers (their roles, the networks they were
+// obtained for, the connection rate limiting and the backoff) is kept in a
+// phonebook.Phonebook keyed by peer ID, so that the peer selection logic is
+// shared with the websocket network. The libp2p address book holds the
+// multiaddrs of the peers.
 type PeerStore struct {
 	peerStoreCAB
-	connectionsRateLimitingCount  uint
-	connectionsRateLimitingWindow time.Duration
-	lock                          deadlock.Mutex
-}
-
-// addressData: holds the information associated with each phonebook address.
-type addressData struct {
-	// retryAfter is the time to wait before retrying to connect to the address.
-	retryAfter time.Time
-
-	// recentConnectionTimes is the log of connection times used to observe the maximum
-	// connections to the address in a given time window.
-	recentConnectionTimes []time.Time
-
-	// networkNames: lists the networks to which the given address belongs.
-	networkNames map[string]bool
-
-	// roles is the roles that this address serves.
-	roles phonebook.RoleSet
+	pb phonebook.Phonebook
+	// lock serializes the phonebook updates, which also update the address book.
+	lock deadlock.Mutex
 }
 
 // peerStoreCAB combines the libp2p Peerstore and CertifiedAddrBook interfaces.
@@ -69,12 +50,18 @@ type peerStoreCAB interface {
 
 // NewPeerStore creates a new peerstore backed by a datastore.
 func NewPeerStore(addrInfo []*peer.AddrInfo, network string) (*PeerStore, error) {
+	return NewPeerStoreWithPhonebook(addrInfo, network, phonebook.MakePhonebook(0, 0))
+}
+
+// NewPeerStoreWithPhonebook creates a new peerstore backed by a datastore,
+// which keeps the phonebook entries of its peers in pb.
+func NewPeerStoreWithPhonebook(addrInfo []*peer.AddrInfo, network string, pb phonebook.Phonebook) (*PeerStore, error) {
 	ps, err := mempstore.NewPeerstore()
 	if err != nil {
 		return nil, fmt.Errorf("cannot initialize a peerstore: %w", err)
 	}
 
-	pstore := &PeerStore{peerStoreCAB: ps}
+	pstore := &PeerStore{peerStoreCAB: ps, pb: pb}
 	pstore.AddPersistentPeers(addrInfo, network, phonebook.RelayRole)
 	return pstore, nil
 }
@@ -87,15 +74,20 @@ func MakePhonebook(connectionsRateLimitingCount uint,
 		return &PeerStore{}, fmt.Errorf("cannot initialize a peerstore: %w", err)
 	}
 	pstore := &PeerStore{peerStoreCAB: ps,
-		connectionsRateLimitingCount:  connectionsRateLimitingCount,
-		connectionsRateLimitingWindow: connectionsRateLimitingWindow,
+		pb: phonebook.MakePhonebook(connectionsRateLimitingCount, connectionsRateLimitingWindow),
 	}
 	return pstore, nil
 }
 
 // GetAddresses returns up to N addresses, but may return fewer
 func (ps *PeerStore) GetAddresses(n int, role phonebook.Role) []*peer.AddrInfo {
-	return shuffleSelect(ps.filterRetryTime(time.Now(), role), n)
+	ids := ps.pb.GetAddresses(n, role)
+	out := make([]*peer.AddrInfo, len(ids))
+	for i, id := range ids {
+		peerID := peer.ID(id)
+		out[i] = &peer.AddrInfo{ID: peerID, Addrs: ps.Addrs(peerID)}
+	}
+	return out
 }
 
 // UpdateRetryAfter updates the retryAfter time for the given address.
@@ -104,16 +96,7 @@ func (ps *PeerStore) UpdateRetryAfter(addr string, retryAfter time.Time) {
 	if err != nil {
 		return
 	}
-	metadata, _ := ps.Get(info.ID, addressDataKey)
-	if metadata != nil {
-		ad, ok := metadata.(addressData)
-		if !ok {
-			return
-		}
-		ad.retryAfter = retryAfter
-		_ = ps.Put(info.ID, addressDataKey, ad)
-	}
-
+	ps.pb.UpdateRetryAfter(string(info.ID), retryAfter)
 }
 
 // GetConnectionWaitTime will calculate and return the wait
@@ -122,91 +105,12 @@ func (ps *PeerStore) UpdateRetryAfter(addr string, retryAfter time.Time) {
 // It will register a provisional next connection time when the waitTime is 0.
 // The provisional time should be updated after the connection with UpdateConnectionTime
 func (ps *PeerStore) GetConnectionWaitTime(addrOrPeerID string) (bool, time.Duration, time.Time) {
-	curTime := time.Now()
-
-	ps.lock.Lock()
-	defer ps.lock.Unlock()
-
-	peerID := peer.ID(addrOrPeerID)
-	metadata, err := ps.Get(peerID, addressDataKey)
-	if err != nil {
-		return false, 0 /* not used */, curTime /* not used */
-	}
-	ad, ok := metadata.(addressData)
-	if !ok {
-		return false, 0 /* not used */, curTime /* not used */
-	}
-
-	// Remove from recentConnectionTimes the times later than ConnectionsRateLimitingWindowSeconds
-	numElmtsToRemove := 0
-	timeSince := time.Duration(0)
-	for numElmtsToRemove < len(ad.recentConnectionTimes) {
-		timeSince = curTime.Sub(ad.recentConnectionTimes[numElmtsToRemove])
-		if timeSince >= ps.connectionsRateLimitingWindow {
-			numElmtsToRemove++
-		} else {
-			break // break the loop. The rest are earlier than 1 second
-		}
-	}
-
-	// Remove the expired elements from e.data[addr].recentConnectionTimes
-	ps.popNElements(numElmtsToRemove, peerID)
-
-	// If there are max number of connections within the time window, wait
-	metadata, _ = ps.Get(peerID, addressDataKey)
-	ad, ok = metadata.(addressData)
-	if !ok {
-		return false, 0 /* not used */, curTime /* not used */
-	}
-	numElts := len(ad.recentConnectionTimes)
-	if uint(numElts) >= ps.connectionsRateLimitingCount {
-		return true, /* true */
-			ps.connectionsRateLimitingWindow - timeSince, curTime /* not used */
-	}
-	// Else, there is space in connectionsRateLimitingCount. The
-	// connection request of the caller will proceed
-	// Update curTime, since it may have significantly changed if waited
-	provisionalTime := time.Now()
-	// Append the provisional time for the next connection request
-	ps.appendTime(peerID, provisionalTime)
-	return true, 0 /* no wait. proceed */, provisionalTime
+	return ps.pb.GetConnectionWaitTime(addrOrPeerID)
 }
 
 // UpdateConnectionTime updates the connection time for the given address.
 func (ps *PeerStore) UpdateConnectionTime(addrOrPeerID string, provisionalTime time.Time) bool {
-	ps.lock.Lock()
-	defer ps.lock.Unlock()
-
-	peerID := peer.ID(addrOrPeerID)
-	metadata, err := ps.Get(peerID, addressDataKey)
-	if err != nil {
-		return false
-	}
-	ad, ok := metadata.(addressData)
-	if !ok {
-		return false
-	}
-	defer func() {
-		_ = ps.Put(peerID, addressDataKey, ad)
-
-	}()
-
-	// Find the provisionalTime and update it
-	entry := ad.recentConnectionTimes
-	for indx, val := range entry {
-		if provisionalTime == val {
-			entry[indx] = time.Now()
-			return true
-		}
-	}
-
-	// Case where the time is not found: it was removed from the list.
-	// This may happen when the time expires before the connection was established with the server.
-	// The time should be added again.
-	entry = append(entry, time.Now())
-	ad.recentConnectionTimes = entry
-
-	return true
+	return ps.pb.UpdateConnectionTime(addrOrPeerID, provisionalTime)
 }
 
 // ReplacePeerList replaces the peer list for the given networkName and role.
@@ -217,52 +121,21 @@ func (ps *PeerStore) ReplacePeerList(addressesThey []*peer.AddrInfo, networkName
 	ps.lock.Lock()
 	defer ps.lock.Unlock()
 
-	// prepare a map of items we'd like to remove.
-	removeItems := make(map[peer.ID]bool, 0)
-	peerIDs := ps.Peers()
-	for _, pid := range peerIDs {
-		data, _ := ps.Get(pid, addressDataKey)
-		if data != nil {
-			ad := data.(addressData)
-			updated := false
-			if ad.networkNames[networkName] && !ad.roles.IsPersistent(role) {
-				if ad.roles.Is(role) {
-					removeItems[pid] = true
-				} else if ad.roles.Has(role) {
-					ad.roles.Remove(role)
-					updated = true
-				}
-			}
-			if updated {
-				_ = ps.Put(pid, addressDataKey, ad)
-			}
-		}
-
+	before := ps.phonebookPeers()
+	ids := make([]string, len(addressesThey))
+	for i, info := range addressesThey {
+		ids[i] = string(info.ID)
+		ps.AddAddrs(info.ID, info.Addrs, libp2p.AddressTTL)
 	}
-	for _, info := range addressesThey {
-		data, _ := ps.Get(info.ID, addressDataKey)
-		if data != nil {
-			// we already have this
-			// update the networkName and role
-			ad := data.(addressData)
-			ad.networkNames[networkName] = true
-			ad.roles.Add(role)
-			_ = ps.Put(info.ID, addressDataKey, ad)
+	ps.pb.ReplacePeerList(ids, networkName, role)
 
-			// do not remove this entry
-			delete(removeItems, info.ID)
-		} else {
-			// we don't have this item. add it.
-			ps.AddAddrs(info.ID, info.Addrs, libp2p.AddressTTL)
-			entry := makePhonebookEntryData(networkName, role, false)
-			_ = ps.Put(info.ID, addressDataKey, entry)
+	// forget the addresses of the peers removed from the phonebook
+	after := ps.phonebookPeers()
+	for peerID := range before {
+		if !after[peerID] {
+			ps.ClearAddrs(peerID)
 		}
 	}
-
-	// remove items that were missing in addressesThey
-	for k := range removeItems {
-		ps.deletePhonebookEntry(k, networkName)
-	}
 }
 
 // AddPersistentPeers stores addresses of peers which are persistent.
@@ -272,112 +145,47 @@ func (ps *PeerStore) AddPersistentPeers(addrInfo []*peer.AddrInfo, networkName s
 	ps.lock.Lock()
 	defer ps.lock.Unlock()
 
-	for _, info := range addrInfo {
-		data, _ := ps.Get(info.ID, addressDataKey)
-		if data != nil {
-			// we already have this.
-			// Make sure the persistence field is set to true and overwrite the role
-			ad := data.(addressData)
-			ad.roles.AddPersistent(role)
-			_ = ps.Put(info.ID, addressDataKey, ad)
-		} else {
-			// we don't have this item. add it.
-			ps.AddAddrs(info.ID, info.Addrs, libp2p.PermanentAddrTTL)
-			entry := makePhonebookEntryData(networkName, role, true)
-			_ = ps.Put(info.ID, addressDataKey, entry)
-		}
+	ids := make([]string, len(addrInfo))
+	for i, info := range addrInfo {
+		ids[i] = string(info.ID)
+		ps.AddAddrs(info.ID, info.Addrs, libp2p.PermanentAddrTTL)
 	}
+	ps.pb.AddPersistentPeers(ids, networkName, role)
 }
 
-// Length returns the number of addrs in peerstore
-func (ps *PeerStore) Length() int {
-	return len(ps.Peers())
-}
-
-// makePhonebookEntryData creates a new address entry for provided network name and role.
-func makePhonebookEntryData(networkName string, role phonebook.Role, persistent bool) addressData {
-	pbData := addressData{
-		networkNames:          make(map[string]bool),
-		recentConnectionTimes: make([]time.Time, 0),
-		roles:                 phonebook.MakeRoleSet(role, persistent),
+// SetBackoffPolicy implements phonebook.ConnectionBackoff.
+func (ps *PeerStore) SetBackoffPolicy(p phonebook.BackoffPolicy) {
+	if backoff, ok := ps.pb.(phonebook.ConnectionBackoff); ok {
+		backoff.SetBackoffPolicy(p)
 	}
-	pbData.networkNames[networkName] = true
-	return pbData
 }
 
-func (ps *PeerStore) deletePhonebookEntry(peerID peer.ID, networkName string) {
-	data, err := ps.Get(peerID, addressDataKey)
-	if err != nil {
-		return
-	}
-	ad := data.(addressData)
-	delete(ad.networkNames, networkName)
-	isEmpty := len(ad.networkNames) == 0
-	if isEmpty {
-		ps.ClearAddrs(peerID)
-		_ = ps.Put(peerID, addressDataKey, nil)
+// MarkFailed implements phonebook.ConnectionBackoff.
+func (ps *PeerStore) MarkFailed(peerID string, t time.Time) {
+	if backoff, ok := ps.pb.(phonebook.ConnectionBackoff); ok {
+		backoff.MarkFailed(peerID, t)
 	}
 }
 
-// appendTime adds the current time to recentConnectionTimes in
-// addressData of addr
-func (ps *PeerStore) appendTime(peerID peer.ID, t time.Time) {
-	data, _ := ps.Get(peerID, addressDataKey)
-	ad := data.(addressData)
-	ad.recentConnectionTimes = append(ad.recentConnectionTimes, t)
-	_ = ps.Put(peerID, addressDataKey, ad)
-}
-
-// popNElements removes the earliest time from recentConnectionTimes in
-// addressData for addr
-// It is expected to be later than ConnectionsRateLimitingWindow
-func (ps *PeerStore) popNElements(n int, peerID peer.ID) {
-	data, _ := ps.Get(peerID, addressDataKey)
-	ad := data.(addressData)
-	ad.recentConnectionTimes = ad.recentConnectionTimes[n:]
-	_ = ps.Put(peerID, addressDataKey, ad)
-}
-
-func (ps *PeerStore) filterRetryTime(t time.Time, role phonebook.Role) []*peer.AddrInfo {
-	o := make([]*peer.AddrInfo, 0, len(ps.Peers()))
-	for _, peerID := range ps.Peers() {
-		data, _ := ps.Get(peerID, addressDataKey)
-		if data != nil {
-			ad := data.(addressData)
-			if t.After(ad.retryAfter) && ad.roles.Has(role) {
-				mas := ps.Addrs(peerID)
-				info := peer.AddrInfo{ID: peerID, Addrs: mas}
-				o = append(o, &info)
-			}
-		}
+// MarkConnected records that a connection to the peer was established at t,
+// which resets its backoff.
+func (ps *PeerStore) MarkConnected(peerID string, t time.Time) {
+	if cache, ok := ps.pb.(phonebook.PeerCache); ok {
+		cache.MarkConnected(peerID, t)
 	}
-	return o
 }
 
-func shuffleSelect(set []*peer.AddrInfo, n int) []*peer.AddrInfo {
-	if n >= len(set) || n == getAllAddresses {
-		// return shuffled copy of everything
-		out := slices.Clone(set)
-		shuffleAddrInfos(out)
-		return out
-	}
-	// Pick random indexes from the set
-	indexSample := make([]int, n)
-	for i := range indexSample {
-		indexSample[i] = rand.Intn(len(set)-i) + i
-		for oi, ois := range indexSample[:i] {
-			if ois == indexSample[i] {
-				indexSample[i] = oi
-			}
-		}
-	}
-	out := make([]*peer.AddrInfo, n)
-	for i, index := range indexSample {
-		out[i] = set[index]
-	}
-	return out
+// Length returns the number of addrs in peerstore
+func (ps *PeerStore) Length() int {
+	return len(ps.Peers())
 }
 
-func shuffleAddrInfos(set []*peer.AddrInfo) {
-	rand.Shuffle(len(set), func(i, j int) { set[i], set[j] = set[j], set[i] })
+// phonebookPeers returns the IDs of the peers in the phonebook.
+func (ps *PeerStore) phonebookPeers() map[peer.ID]bool {
+	entries := ps.pb.Entries()
+	peers := make(map[peer.ID]bool, len(entries))
+	for _, e := range entries {
+		peers[peer.ID(e.Address)] = true
+	}
+	return peers
 }
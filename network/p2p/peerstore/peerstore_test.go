@@ -153,12 +153,7 @@ func TestArrayPhonebookAll(t *testing.T) {
 
 	ph, err := MakePhonebook(1, 1*time.Millisecond)
 	require.NoError(t, err)
-	for _, addr := range set {
-		entry := makePhonebookEntryData("", phonebook.RelayRole, false)
-		info, _ := peerInfoFromDomainPort(addr)
-		ph.AddAddrs(info.ID, info.Addrs, libp2p.AddressTTL)
-		ph.Put(info.ID, addressDataKey, entry)
-	}
+	ph.ReplacePeerList(infoSet, "", phonebook.RelayRole)
 	testPhonebookAll(t, infoSet, ph)
 }
 
@@ -175,12 +170,7 @@ func TestArrayPhonebookUniform1(t *testing.T) {
 
 	ph, err := MakePhonebook(1, 1*time.Millisecond)
 	require.NoError(t, err)
-	for _, addr := range set {
-		entry := makePhonebookEntryData("", phonebook.RelayRole, false)
-		info, _ := peerInfoFromDomainPort(addr)
-		ph.AddAddrs(info.ID, info.Addrs, libp2p.AddressTTL)
-		ph.Put(info.ID, addressDataKey, entry)
-	}
+	ph.ReplacePeerList(infoSet, "", phonebook.RelayRole)
 	testPhonebookUniform(t, infoSet, ph, 1)
 }
 
@@ -197,12 +187,7 @@ func TestArrayPhonebookUniform3(t *testing.T) {
 
 	ph, err := MakePhonebook(1, 1*time.Millisecond)
 	require.NoError(t, err)
-	for _, addr := range set {
-		entry := makePhonebookEntryData("", phonebook.RelayRole, false)
-		info, _ := peerInfoFromDomainPort(addr)
-		ph.AddAddrs(info.ID, info.Addrs, libp2p.AddressTTL)
-		ph.Put(info.ID, addressDataKey, entry)
-	}
+	ph.ReplacePeerList(infoSet, "", phonebook.RelayRole)
 	testPhonebookUniform(t, infoSet, ph, 3)
 }
 
@@ -313,6 +298,17 @@ func TestMultiPhonebookDuplicateFiltering(t *testing.T) {
 	testPhonebookUniform(t, infoSet, ph, 3)
 }
 
+// recentConnectionTimes returns the connection times logged by the phonebook of ps for the peer id.
+func recentConnectionTimes(t *testing.T, ps *PeerStore, id peer.ID) []time.Time {
+	for _, e := range ps.pb.Entries() {
+		if e.Address == string(id) {
+			return e.RecentConnectionTimes
+		}
+	}
+	require.FailNow(t, "peer not in the phonebook", "peer %s", id)
+	return nil
+}
+
 func TestWaitAndAddConnectionTimeLongtWindow(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -321,7 +317,8 @@ func TestWaitAndAddConnectionTimeLongtWindow(t *testing.T) {
 	// The test will artificially simulate time passing
 	timeUnit := 2000 * time.Second
 	connectionsRateLimitingWindow := 2 * timeUnit
-	entries, err := MakePhonebook(3, connectionsRateLimitingWindow)
+	now := time.Now()
+	entries, err := NewPeerStoreWithPhonebook(nil, "", phonebook.MakePhonebookWithClock(3, connectionsRateLimitingWindow, func() time.Time { return now }))
 	require.NoError(t, err)
 	addr1 := "addrABC:4040"
 	addr2 := "addrXYZ:4041"
@@ -340,39 +337,28 @@ func TestWaitAndAddConnectionTimeLongtWindow(t *testing.T) {
 	require.Equal(t, true, addrInPhonebook)
 	require.Equal(t, time.Duration(0), waitTime)
 	require.Equal(t, true, entries.UpdateConnectionTime(string(info1.ID), provisionalTime))
-	data, _ := entries.Get(info1.ID, addressDataKey)
-	require.NotNil(t, data)
-	ad := data.(addressData)
-	phBookData := ad.recentConnectionTimes
+	phBookData := recentConnectionTimes(t, entries, info1.ID)
 	require.Equal(t, 1, len(phBookData))
 
 	// simulate passing a unit of time
-	for rct := range phBookData {
-		phBookData[rct] = phBookData[rct].Add(-1 * timeUnit)
-	}
+	now = now.Add(timeUnit)
 
 	// add another value to addr
 	_, waitTime, provisionalTime = entries.GetConnectionWaitTime(string(info1.ID))
 	require.Equal(t, time.Duration(0), waitTime)
 	require.Equal(t, true, entries.UpdateConnectionTime(string(info1.ID), provisionalTime))
-	data, _ = entries.Get(info1.ID, addressDataKey)
-	ad = data.(addressData)
-	phBookData = ad.recentConnectionTimes
+	phBookData = recentConnectionTimes(t, entries, info1.ID)
 	require.Equal(t, 2, len(phBookData))
 
 	// simulate passing a unit of time
-	for rct := range phBookData {
-		phBookData[rct] = phBookData[rct].Add(-1 * timeUnit)
-	}
+	now = now.Add(timeUnit)
 
 	// the first time should be removed and a new one added
 	// there should not be any wait
 	_, waitTime, provisionalTime = entries.GetConnectionWaitTime(string(info1.ID))
 	require.Equal(t, time.Duration(0), waitTime)
 	require.Equal(t, true, entries.UpdateConnectionTime(string(info1.ID), provisionalTime))
-	data, _ = entries.Get(info1.ID, addressDataKey)
-	ad = data.(addressData)
-	phBookData2 := ad.recentConnectionTimes
+	phBookData2 := recentConnectionTimes(t, entries, info1.ID)
 	require.Equal(t, 2, len(phBookData2))
 
 	// make sure the right time was removed
@@ -390,12 +376,7 @@ func TestWaitAndAddConnectionTimeLongtWindow(t *testing.T) {
 
 	// introduce a gap between the two requests so that only the first will be removed later when waited
 	// simulate passing a unit of time
-	data2, _ := entries.Get(info2.ID, addressDataKey)
-	require.NotNil(t, data2)
-	ad2 := data2.(addressData)
-	for rct := range ad2.recentConnectionTimes {
-		ad2.recentConnectionTimes[rct] = ad2.recentConnectionTimes[rct].Add(-1 * timeUnit)
-	}
+	now = now.Add(timeUnit)
 
 	// value 2
 	_, waitTime, provisionalTime = entries.GetConnectionWaitTime(string(info2.ID))
@@ -406,9 +387,7 @@ func TestWaitAndAddConnectionTimeLongtWindow(t *testing.T) {
 	require.Equal(t, time.Duration(0), waitTime)
 	require.Equal(t, true, entries.UpdateConnectionTime(string(info2.ID), provisionalTime))
 
-	data2, _ = entries.Get(info2.ID, addressDataKey)
-	ad2 = data2.(addressData)
-	phBookData = ad2.recentConnectionTimes
+	phBookData = recentConnectionTimes(t, entries, info2.ID)
 	// all three times should be queued
 	require.Equal(t, 3, len(phBookData))
 
@@ -416,25 +395,19 @@ func TestWaitAndAddConnectionTimeLongtWindow(t *testing.T) {
 	_, waitTime, _ = entries.GetConnectionWaitTime(string(info2.ID))
 	require.Greater(t, int64(waitTime), int64(0))
 	// no element should be removed
-	data2, _ = entries.Get(info2.ID, addressDataKey)
-	ad2 = data2.(addressData)
-	phBookData2 = ad2.recentConnectionTimes
+	phBookData2 = recentConnectionTimes(t, entries, info2.ID)
 	require.Equal(t, phBookData[0], phBookData2[0])
 	require.Equal(t, phBookData[1], phBookData2[1])
 	require.Equal(t, phBookData[2], phBookData2[2])
 	// simulate passing of the waitTime duration
-	for rct := range ad2.recentConnectionTimes {
-		ad2.recentConnectionTimes[rct] = ad2.recentConnectionTimes[rct].Add(-1 * waitTime)
-	}
+	now = now.Add(waitTime)
 
 	// The wait should be sufficient
 	_, waitTime, provisionalTime = entries.GetConnectionWaitTime(string(info2.ID))
 	require.Equal(t, time.Duration(0), waitTime)
 	require.Equal(t, true, entries.UpdateConnectionTime(string(info2.ID), provisionalTime))
 	// only one element should be removed, and one added
-	data2, _ = entries.Get(info2.ID, addressDataKey)
-	ad2 = data2.(addressData)
-	phBookData2 = ad2.recentConnectionTimes
+	phBookData2 = recentConnectionTimes(t, entries, info2.ID)
 	require.Equal(t, 3, len(phBookData2))
 
 	// make sure the right time was removed
@@ -442,6 +415,64 @@ func TestWaitAndAddConnectionTimeLongtWindow(t *testing.T) {
 	require.Equal(t, phBookData[2], phBookData2[1])
 }
 
+// TestPeerStoreConnectionWaitTime checks that the connection rate limiting of
+// the peers is delegated to the phonebook, keyed by peer ID.
+func TestPeerStoreConnectionWaitTime(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	entries, err := MakePhonebook(2, time.Hour)
+	require.NoError(t, err)
+	info1, _ := peerInfoFromDomainPort("addrABC:4040")
+	info2, _ := peerInfoFromDomainPort("addrXYZ:4041")
+
+	// Address not in. Should return false
+	addrInPhonebook, _, provisionalTime := entries.GetConnectionWaitTime(string(info1.ID))
+	require.False(t, addrInPhonebook)
+	require.False(t, entries.UpdateConnectionTime(string(info1.ID), provisionalTime))
+
+	entries.ReplacePeerList([]*peer.AddrInfo{info1, info2}, "default", phonebook.RelayRole)
+	for i := 0; i < 2; i++ {
+		addrInPhonebook, waitTime, provisionalTime := entries.GetConnectionWaitTime(string(info1.ID))
+		require.True(t, addrInPhonebook)
+		require.Zero(t, waitTime)
+		require.True(t, entries.UpdateConnectionTime(string(info1.ID), provisionalTime))
+	}
+	_, waitTime, _ := entries.GetConnectionWaitTime(string(info1.ID))
+	require.Greater(t, waitTime, time.Duration(0))
+
+	// a separate log is used for every peer
+	_, waitTime, _ = entries.GetConnectionWaitTime(string(info2.ID))
+	require.Zero(t, waitTime)
+
+	for _, e := range entries.pb.Entries() {
+		if e.Address == string(info1.ID) {
+			require.Len(t, e.RecentConnectionTimes, 2)
+		}
+	}
+}
+
+// TestPeerStoreBackoff checks that failing peers are backed off, and that the
+// addresses of the peers removed from the phonebook are forgotten.
+func TestPeerStoreBackoff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ph, err := MakePhonebook(1, time.Millisecond)
+	require.NoError(t, err)
+	ph.SetBackoffPolicy(phonebook.BackoffPolicy{Base: time.Hour, Max: time.Hour})
+	info1, _ := peerInfoFromDomainPort("a:4040")
+	info2, _ := peerInfoFromDomainPort("b:4041")
+	ph.ReplacePeerList([]*peer.AddrInfo{info1, info2}, "default", phonebook.RelayRole)
+
+	ph.MarkFailed(string(info1.ID), time.Now())
+	require.Equal(t, []*peer.AddrInfo{info2}, ph.GetAddresses(10, phonebook.RelayRole))
+	ph.MarkConnected(string(info1.ID), time.Now())
+	require.Len(t, ph.GetAddresses(10, phonebook.RelayRole), 2)
+
+	ph.ReplacePeerList([]*peer.AddrInfo{info2}, "default", phonebook.RelayRole)
+	require.Empty(t, ph.Addrs(info1.ID))
+	require.Equal(t, []*peer.AddrInfo{info2}, ph.GetAddresses(10, phonebook.RelayRole))
+}
+
 // TestPhonebookRoles tests that the filtering by roles for different
 // phonebooks entries works as expected.
 func TestPhonebookRoles(t *testing.T) {
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/transport"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+
+	tools_network "github.com/algorand/go-algorand/tools/network"
+)
+
+var errProxyTransportListen = errors.New("p2p proxy transport can't accept incoming connections")
+
+// proxyTransport is a libp2p TCP transport which makes all its connections through an outgoing proxy.
+// It only supports dialing, so a host using it does not listen.
+type proxyTransport struct {
+	upgrader transport.Upgrader
+	rcmgr    network.ResourceManager
+	dialer   tools_network.ContextDialer
+}
+
+// makeProxyTransportConstructor returns a libp2p transport constructor for a proxyTransport using dialer.
+func makeProxyTransportConstructor(dialer tools_network.ContextDialer) func(upgrader transport.Upgrader, rcmgr network.ResourceManager) (*proxyTransport, error) {
+	return func(upgrader transport.Upgrader, rcmgr network.ResourceManager) (*proxyTransport, error) {
+		if rcmgr == nil {
+			rcmgr = &network.NullResourceManager{}
+		}
+		return &proxyTransport{upgrader: upgrader, rcmgr: rcmgr, dialer: dialer}, nil
+	}
+}
+
+// Dial dials the peer at raddr through the proxy and upgrades the connection.
+func (t *proxyTransport) Dial(ctx context.Context, raddr multiaddr.Multiaddr, p peer.ID) (transport.CapableConn, error) {
+	address, err := proxyDialAddress(raddr)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := t.rcmgr.OpenConnection(network.DirOutbound, true, raddr)
+	if err != nil {
+		return nil, err
+	}
+	c, err := t.dialWithScope(ctx, address, raddr, p, scope)
+	if err != nil {
+		scope.Done()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (t *proxyTransport) dialWithScope(ctx context.Context, address string, raddr multiaddr.Multiaddr, p peer.ID, scope network.ConnManagementScope) (transport.CapableConn, error) {
+	if err := scope.SetPeer(p); err != nil {
+		return nil, err
+	}
+	conn, err := t.dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	laddr, err := manet.FromNetAddr(conn.LocalAddr())
+	if err != nil {
+		laddr = multiaddr.StringCast("/ip4/0.0.0.0/tcp/0")
+	}
+	return t.upgrader.Upgrade(ctx, t, &proxiedConn{Conn: conn, laddr: laddr, raddr: raddr}, network.DirOutbound, p, scope)
+}
+
+// CanDial returns true for the TCP addresses which can be handed to the proxy.
+func (t *proxyTransport) CanDial(addr multiaddr.Multiaddr) bool {
+	_, err := proxyDialAddress(addr)
+	return err == nil
+}
+
+// Listen always fails, as the proxy only carries outgoing connections.
+func (t *proxyTransport) Listen(laddr multiaddr.Multiaddr) (transport.Listener, error) {
+	return nil, errProxyTransportListen
+}
+
+// Protocols returns the multiaddr protocols handled by the transport.
+func (t *proxyTransport) Protocols() []int {
+	return []int{multiaddr.P_TCP}
+}
+
+// Proxy returns false, since the connections still reach the dialed peer directly at the libp2p level.
+func (t *proxyTransport) Proxy() bool {
+	return false
+}
+
+func (t *proxyTransport) String() string {
+	return "TCP over proxy"
+}
+
+// proxyDialAddress converts a /ip4, /ip6 or /dns multiaddr followed by /tcp into a host:port address.
+// DNS names are kept as they are, so that the proxy resolves them.
+func proxyDialAddress(addr multiaddr.Multiaddr) (string, error) {
+	hostComponent, rest := multiaddr.SplitFirst(addr)
+	if hostComponent == nil || rest == nil {
+		return "", fmt.Errorf("unsupported proxy dial address %s", addr)
+	}
+	switch hostComponent.Protocol().Code {
+	case multiaddr.P_IP4, multiaddr.P_IP6, multiaddr.P_DNS, multiaddr.P_DNS4, multiaddr.P_DNS6:
+	default:
+		return "", fmt.Errorf("unsupported proxy dial address %s", addr)
+	}
+	portComponent, rest := multiaddr.SplitFirst(rest)
+	if portComponent == nil || portComponent.Protocol().Code != multiaddr.P_TCP || rest != nil {
+		return "", fmt.Errorf("unsupported proxy dial address %s", addr)
+	}
+	return net.JoinHostPort(hostComponent.Value(), portComponent.Value()), nil
+}
+
+// proxiedConn is a manet.Conn for a connection made through a proxy, which reports the
+// dialed multiaddr as its remote address instead of the one of the proxy.
+type proxiedConn struct {
+	net.Conn
+	laddr multiaddr.Multiaddr
+	raddr multiaddr.Multiaddr
+}
+
+func (c *proxiedConn) LocalMultiaddr() multiaddr.Multiaddr {
+	return c.laddr
+}
+
+func (c *proxiedConn) RemoteMultiaddr() multiaddr.Multiaddr {
+	return c.raddr
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/network/p2p/peerstore"
 	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/protocol"
+	tools_network "github.com/algorand/go-algorand/tools/network"
 	"github.com/algorand/go-deadlock"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -221,11 +222,13 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 	for malAddr, malErr := range malformedAddrs {
 		log.Infof("Ignoring malformed phonebook address %s: %s", malAddr, malErr)
 	}
-	pstore, err := peerstore.NewPeerStore(addrInfo, string(networkID))
+	pstore, err := peerstore.NewPeerStoreWithPhonebook(addrInfo, string(networkID), makePhonebook(cfg))
 	if err != nil {
 		return nil, err
 	}
 
+	setDropTelemetrySampling(cfg.GossipDropTelemetrySampling)
+
 	relayMessages := cfg.IsGossipServer() || cfg.ForceRelayMessages
 	net := &P2PNetwork{
 		log:           log,
@@ -254,12 +257,13 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		readBuffer: make(chan IncomingMessage, readBufferLen),
 	}
 	net.broadcaster = msgBroadcaster{
-		ctx:                    net.ctx,
-		log:                    log,
-		config:                 cfg,
-		broadcastQueueHighPrio: make(chan broadcastRequest, outgoingMessagesBufferSize),
-		broadcastQueueBulk:     make(chan broadcastRequest, 100),
-		enableVoteCompression:  cfg.EnableVoteCompression,
+		ctx:                      net.ctx,
+		log:                      log,
+		config:                   cfg,
+		broadcastQueueHighPrio:   make(chan broadcastRequest, outgoingMessagesBufferSize),
+		broadcastQueueBulk:       make(chan broadcastRequest, 100),
+		enableVoteCompression:    cfg.EnableVoteCompression,
+		enableMessageCompression: cfg.EnableMessageCompression,
 	}
 
 	if identityOpts != nil {
@@ -315,11 +319,19 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		addrInfo := pstore.PeerInfo(peerID)
 		addrInfos = append(addrInfos, &addrInfo)
 	}
+	var resolveController dnsaddr.ResolveController = dnsaddr.NewMultiaddrDNSResolveController(cfg.DNSSecurityTXTEnforced(), "")
+	if cfg.OutgoingProxy != "" {
+		proxyDialer, err0 := tools_network.MakeProxyDialer(cfg.OutgoingProxy, nil)
+		if err0 != nil {
+			return nil, err0
+		}
+		resolveController = dnsaddr.NewProxiedMultiaddrDNSResolveController(proxyDialer, cfg.FallbackDNSResolverAddress)
+	}
 	bootstrapper := &bootstrapper{
 		cfg:               cfg,
 		networkID:         networkID,
 		phonebookPeers:    addrInfos,
-		resolveController: dnsaddr.NewMultiaddrDNSResolveController(cfg.DNSSecurityTXTEnforced(), ""),
+		resolveController: resolveController,
 		log:               net.log,
 	}
 	net.bootstrapperStart = bootstrapper.start
@@ -456,49 +468,56 @@ func (n *P2PNetwork) innerStop() {
 	closeGroup.Wait()
 }
 
-// meshThreadInner fetches nodes from DHT and attempts to connect to them
+// dhtNetworkName is the phonebook network name of the peers discovered through the DHT.
+const dhtNetworkName = "dht"
+
+// dhtCapabilities are the capabilities looked up in the DHT to find peers.
+var dhtCapabilities = []p2p.Capability{p2p.Gossip, p2p.Archival}
+
+// meshThreadInner fetches nodes from DNS and DHT and attempts to connect to them.
+// It returns the number of relays found.
 func (n *P2PNetwork) meshThreadInner() int {
 	defer n.service.DialPeersUntilTargetCount(n.config.GossipFanout)
 
 	// fetch peers from DNS
-	var dnsPeers, dhtPeers []peer.AddrInfo
-	dnsPeers = dnsLookupBootstrapPeers(n.log, n.config, n.networkID, dnsaddr.NewMultiaddrDNSResolveController(n.config.DNSSecurityTXTEnforced(), ""))
+	dnsPeers := dnsLookupBootstrapPeers(n.log, n.config, n.networkID, dnsaddr.NewMultiaddrDNSResolveController(n.config.DNSSecurityTXTEnforced(), ""))
+	dnsPeers = mergeP2PAddrInfoResolvedAddresses(dnsPeers, nil)
+	if len(dnsPeers) > 0 {
+		n.pstore.ReplacePeerList(addrInfoPointers(dnsPeers), string(n.networkID), phonebook.RelayRole)
+	}
+	numRelays := len(dnsPeers)
 
-	// discover peers from DHT
+	// discover peers from DHT, and infer their roles from the capabilities they advertise
 	if n.capabilitiesDiscovery != nil {
-		var err error
-		dhtPeers, err = n.capabilitiesDiscovery.PeersForCapability(p2p.Gossip, n.config.GossipFanout)
-		if err != nil {
-			n.log.Warnf("Error getting relay nodes from capabilities discovery: %v", err)
-		}
-		n.log.Debugf("Discovered %d gossip peers from DHT", len(dhtPeers))
-
-		// also discover archival nodes
-		var dhtArchivalPeers []peer.AddrInfo
-		dhtArchivalPeers, err = n.capabilitiesDiscovery.PeersForCapability(p2p.Archival, numArchivalPeersToFind)
-		if err != nil {
-			n.log.Warnf("Error getting archival nodes from capabilities discovery: %v", err)
-		}
-		n.log.Debugf("Discovered %d archival peers from DHT", len(dhtArchivalPeers))
-
-		if len(dhtArchivalPeers) > 0 {
-			replace := make([]*peer.AddrInfo, len(dhtArchivalPeers))
-			for i := range dhtArchivalPeers {
-				replace[i] = &dhtArchivalPeers[i]
+		for _, capability := range dhtCapabilities {
+			count := n.config.GossipFanout
+			if capability == p2p.Archival {
+				count = numArchivalPeersToFind
+			}
+			dhtPeers, err := n.capabilitiesDiscovery.PeersForCapability(capability, count)
+			if err != nil {
+				n.log.Warnf("Error getting %s nodes from capabilities discovery: %v", capability, err)
+			}
+			n.log.Debugf("Discovered %d %s peers from DHT", len(dhtPeers), capability)
+			if len(dhtPeers) == 0 {
+				continue
+			}
+			role := capability.Roles()
+			n.pstore.ReplacePeerList(addrInfoPointers(dhtPeers), dhtNetworkName, role)
+			if role&phonebook.RelayRole != 0 {
+				numRelays += len(dhtPeers)
 			}
-			n.pstore.ReplacePeerList(replace, string(n.networkID), phonebook.ArchivalRole)
 		}
 	}
+	return numRelays
+}
 
-	peers := mergeP2PAddrInfoResolvedAddresses(dnsPeers, dhtPeers)
-	replace := make([]*peer.AddrInfo, len(peers))
-	for i := range peers {
-		replace[i] = &peers[i]
-	}
-	if len(peers) > 0 {
-		n.pstore.ReplacePeerList(replace, string(n.networkID), phonebook.RelayRole)
+func addrInfoPointers(infos []peer.AddrInfo) []*peer.AddrInfo {
+	ptrs := make([]*peer.AddrInfo, len(infos))
+	for i := range infos {
+		ptrs[i] = &infos[i]
 	}
-	return len(peers)
+	return ptrs
 }
 
 func (n *P2PNetwork) meshThread() {
@@ -917,15 +936,16 @@ func (n *P2PNetwork) baseWsStreamHandler(ctx context.Context, p2pPeer peer.ID, s
 	}
 	peerCore := makePeerCore(ctx, n, n.log, n.handler.readBuffer, addr, client, addr)
 	wsp := &wsPeer{
-		wsPeerCore:            peerCore,
-		conn:                  &wsPeerConnP2P{stream: stream},
-		outgoing:              !incoming,
-		identity:              netIdentPeerID,
-		peerType:              peerTypeP2P,
-		TelemetryGUID:         pmi.telemetryID,
-		InstanceName:          pmi.instanceName,
-		features:              decodePeerFeatures(pmi.version, pmi.features),
-		enableVoteCompression: n.config.EnableVoteCompression,
+		wsPeerCore:               peerCore,
+		conn:                     &wsPeerConnP2P{stream: stream},
+		outgoing:                 !incoming,
+		identity:                 netIdentPeerID,
+		peerType:                 peerTypeP2P,
+		TelemetryGUID:            pmi.telemetryID,
+		InstanceName:             pmi.instanceName,
+		features:                 decodePeerFeatures(pmi.version, pmi.features),
+		enableVoteCompression:    n.config.EnableVoteCompression,
+		enableMessageCompression: n.config.EnableMessageCompression,
 	}
 
 	localAddr, has := n.Address()
@@ -0,0 +1,264 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/network/addr"
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// peerExchange.go implements a peer exchange (PEX) protocol, which lets nodes learn about
+// relays without depending solely on the DNS bootstrap records.
+//
+// A relay with an identity key (see netidentity.go) periodically broadcasts a PeerExchangeTag
+// message holding a sample of the relays it is connected to, signed by its identity key.
+// A node only accepts the message from a relay it connected to and whose identity it verified
+// during the identity challenge exchange, and only if the message is signed by that identity.
+// The accepted samples are merged into the phonebook under peerExchangeNetworkName, so that
+// they are kept apart from (and never replace) the addresses obtained from DNS, and their
+// total number is capped by the PeerExchangeMaxPeers configuration.
+
+// maxPeerExchangeAddresses is the maximum number of addresses in a peer exchange message.
+const maxPeerExchangeAddresses = 32
+
+// peerExchangeNetworkName is the phonebook network name of the addresses learned through peer exchange.
+const peerExchangeNetworkName = "pex"
+
+// peerExchangeSenderTTL is the number of PeerExchangeIntervals after which the sample of a
+// relay which stopped sending them is dropped.
+const peerExchangeSenderTTL = 3
+
+var networkPeerExchangeSent = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_exchange_sent_total", Description: "Number of peer exchange messages broadcast"})
+var networkPeerExchangeAccepted = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_exchange_accepted_total", Description: "Number of peer exchange messages merged into the phonebook"})
+var networkPeerExchangeRejected = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_exchange_rejected_total", Description: "Number of peer exchange messages dropped because they could not be authenticated"})
+var networkPeerExchangePeers = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_peer_exchange_peers", Description: "Number of relay addresses learned through peer exchange"})
+
+// peerExchangeMessage is a sample of the relays which the holder of Key is connected to.
+// It is encoded using reflection since it is only exchanged between relays and the nodes
+// connected to them.
+//
+//msgp:ignore peerExchangeMessage peerExchangeMessageSigned
+type peerExchangeMessage struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Addresses []string         `codec:"a"`
+	Key       crypto.PublicKey `codec:"pk"`
+}
+
+type peerExchangeMessageSigned struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Msg       peerExchangeMessage `codec:"pxm"`
+	Signature crypto.Signature    `codec:"sig"`
+}
+
+// PeerExchangeMessageSignedMaxSize returns the maximum size of an encoded peerExchangeMessageSigned.
+func PeerExchangeMessageSignedMaxSize() int {
+	// the fixmap headers and keys of both structs, and the array header of Addresses
+	const overhead = (1 + 4 + 4) + (1 + 2 + 3 + 3)
+	// every byte array and string has at most a 3 byte header
+	return overhead + (2 + len(crypto.Signature{})) + (2 + len(crypto.PublicKey{})) +
+		maxPeerExchangeAddresses*(3+maxAddressLen)
+}
+
+func (m peerExchangeMessage) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.NetPeerExchange, protocol.EncodeReflect(m)
+}
+
+func (m peerExchangeMessage) Sign(s identityChallengeSigner) peerExchangeMessageSigned {
+	return peerExchangeMessageSigned{Msg: m, Signature: s.Sign(m)}
+}
+
+// Verify checks that the signature included in the peerExchangeMessageSigned was created by the included Key
+func (m peerExchangeMessageSigned) Verify() bool {
+	return m.Msg.Key.Verify(m.Msg, m.Signature)
+}
+
+// peerExchangeSample is the latest sample received from a single relay.
+type peerExchangeSample struct {
+	addresses []string
+	received  time.Time
+}
+
+// peerExchangeTracker keeps the latest sample of each relay, and merges them
+// into a capped list of addresses.
+type peerExchangeTracker struct {
+	mu       deadlock.Mutex
+	maxPeers int
+	ttl      time.Duration
+	samples  map[crypto.PublicKey]peerExchangeSample
+}
+
+func makePeerExchangeTracker(maxPeers int, ttl time.Duration) *peerExchangeTracker {
+	return &peerExchangeTracker{
+		maxPeers: maxPeers,
+		ttl:      ttl,
+		samples:  make(map[crypto.PublicKey]peerExchangeSample),
+	}
+}
+
+// add records the sample of sender, and returns the merged addresses of all
+// the current samples. The most recently received samples are merged first,
+// until maxPeers addresses are collected.
+func (t *peerExchangeTracker) add(sender crypto.PublicKey, addresses []string, now time.Time) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.samples[sender] = peerExchangeSample{addresses: addresses, received: now}
+
+	senders := make([]crypto.PublicKey, 0, len(t.samples))
+	for key, sample := range t.samples {
+		if now.Sub(sample.received) > t.ttl {
+			delete(t.samples, key)
+			continue
+		}
+		senders = append(senders, key)
+	}
+	slices.SortFunc(senders, func(a, b crypto.PublicKey) int {
+		return t.samples[b].received.Compare(t.samples[a].received)
+	})
+
+	seen := make(map[string]bool)
+	merged := make([]string, 0, t.maxPeers)
+	for _, key := range senders {
+		for _, a := range t.samples[key].addresses {
+			if len(merged) >= t.maxPeers {
+				return merged
+			}
+			if !seen[a] {
+				seen[a] = true
+				merged = append(merged, a)
+			}
+		}
+	}
+	return merged
+}
+
+// peerExchangeSigner returns the identity key of the network, if it has one.
+func (wn *WebsocketNetwork) peerExchangeSigner() identityChallengeSigner {
+	scheme, ok := wn.identityScheme.(*identityChallengePublicKeyScheme)
+	if !ok || len(scheme.dedupNames) == 0 {
+		return nil
+	}
+	return scheme.identityKeys
+}
+
+// peerExchangeSample returns a random sample of the addresses of the relays
+// which this node is connected to.
+func (wn *WebsocketNetwork) peerExchangeSample() []string {
+	var addrs []string
+	wn.peersLock.RLock()
+	for _, peer := range wn.peers {
+		if peer.outgoing {
+			addrs = append(addrs, peer.GetAddress())
+		}
+	}
+	wn.peersLock.RUnlock()
+
+	rand.Shuffle(len(addrs), func(i, j int) { addrs[i], addrs[j] = addrs[j], addrs[i] })
+	if len(addrs) > maxPeerExchangeAddresses {
+		addrs = addrs[:maxPeerExchangeAddresses]
+	}
+	return addrs
+}
+
+// peerExchangeThread periodically broadcasts a signed sample of the relays
+// this node is connected to.
+func (wn *WebsocketNetwork) peerExchangeThread(signer identityChallengeSigner) {
+	defer wn.wg.Done()
+	ticker := time.NewTicker(wn.config.PeerExchangeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-wn.ctx.Done():
+			return
+		}
+
+		addrs := wn.peerExchangeSample()
+		if len(addrs) == 0 {
+			continue
+		}
+		s := signerSnapshot(signer)
+		msg := peerExchangeMessage{Addresses: addrs, Key: s.PublicKey()}.Sign(s)
+		err := wn.Broadcast(context.Background(), protocol.PeerExchangeTag, protocol.EncodeReflect(&msg), false, nil)
+		if err != nil {
+			wn.log.Debugf("could not broadcast peer exchange message: %v", err)
+			continue
+		}
+		networkPeerExchangeSent.Inc(nil)
+	}
+}
+
+// peerExchangeHandler authenticates a peer exchange message and merges its
+// addresses into the phonebook.
+func peerExchangeHandler(message IncomingMessage) OutgoingMessage {
+	wn := message.Net.(*WebsocketNetwork)
+	peer := message.Sender.(*wsPeer)
+	if wn.peerExchange == nil {
+		return OutgoingMessage{}
+	}
+
+	// only trust the relays we connected to, and whose identity we verified
+	if !peer.outgoing || peer.identityVerified.Load() != 1 {
+		networkPeerExchangeRejected.Inc(nil)
+		return OutgoingMessage{}
+	}
+	if len(message.Data) > PeerExchangeMessageSignedMaxSize() {
+		networkPeerExchangeRejected.Inc(nil)
+		return OutgoingMessage{}
+	}
+	var msg peerExchangeMessageSigned
+	err := protocol.DecodeReflect(message.Data, &msg)
+	if err != nil || len(msg.Msg.Addresses) > maxPeerExchangeAddresses ||
+		msg.Msg.Key != peer.identity || !msg.Verify() {
+		networkPeerExchangeRejected.Inc(nil)
+		wn.log.With("remote", peer.GetAddress()).Info("dropped unauthenticated peer exchange message")
+		return OutgoingMessage{}
+	}
+
+	addrs := make([]string, 0, len(msg.Msg.Addresses))
+	for _, a := range msg.Msg.Addresses {
+		if len(a) > maxAddressLen || a == wn.config.PublicAddress {
+			continue
+		}
+		if _, err := addr.ParseHostOrURL(a); err != nil {
+			continue
+		}
+		addrs = append(addrs, a)
+	}
+
+	merged := wn.peerExchange.add(msg.Msg.Key, addrs, time.Now())
+	wn.phonebook.ReplacePeerList(merged, peerExchangeNetworkName, phonebook.RelayRole)
+	networkPeerExchangeAccepted.Inc(nil)
+	networkPeerExchangePeers.Set(uint64(len(merged)))
+	return OutgoingMessage{}
+}
+
+var peerExchangeHandlers = []TaggedMessageHandler{
+	{protocol.PeerExchangeTag, HandlerFunc(peerExchangeHandler)},
+}
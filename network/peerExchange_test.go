@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPeerExchangeMessageMaxSize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	signer := NewIdentityChallengeScheme(NetIdentityDedupNames("a")).identityKeys
+	msg := peerExchangeMessage{Key: signer.PublicKey()}
+	for i := 0; i < maxPeerExchangeAddresses; i++ {
+		msg.Addresses = append(msg.Addresses, strings.Repeat("a", maxAddressLen))
+	}
+	signed := msg.Sign(signer)
+	require.True(t, signed.Verify())
+	require.LessOrEqual(t, len(protocol.EncodeReflect(&signed)), PeerExchangeMessageSignedMaxSize())
+
+	var decoded peerExchangeMessageSigned
+	require.NoError(t, protocol.DecodeReflect(protocol.EncodeReflect(&signed), &decoded))
+	require.Equal(t, signed, decoded)
+	require.True(t, decoded.Verify())
+
+	decoded.Msg.Addresses[0] = "b"
+	require.False(t, decoded.Verify())
+}
+
+func TestPeerExchangeTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var k1, k2, k3 crypto.PublicKey
+	k1[0], k2[0], k3[0] = 1, 2, 3
+
+	now := time.Now()
+	tr := makePeerExchangeTracker(4, time.Minute)
+	require.Equal(t, []string{"a:1", "b:1"}, tr.add(k1, []string{"a:1", "b:1"}, now))
+
+	// newer samples are merged first, and the total is capped
+	merged := tr.add(k2, []string{"c:1", "a:1", "d:1"}, now.Add(time.Second))
+	require.Equal(t, []string{"c:1", "a:1", "d:1", "b:1"}, merged)
+	merged = tr.add(k3, []string{"e:1"}, now.Add(2*time.Second))
+	require.Equal(t, []string{"e:1", "c:1", "a:1", "d:1"}, merged)
+
+	// a new sample of a sender replaces the previous one, and old samples expire
+	merged = tr.add(k2, []string{"f:1"}, now.Add(time.Minute+time.Second/2))
+	require.Equal(t, []string{"f:1", "e:1"}, merged)
+}
+
+func TestPeerExchangeHandler(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.PublicAddress = "self:4160"
+	pb := phonebook.MakePhonebook(1, time.Millisecond)
+	wn := &WebsocketNetwork{
+		log:          logging.TestingLog(t),
+		config:       cfg,
+		phonebook:    pb,
+		peerExchange: makePeerExchangeTracker(10, time.Hour),
+	}
+
+	signer := NewIdentityChallengeScheme(NetIdentityDedupNames("relay")).identityKeys
+	peer := &wsPeer{outgoing: true, identity: signer.PublicKey()}
+	send := func(msg peerExchangeMessageSigned) {
+		peerExchangeHandler(IncomingMessage{Sender: peer, Net: wn, Data: protocol.EncodeReflect(&msg)})
+	}
+	addrs := []string{"r1:4160", "r2:4160", "self:4160", "::bad"}
+	msg := peerExchangeMessage{Addresses: addrs, Key: signer.PublicKey()}.Sign(signer)
+
+	// the identity of the relay was not verified
+	send(msg)
+	require.Zero(t, pb.Length())
+
+	peer.identityVerified.Store(1)
+
+	// signed by another key
+	other := NewIdentityChallengeScheme(NetIdentityDedupNames("other")).identityKeys
+	send(peerExchangeMessage{Addresses: addrs, Key: other.PublicKey()}.Sign(other))
+	require.Zero(t, pb.Length())
+
+	// tampered with
+	bad := msg
+	bad.Msg.Addresses = []string{"evil:4160"}
+	send(bad)
+	require.Zero(t, pb.Length())
+
+	send(msg)
+	require.ElementsMatch(t, []string{"r1:4160", "r2:4160"}, pb.GetAddresses(10, phonebook.RelayRole))
+
+	// the learned relays don't replace the ones from DNS
+	pb.ReplacePeerList([]string{"dns:4160"}, "default", phonebook.RelayRole)
+	send(peerExchangeMessage{Addresses: []string{"r3:4160"}, Key: signer.PublicKey()}.Sign(signer))
+	require.ElementsMatch(t, []string{"dns:4160", "r3:4160"}, pb.GetAddresses(10, phonebook.RelayRole))
+
+	// messages from incoming connections are ignored
+	peer.outgoing = false
+	send(peerExchangeMessage{Addresses: []string{"r4:4160"}, Key: signer.PublicKey()}.Sign(signer))
+	require.ElementsMatch(t, []string{"dns:4160", "r3:4160"}, pb.GetAddresses(10, phonebook.RelayRole))
+}
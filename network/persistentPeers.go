@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// PersistentPeerStatus describes the connectivity of a persistent peer.
+type PersistentPeerStatus struct {
+	Address string
+	// Connected is set if a connection to the peer is established.
+	Connected bool
+	// ConnectedSince is the time the current connection was established, or zero.
+	ConnectedSince time.Time
+	// LastAttempt is the time of the last connection attempt, or zero.
+	LastAttempt time.Time
+	// Failures is the number of consecutive failed connection attempts, or
+	// connections dropped for being unhealthy.
+	Failures int
+	// NextAttempt is the earliest time of the next connection attempt, while
+	// the peer is not connected.
+	NextAttempt time.Time
+}
+
+// PersistentPeersReporter is implemented by the networks which maintain
+// dedicated connections to their persistent peers.
+type PersistentPeersReporter interface {
+	// PersistentPeers returns the connectivity of the persistent peers, or
+	// nil if they are not maintained separately.
+	PersistentPeers() []PersistentPeerStatus
+}
+
+// persistentPeerNet is the part of the network used by a persistentPeerConnector.
+type persistentPeerNet interface {
+	// persistentPeerAddresses lists the addresses of the persistent relays.
+	persistentPeerAddresses() []string
+	// connectPersistentPeer dials addr unless it is already being connected
+	// to, and reports whether a connection to it is established.
+	connectPersistentPeer(addr string) bool
+	// persistentPeerLastPacket returns the time of the last message received
+	// from addr, and false if it is not connected.
+	persistentPeerLastPacket(addr string) (time.Time, bool)
+	// disconnectPersistentPeer drops the connection to addr.
+	disconnectPersistentPeer(addr string)
+}
+
+const persistentPeerCheckInterval = 5 * time.Second
+const persistentPeerBackoffBase = time.Second
+
+// persistentPeerState is the connectivity of a persistent peer, as seen by the
+// persistentPeerConnector.
+type persistentPeerState struct {
+	connectedSince time.Time
+	lastAttempt    time.Time
+	failures       int
+	nextAttempt    time.Time
+	dialing        bool
+}
+
+// persistentPeerConnector keeps the connections to the persistent peers of the
+// phonebook, independently of the connections made by the mesh thread to fill
+// the gossip fanout. A peer which can't be reached is retried with an
+// exponential backoff with jitter, and a connection which hasn't received any
+// message for healthTimeout is dropped and made again.
+type persistentPeerConnector struct {
+	log           logging.Logger
+	net           persistentPeerNet
+	backoffMax    time.Duration
+	healthTimeout time.Duration
+
+	mu    deadlock.Mutex
+	peers map[string]*persistentPeerState
+}
+
+func makePersistentPeerConnector(log logging.Logger, net persistentPeerNet, backoffMax, healthTimeout time.Duration) *persistentPeerConnector {
+	return &persistentPeerConnector{
+		log:           log,
+		net:           net,
+		backoffMax:    max(backoffMax, persistentPeerBackoffBase),
+		healthTimeout: healthTimeout,
+		peers:         make(map[string]*persistentPeerState),
+	}
+}
+
+func (c *persistentPeerConnector) start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go c.loop(ctx, wg)
+}
+
+func (c *persistentPeerConnector) loop(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(persistentPeerCheckInterval)
+	defer ticker.Stop()
+	for {
+		c.check(ctx, wg, time.Now())
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt after the given number of
+// consecutive failures: it doubles from persistentPeerBackoffBase up to
+// backoffMax, of which a random half is applied, so that the nodes which lost
+// a relay at the same time do not all come back at once.
+func (c *persistentPeerConnector) backoff(failures int) time.Duration {
+	d := persistentPeerBackoffBase
+	for i := 1; i < failures && d < c.backoffMax; i++ {
+		d *= 2
+	}
+	d = min(d, c.backoffMax)
+	return d/2 + time.Duration(crypto.RandUint64()%uint64(d/2+1))
+}
+
+// check updates the state of the persistent peers, dials the ones which are due
+// and drops the unhealthy connections.
+func (c *persistentPeerConnector) check(ctx context.Context, wg *sync.WaitGroup, now time.Time) {
+	addrs := c.net.persistentPeerAddresses()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for addr := range c.peers {
+		if !slices.Contains(addrs, addr) {
+			delete(c.peers, addr)
+		}
+	}
+	for _, addr := range addrs {
+		state, ok := c.peers[addr]
+		if !ok {
+			state = &persistentPeerState{}
+			c.peers[addr] = state
+		}
+		if state.dialing {
+			continue
+		}
+
+		if lastPacket, connected := c.net.persistentPeerLastPacket(addr); connected {
+			if state.connectedSince.IsZero() {
+				state.connectedSince = now
+			}
+			if c.healthTimeout > 0 && now.Sub(lastPacket) > c.healthTimeout {
+				c.log.Infof("persistent peer %s did not send anything for %v, reconnecting", addr, now.Sub(lastPacket))
+				c.net.disconnectPersistentPeer(addr)
+				state.connectedSince = time.Time{}
+				state.failures++
+				state.nextAttempt = now.Add(c.backoff(state.failures))
+			}
+			continue
+		}
+
+		state.connectedSince = time.Time{}
+		if now.Before(state.nextAttempt) || ctx.Err() != nil {
+			continue
+		}
+		state.dialing = true
+		state.lastAttempt = now
+		wg.Add(1)
+		go c.dial(wg, addr, state)
+	}
+}
+
+func (c *persistentPeerConnector) dial(wg *sync.WaitGroup, addr string, state *persistentPeerState) {
+	defer wg.Done()
+	connected := c.net.connectPersistentPeer(addr)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	state.dialing = false
+	if connected {
+		state.failures = 0
+		state.connectedSince = now
+		return
+	}
+	state.failures++
+	state.nextAttempt = now.Add(c.backoff(state.failures))
+	c.log.Debugf("persistent peer %s could not be reached %d times, retrying at %v", addr, state.failures, state.nextAttempt)
+}
+
+// status returns the state of the persistent peers, sorted by address.
+func (c *persistentPeerConnector) status() []PersistentPeerStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	statuses := make([]PersistentPeerStatus, 0, len(c.peers))
+	for addr, state := range c.peers {
+		s := PersistentPeerStatus{
+			Address:        addr,
+			Connected:      !state.connectedSince.IsZero(),
+			ConnectedSince: state.connectedSince,
+			LastAttempt:    state.lastAttempt,
+			Failures:       state.failures,
+		}
+		if !s.Connected {
+			s.NextAttempt = state.nextAttempt
+		}
+		statuses = append(statuses, s)
+	}
+	slices.SortFunc(statuses, func(a, b PersistentPeerStatus) int { return strings.Compare(a.Address, b.Address) })
+	return statuses
+}
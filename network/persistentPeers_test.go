@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type testPersistentPeerNet struct {
+	mu           deadlock.Mutex
+	addrs        []string
+	reachable    map[string]bool
+	connected    map[string]time.Time
+	dials        map[string]int
+	disconnected []string
+}
+
+func (n *testPersistentPeerNet) persistentPeerAddresses() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.addrs
+}
+
+func (n *testPersistentPeerNet) connectPersistentPeer(addr string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.dials[addr]++
+	if n.reachable[addr] {
+		n.connected[addr] = time.Now()
+	}
+	return n.reachable[addr]
+}
+
+func (n *testPersistentPeerNet) persistentPeerLastPacket(addr string) (time.Time, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	t, ok := n.connected[addr]
+	return t, ok
+}
+
+func (n *testPersistentPeerNet) disconnectPersistentPeer(addr string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.connected, addr)
+	n.disconnected = append(n.disconnected, addr)
+}
+
+func TestPersistentPeerConnector(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	net := &testPersistentPeerNet{
+		addrs:     []string{"a:4160", "b:4160"},
+		reachable: map[string]bool{"a:4160": true},
+		connected: make(map[string]time.Time),
+		dials:     make(map[string]int),
+	}
+	c := makePersistentPeerConnector(logging.TestingLog(t), net, 8*time.Second, time.Minute)
+	ctx := context.Background()
+	var wg sync.WaitGroup
+
+	now := time.Now()
+	c.check(ctx, &wg, now)
+	wg.Wait()
+	statuses := c.status()
+	require.Len(t, statuses, 2)
+	require.Equal(t, "a:4160", statuses[0].Address)
+	require.True(t, statuses[0].Connected)
+	require.Zero(t, statuses[0].Failures)
+	require.False(t, statuses[1].Connected)
+	require.Equal(t, 1, statuses[1].Failures)
+	require.False(t, statuses[1].NextAttempt.IsZero())
+
+	// the unreachable peer is not dialed again before its backoff expires
+	c.check(ctx, &wg, now)
+	wg.Wait()
+	require.Equal(t, 1, net.dials["a:4160"])
+	require.Equal(t, 1, net.dials["b:4160"])
+
+	c.check(ctx, &wg, c.status()[1].NextAttempt)
+	wg.Wait()
+	require.Equal(t, 2, net.dials["b:4160"])
+	require.Equal(t, 2, c.status()[1].Failures)
+
+	// a silent connection is dropped
+	c.check(ctx, &wg, time.Now().Add(2*time.Minute))
+	wg.Wait()
+	require.Equal(t, []string{"a:4160"}, net.disconnected)
+	require.False(t, c.status()[0].Connected)
+
+	// the peers removed from the phonebook are forgotten
+	net.mu.Lock()
+	net.addrs = []string{"a:4160"}
+	net.mu.Unlock()
+	c.check(ctx, &wg, now)
+	wg.Wait()
+	require.Len(t, c.status(), 1)
+}
+
+func TestPersistentPeerBackoff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := makePersistentPeerConnector(logging.TestingLog(t), nil, 8*time.Second, 0)
+	for failures, limit := range []time.Duration{time.Second, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second, 8 * time.Second} {
+		for i := 0; i < 10; i++ {
+			d := c.backoff(failures)
+			require.GreaterOrEqual(t, d, limit/2)
+			require.LessOrEqual(t, d, limit)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"slices"
+)
+
+// PeerAddresses is implemented by phonebooks which can store several network
+// addresses for a single logical peer, such as the IPv4 and IPv6 addresses of
+// a dual-stack relay known by its host name. Dialers may use them when the
+// name can't be resolved.
+type PeerAddresses interface {
+	// SetNetworkAddresses records the ip:port addresses at which the peer
+	// known as addr can be reached. It does nothing if addr is not in the
+	// phonebook.
+	SetNetworkAddresses(addr string, netAddrs []string)
+
+	// NetworkAddresses returns the addresses recorded for addr.
+	NetworkAddresses(addr string) []string
+}
+
+// SetNetworkAddresses records the ip:port addresses at which the peer known as addr can be reached.
+func (e *phonebookImpl) SetNetworkAddresses(addr string, netAddrs []string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	entry, found := e.data[addr]
+	if !found {
+		return
+	}
+	entry.netAddrs = slices.Clone(netAddrs)
+	e.data[addr] = entry
+}
+
+// NetworkAddresses returns the addresses recorded for addr.
+func (e *phonebookImpl) NetworkAddresses(addr string) []string {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return slices.Clone(e.data[addr].netAddrs)
+}
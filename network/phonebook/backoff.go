@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"time"
+)
+
+// BackoffPolicy configures the exponential backoff of the addresses which
+// repeatedly fail to connect. After n consecutive failures, an address is left
+// out of GetAddresses for Base * 2^(n-1), up to Max. Every Decay without
+// failures forgives one of them, so that an address which recovered is not
+// immediately backed off for long if it fails again.
+type BackoffPolicy struct {
+	Base  time.Duration
+	Max   time.Duration
+	Decay time.Duration
+}
+
+// enabled reports whether the backoff is in effect.
+func (p BackoffPolicy) enabled() bool {
+	return p.Base > 0 && p.Max >= p.Base
+}
+
+// delay returns the backoff after the given number of consecutive failures.
+func (p BackoffPolicy) delay(failures int) time.Duration {
+	d := p.Base
+	for i := 1; i < failures && d < p.Max; i++ {
+		d *= 2
+	}
+	return min(d, p.Max)
+}
+
+// ConnectionBackoff is implemented by phonebooks which back off the addresses
+// that repeatedly fail to connect. A connection recorded with
+// PeerCache.MarkConnected resets the backoff of its address.
+type ConnectionBackoff interface {
+	// SetBackoffPolicy sets the backoff policy. A zero Base, or a Max lower
+	// than Base, disables the backoff.
+	SetBackoffPolicy(p BackoffPolicy)
+
+	// MarkFailed records that a connection attempt to addr failed at t.
+	MarkFailed(addr string, t time.Time)
+}
+
+// SetBackoffPolicy sets the backoff policy.
+func (e *phonebookImpl) SetBackoffPolicy(p BackoffPolicy) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.backoffPolicy = p
+}
+
+// MarkFailed records that a connection attempt to addr failed at t, and backs
+// off addr accordingly.
+func (e *phonebookImpl) MarkFailed(addr string, t time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	phonebookDialFailures.Inc(nil)
+	entry, found := e.data[addr]
+	if !found || !e.backoffPolicy.enabled() {
+		return
+	}
+	if e.backoffPolicy.Decay > 0 && entry.failures > 0 {
+		forgiven := int(t.Sub(entry.lastFailure) / e.backoffPolicy.Decay)
+		entry.failures = max(0, entry.failures-forgiven)
+	}
+	entry.failures++
+	entry.lastFailure = t
+	entry.backoffUntil = t.Add(e.backoffPolicy.delay(entry.failures))
+	e.data[addr] = entry
+}
+
+// backedOff reports whether addr is backed off at time t.
+func (entry addressData) backedOff(t time.Time) bool {
+	return t.Before(entry.backoffUntil)
+}
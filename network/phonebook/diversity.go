@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/network/addr"
+)
+
+// NetworkTag describes the autonomous system a peer address belongs to.
+type NetworkTag struct {
+	// ASN is the number of the autonomous system announcing the address.
+	ASN uint32
+	// Country is the ISO 3166 code of the country the autonomous system is registered in.
+	Country string
+}
+
+// A Tagger looks up the NetworkTag of phonebook addresses.
+type Tagger interface {
+	// Tag returns the NetworkTag of address, or false if it is unknown.
+	Tag(address string) (NetworkTag, bool)
+}
+
+const (
+	// asnHostCacheTTL is how long the tags of resolved host names are cached.
+	asnHostCacheTTL = time.Hour
+	// asnHostLookupTimeout bounds the resolution of a single host name.
+	asnHostLookupTimeout = 2 * time.Second
+)
+
+type asnRange struct {
+	start, end net.IP // 16-byte forms, inclusive
+	tag        NetworkTag
+}
+
+type asnHostTag struct {
+	tag     NetworkTag
+	ok      bool
+	expires time.Time
+}
+
+// ASNDatabase is a Tagger backed by a table of IP ranges.
+// Host names are resolved, and their tags are cached for an hour.
+type ASNDatabase struct {
+	ranges []asnRange
+
+	lookupIP  func(ctx context.Context, host string) ([]net.IPAddr, error)
+	hostsLock deadlock.Mutex
+	hosts     map[string]asnHostTag
+}
+
+// LoadASNDatabase reads the IP to ASN table at path, in the tab separated
+// format published by iptoasn.com, optionally gzip compressed:
+//
+//	range_start	range_end	AS_number	country_code	AS_description
+func LoadASNDatabase(path string) (*ASNDatabase, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ASN database %s: %w", path, err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+	db, err := readASNDatabase(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read ASN database %s: %w", path, err)
+	}
+	return db, nil
+}
+
+func readASNDatabase(r io.Reader) (*ASNDatabase, error) {
+	db := &ASNDatabase{
+		lookupIP: net.DefaultResolver.LookupIPAddr,
+		hosts:    make(map[string]asnHostTag),
+	}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if len(fields) < 4 {
+			return nil, fmt.Errorf("line %d: expected at least 4 fields, got %d", line, len(fields))
+		}
+		start, end := net.ParseIP(fields[0]), net.ParseIP(fields[1])
+		if start == nil || end == nil {
+			return nil, fmt.Errorf("line %d: invalid range %s-%s", line, fields[0], fields[1])
+		}
+		asn, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid AS number %s", line, fields[2])
+		}
+		if asn == 0 {
+			// AS 0 marks the ranges which are not routed
+			continue
+		}
+		db.ranges = append(db.ranges, asnRange{
+			start: start.To16(),
+			end:   end.To16(),
+			tag:   NetworkTag{ASN: uint32(asn), Country: fields[3]},
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return bytes.Compare(db.ranges[i].start, db.ranges[j].start) < 0
+	})
+	return db, nil
+}
+
+// TagIP returns the NetworkTag of the range containing ip.
+func (db *ASNDatabase) TagIP(ip net.IP) (NetworkTag, bool) {
+	ip = ip.To16()
+	if ip == nil {
+		return NetworkTag{}, false
+	}
+	// find the last range starting at or before ip
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].start, ip) > 0
+	}) - 1
+	if i < 0 || bytes.Compare(ip, db.ranges[i].end) > 0 {
+		return NetworkTag{}, false
+	}
+	return db.ranges[i].tag, true
+}
+
+// Tag implements Tagger.
+func (db *ASNDatabase) Tag(address string) (NetworkTag, bool) {
+	u, err := addr.ParseHostOrURL(address)
+	if err != nil {
+		return NetworkTag{}, false
+	}
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		return db.TagIP(ip)
+	}
+
+	now := time.Now()
+	db.hostsLock.Lock()
+	cached, has := db.hosts[host]
+	db.hostsLock.Unlock()
+	if has && now.Before(cached.expires) {
+		return cached.tag, cached.ok
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), asnHostLookupTimeout)
+	defer cancel()
+	cached = asnHostTag{expires: now.Add(asnHostCacheTTL)}
+	if ips, err := db.lookupIP(ctx, host); err == nil && len(ips) > 0 {
+		cached.tag, cached.ok = db.TagIP(ips[0].IP)
+	}
+	db.hostsLock.Lock()
+	db.hosts[host] = cached
+	db.hostsLock.Unlock()
+	return cached.tag, cached.ok
+}
+
+// DiversityOrder reorders candidates so that the addresses in the autonomous
+// systems least represented among the connected addresses and the preceding
+// candidates come first, so that connecting to the candidates in order spreads
+// the connections across as many autonomous systems as possible.
+// Addresses which can't be tagged are treated as each being in an autonomous
+// system of their own. Otherwise, the order of the candidates is preserved.
+func DiversityOrder(candidates []string, connected []string, tagger Tagger) []string {
+	counts := make(map[uint32]int)
+	for _, a := range connected {
+		if tag, ok := tagger.Tag(a); ok {
+			counts[tag.ASN]++
+		}
+	}
+	type candidate struct {
+		address string
+		asn     uint32
+		tagged  bool
+	}
+	remaining := make([]candidate, len(candidates))
+	for i, a := range candidates {
+		tag, ok := tagger.Tag(a)
+		remaining[i] = candidate{address: a, asn: tag.ASN, tagged: ok}
+	}
+
+	out := make([]string, 0, len(candidates))
+	for len(remaining) > 0 {
+		best, bestCount := 0, -1
+		for i, c := range remaining {
+			count := 0
+			if c.tagged {
+				count = counts[c.asn]
+			}
+			if bestCount < 0 || count < bestCount {
+				best, bestCount = i, count
+			}
+		}
+		c := remaining[best]
+		if c.tagged {
+			counts[c.asn]++
+		}
+		out = append(out, c.address)
+		remaining = append(remaining[:best], remaining[best+1:]...)
+	}
+	return out
+}
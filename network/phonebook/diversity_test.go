@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testASNTable = `1.0.0.0	1.0.0.255	13335	US	CLOUDFLARENET
+1.0.1.0	1.0.3.255	0	None	Not routed
+1.0.4.0	1.0.7.255	38803	AU	GTELECOM
+10.0.0.0	10.0.255.255	64512	ZZ	TEST-A
+10.1.0.0	10.1.255.255	64513	ZZ	TEST-B
+2001:db8::	2001:db8:ffff:ffff:ffff:ffff:ffff:ffff	64514	ZZ	TEST-V6
+`
+
+func TestASNDatabase(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	db, err := readASNDatabase(strings.NewReader(testASNTable))
+	require.NoError(t, err)
+
+	tag, ok := db.Tag("1.0.0.1:4160")
+	require.True(t, ok)
+	require.Equal(t, NetworkTag{ASN: 13335, Country: "US"}, tag)
+	tag, ok = db.Tag("ws://1.0.7.255:4160/v1/net")
+	require.True(t, ok)
+	require.Equal(t, uint32(38803), tag.ASN)
+	tag, ok = db.Tag("[2001:db8::1]:4160")
+	require.True(t, ok)
+	require.Equal(t, uint32(64514), tag.ASN)
+
+	// not routed and unknown ranges
+	_, ok = db.Tag("1.0.2.1:4160")
+	require.False(t, ok)
+	_, ok = db.Tag("9.9.9.9:4160")
+	require.False(t, ok)
+	_, ok = db.Tag("0.0.0.1:4160")
+	require.False(t, ok)
+
+	// host names are resolved once and cached
+	lookups := 0
+	db.lookupIP = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		lookups++
+		return []net.IPAddr{{IP: net.ParseIP("10.1.2.3")}}, nil
+	}
+	for i := 0; i < 3; i++ {
+		tag, ok = db.Tag("relay.example.com:4160")
+		require.True(t, ok)
+		require.Equal(t, uint32(64513), tag.ASN)
+	}
+	require.Equal(t, 1, lookups)
+
+	_, err = readASNDatabase(strings.NewReader("1.0.0.0\t1.0.0.255\tAS1\tUS\tX\n"))
+	require.Error(t, err)
+	_, err = readASNDatabase(strings.NewReader("1.0.0.0\t1.0.0.255\n"))
+	require.Error(t, err)
+}
+
+func TestLoadASNDatabase(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "ip2asn.tsv")
+	require.NoError(t, os.WriteFile(plain, []byte(testASNTable), 0600))
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(testASNTable))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	compressed := filepath.Join(dir, "ip2asn.tsv.gz")
+	require.NoError(t, os.WriteFile(compressed, buf.Bytes(), 0600))
+
+	for _, path := range []string{plain, compressed} {
+		db, err := LoadASNDatabase(path)
+		require.NoError(t, err)
+		require.Len(t, db.ranges, 5)
+	}
+
+	_, err = LoadASNDatabase(filepath.Join(dir, "missing.tsv"))
+	require.Error(t, err)
+}
+
+func TestDiversityOrder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	db, err := readASNDatabase(strings.NewReader(testASNTable))
+	require.NoError(t, err)
+
+	candidates := []string{"10.0.0.1:4160", "10.0.0.2:4160", "10.0.0.3:4160", "10.1.0.1:4160", "1.0.0.1:4160", "9.9.9.9:4160"}
+	order := DiversityOrder(candidates, nil, db)
+	require.Equal(t, []string{"10.0.0.1:4160", "10.1.0.1:4160", "1.0.0.1:4160", "9.9.9.9:4160", "10.0.0.2:4160", "10.0.0.3:4160"}, order)
+
+	// the autonomous systems of the connected peers come last
+	order = DiversityOrder(candidates, []string{"10.1.0.9:4160", "1.0.0.9:4160"}, db)
+	require.Equal(t, []string{"10.0.0.1:4160", "9.9.9.9:4160", "10.0.0.2:4160", "10.1.0.1:4160", "1.0.0.1:4160", "10.0.0.3:4160"}, order)
+
+	require.Empty(t, DiversityOrder(nil, candidates, db))
+}
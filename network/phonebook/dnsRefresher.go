@@ -0,0 +1,205 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+)
+
+// A DNSOrigin is a set of DNS bootstrap records, whose addresses are kept in
+// the phonebook under the origin's own network name.
+type DNSOrigin struct {
+	// Name is the phonebook network name of the addresses of the origin.
+	Name string
+	// Resolve looks up the relay and archival addresses of the origin,
+	// requiring DNSSEC validation if secure is set. An empty result is
+	// treated as a failed lookup, and leaves the phonebook unchanged.
+	Resolve func(ctx context.Context, secure bool) (relays []string, archivals []string)
+}
+
+// DNSRefresherConfig configures a DNSRefresher.
+type DNSRefresherConfig struct {
+	// Interval is the time between refreshes, to which a random duration
+	// of up to Jitter is added. If it is not positive, the records are only
+	// resolved on start and when a refresh is triggered.
+	Interval time.Duration
+	Jitter   time.Duration
+	// Secure requires the DNS records to be validated with DNSSEC.
+	Secure bool
+	// ShrinkAlertPercent is the percentage by which the addresses of an
+	// origin may shrink between two refreshes before a DNSBootstrapShrinkEvent
+	// is emitted. Zero disables the event.
+	ShrinkAlertPercent uint64
+}
+
+// DNSRefresher periodically re-resolves the DNS bootstrap records of a set
+// of origins, and replaces their addresses in the phonebook.
+type DNSRefresher struct {
+	phonebook Phonebook
+	log       logging.Logger
+	cfg       DNSRefresherConfig
+	origins   func() []DNSOrigin
+	// onRefresh, if set, is called after each refresh.
+	onRefresh func()
+
+	trigger chan struct{}
+
+	mu deadlock.Mutex
+	// counts holds the number of addresses of each origin and role found by the last lookup.
+	counts map[dnsOriginRole]int
+}
+
+type dnsOriginRole struct {
+	origin string
+	role   Role
+}
+
+// MakeDNSRefresher creates a DNSRefresher of the origins returned by origins,
+// which is called on every refresh. If onRefresh is not nil, it is called
+// after every refresh.
+func MakeDNSRefresher(pb Phonebook, log logging.Logger, cfg DNSRefresherConfig, origins func() []DNSOrigin, onRefresh func()) *DNSRefresher {
+	return &DNSRefresher{
+		phonebook: pb,
+		log:       log,
+		cfg:       cfg,
+		origins:   origins,
+		onRefresh: onRefresh,
+		trigger:   make(chan struct{}, 1),
+		counts:    make(map[dnsOriginRole]int),
+	}
+}
+
+// Start refreshes the phonebook right away, and then periodically until ctx is done.
+func (r *DNSRefresher) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go r.refreshThread(ctx, wg)
+}
+
+// Trigger requests a refresh ahead of the next scheduled one.
+func (r *DNSRefresher) Trigger() {
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (r *DNSRefresher) refreshThread(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		r.Refresh(ctx)
+
+		if !r.wait(ctx) {
+			return
+		}
+	}
+}
+
+// wait waits until the next refresh is due or triggered, and returns false
+// if ctx is done first.
+func (r *DNSRefresher) wait(ctx context.Context) bool {
+	var timerC <-chan time.Time
+	if r.cfg.Interval > 0 {
+		wait := r.cfg.Interval
+		if r.cfg.Jitter > 0 {
+			wait += time.Duration(rand.Int63n(int64(r.cfg.Jitter)))
+		}
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		timerC = timer.C
+	}
+	select {
+	case <-timerC:
+		return true
+	case <-r.trigger:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Refresh resolves the records of every origin and updates the phonebook.
+func (r *DNSRefresher) Refresh(ctx context.Context) {
+	for _, origin := range r.origins() {
+		relays, archivals := origin.Resolve(ctx, r.cfg.Secure)
+		r.Update(origin.Name, relays, archivals)
+	}
+	if r.onRefresh != nil {
+		r.onRefresh()
+	}
+}
+
+// Update replaces the addresses of the origin in the phonebook with the given
+// relay and archival addresses.
+func (r *DNSRefresher) Update(origin string, relays []string, archivals []string) {
+	r.replace(origin, relays, RelayRole)
+	r.replace(origin, archivals, ArchivalRole)
+}
+
+func (r *DNSRefresher) replace(origin string, addrs []string, role Role) {
+	r.checkShrink(origin, role, len(addrs))
+	if len(addrs) == 0 {
+		r.log.Infof("got no %s DNS addrs for %s", roleName(role), origin)
+		return
+	}
+	r.log.Debugf("got %d %s dns addrs for %s, %#v", len(addrs), roleName(role), origin, addrs[:min(5, len(addrs))])
+	r.phonebook.ReplacePeerList(addrs, origin, role)
+}
+
+// checkShrink records the number of addresses found for the origin and role,
+// and emits a DNSBootstrapShrinkEvent if it dropped by more than ShrinkAlertPercent,
+// which may be the sign of a DNS outage or hijack. It returns true if the event was emitted.
+func (r *DNSRefresher) checkShrink(origin string, role Role, count int) bool {
+	r.mu.Lock()
+	key := dnsOriginRole{origin: origin, role: role}
+	prev := r.counts[key]
+	r.counts[key] = count
+	r.mu.Unlock()
+
+	if r.cfg.ShrinkAlertPercent == 0 || count >= prev {
+		return false
+	}
+	if uint64(prev-count)*100 <= uint64(prev)*r.cfg.ShrinkAlertPercent {
+		return false
+	}
+	r.log.Warnf("%s DNS addrs for %s shrank from %d to %d", roleName(role), origin, prev, count)
+	r.log.EventWithDetails(telemetryspec.Network, telemetryspec.DNSBootstrapShrinkEvent,
+		telemetryspec.DNSBootstrapShrinkEventDetails{
+			Origin:   origin,
+			Role:     roleName(role),
+			Previous: prev,
+			Current:  count,
+		})
+	return true
+}
+
+// roleName returns the name of a single role, as used in metric labels.
+func roleName(role Role) string {
+	for _, rn := range roleNames {
+		if rn.role == role {
+			return rn.name
+		}
+	}
+	return "unknown"
+}
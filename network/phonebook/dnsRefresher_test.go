@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDNSRefresherOrigins(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pb := MakePhonebook(1, time.Millisecond).(*phonebookImpl)
+	results := map[string][]string{
+		"a": {"a1:4160", "a2:4160"},
+		"b": {"b1:4160"},
+	}
+	var secure []bool
+	origins := func() []DNSOrigin {
+		var o []DNSOrigin
+		for _, name := range []string{"a", "b"} {
+			o = append(o, DNSOrigin{Name: name, Resolve: func(ctx context.Context, s bool) ([]string, []string) {
+				secure = append(secure, s)
+				return results[name], nil
+			}})
+		}
+		return o
+	}
+	refreshed := 0
+	r := MakeDNSRefresher(pb, logging.TestingLog(t), DNSRefresherConfig{Secure: true}, origins, func() { refreshed++ })
+
+	r.Refresh(context.Background())
+	require.Equal(t, 1, refreshed)
+	require.Equal(t, []bool{true, true}, secure)
+	require.ElementsMatch(t, []string{"a1:4160", "a2:4160", "b1:4160"}, pb.GetAddresses(getAllAddresses, RelayRole))
+
+	// each origin only replaces its own addresses, and a failed lookup leaves them in place
+	results["a"] = []string{"a3:4160"}
+	results["b"] = nil
+	r.Refresh(context.Background())
+	require.ElementsMatch(t, []string{"a3:4160", "b1:4160"}, pb.GetAddresses(getAllAddresses, RelayRole))
+}
+
+func TestDNSRefresherShrinkAlert(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	r := MakeDNSRefresher(MakePhonebook(1, time.Millisecond), logging.TestingLog(t),
+		DNSRefresherConfig{ShrinkAlertPercent: 50}, func() []DNSOrigin { return nil }, nil)
+
+	require.False(t, r.checkShrink("a", RelayRole, 10))
+	require.False(t, r.checkShrink("a", RelayRole, 5))
+	require.True(t, r.checkShrink("a", RelayRole, 2))
+	require.False(t, r.checkShrink("a", RelayRole, 20))
+	// origins and roles are tracked separately
+	require.False(t, r.checkShrink("a", ArchivalRole, 1))
+	require.False(t, r.checkShrink("b", RelayRole, 1))
+	// an outage is a shrink to zero
+	require.True(t, r.checkShrink("a", RelayRole, 0))
+
+	r.cfg.ShrinkAlertPercent = 0
+	require.False(t, r.checkShrink("b", RelayRole, 0))
+}
+
+func TestDNSRefresherTrigger(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	refreshed := make(chan struct{}, 10)
+	r := MakeDNSRefresher(MakePhonebook(1, time.Millisecond), logging.TestingLog(t),
+		DNSRefresherConfig{Interval: time.Hour}, func() []DNSOrigin { return nil }, func() { refreshed <- struct{}{} })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	r.Start(ctx, &wg)
+	<-refreshed
+	r.Trigger()
+	<-refreshed
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var phonebookActiveBans = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_phonebook_active_bans", Description: "Number of addresses currently banned from the phonebook"})
+var phonebookBansTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_phonebook_bans_total", Description: "Number of times an address was banned from the phonebook"})
+
+var phonebookEntries = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_phonebook_entries", Description: "Number of phonebook addresses having each role"})
+var phonebookPersistentEntries = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_phonebook_persistent_entries", Description: "Number of phonebook addresses having each role persistently"})
+var phonebookEntriesAdded = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_phonebook_entries_added_total", Description: "Number of roles added to phonebook addresses by peer list updates"})
+var phonebookEntriesRemoved = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_phonebook_entries_removed_total", Description: "Number of roles removed from phonebook addresses by peer list updates"})
+var phonebookBackedOffEntries = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_phonebook_backed_off_entries", Description: "Number of phonebook addresses backed off after repeatedly failing to connect"})
+var phonebookDialFailures = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_phonebook_dial_failures_total", Description: "Number of failed connection attempts to phonebook addresses"})
+var phonebookRateLimited = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_phonebook_rate_limited_total", Description: "Number of connection attempts delayed by the phonebook connection rate limit"})
+
+// roleNames are the metric labels of each single role.
+var roleNames = []struct {
+	role Role
+	name string
+}{
+	{RelayRole, "relay"},
+	{ArchivalRole, "archival"},
+	{BlockServiceRole, "blockservice"},
+	{TxGossipRole, "txgossip"},
+}
+
+func roleLabels(name string) map[string]string {
+	return map[string]string{"role": name}
+}
+
+// roleSnapshot returns the roles among role which each address has.
+// The caller must hold e.lock.
+func (e *phonebookImpl) roleSnapshot(role Role) map[string]Role {
+	snapshot := make(map[string]Role)
+	for addr, entry := range e.data {
+		if has := entry.roles.roles & role; has != 0 {
+			snapshot[addr] = has
+		}
+	}
+	return snapshot
+}
+
+// countChanges compares the roles among role which each address has to the
+// snapshot before, and counts the roles added and removed.
+// The caller must hold e.lock.
+func (e *phonebookImpl) countChanges(before map[string]Role, role Role) {
+	after := e.roleSnapshot(role)
+	for _, rn := range roleNames {
+		if role&rn.role == 0 {
+			continue
+		}
+		var added, removed uint64
+		for addr, roles := range after {
+			if roles&rn.role != 0 && before[addr]&rn.role == 0 {
+				added++
+			}
+		}
+		for addr, roles := range before {
+			if roles&rn.role != 0 && after[addr]&rn.role == 0 {
+				removed++
+			}
+		}
+		phonebookEntriesAdded.AddUint64(added, roleLabels(rn.name))
+		phonebookEntriesRemoved.AddUint64(removed, roleLabels(rn.name))
+	}
+}
+
+// updateMetrics recomputes the per-role gauges. The caller must hold e.lock.
+func (e *phonebookImpl) updateMetrics() {
+	for _, rn := range roleNames {
+		var total, persistent uint64
+		for _, entry := range e.data {
+			if entry.roles.Has(rn.role) {
+				total++
+			}
+			if entry.roles.IsPersistent(rn.role) {
+				persistent++
+			}
+		}
+		phonebookEntries.SetLabels(total, roleLabels(rn.name))
+		phonebookPersistentEntries.SetLabels(persistent, roleLabels(rn.name))
+	}
+}
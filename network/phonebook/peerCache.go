@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// peerCacheVersion is the version of the peer cache file format.
+const peerCacheVersion = 1
+
+// peerCacheMaxAge is the age past which a saved peer cache is ignored, since
+// the peers it lists are unlikely to still be reachable.
+const peerCacheMaxAge = 7 * 24 * time.Hour
+
+// PeerCache is implemented by phonebooks whose learned peers can be saved to a
+// file and restored from it after a restart, so that the node can reconnect
+// before DNS bootstrapping completes.
+type PeerCache interface {
+	// MarkConnected records that a connection to addr was established at t,
+	// which also resets its ConnectionBackoff.
+	MarkConnected(addr string, t time.Time)
+
+	// SavePeers writes the peers learned from DNS and other non-persistent
+	// sources to the file at path.
+	SavePeers(path string) error
+
+	// LoadPeers adds the peers saved in the file at path. A missing or
+	// outdated file is ignored. Loaded peers are not persistent, so they are
+	// replaced by the next ReplacePeerList call for their network and role.
+	LoadPeers(path string) error
+}
+
+// cachedPeer is the saved form of a phonebook entry.
+type cachedPeer struct {
+	Address     string    `json:"address"`
+	Networks    []string  `json:"networks"`
+	Roles       Role      `json:"roles"`
+	LastSuccess time.Time `json:"last-success,omitempty"`
+}
+
+type peerCacheFile struct {
+	Version int          `json:"version"`
+	Saved   time.Time    `json:"saved"`
+	Peers   []cachedPeer `json:"peers"`
+}
+
+// MarkConnected records that a connection to addr was established at t.
+func (e *phonebookImpl) MarkConnected(addr string, t time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	entry, found := e.data[addr]
+	if !found {
+		return
+	}
+	entry.lastSuccess = t
+	entry.failures = 0
+	entry.backoffUntil = time.Time{}
+	e.data[addr] = entry
+}
+
+// SavePeers writes the non-persistent phonebook entries to the file at path,
+// replacing it atomically.
+func (e *phonebookImpl) SavePeers(path string) error {
+	cache := peerCacheFile{Version: peerCacheVersion, Saved: time.Now()}
+
+	e.lock.RLock()
+	for addr, entry := range e.data {
+		// persistent peers are configured rather than learned
+		roles := entry.roles.roles &^ Role(entry.roles.persistence)
+		if roles == 0 {
+			continue
+		}
+		networks := make([]string, 0, len(entry.networkNames))
+		for name := range entry.networkNames {
+			networks = append(networks, name)
+		}
+		slices.Sort(networks)
+		cache.Peers = append(cache.Peers, cachedPeer{
+			Address:     addr,
+			Networks:    networks,
+			Roles:       roles,
+			LastSuccess: entry.lastSuccess,
+		})
+	}
+	e.lock.RUnlock()
+
+	slices.SortFunc(cache.Peers, func(a, b cachedPeer) int {
+		return b.LastSuccess.Compare(a.LastSuccess)
+	})
+
+	data, err := json.Marshal(&cache)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	err = os.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadPeers adds the entries saved in the file at path to the phonebook.
+func (e *phonebookImpl) LoadPeers(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var cache peerCacheFile
+	err = json.Unmarshal(data, &cache)
+	if err != nil {
+		return fmt.Errorf("could not decode peer cache %s: %w", path, err)
+	}
+	if cache.Version != peerCacheVersion {
+		return fmt.Errorf("unsupported peer cache version %d in %s", cache.Version, path)
+	}
+	if time.Since(cache.Saved) > peerCacheMaxAge {
+		return nil
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	for _, p := range cache.Peers {
+		if p.Address == "" || p.Roles == 0 || len(p.Networks) == 0 {
+			continue
+		}
+		entry, has := e.data[p.Address]
+		if !has {
+			entry = makePhonebookEntryData(p.Networks[0], p.Roles, false)
+		}
+		for _, name := range p.Networks {
+			entry.networkNames[name] = true
+		}
+		entry.roles.Add(p.Roles)
+		if p.LastSuccess.After(entry.lastSuccess) {
+			entry.lastSuccess = p.LastSuccess
+		}
+		e.data[p.Address] = entry
+	}
+	e.updateMetrics()
+	return nil
+}
@@ -17,9 +17,11 @@
 package phonebook
 
 import (
+	"fmt"
 	"math"
 	"math/rand"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -30,7 +32,7 @@ import (
 const getAllAddresses = math.MaxInt32
 
 // RoleSet defines the roles that a single entry on the phonebook can take.
-// currently, we have two roles : relay role and archival role.
+// currently, we have four roles : relay, archival, block service and transaction gossip.
 //
 //msgp:ignore Roles
 type RoleSet struct {
@@ -39,7 +41,8 @@ type RoleSet struct {
 	_           func()      // func is not comparable so that Roles. This is to prevent roles misuse and direct comparison.
 }
 
-// Role is a single role that a phonebook entry can have.
+// Role is a single role that a phonebook entry can have. Roles are bit flags,
+// so several of them may be combined with | when querying the phonebook.
 type Role uint8
 type persistence uint8
 
@@ -56,6 +59,10 @@ const (
 	RelayRole Role = 1 << iota
 	// ArchivalRole used for all the archival nodes that are provided via the archive SRV record.
 	ArchivalRole
+	// BlockServiceRole used for the nodes which serve blocks to catchup clients.
+	BlockServiceRole
+	// TxGossipRole used for the nodes which accept and relay transaction gossip.
+	TxGossipRole
 )
 
 // MakeRoleSet creates a new RoleSet with the passed role
@@ -105,7 +112,8 @@ func (r RoleSet) hasPersistentRoles() bool {
 
 // Phonebook stores or looks up addresses of nodes we might contact
 type Phonebook interface {
-	// GetAddresses(N) returns up to N addresses, but may return fewer
+	// GetAddresses(N) returns up to N addresses, but may return fewer.
+	// role may combine several roles, in which case addresses having any of them are returned.
 	GetAddresses(n int, role Role) []string
 
 	// UpdateRetryAfter updates the retry-after field for the entries matching the given address
@@ -133,6 +141,63 @@ type Phonebook interface {
 	// i.e. they won't be replaced by ReplacePeerList calls.
 	// If a peer is already in the peerstore, its role will be updated.
 	AddPersistentPeers(dnsAddresses []string, networkName string, role Role)
+
+	// Ban excludes addr from GetAddresses for the given duration, even if
+	// it is added again in the meantime. Banning an address which is
+	// already banned extends the ban if it would otherwise end earlier.
+	Ban(addr string, duration time.Duration)
+
+	// Entries returns a snapshot of the phonebook entries, sorted by address.
+	Entries() []Entry
+
+	// RemovePeer removes addr from the phonebook, including its persistent roles.
+	// It returns false if addr was not in the phonebook. Note that addresses
+	// obtained from a peer list may be added again by the next ReplacePeerList.
+	RemovePeer(addr string) bool
+}
+
+// Entry describes a phonebook address, as returned by Entries.
+type Entry struct {
+	Address string
+	// Roles and PersistentRoles are the roles of the address, and the subset
+	// of them which are persistent.
+	Roles           Role
+	PersistentRoles Role
+	// Origins are the network names the address was obtained for.
+	Origins []string
+
+	RetryAfter            time.Time
+	LastSuccess           time.Time
+	RecentConnectionTimes []time.Time
+	// Failures is the number of recent consecutive failed connection attempts,
+	// and BackoffUntil the end of the resulting backoff.
+	Failures     int
+	BackoffUntil time.Time
+	// BannedUntil is the end of the ban of the address, or zero if it isn't banned.
+	BannedUntil time.Time
+	// NetworkAddresses are the ip:port addresses recorded for the address, see PeerAddresses.
+	NetworkAddresses []string
+}
+
+// RoleNames returns the names of the roles combined in r.
+func RoleNames(r Role) []string {
+	var names []string
+	for _, rn := range roleNames {
+		if r&rn.role != 0 {
+			names = append(names, rn.name)
+		}
+	}
+	return names
+}
+
+// ParseRole returns the role with the given name, as returned by RoleNames.
+func ParseRole(name string) (Role, error) {
+	for _, rn := range roleNames {
+		if rn.name == name {
+			return rn.role, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown phonebook role %q", name)
 }
 
 // addressData: holds the information associated with each phonebook address.
@@ -149,6 +214,20 @@ type addressData struct {
 
 	// roles is the roles that this address serves.
 	roles RoleSet
+
+	// lastSuccess is the last time a connection to the address was established.
+	lastSuccess time.Time
+
+	// failures is the number of consecutive failed connection attempts, net of
+	// the ones forgiven by the backoff policy decay, and lastFailure the time of
+	// the last one. The address isn't returned by GetAddresses until backoffUntil.
+	failures     int
+	lastFailure  time.Time
+	backoffUntil time.Time
+
+	// netAddrs are the ip:port addresses at which the peer known by this
+	// address was last reached, see PeerAddresses.
+	netAddrs []string
 }
 
 // makePhonebookEntryData creates a new addressData entry for provided network name and role.
@@ -169,19 +248,49 @@ type phonebookImpl struct {
 	connectionsRateLimitingCount  uint
 	connectionsRateLimitingWindow time.Duration
 	data                          map[string]addressData
-	lock                          deadlock.RWMutex
+	// bans maps banned addresses to the time their ban ends.
+	bans map[string]time.Time
+	// subnetConnectionTimes logs the connection times of each subnet
+	// limited by subnetRateLimit.
+	subnetRateLimit       SubnetRateLimit
+	subnetConnectionTimes map[string][]time.Time
+	// backoffPolicy configures the backoff of the addresses failing to connect.
+	backoffPolicy BackoffPolicy
+	// now returns the current time; it is time.Now, unless replaced to simulate the passing of time.
+	now  func() time.Time
+	lock deadlock.RWMutex
 }
 
 // MakePhonebook creates phonebookImpl with the passed configuration values
 func MakePhonebook(connectionsRateLimitingCount uint,
 	connectionsRateLimitingWindow time.Duration) Phonebook {
+	return MakePhonebookWithSubnetRateLimit(connectionsRateLimitingCount, connectionsRateLimitingWindow, SubnetRateLimit{})
+}
+
+// MakePhonebookWithSubnetRateLimit creates phonebookImpl which additionally
+// rate limits the connections to all the addresses of a subnet
+func MakePhonebookWithSubnetRateLimit(connectionsRateLimitingCount uint,
+	connectionsRateLimitingWindow time.Duration, subnetRateLimit SubnetRateLimit) Phonebook {
 	return &phonebookImpl{
 		connectionsRateLimitingCount:  connectionsRateLimitingCount,
 		connectionsRateLimitingWindow: connectionsRateLimitingWindow,
 		data:                          make(map[string]addressData, 0),
+		bans:                          make(map[string]time.Time),
+		subnetRateLimit:               subnetRateLimit,
+		subnetConnectionTimes:         make(map[string][]time.Time),
+		now:                           time.Now,
 	}
 }
 
+// MakePhonebookWithClock creates phonebookImpl which reads the current time from now instead of
+// time.Now, so that the connection rate limiting can be exercised without waiting for its window.
+func MakePhonebookWithClock(connectionsRateLimitingCount uint,
+	connectionsRateLimitingWindow time.Duration, now func() time.Time) Phonebook {
+	pb := MakePhonebook(connectionsRateLimitingCount, connectionsRateLimitingWindow).(*phonebookImpl)
+	pb.now = now
+	return pb
+}
+
 func (e *phonebookImpl) deletePhonebookEntry(entryName, networkName string) {
 	pbEntry := e.data[entryName]
 	delete(pbEntry.networkNames, networkName)
@@ -210,13 +319,43 @@ func (e *phonebookImpl) appendTime(addr string, t time.Time) {
 func (e *phonebookImpl) filterRetryTime(t time.Time, role Role) []string {
 	o := make([]string, 0, len(e.data))
 	for addr, entry := range e.data {
-		if t.After(entry.retryAfter) && entry.roles.Has(role) {
+		if t.After(entry.retryAfter) && entry.roles.Has(role) && !e.banned(addr, t) && !entry.backedOff(t) {
 			o = append(o, addr)
 		}
 	}
 	return o
 }
 
+// banned reports whether addr is banned at time t.
+func (e *phonebookImpl) banned(addr string, t time.Time) bool {
+	until, ok := e.bans[addr]
+	return ok && t.Before(until)
+}
+
+// Ban excludes addr from GetAddresses until the given duration has elapsed.
+func (e *phonebookImpl) Ban(addr string, duration time.Duration) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.bans == nil {
+		e.bans = make(map[string]time.Time)
+	}
+	now := e.now()
+	until := now.Add(duration)
+	if until.After(e.bans[addr]) {
+		e.bans[addr] = until
+	}
+	phonebookBansTotal.Inc(nil)
+
+	// drop the bans which have ended
+	for a, u := range e.bans {
+		if !now.Before(u) {
+			delete(e.bans, a)
+		}
+	}
+	phonebookActiveBans.Set(uint64(len(e.bans)))
+}
+
 // ReplacePeerList merges a set of addresses with that passed in.
 // new entries in addressesThey are being added
 // existing items that aren't included in addressesThey are being removed
@@ -225,6 +364,8 @@ func (e *phonebookImpl) ReplacePeerList(addressesThey []string, networkName stri
 	e.lock.Lock()
 	defer e.lock.Unlock()
 
+	before := e.roleSnapshot(role)
+
 	// prepare a map of items we'd like to remove.
 	removeItems := make(map[string]bool, 0)
 	for k, pbd := range e.data {
@@ -258,6 +399,8 @@ func (e *phonebookImpl) ReplacePeerList(addressesThey []string, networkName stri
 	for k := range removeItems {
 		e.deletePhonebookEntry(k, networkName)
 	}
+	e.countChanges(before, role)
+	e.updateMetrics()
 }
 
 // AddPersistentPeers stores addresses of peers which are persistent.
@@ -278,6 +421,7 @@ func (e *phonebookImpl) AddPersistentPeers(dnsAddresses []string, networkName st
 			e.data[addr] = makePhonebookEntryData(networkName, role, true)
 		}
 	}
+	e.updateMetrics()
 }
 
 func (e *phonebookImpl) UpdateRetryAfter(addr string, retryAfter time.Time) {
@@ -307,7 +451,7 @@ func (e *phonebookImpl) GetConnectionWaitTime(addrOrPeerID string) (addrInPhoneb
 	defer e.lock.Unlock()
 
 	_, addrInPhonebook = e.data[addr]
-	curTime := time.Now()
+	curTime := e.now()
 	if !addrInPhonebook {
 		// The addr is not in this phonebook.
 		// Will find the addr in a different phonebook.
@@ -331,16 +475,32 @@ func (e *phonebookImpl) GetConnectionWaitTime(addrOrPeerID string) (addrInPhoneb
 	// If there are max number of connections within the time window, wait
 	numElts := len(e.data[addr].recentConnectionTimes)
 	if uint(numElts) >= e.connectionsRateLimitingCount {
+		phonebookRateLimited.Inc(nil)
 		return addrInPhonebook, /* true */
 			(e.connectionsRateLimitingWindow - timeSince), curTime /* not used */
 	}
 
+	// Also wait if the subnet of the addr has reached its own limit
+	var subnet string
+	if e.subnetRateLimit.enabled() {
+		subnet = e.subnetRateLimit.subnetKey(addr)
+		if subnet != "" {
+			if wait := e.subnetWaitTime(subnet, curTime); wait > 0 {
+				phonebookRateLimited.Inc(nil)
+				return addrInPhonebook /* true */, wait, curTime /* not used */
+			}
+		}
+	}
+
 	// Else, there is space in connectionsRateLimitingCount. The
 	// connection request of the caller will proceed
 	// Update curTime, since it may have significantly changed if waited
-	provisionalTime = time.Now()
+	provisionalTime = e.now()
 	// Append the provisional time for the next connection request
 	e.appendTime(addr, provisionalTime)
+	if subnet != "" {
+		e.subnetConnectionTimes[subnet] = append(e.subnetConnectionTimes[subnet], provisionalTime)
+	}
 	return addrInPhonebook /* true */, 0 /* no wait. proceed */, provisionalTime
 }
 
@@ -360,17 +520,23 @@ func (e *phonebookImpl) UpdateConnectionTime(addrOrPeerID string, provisionalTim
 		e.data[addr] = entry
 	}()
 
+	if e.subnetRateLimit.enabled() {
+		if subnet := e.subnetRateLimit.subnetKey(addr); subnet != "" {
+			e.updateSubnetTime(subnet, provisionalTime)
+		}
+	}
+
 	// Find the provisionalTime and update it
 	for indx, val := range entry.recentConnectionTimes {
 		if provisionalTime == val {
-			entry.recentConnectionTimes[indx] = time.Now()
+			entry.recentConnectionTimes[indx] = e.now()
 			return true
 		}
 	}
 	// Case where the time is not found: it was removed from the list.
 	// This may happen when the time expires before the connection was established with the server.
 	// The time should be added again.
-	entry.recentConnectionTimes = append(entry.recentConnectionTimes, time.Now())
+	entry.recentConnectionTimes = append(entry.recentConnectionTimes, e.now())
 	return true
 }
 
@@ -406,7 +572,73 @@ func shuffleSelect(set []string, n int) []string {
 func (e *phonebookImpl) GetAddresses(n int, role Role) []string {
 	e.lock.RLock()
 	defer e.lock.RUnlock()
-	return shuffleSelect(e.filterRetryTime(time.Now(), role), n)
+
+	now := e.now()
+	active := 0
+	for _, until := range e.bans {
+		if now.Before(until) {
+			active++
+		}
+	}
+	phonebookActiveBans.Set(uint64(active))
+	backedOff := 0
+	for _, entry := range e.data {
+		if entry.backedOff(now) {
+			backedOff++
+		}
+	}
+	phonebookBackedOffEntries.Set(uint64(backedOff))
+	return shuffleSelect(e.filterRetryTime(now, role), n)
+}
+
+// Entries returns a snapshot of the phonebook entries, sorted by address.
+func (e *phonebookImpl) Entries() []Entry {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	now := e.now()
+	entries := make([]Entry, 0, len(e.data))
+	for addr, data := range e.data {
+		entry := Entry{
+			Address:               addr,
+			Roles:                 data.roles.roles,
+			PersistentRoles:       data.roles.roles & Role(data.roles.persistence),
+			RetryAfter:            data.retryAfter,
+			LastSuccess:           data.lastSuccess,
+			RecentConnectionTimes: slices.Clone(data.recentConnectionTimes),
+			Failures:              data.failures,
+			NetworkAddresses:      slices.Clone(data.netAddrs),
+		}
+		if data.backedOff(now) {
+			entry.BackoffUntil = data.backoffUntil
+		}
+		for name := range data.networkNames {
+			entry.Origins = append(entry.Origins, name)
+		}
+		slices.Sort(entry.Origins)
+		if until, banned := e.bans[addr]; banned && now.Before(until) {
+			entry.BannedUntil = until
+		}
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b Entry) int { return strings.Compare(a.Address, b.Address) })
+	return entries
+}
+
+// RemovePeer removes addr from the phonebook, including its persistent roles.
+func (e *phonebookImpl) RemovePeer(addr string) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if _, has := e.data[addr]; !has {
+		return false
+	}
+	const allRoles = RelayRole | ArchivalRole | BlockServiceRole | TxGossipRole
+	before := e.roleSnapshot(allRoles)
+	delete(e.data, addr)
+	e.countChanges(before, allRoles)
+	e.updateMetrics()
+	return true
 }
 
 // Length returns the number of addrs contained
@@ -17,6 +17,9 @@
 package phonebook
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"slices"
 	"testing"
 	"time"
@@ -369,6 +372,8 @@ func TestRolesOperations(t *testing.T) {
 	}{
 		{RelayRole, ArchivalRole},
 		{ArchivalRole, RelayRole},
+		{BlockServiceRole, TxGossipRole},
+		{RelayRole, BlockServiceRole | TxGossipRole},
 	}
 
 	for _, test := range tests {
@@ -479,3 +484,290 @@ func TestRoleSetPersistence(t *testing.T) {
 		t.Run(test.name, test.fn)
 	}
 }
+
+func TestPhonebookPeerCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), "peers.json")
+
+	pb := MakePhonebook(1, time.Millisecond).(*phonebookImpl)
+	pb.AddPersistentPeers([]string{"a"}, "default", RelayRole)
+	pb.ReplacePeerList([]string{"b", "c"}, "default", RelayRole)
+	pb.ReplacePeerList([]string{"c", "d"}, "default", ArchivalRole)
+	connected := time.Now().Add(-time.Minute).Round(0)
+	pb.MarkConnected("b", connected)
+	pb.MarkConnected("unknown", connected)
+	require.NoError(t, pb.SavePeers(path))
+
+	// a missing cache is not an error
+	restored := MakePhonebook(1, time.Millisecond).(*phonebookImpl)
+	require.NoError(t, restored.LoadPeers(path+".missing"))
+	require.Zero(t, restored.Length())
+
+	require.NoError(t, restored.LoadPeers(path))
+	require.Equal(t, 3, restored.Length())
+	require.ElementsMatch(t, []string{"b", "c"}, restored.GetAddresses(getAllAddresses, RelayRole))
+	require.ElementsMatch(t, []string{"c", "d"}, restored.GetAddresses(getAllAddresses, ArchivalRole))
+	require.True(t, connected.Equal(restored.data["b"].lastSuccess))
+	require.False(t, restored.data["c"].roles.hasPersistentRoles())
+
+	// restored peers are replaced once DNS bootstrapping completes
+	restored.ReplacePeerList([]string{"e"}, "default", RelayRole)
+	require.ElementsMatch(t, []string{"e"}, restored.GetAddresses(getAllAddresses, RelayRole))
+}
+
+func TestPhonebookPeerCacheOutdated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), "peers.json")
+	cache := peerCacheFile{
+		Version: peerCacheVersion,
+		Saved:   time.Now().Add(-2 * peerCacheMaxAge),
+		Peers:   []cachedPeer{{Address: "a", Networks: []string{"default"}, Roles: RelayRole}},
+	}
+	data, err := json.Marshal(&cache)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0644))
+
+	pb := MakePhonebook(1, time.Millisecond).(*phonebookImpl)
+	require.NoError(t, pb.LoadPeers(path))
+	require.Zero(t, pb.Length())
+
+	cache.Version = peerCacheVersion + 1
+	data, err = json.Marshal(&cache)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0644))
+	require.Error(t, pb.LoadPeers(path))
+}
+
+func TestPhonebookBan(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pb := MakePhonebook(1, time.Millisecond).(*phonebookImpl)
+	pb.ReplacePeerList([]string{"a", "b"}, "default", RelayRole)
+
+	pb.Ban("a", time.Hour)
+	require.Equal(t, []string{"b"}, pb.GetAddresses(getAllAddresses, RelayRole))
+
+	// the ban survives the address being removed and added back
+	pb.ReplacePeerList([]string{"b"}, "default", RelayRole)
+	pb.ReplacePeerList([]string{"a", "b"}, "default", RelayRole)
+	require.Equal(t, []string{"b"}, pb.GetAddresses(getAllAddresses, RelayRole))
+
+	// a shorter ban does not shorten an existing one
+	pb.Ban("a", time.Nanosecond)
+	require.True(t, pb.banned("a", time.Now().Add(time.Minute)))
+
+	// expired bans no longer exclude the address, and are dropped on the next ban
+	pb.bans["a"] = time.Now().Add(-time.Second)
+	require.ElementsMatch(t, []string{"a", "b"}, pb.GetAddresses(getAllAddresses, RelayRole))
+	pb.Ban("b", time.Hour)
+	require.NotContains(t, pb.bans, "a")
+	require.Equal(t, []string{"a"}, pb.GetAddresses(getAllAddresses, RelayRole))
+}
+
+func TestPhonebookCombinedRoles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ph := MakePhonebook(1, 1).(*phonebookImpl)
+	ph.ReplacePeerList([]string{"relay"}, "default", RelayRole)
+	ph.ReplacePeerList([]string{"archiver"}, "default", ArchivalRole)
+	ph.ReplacePeerList([]string{"blocks"}, "default", BlockServiceRole)
+	ph.ReplacePeerList([]string{"gossip"}, "default", TxGossipRole)
+
+	require.Equal(t, []string{"blocks"}, ph.GetAddresses(getAllAddresses, BlockServiceRole))
+	require.Equal(t, []string{"gossip"}, ph.GetAddresses(getAllAddresses, TxGossipRole))
+	require.ElementsMatch(t, []string{"archiver", "blocks"}, ph.GetAddresses(getAllAddresses, ArchivalRole|BlockServiceRole))
+	require.ElementsMatch(t, []string{"relay", "gossip"}, ph.GetAddresses(getAllAddresses, RelayRole|TxGossipRole))
+
+	// replacing one role keeps the others of an entry
+	ph.ReplacePeerList([]string{"relay"}, "default", BlockServiceRole)
+	require.ElementsMatch(t, []string{"relay"}, ph.GetAddresses(getAllAddresses, BlockServiceRole))
+	require.ElementsMatch(t, []string{"relay", "gossip"}, ph.GetAddresses(getAllAddresses, RelayRole|TxGossipRole))
+}
+
+func TestPhonebookMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	relay := roleLabels("relay")
+	archival := roleLabels("archival")
+	added := phonebookEntriesAdded.GetUint64ValueForLabels(relay)
+	removed := phonebookEntriesRemoved.GetUint64ValueForLabels(relay)
+
+	ph := MakePhonebook(1, time.Hour).(*phonebookImpl)
+	ph.ReplacePeerList([]string{"a", "b", "c"}, "default", RelayRole)
+	ph.AddPersistentPeers([]string{"d"}, "default", ArchivalRole)
+	require.Equal(t, added+3, phonebookEntriesAdded.GetUint64ValueForLabels(relay))
+	require.Equal(t, uint64(3), phonebookEntries.GetUint64ValueForLabels(relay))
+	require.Equal(t, uint64(1), phonebookEntries.GetUint64ValueForLabels(archival))
+	require.Equal(t, uint64(0), phonebookPersistentEntries.GetUint64ValueForLabels(relay))
+	require.Equal(t, uint64(1), phonebookPersistentEntries.GetUint64ValueForLabels(archival))
+
+	// a shrinking refresh is visible in the removed counter
+	ph.ReplacePeerList([]string{"b", "d"}, "default", RelayRole)
+	require.Equal(t, added+4, phonebookEntriesAdded.GetUint64ValueForLabels(relay))
+	require.Equal(t, removed+2, phonebookEntriesRemoved.GetUint64ValueForLabels(relay))
+	require.Equal(t, uint64(2), phonebookEntries.GetUint64ValueForLabels(relay))
+
+	limited := phonebookRateLimited.GetUint64Value()
+	_, waitTime, _ := ph.GetConnectionWaitTime("b")
+	require.Zero(t, waitTime)
+	_, waitTime, _ = ph.GetConnectionWaitTime("b")
+	require.NotZero(t, waitTime)
+	require.Equal(t, limited+1, phonebookRateLimited.GetUint64Value())
+}
+
+func TestSubnetRateLimitKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l := SubnetRateLimit{IPv4Prefix: 24, IPv6Prefix: 48}
+	require.Equal(t, "10.1.2.0/24", l.subnetKey("10.1.2.3:4160"))
+	require.Equal(t, "10.1.2.0/24", l.subnetKey("ws://10.1.2.200:4160/"))
+	require.Equal(t, "2001:db8:1::/48", l.subnetKey("[2001:db8:1:2::1]:4160"))
+	require.Empty(t, l.subnetKey("relay.algorand.network:4160"))
+	require.Empty(t, l.subnetKey(""))
+}
+
+func TestSubnetRateLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	subnet := SubnetRateLimit{Count: 2, Window: time.Hour, IPv4Prefix: 24, IPv6Prefix: 48}
+	pb := MakePhonebookWithSubnetRateLimit(10, time.Hour, subnet).(*phonebookImpl)
+	pb.ReplacePeerList([]string{"10.1.2.1:4160", "10.1.2.2:4160", "10.1.2.3:4160", "10.1.3.1:4160"}, "default", RelayRole)
+
+	_, waitTime, provisionalTime := pb.GetConnectionWaitTime("10.1.2.1:4160")
+	require.Zero(t, waitTime)
+	require.True(t, pb.UpdateConnectionTime("10.1.2.1:4160", provisionalTime))
+	_, waitTime, _ = pb.GetConnectionWaitTime("10.1.2.2:4160")
+	require.Zero(t, waitTime)
+
+	// the third address of the subnet has to wait, even though it was never connected to
+	_, waitTime, _ = pb.GetConnectionWaitTime("10.1.2.3:4160")
+	require.NotZero(t, waitTime)
+	require.LessOrEqual(t, waitTime, time.Hour)
+	require.Empty(t, pb.data["10.1.2.3:4160"].recentConnectionTimes)
+
+	// other subnets are unaffected
+	_, waitTime, _ = pb.GetConnectionWaitTime("10.1.3.1:4160")
+	require.Zero(t, waitTime)
+
+	// the limit applies to the subnet window
+	for s, times := range pb.subnetConnectionTimes {
+		for i := range times {
+			times[i] = times[i].Add(-time.Hour)
+		}
+		pb.subnetConnectionTimes[s] = times
+	}
+	_, waitTime, _ = pb.GetConnectionWaitTime("10.1.2.3:4160")
+	require.Zero(t, waitTime)
+}
+
+func TestPhonebookEntries(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pb := MakePhonebook(1, time.Minute).(*phonebookImpl)
+	pb.ReplacePeerList([]string{"b:4160", "a:4160"}, "default", RelayRole)
+	pb.ReplacePeerList([]string{"a:4160"}, "pex", RelayRole)
+	pb.AddPersistentPeers([]string{"c:4160"}, "admin", RelayRole|ArchivalRole)
+	pb.Ban("b:4160", time.Hour)
+	_, waitTime, _ := pb.GetConnectionWaitTime("a:4160")
+	require.Zero(t, waitTime)
+
+	entries := pb.Entries()
+	require.Len(t, entries, 3)
+	require.Equal(t, "a:4160", entries[0].Address)
+	require.Equal(t, RelayRole, entries[0].Roles)
+	require.Zero(t, entries[0].PersistentRoles)
+	require.Equal(t, []string{"default", "pex"}, entries[0].Origins)
+	require.Len(t, entries[0].RecentConnectionTimes, 1)
+	require.True(t, entries[0].BannedUntil.IsZero())
+	require.False(t, entries[1].BannedUntil.IsZero())
+	require.Equal(t, RelayRole|ArchivalRole, entries[2].PersistentRoles)
+	require.Equal(t, []string{"relay", "archival"}, RoleNames(entries[2].Roles))
+
+	// the snapshot is not affected by later changes
+	entries[0].RecentConnectionTimes[0] = time.Time{}
+	require.False(t, pb.Entries()[0].RecentConnectionTimes[0].IsZero())
+
+	require.True(t, pb.RemovePeer("c:4160"))
+	require.False(t, pb.RemovePeer("c:4160"))
+	require.Len(t, pb.Entries(), 2)
+
+	for _, name := range []string{"relay", "archival", "blockservice", "txgossip"} {
+		role, err := ParseRole(name)
+		require.NoError(t, err)
+		require.Equal(t, []string{name}, RoleNames(role))
+	}
+	_, err := ParseRole("bogus")
+	require.Error(t, err)
+}
+
+func TestPhonebookBackoff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	policy := BackoffPolicy{Base: time.Second, Max: 10 * time.Second, Decay: time.Minute}
+	require.Equal(t, time.Second, policy.delay(1))
+	require.Equal(t, 2*time.Second, policy.delay(2))
+	require.Equal(t, 8*time.Second, policy.delay(4))
+	require.Equal(t, 10*time.Second, policy.delay(5))
+	require.Equal(t, 10*time.Second, policy.delay(100))
+
+	pb := MakePhonebook(1, time.Millisecond).(*phonebookImpl)
+	pb.ReplacePeerList([]string{"a:4160", "b:4160"}, "default", RelayRole)
+
+	// without a policy, failures are only counted by the metric
+	failures := phonebookDialFailures.GetUint64Value()
+	now := time.Now()
+	pb.MarkFailed("a:4160", now)
+	require.Len(t, pb.GetAddresses(10, RelayRole), 2)
+	require.Equal(t, failures+1, phonebookDialFailures.GetUint64Value())
+
+	pb.SetBackoffPolicy(policy)
+	for i := 0; i < 3; i++ {
+		pb.MarkFailed("a:4160", now)
+	}
+	require.Equal(t, 3, pb.data["a:4160"].failures)
+	require.Equal(t, now.Add(4*time.Second), pb.data["a:4160"].backoffUntil)
+	require.Equal(t, []string{"b:4160"}, pb.GetAddresses(10, RelayRole))
+	entries := pb.Entries()
+	require.Equal(t, 3, entries[0].Failures)
+	require.Equal(t, now.Add(4*time.Second), entries[0].BackoffUntil)
+
+	// failures decay over time
+	later := now.Add(2*time.Minute + time.Second)
+	pb.MarkFailed("a:4160", later)
+	require.Equal(t, 2, pb.data["a:4160"].failures)
+	require.Equal(t, later.Add(2*time.Second), pb.data["a:4160"].backoffUntil)
+
+	// a successful connection resets the backoff
+	pb.MarkConnected("a:4160", time.Now())
+	require.Zero(t, pb.data["a:4160"].failures)
+	require.Len(t, pb.GetAddresses(10, RelayRole), 2)
+
+	// a Max lower than Base disables the backoff
+	pb.SetBackoffPolicy(BackoffPolicy{Base: time.Second})
+	pb.MarkFailed("b:4160", time.Now())
+	require.Len(t, pb.GetAddresses(10, RelayRole), 2)
+}
+
+func TestPhonebookNetworkAddresses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pb := MakePhonebook(1, time.Minute)
+	addresses, ok := pb.(PeerAddresses)
+	require.True(t, ok)
+
+	pb.ReplacePeerList([]string{"a:4160"}, "default", RelayRole)
+	netAddrs := []string{"[fd00::1]:4160", "10.0.0.1:4160"}
+	addresses.SetNetworkAddresses("a:4160", netAddrs)
+	addresses.SetNetworkAddresses("b:4160", netAddrs)
+	require.Equal(t, netAddrs, addresses.NetworkAddresses("a:4160"))
+	require.Empty(t, addresses.NetworkAddresses("b:4160"))
+
+	// the addresses are kept when the peer list is refreshed
+	pb.ReplacePeerList([]string{"a:4160"}, "default", RelayRole)
+	entries := pb.(*phonebookImpl).Entries()
+	require.Len(t, entries, 1)
+	require.Equal(t, netAddrs, entries[0].NetworkAddresses)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-algorand/network/addr"
+)
+
+// SubnetRateLimit configures the connection rate limiting of all the
+// phonebook addresses which share a subnet.
+type SubnetRateLimit struct {
+	// Count is the number of connections allowed per subnet in Window.
+	// A zero Count disables the subnet rate limiting.
+	Count  uint
+	Window time.Duration
+
+	// IPv4Prefix and IPv6Prefix are the CIDR prefix lengths of the subnets.
+	IPv4Prefix int
+	IPv6Prefix int
+}
+
+// enabled reports whether the subnet rate limiting is in effect.
+func (l SubnetRateLimit) enabled() bool {
+	return l.Count > 0 && l.Window > 0
+}
+
+// subnetKey returns the subnet, in CIDR notation, which the host of address
+// belongs to, or the empty string if the host is not an IP address.
+func (l SubnetRateLimit) subnetKey(address string) string {
+	u, err := addr.ParseHostOrURL(address)
+	if err != nil {
+		return ""
+	}
+	ip := net.ParseIP(u.Hostname())
+	if ip == nil {
+		return ""
+	}
+	prefix, bits := l.IPv6Prefix, 8*net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, prefix, bits = ip4, l.IPv4Prefix, 8*net.IPv4len
+	}
+	prefix = max(0, min(prefix, bits))
+	return ip.Mask(net.CIDRMask(prefix, bits)).String() + "/" + strconv.Itoa(prefix)
+}
+
+// subnetWaitTime expires the old connection times of subnet, and returns how
+// long to wait before connecting to it if the subnet limit was reached.
+// The caller must hold e.lock.
+func (e *phonebookImpl) subnetWaitTime(subnet string, now time.Time) time.Duration {
+	times := e.subnetConnectionTimes[subnet]
+	n := 0
+	for n < len(times) && now.Sub(times[n]) >= e.subnetRateLimit.Window {
+		n++
+	}
+	times = times[n:]
+	if len(times) == 0 {
+		delete(e.subnetConnectionTimes, subnet)
+		return 0
+	}
+	e.subnetConnectionTimes[subnet] = times
+	if uint(len(times)) >= e.subnetRateLimit.Count {
+		return e.subnetRateLimit.Window - now.Sub(times[0])
+	}
+	return 0
+}
+
+// updateSubnetTime replaces the provisional connection time of subnet with
+// the current time, or records it anew if it has already expired.
+// The caller must hold e.lock.
+func (e *phonebookImpl) updateSubnetTime(subnet string, provisionalTime time.Time) {
+	times := e.subnetConnectionTimes[subnet]
+	for i, t := range times {
+		if t == provisionalTime {
+			times[i] = e.now()
+			return
+		}
+	}
+	e.subnetConnectionTimes[subnet] = append(times, e.now())
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/libp2p/go-nat"
+
+	"github.com/algorand/go-algorand/logging"
+)
+
+// portMappingLifetime is the lifetime requested for a port mapping. The mapping
+// is renewed halfway through its lifetime.
+const portMappingLifetime = time.Hour
+
+// portMappingRetryInterval is how long the portMapper waits before looking for
+// a gateway again after it failed to find one or to map the port.
+const portMappingRetryInterval = 5 * time.Minute
+
+// portMappingDiscoveryTimeout bounds the NAT-PMP and UPnP gateway discovery.
+const portMappingDiscoveryTimeout = 10 * time.Second
+
+const portMappingDescription = "algod"
+
+// natGateway is the part of a NAT-PMP or UPnP gateway used by the portMapper.
+type natGateway interface {
+	Type() string
+	GetExternalAddress() (net.IP, error)
+	AddPortMapping(ctx context.Context, protocol string, internalPort int, description string, timeout time.Duration) (int, error)
+	DeletePortMapping(ctx context.Context, protocol string, internalPort int) error
+}
+
+// portMapper maps the gossip port of a node run behind a home router on the
+// router itself, using NAT-PMP or UPnP, so that the node can accept incoming
+// connections. The mapping is renewed until the portMapper is stopped, and
+// then removed.
+type portMapper struct {
+	log  logging.Logger
+	port int
+	// discover looks for the local gateway
+	discover func(ctx context.Context) (natGateway, error)
+
+	mu           deadlock.RWMutex
+	externalAddr string
+}
+
+func makePortMapper(log logging.Logger, port int) *portMapper {
+	return &portMapper{
+		log:  log,
+		port: port,
+		discover: func(ctx context.Context) (natGateway, error) {
+			return nat.DiscoverGateway(ctx)
+		},
+	}
+}
+
+// ExternalAddress returns the host:port at which the gateway forwards
+// connections to this node, if the port is currently mapped.
+func (pm *portMapper) ExternalAddress() (string, bool) {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+	return pm.externalAddr, pm.externalAddr != ""
+}
+
+func (pm *portMapper) setExternalAddress(addr string) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	pm.externalAddr = addr
+}
+
+// start maps the port and keeps the mapping alive until ctx is done.
+func (pm *portMapper) start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go pm.mappingThread(ctx, wg)
+}
+
+func (pm *portMapper) mappingThread(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	var gw natGateway
+	for {
+		wait := portMappingRetryInterval
+		if gw == nil {
+			discoverCtx, cancel := context.WithTimeout(ctx, portMappingDiscoveryTimeout)
+			var err error
+			gw, err = pm.discover(discoverCtx)
+			cancel()
+			if err != nil {
+				pm.log.Infof("port mapping: could not find a NAT-PMP or UPnP gateway: %v", err)
+				gw = nil
+			}
+		}
+		if gw != nil {
+			if err := pm.mapPort(ctx, gw); err != nil {
+				pm.log.Warnf("port mapping: could not map port %d on the %s gateway: %v", pm.port, gw.Type(), err)
+				pm.setExternalAddress("")
+				// the gateway may have changed; look for it again on the next attempt
+				gw = nil
+			} else {
+				wait = portMappingLifetime / 2
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if gw != nil {
+				pm.unmapPort(gw)
+			}
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// mapPort creates or renews the mapping of the port on the gateway.
+func (pm *portMapper) mapPort(ctx context.Context, gw natGateway) error {
+	externalPort, err := gw.AddPortMapping(ctx, "tcp", pm.port, portMappingDescription, portMappingLifetime)
+	if err != nil {
+		return err
+	}
+	externalIP, err := gw.GetExternalAddress()
+	if err != nil {
+		return err
+	}
+	addr := net.JoinHostPort(externalIP.String(), strconv.Itoa(externalPort))
+	if prev, _ := pm.ExternalAddress(); prev != addr {
+		pm.log.Infof("port mapping: the %s gateway forwards %s to port %d", gw.Type(), addr, pm.port)
+	}
+	pm.setExternalAddress(addr)
+	return nil
+}
+
+func (pm *portMapper) unmapPort(gw natGateway) {
+	pm.setExternalAddress("")
+	ctx, cancel := context.WithTimeout(context.Background(), portMappingDiscoveryTimeout)
+	defer cancel()
+	if err := gw.DeletePortMapping(ctx, "tcp", pm.port); err != nil {
+		pm.log.Infof("port mapping: could not remove the mapping of port %d: %v", pm.port, err)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type testNATGateway struct {
+	mu       deadlock.Mutex
+	mapped   map[int]time.Duration
+	deleted  []int
+	failNext bool
+}
+
+func (gw *testNATGateway) Type() string { return "test" }
+
+func (gw *testNATGateway) GetExternalAddress() (net.IP, error) {
+	return net.IPv4(203, 0, 113, 7), nil
+}
+
+func (gw *testNATGateway) AddPortMapping(ctx context.Context, protocol string, internalPort int, description string, timeout time.Duration) (int, error) {
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+	if gw.failNext {
+		gw.failNext = false
+		return 0, errors.New("mapping refused")
+	}
+	gw.mapped[internalPort] = timeout
+	return internalPort + 1000, nil
+}
+
+func (gw *testNATGateway) DeletePortMapping(ctx context.Context, protocol string, internalPort int) error {
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+	delete(gw.mapped, internalPort)
+	gw.deleted = append(gw.deleted, internalPort)
+	return nil
+}
+
+func TestPortMapper(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	gw := &testNATGateway{mapped: make(map[int]time.Duration)}
+	pm := makePortMapper(logging.TestingLog(t), 4160)
+	pm.discover = func(context.Context) (natGateway, error) { return gw, nil }
+
+	_, ok := pm.ExternalAddress()
+	require.False(t, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	pm.start(ctx, &wg)
+
+	require.Eventually(t, func() bool {
+		_, ok := pm.ExternalAddress()
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+	addr, _ := pm.ExternalAddress()
+	require.Equal(t, "203.0.113.7:5160", addr)
+	gw.mu.Lock()
+	require.Equal(t, portMappingLifetime, gw.mapped[4160])
+	gw.mu.Unlock()
+
+	// stopping removes the mapping
+	cancel()
+	wg.Wait()
+	_, ok = pm.ExternalAddress()
+	require.False(t, ok)
+	require.Empty(t, gw.mapped)
+	require.Equal(t, []int{4160}, gw.deleted)
+}
+
+func TestPortMapperFailures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	gw := &testNATGateway{mapped: make(map[int]time.Duration), failNext: true}
+	pm := makePortMapper(logging.TestingLog(t), 4160)
+
+	// a failed mapping leaves no external address
+	require.Error(t, pm.mapPort(context.Background(), gw))
+	_, ok := pm.ExternalAddress()
+	require.False(t, ok)
+
+	require.NoError(t, pm.mapPort(context.Background(), gw))
+	addr, ok := pm.ExternalAddress()
+	require.True(t, ok)
+	require.Equal(t, "203.0.113.7:5160", addr)
+
+	// no gateway: nothing is mapped until the node stops
+	pm = makePortMapper(logging.TestingLog(t), 4160)
+	pm.discover = func(context.Context) (natGateway, error) { return nil, errors.New("no gateway") }
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	pm.start(ctx, &wg)
+	cancel()
+	wg.Wait()
+	_, ok = pm.ExternalAddress()
+	require.False(t, ok)
+}
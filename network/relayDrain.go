@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// relayDrain.go implements the draining of a relay before it is shut down.
+// A draining relay rejects new incoming connections, and sends a RelayGoodbyeTag message to its
+// incoming peers. A peer receiving the message from a relay it has connected to disconnects from
+// it, and replaces the connection with one to another relay from its phonebook. The draining relay
+// is left out of the peer's phonebook selection until the RetryAfter of the message has passed.
+// Once all the incoming peers have left, or RelayDrainTimeout has passed, the relay is safe to shut down.
+
+// relayGoodbyeRetryAfter is the time peers are asked to stay away from a draining relay.
+const relayGoodbyeRetryAfter = 10 * time.Minute
+
+// maxRelayGoodbyeRetryAfter bounds the time a relay could ask its peers to stay away.
+const maxRelayGoodbyeRetryAfter = time.Hour
+
+var networkRelayGoodbyeSent = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_relay_goodbye_sent_total", Description: "Number of goodbye messages sent to the incoming peers of a draining relay"})
+var networkRelayGoodbyeReceived = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_relay_goodbye_received_total", Description: "Number of goodbye messages received from draining relays"})
+
+// RelayDrainStatus describes the draining of a relay.
+type RelayDrainStatus struct {
+	Draining bool
+	// Since is the time the draining started, or zero if the relay is not draining.
+	Since time.Time
+	// IncomingPeers is the number of incoming peers still connected.
+	IncomingPeers int
+	// SafeToShutdown is set once all the incoming peers left, or the drain timeout has passed.
+	SafeToShutdown bool
+}
+
+// RelayDrainer is implemented by networks which can drain their incoming connections.
+type RelayDrainer interface {
+	// StartDrain stops accepting incoming connections, and asks the incoming peers to move to other relays.
+	StartDrain()
+	// StopDrain accepts incoming connections again.
+	StopDrain()
+	// DrainStatus returns the progress of the draining.
+	DrainStatus() RelayDrainStatus
+}
+
+// relayGoodbyeMessage is sent by a draining relay to its incoming peers.
+type relayGoodbyeMessage struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// RetryAfter is the number of seconds the peer should not reconnect to the relay for.
+	RetryAfter uint64 `codec:"ra"`
+}
+
+// RelayGoodbyeMessageMaxSize returns the maximum size of an encoded relayGoodbyeMessage.
+func RelayGoodbyeMessageMaxSize() int {
+	// fixmap header, the key, and a uint64
+	return 1 + 3 + 9
+}
+
+// StartDrain implements RelayDrainer.
+func (wn *WebsocketNetwork) StartDrain() {
+	if !wn.drainingSince.CompareAndSwap(0, time.Now().UnixNano()) {
+		return
+	}
+	wn.log.Infof("relay is draining, asking %d incoming peers to move to other relays", wn.numIncomingPeers())
+
+	msg := protocol.EncodeReflect(&relayGoodbyeMessage{RetryAfter: uint64(relayGoodbyeRetryAfter / time.Second)})
+	wn.peersLock.RLock()
+	var incoming []*wsPeer
+	for _, peer := range wn.peers {
+		if !peer.outgoing {
+			incoming = append(incoming, peer)
+		}
+	}
+	wn.peersLock.RUnlock()
+	for _, peer := range incoming {
+		if err := peer.Unicast(context.Background(), msg, protocol.RelayGoodbyeTag); err != nil {
+			wn.log.Debugf("could not send goodbye message to %s: %v", peer.GetAddress(), err)
+			continue
+		}
+		networkRelayGoodbyeSent.Inc(nil)
+	}
+}
+
+// StopDrain implements RelayDrainer.
+func (wn *WebsocketNetwork) StopDrain() {
+	if wn.drainingSince.Swap(0) != 0 {
+		wn.log.Info("relay stopped draining")
+	}
+}
+
+// DrainStatus implements RelayDrainer.
+func (wn *WebsocketNetwork) DrainStatus() RelayDrainStatus {
+	since := wn.drainingSince.Load()
+	status := RelayDrainStatus{IncomingPeers: wn.numIncomingPeers()}
+	if since == 0 {
+		return status
+	}
+	status.Draining = true
+	status.Since = time.Unix(0, since)
+	status.SafeToShutdown = status.IncomingPeers == 0 || time.Since(status.Since) >= wn.config.RelayDrainTimeout
+	return status
+}
+
+// checkDraining rejects an incoming connection while the relay is draining.
+func (wn *WebsocketNetwork) checkDraining(response http.ResponseWriter) int {
+	if wn.drainingSince.Load() == 0 {
+		return http.StatusOK
+	}
+	networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "relay_draining"})
+	response.Header().Set(TooManyRequestsRetryAfterHeader, strconv.Itoa(int(relayGoodbyeRetryAfter/time.Second)))
+	response.WriteHeader(http.StatusServiceUnavailable)
+	return http.StatusServiceUnavailable
+}
+
+// relayGoodbyeHandler moves off a relay which is draining: the relay is disconnected, and left out of the
+// phonebook selection for the RetryAfter of the message, so the mesh thread replaces it with another relay.
+func relayGoodbyeHandler(message IncomingMessage) OutgoingMessage {
+	wn := message.Net.(*WebsocketNetwork)
+	peer := message.Sender.(*wsPeer)
+	// only the relays we connected to can ask us to move
+	if !peer.outgoing || len(message.Data) > RelayGoodbyeMessageMaxSize() {
+		return OutgoingMessage{}
+	}
+	var msg relayGoodbyeMessage
+	if err := protocol.DecodeReflect(message.Data, &msg); err != nil {
+		return OutgoingMessage{Action: Disconnect, reason: disconnectBadData}
+	}
+	networkRelayGoodbyeReceived.Inc(nil)
+
+	retryAfter := min(time.Duration(msg.RetryAfter)*time.Second, maxRelayGoodbyeRetryAfter)
+	wn.phonebook.UpdateRetryAfter(peer.GetAddress(), time.Now().Add(retryAfter))
+	wn.log.Infof("relay %s is draining, moving to another relay", peer.GetAddress())
+
+	wn.wg.Add(1)
+	go func() {
+		defer wn.wg.Done()
+		wn.disconnect(peer, disconnectRelayDraining)
+		wn.requestMeshUpdate()
+	}()
+	return OutgoingMessage{}
+}
+
+var relayGoodbyeHandlers = []TaggedMessageHandler{
+	{protocol.RelayGoodbyeTag, HandlerFunc(relayGoodbyeHandler)},
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRelayGoodbyeMessageMaxSize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	msg := relayGoodbyeMessage{RetryAfter: math.MaxUint64}
+	require.Equal(t, RelayGoodbyeMessageMaxSize(), len(protocol.EncodeReflect(&msg)))
+}
+
+func TestRelayDrainRejectsConnections(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	wn := makeTestWebsocketNode(t)
+	rec := httptest.NewRecorder()
+	require.Equal(t, http.StatusOK, wn.checkDraining(rec))
+
+	wn.StartDrain()
+	status := wn.DrainStatus()
+	require.True(t, status.Draining)
+	require.False(t, status.Since.IsZero())
+	require.True(t, status.SafeToShutdown)
+
+	rec = httptest.NewRecorder()
+	require.Equal(t, http.StatusServiceUnavailable, wn.checkDraining(rec))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "600", rec.Header().Get(TooManyRequestsRetryAfterHeader))
+
+	wn.StopDrain()
+	require.Equal(t, RelayDrainStatus{}, wn.DrainStatus())
+	require.Equal(t, http.StatusOK, wn.checkDraining(httptest.NewRecorder()))
+}
+
+// TestRelayDrainMovesPeers checks that the peers of a draining relay disconnect from it, and don't
+// connect to it again.
+func TestRelayDrainMovesPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA, netB, _, closeFunc := setupWebsocketNetworkAB(t, 0)
+	defer closeFunc()
+	addrA, _ := netA.Address()
+	require.Equal(t, 1, netA.DrainStatus().IncomingPeers)
+
+	netA.StartDrain()
+	require.Eventually(t, func() bool {
+		return netB.NumPeers() == 0 && netA.DrainStatus().SafeToShutdown
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the relay is left out of the phonebook selection
+	require.Empty(t, netB.phonebook.GetAddresses(10, phonebook.RelayRole))
+	entries := netB.phonebook.Entries()
+	require.Len(t, entries, 1)
+	require.Equal(t, addrA, entries[0].Address)
+	require.True(t, entries[0].RetryAfter.After(time.Now().Add(relayGoodbyeRetryAfter-time.Minute)))
+}
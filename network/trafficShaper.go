@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// tokenBucket limits a flow of bytes to rate bytes per second, with bursts of
+// up to one second of traffic. A message larger than the available tokens is
+// let through by going into debt, which delays the following messages, so
+// that messages larger than the burst can be sent at all.
+type tokenBucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func makeTokenBucket(bytesPerSecond uint64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: float64(bytesPerSecond), tokens: float64(bytesPerSecond), last: now}
+}
+
+// take removes n bytes worth of tokens from the bucket, and returns how long
+// to wait before sending them.
+func (b *tokenBucket) take(now time.Time, n int) time.Duration {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(b.rate, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// trafficShaper caps the outgoing bandwidth of an incoming connection as a
+// whole, and for each tag with a configured limit. It is only used by the
+// write loop of its peer, so it needs no locking.
+type trafficShaper struct {
+	peer *tokenBucket
+	tags map[protocol.Tag]*tokenBucket
+}
+
+// makeTrafficShaper creates the trafficShaper configured by
+// PeerOutgoingBytesPerSecond and PeerOutgoingTagBytesPerSecond, or returns nil
+// if neither limits the traffic.
+func makeTrafficShaper(cfg config.Local, now time.Time) *trafficShaper {
+	s := &trafficShaper{}
+	if cfg.PeerOutgoingBytesPerSecond > 0 {
+		s.peer = makeTokenBucket(cfg.PeerOutgoingBytesPerSecond, now)
+	}
+	for tag, limit := range cfg.PeerOutgoingTagBytesPerSecond {
+		if _, ok := protocol.TagMap[protocol.Tag(tag)]; !ok || limit == 0 {
+			continue
+		}
+		if s.tags == nil {
+			s.tags = make(map[protocol.Tag]*tokenBucket)
+		}
+		s.tags[protocol.Tag(tag)] = makeTokenBucket(limit, now)
+	}
+	if s.peer == nil && s.tags == nil {
+		return nil
+	}
+	return s
+}
+
+// delay accounts for a message of n bytes with the given tag, and returns how
+// long to wait before sending it.
+func (s *trafficShaper) delay(now time.Time, tag protocol.Tag, n int) time.Duration {
+	var d time.Duration
+	if s.peer != nil {
+		d = s.peer.take(now, n)
+	}
+	if b := s.tags[tag]; b != nil {
+		d = max(d, b.take(now, n))
+	}
+	return d
+}
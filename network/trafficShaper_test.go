@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTokenBucket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Now()
+	b := makeTokenBucket(1000, now)
+	// a full second of burst
+	require.Zero(t, b.take(now, 600))
+	require.Zero(t, b.take(now, 400))
+	// going into debt
+	require.Equal(t, 500*time.Millisecond, b.take(now, 500))
+	// the debt is repaid over time
+	now = now.Add(500 * time.Millisecond)
+	require.Zero(t, b.take(now, 0))
+	require.Equal(t, 2*time.Second, b.take(now, 2000))
+	// the bucket doesn't fill over the burst
+	now = now.Add(time.Hour)
+	require.Equal(t, time.Second, b.take(now, 2000))
+}
+
+func TestTrafficShaper(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	require.Nil(t, makeTrafficShaper(cfg, time.Now()))
+	cfg.PeerOutgoingTagBytesPerSecond = map[string]uint64{"zz": 10, "AV": 0}
+	require.Nil(t, makeTrafficShaper(cfg, time.Now()))
+
+	now := time.Now()
+	cfg.PeerOutgoingBytesPerSecond = 1000
+	cfg.PeerOutgoingTagBytesPerSecond = map[string]uint64{string(protocol.TopicMsgRespTag): 100}
+	s := makeTrafficShaper(cfg, now)
+	require.NotNil(t, s)
+
+	require.Zero(t, s.delay(now, protocol.TopicMsgRespTag, 100))
+	// the tag cap is reached before the peer cap
+	require.Equal(t, time.Second, s.delay(now, protocol.TopicMsgRespTag, 100))
+	require.Zero(t, s.delay(now, protocol.AgreementVoteTag, 700))
+	// the peer cap applies to all the tags
+	require.Equal(t, 100*time.Millisecond, s.delay(now, protocol.AgreementVoteTag, 200))
+}
@@ -175,6 +175,22 @@ type WebsocketNetwork struct {
 	handler     msgHandler
 
 	phonebook phonebook.Phonebook
+	// peerCachePath is the file the learned phonebook peers are saved to,
+	// or empty if they are not saved.
+	peerCachePath string
+
+	// dnsRefresher keeps the addresses of the DNS bootstrap records in the phonebook up to date.
+	dnsRefresher *phonebook.DNSRefresher
+
+	// peerTagger looks up the autonomous systems of the relays to diversify the outgoing connections;
+	// it is nil unless a PeerASNDatabaseFile is configured.
+	peerTagger phonebook.Tagger
+
+	// peerExchange merges the peer samples received from relays; it is nil unless peer exchange is enabled.
+	peerExchange *peerExchangeTracker
+
+	// drainingSince is the time in nanoseconds since the epoch when the relay started draining, or zero.
+	drainingSince atomic.Int64
 
 	genesisID string
 	NetworkID protocol.NetworkID
@@ -205,6 +221,8 @@ type WebsocketNetwork struct {
 	// identity challenge scheme for creating challenges and responding
 	identityScheme  identityChallengeScheme
 	identityTracker identityTracker
+	// identityKeysDir is the directory where the identity keys are persisted, if PersistNetIdentityKeys is set
+	identityKeysDir string
 
 	// outgoingMessagesBufferSize is the size used for outgoing messages.
 	outgoingMessagesBufferSize int
@@ -235,6 +253,19 @@ type WebsocketNetwork struct {
 	// connection in compliance with connectionsRateLimitingCount.
 	dialer limitcaller.Dialer
 
+	// proxyDialer is set when the outgoing connections are made through config.OutgoingProxy.
+	proxyDialer tools_network.ContextDialer
+
+	// portMapper maintains the mapping of the listening port on the NAT gateway when config.EnablePortMapping is set.
+	portMapper *portMapper
+
+	// persistentPeers keeps the connections to the persistent peers when config.EnablePersistentPeerReconnect is set.
+	persistentPeers *persistentPeerConnector
+
+	// connectionBudgets are the targets of outgoing connections per role from config.OutgoingConnectionBudgets.
+	// The outgoing connections are made to meet config.GossipFanout when there are none.
+	connectionBudgets []roleBudget
+
 	// messagesOfInterest specifies the message types that this node
 	// wants to receive.  nil means default.  non-nil causes this
 	// map to be sent to new peers as a MsgOfInterest message type.
@@ -304,6 +335,8 @@ type msgBroadcaster struct {
 	slowWritingPeerMonitorInterval time.Duration
 	// enableVoteCompression controls whether vote compression is enabled
 	enableVoteCompression bool
+	// enableMessageCompression controls whether large messages are zstd compressed for the supporting peers
+	enableMessageCompression bool
 }
 
 // msgHandler contains the logic for handling incoming messages and managing a readBuffer. It provides
@@ -350,11 +383,16 @@ func (wn *WebsocketNetwork) Address() (string, bool) {
 
 // PublicAddress what we tell other nodes to connect to.
 // Might be different than our locally perceived network address due to NAT/etc.
-// Returns config "PublicAddress" if available, otherwise local addr.
+// Returns config "PublicAddress" if available, then the address mapped on the NAT gateway, otherwise local addr.
 func (wn *WebsocketNetwork) PublicAddress() string {
 	if len(wn.config.PublicAddress) > 0 {
 		return wn.config.PublicAddress
 	}
+	if wn.portMapper != nil {
+		if externalAddr, ok := wn.portMapper.ExternalAddress(); ok {
+			return externalAddr
+		}
+	}
 	localAddr, _ := wn.Address()
 	return localAddr
 }
@@ -520,7 +558,7 @@ func (wn *WebsocketNetwork) GetPeers(options ...PeerOption) []Peer {
 			}
 		case PeersPhonebookArchivalNodes:
 			var addrs []string
-			addrs = wn.phonebook.GetAddresses(1000, phonebook.ArchivalRole)
+			addrs = wn.phonebook.GetAddresses(1000, phonebook.ArchivalRole|phonebook.BlockServiceRole)
 			for _, addr := range addrs {
 				client, _ := wn.GetHTTPClient(addr)
 				peerCore := makePeerCore(wn.ctx, wn, wn.log, wn.handler.readBuffer, addr, client, "" /*origin address*/)
@@ -547,7 +585,25 @@ func (wn *WebsocketNetwork) setup() {
 	if wn.nodeInfo == nil {
 		wn.nodeInfo = &nopeNodeInfo{}
 	}
-	wn.dialer = limitcaller.MakeRateLimitingDialer(wn.phonebook, preferredResolver)
+	if wn.proxyDialer != nil {
+		// the proxy resolves the relay addresses, so the DNSSEC-aware resolver is not used here
+		wn.dialer = limitcaller.MakeRateLimitingProxyDialer(wn.phonebook, wn.proxyDialer)
+	} else {
+		wn.dialer = limitcaller.MakeRateLimitingDialerWithPolicy(wn.phonebook, preferredResolver, limitcaller.DialPolicy{
+			PreferIPv6:    wn.config.PreferIPv6,
+			FallbackDelay: wn.config.DualStackFallbackDelay,
+		})
+	}
+	setDropTelemetrySampling(wn.config.GossipDropTelemetrySampling)
+	wn.dialer.SetPacer(limitcaller.MakeDialPacer(wn.config.MaxOutgoingDialsInFlight, wn.config.OutgoingDialInterval))
+	if budgets, err := parseConnectionBudgets(wn.config.OutgoingConnectionBudgets); err != nil {
+		wn.log.Warnf("ignoring OutgoingConnectionBudgets: %v", err)
+	} else {
+		wn.connectionBudgets = budgets
+	}
+	if wn.config.EnablePersistentPeerReconnect {
+		wn.persistentPeers = makePersistentPeerConnector(wn.log, wn, wn.config.PersistentPeerBackoffMax, wn.config.PersistentPeerHealthTimeout)
+	}
 
 	wn.upgrader.ReadBufferSize = 4096
 	wn.upgrader.WriteBufferSize = 4096
@@ -579,17 +635,32 @@ func (wn *WebsocketNetwork) setup() {
 	wn.wsMaxHeaderBytes = wsMaxHeaderBytes
 
 	wn.broadcaster = msgBroadcaster{
-		ctx:                    wn.ctx,
-		log:                    wn.log,
-		config:                 wn.config,
-		broadcastQueueHighPrio: make(chan broadcastRequest, wn.outgoingMessagesBufferSize),
-		broadcastQueueBulk:     make(chan broadcastRequest, 100),
-		enableVoteCompression:  wn.config.EnableVoteCompression,
+		ctx:                      wn.ctx,
+		log:                      wn.log,
+		config:                   wn.config,
+		broadcastQueueHighPrio:   make(chan broadcastRequest, wn.outgoingMessagesBufferSize),
+		broadcastQueueBulk:       make(chan broadcastRequest, 100),
+		enableVoteCompression:    wn.config.EnableVoteCompression,
+		enableMessageCompression: wn.config.EnableMessageCompression,
 	}
 	if wn.broadcaster.slowWritingPeerMonitorInterval == 0 {
 		wn.broadcaster.slowWritingPeerMonitorInterval = slowWritingPeerMonitorInterval
 	}
 	wn.meshUpdateRequests = make(chan meshRequest, 5)
+	wn.dnsRefresher = phonebook.MakeDNSRefresher(wn.phonebook, wn.log, phonebook.DNSRefresherConfig{
+		Interval:           wn.config.DNSBootstrapRefreshInterval,
+		Jitter:             wn.config.DNSBootstrapRefreshJitter,
+		Secure:             wn.config.DNSSecuritySRVEnforced(),
+		ShrinkAlertPercent: wn.config.DNSBootstrapShrinkAlertPercent,
+	}, wn.dnsOrigins, wn.requestMeshUpdate)
+	if wn.config.PeerASNDatabaseFile != "" {
+		db, err := phonebook.LoadASNDatabase(wn.config.PeerASNDatabaseFile)
+		if err != nil {
+			wn.log.Warnf("unable to load the peer ASN database, outgoing peers are not diversified: %v", err)
+		} else {
+			wn.peerTagger = db
+		}
+	}
 	wn.readyChan = make(chan struct{})
 	wn.tryConnectAddrs = make(map[string]int64)
 	wn.eventualReadyDelay = time.Minute
@@ -636,8 +707,21 @@ func (wn *WebsocketNetwork) setup() {
 	}
 }
 
+// SetPeerCache makes the network restore the peers it learned from the file
+// at path on Start, and save them to it on Stop.
+func (wn *WebsocketNetwork) SetPeerCache(path string) {
+	wn.peerCachePath = path
+}
+
 // Start makes network connections and threads
 func (wn *WebsocketNetwork) Start() error {
+	if cache, ok := wn.phonebook.(phonebook.PeerCache); ok && wn.peerCachePath != "" {
+		err := cache.LoadPeers(wn.peerCachePath)
+		if err != nil {
+			wn.log.Warnf("could not load peer cache: %v", err)
+		}
+	}
+
 	wn.messagesOfInterestMu.Lock()
 	defer wn.messagesOfInterestMu.Unlock()
 	wn.messagesOfInterestEncoded = true
@@ -658,6 +742,13 @@ func (wn *WebsocketNetwork) Start() error {
 		wn.listener = wn.requestsTracker.Listener(listener)
 		wn.log.Debugf("listening on %s", wn.listener.Addr().String())
 		wn.throttledOutgoingConnections.Store(int32(wn.config.GossipFanout / 2))
+		if wn.config.EnablePortMapping {
+			_, portStr, _ := net.SplitHostPort(wn.listener.Addr().String())
+			if port, err0 := strconv.Atoi(portStr); err0 == nil {
+				wn.portMapper = makePortMapper(wn.log, port)
+				wn.portMapper.start(wn.ctx, &wn.wg)
+			}
+		}
 	} else {
 		// on non-relay, all the outgoing connections are throttled.
 		wn.throttledOutgoingConnections.Store(int32(wn.config.GossipFanout))
@@ -686,19 +777,25 @@ func (wn *WebsocketNetwork) Start() error {
 		wn.RegisterHandlers(identityHandlers)
 	}
 	if wn.identityScheme == nil {
-		wn.identityScheme = NewIdentityChallengeScheme(NetIdentityDedupNames(wn.config.PublicAddress))
+		wn.identityScheme = NewIdentityChallengeScheme(wn.identitySchemeOptions()...)
 	}
 
 	wn.meshUpdateRequests <- meshRequest{false, nil}
 	if wn.prioScheme != nil {
 		wn.RegisterHandlers(prioHandlers)
 	}
+	if wn.config.EnablePeerExchange {
+		wn.peerExchange = makePeerExchangeTracker(wn.config.PeerExchangeMaxPeers, peerExchangeSenderTTL*wn.config.PeerExchangeInterval)
+		wn.RegisterHandlers(peerExchangeHandlers)
+	}
+	wn.RegisterHandlers(relayGoodbyeHandlers)
 	if wn.listener != nil {
 		wn.wg.Add(1)
 		go wn.httpdThread()
 	}
 	wn.wg.Add(1)
 	go wn.meshThread()
+	wn.dnsRefresher.Start(wn.ctx, &wn.wg)
 
 	// we shouldn't have any ticker here.. but in case we do - just stop it.
 	if wn.peersConnectivityCheckTicker != nil {
@@ -716,6 +813,27 @@ func (wn *WebsocketNetwork) Start() error {
 		wn.wg.Add(1)
 		go wn.prioWeightRefresh()
 	}
+	if wn.config.EnableMDNSDiscovery {
+		var port string
+		if wn.listener != nil {
+			_, port, _ = net.SplitHostPort(wn.listener.Addr().String())
+		}
+		d := makeMDNSDiscovery(wn.log, wn.genesisID, port, func(addrs []string) {
+			wn.phonebook.ReplacePeerList(addrs, mdnsNetworkName, phonebook.RelayRole)
+		})
+		if err := d.start(wn.ctx, &wn.wg); err != nil {
+			wn.log.Warnf("could not start mDNS discovery: %v", err)
+		}
+	}
+	if wn.persistentPeers != nil {
+		wn.persistentPeers.start(wn.ctx, &wn.wg)
+	}
+	if wn.config.EnablePeerExchange && wn.config.IsGossipServer() && wn.config.PeerExchangeInterval > 0 {
+		if signer := wn.peerExchangeSigner(); signer != nil {
+			wn.wg.Add(1)
+			go wn.peerExchangeThread(signer)
+		}
+	}
 
 	go wn.postMessagesOfInterestThread()
 
@@ -792,6 +910,13 @@ func (wn *WebsocketNetwork) Stop() {
 	wn.messagesOfInterestEncoded = false
 	wn.messagesOfInterestEnc = nil
 	wn.messagesOfInterest = nil
+
+	if cache, ok := wn.phonebook.(phonebook.PeerCache); ok && wn.peerCachePath != "" {
+		err := cache.SavePeers(wn.peerCachePath)
+		if err != nil {
+			wn.log.Warnf("could not save peer cache: %v", err)
+		}
+	}
 }
 
 // RegisterHandlers registers the set of given message handlers.
@@ -802,7 +927,7 @@ func (wn *WebsocketNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
 // ClearHandlers deregisters all the existing message handlers.
 func (wn *WebsocketNetwork) ClearHandlers() {
 	// exclude the internal handlers. These would get cleared out when Stop is called.
-	wn.handler.ClearHandlers([]Tag{protocol.NetPrioResponseTag})
+	wn.handler.ClearHandlers([]Tag{protocol.NetPrioResponseTag, protocol.PeerExchangeTag, protocol.RelayGoodbyeTag})
 }
 
 // RegisterValidatorHandlers registers the set of given message handlers.
@@ -870,6 +995,9 @@ func setHeaders(header http.Header, netProtoVer string, meta peerMetadataProvide
 	if meta.Config().EnableVoteCompression {
 		features = append(features, PeerFeatureVoteVpackCompression)
 	}
+	if meta.Config().EnableMessageCompression {
+		features = append(features, PeerFeatureMessageZstdCompression)
+	}
 	header.Set(PeerFeaturesHeader, strings.Join(features, ","))
 
 	if netProtoVer != "" {
@@ -1033,6 +1161,10 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		return
 	}
 
+	if wn.checkDraining(response) != http.StatusOK {
+		return
+	}
+
 	trackedRequest := wn.requestsTracker.GetTrackedRequest(request)
 
 	if wn.checkIncomingConnectionLimits(response, request, trackedRequest.remoteHost, trackedRequest.otherTelemetryGUID, trackedRequest.otherInstanceName) != http.StatusOK {
@@ -1060,6 +1192,10 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 
 	responseHeader := make(http.Header)
 	setHeaders(responseHeader, matchingVersion, wn)
+	subscribedTags := parseSubscribedTags(request.Header.Get(SubscribedTagsHeader))
+	if subscribedTags != nil {
+		responseHeader.Set(SubscribedTagsHeader, encodeSubscribedTags(subscribedTags))
+	}
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1093,19 +1229,21 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 
 	client, _ := wn.GetHTTPClient(trackedRequest.remoteAddress())
 	peer := &wsPeer{
-		wsPeerCore:            makePeerCore(wn.ctx, wn, wn.log, wn.handler.readBuffer, trackedRequest.remoteAddress(), client, trackedRequest.remoteHost),
-		conn:                  wsPeerWebsocketConnImpl{conn},
-		outgoing:              false,
-		InstanceName:          trackedRequest.otherInstanceName,
-		incomingMsgFilter:     wn.incomingMsgFilter,
-		prioChallenge:         challenge,
-		createTime:            trackedRequest.created,
-		version:               matchingVersion,
-		identity:              peerID,
-		identityChallenge:     peerIDChallenge,
-		identityVerified:      atomic.Uint32{},
-		features:              decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
-		enableVoteCompression: wn.config.EnableVoteCompression,
+		wsPeerCore:               makePeerCore(wn.ctx, wn, wn.log, wn.handler.readBuffer, trackedRequest.remoteAddress(), client, trackedRequest.remoteHost),
+		conn:                     wsPeerWebsocketConnImpl{conn},
+		outgoing:                 false,
+		InstanceName:             trackedRequest.otherInstanceName,
+		incomingMsgFilter:        wn.incomingMsgFilter,
+		prioChallenge:            challenge,
+		createTime:               trackedRequest.created,
+		version:                  matchingVersion,
+		identity:                 peerID,
+		identityChallenge:        peerIDChallenge,
+		identityVerified:         atomic.Uint32{},
+		features:                 decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
+		enableVoteCompression:    wn.config.EnableVoteCompression,
+		enableMessageCompression: wn.config.EnableMessageCompression,
+		subscribedTags:           subscribedTags,
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -1176,6 +1314,7 @@ func (wn *msgHandler) messageHandlerThread(wg *sync.WaitGroup, peersConnectivity
 			networkHandleCountByTag.Add(string(msg.Tag), 1)
 			switch outmsg.Action {
 			case Disconnect:
+				CountDroppedMessage(msg.Tag, DropValidationFailed, msg.Sender)
 				wg.Add(1)
 				reason := disconnectBadData
 				if outmsg.reason != disconnectReasonNone {
@@ -1222,17 +1361,24 @@ func (wn *WebsocketNetwork) checkPeersConnectivity() {
 // checkSlowWritingPeers tests each of the peer's current message timestamp.
 // if that timestamp is too old, it means that the transmission of that message
 // takes longer than desired. In that case, it will disconnect the peer, allowing it to reconnect
-// to a faster network endpoint.
+// to a faster network endpoint. It also samples the depth of the peers' send queues.
 func (wn *WebsocketNetwork) checkSlowWritingPeers() {
 	wn.peersLock.Lock()
 	defer wn.peersLock.Unlock()
 	currentTime := time.Now()
+	var queueDepths [numSendPriorities]int
 	for _, peer := range wn.peers {
 		if peer.CheckSlowWritingPeer(currentTime) {
 			wn.wg.Add(1)
 			go wn.disconnectThread(peer, disconnectSlowConn)
 			networkSlowPeerDrops.Inc(nil)
 		}
+		for prio := range queueDepths {
+			queueDepths[prio] += len(peer.sendQueue(sendPriority(prio)))
+		}
+	}
+	for prio, depth := range queueDepths {
+		networkSendQueueDepth.SetLabels(uint64(depth), map[string]string{"priority": sendPriority(prio).String()})
 	}
 }
 
@@ -1390,6 +1536,7 @@ func (wn *WebsocketNetwork) getPeersChangeCounter() int32 {
 
 // preparePeerData prepares batches of data for sending.
 // It performs zstd compression for proposal massages if they this is a prio request and has proposal.
+// The second returned slice holds the compressed form of votes and large messages, for the peers supporting it.
 func (wn *msgBroadcaster) preparePeerData(request broadcastRequest, prio bool) ([]byte, []byte, crypto.Digest) {
 	tbytes := []byte(request.tag)
 	mbytes := make([]byte, len(tbytes)+len(request.data))
@@ -1417,6 +1564,15 @@ func (wn *msgBroadcaster) preparePeerData(request broadcastRequest, prio bool) (
 			wn.log.Warn(logMsg)
 		}
 	}
+	// Optionally compress large messages: only supporting peers will receive it.
+	if wn.enableMessageCompression && zstdCompressedTags[request.tag] && len(request.data) >= wn.config.MessageCompressionThreshold {
+		compressed, logMsg := zstdCompressMsgLevel(tbytes, request.data, wn.config.MessageCompressionLevel)
+		if len(logMsg) > 0 {
+			wn.log.Warn(logMsg)
+		} else if len(compressed) < len(mbytes) {
+			compressedData = compressed
+		}
+	}
 	return mbytes, compressedData, digest
 }
 
@@ -1430,6 +1586,7 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 	networkBroadcastQueueMicros.AddUint64(uint64(broadcastQueueDuration.Nanoseconds()/1000), nil)
 	if broadcastQueueDuration > maxMessageQueueDuration {
 		networkBroadcastsDropped.Inc(nil)
+		CountDroppedMessage(request.tag, DropStale, nil)
 		return
 	}
 
@@ -1446,9 +1603,9 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 			continue
 		}
 		dataToSend := data
-		// check whether to send a compressed vote. dataWithCompression will be empty if this node
-		// has not enabled vote compression.
-		if peer.vpackVoteCompressionSupported() && len(dataWithCompression) > 0 {
+		// check whether to send a compressed vote or message. dataWithCompression will be empty if this node
+		// has not enabled the compression of this message.
+		if len(dataWithCompression) > 0 && peer.compressionSupported(request.tag) {
 			dataToSend = dataWithCompression
 		}
 		ok := peer.writeNonBlock(request.ctx, dataToSend, prio, digest, request.enqueueTime)
@@ -1457,6 +1614,7 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 			continue
 		}
 		networkPeerBroadcastDropped.Inc(nil)
+		CountDroppedMessage(request.tag, DropSendQueueFull, peer)
 	}
 
 	dt := time.Since(start)
@@ -1484,6 +1642,18 @@ func (wn *WebsocketNetwork) outgoingPeers() (peers []Peer) {
 	return
 }
 
+func (wn *WebsocketNetwork) outgoingPeerAddresses() []string {
+	wn.peersLock.RLock()
+	defer wn.peersLock.RUnlock()
+	addrs := make([]string, 0, len(wn.peers))
+	for _, peer := range wn.peers {
+		if peer.outgoing {
+			addrs = append(addrs, peer.GetAddress())
+		}
+	}
+	return addrs
+}
+
 func (wn *WebsocketNetwork) numOutgoingPeers() int {
 	wn.peersLock.RLock()
 	defer wn.peersLock.RUnlock()
@@ -1560,8 +1730,6 @@ func (wn *WebsocketNetwork) meshThread() {
 			wn.DisconnectPeers()
 		}
 
-		wn.refreshRelayArchivePhonebookAddresses()
-
 		// as long as the call to checkExistingConnectionsNeedDisconnecting is deleting existing connections, we want to
 		// kick off the creation of new connections.
 		for {
@@ -1587,37 +1755,141 @@ func (wn *WebsocketNetwork) meshThread() {
 }
 
 func (wn *WebsocketNetwork) refreshRelayArchivePhonebookAddresses() {
-	// TODO: only do DNS fetch every N seconds? Honor DNS TTL? Trust DNS library we're using to handle caching and TTL?
+	wn.dnsRefresher.Refresh(wn.ctx)
+}
+
+// dnsOrigins lists the DNS bootstrap records of the network. The addresses of
+// the first one are kept under the network ID in the phonebook, and those of
+// the others under the network ID qualified by their primary SRV record.
+func (wn *WebsocketNetwork) dnsOrigins() []phonebook.DNSOrigin {
 	dnsBootstrapArray := wn.config.DNSBootstrapArray(wn.NetworkID)
+	origins := make([]phonebook.DNSOrigin, 0, len(dnsBootstrapArray))
+	for i, dnsBootstrap := range dnsBootstrapArray {
+		name := string(wn.NetworkID)
+		if i > 0 {
+			name = fmt.Sprintf("%s/%s", wn.NetworkID, dnsBootstrap.PrimarySRVBootstrap)
+		}
+		origins = append(origins, phonebook.DNSOrigin{
+			Name: name,
+			Resolve: func(ctx context.Context, secure bool) ([]string, []string) {
+				primaryRelayAddrs, primaryArchivalAddrs := wn.getDNSAddrs(ctx, dnsBootstrap.PrimarySRVBootstrap, secure)
+				if dnsBootstrap.BackupSRVBootstrap == "" {
+					return primaryRelayAddrs, primaryArchivalAddrs
+				}
+				backupRelayAddrs, backupArchivalAddrs := wn.getDNSAddrs(ctx, dnsBootstrap.BackupSRVBootstrap, secure)
+				dedupedRelayAddresses := wn.mergePrimarySecondaryAddressSlices(primaryRelayAddrs,
+					backupRelayAddrs, dnsBootstrap.DedupExp)
+				dedupedArchivalAddresses := wn.mergePrimarySecondaryAddressSlices(primaryArchivalAddrs,
+					backupArchivalAddrs, dnsBootstrap.DedupExp)
+				return dedupedRelayAddresses, dedupedArchivalAddresses
+			},
+		})
+	}
+	return origins
+}
 
-	for _, dnsBootstrap := range dnsBootstrapArray {
-		primaryRelayAddrs, primaryArchivalAddrs := wn.getDNSAddrs(dnsBootstrap.PrimarySRVBootstrap)
+// requestMeshUpdate asks the mesh thread to make new connections, unless it
+// already has pending requests.
+func (wn *WebsocketNetwork) requestMeshUpdate() {
+	select {
+	case wn.meshUpdateRequests <- meshRequest{false, nil}:
+	default:
+	}
+}
 
-		if dnsBootstrap.BackupSRVBootstrap != "" {
-			backupRelayAddrs, backupArchivalAddrs := wn.getDNSAddrs(dnsBootstrap.BackupSRVBootstrap)
-			dedupedRelayAddresses := wn.mergePrimarySecondaryAddressSlices(primaryRelayAddrs,
-				backupRelayAddrs, dnsBootstrap.DedupExp)
-			dedupedArchivalAddresses := wn.mergePrimarySecondaryAddressSlices(primaryArchivalAddrs,
-				backupArchivalAddrs, dnsBootstrap.DedupExp)
-			wn.updatePhonebookAddresses(dedupedRelayAddresses, dedupedArchivalAddresses)
-		} else {
-			wn.updatePhonebookAddresses(primaryRelayAddrs, primaryArchivalAddrs)
+// markDialFailure backs off an address which failed to connect, if the phonebook supports it.
+// Being rate limited or connecting to ourselves are not failures of the address.
+func (wn *WebsocketNetwork) markDialFailure(addr string) {
+	if backoff, ok := wn.phonebook.(phonebook.ConnectionBackoff); ok {
+		backoff.MarkFailed(addr, time.Now())
+	}
+}
+
+// PersistentPeers implements PersistentPeersReporter.
+func (wn *WebsocketNetwork) PersistentPeers() []PersistentPeerStatus {
+	if wn.persistentPeers == nil {
+		return nil
+	}
+	return wn.persistentPeers.status()
+}
+
+func (wn *WebsocketNetwork) persistentPeerAddresses() []string {
+	var addrs []string
+	for _, e := range wn.phonebook.Entries() {
+		if e.PersistentRoles&phonebook.RelayRole != 0 && e.Address != wn.config.PublicAddress {
+			addrs = append(addrs, e.Address)
 		}
 	}
+	return addrs
 }
 
-func (wn *WebsocketNetwork) updatePhonebookAddresses(relayAddrs []string, archiveAddrs []string) {
-	if len(relayAddrs) > 0 {
-		wn.log.Debugf("got %d relay dns addrs, %#v", len(relayAddrs), relayAddrs[:min(5, len(relayAddrs))])
-		wn.phonebook.ReplacePeerList(relayAddrs, string(wn.NetworkID), phonebook.RelayRole)
-	} else {
-		wn.log.Infof("got no relay DNS addrs for network %s", wn.NetworkID)
+func (wn *WebsocketNetwork) connectPersistentPeer(addr string) bool {
+	gossipAddr, ok := wn.tryConnectReserveAddr(addr)
+	if ok {
+		wn.wg.Add(1)
+		wn.tryConnect(addr, gossipAddr)
 	}
-	if len(archiveAddrs) > 0 {
-		wn.phonebook.ReplacePeerList(archiveAddrs, string(wn.NetworkID), phonebook.ArchivalRole)
-	} else {
-		wn.log.Infof("got no archive DNS addrs for network %s", wn.NetworkID)
+	return wn.isConnectedTo(addr)
+}
+
+func (wn *WebsocketNetwork) persistentPeerLastPacket(addr string) (time.Time, bool) {
+	wn.peersLock.RLock()
+	defer wn.peersLock.RUnlock()
+	for _, peer := range wn.peers {
+		if peer.outgoing && peer.GetAddress() == addr {
+			return time.Unix(0, peer.GetLastPacketTime()), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (wn *WebsocketNetwork) disconnectPersistentPeer(addr string) {
+	wn.peersLock.RLock()
+	defer wn.peersLock.RUnlock()
+	for _, peer := range wn.peers {
+		if peer.outgoing && peer.GetAddress() == addr {
+			wn.wg.Add(1)
+			go wn.disconnectThread(peer, disconnectUnhealthyPersistentPeer)
+		}
+	}
+}
+
+// adminNetworkName is the phonebook network name of the peers added through PhonebookAdmin.
+const adminNetworkName = "admin"
+
+// PhonebookAdmin is implemented by the networks whose phonebook can be
+// inspected and edited at runtime.
+type PhonebookAdmin interface {
+	// PhonebookEntries returns a snapshot of the phonebook entries.
+	PhonebookEntries() []phonebook.Entry
+	// AddPhonebookPeers adds persistent peers with the given role to the phonebook.
+	AddPhonebookPeers(addrs []string, role phonebook.Role) error
+	// RemovePhonebookPeer removes a peer from the phonebook, returning false if it wasn't there.
+	RemovePhonebookPeer(addr string) bool
+}
+
+// PhonebookEntries implements PhonebookAdmin.
+func (wn *WebsocketNetwork) PhonebookEntries() []phonebook.Entry {
+	return wn.phonebook.Entries()
+}
+
+// AddPhonebookPeers implements PhonebookAdmin. The peers are persistent, so
+// they are kept until they are removed or the node is restarted.
+func (wn *WebsocketNetwork) AddPhonebookPeers(addrs []string, role phonebook.Role) error {
+	for _, a := range addrs {
+		if _, err := addr.ParseHostOrURL(a); err != nil {
+			return fmt.Errorf("invalid peer address %s: %w", a, err)
+		}
 	}
+	wn.phonebook.AddPersistentPeers(addrs, adminNetworkName, role)
+	wn.requestMeshUpdate()
+	return nil
+}
+
+// RemovePhonebookPeer implements PhonebookAdmin. It doesn't disconnect the peer
+// if it is connected.
+func (wn *WebsocketNetwork) RemovePhonebookPeer(addr string) bool {
+	return wn.phonebook.RemovePeer(addr)
 }
 
 // checkNewConnectionsNeeded checks to see if we need to have more connections to meet the GossipFanout target.
@@ -1626,6 +1898,9 @@ func (wn *WebsocketNetwork) updatePhonebookAddresses(relayAddrs []string, archiv
 // note that the determination of needed connection could be inaccurate, and it might return false while
 // more connection should be created.
 func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
+	if len(wn.connectionBudgets) > 0 {
+		return wn.checkConnectionBudgetsNeeded()
+	}
 	desired := wn.config.GossipFanout
 	numOutgoingTotal := wn.numOutgoingPeers() + wn.numOutgoingPending()
 	need := desired - numOutgoingTotal
@@ -1633,7 +1908,10 @@ func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
 		return false
 	}
 	// get more than we need so that we can ignore duplicates
-	newAddrs := wn.phonebook.GetAddresses(desired+numOutgoingTotal, phonebook.RelayRole)
+	newAddrs := wn.phonebook.GetAddresses(desired+numOutgoingTotal, phonebook.RelayRole|phonebook.TxGossipRole)
+	if wn.peerTagger != nil {
+		newAddrs = phonebook.DiversityOrder(newAddrs, wn.outgoingPeerAddresses(), wn.peerTagger)
+	}
 	for _, na := range newAddrs {
 		if na == wn.config.PublicAddress {
 			// filter out self-public address, so we won't try to connect to ourselves.
@@ -1884,9 +2162,9 @@ func (wn *WebsocketNetwork) mergePrimarySecondaryAddressSlices(
 	return
 }
 
-func (wn *WebsocketNetwork) getDNSAddrs(dnsBootstrap string) (relaysAddresses []string, archivalAddresses []string) {
+func (wn *WebsocketNetwork) getDNSAddrs(ctx context.Context, dnsBootstrap string, secure bool) (relaysAddresses []string, archivalAddresses []string) {
 	var err error
-	relaysAddresses, err = wn.resolveSRVRecords(wn.ctx, "algobootstrap", "tcp", dnsBootstrap, wn.config.FallbackDNSResolverAddress, wn.config.DNSSecuritySRVEnforced())
+	relaysAddresses, err = wn.resolveSRVRecords(ctx, "algobootstrap", "tcp", dnsBootstrap, wn.config.FallbackDNSResolverAddress, secure)
 	if err != nil {
 		// only log this warning on testnet or devnet
 		if wn.NetworkID == config.Devnet || wn.NetworkID == config.Testnet {
@@ -1895,7 +2173,7 @@ func (wn *WebsocketNetwork) getDNSAddrs(dnsBootstrap string) (relaysAddresses []
 		relaysAddresses = nil
 	}
 
-	archivalAddresses, err = wn.resolveSRVRecords(wn.ctx, "archive", "tcp", dnsBootstrap, wn.config.FallbackDNSResolverAddress, wn.config.DNSSecuritySRVEnforced())
+	archivalAddresses, err = wn.resolveSRVRecords(ctx, "archive", "tcp", dnsBootstrap, wn.config.FallbackDNSResolverAddress, secure)
 	if err != nil {
 		// only log this warning on testnet or devnet
 		if wn.NetworkID == config.Devnet || wn.NetworkID == config.Testnet {
@@ -1961,6 +2239,14 @@ const PeerFeatureProposalCompression = "ppzstd"
 // supports agreement vote message compression with vpack
 const PeerFeatureVoteVpackCompression = "avvpack"
 
+// PeerFeatureMessageZstdCompression is a value for PeerFeaturesHeader indicating peer
+// supports zstd compression of the large messages other than proposal payloads
+const PeerFeatureMessageZstdCompression = "zstdmsg"
+
+// SubscribedTagsHeader is the HTTP header listing the gossip tags, comma-separated, which are to be
+// exchanged on the connection. The accepting side echoes the tags it agreed to in its response.
+const SubscribedTagsHeader = "X-Algorand-Subscribed-Tags"
+
 var websocketsScheme = map[string]string{"http": "ws", "https": "wss"}
 
 var errBadAddr = errors.New("bad address")
@@ -2011,6 +2297,18 @@ func (wn *WebsocketNetwork) numOutgoingPending() int {
 	return len(wn.tryConnectAddrs)
 }
 
+// pendingConnectAddresses returns the addresses being connected to. Both the
+// phonebook and the websocket addresses are returned.
+func (wn *WebsocketNetwork) pendingConnectAddresses() []string {
+	wn.tryConnectLock.Lock()
+	defer wn.tryConnectLock.Unlock()
+	addrs := make([]string, 0, len(wn.tryConnectAddrs))
+	for a := range wn.tryConnectAddrs {
+		addrs = append(addrs, a)
+	}
+	return addrs
+}
+
 // GetHTTPClient returns a http.Client with a suitable for the network Transport
 // that would also limit the number of outgoing connections.
 func (wn *WebsocketNetwork) GetHTTPClient(address string) (*http.Client, error) {
@@ -2086,6 +2384,10 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 	}
 
 	SetUserAgentHeader(requestHeader)
+	subscribedTags := parseSubscribedTags(wn.config.GossipTagSubscriptions[netAddr])
+	if subscribedTags != nil {
+		requestHeader.Set(SubscribedTagsHeader, encodeSubscribedTags(subscribedTags))
+	}
 	var websocketDialer = websocket.Dialer{
 		Proxy:             http.ProxyFromEnvironment,
 		HandshakeTimeout:  45 * time.Second,
@@ -2094,6 +2396,10 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		NetDial:           wn.dialer.Dial,
 		MaxHeaderSize:     wn.wsMaxHeaderBytes,
 	}
+	if wn.proxyDialer != nil {
+		// the dialer already tunnels through the configured proxy
+		websocketDialer.Proxy = nil
+	}
 
 	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
 
@@ -2112,6 +2418,7 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 			switch response.StatusCode {
 			case http.StatusPreconditionFailed:
 				wn.log.Warnf("ws connect(%s) fail - bad handshake, precondition failed : '%s'", gossipAddr, errString)
+				wn.markDialFailure(netAddr)
 			case http.StatusLoopDetected:
 				wn.log.Infof("ws connect(%s) aborted due to connecting to self", gossipAddr)
 			case http.StatusTooManyRequests:
@@ -2125,9 +2432,11 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 				}
 			default:
 				wn.log.Warnf("ws connect(%s) fail - bad handshake, Status code = %d, Headers = %#v, Body = %s", gossipAddr, response.StatusCode, response.Header, errString)
+				wn.markDialFailure(netAddr)
 			}
 		} else {
 			wn.log.Warnf("ws connect(%s) fail: %s", gossipAddr, err)
+			wn.markDialFailure(netAddr)
 		}
 		return
 	}
@@ -2168,6 +2477,14 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		}
 	}
 
+	if subscribedTags != nil {
+		// the tags the peer agreed to are those exchanged on the connection
+		subscribedTags = parseSubscribedTags(response.Header.Get(SubscribedTagsHeader))
+		if subscribedTags == nil {
+			wn.log.Infof("peer %s does not support gossip tag subscriptions, exchanging all the gossip tags", netAddr)
+		}
+	}
+
 	throttledConnection := false
 	if wn.throttledOutgoingConnections.Add(int32(-1)) >= 0 {
 		throttledConnection = true
@@ -2188,6 +2505,8 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		identity:                    peerID,
 		features:                    decodePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)),
 		enableVoteCompression:       wn.config.EnableVoteCompression,
+		enableMessageCompression:    wn.config.EnableMessageCompression,
+		subscribedTags:              subscribedTags,
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 
@@ -2207,6 +2526,9 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 	}
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
+	if cache, ok := wn.phonebook.(phonebook.PeerCache); ok {
+		cache.MarkConnected(netAddr, time.Now())
+	}
 
 	wn.log.With("event", "ConnectedOut").With("remote", netAddr).With("local", localAddr).Infof("Made outgoing connection to peer %v", netAddr)
 	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerEvent,
@@ -2266,10 +2588,30 @@ func (wn *WebsocketNetwork) SetPeerData(peer Peer, key string, value interface{}
 	}
 }
 
+// makePhonebook creates a phonebook with the rate limiting and backoff of the
+// configuration. It is shared by the websocket and p2p networks.
+func makePhonebook(cfg config.Local) phonebook.Phonebook {
+	pb := phonebook.MakePhonebookWithSubnetRateLimit(cfg.ConnectionsRateLimitingCount,
+		time.Duration(cfg.ConnectionsRateLimitingWindowSeconds)*time.Second,
+		phonebook.SubnetRateLimit{
+			Count:      cfg.SubnetConnectionsRateLimitingCount,
+			Window:     time.Duration(cfg.SubnetConnectionsRateLimitingWindowSeconds) * time.Second,
+			IPv4Prefix: cfg.SubnetRateLimitingIPv4Prefix,
+			IPv6Prefix: cfg.SubnetRateLimitingIPv6Prefix,
+		})
+	if backoff, ok := pb.(phonebook.ConnectionBackoff); ok {
+		backoff.SetBackoffPolicy(phonebook.BackoffPolicy{
+			Base:  cfg.ConnectionBackoffBase,
+			Max:   cfg.ConnectionBackoffMax,
+			Decay: cfg.ConnectionBackoffDecay,
+		})
+	}
+	return pb
+}
+
 // NewWebsocketNetwork constructor for websockets based gossip network
 func NewWebsocketNetwork(log logging.Logger, config config.Local, phonebookAddresses []string, genesisID string, networkID protocol.NetworkID, nodeInfo NodeInfo, identityOpts *identityOpts) (wn *WebsocketNetwork, err error) {
-	pb := phonebook.MakePhonebook(config.ConnectionsRateLimitingCount,
-		time.Duration(config.ConnectionsRateLimitingWindowSeconds)*time.Second)
+	pb := makePhonebook(config)
 
 	addresses := make([]string, 0, len(phonebookAddresses))
 	for _, a := range phonebookAddresses {
@@ -2298,15 +2640,55 @@ func NewWebsocketNetwork(log logging.Logger, config config.Local, phonebookAddre
 	if identityOpts != nil {
 		wn.identityScheme = identityOpts.scheme
 		wn.identityTracker = identityOpts.tracker
+		wn.identityKeysDir = identityOpts.keysDir
 	}
 	if wn.identityTracker == nil {
 		wn.identityTracker = NewIdentityTracker()
 	}
 
+	if config.OutgoingProxy != "" {
+		wn.proxyDialer, err = tools_network.MakeProxyDialer(config.OutgoingProxy, nil)
+		if err != nil {
+			return nil, err
+		}
+		wn.resolveSRVRecords = makeProxiedSRVResolver(wn.proxyDialer)
+	}
+
 	wn.setup()
 	return wn, nil
 }
 
+// identitySchemeOptions returns the options of the identity challenge scheme, using the keyring of identity keys
+// if the keys are persisted or rotated.
+func (wn *WebsocketNetwork) identitySchemeOptions() []IdentityChallengeSchemeOption {
+	opts := []IdentityChallengeSchemeOption{NetIdentityDedupNames(wn.config.PublicAddress)}
+	if wn.config.PublicAddress == "" {
+		return opts
+	}
+	var keysPath string
+	if wn.config.PersistNetIdentityKeys && wn.identityKeysDir != "" {
+		keysPath = path.Join(wn.identityKeysDir, NetIdentityKeysFilename)
+	}
+	if keysPath == "" && wn.config.NetIdentityKeyRotationInterval == 0 {
+		return opts
+	}
+	keyring, err := loadIdentityKeyring(wn.log, keysPath, wn.config.NetIdentityKeyRotationInterval, wn.config.NetIdentityKeyRotationOverlap, time.Now())
+	if err != nil {
+		wn.log.Warnf("unable to load the network identity keys, using an ephemeral key: %v", err)
+		return opts
+	}
+	return append(opts, NetIdentitySigner(keyring))
+}
+
+// makeProxiedSRVResolver returns a resolveSRVRecords implementation which looks up the records through
+// proxyDialer, so that no DNS query leaves the node directly. DNSSEC validation is not available this way.
+func makeProxiedSRVResolver(proxyDialer tools_network.ContextDialer) func(ctx context.Context, service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) ([]string, error) {
+	return func(ctx context.Context, service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) ([]string, error) {
+		resolver := tools_network.MakeProxiedResolver(proxyDialer, fallbackDNSResolverAddress)
+		return tools_network.ReadFromSRVWithResolver(ctx, resolver, service, protocol, name)
+	}
+}
+
 // NewWebsocketGossipNode constructs a websocket network node and returns it as a GossipNode interface implementation
 func NewWebsocketGossipNode(log logging.Logger, config config.Local, phonebookAddresses []string, genesisID string, networkID protocol.NetworkID) (gn GossipNode, err error) {
 	return NewWebsocketNetwork(log, config, phonebookAddresses, genesisID, networkID, nil, nil)
@@ -2373,6 +2755,12 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 	incomingPeers.Set(uint64(wn.numIncomingPeers()))
 	outgoingPeers.Set(uint64(wn.numOutgoingPeers()))
 
+	// avoid redialing relays which misbehaved
+	if peer.outgoing && misbehaviorReasons[reason] && wn.config.MisbehavingPeerBanDuration > 0 {
+		wn.log.Infof("banning peer %s for %v: %s", peer.GetAddress(), wn.config.MisbehavingPeerBanDuration, reason)
+		wn.phonebook.Ban(peer.GetAddress(), wn.config.MisbehavingPeerBanDuration)
+	}
+
 	wn.peersLock.Lock()
 	defer wn.peersLock.Unlock()
 	if peer.peerIndex < len(wn.peers) && wn.peers[peer.peerIndex] == peer {
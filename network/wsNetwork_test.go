@@ -4302,7 +4302,7 @@ func TestRefreshRelayArchivePhonebookAddresses(t *testing.T) {
 }
 
 /*
-Exercises the updatePhonebookAddresses function, notably with different variations of valid relay and
+Exercises the DNSRefresher.Update function, notably with different variations of valid relay and
 archival addresses.
 */
 func TestUpdatePhonebookAddresses(t *testing.T) {
@@ -4327,7 +4327,7 @@ func TestUpdatePhonebookAddresses(t *testing.T) {
 		// Dont overlap with relays, duplicates between them not stored in phonebook as of this writing
 		archiveDomainsGen := rapid.SliceOfN(rapidgen.DomainOf(253, 63, "", relayDomains), 0, 200)
 		archiveDomains := archiveDomainsGen.Draw(t1, "archiveDomains")
-		netA.updatePhonebookAddresses(relayDomains, archiveDomains)
+		netA.dnsRefresher.Update(string(netA.NetworkID), relayDomains, archiveDomains)
 
 		// Check that entries are in fact in phonebook less any duplicates
 		dedupedRelayDomains := removeDuplicateStr(relayDomains, false)
@@ -4368,7 +4368,7 @@ func TestUpdatePhonebookAddresses(t *testing.T) {
 			relayDomains = append(relayDomains, priorRelayDomains[priorIdx])
 		}
 
-		netA.updatePhonebookAddresses(relayDomains, nil)
+		netA.dnsRefresher.Update(string(netA.NetworkID), relayDomains, nil)
 
 		// Check that entries are in fact in phonebook less any duplicates
 		dedupedRelayDomains = removeDuplicateStr(relayDomains, false)
@@ -71,7 +71,10 @@ var defaultSendMessageTags = map[protocol.Tag]bool{
 	protocol.MsgDigestSkipTag:     true,
 	protocol.NetPrioResponseTag:   true,
 	protocol.NetIDVerificationTag: true,
+	protocol.PeerExchangeTag:      true,
+	protocol.ProposalChunkTag:     true,
 	protocol.ProposalPayloadTag:   true,
+	protocol.RelayGoodbyeTag:      true,
 	protocol.TopicMsgRespTag:      true,
 	protocol.MsgOfInterestTag:     true,
 	protocol.TxnTag:               true,
@@ -148,6 +151,16 @@ const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
 const disconnectDuplicateConnection disconnectReason = "DuplicateConnection"
 const disconnectBadIdentityData disconnectReason = "BadIdentityData"
 const disconnectUnexpectedTopicResp disconnectReason = "UnexpectedTopicResp"
+const disconnectUnhealthyPersistentPeer disconnectReason = "UnhealthyPersistentPeer"
+const disconnectRelayDraining disconnectReason = "RelayDraining"
+
+// misbehaviorReasons are the disconnect reasons which indicate that the peer
+// violated the protocol, rather than that the connection failed.
+var misbehaviorReasons = map[disconnectReason]bool{
+	disconnectBadData:             true,
+	disconnectBadIdentityData:     true,
+	disconnectUnexpectedTopicResp: true,
+}
 
 // Response is the structure holding the response from the server
 type Response struct {
@@ -197,8 +210,11 @@ type wsPeer struct {
 
 	closing chan struct{}
 
-	sendBufferHighPrio chan sendMessage
-	sendBufferBulk     chan sendMessage
+	// the send queues, from the highest to the lowest priority; see sendPriority
+	sendBufferHighPrio  chan sendMessage
+	sendBufferBundles   chan sendMessage
+	sendBufferProposals chan sendMessage
+	sendBufferBulk      chan sendMessage
 
 	wg sync.WaitGroup
 
@@ -241,6 +257,9 @@ type wsPeer struct {
 	// enableCompression specifies whether this node can compress or decompress votes (and whether it has advertised this)
 	enableVoteCompression bool
 
+	// enableMessageCompression specifies whether this node can decompress zstd compressed messages (and whether it has advertised this)
+	enableMessageCompression bool
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -251,6 +270,13 @@ type wsPeer struct {
 	// only guarantee is that it's being accessed only during startup and/or by the sending loop go routine.
 	sendMessageTag map[protocol.Tag]bool
 
+	// subscribedTags are the gossip tags negotiated in the handshake to be exchanged on this connection,
+	// or nil if it isn't restricted. The messages-of-interest of the peer can't extend them.
+	subscribedTags map[protocol.Tag]bool
+
+	// shaper caps the bandwidth used to send to an incoming peer, or is nil if it isn't capped.
+	shaper *trafficShaper
+
 	// messagesOfInterestGeneration is this node's messagesOfInterest version that we have seen to this peer.
 	messagesOfInterestGeneration atomic.Uint32
 
@@ -407,6 +433,7 @@ func (wp *wsPeer) Unicast(ctx context.Context, msg []byte, tag protocol.Tag) err
 	ok := wp.writeNonBlock(ctx, mbytes, false, digest, time.Now())
 	if !ok {
 		networkBroadcastsDropped.Inc(nil)
+		CountDroppedMessage(tag, DropSendQueueFull, wp)
 		err = fmt.Errorf("wsPeer failed to unicast: %v", wp.GetAddress())
 	}
 
@@ -474,10 +501,12 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 	wp.log.Debugf("wsPeer init outgoing=%v %#v", wp.outgoing, wp.GetAddress())
 	wp.closing = make(chan struct{})
 	wp.sendBufferHighPrio = make(chan sendMessage, sendBufferLength)
+	wp.sendBufferBundles = make(chan sendMessage, sendBufferLength)
+	wp.sendBufferProposals = make(chan sendMessage, sendBufferLength)
 	wp.sendBufferBulk = make(chan sendMessage, sendBufferLength)
 	wp.lastPacketTime.Store(time.Now().UnixNano())
 	wp.responseChannels = make(map[uint64]chan *Response)
-	wp.sendMessageTag = defaultSendMessageTags
+	wp.sendMessageTag = restrictSendTags(defaultSendMessageTags, wp.subscribedTags)
 	wp.clientDataStore = make(map[string]interface{})
 
 	// processed is a channel that messageHandlerThread writes to
@@ -489,6 +518,10 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 		wp.processed <- struct{}{}
 	}
 
+	if !wp.outgoing {
+		wp.shaper = makeTrafficShaper(config, time.Now())
+	}
+
 	if config.EnableOutgoingNetworkMessageFiltering {
 		wp.outgoingMsgFilter = makeMessageFilter(config.OutgoingMessageFilterBucketCount, config.OutgoingMessageFilterBucketSize)
 	}
@@ -667,13 +700,14 @@ func (wp *wsPeer) readLoop() {
 			wp.txMessageCount.Add(1)
 		case protocol.AgreementVoteTag:
 			wp.avMessageCount.Add(1)
-		case protocol.ProposalPayloadTag:
+		case protocol.ProposalPayloadTag, protocol.ProposalChunkTag:
 			wp.ppMessageCount.Add(1)
 		// the remaining valid tags: no special handling here
-		case protocol.NetPrioResponseTag, protocol.StateProofSigTag, protocol.UniEnsBlockReqTag, protocol.VoteBundleTag, protocol.NetIDVerificationTag:
+		case protocol.NetPrioResponseTag, protocol.StateProofSigTag, protocol.UniEnsBlockReqTag, protocol.VoteBundleTag, protocol.NetIDVerificationTag, protocol.PeerExchangeTag, protocol.RelayGoodbyeTag:
 		default: // unrecognized tag
 			unknownProtocolTagMessagesTotal.Inc(nil)
 			wp.unkMessageCount.Add(1)
+			CountDroppedMessage(msg.Tag, DropUnknownTag, wp)
 			continue // drop message, skip adding it to queue
 			// TODO: should disconnect here?
 		}
@@ -682,6 +716,7 @@ func (wp *wsPeer) readLoop() {
 				//wp.log.Debugf("dropped incoming duplicate %s(%d)", msg.Tag, len(msg.Data))
 				duplicateNetworkMessageReceivedTotal.Inc(nil)
 				duplicateNetworkMessageReceivedBytesTotal.AddUint64(uint64(len(msg.Data)+len(msg.Tag)), nil)
+				CountDroppedMessage(msg.Tag, DropDuplicate, wp)
 				// drop message, skip adding it to queue
 				continue
 			}
@@ -793,12 +828,13 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	if len(msg.data) > MaxMessageLength {
 		wp.log.Errorf("trying to send a message longer than we would receive: %d > %d tag=%s", len(msg.data), MaxMessageLength, string(msg.data[0:2]))
 		// just drop it, don't break the connection
+		CountDroppedMessage(protocol.Tag(msg.data[0:2]), DropTooLong, wp)
 		return disconnectReasonNone
 	}
 	if msg.msgTags != nil {
 		// when msg.msgTags is non-nil, the read loop has received a message-of-interest message that we want to apply.
 		// in order to avoid any locking, it sent it to this queue so that we could set it as the new outgoing message tag filter.
-		wp.sendMessageTag = msg.msgTags
+		wp.sendMessageTag = restrictSendTags(msg.msgTags, wp.subscribedTags)
 		return disconnectReasonNone
 	}
 	// the tags are always 2 char long; note that this is safe since it's only being used for messages that we have generated locally.
@@ -817,6 +853,17 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectStaleWrite
 	}
 
+	if wp.shaper != nil {
+		if delay := wp.shaper.delay(now, tag, len(msg.data)); delay > 0 {
+			networkTrafficShapingDelayMicrosTotal.AddUint64(uint64(delay.Microseconds()), nil)
+			select {
+			case <-wp.closing:
+				return disconnectReasonNone
+			case <-time.After(delay):
+			}
+		}
+	}
+
 	wp.intermittentOutgoingMessageEnqueueTime.Store(msg.enqueued.UnixNano())
 	defer wp.intermittentOutgoingMessageEnqueueTime.Store(0)
 	err := wp.conn.WriteMessage(websocket.BinaryMessage, msg.data)
@@ -851,33 +898,53 @@ func (wp *wsPeer) writeLoop() {
 		wp.writeLoopCleanup(cleanupCloseError)
 	}()
 	for {
-		// send from high prio channel as long as we can
-		select {
-		case data := <-wp.sendBufferHighPrio:
-			if writeErr := wp.writeLoopSend(data); writeErr != disconnectReasonNone {
-				cleanupCloseError = writeErr
+		// send from the highest priority channel having a message as long as we can
+		data, ok := wp.nextPrioritizedMessage()
+		if !ok {
+			// if nothing high prio, send anything
+			select {
+			case <-wp.closing:
 				return
+			case data = <-wp.sendBufferHighPrio:
+			case data = <-wp.sendBufferBundles:
+			case data = <-wp.sendBufferProposals:
+			case data = <-wp.sendBufferBulk:
 			}
-			continue
-		default:
 		}
-		// if nothing high prio, send anything
-		select {
-		case <-wp.closing:
+		if writeErr := wp.writeLoopSend(data); writeErr != disconnectReasonNone {
+			cleanupCloseError = writeErr
 			return
-		case data := <-wp.sendBufferHighPrio:
-			if writeErr := wp.writeLoopSend(data); writeErr != disconnectReasonNone {
-				cleanupCloseError = writeErr
-				return
-			}
-		case data := <-wp.sendBufferBulk:
-			if writeErr := wp.writeLoopSend(data); writeErr != disconnectReasonNone {
-				cleanupCloseError = writeErr
-				return
-			}
 		}
 	}
 }
+
+// nextPrioritizedMessage returns the next message waiting in the high priority queues, taking the
+// queues in priority order. It returns false if none of them has a message.
+func (wp *wsPeer) nextPrioritizedMessage() (sendMessage, bool) {
+	for _, queue := range [...]chan sendMessage{wp.sendBufferHighPrio, wp.sendBufferBundles, wp.sendBufferProposals} {
+		select {
+		case msg := <-queue:
+			return msg, true
+		default:
+		}
+	}
+	return sendMessage{}, false
+}
+
+// sendQueue returns the queue holding the messages of the given priority.
+func (wp *wsPeer) sendQueue(prio sendPriority) chan sendMessage {
+	switch prio {
+	case sendPriorityVotes:
+		return wp.sendBufferHighPrio
+	case sendPriorityBundles:
+		return wp.sendBufferBundles
+	case sendPriorityProposals:
+		return wp.sendBufferProposals
+	default:
+		return wp.sendBufferBulk
+	}
+}
+
 func (wp *wsPeer) writeLoopCleanup(reason disconnectReason) {
 	wp.internalClose(reason)
 	wp.wg.Done()
@@ -893,13 +960,11 @@ func (wp *wsPeer) writeNonBlock(ctx context.Context, data []byte, highPrio bool,
 		return true
 	}
 
-	var outchan chan sendMessage
-
-	if highPrio {
-		outchan = wp.sendBufferHighPrio
-	} else {
-		outchan = wp.sendBufferBulk
+	var tag protocol.Tag
+	if len(data) >= 2 {
+		tag = protocol.Tag(data[:2])
 	}
+	outchan := wp.sendQueue(sendPriorityOf(tag, highPrio))
 	select {
 	case outchan <- sendMessage{data: data, enqueued: msgEnqueueTime, peerEnqueued: time.Now(), ctx: ctx}:
 		return true
@@ -1098,14 +1163,70 @@ func (wp *wsPeer) vpackVoteCompressionSupported() bool {
 	return wp.features&pfCompressedVoteVpack != 0
 }
 
+func (wp *wsPeer) zstdMessageCompressionSupported() bool {
+	return wp.features&pfCompressedMsgZstd != 0
+}
+
+// compressionSupported returns true if the peer accepts the compressed form of the messages with the given tag.
+func (wp *wsPeer) compressionSupported(tag protocol.Tag) bool {
+	if tag == protocol.AgreementVoteTag {
+		return wp.vpackVoteCompressionSupported()
+	}
+	return wp.zstdMessageCompressionSupported()
+}
+
 //msgp:ignore peerFeatureFlag
 type peerFeatureFlag int
 
 const (
 	pfCompressedProposal peerFeatureFlag = 1 << iota
 	pfCompressedVoteVpack
+	pfCompressedMsgZstd
 )
 
+// sendPriority identifies one of the send queues of a peer. The write loop always sends from the
+// highest priority (lowest value) queue holding a message, so that a vote never waits behind a queued
+// bundle, a bundle behind a proposal payload, and none of them behind transactions. A message which is
+// already being written is not interrupted, though.
+//
+//msgp:ignore sendPriority
+type sendPriority int
+
+const (
+	// sendPriorityVotes is used for votes and the other high priority messages
+	sendPriorityVotes sendPriority = iota
+	sendPriorityBundles
+	sendPriorityProposals
+	// sendPriorityBulk is used for transactions and all the other low priority messages
+	sendPriorityBulk
+
+	numSendPriorities
+)
+
+var sendPriorityNames = [numSendPriorities]string{"votes", "bundles", "proposals", "bulk"}
+
+func (p sendPriority) String() string {
+	if p < 0 || p >= numSendPriorities {
+		return fmt.Sprintf("sendPriority(%d)", int(p))
+	}
+	return sendPriorityNames[p]
+}
+
+// sendPriorityOf returns the priority of a message with the given tag, sent with high priority or not.
+func sendPriorityOf(tag protocol.Tag, highPrio bool) sendPriority {
+	if !highPrio {
+		return sendPriorityBulk
+	}
+	switch tag {
+	case protocol.VoteBundleTag:
+		return sendPriorityBundles
+	case protocol.ProposalPayloadTag, protocol.ProposalChunkTag:
+		return sendPriorityProposals
+	default:
+		return sendPriorityVotes
+	}
+}
+
 // versionPeerFeatures defines protocol version when peer features were introduced
 const versionPeerFeatures = "2.2"
 
@@ -1151,6 +1272,9 @@ func decodePeerFeatures(version string, announcedFeatures string) peerFeatureFla
 		if part == PeerFeatureVoteVpackCompression {
 			features |= pfCompressedVoteVpack
 		}
+		if part == PeerFeatureMessageZstdCompression {
+			features |= pfCompressedMsgZstd
+		}
 	}
 	return features
 }
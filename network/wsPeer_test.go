@@ -17,6 +17,7 @@
 package network
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"go/ast"
@@ -31,6 +32,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -59,6 +61,68 @@ func TestCheckSlowWritingPeer(t *testing.T) {
 }
 
 // TestGetRequestNonce tests if unique values are generated each time
+func TestSendQueuePriorities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	peer := wsPeer{
+		sendBufferHighPrio:  make(chan sendMessage, 8),
+		sendBufferBundles:   make(chan sendMessage, 8),
+		sendBufferProposals: make(chan sendMessage, 8),
+		sendBufferBulk:      make(chan sendMessage, 8),
+	}
+
+	ctx := context.Background()
+	msg := func(tag protocol.Tag) []byte { return append([]byte(tag), 1, 2, 3) }
+	// enqueue from the lowest to the highest priority
+	require.True(t, peer.writeNonBlock(ctx, msg(protocol.TxnTag), false, crypto.Digest{}, time.Now()))
+	require.True(t, peer.writeNonBlock(ctx, msg(protocol.ProposalPayloadTag), false, crypto.Digest{}, time.Now()))
+	require.True(t, peer.writeNonBlock(ctx, msg(protocol.ProposalPayloadTag), true, crypto.Digest{}, time.Now()))
+	require.True(t, peer.writeNonBlock(ctx, msg(protocol.VoteBundleTag), true, crypto.Digest{}, time.Now()))
+	require.True(t, peer.writeNonBlock(ctx, msg(protocol.AgreementVoteTag), true, crypto.Digest{}, time.Now()))
+
+	require.Len(t, peer.sendQueue(sendPriorityVotes), 1)
+	require.Len(t, peer.sendQueue(sendPriorityBundles), 1)
+	require.Len(t, peer.sendQueue(sendPriorityProposals), 1)
+	require.Len(t, peer.sendQueue(sendPriorityBulk), 2)
+
+	// high priority messages are taken votes first, regardless of the enqueueing order
+	for _, tag := range []protocol.Tag{protocol.AgreementVoteTag, protocol.VoteBundleTag, protocol.ProposalPayloadTag} {
+		m, ok := peer.nextPrioritizedMessage()
+		require.True(t, ok)
+		require.Equal(t, msg(tag), m.data)
+	}
+	_, ok := peer.nextPrioritizedMessage()
+	require.False(t, ok)
+
+	require.Equal(t, sendPriorityBulk, sendPriorityOf(protocol.AgreementVoteTag, false))
+	require.Equal(t, sendPriorityVotes, sendPriorityOf(protocol.MsgOfInterestTag, true))
+	require.Equal(t, "proposals", sendPriorityProposals.String())
+}
+
+func TestSendPriorityOfHighPriorityTags(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	expected := map[protocol.Tag]sendPriority{
+		protocol.AgreementVoteTag:   sendPriorityVotes,
+		protocol.ProposalPayloadTag: sendPriorityProposals,
+		protocol.ProposalChunkTag:   sendPriorityProposals,
+	}
+	for _, tag := range protocol.TagList {
+		if !highPriorityTag(tag) {
+			require.Equal(t, sendPriorityBulk, sendPriorityOf(tag, false), tag)
+			continue
+		}
+		prio, ok := expected[tag]
+		require.True(t, ok, "high priority tag %s has no expected send priority", tag)
+		require.Equal(t, prio, sendPriorityOf(tag, true), tag)
+		require.Equal(t, sendPriorityBulk, sendPriorityOf(tag, false), tag)
+	}
+	for tag := range expected {
+		require.True(t, highPriorityTag(tag), tag)
+	}
+}
+
 func TestGetRequestNonce(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
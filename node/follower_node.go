@@ -20,6 +20,7 @@ package node
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -38,6 +39,7 @@ import (
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/util/execpool"
@@ -101,6 +103,7 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 	}
 	p2pNode.DeregisterMessageInterest(protocol.AgreementVoteTag)
 	p2pNode.DeregisterMessageInterest(protocol.ProposalPayloadTag)
+	p2pNode.DeregisterMessageInterest(protocol.ProposalChunkTag)
 	p2pNode.DeregisterMessageInterest(protocol.VoteBundleTag)
 	node.net = p2pNode
 
@@ -308,6 +311,12 @@ func (node *AlgorandFollowerNode) ListParticipationKeys() (partKeys []account.Pa
 	return []account.ParticipationRecord{}, nil
 }
 
+// LateProposers returns nil in follower mode, since the follower doesn't
+// run agreement.
+func (node *AlgorandFollowerNode) LateProposers() []agreement.LateProposer {
+	return nil
+}
+
 // GetParticipationKey returns an error in follower mode
 func (node *AlgorandFollowerNode) GetParticipationKey(_ account.ParticipationID) (account.ParticipationRecord, error) {
 	return account.ParticipationRecord{}, fmt.Errorf("cannot get participation key in follower mode")
@@ -474,3 +483,84 @@ func (node *AlgorandFollowerNode) SetBlockTimeStampOffset(offset int64) error {
 func (node *AlgorandFollowerNode) GetBlockTimeStampOffset() (*int64, error) {
 	return nil, fmt.Errorf("cannot get block timestamp offset in follower mode")
 }
+
+// PhonebookEntries returns a snapshot of the entries of the network phonebook.
+func (node *AlgorandFollowerNode) PhonebookEntries() ([]phonebook.Entry, error) {
+	admin, ok := node.net.(network.PhonebookAdmin)
+	if !ok {
+		return nil, ErrPhonebookUnavailable
+	}
+	return admin.PhonebookEntries(), nil
+}
+
+// AddPhonebookPeers adds persistent peers with the given role to the network phonebook.
+func (node *AlgorandFollowerNode) AddPhonebookPeers(addrs []string, role phonebook.Role) error {
+	admin, ok := node.net.(network.PhonebookAdmin)
+	if !ok {
+		return ErrPhonebookUnavailable
+	}
+	return admin.AddPhonebookPeers(addrs, role)
+}
+
+// RemovePhonebookPeer removes a peer from the network phonebook, returning
+// whether it was present.
+func (node *AlgorandFollowerNode) RemovePhonebookPeer(addr string) (bool, error) {
+	admin, ok := node.net.(network.PhonebookAdmin)
+	if !ok {
+		return false, ErrPhonebookUnavailable
+	}
+	return admin.RemovePhonebookPeer(addr), nil
+}
+
+// StartRelayDrain stops accepting incoming connections, and asks the incoming peers to move to other relays.
+func (node *AlgorandFollowerNode) StartRelayDrain() error {
+	drainer, ok := node.net.(network.RelayDrainer)
+	if !ok {
+		return ErrRelayDrainUnavailable
+	}
+	drainer.StartDrain()
+	return nil
+}
+
+// StopRelayDrain accepts incoming connections again.
+func (node *AlgorandFollowerNode) StopRelayDrain() error {
+	drainer, ok := node.net.(network.RelayDrainer)
+	if !ok {
+		return ErrRelayDrainUnavailable
+	}
+	drainer.StopDrain()
+	return nil
+}
+
+// RelayDrainStatus returns the progress of the draining of the node.
+func (node *AlgorandFollowerNode) RelayDrainStatus() (network.RelayDrainStatus, error) {
+	drainer, ok := node.net.(network.RelayDrainer)
+	if !ok {
+		return network.RelayDrainStatus{}, ErrRelayDrainUnavailable
+	}
+	return drainer.DrainStatus(), nil
+}
+
+// ExportLedgerSnapshot writes a snapshot of the ledger to w, which can be imported on another machine
+// with ledger.ImportSnapshot before starting its node.
+func (node *AlgorandFollowerNode) ExportLedgerSnapshot(ctx context.Context, w io.Writer) (ledger.SnapshotHeader, error) {
+	node.mu.Lock()
+	catchingUp := node.catchpointCatchupService != nil
+	node.mu.Unlock()
+	if catchingUp {
+		return ledger.SnapshotHeader{}, ErrLedgerSnapshotDuringCatchup
+	}
+	return node.ledger.ExportSnapshot(ctx, w)
+}
+
+// LookupAccountHistory returns the account data of the given address at the given round, and its
+// balance without the pending rewards.
+func (node *AlgorandFollowerNode) LookupAccountHistory(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.MicroAlgos, error) {
+	return lookupAccountHistory(node.ledger, round, addr)
+}
+
+// AccountProof returns a proof that the balance record of the account is committed to by the root of the
+// accounts merkle trie of the ledger.
+func (node *AlgorandFollowerNode) AccountProof(addr basics.Address) (ledger.AccountProof, error) {
+	return node.ledger.AccountProof(addr)
+}
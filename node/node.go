@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -51,6 +52,7 @@ import (
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/network/p2p"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/stateproof"
@@ -124,6 +126,8 @@ type AlgorandFullNode struct {
 	accountManager  *data.AccountManager
 
 	agreementService         *agreement.Service
+	agreementDiscipline      *timers.NTPDiscipline
+	dbMaintainer             *db.Maintainer
 	catchupService           *catchup.Service
 	catchpointCatchupService *catchup.CatchpointCatchupService
 	blockService             *rpcs.BlockService
@@ -216,12 +220,15 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		}
 	} else {
 		var wsNode *network.WebsocketNetwork
-		wsNode, err = network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node, nil)
+		wsNode, err = network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node, network.NetIdentityKeysDir(rootDir))
 		if err != nil {
 			log.Errorf("could not create websocket node: %v", err)
 			return nil, err
 		}
 		wsNode.SetPrioScheme(node)
+		if cfg.EnablePeerCache {
+			wsNode.SetPeerCache(filepath.Join(node.genesisDirs.RootGenesisDir, config.PeerCacheFilename))
+		}
 		p2pNode = wsNode
 	}
 	node.net = p2pNode
@@ -286,28 +293,42 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		log.Errorf("Cannot load crash data: %v", err)
 		return nil, err
 	}
+	if cfg.DatabaseMaintenanceInterval > 0 {
+		// the database maintenance is the only one reclaiming the unused pages of the crash database, which it
+		// does using incremental vacuum. Switching to it takes a full vacuum, which has to complete before the
+		// agreement service uses the database.
+		enableCrashIncrementalVacuum(log, &crashAccess)
+	}
 
 	blockValidator := blockValidatorImpl{l: node.ledger, verificationPool: node.highPriorityCryptoVerificationPool}
 	agreementLedger := makeAgreementLedger(node.ledger, node.net)
 	var agreementClock timers.Clock[agreement.TimeoutType]
 	if node.devMode {
 		agreementClock = timers.MakeFrozenClock[agreement.TimeoutType]()
+	} else if cfg.AgreementNTPServers != "" {
+		node.agreementDiscipline = timers.MakeNTPDiscipline(strings.Split(cfg.AgreementNTPServers, ","), cfg.AgreementNTPInterval)
+		agreementClock = timers.MakeNTPClock[agreement.TimeoutType](time.Now(), node.agreementDiscipline)
 	} else {
 		agreementClock = timers.MakeMonotonicClock[agreement.TimeoutType](time.Now())
 	}
 
+	agreementNetwork := gossip.WrapNetwork(node.net, log, cfg)
 	agreementParameters := agreement.Parameters{
 		Logger:         log,
 		Accessor:       crashAccess,
 		Clock:          agreementClock,
 		Local:          node.config,
-		Network:        gossip.WrapNetwork(node.net, log, cfg),
+		Network:        agreementNetwork,
 		Ledger:         agreementLedger,
 		BlockFactory:   node,
 		BlockValidator: blockValidator,
 		KeyManager:     node,
 		RandomSource:   node,
 		BacklogPool:    node.highPriorityCryptoVerificationPool,
+		EventListener:  gossip.BandwidthListener(agreementNetwork),
+	}
+	if cfg.EnableBatchVoteVerification {
+		agreementParameters.VoteVerifier = agreement.MakeBatchVoteVerifier()
 	}
 	node.agreementService, err = agreement.MakeService(agreementParameters)
 	if err != nil {
@@ -315,6 +336,14 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 
+	if cfg.DatabaseMaintenanceInterval > 0 {
+		node.dbMaintainer = db.MakeMaintainer(log, cfg.DatabaseMaintenanceInterval, cfg.DatabaseMaintenanceFreePagesPercent, cfg.DatabaseMaintenancePagesPerStep, node.databasesIdle)
+		for name, ldb := range node.ledger.MaintainableDatabases() {
+			node.dbMaintainer.Register(name, ldb)
+		}
+		node.dbMaintainer.Register("agreement", &crashAccess)
+	}
+
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
 	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
 	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
@@ -377,7 +406,13 @@ func (node *AlgorandFullNode) Start() error {
 		node.catchpointCatchupService.Start(node.ctx)
 	} else {
 		node.catchupService.Start()
+		if node.agreementDiscipline != nil {
+			node.agreementDiscipline.Start()
+		}
 		node.agreementService.Start()
+		if node.dbMaintainer != nil {
+			node.dbMaintainer.Start()
+		}
 		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
 		node.blockService.Start()
 		node.ledgerService.Start()
@@ -466,8 +501,19 @@ func (node *AlgorandFullNode) Stop() {
 		node.heartbeatService.Stop()
 		node.stateProofWorker.Stop()
 		node.txHandler.Stop()
-		node.agreementService.Shutdown()
+		if node.dbMaintainer != nil {
+			node.dbMaintainer.Stop()
+		}
+		if snapshot := node.agreementService.Shutdown(); snapshot != nil {
+			err := agreement.PersistSnapshot(node.agreementService.Accessor, snapshot)
+			if err != nil {
+				node.log.Warnf("Cannot save agreement snapshot: %v", err)
+			}
+		}
 		node.agreementService.Accessor.Close()
+		if node.agreementDiscipline != nil {
+			node.agreementDiscipline.Stop()
+		}
 		node.catchupService.Stop()
 		node.txPoolSyncerService.Stop()
 		node.blockService.Stop()
@@ -484,6 +530,36 @@ func (node *AlgorandFullNode) Stop() {
 	node.ledger.Close()
 }
 
+// enableCrashIncrementalVacuum switches the crash database to incremental vacuum, unless it already uses it.
+// It may only be called before the agreement service is created.
+func enableCrashIncrementalVacuum(log logging.Logger, crashAccess *db.Accessor) {
+	pageStats, err := crashAccess.GetPageStats(context.Background())
+	if err != nil {
+		log.Warnf("Cannot measure crash database: %v", err)
+		return
+	}
+	if pageStats.IncrementalVacuum {
+		return
+	}
+	log.Infof("Enabling incremental vacuuming of the crash database, which requires vacuuming it")
+	vacuumStats, err := crashAccess.EnableIncrementalVacuum(context.Background())
+	if err != nil {
+		log.Warnf("Enabling incremental vacuuming of the crash database failed : %v", err)
+		return
+	}
+	log.Infof("Enabled incremental vacuuming of the crash database, reducing number of pages from %d to %d", vacuumStats.PagesBefore, vacuumStats.PagesAfter)
+}
+
+// databasesIdle returns true when the node is neither writing to its ledger nor catching up, so that the
+// database maintenance may run.
+func (node *AlgorandFullNode) databasesIdle() bool {
+	if node.ledger.IsWritingAccounts() {
+		return false
+	}
+	synchronizing, _ := node.catchupService.IsSynchronizing()
+	return !synchronizing
+}
+
 // note: unlike the other two functions, this accepts a whole filename
 func (node *AlgorandFullNode) getExistingPartHandle(filename string) (db.Accessor, error) {
 	filename = filepath.Join(node.genesisDirs.RootGenesisDir, filename)
@@ -811,6 +887,134 @@ func (node *AlgorandFullNode) GenesisHash() crypto.Digest {
 	return node.genesisHash
 }
 
+// LateProposers summarizes the proposers whose credentials arrived after the
+// filter timeout in recent rounds.
+func (node *AlgorandFullNode) LateProposers() []agreement.LateProposer {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	return node.agreementService.LateProposers()
+}
+
+// ErrPhonebookUnavailable is returned by the phonebook administration methods
+// if the network of the node doesn't support them.
+var ErrPhonebookUnavailable = errors.New("the network of the node does not support phonebook administration")
+
+// PhonebookEntries returns a snapshot of the entries of the network phonebook.
+func (node *AlgorandFullNode) PhonebookEntries() ([]phonebook.Entry, error) {
+	admin, ok := node.net.(network.PhonebookAdmin)
+	if !ok {
+		return nil, ErrPhonebookUnavailable
+	}
+	return admin.PhonebookEntries(), nil
+}
+
+// AddPhonebookPeers adds persistent peers with the given role to the network phonebook.
+func (node *AlgorandFullNode) AddPhonebookPeers(addrs []string, role phonebook.Role) error {
+	admin, ok := node.net.(network.PhonebookAdmin)
+	if !ok {
+		return ErrPhonebookUnavailable
+	}
+	return admin.AddPhonebookPeers(addrs, role)
+}
+
+// RemovePhonebookPeer removes a peer from the network phonebook, returning
+// false if it wasn't there.
+func (node *AlgorandFullNode) RemovePhonebookPeer(addr string) (bool, error) {
+	admin, ok := node.net.(network.PhonebookAdmin)
+	if !ok {
+		return false, ErrPhonebookUnavailable
+	}
+	return admin.RemovePhonebookPeer(addr), nil
+}
+
+// PersistentPeers returns the connectivity of the persistent peers of the network, or nil if
+// the network does not maintain them separately.
+func (node *AlgorandFullNode) PersistentPeers() []network.PersistentPeerStatus {
+	reporter, ok := node.net.(network.PersistentPeersReporter)
+	if !ok {
+		return nil
+	}
+	return reporter.PersistentPeers()
+}
+
+// ErrRelayDrainUnavailable is returned by the relay drain methods when the network of the node
+// can't be drained.
+var ErrRelayDrainUnavailable = errors.New("the network of the node does not support draining")
+
+// StartRelayDrain stops accepting incoming connections, and asks the incoming peers to move to other relays.
+func (node *AlgorandFullNode) StartRelayDrain() error {
+	drainer, ok := node.net.(network.RelayDrainer)
+	if !ok {
+		return ErrRelayDrainUnavailable
+	}
+	drainer.StartDrain()
+	return nil
+}
+
+// StopRelayDrain accepts incoming connections again.
+func (node *AlgorandFullNode) StopRelayDrain() error {
+	drainer, ok := node.net.(network.RelayDrainer)
+	if !ok {
+		return ErrRelayDrainUnavailable
+	}
+	drainer.StopDrain()
+	return nil
+}
+
+// RelayDrainStatus returns the progress of the draining of the node.
+func (node *AlgorandFullNode) RelayDrainStatus() (network.RelayDrainStatus, error) {
+	drainer, ok := node.net.(network.RelayDrainer)
+	if !ok {
+		return network.RelayDrainStatus{}, ErrRelayDrainUnavailable
+	}
+	return drainer.DrainStatus(), nil
+}
+
+// ErrAccountHistoryRoundUnavailable is returned by LookupAccountHistory if the requested
+// round is not covered by the account history of the ledger.
+var ErrAccountHistoryRoundUnavailable = errors.New("the round is not covered by the account history")
+
+// LookupAccountHistory returns the account data of the given address at the given round, and its
+// balance without the pending rewards. The round may be older than the rounds kept in memory when
+// the ledger keeps the account history.
+func (node *AlgorandFullNode) LookupAccountHistory(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.MicroAlgos, error) {
+	return lookupAccountHistory(node.ledger, round, addr)
+}
+
+func lookupAccountHistory(l *data.Ledger, round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.MicroAlgos, error) {
+	if latest := l.Latest(); round > latest {
+		return ledgercore.AccountData{}, basics.MicroAlgos{}, fmt.Errorf("%w: round %d is after the latest round %d", ErrAccountHistoryRoundUnavailable, round, latest)
+	}
+	data, withoutRewards, err := l.LookupAccountHistory(round, addr)
+	var roundOffsetError *ledger.RoundOffsetError
+	if errors.As(err, &roundOffsetError) {
+		return ledgercore.AccountData{}, basics.MicroAlgos{}, fmt.Errorf("%w: %v", ErrAccountHistoryRoundUnavailable, err)
+	}
+	return data, withoutRewards, err
+}
+
+// AccountProof returns a proof that the balance record of the account is committed to by the root of the
+// accounts merkle trie of the ledger.
+func (node *AlgorandFullNode) AccountProof(addr basics.Address) (ledger.AccountProof, error) {
+	return node.ledger.AccountProof(addr)
+}
+
+// ErrLedgerSnapshotDuringCatchup is returned by ExportLedgerSnapshot while the node is catching up from a catchpoint.
+var ErrLedgerSnapshotDuringCatchup = errors.New("the ledger cannot be exported while catching up from a catchpoint")
+
+// ExportLedgerSnapshot writes a snapshot of the ledger to w, which can be imported on another machine
+// with ledger.ImportSnapshot before starting its node.
+func (node *AlgorandFullNode) ExportLedgerSnapshot(ctx context.Context, w io.Writer) (ledger.SnapshotHeader, error) {
+	node.mu.Lock()
+	catchingUp := node.catchpointCatchupService != nil
+	node.mu.Unlock()
+	if catchingUp {
+		return ledger.SnapshotHeader{}, ErrLedgerSnapshotDuringCatchup
+	}
+	return node.ledger.ExportSnapshot(ctx, w)
+}
+
 // SuggestedFee returns the suggested fee per byte recommended to ensure a new transaction is processed in a timely fashion.
 // Caller should set fee to max(MinTxnFee, SuggestedFee() * len(encoded SignedTxn))
 func (node *AlgorandFullNode) SuggestedFee() basics.MicroAlgos {
@@ -1232,7 +1436,13 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 			node.heartbeatService.Stop()
 			node.stateProofWorker.Stop()
 			node.txHandler.Stop()
+			if node.dbMaintainer != nil {
+				node.dbMaintainer.Stop()
+			}
 			node.agreementService.Shutdown()
+			if node.agreementDiscipline != nil {
+				node.agreementDiscipline.Stop()
+			}
 			node.catchupService.Stop()
 			node.txPoolSyncerService.Stop()
 			node.blockService.Stop()
@@ -1252,7 +1462,13 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 		// start
 		node.transactionPool.Reset()
 		node.catchupService.Start()
+		if node.agreementDiscipline != nil {
+			node.agreementDiscipline.Start()
+		}
 		node.agreementService.Start()
+		if node.dbMaintainer != nil {
+			node.dbMaintainer.Start()
+		}
 		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
 		node.blockService.Start()
 		node.ledgerService.Start()
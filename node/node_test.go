@@ -801,6 +801,12 @@ func TestMaxSizesCorrect(t *testing.T) {
 	require.Equal(t, nsSize, protocol.NetIDVerificationTag.MaxMessageSize())
 	ppSize := uint64(agreement.TransmittedPayloadMaxSize())
 	require.Equal(t, ppSize, protocol.ProposalPayloadTag.MaxMessageSize())
+	pxSize := uint64(network.PeerExchangeMessageSignedMaxSize())
+	require.Equal(t, pxSize, protocol.PeerExchangeTag.MaxMessageSize())
+	gbSize := uint64(network.RelayGoodbyeMessageMaxSize())
+	require.Equal(t, gbSize, protocol.RelayGoodbyeTag.MaxMessageSize())
+	pcSize := uint64(agreement.ProposalChunkMaxSize())
+	require.Equal(t, pcSize, protocol.ProposalChunkTag.MaxMessageSize())
 	spSize := uint64(stateproof.SigFromAddrMaxSize())
 	require.Equal(t, spSize, protocol.StateProofSigTag.MaxMessageSize())
 	msSize := uint64(crypto.DigestMaxSize())
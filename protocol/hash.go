@@ -52,6 +52,7 @@ const (
 	NetIdentityChallengeResponse     HashID = "NIR"
 	NetIdentityVerificationMessage   HashID = "NIV"
 	NetPrioResponse                  HashID = "NPR"
+	NetPeerExchange                  HashID = "NPX"
 	OnlineAccount                    HashID = "OA"
 	OnlineRoundParams                HashID = "ORP"
 	OneTimeSigKey1                   HashID = "OT1"
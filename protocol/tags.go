@@ -33,9 +33,12 @@ const (
 	MsgDigestSkipTag     Tag = "MS"
 	NetPrioResponseTag   Tag = "NP"
 	NetIDVerificationTag Tag = "NI"
+	PeerExchangeTag      Tag = "PX"
 	PingTag              Tag = "pi" // was removed in 3.2.1
 	PingReplyTag         Tag = "pj" // was removed in 3.2.1
+	ProposalChunkTag     Tag = "PC"
 	ProposalPayloadTag   Tag = "PP"
+	RelayGoodbyeTag      Tag = "GB"
 	StateProofSigTag     Tag = "SP"
 	TopicMsgRespTag      Tag = "TS"
 	TxnTag               Tag = "TX"
@@ -54,7 +57,7 @@ const (
 const AgreementVoteTagMaxSize = 1228
 
 // MsgOfInterestTagMaxSize is the maximum size of a MsgOfInterestTag message
-const MsgOfInterestTagMaxSize = 45
+const MsgOfInterestTagMaxSize = 54
 
 // MsgDigestSkipTagMaxSize is the maximum size of a MsgDigestSkipTag message
 const MsgDigestSkipTagMaxSize = 69
@@ -65,10 +68,19 @@ const NetPrioResponseTagMaxSize = 850
 // NetIDVerificationTagMaxSize is the maximum size of a NetIDVerificationTag message
 const NetIDVerificationTagMaxSize = 215
 
+// PeerExchangeTagMaxSize is the maximum size of a PeerExchangeTag message
+const PeerExchangeTagMaxSize = 9430
+
+// ProposalChunkTagMaxSize is the maximum size of a ProposalChunkTag message
+const ProposalChunkTagMaxSize = 262197
+
 // ProposalPayloadTagMaxSize is the maximum size of a ProposalPayloadTag message
 // This value is dominated by the MaxTxnBytesPerBlock
 const ProposalPayloadTagMaxSize = 5250313
 
+// RelayGoodbyeTagMaxSize is the maximum size of a RelayGoodbyeTag message
+const RelayGoodbyeTagMaxSize = 13
+
 // StateProofSigTagMaxSize is the maximum size of a StateProofSigTag message
 const StateProofSigTagMaxSize = 6378
 
@@ -116,8 +128,14 @@ func (tag Tag) MaxMessageSize() uint64 {
 		return NetPrioResponseTagMaxSize
 	case NetIDVerificationTag:
 		return NetIDVerificationTagMaxSize
+	case PeerExchangeTag:
+		return PeerExchangeTagMaxSize
+	case ProposalChunkTag:
+		return ProposalChunkTagMaxSize
 	case ProposalPayloadTag:
 		return ProposalPayloadTagMaxSize
+	case RelayGoodbyeTag:
+		return RelayGoodbyeTagMaxSize
 	case StateProofSigTag:
 		return StateProofSigTagMaxSize
 	case TopicMsgRespTag:
@@ -140,7 +158,10 @@ var TagList = []Tag{
 	MsgDigestSkipTag,
 	NetIDVerificationTag,
 	NetPrioResponseTag,
+	PeerExchangeTag,
+	ProposalChunkTag,
 	ProposalPayloadTag,
+	RelayGoodbyeTag,
 	StateProofSigTag,
 	TopicMsgRespTag,
 	TxnTag,
@@ -283,7 +283,10 @@ func TestLockdownTagList(t *testing.T) {
 		MsgDigestSkipTag,
 		NetIDVerificationTag,
 		NetPrioResponseTag,
+		PeerExchangeTag,
+		ProposalChunkTag,
 		ProposalPayloadTag,
+		RelayGoodbyeTag,
 		StateProofSigTag,
 		TopicMsgRespTag,
 		TxnTag,
@@ -19,6 +19,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"regexp"
@@ -34,6 +35,7 @@ var numRegex = regexp.MustCompile(`^\d+$`)
 var filename = flag.String("file", "", "Name of the input cadaver file (otherwise, use stdin)")
 var versionCheck = flag.Bool("version", false, "Display current coroner build version and exit")
 var printmsgpack = flag.Bool("msgpack", false, "If provided, emit msgpack instead of a string")
+var replay = flag.Bool("replay", false, "If provided, re-drive the state machine with the recorded events and report divergent actions")
 
 var skipHead = flag.String("skip-head", "", "The first round to trim before")
 var skipTail = flag.String("skip-tail", "", "The last round to trim after")
@@ -100,6 +102,11 @@ func main() {
 		filter.Last = basics.Round(parseRoundBound(*skipTail))
 	}
 
+	if *replay {
+		replayAutopsy(autopsy, filter)
+		return
+	}
+
 	var commitHash string
 	if *printmsgpack {
 		commitHash = autopsy.DumpMessagePack(filter, os.Stdout)
@@ -110,3 +117,19 @@ func main() {
 		log.Printf("coroner: cadaver version mismatches coroner version:\n(%s (cadaver) != %s (coroner))\n", commitHash, version.GetCommitHash())
 	}
 }
+
+func replayAutopsy(autopsy *agreement.Autopsy, filter agreement.AutopsyFilter) {
+	total, diverged := 0, 0
+	autopsy.Replay(func(step agreement.ReplayStep) {
+		if filter.Enabled && (step.Round < filter.First || step.Round > filter.Last) {
+			return
+		}
+		total++
+		fmt.Printf("(%d, %d, %d) %s -> %v\n", step.Round, step.Period, step.Step, step.Event, step.Actions)
+		if step.Diverged {
+			diverged++
+			fmt.Printf("  diverged: recorded %v\n", step.Recorded)
+		}
+	})
+	log.Printf("coroner: replayed %d events, %d diverged from the recorded actions\n", total, diverged)
+}
@@ -70,7 +70,27 @@ func ReadFromSRV(ctx context.Context, service string, protocol string, name stri
 	if err != nil {
 		return addrs, err
 	}
+	return srvAddresses(records), nil
+}
+
+// ReadFromSRVWithResolver is like ReadFromSRV, but only uses the given resolver for the lookup.
+func ReadFromSRVWithResolver(ctx context.Context, resolver ResolverIf, service string, protocol string, name string) (addrs []string, err error) {
+	if name == "" {
+		logging.Base().Debug("no dns lookup due to empty name")
+		return
+	}
+	if protocol != "tcp" && protocol != "udp" && protocol != "tls" {
+		err = fmt.Errorf("unsupported protocol '%s' specified", protocol)
+		return
+	}
+	_, records, err := resolver.LookupSRV(ctx, service, protocol, name)
+	if err != nil {
+		return addrs, fmt.Errorf("ReadFromSRVWithResolver: DNS LookupSRV failed: %w", err)
+	}
+	return srvAddresses(records), nil
+}
 
+func srvAddresses(records []*net.SRV) (addrs []string) {
 	for _, srv := range records {
 		// empty target won't take us far; skip these
 		if srv.Target == "" {
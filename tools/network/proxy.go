@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// ContextDialer establishes connections on behalf of a caller, possibly through a proxy.
+type ContextDialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// MakeProxyDialer creates a ContextDialer which connects through the proxy at proxyURL.
+// Supported schemes are socks5, socks5h and http (using the CONNECT method); credentials
+// may be embedded in the URL. Host names are passed to the proxy unresolved, so that the
+// proxy performs the DNS resolution of the destination.
+func MakeProxyDialer(proxyURL string, forward *net.Dialer) (ContextDialer, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy url '%s': %w", proxyURL, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy url '%s': missing host", proxyURL)
+	}
+	if forward == nil {
+		forward = &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	}
+
+	switch u.Scheme {
+	case "socks5", "socks5h":
+		d, err := proxy.FromURL(u, forward)
+		if err != nil {
+			return nil, err
+		}
+		cd, ok := d.(proxy.ContextDialer)
+		if !ok {
+			return nil, fmt.Errorf("proxy dialer for '%s' does not support contexts", u.Scheme)
+		}
+		return cd, nil
+	case "http":
+		return &httpConnectDialer{proxyAddr: u.Host, user: u.User, forward: forward}, nil
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme '%s'", u.Scheme)
+	}
+}
+
+// httpConnectDialer tunnels connections through an HTTP proxy using the CONNECT method.
+type httpConnectDialer struct {
+	proxyAddr string
+	user      *url.Userinfo
+	forward   *net.Dialer
+}
+
+func (d *httpConnectDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if network != "tcp" && network != "tcp4" && network != "tcp6" {
+		return nil, fmt.Errorf("http proxy does not support network '%s'", network)
+	}
+	conn, err := d.forward.DialContext(ctx, "tcp", d.proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if d.user != nil {
+		password, _ := d.user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(d.user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	// make sure the handshake does not outlive the context
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	if err = req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("http proxy CONNECT to %s failed: %s", address, resp.Status)
+	}
+	if br.Buffered() > 0 {
+		// the proxy has already sent data from the destination; don't lose it
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a net.Conn whose reads are served from a bufio.Reader first.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// MakeProxiedResolver creates a resolver which sends its DNS queries over TCP to dnsAddress
+// through the given dialer. If dnsAddress is empty or is not an IP address, the default DNS server is used.
+func MakeProxiedResolver(dialer ContextDialer, dnsAddress string) *net.Resolver {
+	if net.ParseIP(dnsAddress) == nil {
+		dnsAddress = defaultDNSAddress
+	}
+	server := net.JoinHostPort(dnsAddress, "53")
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			// proxies generally only carry streams, so the queries are made over TCP
+			return dialer.DialContext(ctx, "tcp", server)
+		},
+	}
+}
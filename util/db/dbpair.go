@@ -48,6 +48,23 @@ func OpenPair(filename string, memory bool) (p Pair, err error) {
 	return
 }
 
+// OpenReadOnlyPair opens the existing filename with two accessors which can't write to it, using
+// MakeReadOnlyAccessor, so that the pair can be used where a read/write pair is expected.
+func OpenReadOnlyPair(filename string) (p Pair, err error) {
+	p.Rdb, err = MakeReadOnlyAccessor(filename)
+	if err != nil {
+		return
+	}
+
+	p.Wdb, err = MakeReadOnlyAccessor(filename)
+	if err != nil {
+		p.Rdb.Close()
+		return
+	}
+
+	return
+}
+
 // OpenErasablePair opens the filename with both reading and writing accessors
 // with the secure_delete pragma set, using MakeErasableAccessor.
 func OpenErasablePair(filename string) (p Pair, err error) {
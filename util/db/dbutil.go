@@ -92,6 +92,13 @@ func MakeErasableAccessor(dbfilename string) (Accessor, error) {
 	return makeErasableAccessor(dbfilename, false)
 }
 
+// MakeReadOnlyAccessor creates a new Accessor to an existing database which can't be written through it.
+// The database may be written by another connection or process meanwhile, each transaction of the
+// Accessor reading a consistent snapshot of it.
+func MakeReadOnlyAccessor(dbfilename string) (Accessor, error) {
+	return makeAccessorImpl(dbfilename, true, false, []string{"mode=ro", "_query_only=true"})
+}
+
 func makeErasableAccessor(dbfilename string, readOnly bool) (Accessor, error) {
 	return makeAccessorImpl(dbfilename, readOnly, false, []string{"_secure_delete=on", "_journal_mode=wal"})
 }
@@ -342,6 +349,13 @@ func ResetTransactionWarnDeadline(ctx context.Context, tx interface{}, deadline
 	return
 }
 
+// CopyTo writes a copy of the database to the given file, which must either not exist or be empty. The copy is made
+// within a single read transaction, so it is consistent even when the database is being written to meanwhile.
+func (db *Accessor) CopyTo(ctx context.Context, filename string) error {
+	_, err := db.Handle.ExecContext(ctx, "VACUUM INTO ?", filename)
+	return err
+}
+
 // Vacuum perform a full-vacuum on the given database. In order for the vacuum to succeed, the storage needs to have
 // double the amount of the current database size ( roughly ), and we cannot have any other transaction ( either read
 // or write ) being active.
@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var dbPagesGauge = metrics.MakeGauge(
+	metrics.MetricName{Name: "algod_db_pages", Description: "Number of pages of the maintained databases"})
+var dbFreePagesGauge = metrics.MakeGauge(
+	metrics.MetricName{Name: "algod_db_free_pages", Description: "Number of unused pages of the maintained databases"})
+var dbReclaimedPagesCounter = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_db_maintenance_reclaimed_pages", Description: "Number of unused pages reclaimed by the database maintenance"})
+var dbOptimizationsCounter = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_db_maintenance_optimizations", Description: "Number of times the database maintenance refreshed the query planner statistics"})
+
+// maintenanceStepPause is the pause between two incremental vacuum steps, letting the other connections
+// use the database.
+const maintenanceStepPause = 100 * time.Millisecond
+
+// autoVacuumIncremental is the value of the auto_vacuum pragma of databases using incremental vacuum.
+const autoVacuumIncremental = 2
+
+// PageStats holds the page statistics of a database, which measure how fragmented it is.
+type PageStats struct {
+	// PageSize is the size of a page, in bytes
+	PageSize uint64
+	// PageCount is the number of pages of the database, including the free ones
+	PageCount uint64
+	// FreelistCount is the number of unused pages of the database
+	FreelistCount uint64
+	// IncrementalVacuum is set when the unused pages can be reclaimed by IncrementalVacuum
+	IncrementalVacuum bool
+}
+
+// GetPageStats returns the page statistics of the database.
+func (db *Accessor) GetPageStats(ctx context.Context) (stats PageStats, err error) {
+	stats.PageSize, err = db.GetPageSize(ctx)
+	if err != nil {
+		return
+	}
+	stats.PageCount, err = db.GetPageCount(ctx)
+	if err != nil {
+		return
+	}
+	err = db.Handle.QueryRowContext(ctx, "PRAGMA freelist_count").Scan(&stats.FreelistCount)
+	if err != nil {
+		return
+	}
+	var autoVacuum int
+	err = db.Handle.QueryRowContext(ctx, "PRAGMA auto_vacuum").Scan(&autoVacuum)
+	stats.IncrementalVacuum = autoVacuum == autoVacuumIncremental
+	return
+}
+
+// IncrementalVacuum reclaims up to the given number of unused pages of a database using incremental vacuum.
+// Unlike a full vacuum, it doesn't renumber the rows, and it may run while the database is in use.
+func (db *Accessor) IncrementalVacuum(ctx context.Context, pages uint64) error {
+	if db.readOnly {
+		return fmt.Errorf("read-only database was used to attempt and perform incremental vacuuming")
+	}
+	// the pragma returns a row per reclaimed page, which have to be read for the vacuum to proceed
+	rows, err := db.Handle.QueryContext(ctx, fmt.Sprintf("PRAGMA incremental_vacuum(%d)", pages))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		// each row reports a reclaimed page
+	}
+	return rows.Err()
+}
+
+// Optimize refreshes the query planner statistics of the database when they are likely out of date.
+func (db *Accessor) Optimize(ctx context.Context) error {
+	_, err := db.Handle.ExecContext(ctx, "PRAGMA optimize")
+	return err
+}
+
+// EnableIncrementalVacuum switches the database to incremental vacuum, which requires a full vacuum.
+// Like Vacuum, it should only be used while no other transaction is active.
+func (db *Accessor) EnableIncrementalVacuum(ctx context.Context) (stats VacuumStats, err error) {
+	if db.readOnly {
+		return stats, fmt.Errorf("read-only database was used to attempt and enable incremental vacuuming")
+	}
+	if db.inMemory {
+		return stats, nil
+	}
+	// the auto_vacuum setting is applied by the vacuum of the same connection
+	conn, err := db.Handle.Conn(ctx)
+	if err != nil {
+		return stats, err
+	}
+	defer conn.Close()
+	_, err = conn.ExecContext(ctx, "PRAGMA auto_vacuum=INCREMENTAL")
+	if err != nil {
+		return stats, err
+	}
+	pageSize, err := db.GetPageSize(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.PagesBefore, err = db.GetPageCount(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.SizeBefore = pageSize * stats.PagesBefore
+	_, err = conn.ExecContext(ctx, "VACUUM")
+	if err != nil {
+		return stats, err
+	}
+	stats.PagesAfter, err = db.GetPageCount(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.SizeAfter = pageSize * stats.PagesAfter
+	return stats, nil
+}
+
+// Maintainable is a database which can be maintained in the background while it is in use.
+type Maintainable interface {
+	GetPageStats(ctx context.Context) (PageStats, error)
+	IncrementalVacuum(ctx context.Context, pages uint64) error
+	Optimize(ctx context.Context) error
+}
+
+type maintainedDB struct {
+	name string
+	db   Maintainable
+	// fragmentationReported is set once the log reported that the database needs a full vacuum
+	fragmentationReported bool
+}
+
+// Maintainer periodically measures the fragmentation of databases and, while the node is idle, refreshes
+// their query planner statistics and reclaims their unused pages, a few at a time.
+type Maintainer struct {
+	log              logging.Logger
+	interval         time.Duration
+	freePagesPercent uint64
+	pagesPerStep     uint64
+	idle             func() bool
+	dbs              []*maintainedDB
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// MakeMaintainer creates a Maintainer checking its databases every interval. The unused pages of a database
+// are reclaimed once they make freePagesPercent of its pages, pagesPerStep pages at a time, for as long as
+// idle returns true.
+func MakeMaintainer(log logging.Logger, interval time.Duration, freePagesPercent uint64, pagesPerStep uint64, idle func() bool) *Maintainer {
+	if pagesPerStep == 0 {
+		pagesPerStep = 1
+	}
+	return &Maintainer{
+		log:              log,
+		interval:         interval,
+		freePagesPercent: freePagesPercent,
+		pagesPerStep:     pagesPerStep,
+		idle:             idle,
+	}
+}
+
+// Register adds a database to maintain, named after its role in the metrics and the log.
+// It must be called before Start.
+func (m *Maintainer) Register(name string, db Maintainable) {
+	m.dbs = append(m.dbs, &maintainedDB{name: name, db: db})
+}
+
+// Start begins the periodic maintenance.
+func (m *Maintainer) Start() {
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	m.wg.Add(1)
+	go m.loop()
+}
+
+// Stop stops the maintenance, waiting for any step in progress.
+func (m *Maintainer) Stop() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	m.wg.Wait()
+	m.cancel = nil
+}
+
+func (m *Maintainer) loop() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, mdb := range m.dbs {
+			err := m.maintain(m.ctx, mdb)
+			if err != nil && m.ctx.Err() == nil {
+				m.log.Warnf("db.Maintainer: unable to maintain the %s database: %v", mdb.name, err)
+			}
+		}
+	}
+}
+
+// maintain measures a database, and maintains it while the node is idle.
+func (m *Maintainer) maintain(ctx context.Context, mdb *maintainedDB) error {
+	labels := map[string]string{"db": mdb.name}
+	stats, err := mdb.db.GetPageStats(ctx)
+	if err != nil {
+		return err
+	}
+	dbPagesGauge.SetLabels(stats.PageCount, labels)
+	dbFreePagesGauge.SetLabels(stats.FreelistCount, labels)
+
+	if !m.idle() {
+		return nil
+	}
+	err = mdb.db.Optimize(ctx)
+	if err != nil {
+		return err
+	}
+	dbOptimizationsCounter.Inc(labels)
+
+	if stats.FreelistCount == 0 || stats.FreelistCount*100 < stats.PageCount*m.freePagesPercent {
+		return nil
+	}
+	if !stats.IncrementalVacuum {
+		if !mdb.fragmentationReported {
+			m.log.Infof("db.Maintainer: %d of the %d pages of the %s database are unused, and can only be reclaimed by vacuuming it on startup", stats.FreelistCount, stats.PageCount, mdb.name)
+			mdb.fragmentationReported = true
+		}
+		return nil
+	}
+
+	for stats.FreelistCount > 0 && m.idle() {
+		err = mdb.db.IncrementalVacuum(ctx, m.pagesPerStep)
+		if err != nil {
+			return err
+		}
+		freelistCount := stats.FreelistCount
+		stats, err = mdb.db.GetPageStats(ctx)
+		if err != nil {
+			return err
+		}
+		if stats.FreelistCount < freelistCount {
+			dbReclaimedPagesCounter.AddUint64(freelistCount-stats.FreelistCount, labels)
+		}
+		dbPagesGauge.SetLabels(stats.PageCount, labels)
+		dbFreePagesGauge.SetLabels(stats.FreelistCount, labels)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(maintenanceStepPause):
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDBMaintenance(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ctx := context.Background()
+	acc, err := MakeAccessor(filepath.Join(t.TempDir(), "maintained.db"), false, false)
+	require.NoError(t, err)
+	defer acc.Close()
+
+	stats, err := acc.GetPageStats(ctx)
+	require.NoError(t, err)
+	require.False(t, stats.IncrementalVacuum)
+	_, err = acc.EnableIncrementalVacuum(ctx)
+	require.NoError(t, err)
+	stats, err = acc.GetPageStats(ctx)
+	require.NoError(t, err)
+	require.True(t, stats.IncrementalVacuum)
+
+	// fill the database and empty it, leaving unused pages behind
+	err = acc.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("CREATE TABLE data (id INTEGER PRIMARY KEY, value BLOB)")
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 1000; i++ {
+			_, err = tx.Exec("INSERT INTO data (value) VALUES (?)", make([]byte, 4096))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	err = acc.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM data")
+		return err
+	})
+	require.NoError(t, err)
+	stats, err = acc.GetPageStats(ctx)
+	require.NoError(t, err)
+	require.Greater(t, stats.FreelistCount, uint64(1000))
+
+	idle := false
+	m := MakeMaintainer(logging.TestingLog(t), 0, 10, 256, func() bool { return idle })
+	m.Register("test", &acc)
+
+	// nothing is reclaimed while the node is busy
+	err = m.maintain(ctx, m.dbs[0])
+	require.NoError(t, err)
+	busyStats, err := acc.GetPageStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, stats, busyStats)
+
+	idle = true
+	err = m.maintain(ctx, m.dbs[0])
+	require.NoError(t, err)
+	idleStats, err := acc.GetPageStats(ctx)
+	require.NoError(t, err)
+	require.Zero(t, idleStats.FreelistCount)
+	require.Less(t, idleStats.PageCount, stats.PageCount)
+}
@@ -0,0 +1,315 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	// ntpEpochOffset is the number of seconds between the NTP epoch (1900)
+	// and the Unix epoch (1970).
+	ntpEpochOffset = 2208988800
+
+	ntpPacketSize   = 48
+	ntpQueryTimeout = 5 * time.Second
+
+	ntpDefaultInterval = 1024 * time.Second
+
+	// ntpMaxDrift bounds the estimated frequency error of the local clock,
+	// matching the tolerance assumed by NTP itself (500 ppm).
+	ntpMaxDrift = 500e-6
+)
+
+var errNTPBadResponse = errors.New("malformed NTP response")
+
+// A Discipline estimates the error of the local clock against a reference.
+type Discipline interface {
+	// Offset returns the duration which must be added to the local clock
+	// to obtain the reference time.
+	Offset() time.Duration
+}
+
+// NTPDiscipline periodically queries NTP servers to estimate the offset and
+// the frequency error of the local clock.
+type NTPDiscipline struct {
+	servers  []string
+	interval time.Duration
+	query    func(server string) (time.Duration, error)
+
+	mu        deadlock.Mutex
+	offset    time.Duration
+	drift     float64
+	sampledAt time.Time
+
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// MakeNTPDiscipline creates an NTPDiscipline which queries the given servers
+// in turn every interval once started. Until the first successful query, the
+// local clock is assumed to be correct.
+func MakeNTPDiscipline(servers []string, interval time.Duration) *NTPDiscipline {
+	var trimmed []string
+	for _, s := range servers {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			trimmed = append(trimmed, s)
+		}
+	}
+	if interval <= 0 {
+		interval = ntpDefaultInterval
+	}
+	return &NTPDiscipline{
+		servers:  trimmed,
+		interval: interval,
+		query:    queryNTP,
+	}
+}
+
+// Start begins querying the NTP servers in the background, unless it already does.
+func (d *NTPDiscipline) Start() {
+	if len(d.servers) == 0 || d.done != nil {
+		return
+	}
+	d.done = make(chan struct{})
+	d.stopped = make(chan struct{})
+	go d.loop()
+}
+
+// Stop stops querying the NTP servers. The last estimate remains in effect.
+func (d *NTPDiscipline) Stop() {
+	if d.done == nil {
+		return
+	}
+	close(d.done)
+	<-d.stopped
+	d.done = nil
+}
+
+func (d *NTPDiscipline) loop() {
+	defer close(d.stopped)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for i := 0; ; i++ {
+		server := d.servers[i%len(d.servers)]
+		offset, err := d.query(server)
+		if err != nil {
+			logging.Base().Infof("NTPDiscipline: could not query %s: %v", server, err)
+		} else {
+			d.update(offset, time.Now())
+		}
+
+		select {
+		case <-ticker.C:
+		case <-d.done:
+			return
+		}
+	}
+}
+
+// update records a new offset measurement taken at the given local time.
+func (d *NTPDiscipline) update(offset time.Duration, at time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.sampledAt.IsZero() {
+		elapsed := at.Sub(d.sampledAt)
+		if elapsed > 0 {
+			drift := float64(offset-d.offset) / float64(elapsed)
+			if drift > ntpMaxDrift {
+				drift = ntpMaxDrift
+			} else if drift < -ntpMaxDrift {
+				drift = -ntpMaxDrift
+			}
+			d.drift = drift
+		}
+	}
+	d.offset = offset
+	d.sampledAt = at
+}
+
+func (d *NTPDiscipline) offsetAt(now time.Time) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.sampledAt.IsZero() {
+		return 0
+	}
+	return d.offset + time.Duration(d.drift*float64(now.Sub(d.sampledAt)))
+}
+
+// Offset implements Discipline. Between queries, the offset is extrapolated
+// from the estimated frequency error of the local clock.
+func (d *NTPDiscipline) Offset() time.Duration {
+	return d.offsetAt(time.Now())
+}
+
+// queryNTP returns the offset of the local clock against the given NTP server
+// using a single SNTP exchange (RFC 4330).
+func queryNTP(server string) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+	conn, err := net.DialTimeout("udp", server, ntpQueryTimeout)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(time.Now().Add(ntpQueryTimeout))
+	if err != nil {
+		return 0, err
+	}
+
+	req := make([]byte, ntpPacketSize)
+	// LI = 0, VN = 4, Mode = 3 (client)
+	req[0] = 0x23
+	t1 := time.Now()
+	putNTPTime(req[40:], t1)
+	_, err = conn.Write(req)
+	if err != nil {
+		return 0, err
+	}
+
+	resp := make([]byte, ntpPacketSize)
+	n, err := conn.Read(resp)
+	t4 := time.Now()
+	if err != nil {
+		return 0, err
+	}
+	return ntpOffset(resp[:n], t1, t4)
+}
+
+// ntpOffset computes the clock offset from an NTP server response, given the
+// local times at which the request was sent and the response was received.
+func ntpOffset(resp []byte, t1, t4 time.Time) (time.Duration, error) {
+	if len(resp) < ntpPacketSize {
+		return 0, errNTPBadResponse
+	}
+	mode := resp[0] & 0x7
+	stratum := resp[1]
+	if mode != 4 || stratum == 0 || stratum > 15 {
+		return 0, errNTPBadResponse
+	}
+	t2 := ntpTime(resp[32:])
+	t3 := ntpTime(resp[40:])
+	return (t2.Sub(t1) + t3.Sub(t4)) / 2, nil
+}
+
+func ntpTime(b []byte) time.Time {
+	secs := int64(binary.BigEndian.Uint32(b[0:])) - ntpEpochOffset
+	frac := int64(binary.BigEndian.Uint32(b[4:]))
+	return time.Unix(secs, (frac*int64(time.Second))>>32)
+}
+
+func putNTPTime(b []byte, t time.Time) {
+	secs := uint32(t.Unix() + ntpEpochOffset)
+	frac := uint32((int64(t.Nanosecond()) << 32) / int64(time.Second))
+	binary.BigEndian.PutUint32(b[0:], secs)
+	binary.BigEndian.PutUint32(b[4:], frac)
+}
+
+// NTP is a Clock whose timeouts are measured against local time corrected by
+// a Discipline, so that a drifting system clock does not skew them.
+type NTP[TimeoutType comparable] struct {
+	discipline Discipline
+	zero       time.Time
+	timeouts   map[TimeoutType]timeout
+}
+
+// MakeNTPClock creates a new disciplined clock with a given zero point, which
+// is expressed in reference time.
+func MakeNTPClock[TimeoutType comparable](zero time.Time, discipline Discipline) Clock[TimeoutType] {
+	return &NTP[TimeoutType]{
+		discipline: discipline,
+		zero:       zero,
+	}
+}
+
+func (m *NTP[TimeoutType]) now() time.Time {
+	return time.Now().Add(m.discipline.Offset())
+}
+
+// Zero returns a new Clock reset to the current reference time.
+func (m *NTP[TimeoutType]) Zero() Clock[TimeoutType] {
+	z := m.now()
+	logging.Base().Debugf("Clock zeroed to %v", z)
+	return MakeNTPClock[TimeoutType](z, m.discipline)
+}
+
+// TimeoutAt returns a channel that will signal when the duration has elapsed.
+func (m *NTP[TimeoutType]) TimeoutAt(delta time.Duration, timeoutType TimeoutType) <-chan time.Time {
+	if m.timeouts == nil {
+		m.timeouts = make(map[TimeoutType]timeout)
+	}
+
+	tmt, ok := m.timeouts[timeoutType]
+	if ok && tmt.delta == delta {
+		return tmt.ch
+	}
+
+	tmt = timeout{delta: delta}
+
+	left := m.zero.Add(delta).Sub(m.now())
+	if left < 0 {
+		ch := make(chan time.Time)
+		close(ch)
+		tmt.ch = ch
+	} else {
+		tmt.ch = time.After(left)
+	}
+	m.timeouts[timeoutType] = tmt
+	return tmt.ch
+}
+
+// Encode implements Clock.Encode.
+func (m *NTP[TimeoutType]) Encode() []byte {
+	return protocol.EncodeReflect(m.zero)
+}
+
+// Decode implements Clock.Decode.
+func (m *NTP[TimeoutType]) Decode(data []byte) (Clock[TimeoutType], error) {
+	var zero time.Time
+	err := protocol.DecodeReflect(data, &zero)
+	if err == nil {
+		logging.Base().Debugf("Clock decoded with zero at %v", zero)
+	} else {
+		logging.Base().Errorf("Clock decoded with zero at %v (err: %v)", zero, err)
+	}
+	return MakeNTPClock[TimeoutType](zero, m.discipline), err
+}
+
+func (m *NTP[TimeoutType]) String() string {
+	return m.zero.String()
+}
+
+// Since returns the reference time that has passed since the clock was last
+// zeroed out.
+func (m *NTP[TimeoutType]) Since() time.Duration {
+	return m.now().Sub(m.zero)
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type fixedDiscipline time.Duration
+
+func (d fixedDiscipline) Offset() time.Duration {
+	return time.Duration(d)
+}
+
+func TestNTPClockOffset(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// the local clock is an hour slow
+	d := fixedDiscipline(time.Hour)
+	c := MakeNTPClock[int](time.Now(), d)
+
+	// a zero point taken from the local clock is an hour in the past
+	require.True(t, polled(c.TimeoutAt(30*time.Minute, 0)))
+	require.GreaterOrEqual(t, c.Since(), time.Hour)
+
+	c = c.Zero()
+	require.Less(t, c.Since(), time.Minute)
+	require.False(t, polled(c.TimeoutAt(time.Minute, 0)))
+
+	c2, err := c.Decode(c.Encode())
+	require.NoError(t, err)
+	require.Less(t, c2.Since(), time.Minute)
+	require.False(t, polled(c2.TimeoutAt(time.Minute, 0)))
+}
+
+func TestNTPDisciplineDrift(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	d := MakeNTPDiscipline([]string{" a ", "", "b"}, 0)
+	require.Equal(t, []string{"a", "b"}, d.servers)
+	require.Equal(t, ntpDefaultInterval, d.interval)
+
+	start := time.Now()
+	require.Zero(t, d.offsetAt(start))
+
+	d.update(time.Millisecond, start)
+	require.Equal(t, time.Millisecond, d.offsetAt(start.Add(time.Hour)))
+
+	// the offset grew by 100us in 1000s, so it is extrapolated at the same rate
+	d.update(time.Millisecond+100*time.Microsecond, start.Add(1000*time.Second))
+	require.InDelta(t, float64(time.Millisecond+200*time.Microsecond), float64(d.offsetAt(start.Add(2000*time.Second))), float64(time.Microsecond))
+
+	// implausible drift is clamped
+	d.update(time.Second, start.Add(1001*time.Second))
+	require.InDelta(t, float64(time.Second+500*time.Microsecond), float64(d.offsetAt(start.Add(1002*time.Second))), float64(time.Microsecond))
+}
+
+func TestNTPDisciplineRestart(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	d := MakeNTPDiscipline([]string{"a"}, time.Hour)
+	var queries int
+	d.query = func(server string) (time.Duration, error) {
+		queries++
+		return time.Millisecond, nil
+	}
+
+	// the discipline is started and stopped along with agreement, which may
+	// happen several times over the life of a node
+	d.Start()
+	d.Start()
+	d.Stop()
+	d.Stop()
+	require.Equal(t, 1, queries)
+
+	d.Start()
+	d.Stop()
+	require.Equal(t, 2, queries)
+	require.Equal(t, time.Millisecond, d.Offset())
+}
+
+func TestNTPOffset(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	t1 := time.Unix(1700000000, 0)
+	t4 := t1.Add(20 * time.Millisecond)
+
+	// the server is 5s ahead and the path is symmetric
+	resp := make([]byte, ntpPacketSize)
+	resp[0] = 0x24
+	resp[1] = 2
+	putNTPTime(resp[32:], t1.Add(5*time.Second+10*time.Millisecond))
+	putNTPTime(resp[40:], t1.Add(5*time.Second+10*time.Millisecond))
+
+	offset, err := ntpOffset(resp, t1, t4)
+	require.NoError(t, err)
+	require.InDelta(t, float64(5*time.Second), float64(offset), float64(time.Microsecond))
+
+	_, err = ntpOffset(resp[:10], t1, t4)
+	require.ErrorIs(t, err, errNTPBadResponse)
+
+	resp[1] = 0 // kiss-o'-death
+	_, err = ntpOffset(resp, t1, t4)
+	require.ErrorIs(t, err, errNTPBadResponse)
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// SimulatedTime is a source of virtual time shared by Simulated clocks.
+//
+// Virtual time advances when Advance is called and, if the SimulatedTime was
+// created with a positive rate, continuously at that multiple of real time.
+type SimulatedTime struct {
+	mu deadlock.Mutex
+
+	rate     float64
+	realBase time.Time
+	virtBase time.Time
+
+	waiters []simulatedWaiter
+}
+
+type simulatedWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// MakeSimulatedTime creates a SimulatedTime which starts at the given time.
+// If rate is positive, virtual time advances rate times as fast as real time;
+// otherwise it only advances through calls to Advance.
+func MakeSimulatedTime(start time.Time, rate float64) *SimulatedTime {
+	return &SimulatedTime{
+		rate:     rate,
+		realBase: time.Now(),
+		virtBase: start.Round(0),
+	}
+}
+
+func (s *SimulatedTime) now() time.Time {
+	if s.rate <= 0 {
+		return s.virtBase
+	}
+	elapsed := time.Duration(float64(time.Since(s.realBase)) * s.rate)
+	return s.virtBase.Add(elapsed)
+}
+
+// Now returns the current virtual time.
+func (s *SimulatedTime) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now()
+}
+
+// Advance moves virtual time forward by d, firing all timeouts which become
+// due.
+func (s *SimulatedTime) Advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if d > 0 {
+		s.virtBase = s.now().Add(d)
+		s.realBase = time.Now()
+	}
+	s.fireDue()
+}
+
+// fireDue closes the channels of all waiters which are due. It must be called
+// with s.mu held.
+func (s *SimulatedTime) fireDue() {
+	now := s.now()
+	pending := s.waiters[:0]
+	for _, w := range s.waiters {
+		if w.at.After(now) {
+			pending = append(pending, w)
+			continue
+		}
+		close(w.ch)
+	}
+	for i := len(pending); i < len(s.waiters); i++ {
+		s.waiters[i] = simulatedWaiter{}
+	}
+	s.waiters = pending
+}
+
+func (s *SimulatedTime) check() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fireDue()
+}
+
+// at returns a channel which is closed once virtual time reaches t.
+func (s *SimulatedTime) at(t time.Time) <-chan time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan time.Time)
+	left := t.Sub(s.now())
+	if left <= 0 {
+		close(ch)
+		return ch
+	}
+	s.waiters = append(s.waiters, simulatedWaiter{at: t, ch: ch})
+	if s.rate > 0 {
+		time.AfterFunc(time.Duration(float64(left)/s.rate), s.check)
+	}
+	return ch
+}
+
+// Simulated is a Clock driven by a SimulatedTime, which allows tests to run
+// faster than real time.
+type Simulated[TimeoutType comparable] struct {
+	source   *SimulatedTime
+	zero     time.Time
+	timeouts map[TimeoutType]timeout
+}
+
+// MakeSimulatedClock creates a new simulated clock which is zeroed at the
+// current virtual time of the given source.
+func MakeSimulatedClock[TimeoutType comparable](source *SimulatedTime) Clock[TimeoutType] {
+	return &Simulated[TimeoutType]{
+		source: source,
+		zero:   source.Now(),
+	}
+}
+
+// Zero returns a new Clock reset to the current virtual time.
+func (m *Simulated[TimeoutType]) Zero() Clock[TimeoutType] {
+	return MakeSimulatedClock[TimeoutType](m.source)
+}
+
+// TimeoutAt returns a channel that will signal when the duration has elapsed
+// in virtual time.
+func (m *Simulated[TimeoutType]) TimeoutAt(delta time.Duration, timeoutType TimeoutType) <-chan time.Time {
+	if m.timeouts == nil {
+		m.timeouts = make(map[TimeoutType]timeout)
+	}
+
+	tmt, ok := m.timeouts[timeoutType]
+	if ok && tmt.delta == delta {
+		return tmt.ch
+	}
+
+	tmt = timeout{delta: delta, ch: m.source.at(m.zero.Add(delta))}
+	m.timeouts[timeoutType] = tmt
+	return tmt.ch
+}
+
+// Encode implements Clock.Encode.
+func (m *Simulated[TimeoutType]) Encode() []byte {
+	return protocol.EncodeReflect(m.zero)
+}
+
+// Decode implements Clock.Decode.
+func (m *Simulated[TimeoutType]) Decode(data []byte) (Clock[TimeoutType], error) {
+	var zero time.Time
+	err := protocol.DecodeReflect(data, &zero)
+	return &Simulated[TimeoutType]{source: m.source, zero: zero}, err
+}
+
+func (m *Simulated[TimeoutType]) String() string {
+	return m.zero.String()
+}
+
+// Since returns the virtual time that has passed since the clock was last
+// zeroed out.
+func (m *Simulated[TimeoutType]) Since() time.Duration {
+	return m.source.Now().Sub(m.zero)
+}
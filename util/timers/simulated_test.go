@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSimulatedAdvance(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	src := MakeSimulatedTime(time.Unix(1000, 0), 0)
+	c := MakeSimulatedClock[int](src)
+
+	ch := c.TimeoutAt(time.Hour, 0)
+	require.Same(t, ch, c.TimeoutAt(time.Hour, 0))
+	require.False(t, polled(ch))
+
+	src.Advance(59 * time.Minute)
+	require.False(t, polled(ch))
+	require.Equal(t, 59*time.Minute, c.Since())
+
+	src.Advance(time.Minute)
+	require.True(t, polled(ch))
+
+	// timeouts which have already passed fire immediately
+	require.True(t, polled(c.TimeoutAt(time.Minute, 1)))
+
+	c = c.Zero()
+	require.Zero(t, c.Since())
+	require.False(t, polled(c.TimeoutAt(time.Second, 0)))
+}
+
+func TestSimulatedRate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	src := MakeSimulatedTime(time.Unix(1000, 0), 1000)
+	c := MakeSimulatedClock[int](src)
+
+	// an hour of virtual time passes in under four seconds
+	select {
+	case <-c.TimeoutAt(time.Hour, 0):
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "simulated timeout did not fire")
+	}
+	require.GreaterOrEqual(t, c.Since(), time.Hour)
+}
+
+func TestSimulatedEncodeDecode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	src := MakeSimulatedTime(time.Unix(1000, 0), 0)
+	c := MakeSimulatedClock[int](src)
+	src.Advance(time.Minute)
+
+	c2, err := c.Decode(c.Encode())
+	require.NoError(t, err)
+	require.Equal(t, c.Since(), c2.Since())
+
+	ch := c2.TimeoutAt(2*time.Minute, 0)
+	require.False(t, polled(ch))
+	src.Advance(time.Minute)
+	require.True(t, polled(ch))
+}